@@ -16,6 +16,7 @@ import (
 
 	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type DataCoord struct {
@@ -51,3 +52,8 @@ func (s *DataCoord) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns DataCoord's states
+func (s *DataCoord) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return s.svr.GetComponentStates(ctx, request)
+}
@@ -17,6 +17,7 @@ import (
 	grpcdatanode "github.com/milvus-io/milvus/internal/distributed/datanode"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type DataNode struct {
@@ -53,3 +54,8 @@ func (d *DataNode) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns DataNode's states
+func (d *DataNode) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return d.svr.GetComponentStates(ctx, request)
+}
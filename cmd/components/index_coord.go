@@ -15,6 +15,7 @@ import (
 	"context"
 
 	grpcindexcoord "github.com/milvus-io/milvus/internal/distributed/indexcoord"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type IndexCoord struct {
@@ -49,3 +50,8 @@ func (s *IndexCoord) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns IndexCoord's states
+func (s *IndexCoord) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return s.svr.GetComponentStates(ctx, request)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	grpcindexnode "github.com/milvus-io/milvus/internal/distributed/indexnode"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type IndexNode struct {
@@ -49,3 +50,8 @@ func (n *IndexNode) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns IndexNode's states
+func (n *IndexNode) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return n.svr.GetComponentStates(ctx, request)
+}
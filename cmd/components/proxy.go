@@ -16,6 +16,8 @@ import (
 
 	grpcproxy "github.com/milvus-io/milvus/internal/distributed/proxy"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 )
 
 type Proxy struct {
@@ -50,3 +52,13 @@ func (n *Proxy) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns Proxy's states
+func (n *Proxy) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return n.svr.GetComponentStates(ctx, request)
+}
+
+// MilvusService returns the milvus service served by Proxy
+func (n *Proxy) MilvusService() milvuspb.MilvusServiceServer {
+	return n.svr
+}
@@ -16,6 +16,7 @@ import (
 
 	grpcquerycoord "github.com/milvus-io/milvus/internal/distributed/querycoord"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type QueryCoord struct {
@@ -51,3 +52,8 @@ func (qs *QueryCoord) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns QueryCoord's states
+func (qs *QueryCoord) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return qs.svr.GetComponentStates(ctx, request)
+}
@@ -16,6 +16,7 @@ import (
 
 	grpcquerynode "github.com/milvus-io/milvus/internal/distributed/querynode"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type QueryNode struct {
@@ -52,3 +53,8 @@ func (q *QueryNode) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns QueryNode's states
+func (q *QueryNode) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return q.svr.GetComponentStates(ctx, request)
+}
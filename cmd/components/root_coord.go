@@ -17,6 +17,7 @@ import (
 
 	rc "github.com/milvus-io/milvus/internal/distributed/rootcoord"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/opentracing/opentracing-go"
 )
 
@@ -55,3 +56,8 @@ func (rc *RootCoord) Stop() error {
 	}
 	return nil
 }
+
+// GetComponentStates returns RootCoord's states
+func (rc *RootCoord) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return rc.svr.GetComponentStates(ctx, request)
+}
@@ -14,9 +14,11 @@ package roles
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -36,8 +38,12 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/console"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/restful"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func newMsgFactory(localMsg bool) msgstream.Factory {
@@ -65,6 +71,20 @@ func (mr *MilvusRoles) EnvValue(env string) bool {
 	return env == "1" || env == "true"
 }
 
+// httpPort returns the port serving prometheus metrics and the health probes
+func (mr *MilvusRoles) httpPort() int {
+	paramtable.Params.Init()
+	port, err := paramtable.Params.LoadWithDefault("http.port", "9091")
+	if err != nil {
+		panic(err)
+	}
+	ret, err := strconv.Atoi(port)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
 func (mr *MilvusRoles) setLogConfigFilename(filename string) *log.Config {
 	paramtable.Params.Init()
 	cfg := paramtable.Params.LogConfig
@@ -350,6 +370,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		rc = mr.runRootCoord(ctx, localMsg)
 		if rc != nil {
 			defer rc.Stop()
+			healthz.Register(typeutil.RootCoordRole, rc)
 		}
 	}
 
@@ -358,6 +379,13 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		pn = mr.runProxy(ctx, localMsg, alias)
 		if pn != nil {
 			defer pn.Stop()
+			healthz.Register(typeutil.ProxyRole, pn)
+			if proxy.Params.ConsoleEnabled {
+				console.Register(pn.MilvusService())
+			}
+			if proxy.Params.RESTfulEnabled {
+				restful.Register(pn.MilvusService())
+			}
 		}
 	}
 
@@ -366,6 +394,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		qs = mr.runQueryCoord(ctx, localMsg)
 		if qs != nil {
 			defer qs.Stop()
+			healthz.Register(typeutil.QueryCoordRole, qs)
 		}
 	}
 
@@ -374,6 +403,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		qn = mr.runQueryNode(ctx, localMsg, alias)
 		if qn != nil {
 			defer qn.Stop()
+			healthz.Register(typeutil.QueryNodeRole, qn)
 		}
 	}
 
@@ -382,6 +412,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		ds = mr.runDataCoord(ctx, localMsg)
 		if ds != nil {
 			defer ds.Stop()
+			healthz.Register(typeutil.DataCoordRole, ds)
 		}
 	}
 
@@ -390,6 +421,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		dn = mr.runDataNode(ctx, localMsg, alias)
 		if dn != nil {
 			defer dn.Stop()
+			healthz.Register(typeutil.DataNodeRole, dn)
 		}
 	}
 
@@ -398,6 +430,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		is = mr.runIndexCoord(ctx, localMsg)
 		if is != nil {
 			defer is.Stop()
+			healthz.Register(typeutil.IndexCoordRole, is)
 		}
 	}
 
@@ -406,6 +439,7 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		in = mr.runIndexNode(ctx, localMsg, alias)
 		if in != nil {
 			defer in.Stop()
+			healthz.Register(typeutil.IndexNodeRole, in)
 		}
 	}
 
@@ -417,7 +451,11 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		}
 	}
 
-	metrics.ServeHTTP()
+	healthz.Handle(http.DefaultServeMux)
+	console.Handle(http.DefaultServeMux)
+	restful.Handle(http.DefaultServeMux)
+	log.HandleLevel(http.DefaultServeMux)
+	metrics.ServeHTTP(mr.httpPort())
 
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc,
@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	grpcproxyclient "github.com/milvus-io/milvus/internal/distributed/proxy/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/util/dmlreplay"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func describeCollection(ctx context.Context, client *grpcproxyclient.Client, name string) (*milvuspb.DescribeCollectionResponse, error) {
+	resp, err := client.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		CollectionName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	return resp, nil
+}
+
+func parseTs(value string) (uint64, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+	return tsoutil.ComposeTS(t.UnixNano()/int64(time.Millisecond), 0), nil
+}
+
+func main() {
+	var proxyAddress, pulsarAddress, source, target, channels, start, end string
+	var sourceID int64
+	var replayDelete bool
+	flagSet := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	flagSet.StringVar(&proxyAddress, "proxyAddress", "localhost:19530", "address of the proxy, the replayed rows are applied through it")
+	flagSet.StringVar(&pulsarAddress, "pulsarAddress", "", "address of pulsar, use the one in config files if empty")
+	flagSet.StringVar(&source, "collection", "", "name of the collection whose dml messages are replayed")
+	flagSet.Int64Var(&sourceID, "collectionID", 0, "id of the source collection, required if the source collection was dropped")
+	flagSet.StringVar(&channels, "channels", "", "comma separated physical dml channels of the source collection, required if the source collection was dropped")
+	flagSet.StringVar(&target, "targetCollection", "", "name of the (restored) collection to apply the rows to, must be loaded")
+	flagSet.StringVar(&start, "start", "", "replay the rows inserted since this time, in RFC3339 format")
+	flagSet.StringVar(&end, "end", "", "replay the rows inserted before this time, in RFC3339 format")
+	flagSet.BoolVar(&replayDelete, "replayDelete", false, "replay the delete messages too")
+	flagSet.Usage = func() {
+		fmt.Fprintf(flagSet.Output(), "Replay the dml messages in the message queue to a collection, rows whose primary keys exist are skipped.\n")
+		flagSet.PrintDefaults()
+	}
+
+	if len(os.Args) > 0 {
+		flagSet.Parse(os.Args[1:])
+	}
+	if source == "" || target == "" || start == "" || end == "" {
+		flagSet.Usage()
+		return
+	}
+
+	startTs, err := parseTs(start)
+	if err != nil {
+		log.Error("failed to parse start time", zap.Error(err))
+		return
+	}
+	endTs, err := parseTs(end)
+	if err != nil {
+		log.Error("failed to parse end time", zap.Error(err))
+		return
+	}
+
+	ctx := context.Background()
+	proxy.Params.Init()
+	if len(pulsarAddress) != 0 {
+		proxy.Params.PulsarAddress = pulsarAddress
+	}
+
+	client, err := grpcproxyclient.NewClient(ctx, proxyAddress)
+	if err != nil {
+		log.Error("failed to create proxy client", zap.Error(err))
+		return
+	}
+	if err = client.Init(); err != nil {
+		log.Error("failed to connect to proxy", zap.Error(err))
+		return
+	}
+
+	var pchannels []string
+	if sourceID == 0 || channels == "" {
+		resp, err := describeCollection(ctx, client, source)
+		if err != nil {
+			log.Error("failed to describe source collection", zap.Error(err))
+			return
+		}
+		sourceID = resp.CollectionID
+		pchannels = resp.PhysicalChannelNames
+	} else {
+		pchannels = strings.Split(channels, ",")
+	}
+	targetResp, err := describeCollection(ctx, client, target)
+	if err != nil {
+		log.Error("failed to describe target collection", zap.Error(err))
+		return
+	}
+
+	factory := msgstream.NewPmsFactory()
+	err = factory.SetParams(map[string]interface{}{
+		"PulsarAddress":  proxy.Params.PulsarAddress,
+		"ReceiveBufSize": 1024,
+		"PulsarBufSize":  1024,
+	})
+	if err != nil {
+		log.Error("failed to set msgstream params", zap.Error(err))
+		return
+	}
+	stream, err := factory.NewTtMsgStream(ctx)
+	if err != nil {
+		log.Error("failed to create msgstream", zap.Error(err))
+		return
+	}
+	// a new subscription consumes from the earliest message retained
+	stream.AsConsumer(pchannels, fmt.Sprintf("dml-replay-%d", time.Now().UnixNano()))
+	stream.Start()
+	defer stream.Close()
+
+	replayer, err := dmlreplay.NewReplayer(stream, client, targetResp.Schema, dmlreplay.Config{
+		SourceCollectionID:   sourceID,
+		SourceCollectionName: source,
+		TargetCollectionName: target,
+		StartTs:              startTs,
+		EndTs:                endTs,
+		ReplayDelete:         replayDelete,
+	})
+	if err != nil {
+		log.Error("failed to create replayer", zap.Error(err))
+		return
+	}
+	result, err := replayer.Run(ctx)
+	if err != nil {
+		log.Error("failed to replay", zap.Error(err))
+		return
+	}
+
+	log.Info("finished",
+		zap.Int64("inserted", result.Inserted),
+		zap.Int64("skipped", result.Skipped),
+		zap.Int64("deleted", result.Deleted))
+}
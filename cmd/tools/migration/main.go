@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/migration"
+)
+
+// pulsarChannelCreator creates the topics of the channels by producing to them
+type pulsarChannelCreator struct {
+	factory msgstream.Factory
+}
+
+func (c *pulsarChannelCreator) CreateChannels(ctx context.Context, channels []string) error {
+	stream, err := c.factory.NewMsgStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	stream.AsProducer(channels)
+	return nil
+}
+
+func main() {
+	var sourceEtcd, targetEtcd, sourceRoot, targetRoot, metaSubPath, sourcePath string
+	var minioAddress, minioAccessKey, minioSecretKey, minioBucket, pulsarAddress, mode string
+	var minioUseSSL, force bool
+	flagSet := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	flagSet.StringVar(&mode, "mode", "check", "check, migrate or verify, check only reports what is going to be migrated")
+	flagSet.StringVar(&sourceEtcd, "sourceEtcd", "localhost:2379", "comma separated endpoints of the etcd of the standalone")
+	flagSet.StringVar(&targetEtcd, "targetEtcd", "", "comma separated endpoints of the etcd of the cluster")
+	flagSet.StringVar(&sourceRoot, "sourceRootPath", "by-dev", "etcd.rootPath of the standalone")
+	flagSet.StringVar(&targetRoot, "targetRootPath", "by-dev", "etcd.rootPath of the cluster")
+	flagSet.StringVar(&metaSubPath, "metaSubPath", "meta", "etcd.metaSubPath of both")
+	flagSet.StringVar(&sourcePath, "sourcePath", "", "local directory of the bucket of the standalone, such as /var/lib/milvus/data/a-bucket")
+	flagSet.StringVar(&minioAddress, "minioAddress", "", "address of the minio or S3 of the cluster")
+	flagSet.StringVar(&minioAccessKey, "minioAccessKey", "", "access key of the minio or S3 of the cluster")
+	flagSet.StringVar(&minioSecretKey, "minioSecretKey", "", "secret key of the minio or S3 of the cluster")
+	flagSet.StringVar(&minioBucket, "minioBucket", "a-bucket", "bucket of the cluster, created if not exist")
+	flagSet.BoolVar(&minioUseSSL, "minioUseSSL", false, "access the minio or S3 of the cluster with ssl")
+	flagSet.StringVar(&pulsarAddress, "pulsarAddress", "", "address of the pulsar of the cluster")
+	flagSet.BoolVar(&force, "force", false, "migrate even if the meta of the cluster is not empty")
+	flagSet.Usage = func() {
+		fmt.Fprintf(flagSet.Output(), "Migrate a stopped standalone into a cluster which is not started yet, all collections should be flushed.\n")
+		flagSet.PrintDefaults()
+	}
+
+	if len(os.Args) > 0 {
+		flagSet.Parse(os.Args[1:])
+	}
+	if targetEtcd == "" || sourcePath == "" || minioAddress == "" || (mode == "migrate" && pulsarAddress == "") {
+		flagSet.Usage()
+		return
+	}
+
+	ctx := context.Background()
+	// the keys are loaded and saved with the root paths
+	sourceMeta, err := etcdkv.NewEtcdKV(strings.Split(sourceEtcd, ","), "")
+	if err != nil {
+		log.Error("failed to connect to the etcd of the standalone", zap.Error(err))
+		return
+	}
+	defer sourceMeta.Close()
+	targetMeta, err := etcdkv.NewEtcdKV(strings.Split(targetEtcd, ","), "")
+	if err != nil {
+		log.Error("failed to connect to the etcd of the cluster", zap.Error(err))
+		return
+	}
+	defer targetMeta.Close()
+
+	minioKV, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           minioAddress,
+		AccessKeyID:       minioAccessKey,
+		SecretAccessKeyID: minioSecretKey,
+		UseSSL:            minioUseSSL,
+		BucketName:        minioBucket,
+		CreateBucket:      mode == "migrate",
+	})
+	if err != nil {
+		log.Error("failed to connect to the minio of the cluster", zap.Error(err))
+		return
+	}
+
+	factory := msgstream.NewPmsFactory()
+	err = factory.SetParams(map[string]interface{}{
+		"PulsarAddress":  pulsarAddress,
+		"ReceiveBufSize": 1024,
+		"PulsarBufSize":  1024,
+	})
+	if err != nil {
+		log.Error("failed to set msgstream params", zap.Error(err))
+		return
+	}
+
+	migrator, err := migration.NewMigrator(sourceMeta, targetMeta,
+		storage.NewLocalChunkManager(sourcePath), storage.NewMinioChunkManager(minioKV),
+		&pulsarChannelCreator{factory: factory},
+		migration.Config{
+			SourceRootPath: sourceRoot,
+			TargetRootPath: targetRoot,
+			MetaSubPath:    metaSubPath,
+			Force:          force,
+		})
+	if err != nil {
+		log.Error("failed to create migrator", zap.Error(err))
+		return
+	}
+
+	var report *migration.Report
+	switch mode {
+	case "check":
+		report, err = migrator.Check()
+	case "migrate":
+		report, err = migrator.Migrate(ctx)
+	case "verify":
+		err = migrator.Verify()
+	default:
+		flagSet.Usage()
+		return
+	}
+	if err != nil {
+		log.Error("failed to "+mode, zap.Error(err))
+		return
+	}
+	if report != nil {
+		log.Info("finished",
+			zap.Int("metaKeys", report.MetaKeys),
+			zap.Int("segments", report.Segments),
+			zap.Int64("rows", report.Rows),
+			zap.Int("indexFiles", report.IndexFiles),
+			zap.Int("objects", report.Objects),
+			zap.Int64("bytes", report.Bytes),
+			zap.Strings("channels", report.Channels))
+		return
+	}
+	log.Info("finished")
+}
@@ -70,6 +70,13 @@ func NewIDAllocator(ctx context.Context, idAlloctor idAllocatorInterface, peerID
 	return a, nil
 }
 
+// SetCountPerRPC sets the num of ids fetched from rootcoord per rpc, it should be called before Start
+func (ia *IDAllocator) SetCountPerRPC(count uint32) {
+	if count > 0 {
+		ia.countPerRPC = count
+	}
+}
+
 func (ia *IDAllocator) Start() error {
 	return ia.Allocator.Start()
 }
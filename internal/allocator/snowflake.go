@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package allocator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 12
+
+	// SnowflakeMaxNodeID is the max node id of the snowflake ids, the node ids are wrapped around it
+	SnowflakeMaxNodeID   = 1<<snowflakeNodeBits - 1
+	snowflakeMaxSequence = 1<<snowflakeSequenceBits - 1
+)
+
+// snowflakeEpoch is the start of the timestamps of the snowflake ids
+var snowflakeEpoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SnowflakeIDAllocator allocates the ids locally without a round trip to rootcoord, an id is composed of
+// 41 bits of milliseconds since 2021-01-01, 10 bits of node id and 12 bits of sequence in the millisecond.
+// The ids are increasing on a node even if the clock goes backwards, and unique among the nodes
+// as long as their node ids differ in the lowest 10 bits
+type SnowflakeIDAllocator struct {
+	mu       sync.Mutex
+	nodeID   int64
+	lastMs   int64
+	sequence int64
+
+	now func() time.Time
+}
+
+func NewSnowflakeIDAllocator(nodeID UniqueID) *SnowflakeIDAllocator {
+	return &SnowflakeIDAllocator{
+		nodeID: nodeID & SnowflakeMaxNodeID,
+		lastMs: -1,
+		now:    time.Now,
+	}
+}
+
+// Alloc allocates count ids, which are increasing but not contiguous
+func (sa *SnowflakeIDAllocator) Alloc(count uint32) ([]UniqueID, error) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	ms := sa.now().Sub(snowflakeEpoch).Milliseconds()
+	if ms < 0 {
+		return nil, fmt.Errorf("clock is before the snowflake epoch %s", snowflakeEpoch)
+	}
+
+	ids := make([]UniqueID, 0, count)
+	for i := uint32(0); i < count; i++ {
+		// borrow from the next milliseconds if the clock goes backwards or the sequence is exhausted,
+		// the clock catches up later
+		if ms <= sa.lastMs {
+			ms = sa.lastMs
+			sa.sequence++
+			if sa.sequence > snowflakeMaxSequence {
+				ms++
+				sa.sequence = 0
+			}
+		} else {
+			sa.sequence = 0
+		}
+		sa.lastMs = ms
+		ids = append(ids, ms<<(snowflakeNodeBits+snowflakeSequenceBits)|sa.nodeID<<snowflakeSequenceBits|sa.sequence)
+	}
+	return ids, nil
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package allocator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflakeIDAllocator(t *testing.T) {
+	now := snowflakeEpoch.Add(time.Hour)
+	sa := NewSnowflakeIDAllocator(SnowflakeMaxNodeID + 3)
+	sa.now = func() time.Time { return now }
+	assert.Equal(t, int64(2), sa.nodeID)
+
+	// the sequence is exhausted in a millisecond
+	ids, err := sa.Alloc(snowflakeMaxSequence + 10)
+	assert.Nil(t, err)
+	assert.Equal(t, snowflakeMaxSequence+10, len(ids))
+	for i := 1; i < len(ids); i++ {
+		assert.Greater(t, ids[i], ids[i-1])
+	}
+	assert.Equal(t, int64(2), ids[0]>>snowflakeSequenceBits&SnowflakeMaxNodeID)
+
+	// the clock goes backwards
+	last := ids[len(ids)-1]
+	now = now.Add(-time.Second)
+	ids, err = sa.Alloc(1)
+	assert.Nil(t, err)
+	assert.Greater(t, ids[0], last)
+
+	// another node
+	other := NewSnowflakeIDAllocator(1)
+	other.now = sa.now
+	otherIDs, err := other.Alloc(1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, ids[0], otherIDs[0])
+
+	sa.now = func() time.Time { return snowflakeEpoch.Add(-time.Hour) }
+	sa.lastMs = -1
+	_, err = sa.Alloc(1)
+	assert.Error(t, err)
+}
@@ -188,6 +188,37 @@ IndexWrapper::BuildWithoutIds(const knowhere::DatasetPtr& dataset) {
     rc.ElapseFromBegin("Done");
 }
 
+bool
+IndexWrapper::SupportStreamingBuild() {
+    return is_in_streaming_build_list(get_index_type());
+}
+
+void
+IndexWrapper::TrainWithoutIds(const knowhere::DatasetPtr& dataset) {
+    auto index_type = get_index_type();
+    auto index_mode = get_index_mode();
+    AssertInfo(SupportStreamingBuild(), std::string(index_type) + " doesn't support streaming build!");
+    config_[knowhere::meta::ROWS] = dataset->Get<int64_t>(knowhere::meta::ROWS);
+    if (index_type == knowhere::IndexEnum::INDEX_FAISS_IVFPQ) {
+        if (!config_.contains(knowhere::IndexParams::nbits)) {
+            config_[knowhere::IndexParams::nbits] = 8;
+        }
+    }
+    auto conf_adapter = knowhere::AdapterMgr::GetInstance().GetAdapter(index_type);
+    AssertInfo(conf_adapter->CheckTrain(config_, index_mode), "something wrong in index parameters!");
+
+    knowhere::TimeRecorder rc("TrainWithoutIds", 1);
+    index_->Train(dataset, config_);
+    trained_ = true;
+    rc.ElapseFromBegin("Done");
+}
+
+void
+IndexWrapper::AddWithoutIds(const knowhere::DatasetPtr& dataset) {
+    AssertInfo(trained_, "index should be trained before adding vectors!");
+    index_->AddWithoutIds(dataset, config_);
+}
+
 void
 IndexWrapper::BuildWithIds(const knowhere::DatasetPtr& dataset) {
     Assert(dataset->data().find(milvus::knowhere::meta::IDS) != dataset->data().end());
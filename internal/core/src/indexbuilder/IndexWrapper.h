@@ -29,6 +29,16 @@ class IndexWrapper {
     void
     BuildWithoutIds(const knowhere::DatasetPtr& dataset);
 
+    // whether the index can be trained on a sample and then fed by chunks, instead of building on all the vectors
+    bool
+    SupportStreamingBuild();
+
+    void
+    TrainWithoutIds(const knowhere::DatasetPtr& dataset);
+
+    void
+    AddWithoutIds(const knowhere::DatasetPtr& dataset);
+
     struct Binary {
         std::vector<char> data;
     };
@@ -98,6 +108,7 @@ class IndexWrapper {
 
  private:
     knowhere::VecIndexPtr index_ = nullptr;
+    bool trained_ = false;
     std::string type_params_;
     std::string index_params_;
     milvus::json type_config_;
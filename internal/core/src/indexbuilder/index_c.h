@@ -26,6 +26,7 @@
 extern "C" {
 #endif
 
+#include <stdbool.h>
 #include <stdint.h>
 #include "segcore/collection_c.h"
 #include "common/type_c.h"
@@ -49,6 +50,17 @@ BuildFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float*
 CStatus
 BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
 
+// The streaming build trains the index on a sample of the vectors, then adds the vectors chunk by chunk, so that
+// the vectors of a segment don't need to be in memory at once. It's only supported by some index types
+CStatus
+IsStreamingBuildSupported(CIndex index, bool* res);
+
+CStatus
+TrainFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+
+CStatus
+AddFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+
 CStatus
 SerializeToSlicedBuffer(CIndex index, CBinary* c_binary);
 
@@ -58,6 +58,18 @@ Need_BuildAll_list() {
     return ret;
 }
 
+// the indexes whose training only needs a sample of the vectors, the others such as the graph indexes are built on
+// all the vectors at once, and the nm indexes need all the raw data to be stored
+std::vector<std::string>
+Streaming_Build_List() {
+    static std::vector<std::string> ret{
+        milvus::knowhere::IndexEnum::INDEX_FAISS_IDMAP,
+        milvus::knowhere::IndexEnum::INDEX_FAISS_IVFPQ,
+        milvus::knowhere::IndexEnum::INDEX_FAISS_IVFSQ8,
+    };
+    return ret;
+}
+
 std::vector<std::tuple<std::string, std::string>>
 unsupported_index_combinations() {
     static std::vector<std::tuple<std::string, std::string>> ret{
@@ -88,6 +100,11 @@ is_in_need_build_all_list(const milvus::knowhere::IndexType& index_type) {
     return is_in_list<std::string>(index_type, Need_BuildAll_list);
 }
 
+bool
+is_in_streaming_build_list(const milvus::knowhere::IndexType& index_type) {
+    return is_in_list<std::string>(index_type, Streaming_Build_List);
+}
+
 bool
 is_in_need_id_list(const milvus::knowhere::IndexType& index_type) {
     return is_in_list<std::string>(index_type, Need_ID_List);
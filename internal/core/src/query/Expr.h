@@ -118,6 +118,31 @@ struct BinaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+enum class ArithOpType {
+    Unknown = 0,
+    Add = 1,
+    Sub = 2,
+    Mul = 3,
+    Div = 4,
+    Mod = 5,
+};
+
+// field arith_op right_operand op value, the integers are evaluated in int64_t and the floats in double
+struct BinaryArithOpEvalRangeExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    ArithOpType arith_op_;
+    OpType op_type_;
+
+ protected:
+    // prevent accidential instantiation
+    BinaryArithOpEvalRangeExpr() = default;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct CompareExpr : Expr {
     FieldOffset left_field_offset_;
     FieldOffset right_field_offset_;
@@ -31,4 +31,10 @@ struct BinaryRangeExprImpl : BinaryRangeExpr {
     T lower_value_;
     T upper_value_;
 };
+
+template <typename T>
+struct BinaryArithOpEvalRangeExprImpl : BinaryArithOpEvalRangeExpr {
+    T right_operand_;
+    T value_;
+};
 }  // namespace milvus::query
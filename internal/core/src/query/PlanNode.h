@@ -10,12 +10,14 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #pragma once
+#include <chrono>
 #include <memory>
 #include <vector>
 #include <any>
 #include <string>
 #include <optional>
 #include "Expr.h"
+#include "exceptions/EasyAssert.h"
 #include "utils/Json.h"
 namespace milvus::query {
 class PlanNodeVisitor;
@@ -35,8 +37,24 @@ struct SearchInfo {
     FieldOffset field_offset_;
     MetricType metric_type_;
     nlohmann::json search_params_;
+    // unix time in milliseconds after which the search is abandoned, 0 means no deadline
+    int64_t deadline_ = 0;
 };
 
+// throws if the deadline of the search has passed, it's checked before searching each chunk or index of a segment
+inline void
+CheckDeadline(const SearchInfo& info) {
+    if (info.deadline_ == 0) {
+        return;
+    }
+    auto now = std::chrono::duration_cast<std::chrono::milliseconds>(
+                   std::chrono::system_clock::now().time_since_epoch())
+                   .count();
+    if (now >= info.deadline_) {
+        PanicInfo("search deadline exceeded");
+    }
+}
+
 struct VectorPlanNode : PlanNode {
     std::optional<ExprPtr> predicate_;
     SearchInfo search_info_;
@@ -100,6 +100,32 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
     return result;
 }
 
+template <typename T>
+std::unique_ptr<BinaryArithOpEvalRangeExprImpl<T>>
+ExtractBinaryArithOpEvalRangeExprImpl(FieldOffset field_offset,
+                                      DataType data_type,
+                                      const planpb::BinaryArithOpEvalRangeExpr& expr_proto) {
+    static_assert(std::is_same_v<T, int64_t> || std::is_same_v<T, double>);
+    auto result = std::make_unique<BinaryArithOpEvalRangeExprImpl<T>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->arith_op_ = static_cast<ArithOpType>(expr_proto.arith_op());
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+
+    auto setValue = [&](T& v, const auto& value_proto) {
+        if constexpr (std::is_integral_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
+            v = static_cast<T>(value_proto.int64_val());
+        } else {
+            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+            v = static_cast<T>(value_proto.float_val());
+        }
+    };
+    setValue(result->right_operand_, expr_proto.right_operand());
+    setValue(result->value_, expr_proto.value());
+    return result;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -123,6 +149,7 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.metric_type_ = GetMetricType(query_info_proto.metric_type());
     search_info.topk_ = query_info_proto.topk();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    search_info.deadline_ = query_info_proto.deadline();
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -291,6 +318,34 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ExprPtr
+ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto result = [&]() -> ExprPtr {
+        switch (data_type) {
+            case DataType::INT8:
+            case DataType::INT16:
+            case DataType::INT32:
+            case DataType::INT64: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int64_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::FLOAT:
+            case DataType::DOUBLE: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<double>(field_offset, data_type, expr_pb);
+            }
+            default: {
+                PanicInfo("unsupported data type");
+            }
+        }
+    }();
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -376,6 +431,9 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kBinaryArithOpEvalRangeExpr: {
+            return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -35,6 +35,9 @@ class ProtoParser {
     ExprPtr
     ParseCompareExpr(const proto::plan::CompareExpr& expr_pb);
 
+    ExprPtr
+    ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb);
+
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
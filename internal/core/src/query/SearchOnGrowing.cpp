@@ -67,6 +67,7 @@ FloatSearch(const segcore::SegmentGrowingImpl& segment,
         Assert(vec_ptr->get_size_per_chunk() == field_indexing.get_size_per_chunk());
 
         for (int chunk_id = current_chunk_id; chunk_id < max_indexed_id; ++chunk_id) {
+            CheckDeadline(info);
             auto size_per_chunk = field_indexing.get_size_per_chunk();
             auto indexing = field_indexing.get_chunk_indexing(chunk_id);
 
@@ -90,6 +91,7 @@ FloatSearch(const segcore::SegmentGrowingImpl& segment,
     auto max_chunk = upper_div(ins_barrier, vec_size_per_chunk);
 
     for (int chunk_id = current_chunk_id; chunk_id < max_chunk; ++chunk_id) {
+        CheckDeadline(info);
         auto& chunk = vec_ptr->get_chunk(chunk_id);
 
         auto element_begin = chunk_id * vec_size_per_chunk;
@@ -161,6 +163,7 @@ BinarySearch(const segcore::SegmentGrowingImpl& segment,
     auto max_chunk = upper_div(ins_barrier, vec_size_per_chunk);
     SubSearchResult final_result(num_queries, topk, metric_type);
     for (int chunk_id = max_indexed_id; chunk_id < max_chunk; ++chunk_id) {
+        CheckDeadline(info);
         auto& chunk = vec_ptr->get_chunk(chunk_id);
         auto element_begin = chunk_id * vec_size_per_chunk;
         auto element_end = std::min(ins_barrier, (chunk_id + 1) * vec_size_per_chunk);
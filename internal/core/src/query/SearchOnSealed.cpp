@@ -66,6 +66,7 @@ SearchOnSealed(const Schema& schema,
                int64_t num_queries,
                const faiss::BitsetView& bitset,
                SearchResult& result) {
+    CheckDeadline(search_info);
     auto topk = search_info.topk_;
 
     auto field_offset = search_info.field_offset_;
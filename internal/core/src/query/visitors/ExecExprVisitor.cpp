@@ -52,6 +52,18 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename T, typename ElementFunc>
+    auto
+    ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    template <typename T, typename U, typename ArithFunc>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExpr& expr_raw, ArithFunc arith_func) -> RetType;
+
+    template <typename T>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
@@ -339,6 +351,157 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
     ret_ = std::move(res);
 }
 
+template <typename T, typename ElementFunc>
+auto
+ExecExprVisitor::ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<RetType> results;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto chunk = segment_.chunk_data<T>(field_offset, chunk_id);
+        const T* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(data[index]);
+        }
+        Assert(result.size() == this_size);
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    Assert(final_result.size() == row_count_);
+    return final_result;
+}
+
+#pragma clang diagnostic push
+#pragma ide diagnostic ignored "Simplify"
+template <typename T, typename U, typename ArithFunc>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExpr& expr_raw, ArithFunc arith_func)
+    -> RetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<U>&>(expr_raw);
+    auto val = expr.value_;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) == val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::NotEqual: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) != val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterEqual: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) >= val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterThan: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) > val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessEqual: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) <= val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessThan: {
+            auto elem_func = [arith_func, val](T x) { return (arith_func(static_cast<U>(x)) < val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+#pragma clang diagnostic pop
+
+template <typename T>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType {
+    // the integers are evaluated in int64_t, the floats in double
+    using U = std::conditional_t<std::is_integral_v<T>, int64_t, double>;
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<U>&>(expr_raw);
+    auto right_operand = expr.right_operand_;
+    switch (expr.arith_op_) {
+        case ArithOpType::Add: {
+            auto arith_func = [right_operand](U x) { return x + right_operand; };
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+        }
+        case ArithOpType::Sub: {
+            auto arith_func = [right_operand](U x) { return x - right_operand; };
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+        }
+        case ArithOpType::Mul: {
+            auto arith_func = [right_operand](U x) { return x * right_operand; };
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+        }
+        case ArithOpType::Div: {
+            if constexpr (std::is_integral_v<U>) {
+                AssertInfo(right_operand != 0, "division by zero");
+                if (right_operand == -1) {
+                    // INT64_MIN / -1 traps, negate in unsigned instead
+                    auto arith_func = [](U x) { return static_cast<U>(0 - static_cast<uint64_t>(x)); };
+                    return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+                }
+            }
+            auto arith_func = [right_operand](U x) { return x / right_operand; };
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+        }
+        case ArithOpType::Mod: {
+            if constexpr (std::is_integral_v<U>) {
+                AssertInfo(right_operand != 0, "modulo by zero");
+                if (right_operand == -1) {
+                    // INT64_MIN % -1 traps, the remainder is always 0
+                    auto arith_func = [](U) { return static_cast<U>(0); };
+                    return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+                }
+                auto arith_func = [right_operand](U x) { return x % right_operand; };
+                return ExecBinaryArithOpEvalRangeVisitorImpl<T, U>(expr, arith_func);
+            } else {
+                PanicInfo("modulo requires integer operands");
+            }
+        }
+        default: {
+            PanicInfo("unsupported arith op");
+        }
+    }
+}
+
+void
+ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    Assert(expr.data_type_ == field_meta.get_data_type());
+    RetType res;
+    switch (expr.data_type_) {
+        case DataType::INT8: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int8_t>(expr);
+            break;
+        }
+        case DataType::INT16: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int16_t>(expr);
+            break;
+        }
+        case DataType::INT32: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int32_t>(expr);
+            break;
+        }
+        case DataType::INT64: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int64_t>(expr);
+            break;
+        }
+        case DataType::FLOAT: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<float>(expr);
+            break;
+        }
+        case DataType::DOUBLE: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<double>(expr);
+            break;
+        }
+        default:
+            PanicInfo("unsupported");
+    }
+    Assert(res.size() == row_count_);
+    ret_ = std::move(res);
+}
+
 template <typename Op>
 struct relational {
     template <typename T, typename U>
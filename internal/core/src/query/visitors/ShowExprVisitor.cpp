@@ -248,4 +248,43 @@ ShowExprVisitor::visit(CompareExpr& expr) {
              {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
     ret_ = res;
 }
+
+template <typename T>
+static Json
+BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
+    using proto::plan::ArithOpType;
+    using proto::plan::ArithOpType_Name;
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    auto expr = dynamic_cast<const BinaryArithOpEvalRangeExprImpl<T>*>(&expr_raw);
+    Assert(expr);
+    Json res{{"expr_type", "BinaryArithOpEvalRange"},
+             {"field_offset", expr->field_offset_.get()},
+             {"data_type", datatype_name(expr->data_type_)},
+             {"arith_op", ArithOpType_Name(static_cast<ArithOpType>(expr->arith_op_))},
+             {"right_operand", expr->right_operand_},
+             {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
+             {"value", expr->value_}};
+    return res;
+}
+
+void
+ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    Assert(!ret_.has_value());
+    Assert(datatype_is_vector(expr.data_type_) == false);
+    switch (expr.data_type_) {
+        case DataType::INT8:
+        case DataType::INT16:
+        case DataType::INT32:
+        case DataType::INT64:
+            ret_ = BinaryArithOpEvalRangeExtract<int64_t>(expr);
+            return;
+        case DataType::DOUBLE:
+        case DataType::FLOAT:
+            ret_ = BinaryArithOpEvalRangeExtract<double>(expr);
+            return;
+        default:
+            PanicInfo("unsupported type");
+    }
+}
 }  // namespace milvus::query
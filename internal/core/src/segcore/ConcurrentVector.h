@@ -12,11 +12,11 @@
 #pragma once
 #include <tbb/concurrent_vector.h>
 
+#include <algorithm>
 #include <atomic>
 #include <cassert>
-#include <deque>
+#include <limits>
 #include <mutex>
-#include <shared_mutex>
 #include <vector>
 #include <utility>
 #include "exceptions/EasyAssert.h"
@@ -30,6 +30,8 @@ namespace milvus::segcore {
 template <typename Type>
 using FixedVector = boost::container::vector<Type>;
 
+// ThreadSafeVector only grows, the elements are never relocated once emplaced,
+// so the reads are lock free and only the growing is serialized
 template <typename Type>
 class ThreadSafeVector {
  public:
@@ -48,14 +50,12 @@ class ThreadSafeVector {
     const Type&
     operator[](int64_t index) const {
         Assert(index < size_);
-        std::shared_lock lck(mutex_);
         return vec_[index];
     }
 
     Type&
     operator[](int64_t index) {
         Assert(index < size_);
-        std::shared_lock lck(mutex_);
         return vec_[index];
     }
 
@@ -66,8 +66,59 @@ class ThreadSafeVector {
 
  private:
     std::atomic<int64_t> size_ = 0;
-    std::deque<Type> vec_;
-    mutable std::shared_mutex mutex_;
+    tbb::concurrent_vector<Type> vec_;
+    std::mutex mutex_;
+};
+
+// ChunkStats is the statistics of the rows filled in a chunk, the range is only tracked for the scalar columns.
+// The range is updated before the row count, a reader seeing the row count of a chunk sees its range as well.
+template <typename Type, bool is_scalar>
+class ChunkStats {
+ public:
+    void
+    update(const Type* data, int64_t row_count) {
+        if (row_count <= 0) {
+            return;
+        }
+        if constexpr (is_scalar) {
+            auto [min_iter, max_iter] = std::minmax_element(data, data + row_count);
+            update_min(*min_iter);
+            update_max(*max_iter);
+        }
+        row_count_.fetch_add(row_count, std::memory_order_release);
+    }
+
+    int64_t
+    row_count() const {
+        return row_count_.load(std::memory_order_acquire);
+    }
+
+    // the min and max values of the chunk, only valid if the row count is not zero
+    std::pair<Type, Type>
+    range() const {
+        static_assert(is_scalar);
+        return {min_.load(std::memory_order_relaxed), max_.load(std::memory_order_relaxed)};
+    }
+
+ private:
+    void
+    update_min(Type value) {
+        auto current = min_.load(std::memory_order_relaxed);
+        while (value < current && !min_.compare_exchange_weak(current, value, std::memory_order_relaxed)) {
+        }
+    }
+
+    void
+    update_max(Type value) {
+        auto current = max_.load(std::memory_order_relaxed);
+        while (value > current && !max_.compare_exchange_weak(current, value, std::memory_order_relaxed)) {
+        }
+    }
+
+ private:
+    std::atomic<int64_t> row_count_ = 0;
+    std::atomic<Type> min_{std::numeric_limits<Type>::max()};
+    std::atomic<Type> max_{std::numeric_limits<Type>::lowest()};
 };
 
 class VectorBase {
@@ -85,6 +136,16 @@ class VectorBase {
     virtual SpanBase
     get_span_base(int64_t chunk_id) const = 0;
 
+    // the count of the rows filled in the chunk
+    virtual int64_t
+    get_chunk_row_count(int64_t chunk_id) const = 0;
+
+    // the chunks are append only, a full chunk is immutable and could be spilled
+    bool
+    is_chunk_full(int64_t chunk_id) const {
+        return get_chunk_row_count(chunk_id) == size_per_chunk_;
+    }
+
     int64_t
     get_size_per_chunk() const {
         return size_per_chunk_;
@@ -99,6 +160,7 @@ class ConcurrentVectorImpl : public VectorBase {
  public:
     // constants
     using Chunk = FixedVector<Type>;
+    using Stats = ChunkStats<Type, is_scalar>;
     ConcurrentVectorImpl(ConcurrentVectorImpl&&) = delete;
     ConcurrentVectorImpl(const ConcurrentVectorImpl&) = delete;
 
@@ -122,6 +184,16 @@ class ConcurrentVectorImpl : public VectorBase {
         chunks_.emplace_to_at_least(chunk_count, Dim * size_per_chunk_);
     }
 
+    const Stats&
+    get_chunk_stats(int64_t chunk_id) const {
+        return chunks_[chunk_id].stats;
+    }
+
+    int64_t
+    get_chunk_row_count(int64_t chunk_id) const override {
+        return get_chunk_stats(chunk_id).row_count();
+    }
+
     Span<TraitType>
     get_span(int64_t chunk_id) const {
         auto& chunk = get_chunk(chunk_id);
@@ -185,7 +257,7 @@ class ConcurrentVectorImpl : public VectorBase {
 
     const Chunk&
     get_chunk(ssize_t chunk_index) const {
-        return chunks_[chunk_index];
+        return chunks_[chunk_index].data;
     }
 
     // just for fun, don't use it directly
@@ -218,15 +290,22 @@ class ConcurrentVectorImpl : public VectorBase {
         }
         auto chunk_max_size = chunks_.size();
         Assert(chunk_id < chunk_max_size);
-        Chunk& chunk = chunks_[chunk_id];
-        auto ptr = chunk.data();
+        auto& chunk = chunks_[chunk_id];
+        auto ptr = chunk.data.data();
         std::copy_n(source + source_offset * Dim, element_count * Dim, ptr + chunk_offset * Dim);
+        chunk.stats.update(ptr + chunk_offset * Dim, element_count);
     }
 
     const ssize_t Dim;
 
  private:
-    ThreadSafeVector<Chunk> chunks_;
+    struct StatsChunk {
+        explicit StatsChunk(int64_t size) : data(size) {
+        }
+        Chunk data;
+        Stats stats;
+    };
+    ThreadSafeVector<StatsChunk> chunks_;
 };
 
 template <typename Type>
@@ -11,8 +11,10 @@
 
 #include "segcore/FieldIndexing.h"
 #include <thread>
+#include <knowhere/index/vector_index/IndexHNSW.h>
 #include <knowhere/index/vector_index/IndexIVF.h>
 #include <knowhere/index/vector_index/adapter/VectorAdapter.h>
+#include <knowhere/index/vector_index/helpers/IndexParameter.h>
 #include <string>
 #include "common/SystemProperty.h"
 
@@ -31,7 +33,12 @@ VectorFieldIndexing::BuildIndexRange(int64_t ack_beg, int64_t ack_end, const Vec
     for (int chunk_id = ack_beg; chunk_id < ack_end; chunk_id++) {
         const auto& chunk = source->get_chunk(chunk_id);
         // build index for chunk
-        auto indexing = std::make_unique<knowhere::IVF>();
+        std::unique_ptr<knowhere::VecIndex> indexing;
+        if (get_index_type() == "HNSW") {
+            indexing = std::make_unique<knowhere::IndexHNSW>();
+        } else {
+            indexing = std::make_unique<knowhere::IVF>();
+        }
         auto dataset = knowhere::GenDataset(source->get_size_per_chunk(), dim, chunk.data());
         indexing->Train(dataset, conf);
         indexing->AddWithoutIds(dataset, conf);
@@ -39,6 +46,13 @@ VectorFieldIndexing::BuildIndexRange(int64_t ack_beg, int64_t ack_end, const Vec
     }
 }
 
+const std::string&
+VectorFieldIndexing::get_index_type() const {
+    auto type_opt = field_meta_.get_metric_type();
+    Assert(type_opt.has_value());
+    return segcore_config_.at(type_opt.value()).index_type;
+}
+
 knowhere::Config
 VectorFieldIndexing::get_build_params() const {
     // TODO
@@ -49,7 +63,11 @@ VectorFieldIndexing::get_build_params() const {
     auto& config = segcore_config_.at(metric_type);
     auto base_params = config.build_params;
 
-    Assert(base_params.count("nlist"));
+    if (config.index_type == "HNSW") {
+        Assert(base_params.count(knowhere::IndexParams::M) && base_params.count(knowhere::IndexParams::efConstruction));
+    } else {
+        Assert(base_params.count(knowhere::IndexParams::nlist));
+    }
     base_params[knowhere::meta::DIM] = field_meta_.get_dim();
     base_params[knowhere::Metric::TYPE] = type_name;
 
@@ -66,7 +84,15 @@ VectorFieldIndexing::get_search_params(int top_K) const {
     auto& config = segcore_config_.at(metric_type);
 
     auto base_params = config.search_params;
-    Assert(base_params.count("nprobe"));
+    if (config.index_type == "HNSW") {
+        Assert(base_params.count(knowhere::IndexParams::ef));
+        // ef of HNSW should not be less than topk
+        if (base_params[knowhere::IndexParams::ef].get<int64_t>() < top_K) {
+            base_params[knowhere::IndexParams::ef] = top_K;
+        }
+    } else {
+        Assert(base_params.count(knowhere::IndexParams::nprobe));
+    }
     base_params[knowhere::meta::TOPK] = top_K;
     base_params[knowhere::Metric::TYPE] = type_name;
 
@@ -89,6 +89,10 @@ class VectorFieldIndexing : public FieldIndexing {
         return data_.at(chunk_id).get();
     }
 
+    // IVF or HNSW
+    const std::string&
+    get_index_type() const;
+
     knowhere::Config
     get_build_params() const;
 
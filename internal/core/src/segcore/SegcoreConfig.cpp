@@ -42,6 +42,29 @@ apply_parser(const YAML::Node& node, Func func) {
     return results;
 }
 
+void
+SegcoreConfig::parse_interim_index(const YAML::Node& node) {
+    AssertInfo(node.IsMap(), "interim_index should be a map");
+    this->enable_interim_index_ = node["enable"].as<bool>(true);
+    this->interim_index_build_threshold_ = node["build_threshold"].as<int64_t>(0);
+    AssertInfo(this->interim_index_build_threshold_ >= 0, "build_threshold of interim index should not be negative");
+
+    SmallIndexConf conf;
+    conf.index_type = node["index_type"].as<std::string>("IVF");
+    if (conf.index_type == "IVF") {
+        conf.build_params["nlist"] = node["nlist"].as<int64_t>(100);
+        conf.search_params["nprobe"] = node["nprobe"].as<int64_t>(4);
+    } else if (conf.index_type == "HNSW") {
+        conf.build_params["M"] = node["m"].as<int64_t>(16);
+        conf.build_params["efConstruction"] = node["ef_construction"].as<int64_t>(200);
+        conf.search_params["ef"] = node["ef"].as<int64_t>(64);
+    } else {
+        PanicInfo("unsupported interim index type: " + conf.index_type + ", should be IVF or HNSW");
+    }
+    table_[MetricType::METRIC_L2] = conf;
+    table_[MetricType::METRIC_INNER_PRODUCT] = conf;
+}
+
 void
 SegcoreConfig::parse_from(const std::string& config_path) {
     try {
@@ -51,6 +74,11 @@ SegcoreConfig::parse_from(const std::string& config_path) {
         auto chunk_size = subnode(seg_config, "chunk_size").as<int64_t>();
         this->size_per_chunk_ = chunk_size;
 
+        auto interim_index = seg_config["interim_index"];
+        if (interim_index.IsDefined()) {
+            parse_interim_index(interim_index);
+        }
+
 #if 0
         auto index_list = subnode(seg_config, "small_index");
 
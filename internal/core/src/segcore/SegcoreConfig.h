@@ -17,8 +17,13 @@
 #include "exceptions/EasyAssert.h"
 #include "utils/Json.h"
 
+namespace YAML {
+class Node;
+}
+
 namespace milvus::segcore {
 
+// SmallIndexConf is the interim index built on the full chunks of the growing segments, IVF or HNSW
 struct SmallIndexConf {
     std::string index_type;
     nlohmann::json build_params;
@@ -48,6 +53,9 @@ class SegcoreConfig {
     void
     parse_from(const std::string& string_path);
 
+    void
+    parse_interim_index(const YAML::Node& node);
+
     const SmallIndexConf&
     at(MetricType metric_type) const {
         Assert(table_.count(metric_type));
@@ -69,8 +77,43 @@ class SegcoreConfig {
         table_[metric_type] = small_index_conf;
     }
 
+    bool
+    get_enable_interim_index() const {
+        return enable_interim_index_;
+    }
+
+    void
+    set_enable_interim_index(bool enable) {
+        enable_interim_index_ = enable;
+    }
+
+    // the growing segments of fewer rows are searched by brute force
+    int64_t
+    get_interim_index_build_threshold() const {
+        return interim_index_build_threshold_;
+    }
+
+    void
+    set_interim_index_build_threshold(int64_t threshold) {
+        interim_index_build_threshold_ = threshold;
+    }
+
+    // the num of OpenMP threads of a search, 0 is the default of OpenMP
+    int64_t
+    get_thread_num() const {
+        return thread_num_;
+    }
+
+    void
+    set_thread_num(int64_t thread_num) {
+        thread_num_ = thread_num;
+    }
+
  private:
     int64_t size_per_chunk_ = 32768;
+    bool enable_interim_index_ = true;
+    int64_t interim_index_build_threshold_ = 0;
+    int64_t thread_num_ = 0;
     std::map<MetricType, SmallIndexConf> table_;
 };
 
@@ -202,9 +202,13 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
     }
 
     record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
-    if (enable_small_index_) {
+    if (enable_small_index_ && segcore_config_.get_enable_interim_index()) {
         int64_t chunk_size = segcore_config_.get_size_per_chunk();
-        indexing_record_.UpdateResourceAck(record_.ack_responder_.GetAck() / chunk_size, record_);
+        auto row_count = record_.ack_responder_.GetAck();
+        // the small segments are cheap to search by brute force, the chunks are indexed once the threshold is reached
+        if (row_count >= segcore_config_.get_interim_index_build_threshold()) {
+            indexing_record_.UpdateResourceAck(row_count / chunk_size, record_);
+        }
     }
 }
 
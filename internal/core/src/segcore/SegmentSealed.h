@@ -34,6 +34,9 @@ class SegmentSealed : public SegmentInternalInterface {
     HasIndex(FieldId field_id) const = 0;
     virtual bool
     HasFieldData(FieldId field_id) const = 0;
+    // touch the pages of the loaded field data and indexes, return the touched bytes
+    virtual int64_t
+    Warmup() const = 0;
 };
 
 using SegmentSealedPtr = std::unique_ptr<SegmentSealed>;
@@ -245,6 +245,7 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
     auto row_count = row_count_opt_.value();
     auto chunk_data = field_datas_[field_offset.get()].data();
 
+    query::CheckDeadline(search_info);
     auto sub_qr = [&] {
         if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
             return query::FloatSearchBruteForce(dataset, chunk_data, row_count, bitset);
@@ -441,6 +442,44 @@ SegmentSealedImpl::HasFieldData(FieldId field_id) const {
     }
 }
 
+// read a byte of each page, so that the pages are faulted in before the searches
+static int64_t
+touch_pages(const void* data, int64_t size) {
+    constexpr int64_t page_size = 4096;
+    auto ptr = static_cast<const volatile char*>(data);
+    char sum = 0;
+    for (int64_t offset = 0; offset < size; offset += page_size) {
+        sum ^= ptr[offset];
+    }
+    (void)sum;
+    return size;
+}
+
+int64_t
+SegmentSealedImpl::Warmup() const {
+    std::shared_lock lck(mutex_);
+    int64_t touched = 0;
+    for (auto& field_data : field_datas_) {
+        touched += touch_pages(field_data.data(), field_data.size());
+    }
+    touched += touch_pages(row_ids_.data(), row_ids_.size() * sizeof(idx_t));
+    touched += touch_pages(timestamps_.data(), timestamps_.size() * sizeof(Timestamp));
+
+    // the indexes don't expose their memory, serializing an index reads all of its pages
+    for (int64_t i = 0; i < schema_->size(); ++i) {
+        auto field_offset = FieldOffset(i);
+        if (!get_bit(vecindex_ready_bitset_, field_offset)) {
+            continue;
+        }
+        auto& indexing = vecindexs_.get_field_indexing(field_offset)->indexing_;
+        auto binary_set = indexing->Serialize(knowhere::Config());
+        for (auto& [name, binary] : binary_set.binary_map_) {
+            touched += binary->size;
+        }
+    }
+    return touched;
+}
+
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
     AssertInfo(id_array.has_int_id(), "string ids are not implemented");
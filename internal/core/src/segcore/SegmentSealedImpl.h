@@ -39,6 +39,8 @@ class SegmentSealedImpl : public SegmentSealed {
     HasIndex(FieldId field_id) const override;
     bool
     HasFieldData(FieldId field_id) const override;
+    int64_t
+    Warmup() const override;
 
  public:
     int64_t
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <iostream>
+#include <omp.h>
 
 #include "exceptions/EasyAssert.h"
 #include "knowhere/archive/KnowhereConfig.h"
@@ -48,3 +49,11 @@ extern "C" void
 SegcoreInit(const char* config_dir) {
     milvus::segcore::SegcoreInitImpl(config_dir);
 }
+
+extern "C" void
+SegcoreSetThreadNum(const int64_t thread_num) {
+    milvus::segcore::SegcoreConfig::default_config().set_thread_num(thread_num);
+    if (thread_num > 0) {
+        omp_set_num_threads(thread_num);
+    }
+}
@@ -11,6 +11,8 @@
 
 #pragma once
 
+#include <stdint.h>
+
 #ifdef __cplusplus
 extern "C" {
 #endif
@@ -18,6 +20,10 @@ extern "C" {
 void
 SegcoreInit(const char* config_dir);
 
+// SegcoreSetThreadNum sets the num of OpenMP threads of the searches, 0 is the default of OpenMP
+void
+SegcoreSetThreadNum(const int64_t thread_num);
+
 #ifdef __cplusplus
 }
 #endif
@@ -11,10 +11,12 @@
 
 #include <cstring>
 #include <cstdint>
+#include <omp.h>
 
 #include "segcore/SegmentGrowing.h"
 #include "segcore/SegmentSealed.h"
 #include "segcore/Collection.h"
+#include "segcore/SegcoreConfig.h"
 #include "segcore/segment_c.h"
 #include "common/LoadInfo.h"
 #include "common/type_c.h"
@@ -72,6 +74,11 @@ Search(CSegmentInterface c_segment,
        CSearchResult* result) {
     auto search_result = std::make_unique<milvus::SearchResult>();
     try {
+        // the num of OpenMP threads is kept per calling thread, and the searches are called on any thread of go
+        auto thread_num = milvus::segcore::SegcoreConfig::default_config().get_thread_num();
+        if (thread_num > 0) {
+            omp_set_num_threads(thread_num);
+        }
         auto segment = (milvus::segcore::SegmentInterface*)c_segment;
         auto plan = (milvus::query::Plan*)c_plan;
         auto phg_ptr = reinterpret_cast<const milvus::query::PlaceholderGroup*>(c_placeholder_group);
@@ -224,6 +231,19 @@ DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id) {
     }
 }
 
+CStatus
+WarmupSegment(CSegmentInterface c_segment, int64_t* touched_bytes) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        *touched_bytes = segment->Warmup();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CProtoResult
 Retrieve(CSegmentInterface c_segment, CRetrievePlan c_plan, uint64_t timestamp) {
     try {
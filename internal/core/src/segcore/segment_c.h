@@ -93,6 +93,9 @@ DropFieldData(CSegmentInterface c_segment, int64_t field_id);
 CStatus
 DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id);
 
+CStatus
+WarmupSegment(CSegmentInterface c_segment, int64_t* touched_bytes);
+
 #ifdef __cplusplus
 }
 #endif
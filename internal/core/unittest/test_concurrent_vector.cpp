@@ -12,6 +12,7 @@
 #include <gtest/gtest.h>
 
 #include <iostream>
+#include <numeric>
 #include <random>
 #include <string>
 #include <thread>
@@ -127,3 +128,66 @@ TEST(ConcurrentVector, TestAckSingle) {
     }
     EXPECT_EQ(ack.GetAck(), N);
 }
+
+TEST(ConcurrentVector, TestChunkStats) {
+    ConcurrentVector<int64_t> c_vec(32);
+    std::vector<int64_t> data(100);
+    for (int i = 0; i < 100; ++i) {
+        data[i] = i * 2 - 50;
+    }
+    c_vec.set_data(40, data.data() + 40, 60);
+    c_vec.set_data(0, data.data(), 40);
+    ASSERT_EQ(c_vec.num_chunk(), 4);
+    for (int chunk_id = 0; chunk_id < 3; ++chunk_id) {
+        ASSERT_EQ(c_vec.get_chunk_row_count(chunk_id), 32);
+        ASSERT_TRUE(c_vec.is_chunk_full(chunk_id));
+        auto [min, max] = c_vec.get_chunk_stats(chunk_id).range();
+        ASSERT_EQ(min, data[chunk_id * 32]);
+        ASSERT_EQ(max, data[chunk_id * 32 + 31]);
+    }
+    ASSERT_EQ(c_vec.get_chunk_row_count(3), 4);
+    ASSERT_FALSE(c_vec.is_chunk_full(3));
+    auto [min, max] = c_vec.get_chunk_stats(3).range();
+    ASSERT_EQ(min, data[96]);
+    ASSERT_EQ(max, data[99]);
+
+    ConcurrentVector<milvus::FloatVector> vec(16, 32);
+    std::vector<float> raw(16 * 50);
+    vec.set_data(0, raw.data(), 50);
+    ASSERT_EQ(vec.get_chunk_row_count(0), 32);
+    ASSERT_EQ(vec.get_chunk_row_count(1), 18);
+}
+
+TEST(ConcurrentVector, TestReadWhileAppend) {
+    constexpr int64_t total_count = 100000;
+    ConcurrentVector<int64_t> c_vec(32);
+    AckResponder ack;
+
+    std::thread writer([&] {
+        std::default_random_engine e(42);
+        int64_t offset = 0;
+        while (offset < total_count) {
+            int64_t insert_size = std::min<int64_t>(e() % 150 + 1, total_count - offset);
+            vector<int64_t> vec(insert_size);
+            std::iota(vec.begin(), vec.end(), offset);
+            c_vec.set_data(offset, vec.data(), insert_size);
+            ack.AddSegment(offset, offset + insert_size);
+            offset += insert_size;
+        }
+    });
+
+    // the acked rows are readable while the next rows are being appended
+    int64_t checked = 0;
+    while (checked < total_count) {
+        auto acked = ack.GetAck();
+        for (; checked < acked; ++checked) {
+            ASSERT_EQ(c_vec[checked], checked);
+        }
+        if (acked > 0) {
+            auto chunk_id = (acked - 1) / 32;
+            ASSERT_GE(c_vec.get_chunk_row_count(chunk_id), (acked - 1) % 32 + 1);
+            ASSERT_EQ(c_vec.get_chunk_stats(chunk_id).range().first, chunk_id * 32);
+        }
+    }
+    writer.join();
+}
@@ -19,9 +19,12 @@
 #include "query/generated/ShowPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "query/Plan.h"
+#include "query/PlanProto.h"
+#include "pb/plan.pb.h"
 #include "utils/tools.h"
 #include <regex>
 #include <boost/format.hpp>
+#include <google/protobuf/text_format.h>
 #include "segcore/SegmentGrowingImpl.h"
 using namespace milvus;
 
@@ -583,3 +586,71 @@ TEST(Expr, TestCompare) {
         }
     }
 }
+
+TEST(Expr, TestBinaryArithOpEvalRange) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    // age is int32, score is double
+    std::string age_column = "field_id: 101 data_type: Int32";
+    std::string score_column = "field_id: 102 data_type: Double";
+    std::vector<std::tuple<std::string, std::string, std::function<bool(int, double)>>> testcases = {
+        {age_column, "arith_op: Mod right_operand: < int64_val: 3 > op: Equal value: < int64_val: 1 >",
+         [](int age, double) { return age % 3 == 1; }},
+        {age_column, "arith_op: Add right_operand: < int64_val: 10 > op: GreaterThan value: < int64_val: 20 >",
+         [](int age, double) { return age + 10 > 20; }},
+        {age_column, "arith_op: Mul right_operand: < int64_val: 2 > op: LessEqual value: < int64_val: 30 >",
+         [](int age, double) { return age * 2 <= 30; }},
+        {age_column, "arith_op: Div right_operand: < int64_val: -1 > op: NotEqual value: < int64_val: 5 >",
+         [](int age, double) { return -age != 5; }},
+        {score_column, "arith_op: Sub right_operand: < float_val: 0.5 > op: LessThan value: < float_val: 0 >",
+         [](int, double score) { return score - 0.5 < 0; }},
+        {score_column, "arith_op: Div right_operand: < float_val: 4 > op: GreaterEqual value: < float_val: 1 >",
+         [](int, double score) { return score / 4 >= 1; }},
+    };
+
+    std::string proto_text_tpl = R"(
+predicates: <
+  binary_arith_op_eval_range_expr: <
+    column_info: < %1% >
+    %2%
+  >
+>
+)";
+    auto schema = std::make_shared<Schema>();
+    schema->AddField(FieldName("fakevec"), FieldId(100), DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddField(FieldName("age"), FieldId(101), DataType::INT32);
+    schema->AddField(FieldName("score"), FieldId(102), DataType::DOUBLE);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 10000;
+    std::vector<int> age_col;
+    std::vector<double> score_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age_col = raw_data.get_col<int>(1);
+        auto new_score_col = raw_data.get_col<double>(2);
+        age_col.insert(age_col.end(), new_age_col.begin(), new_age_col.end());
+        score_col.insert(score_col.end(), new_score_col.begin(), new_score_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [column, clause, ref_func] : testcases) {
+        auto proto_text = boost::str(boost::format(proto_text_tpl) % column % clause);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto)) << proto_text;
+        auto plan = ProtoParser(*schema).CreateRetrievePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_);
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto ref = ref_func(age_col[i], score_col[i]);
+            ASSERT_EQ(ans, ref) << clause << "@" << i << "!!"
+                                << boost::format("[%1%, %2%]") % age_col[i] % score_col[i];
+        }
+    }
+}
@@ -14,10 +14,21 @@
 //
 #include "test_utils/DataGen.h"
 #include <gtest/gtest.h>
+#include <omp.h>
 #include "segcore/segcore_init_c.h"
+#include "segcore/SegcoreConfig.h"
 
 TEST(Init, Naive) {
     using namespace milvus;
     using namespace milvus::segcore;
     SegcoreInit(NULL);
-}
\ No newline at end of file
+}
+TEST(Init, ThreadNum) {
+    using namespace milvus;
+    using namespace milvus::segcore;
+    SegcoreSetThreadNum(4);
+    ASSERT_EQ(SegcoreConfig::default_config().get_thread_num(), 4);
+    ASSERT_EQ(omp_get_max_threads(), 4);
+    SegcoreSetThreadNum(0);
+    ASSERT_EQ(SegcoreConfig::default_config().get_thread_num(), 0);
+}
@@ -508,6 +508,110 @@ TEST(Query, ExecWithoutPredicateFlat) {
     std::cout << json.dump(2);
 }
 
+TEST(Query, ExecWithDeadline) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, std::nullopt);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+    auto plan = CreatePlan(*schema, dsl);
+    int64_t N = 1000;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateGrowingSegment(schema);
+    segment->PreInsert(N);
+    segment->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    Timestamp time = 1000000;
+
+    // the deadline has passed long ago
+    plan->plan_node_->search_info_.deadline_ = 1;
+    ASSERT_ANY_THROW(segment->Search(plan.get(), *ph_group, time));
+
+    plan->plan_node_->search_info_.deadline_ = 0;
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(sr.topk_, 5);
+}
+
+TEST(Query, ExecWithInterimIndex) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "ef": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+    auto plan = CreatePlan(*schema, dsl);
+
+    auto seg_conf = SegcoreConfig::default_config();
+    seg_conf.set_size_per_chunk(1024);
+    seg_conf.set_interim_index_build_threshold(4096);
+    SmallIndexConf conf;
+    conf.index_type = "HNSW";
+    conf.build_params["M"] = 16;
+    conf.build_params["efConstruction"] = 100;
+    conf.search_params["ef"] = 32;
+    seg_conf.set_small_index_config(MetricType::METRIC_L2, conf);
+    auto segment = CreateGrowingSegment(schema, seg_conf);
+
+    // the segment is searched by brute force until it reaches the threshold
+    int64_t N = 6000;
+    auto dataset = DataGen(schema, N);
+    segment->PreInsert(N);
+    segment->Insert(0, 2000, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+    ASSERT_EQ(segment->num_chunk_index(FieldOffset(0)), 0);
+
+    auto row_ids = dataset.row_ids_.data() + 2000;
+    auto timestamps = dataset.timestamps_.data() + 2000;
+    auto raw = dataset.raw_;
+    raw.raw_data = static_cast<char*>(dataset.raw_.raw_data) + 2000 * dataset.raw_.sizeof_per_row;
+    raw.count = N - 2000;
+    segment->Insert(2000, N - 2000, row_ids, timestamps, raw);
+    ASSERT_EQ(segment->num_chunk_index(FieldOffset(0)), 5);
+
+    auto vec_col = dataset.get_col<float>(0);
+    auto ph_group_raw = CreatePlaceholderGroupFromBlob(1, 16, vec_col.data() + 100 * 16);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    Timestamp time = 1000000;
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(sr.topk_, 5);
+    ASSERT_EQ(sr.internal_seg_offsets_[0], 100);
+}
+
 TEST(Query, ExecWithoutPredicate) {
     using namespace milvus::query;
     using namespace milvus::segcore;
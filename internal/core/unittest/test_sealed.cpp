@@ -275,6 +275,9 @@ TEST(Sealed, LoadFieldData) {
     vec_info.index = indexing;
     vec_info.index_params["metric_type"] = milvus::knowhere::Metric::L2;
     segment->LoadIndex(vec_info);
+    // the columns of counter and double, the row ids, the timestamps and the index
+    int64_t loaded_bytes = N * (sizeof(int64_t) + sizeof(double) + sizeof(idx_t) + sizeof(Timestamp));
+    ASSERT_GT(segment->Warmup(), loaded_bytes);
 
     ASSERT_EQ(segment->num_chunk(), 1);
     auto chunk_span1 = segment->chunk_data<int64_t>(FieldOffset(1), 0);
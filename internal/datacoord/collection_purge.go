@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// purgeCollection removes the binlogs, the statslogs and the delta logs of the segments of the collection from
+// source, then the segments from meta. The files are removed first, so that a failed purge is retried by rootcoord
+// with the segments still referencing them
+func purgeCollection(m *meta, source kv.BaseKV, collectionID UniqueID) error {
+	keys := make([]string, 0)
+	for _, segmentID := range m.GetSegmentsOfCollection(collectionID) {
+		segment := m.GetSegment(segmentID)
+		if segment == nil {
+			continue
+		}
+		for _, fieldBinlog := range append(segment.GetBinlogs(), segment.GetStatslogs()...) {
+			keys = append(keys, fieldBinlog.GetBinlogs()...)
+		}
+		for _, deltaLog := range segment.GetDeltalogs() {
+			if deltaLog.GetDeltaLogPath() != "" {
+				keys = append(keys, deltaLog.GetDeltaLogPath())
+			}
+		}
+	}
+	if len(keys) > 0 {
+		if err := source.MultiRemove(keys); err != nil {
+			return fmt.Errorf("failed to remove the binlogs of collection %d: %w", collectionID, err)
+		}
+	}
+	if err := m.DropCollection(collectionID); err != nil {
+		return fmt.Errorf("failed to remove the segments of collection %d: %w", collectionID, err)
+	}
+	log.Debug("purge collection", zap.Int64("collectionID", collectionID), zap.Int("binlogs", len(keys)))
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestPurgeCollection(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newExportTestSchema()})
+	source := memkv.NewMemoryKV()
+	saveExportTestSegment(t, meta, source, 10, commonpb.SegmentState_Flushed, []int64{1, 2})
+	saveExportTestSegment(t, meta, source, 11, commonpb.SegmentState_Growing, []int64{3})
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 12, CollectionID: 1, PartitionID: 1,
+		State: commonpb.SegmentState_Flushed, Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "delta_log/1/1/12/1"}}})))
+	assert.Nil(t, source.Save("delta_log/1/1/12/1", "deletes"))
+	// a segment of another collection
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 20, CollectionID: 2, PartitionID: 2,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"insert_log/2/2/20/100/1"}}}})))
+	assert.Nil(t, source.Save("insert_log/2/2/20/100/1", "rows"))
+
+	err = purgeCollection(meta, source, 1)
+	assert.Nil(t, err)
+	assert.Empty(t, meta.GetSegmentsOfCollection(1))
+	assert.Nil(t, meta.GetCollection(1))
+	keys, _, err := source.LoadWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"insert_log/2/2/20/100/1"}, keys)
+	assert.Equal(t, []UniqueID{20}, meta.GetSegmentsOfCollection(2))
+
+	// the segments are removed from the kv of meta
+	reloaded, err := NewMeta(meta.client)
+	assert.Nil(t, err)
+	assert.Empty(t, reloaded.GetSegmentsOfCollection(1))
+	assert.Equal(t, []UniqueID{20}, reloaded.GetSegmentsOfCollection(2))
+
+	// purged again by a retry
+	err = purgeCollection(meta, source, 1)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,597 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/notify"
+)
+
+// isClusteringKeySupported tells whether the fields of the data type can be the clustering key
+func isClusteringKeySupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		return true
+	default:
+		return false
+	}
+}
+
+// clusteringCompactor rewrites the flushed segments of a collection into segments sorted by a scalar field,
+// each compacted segment records the key range of the field, so the query nodes skip the segments out of
+// the range of the predicates.
+// The segments of each partition and channel are compacted separately, the source segments are kept in
+// `Dropped` state and their binlogs are not removed
+type clusteringCompactor struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta
+	allocator allocator
+	kvCreator kvCreatorFunc
+	// the compacted segments are notified to RootCoord like the flushed ones, so their indexes are built
+	flushCh chan<- UniqueID
+	// the completed compactions are notified to the configured sinks, nil if not enabled
+	notifier *notify.Notifier
+
+	mu      sync.Mutex
+	running map[UniqueID]struct{} // collections being compacted
+}
+
+func newClusteringCompactor(ctx context.Context, meta *meta, allocator allocator, kvCreator kvCreatorFunc,
+	flushCh chan<- UniqueID) *clusteringCompactor {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &clusteringCompactor{
+		ctx:       ctx1,
+		cancel:    cancel,
+		meta:      meta,
+		allocator: allocator,
+		kvCreator: kvCreator,
+		flushCh:   flushCh,
+		running:   make(map[UniqueID]struct{}),
+	}
+}
+
+// submit starts the compaction of the collection in background, fails if the collection is being compacted
+func (c *clusteringCompactor) submit(collectionID UniqueID, fieldID UniqueID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.running[collectionID]; ok {
+		return fmt.Errorf("collection %d is being compacted", collectionID)
+	}
+	c.running[collectionID] = struct{}{}
+
+	c.wg.Add(1)
+	go c.run(collectionID, fieldID)
+	return nil
+}
+
+// close cancels the running compactions and waits for them to quit
+func (c *clusteringCompactor) close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *clusteringCompactor) run(collectionID UniqueID, fieldID UniqueID) {
+	defer c.wg.Done()
+	defer func() {
+		c.mu.Lock()
+		delete(c.running, collectionID)
+		c.mu.Unlock()
+	}()
+
+	log.Debug("start clustering compaction", zap.Int64("collectionID", collectionID), zap.Int64("fieldID", fieldID))
+	segmentIDs, err := c.compact(collectionID, fieldID)
+	if err != nil {
+		log.Warn("clustering compaction failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	log.Debug("clustering compaction completed", zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", segmentIDs))
+	c.notifier.Notify(notify.CompactionCompleted, map[string]interface{}{
+		"type":          "clustering",
+		"collection_id": collectionID,
+		"field_id":      fieldID,
+		"segment_ids":   segmentIDs,
+	})
+	for _, segmentID := range segmentIDs {
+		select {
+		case <-c.ctx.Done():
+			// the segments left in `Flushing` state are notified again when datacoord restarts
+			return
+		case c.flushCh <- segmentID:
+		}
+	}
+}
+
+// compact compacts the flushed segments of the collection, returns the ids of the compacted segments
+func (c *clusteringCompactor) compact(collectionID UniqueID, fieldID UniqueID) ([]UniqueID, error) {
+	collection := c.meta.GetCollection(collectionID)
+	if collection == nil {
+		return nil, fmt.Errorf("collection %d not found", collectionID)
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range collection.GetSchema().GetFields() {
+		if f.GetFieldID() == fieldID {
+			field = f
+		}
+	}
+	if field == nil {
+		return nil, fmt.Errorf("field %d not found in collection %d", fieldID, collectionID)
+	}
+	if !isClusteringKeySupported(field.GetDataType()) {
+		return nil, fmt.Errorf("field %s of type %s can't be the clustering key", field.GetName(), field.GetDataType().String())
+	}
+	maxRows, err := calBySchemaPolicy(collection.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	type groupKey struct {
+		partitionID UniqueID
+		channel     string
+	}
+	groups := make(map[groupKey][]*SegmentInfo)
+	keys := make([]groupKey, 0)
+	segments := c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID && segment.GetState() == commonpb.SegmentState_Flushed &&
+			segment.GetLevel() == datapb.SegmentLevel_L1
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+	for _, segment := range segments {
+		key := groupKey{partitionID: segment.GetPartitionID(), channel: segment.GetInsertChannel()}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], segment)
+	}
+
+	source, err := c.kvCreator(c.ctx, Params.MinioBucketName)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close()
+
+	collectionMeta := &etcdpb.CollectionMeta{
+		ID:     collection.GetID(),
+		Schema: collection.GetSchema(),
+	}
+	compacted := make([]UniqueID, 0)
+	for _, key := range keys {
+		group := groups[key]
+		// the segment is compacted already
+		if len(group) == 1 && group[0].GetClusteringInfo() != nil && group[0].GetClusteringInfo().GetFieldID() == fieldID {
+			continue
+		}
+		if err := c.ctx.Err(); err != nil {
+			return nil, err
+		}
+		newSegments, err := c.compactSegments(source, collectionMeta, field, group, maxRows)
+		if err != nil {
+			return nil, fmt.Errorf("compact segments of partition %d channel %s failed: %w", key.partitionID, key.channel, err)
+		}
+		for _, segment := range newSegments {
+			compacted = append(compacted, segment.GetID())
+		}
+	}
+	return compacted, nil
+}
+
+// compactSegments merges the rows of the segments, and writes them sorted by the field into segments of
+// at most maxRows rows without the deleted rows, the segments are replaced in meta by the new ones in `Flushing` state
+func (c *clusteringCompactor) compactSegments(source kv.BaseKV, collectionMeta *etcdpb.CollectionMeta,
+	field *schemapb.FieldSchema, segments []*SegmentInfo, maxRows int) ([]*SegmentInfo, error) {
+	if maxRows <= 0 {
+		return nil, fmt.Errorf("invalid max rows %d of segment", maxRows)
+	}
+	merged := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	segmentIDs := make([]UniqueID, 0, len(segments))
+	var startPosition, dmlPosition *internalpb.MsgPosition
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetID())
+		if pos := segment.GetStartPosition(); pos != nil && (startPosition == nil || pos.GetTimestamp() < startPosition.GetTimestamp()) {
+			startPosition = pos
+		}
+		if pos := segment.GetDmlPosition(); pos != nil && (dmlPosition == nil || pos.GetTimestamp() > dmlPosition.GetTimestamp()) {
+			dmlPosition = pos
+		}
+		data, err := loadSegmentData(source, collectionMeta, segment)
+		if err != nil {
+			return nil, fmt.Errorf("load segment %d failed: %w", segment.GetID(), err)
+		}
+		if data == nil {
+			continue
+		}
+		// the deleted rows are dropped, so the delta logs of the segments are not needed by the new ones
+		if data, err = purgeDeletedRows(source, segment, data); err != nil {
+			return nil, fmt.Errorf("purge the deleted rows of segment %d failed: %w", segment.GetID(), err)
+		}
+		for fieldID, fieldData := range data.Data {
+			mergedData, err := mergeFieldData(merged.Data[fieldID], fieldData)
+			if err != nil {
+				return nil, fmt.Errorf("merge field %d of segment %d failed: %w", fieldID, segment.GetID(), err)
+			}
+			merged.Data[fieldID] = mergedData
+		}
+	}
+
+	numRows, err := getNumRows(collectionMeta.GetSchema().GetFields(), merged)
+	if err != nil {
+		return nil, err
+	}
+	newSegments := make([]*SegmentInfo, 0)
+	if numRows > 0 {
+		keyData, ok := merged.Data[field.GetFieldID()]
+		if !ok {
+			return nil, fmt.Errorf("no data of field %s", field.GetName())
+		}
+		ints, floats, err := getClusteringKeys(keyData)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]int, numRows)
+		for i := range rows {
+			rows[i] = i
+		}
+		if ints != nil {
+			sort.SliceStable(rows, func(i, j int) bool { return ints[rows[i]] < ints[rows[j]] })
+		} else {
+			sort.SliceStable(rows, func(i, j int) bool { return floats[rows[i]] < floats[rows[j]] })
+		}
+
+		for start := 0; start < numRows; start += maxRows {
+			end := start + maxRows
+			if end > numRows {
+				end = numRows
+			}
+			// the rows are sorted by the key, the min and max are the first and the last
+			info := &datapb.ClusteringInfo{
+				FieldID:  field.GetFieldID(),
+				DataType: field.GetDataType(),
+			}
+			if ints != nil {
+				info.IntMin, info.IntMax = ints[rows[start]], ints[rows[end-1]]
+			} else {
+				info.FloatMin, info.FloatMax = floats[rows[start]], floats[rows[end-1]]
+			}
+			segment, err := writeCompactedSegment(c.ctx, c.allocator, source, collectionMeta, segments[0], merged, rows[start:end], info)
+			if err != nil {
+				removeCompactedBinlogs(source, newSegments)
+				return nil, err
+			}
+			segment.MaxRowNum = int64(maxRows)
+			segment.StartPosition = startPosition
+			segment.DmlPosition = dmlPosition
+			newSegments = append(newSegments, segment)
+		}
+	}
+
+	if err := c.meta.CompactSegments(segments, newSegments); err != nil {
+		removeCompactedBinlogs(source, newSegments)
+		return nil, err
+	}
+	log.Debug("segments compacted", zap.Int64s("from", segmentIDs), zap.Int("rows", numRows),
+		zap.Int("segments", len(newSegments)))
+	return newSegments, nil
+}
+
+// writeCompactedSegment saves the provided rows of data as the binlogs of a new segment in the partition and
+// channel of the template
+func writeCompactedSegment(ctx context.Context, alloc allocator, target kv.BaseKV, collectionMeta *etcdpb.CollectionMeta,
+	template *SegmentInfo, data *storage.InsertData, rows []int, info *datapb.ClusteringInfo) (*SegmentInfo, error) {
+	gathered := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData, len(data.Data))}
+	for fieldID, fieldData := range data.Data {
+		fd, err := gatherFieldData(fieldData, rows)
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %w", fieldID, err)
+		}
+		gathered.Data[fieldID] = fd
+	}
+
+	segmentID, err := alloc.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collectionID, partitionID := template.GetCollectionID(), template.GetPartitionID()
+	codec := storage.NewInsertCodec(collectionMeta)
+	defer codec.Close()
+	binlogs, statsBinlogs, err := codec.Serialize(partitionID, segmentID, gathered)
+	if err != nil {
+		return nil, fmt.Errorf("serialize segment %d failed: %w", segmentID, err)
+	}
+
+	kvs := make(map[string]string, len(binlogs)+len(statsBinlogs))
+	field2Logidx := make(map[UniqueID]UniqueID, len(binlogs))
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:             segmentID,
+		CollectionID:   collectionID,
+		PartitionID:    partitionID,
+		InsertChannel:  template.GetInsertChannel(),
+		NumOfRows:      int64(len(rows)),
+		State:          commonpb.SegmentState_Flushing,
+		ClusteringInfo: info,
+	})
+	for _, blob := range binlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse string to fieldID: %w", err)
+		}
+		logidx, err := alloc.allocID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key := buildBinlogPath(Params.InsertBinlogRootPath, collectionID, partitionID, segmentID, fieldID, logidx)
+		kvs[key] = string(blob.GetValue())
+		field2Logidx[fieldID] = logidx
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key},
+			BinlogSize: int64(len(blob.GetValue()))})
+	}
+	for _, blob := range statsBinlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse string to fieldID: %w", err)
+		}
+		key := buildBinlogPath(Params.StatsBinlogRootPath, collectionID, partitionID, segmentID, fieldID, field2Logidx[fieldID])
+		kvs[key] = string(blob.GetValue())
+		segment.Statslogs = append(segment.Statslogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key},
+			BinlogSize: int64(len(blob.GetValue()))})
+	}
+	if err := target.MultiSave(kvs); err != nil {
+		return nil, fmt.Errorf("save binlogs of segment %d failed: %w", segmentID, err)
+	}
+	return segment, nil
+}
+
+// removeCompactedBinlogs removes the binlogs of the compacted segments not added to meta
+func removeCompactedBinlogs(target kv.BaseKV, segments []*SegmentInfo) {
+	keys := make([]string, 0)
+	for _, segment := range segments {
+		for _, fieldBinlog := range append(segment.GetBinlogs(), segment.GetStatslogs()...) {
+			keys = append(keys, fieldBinlog.GetBinlogs()...)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if err := target.MultiRemove(keys); err != nil {
+		log.Warn("failed to remove the binlogs of the compacted segments", zap.Error(err))
+	}
+}
+
+// buildBinlogPath returns the binlog key of the field in the same layout as the data nodes
+func buildBinlogPath(rootPath string, collectionID, partitionID, segmentID, fieldID, logID UniqueID) string {
+	return path.Join(rootPath, strconv.FormatInt(collectionID, 10), strconv.FormatInt(partitionID, 10),
+		strconv.FormatInt(segmentID, 10), strconv.FormatInt(fieldID, 10), strconv.FormatInt(logID, 10))
+}
+
+// getClusteringKeys returns the keys of the rows as int64 for the integer fields, or as float64 for the float fields
+func getClusteringKeys(fieldData storage.FieldData) ([]int64, []float64, error) {
+	switch d := fieldData.(type) {
+	case *storage.Int8FieldData:
+		ints := make([]int64, len(d.Data))
+		for i, v := range d.Data {
+			ints[i] = int64(v)
+		}
+		return ints, nil, nil
+	case *storage.Int16FieldData:
+		ints := make([]int64, len(d.Data))
+		for i, v := range d.Data {
+			ints[i] = int64(v)
+		}
+		return ints, nil, nil
+	case *storage.Int32FieldData:
+		ints := make([]int64, len(d.Data))
+		for i, v := range d.Data {
+			ints[i] = int64(v)
+		}
+		return ints, nil, nil
+	case *storage.Int64FieldData:
+		return append([]int64{}, d.Data...), nil, nil
+	case *storage.FloatFieldData:
+		floats := make([]float64, len(d.Data))
+		for i, v := range d.Data {
+			floats[i] = float64(v)
+		}
+		return nil, floats, nil
+	case *storage.DoubleFieldData:
+		return nil, append([]float64{}, d.Data...), nil
+	default:
+		return nil, nil, errors.New("unsupported clustering key data type")
+	}
+}
+
+// mergeFieldData appends the rows of src to dst, dst is nil for the first segment
+func mergeFieldData(dst storage.FieldData, src storage.FieldData) (storage.FieldData, error) {
+	if dst == nil {
+		return gatherFieldData(src, nil)
+	}
+	switch d := dst.(type) {
+	case *storage.BoolFieldData:
+		s, ok := src.(*storage.BoolFieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.Int8FieldData:
+		s, ok := src.(*storage.Int8FieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.Int16FieldData:
+		s, ok := src.(*storage.Int16FieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.Int32FieldData:
+		s, ok := src.(*storage.Int32FieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.Int64FieldData:
+		s, ok := src.(*storage.Int64FieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.FloatFieldData:
+		s, ok := src.(*storage.FloatFieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.DoubleFieldData:
+		s, ok := src.(*storage.DoubleFieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.StringFieldData:
+		s, ok := src.(*storage.StringFieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.BinaryVectorFieldData:
+		s, ok := src.(*storage.BinaryVectorFieldData)
+		if !ok || s.Dim != d.Dim {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.FloatVectorFieldData:
+		s, ok := src.(*storage.FloatVectorFieldData)
+		if !ok || s.Dim != d.Dim {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Data = append(d.Data, s.Data...)
+	case *storage.SparseFloatVectorFieldData:
+		s, ok := src.(*storage.SparseFloatVectorFieldData)
+		if !ok {
+			return nil, errors.New("mismatched field data type")
+		}
+		d.Contents = append(d.Contents, s.Contents...)
+		if s.Dim > d.Dim {
+			d.Dim = s.Dim
+		}
+	default:
+		return nil, errors.New("unsupported field data type")
+	}
+	return dst, nil
+}
+
+// gatherFieldData returns a copy of the provided rows of fieldData in order, all rows if rows is nil
+func gatherFieldData(fieldData storage.FieldData, rows []int) (storage.FieldData, error) {
+	numRows, err := getFieldNumRows(fieldData)
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		rows = make([]int, numRows)
+		for i := range rows {
+			rows[i] = i
+		}
+	}
+	n := []int64{int64(len(rows))}
+	switch d := fieldData.(type) {
+	case *storage.BoolFieldData:
+		data := make([]bool, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.BoolFieldData{NumRows: n, Data: data}, nil
+	case *storage.Int8FieldData:
+		data := make([]int8, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.Int8FieldData{NumRows: n, Data: data}, nil
+	case *storage.Int16FieldData:
+		data := make([]int16, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.Int16FieldData{NumRows: n, Data: data}, nil
+	case *storage.Int32FieldData:
+		data := make([]int32, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.Int32FieldData{NumRows: n, Data: data}, nil
+	case *storage.Int64FieldData:
+		data := make([]int64, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.Int64FieldData{NumRows: n, Data: data}, nil
+	case *storage.FloatFieldData:
+		data := make([]float32, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.FloatFieldData{NumRows: n, Data: data}, nil
+	case *storage.DoubleFieldData:
+		data := make([]float64, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.DoubleFieldData{NumRows: n, Data: data}, nil
+	case *storage.StringFieldData:
+		data := make([]string, 0, len(rows))
+		for _, i := range rows {
+			data = append(data, d.Data[i])
+		}
+		return &storage.StringFieldData{NumRows: n, Data: data}, nil
+	case *storage.BinaryVectorFieldData:
+		size := d.Dim / 8
+		data := make([]byte, 0, len(rows)*size)
+		for _, i := range rows {
+			data = append(data, d.Data[i*size:(i+1)*size]...)
+		}
+		return &storage.BinaryVectorFieldData{NumRows: n, Data: data, Dim: d.Dim}, nil
+	case *storage.FloatVectorFieldData:
+		data := make([]float32, 0, len(rows)*d.Dim)
+		for _, i := range rows {
+			data = append(data, d.Data[i*d.Dim:(i+1)*d.Dim]...)
+		}
+		return &storage.FloatVectorFieldData{NumRows: n, Data: data, Dim: d.Dim}, nil
+	case *storage.SparseFloatVectorFieldData:
+		contents := make([][]byte, 0, len(rows))
+		for _, i := range rows {
+			contents = append(contents, d.Contents[i])
+		}
+		return &storage.SparseFloatVectorFieldData{NumRows: n, Contents: contents, Dim: d.Dim}, nil
+	default:
+		return nil, errors.New("unsupported field data type")
+	}
+}
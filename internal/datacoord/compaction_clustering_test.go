@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestClusteringCompactor(t *testing.T) {
+	Params.Init()
+	schema := newExportTestSchema()
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	assert.Nil(t, err)
+	// 2 rows per segment
+	segmentMaxSize := Params.SegmentMaxSize
+	Params.SegmentMaxSize = float64(sizePerRecord*2) / 1024 / 1024
+	defer func() {
+		Params.SegmentMaxSize = segmentMaxSize
+	}()
+
+	mockAllocator := newMockAllocator()
+	mockAllocator.cnt = 1000
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: schema})
+	source := memkv.NewMemoryKV()
+	kvCreator := func(ctx context.Context, bucketName string) (kv.BaseKV, error) {
+		return source, nil
+	}
+	saveExportTestSegment(t, meta, source, 10, commonpb.SegmentState_Flushed, []int64{5, 1, 9})
+	saveExportTestSegment(t, meta, source, 11, commonpb.SegmentState_Flushed, []int64{3, 7})
+	saveExportTestSegment(t, meta, source, 12, commonpb.SegmentState_Flushed, []int64{2})
+	saveExportTestSegment(t, meta, source, 13, commonpb.SegmentState_Growing, []int64{4})
+
+	flushCh := make(chan UniqueID, 10)
+	compactor := newClusteringCompactor(context.Background(), meta, mockAllocator, kvCreator, flushCh)
+	defer compactor.close()
+
+	t.Run("compact", func(t *testing.T) {
+		assert.Nil(t, compactor.submit(1, 100))
+		segmentIDs := make([]UniqueID, 0)
+		for len(segmentIDs) < 3 {
+			select {
+			case segmentID := <-flushCh:
+				segmentIDs = append(segmentIDs, segmentID)
+			case <-time.After(10 * time.Second):
+				t.FailNow()
+			}
+		}
+
+		for _, segmentID := range []UniqueID{10, 11, 12} {
+			assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(segmentID).GetState())
+		}
+		assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(13).GetState())
+		assert.EqualValues(t, 7, meta.GetNumRowsOfCollection(1))
+
+		collectionMeta := &etcdpb.CollectionMeta{ID: 1, Schema: schema}
+		ranges := [][]int64{{1, 2}, {3, 5}, {7, 9}}
+		for i, segmentID := range segmentIDs {
+			segment := meta.GetSegment(segmentID)
+			assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+			assert.EqualValues(t, 2, segment.GetNumOfRows())
+			assert.EqualValues(t, 100, segment.GetClusteringInfo().GetFieldID())
+			assert.Equal(t, ranges[i][0], segment.GetClusteringInfo().GetIntMin())
+			assert.Equal(t, ranges[i][1], segment.GetClusteringInfo().GetIntMax())
+			assert.NotEmpty(t, segment.GetStatslogs())
+
+			data, err := loadSegmentData(source, collectionMeta, segment)
+			assert.Nil(t, err)
+			assert.Equal(t, ranges[i], data.Data[100].(*storage.Int64FieldData).Data)
+			assert.Equal(t, "name"+strconv.FormatInt(ranges[i][1], 10), data.Data[101].(*storage.StringFieldData).Data[1])
+			assert.Equal(t, []float32{float32(ranges[i][0]), float32(ranges[i][0]) + 0.5},
+				data.Data[102].(*storage.FloatVectorFieldData).Data[:2])
+		}
+	})
+
+	t.Run("compact the compacted", func(t *testing.T) {
+		for _, segment := range meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetState() == commonpb.SegmentState_Flushing
+		}) {
+			assert.Nil(t, meta.SetState(segment.GetID(), commonpb.SegmentState_Flushed))
+		}
+		segmentIDs, err := compactor.compact(1, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(segmentIDs))
+		assert.EqualValues(t, 7, meta.GetNumRowsOfCollection(1))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, err := compactor.compact(1, 101)
+		assert.NotNil(t, err)
+		_, err = compactor.compact(1, 200)
+		assert.NotNil(t, err)
+		_, err = compactor.compact(2, 100)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMeta_CompactSegments(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 10})))
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Growing, NumOfRows: 5})))
+
+	compacted := NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Flushing, NumOfRows: 10})
+	segment1 := meta.GetSegment(1)
+	assert.NotNil(t, meta.CompactSegments([]*SegmentInfo{segment1, meta.GetSegment(2)}, []*SegmentInfo{compacted}))
+	assert.NotNil(t, meta.CompactSegments([]*SegmentInfo{segment1, NewSegmentInfo(&datapb.SegmentInfo{ID: 4})}, []*SegmentInfo{compacted}))
+	assert.Nil(t, meta.GetSegment(3))
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(1).GetState())
+
+	// the delta logs saved since the compaction started are not applied
+	deltalogs := []*datapb.DeltaLogInfo{{RecordEntries: 1, DeltaLogPath: "delta/1"}}
+	assert.Nil(t, meta.UpdateFlushSegmentsInfo(1, false, nil, nil, deltalogs, nil, nil))
+	assert.NotNil(t, meta.CompactSegments([]*SegmentInfo{segment1}, []*SegmentInfo{compacted}))
+	segment1 = meta.GetSegment(1)
+
+	assert.Nil(t, meta.CompactSegments([]*SegmentInfo{segment1}, []*SegmentInfo{compacted}))
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(1).GetState())
+	assert.Equal(t, []UniqueID{3}, meta.GetSegment(1).GetCompactedTo())
+	assert.Empty(t, meta.GetSegment(3).GetDeltalogs())
+
+	// the delta logs of the dropped segment are saved to the compacted one
+	assert.Nil(t, meta.UpdateFlushSegmentsInfo(1, false, nil, nil, deltalogs, nil, nil))
+	assert.Equal(t, 1, len(meta.GetSegment(3).GetDeltalogs()))
+	assert.Equal(t, "delta/1", meta.GetSegment(3).GetDeltalogs()[0].GetDeltaLogPath())
+	assert.Equal(t, 1, len(meta.GetSegment(1).GetDeltalogs()))
+	assert.Equal(t, commonpb.SegmentState_Flushing, meta.GetSegment(3).GetState())
+	assert.EqualValues(t, 15, meta.GetNumRowsOfCollection(1))
+	assert.Equal(t, 1, len(meta.GetUnFlushedSegments()))
+
+	// the compaction is persisted
+	reloaded, err := NewMeta(meta.client)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.SegmentState_Dropped, reloaded.GetSegment(1).GetState())
+	assert.NotNil(t, reloaded.GetSegment(3))
+}
@@ -0,0 +1,440 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/notify"
+)
+
+// getSegmentDeleteStats sums up the delta logs of the segment, the deleted rows are estimated by the pks of the
+// delta logs, which are picked by the bloom filters of the data nodes and may be absent in the segment
+func getSegmentDeleteStats(segment *SegmentInfo) *datapb.SegmentDeleteStats {
+	stats := &datapb.SegmentDeleteStats{
+		SegmentID:    segment.GetID(),
+		PartitionID:  segment.GetPartitionID(),
+		NumOfRows:    segment.GetNumOfRows(),
+		NumDeltaLogs: int64(len(segment.GetDeltalogs())),
+	}
+	for _, deltalog := range segment.GetDeltalogs() {
+		stats.NumDeletedRows += deltalog.GetRecordEntries()
+		stats.DeltaLogSize += deltalog.GetDeltaLogSize()
+	}
+	return stats
+}
+
+// addSegmentDeleteStats adds the delete stats of a segment to the stats of its collection
+func addSegmentDeleteStats(stats *datapb.GetDeleteStatsResponse, segmentStats *datapb.SegmentDeleteStats) {
+	stats.NumOfRows += segmentStats.GetNumOfRows()
+	stats.NumDeletedRows += segmentStats.GetNumDeletedRows()
+	stats.DeltaLogSize += segmentStats.GetDeltaLogSize()
+}
+
+// getDeleteRatio returns the ratio of the deleted rows to the rows, at most 1
+func getDeleteRatio(numRows, numDeletedRows int64) float64 {
+	if numDeletedRows <= 0 {
+		return 0
+	}
+	if numDeletedRows >= numRows {
+		return 1
+	}
+	return float64(numDeletedRows) / float64(numRows)
+}
+
+// needPurgeDeletes tells whether the deletes of the segment reach the thresholds of the delete compaction
+func needPurgeDeletes(stats *datapb.SegmentDeleteStats) bool {
+	if stats.GetNumDeletedRows() == 0 {
+		return false
+	}
+	return getDeleteRatio(stats.GetNumOfRows(), stats.GetNumDeletedRows()) >= Params.DeleteCompactionRatio ||
+		stats.GetDeltaLogSize() >= Params.DeleteCompactionDeltaLogSize
+}
+
+// loadDeletes reads the delta logs of the segment, returns the pks to their latest delete timestamps
+func loadDeletes(source kv.BaseKV, segment *SegmentInfo) (map[int64]Timestamp, error) {
+	keys := make([]string, 0, len(segment.GetDeltalogs()))
+	for _, deltalog := range segment.GetDeltalogs() {
+		keys = append(keys, deltalog.GetDeltaLogPath())
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	values, err := source.MultiLoad(keys)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(keys))
+	for i, key := range keys {
+		blobs = append(blobs, &storage.Blob{Key: key, Value: []byte(values[i])})
+	}
+	_, _, data, err := storage.NewDeleteCodec(segment.GetCollectionID()).Deserialize(blobs)
+	if err != nil {
+		return nil, err
+	}
+	deletes := make(map[int64]Timestamp, len(data.Pks))
+	for i, pk := range data.Pks {
+		if ts := data.Tss[i]; ts > deletes[pk] {
+			deletes[pk] = ts
+		}
+	}
+	return deletes, nil
+}
+
+// mergeDeletes adds the deletes of from to the deletes of to, keeping the latest delete timestamps
+func mergeDeletes(to, from map[int64]Timestamp) map[int64]Timestamp {
+	if to == nil {
+		to = make(map[int64]Timestamp, len(from))
+	}
+	for pk, ts := range from {
+		if ts > to[pk] {
+			to[pk] = ts
+		}
+	}
+	return to
+}
+
+// purgeDeletedRows returns the rows of data not deleted by the delta logs of the segment
+func purgeDeletedRows(source kv.BaseKV, segment *SegmentInfo, data *storage.InsertData) (*storage.InsertData, error) {
+	deletes, err := loadDeletes(source, segment)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err = applyDeletes(segment.GetID(), data, deletes)
+	return data, err
+}
+
+// applyDeletes returns the rows of data of the segment not deleted and the num of the deleted rows,
+// a row is deleted by a delete of its pk after it is inserted
+func applyDeletes(segmentID UniqueID, data *storage.InsertData, deletes map[int64]Timestamp) (*storage.InsertData, int, error) {
+	if len(deletes) == 0 {
+		return data, 0, nil
+	}
+	pkData, ok := data.Data[rootcoord.RowIDField].(*storage.Int64FieldData)
+	if !ok {
+		return nil, 0, fmt.Errorf("no row ids in segment %d", segmentID)
+	}
+	tsData, ok := data.Data[rootcoord.TimeStampField].(*storage.Int64FieldData)
+	if !ok || len(tsData.Data) != len(pkData.Data) {
+		return nil, 0, fmt.Errorf("no timestamps of the rows in segment %d", segmentID)
+	}
+	rows := make([]int, 0, len(pkData.Data))
+	for i, pk := range pkData.Data {
+		if ts, ok := deletes[pk]; ok && ts > Timestamp(tsData.Data[i]) {
+			continue
+		}
+		rows = append(rows, i)
+	}
+	if len(rows) == len(pkData.Data) {
+		return data, 0, nil
+	}
+	purged := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData, len(data.Data))}
+	for fieldID, fieldData := range data.Data {
+		fd, err := gatherFieldData(fieldData, rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("field %d: %w", fieldID, err)
+		}
+		purged.Data[fieldID] = fd
+	}
+	return purged, len(pkData.Data) - len(rows), nil
+}
+
+// deleteCompactor rewrites the flushed segments without their deleted rows, so the readers of the segments load
+// no delta logs. The segments of many deletes are compacted in the order of their delete ratios periodically,
+// and the segments of a collection could be compacted on demand. The deletes of the L0 segments are
+// compacted into the L1 segments of their channels periodically as well.
+// Each segment is compacted into at most one segment, which keeps the clustering info of the source segment,
+// the source segments are kept in `Dropped` state and their binlogs are not removed
+type deleteCompactor struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta
+	allocator allocator
+	kvCreator kvCreatorFunc
+	// the compacted segments are notified to RootCoord like the flushed ones, so their indexes are built
+	flushCh chan<- UniqueID
+	// the completed compactions are notified to the configured sinks, nil if not enabled
+	notifier *notify.Notifier
+
+	mu      sync.Mutex
+	running map[UniqueID]struct{} // segments being compacted
+}
+
+func newDeleteCompactor(ctx context.Context, meta *meta, allocator allocator, kvCreator kvCreatorFunc,
+	flushCh chan<- UniqueID) *deleteCompactor {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &deleteCompactor{
+		ctx:       ctx1,
+		cancel:    cancel,
+		meta:      meta,
+		allocator: allocator,
+		kvCreator: kvCreator,
+		flushCh:   flushCh,
+		running:   make(map[UniqueID]struct{}),
+	}
+}
+
+// start starts the periodical compaction of the segments of many deletes, if the delete compaction is enabled
+func (c *deleteCompactor) start() {
+	if !Params.EnableDeleteCompaction {
+		return
+	}
+	c.wg.Add(1)
+	go c.loop()
+}
+
+// close cancels the running compactions and waits for them to quit
+func (c *deleteCompactor) close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *deleteCompactor) loop() {
+	defer logutil.LogPanic()
+	defer c.wg.Done()
+	ticker := time.NewTicker(time.Duration(Params.DeleteCompactionInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("delete compaction loop shutdown")
+			return
+		case <-ticker.C:
+			c.foldL0Segments()
+			segments := c.selectSegments()
+			if len(segments) == 0 {
+				continue
+			}
+			if err := c.acquire(segments); err != nil {
+				log.Warn("skip the delete compaction", zap.Error(err))
+				continue
+			}
+			// the segments are compacted in the loop, so the rounds never overlap
+			c.wg.Add(1)
+			c.run(segments)
+		}
+	}
+}
+
+// selectSegments picks the flushed segments of many deletes not being compacted, the ones of the highest
+// delete ratios first
+func (c *deleteCompactor) selectSegments() []*SegmentInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ratios := make(map[UniqueID]float64)
+	segments := c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		if segment.GetState() != commonpb.SegmentState_Flushed || segment.GetLevel() == datapb.SegmentLevel_L0 {
+			return false
+		}
+		if _, ok := c.running[segment.GetID()]; ok {
+			return false
+		}
+		stats := getSegmentDeleteStats(segment)
+		if !needPurgeDeletes(stats) {
+			return false
+		}
+		ratios[segment.GetID()] = getDeleteRatio(stats.GetNumOfRows(), stats.GetNumDeletedRows())
+		return true
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		ri, rj := ratios[segments[i].GetID()], ratios[segments[j].GetID()]
+		if ri != rj {
+			return ri > rj
+		}
+		return segments[i].GetID() < segments[j].GetID()
+	})
+	if len(segments) > Params.DeleteCompactionMaxSegments {
+		segments = segments[:Params.DeleteCompactionMaxSegments]
+	}
+	return segments
+}
+
+// submit starts the compaction of the segments of the collection in background, all the flushed segments with
+// delta logs of the collection if segmentIDs is empty. It fails if any of the segments is not flushed or
+// is being compacted, or is an L0 segment
+func (c *deleteCompactor) submit(collectionID UniqueID, segmentIDs []UniqueID) error {
+	var segments []*SegmentInfo
+	if len(segmentIDs) == 0 {
+		segments = c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == collectionID && segment.GetState() == commonpb.SegmentState_Flushed &&
+				segment.GetLevel() == datapb.SegmentLevel_L1 && len(segment.GetDeltalogs()) > 0
+		})
+		sort.Slice(segments, func(i, j int) bool {
+			return segments[i].GetID() < segments[j].GetID()
+		})
+	} else {
+		for _, segmentID := range segmentIDs {
+			segment := c.meta.GetSegment(segmentID)
+			if segment == nil || segment.GetCollectionID() != collectionID {
+				return fmt.Errorf("segment %d not found in collection %d", segmentID, collectionID)
+			}
+			if segment.GetState() != commonpb.SegmentState_Flushed {
+				return fmt.Errorf("segment %d is %s, not flushed", segmentID, segment.GetState().String())
+			}
+			if segment.GetLevel() == datapb.SegmentLevel_L0 {
+				return fmt.Errorf("segment %d is an L0 segment", segmentID)
+			}
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+	if err := c.acquire(segments); err != nil {
+		return err
+	}
+	c.wg.Add(1)
+	go c.run(segments)
+	return nil
+}
+
+// acquire marks the segments running, fails if any of them is running
+func (c *deleteCompactor) acquire(segments []*SegmentInfo) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, segment := range segments {
+		if _, ok := c.running[segment.GetID()]; ok {
+			return fmt.Errorf("segment %d is being compacted", segment.GetID())
+		}
+	}
+	for _, segment := range segments {
+		c.running[segment.GetID()] = struct{}{}
+	}
+	return nil
+}
+
+// release marks the segments not running
+func (c *deleteCompactor) release(segments []*SegmentInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, segment := range segments {
+		delete(c.running, segment.GetID())
+	}
+}
+
+func (c *deleteCompactor) run(segments []*SegmentInfo) {
+	defer c.wg.Done()
+	defer c.release(segments)
+
+	source, err := c.kvCreator(c.ctx, Params.MinioBucketName)
+	if err != nil {
+		log.Warn("delete compaction failed", zap.Error(err))
+		return
+	}
+	defer source.Close()
+
+	for _, segment := range segments {
+		if c.ctx.Err() != nil {
+			return
+		}
+		compacted, _, err := c.compact(source, segment, nil)
+		if err != nil {
+			log.Warn("delete compaction failed", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			continue
+		}
+		if compacted == nil {
+			continue
+		}
+		select {
+		case <-c.ctx.Done():
+			// the segments left in `Flushing` state are notified again when datacoord restarts
+			return
+		case c.flushCh <- compacted.GetID():
+		}
+	}
+}
+
+// compact rewrites the segment without the rows deleted by its delta logs and by the deletes of the L0 segments,
+// returns the compacted segment, or nil if all the rows of the segment are deleted. The segment is not compacted
+// if it has no delta logs and none of its rows is deleted by the L0 segments, and false is returned then
+func (c *deleteCompactor) compact(source kv.BaseKV, segment *SegmentInfo, l0Deletes map[int64]Timestamp) (*SegmentInfo, bool, error) {
+	collection := c.meta.GetCollection(segment.GetCollectionID())
+	if collection == nil {
+		return nil, false, fmt.Errorf("collection %d not found", segment.GetCollectionID())
+	}
+	collectionMeta := &etcdpb.CollectionMeta{
+		ID:     collection.GetID(),
+		Schema: collection.GetSchema(),
+	}
+	deletes, err := loadDeletes(source, segment)
+	if err != nil {
+		return nil, false, fmt.Errorf("load deletes failed: %w", err)
+	}
+	deletes = mergeDeletes(deletes, l0Deletes)
+	data, err := loadSegmentData(source, collectionMeta, segment)
+	if err != nil {
+		return nil, false, fmt.Errorf("load segment failed: %w", err)
+	}
+	numRows, numDeleted := 0, 0
+	if data != nil {
+		if data, numDeleted, err = applyDeletes(segment.GetID(), data, deletes); err != nil {
+			return nil, false, err
+		}
+		if numRows, err = getNumRows(collection.GetSchema().GetFields(), data); err != nil {
+			return nil, false, err
+		}
+	}
+	if numDeleted == 0 && len(segment.GetDeltalogs()) == 0 {
+		return nil, false, nil
+	}
+
+	newSegments := make([]*SegmentInfo, 0, 1)
+	if numRows > 0 {
+		rows := make([]int, numRows)
+		for i := range rows {
+			rows[i] = i
+		}
+		compacted, err := writeCompactedSegment(c.ctx, c.allocator, source, collectionMeta, segment, data, rows,
+			segment.GetClusteringInfo())
+		if err != nil {
+			return nil, false, err
+		}
+		compacted.MaxRowNum = segment.GetMaxRowNum()
+		compacted.StartPosition = segment.GetStartPosition()
+		compacted.DmlPosition = segment.GetDmlPosition()
+		newSegments = append(newSegments, compacted)
+	}
+	if err := c.meta.CompactSegments([]*SegmentInfo{segment}, newSegments); err != nil {
+		removeCompactedBinlogs(source, newSegments)
+		return nil, false, err
+	}
+	log.Debug("segment compacted without the deleted rows", zap.Int64("segmentID", segment.GetID()),
+		zap.Int64("rows", segment.GetNumOfRows()), zap.Int("rows left", numRows))
+	properties := map[string]interface{}{
+		"type":          "delete",
+		"collection_id": segment.GetCollectionID(),
+		"segment_id":    segment.GetID(),
+		"num_rows":      numRows,
+	}
+	if len(newSegments) == 0 {
+		c.notifier.Notify(notify.CompactionCompleted, properties)
+		return nil, true, nil
+	}
+	properties["compacted_segment_id"] = newSegments[0].GetID()
+	c.notifier.Notify(notify.CompactionCompleted, properties)
+	return newSegments[0], true, nil
+}
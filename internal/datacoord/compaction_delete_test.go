@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// saveDeleteTestDeltalog serializes the deletes into a delta log of source and adds it to the segment
+func saveDeleteTestDeltalog(t *testing.T, meta *meta, source kv.BaseKV, segmentID UniqueID, key string, pks []int64, tss []Timestamp) {
+	blob, err := storage.NewDeleteCodec(1).Serialize(1, segmentID, &storage.DeleteData{Pks: pks, Tss: tss})
+	assert.Nil(t, err)
+	assert.Nil(t, source.Save(key, string(blob.GetValue())))
+	deltalogs := []*datapb.DeltaLogInfo{{
+		RecordEntries: int64(len(pks)),
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.GetValue())),
+	}}
+	assert.Nil(t, meta.UpdateFlushSegmentsInfo(segmentID, false, nil, nil, deltalogs, nil, nil))
+}
+
+func TestDeleteStats(t *testing.T) {
+	Params.Init()
+	segment := NewSegmentInfo(&datapb.SegmentInfo{ID: 1, NumOfRows: 10, Deltalogs: []*datapb.DeltaLogInfo{
+		{RecordEntries: 1, DeltaLogSize: 10}, {RecordEntries: 2, DeltaLogSize: 20}}})
+	stats := getSegmentDeleteStats(segment)
+	assert.EqualValues(t, 3, stats.GetNumDeletedRows())
+	assert.EqualValues(t, 30, stats.GetDeltaLogSize())
+	assert.EqualValues(t, 2, stats.GetNumDeltaLogs())
+	assert.True(t, needPurgeDeletes(stats))
+	stats.NumOfRows = 100
+	assert.False(t, needPurgeDeletes(stats))
+	stats.DeltaLogSize = Params.DeleteCompactionDeltaLogSize
+	assert.True(t, needPurgeDeletes(stats))
+	assert.False(t, needPurgeDeletes(getSegmentDeleteStats(NewSegmentInfo(&datapb.SegmentInfo{ID: 2}))))
+
+	assert.Equal(t, 0.0, getDeleteRatio(0, 0))
+	assert.Equal(t, 0.25, getDeleteRatio(4, 1))
+	assert.Equal(t, 1.0, getDeleteRatio(4, 5))
+}
+
+func TestDeleteCompactor(t *testing.T) {
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	mockAllocator.cnt = 1000
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	schema := newExportTestSchema()
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: schema})
+	source := memkv.NewMemoryKV()
+	kvCreator := func(ctx context.Context, bucketName string) (kv.BaseKV, error) {
+		return source, nil
+	}
+	// the timestamps of the rows are their pks
+	saveExportTestSegment(t, meta, source, 10, commonpb.SegmentState_Flushed, []int64{1, 2, 3, 4})
+	saveExportTestSegment(t, meta, source, 11, commonpb.SegmentState_Flushed, []int64{5, 6})
+	saveExportTestSegment(t, meta, source, 12, commonpb.SegmentState_Flushed, []int64{7, 8})
+	saveExportTestSegment(t, meta, source, 13, commonpb.SegmentState_Growing, []int64{9})
+	// pk 3 is deleted before it is inserted
+	saveDeleteTestDeltalog(t, meta, source, 10, "delta_log/10/1", []int64{1, 2, 3}, []Timestamp{100, 100, 2})
+	saveDeleteTestDeltalog(t, meta, source, 12, "delta_log/12/1", []int64{7, 8}, []Timestamp{100, 100})
+	saveDeleteTestDeltalog(t, meta, source, 13, "delta_log/13/1", []int64{9}, []Timestamp{100})
+
+	flushCh := make(chan UniqueID, 10)
+	compactor := newDeleteCompactor(context.Background(), meta, mockAllocator, kvCreator, flushCh)
+	defer compactor.close()
+
+	t.Run("select segments", func(t *testing.T) {
+		segments := compactor.selectSegments()
+		assert.Equal(t, 2, len(segments))
+		assert.EqualValues(t, 12, segments[0].GetID())
+		assert.EqualValues(t, 10, segments[1].GetID())
+
+		maxSegments := Params.DeleteCompactionMaxSegments
+		Params.DeleteCompactionMaxSegments = 1
+		defer func() {
+			Params.DeleteCompactionMaxSegments = maxSegments
+		}()
+		segments = compactor.selectSegments()
+		assert.Equal(t, 1, len(segments))
+		assert.EqualValues(t, 12, segments[0].GetID())
+	})
+
+	t.Run("invalid segments", func(t *testing.T) {
+		assert.NotNil(t, compactor.submit(1, []UniqueID{13}))
+		assert.NotNil(t, compactor.submit(1, []UniqueID{14}))
+		assert.NotNil(t, compactor.submit(2, []UniqueID{10}))
+		assert.Nil(t, compactor.submit(2, nil))
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		assert.Nil(t, compactor.submit(1, nil))
+		var segmentID UniqueID
+		select {
+		case segmentID = <-flushCh:
+		case <-time.After(10 * time.Second):
+			t.FailNow()
+		}
+		assert.Eventually(t, func() bool {
+			return meta.GetSegment(12).GetState() == commonpb.SegmentState_Dropped
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// all the rows of segment 12 are deleted
+		assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(10).GetState())
+		assert.Equal(t, []UniqueID{segmentID}, meta.GetSegment(10).GetCompactedTo())
+		assert.Empty(t, meta.GetSegment(12).GetCompactedTo())
+		assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(11).GetState())
+		assert.EqualValues(t, 5, meta.GetNumRowsOfCollection(1))
+
+		segment := meta.GetSegment(segmentID)
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.EqualValues(t, 2, segment.GetNumOfRows())
+		assert.Empty(t, segment.GetDeltalogs())
+		data, err := loadSegmentData(source, &etcdpb.CollectionMeta{ID: 1, Schema: schema}, segment)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{3, 4}, data.Data[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, "name4", data.Data[101].(*storage.StringFieldData).Data[1])
+		assert.Empty(t, compactor.selectSegments())
+	})
+
+	t.Run("running segments", func(t *testing.T) {
+		segment := meta.GetSegment(11)
+		assert.Nil(t, compactor.acquire([]*SegmentInfo{segment}))
+		assert.NotNil(t, compactor.submit(1, []UniqueID{11}))
+		compactor.mu.Lock()
+		delete(compactor.running, 11)
+		compactor.mu.Unlock()
+	})
+}
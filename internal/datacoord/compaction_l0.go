@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// allPartitionsID is the partition of the L0 segments, as their deletes apply to all the partitions
+const allPartitionsID UniqueID = -1
+
+// newL0Segment returns the flushed L0 segment of the deletes saved by a data node, the positions of the segment
+// are the ones of the msg packs of the deletes
+func newL0Segment(req *datapb.SaveBinlogPathsRequest) *SegmentInfo {
+	info := &datapb.SegmentInfo{
+		ID:            req.GetSegmentID(),
+		CollectionID:  req.GetCollectionID(),
+		PartitionID:   allPartitionsID,
+		InsertChannel: req.GetChannel(),
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L0,
+		Deltalogs:     req.GetDeltalogs(),
+	}
+	for _, pos := range req.GetStartPositions() {
+		if pos.GetSegmentID() == req.GetSegmentID() {
+			info.StartPosition = pos.GetStartPosition()
+		}
+	}
+	for _, cp := range req.GetCheckPoints() {
+		if cp.GetSegmentID() == req.GetSegmentID() {
+			info.DmlPosition = cp.GetPosition()
+		}
+	}
+	return NewSegmentInfo(info)
+}
+
+// startedBefore tells whether the segment may have the rows inserted before ts, the segments of unknown start
+// positions are assumed to
+func startedBefore(segment *SegmentInfo, ts Timestamp) bool {
+	return segment.GetStartPosition() == nil || segment.GetStartPosition().GetTimestamp() <= ts
+}
+
+// getL0Deltalogs returns the delta logs of the L0 segments which may delete the rows of the segment, the ones of
+// the channel of the segment with deletes after the segment starts
+func getL0Deltalogs(segment *SegmentInfo, l0Segments []*SegmentInfo) []*datapb.DeltaLogInfo {
+	deltalogs := make([]*datapb.DeltaLogInfo, 0)
+	for _, l0 := range l0Segments {
+		if l0.GetInsertChannel() != segment.GetInsertChannel() {
+			continue
+		}
+		for _, deltalog := range l0.GetDeltalogs() {
+			if startedBefore(segment, deltalog.GetTimestampTo()) {
+				deltalogs = append(deltalogs, deltalog)
+			}
+		}
+	}
+	return deltalogs
+}
+
+// getMaxDeleteTs returns the latest delete timestamp of the delta logs of the segments
+func getMaxDeleteTs(segments []*SegmentInfo) Timestamp {
+	var maxTs Timestamp
+	for _, segment := range segments {
+		for _, deltalog := range segment.GetDeltalogs() {
+			if deltalog.GetTimestampTo() > maxTs {
+				maxTs = deltalog.GetTimestampTo()
+			}
+		}
+	}
+	return maxTs
+}
+
+// foldL0Segments compacts the deletes of the flushed L0 segments into the L1 segments of their channels
+func (c *deleteCompactor) foldL0Segments() {
+	channels := make(map[string][]*SegmentInfo)
+	names := make([]string, 0)
+	for _, segment := range c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetLevel() == datapb.SegmentLevel_L0 && segment.GetState() == commonpb.SegmentState_Flushed
+	}) {
+		channel := segment.GetInsertChannel()
+		if _, ok := channels[channel]; !ok {
+			names = append(names, channel)
+		}
+		channels[channel] = append(channels[channel], segment)
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+
+	source, err := c.kvCreator(c.ctx, Params.MinioBucketName)
+	if err != nil {
+		log.Warn("fold L0 segments failed", zap.Error(err))
+		return
+	}
+	defer source.Close()
+	for _, channel := range names {
+		if c.ctx.Err() != nil {
+			return
+		}
+		if err := c.foldChannelL0Segments(source, channel, channels[channel]); err != nil {
+			log.Warn("fold L0 segments failed", zap.String("channel", channel), zap.Error(err))
+		}
+	}
+}
+
+// foldChannelL0Segments applies the deletes of the L0 segments to the flushed L1 segments of the channel started
+// before the deletes, and drops the L0 segments. An L0 segment is kept until all the L1 segments started before
+// its deletes are flushed
+func (c *deleteCompactor) foldChannelL0Segments(source kv.BaseKV, channel string, l0Segments []*SegmentInfo) error {
+	segments := c.meta.GetSegmentsByChannel(channel)
+	minUnflushedTs := Timestamp(math.MaxUint64)
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 || segment.GetState() == commonpb.SegmentState_Flushed ||
+			segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		if segment.GetStartPosition() == nil {
+			return nil
+		}
+		if ts := segment.GetStartPosition().GetTimestamp(); ts < minUnflushedTs {
+			minUnflushedTs = ts
+		}
+	}
+	folded := make([]*SegmentInfo, 0, len(l0Segments))
+	for _, segment := range l0Segments {
+		if getMaxDeleteTs([]*SegmentInfo{segment}) < minUnflushedTs {
+			folded = append(folded, segment)
+		}
+	}
+	if len(folded) == 0 {
+		return nil
+	}
+	maxTs := getMaxDeleteTs(folded)
+	targets := make([]*SegmentInfo, 0)
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L1 && segment.GetState() == commonpb.SegmentState_Flushed &&
+			startedBefore(segment, maxTs) {
+			targets = append(targets, segment)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].GetID() < targets[j].GetID()
+	})
+
+	running := append(append([]*SegmentInfo{}, targets...), folded...)
+	if err := c.acquire(running); err != nil {
+		return err
+	}
+	defer c.release(running)
+
+	var deletes map[int64]Timestamp
+	for _, segment := range folded {
+		l0Deletes, err := loadDeletes(source, segment)
+		if err != nil {
+			return err
+		}
+		deletes = mergeDeletes(deletes, l0Deletes)
+	}
+	for _, segment := range targets {
+		compacted, _, err := c.compact(source, segment, deletes)
+		if err != nil {
+			return err
+		}
+		if compacted == nil {
+			continue
+		}
+		select {
+		case <-c.ctx.Done():
+			return c.ctx.Err()
+		case c.flushCh <- compacted.GetID():
+		}
+	}
+	// the deletes are in the L1 segments now, folding them again after a failure is harmless as the rows are gone
+	if err := c.meta.CompactSegments(folded, nil); err != nil {
+		return err
+	}
+	log.Debug("L0 segments folded into the L1 segments", zap.String("channel", channel),
+		zap.Int("L0 segments", len(folded)), zap.Int("L1 segments", len(targets)))
+	return nil
+}
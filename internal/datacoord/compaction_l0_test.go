@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// saveL0TestSegment serializes the deletes into a delta log of source and adds the L0 segment of it
+func saveL0TestSegment(t *testing.T, meta *meta, source kv.BaseKV, segmentID UniqueID, key string, pks []int64, tss []Timestamp) {
+	blob, err := storage.NewDeleteCodec(1).Serialize(1, segmentID, &storage.DeleteData{Pks: pks, Tss: tss})
+	assert.Nil(t, err)
+	assert.Nil(t, source.Save(key, string(blob.GetValue())))
+	req := &datapb.SaveBinlogPathsRequest{
+		SegmentID:    segmentID,
+		CollectionID: 1,
+		Level:        datapb.SegmentLevel_L0,
+		Deltalogs: []*datapb.DeltaLogInfo{{
+			RecordEntries: int64(len(pks)),
+			TimestampFrom: tss[0],
+			TimestampTo:   tss[len(tss)-1],
+			DeltaLogPath:  key,
+			DeltaLogSize:  int64(len(blob.GetValue())),
+		}},
+	}
+	assert.Nil(t, meta.AddSegment(newL0Segment(req)))
+}
+
+func TestL0Segments(t *testing.T) {
+	req := &datapb.SaveBinlogPathsRequest{
+		SegmentID:    1,
+		CollectionID: 2,
+		Level:        datapb.SegmentLevel_L0,
+		Channel:      "ch1",
+		Deltalogs:    []*datapb.DeltaLogInfo{{TimestampTo: 10, DeltaLogPath: "/delta/1"}},
+		StartPositions: []*datapb.SegmentStartPosition{
+			{SegmentID: 2, StartPosition: &internalpb.MsgPosition{Timestamp: 1}},
+			{SegmentID: 1, StartPosition: &internalpb.MsgPosition{Timestamp: 5}},
+		},
+		CheckPoints: []*datapb.CheckPoint{{SegmentID: 1, Position: &internalpb.MsgPosition{Timestamp: 10}}},
+	}
+	l0 := newL0Segment(req)
+	assert.EqualValues(t, 1, l0.GetID())
+	assert.EqualValues(t, 2, l0.GetCollectionID())
+	assert.Equal(t, allPartitionsID, l0.GetPartitionID())
+	assert.Equal(t, "ch1", l0.GetInsertChannel())
+	assert.Equal(t, commonpb.SegmentState_Flushed, l0.GetState())
+	assert.Equal(t, datapb.SegmentLevel_L0, l0.GetLevel())
+	assert.EqualValues(t, 5, l0.GetStartPosition().GetTimestamp())
+	assert.EqualValues(t, 10, l0.GetDmlPosition().GetTimestamp())
+	assert.EqualValues(t, 10, getMaxDeleteTs([]*SegmentInfo{l0}))
+
+	assert.True(t, startedBefore(NewSegmentInfo(&datapb.SegmentInfo{}), 0))
+	segment := NewSegmentInfo(&datapb.SegmentInfo{InsertChannel: "ch1",
+		StartPosition: &internalpb.MsgPosition{Timestamp: 10}})
+	assert.True(t, startedBefore(segment, 10))
+	assert.False(t, startedBefore(segment, 9))
+
+	l0Segments := []*SegmentInfo{
+		l0,
+		NewSegmentInfo(&datapb.SegmentInfo{InsertChannel: "ch1",
+			Deltalogs: []*datapb.DeltaLogInfo{{TimestampTo: 9}, {TimestampTo: 11, DeltaLogPath: "/delta/2"}}}),
+		NewSegmentInfo(&datapb.SegmentInfo{InsertChannel: "ch2",
+			Deltalogs: []*datapb.DeltaLogInfo{{TimestampTo: 20}}}),
+	}
+	deltalogs := getL0Deltalogs(segment, l0Segments)
+	assert.Equal(t, 2, len(deltalogs))
+	assert.Equal(t, "/delta/1", deltalogs[0].GetDeltaLogPath())
+	assert.Equal(t, "/delta/2", deltalogs[1].GetDeltaLogPath())
+	assert.Empty(t, getL0Deltalogs(NewSegmentInfo(&datapb.SegmentInfo{InsertChannel: "ch3"}), l0Segments))
+}
+
+func TestFoldL0Segments(t *testing.T) {
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	mockAllocator.cnt = 1000
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	schema := newExportTestSchema()
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: schema})
+	source := memkv.NewMemoryKV()
+	kvCreator := func(ctx context.Context, bucketName string) (kv.BaseKV, error) {
+		return source, nil
+	}
+	// the timestamps of the rows are their pks
+	saveExportTestSegment(t, meta, source, 10, commonpb.SegmentState_Flushed, []int64{1, 2, 3, 4})
+	saveExportTestSegment(t, meta, source, 11, commonpb.SegmentState_Growing, []int64{60})
+	assert.Nil(t, meta.UpdateFlushSegmentsInfo(11, false, nil, nil, nil, nil, []*datapb.SegmentStartPosition{{
+		SegmentID:     11,
+		StartPosition: &internalpb.MsgPosition{MsgID: []byte{1}, Timestamp: 50},
+	}}))
+	saveL0TestSegment(t, meta, source, 20, "delta_log/20/1", []int64{1}, []Timestamp{10})
+	saveL0TestSegment(t, meta, source, 21, "delta_log/21/1", []int64{2}, []Timestamp{100})
+
+	flushCh := make(chan UniqueID, 10)
+	compactor := newDeleteCompactor(context.Background(), meta, mockAllocator, kvCreator, flushCh)
+	defer compactor.close()
+
+	// the deletes of segment 21 may apply to segment 11, which is not flushed yet
+	compactor.foldL0Segments()
+	var segmentID UniqueID
+	select {
+	case segmentID = <-flushCh:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(20).GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(21).GetState())
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(10).GetState())
+	assert.Equal(t, []UniqueID{segmentID}, meta.GetSegment(10).GetCompactedTo())
+	assert.EqualValues(t, 3, meta.GetSegment(segmentID).GetNumOfRows())
+	assert.Equal(t, datapb.SegmentLevel_L1, meta.GetSegment(segmentID).GetLevel())
+
+	assert.Nil(t, meta.SetState(segmentID, commonpb.SegmentState_Flushed))
+	assert.Nil(t, meta.SetState(11, commonpb.SegmentState_Flushed))
+	compactor.foldL0Segments()
+	var compactedID UniqueID
+	select {
+	case compactedID = <-flushCh:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(21).GetState())
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(segmentID).GetState())
+	// no rows of segment 11 are deleted
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(11).GetState())
+	assert.Equal(t, 0, len(flushCh))
+
+	segment := meta.GetSegment(compactedID)
+	assert.EqualValues(t, 2, segment.GetNumOfRows())
+	data, err := loadSegmentData(source, &etcdpb.CollectionMeta{ID: 1, Schema: schema}, segment)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 4}, data.Data[100].(*storage.Int64FieldData).Data)
+	assert.Empty(t, compactor.selectSegments())
+}
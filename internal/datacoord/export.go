@@ -0,0 +1,550 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// the fields with smaller ids are the system fields (row id, timestamp), which are not exported
+const startOfUserFieldID = 100
+
+const exportManifestName = "manifest.json"
+
+// kvCreatorFunc creates the kv client of the provided bucket
+type kvCreatorFunc func(ctx context.Context, bucketName string) (kv.BaseKV, error)
+
+func defaultKvCreatorFunc(ctx context.Context, bucketName string) (kv.BaseKV, error) {
+	client, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		BucketName:        bucketName,
+		CreateBucket:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// exportManifest describes the files of an export job, it's saved after all the segments are exported
+type exportManifest struct {
+	CollectionID   UniqueID                `json:"collectionID"`
+	CollectionName string                  `json:"collectionName"`
+	Format         string                  `json:"format"`
+	Fields         []exportManifestField   `json:"fields"`
+	Segments       []exportManifestSegment `json:"segments"`
+}
+
+type exportManifestField struct {
+	FieldID      UniqueID          `json:"fieldID"`
+	Name         string            `json:"name"`
+	DataType     string            `json:"dataType"`
+	IsPrimaryKey bool              `json:"isPrimaryKey,omitempty"`
+	TypeParams   map[string]string `json:"typeParams,omitempty"`
+}
+
+type exportManifestSegment struct {
+	SegmentID   UniqueID `json:"segmentID"`
+	PartitionID UniqueID `json:"partitionID"`
+	NumRows     int64    `json:"numRows"`
+	Files       []string `json:"files"`
+}
+
+// exportJob is an export request and its progress
+type exportJob struct {
+	id  UniqueID
+	req *datapb.ExportRequest
+
+	mu           sync.RWMutex
+	state        datapb.ExportState
+	segmentIDs   []UniqueID
+	manifestPath string
+	reason       string
+}
+
+func (job *exportJob) setState(state datapb.ExportState) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.state = state
+}
+
+func (job *exportJob) addSegment(segmentID UniqueID) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.segmentIDs = append(job.segmentIDs, segmentID)
+}
+
+func (job *exportJob) complete(manifestPath string) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.state = datapb.ExportState_ExportCompleted
+	job.manifestPath = manifestPath
+}
+
+func (job *exportJob) fail(err error) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.state = datapb.ExportState_ExportFailed
+	job.reason = err.Error()
+}
+
+func (job *exportJob) fillState(resp *datapb.GetExportStateResponse) {
+	job.mu.RLock()
+	defer job.mu.RUnlock()
+	resp.State = job.state
+	resp.SegmentIDs = append([]UniqueID{}, job.segmentIDs...)
+	resp.ManifestPath = job.manifestPath
+	resp.Reason = job.reason
+}
+
+// exportManager runs the export jobs in background, at most maxParallelism jobs at the same time.
+// The jobs are kept in memory only, the ones not completed are lost when datacoord restarts
+type exportManager struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta
+	kvCreator kvCreatorFunc
+	tokens    chan struct{}
+
+	mu   sync.RWMutex
+	jobs map[UniqueID]*exportJob
+}
+
+func newExportManager(ctx context.Context, meta *meta, kvCreator kvCreatorFunc, maxParallelism int) *exportManager {
+	if maxParallelism <= 0 {
+		maxParallelism = 1
+	}
+	ctx1, cancel := context.WithCancel(ctx)
+	return &exportManager{
+		ctx:       ctx1,
+		cancel:    cancel,
+		meta:      meta,
+		kvCreator: kvCreator,
+		tokens:    make(chan struct{}, maxParallelism),
+		jobs:      make(map[UniqueID]*exportJob),
+	}
+}
+
+// submit starts the export job in background
+func (m *exportManager) submit(jobID UniqueID, req *datapb.ExportRequest) {
+	job := &exportJob{
+		id:    jobID,
+		req:   req,
+		state: datapb.ExportState_ExportPending,
+	}
+	m.mu.Lock()
+	m.jobs[jobID] = job
+	m.mu.Unlock()
+
+	m.wg.Add(1)
+	go m.run(job)
+}
+
+func (m *exportManager) getJob(jobID UniqueID) *exportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+// close cancels the running jobs and waits for them to quit
+func (m *exportManager) close() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *exportManager) run(job *exportJob) {
+	defer m.wg.Done()
+	select {
+	case <-m.ctx.Done():
+		job.fail(m.ctx.Err())
+		return
+	case m.tokens <- struct{}{}:
+	}
+	defer func() { <-m.tokens }()
+
+	job.setState(datapb.ExportState_Exporting)
+	log.Debug("start export job", zap.Int64("jobID", job.id), zap.Any("request", job.req))
+	manifestPath, err := m.execute(job)
+	if err != nil {
+		log.Warn("export job failed", zap.Int64("jobID", job.id), zap.Error(err))
+		job.fail(err)
+		return
+	}
+	log.Debug("export job completed", zap.Int64("jobID", job.id), zap.String("manifest", manifestPath))
+	job.complete(manifestPath)
+}
+
+// execute writes the flushed segments of the job and then the manifest, returns the manifest path
+func (m *exportManager) execute(job *exportJob) (string, error) {
+	req := job.req
+	collection := m.meta.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		return "", fmt.Errorf("collection %d not found", req.GetCollectionID())
+	}
+	partitions := make(map[UniqueID]struct{}, len(req.GetPartitionIDs()))
+	for _, partitionID := range req.GetPartitionIDs() {
+		partitions[partitionID] = struct{}{}
+	}
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		if segment.GetCollectionID() != req.GetCollectionID() || segment.GetState() != commonpb.SegmentState_Flushed ||
+			segment.GetLevel() == datapb.SegmentLevel_L0 {
+			return false
+		}
+		_, ok := partitions[segment.GetPartitionID()]
+		return len(partitions) == 0 || ok
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+
+	source, err := m.kvCreator(m.ctx, Params.MinioBucketName)
+	if err != nil {
+		return "", err
+	}
+	defer source.Close()
+	bucketName := req.GetBucketName()
+	if bucketName == "" {
+		bucketName = Params.MinioBucketName
+	}
+	target, err := m.kvCreator(m.ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+	defer target.Close()
+
+	manifest := &exportManifest{
+		CollectionID:   collection.GetID(),
+		CollectionName: collection.GetSchema().GetName(),
+		Format:         req.GetFormat().String(),
+		Fields:         make([]exportManifestField, 0),
+		Segments:       make([]exportManifestSegment, 0, len(segments)),
+	}
+	fields := make([]*schemapb.FieldSchema, 0, len(collection.GetSchema().GetFields()))
+	for _, field := range collection.GetSchema().GetFields() {
+		if field.GetFieldID() < startOfUserFieldID {
+			continue
+		}
+		fields = append(fields, field)
+		manifestField := exportManifestField{
+			FieldID:      field.GetFieldID(),
+			Name:         field.GetName(),
+			DataType:     field.GetDataType().String(),
+			IsPrimaryKey: field.GetIsPrimaryKey(),
+		}
+		if len(field.GetTypeParams()) > 0 {
+			manifestField.TypeParams = make(map[string]string)
+			for _, param := range field.GetTypeParams() {
+				manifestField.TypeParams[param.GetKey()] = param.GetValue()
+			}
+		}
+		manifest.Fields = append(manifest.Fields, manifestField)
+	}
+
+	collectionMeta := &etcdpb.CollectionMeta{
+		ID:     collection.GetID(),
+		Schema: collection.GetSchema(),
+	}
+	for _, segment := range segments {
+		if err := m.ctx.Err(); err != nil {
+			return "", err
+		}
+		data, err := loadSegmentData(source, collectionMeta, segment)
+		if err != nil {
+			return "", fmt.Errorf("load segment %d failed: %w", segment.GetID(), err)
+		}
+		exported := exportManifestSegment{
+			SegmentID:   segment.GetID(),
+			PartitionID: segment.GetPartitionID(),
+			Files:       make([]string, 0),
+		}
+		if data != nil {
+			var files map[string]string
+			switch req.GetFormat() {
+			case datapb.ExportFormat_JSON:
+				exported.NumRows, files, err = exportSegmentJSON(req.GetPath(), segment.GetID(), fields, data)
+			case datapb.ExportFormat_Parquet:
+				exported.NumRows, files, err = exportSegmentParquet(req.GetPath(), segment.GetID(), fields, data)
+			default:
+				err = fmt.Errorf("unsupported export format %s", req.GetFormat().String())
+			}
+			if err != nil {
+				return "", fmt.Errorf("export segment %d failed: %w", segment.GetID(), err)
+			}
+			if err := target.MultiSave(files); err != nil {
+				return "", fmt.Errorf("save segment %d failed: %w", segment.GetID(), err)
+			}
+			for key := range files {
+				exported.Files = append(exported.Files, key)
+			}
+			sort.Strings(exported.Files)
+		}
+		manifest.Segments = append(manifest.Segments, exported)
+		job.addSegment(segment.GetID())
+	}
+
+	value, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	manifestPath := path.Join(req.GetPath(), exportManifestName)
+	if err := target.Save(manifestPath, string(value)); err != nil {
+		return "", err
+	}
+	return manifestPath, nil
+}
+
+// loadSegmentData reads and decodes the insert binlogs of segment, returns nil if the segment has no binlog
+func loadSegmentData(source kv.BaseKV, collectionMeta *etcdpb.CollectionMeta, segment *SegmentInfo) (*storage.InsertData, error) {
+	keys := make([]string, 0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		keys = append(keys, fieldBinlog.GetBinlogs()...)
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	values, err := source.MultiLoad(keys)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(keys))
+	for i, key := range keys {
+		blobs = append(blobs, &storage.Blob{Key: key, Value: []byte(values[i])})
+	}
+	codec := storage.NewInsertCodec(collectionMeta)
+	defer codec.Close()
+	_, _, data, err := codec.Deserialize(blobs)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// exportSegmentJSON encodes the rows of data as JSON lines, one object of the field names to values per row,
+// the binary and sparse vectors are base64 encoded
+func exportSegmentJSON(dir string, segmentID UniqueID, fields []*schemapb.FieldSchema, data *storage.InsertData) (int64, map[string]string, error) {
+	numRows, err := getNumRows(fields, data)
+	if err != nil {
+		return 0, nil, err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for i := 0; i < numRows; i++ {
+		row := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			fieldData, ok := data.Data[field.GetFieldID()]
+			if !ok {
+				continue
+			}
+			value, err := getRowValue(fieldData, i)
+			if err != nil {
+				return 0, nil, fmt.Errorf("field %s: %w", field.GetName(), err)
+			}
+			row[field.GetName()] = value
+		}
+		if err := encoder.Encode(row); err != nil {
+			return 0, nil, err
+		}
+	}
+	key := path.Join(dir, strconv.FormatInt(segmentID, 10)+".json")
+	return int64(numRows), map[string]string{key: buf.String()}, nil
+}
+
+// exportSegmentParquet writes a parquet file of a single column per field
+func exportSegmentParquet(dir string, segmentID UniqueID, fields []*schemapb.FieldSchema, data *storage.InsertData) (int64, map[string]string, error) {
+	numRows, err := getNumRows(fields, data)
+	if err != nil {
+		return 0, nil, err
+	}
+	files := make(map[string]string)
+	if numRows == 0 {
+		return 0, files, nil
+	}
+	for _, field := range fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		value, err := writeFieldPayload(field.GetDataType(), fieldData)
+		if err != nil {
+			return 0, nil, fmt.Errorf("field %s: %w", field.GetName(), err)
+		}
+		key := path.Join(dir, strconv.FormatInt(segmentID, 10), strconv.FormatInt(field.GetFieldID(), 10)+".parquet")
+		files[key] = value
+	}
+	return int64(numRows), files, nil
+}
+
+func writeFieldPayload(dataType schemapb.DataType, fieldData storage.FieldData) (string, error) {
+	writer, err := storage.NewPayloadWriter(dataType)
+	if err != nil {
+		return "", err
+	}
+	defer writer.Close()
+
+	switch d := fieldData.(type) {
+	case *storage.BoolFieldData:
+		err = writer.AddBoolToPayload(d.Data)
+	case *storage.Int8FieldData:
+		err = writer.AddInt8ToPayload(d.Data)
+	case *storage.Int16FieldData:
+		err = writer.AddInt16ToPayload(d.Data)
+	case *storage.Int32FieldData:
+		err = writer.AddInt32ToPayload(d.Data)
+	case *storage.Int64FieldData:
+		err = writer.AddInt64ToPayload(d.Data)
+	case *storage.FloatFieldData:
+		err = writer.AddFloatToPayload(d.Data)
+	case *storage.DoubleFieldData:
+		err = writer.AddDoubleToPayload(d.Data)
+	case *storage.StringFieldData:
+		for _, str := range d.Data {
+			if err = writer.AddOneStringToPayload(str); err != nil {
+				break
+			}
+		}
+	case *storage.BinaryVectorFieldData:
+		err = writer.AddBinaryVectorToPayload(d.Data, d.Dim)
+	case *storage.FloatVectorFieldData:
+		err = writer.AddFloatVectorToPayload(d.Data, d.Dim)
+	case *storage.SparseFloatVectorFieldData:
+		for _, content := range d.Contents {
+			if err = writer.AddOneSparseFloatVectorToPayload(content); err != nil {
+				break
+			}
+		}
+	default:
+		err = errors.New("unsupported field data type")
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := writer.FinishPayloadWriter(); err != nil {
+		return "", err
+	}
+	buffer, err := writer.GetPayloadBufferFromWriter()
+	if err != nil {
+		return "", err
+	}
+	// the buffer belongs to the writer, copy it before the writer is released
+	return string(buffer), nil
+}
+
+// getNumRows returns the row number of data and checks the fields have the same row number
+func getNumRows(fields []*schemapb.FieldSchema, data *storage.InsertData) (int, error) {
+	numRows := -1
+	for _, field := range fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		rows, err := getFieldNumRows(fieldData)
+		if err != nil {
+			return 0, fmt.Errorf("field %s: %w", field.GetName(), err)
+		}
+		if numRows >= 0 && rows != numRows {
+			return 0, fmt.Errorf("field %s has %d rows, but other fields have %d rows", field.GetName(), rows, numRows)
+		}
+		numRows = rows
+	}
+	if numRows < 0 {
+		return 0, nil
+	}
+	return numRows, nil
+}
+
+func getFieldNumRows(fieldData storage.FieldData) (int, error) {
+	switch d := fieldData.(type) {
+	case *storage.BoolFieldData:
+		return len(d.Data), nil
+	case *storage.Int8FieldData:
+		return len(d.Data), nil
+	case *storage.Int16FieldData:
+		return len(d.Data), nil
+	case *storage.Int32FieldData:
+		return len(d.Data), nil
+	case *storage.Int64FieldData:
+		return len(d.Data), nil
+	case *storage.FloatFieldData:
+		return len(d.Data), nil
+	case *storage.DoubleFieldData:
+		return len(d.Data), nil
+	case *storage.StringFieldData:
+		return len(d.Data), nil
+	case *storage.BinaryVectorFieldData:
+		if d.Dim <= 0 {
+			return 0, fmt.Errorf("invalid dim %d", d.Dim)
+		}
+		return len(d.Data) * 8 / d.Dim, nil
+	case *storage.FloatVectorFieldData:
+		if d.Dim <= 0 {
+			return 0, fmt.Errorf("invalid dim %d", d.Dim)
+		}
+		return len(d.Data) / d.Dim, nil
+	case *storage.SparseFloatVectorFieldData:
+		return len(d.Contents), nil
+	default:
+		return 0, errors.New("unsupported field data type")
+	}
+}
+
+func getRowValue(fieldData storage.FieldData, i int) (interface{}, error) {
+	switch d := fieldData.(type) {
+	case *storage.BoolFieldData:
+		return d.Data[i], nil
+	case *storage.Int8FieldData:
+		return d.Data[i], nil
+	case *storage.Int16FieldData:
+		return d.Data[i], nil
+	case *storage.Int32FieldData:
+		return d.Data[i], nil
+	case *storage.Int64FieldData:
+		return d.Data[i], nil
+	case *storage.FloatFieldData:
+		return d.Data[i], nil
+	case *storage.DoubleFieldData:
+		return d.Data[i], nil
+	case *storage.StringFieldData:
+		return d.Data[i], nil
+	case *storage.BinaryVectorFieldData:
+		size := d.Dim / 8
+		return d.Data[i*size : (i+1)*size], nil
+	case *storage.FloatVectorFieldData:
+		return d.Data[i*d.Dim : (i+1)*d.Dim], nil
+	case *storage.SparseFloatVectorFieldData:
+		return d.Contents[i], nil
+	default:
+		return nil, errors.New("unsupported field data type")
+	}
+}
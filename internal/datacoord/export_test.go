@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newExportTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_String},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+// saveExportTestSegment serializes the rows of pks into the binlogs of source and adds the segment to meta
+func saveExportTestSegment(t *testing.T, meta *meta, source kv.BaseKV, segmentID UniqueID, state commonpb.SegmentState, pks []int64) {
+	data := &storage.InsertData{Data: map[int64]storage.FieldData{
+		0:   &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+		1:   &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+		100: &storage.Int64FieldData{NumRows: []int64{int64(len(pks))}, Data: pks},
+		101: &storage.StringFieldData{NumRows: []int64{int64(len(pks))}},
+		102: &storage.FloatVectorFieldData{NumRows: []int64{int64(len(pks))}, Dim: 2},
+	}}
+	for _, pk := range pks {
+		data.Data[101].(*storage.StringFieldData).Data = append(data.Data[101].(*storage.StringFieldData).Data, "name"+strconv.FormatInt(pk, 10))
+		vec := data.Data[102].(*storage.FloatVectorFieldData)
+		vec.Data = append(vec.Data, float32(pk), float32(pk)+0.5)
+	}
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: 1, Schema: newExportTestSchema()})
+	blobs, _, err := codec.Serialize(1, segmentID, data)
+	assert.Nil(t, err)
+
+	binlogs := make([]*datapb.FieldBinlog, 0, len(blobs))
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		assert.Nil(t, err)
+		key := path.Join("insert_log", "1", "1", strconv.FormatInt(segmentID, 10), blob.GetKey(), "1")
+		assert.Nil(t, source.Save(key, string(blob.GetValue())))
+		binlogs = append(binlogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key}})
+	}
+	assert.Nil(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: 1,
+		PartitionID:  1,
+		NumOfRows:    int64(len(pks)),
+		State:        state,
+		Binlogs:      binlogs,
+	})))
+}
+
+func waitExportJob(t *testing.T, manager *exportManager, jobID UniqueID) *datapb.GetExportStateResponse {
+	resp := &datapb.GetExportStateResponse{}
+	assert.Eventually(t, func() bool {
+		manager.getJob(jobID).fillState(resp)
+		return resp.GetState() == datapb.ExportState_ExportCompleted || resp.GetState() == datapb.ExportState_ExportFailed
+	}, 10*time.Second, 10*time.Millisecond)
+	return resp
+}
+
+func TestExportManager(t *testing.T) {
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newExportTestSchema()})
+
+	buckets := map[string]*memkv.MemoryKV{}
+	kvCreator := func(ctx context.Context, bucketName string) (kv.BaseKV, error) {
+		if _, ok := buckets[bucketName]; !ok {
+			buckets[bucketName] = memkv.NewMemoryKV()
+		}
+		return buckets[bucketName], nil
+	}
+	source, _ := kvCreator(context.Background(), Params.MinioBucketName)
+	saveExportTestSegment(t, meta, source, 10, commonpb.SegmentState_Flushed, []int64{1, 2, 3})
+	saveExportTestSegment(t, meta, source, 11, commonpb.SegmentState_Flushed, []int64{4})
+	saveExportTestSegment(t, meta, source, 12, commonpb.SegmentState_Growing, []int64{5})
+
+	manager := newExportManager(context.Background(), meta, kvCreator, 1)
+	defer manager.close()
+
+	t.Run("export json", func(t *testing.T) {
+		manager.submit(100, &datapb.ExportRequest{
+			CollectionID: 1,
+			Format:       datapb.ExportFormat_JSON,
+			BucketName:   "export",
+			Path:         "json",
+		})
+		resp := waitExportJob(t, manager, 100)
+		assert.Equal(t, datapb.ExportState_ExportCompleted, resp.GetState(), resp.GetReason())
+		assert.Equal(t, []int64{10, 11}, resp.GetSegmentIDs())
+		assert.Equal(t, "json/manifest.json", resp.GetManifestPath())
+
+		value, err := buckets["export"].Load(resp.GetManifestPath())
+		assert.Nil(t, err)
+		manifest := &exportManifest{}
+		assert.Nil(t, json.Unmarshal([]byte(value), manifest))
+		assert.Equal(t, "JSON", manifest.Format)
+		assert.Equal(t, 3, len(manifest.Fields))
+		assert.Equal(t, "2", manifest.Fields[2].TypeParams["dim"])
+		assert.Equal(t, 2, len(manifest.Segments))
+		assert.EqualValues(t, 3, manifest.Segments[0].NumRows)
+		assert.Equal(t, []string{"json/10.json"}, manifest.Segments[0].Files)
+
+		value, err = buckets["export"].Load("json/10.json")
+		assert.Nil(t, err)
+		scanner := bufio.NewScanner(strings.NewReader(value))
+		rows := make([]map[string]interface{}, 0)
+		for scanner.Scan() {
+			row := make(map[string]interface{})
+			assert.Nil(t, json.Unmarshal(scanner.Bytes(), &row))
+			rows = append(rows, row)
+		}
+		assert.Equal(t, 3, len(rows))
+		assert.EqualValues(t, 2, rows[1]["pk"])
+		assert.Equal(t, "name2", rows[1]["name"])
+		assert.Equal(t, []interface{}{2.0, 2.5}, rows[1]["vec"])
+		_, ok := rows[1]["row_id"]
+		assert.False(t, ok)
+	})
+
+	t.Run("export parquet", func(t *testing.T) {
+		manager.submit(101, &datapb.ExportRequest{
+			CollectionID: 1,
+			PartitionIDs: []int64{1},
+			Format:       datapb.ExportFormat_Parquet,
+			Path:         "parquet",
+		})
+		resp := waitExportJob(t, manager, 101)
+		assert.Equal(t, datapb.ExportState_ExportCompleted, resp.GetState(), resp.GetReason())
+
+		value, err := buckets[Params.MinioBucketName].Load("parquet/10/100.parquet")
+		assert.Nil(t, err)
+		reader, err := storage.NewPayloadReader(schemapb.DataType_Int64, []byte(value))
+		assert.Nil(t, err)
+		defer reader.Close()
+		pks, err := reader.GetInt64FromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, pks)
+	})
+
+	t.Run("export nothing", func(t *testing.T) {
+		manager.submit(102, &datapb.ExportRequest{
+			CollectionID: 1,
+			PartitionIDs: []int64{2},
+			Path:         "empty",
+		})
+		resp := waitExportJob(t, manager, 102)
+		assert.Equal(t, datapb.ExportState_ExportCompleted, resp.GetState(), resp.GetReason())
+		assert.Equal(t, 0, len(resp.GetSegmentIDs()))
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		manager.submit(103, &datapb.ExportRequest{
+			CollectionID: 2,
+			Path:         "none",
+		})
+		resp := waitExportJob(t, manager, 103)
+		assert.Equal(t, datapb.ExportState_ExportFailed, resp.GetState())
+		assert.NotEmpty(t, resp.GetReason())
+	})
+
+	assert.Nil(t, manager.getJob(104))
+}
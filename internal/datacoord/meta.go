@@ -12,6 +12,7 @@ package datacoord
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,6 +21,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -94,13 +97,101 @@ func (m *meta) GetNumRowsOfCollection(collectionID UniqueID) int64 {
 	var ret int64 = 0
 	segments := m.segments.GetSegments()
 	for _, segment := range segments {
-		if segment.GetCollectionID() == collectionID {
+		if segment.GetCollectionID() == collectionID && segment.GetState() != commonpb.SegmentState_Dropped {
 			ret += segment.GetNumOfRows()
 		}
 	}
 	return ret
 }
 
+// GetCollectionStatistics returns the rows and the binlog bytes of the segments not dropped of the collection, the
+// binlogs saved before their sizes are recorded are not counted, and the per field estimated sizes are only
+// available if the schema of the collection is cached
+func (m *meta) GetCollectionStatistics(collectionID UniqueID) *datapb.CollectionStatistics {
+	m.RLock()
+	defer m.RUnlock()
+	stats := &datapb.CollectionStatistics{}
+	fields := make(map[UniqueID]*datapb.FieldStatistics)
+	getField := func(fieldID UniqueID) *datapb.FieldStatistics {
+		field, ok := fields[fieldID]
+		if !ok {
+			field = &datapb.FieldStatistics{FieldID: fieldID}
+			fields[fieldID] = field
+		}
+		return field
+	}
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetCollectionID() != collectionID || segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		stats.RowCount += segment.GetNumOfRows()
+		if segment.GetState() == commonpb.SegmentState_Growing {
+			stats.GrowingRowCount += segment.GetNumOfRows()
+		} else {
+			stats.SealedRowCount += segment.GetNumOfRows()
+		}
+		for _, binlog := range segment.GetBinlogs() {
+			stats.BinlogSize += binlog.GetBinlogSize()
+			getField(binlog.GetFieldID()).BinlogSize += binlog.GetBinlogSize()
+		}
+		for _, statslog := range segment.GetStatslogs() {
+			stats.StatslogSize += statslog.GetBinlogSize()
+		}
+		for _, deltalog := range segment.GetDeltalogs() {
+			stats.DeltalogSize += deltalog.GetDeltaLogSize()
+		}
+	}
+	if collection, ok := m.collections[collectionID]; ok && collection.GetSchema() != nil {
+		for _, fieldSchema := range collection.GetSchema().GetFields() {
+			size, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{fieldSchema}})
+			if err != nil {
+				continue
+			}
+			getField(fieldSchema.GetFieldID()).EstimatedSize = stats.RowCount * int64(size)
+		}
+	}
+	stats.Fields = make([]*datapb.FieldStatistics, 0, len(fields))
+	for _, field := range fields {
+		stats.Fields = append(stats.Fields, field)
+	}
+	sort.Slice(stats.Fields, func(i, j int) bool {
+		return stats.Fields[i].GetFieldID() < stats.Fields[j].GetFieldID()
+	})
+	return stats
+}
+
+// GetSegmentsStatistics returns the num of segments in each state, and the estimated binlog size of the
+// flushed segments of each collection whose schema is cached
+func (m *meta) GetSegmentsStatistics() (map[commonpb.SegmentState]int64, map[UniqueID]int64) {
+	m.RLock()
+	defer m.RUnlock()
+	stateNums := make(map[commonpb.SegmentState]int64)
+	binlogSizes := make(map[UniqueID]int64)
+	sizePerRecords := make(map[UniqueID]int64)
+	for _, segment := range m.segments.GetSegments() {
+		stateNums[segment.GetState()]++
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		collID := segment.GetCollectionID()
+		sizePerRecord, ok := sizePerRecords[collID]
+		if !ok {
+			collection, has := m.collections[collID]
+			if !has || collection.GetSchema() == nil {
+				continue
+			}
+			size, err := typeutil.EstimateSizePerRecord(collection.GetSchema())
+			if err != nil {
+				continue
+			}
+			sizePerRecord = int64(size)
+			sizePerRecords[collID] = sizePerRecord
+		}
+		binlogSizes[collID] += segment.GetNumOfRows() * sizePerRecord
+	}
+	return stateNums, binlogSizes
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(segment *SegmentInfo) error {
 	m.Lock()
@@ -127,6 +218,28 @@ func (m *meta) DropSegment(segmentID UniqueID) error {
 	return nil
 }
 
+// DropCollection removes the segments of the collection from meta and the collection from the cache
+func (m *meta) DropCollection(collectionID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	segments := make([]*SegmentInfo, 0)
+	keys := make([]string, 0)
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetCollectionID() == collectionID {
+			segments = append(segments, segment)
+			keys = append(keys, buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()))
+		}
+	}
+	if err := m.client.MultiRemove(keys); err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.DropSegment(segment.GetID())
+	}
+	delete(m.collections, collectionID)
+	return nil
+}
+
 // GetSegment returns segment info with provided id
 // if not segment is found, nil will be returned
 func (m *meta) GetSegment(segID UniqueID) *SegmentInfo {
@@ -148,10 +261,10 @@ func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 
 // UpdateFlushSegmentsInfo update segment partial/completed flush info
 // `flushed` parameter indicating whether segment is flushed completely or partially
-// `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
+// `binlogs`, `statslogs`, `checkpoints` and `statPositions` are persistence data for segment
 func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
-	binlogs []*datapb.FieldBinlog, checkpoints []*datapb.CheckPoint,
-	startPositions []*datapb.SegmentStartPosition) error {
+	binlogs []*datapb.FieldBinlog, statslogs []*datapb.FieldBinlog, deltalogs []*datapb.DeltaLogInfo,
+	checkpoints []*datapb.CheckPoint, startPositions []*datapb.SegmentStartPosition) error {
 	m.Lock()
 	defer m.Unlock()
 
@@ -163,12 +276,20 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 	kv := make(map[string]string)
 	modSegments := make(map[UniqueID]struct{})
 
+	// the deletes of a dropped segment are saved to the segments it is compacted into
+	if len(deltalogs) > 0 {
+		for _, id := range m.getDeltalogsTargets(segment) {
+			target := m.segments.GetSegment(id)
+			m.segments.SetDeltalogs(id, append(target.Clone().GetDeltalogs(), deltalogs...))
+			modSegments[id] = struct{}{}
+		}
+	}
+
 	if flushed {
 		m.segments.SetState(segmentID, commonpb.SegmentState_Flushing)
 		modSegments[segmentID] = struct{}{}
 	}
 
-	currBinlogs := segment.Clone().SegmentInfo.GetBinlogs()
 	var getFieldBinlogs = func(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
 		for _, binlog := range binlogs {
 			if id == binlog.GetFieldID() {
@@ -177,15 +298,23 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 		}
 		return nil
 	}
-	for _, tBinlogs := range binlogs {
-		fieldBinlogs := getFieldBinlogs(tBinlogs.GetFieldID(), currBinlogs)
-		if fieldBinlogs == nil {
-			currBinlogs = append(currBinlogs, tBinlogs)
-		} else {
-			fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, tBinlogs.Binlogs...)
+	var mergeFieldBinlogs = func(currBinlogs []*datapb.FieldBinlog, binlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+		for _, tBinlogs := range binlogs {
+			fieldBinlogs := getFieldBinlogs(tBinlogs.GetFieldID(), currBinlogs)
+			if fieldBinlogs == nil {
+				currBinlogs = append(currBinlogs, tBinlogs)
+			} else {
+				fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, tBinlogs.Binlogs...)
+				fieldBinlogs.BinlogSize += tBinlogs.BinlogSize
+			}
 		}
+		return currBinlogs
+	}
+	cloned := segment.Clone()
+	m.segments.SetBinlogs(segmentID, mergeFieldBinlogs(cloned.GetBinlogs(), binlogs))
+	if len(statslogs) > 0 {
+		m.segments.SetStatslogs(segmentID, mergeFieldBinlogs(cloned.GetStatslogs(), statslogs))
 	}
-	m.segments.SetBinlogs(segmentID, currBinlogs)
 	modSegments[segmentID] = struct{}{}
 
 	for _, pos := range startPositions {
@@ -220,6 +349,76 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 	return nil
 }
 
+// CompactSegments replaces the compactFrom segments with the compactTo segments in one transaction,
+// the compactFrom segments are kept in `Dropped` state, and fails if any of them is not `Flushed`.
+// compactFrom are the segments read by the compaction, it fails if any of them has new delta logs since,
+// as the deletes of the delta logs are not applied to the compactTo segments
+func (m *meta) CompactSegments(compactFrom []*SegmentInfo, compactTo []*SegmentInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	compactedTo := make([]UniqueID, 0, len(compactTo))
+	for _, segment := range compactTo {
+		compactedTo = append(compactedTo, segment.GetID())
+	}
+	dropped := make([]*SegmentInfo, 0, len(compactFrom))
+	for _, from := range compactFrom {
+		segmentID := from.GetID()
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil {
+			return fmt.Errorf("segment %d not found", segmentID)
+		}
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			return fmt.Errorf("segment %d is %s, not flushed", segmentID, segment.GetState().String())
+		}
+		if len(segment.GetDeltalogs()) != len(from.GetDeltalogs()) {
+			return fmt.Errorf("segment %d has new delta logs since the compaction started", segmentID)
+		}
+		cloned := segment.Clone(SetState(commonpb.SegmentState_Dropped))
+		cloned.CompactedTo = compactedTo
+		dropped = append(dropped, cloned)
+	}
+
+	segments := append(dropped, compactTo...)
+	kv := make(map[string]string)
+	for _, segment := range segments {
+		key := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+		kv[key] = proto.MarshalTextString(segment.SegmentInfo)
+	}
+	if err := m.saveKvTxn(kv); err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
+// getDeltalogsTargets returns the segments to save the delta logs of the segment, the segment itself
+// unless it is dropped, or the segments it is compacted into, recursively
+func (m *meta) getDeltalogsTargets(segment *SegmentInfo) []UniqueID {
+	targets := make([]UniqueID, 0, 1)
+	visited := make(map[UniqueID]struct{})
+	var visit func(segment *SegmentInfo)
+	visit = func(segment *SegmentInfo) {
+		if _, ok := visited[segment.GetID()]; ok {
+			return
+		}
+		visited[segment.GetID()] = struct{}{}
+		if segment.GetState() != commonpb.SegmentState_Dropped {
+			targets = append(targets, segment.GetID())
+			return
+		}
+		for _, id := range segment.GetCompactedTo() {
+			if compacted := m.segments.GetSegment(id); compacted != nil {
+				visit(compacted)
+			}
+		}
+	}
+	visit(segment)
+	return targets
+}
+
 // ListSegmentIDs list all segment ids stored in meta (no collection filter)
 func (m *meta) ListSegmentIDs() []UniqueID {
 	m.RLock()
@@ -234,6 +433,20 @@ func (m *meta) ListSegmentIDs() []UniqueID {
 	return infos
 }
 
+// SelectSegments returns all segment info matching the provided selector
+func (m *meta) SelectSegments(selector func(segment *SegmentInfo) bool) []*SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	infos := make([]*SegmentInfo, 0)
+	segments := m.segments.GetSegments()
+	for _, segment := range segments {
+		if selector(segment) {
+			infos = append(infos, segment)
+		}
+	}
+	return infos
+}
+
 // GetSegmentsByChannel returns all segment info which insert channel equals provided `dmlCh`
 func (m *meta) GetSegmentsByChannel(dmlCh string) []*SegmentInfo {
 	m.RLock()
@@ -277,6 +490,30 @@ func (m *meta) GetSegmentsOfPartition(collectionID, partitionID UniqueID) []Uniq
 	return ret
 }
 
+// GetPartitionStatistics returns the statistics of the segments not dropped of provided collection & partition,
+// the data size is estimated by the rows if the collection schema is cached
+func (m *meta) GetPartitionStatistics(collectionID UniqueID, partitionID UniqueID) *datapb.PartitionStatistics {
+	m.RLock()
+	defer m.RUnlock()
+	stats := &datapb.PartitionStatistics{PartitionID: partitionID}
+	for _, info := range m.segments.GetSegments() {
+		if info.CollectionID != collectionID || info.PartitionID != partitionID || info.State == commonpb.SegmentState_Dropped {
+			continue
+		}
+		stats.RowCount += info.NumOfRows
+		stats.SegmentCount++
+		if info.State == commonpb.SegmentState_Growing {
+			stats.GrowingSegmentCount++
+		}
+	}
+	if collection, ok := m.collections[collectionID]; ok && collection.GetSchema() != nil {
+		if size, err := typeutil.EstimateSizePerRecord(collection.GetSchema()); err == nil {
+			stats.DataSize = stats.RowCount * int64(size)
+		}
+	}
+	return stats
+}
+
 // GetNumRowsOfPartition returns row count of segments belongs to provided collection & partition
 func (m *meta) GetNumRowsOfPartition(collectionID UniqueID, partitionID UniqueID) int64 {
 	m.RLock()
@@ -284,21 +521,22 @@ func (m *meta) GetNumRowsOfPartition(collectionID UniqueID, partitionID UniqueID
 	var ret int64 = 0
 	segments := m.segments.GetSegments()
 	for _, info := range segments {
-		if info.CollectionID == collectionID && info.PartitionID == partitionID {
+		if info.CollectionID == collectionID && info.PartitionID == partitionID && info.State != commonpb.SegmentState_Dropped {
 			ret += info.NumOfRows
 		}
 	}
 	return ret
 }
 
-// GetUnFlushedSegments get all segments which state is not `Flushing` nor `Flushed`
+// GetUnFlushedSegments get all segments which state is not `Flushing`, `Flushed` nor `Dropped`
 func (m *meta) GetUnFlushedSegments() []*SegmentInfo {
 	m.RLock()
 	defer m.RUnlock()
 	ret := make([]*SegmentInfo, 0)
 	segments := m.segments.GetSegments()
 	for _, info := range segments {
-		if info.State != commonpb.SegmentState_Flushing && info.State != commonpb.SegmentState_Flushed {
+		if info.State != commonpb.SegmentState_Flushing && info.State != commonpb.SegmentState_Flushed &&
+			info.State != commonpb.SegmentState_Dropped {
 			ret = append(ret, info)
 		}
 	}
@@ -368,8 +606,7 @@ func (m *meta) MoveSegmentBinlogs(segmentID UniqueID, oldPathPrefix string, fiel
 		k := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 		kv[k] = proto.MarshalTextString(segment.SegmentInfo)
 	}
-	m.client.MultiSaveAndRemoveWithPrefix(kv, removals)
-	return nil
+	return m.client.MultiSaveAndRemoveWithPrefix(kv, removals)
 }
 
 // saveSegmentInfo utility function saving segment info into kv store
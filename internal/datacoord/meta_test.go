@@ -204,3 +204,119 @@ func TestGetUnFlushedSegments(t *testing.T) {
 	assert.EqualValues(t, 0, segments[0].ID)
 	assert.NotEqualValues(t, commonpb.SegmentState_Flushed, segments[0].State)
 }
+
+func TestGetSegmentsStatistics(t *testing.T) {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	// 125 bytes per record
+	meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema()})
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 0, CollectionID: 0, State: commonpb.SegmentState_Growing, NumOfRows: 100},
+		{ID: 1, CollectionID: 0, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		{ID: 2, CollectionID: 0, State: commonpb.SegmentState_Flushed, NumOfRows: 20},
+		// the schema of collection 1 is not cached
+		{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+	}
+	for _, segment := range segments {
+		err = meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+
+	stateNums, binlogSizes := meta.GetSegmentsStatistics()
+	assert.EqualValues(t, 1, stateNums[commonpb.SegmentState_Growing])
+	assert.EqualValues(t, 3, stateNums[commonpb.SegmentState_Flushed])
+	assert.EqualValues(t, 0, stateNums[commonpb.SegmentState_Sealed])
+	assert.EqualValues(t, 1, len(binlogSizes))
+	assert.EqualValues(t, 30*125, binlogSizes[0])
+}
+
+func TestGetCollectionStatisticsOfMeta(t *testing.T) {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	// 125 bytes per record of field 1, and 0 of field 2 of no dim
+	meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema()})
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 0, CollectionID: 0, State: commonpb.SegmentState_Growing, NumOfRows: 100},
+		{ID: 1, CollectionID: 0, State: commonpb.SegmentState_Flushed, NumOfRows: 10,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []string{"b1"}, BinlogSize: 1000},
+				{FieldID: 2, Binlogs: []string{"b2"}, BinlogSize: 40},
+			},
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"s1"}, BinlogSize: 8}},
+			Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: "d1", DeltaLogSize: 16}}},
+		// the binlogs of field 100 are saved before their sizes are recorded
+		{ID: 2, CollectionID: 0, State: commonpb.SegmentState_Sealed, NumOfRows: 20,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"b3"}}}},
+		{ID: 3, CollectionID: 0, State: commonpb.SegmentState_Dropped, NumOfRows: 20,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"b4"}, BinlogSize: 1000}}},
+		{ID: 4, CollectionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 10,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"b5"}, BinlogSize: 100}}},
+	}
+	for _, segment := range segments {
+		err = meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+
+	stats := meta.GetCollectionStatistics(0)
+	assert.EqualValues(t, 130, stats.RowCount)
+	assert.EqualValues(t, 100, stats.GrowingRowCount)
+	assert.EqualValues(t, 30, stats.SealedRowCount)
+	assert.EqualValues(t, 1040, stats.BinlogSize)
+	assert.EqualValues(t, 8, stats.StatslogSize)
+	assert.EqualValues(t, 16, stats.DeltalogSize)
+	assert.Equal(t, 3, len(stats.Fields))
+	assert.Equal(t, &datapb.FieldStatistics{FieldID: 1, BinlogSize: 1000, EstimatedSize: 130 * 125}, stats.Fields[0])
+	assert.Equal(t, &datapb.FieldStatistics{FieldID: 2, BinlogSize: 40}, stats.Fields[1])
+	assert.Equal(t, &datapb.FieldStatistics{FieldID: 100}, stats.Fields[2])
+
+	// the schema of collection 1 is not cached
+	stats = meta.GetCollectionStatistics(1)
+	assert.EqualValues(t, 10, stats.SealedRowCount)
+	assert.Equal(t, 1, len(stats.Fields))
+	assert.EqualValues(t, 100, stats.Fields[0].BinlogSize)
+	assert.EqualValues(t, 0, stats.Fields[0].EstimatedSize)
+
+	assert.Equal(t, 0, len(meta.GetCollectionStatistics(2).Fields))
+}
+
+func TestGetPartitionStatisticsOfMeta(t *testing.T) {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	// 125 bytes per record
+	meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema()})
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 0, CollectionID: 0, PartitionID: 0, State: commonpb.SegmentState_Growing, NumOfRows: 100},
+		{ID: 1, CollectionID: 0, PartitionID: 0, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		{ID: 2, CollectionID: 0, PartitionID: 0, State: commonpb.SegmentState_Dropped, NumOfRows: 20},
+		{ID: 3, CollectionID: 0, PartitionID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		// the schema of collection 1 is not cached
+		{ID: 4, CollectionID: 1, PartitionID: 0, State: commonpb.SegmentState_Sealed, NumOfRows: 10},
+	}
+	for _, segment := range segments {
+		err = meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+
+	stats := meta.GetPartitionStatistics(0, 0)
+	assert.EqualValues(t, 0, stats.PartitionID)
+	assert.EqualValues(t, 110, stats.RowCount)
+	assert.EqualValues(t, 2, stats.SegmentCount)
+	assert.EqualValues(t, 1, stats.GrowingSegmentCount)
+	assert.EqualValues(t, 110*125, stats.DataSize)
+
+	stats = meta.GetPartitionStatistics(1, 0)
+	assert.EqualValues(t, 10, stats.RowCount)
+	assert.EqualValues(t, 1, stats.SegmentCount)
+	assert.EqualValues(t, 0, stats.GrowingSegmentCount)
+	assert.EqualValues(t, 0, stats.DataSize)
+
+	stats = meta.GetPartitionStatistics(0, 2)
+	assert.EqualValues(t, 2, stats.PartitionID)
+	assert.EqualValues(t, 0, stats.SegmentCount)
+}
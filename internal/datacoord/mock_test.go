@@ -274,6 +274,10 @@ func (m *mockRootCoordService) ShowCollections(ctx context.Context, req *milvusp
 	}, nil
 }
 
+func (m *mockRootCoordService) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordService) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -373,6 +377,27 @@ func (m *mockRootCoordService) ReleaseDQLMessageStream(ctx context.Context, req
 func (m *mockRootCoordService) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
+func (m *mockRootCoordService) CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) UndropCollection(ctx context.Context, req *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoordService) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
 func (m *mockRootCoordService) AddNewSegment(ctx context.Context, in *datapb.SegmentMsg) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
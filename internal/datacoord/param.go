@@ -48,6 +48,7 @@ type ParamTable struct {
 	SegmentMaxSize          float64
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
+	SegAssignmentMinRows    int64
 
 	InsertChannelPrefixName   string
 	StatisticsChannelName     string
@@ -56,6 +57,29 @@ type ParamTable struct {
 	DataCoordSubscriptionName string
 
 	Log log.Config
+
+	// --- MinIO ---
+	MinioAddress         string
+	MinioAccessKeyID     string
+	MinioSecretAccessKey string
+	MinioUseSSL          bool
+	MinioBucketName      string
+
+	// --- Export ---
+	ExportMaxParallelism int
+
+	// --- Compaction ---
+	EnableClusteringCompaction bool
+	InsertBinlogRootPath       string
+	StatsBinlogRootPath        string
+	// the flushed segments whose delete ratio reaches DeleteCompactionRatio, or whose delta logs reach
+	// DeleteCompactionDeltaLogSize bytes, are rewritten without the deleted rows every DeleteCompactionInterval
+	// seconds, at most DeleteCompactionMaxSegments segments of the highest delete ratios at a time
+	EnableDeleteCompaction       bool
+	DeleteCompactionInterval     int64
+	DeleteCompactionRatio        float64
+	DeleteCompactionDeltaLogSize int64
+	DeleteCompactionMaxSegments  int
 }
 
 var Params ParamTable
@@ -83,6 +107,7 @@ func (p *ParamTable) Init() {
 		p.initSegmentMaxSize()
 		p.initSegmentSealProportion()
 		p.initSegAssignmentExpiration()
+		p.initSegAssignmentMinRows()
 		p.initInsertChannelPrefixName()
 		p.initStatisticsChannelName()
 		p.initTimeTickChannelName()
@@ -92,6 +117,18 @@ func (p *ParamTable) Init() {
 
 		p.initFlushStreamPosSubPath()
 		p.initStatsStreamPosSubPath()
+
+		p.initMinioAddress()
+		p.initMinioAccessKeyID()
+		p.initMinioSecretAccessKey()
+		p.initMinioUseSSL()
+		p.initMinioBucketName()
+		p.initExportMaxParallelism()
+
+		p.initEnableClusteringCompaction()
+		p.initDeleteCompaction()
+		p.initInsertBinlogRootPath()
+		p.initStatsBinlogRootPath()
 	})
 }
 
@@ -171,6 +208,10 @@ func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64("datacoord.segment.assignmentExpiration")
 }
 
+func (p *ParamTable) initSegAssignmentMinRows() {
+	p.SegAssignmentMinRows = p.ParseInt64("datacoord.segment.assignmentMinRows")
+}
+
 func (p *ParamTable) initInsertChannelPrefixName() {
 	var err error
 	p.InsertChannelPrefixName, err = p.Load("msgChannel.chanNamePrefix.dataCoordInsertChannel")
@@ -252,3 +293,75 @@ func (p *ParamTable) initStatsStreamPosSubPath() {
 	}
 	p.StatsStreamPosSubPath = subPath
 }
+
+func (p *ParamTable) initMinioAddress() {
+	endpoint, err := p.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAddress = endpoint
+}
+
+func (p *ParamTable) initMinioAccessKeyID() {
+	keyID, err := p.Load("minio.accessKeyID")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAccessKeyID = keyID
+}
+
+func (p *ParamTable) initMinioSecretAccessKey() {
+	key, err := p.Load("minio.secretAccessKey")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioSecretAccessKey = key
+}
+
+func (p *ParamTable) initMinioUseSSL() {
+	usessl, err := p.Load("minio.useSSL")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioUseSSL, _ = strconv.ParseBool(usessl)
+}
+
+func (p *ParamTable) initMinioBucketName() {
+	bucketName, err := p.Load("minio.bucketName")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioBucketName = bucketName
+}
+
+func (p *ParamTable) initExportMaxParallelism() {
+	p.ExportMaxParallelism = p.ParseInt("datacoord.export.maxParallelism")
+}
+
+func (p *ParamTable) initEnableClusteringCompaction() {
+	p.EnableClusteringCompaction = p.ParseBool("datacoord.compaction.clustering.enable", false)
+}
+
+func (p *ParamTable) initDeleteCompaction() {
+	p.EnableDeleteCompaction = p.ParseBool("datacoord.compaction.delete.enable", true)
+	p.DeleteCompactionInterval = p.ParseInt64("datacoord.compaction.delete.interval")
+	p.DeleteCompactionRatio = p.ParseFloat("datacoord.compaction.delete.deleteRatio")
+	p.DeleteCompactionDeltaLogSize = p.ParseInt64("datacoord.compaction.delete.deltaLogSize") * 1024 * 1024
+	p.DeleteCompactionMaxSegments = p.ParseInt("datacoord.compaction.delete.maxSegments")
+}
+
+func (p *ParamTable) initInsertBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.InsertBinlogRootPath = path.Join(rootPath, "insert_log")
+}
+
+func (p *ParamTable) initStatsBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
+}
@@ -59,11 +59,7 @@ func AllocatePolicyV1(segments []*SegmentInfo, count int64,
 		return newSegmentAllocations, existedSegmentAllocations
 	}
 	for _, segment := range segments {
-		var allocSize int64
-		for _, allocation := range segment.allocations {
-			allocSize += allocation.NumOfRows
-		}
-		free := segment.GetMaxRowNum() - segment.GetNumOfRows() - allocSize
+		free := getSegmentFreeRows(segment)
 		if free < count {
 			continue
 		}
@@ -79,6 +75,54 @@ func AllocatePolicyV1(segments []*SegmentInfo, count int64,
 	return newSegmentAllocations, existedSegmentAllocations
 }
 
+// getPreallocatePolicy get AllocatePolicy which extends the allocation of the remaining count
+// to at least minRows rows, so that the following inserts of a proxy fit in the same allocation
+// instead of applying for a new one per insert batch.
+// The extended rows are bounded by the free space of the segment, the unused ones are reclaimed
+// when the allocation expires.
+func getPreallocatePolicy(minRows int64) AllocatePolicy {
+	return func(segments []*SegmentInfo, count int64,
+		maxCountPerSegment int64) ([]*Allocation, []*Allocation) {
+		newSegmentAllocations, existedSegmentAllocations := AllocatePolicyV1(segments, count, maxCountPerSegment)
+		remain := count % maxCountPerSegment
+		if remain == 0 || remain >= minRows {
+			return newSegmentAllocations, existedSegmentAllocations
+		}
+
+		// the allocation of the remaining count is always the last one
+		if len(existedSegmentAllocations) > 0 {
+			allocation := existedSegmentAllocations[len(existedSegmentAllocations)-1]
+			for _, segment := range segments {
+				if segment.GetID() != allocation.SegmentID {
+					continue
+				}
+				allocation.NumOfRows = minInt64(minRows, getSegmentFreeRows(segment))
+				break
+			}
+			return newSegmentAllocations, existedSegmentAllocations
+		}
+		allocation := newSegmentAllocations[len(newSegmentAllocations)-1]
+		allocation.NumOfRows = minInt64(minRows, maxCountPerSegment)
+		return newSegmentAllocations, existedSegmentAllocations
+	}
+}
+
+// getSegmentFreeRows returns the rows of segment neither inserted nor allocated
+func getSegmentFreeRows(segment *SegmentInfo) int64 {
+	var allocSize int64
+	for _, allocation := range segment.allocations {
+		allocSize += allocation.NumOfRows
+	}
+	return segment.GetMaxRowNum() - segment.GetNumOfRows() - allocSize
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // segmentSealPolicy seal policy applies to segment
 type segmentSealPolicy func(segment *SegmentInfo, ts Timestamp) bool
 
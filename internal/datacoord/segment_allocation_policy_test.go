@@ -137,6 +137,50 @@ func TestSortSegmentsByLastExpires(t *testing.T) {
 	}
 }
 
+func TestPreallocatePolicy(t *testing.T) {
+	policy := getPreallocatePolicy(100)
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:        1,
+			MaxRowNum: 1000,
+			NumOfRows: 850,
+		},
+		allocations: []*Allocation{{SegmentID: 1, NumOfRows: 100}},
+	}
+
+	t.Run("extend new segment allocation", func(t *testing.T) {
+		newAllocs, existedAllocs := policy(nil, 10, 1000)
+		assert.EqualValues(t, 0, len(existedAllocs))
+		assert.EqualValues(t, 1, len(newAllocs))
+		assert.EqualValues(t, 100, newAllocs[0].NumOfRows)
+
+		newAllocs, _ = policy(nil, 10, 50)
+		assert.EqualValues(t, 1, len(newAllocs))
+		assert.EqualValues(t, 50, newAllocs[0].NumOfRows)
+	})
+
+	t.Run("extend existed segment allocation within free rows", func(t *testing.T) {
+		newAllocs, existedAllocs := policy([]*SegmentInfo{segment}, 10, 1000)
+		assert.EqualValues(t, 0, len(newAllocs))
+		assert.EqualValues(t, 1, len(existedAllocs))
+		assert.EqualValues(t, 1, existedAllocs[0].SegmentID)
+		assert.EqualValues(t, 50, existedAllocs[0].NumOfRows)
+	})
+
+	t.Run("keep large allocation", func(t *testing.T) {
+		newAllocs, existedAllocs := policy(nil, 2200, 1000)
+		assert.EqualValues(t, 0, len(existedAllocs))
+		assert.EqualValues(t, 3, len(newAllocs))
+		assert.EqualValues(t, 1000, newAllocs[0].NumOfRows)
+		assert.EqualValues(t, 1000, newAllocs[1].NumOfRows)
+		assert.EqualValues(t, 200, newAllocs[2].NumOfRows)
+
+		newAllocs, _ = policy(nil, 2050, 1000)
+		assert.EqualValues(t, 3, len(newAllocs))
+		assert.EqualValues(t, 100, newAllocs[2].NumOfRows)
+	})
+}
+
 func TestSealSegmentPolicy(t *testing.T) {
 	t.Run("test seal segment by lifetime", func(t *testing.T) {
 		lifetime := 2 * time.Second
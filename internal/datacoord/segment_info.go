@@ -108,6 +108,18 @@ func (s *SegmentsInfo) SetBinlogs(segmentID UniqueID, binlogs []*datapb.FieldBin
 	}
 }
 
+func (s *SegmentsInfo) SetStatslogs(segmentID UniqueID, statslogs []*datapb.FieldBinlog) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(SetStatslogs(statslogs))
+	}
+}
+
+func (s *SegmentsInfo) SetDeltalogs(segmentID UniqueID, deltalogs []*datapb.DeltaLogInfo) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(SetDeltalogs(deltalogs))
+	}
+}
+
 func (s *SegmentsInfo) SetFlushTime(segmentID UniqueID, t time.Time) {
 	if segment, ok := s.segments[segmentID]; ok {
 		s.segments[segmentID] = segment.ShadowClone(SetFlushTime(t))
@@ -205,6 +217,18 @@ func SetBinlogs(binlogs []*datapb.FieldBinlog) SegmentInfoOption {
 	}
 }
 
+func SetStatslogs(statslogs []*datapb.FieldBinlog) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Statslogs = statslogs
+	}
+}
+
+func SetDeltalogs(deltalogs []*datapb.DeltaLogInfo) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Deltalogs = deltalogs
+	}
+}
+
 func SetFlushTime(t time.Time) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
 		segment.lastFlushTime = t
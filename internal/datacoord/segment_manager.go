@@ -260,7 +260,15 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		}
 	}
 
-	allocations := append(newSegmentAllocations, existedSegmentAllocations...)
+	// the allocations kept in meta are recycled once expired, return copies to the caller
+	allocations := make([]*Allocation, 0, len(newSegmentAllocations)+len(existedSegmentAllocations))
+	for _, allocation := range append(newSegmentAllocations, existedSegmentAllocations...) {
+		allocations = append(allocations, &Allocation{
+			SegmentID:  allocation.SegmentID,
+			NumOfRows:  allocation.NumOfRows,
+			ExpireTime: allocation.ExpireTime,
+		})
+	}
 	return allocations, nil
 }
 
@@ -340,6 +348,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	segment := s.meta.GetSegment(segmentID)
 	if segment == nil {
 		log.Warn("failed to get segment", zap.Int64("id", segmentID))
+		return
 	}
 	s.meta.SetAllocations(segmentID, []*Allocation{})
 	for _, allocation := range segment.allocations {
@@ -107,6 +107,29 @@ func TestAllocSegment(t *testing.T) {
 		assert.NotEqualValues(t, 0, allocations[0].ExpireTime)
 	})
 
+	t.Run("allocation not shared with meta", func(t *testing.T) {
+		allocations, err := segmentManager.AllocSegment(ctx, collID, 200, "c1", 100)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		segment := meta.GetSegment(allocations[0].SegmentID)
+		assert.NotNil(t, segment)
+		assert.EqualValues(t, 1, len(segment.allocations))
+		assert.Equal(t, *segment.allocations[0], *allocations[0])
+		assert.False(t, segment.allocations[0] == allocations[0])
+
+		err = segmentManager.ExpireAllocations("c1", allocations[0].ExpireTime)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 100, allocations[0].NumOfRows)
+	})
+
+	t.Run("preallocation", func(t *testing.T) {
+		segmentManager := newSegmentManager(meta, mockAllocator, withAllocPolicy(getPreallocatePolicy(1000)))
+		allocations, err := segmentManager.AllocSegment(ctx, collID, 300, "c1", 100)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		assert.EqualValues(t, 1000, allocations[0].NumOfRows)
+	})
+
 	t.Run("allocation fails", func(t *testing.T) {
 		failsAllocator := &FailsAllocator{}
 		segmentManager := newSegmentManager(meta, failsAllocator)
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,8 +30,10 @@ import (
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/notify"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -49,6 +52,8 @@ var (
 	ttCheckerName    = "dataTtChecker"
 	ttMaxInterval    = 3 * time.Minute
 	ttCheckerWarnMsg = fmt.Sprintf("we haven't received tt for %f minutes", ttMaxInterval.Minutes())
+
+	metricsUpdateInterval = 15 * time.Second
 )
 
 type (
@@ -103,6 +108,14 @@ type Server struct {
 
 	dataClientCreator      dataNodeCreatorFunc
 	rootCoordClientCreator rootCoordCreatorFunc
+
+	exportManager       *exportManager
+	clusteringCompactor *clusteringCompactor
+	deleteCompactor     *deleteCompactor
+	// creates the kv clients of the buckets of the binlogs and the exported files
+	kvCreator kvCreatorFunc
+	// sends the flushes, compactions and the down datanodes to the configured sinks, nil if not enabled
+	notifier *notify.Notifier
 }
 
 // ServerHelper datacoord server injection helper
@@ -149,6 +162,7 @@ func CreateServer(ctx context.Context, factory msgstream.Factory, opts ...Option
 		flushCh:                make(chan UniqueID, 1024),
 		dataClientCreator:      defaultDataNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
+		kvCreator:              defaultKvCreatorFunc,
 		helper:                 defaultServerHelper(),
 
 		metricsCacheManager: metricsinfo.NewMetricsCacheManager(),
@@ -216,7 +230,19 @@ func (s *Server) Start() error {
 
 	s.allocator = newRootCoordAllocator(s.rootCoordClient)
 
+	if s.notifier, err = notify.NewNotifierFromConfig(s.ctx, typeutil.DataCoordRole, notify.LoadConfig(&Params.BaseTable),
+		s.msFactory); err != nil {
+		return err
+	}
+	s.notifier.Start()
+
 	s.startSegmentManager()
+	s.exportManager = newExportManager(s.ctx, s.meta, s.kvCreator, Params.ExportMaxParallelism)
+	s.clusteringCompactor = newClusteringCompactor(s.ctx, s.meta, s.allocator, s.kvCreator, s.flushCh)
+	s.clusteringCompactor.notifier = s.notifier
+	s.deleteCompactor = newDeleteCompactor(s.ctx, s.meta, s.allocator, s.kvCreator, s.flushCh)
+	s.deleteCompactor.notifier = s.notifier
+	s.deleteCompactor.start()
 	if err = s.initServiceDiscovery(); err != nil {
 		return err
 	}
@@ -268,7 +294,8 @@ func (s *Server) initServiceDiscovery() error {
 }
 
 func (s *Server) startSegmentManager() {
-	s.segmentManager = newSegmentManager(s.meta, s.allocator)
+	s.segmentManager = newSegmentManager(s.meta, s.allocator,
+		withAllocPolicy(getPreallocatePolicy(Params.SegAssignmentMinRows)))
 }
 
 func (s *Server) initMeta() error {
@@ -290,12 +317,13 @@ func (s *Server) initMeta() error {
 
 func (s *Server) startServerLoop() {
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
-	s.serverLoopWg.Add(5)
+	s.serverLoopWg.Add(6)
 	go s.startStatsChannel(s.serverLoopCtx)
 	go s.startDataNodeTtLoop(s.serverLoopCtx)
 	go s.startWatchService(s.serverLoopCtx)
 	go s.startActiveCheck(s.serverLoopCtx)
 	go s.startFlushLoop(s.serverLoopCtx)
+	go s.startMetricsLoop(s.serverLoopCtx)
 }
 
 func (s *Server) startStatsChannel(ctx context.Context) {
@@ -448,6 +476,11 @@ func (s *Server) handleSessionEvent(ctx context.Context, event *sessionutil.Sess
 			zap.Int64("serverID", info.Version))
 		s.cluster.UnRegister(node)
 		s.metricsCacheManager.InvalidateSystemInfoMetrics()
+		s.notifier.Notify(notify.NodeDown, map[string]interface{}{
+			"role":      typeutil.DataNodeRole,
+			"server_id": info.Version,
+			"address":   info.Address,
+		})
 	default:
 		log.Warn("receive unknown service event type",
 			zap.Any("type", event.EventType))
@@ -474,6 +507,55 @@ func (s *Server) startActiveCheck(ctx context.Context) {
 	}
 }
 
+// startMetricsLoop updates the segment metrics periodically
+func (s *Server) startMetricsLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+	defer s.serverLoopWg.Done()
+	ticker := time.NewTicker(metricsUpdateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("metrics loop shutdown")
+			return
+		case <-ticker.C:
+			s.updateSegmentMetrics()
+		}
+	}
+}
+
+func (s *Server) updateSegmentMetrics() {
+	stateNums, binlogSizes := s.meta.GetSegmentsStatistics()
+	for _, state := range []commonpb.SegmentState{commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed,
+		commonpb.SegmentState_Flushing, commonpb.SegmentState_Flushed} {
+		metrics.DataCoordSegmentNum.WithLabelValues(state.String()).Set(float64(stateNums[state]))
+	}
+	// reset to remove the dropped collections
+	metrics.DataCoordCollectionBinlogSize.Reset()
+	for collID, size := range binlogSizes {
+		metrics.DataCoordCollectionBinlogSize.WithLabelValues(strconv.FormatInt(collID, 10)).Set(float64(size))
+	}
+
+	deleteStats := make(map[UniqueID]*datapb.GetDeleteStatsResponse)
+	for _, segment := range s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed
+	}) {
+		stats, ok := deleteStats[segment.GetCollectionID()]
+		if !ok {
+			stats = &datapb.GetDeleteStatsResponse{}
+			deleteStats[segment.GetCollectionID()] = stats
+		}
+		addSegmentDeleteStats(stats, getSegmentDeleteStats(segment))
+	}
+	metrics.DataCoordCollectionDeleteRatio.Reset()
+	metrics.DataCoordCollectionDeltaLogSize.Reset()
+	for collID, stats := range deleteStats {
+		label := strconv.FormatInt(collID, 10)
+		metrics.DataCoordCollectionDeleteRatio.WithLabelValues(label).Set(getDeleteRatio(stats.GetNumOfRows(), stats.GetNumDeletedRows()))
+		metrics.DataCoordCollectionDeltaLogSize.WithLabelValues(label).Set(float64(stats.GetDeltaLogSize()))
+	}
+}
+
 func (s *Server) startFlushLoop(ctx context.Context) {
 	defer logutil.LogPanic()
 	defer s.serverLoopWg.Done()
@@ -521,6 +603,12 @@ func (s *Server) postFlush(ctx context.Context, segmentID UniqueID) error {
 		return err
 	}
 	log.Debug("flush segment complete", zap.Int64("id", segmentID))
+	s.notifier.Notify(notify.FlushCompleted, map[string]interface{}{
+		"segment_id":    segmentID,
+		"collection_id": segment.GetCollectionID(),
+		"partition_id":  segment.GetPartitionID(),
+		"num_rows":      segment.GetNumOfRows(),
+	})
 	return nil
 }
 
@@ -558,6 +646,10 @@ func (s *Server) Stop() error {
 	log.Debug("dataCoord server shutdown")
 	s.cluster.Close()
 	s.stopServerLoop()
+	s.exportManager.close()
+	s.clusteringCompactor.close()
+	s.deleteCompactor.close()
+	s.notifier.Stop()
 	return nil
 }
 
@@ -638,7 +730,14 @@ func (s *Server) GetVChanPositions(vchans []vchannel, seekFromStartPosition bool
 		var seekPosition *internalpb.MsgPosition
 		var useUnflushedPosition bool
 		for _, s := range segments {
-			if s.State == commonpb.SegmentState_Flushing || s.State == commonpb.SegmentState_Flushed {
+			// the deletes of the L0 segments are replayed from the positions of the L1 segments,
+			// which saves them again harmlessly
+			if s.GetLevel() == datapb.SegmentLevel_L0 {
+				continue
+			}
+			// the data of the dropped segments is in the compacted segments, which are never replayed
+			if s.State == commonpb.SegmentState_Flushing || s.State == commonpb.SegmentState_Flushed ||
+				s.State == commonpb.SegmentState_Dropped {
 				flushedSegmentIDs = append(flushedSegmentIDs, s.ID)
 				if seekPosition == nil || (!useUnflushedPosition && s.DmlPosition.Timestamp > seekPosition.Timestamp) {
 					seekPosition = s.DmlPosition
@@ -12,6 +12,7 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"os"
 	"path"
@@ -375,6 +376,16 @@ func TestGetCollectionStatistics(t *testing.T) {
 		assert.Nil(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed, NumOfRows: 10,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"b1"}, BinlogSize: 100}}}))
+		assert.Nil(t, err)
+		resp, err = svr.GetCollectionStatistics(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.EqualValues(t, 10, resp.Statistics.SealedRowCount)
+		assert.EqualValues(t, 100, resp.Statistics.BinlogSize)
+		assert.Equal(t, 2, len(resp.Stats))
+
 	})
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -400,6 +411,15 @@ func TestGetPartitionStatistics(t *testing.T) {
 		resp, err := svr.GetPartitionStatistics(context.Background(), req)
 		assert.Nil(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing, NumOfRows: 10}))
+		assert.Nil(t, err)
+		resp, err = svr.GetPartitionStatistics(context.Background(), req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.EqualValues(t, 10, resp.Statistics.RowCount)
+		assert.EqualValues(t, 1, resp.Statistics.GrowingSegmentCount)
+		assert.Equal(t, 3, len(resp.Stats))
 	})
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -568,6 +588,111 @@ func TestGetFlushedSegments(t *testing.T) {
 	})
 }
 
+func TestListSegments(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		segments := []*datapb.SegmentInfo{
+			{ID: 5, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+			{ID: 1, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Growing},
+			{ID: 3, CollectionID: 1, PartitionID: 2, InsertChannel: "ch2", State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 1, PartitionID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Sealed},
+			{ID: 4, CollectionID: 2, PartitionID: 3, InsertChannel: "ch3", State: commonpb.SegmentState_Flushed},
+		}
+		for _, segment := range segments {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		type testCase struct {
+			req      *datapb.ListSegmentsRequest
+			expected []int64
+			total    int64
+		}
+		cases := []testCase{
+			{&datapb.ListSegmentsRequest{}, []int64{1, 2, 3, 4, 5}, 5},
+			{&datapb.ListSegmentsRequest{CollectionID: 1}, []int64{1, 2, 3, 5}, 4},
+			{&datapb.ListSegmentsRequest{CollectionID: 1, PartitionIDs: []int64{2, 3}}, []int64{2, 3}, 2},
+			{&datapb.ListSegmentsRequest{States: []commonpb.SegmentState{commonpb.SegmentState_Flushed}}, []int64{3, 4, 5}, 3},
+			{&datapb.ListSegmentsRequest{ChannelName: "ch1"}, []int64{1, 2, 5}, 3},
+			{&datapb.ListSegmentsRequest{CollectionID: 1, ChannelName: "ch1", States: []commonpb.SegmentState{commonpb.SegmentState_Sealed}}, []int64{2}, 1},
+			{&datapb.ListSegmentsRequest{Offset: 1, Limit: 2}, []int64{2, 3}, 5},
+			{&datapb.ListSegmentsRequest{Offset: 3, Limit: 10}, []int64{4, 5}, 5},
+			{&datapb.ListSegmentsRequest{Offset: 4}, []int64{5}, 5},
+			{&datapb.ListSegmentsRequest{Offset: 10, Limit: 2}, []int64{}, 5},
+			{&datapb.ListSegmentsRequest{CollectionID: 3}, []int64{}, 0},
+		}
+		for _, tc := range cases {
+			resp, err := svr.ListSegments(context.Background(), tc.req)
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+			ids := make([]int64, 0, len(resp.GetInfos()))
+			for _, info := range resp.GetInfos() {
+				ids = append(ids, info.GetID())
+			}
+			assert.Equal(t, tc.expected, ids)
+			assert.Equal(t, tc.total, resp.GetTotal())
+		}
+
+		resp, err := svr.ListSegments(context.Background(), &datapb.ListSegmentsRequest{Offset: -1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.ListSegments(context.Background(), &datapb.ListSegmentsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, serverNotServingErrMsg, resp.GetStatus().GetReason())
+	})
+}
+
+func TestGetDeleteStats(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1})
+		segments := []*datapb.SegmentInfo{
+			{ID: 2, CollectionID: 1, PartitionID: 1, NumOfRows: 10, State: commonpb.SegmentState_Flushed,
+				Deltalogs: []*datapb.DeltaLogInfo{{RecordEntries: 2, DeltaLogSize: 100}, {RecordEntries: 3, DeltaLogSize: 50}}},
+			{ID: 1, CollectionID: 1, PartitionID: 1, NumOfRows: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 3, CollectionID: 1, PartitionID: 1, NumOfRows: 10, State: commonpb.SegmentState_Dropped,
+				Deltalogs: []*datapb.DeltaLogInfo{{RecordEntries: 10, DeltaLogSize: 100}}},
+		}
+		for _, segment := range segments {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(segment)))
+		}
+
+		resp, err := svr.GetDeleteStats(context.Background(), &datapb.GetDeleteStatsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 20, resp.GetNumOfRows())
+		assert.EqualValues(t, 5, resp.GetNumDeletedRows())
+		assert.EqualValues(t, 150, resp.GetDeltaLogSize())
+		assert.Equal(t, 2, len(resp.GetSegments()))
+		assert.EqualValues(t, 1, resp.GetSegments()[0].GetSegmentID())
+		assert.EqualValues(t, 2, resp.GetSegments()[1].GetNumDeltaLogs())
+
+		status, err := svr.PurgeDeletes(context.Background(), &datapb.PurgeDeletesRequest{CollectionID: 1, SegmentIDs: []int64{3}})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetDeleteStats(context.Background(), &datapb.GetDeleteStatsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, serverNotServingErrMsg, resp.GetStatus().GetReason())
+		status, err := svr.PurgeDeletes(context.Background(), &datapb.PurgeDeletesRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, serverNotServingErrMsg, status.GetReason())
+	})
+}
+
 func TestServer_GetMetrics(t *testing.T) {
 	svr := newTestServer(t, nil)
 	defer closeTestServer(t, svr)
@@ -736,6 +861,13 @@ func TestSaveBinlogPaths(t *testing.T) {
 						"/by-dev/test/0/1/2/1/Allo1",
 						"/by-dev/test/0/1/2/1/Allo2",
 					},
+					BinlogSize: 1024,
+				},
+			},
+			Field2StatslogPaths: []*datapb.FieldBinlog{
+				{
+					FieldID: 1,
+					Binlogs: []string{"/by-dev/stats/0/1/2/1/Allo1"},
 				},
 			},
 			CheckPoints: []*datapb.CheckPoint{
@@ -765,6 +897,11 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, 1, fieldBinlogs.GetFieldID())
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo1", fieldBinlogs.GetBinlogs()[0])
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo2", fieldBinlogs.GetBinlogs()[1])
+		assert.EqualValues(t, 1024, fieldBinlogs.GetBinlogSize())
+		statslogs := segment.GetStatslogs()
+		assert.EqualValues(t, 1, len(statslogs))
+		assert.EqualValues(t, 1, statslogs[0].GetFieldID())
+		assert.EqualValues(t, []string{"/by-dev/stats/0/1/2/1/Allo1"}, statslogs[0].GetBinlogs())
 
 		segmentInfo := svr.meta.GetSegment(0)
 		assert.NotNil(t, segmentInfo)
@@ -773,6 +910,42 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, segmentInfo.NumOfRows, 10)
 	})
 
+	t.Run("save L0 segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
+			SegmentID:    10,
+			CollectionID: 0,
+			Level:        datapb.SegmentLevel_L0,
+			Channel:      "ch1",
+			Flushed:      true,
+			Deltalogs:    []*datapb.DeltaLogInfo{{RecordEntries: 2, TimestampTo: 20, DeltaLogPath: "/delta/1"}},
+			StartPositions: []*datapb.SegmentStartPosition{{
+				SegmentID:     10,
+				StartPosition: &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: 10},
+			}},
+			CheckPoints: []*datapb.CheckPoint{{
+				SegmentID: 10,
+				Position:  &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{2}, Timestamp: 20},
+			}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		segment := svr.meta.GetSegment(10)
+		assert.NotNil(t, segment)
+		assert.Equal(t, datapb.SegmentLevel_L0, segment.GetLevel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.Equal(t, allPartitionsID, segment.GetPartitionID())
+		assert.Equal(t, "ch1", segment.GetInsertChannel())
+		assert.EqualValues(t, 10, segment.GetStartPosition().GetTimestamp())
+		assert.EqualValues(t, 20, segment.GetDmlPosition().GetTimestamp())
+		assert.Equal(t, 1, len(segment.GetDeltalogs()))
+		assert.Zero(t, segment.GetNumOfRows())
+		assert.Equal(t, 0, len(svr.flushCh))
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -1238,6 +1411,62 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.ElementsMatch(t, []string{"/binlog/file1", "/binlog/file2"}, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetBinlogs())
 	})
 
+	t.Run("test get deltalogs of L0 segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.rootCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.RootCoord, error) {
+			return newMockRootCoordService(), nil
+		}
+
+		segment := createSegment(0, 0, 0, 100, 10, "vchan1", commonpb.SegmentState_Flushed)
+		segment.StartPosition.Timestamp = 5
+		segment.Deltalogs = []*datapb.DeltaLogInfo{{DeltaLogPath: "/delta/0"}}
+		err := svr.meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+		l0Segments := []struct {
+			id      UniqueID
+			channel string
+			ts      Timestamp
+		}{
+			{1, "vchan1", 20},
+			{2, "vchan1", 4}, // deletes before the segment starts
+			{3, "vchan2", 20},
+		}
+		for _, l0 := range l0Segments {
+			resp, err := svr.SaveBinlogPaths(context.TODO(), &datapb.SaveBinlogPathsRequest{
+				SegmentID:    l0.id,
+				CollectionID: 0,
+				Level:        datapb.SegmentLevel_L0,
+				Channel:      l0.channel,
+				Deltalogs: []*datapb.DeltaLogInfo{{TimestampTo: l0.ts,
+					DeltaLogPath: fmt.Sprintf("/delta/%d", l0.id)}},
+				CheckPoints: []*datapb.CheckPoint{{
+					SegmentID: l0.id,
+					Position:  &internalpb.MsgPosition{ChannelName: l0.channel, Timestamp: 100},
+				}},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		}
+
+		resp, err := svr.GetRecoveryInfo(context.TODO(), &datapb.GetRecoveryInfoRequest{
+			CollectionID: 0,
+			PartitionID:  0,
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.EqualValues(t, 1, len(resp.GetBinlogs()))
+		deltalogs := resp.GetBinlogs()[0].GetDeltalogs()
+		assert.Equal(t, 2, len(deltalogs))
+		assert.Equal(t, "/delta/0", deltalogs[0].GetDeltaLogPath())
+		assert.Equal(t, "/delta/1", deltalogs[1].GetDeltaLogPath())
+		// the L0 segments are not replayed
+		assert.EqualValues(t, 1, len(resp.GetChannels()))
+		assert.ElementsMatch(t, []UniqueID{0}, resp.GetChannels()[0].GetFlushedSegments())
+		assert.EqualValues(t, 10, resp.GetChannels()[0].GetSeekPosition().GetTimestamp())
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -3,6 +3,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync/atomic"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -199,8 +202,7 @@ func (s *Server) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsert
 	return resp, nil
 }
 
-// GetCollectionStatistics returns statistics for collection
-// for now only row count is returned
+// GetCollectionStatistics returns statistics for collection, the row count and the binlog bytes are returned
 func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	resp := &datapb.GetCollectionStatisticsResponse{
 		Status: &commonpb.Status{
@@ -211,9 +213,12 @@ func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCol
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
 	}
-	nums := s.meta.GetNumRowsOfCollection(req.CollectionID)
+	stats := s.meta.GetCollectionStatistics(req.CollectionID)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
-	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(nums, 10)})
+	resp.Stats = append(resp.Stats,
+		&commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(stats.RowCount, 10)},
+		&commonpb.KeyValuePair{Key: "binlog_size", Value: strconv.FormatInt(stats.BinlogSize, 10)})
+	resp.Statistics = stats
 	return resp, nil
 }
 
@@ -229,9 +234,13 @@ func (s *Server) GetPartitionStatistics(ctx context.Context, req *datapb.GetPart
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
 	}
-	nums := s.meta.GetNumRowsOfPartition(req.CollectionID, req.PartitionID)
+	stats := s.meta.GetPartitionStatistics(req.CollectionID, req.PartitionID)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
-	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(nums, 10)})
+	resp.Stats = append(resp.Stats,
+		&commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(stats.RowCount, 10)},
+		&commonpb.KeyValuePair{Key: "segment_count", Value: strconv.FormatInt(stats.SegmentCount, 10)},
+		&commonpb.KeyValuePair{Key: "data_size", Value: strconv.FormatInt(stats.DataSize, 10)})
+	resp.Statistics = stats
 	return resp, nil
 }
 
@@ -285,9 +294,22 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		zap.Int64("segmentID", req.GetSegmentID()),
 		zap.Any("checkpoints", req.GetCheckPoints()))
 
+	// the L0 segments are created flushed by the data nodes with the deletes of their channels
+	if req.GetLevel() == datapb.SegmentLevel_L0 {
+		if err := s.meta.AddSegment(newL0Segment(req)); err != nil {
+			log.Error("save L0 segment failed",
+				zap.Int64("segmentID", req.GetSegmentID()),
+				zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		resp.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
 	// set segment to SegmentState_Flushing and save binlogs and checkpoints
 	err := s.meta.UpdateFlushSegmentsInfo(req.GetSegmentID(), req.GetFlushed(),
-		req.GetField2BinlogPaths(), req.GetCheckPoints(), req.GetStartPositions())
+		req.GetField2BinlogPaths(), req.GetField2StatslogPaths(), req.GetDeltalogs(), req.GetCheckPoints(), req.GetStartPositions())
 	if err != nil {
 		log.Error("save binlog and checkpoints failed",
 			zap.Int64("segmentID", req.GetSegmentID()),
@@ -350,6 +372,12 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segmentsNumOfRows := make(map[UniqueID]int64)
+	segmentsClusteringInfo := make(map[UniqueID]*datapb.ClusteringInfo)
+	segmentsDeltalogs := make(map[UniqueID][]*datapb.DeltaLogInfo)
+	l0Segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID && segment.GetLevel() == datapb.SegmentLevel_L0 &&
+			segment.GetState() == commonpb.SegmentState_Flushed
+	})
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
 		if segment == nil {
@@ -377,14 +405,19 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		}
 
 		segmentsNumOfRows[id] = segment.NumOfRows
+		segmentsClusteringInfo[id] = segment.GetClusteringInfo()
+		segmentsDeltalogs[id] = append(append([]*datapb.DeltaLogInfo{}, segment.GetDeltalogs()...),
+			getL0Deltalogs(segment, l0Segments)...)
 	}
 
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
 	for segmentID, fieldBinlogs := range segment2Binlogs {
 		sbl := &datapb.SegmentBinlogs{
-			SegmentID:    segmentID,
-			NumOfRows:    segmentsNumOfRows[segmentID],
-			FieldBinlogs: fieldBinlogs,
+			SegmentID:      segmentID,
+			NumOfRows:      segmentsNumOfRows[segmentID],
+			FieldBinlogs:   fieldBinlogs,
+			ClusteringInfo: segmentsClusteringInfo[segmentID],
+			Deltalogs:      segmentsDeltalogs[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -455,7 +488,7 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	ret := make([]UniqueID, 0, len(segmentIDs))
 	for _, id := range segmentIDs {
 		s := s.meta.GetSegment(id)
-		if s == nil || s.GetState() != commonpb.SegmentState_Flushed {
+		if s == nil || s.GetState() != commonpb.SegmentState_Flushed || s.GetLevel() == datapb.SegmentLevel_L0 {
 			continue
 		}
 		ret = append(ret, id)
@@ -465,6 +498,275 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	return resp, nil
 }
 
+// ListSegments returns the segments matching the provided collection, partitions, states and channel,
+// the empty filters match all segments.
+// The matched segments are sorted by id and paged by offset and limit, limit <= 0 returns all after offset
+func (s *Server) ListSegments(ctx context.Context, req *datapb.ListSegmentsRequest) (*datapb.ListSegmentsResponse, error) {
+	resp := &datapb.ListSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	log.Debug("ListSegments",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+		zap.Any("states", req.GetStates()),
+		zap.String("channelName", req.GetChannelName()),
+		zap.Int64("offset", req.GetOffset()),
+		zap.Int64("limit", req.GetLimit()))
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if req.GetOffset() < 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid offset %d", req.GetOffset())
+		return resp, nil
+	}
+
+	partitions := make(map[UniqueID]struct{}, len(req.GetPartitionIDs()))
+	for _, partitionID := range req.GetPartitionIDs() {
+		partitions[partitionID] = struct{}{}
+	}
+	states := make(map[commonpb.SegmentState]struct{}, len(req.GetStates()))
+	for _, state := range req.GetStates() {
+		states[state] = struct{}{}
+	}
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		if req.GetCollectionID() != 0 && segment.GetCollectionID() != req.GetCollectionID() {
+			return false
+		}
+		if len(partitions) > 0 {
+			if _, ok := partitions[segment.GetPartitionID()]; !ok {
+				return false
+			}
+		}
+		if len(states) > 0 {
+			if _, ok := states[segment.GetState()]; !ok {
+				return false
+			}
+		}
+		return req.GetChannelName() == "" || segment.GetInsertChannel() == req.GetChannelName()
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+
+	total := int64(len(segments))
+	start, end := req.GetOffset(), total
+	if start > total {
+		start = total
+	}
+	if req.GetLimit() > 0 && start+req.GetLimit() < end {
+		end = start + req.GetLimit()
+	}
+	infos := make([]*datapb.SegmentInfo, 0, end-start)
+	for _, segment := range segments[start:end] {
+		infos = append(infos, segment.SegmentInfo)
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Infos = infos
+	resp.Total = total
+	return resp, nil
+}
+
+// Export starts a background job exporting the flushed segments of the collection to the requested bucket path,
+// the job state is queried by GetExportState with the returned job id
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	resp := &datapb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	log.Debug("receive export request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+		zap.String("format", req.GetFormat().String()),
+		zap.String("bucketName", req.GetBucketName()),
+		zap.String("path", req.GetPath()))
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if req.GetPath() == "" {
+		resp.Status.Reason = "export path is empty"
+		return resp, nil
+	}
+	if _, ok := datapb.ExportFormat_name[int32(req.GetFormat())]; !ok {
+		resp.Status.Reason = fmt.Sprintf("unsupported export format %d", req.GetFormat())
+		return resp, nil
+	}
+	if coll := s.meta.GetCollection(req.GetCollectionID()); coll == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Status.Reason = fmt.Sprintf("failed to get collection %d: %s", req.GetCollectionID(), err.Error())
+			return resp, nil
+		}
+	}
+	jobID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	s.exportManager.submit(jobID, req)
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.JobID = jobID
+	return resp, nil
+}
+
+// GetExportState returns the state of the export job and the segments exported so far
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	resp := &datapb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	job := s.exportManager.getJob(req.GetJobID())
+	if job == nil {
+		resp.Status.Reason = fmt.Sprintf("export job %d not found", req.GetJobID())
+		return resp, nil
+	}
+	job.fillState(resp)
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ClusteringCompact starts the clustering compaction of the collection by the field in background,
+// the flushed segments are replaced by the compacted segments when the compaction completes
+func (s *Server) ClusteringCompact(ctx context.Context, req *datapb.ClusteringCompactRequest) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	log.Debug("receive clustering compaction request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("fieldID", req.GetFieldID()))
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if !Params.EnableClusteringCompaction {
+		resp.Reason = "clustering compaction is disabled"
+		return resp, nil
+	}
+	coll := s.meta.GetCollection(req.GetCollectionID())
+	if coll == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Reason = fmt.Sprintf("failed to get collection %d: %s", req.GetCollectionID(), err.Error())
+			return resp, nil
+		}
+		coll = s.meta.GetCollection(req.GetCollectionID())
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range coll.GetSchema().GetFields() {
+		if f.GetFieldID() == req.GetFieldID() {
+			field = f
+		}
+	}
+	if field == nil {
+		resp.Reason = fmt.Sprintf("field %d not found in collection %d", req.GetFieldID(), req.GetCollectionID())
+		return resp, nil
+	}
+	if !isClusteringKeySupported(field.GetDataType()) {
+		resp.Reason = fmt.Sprintf("field %s of type %s can't be the clustering key", field.GetName(), field.GetDataType().String())
+		return resp, nil
+	}
+	if err := s.clusteringCompactor.submit(req.GetCollectionID(), req.GetFieldID()); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetDeleteStats returns the deleted rows and the delta log sizes of the flushed segments of the collection
+func (s *Server) GetDeleteStats(ctx context.Context, req *datapb.GetDeleteStatsRequest) (*datapb.GetDeleteStatsResponse, error) {
+	resp := &datapb.GetDeleteStatsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if s.meta.GetCollection(req.GetCollectionID()) == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Status.Reason = fmt.Sprintf("failed to get collection %d: %s", req.GetCollectionID(), err.Error())
+			return resp, nil
+		}
+	}
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetCollectionID() && segment.GetState() == commonpb.SegmentState_Flushed
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+	for _, segment := range segments {
+		stats := getSegmentDeleteStats(segment)
+		addSegmentDeleteStats(resp, stats)
+		resp.Segments = append(resp.Segments, stats)
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// PurgeDeletes starts the delete compaction of the segments of the collection in background, all the flushed
+// segments with delta logs if no segment is specified
+func (s *Server) PurgeDeletes(ctx context.Context, req *datapb.PurgeDeletesRequest) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	log.Debug("receive purge deletes request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if s.meta.GetCollection(req.GetCollectionID()) == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Reason = fmt.Sprintf("failed to get collection %d: %s", req.GetCollectionID(), err.Error())
+			return resp, nil
+		}
+	}
+	if err := s.deleteCompactor.submit(req.GetCollectionID(), req.GetSegmentIDs()); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// PurgeCollection removes the segments of a collection purged by rootcoord and their binlogs, rootcoord purges a
+// dropped collection once the retention of the drop expires
+func (s *Server) PurgeCollection(ctx context.Context, req *datapb.PurgeCollectionRequest) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	log.Debug("receive purge collection request", zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	source, err := s.kvCreator(ctx, Params.MinioBucketName)
+	if err != nil {
+		resp.Reason = fmt.Sprintf("failed to create the kv of bucket %s: %s", Params.MinioBucketName, err.Error())
+		return resp, nil
+	}
+	segmentIDs := s.meta.GetSegmentsOfCollection(req.GetCollectionID())
+	if err := purgeCollection(s.meta, source, req.GetCollectionID()); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	for _, segmentID := range segmentIDs {
+		s.segmentManager.DropSegment(ctx, segmentID)
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // GetMetrics returns DataCoord metrics info
 // it may include SystemMetrics, Topology metrics, etc.
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
@@ -528,6 +830,14 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return metrics, err
 	}
 
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.DataCoordRole, Params.NodeID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.DataCoordRole, Params.NodeID), &Params)
+	}
+
 	log.Debug("DataCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.NodeID),
 		zap.String("req", req.Request),
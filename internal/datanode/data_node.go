@@ -78,6 +78,8 @@ const illegalRequestErrStr = "Illegal request"
 
 //  `clearSignal` is a signal channel for releasing the flowgraph resources.
 //  `segmentCache` stores all flushing and flushed segments.
+//  `bufferManager` shares the memory budget of the insert buffers among the flowgraphs.
+//  `syncPool` syncs the detached insert buffers of the flowgraphs to the object storage.
 type DataNode struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -90,6 +92,8 @@ type DataNode struct {
 	vchan2FlushCh     map[string]chan<- *flushMsg // vchannel name
 	clearSignal       chan UniqueID               // collection ID
 	segmentCache      *Cache
+	bufferManager     *writeBufferManager
+	syncPool          *syncPool
 
 	rootCoord types.RootCoord
 	dataCoord types.DataCoord
@@ -162,12 +166,17 @@ func (node *DataNode) Register() error {
 	return nil
 }
 
-// Init function do nothing now.
+// Init creates the write buffer manager and the sync pool shared by the flowgraphs.
 func (node *DataNode) Init() error {
 	log.Debug("DataNode Init",
 		zap.String("SegmentStatisticsChannelName", Params.SegmentStatisticsChannelName),
 		zap.String("TimeTickChannelName", Params.TimeTickChannelName),
+		zap.Int64("FlushMemoryHighWatermark", Params.FlushMemoryHighWatermark),
+		zap.Int64("FlushMemoryLowWatermark", Params.FlushMemoryLowWatermark),
+		zap.Int("FlushSyncWorkers", Params.FlushSyncWorkers),
 	)
+	node.bufferManager = newWriteBufferManager(Params.FlushMemoryHighWatermark, Params.FlushMemoryLowWatermark)
+	node.syncPool = newSyncPool(Params.FlushSyncWorkers, Params.FlushMaxPendingSyncs)
 
 	return nil
 }
@@ -253,7 +262,7 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 	)
 
 	flushChan := make(chan *flushMsg, 100)
-	dataSyncService, err := newDataSyncService(node.ctx, flushChan, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord)
+	dataSyncService, err := newDataSyncService(node.ctx, flushChan, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord, node.bufferManager, node.syncPool)
 	if err != nil {
 		return err
 	}
@@ -631,6 +640,14 @@ func (node *DataNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 		return systemInfoMetrics, err
 	}
 
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.DataNodeRole, Params.NodeID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.DataNodeRole, Params.NodeID), &Params)
+	}
+
 	log.Debug("DataNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.NodeID),
 		zap.String("req", req.Request),
@@ -39,7 +39,9 @@ type dataSyncService struct {
 	dataCoord    types.DataCoord
 	clearSignal  chan<- UniqueID
 
-	saveBinlog func(fu *segmentFlushUnit) error
+	saveBinlog    func(fu *segmentFlushUnit) error
+	bufferManager *writeBufferManager
+	syncPool      *syncPool
 }
 
 func newDataSyncService(ctx context.Context,
@@ -50,6 +52,8 @@ func newDataSyncService(ctx context.Context,
 	vchan *datapb.VchannelInfo,
 	clearSignal chan<- UniqueID,
 	dataCoord types.DataCoord,
+	bufferManager *writeBufferManager,
+	syncPool *syncPool,
 
 ) (*dataSyncService, error) {
 
@@ -70,6 +74,9 @@ func newDataSyncService(ctx context.Context,
 		collectionID: vchan.GetCollectionID(),
 		dataCoord:    dataCoord,
 		clearSignal:  clearSignal,
+
+		bufferManager: bufferManager,
+		syncPool:      syncPool,
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -115,7 +122,11 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		id2path := []*datapb.FieldBinlog{}
 		checkPoints := []*datapb.CheckPoint{}
 		for k, v := range fu.field2Path {
-			id2path = append(id2path, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}})
+			id2path = append(id2path, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}, BinlogSize: fu.field2Size[k]})
+		}
+		id2stats := make([]*datapb.FieldBinlog, 0, len(fu.field2Stats))
+		for k, v := range fu.field2Stats {
+			id2stats = append(id2stats, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}, BinlogSize: fu.field2StatsSize[k]})
 		}
 		for k, v := range fu.checkPoint {
 			v := v
@@ -138,12 +149,16 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 				Timestamp: 0, //TODO time stamp
 				SourceID:  Params.NodeID,
 			},
-			SegmentID:         fu.segID,
-			CollectionID:      fu.collID,
-			Field2BinlogPaths: id2path,
-			CheckPoints:       checkPoints,
-			StartPositions:    fu.startPositions,
-			Flushed:           fu.flushed,
+			SegmentID:           fu.segID,
+			CollectionID:        fu.collID,
+			Field2BinlogPaths:   id2path,
+			Field2StatslogPaths: id2stats,
+			CheckPoints:         checkPoints,
+			StartPositions:      fu.startPositions,
+			Flushed:             fu.flushed,
+			Deltalogs:           fu.deltalogs,
+			Level:               fu.level,
+			Channel:             vchanInfo.GetChannelName(),
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
 		if err != nil {
@@ -165,8 +180,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		vchanInfo.GetSeekPosition(),
 	)
 	var ddNode Node = newDDNode(dsService.clearSignal, dsService.collectionID, vchanInfo)
-	var insertBufferNode Node
-	insertBufferNode, err = newInsertBufferNode(
+	ibNode, err := newInsertBufferNode(
 		dsService.ctx,
 		dsService.replica,
 		dsService.msFactory,
@@ -178,8 +192,11 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 	if err != nil {
 		return err
 	}
+	ibNode.bufferManager = dsService.bufferManager
+	ibNode.syncPool = dsService.syncPool
+	var insertBufferNode Node = ibNode
 
-	dn := newDeleteDNode(dsService.replica)
+	dn := newDeleteDNode(dsService.replica, dsService.idAllocator, ibNode.minIOKV, saveBinlog, vchanInfo.GetChannelName())
 
 	var deleteNode Node = dn
 
@@ -105,6 +105,8 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 				getVchanInfo(test.isValidCase, test.collID, test.ufCollID, test.ufSegID, test.chanName, test.ufchanName, test.ufNor),
 				make(chan UniqueID),
 				df,
+				newWriteBufferManager(0, 0),
+				newSyncPool(1, 1),
 			)
 
 			if !test.isValidCase {
@@ -186,7 +188,7 @@ func TestDataSyncService_Start(t *testing.T) {
 	}
 
 	signalCh := make(chan UniqueID, 100)
-	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, &DataCoordFactory{})
+	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, &DataCoordFactory{}, newWriteBufferManager(0, 0), newSyncPool(4, 16))
 
 	assert.Nil(t, err)
 	// sync.replica.addCollection(collMeta.ID, collMeta.Schema)
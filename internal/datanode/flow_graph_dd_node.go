@@ -16,7 +16,6 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -30,6 +29,7 @@ type ddNode struct {
 
 	clearSignal  chan<- UniqueID
 	collectionID UniqueID
+	channelName  string
 
 	segID2SegInfo   sync.Map // segment ID to *SegmentInfo
 	flushedSegments []UniqueID
@@ -40,16 +40,16 @@ func (ddn *ddNode) Name() string {
 }
 
 func (ddn *ddNode) Operate(in []Msg) []Msg {
-	// log.Debug("DDNode Operating")
+	// flowGraphLog().Debug("DDNode Operating")
 
 	if len(in) != 1 {
-		log.Warn("Invalid operate message input in ddNode", zap.Int("input length", len(in)))
+		flowGraphLog().Warn("Invalid operate message input in ddNode", zap.Int("input length", len(in)))
 		return []Msg{}
 	}
 
 	msMsg, ok := in[0].(*MsgStreamMsg)
 	if !ok {
-		log.Warn("Type assertion failed for MsgStreamMsg")
+		flowGraphLog().Warn("Type assertion failed for MsgStreamMsg")
 		return []Msg{}
 	}
 
@@ -62,6 +62,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msMsg.TimestampMin(),
 			timestampMax: msMsg.TimestampMax(),
@@ -74,21 +75,21 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 		switch msg.Type() {
 		case commonpb.MsgType_DropCollection:
 			if msg.(*msgstream.DropCollectionMsg).GetCollectionID() == ddn.collectionID {
-				log.Info("Destroying current flowgraph", zap.Any("collectionID", ddn.collectionID))
+				flowGraphLog().Info("Destroying current flowgraph", zap.Any("collectionID", ddn.collectionID))
 				ddn.clearSignal <- ddn.collectionID
 				return []Msg{}
 			}
 		case commonpb.MsgType_Insert:
-			log.Debug("DDNode with insert messages")
+			flowGraphLog().Debug("DDNode with insert messages")
 			imsg := msg.(*msgstream.InsertMsg)
 			if imsg.CollectionID != ddn.collectionID {
-				//log.Debug("filter invalid InsertMsg, collection mis-match",
+				//flowGraphLog().Debug("filter invalid InsertMsg, collection mis-match",
 				//	zap.Int64("Get msg collID", imsg.CollectionID),
 				//	zap.Int64("Expected collID", ddn.collectionID))
 				continue
 			}
 			if msg.EndTs() < FilterThreshold {
-				log.Info("Filtering Insert Messages",
+				flowGraphLog().Info("Filtering Insert Messages",
 					zap.Uint64("Message endts", msg.EndTs()),
 					zap.Uint64("FilterThreshold", FilterThreshold),
 				)
@@ -97,6 +98,13 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				}
 			}
 			iMsg.insertMessages = append(iMsg.insertMessages, msg.(*msgstream.InsertMsg))
+		case commonpb.MsgType_Delete:
+			dmsg := msg.(*msgstream.DeleteMsg)
+			// the delete messages carry no collection id, the ones of the other virtual channels are filtered
+			if dmsg.GetChannelID() != ddn.channelName {
+				continue
+			}
+			iMsg.deleteMessages = append(iMsg.deleteMessages, dmsg)
 		}
 	}
 
@@ -142,7 +150,7 @@ func newDDNode(clearSignal chan<- UniqueID, collID UniqueID, vchanInfo *datapb.V
 
 	fs := make([]UniqueID, 0, len(vchanInfo.GetFlushedSegments()))
 	fs = append(fs, vchanInfo.GetFlushedSegments()...)
-	log.Debug("ddNode add flushed segment",
+	flowGraphLog().Debug("ddNode add flushed segment",
 		zap.Int64("collectionID", vchanInfo.GetCollectionID()),
 		zap.Int("No. Segment", len(vchanInfo.GetFlushedSegments())),
 	)
@@ -151,6 +159,7 @@ func newDDNode(clearSignal chan<- UniqueID, collID UniqueID, vchanInfo *datapb.V
 		BaseNode:        baseNode,
 		clearSignal:     clearSignal,
 		collectionID:    collID,
+		channelName:     vchanInfo.GetChannelName(),
 		flushedSegments: fs,
 	}
 
@@ -158,7 +167,7 @@ func newDDNode(clearSignal chan<- UniqueID, collID UniqueID, vchanInfo *datapb.V
 		dd.segID2SegInfo.Store(us.GetID(), us)
 	}
 
-	log.Debug("ddNode add unflushed segment",
+	flowGraphLog().Debug("ddNode add unflushed segment",
 		zap.Int64("collectionID", collID),
 		zap.Int("No. Segment", len(vchanInfo.GetUnflushedSegments())),
 	)
@@ -14,16 +14,49 @@ package datanode
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
+// allPartitionsID is the partition of the L0 segments, as the deletes apply to all the partitions
+const allPartitionsID UniqueID = -1
+
 type deleteNode struct {
 	BaseNode
 
-	replica Replica
+	channelName string
+	replica     Replica
+	idAllocator allocatorInterface
+	minIOKV     kv.BaseKV
+	saveBinlog  func(fu *segmentFlushUnit) error
+
+	delBuf     map[UniqueID]*delDataBuf // segment id to the deletes of the pks which may be in the segment
+	delBufSize int64                    // num of the buffered pks of all the segments
+
+	// the deletes of the channel buffered for the next L0 segment if Params.FlushDeleteToL0, with the start
+	// position of the msg pack of the first delete and the end position of the latest msg pack
+	l0Buf      *delDataBuf
+	l0StartPos *internalpb.MsgPosition
+	l0EndPos   *internalpb.MsgPosition
+}
+
+// delDataBuf buffers the deleted pks of a segment and their timestamps
+type delDataBuf struct {
+	pks []int64
+	tss []Timestamp
 }
 
 func (dn *deleteNode) Name() string {
@@ -42,15 +75,239 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
-	_, ok := in[0].(*MsgStreamMsg)
+	msMsg, ok := in[0].(*MsgStreamMsg)
 	if !ok {
 		log.Warn("type assertion failed for MsgStreamMsg")
 		return []Msg{}
 	}
 
+	if Params.FlushDeleteToL0 {
+		dn.bufferL0Deletes(msMsg)
+		if dn.needFlushL0(msMsg.TimestampMax()) {
+			dn.flushL0Buf()
+		}
+		return []Msg{}
+	}
+
+	for _, msg := range msMsg.TsMessages() {
+		if msg.Type() != commonpb.MsgType_Delete {
+			continue
+		}
+		if err := dn.bufferDeleteMsg(msg.(*msgstream.DeleteMsg)); err != nil {
+			log.Warn("buffer delete message failed", zap.Error(err))
+		}
+	}
+
+	if dn.delBufSize >= Params.FlushDeleteBufferSize {
+		dn.flushDelBuf()
+	}
+
 	return []Msg{}
 }
 
+// bufferL0Deletes buffers all the deleted pks of the msg pack for the next L0 segment of the channel
+func (dn *deleteNode) bufferL0Deletes(msMsg *MsgStreamMsg) {
+	for _, msg := range msMsg.TsMessages() {
+		if msg.Type() != commonpb.MsgType_Delete {
+			continue
+		}
+		dmsg := msg.(*msgstream.DeleteMsg)
+		if len(dmsg.PrimaryKeys) != len(dmsg.Timestamps) {
+			log.Warn("buffer delete message failed", zap.Error(errors.New("misaligned delete message detected")))
+			continue
+		}
+		if len(dmsg.PrimaryKeys) == 0 {
+			continue
+		}
+		if dn.l0Buf == nil {
+			dn.l0Buf = &delDataBuf{}
+			if len(msMsg.StartPositions()) > 0 {
+				dn.l0StartPos = msMsg.StartPositions()[0]
+			}
+		}
+		dn.l0Buf.pks = append(dn.l0Buf.pks, dmsg.PrimaryKeys...)
+		dn.l0Buf.tss = append(dn.l0Buf.tss, dmsg.Timestamps...)
+		dn.delBufSize += int64(len(dmsg.PrimaryKeys))
+	}
+	if dn.l0Buf != nil && len(msMsg.EndPositions()) > 0 {
+		dn.l0EndPos = msMsg.EndPositions()[0]
+	}
+}
+
+// needFlushL0 tells whether the L0 buffer reaches the buffer size, or its first delete has been buffered
+// for Params.FlushDeleteInterval seconds by the time tick
+func (dn *deleteNode) needFlushL0(timeTick Timestamp) bool {
+	if dn.l0Buf == nil {
+		return false
+	}
+	if dn.delBufSize >= Params.FlushDeleteBufferSize {
+		return true
+	}
+	first, _ := tsoutil.ParseTS(dn.l0Buf.tss[0])
+	now, _ := tsoutil.ParseTS(timeTick)
+	return now.Sub(first) >= time.Duration(Params.FlushDeleteInterval)*time.Second
+}
+
+// bufferDeleteMsg buffers the deleted pks to the segments which may contain them
+func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg) error {
+	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+		return errors.New("misaligned delete message detected")
+	}
+	tss := make(map[int64]Timestamp, len(msg.PrimaryKeys))
+	for i, pk := range msg.PrimaryKeys {
+		tss[pk] = msg.Timestamps[i]
+	}
+
+	segIDToPKs := dn.replica.filterSegmentsByPKs(msg.PrimaryKeys)
+	for segID, pks := range segIDToPKs {
+		buf, ok := dn.delBuf[segID]
+		if !ok {
+			buf = &delDataBuf{}
+			dn.delBuf[segID] = buf
+		}
+		for _, pk := range pks {
+			buf.pks = append(buf.pks, pk)
+			buf.tss = append(buf.tss, tss[pk])
+		}
+		dn.delBufSize += int64(len(pks))
+	}
+	log.Debug("buffer delete message",
+		zap.Int("pks", len(msg.PrimaryKeys)),
+		zap.Int("segments", len(segIDToPKs)),
+	)
+	return nil
+}
+
+// flushDelBuf saves the buffered deletes of each segment as a delta log and reports it to DataCoord,
+// the deletes of the segments failed to save are kept in the buffer and saved in the next flush
+func (dn *deleteNode) flushDelBuf() {
+	segIDs := make([]UniqueID, 0, len(dn.delBuf))
+	for segID := range dn.delBuf {
+		segIDs = append(segIDs, segID)
+	}
+	sort.Slice(segIDs, func(i, j int) bool { return segIDs[i] < segIDs[j] })
+
+	for _, segID := range segIDs {
+		buf := dn.delBuf[segID]
+		if err := dn.flushSegmentDeletes(segID, buf); err != nil {
+			log.Warn("flush the deletes of segment failed", zap.String("channel", dn.channelName),
+				zap.Int64("segmentID", segID), zap.Int("pks", len(buf.pks)), zap.Error(err))
+			continue
+		}
+		delete(dn.delBuf, segID)
+		dn.delBufSize -= int64(len(buf.pks))
+	}
+}
+
+func (dn *deleteNode) flushSegmentDeletes(segID UniqueID, buf *delDataBuf) error {
+	collID := dn.replica.getCollectionID()
+	partitionID, err := dn.replica.getSegmentPartitionID(segID)
+	if err != nil {
+		return err
+	}
+	deltalog, err := dn.saveDeltalog(collID, partitionID, segID, buf)
+	if err != nil {
+		return err
+	}
+	err = dn.saveBinlog(&segmentFlushUnit{
+		collID:     collID,
+		segID:      segID,
+		field2Path: map[UniqueID]string{},
+		deltalogs:  []*datapb.DeltaLogInfo{deltalog},
+	})
+	if err != nil {
+		_ = dn.minIOKV.Remove(deltalog.GetDeltaLogPath())
+		return err
+	}
+	log.Debug("save the deletes as delta log", zap.Int64("segmentID", segID),
+		zap.Int("pks", len(buf.pks)), zap.String("path", deltalog.GetDeltaLogPath()))
+	return nil
+}
+
+// flushL0Buf saves the buffered deletes of the channel as the delta log of a new L0 segment, the deletes
+// are kept in the buffer and saved in the next flush if the save fails
+func (dn *deleteNode) flushL0Buf() {
+	buf := dn.l0Buf
+	segID, err := dn.flushL0Segment(buf)
+	if err != nil {
+		log.Warn("flush the deletes as L0 segment failed", zap.String("channel", dn.channelName),
+			zap.Int("pks", len(buf.pks)), zap.Error(err))
+		return
+	}
+	log.Debug("save the deletes as L0 segment", zap.String("channel", dn.channelName),
+		zap.Int64("segmentID", segID), zap.Int("pks", len(buf.pks)))
+	dn.l0Buf, dn.l0StartPos, dn.l0EndPos = nil, nil, nil
+	dn.delBufSize -= int64(len(buf.pks))
+}
+
+// flushL0Segment saves the deletes as an L0 segment of the channel, the positions of the segment are the ones
+// of the msg packs of the deletes
+func (dn *deleteNode) flushL0Segment(buf *delDataBuf) (UniqueID, error) {
+	collID := dn.replica.getCollectionID()
+	segID, err := dn.idAllocator.allocID()
+	if err != nil {
+		return 0, fmt.Errorf("cannot alloc ID: %w", err)
+	}
+	deltalog, err := dn.saveDeltalog(collID, allPartitionsID, segID, buf)
+	if err != nil {
+		return 0, err
+	}
+	fu := &segmentFlushUnit{
+		collID:     collID,
+		segID:      segID,
+		field2Path: map[UniqueID]string{},
+		deltalogs:  []*datapb.DeltaLogInfo{deltalog},
+		flushed:    true,
+		level:      datapb.SegmentLevel_L0,
+	}
+	if dn.l0StartPos != nil {
+		fu.startPositions = []*datapb.SegmentStartPosition{{StartPosition: dn.l0StartPos, SegmentID: segID}}
+	}
+	if dn.l0EndPos != nil {
+		fu.checkPoint = map[UniqueID]segmentCheckPoint{segID: {numRows: 0, pos: *dn.l0EndPos}}
+	}
+	if err := dn.saveBinlog(fu); err != nil {
+		_ = dn.minIOKV.Remove(deltalog.GetDeltaLogPath())
+		return 0, err
+	}
+	return segID, nil
+}
+
+// saveDeltalog serializes the deletes into a delta log of the segment and saves it to MinIO
+func (dn *deleteNode) saveDeltalog(collID, partitionID, segID UniqueID, buf *delDataBuf) (*datapb.DeltaLogInfo, error) {
+	blob, err := storage.NewDeleteCodec(collID).Serialize(partitionID, segID, &storage.DeleteData{Pks: buf.pks, Tss: buf.tss})
+	if err != nil {
+		return nil, err
+	}
+	logidx, err := dn.idAllocator.allocID()
+	if err != nil {
+		return nil, fmt.Errorf("cannot alloc ID: %w", err)
+	}
+	// no error raise if alloc=false
+	k, _ := dn.idAllocator.genKey(false, collID, partitionID, segID, logidx)
+	key := path.Join(Params.DeltaBinlogRootPath, k)
+	if err := dn.minIOKV.Save(key, string(blob.GetValue())); err != nil {
+		return nil, fmt.Errorf("cannot save to MinIO: %w", err)
+	}
+
+	tsFrom, tsTo := buf.tss[0], buf.tss[0]
+	for _, ts := range buf.tss {
+		if ts < tsFrom {
+			tsFrom = ts
+		}
+		if ts > tsTo {
+			tsTo = ts
+		}
+	}
+	return &datapb.DeltaLogInfo{
+		RecordEntries: int64(len(buf.pks)),
+		TimestampFrom: tsFrom,
+		TimestampTo:   tsTo,
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.GetValue())),
+	}, nil
+}
+
 func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, error) {
 	if pks == nil {
 		return nil, errors.New("pks is nil")
@@ -72,12 +329,18 @@ func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, erro
 	return results, nil
 }
 
-func newDeleteDNode(replica Replica) *deleteNode {
+func newDeleteDNode(replica Replica, idAllocator allocatorInterface, minIOKV kv.BaseKV,
+	saveBinlog func(fu *segmentFlushUnit) error, channelName string) *deleteNode {
 	baseNode := BaseNode{}
 	baseNode.SetMaxParallelism(Params.FlowGraphMaxQueueLength)
 
 	return &deleteNode{
-		BaseNode: baseNode,
-		replica:  replica,
+		BaseNode:    baseNode,
+		channelName: channelName,
+		replica:     replica,
+		idAllocator: idAllocator,
+		minIOKV:     minIOKV,
+		saveBinlog:  saveBinlog,
+		delBuf:      make(map[UniqueID]*delDataBuf),
 	}
 }
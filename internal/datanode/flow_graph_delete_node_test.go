@@ -13,10 +13,21 @@ package datanode
 
 import (
 	"encoding/binary"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func TestFlowGraphDeleteNode_newDeleteNode(te *testing.T) {
@@ -30,7 +41,7 @@ func TestFlowGraphDeleteNode_newDeleteNode(te *testing.T) {
 
 	for _, test := range tests {
 		te.Run(test.description, func(t *testing.T) {
-			dn := newDeleteDNode(test.replica)
+			dn := newDeleteDNode(test.replica, NewAllocatorFactory(), memkv.NewMemoryKV(), nil, "")
 
 			assert.NotNil(t, dn)
 			assert.Equal(t, "deleteNode", dn.Name())
@@ -120,3 +131,201 @@ func Test_GetSegmentsByPKs(t *testing.T) {
 	_, err = getSegmentsByPKs([]int64{0, 1, 2, 3, 4}, nil)
 	assert.NotNil(t, err)
 }
+
+func TestFlowGraphDeleteNode_bufferDeleteMsg(t *testing.T) {
+	chanName := "insert-02"
+	pos := &internalpb.MsgPosition{ChannelName: chanName}
+	replica := newSegmentReplica(&RootCoordFactory{}, 1)
+	err := replica.addNewSegment(1, 1, 2, chanName, pos, pos)
+	assert.Nil(t, err)
+	err = replica.addNewSegment(2, 1, 2, chanName, pos, pos)
+	assert.Nil(t, err)
+	replica.updateSegmentPKRange(1, []int64{1, 2})
+	replica.updateSegmentPKRange(2, []int64{3})
+
+	defer func(toL0 bool) { Params.FlushDeleteToL0 = toL0 }(Params.FlushDeleteToL0)
+	Params.FlushDeleteToL0 = false
+	dn := newDeleteDNode(replica, NewAllocatorFactory(), memkv.NewMemoryKV(), nil, chanName)
+	msg := &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:        &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			ChannelID:   chanName,
+			Timestamps:  []uint64{100, 101, 102},
+			PrimaryKeys: []int64{1, 3, 4},
+		},
+	}
+	rt := dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, 0, 0, nil, nil)})
+	assert.Empty(t, rt)
+	assert.Equal(t, 2, len(dn.delBuf))
+	assert.Equal(t, &delDataBuf{pks: []int64{1}, tss: []Timestamp{100}}, dn.delBuf[1])
+	assert.Equal(t, &delDataBuf{pks: []int64{3}, tss: []Timestamp{101}}, dn.delBuf[2])
+
+	msg.Timestamps = msg.Timestamps[:1]
+	err = dn.bufferDeleteMsg(msg)
+	assert.Error(t, err)
+}
+
+func TestFlowGraphDeleteNode_flushDelBuf(t *testing.T) {
+	chanName := "insert-02"
+	pos := &internalpb.MsgPosition{ChannelName: chanName}
+	replica := newSegmentReplica(&RootCoordFactory{}, 1)
+	err := replica.addNewSegment(1, 1, 2, chanName, pos, pos)
+	assert.Nil(t, err)
+	err = replica.addNewSegment(2, 1, 2, chanName, pos, pos)
+	assert.Nil(t, err)
+	replica.updateSegmentPKRange(1, []int64{1, 2})
+	replica.updateSegmentPKRange(2, []int64{3})
+
+	saved := make([]*segmentFlushUnit, 0)
+	saveErr := errors.New("mock save failure")
+	saveBinlog := func(fu *segmentFlushUnit) error {
+		if fu.segID == 2 && saveErr != nil {
+			return saveErr
+		}
+		saved = append(saved, fu)
+		return nil
+	}
+	kv := memkv.NewMemoryKV()
+	dn := newDeleteDNode(replica, NewAllocatorFactory(), kv, saveBinlog, chanName)
+
+	defer func(size int64) { Params.FlushDeleteBufferSize = size }(Params.FlushDeleteBufferSize)
+	Params.FlushDeleteBufferSize = 3
+	defer func(toL0 bool) { Params.FlushDeleteToL0 = toL0 }(Params.FlushDeleteToL0)
+	Params.FlushDeleteToL0 = false
+	msg := &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:        &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			ChannelID:   chanName,
+			Timestamps:  []uint64{100, 101},
+			PrimaryKeys: []int64{1, 2},
+		},
+	}
+	dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, 0, 0, nil, nil)})
+	assert.Equal(t, int64(2), dn.delBufSize)
+	assert.Empty(t, saved)
+
+	// the buffer reaches the limit, the deletes of segment 2 are kept as its save fails
+	msg.Timestamps, msg.PrimaryKeys = []uint64{102}, []int64{3}
+	dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, 0, 0, nil, nil)})
+	assert.Equal(t, 1, len(saved))
+	assert.Equal(t, UniqueID(1), saved[0].segID)
+	assert.Equal(t, 1, len(saved[0].deltalogs))
+	deltalog := saved[0].deltalogs[0]
+	assert.Equal(t, int64(2), deltalog.GetRecordEntries())
+	assert.Equal(t, uint64(100), deltalog.GetTimestampFrom())
+	assert.Equal(t, uint64(101), deltalog.GetTimestampTo())
+
+	value, err := kv.Load(deltalog.GetDeltaLogPath())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(value)), deltalog.GetDeltaLogSize())
+	_, segID, data, err := storage.NewDeleteCodec(1).Deserialize([]*storage.Blob{{Key: "1", Value: []byte(value)}})
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(1), segID)
+	assert.Equal(t, []int64{1, 2}, data.Pks)
+
+	assert.Equal(t, int64(1), dn.delBufSize)
+	assert.Equal(t, &delDataBuf{pks: []int64{3}, tss: []Timestamp{102}}, dn.delBuf[2])
+	keys, _, err := kv.LoadWithPrefix(Params.DeltaBinlogRootPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(keys))
+
+	saveErr = nil
+	dn.flushDelBuf()
+	assert.Equal(t, 2, len(saved))
+	assert.Equal(t, UniqueID(2), saved[1].segID)
+	assert.Zero(t, dn.delBufSize)
+	assert.Empty(t, dn.delBuf)
+}
+
+func TestFlowGraphDeleteNode_flushL0Buf(t *testing.T) {
+	chanName := "insert-02"
+	replica := newSegmentReplica(&RootCoordFactory{}, 1)
+
+	saved := make([]*segmentFlushUnit, 0)
+	saveErr := errors.New("mock save failure")
+	saveBinlog := func(fu *segmentFlushUnit) error {
+		if saveErr != nil {
+			return saveErr
+		}
+		saved = append(saved, fu)
+		return nil
+	}
+	kv := memkv.NewMemoryKV()
+	dn := newDeleteDNode(replica, NewAllocatorFactory(), kv, saveBinlog, chanName)
+
+	defer func(size int64) { Params.FlushDeleteBufferSize = size }(Params.FlushDeleteBufferSize)
+	Params.FlushDeleteBufferSize = 3
+	defer func(toL0 bool) { Params.FlushDeleteToL0 = toL0 }(Params.FlushDeleteToL0)
+	Params.FlushDeleteToL0 = true
+
+	startPos := &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{1}, Timestamp: 100}
+	endPos := &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{2}, Timestamp: 101}
+	msg := &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:        &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			ChannelID:   chanName,
+			Timestamps:  []uint64{100, 101},
+			PrimaryKeys: []int64{1, 2},
+		},
+	}
+	dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, 0, 101,
+		[]*internalpb.MsgPosition{startPos}, []*internalpb.MsgPosition{endPos})})
+	assert.Equal(t, int64(2), dn.delBufSize)
+	assert.Empty(t, dn.delBuf)
+	assert.Equal(t, &delDataBuf{pks: []int64{1, 2}, tss: []Timestamp{100, 101}}, dn.l0Buf)
+	assert.Equal(t, startPos, dn.l0StartPos)
+
+	// the buffer reaches the limit, the deletes are kept as the save fails
+	lastPos := &internalpb.MsgPosition{ChannelName: chanName, MsgID: []byte{3}, Timestamp: 102}
+	msg.Timestamps, msg.PrimaryKeys = []uint64{102}, []int64{3}
+	dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, 101, 102,
+		[]*internalpb.MsgPosition{endPos}, []*internalpb.MsgPosition{lastPos})})
+	assert.Empty(t, saved)
+	assert.Equal(t, int64(3), dn.delBufSize)
+	assert.Equal(t, startPos, dn.l0StartPos)
+	assert.Equal(t, lastPos, dn.l0EndPos)
+	keys, _, err := kv.LoadWithPrefix(Params.DeltaBinlogRootPath)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	saveErr = nil
+	dn.flushL0Buf()
+	assert.Equal(t, 1, len(saved))
+	fu := saved[0]
+	assert.Equal(t, datapb.SegmentLevel_L0, fu.level)
+	assert.True(t, fu.flushed)
+	assert.Equal(t, startPos, fu.startPositions[0].GetStartPosition())
+	assert.Equal(t, fu.segID, fu.startPositions[0].GetSegmentID())
+	assert.Equal(t, *lastPos, fu.checkPoint[fu.segID].pos)
+	assert.Equal(t, 1, len(fu.deltalogs))
+	assert.Equal(t, int64(3), fu.deltalogs[0].GetRecordEntries())
+	assert.Equal(t, uint64(102), fu.deltalogs[0].GetTimestampTo())
+
+	value, err := kv.Load(fu.deltalogs[0].GetDeltaLogPath())
+	assert.Nil(t, err)
+	partitionID, segID, data, err := storage.NewDeleteCodec(1).Deserialize([]*storage.Blob{{Key: "1", Value: []byte(value)}})
+	assert.Nil(t, err)
+	assert.Equal(t, allPartitionsID, partitionID)
+	assert.Equal(t, fu.segID, segID)
+	assert.Equal(t, []int64{1, 2, 3}, data.Pks)
+	assert.Zero(t, dn.delBufSize)
+	assert.Nil(t, dn.l0Buf)
+	assert.Nil(t, dn.l0StartPos)
+	assert.Nil(t, dn.l0EndPos)
+
+	t.Run("flush interval", func(t *testing.T) {
+		ts := tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+		msg.Timestamps, msg.PrimaryKeys = []uint64{ts}, []int64{4}
+		dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{msg}, ts, ts, nil, nil)})
+		assert.Equal(t, 1, len(saved))
+		assert.False(t, dn.needFlushL0(ts))
+
+		tick := tsoutil.ComposeTS(time.Now().Add(time.Duration(Params.FlushDeleteInterval)*time.Second).UnixNano()/int64(time.Millisecond), 0)
+		assert.True(t, dn.needFlushL0(tick))
+		dn.Operate([]Msg{flowgraph.GenerateMsgStreamMsg(nil, ts, tick, nil, nil)})
+		assert.Equal(t, 2, len(saved))
+		assert.Nil(t, dn.l0Buf)
+		assert.Nil(t, saved[1].startPositions)
+		assert.Nil(t, saved[1].checkPoint)
+	})
+}
@@ -29,7 +29,9 @@ func newDmInputNode(ctx context.Context, factory msgstream.Factory, pchannelName
 	insertStream.AsConsumer([]string{pchannelName}, consumeSubName)
 	log.Debug("datanode AsConsumer physical channel: " + pchannelName + " : " + consumeSubName)
 
-	if seekPos != nil {
+	// the checkpoints migrated from another message queue have no msg id,
+	// the channels are consumed from the earliest position instead
+	if seekPos != nil && len(seekPos.MsgID) != 0 {
 		// ChannelName in seek position is virtual channel name.
 		seekPos.ChannelName = pchannelName
 		log.Debug("datanode Seek: " + seekPos.GetChannelName())
@@ -38,5 +40,7 @@ func newDmInputNode(ctx context.Context, factory msgstream.Factory, pchannelName
 
 	var stream msgstream.MsgStream = insertStream
 	node := flowgraph.NewInputNode(&stream, "dmInputNode", maxQueueLength, maxParallelism)
+	// the flush requests of an idle channel wait for the next msg pack passed in skip mode
+	node.SetSkipMode(Params.FlowGraphSkipModeIdleTicks, Params.FlowGraphSkipModeInterval)
 	return node
 }
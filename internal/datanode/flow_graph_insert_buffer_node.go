@@ -28,7 +28,6 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
-	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
@@ -57,8 +56,11 @@ type insertBufferNode struct {
 	insertBuffer *insertBuffer
 	replica      Replica
 	idAllocator  allocatorInterface
-	flushMap     sync.Map
 	flushChan    <-chan *flushMsg
+	// bufferManager picks the segments to sync while the buffers of all the flowgraphs exceed the memory budget
+	bufferManager *writeBufferManager
+	// syncPool runs the syncs of the detached buffers, the syncs are run in the flowgraph if it is nil
+	syncPool *syncPool
 
 	minIOKV kv.BaseKV
 
@@ -76,12 +78,17 @@ type segmentCheckPoint struct {
 }
 
 type segmentFlushUnit struct {
-	collID         UniqueID
-	segID          UniqueID
-	field2Path     map[UniqueID]string
-	checkPoint     map[UniqueID]segmentCheckPoint
-	startPositions []*datapb.SegmentStartPosition
-	flushed        bool
+	collID          UniqueID
+	segID           UniqueID
+	field2Path      map[UniqueID]string
+	field2Stats     map[UniqueID]string // paths of the stats binlogs of the scalar fields
+	field2Size      map[UniqueID]int64  // bytes of the insert binlogs
+	field2StatsSize map[UniqueID]int64  // bytes of the stats binlogs
+	deltalogs       []*datapb.DeltaLogInfo
+	checkPoint      map[UniqueID]segmentCheckPoint
+	startPositions  []*datapb.SegmentStartPosition
+	flushed         bool
+	level           datapb.SegmentLevel // the L0 segments are created by their flush units
 }
 
 type insertBuffer struct {
@@ -131,7 +138,7 @@ func (ib *insertBuffer) size(segmentID UniqueID) int64 {
 }
 
 func (ib *insertBuffer) full(segmentID UniqueID) bool {
-	log.Debug("Segment size", zap.Any("segment", segmentID), zap.Int64("size", ib.size(segmentID)), zap.Int64("maxsize", ib.maxSize))
+	flowGraphLog().Debug("Segment size", zap.Any("segment", segmentID), zap.Int64("size", ib.size(segmentID)), zap.Int64("maxsize", ib.maxSize))
 	return ib.size(segmentID) >= ib.maxSize
 }
 
@@ -141,22 +148,23 @@ func (ibNode *insertBufferNode) Name() string {
 
 func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
-	// log.Debug("InsertBufferNode Operating")
+	// flowGraphLog().Debug("InsertBufferNode Operating")
 
 	if len(in) != 1 {
-		log.Error("Invalid operate message input in insertBufferNode", zap.Int("input length", len(in)))
+		flowGraphLog().Error("Invalid operate message input in insertBufferNode", zap.Int("input length", len(in)))
 		// TODO: add error handling
 	}
 
 	iMsg, ok := in[0].(*insertMsg)
 	if !ok {
-		log.Error("type assertion failed for insertMsg")
+		flowGraphLog().Error("type assertion failed for insertMsg")
 		// TODO: add error handling
 	}
 
 	if iMsg == nil {
 		ibNode.timeTickStream.Close()
 		ibNode.segmentStatisticsStream.Close()
+		ibNode.bufferManager.releaseChannel(ibNode.channelName)
 		return []Msg{}
 	}
 
@@ -181,6 +189,10 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		endPositions = append(endPositions, pos)
 	}
 
+	if Params.RejectDuplicatedPK {
+		iMsg.insertMessages = ibNode.rejectDuplicatedPKs(iMsg.insertMessages)
+	}
+
 	// Updating segment statistics
 	uniqueSeg := make(map[UniqueID]int64)
 	for _, msg := range iMsg.insertMessages {
@@ -193,7 +205,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			err := ibNode.replica.addNewSegment(currentSegID, collID, partitionID, msg.GetChannelID(),
 				startPositions[0], endPositions[0])
 			if err != nil {
-				log.Error("add segment wrong", zap.Error(err))
+				flowGraphLog().Error("add segment wrong", zap.Error(err))
 			}
 
 		}
@@ -208,14 +220,14 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		err := ibNode.replica.updateStatistics(id, num)
 		if err != nil {
-			log.Error("update Segment Row number wrong", zap.Error(err))
+			flowGraphLog().Error("update Segment Row number wrong", zap.Error(err))
 		}
 	}
 
 	if len(segToUpdate) > 0 {
 		err := ibNode.updateSegStatistics(segToUpdate)
 		if err != nil {
-			log.Error("update segment statistics error", zap.Error(err))
+			flowGraphLog().Error("update segment statistics error", zap.Error(err))
 		}
 	}
 
@@ -224,129 +236,108 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	for _, msg := range iMsg.insertMessages {
 		err := ibNode.bufferInsertMsg(iMsg, msg)
 		if err != nil {
-			log.Warn("msg to buffer failed", zap.Error(err))
+			flowGraphLog().Warn("msg to buffer failed", zap.Error(err))
 		}
 	}
 
 	if len(iMsg.insertMessages) > 0 {
-		log.Debug("---insert buffer status---")
+		flowGraphLog().Debug("---insert buffer status---")
 		var stopSign int = 0
 		for k := range ibNode.insertBuffer.insertData {
 			if stopSign >= 10 {
-				log.Debug("......")
+				flowGraphLog().Debug("......")
 				break
 			}
-			log.Debug("seg buffer status", zap.Int64("segmentID", k), zap.Int64("buffer size", ibNode.insertBuffer.size(k)))
+			flowGraphLog().Debug("seg buffer status", zap.Int64("segmentID", k), zap.Int64("buffer size", ibNode.insertBuffer.size(k)))
 			stopSign++
 		}
 	}
 
-	finishCh := make(chan segmentFlushUnit, len(segToUpdate))
-	finishCnt := sync.WaitGroup{}
+	segsToFlush := make([]UniqueID, 0, len(segToUpdate))
+	fullSegs := make(map[UniqueID]bool)
 	for _, segToFlush := range segToUpdate {
 		// If full, auto flush
 		if ibNode.insertBuffer.full(segToFlush) {
-			log.Debug(". Insert Buffer full, auto flushing ",
+			flowGraphLog().Debug(". Insert Buffer full, auto flushing ",
 				zap.Int64("num of rows", ibNode.insertBuffer.size(segToFlush)))
-
-			collMeta, err := ibNode.getCollMetabySegID(segToFlush, iMsg.timeRange.timestampMax)
-			if err != nil {
-				log.Error("Auto flush failed .. cannot get collection meta ..", zap.Error(err))
-				continue
-			}
-
-			ibNode.flushMap.Store(segToFlush, ibNode.insertBuffer.insertData[segToFlush])
-			delete(ibNode.insertBuffer.insertData, segToFlush)
-
-			collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segToFlush)
-			if err != nil {
-				log.Error("Auto flush failed .. cannot get collection ID or partition ID..", zap.Error(err))
-				continue
-			}
-			finishCnt.Add(1)
-
-			go flushSegment(collMeta, segToFlush, partitionID, collID,
-				&ibNode.flushMap, ibNode.minIOKV, finishCh, &finishCnt, ibNode, ibNode.idAllocator)
+			segsToFlush = append(segsToFlush, segToFlush)
+			fullSegs[segToFlush] = true
+		}
+	}
+	// the largest buffers of all the flowgraphs are synced above the memory budget, even if they are not full
+	for _, segToSync := range ibNode.bufferManager.segmentsToSync(ibNode.channelName) {
+		if fullSegs[segToSync] {
+			continue
+		}
+		if _, ok := ibNode.insertBuffer.insertData[segToSync]; !ok {
+			ibNode.bufferManager.release(segToSync)
+			continue
 		}
+		flowGraphLog().Debug(". Write buffer above memory watermark, auto flushing ",
+			zap.Int64("segmentID", segToSync),
+			zap.Int64("num of rows", ibNode.insertBuffer.size(segToSync)))
+		segsToFlush = append(segsToFlush, segToSync)
 	}
-	finishCnt.Wait()
-	close(finishCh)
-	for fu := range finishCh {
-		if fu.field2Path == nil {
-			log.Debug("segment is empty")
+
+	for _, segToFlush := range segsToFlush {
+		collMeta, err := ibNode.getCollMetabySegID(segToFlush, iMsg.timeRange.timestampMax)
+		if err != nil {
+			flowGraphLog().Error("Auto flush failed .. cannot get collection meta ..", zap.Error(err))
 			continue
 		}
-		fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
-		fu.flushed = false
-		if err := ibNode.dsSaveBinlog(&fu); err != nil {
-			log.Debug("data service save bin log path failed", zap.Error(err))
+
+		collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segToFlush)
+		if err != nil {
+			flowGraphLog().Error("Auto flush failed .. cannot get collection ID or partition ID..", zap.Error(err))
+			continue
 		}
+
+		ibNode.submitSync(collMeta, segToFlush, partitionID, collID, false, nil)
 	}
 
 	// iMsg is Flush() msg from datacoord
 	select {
 	case fmsg := <-ibNode.flushChan:
 		currentSegID := fmsg.segmentID
-		log.Debug(". Receiving flush message",
+		flowGraphLog().Debug(". Receiving flush message",
 			zap.Int64("segmentID", currentSegID),
 			zap.Int64("collectionID", fmsg.collectionID),
 		)
 
 		if ibNode.insertBuffer.size(currentSegID) <= 0 {
-			log.Debug(".. Buffer empty ...")
-			ibNode.dsSaveBinlog(&segmentFlushUnit{
-				collID:     fmsg.collectionID,
-				segID:      currentSegID,
-				field2Path: map[UniqueID]string{},
-				checkPoint: ibNode.replica.listSegmentsCheckPoints(),
-				flushed:    true,
+			flowGraphLog().Debug(".. Buffer empty ...")
+			// the segment is flushed after its pending syncs
+			ibNode.syncPool.submit(currentSegID, func() {
+				ibNode.dsSaveBinlog(&segmentFlushUnit{
+					collID:     fmsg.collectionID,
+					segID:      currentSegID,
+					field2Path: map[UniqueID]string{},
+					checkPoint: ibNode.replica.listSegmentsCheckPoints(),
+					flushed:    true,
+				})
+				ibNode.replica.segmentFlushed(currentSegID)
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
 			})
-			ibNode.replica.segmentFlushed(currentSegID)
-			fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
 		} else { //insertBuffer(not empty) -> binLogs -> minIO/S3
-			log.Debug(".. Buffer not empty, flushing ..")
-			finishCh := make(chan segmentFlushUnit, 1)
-
-			ibNode.flushMap.Store(currentSegID, ibNode.insertBuffer.insertData[currentSegID])
-			delete(ibNode.insertBuffer.insertData, currentSegID)
-			clearFn := func() {
-				finishCh <- segmentFlushUnit{field2Path: nil}
-				log.Debug(".. Clearing flush Buffer ..")
-				ibNode.flushMap.Delete(currentSegID)
-				close(finishCh)
-				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: nil}}
-			}
+			flowGraphLog().Debug(".. Buffer not empty, flushing ..")
 
 			collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(currentSegID)
 			if err != nil {
-				log.Error("Flush failed .. cannot get segment ..", zap.Error(err))
-				clearFn()
+				flowGraphLog().Error("Flush failed .. cannot get segment ..", zap.Error(err))
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: nil}}
 				break
 				// TODO add error handling
 			}
 
 			collMeta, err := ibNode.getCollMetabySegID(currentSegID, iMsg.timeRange.timestampMax)
 			if err != nil {
-				log.Error("Flush failed .. cannot get collection schema ..", zap.Error(err))
-				clearFn()
+				flowGraphLog().Error("Flush failed .. cannot get collection schema ..", zap.Error(err))
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: nil}}
 				break
 				// TODO add error handling
 			}
 
-			flushSegment(collMeta, currentSegID, partitionID, collID,
-				&ibNode.flushMap, ibNode.minIOKV, finishCh, nil, ibNode, ibNode.idAllocator)
-			fu := <-finishCh
-			close(finishCh)
-			if fu.field2Path != nil {
-				fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
-				fu.flushed = true
-				if err := ibNode.dsSaveBinlog(&fu); err != nil {
-					log.Debug("Data service save binlog path failed", zap.Error(err))
-				} else {
-					ibNode.replica.segmentFlushed(fu.segID)
-				}
-			}
-			fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
+			ibNode.submitSync(collMeta, currentSegID, partitionID, collID, true, fmsg.dmlFlushedCh)
 		}
 
 	default:
@@ -354,14 +345,110 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	// TODO write timetick
 	if err := ibNode.writeHardTimeTick(iMsg.timeRange.timestampMax); err != nil {
-		log.Error("send hard time tick into pulsar channel failed", zap.Error(err))
+		flowGraphLog().Error("send hard time tick into pulsar channel failed", zap.Error(err))
 	}
 
 	for _, sp := range spans {
 		sp.Finish()
 	}
 
-	return nil
+	// the delete messages are buffered by the delete node after the inserts before them
+	deleteMessages := make([]msgstream.TsMsg, 0, len(iMsg.deleteMessages))
+	for _, msg := range iMsg.deleteMessages {
+		deleteMessages = append(deleteMessages, msg)
+	}
+	var res Msg = flowgraph.GenerateMsgStreamMsg(deleteMessages, iMsg.timeRange.timestampMin, iMsg.timeRange.timestampMax,
+		startPositions, endPositions)
+	return []Msg{res}
+}
+
+// rejectDuplicatedPKs drops the rows of the insert messages whose pks are in the growing segments or in the rows
+// before them, the messages left without any row are dropped
+func (ibNode *insertBufferNode) rejectDuplicatedPKs(msgs []*msgstream.InsertMsg) []*msgstream.InsertMsg {
+	pks := make([]int64, 0)
+	for _, msg := range msgs {
+		pks = append(pks, msg.GetRowIDs()...)
+	}
+	duplicated := ibNode.replica.findDuplicatedPKs(pks)
+
+	results := make([]*msgstream.InsertMsg, 0, len(msgs))
+	seen := make(map[int64]struct{}, len(pks))
+	numRejected := 0
+	for _, msg := range msgs {
+		numRows := len(msg.RowIDs)
+		if len(msg.Timestamps) != numRows || len(msg.RowData) != numRows {
+			// misaligned messages are left to bufferInsertMsg
+			results = append(results, msg)
+			continue
+		}
+		filterHashValues := len(msg.HashValues) == numRows
+
+		kept := 0
+		for i, pk := range msg.RowIDs {
+			_, inSegments := duplicated[pk]
+			_, inMsgs := seen[pk]
+			if inSegments || inMsgs {
+				continue
+			}
+			seen[pk] = struct{}{}
+			msg.RowIDs[kept] = msg.RowIDs[i]
+			msg.Timestamps[kept] = msg.Timestamps[i]
+			msg.RowData[kept] = msg.RowData[i]
+			if filterHashValues {
+				msg.HashValues[kept] = msg.HashValues[i]
+			}
+			kept++
+		}
+		numRejected += numRows - kept
+		if kept == 0 {
+			continue
+		}
+		msg.RowIDs = msg.RowIDs[:kept]
+		msg.Timestamps = msg.Timestamps[:kept]
+		msg.RowData = msg.RowData[:kept]
+		if filterHashValues {
+			msg.HashValues = msg.HashValues[:kept]
+		}
+		results = append(results, msg)
+	}
+	if numRejected > 0 {
+		flowGraphLog().Warn("reject the rows of duplicated primary keys",
+			zap.String("channel", ibNode.channelName), zap.Int("rows", numRejected))
+	}
+	return results
+}
+
+// submitSync detaches the buffer of the segment and syncs it in the sync pool after the pending syncs of the segment.
+// The checkpoint of the segment is taken at the detach, so that it never covers the rows buffered after the buffer,
+// and it is set once the binlogs are saved. flushedCh is notified after the sync if it is not nil
+func (ibNode *insertBufferNode) submitSync(collMeta *etcdpb.CollectionMeta, segID, partitionID, collID UniqueID,
+	flushed bool, flushedCh chan<- []*datapb.FieldBinlog) {
+
+	data := ibNode.insertBuffer.insertData[segID]
+	delete(ibNode.insertBuffer.insertData, segID)
+	ibNode.bufferManager.release(segID)
+	checkPoint := ibNode.replica.snapshotSegmentCheckPoint(segID)
+
+	ibNode.syncPool.submit(segID, func() {
+		binlogs := []string{}
+		fu, err := flushSegment(collMeta, segID, partitionID, collID, data, checkPoint, ibNode.minIOKV, ibNode, ibNode.idAllocator)
+		if err != nil {
+			flowGraphLog().Error("Flush failed ..", zap.Int64("segmentID", segID), zap.Error(err))
+			binlogs = nil
+		} else {
+			fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
+			fu.flushed = flushed
+			if err := ibNode.dsSaveBinlog(fu); err != nil {
+				flowGraphLog().Debug("Data service save binlog path failed", zap.Error(err))
+				binlogs = nil
+			} else if flushed {
+				ibNode.replica.segmentFlushed(fu.segID)
+			}
+		}
+		if flushedCh != nil {
+			flushedCh <- []*datapb.FieldBinlog{{FieldID: segID, Binlogs: binlogs}}
+		}
+	})
 }
 
 // bufferInsertMsg put InsertMsg into buffer
@@ -387,7 +474,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(iMsg *insertMsg, msg *msgstream.
 	collSchema, err := ibNode.replica.getCollectionSchema(collectionID, msg.EndTs())
 	if err != nil {
 		// GOOSE TODO add error handler
-		log.Error("Get schema wrong:", zap.Error(err))
+		flowGraphLog().Error("Get schema wrong:", zap.Error(err))
 		return err
 	}
 
@@ -408,13 +495,13 @@ func (ibNode *insertBufferNode) bufferInsertMsg(iMsg *insertMsg, msg *msgstream.
 				if t.Key == "dim" {
 					dim, err = strconv.Atoi(t.Value)
 					if err != nil {
-						log.Error("strconv wrong on get dim", zap.Error(err))
+						flowGraphLog().Error("strconv wrong on get dim", zap.Error(err))
 					}
 					break
 				}
 			}
 			if dim <= 0 {
-				log.Error("invalid dim")
+				flowGraphLog().Error("invalid dim")
 				continue
 				// TODO: add error handling
 			}
@@ -448,13 +535,13 @@ func (ibNode *insertBufferNode) bufferInsertMsg(iMsg *insertMsg, msg *msgstream.
 				if t.Key == "dim" {
 					dim, err = strconv.Atoi(t.Value)
 					if err != nil {
-						log.Error("strconv wrong")
+						flowGraphLog().Error("strconv wrong")
 					}
 					break
 				}
 			}
 			if dim <= 0 {
-				log.Error("invalid dim")
+				flowGraphLog().Error("invalid dim")
 				// TODO: add error handling
 			}
 
@@ -611,6 +698,11 @@ func (ibNode *insertBufferNode) bufferInsertMsg(iMsg *insertMsg, msg *msgstream.
 
 	// 1.3 store in buffer
 	ibNode.insertBuffer.insertData[currentSegID] = idata
+	bufferedSize := int64(len(msg.RowIDs)+len(msg.Timestamps)) * int64(unsafe.Sizeof(UniqueID(0)))
+	for _, blob := range msg.RowData {
+		bufferedSize += int64(len(blob.GetValue()))
+	}
+	ibNode.bufferManager.add(ibNode.channelName, currentSegID, bufferedSize)
 
 	// store current endPositions as Segment->EndPostion
 	endPositions := make([]*internalpb.MsgPosition, 0, len(iMsg.endPositions))
@@ -629,52 +721,35 @@ func readBinary(data []byte, receiver interface{}, dataType schemapb.DataType) {
 	buf := bytes.NewReader(data)
 	err := binary.Read(buf, binary.LittleEndian, receiver)
 	if err != nil {
-		log.Error("binary.Read failed", zap.Any("data type", dataType), zap.Error(err))
+		flowGraphLog().Error("binary.Read failed", zap.Any("data type", dataType), zap.Error(err))
 	}
 }
 
+// flushSegment serializes the detached buffer of the segment to the insert binlogs and the stats binlogs, saves them
+// to the object storage and sets the checkpoint of the segment to the position of the buffer
 func flushSegment(
 	collMeta *etcdpb.CollectionMeta,
 	segID, partitionID, collID UniqueID,
-	insertData *sync.Map,
+	data *InsertData,
+	checkPoint *segmentCheckPoint,
 	kv kv.BaseKV,
-	flushUnit chan<- segmentFlushUnit,
-	wgFinish *sync.WaitGroup,
 	ibNode *insertBufferNode,
-	idAllocator allocatorInterface) {
-
-	if wgFinish != nil {
-		defer wgFinish.Done()
-	}
+	idAllocator allocatorInterface) (*segmentFlushUnit, error) {
 
-	clearFn := func(isSuccess bool) {
-		if !isSuccess {
-			flushUnit <- segmentFlushUnit{field2Path: nil}
-		}
-
-		log.Debug(".. Clearing flush Buffer ..")
-		insertData.Delete(segID)
+	if data == nil {
+		return nil, fmt.Errorf("no buffer of segment %d", segID)
 	}
-
 	inCodec := storage.NewInsertCodec(collMeta)
 
 	// buffer data to binlogs
-	data, ok := insertData.Load(segID)
-	if !ok {
-		log.Error("Flush failed ... cannot load insertData ..")
-		clearFn(false)
-		return
-	}
-
-	binLogs, statsBinlogs, err := inCodec.Serialize(partitionID, segID, data.(*InsertData))
+	binLogs, statsBinlogs, err := inCodec.Serialize(partitionID, segID, data)
 	if err != nil {
-		log.Error("Flush failed ... cannot generate binlog ..", zap.Error(err))
-		clearFn(false)
-		return
+		return nil, fmt.Errorf("cannot generate binlog: %w", err)
 	}
 
-	log.Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)))
+	flowGraphLog().Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)))
 	field2Path := make(map[UniqueID]string, len(binLogs))
+	field2Size := make(map[UniqueID]int64, len(binLogs))
 	kvs := make(map[string]string, len(binLogs))
 	paths := make([]string, 0, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
@@ -683,17 +758,13 @@ func flushSegment(
 	for _, blob := range binLogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
-			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
-			clearFn(false)
-			return
+			return nil, fmt.Errorf("cannot parse string to fieldID: %w", err)
 		}
-		log.Debug("save binlog", zap.Int64("fieldID", fieldID))
+		flowGraphLog().Debug("save binlog", zap.Int64("fieldID", fieldID))
 
 		logidx, err := idAllocator.allocID()
 		if err != nil {
-			log.Error("Flush failed ... cannot alloc ID ..", zap.Error(err))
-			clearFn(false)
-			return
+			return nil, fmt.Errorf("cannot alloc ID: %w", err)
 		}
 
 		// no error raise if alloc=false
@@ -703,16 +774,17 @@ func flushSegment(
 		paths = append(paths, key)
 		kvs[key] = string(blob.Value[:])
 		field2Path[fieldID] = key
+		field2Size[fieldID] = int64(len(blob.Value))
 		field2Logidx[fieldID] = logidx
 	}
 
 	// write stats binlog
+	field2Stats := make(map[UniqueID]string, len(statsBinlogs))
+	field2StatsSize := make(map[UniqueID]int64, len(statsBinlogs))
 	for _, blob := range statsBinlogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
-			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
-			clearFn(false)
-			return
+			return nil, fmt.Errorf("cannot parse string to fieldID: %w", err)
 		}
 
 		logidx := field2Logidx[fieldID]
@@ -721,22 +793,25 @@ func flushSegment(
 		k, _ := idAllocator.genKey(false, collID, partitionID, segID, fieldID, logidx)
 
 		key := path.Join(Params.StatsBinlogRootPath, k)
+		paths = append(paths, key)
 		kvs[key] = string(blob.Value[:])
+		field2Stats[fieldID] = key
+		field2StatsSize[fieldID] = int64(len(blob.Value))
 	}
-	log.Debug("save binlog file to MinIO/S3")
+	flowGraphLog().Debug("save binlog file to MinIO/S3")
 
 	err = kv.MultiSave(kvs)
 	if err != nil {
-		log.Error("Flush failed ... cannot save to MinIO ..", zap.Error(err))
 		_ = kv.MultiRemove(paths)
-		clearFn(false)
-		return
+		return nil, fmt.Errorf("cannot save to MinIO: %w", err)
 	}
 
-	ibNode.replica.updateSegmentCheckPoint(segID)
+	if checkPoint != nil {
+		ibNode.replica.setSegmentCheckPoint(segID, *checkPoint)
+	}
 	startPos := ibNode.replica.listNewSegmentsStartPositions()
-	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, startPositions: startPos}
-	clearFn(true)
+	return &segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, field2Stats: field2Stats,
+		field2Size: field2Size, field2StatsSize: field2StatsSize, startPositions: startPos}, nil
 }
 
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
@@ -762,16 +837,16 @@ func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
 }
 
 func (ibNode *insertBufferNode) updateSegStatistics(segIDs []UniqueID) error {
-	log.Debug("Updating segments statistics...")
+	flowGraphLog().Debug("Updating segments statistics...")
 	statsUpdates := make([]*internalpb.SegmentStatisticsUpdates, 0, len(segIDs))
 	for _, segID := range segIDs {
 		updates, err := ibNode.replica.getSegmentStatisticsUpdates(segID)
 		if err != nil {
-			log.Error("get segment statistics updates wrong", zap.Int64("segmentID", segID), zap.Error(err))
+			flowGraphLog().Error("get segment statistics updates wrong", zap.Int64("segmentID", segID), zap.Error(err))
 			continue
 		}
 
-		log.Debug("Segment Statistics to Update",
+		flowGraphLog().Debug("Segment Statistics to Update",
 			zap.Int64("Segment ID", updates.GetSegmentID()),
 			zap.Int64("NumOfRows", updates.GetNumRows()),
 		)
@@ -868,7 +943,7 @@ func newInsertBufferNode(
 		return nil, err
 	}
 	wTt.AsProducer([]string{Params.TimeTickChannelName})
-	log.Debug("datanode AsProducer", zap.String("TimeTickChannelName", Params.TimeTickChannelName))
+	flowGraphLog().Debug("datanode AsProducer", zap.String("TimeTickChannelName", Params.TimeTickChannelName))
 	var wTtMsgStream msgstream.MsgStream = wTt
 	wTtMsgStream.Start()
 
@@ -878,7 +953,7 @@ func newInsertBufferNode(
 		return nil, err
 	}
 	segS.AsProducer([]string{Params.SegmentStatisticsChannelName})
-	log.Debug("datanode AsProducer", zap.String("SegmentStatisChannelName", Params.SegmentStatisticsChannelName))
+	flowGraphLog().Debug("datanode AsProducer", zap.String("SegmentStatisChannelName", Params.SegmentStatisticsChannelName))
 	var segStatisticsMsgStream msgstream.MsgStream = segS
 	segStatisticsMsgStream.Start()
 
@@ -892,7 +967,6 @@ func newInsertBufferNode(
 		segmentStatisticsStream: segStatisticsMsgStream,
 
 		replica:            replica,
-		flushMap:           sync.Map{},
 		flushChan:          flushCh,
 		idAllocator:        idAllocator,
 		dsSaveBinlog:       saveBinlog,
@@ -17,7 +17,6 @@ import (
 	"fmt"
 	"math"
 	"path"
-	"sync"
 	"testing"
 	"time"
 
@@ -212,7 +211,6 @@ func TestFlushSegment(t *testing.T) {
 		segmentID, partitionID, collectionID)
 
 	collMeta := genCollectionMeta(collectionID, "test_flush_segment_txn")
-	flushMap := sync.Map{}
 	mockRootCoord := &RootCoordFactory{}
 
 	replica := newReplica(mockRootCoord, collMeta.ID)
@@ -221,8 +219,6 @@ func TestFlushSegment(t *testing.T) {
 	require.NoError(t, err)
 	replica.updateSegmentEndPosition(segmentID, &internalpb.MsgPosition{ChannelName: "TestChannel"})
 
-	finishCh := make(chan segmentFlushUnit, 1)
-
 	insertData := &InsertData{
 		Data: make(map[storage.FieldID]storage.FieldData),
 	}
@@ -238,7 +234,6 @@ func TestFlushSegment(t *testing.T) {
 		NumRows: []int64{10},
 		Data:    make([]float32, 10),
 	}
-	flushMap.Store(segmentID, insertData)
 
 	msFactory := msgstream.NewPmsFactory()
 	m := map[string]interface{}{
@@ -254,26 +249,40 @@ func TestFlushSegment(t *testing.T) {
 	ibNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
 	require.NoError(t, err)
 
-	flushSegment(collMeta,
+	checkPoint := &segmentCheckPoint{numRows: 10, pos: internalpb.MsgPosition{ChannelName: "TestChannel"}}
+	fu, err := flushSegment(collMeta,
 		segmentID,
 		partitionID,
 		collectionID,
-		&flushMap,
+		insertData,
+		checkPoint,
 		mockMinIO,
-		finishCh,
-		nil,
 		ibNode,
 		idAllocMock)
-
-	fu := <-finishCh
+	require.NoError(t, err)
 	assert.NotNil(t, fu.field2Path)
 	assert.Equal(t, fu.segID, segmentID)
+	assert.Equal(t, int64(10), replica.listSegmentsCheckPoints()[segmentID].numRows)
+
+	_, err = flushSegment(collMeta, segmentID, partitionID, collectionID, nil, nil, mockMinIO, ibNode, idAllocMock)
+	assert.Error(t, err)
 
 	k, _ := idAllocMock.genKey(false, collectionID, partitionID, segmentID, 0)
 	key := path.Join(Params.StatsBinlogRootPath, k)
 	_, values, _ := mockMinIO.LoadWithPrefix(key)
 	assert.Equal(t, len(values), 1)
-	assert.Equal(t, values[0], `{"max":9,"min":0}`)
+	statsReader := &storage.StatsReader{}
+	statsReader.SetBuffer([]byte(values[0]))
+	assert.Equal(t, storage.Int64Stats{Max: 9, Min: 0}, statsReader.GetInt64Stats())
+	stats, err := statsReader.GetFieldStats()
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), stats.NumRows)
+	assert.Equal(t, int64(10), stats.NDV)
+	assert.Contains(t, fu.field2Stats[0], key)
+	assert.EqualValues(t, len(values[0]), fu.field2StatsSize[0])
+	for fieldID := range fu.field2Path {
+		assert.Greater(t, fu.field2Size[fieldID], int64(0))
+	}
 }
 
 func genCollectionMeta(collectionID UniqueID, collectionName string) *etcdpb.CollectionMeta {
@@ -696,3 +705,36 @@ func TestInsertBufferNode_bufferInsertMsg(t *testing.T) {
 		assert.NotNil(t, err)
 	}
 }
+
+func TestInsertBufferNode_rejectDuplicatedPKs(t *testing.T) {
+	Params.PKExactIndex = true
+	defer func() { Params.PKExactIndex = false }()
+
+	chanName := "insert-02"
+	pos := &internalpb.MsgPosition{ChannelName: chanName}
+	replica := newSegmentReplica(&RootCoordFactory{}, 1)
+	err := replica.addNewSegment(1, 1, 2, chanName, pos, pos)
+	require.Nil(t, err)
+	replica.updateSegmentPKRange(1, []int64{1, 2})
+
+	newMsg := func(pks ...int64) *msgstream.InsertMsg {
+		msg := &msgstream.InsertMsg{}
+		for _, pk := range pks {
+			msg.RowIDs = append(msg.RowIDs, pk)
+			msg.Timestamps = append(msg.Timestamps, Timestamp(pk))
+			msg.RowData = append(msg.RowData, &commonpb.Blob{Value: []byte{byte(pk)}})
+		}
+		return msg
+	}
+	misaligned := newMsg(2)
+	misaligned.Timestamps = nil
+
+	ibNode := &insertBufferNode{replica: replica, channelName: chanName}
+	msgs := ibNode.rejectDuplicatedPKs([]*msgstream.InsertMsg{newMsg(1, 3, 4), newMsg(2), newMsg(4, 5), misaligned})
+	require.Equal(t, 3, len(msgs))
+	assert.Equal(t, []int64{3, 4}, msgs[0].RowIDs)
+	assert.Equal(t, []Timestamp{3, 4}, msgs[0].Timestamps)
+	assert.Equal(t, []byte{3}, msgs[0].RowData[0].Value)
+	assert.Equal(t, []int64{5}, msgs[1].RowIDs)
+	assert.Equal(t, misaligned, msgs[2])
+}
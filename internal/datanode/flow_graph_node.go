@@ -11,7 +11,20 @@
 
 package datanode
 
-import "github.com/milvus-io/milvus/internal/util/flowgraph"
+import (
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+)
+
+// flowGraphLogModule is the log module of the dd and insert buffer nodes, whose level can be changed
+// apart from the global one
+const flowGraphLogModule = "datanode.flowgraph"
+
+func flowGraphLog() *zap.Logger {
+	return log.Module(flowGraphLogModule)
+}
 
 type (
 	Node      = flowgraph.Node
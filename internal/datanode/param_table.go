@@ -12,6 +12,7 @@
 package datanode
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
@@ -33,9 +34,36 @@ type ParamTable struct {
 	FlushInsertBufferSize   int64
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	DeltaBinlogRootPath     string
 	Log                     log.Config
 	Alias                   string // Different datanode in one machine
 
+	// the buffered deletes of all the segments of a flowgraph are saved as delta logs once they reach
+	// FlushDeleteBufferSize pks
+	FlushDeleteBufferSize int64
+	// the buffered deletes of a channel are saved as L0 segments if FlushDeleteToL0, at least once in
+	// FlushDeleteInterval seconds, instead of the delta logs of the segments picked by the bloom filters
+	FlushDeleteToL0     bool
+	FlushDeleteInterval int64
+
+	// the input node of an idle flowgraph passes one of FlowGraphSkipModeInterval empty msg packs after
+	// FlowGraphSkipModeIdleTicks empty msg packs in a row, skip mode is disabled if either is 0
+	FlowGraphSkipModeIdleTicks int32
+	FlowGraphSkipModeInterval  int32
+
+	// the buffered bytes of all the flowgraphs, the write buffers are unlimited if the high watermark is 0
+	FlushMemoryHighWatermark int64
+	FlushMemoryLowWatermark  int64
+	// the detached buffers are synced by FlushSyncWorkers at once, and the flowgraphs are blocked while
+	// FlushMaxPendingSyncs syncs are unfinished
+	FlushSyncWorkers     int
+	FlushMaxPendingSyncs int
+
+	// the growing segments keep the exact pks besides the bloom filters if PKExactIndex, and the inserted rows of
+	// the pks in the exact pks are dropped if RejectDuplicatedPK, which implies PKExactIndex
+	PKExactIndex       bool
+	RejectDuplicatedPK bool
+
 	// === DataNode External Components Configs ===
 	// --- Pulsar ---
 	PulsarAddress string
@@ -82,9 +110,16 @@ func (p *ParamTable) Init() {
 		// === DataNode Internal Components Configs ===
 		p.initFlowGraphMaxQueueLength()
 		p.initFlowGraphMaxParallelism()
+		p.initFlowGraphSkipMode()
 		p.initFlushInsertBufferSize()
+		p.initFlushDeleteBufferSize()
+		p.initFlushDeleteToL0()
+		p.initFlushMemoryWatermark()
+		p.initFlushSyncPool()
+		p.initPrimaryKey()
 		p.initInsertBinlogRootPath()
 		p.initStatsBinlogRootPath()
+		p.initDeltaBinlogRootPath()
 		p.initLogCfg()
 
 		// === DataNode External Components Configs ===
@@ -122,11 +157,129 @@ func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("dataNode.dataSync.flowGraph.maxParallelism")
 }
 
+func (p *ParamTable) initFlowGraphSkipMode() {
+	load := func(key string, defaultValue string) int32 {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.ParseInt(str, 10, 32)
+		if err != nil {
+			panic(err)
+		}
+		if v < 0 {
+			panic(fmt.Sprintf("dataNode must not be negative, got %d", key, v))
+		}
+		return int32(v)
+	}
+	p.FlowGraphSkipModeIdleTicks = load("dataNode.dataSync.flowGraph.skipMode.idleTicks", "50")
+	p.FlowGraphSkipModeInterval = load("dataNode.dataSync.flowGraph.skipMode.skipInterval", "5")
+}
+
 // ---- flush configs ----
 func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("datanode.flush.insertBufSize")
 }
 
+func (p *ParamTable) initFlushDeleteBufferSize() {
+	str, err := p.LoadWithDefault("dataNode.flush.deleteBufSize", "65536")
+	if err != nil {
+		panic(err)
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if v <= 0 {
+		panic(fmt.Sprintf("dataNode.flush.deleteBufSize must be positive, got %d", v))
+	}
+	p.FlushDeleteBufferSize = v
+}
+
+func (p *ParamTable) initFlushDeleteToL0() {
+	str, err := p.LoadWithDefault("dataNode.flush.deleteToL0", "true")
+	if err != nil {
+		panic(err)
+	}
+	p.FlushDeleteToL0, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+
+	str, err = p.LoadWithDefault("dataNode.flush.deleteInterval", "10")
+	if err != nil {
+		panic(err)
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if v <= 0 {
+		panic(fmt.Sprintf("dataNode.flush.deleteInterval must be positive, got %d", v))
+	}
+	p.FlushDeleteInterval = v
+}
+
+func (p *ParamTable) initFlushMemoryWatermark() {
+	load := func(key string, defaultValue string) int64 {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		if v < 0 {
+			panic(fmt.Sprintf("%s must not be negative, got %d", key, v))
+		}
+		return v
+	}
+	high := load("dataNode.flush.memoryHighWatermark", "2048")
+	low := load("dataNode.flush.memoryLowWatermark", "1024")
+	if low > high {
+		panic(fmt.Sprintf("dataNode.flush.memoryLowWatermark %d must not exceed dataNode.flush.memoryHighWatermark %d", low, high))
+	}
+	p.FlushMemoryHighWatermark = high * 1024 * 1024
+	p.FlushMemoryLowWatermark = low * 1024 * 1024
+}
+
+func (p *ParamTable) initFlushSyncPool() {
+	load := func(key string, defaultValue string) int {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.Atoi(str)
+		if err != nil {
+			panic(err)
+		}
+		if v <= 0 {
+			panic(fmt.Sprintf("%s must be positive, got %d", key, v))
+		}
+		return v
+	}
+	p.FlushSyncWorkers = load("dataNode.flush.syncWorkers", "16")
+	p.FlushMaxPendingSyncs = load("dataNode.flush.maxPendingSyncs", "256")
+}
+
+// ---- primary key configs ----
+func (p *ParamTable) initPrimaryKey() {
+	load := func(key string, defaultValue string) bool {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.ParseBool(str)
+		if err != nil {
+			panic(err)
+		}
+		return v
+	}
+	p.RejectDuplicatedPK = load("dataNode.primaryKey.rejectDuplicates", "false")
+	p.PKExactIndex = load("dataNode.primaryKey.exactIndex", "false") || p.RejectDuplicatedPK
+}
+
 func (p *ParamTable) initInsertBinlogRootPath() {
 	// GOOSE TODO: rootPath change to  TenentID
 	rootPath, err := p.Load("etcd.rootPath")
@@ -144,6 +297,14 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initDeltaBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeltaBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
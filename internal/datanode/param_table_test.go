@@ -14,6 +14,8 @@ package datanode
 import (
 	"log"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParamTable_DataNode(t *testing.T) {
@@ -39,11 +41,78 @@ func TestParamTable_DataNode(t *testing.T) {
 		log.Println("flowGraphMaxParallelism:", maxParallelism)
 	})
 
+	t.Run("Test flowGraphSkipMode", func(t *testing.T) {
+		assert.Equal(t, int32(50), Params.FlowGraphSkipModeIdleTicks)
+		assert.Equal(t, int32(5), Params.FlowGraphSkipModeInterval)
+
+		Params.Save("dataNode.dataSync.flowGraph.skipMode.idleTicks", "-1")
+		assert.Panics(t, func() { Params.initFlowGraphSkipMode() })
+		Params.Save("dataNode.dataSync.flowGraph.skipMode.idleTicks", "50")
+		Params.initFlowGraphSkipMode()
+	})
+
 	t.Run("Test FlushInsertBufSize", func(t *testing.T) {
 		size := Params.FlushInsertBufferSize
 		log.Println("FlushInsertBufferSize:", size)
 	})
 
+	t.Run("Test FlushDeleteBufSize", func(t *testing.T) {
+		assert.Equal(t, int64(65536), Params.FlushDeleteBufferSize)
+
+		Params.Save("dataNode.flush.deleteBufSize", "0")
+		assert.Panics(t, func() { Params.initFlushDeleteBufferSize() })
+		Params.Save("dataNode.flush.deleteBufSize", "65536")
+		Params.initFlushDeleteBufferSize()
+	})
+
+	t.Run("Test FlushDeleteToL0", func(t *testing.T) {
+		assert.True(t, Params.FlushDeleteToL0)
+		assert.Equal(t, int64(10), Params.FlushDeleteInterval)
+
+		Params.Save("dataNode.flush.deleteInterval", "0")
+		assert.Panics(t, func() { Params.initFlushDeleteToL0() })
+		Params.Save("dataNode.flush.deleteInterval", "10")
+		Params.Save("dataNode.flush.deleteToL0", "invalid")
+		assert.Panics(t, func() { Params.initFlushDeleteToL0() })
+		Params.Save("dataNode.flush.deleteToL0", "true")
+		Params.initFlushDeleteToL0()
+	})
+
+	t.Run("Test FlushMemoryWatermark", func(t *testing.T) {
+		assert.Equal(t, int64(2048*1024*1024), Params.FlushMemoryHighWatermark)
+		assert.Equal(t, int64(1024*1024*1024), Params.FlushMemoryLowWatermark)
+
+		Params.Save("dataNode.flush.memoryLowWatermark", "4096")
+		assert.Panics(t, func() { Params.initFlushMemoryWatermark() })
+		Params.Save("dataNode.flush.memoryLowWatermark", "1024")
+		Params.initFlushMemoryWatermark()
+	})
+
+	t.Run("Test PrimaryKey", func(t *testing.T) {
+		assert.False(t, Params.PKExactIndex)
+		assert.False(t, Params.RejectDuplicatedPK)
+
+		Params.Save("dataNode.primaryKey.rejectDuplicates", "true")
+		Params.initPrimaryKey()
+		assert.True(t, Params.PKExactIndex)
+		assert.True(t, Params.RejectDuplicatedPK)
+
+		Params.Save("dataNode.primaryKey.rejectDuplicates", "yes")
+		assert.Panics(t, func() { Params.initPrimaryKey() })
+		Params.Save("dataNode.primaryKey.rejectDuplicates", "false")
+		Params.initPrimaryKey()
+	})
+
+	t.Run("Test FlushSyncPool", func(t *testing.T) {
+		assert.Equal(t, 16, Params.FlushSyncWorkers)
+		assert.Equal(t, 256, Params.FlushMaxPendingSyncs)
+
+		Params.Save("dataNode.flush.syncWorkers", "0")
+		assert.Panics(t, func() { Params.initFlushSyncPool() })
+		Params.Save("dataNode.flush.syncWorkers", "16")
+		Params.initFlushSyncPool()
+	})
+
 	t.Run("Test InsertBinlogRootPath", func(t *testing.T) {
 		path := Params.InsertBinlogRootPath
 		log.Println("InsertBinlogRootPath:", path)
@@ -39,6 +39,7 @@ type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
+	getSegmentPartitionID(segID UniqueID) (UniqueID, error)
 
 	addNewSegment(segID, collID, partitionID UniqueID, channelName string, startPos, endPos *internalpb.MsgPosition) error
 	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error
@@ -46,7 +47,11 @@ type Replica interface {
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
+	snapshotSegmentCheckPoint(segID UniqueID) *segmentCheckPoint
+	setSegmentCheckPoint(segID UniqueID, cp segmentCheckPoint)
 	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	filterSegmentsByPKs(pks []int64) map[UniqueID][]int64
+	findDuplicatedPKs(pks []int64) map[int64]struct{}
 	hasSegment(segID UniqueID, countFlushed bool) bool
 
 	updateStatistics(segID UniqueID, numRows int64) error
@@ -73,6 +78,8 @@ type Segment struct {
 	// TODO silverxia, needs to change to interface to support `string` type PK
 	minPK int64 //	minimal pk value, shortcut for checking whether a pk is inside this segment
 	maxPK int64 //  maximal pk value, same above
+	// exact pks of a growing segment if Params.PKExactIndex, it is dropped once the segment is flushed
+	pkIndex map[int64]struct{}
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -94,6 +101,9 @@ func (s *Segment) updatePKRange(rowIDs []int64) {
 	for _, rowID := range rowIDs {
 		binary.BigEndian.PutUint64(buf, uint64(rowID))
 		s.pkFilter.Add(buf)
+		if s.pkIndex != nil {
+			s.pkIndex[rowID] = struct{}{}
+		}
 		if rowID > s.maxPK {
 			s.maxPK = rowID
 		}
@@ -148,6 +158,7 @@ func (replica *SegmentReplica) new2FlushedSegment(segID UniqueID) {
 
 	seg.isNew.Store(false)
 	seg.isFlushed.Store(true)
+	seg.pkIndex = nil
 	replica.flushedSegments[segID] = &seg
 
 	delete(replica.newSegments, segID)
@@ -157,6 +168,7 @@ func (replica *SegmentReplica) normal2FlushedSegment(segID UniqueID) {
 	var seg Segment = *replica.normalSegments[segID]
 
 	seg.isFlushed.Store(true)
+	seg.pkIndex = nil
 	replica.flushedSegments[segID] = &seg
 
 	delete(replica.normalSegments, segID)
@@ -177,6 +189,19 @@ func (replica *SegmentReplica) getCollectionAndPartitionID(segID UniqueID) (coll
 	return 0, 0, fmt.Errorf("Cannot find segment, id = %v", segID)
 }
 
+// getSegmentPartitionID gets the partition of the segment, from all the *New*, *Normal* and *Flushed* segments.
+func (replica *SegmentReplica) getSegmentPartitionID(segID UniqueID) (UniqueID, error) {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		if seg, ok := segments[segID]; ok {
+			return seg.partitionID, nil
+		}
+	}
+	return 0, fmt.Errorf("Cannot find segment, id = %v", segID)
+}
+
 // addNewSegment adds a *New* and *NotFlushed* new segment. Before add, please make sure there's no
 // such segment by `hasSegment`
 func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID, channelName string,
@@ -213,6 +238,9 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		minPK:    math.MaxInt64, // use max value, represents no value
 		maxPK:    math.MinInt64, // use min value represents no value
 	}
+	if Params.PKExactIndex {
+		seg.pkIndex = make(map[int64]struct{})
+	}
 
 	seg.isNew.Store(true)
 	seg.isFlushed.Store(false)
@@ -341,6 +369,57 @@ func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, rowIDs []int
 	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
 }
 
+// filterSegmentsByPKs gets the segments which may contain the pks by the bloom filters, from all the *New*,
+// *Normal* and *Flushed* segments.
+func (replica *SegmentReplica) filterSegmentsByPKs(pks []int64) map[UniqueID][]int64 {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	segments := make([]*Segment, 0, len(replica.newSegments)+len(replica.normalSegments)+len(replica.flushedSegments))
+	for _, seg := range replica.newSegments {
+		segments = append(segments, seg)
+	}
+	for _, seg := range replica.normalSegments {
+		segments = append(segments, seg)
+	}
+	for _, seg := range replica.flushedSegments {
+		segments = append(segments, seg)
+	}
+	results, err := getSegmentsByPKs(pks, segments)
+	if err != nil {
+		log.Warn("filter segments by pks failed", zap.Error(err))
+		return map[UniqueID][]int64{}
+	}
+	return results
+}
+
+// findDuplicatedPKs gets the pks which are in the exact pk indexes of the *New* and *Normal* segments. The segments
+// without the index, such as the segments recovered from the checkpoints, are not checked.
+func (replica *SegmentReplica) findDuplicatedPKs(pks []int64) map[int64]struct{} {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	results := make(map[int64]struct{})
+	find := func(segments map[UniqueID]*Segment) {
+		for _, seg := range segments {
+			if seg.pkIndex == nil {
+				continue
+			}
+			for _, pk := range pks {
+				if pk < seg.minPK || pk > seg.maxPK {
+					continue
+				}
+				if _, ok := seg.pkIndex[pk]; ok {
+					results[pk] = struct{}{}
+				}
+			}
+		}
+	}
+	find(replica.newSegments)
+	find(replica.normalSegments)
+	return results
+}
+
 func (replica *SegmentReplica) removeSegment(segID UniqueID) error {
 	return nil
 }
@@ -452,3 +531,39 @@ func (replica *SegmentReplica) updateSegmentCheckPoint(segID UniqueID) {
 
 	log.Warn("There's no segment", zap.Int64("ID", segID))
 }
+
+// snapshotSegmentCheckPoint returns the current position of the *New* or *Normal* segment, which becomes
+// its checkpoint by setSegmentCheckPoint once the buffer detached at the position is saved
+func (replica *SegmentReplica) snapshotSegmentCheckPoint(segID UniqueID) *segmentCheckPoint {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	if seg, ok := replica.newSegments[segID]; ok {
+		return &segmentCheckPoint{seg.numRows, *seg.endPos}
+	}
+
+	if seg, ok := replica.normalSegments[segID]; ok {
+		return &segmentCheckPoint{seg.numRows, *seg.endPos}
+	}
+
+	log.Warn("There's no segment", zap.Int64("ID", segID))
+	return nil
+}
+
+// setSegmentCheckPoint sets the checkpoint of the *New* or *Normal* segment.
+func (replica *SegmentReplica) setSegmentCheckPoint(segID UniqueID, cp segmentCheckPoint) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	if seg, ok := replica.newSegments[segID]; ok {
+		seg.checkPoint = cp
+		return
+	}
+
+	if seg, ok := replica.normalSegments[segID]; ok {
+		seg.checkPoint = cp
+		return
+	}
+
+	log.Warn("There's no segment", zap.Int64("ID", segID))
+}
@@ -117,6 +117,21 @@ func TestSegmentReplica_getCollectionAndPartitionID(te *testing.T) {
 	}
 }
 
+func TestSegmentReplica_getSegmentPartitionID(t *testing.T) {
+	sr := &SegmentReplica{
+		newSegments:     map[UniqueID]*Segment{1: {segmentID: 1, partitionID: 10}},
+		normalSegments:  map[UniqueID]*Segment{2: {segmentID: 2, partitionID: 20}},
+		flushedSegments: map[UniqueID]*Segment{3: {segmentID: 3, partitionID: 30}},
+	}
+	for segID, expected := range map[UniqueID]UniqueID{1: 10, 2: 20, 3: 30} {
+		partitionID, err := sr.getSegmentPartitionID(segID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, partitionID)
+	}
+	_, err := sr.getSegmentPartitionID(4)
+	assert.Error(t, err)
+}
+
 func TestSegmentReplica(t *testing.T) {
 	rc := &RootCoordFactory{}
 	collID := UniqueID(1)
@@ -559,6 +574,16 @@ func TestSegmentReplica_InterfaceMethod(te *testing.T) {
 		assert.Equal(t, int64(10), replica.normalSegments[UniqueID(0)].checkPoint.numRows)
 		replica.updateSegmentCheckPoint(1)
 		assert.Equal(t, int64(20), replica.normalSegments[UniqueID(1)].checkPoint.numRows)
+
+		// the checkpoint of a detached buffer does not cover the rows buffered after it
+		cp0 := replica.snapshotSegmentCheckPoint(0)
+		require.NotNil(t, cp0)
+		assert.Equal(t, int64(10), cp0.numRows)
+		err = replica.updateStatistics(0, 5)
+		assert.NoError(t, err)
+		replica.setSegmentCheckPoint(0, *cp0)
+		assert.Equal(t, int64(10), replica.normalSegments[UniqueID(0)].checkPoint.numRows)
+		assert.Nil(t, replica.snapshotSegmentCheckPoint(100))
 	})
 }
 
@@ -627,3 +652,43 @@ func TestReplica_UpdatePKRange(t *testing.T) {
 	}
 
 }
+
+func TestReplica_PKIndex(t *testing.T) {
+	Params.PKExactIndex = true
+	defer func() { Params.PKExactIndex = false }()
+
+	rc := &RootCoordFactory{}
+	collID := UniqueID(1)
+	partID := UniqueID(2)
+	chanName := "insert-02"
+	startPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(100)}
+	endPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(200)}
+	cp := &segmentCheckPoint{int64(10), internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(10)}}
+
+	replica := newSegmentReplica(rc, collID)
+	err := replica.addNewSegment(1, collID, partID, chanName, startPos, endPos)
+	assert.Nil(t, err)
+	err = replica.addNewSegment(2, collID, partID, chanName, startPos, endPos)
+	assert.Nil(t, err)
+	err = replica.addNormalSegment(3, collID, partID, chanName, 100, cp)
+	assert.Nil(t, err)
+
+	replica.updateSegmentPKRange(1, []int64{1, 2, 3})
+	replica.updateSegmentPKRange(2, []int64{10, 11})
+	replica.updateSegmentPKRange(3, []int64{20})
+
+	segIDToPKs := replica.filterSegmentsByPKs([]int64{2, 11, 20, 30})
+	assert.Equal(t, map[UniqueID][]int64{1: {2}, 2: {11}, 3: {20}}, segIDToPKs)
+
+	// the recovered normal segment has no exact pks
+	duplicated := replica.findDuplicatedPKs([]int64{2, 11, 20, 30})
+	assert.Equal(t, map[int64]struct{}{2: {}, 11: {}}, duplicated)
+
+	// the exact pks are dropped once flushed, the bloom filter is kept
+	replica.segmentFlushed(1)
+	assert.Nil(t, replica.flushedSegments[1].pkIndex)
+	duplicated = replica.findDuplicatedPKs([]int64{2, 11})
+	assert.Equal(t, map[int64]struct{}{11: {}}, duplicated)
+	segIDToPKs = replica.filterSegmentsByPKs([]int64{2})
+	assert.Equal(t, map[UniqueID][]int64{1: {2}}, segIDToPKs)
+}
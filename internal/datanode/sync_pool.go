@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+)
+
+// syncPool runs the syncs of the detached buffers of all the flowgraphs in a bounded num of workers, so that a slow
+// upload does not hold back the flowgraph. The syncs of a segment run one by one in the order they are submitted,
+// which keeps the binlogs and the checkpoints of a segment in order, and the syncs of the segments run in parallel.
+// The flowgraph submitting a sync is blocked while maxPending syncs are unfinished, which bounds the detached buffers
+type syncPool struct {
+	workers chan struct{}
+	pending chan struct{}
+
+	mu     sync.Mutex
+	queues map[UniqueID][]func() // segment id to its syncs, the segment has a running goroutine if present
+	wg     sync.WaitGroup
+}
+
+func newSyncPool(workers int, maxPending int) *syncPool {
+	return &syncPool{
+		workers: make(chan struct{}, workers),
+		pending: make(chan struct{}, maxPending),
+		queues:  make(map[UniqueID][]func()),
+	}
+}
+
+// submit runs the sync of the segment after the submitted syncs of the segment, the sync is run at once if the pool
+// is nil
+func (p *syncPool) submit(segmentID UniqueID, task func()) {
+	if p == nil {
+		task()
+		return
+	}
+	p.pending <- struct{}{}
+	p.wg.Add(1)
+
+	p.mu.Lock()
+	queue, running := p.queues[segmentID]
+	p.queues[segmentID] = append(queue, task)
+	p.mu.Unlock()
+	if !running {
+		go p.run(segmentID)
+	}
+}
+
+func (p *syncPool) run(segmentID UniqueID) {
+	for {
+		p.mu.Lock()
+		queue := p.queues[segmentID]
+		if len(queue) == 0 {
+			delete(p.queues, segmentID)
+			p.mu.Unlock()
+			return
+		}
+		task := queue[0]
+		p.queues[segmentID] = queue[1:]
+		p.mu.Unlock()
+
+		p.workers <- struct{}{}
+		task()
+		<-p.workers
+		<-p.pending
+		p.wg.Done()
+	}
+}
+
+// wait blocks until the submitted syncs are finished
+func (p *syncPool) wait() {
+	if p == nil {
+		return
+	}
+	p.wg.Wait()
+}
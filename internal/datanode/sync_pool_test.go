@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncPool(t *testing.T) {
+	t.Run("nil pool", func(t *testing.T) {
+		var p *syncPool
+		done := false
+		p.submit(1, func() { done = true })
+		p.wait()
+		assert.True(t, done)
+	})
+
+	t.Run("in order per segment", func(t *testing.T) {
+		p := newSyncPool(4, 16)
+		var mu sync.Mutex
+		order := make(map[UniqueID][]int)
+		for i := 0; i < 10; i++ {
+			for _, segID := range []UniqueID{1, 2, 3} {
+				i, segID := i, segID
+				p.submit(segID, func() {
+					time.Sleep(time.Millisecond)
+					mu.Lock()
+					order[segID] = append(order[segID], i)
+					mu.Unlock()
+				})
+			}
+		}
+		p.wait()
+		for _, segID := range []UniqueID{1, 2, 3} {
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, order[segID])
+		}
+	})
+
+	t.Run("bounded workers", func(t *testing.T) {
+		p := newSyncPool(2, 16)
+		var running, maxRunning int32
+		for segID := UniqueID(0); segID < 8; segID++ {
+			p.submit(segID, func() {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			})
+		}
+		p.wait()
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+	})
+
+	t.Run("bounded pending", func(t *testing.T) {
+		p := newSyncPool(1, 1)
+		release := make(chan struct{})
+		p.submit(1, func() { <-release })
+
+		submitted := make(chan struct{})
+		go func() {
+			p.submit(2, func() {})
+			close(submitted)
+		}()
+		select {
+		case <-submitted:
+			t.Fatal("submit is not blocked by the pending sync")
+		case <-time.After(20 * time.Millisecond):
+		}
+		close(release)
+		<-submitted
+		p.wait()
+	})
+}
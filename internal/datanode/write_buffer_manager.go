@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+type writeBuffer struct {
+	channel string
+	size    int64
+	syncing bool
+}
+
+// writeBufferManager tracks the buffered bytes of the segments of all the flowgraphs of the data node. While the
+// total exceeds the high watermark, the segments of the largest buffers are picked to sync until the rest is below
+// the low watermark, and each flowgraph syncs the picked segments of its channel on its next message
+type writeBufferManager struct {
+	mu            sync.Mutex
+	highWatermark int64 // bytes, unlimited if it is 0
+	lowWatermark  int64
+	buffers       map[UniqueID]*writeBuffer // segment id to its buffer
+	total         int64
+}
+
+func newWriteBufferManager(highWatermark int64, lowWatermark int64) *writeBufferManager {
+	return &writeBufferManager{
+		highWatermark: highWatermark,
+		lowWatermark:  lowWatermark,
+		buffers:       make(map[UniqueID]*writeBuffer),
+	}
+}
+
+// add records size more buffered bytes of the segment on the channel
+func (m *writeBufferManager) add(channel string, segmentID UniqueID, size int64) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	buffer, ok := m.buffers[segmentID]
+	if !ok {
+		buffer = &writeBuffer{channel: channel}
+		m.buffers[segmentID] = buffer
+	}
+	buffer.size += size
+	m.total += size
+	metrics.DataNodeWriteBufferSize.Set(float64(m.total))
+}
+
+// release drops the buffer of the segment once it is moved out of the insert buffer to flush
+func (m *writeBufferManager) release(segmentID UniqueID) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.releaseLocked(segmentID)
+	metrics.DataNodeWriteBufferSize.Set(float64(m.total))
+}
+
+// releaseChannel drops the buffers of the closed flowgraph of the channel
+func (m *writeBufferManager) releaseChannel(channel string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for segmentID, buffer := range m.buffers {
+		if buffer.channel == channel {
+			m.releaseLocked(segmentID)
+		}
+	}
+	metrics.DataNodeWriteBufferSize.Set(float64(m.total))
+}
+
+func (m *writeBufferManager) releaseLocked(segmentID UniqueID) {
+	if buffer, ok := m.buffers[segmentID]; ok {
+		m.total -= buffer.size
+		delete(m.buffers, segmentID)
+	}
+}
+
+// bufferedBytes returns the buffered bytes of all the flowgraphs
+func (m *writeBufferManager) bufferedBytes() int64 {
+	if m == nil {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.total
+}
+
+// segmentsToSync returns the segments of the channel picked to sync, the segments are picked once the buffered
+// bytes exceed the high watermark, and stay picked until they are released
+func (m *writeBufferManager) segmentsToSync(channel string) []UniqueID {
+	if m == nil || m.highWatermark <= 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.total > m.highWatermark {
+		rest := m.total
+		candidates := make([]UniqueID, 0, len(m.buffers))
+		for segmentID, buffer := range m.buffers {
+			if buffer.syncing {
+				rest -= buffer.size
+			} else {
+				candidates = append(candidates, segmentID)
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			return m.buffers[candidates[i]].size > m.buffers[candidates[j]].size
+		})
+		for _, segmentID := range candidates {
+			if rest <= m.lowWatermark {
+				break
+			}
+			buffer := m.buffers[segmentID]
+			buffer.syncing = true
+			rest -= buffer.size
+			metrics.DataNodeWriteBufferSyncCounter.Inc()
+			log.Debug("write buffer exceeds the high watermark, sync segment",
+				zap.Int64("segmentID", segmentID),
+				zap.String("channel", buffer.channel),
+				zap.Int64("size", buffer.size),
+				zap.Int64("total", m.total),
+				zap.Int64("highWatermark", m.highWatermark))
+		}
+	}
+
+	segmentIDs := make([]UniqueID, 0)
+	for segmentID, buffer := range m.buffers {
+		if buffer.syncing && buffer.channel == channel {
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+	}
+	return segmentIDs
+}
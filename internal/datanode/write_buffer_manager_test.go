@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sortedSegments(segmentIDs []UniqueID) []UniqueID {
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	return segmentIDs
+}
+
+func TestWriteBufferManager(t *testing.T) {
+	m := newWriteBufferManager(100, 50)
+	m.add("ch1", 1, 30)
+	m.add("ch1", 2, 20)
+	m.add("ch2", 3, 40)
+	assert.Equal(t, int64(90), m.bufferedBytes())
+	assert.Equal(t, 0, len(m.segmentsToSync("ch1")))
+
+	// above the high watermark, the largest buffers are picked until the rest is below the low watermark
+	m.add("ch2", 3, 20)
+	assert.Equal(t, int64(110), m.bufferedBytes())
+	assert.Equal(t, 0, len(m.segmentsToSync("ch1")))
+	assert.Equal(t, []UniqueID{3}, m.segmentsToSync("ch2"))
+
+	// the picked segments stay picked until released
+	m.add("ch1", 1, 40)
+	assert.Equal(t, []UniqueID{1}, m.segmentsToSync("ch1"))
+	assert.Equal(t, []UniqueID{3}, m.segmentsToSync("ch2"))
+
+	m.release(3)
+	m.release(1)
+	assert.Equal(t, int64(20), m.bufferedBytes())
+	assert.Equal(t, 0, len(m.segmentsToSync("ch1")))
+	assert.Equal(t, 0, len(m.segmentsToSync("ch2")))
+
+	m.add("ch2", 4, 100)
+	m.releaseChannel("ch2")
+	assert.Equal(t, int64(20), m.bufferedBytes())
+	m.release(100)
+	assert.Equal(t, int64(20), m.bufferedBytes())
+}
+
+func TestWriteBufferManager_LowWatermark(t *testing.T) {
+	m := newWriteBufferManager(100, 0)
+	m.add("ch1", 1, 60)
+	m.add("ch1", 2, 50)
+	assert.Equal(t, []UniqueID{1, 2}, sortedSegments(m.segmentsToSync("ch1")))
+}
+
+func TestWriteBufferManager_Unlimited(t *testing.T) {
+	m := newWriteBufferManager(0, 0)
+	m.add("ch1", 1, 1<<40)
+	assert.Equal(t, 0, len(m.segmentsToSync("ch1")))
+
+	var nilManager *writeBufferManager
+	nilManager.add("ch1", 1, 10)
+	nilManager.release(1)
+	nilManager.releaseChannel("ch1")
+	assert.Equal(t, int64(0), nilManager.bufferedBytes())
+	assert.Equal(t, 0, len(nilManager.segmentsToSync("ch1")))
+}
@@ -9,6 +9,7 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"github.com/milvus-io/milvus/internal/distributed/grpcconfigs"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -361,6 +362,13 @@ func (bct *buildClientTask) Run() {
 			conn, err := grpc.DialContext(bct.ctx, bct.sess.Address,
 				grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
 				grpc.WithDisableRetry(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(grpcconfigs.DefaultClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(grpcconfigs.DefaultClientMaxSendSize)),
+				grpcconfigs.ClientConfig{
+					KeepaliveTime:    grpcconfigs.DefaultClientKeepaliveTime,
+					KeepaliveTimeout: grpcconfigs.DefaultClientKeepaliveTimeout,
+				}.KeepaliveDialOption(),
 				grpc.WithUnaryInterceptor(
 					grpc_middleware.ChainUnaryClient(
 						grpc_retry.UnaryClientInterceptor(
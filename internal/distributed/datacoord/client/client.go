@@ -13,22 +13,17 @@ package grpcdatacoordclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
-	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -39,26 +34,11 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	grpcClient datapb.DataCoordClient
-	conn       *grpc.ClientConn
+	// grpcClients are the clients of the connections of the pool
+	grpcClients []datapb.DataCoordClient
+	pool        *grpcclient.Pool
 
 	sess *sessionutil.Session
-	addr string
-}
-
-func getDataCoordAddress(sess *sessionutil.Session) (string, error) {
-	key := typeutil.DataCoordRole
-	msess, _, err := sess.GetSessions(key)
-	if err != nil {
-		log.Debug("DataCoordClient, getSessions failed", zap.Any("key", key), zap.Error(err))
-		return "", err
-	}
-	ms, ok := msess[key]
-	if !ok {
-		log.Debug("DataCoordClient, not existed in msess ", zap.Any("key", key), zap.Any("len of msess", len(msess)))
-		return "", fmt.Errorf("number of datacoord is incorrect, %d", len(msess))
-	}
-	return ms.Address, nil
 }
 
 func NewClient(ctx context.Context, metaRoot string, etcdEndpoints []string) (*Client, error) {
@@ -84,40 +64,24 @@ func (c *Client) Init() error {
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
 	connectDataCoordFn := func() error {
-		c.addr, err = getDataCoordAddress(c.sess)
-		if err != nil {
-			log.Debug("DataCoordClient getDataCoordAddr failed", zap.Error(err))
-			return err
-		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("DataCoordClient try reconnect ", zap.String("address", c.addr))
+		log.Debug("DataCoordClient try reconnect ", zap.String("role", typeutil.DataCoordRole))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		pool, err := grpcclient.DialPool(ctx, &grpcclient.Config{
+			Role:     typeutil.DataCoordRole,
+			Sessions: c.sess,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		}, Params.GrpcClientConfig.PoolSize)
 		if err != nil {
 			return err
 		}
-		c.conn = conn
+		c.pool = pool
 		return nil
 	}
 
@@ -126,25 +90,11 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		log.Debug("DataCoord try reconnect failed", zap.Error(err))
 		return err
 	}
-	c.grpcClient = datapb.NewDataCoordClient(c.conn)
-	return nil
-}
-
-func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
-	}
-	log.Debug("DataCoord Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to datacoord failed with error:\n" + err.Error())
-	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
+	c.grpcClients = make([]datapb.DataCoordClient, 0, c.pool.Size())
+	for i := 0; i < c.pool.Size(); i++ {
+		c.grpcClients = append(c.grpcClients, datapb.NewDataCoordClient(c.pool.Conn(i)))
 	}
-	return ret, err
+	return nil
 }
 
 func (c *Client) Start() error {
@@ -153,7 +103,12 @@ func (c *Client) Start() error {
 
 func (c *Client) Stop() error {
 	c.cancel()
-	return c.conn.Close()
+	return c.pool.Close()
+}
+
+// getGrpcClient returns the client of the next healthy connection of the pool
+func (c *Client) getGrpcClient() datapb.DataCoordClient {
+	return c.grpcClients[c.pool.Pick()]
 }
 
 // Register dumy
@@ -162,103 +117,89 @@ func (c *Client) Register() error {
 }
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 func (c *Client) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.Flush(ctx, req)
-	})
-	return ret.(*datapb.FlushResponse), err
+	return c.getGrpcClient().Flush(ctx, req)
 }
 
 func (c *Client) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AssignSegmentID(ctx, req)
-	})
-	return ret.(*datapb.AssignSegmentIDResponse), err
+	return c.getGrpcClient().AssignSegmentID(ctx, req)
 }
 
 func (c *Client) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentStates(ctx, req)
-	})
-	return ret.(*datapb.GetSegmentStatesResponse), err
+	return c.getGrpcClient().GetSegmentStates(ctx, req)
 }
 
 func (c *Client) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetInsertBinlogPaths(ctx, req)
-	})
-	return ret.(*datapb.GetInsertBinlogPathsResponse), err
+	return c.getGrpcClient().GetInsertBinlogPaths(ctx, req)
 }
 
 func (c *Client) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetCollectionStatistics(ctx, req)
-	})
-	return ret.(*datapb.GetCollectionStatisticsResponse), err
+	return c.getGrpcClient().GetCollectionStatistics(ctx, req)
 }
 
 func (c *Client) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetPartitionStatistics(ctx, req)
-	})
-	return ret.(*datapb.GetPartitionStatisticsResponse), err
+	return c.getGrpcClient().GetPartitionStatistics(ctx, req)
 }
 
 func (c *Client) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfoChannel(ctx, &datapb.GetSegmentInfoChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetSegmentInfoChannel(ctx, &datapb.GetSegmentInfoChannelRequest{})
 }
 
 func (c *Client) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfo(ctx, req)
-	})
-	return ret.(*datapb.GetSegmentInfoResponse), err
+	return c.getGrpcClient().GetSegmentInfo(ctx, req)
 }
 
 func (c *Client) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	return c.grpcClient.SaveBinlogPaths(ctx, req)
+	return c.getGrpcClient().SaveBinlogPaths(ctx, req)
 }
 
 func (c *Client) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetRecoveryInfo(ctx, req)
-	})
-	return ret.(*datapb.GetRecoveryInfoResponse), err
+	return c.getGrpcClient().GetRecoveryInfo(ctx, req)
 }
 
 func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetFlushedSegments(ctx, req)
-	})
-	return ret.(*datapb.GetFlushedSegmentsResponse), err
+	return c.getGrpcClient().GetFlushedSegments(ctx, req)
+}
+
+func (c *Client) ListSegments(ctx context.Context, req *datapb.ListSegmentsRequest) (*datapb.ListSegmentsResponse, error) {
+	return c.getGrpcClient().ListSegments(ctx, req)
+}
+
+func (c *Client) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return c.getGrpcClient().Export(ctx, req)
+}
+
+func (c *Client) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return c.getGrpcClient().GetExportState(ctx, req)
+}
+
+func (c *Client) ClusteringCompact(ctx context.Context, req *datapb.ClusteringCompactRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().ClusteringCompact(ctx, req)
+}
+
+func (c *Client) GetDeleteStats(ctx context.Context, req *datapb.GetDeleteStatsRequest) (*datapb.GetDeleteStatsResponse, error) {
+	return c.getGrpcClient().GetDeleteStats(ctx, req)
+}
+
+func (c *Client) PurgeDeletes(ctx context.Context, req *datapb.PurgeDeletesRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().PurgeDeletes(ctx, req)
+}
+
+func (c *Client) PurgeCollection(ctx context.Context, req *datapb.PurgeCollectionRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().PurgeCollection(ctx, req)
 }
 
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetMetrics(ctx, req)
-	})
-	return ret.(*milvuspb.GetMetricsResponse), err
+	return c.getGrpcClient().GetMetrics(ctx, req)
 }
@@ -31,6 +31,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 var Params ParamTable
@@ -45,6 +46,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "dataCoord")
 	})
 }
 
@@ -122,13 +122,14 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	serverOpts := append(Params.GrpcServerConfig.ServerOptions(),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
+	s.grpcServer = grpc.NewServer(serverOpts...)
 	//grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor))
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	grpc_prometheus.Register(s.grpcServer)
@@ -234,6 +235,34 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	return s.dataCoord.GetFlushedSegments(ctx, req)
 }
 
+func (s *Server) ListSegments(ctx context.Context, req *datapb.ListSegmentsRequest) (*datapb.ListSegmentsResponse, error) {
+	return s.dataCoord.ListSegments(ctx, req)
+}
+
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return s.dataCoord.Export(ctx, req)
+}
+
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return s.dataCoord.GetExportState(ctx, req)
+}
+
+func (s *Server) ClusteringCompact(ctx context.Context, req *datapb.ClusteringCompactRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ClusteringCompact(ctx, req)
+}
+
+func (s *Server) GetDeleteStats(ctx context.Context, req *datapb.GetDeleteStatsRequest) (*datapb.GetDeleteStatsResponse, error) {
+	return s.dataCoord.GetDeleteStats(ctx, req)
+}
+
+func (s *Server) PurgeDeletes(ctx context.Context, req *datapb.PurgeDeletesRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PurgeDeletes(ctx, req)
+}
+
+func (s *Server) PurgeCollection(ctx context.Context, req *datapb.PurgeCollectionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PurgeCollection(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.dataCoord.GetMetrics(ctx, req)
 }
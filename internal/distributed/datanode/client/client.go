@@ -13,22 +13,18 @@ package grpcdatanodeclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
-	"github.com/milvus-io/milvus/internal/util/trace"
-	"google.golang.org/grpc/codes"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -67,33 +63,20 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
-		opts := trace.GetInterceptorOpts()
 		log.Debug("DataNode connect ", zap.String("address", c.addr))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithDisableRetry(),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		conn, err := grpcclient.Dial(ctx, &grpcclient.Config{
+			Role:    typeutil.DataNodeRole,
+			Address: c.addr,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		})
 		if err != nil {
 			return err
 		}
@@ -111,23 +94,6 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 	return nil
 }
 
-func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
-	}
-	log.Debug("DataNode Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to datanode failed with error:\n" + err.Error())
-	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
-	}
-	return ret, err
-}
-
 func (c *Client) Start() error {
 	return nil
 }
@@ -143,36 +109,21 @@ func (c *Client) Register() error {
 }
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpc.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.grpc.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpc.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.grpc.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 func (c *Client) WatchDmChannels(ctx context.Context, req *datapb.WatchDmChannelsRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpc.WatchDmChannels(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.grpc.WatchDmChannels(ctx, req)
 }
 
 func (c *Client) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpc.FlushSegments(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.grpc.FlushSegments(ctx, req)
 }
 
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpc.GetMetrics(ctx, req)
-	})
-	return ret.(*milvuspb.GetMetricsResponse), err
+	return c.grpc.GetMetrics(ctx, req)
 }
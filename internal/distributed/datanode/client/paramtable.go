@@ -27,6 +27,7 @@ type ParamTable struct {
 
 	ClientMaxSendSize int
 	ClientMaxRecvSize int
+	GrpcClientConfig  grpcconfigs.ClientConfig
 }
 
 var Params ParamTable
@@ -38,6 +39,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initClientMaxSendSize()
 		pt.initClientMaxRecvSize()
+		pt.GrpcClientConfig = grpcconfigs.LoadClientConfig(&pt.BaseTable, "dataNode")
 	})
 }
 
@@ -39,6 +39,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 func (pt *ParamTable) Init() {
@@ -50,6 +51,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "dataNode")
 	})
 }
 
@@ -11,11 +11,152 @@
 
 package grpcconfigs
 
-import "math"
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
 
 const (
 	DefaultServerMaxSendSize = math.MaxInt32
 	DefaultServerMaxRecvSize = math.MaxInt32
 	DefaultClientMaxSendSize = 100 * 1024 * 1024
 	DefaultClientMaxRecvSize = 100 * 1024 * 1024
+
+	// DefaultServerKeepaliveTime pings the clients after the connection is idle for 60s
+	DefaultServerKeepaliveTime    = 60 * time.Second
+	DefaultServerKeepaliveTimeout = 10 * time.Second
+	// DefaultServerMaxConcurrentStreams doesn't limit the concurrent streams of a connection
+	DefaultServerMaxConcurrentStreams = math.MaxUint32
+	DefaultClientKeepaliveTime        = 10 * time.Second
+	DefaultClientKeepaliveTimeout     = 20 * time.Second
+	DefaultClientPoolSize             = 1
+
+	// keepaliveMinTime is the least interval of the pings accepted by the servers,
+	// the connection of a client pinging more frequently is closed with too_many_pings
+	keepaliveMinTime = 5 * time.Second
 )
+
+// ServerConfig is the keepalive and the stream config of the grpc server of a component
+type ServerConfig struct {
+	KeepaliveTime        time.Duration
+	KeepaliveTimeout     time.Duration
+	MaxConcurrentStreams uint32
+}
+
+// ClientConfig is the keepalive and the connection pool config of the grpc clients of a component
+type ClientConfig struct {
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	// PoolSize is the number of the connections of a coordinator client
+	PoolSize int
+}
+
+// LoadServerConfig loads the keepalive and the stream config of the grpc server of the component from the
+// <role>.grpc section, the keepalive times are in milliseconds and the invalid values are set to default
+func LoadServerConfig(pt *paramtable.BaseTable, role string) ServerConfig {
+	cfg := ServerConfig{
+		KeepaliveTime:    loadDuration(pt, role+".grpc.serverKeepaliveTime", DefaultServerKeepaliveTime),
+		KeepaliveTimeout: loadDuration(pt, role+".grpc.serverKeepaliveTimeout", DefaultServerKeepaliveTimeout),
+	}
+
+	key := role + ".grpc.serverMaxConcurrentStreams"
+	valueStr, err := pt.LoadWithDefault(key, strconv.FormatUint(DefaultServerMaxConcurrentStreams, 10))
+	if err == nil {
+		var value uint64
+		value, err = strconv.ParseUint(valueStr, 10, 32)
+		if err == nil && value > 0 {
+			cfg.MaxConcurrentStreams = uint32(value)
+		}
+	}
+	if cfg.MaxConcurrentStreams == 0 { // not in valid format
+		log.Warn("Failed to parse "+key+", set to default", zap.String(key, valueStr), zap.Error(err))
+		cfg.MaxConcurrentStreams = DefaultServerMaxConcurrentStreams
+	}
+
+	log.Debug("LoadServerConfig", zap.String("role", role),
+		zap.Duration(role+".grpc.serverKeepaliveTime", cfg.KeepaliveTime),
+		zap.Duration(role+".grpc.serverKeepaliveTimeout", cfg.KeepaliveTimeout),
+		zap.Uint32(key, cfg.MaxConcurrentStreams))
+	return cfg
+}
+
+// LoadClientConfig loads the keepalive and the connection pool config of the grpc clients of the component
+// from the <role>.grpc section
+func LoadClientConfig(pt *paramtable.BaseTable, role string) ClientConfig {
+	cfg := ClientConfig{
+		KeepaliveTime:    loadDuration(pt, role+".grpc.clientKeepaliveTime", DefaultClientKeepaliveTime),
+		KeepaliveTimeout: loadDuration(pt, role+".grpc.clientKeepaliveTimeout", DefaultClientKeepaliveTimeout),
+	}
+
+	key := role + ".grpc.clientPoolSize"
+	valueStr, err := pt.LoadWithDefault(key, strconv.Itoa(DefaultClientPoolSize))
+	if err == nil {
+		cfg.PoolSize, err = strconv.Atoi(valueStr)
+	}
+	if err != nil || cfg.PoolSize <= 0 { // not in valid format
+		log.Warn("Failed to parse "+key+", set to default", zap.String(key, valueStr), zap.Error(err))
+		cfg.PoolSize = DefaultClientPoolSize
+	}
+	// the connection is closed by the servers if pinged more frequently than keepaliveMinTime
+	if cfg.KeepaliveTime < keepaliveMinTime {
+		log.Warn("LoadClientConfig", zap.String("role", role),
+			zap.Duration(role+".grpc.clientKeepaliveTime", cfg.KeepaliveTime),
+			zap.Duration("raised to", keepaliveMinTime))
+		cfg.KeepaliveTime = keepaliveMinTime
+	}
+
+	log.Debug("LoadClientConfig", zap.String("role", role),
+		zap.Duration(role+".grpc.clientKeepaliveTime", cfg.KeepaliveTime),
+		zap.Duration(role+".grpc.clientKeepaliveTimeout", cfg.KeepaliveTimeout),
+		zap.Int(key, cfg.PoolSize))
+	return cfg
+}
+
+func loadDuration(pt *paramtable.BaseTable, key string, defaultValue time.Duration) time.Duration {
+	valueStr, err := pt.LoadWithDefault(key, strconv.FormatInt(defaultValue.Milliseconds(), 10))
+	if err != nil {
+		return defaultValue
+	}
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil || value <= 0 { // not in valid format
+		log.Warn("Failed to parse "+key+", set to default", zap.String(key, valueStr), zap.Error(err))
+		return defaultValue
+	}
+	return time.Duration(value) * time.Millisecond
+}
+
+// ServerOptions returns the keepalive and the stream options of the grpc server
+func (cfg ServerConfig) ServerOptions() []grpc.ServerOption {
+	// no stream is accepted if the max concurrent streams is 0, such as the config is not loaded
+	if cfg.MaxConcurrentStreams == 0 {
+		cfg.MaxConcurrentStreams = DefaultServerMaxConcurrentStreams
+	}
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+		grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams),
+	}
+}
+
+// KeepaliveDialOption returns the keepalive option of the grpc clients
+func (cfg ClientConfig) KeepaliveDialOption() grpc.DialOption {
+	return grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                cfg.KeepaliveTime,
+		Timeout:             cfg.KeepaliveTimeout,
+		PermitWithoutStream: true,
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcconfigs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestLoadServerConfig(t *testing.T) {
+	var pt paramtable.BaseTable
+	pt.Init()
+
+	cfg := LoadServerConfig(&pt, "proxy")
+	assert.Equal(t, DefaultServerKeepaliveTime, cfg.KeepaliveTime)
+	assert.Equal(t, DefaultServerKeepaliveTimeout, cfg.KeepaliveTimeout)
+	assert.Equal(t, uint32(DefaultServerMaxConcurrentStreams), cfg.MaxConcurrentStreams)
+
+	pt.Save("test.grpc.serverKeepaliveTime", "30000")
+	pt.Save("test.grpc.serverKeepaliveTimeout", "-1")
+	pt.Save("test.grpc.serverMaxConcurrentStreams", "100")
+	cfg = LoadServerConfig(&pt, "test")
+	assert.Equal(t, 30*time.Second, cfg.KeepaliveTime)
+	assert.Equal(t, DefaultServerKeepaliveTimeout, cfg.KeepaliveTimeout)
+	assert.Equal(t, uint32(100), cfg.MaxConcurrentStreams)
+	assert.Equal(t, 3, len(cfg.ServerOptions()))
+
+	pt.Save("test.grpc.serverMaxConcurrentStreams", "0")
+	cfg = LoadServerConfig(&pt, "test")
+	assert.Equal(t, uint32(DefaultServerMaxConcurrentStreams), cfg.MaxConcurrentStreams)
+}
+
+func TestLoadClientConfig(t *testing.T) {
+	var pt paramtable.BaseTable
+	pt.Init()
+
+	cfg := LoadClientConfig(&pt, "queryNode")
+	assert.Equal(t, DefaultClientKeepaliveTime, cfg.KeepaliveTime)
+	assert.Equal(t, DefaultClientKeepaliveTimeout, cfg.KeepaliveTimeout)
+	assert.Equal(t, DefaultClientPoolSize, cfg.PoolSize)
+
+	pt.Save("test.grpc.clientKeepaliveTime", "1000")
+	pt.Save("test.grpc.clientKeepaliveTimeout", "abc")
+	pt.Save("test.grpc.clientPoolSize", "4")
+	cfg = LoadClientConfig(&pt, "test")
+	assert.Equal(t, keepaliveMinTime, cfg.KeepaliveTime)
+	assert.Equal(t, DefaultClientKeepaliveTimeout, cfg.KeepaliveTimeout)
+	assert.Equal(t, 4, cfg.PoolSize)
+
+	pt.Save("test.grpc.clientPoolSize", "0")
+	cfg = LoadClientConfig(&pt, "test")
+	assert.Equal(t, DefaultClientPoolSize, cfg.PoolSize)
+	assert.NotNil(t, cfg.KeepaliveDialOption())
+}
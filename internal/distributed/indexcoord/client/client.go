@@ -13,19 +13,15 @@ package grpcindexcoordclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
-	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 
@@ -39,29 +35,13 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	grpcClient indexpb.IndexCoordClient
-	conn       *grpc.ClientConn
+	// grpcClients are the clients of the connections of the pool
+	grpcClients []indexpb.IndexCoordClient
+	pool        *grpcclient.Pool
 
-	addr string
 	sess *sessionutil.Session
 }
 
-func getIndexCoordAddr(sess *sessionutil.Session) (string, error) {
-	key := typeutil.IndexCoordRole
-	msess, _, err := sess.GetSessions(key)
-	if err != nil {
-		log.Debug("IndexCoordClient GetSessions failed", zap.Any("key", key), zap.Error(err))
-		return "", err
-	}
-	log.Debug("IndexCoordClient GetSessions success", zap.Any("key", key), zap.Any("msess", msess))
-	ms, ok := msess[key]
-	if !ok {
-		log.Debug("IndexCoordClient msess key not existed", zap.Any("key", key), zap.Any("len of msess", len(msess)))
-		return "", fmt.Errorf("number of indexcoord is incorrect, %d", len(msess))
-	}
-	return ms.Address, nil
-}
-
 func NewClient(ctx context.Context, metaRoot string, etcdEndpoints []string) (*Client, error) {
 	sess := sessionutil.NewSession(ctx, metaRoot, etcdEndpoints)
 	if sess == nil {
@@ -85,35 +65,24 @@ func (c *Client) Init() error {
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
 	connectIndexCoordaddrFn := func() error {
-		c.addr, err = getIndexCoordAddr(c.sess)
-		if err != nil {
-			log.Debug("IndexCoordClient getIndexCoordAddress failed")
-			return err
-		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("IndexCoordClient try connect ", zap.String("address", c.addr))
+		log.Debug("IndexCoordClient try connect ", zap.String("role", typeutil.IndexCoordRole))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(3)),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3)),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		pool, err := grpcclient.DialPool(ctx, &grpcclient.Config{
+			Role:     typeutil.IndexCoordRole,
+			Sessions: c.sess,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		}, Params.GrpcClientConfig.PoolSize)
 		if err != nil {
 			return err
 		}
-		c.conn = conn
+		c.pool = pool
 		return nil
 	}
 
@@ -123,25 +92,11 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("IndexCoordClient connect success")
-	c.grpcClient = indexpb.NewIndexCoordClient(c.conn)
-	return nil
-}
-
-func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
-	}
-	log.Debug("IndexCoord Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to indexcoord failed with error:\n" + err.Error())
+	c.grpcClients = make([]indexpb.IndexCoordClient, 0, c.pool.Size())
+	for i := 0; i < c.pool.Size(); i++ {
+		c.grpcClients = append(c.grpcClients, indexpb.NewIndexCoordClient(c.pool.Conn(i)))
 	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
-	}
-	return ret, err
+	return nil
 }
 
 func (c *Client) Start() error {
@@ -150,7 +105,12 @@ func (c *Client) Start() error {
 
 func (c *Client) Stop() error {
 	c.cancel()
-	return c.conn.Close()
+	return c.pool.Close()
+}
+
+// getGrpcClient returns the client of the next healthy connection of the pool
+func (c *Client) getGrpcClient() indexpb.IndexCoordClient {
+	return c.grpcClients[c.pool.Pick()]
 }
 
 // Register dummy
@@ -159,56 +119,48 @@ func (c *Client) Register() error {
 }
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 func (c *Client) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.BuildIndexResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.BuildIndex(ctx, req)
-	})
-	return ret.(*indexpb.BuildIndexResponse), err
+	return c.getGrpcClient().BuildIndex(ctx, req)
 }
 
 func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropIndex(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().DropIndex(ctx, req)
 }
 
 func (c *Client) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetIndexStates(ctx, req)
-	})
-	return ret.(*indexpb.GetIndexStatesResponse), err
+	return c.getGrpcClient().GetIndexStates(ctx, req)
 }
 func (c *Client) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetIndexFilePaths(ctx, req)
-	})
-	return ret.(*indexpb.GetIndexFilePathsResponse), err
+	return c.getGrpcClient().GetIndexFilePaths(ctx, req)
 }
 
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetMetrics(ctx, req)
-	})
-	return ret.(*milvuspb.GetMetricsResponse), err
+	return c.getGrpcClient().GetMetrics(ctx, req)
+}
+
+func (c *Client) PauseIndexBuild(ctx context.Context, req *indexpb.PauseIndexBuildRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().PauseIndexBuild(ctx, req)
+}
+
+func (c *Client) ResumeIndexBuild(ctx context.Context, req *indexpb.ResumeIndexBuildRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().ResumeIndexBuild(ctx, req)
+}
+
+func (c *Client) SetIndexBuildPriority(ctx context.Context, req *indexpb.SetIndexBuildPriorityRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().SetIndexBuildPriority(ctx, req)
+}
+
+func (c *Client) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return c.getGrpcClient().GetIndexStatistics(ctx, req)
 }
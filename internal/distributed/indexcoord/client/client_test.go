@@ -77,6 +77,30 @@ func TestIndexCoordClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("PauseIndexBuild", func(t *testing.T) {
+		resp, err := icc.PauseIndexBuild(ctx, &indexpb.PauseIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuild", func(t *testing.T) {
+		resp, err := icc.ResumeIndexBuild(ctx, &indexpb.ResumeIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexBuildPriority", func(t *testing.T) {
+		resp, err := icc.SetIndexBuildPriority(ctx, &indexpb.SetIndexBuildPriorityRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("GetIndexStatistics", func(t *testing.T) {
+		resp, err := icc.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("DropIndex", func(t *testing.T) {
 		req := &indexpb.DropIndexRequest{
 			IndexID: 0,
@@ -30,6 +30,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 var Params ParamTable
@@ -48,6 +49,7 @@ func (pt *ParamTable) initParams() {
 
 	pt.initServerMaxSendSize()
 	pt.initServerMaxRecvSize()
+	pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "indexCoord")
 }
 
 func (pt *ParamTable) initServicePort() {
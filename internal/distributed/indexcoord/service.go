@@ -155,6 +155,22 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 	return s.indexcoord.GetMetrics(ctx, request)
 }
 
+func (s *Server) PauseIndexBuild(ctx context.Context, request *indexpb.PauseIndexBuildRequest) (*commonpb.Status, error) {
+	return s.indexcoord.PauseIndexBuild(ctx, request)
+}
+
+func (s *Server) ResumeIndexBuild(ctx context.Context, request *indexpb.ResumeIndexBuildRequest) (*commonpb.Status, error) {
+	return s.indexcoord.ResumeIndexBuild(ctx, request)
+}
+
+func (s *Server) SetIndexBuildPriority(ctx context.Context, request *indexpb.SetIndexBuildPriorityRequest) (*commonpb.Status, error) {
+	return s.indexcoord.SetIndexBuildPriority(ctx, request)
+}
+
+func (s *Server) GetIndexStatistics(ctx context.Context, request *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return s.indexcoord.GetIndexStatistics(ctx, request)
+}
+
 func (s *Server) startGrpcLoop(grpcPort int) {
 
 	defer s.loopWg.Done()
@@ -171,11 +187,12 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	serverOpts := append(Params.GrpcServerConfig.ServerOptions(),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+	s.grpcServer = grpc.NewServer(serverOpts...)
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
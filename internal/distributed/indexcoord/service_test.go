@@ -76,6 +76,30 @@ func TestIndexCoordinateServer(t *testing.T) {
 		assert.Equal(t, commonpb.IndexState_Finished, resp.States[0].State)
 	})
 
+	t.Run("PauseIndexBuild", func(t *testing.T) {
+		resp, err := indexCoord.PauseIndexBuild(ctx, &indexpb.PauseIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuild", func(t *testing.T) {
+		resp, err := indexCoord.ResumeIndexBuild(ctx, &indexpb.ResumeIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexBuildPriority", func(t *testing.T) {
+		resp, err := indexCoord.SetIndexBuildPriority(ctx, &indexpb.SetIndexBuildPriorityRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("GetIndexStatistics", func(t *testing.T) {
+		resp, err := indexCoord.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("DropIndex", func(t *testing.T) {
 		req := &indexpb.DropIndexRequest{
 			IndexID: 0,
@@ -34,6 +34,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 var Params ParamTable
@@ -46,6 +47,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "indexNode")
 	})
 }
 
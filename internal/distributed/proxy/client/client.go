@@ -13,31 +13,28 @@ package grpcproxyclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
-	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 )
 
 type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	grpcClient proxypb.ProxyClient
-	conn       *grpc.ClientConn
+	grpcClient   proxypb.ProxyClient
+	milvusClient milvuspb.MilvusServiceClient
+	conn         *grpc.ClientConn
 
 	addr string
 }
@@ -62,32 +59,20 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
-		opts := trace.GetInterceptorOpts()
 		log.Debug("ProxyClient try connect ", zap.String("address", c.addr))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		conn, err := grpcclient.Dial(ctx, &grpcclient.Config{
+			Role:    typeutil.ProxyRole,
+			Address: c.addr,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		})
 		if err != nil {
 			return err
 		}
@@ -102,26 +87,10 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 	}
 	log.Debug("ProxyClient connect success")
 	c.grpcClient = proxypb.NewProxyClient(c.conn)
+	c.milvusClient = milvuspb.NewMilvusServiceClient(c.conn)
 	return nil
 }
 
-func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
-	}
-	log.Debug("Proxy Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to proxy failed with error:\n" + err.Error())
-	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
-	}
-	return ret, err
-}
-
 func (c *Client) Start() error {
 	return nil
 }
@@ -136,29 +105,51 @@ func (c *Client) Register() error {
 }
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 func (c *Client) InvalidateCollectionMetaCache(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.InvalidateCollectionMetaCache(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.grpcClient.InvalidateCollectionMetaCache(ctx, req)
 }
 
 func (c *Client) ReleaseDQLMessageStream(ctx context.Context, req *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleaseDQLMessageStream(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.grpcClient.ReleaseDQLMessageStream(ctx, req)
+}
+
+func (c *Client) InvalidateSegmentDistribution(ctx context.Context, req *proxypb.InvalidateSegmentDistributionRequest) (*commonpb.Status, error) {
+	return c.grpcClient.InvalidateSegmentDistribution(ctx, req)
+}
+
+// Insert is used by the dml mirror of another proxy
+func (c *Client) Insert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	return c.milvusClient.Insert(ctx, req)
+}
+
+// StreamInsert opens a stream to insert the batches of the bulk writers
+func (c *Client) StreamInsert(ctx context.Context) (milvuspb.MilvusService_StreamInsertClient, error) {
+	return c.milvusClient.StreamInsert(ctx)
+}
+
+// Delete is used by the dml mirror of another proxy
+func (c *Client) Delete(ctx context.Context, req *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	return c.milvusClient.Delete(ctx, req)
+}
+
+// Search is used by the search shadow of another proxy
+func (c *Client) Search(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	return c.milvusClient.Search(ctx, req)
+}
+
+// Query is used by the dml replay tool to check the existing primary keys
+func (c *Client) Query(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	return c.milvusClient.Query(ctx, req)
+}
+
+// DescribeCollection is used by the dml replay tool to get the schema and channels of collections
+func (c *Client) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return c.milvusClient.DescribeCollection(ctx, req)
 }
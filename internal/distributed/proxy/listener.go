@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proxy"
+)
+
+const (
+	// DMLGroup is the group of the rpcs writing data
+	DMLGroup = proxy.DMLGroup
+	// DQLGroup is the group of the rpcs reading data
+	DQLGroup = proxy.DQLGroup
+	// AdminGroup is the group of the other rpcs of the milvus service, such as ddl, index, load and metrics
+	AdminGroup = proxy.AdminGroup
+
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+)
+
+// ListenerGroups are the rpc groups which can be bound to a dedicated listener
+var ListenerGroups = []string{DMLGroup, DQLGroup, AdminGroup}
+
+// ListenerConfig is the config of a dedicated listener serving a group of the milvus service rpcs
+type ListenerConfig struct {
+	Group       string
+	IP          string
+	Port        int
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+// Address returns the address to listen on, all interfaces are listened on if IP is empty
+func (c *ListenerConfig) Address() string {
+	return c.IP + ":" + strconv.Itoa(c.Port)
+}
+
+// credentials returns nil if the listener is not configured with tls
+func (c *ListenerConfig) credentials() (credentials.TransportCredentials, error) {
+	if c.TLSCertFile == "" {
+		return nil, nil
+	}
+	return credentials.NewServerTLSFromFile(c.TLSCertFile, c.TLSKeyFile)
+}
+
+// methodGroup returns the group of a milvus service rpc, or an empty string for the internal rpcs
+func methodGroup(fullMethod string) string {
+	return proxy.MethodGroup(fullMethod)
+}
+
+// groupFilterInterceptor rejects the milvus service rpcs which are not served by this server, served returns
+// whether the group of an rpc is served, the internal rpcs are always passed to the handler
+func groupFilterInterceptor(served func(group string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		group := methodGroup(info.FullMethod)
+		if group != "" && !served(group) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not served on this port, use the %s listener of the proxy",
+				path.Base(info.FullMethod), group)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// groupFilterStreamInterceptor is the groupFilterInterceptor of the streaming rpcs
+func groupFilterStreamInterceptor(served func(group string) bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		group := methodGroup(info.FullMethod)
+		if group != "" && !served(group) {
+			return status.Errorf(codes.PermissionDenied, "%s is not served on this port, use the %s listener of the proxy",
+				path.Base(info.FullMethod), group)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// mainServedGroups returns the groups served on the main port, which are the ones without a dedicated listener
+func mainServedGroups(listeners []*ListenerConfig) func(group string) bool {
+	dedicated := make(map[string]bool)
+	for _, l := range listeners {
+		dedicated[l.Group] = true
+	}
+	return func(group string) bool {
+		return !dedicated[group]
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodGroup(t *testing.T) {
+	assert.Equal(t, DMLGroup, methodGroup(milvusServicePrefix+"Insert"))
+	assert.Equal(t, DMLGroup, methodGroup(milvusServicePrefix+"Flush"))
+	assert.Equal(t, DMLGroup, methodGroup(milvusServicePrefix+"StreamInsert"))
+	assert.Equal(t, DQLGroup, methodGroup(milvusServicePrefix+"Search"))
+	assert.Equal(t, DQLGroup, methodGroup(milvusServicePrefix+"SearchStream"))
+	assert.Equal(t, DQLGroup, methodGroup(milvusServicePrefix+"Query"))
+	assert.Equal(t, DQLGroup, methodGroup(milvusServicePrefix+"QueryIterator"))
+	assert.Equal(t, AdminGroup, methodGroup(milvusServicePrefix+"CreateCollection"))
+	assert.Equal(t, AdminGroup, methodGroup(milvusServicePrefix+"GetMetrics"))
+	assert.Equal(t, "", methodGroup("/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"))
+}
+
+func TestGroupFilterInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(served func(string) bool, fullMethod string) error {
+		_, err := groupFilterInterceptor(served)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+		return err
+	}
+
+	main := mainServedGroups([]*ListenerConfig{{Group: DMLGroup, Port: 19540}})
+	err := call(main, milvusServicePrefix+"Insert")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, call(main, milvusServicePrefix+"Search"))
+	assert.Nil(t, call(main, milvusServicePrefix+"CreateCollection"))
+	assert.Nil(t, call(main, "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"))
+
+	dml := func(group string) bool { return group == DMLGroup }
+	assert.Nil(t, call(dml, milvusServicePrefix+"Insert"))
+	err = call(dml, milvusServicePrefix+"Search")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGroupFilterStreamInterceptor(t *testing.T) {
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+	call := func(served func(string) bool, fullMethod string) error {
+		return groupFilterStreamInterceptor(served)(nil, nil, &grpc.StreamServerInfo{FullMethod: fullMethod}, handler)
+	}
+
+	main := mainServedGroups([]*ListenerConfig{{Group: DMLGroup, Port: 19540}})
+	err := call(main, milvusServicePrefix+"StreamInsert")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	dml := func(group string) bool { return group == DMLGroup }
+	assert.Nil(t, call(dml, milvusServicePrefix+"StreamInsert"))
+}
+
+func TestListenerConfig(t *testing.T) {
+	cfg := &ListenerConfig{Group: DQLGroup, Port: 19541}
+	assert.Equal(t, ":19541", cfg.Address())
+	creds, err := cfg.credentials()
+	assert.Nil(t, err)
+	assert.Nil(t, creds)
+
+	cfg.IP = "127.0.0.1"
+	assert.Equal(t, "127.0.0.1:19541", cfg.Address())
+	cfg.TLSCertFile, cfg.TLSKeyFile = "/not/exist.crt", "/not/exist.key"
+	_, err = cfg.credentials()
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@
 package grpcproxy
 
 import (
+	"fmt"
 	"strconv"
 	"sync"
 
@@ -37,6 +38,12 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
+	// ServerReflection registers the grpc server reflection service on the proxy
+	ServerReflection bool
+
+	// Listeners are the dedicated listeners of the rpc groups, the groups without one are served on Port
+	Listeners []*ListenerConfig
 }
 
 var Params ParamTable
@@ -49,6 +56,8 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "proxy")
+		pt.initServerReflection()
 	})
 }
 
@@ -66,6 +75,7 @@ func (pt *ParamTable) initParams() {
 	pt.initIndexCoordAddress()
 	pt.initDataCoordAddress()
 	pt.initQueryCoordAddress()
+	pt.initListeners()
 }
 
 // todo remove and use load from env
@@ -154,3 +164,51 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("proxy.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initServerReflection() {
+	pt.ServerReflection = pt.ParseBool("proxy.grpc.serverReflection", true)
+}
+
+func (pt *ParamTable) initListeners() {
+	pt.Listeners = nil
+	ports := map[int]string{pt.Port: "proxy.port"}
+	for _, group := range ListenerGroups {
+		prefix := "proxy.listeners." + group + "."
+		portStr, err := pt.LoadWithDefault(prefix+"port", "0")
+		if err != nil {
+			panic(err)
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			panic(err)
+		}
+		if port < 0 || port > 65535 {
+			panic(fmt.Errorf("%sport should be in [0, 65535], got %d", prefix, port))
+		}
+		if port == 0 {
+			continue
+		}
+		if key, ok := ports[port]; ok {
+			panic(fmt.Errorf("%sport %d conflicts with %s", prefix, port, key))
+		}
+		ports[port] = prefix + "port"
+
+		listener := &ListenerConfig{
+			Group: group,
+			Port:  port,
+		}
+		if listener.IP, err = pt.LoadWithDefault(prefix+"ip", ""); err != nil {
+			panic(err)
+		}
+		if listener.TLSCertFile, err = pt.LoadWithDefault(prefix+"tlsCertFile", ""); err != nil {
+			panic(err)
+		}
+		if listener.TLSKeyFile, err = pt.LoadWithDefault(prefix+"tlsKeyFile", ""); err != nil {
+			panic(err)
+		}
+		if (listener.TLSCertFile == "") != (listener.TLSKeyFile == "") {
+			panic(fmt.Errorf("%stlsCertFile and %stlsKeyFile should be set together", prefix, prefix))
+		}
+		pt.Listeners = append(pt.Listeners, listener)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
 
@@ -23,4 +24,35 @@ func TestParamTable(t *testing.T) {
 
 	log.Info("TestParamTable", zap.Int("ServerMaxSendSize", Params.ServerMaxSendSize))
 	log.Info("TestParamTable", zap.Int("ServerMaxRecvSize", Params.ServerMaxRecvSize))
+	assert.True(t, Params.ServerReflection)
+
+	Params.Save("proxy.grpc.serverReflection", "false")
+	Params.initServerReflection()
+	assert.False(t, Params.ServerReflection)
+	Params.Save("proxy.grpc.serverReflection", "abc")
+	assert.Panics(t, func() { Params.initServerReflection() })
+	Params.Save("proxy.grpc.serverReflection", "true")
+	Params.initServerReflection()
+
+	Params.Save("proxy.listeners.dml.port", "19540")
+	Params.Save("proxy.listeners.admin.port", "19542")
+	Params.Save("proxy.listeners.admin.ip", "127.0.0.1")
+	Params.initListeners()
+	assert.Equal(t, 2, len(Params.Listeners))
+	assert.Equal(t, DMLGroup, Params.Listeners[0].Group)
+	assert.Equal(t, ":19540", Params.Listeners[0].Address())
+	assert.Equal(t, AdminGroup, Params.Listeners[1].Group)
+	assert.Equal(t, "127.0.0.1:19542", Params.Listeners[1].Address())
+
+	Params.Save("proxy.listeners.admin.port", "19540")
+	assert.Panics(t, func() { Params.initListeners() })
+	Params.Save("proxy.listeners.admin.port", "19542")
+	Params.Save("proxy.listeners.dml.tlsCertFile", "/path/to/cert")
+	assert.Panics(t, func() { Params.initListeners() })
+
+	Params.Save("proxy.listeners.dml.tlsCertFile", "")
+	Params.Save("proxy.listeners.dml.port", "0")
+	Params.Save("proxy.listeners.admin.port", "0")
+	Params.initListeners()
+	assert.Equal(t, 0, len(Params.Listeners))
 }
@@ -22,12 +22,16 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
 
 	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	grpcindexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	grpcproxyclient "github.com/milvus-io/milvus/internal/distributed/proxy/client"
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -41,15 +45,13 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
-const (
-	GRPCMaxMagSize = 2 << 30
-)
-
 type Server struct {
 	ctx        context.Context
 	wg         sync.WaitGroup
 	proxy      *proxy.Proxy
 	grpcServer *grpc.Server
+	// listenerServers serve the rpc groups bound to the dedicated listeners
+	listenerServers []*grpc.Server
 
 	grpcErrChan chan error
 
@@ -57,6 +59,12 @@ type Server struct {
 	dataCoordClient  *grpcdatacoordclient.Client
 	queryCooedClient *grpcquerycoordclient.Client
 	indexCoordClient *grpcindexcoordclient.Client
+	mirrorClient     *grpcproxyclient.Client
+	shadowClient     *grpcproxyclient.Client
+
+	accessLogger  *proxy.AccessLogger
+	auditLogger   *proxy.AuditLogger
+	authenticator proxy.Authenticator
 
 	tracer opentracing.Tracer
 	closer io.Closer
@@ -92,15 +100,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
-		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
-		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+	s.grpcServer = s.newGrpcServer(nil, mainServedGroups(Params.Listeners))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
@@ -111,6 +111,92 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 
 }
 
+// newGrpcServer returns a grpc server serving the groups of the milvus service rpcs accepted by served,
+// the server is served with tls if creds is not nil
+func (s *Server) newGrpcServer(creds credentials.TransportCredentials, served func(group string) bool) *grpc.Server {
+	opts := trace.GetInterceptorOpts()
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_opentracing.UnaryServerInterceptor(opts...),
+		proxy.UnaryServerInterceptor(),
+		proxy.RequestIDInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_opentracing.StreamServerInterceptor(opts...),
+	}
+	// authenticated before the access log, so that the authenticated user is logged
+	if s.authenticator != nil {
+		unaryInterceptors = append(unaryInterceptors, proxy.AuthenticationInterceptor(s.authenticator))
+		streamInterceptors = append(streamInterceptors, proxy.AuthenticationStreamInterceptor(s.authenticator))
+	}
+	if s.accessLogger != nil {
+		unaryInterceptors = append(unaryInterceptors, proxy.AccessLogInterceptor(s.accessLogger))
+	}
+	if s.auditLogger != nil {
+		unaryInterceptors = append(unaryInterceptors, proxy.AuditLogInterceptor(s.auditLogger))
+	}
+	unaryInterceptors = append(unaryInterceptors, groupFilterInterceptor(served))
+	streamInterceptors = append(streamInterceptors, groupFilterStreamInterceptor(served))
+
+	serverOpts := append(Params.GrpcServerConfig.ServerOptions(),
+		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
+		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+	)
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	server := grpc.NewServer(serverOpts...)
+	// the services are listed by the clients such as grpcurl without the proto files
+	if Params.ServerReflection {
+		reflection.Register(server)
+	}
+	return server
+}
+
+// startListenerLoop serves the group of the milvus service rpcs of a dedicated listener
+func (s *Server) startListenerLoop(cfg *ListenerConfig, server *grpc.Server) {
+	defer s.wg.Done()
+
+	log.Debug("proxy", zap.String("listener group", cfg.Group), zap.String("listener address", cfg.Address()))
+	lis, err := net.Listen("tcp", cfg.Address())
+	if err != nil {
+		log.Warn("proxy", zap.String("listener group", cfg.Group), zap.String("Server:failed to listen:", err.Error()))
+		s.grpcErrChan <- err
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
+	if err := server.Serve(lis); err != nil {
+		s.grpcErrChan <- err
+	}
+}
+
+func (s *Server) startListeners() error {
+	for _, cfg := range Params.Listeners {
+		creds, err := cfg.credentials()
+		if err != nil {
+			log.Debug("Proxy load listener tls failed", zap.String("group", cfg.Group), zap.Error(err))
+			return err
+		}
+		group := cfg.Group
+		server := s.newGrpcServer(creds, func(g string) bool { return g == group })
+		milvuspb.RegisterMilvusServiceServer(server, s)
+		s.listenerServers = append(s.listenerServers, server)
+
+		s.wg.Add(1)
+		go s.startListenerLoop(cfg, server)
+		if err = <-s.grpcErrChan; err != nil {
+			return err
+		}
+		log.Debug("create grpc listener ...", zap.String("group", group), zap.Bool("tls", creds != nil))
+	}
+	return nil
+}
+
 func (s *Server) Run() error {
 
 	if err := s.init(); err != nil {
@@ -160,6 +246,39 @@ func (s *Server) init() error {
 		return err
 	}
 
+	if proxy.Params.AccessLog.Enable {
+		s.accessLogger, err = proxy.NewAccessLogger(s.ctx, &proxy.Params.AccessLog)
+		if err != nil {
+			log.Debug("Proxy new accessLogger failed ", zap.Error(err))
+			return err
+		}
+		log.Debug("Proxy", zap.String("access log sink", proxy.Params.AccessLog.Sink))
+	}
+
+	if proxy.Params.AuditLog.Enable {
+		s.auditLogger, err = proxy.NewAuditLogger(s.ctx, &proxy.Params.AuditLog)
+		if err != nil {
+			log.Debug("Proxy new auditLogger failed ", zap.Error(err))
+			return err
+		}
+		s.proxy.SetAuditLogger(s.auditLogger)
+		log.Debug("Proxy", zap.String("audit log path", proxy.Params.AuditLog.MinioPath))
+	}
+
+	if proxy.Params.Authentication.Enable {
+		s.authenticator, err = proxy.NewAuthenticator(&proxy.Params.Authentication)
+		if err != nil {
+			log.Debug("Proxy new authenticator failed ", zap.Error(err))
+			return err
+		}
+		log.Debug("Proxy", zap.String("authentication type", proxy.Params.Authentication.Type))
+	}
+	if proxy.Params.ApiKeyEnabled {
+		// the other credentials are still authenticated by the configured authenticator
+		s.authenticator = s.proxy.NewApiKeyAuthenticator(s.authenticator)
+		log.Debug("Proxy api key enabled", zap.Duration("refresh interval", proxy.Params.ApiKeyRefreshInterval))
+	}
+
 	s.wg.Add(1)
 	go s.startGrpcLoop(Params.Port)
 	// wait for grpc server loop start
@@ -168,6 +287,9 @@ func (s *Server) init() error {
 	if err != nil {
 		return err
 	}
+	if err = s.startListeners(); err != nil {
+		return err
+	}
 
 	rootCoordAddr := Params.RootCoordAddress
 	log.Debug("Proxy", zap.String("RootCoord address", rootCoordAddr))
@@ -234,6 +356,38 @@ func (s *Server) init() error {
 	s.proxy.SetQueryCoordClient(s.queryCooedClient)
 	log.Debug("set query coordinator client ...")
 
+	if proxy.Params.MirrorAddress != "" {
+		log.Debug("Proxy", zap.String("dml mirror address", proxy.Params.MirrorAddress))
+		s.mirrorClient, err = grpcproxyclient.NewClient(s.ctx, proxy.Params.MirrorAddress)
+		if err != nil {
+			log.Debug("Proxy new mirrorClient failed ", zap.Error(err))
+			return err
+		}
+		err = s.mirrorClient.Init()
+		if err != nil {
+			log.Debug("Proxy mirrorClient init failed ", zap.Error(err))
+			return err
+		}
+		s.proxy.SetDMLMirrorTarget(s.mirrorClient)
+		log.Debug("set dml mirror target ...")
+	}
+
+	if proxy.Params.ShadowAddress != "" {
+		log.Debug("Proxy", zap.String("search shadow address", proxy.Params.ShadowAddress))
+		s.shadowClient, err = grpcproxyclient.NewClient(s.ctx, proxy.Params.ShadowAddress)
+		if err != nil {
+			log.Debug("Proxy new shadowClient failed ", zap.Error(err))
+			return err
+		}
+		err = s.shadowClient.Init()
+		if err != nil {
+			log.Debug("Proxy shadowClient init failed ", zap.Error(err))
+			return err
+		}
+		s.proxy.SetSearchShadowTarget(s.shadowClient)
+		log.Debug("set search shadow target ...")
+	}
+
 	s.proxy.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("proxy", zap.Any("state of proxy", internalpb.StateCode_Initializing))
 
@@ -260,6 +414,20 @@ func (s *Server) Stop() error {
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
+	for _, server := range s.listenerServers {
+		server.GracefulStop()
+	}
+
+	if s.accessLogger != nil {
+		if err = s.accessLogger.Close(); err != nil {
+			log.Warn("Proxy close access logger failed", zap.Error(err))
+		}
+	}
+	if s.auditLogger != nil {
+		if err = s.auditLogger.Close(); err != nil {
+			log.Warn("Proxy close audit logger failed", zap.Error(err))
+		}
+	}
 
 	err = s.proxy.Stop()
 	if err != nil {
@@ -287,6 +455,10 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+func (s *Server) InvalidateSegmentDistribution(ctx context.Context, request *proxypb.InvalidateSegmentDistributionRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateSegmentDistribution(ctx, request)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
 }
@@ -307,6 +479,10 @@ func (s *Server) ReleaseCollection(ctx context.Context, request *milvuspb.Releas
 	return s.proxy.ReleaseCollection(ctx, request)
 }
 
+func (s *Server) WarmupCollection(ctx context.Context, request *milvuspb.WarmupCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.WarmupCollection(ctx, request)
+}
+
 func (s *Server) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
 	return s.proxy.DescribeCollection(ctx, request)
 }
@@ -319,6 +495,50 @@ func (s *Server) ShowCollections(ctx context.Context, request *milvuspb.ShowColl
 	return s.proxy.ShowCollections(ctx, request)
 }
 
+func (s *Server) CreateApiKey(ctx context.Context, request *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return s.proxy.CreateApiKey(ctx, request)
+}
+
+func (s *Server) RotateApiKey(ctx context.Context, request *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return s.proxy.RotateApiKey(ctx, request)
+}
+
+func (s *Server) DropApiKey(ctx context.Context, request *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	return s.proxy.DropApiKey(ctx, request)
+}
+
+func (s *Server) QueryAuditLog(ctx context.Context, request *milvuspb.QueryAuditLogRequest) (*milvuspb.QueryAuditLogResponse, error) {
+	return s.proxy.QueryAuditLog(ctx, request)
+}
+
+func (s *Server) GetChannelTimeTicks(ctx context.Context, request *milvuspb.GetChannelTimeTicksRequest) (*milvuspb.GetChannelTimeTicksResponse, error) {
+	return s.proxy.GetChannelTimeTicks(ctx, request)
+}
+
+func (s *Server) GetQuotaUsage(ctx context.Context, request *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	return s.proxy.GetQuotaUsage(ctx, request)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, request *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.UndropCollection(ctx, request)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, request *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.proxy.ListDroppedCollections(ctx, request)
+}
+
+func (s *Server) DescribePartition(ctx context.Context, request *milvuspb.DescribePartitionRequest) (*milvuspb.DescribePartitionResponse, error) {
+	return s.proxy.DescribePartition(ctx, request)
+}
+
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollection(ctx, request)
+}
+
+func (s *Server) GetCollectionRuntimeStats(ctx context.Context, request *milvuspb.GetCollectionRuntimeStatsRequest) (*milvuspb.GetCollectionRuntimeStatsResponse, error) {
+	return s.proxy.GetCollectionRuntimeStats(ctx, request)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreatePartition(ctx, request)
 }
@@ -373,6 +593,10 @@ func (s *Server) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*
 	return s.proxy.Insert(ctx, request)
 }
 
+func (s *Server) StreamInsert(stream milvuspb.MilvusService_StreamInsertServer) error {
+	return s.proxy.StreamInsert(stream)
+}
+
 func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	return s.proxy.Delete(ctx, request)
 }
@@ -381,6 +605,10 @@ func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) SearchStream(request *milvuspb.SearchRequest, stream milvuspb.MilvusService_SearchStreamServer) error {
+	return s.proxy.SearchStream(request, stream)
+}
+
 func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return s.proxy.Flush(ctx, request)
 }
@@ -389,6 +617,14 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+func (s *Server) QueryIterator(ctx context.Context, request *milvuspb.QueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	return s.proxy.QueryIterator(ctx, request)
+}
+
+func (s *Server) SearchIterator(ctx context.Context, request *milvuspb.SearchIteratorRequest) (*milvuspb.SearchIteratorResults, error) {
+	return s.proxy.SearchIterator(ctx, request)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -417,3 +653,7 @@ func (s *Server) RegisterLink(ctx context.Context, request *milvuspb.RegisterLin
 func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.proxy.GetMetrics(ctx, request)
 }
+
+func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return s.proxy.CheckHealth(ctx, request)
+}
@@ -13,20 +13,15 @@ package grpcquerycoordclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
-	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -39,26 +34,11 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	grpcClient querypb.QueryCoordClient
-	conn       *grpc.ClientConn
+	// grpcClients are the clients of the connections of the pool
+	grpcClients []querypb.QueryCoordClient
+	pool        *grpcclient.Pool
 
 	sess *sessionutil.Session
-	addr string
-}
-
-func getQueryCoordAddress(sess *sessionutil.Session) (string, error) {
-	key := typeutil.QueryCoordRole
-	msess, _, err := sess.GetSessions(key)
-	if err != nil {
-		log.Debug("QueryCoordClient GetSessions failed", zap.Error(err))
-		return "", err
-	}
-	ms, ok := msess[key]
-	if !ok {
-		log.Debug("QueryCoordClient msess key not existed", zap.Any("key", key))
-		return "", fmt.Errorf("number of querycoord is incorrect, %d", len(msess))
-	}
-	return ms.Address, nil
 }
 
 // NewClient creates a client for QueryCoord grpc call.
@@ -85,41 +65,24 @@ func (c *Client) Init() error {
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
 	connectQueryCoordAddressFn := func() error {
-		c.addr, err = getQueryCoordAddress(c.sess)
-		if err != nil {
-			log.Debug("QueryCoordClient getQueryCoordAddress failed", zap.Error(err))
-			return err
-		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("QueryCoordClient try reconnect ", zap.String("address", c.addr))
+		log.Debug("QueryCoordClient try reconnect ", zap.String("role", typeutil.QueryCoordRole))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		pool, err := grpcclient.DialPool(ctx, &grpcclient.Config{
+			Role:     typeutil.QueryCoordRole,
+			Sessions: c.sess,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		}, Params.GrpcClientConfig.PoolSize)
 		if err != nil {
 			return err
 		}
-		c.conn = conn
+		c.pool = pool
 		return nil
 	}
 
@@ -129,25 +92,11 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("QueryCoordClient try reconnect success")
-	c.grpcClient = querypb.NewQueryCoordClient(c.conn)
-	return nil
-}
-
-func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
+	c.grpcClients = make([]querypb.QueryCoordClient, 0, c.pool.Size())
+	for i := 0; i < c.pool.Size(); i++ {
+		c.grpcClients = append(c.grpcClients, querypb.NewQueryCoordClient(c.pool.Conn(i)))
 	}
-	log.Debug("QueryCoord Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to querycoord failed with error:\n" + err.Error())
-	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
-	}
-	return ret, err
+	return nil
 }
 
 func (c *Client) Start() error {
@@ -156,7 +105,12 @@ func (c *Client) Start() error {
 
 func (c *Client) Stop() error {
 	c.cancel()
-	return c.conn.Close()
+	return c.pool.Close()
+}
+
+// getGrpcClient returns the client of the next healthy connection of the pool
+func (c *Client) getGrpcClient() querypb.QueryCoordClient {
+	return c.grpcClients[c.pool.Pick()]
 }
 
 // Register dummy
@@ -165,92 +119,61 @@ func (c *Client) Register() error {
 }
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 func (c *Client) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowCollections(ctx, req)
-	})
-	return ret.(*querypb.ShowCollectionsResponse), err
+	return c.getGrpcClient().ShowCollections(ctx, req)
 }
 
 func (c *Client) LoadCollection(ctx context.Context, req *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.LoadCollection(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().LoadCollection(ctx, req)
 }
 
 func (c *Client) ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleaseCollection(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().ReleaseCollection(ctx, req)
 }
 
 func (c *Client) ShowPartitions(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowPartitions(ctx, req)
-	})
-	return ret.(*querypb.ShowPartitionsResponse), err
+	return c.getGrpcClient().ShowPartitions(ctx, req)
 }
 
 func (c *Client) LoadPartitions(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.LoadPartitions(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().LoadPartitions(ctx, req)
 }
 
 func (c *Client) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleasePartitions(ctx, req)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().ReleasePartitions(ctx, req)
 }
 
 func (c *Client) CreateQueryChannel(ctx context.Context, req *querypb.CreateQueryChannelRequest) (*querypb.CreateQueryChannelResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateQueryChannel(ctx, req)
-	})
-	return ret.(*querypb.CreateQueryChannelResponse), err
+	return c.getGrpcClient().CreateQueryChannel(ctx, req)
 }
 
 func (c *Client) GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetPartitionStates(ctx, req)
-	})
-	return ret.(*querypb.GetPartitionStatesResponse), err
+	return c.getGrpcClient().GetPartitionStates(ctx, req)
 }
 
 func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfo(ctx, req)
-	})
-	return ret.(*querypb.GetSegmentInfoResponse), err
+	return c.getGrpcClient().GetSegmentInfo(ctx, req)
+}
+
+func (c *Client) GetSegmentDistribution(ctx context.Context, req *querypb.GetSegmentDistributionRequest) (*querypb.GetSegmentDistributionResponse, error) {
+	return c.getGrpcClient().GetSegmentDistribution(ctx, req)
+}
+
+func (c *Client) RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().RefreshIndex(ctx, req)
 }
 
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetMetrics(ctx, req)
-	})
-	return ret.(*milvuspb.GetMetricsResponse), err
+	return c.getGrpcClient().GetMetrics(ctx, req)
 }
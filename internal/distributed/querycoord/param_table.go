@@ -34,6 +34,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 func (pt *ParamTable) Init() {
@@ -45,6 +46,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.GrpcServerConfig = grpcconfigs.LoadServerConfig(&pt.BaseTable, "queryCoord")
 	})
 }
 
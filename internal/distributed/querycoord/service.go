@@ -21,12 +21,14 @@ import (
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	pnc "github.com/milvus-io/milvus/internal/distributed/proxy/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	qc "github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -161,6 +163,22 @@ func (s *Server) init() error {
 	}
 	log.Debug("QueryCoord report DataCoord ready")
 
+	s.queryCoord.SetNewProxyClient(
+		func(se *sessionutil.Session) (types.Proxy, error) {
+			cli, err := pnc.NewClient(s.loopCtx, se.Address)
+			if err != nil {
+				return nil, err
+			}
+			if err := cli.Init(); err != nil {
+				return nil, err
+			}
+			if err := cli.Start(); err != nil {
+				return nil, err
+			}
+			return cli, nil
+		},
+	)
+
 	s.queryCoord.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("QueryCoord", zap.Any("State", internalpb.StateCode_Initializing))
 	if err := s.queryCoord.Init(); err != nil {
@@ -185,13 +203,14 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	serverOpts := append(Params.GrpcServerConfig.ServerOptions(),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
+	s.grpcServer = grpc.NewServer(serverOpts...)
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -276,6 +295,14 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	return s.queryCoord.GetSegmentInfo(ctx, req)
 }
 
+func (s *Server) GetSegmentDistribution(ctx context.Context, req *querypb.GetSegmentDistributionRequest) (*querypb.GetSegmentDistributionResponse, error) {
+	return s.queryCoord.GetSegmentDistribution(ctx, req)
+}
+
+func (s *Server) RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error) {
+	return s.queryCoord.RefreshIndex(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
 }
@@ -13,13 +13,9 @@ package grpcrootcoordclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -27,13 +23,12 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
-	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 )
 
 // GrpcClient grpc client
@@ -41,27 +36,11 @@ type GrpcClient struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	grpcClient rootcoordpb.RootCoordClient
-	conn       *grpc.ClientConn
+	// grpcClients are the clients of the connections of the pool
+	grpcClients []rootcoordpb.RootCoordClient
+	pool        *grpcclient.Pool
 
 	sess *sessionutil.Session
-	addr string
-}
-
-func getRootCoordAddr(sess *sessionutil.Session) (string, error) {
-	key := typeutil.RootCoordRole
-	msess, _, err := sess.GetSessions(key)
-	if err != nil {
-		log.Debug("RootCoordClient GetSessions failed", zap.Any("key", key))
-		return "", err
-	}
-	log.Debug("RootCoordClient GetSessions success")
-	ms, ok := msess[key]
-	if !ok {
-		log.Debug("RootCoordClient mess key not exist", zap.Any("key", key))
-		return "", fmt.Errorf("number of RootCoord is incorrect, %d", len(msess))
-	}
-	return ms.Address, nil
 }
 
 // NewClient create root coordinator client with specified ectd info and timeout
@@ -93,40 +72,24 @@ func (c *GrpcClient) Init() error {
 func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 	var err error
 	connectRootCoordAddrFn := func() error {
-		c.addr, err = getRootCoordAddr(c.sess)
-		if err != nil {
-			log.Debug("RootCoordClient getRootCoordAddr failed", zap.Error(err))
-			return err
-		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("RootCoordClient try reconnect ", zap.String("address", c.addr))
+		log.Debug("RootCoordClient try reconnect ", zap.String("role", typeutil.RootCoordRole))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
-			grpc.WithDefaultCallOptions(
-				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
-				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
-			grpc.WithUnaryInterceptor(
-				grpc_middleware.ChainUnaryClient(
-					grpc_retry.UnaryClientInterceptor(
-						grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
-				)),
-			grpc.WithStreamInterceptor(
-				grpc_middleware.ChainStreamClient(
-					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3),
-						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
-					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
-				)),
-		)
+		pool, err := grpcclient.DialPool(ctx, &grpcclient.Config{
+			Role:     typeutil.RootCoordRole,
+			Sessions: c.sess,
+			DialOptions: []grpc.DialOption{
+				grpc.WithInsecure(), grpc.WithBlock(),
+				Params.GrpcClientConfig.KeepaliveDialOption(),
+				grpc.WithDefaultCallOptions(
+					grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
+					grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
+			},
+		}, Params.GrpcClientConfig.PoolSize)
 		if err != nil {
 			return err
 		}
-		c.conn = conn
+		c.pool = pool
 		return nil
 	}
 
@@ -136,7 +99,10 @@ func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("RootCoordClient try reconnect success")
-	c.grpcClient = rootcoordpb.NewRootCoordClient(c.conn)
+	c.grpcClients = make([]rootcoordpb.RootCoordClient, 0, c.pool.Size())
+	for i := 0; i < c.pool.Size(); i++ {
+		c.grpcClients = append(c.grpcClients, rootcoordpb.NewRootCoordClient(c.pool.Conn(i)))
+	}
 	return nil
 }
 
@@ -146,7 +112,12 @@ func (c *GrpcClient) Start() error {
 
 func (c *GrpcClient) Stop() error {
 	c.cancel()
-	return c.conn.Close()
+	return c.pool.Close()
+}
+
+// getGrpcClient returns the client of the next healthy connection of the pool
+func (c *GrpcClient) getGrpcClient() rootcoordpb.RootCoordClient {
+	return c.grpcClients[c.pool.Pick()]
 }
 
 // Register dummy
@@ -154,182 +125,123 @@ func (c *GrpcClient) Register() error {
 	return nil
 }
 
-func (c *GrpcClient) recall(caller func() (interface{}, error)) (interface{}, error) {
-	ret, err := caller()
-	if err == nil {
-		return ret, nil
-	}
-	log.Debug("RootCoord Client grpc error", zap.Error(err))
-	err = c.connect()
-	if err != nil {
-		return ret, errors.New("Connect to rootcoord failed with error:\n" + err.Error())
-	}
-	ret, err = caller()
-	if err == nil {
-		return ret, nil
-	}
-	return ret, err
-}
-
 // GetComponentStates TODO: timeout need to be propagated through ctx
 func (c *GrpcClient) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
-	})
-	return ret.(*internalpb.ComponentStates), err
+	return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 }
 func (c *GrpcClient) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 }
 
 // GetStatisticsChannel just define a channel, not used currently
 func (c *GrpcClient) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
-	})
-	return ret.(*milvuspb.StringResponse), err
+	return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 }
 
 //DDL request
 func (c *GrpcClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateCollection(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().CreateCollection(ctx, in)
 }
 
 func (c *GrpcClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropCollection(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().DropCollection(ctx, in)
 }
 
 func (c *GrpcClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.HasCollection(ctx, in)
-	})
-	return ret.(*milvuspb.BoolResponse), err
+	return c.getGrpcClient().HasCollection(ctx, in)
 }
 func (c *GrpcClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeCollection(ctx, in)
-	})
-	return ret.(*milvuspb.DescribeCollectionResponse), err
+	return c.getGrpcClient().DescribeCollection(ctx, in)
 }
 
 func (c *GrpcClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowCollections(ctx, in)
-	})
-	return ret.(*milvuspb.ShowCollectionsResponse), err
+	return c.getGrpcClient().ShowCollections(ctx, in)
+}
+func (c *GrpcClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().AlterCollection(ctx, in)
 }
 func (c *GrpcClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreatePartition(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().CreatePartition(ctx, in)
 }
 
 func (c *GrpcClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropPartition(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().DropPartition(ctx, in)
 }
 
 func (c *GrpcClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.HasPartition(ctx, in)
-	})
-	return ret.(*milvuspb.BoolResponse), err
+	return c.getGrpcClient().HasPartition(ctx, in)
 }
 
 func (c *GrpcClient) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowPartitions(ctx, in)
-	})
-	return ret.(*milvuspb.ShowPartitionsResponse), err
+	return c.getGrpcClient().ShowPartitions(ctx, in)
 }
 
 // CreateIndex index builder service
 func (c *GrpcClient) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateIndex(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().CreateIndex(ctx, in)
 }
 
 func (c *GrpcClient) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropIndex(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().DropIndex(ctx, in)
 }
 
 func (c *GrpcClient) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeIndex(ctx, in)
-	})
-	return ret.(*milvuspb.DescribeIndexResponse), err
+	return c.getGrpcClient().DescribeIndex(ctx, in)
 }
 
 // AllocTimestamp global timestamp allocator
 func (c *GrpcClient) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AllocTimestamp(ctx, in)
-	})
-	return ret.(*rootcoordpb.AllocTimestampResponse), err
+	return c.getGrpcClient().AllocTimestamp(ctx, in)
 }
 
 func (c *GrpcClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AllocID(ctx, in)
-	})
-	return ret.(*rootcoordpb.AllocIDResponse), err
+	return c.getGrpcClient().AllocID(ctx, in)
 }
 
 // UpdateChannelTimeTick used to handle ChannelTimeTickMsg
 func (c *GrpcClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.UpdateChannelTimeTick(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().UpdateChannelTimeTick(ctx, in)
 }
 
 // DescribeSegment receiver time tick from proxy service, and put it into this channel
 func (c *GrpcClient) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeSegment(ctx, in)
-	})
-	return ret.(*milvuspb.DescribeSegmentResponse), err
+	return c.getGrpcClient().DescribeSegment(ctx, in)
 }
 
 func (c *GrpcClient) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowSegments(ctx, in)
-	})
-	return ret.(*milvuspb.ShowSegmentsResponse), err
+	return c.getGrpcClient().ShowSegments(ctx, in)
 }
 func (c *GrpcClient) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleaseDQLMessageStream(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().ReleaseDQLMessageStream(ctx, in)
 }
 func (c *GrpcClient) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.SegmentFlushCompleted(ctx, in)
-	})
-	return ret.(*commonpb.Status), err
+	return c.getGrpcClient().SegmentFlushCompleted(ctx, in)
+}
+
+func (c *GrpcClient) CreateApiKey(ctx context.Context, in *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return c.getGrpcClient().CreateApiKey(ctx, in)
+}
+func (c *GrpcClient) RotateApiKey(ctx context.Context, in *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return c.getGrpcClient().RotateApiKey(ctx, in)
+}
+func (c *GrpcClient) DropApiKey(ctx context.Context, in *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().DropApiKey(ctx, in)
+}
+func (c *GrpcClient) ListApiKeys(ctx context.Context, in *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return c.getGrpcClient().ListApiKeys(ctx, in)
+}
+func (c *GrpcClient) GetQuotaUsage(ctx context.Context, in *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	return c.getGrpcClient().GetQuotaUsage(ctx, in)
+}
+
+func (c *GrpcClient) UndropCollection(ctx context.Context, in *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return c.getGrpcClient().UndropCollection(ctx, in)
+}
+
+func (c *GrpcClient) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return c.getGrpcClient().ListDroppedCollections(ctx, in)
 }
 
 func (c *GrpcClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetMetrics(ctx, in)
-	})
-	return ret.(*milvuspb.GetMetricsResponse), err
+	return c.getGrpcClient().GetMetrics(ctx, in)
 }
@@ -37,6 +37,7 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+	GrpcServerConfig  grpcconfigs.ServerConfig
 }
 
 func (p *ParamTable) Init() {
@@ -54,6 +55,7 @@ func (p *ParamTable) Init() {
 
 		p.initServerMaxSendSize()
 		p.initServerMaxRecvSize()
+		p.GrpcServerConfig = grpcconfigs.LoadServerConfig(&p.BaseTable, "rootCoord")
 	})
 }
 
@@ -205,11 +205,12 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	serverOpts := append(Params.GrpcServerConfig.ServerOptions(),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(grpc_opentracing.StreamServerInterceptor(opts...)))
+	s.grpcServer = grpc.NewServer(serverOpts...)
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -295,6 +296,10 @@ func (s *Server) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectio
 	return s.rootCoord.ShowCollections(ctx, in)
 }
 
+func (s *Server) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterCollection(ctx, in)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreatePartition(ctx, in)
 }
@@ -353,6 +358,34 @@ func (s *Server) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFl
 	return s.rootCoord.SegmentFlushCompleted(ctx, in)
 }
 
+func (s *Server) CreateApiKey(ctx context.Context, in *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return s.rootCoord.CreateApiKey(ctx, in)
+}
+
+func (s *Server) RotateApiKey(ctx context.Context, in *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return s.rootCoord.RotateApiKey(ctx, in)
+}
+
+func (s *Server) DropApiKey(ctx context.Context, in *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropApiKey(ctx, in)
+}
+
+func (s *Server) ListApiKeys(ctx context.Context, in *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return s.rootCoord.ListApiKeys(ctx, in)
+}
+
+func (s *Server) GetQuotaUsage(ctx context.Context, in *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	return s.rootCoord.GetQuotaUsage(ctx, in)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, in *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UndropCollection(ctx, in)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.rootCoord.ListDroppedCollections(ctx, in)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.rootCoord.GetMetrics(ctx, in)
 }
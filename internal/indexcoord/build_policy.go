@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// expensiveIndexTypes are the graph based indexes whose build cost is not worth paying
+// for segments that are small and may soon be dropped or compacted.
+var expensiveIndexTypes = map[indexparamcheck.IndexType]struct{}{
+	indexparamcheck.IndexHNSW:      {},
+	indexparamcheck.IndexRHNSWFlat: {},
+	indexparamcheck.IndexRHNSWPQ:   {},
+	indexparamcheck.IndexRHNSWSQ:   {},
+	indexparamcheck.IndexNSG:       {},
+	indexparamcheck.IndexANNOY:     {},
+	indexparamcheck.IndexNGTPANNG:  {},
+	indexparamcheck.IndexNGTONNG:   {},
+}
+
+func isExpensiveIndex(indexParams []*commonpb.KeyValuePair) bool {
+	for _, kv := range indexParams {
+		if kv.Key == "index_type" {
+			_, ok := expensiveIndexTypes[kv.Value]
+			return ok
+		}
+	}
+	return false
+}
+
+// buildDeferPolicy decides whether an unissued index task should be held back for now,
+// a deferred segment is searched by brute force until its index is built.
+type buildDeferPolicy func(meta *indexpb.IndexMeta, now time.Time) bool
+
+// deferNothingPolicy assigns every task as soon as possible
+func deferNothingPolicy(meta *indexpb.IndexMeta, now time.Time) bool {
+	return false
+}
+
+// getTemperaturePolicy defers expensive index builds until the segment has at least minRows rows
+// or the task is older than minAge
+func getTemperaturePolicy(minRows int64, minAge time.Duration) buildDeferPolicy {
+	return func(meta *indexpb.IndexMeta, now time.Time) bool {
+		if !isExpensiveIndex(meta.GetReq().GetIndexParams()) {
+			return false
+		}
+		if meta.GetReq().GetNumRows() >= minRows {
+			return false
+		}
+		return now.Sub(time.Unix(meta.GetCreateTime(), 0)) < minAge
+	}
+}
+
+// isRetryableFailure returns whether a failed index build may succeed when it is retried
+func isRetryableFailure(class indexpb.IndexFailClass) bool {
+	return class == indexpb.IndexFailClass_Transient || class == indexpb.IndexFailClass_OutOfMemory
+}
+
+// retryBackoff returns the time to wait before the retryCount-th retry of a failed build,
+// which is doubled on every retry up to maxBackoff
+func retryBackoff(retryCount int32, backoff, maxBackoff time.Duration) time.Duration {
+	for i := int32(1); i < retryCount && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// getParam returns the value of key in params, the values nested in the "params" json are looked up too
+func getParam(params []*commonpb.KeyValuePair, key string) (string, bool) {
+	for _, kv := range params {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	for _, kv := range params {
+		if kv.Key != "params" {
+			continue
+		}
+		nested, err := funcutil.ParseIndexParamsMap(kv.Value)
+		if err != nil {
+			continue
+		}
+		if value, ok := nested[key]; ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// estimateTaskSize returns the bytes of the vectors a build loads, which is rows * dim * 4 for the float vectors
+// and rows * dim / 8 for the binary vectors, 0 if the dim is unknown
+func estimateTaskSize(req *indexpb.BuildIndexRequest) int64 {
+	value, ok := getParam(req.GetTypeParams(), "dim")
+	if !ok {
+		return 0
+	}
+	dim, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || dim <= 0 {
+		return 0
+	}
+	indexType, _ := getParam(req.GetIndexParams(), "index_type")
+	if strings.HasPrefix(indexType, "BIN_") {
+		return req.GetNumRows() * dim / 8
+	}
+	return req.GetNumRows() * dim * 4
+}
+
+// requiresGPU returns whether the build is of a gpu index, which is assigned to the gpu nodes
+func requiresGPU(req *indexpb.BuildIndexRequest) bool {
+	indexType, _ := getParam(req.GetIndexParams(), "index_type")
+	mode, _ := getParam(req.GetIndexParams(), indexparamcheck.IndexMode)
+	return indexparamcheck.IsGPUIndex(indexType, mode)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestIndexMeta(indexType string, numRows int64, createTime time.Time) *indexpb.IndexMeta {
+	return &indexpb.IndexMeta{
+		Req: &indexpb.BuildIndexRequest{
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: "index_type", Value: indexType},
+				{Key: "metric_type", Value: "L2"},
+			},
+			NumRows: numRows,
+		},
+		CreateTime: createTime.Unix(),
+	}
+}
+
+func TestIsExpensiveIndex(t *testing.T) {
+	assert.True(t, isExpensiveIndex(newTestIndexMeta("HNSW", 0, time.Now()).Req.IndexParams))
+	assert.False(t, isExpensiveIndex(newTestIndexMeta("IVF_FLAT", 0, time.Now()).Req.IndexParams))
+	assert.False(t, isExpensiveIndex(nil))
+}
+
+func TestTemperaturePolicy(t *testing.T) {
+	now := time.Now()
+	policy := getTemperaturePolicy(1000, time.Minute)
+
+	t.Run("young small segment", func(t *testing.T) {
+		assert.True(t, policy(newTestIndexMeta("HNSW", 10, now), now))
+	})
+
+	t.Run("large segment", func(t *testing.T) {
+		assert.False(t, policy(newTestIndexMeta("HNSW", 1000, now), now))
+	})
+
+	t.Run("old segment", func(t *testing.T) {
+		assert.False(t, policy(newTestIndexMeta("HNSW", 10, now.Add(-2*time.Minute)), now))
+	})
+
+	t.Run("cheap index", func(t *testing.T) {
+		assert.False(t, policy(newTestIndexMeta("IVF_FLAT", 10, now), now))
+	})
+
+	t.Run("meta without create time", func(t *testing.T) {
+		meta := newTestIndexMeta("HNSW", 10, now)
+		meta.CreateTime = 0
+		assert.False(t, policy(meta, now))
+	})
+
+	assert.False(t, deferNothingPolicy(newTestIndexMeta("HNSW", 10, now), now))
+}
+
+func TestRetryPolicy(t *testing.T) {
+	assert.True(t, isRetryableFailure(indexpb.IndexFailClass_Transient))
+	assert.True(t, isRetryableFailure(indexpb.IndexFailClass_OutOfMemory))
+	assert.False(t, isRetryableFailure(indexpb.IndexFailClass_CorruptBinlog))
+	assert.False(t, isRetryableFailure(indexpb.IndexFailClass_UnsupportedParam))
+	assert.False(t, isRetryableFailure(indexpb.IndexFailClass_FailClassNone))
+
+	assert.Equal(t, 10*time.Second, retryBackoff(1, 10*time.Second, time.Minute))
+	assert.Equal(t, 20*time.Second, retryBackoff(2, 10*time.Second, time.Minute))
+	assert.Equal(t, 40*time.Second, retryBackoff(3, 10*time.Second, time.Minute))
+	assert.Equal(t, time.Minute, retryBackoff(4, 10*time.Second, time.Minute))
+	assert.Equal(t, time.Minute, retryBackoff(100, 10*time.Second, time.Minute))
+}
+
+func TestEstimateTaskSize(t *testing.T) {
+	req := &indexpb.BuildIndexRequest{
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "IVF_FLAT"},
+		},
+		NumRows: 1000,
+	}
+	assert.Equal(t, int64(1000*128*4), estimateTaskSize(req))
+
+	req.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: "BIN_IVF_FLAT"}}
+	assert.Equal(t, int64(1000*128/8), estimateTaskSize(req))
+
+	req.TypeParams = []*commonpb.KeyValuePair{{Key: "params", Value: "{\"dim\": 64}"}}
+	req.IndexParams = []*commonpb.KeyValuePair{{Key: "params", Value: "{\"index_type\": \"HNSW\", \"M\": 16}"}}
+	assert.Equal(t, int64(1000*64*4), estimateTaskSize(req))
+
+	req.TypeParams = nil
+	assert.Equal(t, int64(0), estimateTaskSize(req))
+	req.TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: "invalid"}}
+	assert.Equal(t, int64(0), estimateTaskSize(req))
+}
+
+func TestRequiresGPU(t *testing.T) {
+	assert.True(t, requiresGPU(newTestIndexMeta("IVF_SQ8_HYBRID", 0, time.Now()).Req))
+	assert.False(t, requiresGPU(newTestIndexMeta("IVF_PQ", 0, time.Now()).Req))
+
+	req := newTestIndexMeta("IVF_PQ", 0, time.Now()).Req
+	req.IndexParams = append(req.IndexParams, &commonpb.KeyValuePair{Key: "params", Value: "{\"index_mode\": \"GPU\"}"})
+	assert.True(t, requiresGPU(req))
+}
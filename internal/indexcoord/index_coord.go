@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/notify"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -72,8 +73,13 @@ type IndexCoord struct {
 	metaTable   *metaTable
 	nodeManager *NodeManager
 
+	deferPolicy buildDeferPolicy
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	// sends the built indexes and the down indexnodes to the configured webhooks, nil if not enabled
+	notifier *notify.Notifier
+
 	nodeLock sync.RWMutex
 
 	// Add callback functions at different stages
@@ -139,7 +145,7 @@ func (i *IndexCoord) Init() error {
 	for _, session := range sessions {
 		session := session
 		go func() {
-			if err := i.nodeManager.AddNode(session.ServerID, session.Address); err != nil {
+			if err := i.nodeManager.AddNode(session.ServerID, session.Address, session.Labels); err != nil {
 				log.Debug("IndexCoord", zap.Any("ServerID", session.ServerID),
 					zap.Any("Add IndexNode error", err))
 			}
@@ -148,9 +154,11 @@ func (i *IndexCoord) Init() error {
 	}
 	log.Debug("IndexCoord", zap.Any("IndexNode number", len(i.nodeManager.nodeClients)))
 	i.eventChan = i.session.WatchServices(typeutil.IndexNodeRole, revision+1)
-	nodeTasks := i.metaTable.GetNodeTaskStats()
-	for nodeID, taskNum := range nodeTasks {
-		i.nodeManager.pq.UpdatePriority(nodeID, taskNum)
+	nodeTasks := i.metaTable.GetNodeTasks()
+	for nodeID, tasks := range nodeTasks {
+		for indexBuildID, taskSize := range tasks {
+			i.nodeManager.addTask(nodeID, indexBuildID, taskSize)
+		}
 	}
 
 	//init idAllocator
@@ -196,6 +204,19 @@ func (i *IndexCoord) Init() error {
 
 	i.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
+	// IndexCoord has no msg stream, the events are only sent to the webhooks
+	i.notifier, err = notify.NewNotifierFromConfig(i.loopCtx, typeutil.IndexCoordRole, notify.LoadConfig(&Params.BaseTable), nil)
+	if err != nil {
+		return err
+	}
+
+	i.deferPolicy = deferNothingPolicy
+	if Params.DeferBuildEnabled {
+		i.deferPolicy = getTemperaturePolicy(Params.DeferBuildMinRows, Params.DeferBuildMinAge)
+		log.Debug("IndexCoord defer expensive index builds", zap.Int64("minRows", Params.DeferBuildMinRows),
+			zap.Duration("minAge", Params.DeferBuildMinAge))
+	}
+
 	log.Debug("IndexCoord assign tasks server success", zap.Error(err))
 	return nil
 }
@@ -216,7 +237,16 @@ func (i *IndexCoord) Start() error {
 	i.loopWg.Add(1)
 	go i.watchMetaLoop()
 
+	i.loopWg.Add(1)
+	go i.retryLoop()
+
+	if Params.ReencodeEnabled {
+		i.loopWg.Add(1)
+		go i.reencodeLoop()
+	}
+
 	i.sched.Start()
+	i.notifier.Start()
 	// Start callbacks
 	for _, cb := range i.startCallbacks {
 		cb()
@@ -232,6 +262,7 @@ func (i *IndexCoord) Stop() error {
 	i.loopCancel()
 	i.sched.Close()
 	i.loopWg.Wait()
+	i.notifier.Stop()
 	for _, cb := range i.closeCallbacks {
 		cb()
 	}
@@ -422,6 +453,60 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 	return ret, nil
 }
 
+// PauseIndexBuild holds back the unassigned index builds of the collection, the running builds go on
+func (i *IndexCoord) PauseIndexBuild(ctx context.Context, req *indexpb.PauseIndexBuildRequest) (*commonpb.Status, error) {
+	log.Debug("IndexCoord PauseIndexBuild", zap.Int64("collectionID", req.CollectionID))
+	if err := i.metaTable.SetBuildPaused(req.CollectionID, true); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (i *IndexCoord) ResumeIndexBuild(ctx context.Context, req *indexpb.ResumeIndexBuildRequest) (*commonpb.Status, error) {
+	log.Debug("IndexCoord ResumeIndexBuild", zap.Int64("collectionID", req.CollectionID))
+	if err := i.metaTable.SetBuildPaused(req.CollectionID, false); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// SetIndexBuildPriority sets the priority of the index builds of the collection, such as a negative priority
+// for the reindex of a large collection so that the builds of the newly flushed segments go first
+func (i *IndexCoord) SetIndexBuildPriority(ctx context.Context, req *indexpb.SetIndexBuildPriorityRequest) (*commonpb.Status, error) {
+	log.Debug("IndexCoord SetIndexBuildPriority", zap.Int64("collectionID", req.CollectionID),
+		zap.Int32("priority", req.Priority))
+	if err := i.metaTable.SetBuildPriority(req.CollectionID, req.Priority); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// GetIndexStatistics returns the sizes of the index files of the collection by the index
+func (i *IndexCoord) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	log.Debug("IndexCoord GetIndexStatistics", zap.Int64("collectionID", req.CollectionID))
+	return &indexpb.GetIndexStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Statistics: i.metaTable.GetIndexStatistics(req.CollectionID),
+	}, nil
+}
+
 func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	log.Debug("IndexCoord GetIndexFilePaths", zap.Int64s("IndexBuildIds", req.IndexBuildIDs))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
@@ -509,6 +594,14 @@ func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReq
 		return metrics, err
 	}
 
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, i.session.ServerID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.IndexCoordRole, i.session.ServerID), &Params)
+	}
+
 	log.Debug("IndexCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", i.ID),
 		zap.String("req", req.Request),
@@ -593,6 +686,82 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 	}
 }
 
+// reencodeLoop rebuilds the indexes of older file formats in the current format, at most ReencodeMaxTasks at once,
+// so that the indexes are upgraded between releases without being dropped and rebuilt by the users
+func (i *IndexCoord) reencodeLoop() {
+	ctx, cancel := context.WithCancel(i.loopCtx)
+
+	defer cancel()
+	defer i.loopWg.Done()
+
+	timeTicker := time.NewTicker(durationInterval)
+	defer timeTicker.Stop()
+	log.Debug("IndexCoord start reencode loop", zap.Int32("formatVersion", typeutil.IndexFormatVersion))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeTicker.C:
+			i.reencodeIndexes()
+		}
+	}
+}
+
+// reencodeIndexes reissues the builds of the indexes of older file formats up to the limit of the running ones
+func (i *IndexCoord) reencodeIndexes() {
+	limit := Params.ReencodeMaxTasks - i.metaTable.GetNumReencodingTasks()
+	if limit <= 0 {
+		return
+	}
+	for _, meta := range i.metaTable.GetIndexesToReencode(limit) {
+		indexBuildID := meta.indexMeta.IndexBuildID
+		if err := i.metaTable.ReencodeIndex(indexBuildID); err != nil {
+			log.Debug("IndexCoord reencode index failed", zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+			continue
+		}
+		log.Debug("IndexCoord reencode index", zap.Int64("indexBuildID", indexBuildID),
+			zap.Int32("formatVersion", meta.indexMeta.FormatVersion))
+	}
+}
+
+// retryLoop reissues the failed builds of the transient fail classes with backoff, the failed builds of the other
+// classes, or retried more than RetryMaxTimes, stay failed and are reported by GetIndexStates
+func (i *IndexCoord) retryLoop() {
+	ctx, cancel := context.WithCancel(i.loopCtx)
+
+	defer cancel()
+	defer i.loopWg.Done()
+
+	timeTicker := time.NewTicker(durationInterval)
+	defer timeTicker.Stop()
+	log.Debug("IndexCoord start retry loop", zap.Int32("maxTimes", Params.RetryMaxTimes))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeTicker.C:
+			i.retryIndexes(time.Now())
+		}
+	}
+}
+
+// retryIndexes reissues the failed builds to retry, which are assigned after their backoff
+func (i *IndexCoord) retryIndexes(now time.Time) {
+	for _, meta := range i.metaTable.GetIndexesToRetry(Params.RetryMaxTimes) {
+		indexBuildID := meta.indexMeta.IndexBuildID
+		backoff := retryBackoff(meta.indexMeta.RetryCount+1, Params.RetryBackoff, Params.RetryMaxBackoff)
+		if err := i.metaTable.RetryIndex(indexBuildID, now.Add(backoff)); err != nil {
+			log.Debug("IndexCoord retry index failed", zap.Int64("indexBuildID", indexBuildID), zap.Error(err))
+			continue
+		}
+		log.Debug("IndexCoord retry index", zap.Int64("indexBuildID", indexBuildID),
+			zap.String("failClass", meta.indexMeta.FailClass.String()),
+			zap.Int32("retryCount", meta.indexMeta.RetryCount+1), zap.Duration("backoff", backoff))
+	}
+}
+
 func (i *IndexCoord) watchNodeLoop() {
 	ctx, cancel := context.WithCancel(i.loopCtx)
 
@@ -612,7 +781,7 @@ func (i *IndexCoord) watchNodeLoop() {
 				log.Debug("IndexCoord watchNodeLoop SessionAddEvent", zap.Any("serverID", serverID),
 					zap.Any("address", event.Session.Address))
 				go func() {
-					err := i.nodeManager.AddNode(serverID, event.Session.Address)
+					err := i.nodeManager.AddNode(serverID, event.Session.Address, event.Session.Labels)
 					if err != nil {
 						log.Error("IndexCoord", zap.Any("Add IndexNode err", err))
 					}
@@ -624,6 +793,11 @@ func (i *IndexCoord) watchNodeLoop() {
 				log.Debug("IndexCoord watchNodeLoop SessionDelEvent", zap.Any("serverID", serverID))
 				i.nodeManager.RemoveNode(serverID)
 				i.metricsCacheManager.InvalidateSystemInfoMetrics()
+				i.notifier.Notify(notify.NodeDown, map[string]interface{}{
+					"role":      typeutil.IndexNodeRole,
+					"server_id": serverID,
+					"address":   event.Session.Address,
+				})
 			}
 		}
 	}
@@ -655,11 +829,20 @@ func (i *IndexCoord) watchMetaLoop() {
 				case mvccpb.PUT:
 					reload := i.metaTable.LoadMetaFromETCD(indexBuildID, eventRevision)
 					log.Debug("IndexCoord watchMetaLoop PUT", zap.Any("IndexBuildID", indexBuildID), zap.Any("reload", reload))
-					if reload {
+					if reload && (indexMeta.State == commonpb.IndexState_Finished || indexMeta.State == commonpb.IndexState_Failed) {
 						log.Debug("This task has finished", zap.Int64("indexBuildID", indexBuildID),
 							zap.Int64("Finish by IndexNode", indexMeta.NodeID),
 							zap.Int64("The version of the task", indexMeta.Version))
-						i.nodeManager.pq.IncPriority(indexMeta.NodeID, -1)
+						i.nodeManager.finishTask(indexMeta.NodeID, indexBuildID)
+						if indexMeta.State == commonpb.IndexState_Finished {
+							i.notifier.Notify(notify.IndexBuilt, map[string]interface{}{
+								"index_build_id": indexBuildID,
+								"index_id":       indexMeta.GetReq().GetIndexID(),
+								"index_name":     indexMeta.GetReq().GetIndexName(),
+								"collection_id":  indexMeta.GetReq().GetCollectionID(),
+								"node_id":        indexMeta.NodeID,
+							})
+						}
 					}
 				case mvccpb.DELETE:
 					log.Debug("IndexCoord watchMetaLoop DELETE", zap.Any("The meta has been deleted of indexBuildID", indexBuildID))
@@ -685,6 +868,39 @@ func (i *IndexCoord) assignTask(builderClient types.IndexNode, req *indexpb.Crea
 	return true
 }
 
+// getTasksToAssign returns the unassigned tasks except the ones held back by the defer policy or the retry backoff
+// and the ones of the paused collections, the tasks of higher collection priorities come first, then the ones of lower versions
+func (i *IndexCoord) getTasksToAssign(onlineNodeIDs []int64) []Meta {
+	metas := i.metaTable.GetUnassignedTasks(onlineNodeIDs)
+	controls := i.metaTable.GetBuildControls()
+	now := time.Now()
+	ret := make([]Meta, 0, len(metas))
+	for _, meta := range metas {
+		if controls[meta.indexMeta.Req.GetCollectionID()].GetPaused() {
+			continue
+		}
+		if meta.indexMeta.State == commonpb.IndexState_Unissued && meta.indexMeta.RetryTime > now.Unix() {
+			continue
+		}
+		if meta.indexMeta.State == commonpb.IndexState_Unissued && i.deferPolicy(meta.indexMeta, now) {
+			log.Debug("IndexCoord defer the build of small young segment",
+				zap.Int64("indexBuildID", meta.indexMeta.IndexBuildID),
+				zap.Int64("numRows", meta.indexMeta.Req.NumRows))
+			continue
+		}
+		ret = append(ret, meta)
+	}
+	sort.Slice(ret, func(x, y int) bool {
+		px := controls[ret[x].indexMeta.Req.GetCollectionID()].GetPriority()
+		py := controls[ret[y].indexMeta.Req.GetCollectionID()].GetPriority()
+		if px != py {
+			return px > py
+		}
+		return ret[x].indexMeta.Version < ret[y].indexMeta.Version
+	})
+	return ret
+}
+
 func (i *IndexCoord) assignTaskLoop() {
 	ctx, cancel := context.WithCancel(i.loopCtx)
 
@@ -713,23 +929,31 @@ func (i *IndexCoord) assignTaskLoop() {
 				serverIDs = append(serverIDs, session.ServerID)
 			}
 			log.Debug("IndexCoord assignTaskLoop", zap.Any("Available IndexNode IDs", serverIDs))
-			metas := i.metaTable.GetUnassignedTasks(serverIDs)
-			sort.Slice(metas, func(i, j int) bool {
-				return metas[i].indexMeta.Version <= metas[j].indexMeta.Version
-			})
+			metas := i.getTasksToAssign(serverIDs)
 			log.Debug("IndexCoord assignTaskLoop", zap.Any("Unassigned tasks number", len(metas)), zap.Any("Unassigned tasks meta", metas))
 			for index, meta := range metas {
 				indexBuildID := meta.indexMeta.IndexBuildID
+				taskSize := estimateTaskSize(meta.indexMeta.Req)
+				gpu := requiresGPU(meta.indexMeta.Req)
+				nodeID, builderClient := i.nodeManager.PeekClient(taskSize, gpu)
+				if builderClient == nil {
+					log.Debug("IndexCoord assignmentTasksLoop can not find available IndexNode")
+					// the later tasks may still go to the nodes of other devices
+					continue
+				}
+				log.Debug("IndexCoord PeekClient success", zap.Int64("nodeID", nodeID), zap.Int64("taskSize", taskSize),
+					zap.Bool("gpu", gpu))
+				if meta.indexMeta.State == commonpb.IndexState_InProgress {
+					// the node of the task is gone, the version bump below makes the node drop
+					// the result if it comes back
+					log.Debug("IndexCoord reassign the task of the offline IndexNode", zap.Int64("indexBuildID", indexBuildID),
+						zap.Int64("offline nodeID", meta.indexMeta.NodeID))
+					i.nodeManager.finishTask(meta.indexMeta.NodeID, indexBuildID)
+				}
 				if err = i.metaTable.UpdateVersion(indexBuildID); err != nil {
 					log.Debug("IndexCoord assignmentTasksLoop metaTable.UpdateVersion failed", zap.Error(err))
 				}
 				log.Debug("The version of the task has been updated", zap.Int64("indexBuildID", indexBuildID))
-				nodeID, builderClient := i.nodeManager.PeekClient()
-				if builderClient == nil {
-					log.Debug("IndexCoord assignmentTasksLoop can not find available IndexNode")
-					break
-				}
-				log.Debug("IndexCoord PeekClient success", zap.Int64("nodeID", nodeID))
 				req := &indexpb.CreateIndexRequest{
 					IndexBuildID: indexBuildID,
 					IndexName:    meta.indexMeta.Req.IndexName,
@@ -749,7 +973,7 @@ func (i *IndexCoord) assignTaskLoop() {
 				}
 				log.Debug("This task has been assigned", zap.Int64("indexBuildID", indexBuildID),
 					zap.Int64("The IndexNode execute this task", nodeID))
-				i.nodeManager.pq.IncPriority(nodeID, 1)
+				i.nodeManager.addTask(nodeID, indexBuildID, taskSize)
 				if index > taskLimit {
 					break
 				}
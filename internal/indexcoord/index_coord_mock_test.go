@@ -76,6 +76,30 @@ func TestIndexCoordMock(t *testing.T) {
 		assert.Equal(t, commonpb.IndexState_Finished, resp.States[0].State)
 	})
 
+	t.Run("PauseIndexBuild", func(t *testing.T) {
+		resp, err := icm.PauseIndexBuild(ctx, &indexpb.PauseIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuild", func(t *testing.T) {
+		resp, err := icm.ResumeIndexBuild(ctx, &indexpb.ResumeIndexBuildRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexBuildPriority", func(t *testing.T) {
+		resp, err := icm.SetIndexBuildPriority(ctx, &indexpb.SetIndexBuildPriorityRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("GetIndexStatistics", func(t *testing.T) {
+		resp, err := icm.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("DropIndex", func(t *testing.T) {
 		req := &indexpb.DropIndexRequest{
 			IndexID: 0,
@@ -161,6 +185,30 @@ func TestIndexCoordMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("PauseIndexBuild", func(t *testing.T) {
+		resp, err := icm.PauseIndexBuild(ctx, &indexpb.PauseIndexBuildRequest{CollectionID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
+	t.Run("ResumeIndexBuild", func(t *testing.T) {
+		resp, err := icm.ResumeIndexBuild(ctx, &indexpb.ResumeIndexBuildRequest{CollectionID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
+	t.Run("SetIndexBuildPriority", func(t *testing.T) {
+		resp, err := icm.SetIndexBuildPriority(ctx, &indexpb.SetIndexBuildPriorityRequest{CollectionID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
+	t.Run("GetIndexStatistics", func(t *testing.T) {
+		resp, err := icm.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: 1})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	t.Run("DropIndex", func(t *testing.T) {
 		req := &indexpb.DropIndexRequest{
 			IndexID: 0,
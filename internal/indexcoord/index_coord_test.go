@@ -122,6 +122,21 @@ func TestIndexCoord(t *testing.T) {
 		assert.Equal(t, "IndexFilePath-2", resp.FilePaths[0].IndexFilePaths[1])
 	})
 
+	t.Run("Get Index Statistics", func(t *testing.T) {
+		resp, err := ic.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		var stats *indexpb.IndexStatistics
+		for _, s := range resp.Statistics {
+			if s.IndexID == indexID {
+				stats = s
+			}
+		}
+		assert.NotNil(t, stats)
+		assert.Equal(t, int64(1), stats.FinishedCount)
+		assert.Equal(t, int64(1024), stats.SerializedSize)
+	})
+
 	time.Sleep(10 * time.Second)
 
 	t.Run("Drop Index", func(t *testing.T) {
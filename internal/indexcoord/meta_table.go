@@ -15,8 +15,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/retry"
 
@@ -27,8 +29,11 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+const buildControlPrefix = "buildControls"
+
 type Meta struct {
 	indexMeta *indexpb.IndexMeta
 	revision  int64
@@ -37,6 +42,9 @@ type Meta struct {
 type metaTable struct {
 	client            *etcdkv.EtcdKV    // client of a reliable kv service, i.e. etcd client
 	indexBuildID2Meta map[UniqueID]Meta // index build id to index meta
+	// collection id to the pause and priority of the index builds of the collection,
+	// the collections of the default control are not kept
+	buildControls map[UniqueID]*indexpb.CollectionBuildControl
 
 	lock sync.RWMutex
 }
@@ -77,6 +85,20 @@ func (mt *metaTable) reloadFromKV() error {
 		}
 		mt.indexBuildID2Meta[indexMeta.IndexBuildID] = *meta
 	}
+
+	mt.buildControls = make(map[UniqueID]*indexpb.CollectionBuildControl)
+	_, values, err = mt.client.LoadWithPrefix(buildControlPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		control := &indexpb.CollectionBuildControl{}
+		err = proto.UnmarshalText(value, control)
+		if err != nil {
+			return fmt.Errorf("IndexCoord metaTable reloadFromKV UnmarshalText indexpb.CollectionBuildControl err:%w", err)
+		}
+		mt.buildControls[control.CollectionID] = control
+	}
 	return nil
 }
 
@@ -141,6 +163,7 @@ func (mt *metaTable) AddIndex(indexBuildID UniqueID, req *indexpb.BuildIndexRequ
 			Req:          req,
 			NodeID:       0,
 			Version:      0,
+			CreateTime:   time.Now().Unix(),
 		},
 		revision: 0,
 	}
@@ -269,6 +292,12 @@ func (mt *metaTable) GetIndexStates(indexBuildIDs []UniqueID) []*indexpb.IndexIn
 			state.IndexID = meta.indexMeta.Req.IndexID
 			state.IndexName = meta.indexMeta.Req.IndexName
 			state.Reason = meta.indexMeta.FailReason
+			state.FailClass = meta.indexMeta.FailClass
+			// the indexes being re-encoded, or failed to, serve the files of the previous format
+			if len(meta.indexMeta.IndexFilePaths) > 0 {
+				state.State = commonpb.IndexState_Finished
+				state.Reason = ""
+			}
 		}
 		indexStates = append(indexStates, state)
 	}
@@ -357,6 +386,140 @@ func (mt *metaTable) GetUnusedIndexFiles(limit int) []Meta {
 	return metas
 }
 
+// GetIndexesToReencode returns at most limit finished indexes of older file formats than the current one
+func (mt *metaTable) GetIndexesToReencode(limit int) []Meta {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	var metas []Meta
+	for _, meta := range mt.indexBuildID2Meta {
+		if len(metas) >= limit {
+			break
+		}
+		if meta.indexMeta.State == commonpb.IndexState_Finished && !meta.indexMeta.MarkDeleted &&
+			meta.indexMeta.FormatVersion < typeutil.IndexFormatVersion {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+// GetNumReencodingTasks returns the number of the indexes being re-encoded
+func (mt *metaTable) GetNumReencodingTasks() int {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	num := 0
+	for _, meta := range mt.indexBuildID2Meta {
+		if (meta.indexMeta.State == commonpb.IndexState_Unissued || meta.indexMeta.State == commonpb.IndexState_InProgress) &&
+			len(meta.indexMeta.IndexFilePaths) > 0 {
+			num++
+		}
+	}
+	return num
+}
+
+// ReencodeIndex reissues the build of a finished index to write its files in the current format, the index keeps
+// its file paths until the build finishes, and the files of the previous format are recycled then
+func (mt *metaTable) ReencodeIndex(indexBuildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	log.Debug("IndexCoord metaTable ReencodeIndex", zap.Any("indexBuildID", indexBuildID), zap.Any("exists", ok))
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.State != commonpb.IndexState_Finished {
+		return fmt.Errorf("index is not finished with ID = %d", indexBuildID)
+	}
+
+	reencode := func(indexMeta *indexpb.IndexMeta) {
+		indexMeta.State = commonpb.IndexState_Unissued
+		indexMeta.FailReason = ""
+		indexMeta.Recycled = false
+	}
+	reencode(meta.indexMeta)
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(meta.indexMeta.IndexBuildID)
+			if m == nil {
+				return err
+			}
+			reencode(m.indexMeta)
+			return mt.saveIndexMeta(m)
+		}
+		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
+		if err2 != nil {
+			meta.indexMeta.State = commonpb.IndexState_Finished
+			log.Debug("IndexCoord metaTable ReencodeIndex failed", zap.Error(err2))
+			return err2
+		}
+	}
+
+	return nil
+}
+
+// GetIndexesToRetry returns the failed builds of the retryable fail classes which were retried less than maxRetries times
+func (mt *metaTable) GetIndexesToRetry(maxRetries int32) []Meta {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	var metas []Meta
+	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.State == commonpb.IndexState_Failed && !meta.indexMeta.MarkDeleted &&
+			isRetryableFailure(meta.indexMeta.FailClass) && meta.indexMeta.RetryCount < maxRetries {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+// RetryIndex reissues a failed build of a retryable fail class, the build is not assigned before retryTime,
+// the fail reason is kept until the build finishes
+func (mt *metaTable) RetryIndex(indexBuildID UniqueID, retryTime time.Time) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	log.Debug("IndexCoord metaTable RetryIndex", zap.Any("indexBuildID", indexBuildID), zap.Any("exists", ok))
+	if !ok {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.State != commonpb.IndexState_Failed || !isRetryableFailure(meta.indexMeta.FailClass) {
+		return fmt.Errorf("index is not retryable with ID = %d", indexBuildID)
+	}
+
+	reissue := func(indexMeta *indexpb.IndexMeta) {
+		indexMeta.State = commonpb.IndexState_Unissued
+		indexMeta.RetryCount++
+		indexMeta.RetryTime = retryTime.Unix()
+	}
+	reissue(meta.indexMeta)
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(meta.indexMeta.IndexBuildID)
+			if m == nil {
+				return err
+			}
+			if m.indexMeta.State != commonpb.IndexState_Failed {
+				return nil
+			}
+			reissue(m.indexMeta)
+			return mt.saveIndexMeta(m)
+		}
+		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
+		if err2 != nil {
+			meta.indexMeta.State = commonpb.IndexState_Failed
+			meta.indexMeta.RetryCount--
+			log.Debug("IndexCoord metaTable RetryIndex failed", zap.Error(err2))
+			return err2
+		}
+	}
+
+	return nil
+}
+
 func (mt *metaTable) GetUnassignedTasks(onlineNodeIDs []int64) []Meta {
 	mt.lock.RLock()
 	defer mt.lock.RUnlock()
@@ -481,16 +644,108 @@ func (mt *metaTable) LoadMetaFromETCD(indexBuildID int64, revision int64) bool {
 	return true
 }
 
-func (mt *metaTable) GetNodeTaskStats() map[UniqueID]int {
+// GetNodeTasks returns the tasks in progress of the nodes with their estimated sizes, nodeID -> indexBuildID -> size
+func (mt *metaTable) GetNodeTasks() map[UniqueID]map[UniqueID]int64 {
 	mt.lock.RLock()
 	defer mt.lock.RUnlock()
 
-	log.Debug("IndexCoord MetaTable GetPriorityForNodeID")
-	nodePriority := make(map[UniqueID]int)
+	log.Debug("IndexCoord MetaTable GetNodeTasks")
+	nodeTasks := make(map[UniqueID]map[UniqueID]int64)
 	for _, meta := range mt.indexBuildID2Meta {
 		if meta.indexMeta.State == commonpb.IndexState_InProgress {
-			nodePriority[meta.indexMeta.NodeID]++
+			if _, ok := nodeTasks[meta.indexMeta.NodeID]; !ok {
+				nodeTasks[meta.indexMeta.NodeID] = make(map[UniqueID]int64)
+			}
+			nodeTasks[meta.indexMeta.NodeID][meta.indexMeta.IndexBuildID] = estimateTaskSize(meta.indexMeta.Req)
+		}
+	}
+	return nodeTasks
+}
+
+// GetIndexStatistics returns the statistics of the index files of the collection by the index, ordered by the index id,
+// the indexes being re-encoded count the files of the previous format
+func (mt *metaTable) GetIndexStatistics(collectionID UniqueID) []*indexpb.IndexStatistics {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	index2Stats := make(map[UniqueID]*indexpb.IndexStatistics)
+	for _, meta := range mt.indexBuildID2Meta {
+		req := meta.indexMeta.Req
+		if req.GetCollectionID() != collectionID || meta.indexMeta.MarkDeleted || len(meta.indexMeta.IndexFilePaths) == 0 {
+			continue
+		}
+		stats, ok := index2Stats[req.IndexID]
+		if !ok {
+			stats = &indexpb.IndexStatistics{
+				IndexID:   req.IndexID,
+				IndexName: req.IndexName,
+				FieldID:   req.FieldID,
+			}
+			index2Stats[req.IndexID] = stats
 		}
+		stats.FinishedCount++
+		stats.SerializedSize += meta.indexMeta.SerializedSize
+	}
+	ret := make([]*indexpb.IndexStatistics, 0, len(index2Stats))
+	for _, stats := range index2Stats {
+		ret = append(ret, stats)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].IndexID < ret[j].IndexID
+	})
+	return ret
+}
+
+// updateBuildControl applies fn to the build control of the collection and saves it
+func (mt *metaTable) updateBuildControl(collectionID UniqueID, fn func(control *indexpb.CollectionBuildControl)) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	control := &indexpb.CollectionBuildControl{CollectionID: collectionID}
+	if old, ok := mt.buildControls[collectionID]; ok {
+		control = proto.Clone(old).(*indexpb.CollectionBuildControl)
+	}
+	fn(control)
+
+	key := buildControlPrefix + "/" + strconv.FormatInt(collectionID, 10)
+	if !control.Paused && control.Priority == 0 {
+		if err := mt.client.Remove(key); err != nil {
+			return err
+		}
+		delete(mt.buildControls, collectionID)
+		return nil
+	}
+	if err := mt.client.Save(key, proto.MarshalTextString(control)); err != nil {
+		return err
+	}
+	mt.buildControls[collectionID] = control
+	return nil
+}
+
+// SetBuildPaused pauses or resumes the assignment of the index builds of the collection
+func (mt *metaTable) SetBuildPaused(collectionID UniqueID, paused bool) error {
+	log.Debug("IndexCoord metaTable SetBuildPaused", zap.Int64("collectionID", collectionID), zap.Bool("paused", paused))
+	return mt.updateBuildControl(collectionID, func(control *indexpb.CollectionBuildControl) {
+		control.Paused = paused
+	})
+}
+
+// SetBuildPriority sets the priority of the index builds of the collection
+func (mt *metaTable) SetBuildPriority(collectionID UniqueID, priority int32) error {
+	log.Debug("IndexCoord metaTable SetBuildPriority", zap.Int64("collectionID", collectionID), zap.Int32("priority", priority))
+	return mt.updateBuildControl(collectionID, func(control *indexpb.CollectionBuildControl) {
+		control.Priority = priority
+	})
+}
+
+// GetBuildControls returns the build controls of the collections which are paused or not of the default priority
+func (mt *metaTable) GetBuildControls() map[UniqueID]*indexpb.CollectionBuildControl {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	ret := make(map[UniqueID]*indexpb.CollectionBuildControl, len(mt.buildControls))
+	for collectionID, control := range mt.buildControls {
+		ret[collectionID] = proto.Clone(control).(*indexpb.CollectionBuildControl)
 	}
-	return nodePriority
+	return ret
 }
@@ -14,6 +14,7 @@ package indexcoord
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -245,7 +246,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, false, ok)
 	})
 
-	t.Run("GetNodeTaskStats", func(t *testing.T) {
+	t.Run("GetNodeTasks", func(t *testing.T) {
 		req5 := &indexpb.BuildIndexRequest{
 			IndexBuildID: 9,
 			IndexName:    "test_index",
@@ -261,8 +262,156 @@ func TestMetaTable(t *testing.T) {
 		err = metaTable.BuildIndex(req5.IndexBuildID, 4)
 		assert.Nil(t, err)
 
-		priorities := metaTable.GetNodeTaskStats()
-		assert.Equal(t, 1, priorities[4])
+		nodeTasks := metaTable.GetNodeTasks()
+		assert.Equal(t, 1, len(nodeTasks[4]))
+		_, ok := nodeTasks[4][req5.IndexBuildID]
+		assert.True(t, ok)
+	})
+
+	t.Run("ReencodeIndex", func(t *testing.T) {
+		indexMeta6 := &indexpb.IndexMeta{
+			IndexBuildID:   10,
+			State:          commonpb.IndexState_Finished,
+			Req:            &indexpb.BuildIndexRequest{IndexBuildID: 10, IndexName: "test_index", IndexID: 6},
+			IndexFilePaths: []string{"IndexFilePath-10-1", "IndexFilePath-10-2"},
+			Version:        1,
+			Recycled:       true,
+		}
+		err = metaTable.saveIndexMeta(&Meta{indexMeta: indexMeta6})
+		assert.Nil(t, err)
+
+		toReencode := make([]UniqueID, 0)
+		for _, meta := range metaTable.GetIndexesToReencode(100) {
+			toReencode = append(toReencode, meta.indexMeta.IndexBuildID)
+		}
+		assert.Contains(t, toReencode, indexMeta6.IndexBuildID)
+		assert.NotContains(t, toReencode, indexMeta1.IndexBuildID)
+		assert.Equal(t, 1, len(metaTable.GetIndexesToReencode(1)))
+		numReencoding := metaTable.GetNumReencodingTasks()
+
+		err = metaTable.ReencodeIndex(indexMeta6.IndexBuildID)
+		assert.Nil(t, err)
+		assert.Equal(t, numReencoding+1, metaTable.GetNumReencodingTasks())
+		meta := metaTable.indexBuildID2Meta[indexMeta6.IndexBuildID]
+		assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+		assert.False(t, meta.indexMeta.Recycled)
+		assert.Equal(t, indexMeta6.IndexFilePaths, meta.indexMeta.IndexFilePaths)
+		// the index keeps serving the files of the previous format
+		indexInfos := metaTable.GetIndexStates([]UniqueID{indexMeta6.IndexBuildID})
+		assert.Equal(t, commonpb.IndexState_Finished, indexInfos[0].State)
+		indexFilePathInfo, err := metaTable.GetIndexFilePathInfo(indexMeta6.IndexBuildID)
+		assert.Nil(t, err)
+		assert.Equal(t, indexMeta6.IndexFilePaths, indexFilePathInfo.IndexFilePaths)
+
+		err = metaTable.ReencodeIndex(indexMeta6.IndexBuildID)
+		assert.NotNil(t, err)
+		err = metaTable.ReencodeIndex(11)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("BuildControl", func(t *testing.T) {
+		err = metaTable.SetBuildPaused(100, true)
+		assert.Nil(t, err)
+		err = metaTable.SetBuildPriority(101, -1)
+		assert.Nil(t, err)
+		err = metaTable.SetBuildPriority(102, 1)
+		assert.Nil(t, err)
+
+		mt, err := NewMetaTable(etcdKV)
+		assert.Nil(t, err)
+		controls := mt.GetBuildControls()
+		assert.Equal(t, 3, len(controls))
+		assert.True(t, controls[100].Paused)
+		assert.Equal(t, int32(-1), controls[101].Priority)
+
+		ic := &IndexCoord{metaTable: metaTable, deferPolicy: deferNothingPolicy}
+		for i, collectionID := range []UniqueID{100, 101, 102, 103} {
+			req := &indexpb.BuildIndexRequest{
+				IndexBuildID: UniqueID(20 + i),
+				IndexName:    "test_index",
+				IndexID:      UniqueID(20 + i),
+				CollectionID: collectionID,
+			}
+			err = metaTable.AddIndex(req.IndexBuildID, req)
+			assert.Nil(t, err)
+		}
+		var collectionIDs []UniqueID
+		for _, meta := range ic.getTasksToAssign(nil) {
+			if meta.indexMeta.Req.CollectionID >= 100 {
+				collectionIDs = append(collectionIDs, meta.indexMeta.Req.CollectionID)
+			}
+		}
+		assert.Equal(t, []UniqueID{102, 103, 101}, collectionIDs)
+
+		err = metaTable.SetBuildPaused(100, false)
+		assert.Nil(t, err)
+		err = metaTable.SetBuildPriority(101, 0)
+		assert.Nil(t, err)
+		err = metaTable.SetBuildPriority(102, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(metaTable.GetBuildControls()))
+		_, values, err := etcdKV.LoadWithPrefix(buildControlPrefix)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(values))
+	})
+
+	t.Run("RetryIndex", func(t *testing.T) {
+		oomMeta := &indexpb.IndexMeta{
+			IndexBuildID: 30,
+			State:        commonpb.IndexState_Failed,
+			FailReason:   "out of memory",
+			FailClass:    indexpb.IndexFailClass_OutOfMemory,
+			Req:          &indexpb.BuildIndexRequest{IndexBuildID: 30, IndexName: "test_index", IndexID: 30},
+		}
+		err = metaTable.saveIndexMeta(&Meta{indexMeta: oomMeta})
+		assert.Nil(t, err)
+		corruptMeta := &indexpb.IndexMeta{
+			IndexBuildID: 31,
+			State:        commonpb.IndexState_Failed,
+			FailReason:   "corrupt binlog",
+			FailClass:    indexpb.IndexFailClass_CorruptBinlog,
+			Req:          &indexpb.BuildIndexRequest{IndexBuildID: 31, IndexName: "test_index", IndexID: 31},
+		}
+		err = metaTable.saveIndexMeta(&Meta{indexMeta: corruptMeta})
+		assert.Nil(t, err)
+
+		toRetry := make([]UniqueID, 0)
+		for _, meta := range metaTable.GetIndexesToRetry(1) {
+			toRetry = append(toRetry, meta.indexMeta.IndexBuildID)
+		}
+		assert.Contains(t, toRetry, oomMeta.IndexBuildID)
+		assert.NotContains(t, toRetry, corruptMeta.IndexBuildID)
+		indexInfos := metaTable.GetIndexStates([]UniqueID{corruptMeta.IndexBuildID})
+		assert.Equal(t, commonpb.IndexState_Failed, indexInfos[0].State)
+		assert.Equal(t, indexpb.IndexFailClass_CorruptBinlog, indexInfos[0].FailClass)
+		assert.Equal(t, corruptMeta.FailReason, indexInfos[0].Reason)
+
+		now := time.Now()
+		err = metaTable.RetryIndex(oomMeta.IndexBuildID, now.Add(time.Minute))
+		assert.Nil(t, err)
+		meta := metaTable.indexBuildID2Meta[oomMeta.IndexBuildID]
+		assert.Equal(t, commonpb.IndexState_Unissued, meta.indexMeta.State)
+		assert.Equal(t, int32(1), meta.indexMeta.RetryCount)
+		assert.Equal(t, now.Add(time.Minute).Unix(), meta.indexMeta.RetryTime)
+
+		// the retried build is held back until its retry time
+		ic := &IndexCoord{metaTable: metaTable, deferPolicy: deferNothingPolicy}
+		for _, m := range ic.getTasksToAssign(nil) {
+			assert.NotEqual(t, oomMeta.IndexBuildID, m.indexMeta.IndexBuildID)
+		}
+
+		err = metaTable.RetryIndex(oomMeta.IndexBuildID, now)
+		assert.NotNil(t, err)
+		err = metaTable.RetryIndex(corruptMeta.IndexBuildID, now)
+		assert.NotNil(t, err)
+		err = metaTable.RetryIndex(32, now)
+		assert.NotNil(t, err)
+
+		// the build is not retried more than maxRetries times
+		meta.indexMeta.State = commonpb.IndexState_Failed
+		for _, m := range metaTable.GetIndexesToRetry(1) {
+			assert.NotEqual(t, oomMeta.IndexBuildID, m.indexMeta.IndexBuildID)
+		}
 	})
 
 	err = etcdKV.RemoveWithPrefix("indexes/")
@@ -289,3 +438,31 @@ func TestMetaTable_Error(t *testing.T) {
 	err = etcdKV.RemoveWithPrefix("indexes/")
 	assert.Nil(t, err)
 }
+
+func TestMetaTable_GetIndexStatistics(t *testing.T) {
+	newMeta := func(buildID UniqueID, collectionID UniqueID, indexID UniqueID, size int64, paths []string) Meta {
+		return Meta{indexMeta: &indexpb.IndexMeta{
+			IndexBuildID:   buildID,
+			Req:            &indexpb.BuildIndexRequest{CollectionID: collectionID, IndexID: indexID, IndexName: "idx", FieldID: 100},
+			IndexFilePaths: paths,
+			SerializedSize: size,
+		}}
+	}
+	mt := &metaTable{indexBuildID2Meta: map[UniqueID]Meta{
+		1: newMeta(1, 10, 1000, 100, []string{"p1"}),
+		2: newMeta(2, 10, 1000, 200, []string{"p2"}),
+		// not finished
+		3: newMeta(3, 10, 1000, 0, nil),
+		4: newMeta(4, 10, 999, 50, []string{"p4"}),
+		5: newMeta(5, 11, 1000, 400, []string{"p5"}),
+	}}
+	deleted := newMeta(6, 10, 1000, 800, []string{"p6"})
+	deleted.indexMeta.MarkDeleted = true
+	mt.indexBuildID2Meta[6] = deleted
+
+	stats := mt.GetIndexStatistics(10)
+	assert.Equal(t, 2, len(stats))
+	assert.Equal(t, &indexpb.IndexStatistics{IndexID: 999, IndexName: "idx", FieldID: 100, FinishedCount: 1, SerializedSize: 50}, stats[0])
+	assert.Equal(t, &indexpb.IndexStatistics{IndexID: 1000, IndexName: "idx", FieldID: 100, FinishedCount: 2, SerializedSize: 300}, stats[1])
+	assert.Equal(t, 0, len(mt.GetIndexStatistics(12)))
+}
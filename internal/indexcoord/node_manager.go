@@ -13,56 +13,102 @@ package indexcoord
 
 import (
 	"context"
+	"strconv"
 	"sync"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
 	grpcindexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"go.uber.org/zap"
 )
 
+// nodeCapacity is the task slots advertised by an index node, 0 for no limit, and whether it has a gpu
+type nodeCapacity struct {
+	slots      int64
+	memorySize int64
+	gpu        bool
+}
+
 type NodeManager struct {
 	nodeClients map[UniqueID]types.IndexNode
 	pq          *PriorityQueue
+	// the build tasks running on the nodes and their estimated sizes, nodeID -> indexBuildID -> size
+	nodeTasks    map[UniqueID]map[UniqueID]int64
+	nodeCapacity map[UniqueID]nodeCapacity
 
 	lock sync.RWMutex
 }
 
 func NewNodeManager() *NodeManager {
 	return &NodeManager{
-		nodeClients: make(map[UniqueID]types.IndexNode),
-		pq:          &PriorityQueue{},
-		lock:        sync.RWMutex{},
+		nodeClients:  make(map[UniqueID]types.IndexNode),
+		pq:           &PriorityQueue{},
+		nodeTasks:    make(map[UniqueID]map[UniqueID]int64),
+		nodeCapacity: make(map[UniqueID]nodeCapacity),
+		lock:         sync.RWMutex{},
+	}
+}
+
+// getCapacity asks the node for its task slots, the nodes which do not advertise slots are not limited
+func getCapacity(nodeID UniqueID, client types.IndexNode) nodeCapacity {
+	ctx, cancel := context.WithTimeout(context.Background(), reqTimeoutInterval)
+	defer cancel()
+	resp, err := client.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+	if err != nil || resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Warn("IndexCoord NodeManager get task slots failed, the tasks of the node are not limited",
+			zap.Int64("nodeID", nodeID), zap.Error(err))
+		return nodeCapacity{}
+	}
+	return nodeCapacity{
+		slots:      resp.Slots,
+		memorySize: resp.MemorySize,
 	}
 }
 
 func (nm *NodeManager) setClient(nodeID UniqueID, client types.IndexNode) {
+	nm.addClient(nodeID, client, false)
+}
+
+func (nm *NodeManager) addClient(nodeID UniqueID, client types.IndexNode, gpu bool) {
+	capacity := getCapacity(nodeID, client)
+	capacity.gpu = gpu
+
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 
-	log.Debug("IndexCoord NodeManager setClient", zap.Int64("nodeID", nodeID))
+	log.Debug("IndexCoord NodeManager setClient", zap.Int64("nodeID", nodeID),
+		zap.Int64("slots", capacity.slots), zap.Int64("memorySize", capacity.memorySize), zap.Bool("gpu", gpu))
 	defer log.Debug("IndexNode NodeManager setclient success", zap.Any("nodeID", nodeID))
 	item := &PQItem{
 		key:      nodeID,
 		priority: 0,
 	}
 	nm.nodeClients[nodeID] = client
+	nm.nodeCapacity[nodeID] = capacity
 	nm.pq.Push(item)
+	// the tasks restored from the meta may arrive before the node
+	nm.pq.IncPriority(nodeID, len(nm.nodeTasks[nodeID]))
 }
 
 func (nm *NodeManager) RemoveNode(nodeID UniqueID) {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 
-	log.Debug("IndexCoord", zap.Any("Remove node with ID", nodeID))
+	log.Debug("IndexCoord", zap.Any("Remove node with ID", nodeID),
+		zap.Int("The tasks to reassign", len(nm.nodeTasks[nodeID])))
 	delete(nm.nodeClients, nodeID)
+	delete(nm.nodeTasks, nodeID)
+	delete(nm.nodeCapacity, nodeID)
 	nm.pq.Remove(nodeID)
 }
 
-func (nm *NodeManager) AddNode(nodeID UniqueID, address string) error {
-	log.Debug("IndexCoord addNode", zap.Any("nodeID", nodeID), zap.Any("node address", address))
+func (nm *NodeManager) AddNode(nodeID UniqueID, address string, labels map[string]string) error {
+	log.Debug("IndexCoord addNode", zap.Any("nodeID", nodeID), zap.Any("node address", address), zap.Any("labels", labels))
 	if nm.pq.CheckExist(nodeID) {
 		log.Debug("IndexCoord", zap.Any("Node client already exist with ID:", nodeID))
 		return nil
@@ -78,23 +124,115 @@ func (nm *NodeManager) AddNode(nodeID UniqueID, address string) error {
 		log.Error("IndexCoord NodeManager", zap.Any("Add node err", err))
 		return err
 	}
-	nm.setClient(nodeID, nodeClient)
+	gpu, _ := strconv.ParseBool(labels[sessionutil.LabelGPU])
+	nm.addClient(nodeID, nodeClient, gpu)
 	return nil
 }
 
-func (nm *NodeManager) PeekClient() (UniqueID, types.IndexNode) {
+// canAccept returns whether the node has a free slot and enough free memory for the task, an idle node
+// accepts any task so that the tasks larger than the memory of every node are still built
+func (nm *NodeManager) canAccept(nodeID UniqueID, taskSize int64) bool {
+	tasks := nm.nodeTasks[nodeID]
+	if len(tasks) == 0 {
+		return true
+	}
+	capacity := nm.nodeCapacity[nodeID]
+	if capacity.slots > 0 && int64(len(tasks)) >= capacity.slots {
+		return false
+	}
+	if capacity.memorySize > 0 {
+		used := taskSize
+		for _, size := range tasks {
+			used += size
+		}
+		if used > capacity.memorySize {
+			return false
+		}
+	}
+	return true
+}
+
+func (nm *NodeManager) hasGPUNode() bool {
+	for id := range nm.nodeClients {
+		if nm.nodeCapacity[id].gpu {
+			return true
+		}
+	}
+	return false
+}
+
+// PeekClient picks the node of the fewest tasks among the nodes which can accept a task of taskSize bytes,
+// nil if every node is full. The gpu tasks are only assigned to the gpu nodes unless there is none, then
+// they are built on cpu, the other tasks go to the gpu nodes only if every cpu node is full
+func (nm *NodeManager) PeekClient(taskSize int64, gpu bool) (UniqueID, types.IndexNode) {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 
-	log.Debug("IndexCoord NodeManager PeekClient")
+	log.Debug("IndexCoord NodeManager PeekClient", zap.Int64("taskSize", taskSize), zap.Bool("gpu", gpu))
 
-	nodeID := nm.pq.Peek()
-	client, ok := nm.nodeClients[nodeID]
-	if !ok {
-		log.Error("IndexCoord NodeManager PeekClient", zap.Any("There is no IndexNode client corresponding to NodeID", nodeID))
+	gpuOnly := gpu && nm.hasGPUNode()
+	nodeID := UniqueID(-1)
+	less := func(id UniqueID) bool {
+		if nodeID == -1 {
+			return true
+		}
+		if !gpu && nm.nodeCapacity[id].gpu != nm.nodeCapacity[nodeID].gpu {
+			return !nm.nodeCapacity[id].gpu
+		}
+		if len(nm.nodeTasks[id]) != len(nm.nodeTasks[nodeID]) {
+			return len(nm.nodeTasks[id]) < len(nm.nodeTasks[nodeID])
+		}
+		return id < nodeID
+	}
+	for id := range nm.nodeClients {
+		if gpuOnly && !nm.nodeCapacity[id].gpu {
+			continue
+		}
+		if !nm.canAccept(id, taskSize) {
+			continue
+		}
+		if less(id) {
+			nodeID = id
+		}
+	}
+	if nodeID == -1 {
+		log.Debug("IndexCoord NodeManager PeekClient, there is no IndexNode of free slots")
 		return nodeID, nil
 	}
-	return nodeID, client
+	return nodeID, nm.nodeClients[nodeID]
+}
+
+// addTask records the task running on the node
+func (nm *NodeManager) addTask(nodeID UniqueID, indexBuildID UniqueID, taskSize int64) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	tasks, ok := nm.nodeTasks[nodeID]
+	if !ok {
+		tasks = make(map[UniqueID]int64)
+		nm.nodeTasks[nodeID] = tasks
+	}
+	if _, ok := tasks[indexBuildID]; ok {
+		return
+	}
+	tasks[indexBuildID] = taskSize
+	nm.pq.IncPriority(nodeID, 1)
+}
+
+// finishTask frees the slot of the task on the node
+func (nm *NodeManager) finishTask(nodeID UniqueID, indexBuildID UniqueID) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	tasks, ok := nm.nodeTasks[nodeID]
+	if !ok {
+		return
+	}
+	if _, ok := tasks[indexBuildID]; !ok {
+		return
+	}
+	delete(tasks, indexBuildID)
+	nm.pq.IncPriority(nodeID, -1)
 }
 
 type indexNodeGetMetricsResponse struct {
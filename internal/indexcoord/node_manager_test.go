@@ -14,9 +14,83 @@ package indexcoord
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNodeManager_getMetrics(t *testing.T) {
 	log.Info("TestNodeManager_getMetrics, todo")
 }
+
+func TestNodeManager_PeekClient(t *testing.T) {
+	nm := NewNodeManager()
+
+	nodeID, client := nm.PeekClient(0, false)
+	assert.Equal(t, UniqueID(-1), nodeID)
+	assert.Nil(t, client)
+
+	// a task restored from the meta before its node is added
+	nm.addTask(1, 100, 10)
+	nm.setClient(1, &indexnode.Mock{Slots: 2, MemorySize: 100})
+	nm.setClient(2, &indexnode.Mock{Slots: 1, MemorySize: 100})
+	// the node which does not advertise slots is not limited
+	nm.setClient(3, &indexnode.Mock{Err: true})
+
+	nodeID, _ = nm.PeekClient(10, false)
+	assert.Equal(t, UniqueID(2), nodeID)
+	nm.addTask(2, 101, 10)
+
+	nodeID, _ = nm.PeekClient(10, false)
+	assert.Equal(t, UniqueID(3), nodeID)
+	nm.addTask(3, 102, 10)
+
+	// the node 2 has no free slot and the node 1 has no memory for the task
+	nodeID, _ = nm.PeekClient(95, false)
+	assert.Equal(t, UniqueID(3), nodeID)
+	nm.RemoveNode(3)
+	nodeID, client = nm.PeekClient(95, false)
+	assert.Equal(t, UniqueID(-1), nodeID)
+	assert.Nil(t, client)
+
+	nodeID, _ = nm.PeekClient(50, false)
+	assert.Equal(t, UniqueID(1), nodeID)
+	nm.addTask(1, 103, 50)
+	nodeID, _ = nm.PeekClient(0, false)
+	assert.Equal(t, UniqueID(-1), nodeID)
+
+	// an idle node accepts the tasks larger than its memory
+	nm.finishTask(2, 101)
+	nodeID, _ = nm.PeekClient(1000, false)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	nm.finishTask(1, 100)
+	nm.finishTask(1, 100)
+	assert.Equal(t, 1, len(nm.nodeTasks[1]))
+}
+
+func TestNodeManager_PeekGPUClient(t *testing.T) {
+	nm := NewNodeManager()
+	nm.setClient(1, &indexnode.Mock{})
+
+	// the gpu tasks are built on cpu if there is no gpu node
+	nodeID, _ := nm.PeekClient(0, true)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	nm.addClient(2, &indexnode.Mock{Slots: 1}, true)
+	nodeID, _ = nm.PeekClient(0, true)
+	assert.Equal(t, UniqueID(2), nodeID)
+	nm.addTask(2, 100, 0)
+	nodeID, client := nm.PeekClient(0, true)
+	assert.Equal(t, UniqueID(-1), nodeID)
+	assert.Nil(t, client)
+
+	// the cpu tasks go to the gpu nodes only if the cpu nodes are full
+	nm.finishTask(2, 100)
+	nm.addTask(1, 101, 0)
+	nodeID, _ = nm.PeekClient(0, false)
+	assert.Equal(t, UniqueID(1), nodeID)
+	nm.RemoveNode(1)
+	nodeID, _ = nm.PeekClient(0, false)
+	assert.Equal(t, UniqueID(2), nodeID)
+}
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -37,6 +38,17 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	DeferBuildEnabled bool
+	DeferBuildMinRows int64
+	DeferBuildMinAge  time.Duration
+
+	ReencodeEnabled  bool
+	ReencodeMaxTasks int
+
+	RetryMaxTimes   int32
+	RetryBackoff    time.Duration
+	RetryMaxBackoff time.Duration
+
 	Log log.Config
 }
 
@@ -55,6 +67,14 @@ func (pt *ParamTable) Init() {
 		pt.initMinIOSecretAccessKey()
 		pt.initMinIOUseSSL()
 		pt.initMinioBucketName()
+		pt.initDeferBuildEnabled()
+		pt.initDeferBuildMinRows()
+		pt.initDeferBuildMinAge()
+		pt.initReencodeEnabled()
+		pt.initReencodeMaxTasks()
+		pt.initRetryMaxTimes()
+		pt.initRetryBackoff()
+		pt.initRetryMaxBackoff()
 	})
 }
 
@@ -133,6 +153,38 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+func (pt *ParamTable) initDeferBuildEnabled() {
+	pt.DeferBuildEnabled = pt.ParseBool("indexCoord.deferBuild.enabled", false)
+}
+
+func (pt *ParamTable) initDeferBuildMinRows() {
+	pt.DeferBuildMinRows = pt.ParseInt64("indexCoord.deferBuild.minRows")
+}
+
+func (pt *ParamTable) initDeferBuildMinAge() {
+	pt.DeferBuildMinAge = time.Duration(pt.ParseInt64("indexCoord.deferBuild.minAge")) * time.Second
+}
+
+func (pt *ParamTable) initReencodeEnabled() {
+	pt.ReencodeEnabled = pt.ParseBool("indexCoord.reencode.enabled", true)
+}
+
+func (pt *ParamTable) initReencodeMaxTasks() {
+	pt.ReencodeMaxTasks = pt.ParseInt("indexCoord.reencode.maxTasks")
+}
+
+func (pt *ParamTable) initRetryMaxTimes() {
+	pt.RetryMaxTimes = int32(pt.ParseInt("indexCoord.retry.maxTimes"))
+}
+
+func (pt *ParamTable) initRetryBackoff() {
+	pt.RetryBackoff = time.Duration(pt.ParseInt64("indexCoord.retry.backoff")) * time.Second
+}
+
+func (pt *ParamTable) initRetryMaxBackoff() {
+	pt.RetryMaxBackoff = time.Duration(pt.ParseInt64("indexCoord.retry.maxBackoff")) * time.Second
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
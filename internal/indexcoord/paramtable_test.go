@@ -57,6 +57,23 @@ func TestParamTable(t *testing.T) {
 	t.Run("MinioBucketName", func(t *testing.T) {
 		t.Logf("MinioBucketName: %v", Params.MinioBucketName)
 	})
+
+	t.Run("DeferBuild", func(t *testing.T) {
+		t.Logf("DeferBuildEnabled: %v", Params.DeferBuildEnabled)
+		t.Logf("DeferBuildMinRows: %v", Params.DeferBuildMinRows)
+		t.Logf("DeferBuildMinAge: %v", Params.DeferBuildMinAge)
+	})
+
+	t.Run("Reencode", func(t *testing.T) {
+		t.Logf("ReencodeEnabled: %v", Params.ReencodeEnabled)
+		t.Logf("ReencodeMaxTasks: %v", Params.ReencodeMaxTasks)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Logf("RetryMaxTimes: %v", Params.RetryMaxTimes)
+		t.Logf("RetryBackoff: %v", Params.RetryBackoff)
+		t.Logf("RetryMaxBackoff: %v", Params.RetryMaxBackoff)
+	})
 }
 
 //TODO: Params Load should be return error when key does not exist.
@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	indexTypeKey         = "index_type"
+	gpuIDKey             = "gpu_id"
+	gpuMemoryPoolSizeKey = "gpu_memory_pool_size" // MB
+)
+
+// setBuildDevice adapts the flattened index params to the device of the node and returns the params to build the
+// index with. The gpu indexes are built on the device of the node with its memory pool, the index params saved
+// with the index files keep no device. The nodes without gpu build the cpu indexes of the same search params
+// instead, which happens when the index coord finds no gpu node online
+func setBuildDevice(indexParams map[string]string, gpuEnabled bool, deviceID int64, memoryPoolSize int64) map[string]string {
+	if !indexparamcheck.IsGPUIndex(indexParams[indexTypeKey], indexParams[indexparamcheck.IndexMode]) {
+		return indexParams
+	}
+	if !gpuEnabled {
+		indexParams[indexTypeKey] = indexparamcheck.CPUFallbackIndexType(indexParams[indexTypeKey])
+		if _, ok := indexParams[indexparamcheck.IndexMode]; ok {
+			indexParams[indexparamcheck.IndexMode] = indexparamcheck.CPUMode
+		}
+		return indexParams
+	}
+	buildParams := make(map[string]string, len(indexParams)+2)
+	for key, value := range indexParams {
+		buildParams[key] = value
+	}
+	buildParams[gpuIDKey] = strconv.FormatInt(deviceID, 10)
+	buildParams[gpuMemoryPoolSizeKey] = strconv.FormatInt(memoryPoolSize, 10)
+	return buildParams
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetBuildDevice(t *testing.T) {
+	indexParams := map[string]string{indexTypeKey: indexparamcheck.IndexHNSW}
+	buildParams := setBuildDevice(indexParams, true, 1, 512)
+	assert.Equal(t, indexParams, buildParams)
+	_, ok := buildParams[gpuIDKey]
+	assert.False(t, ok)
+
+	indexParams = map[string]string{indexTypeKey: indexparamcheck.IndexFaissIvfSQ8H}
+	buildParams = setBuildDevice(indexParams, true, 1, 512)
+	assert.Equal(t, "1", buildParams[gpuIDKey])
+	assert.Equal(t, "512", buildParams[gpuMemoryPoolSizeKey])
+	_, ok = indexParams[gpuIDKey]
+	assert.False(t, ok)
+
+	buildParams = setBuildDevice(indexParams, false, 1, 512)
+	assert.Equal(t, indexparamcheck.IndexFaissIvfSQ8, buildParams[indexTypeKey])
+	assert.Equal(t, indexparamcheck.IndexFaissIvfSQ8, indexParams[indexTypeKey])
+
+	indexParams = map[string]string{
+		indexTypeKey:              indexparamcheck.IndexFaissIvfPQ,
+		indexparamcheck.IndexMode: indexparamcheck.GPUMode,
+	}
+	buildParams = setBuildDevice(indexParams, false, 0, 512)
+	assert.Equal(t, indexparamcheck.IndexFaissIvfPQ, buildParams[indexTypeKey])
+	assert.Equal(t, indexparamcheck.CPUMode, buildParams[indexparamcheck.IndexMode])
+}
@@ -14,6 +14,10 @@ package indexnode
 import (
 	"errors"
 	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 func msgIndexNodeIsUnhealthy(nodeID UniqueID) string {
@@ -23,3 +27,44 @@ func msgIndexNodeIsUnhealthy(nodeID UniqueID) string {
 func errIndexNodeIsUnhealthy(nodeID UniqueID) error {
 	return errors.New(msgIndexNodeIsUnhealthy(nodeID))
 }
+
+// failClassError is a failed index build of a known fail class
+type failClassError struct {
+	class indexpb.IndexFailClass
+	err   error
+}
+
+func (e *failClassError) Error() string {
+	return e.err.Error()
+}
+
+func (e *failClassError) Unwrap() error {
+	return e.err
+}
+
+func withFailClass(err error, class indexpb.IndexFailClass) error {
+	if err == nil {
+		return nil
+	}
+	return &failClassError{class: class, err: err}
+}
+
+// classifyBuildError returns the fail class of a failed index build, the errors of the C++ core are classified by
+// their error codes and the unknown errors, such as the failures to load the binlogs, are transient
+func classifyBuildError(err error) indexpb.IndexFailClass {
+	if err == nil {
+		return indexpb.IndexFailClass_FailClassNone
+	}
+	var fcErr *failClassError
+	if errors.As(err, &fcErr) {
+		return fcErr.class
+	}
+	switch cgoerror.ErrorCode(err) {
+	case commonpb.ErrorCode_OutOfMemory:
+		return indexpb.IndexFailClass_OutOfMemory
+	case commonpb.ErrorCode_IllegalArgument, commonpb.ErrorCode_IllegalDimension, commonpb.ErrorCode_IllegalIndexType,
+		commonpb.ErrorCode_IllegalNLIST, commonpb.ErrorCode_IllegalMetricType:
+		return indexpb.IndexFailClass_UnsupportedParam
+	}
+	return indexpb.IndexFailClass_Transient
+}
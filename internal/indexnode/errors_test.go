@@ -12,9 +12,15 @@
 package indexnode
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
@@ -33,3 +39,21 @@ func TestErrIndexNodeIsUnhealthy(t *testing.T) {
 		log.Info("TestErrIndexNodeIsUnhealthy", zap.Error(errIndexNodeIsUnhealthy(nodeID)))
 	}
 }
+
+func TestClassifyBuildError(t *testing.T) {
+	assert.Equal(t, indexpb.IndexFailClass_FailClassNone, classifyBuildError(nil))
+	assert.Nil(t, withFailClass(nil, indexpb.IndexFailClass_CorruptBinlog))
+
+	err := withFailClass(errors.New("bad binlog"), indexpb.IndexFailClass_CorruptBinlog)
+	assert.Equal(t, "bad binlog", err.Error())
+	assert.Equal(t, indexpb.IndexFailClass_CorruptBinlog, classifyBuildError(err))
+	assert.Equal(t, indexpb.IndexFailClass_CorruptBinlog, classifyBuildError(fmt.Errorf("build: %w", err)))
+
+	oom := cgoerror.Newf("BuildFloatVecIndexWithoutIds", commonpb.ErrorCode_OutOfMemory, "out of memory")
+	assert.Equal(t, indexpb.IndexFailClass_OutOfMemory, classifyBuildError(withSegmentContext(oom, 1, 100)))
+	nlist := cgoerror.Newf("CreateIndex", commonpb.ErrorCode_IllegalNLIST, "nlist out of range")
+	assert.Equal(t, indexpb.IndexFailClass_UnsupportedParam, classifyBuildError(nlist))
+	unexpected := cgoerror.Newf("Serialize", commonpb.ErrorCode_UnexpectedError, "unexpected")
+	assert.Equal(t, indexpb.IndexFailClass_Transient, classifyBuildError(unexpected))
+	assert.Equal(t, indexpb.IndexFailClass_Transient, classifyBuildError(errors.New("minio timeout")))
+}
@@ -25,7 +25,6 @@ package indexnode
 import "C"
 
 import (
-	"fmt"
 	"unsafe"
 
 	"go.uber.org/zap"
@@ -35,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexcgopb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 // TODO: use storage.Blob instead later
@@ -45,11 +45,48 @@ type Index interface {
 	Load([]*Blob) error
 	BuildFloatVecIndexWithoutIds(vectors []float32) error
 	BuildBinaryVecIndexWithoutIds(vectors []byte) error
+	IsStreamingBuildSupported() (bool, error)
+	TrainFloatVecIndexWithoutIds(vectors []float32) error
+	AddFloatVecIndexWithoutIds(vectors []float32) error
 	Delete() error
 }
 
 type CIndex struct {
 	indexPtr C.CIndex
+	// params are the type params and the index params, the context of the errors
+	params map[string]string
+}
+
+// newCStatusError returns the structured error of a failed status, nil if succeeded, the message of the status is freed
+func newCStatusError(status *C.CStatus, op string) *cgoerror.Error {
+	if status.error_code == 0 {
+		return nil
+	}
+	errorMsg := C.GoString(status.error_msg)
+	C.free(unsafe.Pointer(status.error_msg))
+	err := cgoerror.New(op, int32(status.error_code), errorMsg)
+	log.Debug("indexnode C runtime exception", zap.Error(err))
+	return err
+}
+
+func (index *CIndex) newCStatusError(status *C.CStatus, op string) *cgoerror.Error {
+	if err := newCStatusError(status, op); err != nil {
+		return err.WithParams(index.params)
+	}
+	return nil
+}
+
+// emptyDataError is returned instead of passing an empty buffer to the C++ core
+func (index *CIndex) emptyDataError(op string) *cgoerror.Error {
+	return cgoerror.Newf(op, commonpb.ErrorCode_IllegalArgument, "empty data").WithParams(index.params)
+}
+
+// withSegmentContext adds the segment and the field of the index to the errors of the C++ core
+func withSegmentContext(err error, segmentID UniqueID, fieldID int64) error {
+	if cErr, ok := cgoerror.As(err); ok {
+		cErr.WithSegment(segmentID).WithField(fieldID)
+	}
+	return err
 }
 
 func (index *CIndex) Serialize() ([]*Blob, error) {
@@ -61,14 +98,14 @@ func (index *CIndex) Serialize() ([]*Blob, error) {
 			C.DeleteCBinary(cBinary)
 		}
 	}()
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return nil, fmt.Errorf("SerializeToSlicedBuffer failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	if err := index.newCStatusError(&status, "SerializeToSlicedBuffer"); err != nil {
+		return nil, err
 	}
 
 	binarySize := C.GetCBinarySize(cBinary)
+	if binarySize <= 0 {
+		return nil, index.emptyDataError("GetCBinaryData")
+	}
 	binaryData := make([]byte, binarySize)
 	C.GetCBinaryData(cBinary, unsafe.Pointer(&binaryData[0]))
 
@@ -101,12 +138,12 @@ func (index *CIndex) Load(blobs []*Blob) error {
 		CStatus
 		LoadFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size);
 	*/
+	if len(datas) == 0 {
+		return index.emptyDataError("LoadFromSlicedBuffer")
+	}
 	status := C.LoadFromSlicedBuffer(index.indexPtr, (*C.char)(unsafe.Pointer(&datas[0])), (C.int32_t)(len(datas)))
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return fmt.Errorf("BuildFloatVecIndexWithoutIds failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	if err := index.newCStatusError(&status, "LoadFromSlicedBuffer"); err != nil {
+		return err
 	}
 	return nil
 }
@@ -117,13 +154,55 @@ func (index *CIndex) BuildFloatVecIndexWithoutIds(vectors []float32) error {
 		BuildFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
 	*/
 	log.Debug("before BuildFloatVecIndexWithoutIds")
+	if len(vectors) == 0 {
+		return index.emptyDataError("BuildFloatVecIndexWithoutIds")
+	}
 	status := C.BuildFloatVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		log.Debug("indexnode", zap.String("BuildFloatVecIndexWithoutIds error msg: ", errorMsg))
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return fmt.Errorf("BuildFloatVecIndexWithoutIds failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	if err := index.newCStatusError(&status, "BuildFloatVecIndexWithoutIds"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (index *CIndex) IsStreamingBuildSupported() (bool, error) {
+	/*
+		CStatus
+		IsStreamingBuildSupported(CIndex index, bool* res);
+	*/
+	var res C.bool
+	status := C.IsStreamingBuildSupported(index.indexPtr, &res)
+	if err := index.newCStatusError(&status, "IsStreamingBuildSupported"); err != nil {
+		return false, err
+	}
+	return bool(res), nil
+}
+
+func (index *CIndex) TrainFloatVecIndexWithoutIds(vectors []float32) error {
+	/*
+		CStatus
+		TrainFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+	*/
+	if len(vectors) == 0 {
+		return index.emptyDataError("TrainFloatVecIndexWithoutIds")
+	}
+	status := C.TrainFloatVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
+	if err := index.newCStatusError(&status, "TrainFloatVecIndexWithoutIds"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (index *CIndex) AddFloatVecIndexWithoutIds(vectors []float32) error {
+	/*
+		CStatus
+		AddFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float* vectors);
+	*/
+	if len(vectors) == 0 {
+		return index.emptyDataError("AddFloatVecIndexWithoutIds")
+	}
+	status := C.AddFloatVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
+	if err := index.newCStatusError(&status, "AddFloatVecIndexWithoutIds"); err != nil {
+		return err
 	}
 	return nil
 }
@@ -133,12 +212,12 @@ func (index *CIndex) BuildBinaryVecIndexWithoutIds(vectors []byte) error {
 		CStatus
 		BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
 	*/
+	if len(vectors) == 0 {
+		return index.emptyDataError("BuildBinaryVecIndexWithoutIds")
+	}
 	status := C.BuildBinaryVecIndexWithoutIds(index.indexPtr, (C.int64_t)(len(vectors)), (*C.uint8_t)(&vectors[0]))
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return fmt.Errorf("BuildBinaryVecIndexWithoutIds failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	if err := index.newCStatusError(&status, "BuildBinaryVecIndexWithoutIds"); err != nil {
+		return err
 	}
 	return nil
 }
@@ -186,15 +265,19 @@ func NewCIndex(typeParams, indexParams map[string]string) (Index, error) {
 	log.Debug("before create index ...")
 	status := C.CreateIndex(typeParamsPointer, indexParamsPointer, &indexPtr)
 	log.Debug("after create index ...")
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		log.Debug("indexnode", zap.String("create index error msg", errorMsg))
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return nil, fmt.Errorf(" failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	params := make(map[string]string, len(typeParams)+len(indexParams))
+	for key, value := range typeParams {
+		params[key] = value
+	}
+	for key, value := range indexParams {
+		params[key] = value
+	}
+	if err := newCStatusError(&status, "CreateIndex"); err != nil {
+		return nil, err.WithParams(params)
 	}
 
 	return &CIndex{
 		indexPtr: indexPtr,
+		params:   params,
 	}, nil
 }
@@ -85,6 +85,9 @@ func (i *IndexNode) Register() error {
 	if i.session == nil {
 		return errors.New("failed to initialize session")
 	}
+	if Params.GPUEnabled {
+		i.session.Labels = map[string]string{sessionutil.LabelGPU: "true"}
+	}
 	i.session.Init(typeutil.IndexNodeRole, Params.IP+":"+strconv.Itoa(Params.Port), false)
 	Params.NodeID = i.session.ServerID
 	return nil
@@ -309,6 +312,14 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 		return metrics, err
 	}
 
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, Params.NodeID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, Params.NodeID), &Params)
+	}
+
 	log.Debug("IndexNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.NodeID),
 		zap.String("req", req.Request),
@@ -322,3 +333,44 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 		Response: "",
 	}, nil
 }
+
+// GetTaskSlots returns the build tasks the node can run at once and the memory for the builds, the index coord
+// assigns the builds to the nodes of free slots and enough memory
+func (i *IndexNode) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error) {
+	if !i.isHealthy() {
+		log.Warn("IndexNode.GetTaskSlots failed",
+			zap.Int64("node_id", Params.NodeID),
+			zap.Error(errIndexNodeIsUnhealthy(Params.NodeID)))
+
+		return &indexpb.GetTaskSlotsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexNodeIsUnhealthy(Params.NodeID),
+			},
+		}, nil
+	}
+
+	slots := computeTaskSlots(metricsinfo.GetUsableCPUCount(), Params.TaskSlotCPUs)
+	memorySize := int64(float64(metricsinfo.GetMemoryCount()) * Params.TaskMemoryRatio)
+	log.Debug("IndexNode.GetTaskSlots",
+		zap.Int64("node_id", Params.NodeID),
+		zap.Int64("slots", slots),
+		zap.Int64("memory_size", memorySize))
+
+	return &indexpb.GetTaskSlotsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Slots:      slots,
+		MemorySize: memorySize,
+	}, nil
+}
+
+// computeTaskSlots returns a slot per cpuPerSlot cores, a node of fewer cores still has a slot
+func computeTaskSlots(cpus int, cpuPerSlot int64) int64 {
+	slots := int64(cpus) / cpuPerSlot
+	if slots < 1 {
+		return 1
+	}
+	return slots
+}
@@ -35,6 +35,10 @@ type Mock struct {
 	Failure bool
 	Err     bool
 
+	// the task slots advertised, 0 for no limit
+	Slots      int64
+	MemorySize int64
+
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
@@ -81,6 +85,7 @@ func (inm *Mock) buildIndexTask() {
 				proto.MarshalTextString(&indexMeta))
 			indexMeta.Version = indexMeta.Version + 1
 			indexMeta.State = commonpb.IndexState_Finished
+			indexMeta.SerializedSize = 1024
 			_ = inm.etcdKV.CompareVersionAndSwap(req.MetaPath, versions[0]+1,
 				proto.MarshalTextString(&indexMeta))
 		}
@@ -187,6 +192,33 @@ func (inm *Mock) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	}, nil
 }
 
+func (inm *Mock) GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error) {
+	if inm.Err {
+		return &indexpb.GetTaskSlotsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexNode GetTaskSlots failed")
+	}
+
+	if inm.Failure {
+		return &indexpb.GetTaskSlotsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "IndexNode GetTaskSlots failed",
+			},
+		}, nil
+	}
+
+	return &indexpb.GetTaskSlotsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Slots:      inm.Slots,
+		MemorySize: inm.MemorySize,
+	}, nil
+}
+
 func (inm *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if inm.Err {
 		return &milvuspb.GetMetricsResponse{
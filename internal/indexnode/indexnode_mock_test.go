@@ -76,6 +76,12 @@ func TestIndexNodeMock(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("GetTaskSlots", func(t *testing.T) {
+		resp, err := inm.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	err = inm.Stop()
 	assert.Nil(t, err)
 }
@@ -129,6 +135,12 @@ func TestIndexNodeMockError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("GetTaskSlots error", func(t *testing.T) {
+		resp, err := inm.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	err = inm.Stop()
 	assert.NotNil(t, err)
 }
@@ -178,6 +190,12 @@ func TestIndexNodeMockFiled(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("GetTaskSlots failed", func(t *testing.T) {
+		resp, err := inm.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	err = inm.Stop()
 	assert.Nil(t, err)
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -177,6 +178,8 @@ func TestIndexNode(t *testing.T) {
 			err = proto.UnmarshalText(value, &indexMetaTmp2)
 			assert.Nil(t, err)
 			assert.Equal(t, commonpb.IndexState_Finished, indexMetaTmp2.State)
+			assert.Equal(t, typeutil.IndexFormatVersion, indexMetaTmp2.FormatVersion)
+			assert.Greater(t, indexMetaTmp2.SerializedSize, int64(0))
 			defer in.kv.MultiRemove(indexMetaTmp2.IndexFilePaths)
 		}
 		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
@@ -450,6 +453,14 @@ func TestIndexNode(t *testing.T) {
 			zap.String("name", resp.ComponentName))
 	})
 
+	t.Run("GetTaskSlots", func(t *testing.T) {
+		resp, err := in.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.GreaterOrEqual(t, resp.Slots, int64(1))
+		assert.Greater(t, resp.MemorySize, int64(0))
+	})
+
 	err = in.Stop()
 	assert.Nil(t, err)
 }
@@ -709,6 +720,12 @@ func TestIndexNode_Error(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
+	t.Run("GetTaskSlots", func(t *testing.T) {
+		resp, err := in.GetTaskSlots(ctx, &indexpb.GetTaskSlotsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
 	in.UpdateStateCode(internalpb.StateCode_Healthy)
 
 	t.Run("Request Illegal", func(t *testing.T) {
@@ -751,3 +768,10 @@ func TestIndexNode_InitError(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 	})
 }
+
+func TestComputeTaskSlots(t *testing.T) {
+	assert.Equal(t, int64(1), computeTaskSlots(1, 4))
+	assert.Equal(t, int64(1), computeTaskSlots(4, 4))
+	assert.Equal(t, int64(2), computeTaskSlots(11, 4))
+	assert.Equal(t, int64(16), computeTaskSlots(16, 1))
+}
@@ -45,6 +45,16 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	StreamingBuildEnabled         bool
+	StreamingBuildTrainSampleSize int64 // MB
+
+	TaskSlotCPUs    int64   // the cpu cores of a build
+	TaskMemoryRatio float64 // the ratio of the memory for the builds
+
+	GPUEnabled        bool
+	GPUDeviceID       int64
+	GPUMemoryPoolSize int64 // MB
+
 	Log log.Config
 }
 
@@ -71,6 +81,10 @@ func (pt *ParamTable) initParams() {
 	pt.initMinioBucketName()
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
+	pt.initStreamingBuildEnabled()
+	pt.initStreamingBuildTrainSampleSize()
+	pt.initTaskSlots()
+	pt.initGPU()
 }
 
 func (pt *ParamTable) initMinIOAddress() {
@@ -136,6 +150,99 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+func (pt *ParamTable) initStreamingBuildEnabled() {
+	str, err := pt.LoadWithDefault("indexNode.streamingBuild.enabled", "true")
+	if err != nil {
+		panic(err)
+	}
+	enabled, err := strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+	pt.StreamingBuildEnabled = enabled
+}
+
+func (pt *ParamTable) initStreamingBuildTrainSampleSize() {
+	str, err := pt.LoadWithDefault("indexNode.streamingBuild.trainSampleSize", "256")
+	if err != nil {
+		panic(err)
+	}
+	size, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if size <= 0 {
+		panic(fmt.Sprintf("invalid indexNode.streamingBuild.trainSampleSize %d, should be positive", size))
+	}
+	pt.StreamingBuildTrainSampleSize = size
+}
+
+func (pt *ParamTable) initTaskSlots() {
+	str, err := pt.LoadWithDefault("indexNode.taskSlots.cpuPerSlot", "4")
+	if err != nil {
+		panic(err)
+	}
+	cpus, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if cpus <= 0 {
+		panic(fmt.Sprintf("invalid indexNode.taskSlots.cpuPerSlot %d, should be positive", cpus))
+	}
+	pt.TaskSlotCPUs = cpus
+
+	str, err = pt.LoadWithDefault("indexNode.taskSlots.memoryRatio", "0.5")
+	if err != nil {
+		panic(err)
+	}
+	ratio, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if ratio <= 0 || ratio > 1 {
+		panic(fmt.Sprintf("invalid indexNode.taskSlots.memoryRatio %f, should be in (0, 1]", ratio))
+	}
+	pt.TaskMemoryRatio = ratio
+}
+
+func (pt *ParamTable) initGPU() {
+	str, err := pt.LoadWithDefault("indexNode.gpu.enabled", "false")
+	if err != nil {
+		panic(err)
+	}
+	enabled, err := strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+	pt.GPUEnabled = enabled
+
+	str, err = pt.LoadWithDefault("indexNode.gpu.deviceID", "0")
+	if err != nil {
+		panic(err)
+	}
+	deviceID, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if deviceID < 0 {
+		panic(fmt.Sprintf("invalid indexNode.gpu.deviceID %d, should not be negative", deviceID))
+	}
+	pt.GPUDeviceID = deviceID
+
+	str, err = pt.LoadWithDefault("indexNode.gpu.memoryPoolSize", "1024")
+	if err != nil {
+		panic(err)
+	}
+	size, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if size <= 0 {
+		panic(fmt.Sprintf("invalid indexNode.gpu.memoryPoolSize %d, should be positive", size))
+	}
+	pt.GPUMemoryPoolSize = size
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
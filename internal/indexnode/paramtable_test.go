@@ -13,6 +13,8 @@ package indexnode
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParamTable(t *testing.T) {
@@ -65,6 +67,43 @@ func TestParamTable(t *testing.T) {
 	t.Run("MinioBucketName", func(t *testing.T) {
 		t.Logf("MinioBucketName: %v", Params.MinioBucketName)
 	})
+
+	t.Run("StreamingBuild", func(t *testing.T) {
+		assert.True(t, Params.StreamingBuildEnabled)
+		assert.Equal(t, int64(256), Params.StreamingBuildTrainSampleSize)
+
+		Params.Save("indexNode.streamingBuild.trainSampleSize", "0")
+		assert.Panics(t, func() { Params.initStreamingBuildTrainSampleSize() })
+		Params.Save("indexNode.streamingBuild.trainSampleSize", "256")
+		Params.initStreamingBuildTrainSampleSize()
+	})
+
+	t.Run("TaskSlots", func(t *testing.T) {
+		assert.Equal(t, int64(4), Params.TaskSlotCPUs)
+		assert.Equal(t, 0.5, Params.TaskMemoryRatio)
+
+		Params.Save("indexNode.taskSlots.cpuPerSlot", "0")
+		assert.Panics(t, func() { Params.initTaskSlots() })
+		Params.Save("indexNode.taskSlots.cpuPerSlot", "4")
+		Params.Save("indexNode.taskSlots.memoryRatio", "1.5")
+		assert.Panics(t, func() { Params.initTaskSlots() })
+		Params.Save("indexNode.taskSlots.memoryRatio", "0.5")
+		Params.initTaskSlots()
+	})
+
+	t.Run("GPU", func(t *testing.T) {
+		assert.False(t, Params.GPUEnabled)
+		assert.Equal(t, int64(0), Params.GPUDeviceID)
+		assert.Equal(t, int64(1024), Params.GPUMemoryPoolSize)
+
+		Params.Save("indexNode.gpu.deviceID", "-1")
+		assert.Panics(t, func() { Params.initGPU() })
+		Params.Save("indexNode.gpu.deviceID", "0")
+		Params.Save("indexNode.gpu.memoryPoolSize", "0")
+		assert.Panics(t, func() { Params.initGPU() })
+		Params.Save("indexNode.gpu.memoryPoolSize", "1024")
+		Params.initGPU()
+	})
 }
 
 //TODO: Params Load should be return error when key does not exist.
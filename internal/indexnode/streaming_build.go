@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// streamingBuilder feeds the float vectors of a segment into an index chunk by chunk. The chunks are buffered
+// until trainSize float values are collected, then the index is trained on the buffered sample and the
+// following chunks are added directly, so that at most the sample and one chunk are kept in memory
+type streamingBuilder struct {
+	trainSize int
+	train     func(vectors []float32) error
+	add       func(vectors []float32) error
+
+	sample  []float32
+	trained bool
+	rows    int
+}
+
+func newStreamingBuilder(trainSize int, train, add func(vectors []float32) error) *streamingBuilder {
+	return &streamingBuilder{
+		trainSize: trainSize,
+		train:     train,
+		add:       add,
+	}
+}
+
+// feed adds a chunk of vectors, vectors may be reused by the caller once feed returns
+func (b *streamingBuilder) feed(vectors []float32, rows int) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	b.rows += rows
+	if b.trained {
+		return b.add(vectors)
+	}
+	b.sample = append(b.sample, vectors...)
+	if len(b.sample) < b.trainSize {
+		return nil
+	}
+	return b.trainOnSample()
+}
+
+func (b *streamingBuilder) trainOnSample() error {
+	if err := b.train(b.sample); err != nil {
+		return err
+	}
+	b.trained = true
+	if err := b.add(b.sample); err != nil {
+		return err
+	}
+	b.sample = nil
+	return nil
+}
+
+// finish trains the index on the buffered vectors if the segment is smaller than the train size
+func (b *streamingBuilder) finish() error {
+	if !b.trained {
+		if len(b.sample) == 0 {
+			return errors.New("no vectors to build the index")
+		}
+		return b.trainOnSample()
+	}
+	return nil
+}
+
+// sortDataPaths sorts the binlog paths in the order the insert codec deserializes them, so that the
+// offsets of the vectors in the index are the same as the ones of the rows in the segment
+func sortDataPaths(paths []string) []string {
+	blobs := make(storage.BlobList, len(paths))
+	for i, p := range paths {
+		blobs[i] = &storage.Blob{Key: p}
+	}
+	sort.Sort(blobs)
+	sorted := make([]string, len(blobs))
+	for i, blob := range blobs {
+		sorted[i] = blob.Key
+	}
+	return sorted
+}
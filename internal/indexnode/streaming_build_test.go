@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStreamingIndex struct {
+	trained []float32
+	added   []float32
+	calls   []string
+}
+
+func (m *mockStreamingIndex) train(vectors []float32) error {
+	m.trained = append([]float32{}, vectors...)
+	m.calls = append(m.calls, "train")
+	return nil
+}
+
+func (m *mockStreamingIndex) add(vectors []float32) error {
+	if len(m.trained) == 0 {
+		return errors.New("not trained")
+	}
+	m.added = append(m.added, vectors...)
+	m.calls = append(m.calls, "add")
+	return nil
+}
+
+func TestStreamingBuilder(t *testing.T) {
+	t.Run("train on sample", func(t *testing.T) {
+		m := &mockStreamingIndex{}
+		b := newStreamingBuilder(4, m.train, m.add)
+		assert.Nil(t, b.feed([]float32{1, 2}, 1))
+		assert.Empty(t, m.calls)
+		assert.Nil(t, b.feed([]float32{3, 4}, 1))
+		assert.Equal(t, []float32{1, 2, 3, 4}, m.trained)
+		assert.Nil(t, b.feed([]float32{5, 6}, 1))
+		assert.Nil(t, b.feed(nil, 0))
+		assert.Nil(t, b.finish())
+		assert.Equal(t, []string{"train", "add", "add"}, m.calls)
+		assert.Equal(t, []float32{1, 2, 3, 4, 5, 6}, m.added)
+		assert.Equal(t, 3, b.rows)
+		assert.Nil(t, b.sample)
+	})
+
+	t.Run("segment smaller than sample", func(t *testing.T) {
+		m := &mockStreamingIndex{}
+		b := newStreamingBuilder(100, m.train, m.add)
+		assert.Nil(t, b.feed([]float32{1, 2}, 1))
+		assert.Nil(t, b.finish())
+		assert.Equal(t, []string{"train", "add"}, m.calls)
+		assert.Equal(t, []float32{1, 2}, m.added)
+	})
+
+	t.Run("no vectors", func(t *testing.T) {
+		m := &mockStreamingIndex{}
+		b := newStreamingBuilder(100, m.train, m.add)
+		assert.Error(t, b.finish())
+	})
+
+	t.Run("train failed", func(t *testing.T) {
+		b := newStreamingBuilder(1, func([]float32) error { return errors.New("mock") }, nil)
+		assert.Error(t, b.feed([]float32{1}, 1))
+	})
+}
+
+func TestSortDataPaths(t *testing.T) {
+	paths := []string{"a/b/10", "a/b/2", "a/b/1"}
+	assert.Equal(t, []string{"a/b/1", "a/b/2", "a/b/10"}, sortDataPaths(paths))
+}
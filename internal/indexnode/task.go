@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -86,12 +87,13 @@ func (bt *BaseTask) Notify(err error) {
 
 type IndexBuildTask struct {
 	BaseTask
-	index     Index
-	kv        kv.BaseKV
-	etcdKV    *etcdkv.EtcdKV
-	savePaths []string
-	req       *indexpb.CreateIndexRequest
-	nodeID    UniqueID
+	index          Index
+	kv             kv.BaseKV
+	etcdKV         *etcdkv.EtcdKV
+	savePaths      []string
+	serializedSize int64 // the bytes of the saved index files
+	req            *indexpb.CreateIndexRequest
+	nodeID         UniqueID
 }
 
 func (it *IndexBuildTask) Ctx() context.Context {
@@ -150,12 +152,19 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 		if pre {
 			return nil
 		}
-		indexMeta.IndexFilePaths = it.savePaths
 		indexMeta.State = commonpb.IndexState_Finished
 		if it.err != nil {
 			log.Debug("IndexNode CreateIndex Failed", zap.Int64("IndexBuildID", indexMeta.IndexBuildID), zap.Any("err", err))
 			indexMeta.State = commonpb.IndexState_Failed
 			indexMeta.FailReason = it.err.Error()
+			indexMeta.FailClass = classifyBuildError(it.err)
+		} else {
+			indexMeta.FailReason = ""
+			indexMeta.FailClass = indexpb.IndexFailClass_FailClassNone
+			// a failed re-encode keeps the files of the previous format
+			indexMeta.IndexFilePaths = it.savePaths
+			indexMeta.SerializedSize = it.serializedSize
+			indexMeta.FormatVersion = typeutil.IndexFormatVersion
 		}
 		log.Debug("IndexNode", zap.Int64("indexBuildID", indexMeta.IndexBuildID), zap.Any("IndexState", indexMeta.State))
 		err = it.etcdKV.CompareVersionAndSwap(it.req.MetaPath, versions[0],
@@ -197,12 +206,12 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		key, value := kvPair.GetKey(), kvPair.GetValue()
 		_, ok := typeParams[key]
 		if ok {
-			return errors.New("duplicated key in type params")
+			return withFailClass(errors.New("duplicated key in type params"), indexpb.IndexFailClass_UnsupportedParam)
 		}
 		if key == paramsKeyToParse {
 			params, err := funcutil.ParseIndexParamsMap(value)
 			if err != nil {
-				return err
+				return withFailClass(err, indexpb.IndexFailClass_UnsupportedParam)
 			}
 			for pk, pv := range params {
 				typeParams[pk] = pv
@@ -217,12 +226,12 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		key, value := kvPair.GetKey(), kvPair.GetValue()
 		_, ok := indexParams[key]
 		if ok {
-			return errors.New("duplicated key in index params")
+			return withFailClass(errors.New("duplicated key in index params"), indexpb.IndexFailClass_UnsupportedParam)
 		}
 		if key == paramsKeyToParse {
 			params, err := funcutil.ParseIndexParamsMap(value)
 			if err != nil {
-				return err
+				return withFailClass(err, indexpb.IndexFailClass_UnsupportedParam)
 			}
 			for pk, pv := range params {
 				indexParams[pk] = pv
@@ -232,7 +241,8 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	it.index, err = NewCIndex(typeParams, indexParams)
+	buildParams := setBuildDevice(indexParams, Params.GPUEnabled, Params.GPUDeviceID, Params.GPUMemoryPoolSize)
+	it.index, err = NewCIndex(typeParams, buildParams)
 	if err != nil {
 		log.Error("IndexNode IndexBuildTask Execute NewCIndex failed", zap.Error(err))
 		return err
@@ -270,12 +280,102 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		return blobs
 	}
 
+	streaming := false
+	if Params.StreamingBuildEnabled {
+		streaming, err = it.index.IsStreamingBuildSupported()
+		if err != nil {
+			return err
+		}
+	}
+	var partitionID, segmentID UniqueID
+	if streaming {
+		partitionID, segmentID, err = it.buildStreaming(getBlobByPath, tr)
+	} else {
+		partitionID, segmentID, err = it.buildAll(getBlobByPath, tr)
+	}
+	if err != nil {
+		return err
+	}
+
+	indexBlobs, err := it.index.Serialize()
+	if err != nil {
+		log.Error("IndexNode index Serialize failed", zap.Error(err))
+		return err
+	}
+	tr.Record("serialize index done")
+
+	var indexCodec storage.IndexCodec
+	serializedIndexBlobs, err := indexCodec.Serialize(getStorageBlobs(indexBlobs), indexParams, it.req.IndexName, it.req.IndexID)
+	if err != nil {
+		return err
+	}
+	tr.Record("serialize index codec done")
+
+	getSavePathByKey := func(key string) string {
+		// TODO: fix me, use more reasonable method
+		return strconv.Itoa(int(it.req.IndexBuildID)) + "/" + strconv.Itoa(int(it.req.Version)) + "/" + strconv.Itoa(int(partitionID)) + "/" + strconv.Itoa(int(segmentID)) + "/" + key
+	}
+	saveBlob := func(path string, value []byte) error {
+		return it.kv.Save(path, string(value))
+	}
+
+	it.savePaths = make([]string, len(serializedIndexBlobs))
+	saveIndexFile := func(idx int) error {
+		blob := serializedIndexBlobs[idx]
+		key, value := blob.Key, blob.Value
+
+		savePath := getSavePathByKey(key)
+
+		saveIndexFileFn := func() error {
+			v, err := it.etcdKV.Load(it.req.MetaPath)
+			if err != nil {
+				log.Debug("IndexNode load meta failed", zap.Any("path", it.req.MetaPath), zap.Error(err))
+				return err
+			}
+			indexMeta := indexpb.IndexMeta{}
+			err = proto.UnmarshalText(v, &indexMeta)
+			if err != nil {
+				log.Debug("IndexNode Unmarshal indexMeta error ", zap.Error(err))
+				return err
+			}
+			//log.Debug("IndexNode Unmarshal indexMeta success ", zap.Any("meta", indexMeta))
+			if indexMeta.Version > it.req.Version {
+				log.Debug("IndexNode try saveIndexFile failed req.Version is low", zap.Any("req.Version", it.req.Version),
+					zap.Any("indexMeta.Version", indexMeta.Version))
+				return errors.New("This task has been reassigned ")
+			}
+			return saveBlob(savePath, value)
+		}
+		err := retry.Do(ctx, saveIndexFileFn, retry.Attempts(5))
+		log.Debug("IndexNode try saveIndexFile final", zap.Error(err), zap.Any("savePath", savePath))
+		if err != nil {
+			return err
+		}
+
+		it.savePaths[idx] = savePath
+
+		return nil
+	}
+	err = funcutil.ProcessFuncParallel(len(serializedIndexBlobs), runtime.NumCPU(), saveIndexFile, "saveIndexFile")
+	if err != nil {
+		return err
+	}
+	it.serializedSize = 0
+	for _, blob := range serializedIndexBlobs {
+		it.serializedSize += int64(len(blob.Value))
+	}
+	tr.Record("save index file done")
+	log.Debug("IndexNode CreateIndex finished")
+	tr.Elapse("all done")
+	return nil
+}
+
+// buildAll loads all the binlogs of the segment and builds the index on all the vectors at once
+func (it *IndexBuildTask) buildAll(getBlobByPath func(path string) (*Blob, error), tr *timerecord.TimeRecorder) (UniqueID, UniqueID, error) {
 	toLoadDataPaths := it.req.GetDataPaths()
-	keys := make([]string, len(toLoadDataPaths))
 	blobs := make([]*Blob, len(toLoadDataPaths))
 
 	loadKey := func(idx int) error {
-		keys[idx] = getKeyByPathNaive(toLoadDataPaths[idx])
 		blob, err := getBlobByPath(toLoadDataPaths[idx])
 		if err != nil {
 			return err
@@ -285,117 +385,97 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 
 		return nil
 	}
-	err = funcutil.ProcessFuncParallel(len(toLoadDataPaths), runtime.NumCPU(), loadKey, "loadKey")
+	err := funcutil.ProcessFuncParallel(len(toLoadDataPaths), runtime.NumCPU(), loadKey, "loadKey")
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	log.Debug("IndexNode load data success")
 	tr.Record("loadKey done")
 
-	storageBlobs := getStorageBlobs(blobs)
 	var insertCodec storage.InsertCodec
 	defer insertCodec.Close()
-	partitionID, segmentID, insertData, err2 := insertCodec.Deserialize(storageBlobs)
-	if err2 != nil {
-		return err2
+	partitionID, segmentID, insertData, err := insertCodec.Deserialize(blobs)
+	if err != nil {
+		return 0, 0, withFailClass(err, indexpb.IndexFailClass_CorruptBinlog)
 	}
 	if len(insertData.Data) != 1 {
-		return errors.New("we expect only one field in deserialized insert data")
+		return 0, 0, withFailClass(errors.New("we expect only one field in deserialized insert data"), indexpb.IndexFailClass_CorruptBinlog)
 	}
 	tr.Record("deserialize storage blobs done")
 
-	for _, value := range insertData.Data {
-		// TODO: BinaryVectorFieldData
-		floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
-		if fOk {
-			err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+	for fieldID, value := range insertData.Data {
+		switch data := value.(type) {
+		case *storage.FloatVectorFieldData:
+			err = it.index.BuildFloatVecIndexWithoutIds(data.Data)
 			if err != nil {
+				err = withSegmentContext(err, segmentID, fieldID)
 				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
-				return err
+				return 0, 0, err
 			}
 			tr.Record("build float vector index done")
-		}
-
-		binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
-		if bOk {
-			err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
+		case *storage.BinaryVectorFieldData:
+			err = it.index.BuildBinaryVecIndexWithoutIds(data.Data)
 			if err != nil {
+				err = withSegmentContext(err, segmentID, fieldID)
 				log.Error("IndexNode BuildBinaryVecIndexWithoutIds failed", zap.Error(err))
-				return err
+				return 0, 0, err
 			}
 			tr.Record("build binary vector index done")
+		default:
+			return 0, 0, withFailClass(errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData"), indexpb.IndexFailClass_CorruptBinlog)
 		}
+	}
+	return partitionID, segmentID, nil
+}
 
-		if !fOk && !bOk {
-			return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
-		}
+// buildStreaming loads the binlogs of the segment one by one, the index is trained on the first vectors up to
+// the train sample size and the others are added binlog by binlog, so that the segment doesn't need to fit in memory
+func (it *IndexBuildTask) buildStreaming(getBlobByPath func(path string) (*Blob, error), tr *timerecord.TimeRecorder) (UniqueID, UniqueID, error) {
+	trainSize := int(Params.StreamingBuildTrainSampleSize * 1024 * 1024 / 4)
+	builder := newStreamingBuilder(trainSize, it.index.TrainFloatVecIndexWithoutIds, it.index.AddFloatVecIndexWithoutIds)
 
-		indexBlobs, err := it.index.Serialize()
+	var partitionID, segmentID UniqueID
+	var fieldID storage.FieldID
+	for i, dataPath := range sortDataPaths(it.req.GetDataPaths()) {
+		blob, err := getBlobByPath(dataPath)
 		if err != nil {
-			log.Error("IndexNode index Serialize failed", zap.Error(err))
-			return err
+			return 0, 0, err
 		}
-		tr.Record("serialize index done")
-
-		var indexCodec storage.IndexCodec
-		serializedIndexBlobs, err := indexCodec.Serialize(getStorageBlobs(indexBlobs), indexParams, it.req.IndexName, it.req.IndexID)
+		var insertCodec storage.InsertCodec
+		pID, sID, insertData, err := insertCodec.Deserialize([]*Blob{blob})
 		if err != nil {
-			return err
+			insertCodec.Close()
+			return 0, 0, withFailClass(err, indexpb.IndexFailClass_CorruptBinlog)
 		}
-		tr.Record("serialize index codec done")
-
-		getSavePathByKey := func(key string) string {
-			// TODO: fix me, use more reasonable method
-			return strconv.Itoa(int(it.req.IndexBuildID)) + "/" + strconv.Itoa(int(it.req.Version)) + "/" + strconv.Itoa(int(partitionID)) + "/" + strconv.Itoa(int(segmentID)) + "/" + key
+		if len(insertData.Data) != 1 {
+			insertCodec.Close()
+			return 0, 0, withFailClass(errors.New("we expect only one field in deserialized insert data"), indexpb.IndexFailClass_CorruptBinlog)
 		}
-		saveBlob := func(path string, value []byte) error {
-			return it.kv.Save(path, string(value))
-		}
-
-		it.savePaths = make([]string, len(serializedIndexBlobs))
-		saveIndexFile := func(idx int) error {
-			blob := serializedIndexBlobs[idx]
-			key, value := blob.Key, blob.Value
-
-			savePath := getSavePathByKey(key)
-
-			saveIndexFileFn := func() error {
-				v, err := it.etcdKV.Load(it.req.MetaPath)
-				if err != nil {
-					log.Debug("IndexNode load meta failed", zap.Any("path", it.req.MetaPath), zap.Error(err))
-					return err
-				}
-				indexMeta := indexpb.IndexMeta{}
-				err = proto.UnmarshalText(v, &indexMeta)
-				if err != nil {
-					log.Debug("IndexNode Unmarshal indexMeta error ", zap.Error(err))
-					return err
-				}
-				//log.Debug("IndexNode Unmarshal indexMeta success ", zap.Any("meta", indexMeta))
-				if indexMeta.Version > it.req.Version {
-					log.Debug("IndexNode try saveIndexFile failed req.Version is low", zap.Any("req.Version", it.req.Version),
-						zap.Any("indexMeta.Version", indexMeta.Version))
-					return errors.New("This task has been reassigned ")
-				}
-				return saveBlob(savePath, value)
-			}
-			err := retry.Do(ctx, saveIndexFileFn, retry.Attempts(5))
-			log.Debug("IndexNode try saveIndexFile final", zap.Error(err), zap.Any("savePath", savePath))
-			if err != nil {
-				return err
+		for id, value := range insertData.Data {
+			if i > 0 && id != fieldID {
+				err = withFailClass(fmt.Errorf("binlogs of different fields %d and %d in the index build", fieldID, id), indexpb.IndexFailClass_CorruptBinlog)
+			} else if data, ok := value.(*storage.FloatVectorFieldData); ok {
+				err = builder.feed(data.Data, len(data.Data)/data.Dim)
+			} else {
+				err = withFailClass(errors.New("we expect FloatVectorFieldData in the streaming build"), indexpb.IndexFailClass_CorruptBinlog)
 			}
-
-			it.savePaths[idx] = savePath
-
-			return nil
+			fieldID = id
 		}
-		err = funcutil.ProcessFuncParallel(len(serializedIndexBlobs), runtime.NumCPU(), saveIndexFile, "saveIndexFile")
+		insertCodec.Close()
 		if err != nil {
-			return err
+			err = withSegmentContext(err, sID, fieldID)
+			log.Error("IndexNode streaming build failed", zap.String("dataPath", dataPath), zap.Error(err))
+			return 0, 0, err
 		}
-		tr.Record("save index file done")
+		partitionID, segmentID = pID, sID
 	}
-	log.Debug("IndexNode CreateIndex finished")
-	tr.Elapse("all done")
-	return nil
+	if err := builder.finish(); err != nil {
+		err = withSegmentContext(err, segmentID, fieldID)
+		log.Error("IndexNode streaming build failed", zap.Error(err))
+		return 0, 0, err
+	}
+	log.Debug("IndexNode streaming build done", zap.Int64("indexBuildID", it.req.IndexBuildID),
+		zap.Int("binlogs", len(it.req.GetDataPaths())), zap.Int("rows", builder.rows))
+	tr.Record("build float vector index in streaming done")
+	return partitionID, segmentID, nil
 }
@@ -154,24 +154,18 @@ func (kv *MemoryKV) Close() {
 }
 
 func (kv *MemoryKV) MultiRemoveWithPrefix(keys []string) error {
-	panic("not implement")
+	kv.Lock()
+	defer kv.Unlock()
+
+	kv.removeWithPrefixes(keys)
+	return nil
 }
+
 func (kv *MemoryKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
 	kv.Lock()
 	defer kv.Unlock()
 
-	keys := make([]memoryKVItem, 0)
-	for _, key := range removals {
-		kv.tree.Ascend(func(i btree.Item) bool {
-			if strings.HasPrefix(i.(memoryKVItem).key, key) {
-				keys = append(keys, i.(memoryKVItem))
-			}
-			return true
-		})
-	}
-	for _, item := range keys {
-		kv.tree.Delete(item)
-	}
+	kv.removeWithPrefixes(removals)
 
 	for key, value := range saves {
 		kv.tree.ReplaceOrInsert(memoryKVItem{key, value})
@@ -196,3 +190,19 @@ func (kv *MemoryKV) RemoveWithPrefix(key string) error {
 	}
 	return nil
 }
+
+// removeWithPrefixes removes all the items matching any of the prefixes, kv.Lock() before call this function
+func (kv *MemoryKV) removeWithPrefixes(prefixes []string) {
+	keys := make([]memoryKVItem, 0)
+	for _, prefix := range prefixes {
+		kv.tree.Ascend(func(i btree.Item) bool {
+			if strings.HasPrefix(i.(memoryKVItem).key, prefix) {
+				keys = append(keys, i.(memoryKVItem))
+			}
+			return true
+		})
+	}
+	for _, item := range keys {
+		kv.tree.Delete(item)
+	}
+}
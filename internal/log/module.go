@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LevelPath is the http path to get and change the log levels at runtime
+const LevelPath = "/log/level"
+
+var (
+	moduleMu      sync.RWMutex
+	moduleLevels  = make(map[string]zapcore.Level)
+	moduleLoggers = make(map[string]*moduleLogger)
+)
+
+type moduleLogger struct {
+	parent *zap.Logger
+	logger *zap.Logger
+}
+
+// moduleCore filters the entries of a module logger by the level of the module if it is set,
+// otherwise by the global level
+type moduleCore struct {
+	zapcore.Core
+	module string
+}
+
+func (c *moduleCore) Enabled(level zapcore.Level) bool {
+	moduleMu.RLock()
+	moduleLevel, ok := moduleLevels[c.module]
+	moduleMu.RUnlock()
+	if ok {
+		return moduleLevel.Enabled(level)
+	}
+	return c.Core.Enabled(level)
+}
+
+func (c *moduleCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleCore{
+		Core:   c.Core.With(fields),
+		module: c.module,
+	}
+}
+
+func (c *moduleCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Module returns the logger of a module, whose level can be changed by SetModuleLevel apart from the global one
+func Module(module string) *zap.Logger {
+	parent := L()
+	moduleMu.RLock()
+	l, ok := moduleLoggers[module]
+	moduleMu.RUnlock()
+	if ok && l.parent == parent {
+		return l.logger
+	}
+
+	l = &moduleLogger{
+		parent: parent,
+		logger: parent.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &moduleCore{Core: core, module: module}
+		})).With(zap.String("module", module)),
+	}
+	moduleMu.Lock()
+	moduleLoggers[module] = l
+	moduleMu.Unlock()
+	return l.logger
+}
+
+// SetModuleLevel sets the level of a module logger
+func SetModuleLevel(module string, level zapcore.Level) {
+	moduleMu.Lock()
+	defer moduleMu.Unlock()
+	moduleLevels[module] = level
+}
+
+// ResetModuleLevel makes a module logger follow the global level again
+func ResetModuleLevel(module string) {
+	moduleMu.Lock()
+	defer moduleMu.Unlock()
+	delete(moduleLevels, module)
+}
+
+// LevelState is the global level and the levels set on the module loggers
+type LevelState struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+// LevelRequest changes the global level, or the level of Module if it is not empty.
+// The level of the module is reset to follow the global one if Reset is true
+type LevelRequest struct {
+	Level  string `json:"level"`
+	Module string `json:"module"`
+	Reset  bool   `json:"reset"`
+}
+
+// GetLevelState returns the current log levels
+func GetLevelState() *LevelState {
+	state := &LevelState{
+		Level:   GetLevel().String(),
+		Modules: make(map[string]string),
+	}
+	moduleMu.RLock()
+	defer moduleMu.RUnlock()
+	for module, level := range moduleLevels {
+		state.Modules[module] = level.String()
+	}
+	return state
+}
+
+// ApplyLevelRequest changes the log levels as the request asks and returns the levels after the change
+func ApplyLevelRequest(req *LevelRequest) (*LevelState, error) {
+	if req.Reset {
+		if req.Module == "" {
+			return nil, fmt.Errorf("module is required to reset the level")
+		}
+		ResetModuleLevel(req.Module)
+		Info("reset log level of module", zap.String("module", req.Module))
+		return GetLevelState(), nil
+	}
+	if req.Level == "" {
+		return GetLevelState(), nil
+	}
+
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %s: %w", req.Level, err)
+	}
+	if req.Module == "" {
+		SetLevel(level)
+	} else {
+		SetModuleLevel(req.Module, level)
+	}
+	Info("change log level", zap.String("module", req.Module), zap.String("level", level.String()))
+	return GetLevelState(), nil
+}
+
+// HandleLevel serves the log levels on mux, GET returns the levels and PUT changes them with
+// a json LevelRequest in the body
+func HandleLevel(mux *http.ServeMux) {
+	mux.HandleFunc(LevelPath, func(w http.ResponseWriter, r *http.Request) {
+		var state *LevelState
+		switch r.Method {
+		case http.MethodGet:
+			state = GetLevelState()
+		case http.MethodPut, http.MethodPost:
+			req := &LevelRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				http.Error(w, fmt.Sprintf("failed to decode the request: %s", err.Error()), http.StatusBadRequest)
+				return
+			}
+			var err error
+			state, err = ApplyLevelRequest(req)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(state); err != nil {
+			Warn("failed to write the response of log level", zap.Error(err))
+		}
+	})
+}
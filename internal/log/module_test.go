@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func replaceWithObserver() (*observer.ObservedLogs, func()) {
+	oldL, oldP := L(), _globalP.Load().(*ZapProperties)
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	core, logs := observer.New(level)
+	ReplaceGlobals(zap.New(core), &ZapProperties{Core: core, Level: level})
+	return logs, func() {
+		ReplaceGlobals(oldL, oldP)
+	}
+}
+
+func TestModuleLevel(t *testing.T) {
+	logs, restore := replaceWithObserver()
+	defer restore()
+	defer ResetModuleLevel("test.module")
+
+	Module("test.module").Debug("debug before")
+	SetModuleLevel("test.module", zapcore.DebugLevel)
+	Module("test.module").Debug("debug after")
+	Module("test.other").Debug("debug other")
+	assert.Equal(t, 1, logs.FilterMessage("debug after").Len())
+	assert.Equal(t, 0, logs.FilterMessage("debug before").Len())
+	assert.Equal(t, 0, logs.FilterMessage("debug other").Len())
+
+	SetModuleLevel("test.module", zapcore.ErrorLevel)
+	Module("test.module").Warn("warn muted")
+	Module("test.other").Warn("warn other")
+	assert.Equal(t, 0, logs.FilterMessage("warn muted").Len())
+	assert.Equal(t, 1, logs.FilterMessage("warn other").Len())
+	assert.Equal(t, "test.other", logs.FilterMessage("warn other").All()[0].ContextMap()["module"])
+
+	ResetModuleLevel("test.module")
+	Module("test.module").Warn("warn reset")
+	assert.Equal(t, 1, logs.FilterMessage("warn reset").Len())
+}
+
+func TestApplyLevelRequest(t *testing.T) {
+	_, restore := replaceWithObserver()
+	defer restore()
+	defer ResetModuleLevel("test.module")
+
+	state, err := ApplyLevelRequest(&LevelRequest{Level: "warn"})
+	assert.Nil(t, err)
+	assert.Equal(t, "warn", state.Level)
+	assert.Equal(t, zapcore.WarnLevel, GetLevel())
+
+	state, err = ApplyLevelRequest(&LevelRequest{Level: "debug", Module: "test.module"})
+	assert.Nil(t, err)
+	assert.Equal(t, "debug", state.Modules["test.module"])
+
+	state, err = ApplyLevelRequest(&LevelRequest{Module: "test.module", Reset: true})
+	assert.Nil(t, err)
+	_, ok := state.Modules["test.module"]
+	assert.False(t, ok)
+
+	_, err = ApplyLevelRequest(&LevelRequest{Level: "verbose"})
+	assert.Error(t, err)
+	_, err = ApplyLevelRequest(&LevelRequest{Reset: true})
+	assert.Error(t, err)
+}
+
+func TestHandleLevel(t *testing.T) {
+	_, restore := replaceWithObserver()
+	defer restore()
+
+	mux := http.NewServeMux()
+	HandleLevel(mux)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPut, LevelPath, strings.NewReader(`{"level": "error"}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, LevelPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	state := &LevelState{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), state))
+	assert.Equal(t, "error", state.Level)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPut, LevelPath, strings.NewReader(`{"level": "verbose"}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, LevelPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
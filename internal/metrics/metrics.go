@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -11,11 +12,27 @@ import (
 )
 
 const (
-	milvusNamespace    = "milvus"
-	subSystemRootCoord = "rootcoord"
-	subSystemDataCoord = "dataCoord"
-	subSystemDataNode  = "dataNode"
-	subSystemProxy     = "proxy"
+	milvusNamespace     = "milvus"
+	subSystemRootCoord  = "rootcoord"
+	subSystemDataCoord  = "dataCoord"
+	subSystemDataNode   = "dataNode"
+	subSystemProxy      = "proxy"
+	subSystemQueryCoord = "queryCoord"
+	subSystemQueryNode  = "queryNode"
+
+	// CacheHitLabel and CacheMissLabel are the types of the accesses of a cache
+	CacheHitLabel  = "hit"
+	CacheMissLabel = "miss"
+
+	// TotalLabel, SuccessLabel and FailLabel are the statuses of the requests
+	TotalLabel   = "total"
+	SuccessLabel = "success"
+	FailLabel    = "fail"
+)
+
+var (
+	// buckets in milliseconds, from 1ms to about 131s
+	latencyBuckets = prometheus.ExponentialBuckets(1, 2, 18)
 )
 
 var (
@@ -76,6 +93,24 @@ var (
 			Help:      "Counter of show collections",
 		}, []string{"client_id", "type"})
 
+	// RootCoordAlterCollectionCounter used to count the num of calls of AlterCollection
+	RootCoordAlterCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "alter_collection_total",
+			Help:      "Counter of alter collection",
+		}, []string{"client_id", "type"})
+
+	// RootCoordUndropCollectionCounter used to count the num of calls of UndropCollection
+	RootCoordUndropCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "undrop_collection_total",
+			Help:      "Counter of undrop collection",
+		}, []string{"client_id", "type"})
+
 	// RootCoordCreatePartitionCounter used to count the num of calls of CreatePartition
 	RootCoordCreatePartitionCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -169,6 +204,16 @@ var (
 			Help:      "Time tick of insert Channel in 24H",
 		}, []string{"vchannel"})
 
+	// RootCoordDmlChannelTimeTickLag records the milliseconds between the max and the min time tick of the
+	// sources of a dml channel, the min one is sent to the channel
+	RootCoordDmlChannelTimeTickLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "dml_channel_time_tick_lag",
+			Help:      "Milliseconds between the max and the min time tick of the sources of a dml channel",
+		}, []string{"pchannel"})
+
 	// RootCoordDDChannelTimeTick used to count the time tick num of dd channel in 24H
 	RootCoordDDChannelTimeTick = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -189,6 +234,8 @@ func RegisterRootCoord() {
 	prometheus.MustRegister(RootCoordHasCollectionCounter)
 	prometheus.MustRegister(RootCoordDescribeCollectionCounter)
 	prometheus.MustRegister(RootCoordShowCollectionsCounter)
+	prometheus.MustRegister(RootCoordAlterCollectionCounter)
+	prometheus.MustRegister(RootCoordUndropCollectionCounter)
 	prometheus.MustRegister(RootCoordCreatePartitionCounter)
 	prometheus.MustRegister(RootCoordDropPartitionCounter)
 	prometheus.MustRegister(RootCoordHasPartitionCounter)
@@ -201,6 +248,7 @@ func RegisterRootCoord() {
 
 	// for time tick
 	prometheus.MustRegister(RootCoordInsertChannelTimeTick)
+	prometheus.MustRegister(RootCoordDmlChannelTimeTickLag)
 	prometheus.MustRegister(RootCoordDDChannelTimeTick)
 	//prometheus.MustRegister(PanicCounter)
 }
@@ -520,6 +568,155 @@ var (
 			Name:      "dml_channels_time_tick",
 			Help:      "Time tick of dml channels",
 		}, []string{"pchan"})
+
+	// ProxyDmlChannelTimeTickSkew used to record the difference in milliseconds between the max and the min time ticks
+	// of dml channels
+	ProxyDmlChannelTimeTickSkew = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "dml_channels_time_tick_skew_ms",
+			Help:      "Difference in milliseconds between the max and the min time ticks of dml channels",
+		})
+
+	// ProxyStalledDmlChannelNum used to count the dml channels whose time tick doesn't advance
+	ProxyStalledDmlChannelNum = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "stalled_dml_channel_num",
+			Help:      "The num of dml channels whose time tick doesn't advance",
+		})
+
+	// ProxyDMLMirrorPending used to count the dml requests waiting to be mirrored
+	ProxyDMLMirrorPending = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "dml_mirror_pending",
+			Help:      "Num of dml requests waiting to be mirrored",
+		})
+
+	// ProxyDMLMirrorLag used to record the lag in milliseconds of the last mirrored dml request
+	ProxyDMLMirrorLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "dml_mirror_lag_ms",
+			Help:      "Lag in milliseconds of the last mirrored dml request",
+		})
+
+	// ProxyDMLMirrorCounter used to count the num of mirrored dml requests
+	ProxyDMLMirrorCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "dml_mirror_total",
+			Help:      "Counter of mirrored dml requests",
+		}, []string{"type", "status"})
+
+	// ProxyRequestLatency used to record the latency in milliseconds of the grpc requests
+	ProxyRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "request_latency_ms",
+			Help:      "Latency in milliseconds of the requests",
+			Buckets:   latencyBuckets,
+		}, []string{"function", "status"})
+
+	// ProxyInsertRowsCounter used to count the num of inserted rows
+	ProxyInsertRowsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "insert_rows_total",
+			Help:      "Counter of inserted rows",
+		})
+
+	// ProxyInsertBytesCounter used to count the size in bytes of the insert requests
+	ProxyInsertBytesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "insert_bytes_total",
+			Help:      "Counter of the size in bytes of insert requests",
+		})
+
+	// ProxySearchVectorsCounter used to count the num of searched vectors
+	ProxySearchVectorsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "search_vectors_total",
+			Help:      "Counter of searched vectors",
+		})
+
+	// ProxyQueueWaitLatency used to record the time in milliseconds that tasks wait in the task queues
+	ProxyQueueWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "queue_wait_latency_ms",
+			Help:      "Time in milliseconds that tasks wait in the task queues",
+			Buckets:   latencyBuckets,
+		}, []string{"queue"})
+
+	// ProxyTaskQueueLength used to record the number of the unissued and the active tasks of the task queues
+	ProxyTaskQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "task_queue_length",
+			Help:      "Number of the unissued and the active tasks of the task queues",
+		}, []string{"queue", "state"})
+
+	// ProxyRejectedTaskCounter used to count the tasks rejected because the task queues are full
+	ProxyRejectedTaskCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "rejected_task_total",
+			Help:      "Counter of the tasks rejected because the task queues are full",
+		}, []string{"queue"})
+
+	// ProxyMetaCacheCounter used to count the hits and misses of the meta cache
+	ProxyMetaCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "meta_cache_access_total",
+			Help:      "Counter of the accesses of the meta cache",
+		}, []string{"function", "type"})
+
+	// ProxySearchShadowCounter used to count the search requests shadowed to the canary group
+	ProxySearchShadowCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "search_shadow_total",
+			Help:      "Counter of search requests shadowed to the canary group",
+		}, []string{"status"})
+
+	// ProxySearchShadowLatency used to record the search latency in milliseconds of the primary and the canary group
+	ProxySearchShadowLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "search_shadow_latency_ms",
+			Help:      "Latency in milliseconds of the shadowed search requests",
+			Buckets:   latencyBuckets,
+		}, []string{"group"})
+
+	// ProxySearchShadowRecall used to record the recall of the canary results against the primary results
+	ProxySearchShadowRecall = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "search_shadow_recall",
+			Help:      "Recall of the canary search results against the primary results",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+		})
 )
 
 //RegisterProxy register Proxy metrics
@@ -569,16 +766,158 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
 
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
+	prometheus.MustRegister(ProxyDmlChannelTimeTickSkew)
+	prometheus.MustRegister(ProxyStalledDmlChannelNum)
+
+	prometheus.MustRegister(ProxyDMLMirrorPending)
+	prometheus.MustRegister(ProxyDMLMirrorLag)
+	prometheus.MustRegister(ProxyDMLMirrorCounter)
+
+	prometheus.MustRegister(ProxyRequestLatency)
+	prometheus.MustRegister(ProxyInsertRowsCounter)
+	prometheus.MustRegister(ProxyInsertBytesCounter)
+	prometheus.MustRegister(ProxySearchVectorsCounter)
+	prometheus.MustRegister(ProxyQueueWaitLatency)
+	prometheus.MustRegister(ProxyTaskQueueLength)
+	prometheus.MustRegister(ProxyRejectedTaskCounter)
+	prometheus.MustRegister(ProxyMetaCacheCounter)
+
+	prometheus.MustRegister(ProxySearchShadowCounter)
+	prometheus.MustRegister(ProxySearchShadowLatency)
+	prometheus.MustRegister(ProxySearchShadowRecall)
 }
 
+var (
+	// QueryCoordLoadedSegmentNum records the num of segments loaded on each query node
+	QueryCoordLoadedSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryCoord,
+			Name:      "loaded_segment_num",
+			Help:      "Num of segments loaded on each query node",
+		}, []string{"node_id"})
+
+	// QueryCoordTriggerTaskNum records the num of tasks of each type waiting in the trigger task queue
+	QueryCoordTriggerTaskNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryCoord,
+			Name:      "trigger_task_num",
+			Help:      "Num of tasks waiting in the trigger task queue",
+		}, []string{"type"})
+)
+
 //RegisterQueryCoord register QueryCoord metrics
 func RegisterQueryCoord() {
-
+	prometheus.MustRegister(QueryCoordLoadedSegmentNum)
+	prometheus.MustRegister(QueryCoordTriggerTaskNum)
 }
 
+var (
+	// QueryNodeLocalCacheCounter used to count the hits and misses of the local disk cache
+	QueryNodeLocalCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "local_cache_access_total",
+			Help:      "Counter of local cache accesses",
+		}, []string{"type"})
+
+	// QueryNodeLocalCacheEvictionCounter used to count the files evicted from the local disk cache
+	QueryNodeLocalCacheEvictionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "local_cache_evictions_total",
+			Help:      "Counter of local cache evictions",
+		})
+
+	// QueryNodeLocalCacheSize records the size of the files in the local disk cache
+	QueryNodeLocalCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "local_cache_size_bytes",
+			Help:      "Size of the files in local cache",
+		})
+
+	// QueryNodeSegmentCacheCounter used to count the hits and misses of the lazily loaded segments
+	QueryNodeSegmentCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "segment_cache_access_total",
+			Help:      "Counter of segment cache accesses",
+		}, []string{"type"})
+
+	// QueryNodeSegmentCacheEvictionCounter used to count the segments evicted from the segment cache
+	QueryNodeSegmentCacheEvictionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "segment_cache_evictions_total",
+			Help:      "Counter of segment cache evictions",
+		})
+
+	// QueryNodeSegmentCacheSize records the estimated size of the resident segments in the segment cache
+	QueryNodeSegmentCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "segment_cache_size_bytes",
+			Help:      "Size of the resident segments in segment cache",
+		})
+
+	// QueryNodeCPUUsage records the cpu usage sampled by the admission control
+	QueryNodeCPUUsage = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "cpu_usage_percent",
+			Help:      "CPU usage of query node in percent",
+		})
+
+	// QueryNodeTSafeLag records the lag in milliseconds of the tsafe of each dml channel behind the current time
+	QueryNodeTSafeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "tsafe_lag_ms",
+			Help:      "Lag in milliseconds of the tsafe of the dml channels behind the current time",
+		}, []string{"channel"})
+
+	// QueryNodeGuaranteeTimeoutCounter used to count the read requests failed because their guarantee timestamps
+	// were not reached in time
+	QueryNodeGuaranteeTimeoutCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "guarantee_timeout_total",
+			Help:      "Counter of read requests failed because their guarantee timestamps were not reached in time",
+		}, []string{"msg_type"})
+
+	// QueryNodeRejectedReadCounter used to count the read requests rejected while query node is overloaded
+	QueryNodeRejectedReadCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "rejected_read_requests_total",
+			Help:      "Counter of read requests rejected by the cpu or memory admission control",
+		}, []string{"msg_type"})
+)
+
 //RegisterQueryNode register QueryNode metrics
 func RegisterQueryNode() {
-
+	prometheus.MustRegister(QueryNodeLocalCacheCounter)
+	prometheus.MustRegister(QueryNodeLocalCacheEvictionCounter)
+	prometheus.MustRegister(QueryNodeLocalCacheSize)
+	prometheus.MustRegister(QueryNodeSegmentCacheCounter)
+	prometheus.MustRegister(QueryNodeSegmentCacheEvictionCounter)
+	prometheus.MustRegister(QueryNodeSegmentCacheSize)
+	prometheus.MustRegister(QueryNodeCPUUsage)
+	prometheus.MustRegister(QueryNodeRejectedReadCounter)
+	prometheus.MustRegister(QueryNodeTSafeLag)
+	prometheus.MustRegister(QueryNodeGuaranteeTimeoutCounter)
 }
 
 var (
@@ -591,11 +930,55 @@ var (
 			Help:      "List of data nodes registered within etcd",
 		}, []string{"status"},
 	)
+
+	// DataCoordSegmentNum records the num of segments in each state
+	DataCoordSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "segment_num",
+			Help:      "Num of segments in each state",
+		}, []string{"state"},
+	)
+
+	// DataCoordCollectionBinlogSize records the estimated binlog size of the flushed segments of each collection
+	DataCoordCollectionBinlogSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "collection_binlog_size_bytes",
+			Help:      "Estimated binlog size in bytes of the flushed segments of each collection",
+		}, []string{"collection_id"},
+	)
+
+	// DataCoordCollectionDeleteRatio records the ratio of the deleted rows of the flushed segments of each collection
+	DataCoordCollectionDeleteRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "collection_delete_ratio",
+			Help:      "Ratio of the deleted rows to the rows of the flushed segments of each collection",
+		}, []string{"collection_id"},
+	)
+
+	// DataCoordCollectionDeltaLogSize records the delta log size of the flushed segments of each collection
+	DataCoordCollectionDeltaLogSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "collection_delta_log_size_bytes",
+			Help:      "Delta log size in bytes of the flushed segments of each collection",
+		}, []string{"collection_id"},
+	)
 )
 
 //RegisterDataCoord register DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.Register(DataCoordDataNodeList)
+	prometheus.Register(DataCoordSegmentNum)
+	prometheus.Register(DataCoordCollectionBinlogSize)
+	prometheus.Register(DataCoordCollectionDeleteRatio)
+	prometheus.Register(DataCoordCollectionDeltaLogSize)
 }
 
 var (
@@ -616,12 +999,32 @@ var (
 			Name:      "watch_dm_channels_total",
 			Help:      "Counter of watch dm channel",
 		}, []string{"type"})
+
+	// DataNodeWriteBufferSize records the buffered bytes of the insert buffers of all the flowgraphs
+	DataNodeWriteBufferSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "write_buffer_size_bytes",
+			Help:      "Size of the insert buffers of all the flowgraphs",
+		})
+
+	// DataNodeWriteBufferSyncCounter used to count the segments synced by the write buffer manager above the watermark
+	DataNodeWriteBufferSyncCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "write_buffer_syncs_total",
+			Help:      "Counter of segments synced above the write buffer watermark",
+		})
 )
 
 //RegisterDataNode register DataNode metrics
 func RegisterDataNode() {
 	prometheus.Register(DataNodeFlushSegmentsCounter)
 	prometheus.Register(DataNodeWatchDmChannelsCounter)
+	prometheus.Register(DataNodeWriteBufferSize)
+	prometheus.Register(DataNodeWriteBufferSyncCounter)
 }
 
 //RegisterIndexCoord register IndexCoord metrics
@@ -639,11 +1042,11 @@ func RegisterMsgStreamCoord() {
 
 }
 
-//ServeHTTP serve prometheus http service
-func ServeHTTP() {
+//ServeHTTP serve prometheus http service, together with the other handlers registered on http.DefaultServeMux
+func ServeHTTP(port int) {
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
-		if err := http.ListenAndServe(":9091", nil); err != nil {
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", port), nil); err != nil {
 			log.Error("handle metrics failed", zap.Error(err))
 		}
 	}()
@@ -957,3 +957,55 @@ func (m *DataNodeTtMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 		DataNodeTtMsg: msg,
 	}, nil
 }
+
+/////////////////////////////////////////EventNotification//////////////////////////////////////////
+type EventNotificationMsg struct {
+	BaseMsg
+	internalpb.EventNotificationMsg
+}
+
+func (m *EventNotificationMsg) TraceCtx() context.Context {
+	return m.BaseMsg.Ctx
+}
+
+func (m *EventNotificationMsg) SetTraceCtx(ctx context.Context) {
+	m.BaseMsg.Ctx = ctx
+}
+
+func (m *EventNotificationMsg) ID() UniqueID {
+	return m.Base.MsgID
+}
+
+func (m *EventNotificationMsg) Type() MsgType {
+	return m.Base.MsgType
+}
+
+func (m *EventNotificationMsg) SourceID() int64 {
+	return m.Base.SourceID
+}
+
+func (m *EventNotificationMsg) Marshal(input TsMsg) (MarshalType, error) {
+	msg := input.(*EventNotificationMsg)
+	t, err := proto.Marshal(&msg.EventNotificationMsg)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (m *EventNotificationMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	msg := internalpb.EventNotificationMsg{}
+	in, err := ConvertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &msg)
+	if err != nil {
+		return nil, err
+	}
+	eventMsg := &EventNotificationMsg{EventNotificationMsg: msg}
+	eventMsg.BeginTimestamp = msg.Base.Timestamp
+	eventMsg.EndTimestamp = msg.Base.Timestamp
+
+	return eventMsg, nil
+}
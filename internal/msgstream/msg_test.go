@@ -735,3 +735,50 @@ func TestDataNodeTtMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, tsMsg)
 }
+
+func TestEventNotificationMsg(t *testing.T) {
+	eventMsg := &EventNotificationMsg{
+		BaseMsg: generateBaseMsg(),
+		EventNotificationMsg: internalpb.EventNotificationMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_EventNotification,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			EventType: "IndexBuilt",
+			Payload:   `{"type":"IndexBuilt"}`,
+		},
+	}
+
+	assert.NotNil(t, eventMsg.TraceCtx())
+
+	ctx := context.Background()
+	eventMsg.SetTraceCtx(ctx)
+	assert.Equal(t, ctx, eventMsg.TraceCtx())
+
+	assert.Equal(t, int64(1), eventMsg.ID())
+	assert.Equal(t, commonpb.MsgType_EventNotification, eventMsg.Type())
+	assert.Equal(t, int64(3), eventMsg.SourceID())
+
+	bytes, err := eventMsg.Marshal(eventMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := eventMsg.Unmarshal(bytes)
+	assert.Nil(t, err)
+
+	eventMsg2, ok := tsMsg.(*EventNotificationMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), eventMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_EventNotification, eventMsg2.Type())
+	assert.Equal(t, uint64(2), eventMsg2.BeginTs())
+	assert.Equal(t, "IndexBuilt", eventMsg2.EventType)
+	assert.Equal(t, eventMsg.Payload, eventMsg2.Payload)
+}
+
+func TestEventNotificationMsg_Unmarshal_IllegalParameter(t *testing.T) {
+	eventMsg := &EventNotificationMsg{}
+	tsMsg, err := eventMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
@@ -49,6 +49,12 @@ const (
 	ErrorCode_OutOfMemory           ErrorCode = 24
 	ErrorCode_IndexNotExist         ErrorCode = 25
 	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_PartitionNotExists    ErrorCode = 27
+	ErrorCode_SegmentNotLoaded      ErrorCode = 28
+	ErrorCode_RateLimited           ErrorCode = 29
+	ErrorCode_NotReadyToServe       ErrorCode = 30
+	ErrorCode_TimeTickLagging       ErrorCode = 31
+	ErrorCode_QuotaExceeded         ErrorCode = 32
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,6 +86,12 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "PartitionNotExists",
+	28:   "SegmentNotLoaded",
+	29:   "RateLimited",
+	30:   "NotReadyToServe",
+	31:   "TimeTickLagging",
+	32:   "QuotaExceeded",
 	1000: "DDRequestRace",
 }
 
@@ -110,6 +122,12 @@ var ErrorCode_value = map[string]int32{
 	"OutOfMemory":           24,
 	"IndexNotExist":         25,
 	"EmptyCollection":       26,
+	"PartitionNotExists":    27,
+	"SegmentNotLoaded":      28,
+	"RateLimited":           29,
+	"NotReadyToServe":       30,
+	"TimeTickLagging":       31,
+	"QuotaExceeded":         32,
 	"DDRequestRace":         1000,
 }
 
@@ -164,6 +182,7 @@ const (
 	SegmentState_Sealed           SegmentState = 3
 	SegmentState_Flushed          SegmentState = 4
 	SegmentState_Flushing         SegmentState = 5
+	SegmentState_Dropped          SegmentState = 6
 )
 
 var SegmentState_name = map[int32]string{
@@ -173,6 +192,7 @@ var SegmentState_name = map[int32]string{
 	3: "Sealed",
 	4: "Flushed",
 	5: "Flushing",
+	6: "Dropped",
 }
 
 var SegmentState_value = map[string]int32{
@@ -182,6 +202,7 @@ var SegmentState_value = map[string]int32{
 	"Sealed":           3,
 	"Flushed":          4,
 	"Flushing":         5,
+	"Dropped":          6,
 }
 
 func (x SegmentState) String() string {
@@ -205,6 +226,8 @@ const (
 	MsgType_GetSystemConfigs   MsgType = 105
 	MsgType_LoadCollection     MsgType = 106
 	MsgType_ReleaseCollection  MsgType = 107
+	MsgType_AlterCollection    MsgType = 108
+	MsgType_UndropCollection   MsgType = 109
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -243,6 +266,11 @@ const (
 	MsgType_RemoveQueryChannels     MsgType = 511
 	// DATA SERVICE
 	MsgType_SegmentInfo MsgType = 600
+	// CREDENTIAL
+	MsgType_CreateApiKey MsgType = 1100
+	MsgType_RotateApiKey MsgType = 1101
+	MsgType_DropApiKey   MsgType = 1102
+	MsgType_ListApiKeys  MsgType = 1103
 	// SYSTEM CONTROL
 	MsgType_TimeTick          MsgType = 1200
 	MsgType_QueryNodeStats    MsgType = 1201
@@ -253,6 +281,7 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	MsgType_EventNotification MsgType = 1209
 )
 
 var MsgType_name = map[int32]string{
@@ -265,6 +294,8 @@ var MsgType_name = map[int32]string{
 	105:  "GetSystemConfigs",
 	106:  "LoadCollection",
 	107:  "ReleaseCollection",
+	108:  "AlterCollection",
+	109:  "UndropCollection",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -297,6 +328,10 @@ var MsgType_name = map[int32]string{
 	510:  "WatchQueryChannels",
 	511:  "RemoveQueryChannels",
 	600:  "SegmentInfo",
+	1100: "CreateApiKey",
+	1101: "RotateApiKey",
+	1102: "DropApiKey",
+	1103: "ListApiKeys",
 	1200: "TimeTick",
 	1201: "QueryNodeStats",
 	1202: "LoadIndex",
@@ -306,6 +341,7 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1209: "EventNotification",
 }
 
 var MsgType_value = map[string]int32{
@@ -318,6 +354,8 @@ var MsgType_value = map[string]int32{
 	"GetSystemConfigs":        105,
 	"LoadCollection":          106,
 	"ReleaseCollection":       107,
+	"AlterCollection":         108,
+	"UndropCollection":        109,
 	"CreatePartition":         200,
 	"DropPartition":           201,
 	"HasPartition":            202,
@@ -350,6 +388,10 @@ var MsgType_value = map[string]int32{
 	"WatchQueryChannels":      510,
 	"RemoveQueryChannels":     511,
 	"SegmentInfo":             600,
+	"CreateApiKey":            1100,
+	"RotateApiKey":            1101,
+	"DropApiKey":              1102,
+	"ListApiKeys":             1103,
 	"TimeTick":                1200,
 	"QueryNodeStats":          1201,
 	"LoadIndex":               1202,
@@ -359,6 +401,7 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":       1206,
 	"SegmentFlushDone":        1207,
 	"DataNodeTt":              1208,
+	"EventNotification":       1209,
 }
 
 func (x MsgType) String() string {
@@ -579,6 +622,7 @@ type MsgBase struct {
 	MsgID                int64    `protobuf:"varint,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
 	Timestamp            uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	SourceID             int64    `protobuf:"varint,4,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	RequestID            string   `protobuf:"bytes,5,opt,name=requestID,proto3" json:"requestID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -637,6 +681,13 @@ func (m *MsgBase) GetSourceID() int64 {
 	return 0
 }
 
+func (m *MsgBase) GetRequestID() string {
+	if m != nil {
+		return m.RequestID
+	}
+	return ""
+}
+
 // Don't Modify This. @czs
 type MsgHeader struct {
 	Base                 *MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -694,86 +745,95 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0x44, 0xd9, 0x32, 0xc7, 0xb2, 0x3d, 0x1e, 0x2f, 0x71, 0xf2, 0x1b, 0x3f, 0x02, 0x5f,
-	0x05, 0x06, 0x62, 0xb7, 0x0d, 0xda, 0x5e, 0xe5, 0x22, 0x16, 0xbd, 0x08, 0x89, 0x97, 0x52, 0x4e,
-	0x5a, 0xf4, 0x26, 0x18, 0x93, 0x47, 0xd2, 0x34, 0xe4, 0x8c, 0x3a, 0x33, 0x74, 0xac, 0xfb, 0x3e,
-	0x40, 0x9b, 0xe7, 0x68, 0x8b, 0xee, 0x2d, 0xfa, 0x04, 0xdd, 0xaf, 0xfb, 0x08, 0x7d, 0x80, 0xae,
-	0x59, 0x8b, 0x33, 0xa4, 0x24, 0x06, 0x48, 0xef, 0x78, 0xbe, 0xb3, 0x7d, 0xf3, 0x9d, 0x39, 0x43,
-	0xd2, 0x88, 0x54, 0x9a, 0x2a, 0xb9, 0xd9, 0xd7, 0xca, 0x2a, 0xb6, 0x98, 0x8a, 0xe4, 0x2c, 0x33,
-	0xb9, 0xb5, 0x99, 0xbb, 0xd6, 0xef, 0x92, 0xa9, 0xb6, 0xe5, 0x36, 0x33, 0xec, 0x3a, 0x21, 0xa0,
-	0xb5, 0xd2, 0x77, 0x23, 0x15, 0xc3, 0x6a, 0xe5, 0x72, 0xe5, 0xca, 0xdc, 0x2b, 0xff, 0xdf, 0x7c,
-	0x41, 0xce, 0xe6, 0x0e, 0x86, 0x35, 0x55, 0x0c, 0xa1, 0x0f, 0xc3, 0x4f, 0xb6, 0x42, 0xa6, 0x34,
-	0x70, 0xa3, 0xe4, 0x6a, 0xf5, 0x72, 0xe5, 0x8a, 0x1f, 0x16, 0xd6, 0xfa, 0x6b, 0xa4, 0x71, 0x13,
-	0x06, 0x77, 0x78, 0x92, 0xc1, 0x31, 0x17, 0x9a, 0x51, 0xe2, 0xdd, 0x83, 0x81, 0xab, 0xef, 0x87,
-	0xf8, 0xc9, 0x96, 0xc8, 0xe4, 0x19, 0xba, 0x8b, 0xc4, 0xdc, 0x58, 0x5f, 0x23, 0xb5, 0xed, 0x44,
-	0x9d, 0x8e, 0xbd, 0x98, 0xd1, 0x18, 0x7a, 0xaf, 0x92, 0xfa, 0x8d, 0x38, 0xd6, 0x60, 0x0c, 0x9b,
-	0x23, 0x55, 0xd1, 0x2f, 0xea, 0x55, 0x45, 0x9f, 0x31, 0x52, 0xeb, 0x2b, 0x6d, 0x5d, 0x35, 0x2f,
-	0x74, 0xdf, 0xeb, 0x0f, 0x2a, 0xa4, 0x7e, 0x60, 0xba, 0xdb, 0xdc, 0x00, 0x7b, 0x9d, 0x4c, 0xa7,
-	0xa6, 0x7b, 0xd7, 0x0e, 0xfa, 0xc3, 0x53, 0xae, 0xbd, 0xf0, 0x94, 0x07, 0xa6, 0x7b, 0x32, 0xe8,
-	0x43, 0x58, 0x4f, 0xf3, 0x0f, 0x64, 0x92, 0x9a, 0x6e, 0x2b, 0x28, 0x2a, 0xe7, 0x06, 0x5b, 0x23,
-	0xbe, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xfe, 0xaa, 0x77, 0xb9, 0x72, 0xa5, 0x16, 0x8e, 0x01, 0x76,
-	0x89, 0x4c, 0x1b, 0x95, 0xe9, 0x08, 0x5a, 0xc1, 0x6a, 0xcd, 0xa5, 0x8d, 0xec, 0xf5, 0xeb, 0xc4,
-	0x3f, 0x30, 0xdd, 0x7d, 0xe0, 0x31, 0x68, 0xf6, 0x12, 0xa9, 0x9d, 0x72, 0x93, 0x33, 0x9a, 0xf9,
-	0x6f, 0x46, 0x78, 0x82, 0xd0, 0x45, 0x6e, 0x7c, 0x5b, 0x23, 0xfe, 0x68, 0x12, 0x6c, 0x86, 0xd4,
-	0xdb, 0x59, 0x14, 0x81, 0x31, 0x74, 0x82, 0x2d, 0x92, 0xf9, 0xdb, 0x12, 0xce, 0xfb, 0x10, 0x59,
-	0x88, 0x5d, 0x0c, 0xad, 0xb0, 0x05, 0x32, 0xdb, 0x54, 0x52, 0x42, 0x64, 0x77, 0xb9, 0x48, 0x20,
-	0xa6, 0x55, 0xb6, 0x44, 0xe8, 0x31, 0xe8, 0x54, 0x18, 0x23, 0x94, 0x0c, 0x40, 0x0a, 0x88, 0xa9,
-	0xc7, 0x2e, 0x90, 0xc5, 0xa6, 0x4a, 0x12, 0x88, 0xac, 0x50, 0xf2, 0x50, 0xd9, 0x9d, 0x73, 0x61,
-	0xac, 0xa1, 0x35, 0x2c, 0xdb, 0x4a, 0x12, 0xe8, 0xf2, 0xe4, 0x86, 0xee, 0x66, 0x29, 0x48, 0x4b,
-	0x27, 0xb1, 0x46, 0x01, 0x06, 0x22, 0x05, 0x89, 0x95, 0x68, 0xbd, 0x84, 0xb6, 0x64, 0x0c, 0xe7,
-	0xa8, 0x1f, 0x9d, 0x66, 0x17, 0xc9, 0x72, 0x81, 0x96, 0x1a, 0xf0, 0x14, 0xa8, 0xcf, 0xe6, 0xc9,
-	0x4c, 0xe1, 0x3a, 0x39, 0x3a, 0xbe, 0x49, 0x49, 0xa9, 0x42, 0xa8, 0xee, 0x87, 0x10, 0x29, 0x1d,
-	0xd3, 0x99, 0x12, 0x85, 0x3b, 0x10, 0x59, 0xa5, 0x5b, 0x01, 0x6d, 0x20, 0xe1, 0x02, 0x6c, 0x03,
-	0xd7, 0x51, 0x2f, 0x04, 0x93, 0x25, 0x96, 0xce, 0x32, 0x4a, 0x1a, 0xbb, 0x22, 0x81, 0x43, 0x65,
-	0x77, 0x55, 0x26, 0x63, 0x3a, 0xc7, 0xe6, 0x08, 0x39, 0x00, 0xcb, 0x0b, 0x05, 0xe6, 0xb1, 0x6d,
-	0x93, 0x47, 0x3d, 0x28, 0x00, 0xca, 0x56, 0x08, 0x6b, 0x72, 0x29, 0x95, 0x6d, 0x6a, 0xe0, 0x16,
-	0x76, 0x55, 0x12, 0x83, 0xa6, 0x0b, 0x48, 0xe7, 0x39, 0x5c, 0x24, 0x40, 0xd9, 0x38, 0x3a, 0x80,
-	0x04, 0x46, 0xd1, 0x8b, 0xe3, 0xe8, 0x02, 0xc7, 0xe8, 0x25, 0x24, 0xbf, 0x9d, 0x89, 0x24, 0x76,
-	0x92, 0xe4, 0x63, 0x59, 0x46, 0x8e, 0x05, 0xf9, 0xc3, 0x5b, 0xad, 0xf6, 0x09, 0x5d, 0x61, 0xcb,
-	0x64, 0xa1, 0x40, 0x0e, 0xc0, 0x6a, 0x11, 0x39, 0xf1, 0x2e, 0x20, 0xd5, 0xa3, 0xcc, 0x1e, 0x75,
-	0x0e, 0x20, 0x55, 0x7a, 0x40, 0x57, 0x71, 0xa0, 0xae, 0xd2, 0x70, 0x44, 0xf4, 0x22, 0x76, 0xd8,
-	0x49, 0xfb, 0x76, 0x30, 0x96, 0x97, 0x5e, 0x62, 0x8c, 0xcc, 0x06, 0x41, 0x08, 0xef, 0x66, 0x60,
-	0x6c, 0xc8, 0x23, 0xa0, 0xbf, 0xd5, 0x37, 0xde, 0x22, 0xc4, 0xe5, 0xe2, 0xee, 0x03, 0x63, 0x64,
-	0x6e, 0x6c, 0x1d, 0x2a, 0x09, 0x74, 0x82, 0x35, 0xc8, 0xf4, 0x6d, 0x29, 0x8c, 0xc9, 0x20, 0xa6,
-	0x15, 0xd4, 0xad, 0x25, 0x8f, 0xb5, 0xea, 0xe2, 0xca, 0xd1, 0x2a, 0x7a, 0x77, 0x85, 0x14, 0xa6,
-	0xe7, 0x6e, 0x0c, 0x21, 0x53, 0x85, 0x80, 0xb5, 0x8d, 0x0e, 0x69, 0xb4, 0xa1, 0x8b, 0x97, 0x23,
-	0xaf, 0xbd, 0x44, 0x68, 0xd9, 0x1e, 0x57, 0x1f, 0xd1, 0xae, 0xe0, 0xe5, 0xdd, 0xd3, 0xea, 0xbe,
-	0x90, 0x5d, 0x5a, 0xc5, 0x62, 0x6d, 0xe0, 0x89, 0x2b, 0x3c, 0x43, 0xea, 0xbb, 0x49, 0xe6, 0xba,
-	0xd4, 0x5c, 0x4f, 0x34, 0x30, 0x6c, 0x72, 0xe3, 0xbd, 0x69, 0xb7, 0xd2, 0x6e, 0x33, 0x67, 0x89,
-	0x7f, 0x5b, 0xc6, 0xd0, 0x11, 0x12, 0x62, 0x3a, 0xe1, 0xd4, 0x77, 0x53, 0x2a, 0xc9, 0x10, 0xe3,
-	0x21, 0x03, 0xad, 0xfa, 0x25, 0x0c, 0x50, 0xc2, 0x7d, 0x6e, 0x4a, 0x50, 0x07, 0x47, 0x1a, 0x80,
-	0x89, 0xb4, 0x38, 0x2d, 0xa7, 0x77, 0x51, 0xda, 0x76, 0x4f, 0xdd, 0x1f, 0x63, 0x86, 0xf6, 0xb0,
-	0xd3, 0x1e, 0xd8, 0xf6, 0xc0, 0x58, 0x48, 0x9b, 0x4a, 0x76, 0x44, 0xd7, 0x50, 0x81, 0x9d, 0x6e,
-	0x29, 0x1e, 0x97, 0xd2, 0xdf, 0xc1, 0xa1, 0x86, 0x90, 0x00, 0x37, 0xe5, 0xaa, 0xf7, 0xd8, 0x12,
-	0x99, 0xcf, 0xa9, 0x1e, 0x73, 0x6d, 0x85, 0x03, 0xbf, 0xab, 0xb8, 0x89, 0x69, 0xd5, 0x1f, 0x63,
-	0xdf, 0xe3, 0xfa, 0x36, 0xf6, 0xb9, 0x19, 0x43, 0x3f, 0x54, 0xd8, 0x0a, 0x59, 0x18, 0x52, 0x1d,
-	0xe3, 0x3f, 0x56, 0xd8, 0x22, 0x99, 0x43, 0xaa, 0x23, 0xcc, 0xd0, 0x9f, 0x1c, 0x88, 0xa4, 0x4a,
-	0xe0, 0xcf, 0xae, 0x42, 0xc1, 0xaa, 0x84, 0xff, 0xe2, 0x9a, 0x61, 0x85, 0x62, 0x70, 0x86, 0x3e,
-	0xac, 0x20, 0xd3, 0x61, 0xb3, 0x02, 0xa6, 0x8f, 0x5c, 0x20, 0x56, 0x1d, 0x05, 0x3e, 0x76, 0x81,
-	0x45, 0xcd, 0x11, 0xfa, 0xc4, 0xa1, 0xfb, 0x5c, 0xc6, 0xaa, 0xd3, 0x19, 0xa1, 0x4f, 0x2b, 0x6c,
-	0x95, 0x2c, 0x62, 0xfa, 0x36, 0x4f, 0xb8, 0x8c, 0xc6, 0xf1, 0xcf, 0x2a, 0x8c, 0x92, 0x99, 0x5c,
-	0x18, 0x77, 0x31, 0xe9, 0x87, 0x55, 0x27, 0x4a, 0x41, 0x20, 0xc7, 0x3e, 0xaa, 0xb2, 0x39, 0xe2,
-	0xa3, 0x50, 0xb9, 0xfd, 0x71, 0x95, 0xcd, 0x90, 0xa9, 0x96, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x2f,
-	0xcf, 0x54, 0xbe, 0x7e, 0xf4, 0x03, 0xbc, 0xa2, 0x93, 0xee, 0xf2, 0xd0, 0x07, 0xce, 0x91, 0x3f,
-	0x14, 0xf4, 0x77, 0xcf, 0x1d, 0xb5, 0xfc, 0x6a, 0xfc, 0xe1, 0x61, 0xa7, 0x3d, 0xb0, 0xe3, 0x8d,
-	0xa0, 0x7f, 0x7a, 0xec, 0x12, 0x59, 0x1e, 0x62, 0x6e, 0x87, 0x47, 0xbb, 0xf0, 0x97, 0xc7, 0xd6,
-	0xc8, 0x85, 0x3d, 0xb0, 0xe3, 0xb9, 0x62, 0x92, 0x30, 0x56, 0x44, 0x86, 0xfe, 0xed, 0xb1, 0xff,
-	0x91, 0x95, 0x3d, 0xb0, 0x23, 0x7d, 0x4b, 0xce, 0x7f, 0x3c, 0x36, 0x4b, 0xa6, 0x43, 0x5c, 0x72,
-	0x38, 0x03, 0xfa, 0xd0, 0xc3, 0x21, 0x0d, 0xcd, 0x82, 0xce, 0x23, 0x0f, 0xa5, 0x7b, 0x93, 0xdb,
-	0xa8, 0x17, 0xa4, 0xcd, 0x1e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0x7b, 0x6c, 0x99, 0xd0, 0x10, 0x52,
-	0x75, 0x06, 0x25, 0xf8, 0x09, 0x3e, 0xde, 0xcc, 0x05, 0xbf, 0x91, 0x81, 0x1e, 0x8c, 0x1c, 0x4f,
-	0x3d, 0x94, 0x3a, 0x8f, 0x7f, 0xde, 0xf3, 0xcc, 0x43, 0xa9, 0x0b, 0xe5, 0x5b, 0xb2, 0xa3, 0xe8,
-	0xaf, 0x35, 0x64, 0x75, 0x22, 0x52, 0x38, 0x11, 0xd1, 0x3d, 0xfa, 0x89, 0x8f, 0xac, 0x5c, 0xd2,
-	0xa1, 0x8a, 0x01, 0xe9, 0x1b, 0xfa, 0xa9, 0x8f, 0xd2, 0xe3, 0xe8, 0x72, 0xe9, 0x3f, 0x73, 0x76,
-	0xf1, 0xc6, 0xb4, 0x02, 0xfa, 0x39, 0x3e, 0xe8, 0xa4, 0xb0, 0x4f, 0xda, 0x47, 0xf4, 0x0b, 0x1f,
-	0x8f, 0x71, 0x23, 0x49, 0x54, 0xc4, 0xed, 0xe8, 0x02, 0x7d, 0xe9, 0xe3, 0x0d, 0x2c, 0x3d, 0x0f,
-	0x85, 0x30, 0x5f, 0xf9, 0x78, 0xbc, 0x02, 0x77, 0x63, 0x0b, 0xf0, 0xd9, 0xf8, 0xda, 0x55, 0x0d,
-	0xb8, 0xe5, 0xc8, 0xe4, 0xc4, 0xd2, 0x6f, 0xfc, 0x8d, 0x75, 0x52, 0x0f, 0x4c, 0xe2, 0x5e, 0x81,
-	0x3a, 0xf1, 0x02, 0x93, 0xd0, 0x09, 0x7c, 0xac, 0xb6, 0x95, 0x4a, 0x76, 0xce, 0xfb, 0xfa, 0xce,
-	0xcb, 0xb4, 0xb2, 0xfd, 0xea, 0xdb, 0xd7, 0xba, 0xc2, 0xf6, 0xb2, 0x53, 0xfc, 0x91, 0x6e, 0xe5,
-	0x7f, 0xd6, 0xab, 0x42, 0x15, 0x5f, 0x5b, 0x42, 0x5a, 0xd0, 0x92, 0x27, 0x5b, 0xee, 0x67, 0xbb,
-	0x95, 0xff, 0x6c, 0xfb, 0xa7, 0xa7, 0x53, 0xce, 0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x24, 0x61, 0xe5, 0x46, 0x09, 0x00, 0x00,
+	// 1438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xdb, 0x72, 0xe4, 0x48,
+	0x11, 0x75, 0xb7, 0xda, 0xee, 0x56, 0xb9, 0x6d, 0x97, 0xcb, 0x97, 0xf1, 0xce, 0x9a, 0xc5, 0xe1,
+	0xa7, 0x09, 0x47, 0xac, 0x0d, 0x6c, 0x00, 0x4f, 0xfb, 0x60, 0xb7, 0x7c, 0xe9, 0x18, 0xdf, 0x56,
+	0xdd, 0x1e, 0x08, 0x5e, 0x26, 0xca, 0x52, 0x76, 0x77, 0x31, 0x92, 0x4a, 0x54, 0x95, 0x3c, 0xee,
+	0xbf, 0x58, 0xf8, 0x0e, 0x20, 0x96, 0xdb, 0x00, 0x7f, 0xc0, 0x65, 0x66, 0x78, 0xe5, 0x13, 0xf8,
+	0x00, 0xae, 0x73, 0x25, 0xb2, 0xa4, 0x6e, 0xc9, 0xc4, 0xf0, 0xa6, 0x3c, 0x95, 0x79, 0xf2, 0x54,
+	0x66, 0x2a, 0x25, 0xd2, 0x0e, 0x64, 0x1c, 0xcb, 0x64, 0x37, 0x55, 0xd2, 0x48, 0xb6, 0x12, 0x8b,
+	0xe8, 0x26, 0xd3, 0xb9, 0xb5, 0x9b, 0x1f, 0x6d, 0x3f, 0x26, 0x73, 0x3d, 0xc3, 0x4d, 0xa6, 0xd9,
+	0xe7, 0x84, 0x80, 0x52, 0x52, 0x3d, 0x0e, 0x64, 0x08, 0x1b, 0xb5, 0xad, 0xda, 0x83, 0xc5, 0x6f,
+	0x7d, 0xb2, 0xfb, 0x81, 0x98, 0xdd, 0x43, 0x74, 0xeb, 0xc8, 0x10, 0x7c, 0x17, 0x26, 0x8f, 0x6c,
+	0x9d, 0xcc, 0x29, 0xe0, 0x5a, 0x26, 0x1b, 0xf5, 0xad, 0xda, 0x03, 0xd7, 0x2f, 0xac, 0xed, 0xef,
+	0x90, 0xf6, 0x43, 0x18, 0x3f, 0xe2, 0x51, 0x06, 0x97, 0x5c, 0x28, 0x46, 0x89, 0xf3, 0x04, 0xc6,
+	0x96, 0xdf, 0xf5, 0xf1, 0x91, 0xad, 0x92, 0xd9, 0x1b, 0x3c, 0x2e, 0x02, 0x73, 0x63, 0x7b, 0x93,
+	0x34, 0x0e, 0x22, 0x79, 0x5d, 0x9e, 0x62, 0x44, 0x7b, 0x72, 0xfa, 0x29, 0x69, 0xee, 0x87, 0xa1,
+	0x02, 0xad, 0xd9, 0x22, 0xa9, 0x8b, 0xb4, 0xe0, 0xab, 0x8b, 0x94, 0x31, 0xd2, 0x48, 0xa5, 0x32,
+	0x96, 0xcd, 0xf1, 0xed, 0xf3, 0xf6, 0x57, 0x35, 0xd2, 0x3c, 0xd3, 0xc3, 0x03, 0xae, 0x81, 0x7d,
+	0x97, 0xb4, 0x62, 0x3d, 0x7c, 0x6c, 0xc6, 0xe9, 0xe4, 0x96, 0x9b, 0x1f, 0xbc, 0xe5, 0x99, 0x1e,
+	0xf6, 0xc7, 0x29, 0xf8, 0xcd, 0x38, 0x7f, 0x40, 0x25, 0xb1, 0x1e, 0x76, 0xbd, 0x82, 0x39, 0x37,
+	0xd8, 0x26, 0x71, 0x8d, 0x88, 0x41, 0x1b, 0x1e, 0xa7, 0x1b, 0xce, 0x56, 0xed, 0x41, 0xc3, 0x2f,
+	0x01, 0x76, 0x9f, 0xb4, 0xb4, 0xcc, 0x54, 0x00, 0x5d, 0x6f, 0xa3, 0x61, 0xc3, 0xa6, 0x36, 0x46,
+	0x2a, 0xf8, 0x51, 0x06, 0xda, 0x74, 0xbd, 0x8d, 0x59, 0xab, 0xbf, 0x04, 0xb6, 0x3f, 0x27, 0xee,
+	0x99, 0x1e, 0x9e, 0x00, 0x0f, 0x41, 0xb1, 0x6f, 0x90, 0xc6, 0x35, 0xd7, 0xb9, 0xde, 0xf9, 0xff,
+	0xaf, 0x17, 0xef, 0xe7, 0x5b, 0xcf, 0x9d, 0x67, 0xb3, 0xc4, 0x9d, 0xf6, 0x89, 0xcd, 0x93, 0x66,
+	0x2f, 0x0b, 0x02, 0xd0, 0x9a, 0xce, 0xb0, 0x15, 0xb2, 0x74, 0x95, 0xc0, 0x6d, 0x0a, 0x81, 0x81,
+	0xd0, 0xfa, 0xd0, 0x1a, 0x5b, 0x26, 0x0b, 0x1d, 0x99, 0x24, 0x10, 0x98, 0x23, 0x2e, 0x22, 0x08,
+	0x69, 0x9d, 0xad, 0x12, 0x7a, 0x09, 0x2a, 0x16, 0x5a, 0x0b, 0x99, 0x78, 0x90, 0x08, 0x08, 0xa9,
+	0xc3, 0xee, 0x91, 0x95, 0x8e, 0x8c, 0x22, 0x08, 0x8c, 0x90, 0xc9, 0xb9, 0x34, 0x87, 0xb7, 0x42,
+	0x1b, 0x4d, 0x1b, 0x48, 0xdb, 0x8d, 0x22, 0x18, 0xf2, 0x68, 0x5f, 0x0d, 0xb3, 0x18, 0x12, 0x43,
+	0x67, 0x91, 0xa3, 0x00, 0x3d, 0x11, 0x43, 0x82, 0x4c, 0xb4, 0x59, 0x41, 0xbb, 0x49, 0x08, 0xb7,
+	0x58, 0x5d, 0xda, 0x62, 0x1f, 0x91, 0xb5, 0x02, 0xad, 0x24, 0xe0, 0x31, 0x50, 0x97, 0x2d, 0x91,
+	0xf9, 0xe2, 0xa8, 0x7f, 0x71, 0xf9, 0x90, 0x92, 0x0a, 0x83, 0x2f, 0x9f, 0xfa, 0x10, 0x48, 0x15,
+	0xd2, 0xf9, 0x8a, 0x84, 0x47, 0x10, 0x18, 0xa9, 0xba, 0x1e, 0x6d, 0xa3, 0xe0, 0x02, 0xec, 0x01,
+	0x57, 0xc1, 0xc8, 0x07, 0x9d, 0x45, 0x86, 0x2e, 0x30, 0x4a, 0xda, 0x47, 0x22, 0x82, 0x73, 0x69,
+	0x8e, 0x64, 0x96, 0x84, 0x74, 0x91, 0x2d, 0x12, 0x72, 0x06, 0x86, 0x17, 0x15, 0x58, 0xc2, 0xb4,
+	0x1d, 0x1e, 0x8c, 0xa0, 0x00, 0x28, 0x5b, 0x27, 0xac, 0xc3, 0x93, 0x44, 0x9a, 0x8e, 0x02, 0x6e,
+	0xe0, 0x48, 0x46, 0x21, 0x28, 0xba, 0x8c, 0x72, 0xee, 0xe0, 0x22, 0x02, 0xca, 0x4a, 0x6f, 0x0f,
+	0x22, 0x98, 0x7a, 0xaf, 0x94, 0xde, 0x05, 0x8e, 0xde, 0xab, 0x28, 0xfe, 0x20, 0x13, 0x51, 0x68,
+	0x4b, 0x92, 0xb7, 0x65, 0x0d, 0x35, 0x16, 0xe2, 0xcf, 0x4f, 0xbb, 0xbd, 0x3e, 0x5d, 0x67, 0x6b,
+	0x64, 0xb9, 0x40, 0xce, 0xc0, 0x28, 0x11, 0xd8, 0xe2, 0xdd, 0x43, 0xa9, 0x17, 0x99, 0xb9, 0x18,
+	0x9c, 0x41, 0x2c, 0xd5, 0x98, 0x6e, 0x60, 0x43, 0x2d, 0xd3, 0xa4, 0x45, 0xf4, 0x23, 0xcc, 0x70,
+	0x18, 0xa7, 0x66, 0x5c, 0x96, 0x97, 0xde, 0x67, 0x8c, 0x2c, 0x78, 0x9e, 0x9f, 0x8f, 0x9d, 0xcf,
+	0x03, 0xa0, 0x7f, 0x6b, 0xa2, 0xf0, 0x4b, 0xae, 0x8c, 0xb8, 0xdb, 0xe2, 0x8f, 0x51, 0x78, 0x0f,
+	0x86, 0xd8, 0xda, 0x73, 0x69, 0x4e, 0x25, 0x0f, 0x21, 0xa4, 0x9b, 0x98, 0xda, 0xe7, 0x06, 0x4e,
+	0x45, 0x2c, 0x0c, 0x84, 0xf4, 0x6b, 0x98, 0xe7, 0x5c, 0x1a, 0x1f, 0x78, 0x38, 0xee, 0xcb, 0x1e,
+	0xa8, 0x1b, 0xa0, 0x9f, 0x20, 0xd8, 0x17, 0x31, 0xf4, 0x45, 0xf0, 0xe4, 0x94, 0x0f, 0x87, 0x22,
+	0x19, 0xd2, 0xaf, 0xa3, 0xc8, 0x2f, 0x32, 0x69, 0xf8, 0xe1, 0x6d, 0x00, 0x80, 0x6c, 0x5b, 0x3b,
+	0xdf, 0x27, 0xc4, 0xea, 0xc6, 0xad, 0x04, 0x8c, 0x91, 0xc5, 0xd2, 0x3a, 0x97, 0x09, 0xd0, 0x19,
+	0xd6, 0x26, 0xad, 0xab, 0x44, 0x68, 0x9d, 0x41, 0x48, 0x6b, 0xd8, 0xb3, 0x6e, 0x72, 0xa9, 0xe4,
+	0x10, 0x97, 0x01, 0xad, 0xe3, 0xe9, 0x91, 0x48, 0x84, 0x1e, 0xd9, 0x69, 0x25, 0x64, 0xae, 0x68,
+	0x5e, 0x63, 0x47, 0x93, 0x76, 0xa1, 0x3e, 0xe7, 0x2e, 0x6f, 0xf3, 0x3f, 0xec, 0xd3, 0x92, 0xd5,
+	0xf0, 0xc5, 0x39, 0x56, 0xf2, 0x29, 0xaa, 0xad, 0x23, 0x59, 0x0f, 0x78, 0x64, 0x89, 0xe7, 0x49,
+	0xf3, 0x28, 0xca, 0x6c, 0x96, 0x86, 0xcd, 0x89, 0x06, 0xba, 0xcd, 0xe2, 0x91, 0xa7, 0x64, 0x9a,
+	0x42, 0x48, 0xe7, 0x76, 0xbe, 0x74, 0xed, 0xe6, 0xb1, 0x0b, 0x64, 0x81, 0xb8, 0x57, 0x49, 0x08,
+	0x03, 0x91, 0x40, 0x48, 0x67, 0xec, 0x18, 0xd8, 0x71, 0xa9, 0xf4, 0x23, 0xc4, 0x1b, 0x63, 0x74,
+	0x05, 0x03, 0x2c, 0xd3, 0x09, 0xd7, 0x15, 0x68, 0x80, 0x2d, 0xf2, 0x40, 0x07, 0x4a, 0x5c, 0x57,
+	0xc3, 0x87, 0x58, 0xe6, 0xde, 0x48, 0x3e, 0x2d, 0x31, 0x4d, 0x47, 0x98, 0xe9, 0x18, 0x4c, 0x6f,
+	0xac, 0x0d, 0xc4, 0x1d, 0x99, 0x0c, 0xc4, 0x50, 0x53, 0x81, 0x99, 0xb0, 0x87, 0x95, 0xf0, 0x1f,
+	0xe2, 0x74, 0xf9, 0x10, 0x01, 0xd7, 0x55, 0xd6, 0x27, 0x6c, 0x95, 0x2c, 0xe5, 0x52, 0xa7, 0x63,
+	0x41, 0xff, 0x50, 0xb3, 0xa3, 0xa3, 0x64, 0x5a, 0x62, 0x7f, 0xc4, 0x3d, 0xd2, 0x3e, 0xe1, 0xba,
+	0x84, 0xfe, 0x54, 0x63, 0xeb, 0x64, 0x79, 0x22, 0xb5, 0xc4, 0xff, 0x5c, 0x63, 0x2b, 0x64, 0x11,
+	0xa5, 0x4e, 0x31, 0x4d, 0x9f, 0x5b, 0x10, 0x45, 0x55, 0xc0, 0x17, 0x96, 0xa1, 0x50, 0x55, 0xc1,
+	0x5f, 0xda, 0x64, 0xc8, 0x50, 0x74, 0x51, 0xd3, 0x57, 0x35, 0x54, 0x3a, 0x49, 0x56, 0xc0, 0xf4,
+	0xb5, 0x75, 0x44, 0xd6, 0xa9, 0xe3, 0x1b, 0xeb, 0x58, 0x70, 0x4e, 0xd1, 0xb7, 0x16, 0x3d, 0xe1,
+	0x49, 0x28, 0x07, 0x83, 0x29, 0xfa, 0xae, 0xc6, 0x36, 0xc8, 0x0a, 0x86, 0x1f, 0xf0, 0x88, 0x27,
+	0x41, 0xe9, 0xff, 0xbe, 0xc6, 0x28, 0x99, 0xcf, 0x0b, 0x63, 0xa7, 0x94, 0xfe, 0xb4, 0x6e, 0x8b,
+	0x52, 0x08, 0xc8, 0xb1, 0x9f, 0xd5, 0xd9, 0x22, 0x71, 0xb1, 0x50, 0xb9, 0xfd, 0xf3, 0x3a, 0x9b,
+	0x27, 0x73, 0xdd, 0x44, 0x83, 0x32, 0xf4, 0x4b, 0x9c, 0xa4, 0xb9, 0x7c, 0x0f, 0xd0, 0x1f, 0xe3,
+	0xbc, 0xce, 0xda, 0x49, 0xa2, 0x3f, 0xb1, 0x07, 0xf9, 0xc6, 0xa2, 0x7f, 0x77, 0xec, 0x55, 0xab,
+	0xeb, 0xeb, 0x1f, 0x0e, 0x66, 0x3a, 0x06, 0x53, 0xbe, 0x1e, 0xf4, 0x9f, 0x0e, 0xbb, 0x4f, 0xd6,
+	0x26, 0x98, 0x5d, 0x26, 0xd3, 0x17, 0xe3, 0x5f, 0x0e, 0xdb, 0x24, 0xf7, 0x8e, 0xc1, 0x94, 0x7d,
+	0xc5, 0x20, 0xa1, 0x8d, 0x08, 0x34, 0xfd, 0xb7, 0xc3, 0x3e, 0x26, 0xeb, 0xc7, 0x60, 0xa6, 0xf5,
+	0xad, 0x1c, 0xfe, 0xc7, 0x61, 0x0b, 0xa4, 0xe5, 0xe3, 0xb6, 0x81, 0x1b, 0xa0, 0xaf, 0x1c, 0x6c,
+	0xd2, 0xc4, 0x2c, 0xe4, 0xbc, 0x76, 0xb0, 0x74, 0xdf, 0xe3, 0x26, 0x18, 0x79, 0x71, 0x67, 0xc4,
+	0x93, 0x04, 0x22, 0x4d, 0xdf, 0x38, 0x6c, 0x8d, 0x50, 0x1f, 0x62, 0x79, 0x03, 0x15, 0xf8, 0x2d,
+	0x7e, 0x45, 0x98, 0x75, 0xfe, 0x22, 0x03, 0x35, 0x9e, 0x1e, 0xbc, 0x73, 0xb0, 0xd4, 0xb9, 0xff,
+	0xdd, 0x93, 0xf7, 0x0e, 0x96, 0xba, 0xa8, 0x7c, 0x37, 0x19, 0x48, 0xfa, 0xd7, 0x06, 0xaa, 0x9a,
+	0xac, 0x14, 0xfa, 0x95, 0x8b, 0xaa, 0x6c, 0xd0, 0xb9, 0x0c, 0x01, 0xe5, 0x6b, 0xfa, 0x0b, 0x17,
+	0x4b, 0x8f, 0xad, 0xcb, 0x4b, 0xff, 0x4b, 0x6b, 0xfb, 0x93, 0x6f, 0x2c, 0xfd, 0x15, 0x7e, 0x59,
+	0x48, 0x61, 0xf7, 0x7b, 0x17, 0xf4, 0xd7, 0x2e, 0x5e, 0x63, 0x3f, 0x8a, 0x64, 0xc0, 0xcd, 0x74,
+	0x80, 0x7e, 0xe3, 0xe2, 0x04, 0x56, 0x76, 0x45, 0x51, 0x98, 0x67, 0x2e, 0x5e, 0xaf, 0xc0, 0x6d,
+	0xdb, 0x3c, 0xdc, 0x21, 0xbf, 0xb5, 0xac, 0x1e, 0x37, 0x1c, 0x95, 0xf4, 0x0d, 0xfd, 0x1d, 0x6a,
+	0x5b, 0xda, 0x8f, 0x0c, 0xa8, 0xca, 0x5b, 0x15, 0x21, 0xe9, 0xe1, 0x4d, 0xbe, 0x4c, 0xc5, 0x40,
+	0x04, 0xdc, 0xc2, 0xbf, 0x77, 0xb1, 0xd7, 0xf9, 0x50, 0xed, 0xa7, 0xe2, 0x21, 0x8c, 0xe9, 0xf3,
+	0x16, 0x42, 0xbe, 0x34, 0x25, 0xf4, 0xa2, 0x65, 0x73, 0x28, 0x99, 0x16, 0xc0, 0xcb, 0x16, 0x16,
+	0xe8, 0x54, 0x68, 0x93, 0x03, 0x9a, 0xfe, 0xa5, 0x85, 0xef, 0xfd, 0x55, 0x12, 0xde, 0xdd, 0x26,
+	0xf1, 0xce, 0x36, 0x69, 0x7a, 0x3a, 0xb2, 0x1b, 0xa9, 0x49, 0x1c, 0x4f, 0x47, 0x74, 0x06, 0xb7,
+	0xe8, 0x81, 0x94, 0xd1, 0xe1, 0x6d, 0xaa, 0x1e, 0x7d, 0x93, 0xd6, 0x0e, 0xbe, 0xfd, 0x83, 0xcf,
+	0x86, 0xc2, 0x8c, 0xb2, 0x6b, 0xfc, 0xbb, 0xd8, 0xcb, 0x7f, 0x37, 0x3e, 0x15, 0xb2, 0x78, 0xda,
+	0x13, 0x89, 0x01, 0x95, 0xf0, 0x68, 0xcf, 0xfe, 0x81, 0xec, 0xe5, 0x7f, 0x20, 0xe9, 0xf5, 0xf5,
+	0x9c, 0xb5, 0x3f, 0xfb, 0xef, 0x00, 0x81, 0x00, 0xfc, 0xb8, 0x79, 0x0a, 0x00, 0x00,
 }
@@ -53,6 +53,87 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{0}
 }
 
+type ExportFormat int32
+
+const (
+	ExportFormat_JSON    ExportFormat = 0
+	ExportFormat_Parquet ExportFormat = 1
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "JSON",
+	1: "Parquet",
+}
+
+var ExportFormat_value = map[string]int32{
+	"JSON":    0,
+	"Parquet": 1,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{1}
+}
+
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_Exporting       ExportState = 1
+	ExportState_ExportCompleted ExportState = 2
+	ExportState_ExportFailed    ExportState = 3
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "Exporting",
+	2: "ExportCompleted",
+	3: "ExportFailed",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"Exporting":       1,
+	"ExportCompleted": 2,
+	"ExportFailed":    3,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{2}
+}
+
+type SegmentLevel int32
+
+const (
+	SegmentLevel_L1 SegmentLevel = 0
+	SegmentLevel_L0 SegmentLevel = 1
+)
+
+var SegmentLevel_name = map[int32]string{
+	0: "L1",
+	1: "L0",
+}
+
+var SegmentLevel_value = map[string]int32{
+	"L1": 0,
+	"L0": 1,
+}
+
+func (x SegmentLevel) String() string {
+	return proto.EnumName(SegmentLevel_name, int32(x))
+}
+
+func (SegmentLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{3}
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -842,6 +923,7 @@ func (m *GetCollectionStatisticsRequest) GetCollectionID() int64 {
 type GetCollectionStatisticsResponse struct {
 	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	Status               *commonpb.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Statistics           *CollectionStatistics    `protobuf:"bytes,3,opt,name=statistics,proto3" json:"statistics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -886,6 +968,13 @@ func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
+func (m *GetCollectionStatisticsResponse) GetStatistics() *CollectionStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
 type GetPartitionStatisticsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -952,6 +1041,7 @@ func (m *GetPartitionStatisticsRequest) GetPartitionID() int64 {
 type GetPartitionStatisticsResponse struct {
 	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	Status               *commonpb.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Statistics           *PartitionStatistics     `protobuf:"bytes,3,opt,name=statistics,proto3" json:"statistics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -996,6 +1086,13 @@ func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
+func (m *GetPartitionStatisticsResponse) GetStatistics() *PartitionStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
 type GetSegmentInfoChannelRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1369,6 +1466,11 @@ type SegmentInfo struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	DmlPosition          *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=dml_position,json=dmlPosition,proto3" json:"dml_position,omitempty"`
 	Binlogs              []*FieldBinlog          `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	Statslogs            []*FieldBinlog          `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	ClusteringInfo       *ClusteringInfo         `protobuf:"bytes,13,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,14,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactedTo          []int64                 `protobuf:"varint,15,rep,packed,name=compactedTo,proto3" json:"compactedTo,omitempty"`
+	Level                SegmentLevel            `protobuf:"varint,16,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1476,6 +1578,41 @@ func (m *SegmentInfo) GetBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentInfo) GetStatslogs() []*FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetClusteringInfo() *ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCompactedTo() []int64 {
+	if m != nil {
+		return m.CompactedTo
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetLevel() SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return SegmentLevel_L1
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1531,6 +1668,10 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Level                SegmentLevel            `protobuf:"varint,10,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
+	Channel              string                  `protobuf:"bytes,11,opt,name=channel,proto3" json:"channel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1610,6 +1751,34 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *SaveBinlogPathsRequest) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SaveBinlogPathsRequest) GetLevel() SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return SegmentLevel_L1
+}
+
+func (m *SaveBinlogPathsRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1831,12 +2000,14 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 }
 
 type SegmentBinlogs struct {
-	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64           `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	ClusteringInfo       *ClusteringInfo `protobuf:"bytes,4,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
@@ -1885,9 +2056,24 @@ func (m *SegmentBinlogs) GetNumOfRows() int64 {
 	return 0
 }
 
+func (m *SegmentBinlogs) GetClusteringInfo() *ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
+func (m *SegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	BinlogSize           int64    `protobuf:"varint,3,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1932,6 +2118,13 @@ func (m *FieldBinlog) GetBinlogs() []string {
 	return nil
 }
 
+func (m *FieldBinlog) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -2294,284 +2487,1483 @@ func (m *SegmentFieldBinlogMeta) GetBinlogPath() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+type ListSegmentsRequest struct {
+	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64                 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	States               []commonpb.SegmentState `protobuf:"varint,4,rep,packed,name=states,proto3,enum=milvus.proto.common.SegmentState" json:"states,omitempty"`
+	ChannelName          string                  `protobuf:"bytes,5,opt,name=channelName,proto3" json:"channelName,omitempty"`
+	Offset               int64                   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64                   `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
-
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 1994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdb, 0x6f, 0x1b, 0x59,
-	0x19, 0xef, 0x78, 0x72, 0xb1, 0x3f, 0x3b, 0x4e, 0x72, 0x28, 0x59, 0xe3, 0x76, 0xd3, 0x74, 0x60,
-	0xdb, 0x6c, 0x61, 0x93, 0xad, 0x0b, 0x62, 0x45, 0x59, 0xd0, 0x36, 0xde, 0x46, 0x11, 0x49, 0x09,
-	0x93, 0xee, 0xae, 0xc4, 0x3e, 0x58, 0x13, 0xfb, 0xc4, 0x19, 0xea, 0x99, 0xf1, 0xce, 0x39, 0x4e,
-	0xd3, 0xa7, 0xae, 0x8a, 0xb4, 0x08, 0x84, 0xb8, 0x8a, 0x37, 0x24, 0x10, 0x4f, 0x48, 0xbc, 0xf0,
-	0x67, 0xf0, 0x2f, 0xf1, 0x86, 0xce, 0x75, 0xae, 0xb6, 0x27, 0x0e, 0x6d, 0xde, 0x7c, 0xce, 0x7c,
-	0xb7, 0xf3, 0x9d, 0xef, 0xf2, 0x3b, 0x5f, 0x02, 0x2b, 0x3d, 0x87, 0x3a, 0x9d, 0x6e, 0x10, 0x84,
-	0xbd, 0xad, 0x61, 0x18, 0xd0, 0x00, 0xad, 0x7a, 0xee, 0xe0, 0x6c, 0x44, 0xc4, 0x6a, 0x8b, 0x7d,
-	0x6e, 0xd6, 0xba, 0x81, 0xe7, 0x05, 0xbe, 0xd8, 0x6a, 0xd6, 0x5d, 0x9f, 0xe2, 0xd0, 0x77, 0x06,
-	0x72, 0x5d, 0x8b, 0x33, 0x34, 0x6b, 0xa4, 0x7b, 0x8a, 0x3d, 0x47, 0xac, 0xac, 0x73, 0xa8, 0x3d,
-	0x1e, 0x8c, 0xc8, 0xa9, 0x8d, 0xbf, 0x18, 0x61, 0x42, 0xd1, 0xfb, 0x30, 0x77, 0xec, 0x10, 0xdc,
-	0x30, 0x36, 0x8c, 0xcd, 0x6a, 0xeb, 0xe6, 0x56, 0x42, 0x97, 0xd4, 0x72, 0x40, 0xfa, 0x8f, 0x1c,
-	0x82, 0x6d, 0x4e, 0x89, 0x10, 0xcc, 0xf5, 0x8e, 0xf7, 0xda, 0x8d, 0xd2, 0x86, 0xb1, 0x69, 0xda,
-	0xfc, 0x37, 0xb2, 0xa0, 0xd6, 0x0d, 0x06, 0x03, 0xdc, 0xa5, 0x6e, 0xe0, 0xef, 0xb5, 0x1b, 0x73,
-	0xfc, 0x5b, 0x62, 0xcf, 0xfa, 0xab, 0x01, 0x4b, 0x52, 0x35, 0x19, 0x06, 0x3e, 0xc1, 0xe8, 0x01,
-	0x2c, 0x10, 0xea, 0xd0, 0x11, 0x91, 0xda, 0x6f, 0xe4, 0x6a, 0x3f, 0xe2, 0x24, 0xb6, 0x24, 0x2d,
-	0xa4, 0xde, 0xcc, 0xaa, 0x47, 0xeb, 0x00, 0x04, 0xf7, 0x3d, 0xec, 0xd3, 0xbd, 0x36, 0x69, 0xcc,
-	0x6d, 0x98, 0x9b, 0xa6, 0x1d, 0xdb, 0xb1, 0xfe, 0x68, 0xc0, 0xca, 0x91, 0x5a, 0x2a, 0xef, 0x5c,
-	0x87, 0xf9, 0x6e, 0x30, 0xf2, 0x29, 0x37, 0x70, 0xc9, 0x16, 0x0b, 0x74, 0x1b, 0x6a, 0xdd, 0x53,
-	0xc7, 0xf7, 0xf1, 0xa0, 0xe3, 0x3b, 0x1e, 0xe6, 0xa6, 0x54, 0xec, 0xaa, 0xdc, 0x7b, 0xe2, 0x78,
-	0xb8, 0x90, 0x45, 0x1b, 0x50, 0x1d, 0x3a, 0x21, 0x75, 0x13, 0x3e, 0x8b, 0x6f, 0x59, 0x7f, 0x37,
-	0x60, 0xed, 0x23, 0x42, 0xdc, 0xbe, 0x9f, 0xb1, 0x6c, 0x0d, 0x16, 0xfc, 0xa0, 0x87, 0xf7, 0xda,
-	0xdc, 0x34, 0xd3, 0x96, 0x2b, 0x74, 0x03, 0x2a, 0x43, 0x8c, 0xc3, 0x4e, 0x18, 0x0c, 0x94, 0x61,
-	0x65, 0xb6, 0x61, 0x07, 0x03, 0x8c, 0x7e, 0x06, 0xab, 0x24, 0x25, 0x88, 0x34, 0xcc, 0x0d, 0x73,
-	0xb3, 0xda, 0xfa, 0xe6, 0x56, 0x26, 0xca, 0xb6, 0xd2, 0x4a, 0xed, 0x2c, 0xb7, 0xf5, 0x65, 0x09,
-	0xbe, 0xa6, 0xe9, 0x84, 0xad, 0xec, 0x37, 0xf3, 0x1c, 0xc1, 0x7d, 0x6d, 0x9e, 0x58, 0x14, 0xf1,
-	0x9c, 0x76, 0xb9, 0x19, 0x77, 0x79, 0x81, 0x00, 0x4b, 0xfb, 0x73, 0x3e, 0xe3, 0x4f, 0x74, 0x0b,
-	0xaa, 0xf8, 0x7c, 0xe8, 0x86, 0xb8, 0x43, 0x5d, 0x0f, 0x37, 0x16, 0x36, 0x8c, 0xcd, 0x39, 0x1b,
-	0xc4, 0xd6, 0x53, 0xd7, 0x8b, 0x47, 0xe4, 0x62, 0xe1, 0x88, 0xb4, 0xfe, 0x61, 0xc0, 0x5b, 0x99,
-	0x5b, 0x92, 0x21, 0x6e, 0xc3, 0x0a, 0x3f, 0x79, 0xe4, 0x19, 0x16, 0xec, 0xcc, 0xe1, 0x77, 0x26,
-	0x39, 0x3c, 0x22, 0xb7, 0x33, 0xfc, 0x31, 0x23, 0x4b, 0xc5, 0x8d, 0x7c, 0x06, 0x6f, 0xed, 0x62,
-	0x2a, 0x15, 0xb0, 0x6f, 0x98, 0xcc, 0x5e, 0x02, 0x92, 0xb9, 0x54, 0xca, 0xe4, 0xd2, 0xbf, 0x4b,
-	0x3a, 0x97, 0xb8, 0xaa, 0x3d, 0xff, 0x24, 0x40, 0x37, 0xa1, 0xa2, 0x49, 0x64, 0x54, 0x44, 0x1b,
-	0xe8, 0xfb, 0x30, 0xcf, 0x2c, 0x15, 0x21, 0x51, 0x6f, 0xdd, 0xce, 0x3f, 0x53, 0x4c, 0xa6, 0x2d,
-	0xe8, 0xd1, 0x1e, 0xd4, 0x09, 0x75, 0x42, 0xda, 0x19, 0x06, 0x84, 0xdf, 0x33, 0x0f, 0x9c, 0x6a,
-	0xcb, 0x4a, 0x4a, 0xd0, 0x25, 0xf2, 0x80, 0xf4, 0x0f, 0x25, 0xa5, 0xbd, 0xc4, 0x39, 0xd5, 0x12,
-	0x7d, 0x0c, 0x35, 0xec, 0xf7, 0x22, 0x41, 0x73, 0x85, 0x05, 0x55, 0xb1, 0xdf, 0xd3, 0x62, 0xa2,
-	0xfb, 0x99, 0x2f, 0x7e, 0x3f, 0xbf, 0x35, 0xa0, 0x91, 0xbd, 0xa0, 0xcb, 0x14, 0xca, 0x87, 0x82,
-	0x09, 0x8b, 0x0b, 0x9a, 0x98, 0xe1, 0xfa, 0x92, 0x6c, 0xc9, 0x62, 0xb9, 0xf0, 0xf5, 0xc8, 0x1a,
-	0xfe, 0xe5, 0xb5, 0x05, 0xcb, 0x2f, 0x0d, 0x58, 0x4b, 0xeb, 0xba, 0xcc, 0xb9, 0xbf, 0x0b, 0xf3,
-	0xae, 0x7f, 0x12, 0xa8, 0x63, 0xaf, 0x4f, 0xc8, 0x33, 0xa6, 0x4b, 0x10, 0x5b, 0x1e, 0xdc, 0xd8,
-	0xc5, 0x74, 0xcf, 0x27, 0x38, 0xa4, 0x8f, 0x5c, 0x7f, 0x10, 0xf4, 0x0f, 0x1d, 0x7a, 0x7a, 0x89,
-	0x1c, 0x49, 0x84, 0x7b, 0x29, 0x15, 0xee, 0xd6, 0x3f, 0x0d, 0xb8, 0x99, 0xaf, 0x4f, 0x1e, 0xbd,
-	0x09, 0xe5, 0x13, 0x17, 0x0f, 0x7a, 0xcc, 0x67, 0x06, 0xf7, 0x99, 0x5e, 0xb3, 0x5c, 0x19, 0x32,
-	0x62, 0x79, 0xc2, 0xdb, 0x63, 0x02, 0xf4, 0x88, 0x86, 0xae, 0xdf, 0xdf, 0x77, 0x09, 0xb5, 0x05,
-	0x7d, 0xcc, 0x9f, 0x66, 0xf1, 0xc8, 0xfc, 0x8d, 0x01, 0xeb, 0xbb, 0x98, 0xee, 0xe8, 0x52, 0xcb,
-	0xbe, 0xbb, 0x84, 0xba, 0x5d, 0xf2, 0x7a, 0x41, 0x44, 0x4e, 0xcf, 0xb4, 0x7e, 0x6f, 0xc0, 0xad,
-	0xb1, 0xc6, 0x48, 0xd7, 0xc9, 0x52, 0xa2, 0x0a, 0x6d, 0x7e, 0x29, 0xf9, 0x09, 0x7e, 0xf1, 0xa9,
-	0x33, 0x18, 0xe1, 0x43, 0xc7, 0x0d, 0x45, 0x29, 0x99, 0xb1, 0xb0, 0xfe, 0xcb, 0x80, 0xb7, 0x77,
-	0x31, 0x3d, 0x54, 0x6d, 0xe6, 0x0a, 0xbd, 0x53, 0x00, 0x51, 0xfc, 0x4e, 0x5c, 0x66, 0xae, 0xb5,
-	0x57, 0xe2, 0xbe, 0x75, 0x9e, 0x07, 0xb1, 0x84, 0xdc, 0x11, 0x58, 0x40, 0x3a, 0xcf, 0xfa, 0x4b,
-	0x09, 0x6a, 0x9f, 0x4a, 0x7c, 0xc0, 0xdb, 0x48, 0xda, 0x0f, 0x46, 0xbe, 0x1f, 0x62, 0x90, 0x22,
-	0x0f, 0x65, 0xec, 0xc2, 0x12, 0xc1, 0xf8, 0xd9, 0x2c, 0x4d, 0xa3, 0xc6, 0x18, 0x75, 0xb1, 0xdf,
-	0x87, 0xd5, 0x91, 0x7f, 0xc2, 0x60, 0x2d, 0xee, 0xc9, 0x53, 0x08, 0x74, 0x39, 0xbd, 0xf2, 0x64,
-	0x19, 0xd1, 0x26, 0x2c, 0xa7, 0x65, 0xcd, 0xf3, 0xe4, 0x4f, 0x6f, 0x5b, 0xbf, 0x36, 0x60, 0xed,
-	0x33, 0x87, 0x76, 0x4f, 0xdb, 0x9e, 0xf4, 0xd8, 0x25, 0xe2, 0xed, 0x43, 0xa8, 0x9c, 0x49, 0xef,
-	0xa8, 0xa2, 0x72, 0x2b, 0xc7, 0xf8, 0xf8, 0x3d, 0xd8, 0x11, 0x07, 0x83, 0xa9, 0xd7, 0x39, 0xb2,
-	0x57, 0xd6, 0xbd, 0xf9, 0xc8, 0x9f, 0x86, 0xee, 0xcf, 0x01, 0xa4, 0x71, 0x07, 0xa4, 0x3f, 0x83,
-	0x5d, 0x1f, 0xc0, 0xa2, 0x94, 0x26, 0x83, 0x7b, 0xda, 0xe5, 0x2a, 0x72, 0xeb, 0x13, 0xa8, 0xb5,
-	0xdb, 0xfb, 0xdc, 0x3d, 0x07, 0x98, 0x3a, 0x85, 0xe2, 0xf7, 0x36, 0xd4, 0x8e, 0x79, 0x4f, 0xe8,
-	0x44, 0x75, 0xbe, 0x62, 0x57, 0x8f, 0xa3, 0x3e, 0x61, 0xbd, 0x84, 0x7a, 0x54, 0x04, 0x79, 0x62,
-	0xd4, 0xa1, 0xa4, 0xc5, 0x95, 0xf6, 0xda, 0xe8, 0x43, 0x58, 0x10, 0x2f, 0x3f, 0x69, 0xf1, 0x3b,
-	0x49, 0x8b, 0xe5, 0xab, 0x30, 0x56, 0x49, 0xf9, 0x86, 0x2d, 0x99, 0x98, 0x47, 0x75, 0xe1, 0x10,
-	0x8f, 0x04, 0xd3, 0x8e, 0xed, 0x58, 0xff, 0x35, 0xa1, 0x1a, 0x3b, 0x70, 0x46, 0x7d, 0xfa, 0x9c,
-	0xa5, 0xe9, 0xf5, 0xca, 0xcc, 0x22, 0xf6, 0x77, 0xa0, 0xee, 0xf2, 0x1e, 0xd9, 0x91, 0xd1, 0xc6,
-	0x8b, 0x5a, 0xc5, 0x5e, 0x12, 0xbb, 0x32, 0xf4, 0xd1, 0x3a, 0x54, 0xfd, 0x91, 0xd7, 0x09, 0x4e,
-	0x3a, 0x61, 0xf0, 0x9c, 0x48, 0xe8, 0x5f, 0xf1, 0x47, 0xde, 0x4f, 0x4f, 0xec, 0xe0, 0x39, 0x89,
-	0xd0, 0xe5, 0xc2, 0x05, 0xd1, 0xe5, 0x3a, 0x54, 0x3d, 0xe7, 0x9c, 0x49, 0xed, 0xf8, 0x23, 0x8f,
-	0xbf, 0x0a, 0x4c, 0xbb, 0xe2, 0x39, 0xe7, 0x76, 0xf0, 0xfc, 0xc9, 0xc8, 0x43, 0x9b, 0xb0, 0x32,
-	0x70, 0x08, 0xed, 0xc4, 0x9f, 0x15, 0x65, 0xfe, 0xac, 0xa8, 0xb3, 0xfd, 0x8f, 0xa3, 0xa7, 0x45,
-	0x16, 0xa7, 0x56, 0x2e, 0x81, 0x53, 0x7b, 0xde, 0x20, 0x12, 0x04, 0xc5, 0x71, 0x6a, 0xcf, 0x1b,
-	0x68, 0x31, 0x1f, 0xc0, 0xa2, 0x88, 0x28, 0xd2, 0xa8, 0x8e, 0x2d, 0x58, 0x8f, 0x19, 0xe8, 0x10,
-	0x00, 0xc5, 0x56, 0xe4, 0xd6, 0x4b, 0xb8, 0x1e, 0x39, 0x2b, 0x66, 0x58, 0xf6, 0x8c, 0xc6, 0xac,
-	0x67, 0x9c, 0x0c, 0x9f, 0x7e, 0x65, 0xc2, 0xda, 0x91, 0x73, 0x86, 0x5f, 0x3f, 0x52, 0x2b, 0x54,
-	0x7d, 0xf6, 0x61, 0x95, 0x83, 0xb3, 0x56, 0xcc, 0x9e, 0x09, 0x4d, 0x20, 0xee, 0xd3, 0x2c, 0x23,
-	0xfa, 0x31, 0xeb, 0x5e, 0xb8, 0xfb, 0xec, 0x30, 0x70, 0x55, 0x03, 0xa8, 0xb6, 0xde, 0xce, 0x91,
-	0xb3, 0xa3, 0xa9, 0xec, 0x38, 0x07, 0x3a, 0x84, 0xe5, 0xe4, 0x35, 0x90, 0xc6, 0x02, 0x17, 0x72,
-	0x77, 0xe2, 0x13, 0x20, 0xf2, 0xbe, 0x5d, 0x4f, 0x5c, 0x06, 0x41, 0x0d, 0x58, 0x94, 0x0d, 0x88,
-	0xa7, 0x40, 0xd9, 0x56, 0x4b, 0x86, 0x0e, 0x21, 0xb2, 0x63, 0xca, 0x23, 0xef, 0x47, 0x50, 0xd6,
-	0x91, 0x51, 0x2a, 0x1c, 0x19, 0x9a, 0x27, 0x9d, 0xe6, 0x66, 0x2a, 0xcd, 0xad, 0x57, 0x06, 0x2c,
-	0xb5, 0x1d, 0xea, 0x3c, 0x09, 0x7a, 0xf8, 0xe9, 0x8c, 0x95, 0xbe, 0xc0, 0x88, 0xe2, 0x26, 0x54,
-	0x58, 0xa2, 0x13, 0xea, 0x78, 0x43, 0x6e, 0xc4, 0x9c, 0x1d, 0x6d, 0xb0, 0xf7, 0xcc, 0x92, 0xac,
-	0x4b, 0x47, 0x7a, 0x64, 0xc5, 0x45, 0x19, 0x5c, 0x14, 0xff, 0x8d, 0x7e, 0x90, 0x7c, 0xef, 0x7e,
-	0x2b, 0xf7, 0x7a, 0xb9, 0x10, 0xde, 0xe5, 0x13, 0x45, 0xa9, 0x08, 0x50, 0xfe, 0xd2, 0x80, 0x9a,
-	0x72, 0x05, 0xaf, 0xcf, 0x0d, 0x58, 0x74, 0x7a, 0xbd, 0x10, 0x13, 0x22, 0xed, 0x50, 0x4b, 0xf6,
-	0xe5, 0x0c, 0x87, 0x44, 0x5d, 0x8a, 0x69, 0xab, 0x25, 0xfa, 0x21, 0x94, 0x35, 0x2c, 0x10, 0x63,
-	0xa2, 0x8d, 0xf1, 0x76, 0x4a, 0x60, 0xa7, 0x39, 0xac, 0x3f, 0x19, 0x50, 0x97, 0xd1, 0x25, 0xc2,
-	0x9b, 0x4c, 0x09, 0x8f, 0x47, 0x50, 0x3b, 0x89, 0x52, 0x63, 0xd2, 0x03, 0x2e, 0x9e, 0x41, 0x09,
-	0x9e, 0xa9, 0x21, 0xf2, 0x11, 0x54, 0x63, 0xcc, 0x3c, 0xb0, 0xc5, 0xb3, 0x4a, 0x9a, 0xa3, 0x96,
-	0xec, 0xcb, 0x71, 0xcc, 0x8e, 0x4a, 0x54, 0xfd, 0xfe, 0x63, 0xf0, 0x59, 0x8a, 0x8d, 0xbb, 0xc1,
-	0x19, 0x0e, 0x5f, 0x5c, 0xfe, 0xc5, 0xfa, 0x30, 0xe6, 0xe6, 0x82, 0xe8, 0x4b, 0x33, 0xa0, 0x87,
-	0x91, 0x9d, 0x66, 0x1e, 0x60, 0x8f, 0x27, 0xb9, 0x74, 0x52, 0x74, 0x94, 0x3f, 0x88, 0xb7, 0x77,
-	0xf2, 0x28, 0xb3, 0xd6, 0xd1, 0xff, 0x4b, 0xc7, 0xb7, 0xfe, 0x6c, 0xc0, 0x37, 0x76, 0x31, 0x7d,
-	0x9c, 0xc4, 0xbb, 0x57, 0x6d, 0x95, 0x07, 0xcd, 0x3c, 0xa3, 0x2e, 0x73, 0xeb, 0x4d, 0x28, 0x13,
-	0x05, 0xf2, 0xc5, 0x54, 0x44, 0xaf, 0xad, 0xaf, 0x0c, 0x68, 0x48, 0x2d, 0x5c, 0xe7, 0x4e, 0xe0,
-	0x0d, 0x07, 0x98, 0xe2, 0xde, 0x9b, 0x46, 0xaf, 0x7f, 0x33, 0x60, 0x25, 0x5e, 0x87, 0x78, 0x29,
-	0xf9, 0x1e, 0xcc, 0x73, 0xf0, 0x2f, 0x2d, 0x98, 0x1a, 0xac, 0x82, 0x9a, 0x65, 0x14, 0x6f, 0x2b,
-	0x4f, 0x89, 0xaa, 0x33, 0x72, 0x19, 0x15, 0x43, 0xf3, 0xc2, 0xc5, 0xd0, 0x3a, 0x82, 0x35, 0xe5,
-	0xa9, 0x28, 0xaf, 0x39, 0xd2, 0x1e, 0x9f, 0xdb, 0xb7, 0xa0, 0x1a, 0xc3, 0xd7, 0xb2, 0xc4, 0x43,
-	0x04, 0xaf, 0xef, 0xdd, 0x87, 0xd5, 0x8c, 0x42, 0x54, 0x07, 0xf8, 0xc4, 0xef, 0xca, 0x9b, 0x58,
-	0xb9, 0x86, 0x6a, 0x50, 0x56, 0xf7, 0xb2, 0x62, 0xb4, 0x5e, 0x2d, 0x41, 0x85, 0x15, 0xdc, 0x9d,
-	0x20, 0x08, 0x7b, 0x68, 0x08, 0x88, 0x8f, 0x29, 0xbc, 0x61, 0xe0, 0xeb, 0x79, 0x1e, 0x7a, 0x7f,
-	0x4c, 0xb7, 0xcb, 0x92, 0xca, 0x78, 0x6f, 0xde, 0x19, 0xc3, 0x91, 0x22, 0xb7, 0xae, 0x21, 0x8f,
-	0x6b, 0x64, 0x50, 0xf3, 0xa9, 0xdb, 0x7d, 0xa6, 0x80, 0xf1, 0x04, 0x8d, 0x29, 0x52, 0xa5, 0x31,
-	0x35, 0x26, 0x94, 0x0b, 0x31, 0x4b, 0x52, 0x01, 0x6f, 0x5d, 0x43, 0x5f, 0xc0, 0x75, 0xf6, 0x6e,
-	0xd7, 0xe3, 0x03, 0xa5, 0xb0, 0x35, 0x5e, 0x61, 0x86, 0xf8, 0x82, 0x2a, 0xf7, 0x61, 0x9e, 0x27,
-	0x03, 0xca, 0x0b, 0xb8, 0xf8, 0x1f, 0xb5, 0x9a, 0x1b, 0xe3, 0x09, 0xb4, 0xb4, 0x5f, 0xc0, 0x72,
-	0x6a, 0x68, 0x8f, 0xde, 0xcd, 0x61, 0xcb, 0xff, 0xf3, 0x4b, 0xf3, 0x5e, 0x11, 0x52, 0xad, 0xab,
-	0x0f, 0xf5, 0xe4, 0x90, 0x03, 0x6d, 0xe6, 0xf0, 0xe7, 0x0e, 0x5c, 0x9b, 0xef, 0x16, 0xa0, 0xd4,
-	0x8a, 0x3c, 0x58, 0x49, 0x0f, 0x91, 0xd1, 0xbd, 0x89, 0x02, 0x92, 0xe1, 0xf6, 0xed, 0x42, 0xb4,
-	0x5a, 0xdd, 0x0b, 0x1e, 0x04, 0x99, 0x21, 0x26, 0xda, 0xca, 0x17, 0x33, 0x6e, 0xba, 0xda, 0xdc,
-	0x2e, 0x4c, 0xaf, 0x55, 0xbf, 0x12, 0x4d, 0x38, 0x6f, 0x10, 0x88, 0xee, 0xe7, 0x8b, 0x9b, 0x30,
-	0xc1, 0x6c, 0xb6, 0x2e, 0xc2, 0xa2, 0x8d, 0x78, 0xc9, 0xbb, 0x67, 0xce, 0x30, 0x2d, 0x9d, 0x77,
-	0x4a, 0xde, 0xf8, 0x29, 0x61, 0xf3, 0xfe, 0x05, 0x38, 0xb4, 0x01, 0x41, 0x7a, 0x4c, 0xaf, 0xd2,
-	0x70, 0x7b, 0x6a, 0xd4, 0xcc, 0x96, 0x83, 0x9f, 0xc3, 0x72, 0xea, 0xdd, 0x95, 0x9b, 0x35, 0xf9,
-	0x6f, 0xb3, 0xe6, 0xa4, 0xbe, 0x28, 0x52, 0x32, 0x05, 0x46, 0xd0, 0x98, 0xe8, 0xcf, 0x01, 0x2c,
-	0xcd, 0x7b, 0x45, 0x48, 0xf5, 0x41, 0x08, 0x2f, 0x97, 0xa9, 0x86, 0x8e, 0xbe, 0x93, 0x2f, 0x23,
-	0x1f, 0x8c, 0x34, 0xdf, 0x2b, 0x48, 0xad, 0x95, 0x76, 0x00, 0x76, 0x31, 0x3d, 0xc0, 0x34, 0x64,
-	0x31, 0x72, 0x27, 0xd7, 0xe5, 0x11, 0x81, 0x52, 0x73, 0x77, 0x2a, 0x9d, 0x52, 0xd0, 0xfa, 0x6a,
-	0x0e, 0xca, 0x0a, 0xf5, 0x5f, 0x41, 0x0f, 0xba, 0x82, 0xa6, 0xf0, 0x39, 0x2c, 0xa7, 0xc6, 0xa0,
-	0xb9, 0x31, 0x93, 0x3f, 0x2a, 0x9d, 0x16, 0x90, 0x9f, 0xc9, 0xff, 0x58, 0xd0, 0xf1, 0x71, 0x77,
-	0x5c, 0x63, 0x49, 0x87, 0xc6, 0x14, 0xc1, 0xaf, 0x3b, 0x10, 0x1e, 0x3d, 0xf8, 0xf9, 0xfd, 0xbe,
-	0x4b, 0x4f, 0x47, 0xc7, 0x4c, 0xf5, 0xb6, 0xa0, 0x7c, 0xcf, 0x0d, 0xe4, 0xaf, 0x6d, 0x75, 0x03,
-	0xdb, 0x5c, 0xd2, 0x36, 0x3b, 0xc7, 0xf0, 0xf8, 0x78, 0x81, 0xaf, 0x1e, 0xfc, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x76, 0x42, 0xbf, 0x37, 0x83, 0x22, 0x00, 0x00,
+func (m *ListSegmentsRequest) Reset()         { *m = ListSegmentsRequest{} }
+func (m *ListSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSegmentsRequest) ProtoMessage()    {}
+func (*ListSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+func (m *ListSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSegmentsRequest.Unmarshal(m, b)
 }
-
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *ListSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSegmentsRequest.Marshal(b, m, deterministic)
 }
-
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+func (m *ListSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSegmentsRequest.Merge(m, src)
+}
+func (m *ListSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSegmentsRequest.Size(m)
+}
+func (m *ListSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSegmentsRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListSegmentsRequest proto.InternalMessageInfo
+
+func (m *ListSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetStates() []commonpb.SegmentState {
+	if m != nil {
+		return m.States
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
-	out := new(GetSegmentStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSegmentsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
-	out := new(GetInsertBinlogPathsResponse)
+type ListSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	Total                int64            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSegmentsResponse) Reset()         { *m = ListSegmentsResponse{} }
+func (m *ListSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSegmentsResponse) ProtoMessage()    {}
+func (*ListSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{41}
+}
+
+func (m *ListSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSegmentsResponse.Unmarshal(m, b)
+}
+func (m *ListSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSegmentsResponse.Merge(m, src)
+}
+func (m *ListSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSegmentsResponse.Size(m)
+}
+func (m *ListSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSegmentsResponse proto.InternalMessageInfo
+
+func (m *ListSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListSegmentsResponse) GetInfos() []*SegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *ListSegmentsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Format               ExportFormat      `protobuf:"varint,4,opt,name=format,proto3,enum=milvus.proto.data.ExportFormat" json:"format,omitempty"`
+	BucketName           string            `protobuf:"bytes,5,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	Path                 string            `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{42}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_JSON
+}
+
+func (m *ExportRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	JobID                int64            `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{43}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID                int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{44}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                ExportState      `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.ExportState" json:"state,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	ManifestPath         string           `protobuf:"bytes,4,opt,name=manifestPath,proto3" json:"manifestPath,omitempty"`
+	Reason               string           `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{45}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *GetExportStateResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetManifestPath() string {
+	if m != nil {
+		return m.ManifestPath
+	}
+	return ""
+}
+
+func (m *GetExportStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ClusteringInfo struct {
+	FieldID              int64             `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IntMin               int64             `protobuf:"varint,3,opt,name=int_min,json=intMin,proto3" json:"int_min,omitempty"`
+	IntMax               int64             `protobuf:"varint,4,opt,name=int_max,json=intMax,proto3" json:"int_max,omitempty"`
+	FloatMin             float64           `protobuf:"fixed64,5,opt,name=float_min,json=floatMin,proto3" json:"float_min,omitempty"`
+	FloatMax             float64           `protobuf:"fixed64,6,opt,name=float_max,json=floatMax,proto3" json:"float_max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ClusteringInfo) Reset()         { *m = ClusteringInfo{} }
+func (m *ClusteringInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusteringInfo) ProtoMessage()    {}
+func (*ClusteringInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{46}
+}
+
+func (m *ClusteringInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringInfo.Unmarshal(m, b)
+}
+func (m *ClusteringInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringInfo.Marshal(b, m, deterministic)
+}
+func (m *ClusteringInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringInfo.Merge(m, src)
+}
+func (m *ClusteringInfo) XXX_Size() int {
+	return xxx_messageInfo_ClusteringInfo.Size(m)
+}
+func (m *ClusteringInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringInfo proto.InternalMessageInfo
+
+func (m *ClusteringInfo) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ClusteringInfo) GetDataType() schemapb.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return schemapb.DataType_None
+}
+
+func (m *ClusteringInfo) GetIntMin() int64 {
+	if m != nil {
+		return m.IntMin
+	}
+	return 0
+}
+
+func (m *ClusteringInfo) GetIntMax() int64 {
+	if m != nil {
+		return m.IntMax
+	}
+	return 0
+}
+
+func (m *ClusteringInfo) GetFloatMin() float64 {
+	if m != nil {
+		return m.FloatMin
+	}
+	return 0
+}
+
+func (m *ClusteringInfo) GetFloatMax() float64 {
+	if m != nil {
+		return m.FloatMax
+	}
+	return 0
+}
+
+type ClusteringCompactRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	FieldID              int64             `protobuf:"varint,3,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ClusteringCompactRequest) Reset()         { *m = ClusteringCompactRequest{} }
+func (m *ClusteringCompactRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusteringCompactRequest) ProtoMessage()    {}
+func (*ClusteringCompactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{47}
+}
+
+func (m *ClusteringCompactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringCompactRequest.Unmarshal(m, b)
+}
+func (m *ClusteringCompactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringCompactRequest.Marshal(b, m, deterministic)
+}
+func (m *ClusteringCompactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringCompactRequest.Merge(m, src)
+}
+func (m *ClusteringCompactRequest) XXX_Size() int {
+	return xxx_messageInfo_ClusteringCompactRequest.Size(m)
+}
+func (m *ClusteringCompactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringCompactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringCompactRequest proto.InternalMessageInfo
+
+func (m *ClusteringCompactRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ClusteringCompactRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ClusteringCompactRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+type DeltaLogInfo struct {
+	RecordEntries        int64    `protobuf:"varint,1,opt,name=record_entries,json=recordEntries,proto3" json:"record_entries,omitempty"`
+	TimestampFrom        uint64   `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
+	TimestampTo          uint64   `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
+	DeltaLogPath         string   `protobuf:"bytes,4,opt,name=delta_log_path,json=deltaLogPath,proto3" json:"delta_log_path,omitempty"`
+	DeltaLogSize         int64    `protobuf:"varint,5,opt,name=delta_log_size,json=deltaLogSize,proto3" json:"delta_log_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
+func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
+func (*DeltaLogInfo) ProtoMessage()    {}
+func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{48}
+}
+
+func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeltaLogInfo.Unmarshal(m, b)
+}
+func (m *DeltaLogInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeltaLogInfo.Marshal(b, m, deterministic)
+}
+func (m *DeltaLogInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaLogInfo.Merge(m, src)
+}
+func (m *DeltaLogInfo) XXX_Size() int {
+	return xxx_messageInfo_DeltaLogInfo.Size(m)
+}
+func (m *DeltaLogInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaLogInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaLogInfo proto.InternalMessageInfo
+
+func (m *DeltaLogInfo) GetRecordEntries() int64 {
+	if m != nil {
+		return m.RecordEntries
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampFrom() uint64 {
+	if m != nil {
+		return m.TimestampFrom
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampTo() uint64 {
+	if m != nil {
+		return m.TimestampTo
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetDeltaLogPath() string {
+	if m != nil {
+		return m.DeltaLogPath
+	}
+	return ""
+}
+
+func (m *DeltaLogInfo) GetDeltaLogSize() int64 {
+	if m != nil {
+		return m.DeltaLogSize
+	}
+	return 0
+}
+
+type GetDeleteStatsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetDeleteStatsRequest) Reset()         { *m = GetDeleteStatsRequest{} }
+func (m *GetDeleteStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteStatsRequest) ProtoMessage()    {}
+func (*GetDeleteStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{49}
+}
+
+func (m *GetDeleteStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteStatsRequest.Unmarshal(m, b)
+}
+func (m *GetDeleteStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteStatsRequest.Merge(m, src)
+}
+func (m *GetDeleteStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteStatsRequest.Size(m)
+}
+func (m *GetDeleteStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteStatsRequest proto.InternalMessageInfo
+
+func (m *GetDeleteStatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDeleteStatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type SegmentDeleteStats struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumOfRows            int64    `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	NumDeletedRows       int64    `protobuf:"varint,4,opt,name=num_deleted_rows,json=numDeletedRows,proto3" json:"num_deleted_rows,omitempty"`
+	NumDeltaLogs         int64    `protobuf:"varint,5,opt,name=num_delta_logs,json=numDeltaLogs,proto3" json:"num_delta_logs,omitempty"`
+	DeltaLogSize         int64    `protobuf:"varint,6,opt,name=delta_log_size,json=deltaLogSize,proto3" json:"delta_log_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentDeleteStats) Reset()         { *m = SegmentDeleteStats{} }
+func (m *SegmentDeleteStats) String() string { return proto.CompactTextString(m) }
+func (*SegmentDeleteStats) ProtoMessage()    {}
+func (*SegmentDeleteStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{50}
+}
+
+func (m *SegmentDeleteStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentDeleteStats.Unmarshal(m, b)
+}
+func (m *SegmentDeleteStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentDeleteStats.Marshal(b, m, deterministic)
+}
+func (m *SegmentDeleteStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentDeleteStats.Merge(m, src)
+}
+func (m *SegmentDeleteStats) XXX_Size() int {
+	return xxx_messageInfo_SegmentDeleteStats.Size(m)
+}
+func (m *SegmentDeleteStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentDeleteStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentDeleteStats proto.InternalMessageInfo
+
+func (m *SegmentDeleteStats) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentDeleteStats) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentDeleteStats) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentDeleteStats) GetNumDeletedRows() int64 {
+	if m != nil {
+		return m.NumDeletedRows
+	}
+	return 0
+}
+
+func (m *SegmentDeleteStats) GetNumDeltaLogs() int64 {
+	if m != nil {
+		return m.NumDeltaLogs
+	}
+	return 0
+}
+
+func (m *SegmentDeleteStats) GetDeltaLogSize() int64 {
+	if m != nil {
+		return m.DeltaLogSize
+	}
+	return 0
+}
+
+type GetDeleteStatsResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NumOfRows            int64                 `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	NumDeletedRows       int64                 `protobuf:"varint,3,opt,name=num_deleted_rows,json=numDeletedRows,proto3" json:"num_deleted_rows,omitempty"`
+	DeltaLogSize         int64                 `protobuf:"varint,4,opt,name=delta_log_size,json=deltaLogSize,proto3" json:"delta_log_size,omitempty"`
+	Segments             []*SegmentDeleteStats `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetDeleteStatsResponse) Reset()         { *m = GetDeleteStatsResponse{} }
+func (m *GetDeleteStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteStatsResponse) ProtoMessage()    {}
+func (*GetDeleteStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{51}
+}
+
+func (m *GetDeleteStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteStatsResponse.Unmarshal(m, b)
+}
+func (m *GetDeleteStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteStatsResponse.Merge(m, src)
+}
+func (m *GetDeleteStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteStatsResponse.Size(m)
+}
+func (m *GetDeleteStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteStatsResponse proto.InternalMessageInfo
+
+func (m *GetDeleteStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDeleteStatsResponse) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *GetDeleteStatsResponse) GetNumDeletedRows() int64 {
+	if m != nil {
+		return m.NumDeletedRows
+	}
+	return 0
+}
+
+func (m *GetDeleteStatsResponse) GetDeltaLogSize() int64 {
+	if m != nil {
+		return m.DeltaLogSize
+	}
+	return 0
+}
+
+func (m *GetDeleteStatsResponse) GetSegments() []*SegmentDeleteStats {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type PurgeDeletesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentIDs           []int64           `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PurgeDeletesRequest) Reset()         { *m = PurgeDeletesRequest{} }
+func (m *PurgeDeletesRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeDeletesRequest) ProtoMessage()    {}
+func (*PurgeDeletesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{52}
+}
+
+func (m *PurgeDeletesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeDeletesRequest.Unmarshal(m, b)
+}
+func (m *PurgeDeletesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeDeletesRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeDeletesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDeletesRequest.Merge(m, src)
+}
+func (m *PurgeDeletesRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeDeletesRequest.Size(m)
+}
+func (m *PurgeDeletesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDeletesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeDeletesRequest proto.InternalMessageInfo
+
+func (m *PurgeDeletesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PurgeDeletesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PurgeDeletesRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type PurgeCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PurgeCollectionRequest) Reset()         { *m = PurgeCollectionRequest{} }
+func (m *PurgeCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeCollectionRequest) ProtoMessage()    {}
+func (*PurgeCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{53}
+}
+
+func (m *PurgeCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeCollectionRequest.Unmarshal(m, b)
+}
+func (m *PurgeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeCollectionRequest.Merge(m, src)
+}
+func (m *PurgeCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeCollectionRequest.Size(m)
+}
+func (m *PurgeCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeCollectionRequest proto.InternalMessageInfo
+
+func (m *PurgeCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PurgeCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type PartitionStatistics struct {
+	PartitionID          int64    `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	RowCount             int64    `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentCount         int64    `protobuf:"varint,3,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	GrowingSegmentCount  int64    `protobuf:"varint,4,opt,name=growing_segment_count,json=growingSegmentCount,proto3" json:"growing_segment_count,omitempty"`
+	DataSize             int64    `protobuf:"varint,5,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionStatistics) Reset()         { *m = PartitionStatistics{} }
+func (m *PartitionStatistics) String() string { return proto.CompactTextString(m) }
+func (*PartitionStatistics) ProtoMessage()    {}
+func (*PartitionStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{54}
+}
+
+func (m *PartitionStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionStatistics.Unmarshal(m, b)
+}
+func (m *PartitionStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionStatistics.Marshal(b, m, deterministic)
+}
+func (m *PartitionStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStatistics.Merge(m, src)
+}
+func (m *PartitionStatistics) XXX_Size() int {
+	return xxx_messageInfo_PartitionStatistics.Size(m)
+}
+func (m *PartitionStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStatistics proto.InternalMessageInfo
+
+func (m *PartitionStatistics) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionStatistics) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *PartitionStatistics) GetSegmentCount() int64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *PartitionStatistics) GetGrowingSegmentCount() int64 {
+	if m != nil {
+		return m.GrowingSegmentCount
+	}
+	return 0
+}
+
+func (m *PartitionStatistics) GetDataSize() int64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+type CollectionStatistics struct {
+	RowCount             int64              `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	GrowingRowCount      int64              `protobuf:"varint,2,opt,name=growing_row_count,json=growingRowCount,proto3" json:"growing_row_count,omitempty"`
+	SealedRowCount       int64              `protobuf:"varint,3,opt,name=sealed_row_count,json=sealedRowCount,proto3" json:"sealed_row_count,omitempty"`
+	BinlogSize           int64              `protobuf:"varint,4,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	StatslogSize         int64              `protobuf:"varint,5,opt,name=statslog_size,json=statslogSize,proto3" json:"statslog_size,omitempty"`
+	DeltalogSize         int64              `protobuf:"varint,6,opt,name=deltalog_size,json=deltalogSize,proto3" json:"deltalog_size,omitempty"`
+	Fields               []*FieldStatistics `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CollectionStatistics) Reset()         { *m = CollectionStatistics{} }
+func (m *CollectionStatistics) String() string { return proto.CompactTextString(m) }
+func (*CollectionStatistics) ProtoMessage()    {}
+func (*CollectionStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{55}
+}
+
+func (m *CollectionStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionStatistics.Unmarshal(m, b)
+}
+func (m *CollectionStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionStatistics.Marshal(b, m, deterministic)
+}
+func (m *CollectionStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionStatistics.Merge(m, src)
+}
+func (m *CollectionStatistics) XXX_Size() int {
+	return xxx_messageInfo_CollectionStatistics.Size(m)
+}
+func (m *CollectionStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionStatistics proto.InternalMessageInfo
+
+func (m *CollectionStatistics) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetGrowingRowCount() int64 {
+	if m != nil {
+		return m.GrowingRowCount
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetSealedRowCount() int64 {
+	if m != nil {
+		return m.SealedRowCount
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetStatslogSize() int64 {
+	if m != nil {
+		return m.StatslogSize
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetDeltalogSize() int64 {
+	if m != nil {
+		return m.DeltalogSize
+	}
+	return 0
+}
+
+func (m *CollectionStatistics) GetFields() []*FieldStatistics {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type FieldStatistics struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	BinlogSize           int64    `protobuf:"varint,2,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	EstimatedSize        int64    `protobuf:"varint,3,opt,name=estimated_size,json=estimatedSize,proto3" json:"estimated_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldStatistics) Reset()         { *m = FieldStatistics{} }
+func (m *FieldStatistics) String() string { return proto.CompactTextString(m) }
+func (*FieldStatistics) ProtoMessage()    {}
+func (*FieldStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{56}
+}
+
+func (m *FieldStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStatistics.Unmarshal(m, b)
+}
+func (m *FieldStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStatistics.Marshal(b, m, deterministic)
+}
+func (m *FieldStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStatistics.Merge(m, src)
+}
+func (m *FieldStatistics) XXX_Size() int {
+	return xxx_messageInfo_FieldStatistics.Size(m)
+}
+func (m *FieldStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStatistics proto.InternalMessageInfo
+
+func (m *FieldStatistics) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldStatistics) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *FieldStatistics) GetEstimatedSize() int64 {
+	if m != nil {
+		return m.EstimatedSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("milvus.proto.data.ExportState", ExportState_name, ExportState_value)
+	proto.RegisterEnum("milvus.proto.data.SegmentLevel", SegmentLevel_name, SegmentLevel_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*ListSegmentsRequest)(nil), "milvus.proto.data.ListSegmentsRequest")
+	proto.RegisterType((*ListSegmentsResponse)(nil), "milvus.proto.data.ListSegmentsResponse")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.data.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.data.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.data.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.data.GetExportStateResponse")
+	proto.RegisterType((*ClusteringInfo)(nil), "milvus.proto.data.ClusteringInfo")
+	proto.RegisterType((*ClusteringCompactRequest)(nil), "milvus.proto.data.ClusteringCompactRequest")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*GetDeleteStatsRequest)(nil), "milvus.proto.data.GetDeleteStatsRequest")
+	proto.RegisterType((*SegmentDeleteStats)(nil), "milvus.proto.data.SegmentDeleteStats")
+	proto.RegisterType((*GetDeleteStatsResponse)(nil), "milvus.proto.data.GetDeleteStatsResponse")
+	proto.RegisterType((*PurgeDeletesRequest)(nil), "milvus.proto.data.PurgeDeletesRequest")
+	proto.RegisterType((*PurgeCollectionRequest)(nil), "milvus.proto.data.PurgeCollectionRequest")
+	proto.RegisterType((*PartitionStatistics)(nil), "milvus.proto.data.PartitionStatistics")
+	proto.RegisterType((*CollectionStatistics)(nil), "milvus.proto.data.CollectionStatistics")
+	proto.RegisterType((*FieldStatistics)(nil), "milvus.proto.data.FieldStatistics")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 3102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x5e, 0x92, 0xa2, 0xc8, 0x8f, 0x0f, 0x51, 0x63, 0x45, 0xe1, 0x8f, 0x76, 0x64, 0x79, 0x13,
+	0xc7, 0xb2, 0xf2, 0x8b, 0x1f, 0x4a, 0x8b, 0xa4, 0x79, 0xb4, 0x88, 0x2d, 0x5b, 0x50, 0x2a, 0x3b,
+	0xea, 0x4a, 0x49, 0x80, 0xfa, 0x40, 0xac, 0xb8, 0x43, 0x7a, 0x63, 0xee, 0x2e, 0xb3, 0xb3, 0xb4,
+	0xe5, 0x5c, 0x12, 0xa4, 0x40, 0x80, 0x3e, 0x0e, 0x2d, 0x8a, 0xa2, 0x40, 0xdb, 0xa0, 0x45, 0x4f,
+	0x05, 0x7a, 0xc9, 0x9f, 0x51, 0xb4, 0xb7, 0x1e, 0x8a, 0xde, 0x7a, 0x6e, 0xff, 0x80, 0x9e, 0x8b,
+	0x79, 0xec, 0xee, 0xec, 0xee, 0x90, 0x5c, 0x49, 0x91, 0x7d, 0x12, 0x67, 0xe6, 0x9b, 0xf9, 0x1e,
+	0xf3, 0xbd, 0x77, 0x04, 0x2d, 0xcb, 0x0c, 0xcc, 0x6e, 0xcf, 0xf3, 0x7c, 0xeb, 0xea, 0xc8, 0xf7,
+	0x02, 0x0f, 0x2d, 0x3a, 0xf6, 0xf0, 0xd1, 0x98, 0xf0, 0xd1, 0x55, 0xba, 0xdc, 0xa9, 0xf7, 0x3c,
+	0xc7, 0xf1, 0x5c, 0x3e, 0xd5, 0x69, 0xda, 0x6e, 0x80, 0x7d, 0xd7, 0x1c, 0x8a, 0x71, 0x5d, 0xde,
+	0xd0, 0xa9, 0x93, 0xde, 0x03, 0xec, 0x98, 0x7c, 0xa4, 0x1f, 0x42, 0xfd, 0xce, 0x70, 0x4c, 0x1e,
+	0x18, 0xf8, 0x93, 0x31, 0x26, 0x01, 0xba, 0x0e, 0xa5, 0x03, 0x93, 0xe0, 0xb6, 0xb6, 0xaa, 0xad,
+	0xd5, 0x36, 0xce, 0x5f, 0x4d, 0xe0, 0x12, 0x58, 0xee, 0x92, 0xc1, 0x4d, 0x93, 0x60, 0x83, 0x41,
+	0x22, 0x04, 0x25, 0xeb, 0x60, 0x7b, 0xb3, 0x5d, 0x58, 0xd5, 0xd6, 0x8a, 0x06, 0xfb, 0x8d, 0x74,
+	0xa8, 0xf7, 0xbc, 0xe1, 0x10, 0xf7, 0x02, 0xdb, 0x73, 0xb7, 0x37, 0xdb, 0x25, 0xb6, 0x96, 0x98,
+	0xd3, 0x7f, 0xa7, 0x41, 0x43, 0xa0, 0x26, 0x23, 0xcf, 0x25, 0x18, 0xbd, 0x06, 0x65, 0x12, 0x98,
+	0xc1, 0x98, 0x08, 0xec, 0xe7, 0x94, 0xd8, 0xf7, 0x18, 0x88, 0x21, 0x40, 0x73, 0xa1, 0x2f, 0x66,
+	0xd1, 0xa3, 0x15, 0x00, 0x82, 0x07, 0x0e, 0x76, 0x83, 0xed, 0x4d, 0xd2, 0x2e, 0xad, 0x16, 0xd7,
+	0x8a, 0x86, 0x34, 0xa3, 0xff, 0x42, 0x83, 0xd6, 0x5e, 0x38, 0x0c, 0xa5, 0xb3, 0x04, 0x73, 0x3d,
+	0x6f, 0xec, 0x06, 0x8c, 0xc0, 0x86, 0xc1, 0x07, 0xe8, 0x22, 0xd4, 0x7b, 0x0f, 0x4c, 0xd7, 0xc5,
+	0xc3, 0xae, 0x6b, 0x3a, 0x98, 0x91, 0x52, 0x35, 0x6a, 0x62, 0xee, 0x9e, 0xe9, 0xe0, 0x5c, 0x14,
+	0xad, 0x42, 0x6d, 0x64, 0xfa, 0x81, 0x9d, 0x90, 0x99, 0x3c, 0xa5, 0xff, 0x41, 0x83, 0xe5, 0x77,
+	0x09, 0xb1, 0x07, 0x6e, 0x86, 0xb2, 0x65, 0x28, 0xbb, 0x9e, 0x85, 0xb7, 0x37, 0x19, 0x69, 0x45,
+	0x43, 0x8c, 0xd0, 0x39, 0xa8, 0x8e, 0x30, 0xf6, 0xbb, 0xbe, 0x37, 0x0c, 0x09, 0xab, 0xd0, 0x09,
+	0xc3, 0x1b, 0x62, 0xf4, 0x03, 0x58, 0x24, 0xa9, 0x83, 0x48, 0xbb, 0xb8, 0x5a, 0x5c, 0xab, 0x6d,
+	0xbc, 0x78, 0x35, 0xa3, 0x65, 0x57, 0xd3, 0x48, 0x8d, 0xec, 0x6e, 0xfd, 0xf3, 0x02, 0x9c, 0x8d,
+	0xe0, 0x38, 0xad, 0xf4, 0x37, 0x95, 0x1c, 0xc1, 0x83, 0x88, 0x3c, 0x3e, 0xc8, 0x23, 0xb9, 0x48,
+	0xe4, 0x45, 0x59, 0xe4, 0x39, 0x14, 0x2c, 0x2d, 0xcf, 0xb9, 0x8c, 0x3c, 0xd1, 0x05, 0xa8, 0xe1,
+	0xc3, 0x91, 0xed, 0xe3, 0x6e, 0x60, 0x3b, 0xb8, 0x5d, 0x5e, 0xd5, 0xd6, 0x4a, 0x06, 0xf0, 0xa9,
+	0x7d, 0xdb, 0x91, 0x35, 0x72, 0x3e, 0xb7, 0x46, 0xea, 0x7f, 0xd4, 0xe0, 0xf9, 0xcc, 0x2d, 0x09,
+	0x15, 0x37, 0xa0, 0xc5, 0x38, 0x8f, 0x25, 0x43, 0x95, 0x9d, 0x0a, 0xfc, 0xe5, 0x69, 0x02, 0x8f,
+	0xc1, 0x8d, 0xcc, 0x7e, 0x89, 0xc8, 0x42, 0x7e, 0x22, 0x1f, 0xc2, 0xf3, 0x5b, 0x38, 0x10, 0x08,
+	0xe8, 0x1a, 0x26, 0xc7, 0x77, 0x01, 0x49, 0x5b, 0x2a, 0x64, 0x6c, 0xe9, 0xeb, 0x02, 0xb4, 0x64,
+	0x54, 0xdb, 0x6e, 0xdf, 0x43, 0xe7, 0xa1, 0x1a, 0x81, 0x08, 0xad, 0x88, 0x27, 0xd0, 0xeb, 0x30,
+	0x47, 0x29, 0xe5, 0x2a, 0xd1, 0xdc, 0xb8, 0xa8, 0xe6, 0x49, 0x3a, 0xd3, 0xe0, 0xf0, 0x68, 0x1b,
+	0x9a, 0x24, 0x30, 0xfd, 0xa0, 0x3b, 0xf2, 0x08, 0xbb, 0x67, 0xa6, 0x38, 0xb5, 0x0d, 0x3d, 0x79,
+	0x42, 0xe4, 0x22, 0xef, 0x92, 0xc1, 0xae, 0x80, 0x34, 0x1a, 0x6c, 0x67, 0x38, 0x44, 0xb7, 0xa1,
+	0x8e, 0x5d, 0x2b, 0x3e, 0xa8, 0x94, 0xfb, 0xa0, 0x1a, 0x76, 0xad, 0xe8, 0x98, 0xf8, 0x7e, 0xe6,
+	0xf2, 0xdf, 0xcf, 0xcf, 0x34, 0x68, 0x67, 0x2f, 0xe8, 0x24, 0x8e, 0xf2, 0x2d, 0xbe, 0x09, 0xf3,
+	0x0b, 0x9a, 0x6a, 0xe1, 0xd1, 0x25, 0x19, 0x62, 0x8b, 0x6e, 0xc3, 0x73, 0x31, 0x35, 0x6c, 0xe5,
+	0xd4, 0x94, 0xe5, 0x47, 0x1a, 0x2c, 0xa7, 0x71, 0x9d, 0x84, 0xef, 0x6f, 0xc1, 0x9c, 0xed, 0xf6,
+	0xbd, 0x90, 0xed, 0x95, 0x29, 0x76, 0x46, 0x71, 0x71, 0x60, 0xdd, 0x81, 0x73, 0x5b, 0x38, 0xd8,
+	0x76, 0x09, 0xf6, 0x83, 0x9b, 0xb6, 0x3b, 0xf4, 0x06, 0xbb, 0x66, 0xf0, 0xe0, 0x04, 0x36, 0x92,
+	0x50, 0xf7, 0x42, 0x4a, 0xdd, 0xf5, 0x3f, 0x69, 0x70, 0x5e, 0x8d, 0x4f, 0xb0, 0xde, 0x81, 0x4a,
+	0xdf, 0xc6, 0x43, 0x6b, 0x7b, 0x93, 0x3b, 0x8c, 0xa2, 0x11, 0x8d, 0xa9, 0xad, 0x8c, 0x28, 0xb0,
+	0xe0, 0xf0, 0xe2, 0x04, 0x05, 0xdd, 0x0b, 0x7c, 0xdb, 0x1d, 0xec, 0xd8, 0x24, 0x30, 0x38, 0xbc,
+	0x24, 0xcf, 0x62, 0x7e, 0xcd, 0xfc, 0x89, 0x06, 0x2b, 0x5b, 0x38, 0xb8, 0x15, 0xb9, 0x5a, 0xba,
+	0x6e, 0x93, 0xc0, 0xee, 0x91, 0xd3, 0x4d, 0x22, 0x14, 0x31, 0x53, 0xff, 0xa7, 0x06, 0x17, 0x26,
+	0x12, 0x23, 0x44, 0x27, 0x5c, 0x49, 0xe8, 0x68, 0xd5, 0xae, 0xe4, 0xfb, 0xf8, 0xc9, 0x87, 0xe6,
+	0x70, 0x8c, 0x77, 0x4d, 0xdb, 0xe7, 0xae, 0xe4, 0x78, 0x8e, 0x15, 0x6d, 0x01, 0x90, 0x88, 0x06,
+	0x21, 0xd7, 0xcb, 0x0a, 0x9d, 0x53, 0x92, 0x2c, 0x6d, 0xd5, 0xff, 0xac, 0xc1, 0x0b, 0x5b, 0x38,
+	0xd8, 0x0d, 0xe3, 0xd5, 0x33, 0x14, 0x73, 0x8e, 0xd4, 0xe4, 0x1f, 0x5c, 0x2b, 0x94, 0xd4, 0x3e,
+	0x93, 0x7b, 0xb8, 0xa3, 0xb8, 0x07, 0x55, 0x8c, 0x55, 0x51, 0x2c, 0x5f, 0xc3, 0x0a, 0x33, 0x4c,
+	0xc9, 0x43, 0xdc, 0xe2, 0xc9, 0x89, 0xb8, 0x04, 0xfd, 0x57, 0x05, 0xa8, 0x7f, 0x28, 0x12, 0x16,
+	0xba, 0x9c, 0x91, 0xa7, 0xa6, 0x96, 0xa7, 0x94, 0xe3, 0xa8, 0xd2, 0x9e, 0x2d, 0x68, 0x10, 0x8c,
+	0x1f, 0x1e, 0x27, 0x8a, 0xd5, 0xe9, 0xc6, 0x70, 0x84, 0x76, 0x60, 0x71, 0xec, 0xf6, 0x69, 0x9e,
+	0x8d, 0x2d, 0xc1, 0x05, 0x4f, 0x77, 0x67, 0xbb, 0xc2, 0xec, 0x46, 0xb4, 0x06, 0x0b, 0xe9, 0xb3,
+	0xe6, 0x98, 0x37, 0x4a, 0x4f, 0xeb, 0x3f, 0xd6, 0x60, 0xf9, 0x23, 0x33, 0xe8, 0x3d, 0xd8, 0x74,
+	0x84, 0xc4, 0x4e, 0xa0, 0xb7, 0xef, 0x40, 0xf5, 0x91, 0x90, 0x4e, 0xe8, 0xe5, 0x2e, 0x28, 0x88,
+	0x97, 0xef, 0xc1, 0x88, 0x77, 0xd0, 0xbc, 0x79, 0x89, 0x95, 0x1a, 0x21, 0x75, 0x4f, 0xdf, 0x82,
+	0x66, 0x95, 0x1b, 0x87, 0x00, 0x82, 0xb8, 0xbb, 0x64, 0x70, 0x0c, 0xba, 0xde, 0x80, 0x79, 0x71,
+	0x9a, 0x30, 0x92, 0x59, 0x97, 0x1b, 0x82, 0xeb, 0x1f, 0x40, 0x7d, 0x73, 0x73, 0x87, 0x89, 0xe7,
+	0x2e, 0x0e, 0xcc, 0x5c, 0xfa, 0x7b, 0x11, 0xea, 0x07, 0x2c, 0x48, 0x75, 0xe3, 0xc0, 0x53, 0x35,
+	0x6a, 0x07, 0x71, 0xe0, 0xd2, 0x3f, 0x83, 0x66, 0xec, 0xe2, 0x98, 0x61, 0x34, 0xa1, 0x10, 0x1d,
+	0x57, 0xd8, 0xde, 0x44, 0xef, 0x40, 0x99, 0x97, 0xa2, 0x82, 0xe2, 0x4b, 0x49, 0x8a, 0xf9, 0x9a,
+	0xec, 0x27, 0xd9, 0x84, 0x21, 0x36, 0x51, 0x89, 0x46, 0x0e, 0x88, 0x57, 0x2d, 0x45, 0x43, 0x9a,
+	0xd1, 0x7f, 0x5b, 0x86, 0x9a, 0xc4, 0x70, 0x06, 0x7d, 0x9a, 0xcf, 0xc2, 0x6c, 0xbf, 0x57, 0xcc,
+	0x96, 0x10, 0x97, 0xa0, 0x69, 0xb3, 0xa0, 0xdd, 0x15, 0xda, 0xc6, 0x9c, 0x63, 0xd5, 0x68, 0xf0,
+	0x59, 0xa1, 0xfa, 0x68, 0x05, 0x6a, 0xee, 0xd8, 0xe9, 0x7a, 0xfd, 0xae, 0xef, 0x3d, 0x26, 0xa2,
+	0x16, 0xa9, 0xba, 0x63, 0xe7, 0xfd, 0xbe, 0xe1, 0x3d, 0x26, 0x71, 0xba, 0x5b, 0x3e, 0x62, 0xba,
+	0xbb, 0x02, 0x35, 0xc7, 0x3c, 0xa4, 0xa7, 0x76, 0xdd, 0xb1, 0xc3, 0xca, 0x94, 0xa2, 0x51, 0x75,
+	0xcc, 0x43, 0xc3, 0x7b, 0x7c, 0x6f, 0xec, 0xa0, 0x35, 0x68, 0x0d, 0x4d, 0x12, 0x74, 0xe5, 0x3a,
+	0xa7, 0xc2, 0xea, 0x9c, 0x26, 0x9d, 0xbf, 0x1d, 0xd7, 0x3a, 0xd9, 0xc4, 0xb9, 0x7a, 0x82, 0xc4,
+	0xd9, 0x72, 0x86, 0xf1, 0x41, 0x90, 0x3f, 0x71, 0xb6, 0x9c, 0x61, 0x74, 0xcc, 0x1b, 0x30, 0xcf,
+	0x35, 0x8a, 0xb4, 0x6b, 0x13, 0x1d, 0xd6, 0x1d, 0x9a, 0x05, 0xf1, 0x8c, 0xc9, 0x08, 0xc1, 0xd1,
+	0xdb, 0x50, 0x65, 0xa1, 0x83, 0xed, 0xad, 0xe7, 0xda, 0x1b, 0x6f, 0x40, 0xef, 0xc1, 0x42, 0x6f,
+	0x38, 0x26, 0x01, 0xa6, 0xf9, 0x52, 0x97, 0xe6, 0x83, 0xed, 0x06, 0xe3, 0xe0, 0xa2, 0x2a, 0x8e,
+	0x47, 0x90, 0xcc, 0xac, 0x9a, 0xbd, 0xc4, 0x98, 0x7a, 0x2e, 0x0b, 0x0f, 0x03, 0x93, 0x51, 0xd2,
+	0x9c, 0xe8, 0xb9, 0x36, 0x29, 0xcc, 0x8e, 0xc7, 0xcf, 0x88, 0x77, 0xb0, 0x40, 0xe1, 0x39, 0x23,
+	0xb3, 0x17, 0x60, 0x6b, 0xdf, 0x6b, 0x2f, 0x30, 0x2d, 0x97, 0xa7, 0xd0, 0xb7, 0x61, 0x6e, 0x88,
+	0x1f, 0xe1, 0x61, 0xbb, 0xc5, 0x34, 0xe7, 0xc2, 0x64, 0xb3, 0xdf, 0xa1, 0x60, 0x06, 0x87, 0xd6,
+	0x3f, 0x83, 0xa5, 0x58, 0x9d, 0xa4, 0xab, 0xcb, 0x6a, 0x81, 0x76, 0x5c, 0x2d, 0x98, 0x9e, 0xf1,
+	0xfe, 0xbb, 0x04, 0xcb, 0x7b, 0xe6, 0x23, 0x7c, 0xfa, 0xc9, 0x75, 0x2e, 0xff, 0xbc, 0x03, 0x8b,
+	0x2c, 0x9f, 0xde, 0x90, 0xe8, 0x69, 0x97, 0x72, 0x69, 0x4e, 0x76, 0x23, 0xfa, 0x1e, 0x8d, 0xef,
+	0xb8, 0xf7, 0x70, 0xd7, 0xb3, 0xc3, 0x10, 0x59, 0xdb, 0x78, 0x41, 0xa5, 0x3d, 0x11, 0x94, 0x21,
+	0xef, 0x40, 0xbb, 0xb0, 0x90, 0xbc, 0x06, 0xd2, 0x2e, 0xaf, 0x16, 0x27, 0xa4, 0x92, 0xaa, 0x8b,
+	0x34, 0x9a, 0x89, 0xcb, 0x20, 0xa8, 0x0d, 0xf3, 0x22, 0x44, 0x33, 0x27, 0x51, 0x31, 0xc2, 0x21,
+	0xda, 0x85, 0xb3, 0x9c, 0x83, 0x3d, 0x61, 0x01, 0x9c, 0xf9, 0x4a, 0x2e, 0xe6, 0x55, 0x5b, 0x93,
+	0x4a, 0x5f, 0x3d, 0xb2, 0xd2, 0x47, 0x2a, 0x0d, 0x47, 0x51, 0x69, 0xca, 0x61, 0xe8, 0x83, 0x6b,
+	0xcc, 0x07, 0x87, 0x43, 0x5a, 0xb2, 0x40, 0x2c, 0xe9, 0x19, 0x9d, 0x87, 0xef, 0x42, 0x25, 0xd2,
+	0xfd, 0x42, 0x6e, 0xdd, 0x8f, 0xf6, 0xa4, 0x5d, 0x7d, 0x31, 0xe5, 0xea, 0xf5, 0x2f, 0x34, 0x68,
+	0x6c, 0x9a, 0x81, 0x79, 0xcf, 0xb3, 0xf0, 0xfe, 0x31, 0xa3, 0x7d, 0x8e, 0xbe, 0xd9, 0x79, 0xa8,
+	0x52, 0x67, 0x4f, 0x02, 0xd3, 0x19, 0x31, 0x22, 0x4a, 0x46, 0x3c, 0x41, 0x8b, 0xec, 0x86, 0x88,
+	0x4d, 0x7b, 0x51, 0x1f, 0x95, 0x1d, 0xa5, 0xb1, 0xa3, 0xd8, 0x6f, 0xf4, 0x66, 0xb2, 0x09, 0xf3,
+	0x92, 0x52, 0x81, 0xd9, 0x21, 0x2c, 0xd3, 0x4b, 0x04, 0xa6, 0x3c, 0xd5, 0xdb, 0xe7, 0x1a, 0xd4,
+	0x43, 0x51, 0x30, 0x6f, 0xd9, 0x86, 0x79, 0xd3, 0xb2, 0x7c, 0x4c, 0x88, 0xa0, 0x23, 0x1c, 0xd2,
+	0x95, 0x47, 0xd8, 0x27, 0xe1, 0xa5, 0x14, 0x8d, 0x70, 0x88, 0xde, 0x86, 0x4a, 0x94, 0x1a, 0xf2,
+	0xde, 0xe5, 0xea, 0x64, 0x3a, 0x45, 0x91, 0x10, 0xed, 0xd0, 0xbf, 0x2a, 0x40, 0x53, 0x28, 0xd3,
+	0x4d, 0x11, 0x3c, 0xa6, 0xab, 0xc7, 0x4d, 0xa8, 0xf7, 0x63, 0xfd, 0x9f, 0xd6, 0x55, 0x90, 0xcd,
+	0x24, 0xb1, 0x67, 0x96, 0x8a, 0xa8, 0x02, 0x50, 0xe9, 0x1b, 0x09, 0x40, 0x73, 0x47, 0xb5, 0x45,
+	0xfd, 0x00, 0x6a, 0x12, 0x1f, 0xcc, 0x8b, 0xf0, 0xb6, 0x83, 0x90, 0x4c, 0x38, 0xa4, 0x2b, 0x07,
+	0x92, 0x48, 0xaa, 0x71, 0x30, 0xbe, 0x00, 0x22, 0x31, 0xec, 0x12, 0xfb, 0x53, 0x2c, 0xb8, 0x05,
+	0x3e, 0xb5, 0x67, 0x7f, 0x8a, 0xf5, 0xbf, 0x68, 0xac, 0x19, 0x69, 0xe0, 0x9e, 0xf7, 0x08, 0xfb,
+	0x4f, 0x4e, 0xde, 0xf2, 0x79, 0x4b, 0x52, 0x89, 0x9c, 0xd5, 0x42, 0xb4, 0x01, 0xbd, 0x15, 0x33,
+	0x52, 0x54, 0x15, 0xaa, 0xb2, 0xff, 0x11, 0x17, 0x1a, 0xf1, 0xaa, 0xff, 0x9c, 0x37, 0xaf, 0x92,
+	0xac, 0x1c, 0x37, 0xaa, 0x7d, 0x23, 0x19, 0xaa, 0xfe, 0x4b, 0x0d, 0xfe, 0x6f, 0x0b, 0x07, 0x77,
+	0x92, 0xf5, 0xd9, 0xb3, 0xa6, 0xca, 0x81, 0x8e, 0x8a, 0xa8, 0x93, 0xdc, 0x7a, 0x07, 0x2a, 0x24,
+	0x2c, 0x4a, 0x79, 0x5b, 0x31, 0x1a, 0xeb, 0x5f, 0x6a, 0xd0, 0x16, 0x58, 0x18, 0xce, 0x5b, 0x9e,
+	0x33, 0x1a, 0xe2, 0x00, 0x5b, 0x4f, 0xbb, 0xda, 0xfa, 0xbd, 0x06, 0x2d, 0xd9, 0x67, 0xd2, 0x55,
+	0x1a, 0xf0, 0x58, 0xb1, 0x2a, 0x28, 0x98, 0xa9, 0xac, 0x1c, 0x9a, 0x9a, 0x1c, 0x0b, 0xf2, 0xfb,
+	0x24, 0xf4, 0x89, 0x62, 0x18, 0x3b, 0xee, 0xe2, 0x91, 0x1d, 0xb7, 0xbe, 0x07, 0xcb, 0xa1, 0xa4,
+	0x62, 0xc3, 0x67, 0x95, 0xe1, 0x64, 0xe3, 0x8f, 0x4d, 0x9c, 0xd6, 0x83, 0x22, 0x1c, 0x41, 0x5c,
+	0x0e, 0xea, 0xbf, 0x29, 0xc0, 0x59, 0xda, 0x79, 0x7c, 0x3a, 0xea, 0xa7, 0x43, 0x5d, 0xd2, 0xb5,
+	0xb0, 0x38, 0x4c, 0xcc, 0xa1, 0xef, 0x44, 0xed, 0x70, 0x9a, 0xe5, 0xe5, 0x2a, 0xb9, 0xc4, 0x86,
+	0x74, 0xf7, 0x66, 0x2e, 0x1b, 0x7c, 0x97, 0xa1, 0xec, 0xf5, 0xfb, 0x04, 0x07, 0xac, 0x9e, 0x2b,
+	0x1a, 0x62, 0x44, 0x3f, 0x66, 0x0d, 0x6d, 0xc7, 0x0e, 0x44, 0x9d, 0xc6, 0x07, 0xfa, 0xaf, 0x35,
+	0x58, 0x4a, 0x0a, 0xe7, 0xa9, 0xf7, 0xbb, 0x29, 0x65, 0x81, 0x17, 0x98, 0x43, 0x61, 0xab, 0x7c,
+	0xa0, 0xff, 0x57, 0x83, 0xc6, 0xed, 0xc3, 0x91, 0xe7, 0x07, 0xcf, 0xfe, 0xc2, 0x5e, 0x87, 0x72,
+	0xdf, 0xf3, 0x1d, 0x33, 0x68, 0x97, 0x26, 0xa6, 0x85, 0x9c, 0xd6, 0x3b, 0x0c, 0xcc, 0x10, 0xe0,
+	0xb4, 0x51, 0x70, 0x30, 0xee, 0x3d, 0xc4, 0x81, 0x74, 0x5b, 0xd2, 0x0c, 0xcd, 0x7c, 0x98, 0xd6,
+	0x96, 0xd9, 0x0a, 0xfb, 0xad, 0xdf, 0x87, 0x66, 0xc8, 0xf7, 0x49, 0xee, 0x62, 0x09, 0xe6, 0x3e,
+	0xf6, 0xe2, 0x76, 0x11, 0x1f, 0xe8, 0x5d, 0xf6, 0x31, 0x85, 0x9f, 0xcf, 0x35, 0xeb, 0xd8, 0xc2,
+	0x55, 0x23, 0xf8, 0x17, 0x8f, 0x42, 0x09, 0x0c, 0x27, 0x54, 0x29, 0x39, 0x0f, 0x5c, 0x99, 0x28,
+	0xf9, 0x54, 0x6b, 0x42, 0x6e, 0x79, 0x15, 0xd3, 0x2d, 0x2f, 0x7a, 0xe9, 0x8e, 0xe9, 0xda, 0x7d,
+	0x4c, 0x02, 0xea, 0x23, 0x44, 0xe3, 0x24, 0x31, 0x47, 0x0d, 0xc9, 0xc7, 0x26, 0xf1, 0x5c, 0x71,
+	0x6f, 0x62, 0xa4, 0xff, 0x5d, 0x83, 0x66, 0x32, 0xf1, 0x99, 0xe2, 0x9d, 0xde, 0x84, 0x2a, 0x7b,
+	0x44, 0x11, 0x3c, 0x19, 0x85, 0x2c, 0xbc, 0xa0, 0xec, 0x35, 0xd1, 0x5c, 0x74, 0xff, 0xc9, 0x08,
+	0x1b, 0x15, 0x4b, 0xfc, 0x42, 0xcf, 0xc3, 0xbc, 0xed, 0x06, 0x5d, 0xc7, 0x76, 0x85, 0x65, 0x94,
+	0x6d, 0x37, 0xb8, 0x6b, 0xbb, 0xd1, 0x82, 0x79, 0xd8, 0x2e, 0xc5, 0x0b, 0xe6, 0x21, 0xfd, 0xe2,
+	0xde, 0x1f, 0x7a, 0x26, 0xdf, 0x43, 0xa9, 0xd6, 0x8c, 0x0a, 0x9b, 0xa0, 0xbb, 0xe2, 0x45, 0xf3,
+	0xb0, 0x5d, 0x96, 0x17, 0xcd, 0x43, 0x5a, 0xa6, 0xb4, 0x63, 0xa6, 0x6e, 0xf1, 0x22, 0xff, 0x74,
+	0x0d, 0x4f, 0x12, 0x5a, 0x31, 0x21, 0x34, 0xfd, 0x6f, 0x34, 0x37, 0x97, 0x92, 0x42, 0xda, 0xe9,
+	0xf2, 0x71, 0xcf, 0xf3, 0xad, 0x2e, 0x76, 0x03, 0xdf, 0xc6, 0x44, 0x88, 0xb9, 0xc1, 0x67, 0x6f,
+	0xf3, 0x49, 0x0a, 0x16, 0x95, 0x19, 0xdd, 0xbe, 0xef, 0x39, 0x0c, 0x6f, 0xc9, 0x68, 0x44, 0xb3,
+	0x77, 0x7c, 0xcf, 0xa1, 0x15, 0x4c, 0x0c, 0x16, 0x78, 0xa2, 0x42, 0xa9, 0x45, 0x73, 0xfb, 0x1e,
+	0x7a, 0x09, 0x9a, 0x2c, 0x0f, 0xed, 0x46, 0x71, 0x45, 0x68, 0x88, 0x25, 0xc8, 0x62, 0x1a, 0x92,
+	0x80, 0x62, 0x09, 0x26, 0x6f, 0xae, 0x45, 0x50, 0x2c, 0xc5, 0x74, 0x98, 0xc9, 0x6d, 0x62, 0x1a,
+	0xf3, 0x59, 0xad, 0x7a, 0xaa, 0x62, 0xd5, 0xff, 0xa3, 0x01, 0x12, 0x4e, 0x56, 0xc2, 0x39, 0xa3,
+	0xb2, 0x48, 0x25, 0x4d, 0x85, 0x6c, 0xb3, 0x71, 0x56, 0xdd, 0xb0, 0x06, 0x2d, 0xba, 0x6e, 0x31,
+	0x94, 0x16, 0x07, 0xe2, 0xca, 0xd9, 0x74, 0xc7, 0x0e, 0xa7, 0xc4, 0x62, 0x90, 0x2f, 0x41, 0x53,
+	0x40, 0x72, 0xc9, 0x85, 0x2d, 0xc9, 0x3a, 0x87, 0x63, 0x82, 0x23, 0x0a, 0xd9, 0x96, 0x15, 0xb2,
+	0xfd, 0xbc, 0xc0, 0xbc, 0x4d, 0x42, 0xb8, 0x27, 0xf1, 0x36, 0x29, 0x2e, 0x0b, 0x79, 0xb8, 0x2c,
+	0x4e, 0xe2, 0x32, 0x45, 0x7f, 0x29, 0x4b, 0x3f, 0x7a, 0x57, 0xca, 0x1b, 0x79, 0x81, 0x74, 0x69,
+	0x72, 0xcc, 0x94, 0xb9, 0x8c, 0xd3, 0xcb, 0x9f, 0x6a, 0x70, 0x76, 0x77, 0xec, 0x0f, 0x30, 0x5f,
+	0x3e, 0xe5, 0xf4, 0x66, 0x86, 0x63, 0xd5, 0x5d, 0x58, 0x66, 0xc4, 0xc4, 0xad, 0xf3, 0xd3, 0xd5,
+	0xf6, 0xbf, 0x52, 0xee, 0xb3, 0x9f, 0xd1, 0xd2, 0x0a, 0xad, 0x65, 0x15, 0xfa, 0x1c, 0x54, 0x69,
+	0xe7, 0x9a, 0x3f, 0xf0, 0xe1, 0x47, 0x57, 0x7c, 0xef, 0xf1, 0x2d, 0x3a, 0x46, 0x2f, 0x42, 0x43,
+	0x30, 0xd5, 0x8d, 0x5f, 0x00, 0x15, 0x8d, 0xba, 0x98, 0xe4, 0x40, 0x1b, 0xf0, 0xdc, 0xc0, 0xf7,
+	0x1e, 0xd3, 0x3a, 0x39, 0x09, 0xcc, 0x6f, 0xfa, 0xac, 0x58, 0xdc, 0x93, 0xf7, 0x9c, 0x13, 0xf1,
+	0x40, 0xf2, 0x16, 0xcc, 0xe1, 0x33, 0x6d, 0xfe, 0xba, 0x00, 0x4b, 0xaa, 0x6f, 0xb3, 0x49, 0x5a,
+	0xb5, 0x14, 0xad, 0xeb, 0xb0, 0x18, 0x92, 0x91, 0x66, 0x68, 0x41, 0x2c, 0x18, 0x21, 0xec, 0x1a,
+	0x7d, 0x03, 0x64, 0x0e, 0xb1, 0x25, 0x81, 0x0a, 0xfd, 0xe5, 0xf3, 0x11, 0x64, 0xaa, 0x72, 0x2e,
+	0xa5, 0x2b, 0x67, 0x26, 0x22, 0xd1, 0x79, 0x4b, 0xf8, 0xbe, 0x70, 0x32, 0x04, 0x0a, 0xeb, 0xf9,
+	0xac, 0x11, 0x87, 0x40, 0x6f, 0x42, 0x99, 0x79, 0x7e, 0xfa, 0xd2, 0xa9, 0x98, 0xed, 0x79, 0xc5,
+	0x0d, 0x8d, 0x58, 0x22, 0x86, 0xd8, 0xa1, 0x13, 0x58, 0x48, 0x2d, 0xe5, 0x2a, 0x15, 0x18, 0x2d,
+	0x85, 0x0c, 0x4f, 0x97, 0xa0, 0x89, 0x49, 0x60, 0x3b, 0x26, 0x35, 0x6e, 0xa9, 0x63, 0xd0, 0x88,
+	0x66, 0x29, 0xd8, 0xfa, 0x0d, 0x58, 0xcc, 0x94, 0x30, 0xa8, 0x09, 0xf0, 0x81, 0xdb, 0x13, 0xb5,
+	0x5d, 0xeb, 0x0c, 0xaa, 0x43, 0x25, 0xac, 0xf4, 0x5a, 0xda, 0xfa, 0x25, 0xa8, 0xcb, 0x09, 0x22,
+	0xaa, 0x40, 0xe9, 0xbd, 0xbd, 0xf7, 0xef, 0xb5, 0xce, 0xa0, 0x1a, 0xcc, 0xef, 0x9a, 0xfe, 0x27,
+	0x63, 0x1c, 0xb4, 0xb4, 0xf5, 0x0f, 0xa1, 0x26, 0x65, 0x33, 0x68, 0x31, 0x4c, 0x81, 0x77, 0xb1,
+	0x6b, 0xd9, 0xee, 0xa0, 0x75, 0x06, 0x35, 0xa0, 0xca, 0xa7, 0xe8, 0x50, 0x43, 0x67, 0x61, 0x81,
+	0x0f, 0xa3, 0xaa, 0xb2, 0x55, 0x40, 0xad, 0x08, 0x99, 0x69, 0x0f, 0xb1, 0xd5, 0x2a, 0xae, 0xaf,
+	0x40, 0x5d, 0x6e, 0x5b, 0xa2, 0x32, 0x14, 0x76, 0x6e, 0xb4, 0xce, 0xb0, 0xbf, 0xd7, 0x5b, 0xda,
+	0xc6, 0x57, 0x08, 0xaa, 0x34, 0x03, 0xb9, 0xe5, 0x79, 0xbe, 0x85, 0x46, 0x80, 0xd8, 0xc3, 0x06,
+	0x67, 0xe4, 0xb9, 0xd1, 0x0b, 0x20, 0x74, 0x7d, 0x42, 0x2b, 0x32, 0x0b, 0x2a, 0x4c, 0xbe, 0xf3,
+	0xf2, 0x84, 0x1d, 0x29, 0x70, 0xfd, 0x0c, 0x72, 0x18, 0x46, 0xfa, 0x2d, 0x68, 0xdf, 0xee, 0x3d,
+	0x0c, 0xbf, 0x5c, 0x4d, 0xc1, 0x98, 0x02, 0x0d, 0x31, 0xa6, 0x1e, 0x16, 0x89, 0x01, 0x7f, 0x7d,
+	0x12, 0x46, 0x06, 0xfd, 0x0c, 0xfa, 0x04, 0x96, 0xe8, 0x87, 0xf5, 0x48, 0x67, 0x42, 0x84, 0x1b,
+	0x93, 0x11, 0x66, 0x80, 0x8f, 0x88, 0x72, 0x07, 0xe6, 0x58, 0xf5, 0x8f, 0x54, 0xb5, 0x83, 0xfc,
+	0x0c, 0xb6, 0xb3, 0x3a, 0x19, 0x20, 0x3a, 0xed, 0x63, 0x58, 0x48, 0x3d, 0xf3, 0x43, 0x57, 0x14,
+	0xdb, 0xd4, 0x0f, 0x36, 0x3b, 0xeb, 0x79, 0x40, 0x23, 0x5c, 0x03, 0x68, 0x26, 0x5f, 0x21, 0xa0,
+	0x35, 0xc5, 0x7e, 0xe5, 0x13, 0xad, 0xce, 0x95, 0x1c, 0x90, 0x11, 0x22, 0x07, 0x5a, 0xe9, 0x67,
+	0x67, 0x68, 0x7d, 0xea, 0x01, 0x49, 0x75, 0x7b, 0x25, 0x17, 0x6c, 0x84, 0xee, 0x09, 0x2c, 0xa9,
+	0x9e, 0x3d, 0xa1, 0xab, 0xea, 0x63, 0x26, 0xbd, 0xc7, 0xea, 0x5c, 0xcb, 0x0d, 0x1f, 0xa1, 0xfe,
+	0x82, 0x77, 0x1d, 0x95, 0xbe, 0xfe, 0x86, 0xfa, 0xb8, 0x29, 0x6f, 0x9e, 0x3a, 0x1b, 0x47, 0xd9,
+	0x12, 0x11, 0xf1, 0x19, 0x4b, 0x9d, 0x54, 0xc1, 0xf3, 0xba, 0xfa, 0xbc, 0xc9, 0xcf, 0x81, 0x3a,
+	0x37, 0x8e, 0xb0, 0x23, 0x22, 0xc0, 0x4b, 0x3f, 0xec, 0x0b, 0xcd, 0xf0, 0xda, 0x4c, 0xad, 0x39,
+	0x9e, 0x0d, 0xde, 0x87, 0x85, 0xd4, 0x67, 0x3f, 0xa5, 0xd5, 0xa8, 0x3f, 0x0d, 0x76, 0xa6, 0x25,
+	0x90, 0xdc, 0x24, 0x53, 0xdd, 0x57, 0x34, 0x41, 0xfb, 0x15, 0x1d, 0xda, 0xce, 0x7a, 0x1e, 0xd0,
+	0x88, 0x11, 0xc2, 0xdc, 0x65, 0xaa, 0x83, 0x89, 0xfe, 0x5f, 0x7d, 0x86, 0xba, 0xfb, 0xda, 0x79,
+	0x35, 0x27, 0x74, 0x84, 0xb4, 0x0b, 0xb0, 0x85, 0x83, 0xbb, 0x38, 0xf0, 0xa9, 0x8e, 0xbc, 0xac,
+	0x14, 0x79, 0x0c, 0x10, 0xa2, 0xb9, 0x3c, 0x13, 0x2e, 0x42, 0x60, 0x42, 0x5d, 0x6e, 0x45, 0x21,
+	0xd5, 0x93, 0x29, 0x45, 0x23, 0xaf, 0x73, 0x79, 0x26, 0x5c, 0x84, 0xe2, 0x7d, 0x28, 0xf3, 0xc8,
+	0x88, 0x56, 0x27, 0x36, 0x12, 0xc2, 0x63, 0x2f, 0x4e, 0x81, 0x48, 0x39, 0x47, 0x39, 0x66, 0x4f,
+	0x70, 0x8e, 0xd9, 0x96, 0x4b, 0xe7, 0x4a, 0x0e, 0x48, 0x49, 0xfa, 0x8b, 0x99, 0xfa, 0x1c, 0xbd,
+	0x32, 0xf5, 0x9b, 0x4c, 0xb2, 0x8a, 0x9f, 0xa5, 0xbf, 0x9c, 0x13, 0xb9, 0x64, 0x9c, 0xc0, 0x49,
+	0xb6, 0x92, 0xed, 0x5c, 0xc9, 0x01, 0x19, 0x71, 0xf2, 0x01, 0xd4, 0xe5, 0x7a, 0x45, 0x79, 0xcd,
+	0x8a, 0x82, 0x66, 0x16, 0xfd, 0xf7, 0x61, 0x21, 0x55, 0x79, 0x28, 0xed, 0x4f, 0x5d, 0x9d, 0xcc,
+	0x38, 0x7c, 0xe3, 0xcb, 0x12, 0x54, 0xc2, 0xaf, 0x85, 0xcf, 0x20, 0x3d, 0x7a, 0x06, 0xf9, 0xca,
+	0x7d, 0x58, 0x48, 0x3d, 0xa1, 0x53, 0x8a, 0x53, 0xfd, 0xcc, 0x6e, 0xd6, 0x5d, 0x7d, 0x24, 0xfe,
+	0xfd, 0x26, 0x32, 0xf5, 0xcb, 0x93, 0x72, 0x9e, 0xb4, 0xad, 0xcf, 0x38, 0xf8, 0xb4, 0x7d, 0xd4,
+	0xcd, 0xd7, 0x7e, 0x78, 0x63, 0x60, 0x07, 0x0f, 0xc6, 0x07, 0x14, 0xf5, 0x35, 0x0e, 0xf9, 0xaa,
+	0xed, 0x89, 0x5f, 0xd7, 0xc2, 0x1b, 0xb8, 0xc6, 0x4e, 0xba, 0x46, 0xf9, 0x18, 0x1d, 0x1c, 0x94,
+	0xd9, 0xe8, 0xb5, 0xff, 0x0d, 0x00, 0x2e, 0x90, 0x86, 0x68, 0x50, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+	ClusteringCompact(ctx context.Context, in *ClusteringCompactRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetDeleteStats(ctx context.Context, in *GetDeleteStatsRequest, opts ...grpc.CallOption) (*GetDeleteStatsResponse, error)
+	PurgeDeletes(ctx context.Context, in *PurgeDeletesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+	out := new(GetSegmentStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
+	out := new(GetInsertBinlogPathsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2642,6 +4034,69 @@ func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *dataCoordClient) ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error) {
+	out := new(ListSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ClusteringCompact(ctx context.Context, in *ClusteringCompactRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ClusteringCompact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetDeleteStats(ctx context.Context, in *GetDeleteStatsRequest, opts ...grpc.CallOption) (*GetDeleteStatsResponse, error) {
+	out := new(GetDeleteStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetDeleteStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PurgeDeletes(ctx context.Context, in *PurgeDeletesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PurgeDeletes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PurgeCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2660,6 +4115,13 @@ type DataCoordServer interface {
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	ListSegments(context.Context, *ListSegmentsRequest) (*ListSegmentsResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+	ClusteringCompact(context.Context, *ClusteringCompactRequest) (*commonpb.Status, error)
+	GetDeleteStats(context.Context, *GetDeleteStatsRequest) (*GetDeleteStatsResponse, error)
+	PurgeDeletes(context.Context, *PurgeDeletesRequest) (*commonpb.Status, error)
+	PurgeCollection(context.Context, *PurgeCollectionRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -2712,6 +4174,34 @@ func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvus
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedDataCoordServer) ListSegments(ctx context.Context, req *ListSegmentsRequest) (*ListSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegments not implemented")
+}
+
+func (*UnimplementedDataCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+
+func (*UnimplementedDataCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+
+func (*UnimplementedDataCoordServer) ClusteringCompact(ctx context.Context, req *ClusteringCompactRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusteringCompact not implemented")
+}
+
+func (*UnimplementedDataCoordServer) GetDeleteStats(ctx context.Context, req *GetDeleteStatsRequest) (*GetDeleteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteStats not implemented")
+}
+
+func (*UnimplementedDataCoordServer) PurgeDeletes(ctx context.Context, req *PurgeDeletesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletes not implemented")
+}
+
+func (*UnimplementedDataCoordServer) PurgeCollection(ctx context.Context, req *PurgeCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCollection not implemented")
+}
+
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
 }
@@ -2986,6 +4476,132 @@ func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ListSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ListSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListSegments(ctx, req.(*ListSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ClusteringCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusteringCompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ClusteringCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ClusteringCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ClusteringCompact(ctx, req.(*ClusteringCompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetDeleteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeleteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetDeleteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetDeleteStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetDeleteStats(ctx, req.(*GetDeleteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_PurgeDeletes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).PurgeDeletes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/PurgeDeletes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).PurgeDeletes(ctx, req.(*PurgeDeletesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_PurgeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).PurgeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/PurgeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).PurgeCollection(ctx, req.(*PurgeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -3050,6 +4666,34 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _DataCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "ListSegments",
+			Handler:    _DataCoord_ListSegments_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _DataCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _DataCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "ClusteringCompact",
+			Handler:    _DataCoord_ClusteringCompact_Handler,
+		},
+		{
+			MethodName: "GetDeleteStats",
+			Handler:    _DataCoord_GetDeleteStats_Handler,
+		},
+		{
+			MethodName: "PurgeDeletes",
+			Handler:    _DataCoord_PurgeDeletes_Handler,
+		},
+		{
+			MethodName: "PurgeCollection",
+			Handler:    _DataCoord_PurgeCollection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
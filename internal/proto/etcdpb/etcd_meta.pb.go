@@ -144,6 +144,7 @@ type IndexInfo struct {
 	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	ReplacedIndexID      int64                    `protobuf:"varint,4,opt,name=replaced_indexID,json=replacedIndexID,proto3" json:"replaced_indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -195,6 +196,13 @@ func (m *IndexInfo) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *IndexInfo) GetReplacedIndexID() int64 {
+	if m != nil {
+		return m.ReplacedIndexID
+	}
+	return 0
+}
+
 type FieldIndexInfo struct {
 	FiledID              int64    `protobuf:"varint,1,opt,name=filedID,proto3" json:"filedID,omitempty"`
 	IndexID              int64    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
@@ -253,6 +261,8 @@ type CollectionInfo struct {
 	PhysicalChannelNames       []string                   `protobuf:"bytes,8,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
 	PartitionCreatedTimestamps []uint64                   `protobuf:"varint,9,rep,packed,name=partition_created_timestamps,json=partitionCreatedTimestamps,proto3" json:"partition_created_timestamps,omitempty"`
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	Properties                 []*commonpb.KeyValuePair   `protobuf:"bytes,11,rep,name=properties,proto3" json:"properties,omitempty"`
+	DropTs                     uint64                     `protobuf:"varint,12,opt,name=drop_ts,json=dropTs,proto3" json:"drop_ts,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
 	XXX_unrecognized           []byte                     `json:"-"`
 	XXX_sizecache              int32                      `json:"-"`
@@ -353,6 +363,20 @@ func (m *CollectionInfo) GetShardsNum() int32 {
 	return 0
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetDropTs() uint64 {
+	if m != nil {
+		return m.DropTs
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -519,6 +543,101 @@ func (m *CollectionMeta) GetPartitionIDs() []int64 {
 	return nil
 }
 
+type ApiKeyInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Privileges           []string `protobuf:"bytes,3,rep,name=privileges,proto3" json:"privileges,omitempty"`
+	RateLimit            float64  `protobuf:"fixed64,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	CreateTime           int64    `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	RotateTime           int64    `protobuf:"varint,6,opt,name=rotate_time,json=rotateTime,proto3" json:"rotate_time,omitempty"`
+	PreviousHash         string   `protobuf:"bytes,7,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	PreviousExpireTime   int64    `protobuf:"varint,8,opt,name=previous_expire_time,json=previousExpireTime,proto3" json:"previous_expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiKeyInfo) Reset()         { *m = ApiKeyInfo{} }
+func (m *ApiKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyInfo) ProtoMessage()    {}
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{7}
+}
+
+func (m *ApiKeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyInfo.Unmarshal(m, b)
+}
+func (m *ApiKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyInfo.Marshal(b, m, deterministic)
+}
+func (m *ApiKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyInfo.Merge(m, src)
+}
+func (m *ApiKeyInfo) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyInfo.Size(m)
+}
+func (m *ApiKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyInfo proto.InternalMessageInfo
+
+func (m *ApiKeyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetPrivileges() []string {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
+func (m *ApiKeyInfo) GetRateLimit() float64 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+func (m *ApiKeyInfo) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *ApiKeyInfo) GetRotateTime() int64 {
+	if m != nil {
+		return m.RotateTime
+	}
+	return 0
+}
+
+func (m *ApiKeyInfo) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetPreviousExpireTime() int64 {
+	if m != nil {
+		return m.PreviousExpireTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TenantMeta)(nil), "milvus.proto.etcd.TenantMeta")
 	proto.RegisterType((*ProxyMeta)(nil), "milvus.proto.etcd.ProxyMeta")
@@ -527,55 +646,66 @@ func init() {
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
+	proto.RegisterType((*ApiKeyInfo)(nil), "milvus.proto.etcd.ApiKeyInfo")
 }
 
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xdb, 0x38,
-	0x10, 0x85, 0x22, 0xc7, 0x8e, 0xc6, 0x8e, 0x93, 0x70, 0x3f, 0x40, 0x04, 0xd9, 0x5d, 0x45, 0x40,
-	0xb2, 0x02, 0x16, 0x6b, 0x63, 0x93, 0x45, 0x6f, 0x05, 0xda, 0x46, 0x08, 0x60, 0x14, 0x0d, 0x52,
-	0xc5, 0xe8, 0xa1, 0x17, 0x81, 0x96, 0x68, 0x9b, 0x80, 0x48, 0xb9, 0x22, 0x15, 0xc4, 0xb7, 0x9e,
-	0xfb, 0x13, 0xfa, 0xe7, 0x7a, 0xec, 0xa1, 0x7f, 0xa2, 0x10, 0x29, 0xc9, 0x76, 0xe2, 0x1e, 0x7b,
-	0xd3, 0xbc, 0x99, 0x21, 0xdf, 0xbc, 0x79, 0x14, 0x1c, 0x50, 0x15, 0x27, 0x11, 0xa7, 0x8a, 0x0c,
-	0x16, 0x79, 0xa6, 0x32, 0x74, 0xc4, 0x59, 0x7a, 0x5f, 0x48, 0x13, 0x0d, 0xca, 0xec, 0x71, 0x2f,
-	0xce, 0x38, 0xcf, 0x84, 0x81, 0x8e, 0x7b, 0x32, 0x9e, 0x53, 0x5e, 0x95, 0x7b, 0x9f, 0x2d, 0x80,
-	0x31, 0x15, 0x44, 0xa8, 0x37, 0x54, 0x11, 0xd4, 0x87, 0x9d, 0x51, 0x80, 0x2d, 0xd7, 0xf2, 0xed,
-	0x70, 0x67, 0x14, 0xa0, 0x73, 0x38, 0x10, 0x05, 0x8f, 0x3e, 0x14, 0x34, 0x5f, 0x46, 0x22, 0x4b,
-	0xa8, 0xc4, 0x3b, 0x3a, 0xb9, 0x2f, 0x0a, 0xfe, 0xb6, 0x44, 0x6f, 0x4a, 0x10, 0xfd, 0x03, 0x47,
-	0x4c, 0x48, 0x9a, 0xab, 0x28, 0x9e, 0x13, 0x21, 0x68, 0x3a, 0x0a, 0x24, 0xb6, 0x5d, 0xdb, 0x77,
-	0xc2, 0x43, 0x93, 0xb8, 0x6a, 0x70, 0xf4, 0x37, 0x1c, 0x98, 0x03, 0x9b, 0x5a, 0xdc, 0x72, 0x2d,
-	0xdf, 0x09, 0xfb, 0x1a, 0x6e, 0x2a, 0xbd, 0x8f, 0x16, 0x38, 0xb7, 0x79, 0xf6, 0xb0, 0xdc, 0xca,
-	0xed, 0x19, 0x74, 0x48, 0x92, 0xe4, 0x54, 0x1a, 0x4e, 0xdd, 0x8b, 0x93, 0xc1, 0xc6, 0xec, 0xd5,
-	0xd4, 0x2f, 0x4d, 0x4d, 0x58, 0x17, 0x97, 0x5c, 0x73, 0x2a, 0x8b, 0x74, 0x1b, 0x57, 0x93, 0x58,
-	0x71, 0xf5, 0x3e, 0x59, 0xe0, 0x8c, 0x44, 0x42, 0x1f, 0x46, 0x62, 0x9a, 0xa1, 0x3f, 0x00, 0x58,
-	0x19, 0x44, 0x82, 0x70, 0xaa, 0xa9, 0x38, 0xa1, 0xa3, 0x91, 0x1b, 0xc2, 0x29, 0xc2, 0xd0, 0xd1,
-	0xc1, 0x28, 0xa8, 0x54, 0xaa, 0x43, 0x14, 0x40, 0xcf, 0x34, 0x2e, 0x48, 0x4e, 0xb8, 0xb9, 0xae,
-	0x7b, 0x71, 0xba, 0x95, 0xf0, 0x6b, 0xba, 0x7c, 0x47, 0xd2, 0x82, 0xde, 0x12, 0x96, 0x87, 0x5d,
-	0xdd, 0x76, 0xab, 0xbb, 0xbc, 0x00, 0xfa, 0xd7, 0x8c, 0xa6, 0xc9, 0x8a, 0x10, 0x86, 0xce, 0x94,
-	0xa5, 0x34, 0x69, 0x84, 0xa9, 0xc3, 0x1f, 0x73, 0xf1, 0xbe, 0xd8, 0xd0, 0xbf, 0xca, 0xd2, 0x94,
-	0xc6, 0x8a, 0x65, 0x42, 0x1f, 0xf3, 0x58, 0xda, 0xe7, 0xd0, 0x36, 0x2e, 0xa9, 0x94, 0x3d, 0xdb,
-	0x24, 0x5a, 0x39, 0x68, 0x75, 0xc8, 0x9d, 0x06, 0xc2, 0xaa, 0x09, 0xfd, 0x05, 0xdd, 0x38, 0xa7,
-	0x44, 0xd1, 0x48, 0x31, 0x4e, 0xb1, 0xed, 0x5a, 0x7e, 0x2b, 0x04, 0x03, 0x8d, 0x19, 0xa7, 0xc8,
-	0x83, 0xde, 0x82, 0xe4, 0x8a, 0x69, 0x02, 0x81, 0xc4, 0x2d, 0xd7, 0xf6, 0xed, 0x70, 0x03, 0x43,
-	0xe7, 0xd0, 0x6f, 0xe2, 0x52, 0x5d, 0x89, 0x77, 0xf5, 0x8e, 0x1e, 0xa1, 0xe8, 0x1a, 0xf6, 0xa7,
-	0xa5, 0x28, 0x91, 0x9e, 0x8f, 0x4a, 0xdc, 0xde, 0xa6, 0x6d, 0xf9, 0x10, 0x06, 0x9b, 0xe2, 0x85,
-	0xbd, 0x69, 0x13, 0x53, 0x89, 0x2e, 0xe0, 0xb7, 0x7b, 0x96, 0xab, 0x82, 0xa4, 0xb5, 0x2f, 0xf4,
-	0x96, 0x25, 0xee, 0xe8, 0x6b, 0x7f, 0xa9, 0x92, 0x95, 0x37, 0xcc, 0xdd, 0xff, 0xc3, 0xef, 0x8b,
-	0xf9, 0x52, 0xb2, 0xf8, 0x49, 0xd3, 0x9e, 0x6e, 0xfa, 0xb5, 0xce, 0x6e, 0x74, 0xbd, 0x80, 0x93,
-	0x66, 0x86, 0xc8, 0xa8, 0x92, 0x68, 0xa5, 0xa4, 0x22, 0x7c, 0x21, 0xb1, 0xe3, 0xda, 0x7e, 0x2b,
-	0x3c, 0x6e, 0x6a, 0xae, 0x4c, 0xc9, 0xb8, 0xa9, 0x28, 0x7d, 0x28, 0xe7, 0x24, 0x4f, 0x64, 0x24,
-	0x0a, 0x8e, 0xc1, 0xb5, 0xfc, 0xdd, 0xd0, 0x31, 0xc8, 0x4d, 0xc1, 0xbd, 0xaf, 0x16, 0x1c, 0xde,
-	0xd1, 0x19, 0xa7, 0x42, 0xad, 0xac, 0xe2, 0x41, 0x2f, 0x5e, 0x6d, 0xbd, 0xde, 0xf6, 0x06, 0x86,
-	0x5c, 0xe8, 0xae, 0xed, 0xa0, 0x32, 0xce, 0x3a, 0x84, 0x4e, 0xc0, 0x91, 0xd5, 0xc9, 0x81, 0x5e,
-	0xac, 0x1d, 0xae, 0x00, 0x63, 0xc7, 0x52, 0x53, 0xf3, 0xa2, 0xb5, 0x1d, 0x75, 0xb8, 0x6e, 0xc7,
-	0xdd, 0xcd, 0xa7, 0x81, 0xa1, 0x33, 0x29, 0x98, 0xee, 0x69, 0x9b, 0x4c, 0x15, 0xa2, 0x53, 0xe8,
-	0x51, 0x41, 0x26, 0x29, 0x35, 0xab, 0xc5, 0x1d, 0xd7, 0xf2, 0xf7, 0xc2, 0xae, 0xc1, 0xf4, 0x60,
-	0xde, 0x37, 0x6b, 0xdd, 0xcb, 0x5b, 0x7f, 0x13, 0x3f, 0xdb, 0xcb, 0x7f, 0x02, 0x34, 0x02, 0xd4,
-	0x4e, 0x5e, 0x43, 0xd0, 0xd9, 0x9a, 0x8f, 0x23, 0x45, 0x66, 0xb5, 0x8f, 0xf7, 0x1b, 0x74, 0x4c,
-	0x66, 0xf2, 0xc9, 0x93, 0x68, 0x3f, 0x7d, 0x12, 0xaf, 0x2e, 0xdf, 0xff, 0x37, 0x63, 0x6a, 0x5e,
-	0x4c, 0xca, 0x5f, 0xc5, 0xd0, 0x8c, 0xf1, 0x2f, 0xcb, 0xaa, 0xaf, 0x21, 0x13, 0x8a, 0xe6, 0x82,
-	0xa4, 0x43, 0x3d, 0xd9, 0xb0, 0xb4, 0xfc, 0x62, 0x32, 0x69, 0xeb, 0xe8, 0xf2, 0x7b, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x4a, 0x04, 0x49, 0x0f, 0x2a, 0x06, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xd1, 0x6e, 0xe3, 0x44,
+	0x17, 0x96, 0x9b, 0x34, 0xa9, 0x4f, 0xd2, 0xb4, 0x3b, 0xff, 0xfe, 0x60, 0x55, 0x85, 0xf5, 0x1a,
+	0xed, 0x12, 0x84, 0x68, 0xa1, 0x8b, 0xb8, 0x43, 0xa2, 0xd4, 0xac, 0x88, 0x16, 0xaa, 0xe2, 0x8d,
+	0xb8, 0xe0, 0xc6, 0x9a, 0xd8, 0xa7, 0xc9, 0x48, 0xf6, 0xd8, 0xcc, 0x8c, 0xab, 0xe6, 0x8e, 0x07,
+	0xe0, 0x09, 0x78, 0x0f, 0xae, 0x78, 0x20, 0x2e, 0x78, 0x09, 0x34, 0x33, 0xb6, 0xe3, 0xb4, 0x41,
+	0xe2, 0x86, 0x3b, 0x9f, 0xef, 0x9c, 0x33, 0xf3, 0xcd, 0xf9, 0xbe, 0x63, 0x38, 0x42, 0x95, 0xa4,
+	0x71, 0x8e, 0x8a, 0x9e, 0x95, 0xa2, 0x50, 0x05, 0x79, 0x92, 0xb3, 0xec, 0xae, 0x92, 0x36, 0x3a,
+	0xd3, 0xd9, 0x93, 0x71, 0x52, 0xe4, 0x79, 0xc1, 0x2d, 0x74, 0x32, 0x96, 0xc9, 0x0a, 0xf3, 0xba,
+	0x3c, 0xf8, 0xcd, 0x01, 0x98, 0x23, 0xa7, 0x5c, 0x7d, 0x8f, 0x8a, 0x92, 0x09, 0xec, 0xcd, 0x42,
+	0xcf, 0xf1, 0x9d, 0x69, 0x2f, 0xda, 0x9b, 0x85, 0xe4, 0x25, 0x1c, 0xf1, 0x2a, 0x8f, 0x7f, 0xae,
+	0x50, 0xac, 0x63, 0x5e, 0xa4, 0x28, 0xbd, 0x3d, 0x93, 0x3c, 0xe4, 0x55, 0xfe, 0x83, 0x46, 0xaf,
+	0x35, 0x48, 0x3e, 0x86, 0x27, 0x8c, 0x4b, 0x14, 0x2a, 0x4e, 0x56, 0x94, 0x73, 0xcc, 0x66, 0xa1,
+	0xf4, 0x7a, 0x7e, 0x6f, 0xea, 0x46, 0xc7, 0x36, 0x71, 0xd5, 0xe2, 0xe4, 0x43, 0x38, 0xb2, 0x07,
+	0xb6, 0xb5, 0x5e, 0xdf, 0x77, 0xa6, 0x6e, 0x34, 0x31, 0x70, 0x5b, 0x19, 0xfc, 0xe2, 0x80, 0x7b,
+	0x23, 0x8a, 0xfb, 0xf5, 0x4e, 0x6e, 0x5f, 0xc0, 0x90, 0xa6, 0xa9, 0x40, 0x69, 0x39, 0x8d, 0x2e,
+	0x4e, 0xcf, 0xb6, 0xde, 0x5e, 0xbf, 0xfa, 0xd2, 0xd6, 0x44, 0x4d, 0xb1, 0xe6, 0x2a, 0x50, 0x56,
+	0xd9, 0x2e, 0xae, 0x36, 0xb1, 0xe1, 0x1a, 0xfc, 0xee, 0x80, 0x3b, 0xe3, 0x29, 0xde, 0xcf, 0xf8,
+	0x6d, 0x41, 0xde, 0x03, 0x60, 0x3a, 0x88, 0x39, 0xcd, 0xd1, 0x50, 0x71, 0x23, 0xd7, 0x20, 0xd7,
+	0x34, 0x47, 0xe2, 0xc1, 0xd0, 0x04, 0xb3, 0xb0, 0x9e, 0x52, 0x13, 0x92, 0x10, 0xc6, 0xb6, 0xb1,
+	0xa4, 0x82, 0xe6, 0xf6, 0xba, 0xd1, 0xc5, 0xf3, 0x9d, 0x84, 0xdf, 0xe0, 0xfa, 0x47, 0x9a, 0x55,
+	0x78, 0x43, 0x99, 0x88, 0x46, 0xa6, 0xed, 0xc6, 0x74, 0x91, 0x8f, 0xe0, 0x58, 0x60, 0x99, 0xd1,
+	0x04, 0xd3, 0xb8, 0xb9, 0xa8, 0x6f, 0x2e, 0x3a, 0x6a, 0x70, 0xcb, 0x35, 0x0c, 0x42, 0x98, 0xbc,
+	0x66, 0x98, 0xa5, 0x1b, 0xee, 0x1e, 0x0c, 0x6f, 0x59, 0x86, 0x69, 0x3b, 0xc3, 0x26, 0xfc, 0x67,
+	0xda, 0xc1, 0x1f, 0x7d, 0x98, 0x5c, 0x15, 0x59, 0x86, 0x89, 0x62, 0x05, 0x37, 0xc7, 0x3c, 0x54,
+	0xe1, 0x4b, 0x18, 0x58, 0x43, 0xd5, 0x22, 0xbc, 0xd8, 0x7e, 0x53, 0x6d, 0xb6, 0xcd, 0x21, 0x6f,
+	0x0d, 0x10, 0xd5, 0x4d, 0xe4, 0x19, 0x8c, 0x12, 0x81, 0x54, 0x61, 0xac, 0x58, 0x8e, 0x5e, 0xcf,
+	0x77, 0xa6, 0xfd, 0x08, 0x2c, 0x34, 0x67, 0x39, 0x92, 0x00, 0xc6, 0x25, 0x15, 0x8a, 0x19, 0x02,
+	0xa1, 0xf4, 0xfa, 0x7e, 0x6f, 0xda, 0x8b, 0xb6, 0x30, 0xf2, 0x12, 0x26, 0x6d, 0xac, 0x85, 0x90,
+	0xde, 0xbe, 0x91, 0xf3, 0x01, 0x4a, 0x5e, 0xc3, 0xe1, 0xad, 0x1e, 0x8a, 0x1d, 0x1e, 0x4a, 0x6f,
+	0xb0, 0x4b, 0x06, 0xbd, 0x33, 0x67, 0xdb, 0xc3, 0x8b, 0xc6, 0xb7, 0x6d, 0x8c, 0x92, 0x5c, 0xc0,
+	0xff, 0xef, 0x98, 0x50, 0x15, 0xcd, 0x1a, 0x0b, 0x19, 0x43, 0x48, 0x6f, 0x68, 0xae, 0xfd, 0x5f,
+	0x9d, 0xac, 0x6d, 0x64, 0xef, 0xfe, 0x1c, 0xde, 0x29, 0x57, 0x6b, 0xc9, 0x92, 0x47, 0x4d, 0x07,
+	0xa6, 0xe9, 0x69, 0x93, 0xdd, 0xea, 0xfa, 0x0a, 0x4e, 0xdb, 0x37, 0xc4, 0x76, 0x2a, 0xa9, 0x99,
+	0x94, 0x54, 0x34, 0x2f, 0xa5, 0xe7, 0xfa, 0xbd, 0x69, 0x3f, 0x3a, 0x69, 0x6b, 0xae, 0x6c, 0xc9,
+	0xbc, 0xad, 0xd0, 0x96, 0x95, 0x2b, 0x2a, 0x52, 0x19, 0xf3, 0x2a, 0xf7, 0xc0, 0x77, 0xa6, 0xfb,
+	0x91, 0x6b, 0x91, 0xeb, 0x2a, 0x27, 0x97, 0x00, 0xa5, 0x28, 0x4a, 0x14, 0x8a, 0xa1, 0xf4, 0x46,
+	0xff, 0xd6, 0x96, 0x9d, 0x26, 0xf2, 0x2e, 0x0c, 0x53, 0x51, 0x94, 0xb1, 0x92, 0xde, 0xd8, 0xc8,
+	0x37, 0xd0, 0xe1, 0x5c, 0x06, 0x7f, 0x3a, 0x70, 0xfc, 0x16, 0x97, 0x39, 0x72, 0xb5, 0xb1, 0x61,
+	0x00, 0xe3, 0x64, 0xe3, 0xa8, 0xc6, 0x49, 0x5b, 0x18, 0xf1, 0x61, 0xd4, 0xd1, 0xb7, 0x36, 0x65,
+	0x17, 0x22, 0xa7, 0xe0, 0xca, 0xfa, 0xe4, 0xd0, 0x98, 0xa6, 0x17, 0x6d, 0x00, 0x6b, 0x75, 0xad,
+	0x57, 0xb3, 0x1e, 0x4d, 0xd8, 0xb5, 0xfa, 0xfe, 0xf6, 0x86, 0x7a, 0x30, 0x5c, 0x54, 0xcc, 0xf4,
+	0x0c, 0x6c, 0xa6, 0x0e, 0xc9, 0x73, 0x18, 0x23, 0xa7, 0x8b, 0x0c, 0xad, 0x6d, 0xbc, 0xa1, 0xef,
+	0x4c, 0x0f, 0xa2, 0x91, 0xc5, 0xcc, 0xc3, 0x82, 0xbf, 0x9c, 0xee, 0x9e, 0xec, 0xfc, 0x5b, 0xfd,
+	0xd7, 0x7b, 0xf2, 0x3e, 0x40, 0x3b, 0x80, 0x66, 0x4b, 0x3a, 0x08, 0x79, 0xd1, 0xd9, 0x91, 0x58,
+	0xd1, 0x65, 0xb3, 0x23, 0x87, 0x2d, 0x3a, 0xa7, 0x4b, 0xf9, 0x68, 0xdd, 0x06, 0x8f, 0xd7, 0x2d,
+	0xf8, 0x75, 0x0f, 0xe0, 0xb2, 0x64, 0x6f, 0x70, 0x6d, 0x14, 0x25, 0xd0, 0xef, 0xfc, 0x0e, 0xcd,
+	0xb7, 0xc6, 0x56, 0x54, 0xae, 0xcc, 0x5b, 0xdd, 0xc8, 0x7c, 0x6b, 0x86, 0xa5, 0x60, 0x77, 0x2c,
+	0xc3, 0x25, 0x36, 0x3f, 0xdc, 0x0e, 0xa2, 0x9d, 0x2a, 0xf4, 0x03, 0x33, 0x96, 0x33, 0x65, 0x84,
+	0x73, 0x22, 0x57, 0x23, 0xdf, 0x69, 0xe0, 0xe1, 0x04, 0xac, 0x7c, 0xdd, 0x09, 0x3c, 0x83, 0x91,
+	0x28, 0x54, 0x5b, 0x60, 0x55, 0x04, 0x0b, 0x99, 0x82, 0x0f, 0xe0, 0xb0, 0x14, 0x78, 0xc7, 0x8a,
+	0x4a, 0xc6, 0x86, 0xdd, 0xd0, 0xb0, 0x1b, 0x37, 0xe0, 0xb7, 0x9a, 0xe5, 0xa7, 0xf0, 0xb4, 0x2d,
+	0xc2, 0xfb, 0x92, 0x89, 0xfa, 0xb8, 0x03, 0x73, 0x1c, 0x69, 0x72, 0xdf, 0x98, 0x94, 0x3e, 0xf6,
+	0xeb, 0x57, 0x3f, 0x7d, 0xb6, 0x64, 0x6a, 0x55, 0x2d, 0xf4, 0xa6, 0x9c, 0x5b, 0x55, 0x3f, 0x61,
+	0x45, 0xfd, 0x75, 0xce, 0xb8, 0x42, 0xc1, 0x69, 0x76, 0x6e, 0x84, 0x3e, 0xd7, 0x7f, 0x97, 0x72,
+	0xb1, 0x18, 0x98, 0xe8, 0xd5, 0xdf, 0x03, 0x00, 0xd6, 0xc7, 0x46, 0xc4, 0xc0, 0x07, 0x00, 0x00,
 }
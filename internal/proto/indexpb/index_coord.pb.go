@@ -27,6 +27,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type IndexFailClass int32
+
+const (
+	IndexFailClass_FailClassNone    IndexFailClass = 0
+	IndexFailClass_Transient        IndexFailClass = 1
+	IndexFailClass_OutOfMemory      IndexFailClass = 2
+	IndexFailClass_CorruptBinlog    IndexFailClass = 3
+	IndexFailClass_UnsupportedParam IndexFailClass = 4
+)
+
+var IndexFailClass_name = map[int32]string{
+	0: "FailClassNone",
+	1: "Transient",
+	2: "OutOfMemory",
+	3: "CorruptBinlog",
+	4: "UnsupportedParam",
+}
+
+var IndexFailClass_value = map[string]int32{
+	"FailClassNone":    0,
+	"Transient":        1,
+	"OutOfMemory":      2,
+	"CorruptBinlog":    3,
+	"UnsupportedParam": 4,
+}
+
+func (x IndexFailClass) String() string {
+	return proto.EnumName(IndexFailClass_name, int32(x))
+}
+
+func (IndexFailClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{0}
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -174,6 +208,7 @@ type IndexInfo struct {
 	IndexID              int64               `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	IndexName            string              `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	Reason               string              `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	FailClass            IndexFailClass      `protobuf:"varint,6,opt,name=fail_class,json=failClass,proto3,enum=milvus.proto.index.IndexFailClass" json:"fail_class,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -239,6 +274,13 @@ func (m *IndexInfo) GetReason() string {
 	return ""
 }
 
+func (m *IndexInfo) GetFailClass() IndexFailClass {
+	if m != nil {
+		return m.FailClass
+	}
+	return IndexFailClass_FailClassNone
+}
+
 type GetIndexStatesResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	States               []*IndexInfo     `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
@@ -388,6 +430,9 @@ type BuildIndexRequest struct {
 	DataPaths            []string                 `protobuf:"bytes,5,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	NumRows              int64                    `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	CollectionID         int64                    `protobuf:"varint,9,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	FieldID              int64                    `protobuf:"varint,10,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -460,6 +505,27 @@ func (m *BuildIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *BuildIndexRequest) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BuildIndexRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type BuildIndexResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
@@ -658,6 +724,12 @@ type IndexMeta struct {
 	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Version              int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	CreateTime           int64               `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	FormatVersion        int32               `protobuf:"varint,11,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	FailClass            IndexFailClass      `protobuf:"varint,12,opt,name=fail_class,json=failClass,proto3,enum=milvus.proto.index.IndexFailClass" json:"fail_class,omitempty"`
+	RetryCount           int32               `protobuf:"varint,13,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	RetryTime            int64               `protobuf:"varint,14,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`
+	SerializedSize       int64               `protobuf:"varint,15,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -751,6 +823,48 @@ func (m *IndexMeta) GetRecycled() bool {
 	return false
 }
 
+func (m *IndexMeta) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetFormatVersion() int32 {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetFailClass() IndexFailClass {
+	if m != nil {
+		return m.FailClass
+	}
+	return IndexFailClass_FailClassNone
+}
+
+func (m *IndexMeta) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetRetryTime() int64 {
+	if m != nil {
+		return m.RetryTime
+	}
+	return 0
+}
+
+func (m *IndexMeta) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -790,7 +904,471 @@ func (m *DropIndexRequest) GetIndexID() int64 {
 	return 0
 }
 
+type GetTaskSlotsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTaskSlotsRequest) Reset()         { *m = GetTaskSlotsRequest{} }
+func (m *GetTaskSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskSlotsRequest) ProtoMessage()    {}
+func (*GetTaskSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{13}
+}
+
+func (m *GetTaskSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaskSlotsRequest.Unmarshal(m, b)
+}
+func (m *GetTaskSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaskSlotsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTaskSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskSlotsRequest.Merge(m, src)
+}
+func (m *GetTaskSlotsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTaskSlotsRequest.Size(m)
+}
+func (m *GetTaskSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskSlotsRequest proto.InternalMessageInfo
+
+func (m *GetTaskSlotsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetTaskSlotsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Slots                int64            `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	MemorySize           int64            `protobuf:"varint,3,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTaskSlotsResponse) Reset()         { *m = GetTaskSlotsResponse{} }
+func (m *GetTaskSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskSlotsResponse) ProtoMessage()    {}
+func (*GetTaskSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{14}
+}
+
+func (m *GetTaskSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaskSlotsResponse.Unmarshal(m, b)
+}
+func (m *GetTaskSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaskSlotsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTaskSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskSlotsResponse.Merge(m, src)
+}
+func (m *GetTaskSlotsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTaskSlotsResponse.Size(m)
+}
+func (m *GetTaskSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskSlotsResponse proto.InternalMessageInfo
+
+func (m *GetTaskSlotsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetTaskSlotsResponse) GetSlots() int64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *GetTaskSlotsResponse) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
+type PauseIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PauseIndexBuildRequest) Reset()         { *m = PauseIndexBuildRequest{} }
+func (m *PauseIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseIndexBuildRequest) ProtoMessage()    {}
+func (*PauseIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{15}
+}
+
+func (m *PauseIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *PauseIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseIndexBuildRequest.Merge(m, src)
+}
+func (m *PauseIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseIndexBuildRequest.Size(m)
+}
+func (m *PauseIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseIndexBuildRequest proto.InternalMessageInfo
+
+func (m *PauseIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PauseIndexBuildRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ResumeIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeIndexBuildRequest) Reset()         { *m = ResumeIndexBuildRequest{} }
+func (m *ResumeIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeIndexBuildRequest) ProtoMessage()    {}
+func (*ResumeIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{16}
+}
+
+func (m *ResumeIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *ResumeIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeIndexBuildRequest.Merge(m, src)
+}
+func (m *ResumeIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeIndexBuildRequest.Size(m)
+}
+func (m *ResumeIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeIndexBuildRequest proto.InternalMessageInfo
+
+func (m *ResumeIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ResumeIndexBuildRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type SetIndexBuildPriorityRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Priority             int32             `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetIndexBuildPriorityRequest) Reset()         { *m = SetIndexBuildPriorityRequest{} }
+func (m *SetIndexBuildPriorityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetIndexBuildPriorityRequest) ProtoMessage()    {}
+func (*SetIndexBuildPriorityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{17}
+}
+
+func (m *SetIndexBuildPriorityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetIndexBuildPriorityRequest.Unmarshal(m, b)
+}
+func (m *SetIndexBuildPriorityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetIndexBuildPriorityRequest.Marshal(b, m, deterministic)
+}
+func (m *SetIndexBuildPriorityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIndexBuildPriorityRequest.Merge(m, src)
+}
+func (m *SetIndexBuildPriorityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetIndexBuildPriorityRequest.Size(m)
+}
+func (m *SetIndexBuildPriorityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIndexBuildPriorityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIndexBuildPriorityRequest proto.InternalMessageInfo
+
+func (m *SetIndexBuildPriorityRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetIndexBuildPriorityRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetIndexBuildPriorityRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type CollectionBuildControl struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Priority             int32    `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionBuildControl) Reset()         { *m = CollectionBuildControl{} }
+func (m *CollectionBuildControl) String() string { return proto.CompactTextString(m) }
+func (*CollectionBuildControl) ProtoMessage()    {}
+func (*CollectionBuildControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{18}
+}
+
+func (m *CollectionBuildControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionBuildControl.Unmarshal(m, b)
+}
+func (m *CollectionBuildControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionBuildControl.Marshal(b, m, deterministic)
+}
+func (m *CollectionBuildControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionBuildControl.Merge(m, src)
+}
+func (m *CollectionBuildControl) XXX_Size() int {
+	return xxx_messageInfo_CollectionBuildControl.Size(m)
+}
+func (m *CollectionBuildControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionBuildControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionBuildControl proto.InternalMessageInfo
+
+func (m *CollectionBuildControl) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionBuildControl) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *CollectionBuildControl) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type GetIndexStatisticsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetIndexStatisticsRequest) Reset()         { *m = GetIndexStatisticsRequest{} }
+func (m *GetIndexStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsRequest) ProtoMessage()    {}
+func (*GetIndexStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{19}
+}
+
+func (m *GetIndexStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetIndexStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetIndexStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsRequest.Merge(m, src)
+}
+func (m *GetIndexStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Size(m)
+}
+func (m *GetIndexStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetIndexStatisticsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetIndexStatisticsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type IndexStatistics struct {
+	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName            string   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	FieldID              int64    `protobuf:"varint,3,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	FinishedCount        int64    `protobuf:"varint,4,opt,name=finished_count,json=finishedCount,proto3" json:"finished_count,omitempty"`
+	SerializedSize       int64    `protobuf:"varint,5,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexStatistics) Reset()         { *m = IndexStatistics{} }
+func (m *IndexStatistics) String() string { return proto.CompactTextString(m) }
+func (*IndexStatistics) ProtoMessage()    {}
+func (*IndexStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{20}
+}
+
+func (m *IndexStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexStatistics.Unmarshal(m, b)
+}
+func (m *IndexStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexStatistics.Marshal(b, m, deterministic)
+}
+func (m *IndexStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexStatistics.Merge(m, src)
+}
+func (m *IndexStatistics) XXX_Size() int {
+	return xxx_messageInfo_IndexStatistics.Size(m)
+}
+func (m *IndexStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexStatistics proto.InternalMessageInfo
+
+func (m *IndexStatistics) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexStatistics) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetFinishedCount() int64 {
+	if m != nil {
+		return m.FinishedCount
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+type GetIndexStatisticsResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Statistics           []*IndexStatistics `protobuf:"bytes,2,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetIndexStatisticsResponse) Reset()         { *m = GetIndexStatisticsResponse{} }
+func (m *GetIndexStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsResponse) ProtoMessage()    {}
+func (*GetIndexStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{21}
+}
+
+func (m *GetIndexStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetIndexStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsResponse.Merge(m, src)
+}
+func (m *GetIndexStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Size(m)
+}
+func (m *GetIndexStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetIndexStatisticsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetIndexStatisticsResponse) GetStatistics() []*IndexStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("milvus.proto.index.IndexFailClass", IndexFailClass_name, IndexFailClass_value)
 	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
 	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
 	proto.RegisterType((*GetIndexStatesRequest)(nil), "milvus.proto.index.GetIndexStatesRequest")
@@ -804,73 +1382,115 @@ func init() {
 	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
 	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*GetTaskSlotsRequest)(nil), "milvus.proto.index.GetTaskSlotsRequest")
+	proto.RegisterType((*GetTaskSlotsResponse)(nil), "milvus.proto.index.GetTaskSlotsResponse")
+	proto.RegisterType((*PauseIndexBuildRequest)(nil), "milvus.proto.index.PauseIndexBuildRequest")
+	proto.RegisterType((*ResumeIndexBuildRequest)(nil), "milvus.proto.index.ResumeIndexBuildRequest")
+	proto.RegisterType((*SetIndexBuildPriorityRequest)(nil), "milvus.proto.index.SetIndexBuildPriorityRequest")
+	proto.RegisterType((*CollectionBuildControl)(nil), "milvus.proto.index.CollectionBuildControl")
+	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.index.GetIndexStatisticsRequest")
+	proto.RegisterType((*IndexStatistics)(nil), "milvus.proto.index.IndexStatistics")
+	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.index.GetIndexStatisticsResponse")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xf9, 0x12, 0x3b, 0x1e, 0x87, 0xa8, 0x59, 0x4a, 0x75, 0xb8, 0x54, 0x75, 0x8e, 0x52,
-	0x0c, 0x6a, 0x9d, 0xca, 0xa5, 0xf0, 0x84, 0x04, 0x89, 0x45, 0x64, 0xa1, 0x54, 0xd1, 0x36, 0xe2,
-	0x01, 0x09, 0x59, 0x1b, 0xdf, 0x24, 0x59, 0xf5, 0xfe, 0xe5, 0x76, 0x5d, 0x91, 0x77, 0xde, 0x79,
-	0x2b, 0xe2, 0x93, 0xf0, 0x39, 0xfa, 0xcc, 0x97, 0x41, 0xbb, 0xb7, 0x77, 0xb9, 0x3b, 0x9f, 0x53,
-	0x87, 0x50, 0x78, 0xe1, 0xed, 0x66, 0xf6, 0x37, 0x33, 0x3b, 0xbf, 0x9d, 0xfd, 0xdd, 0xc2, 0x16,
-	0x0f, 0x3d, 0xfc, 0x79, 0x32, 0x8d, 0xa2, 0xc4, 0x1b, 0xc4, 0x49, 0x24, 0x23, 0x42, 0x02, 0xee,
-	0xbf, 0x9a, 0x89, 0xd4, 0x1a, 0xe8, 0xf5, 0xee, 0xc6, 0x34, 0x0a, 0x82, 0x28, 0x4c, 0x7d, 0xdd,
-	0x4d, 0x1e, 0x4a, 0x4c, 0x42, 0xe6, 0x1b, 0x7b, 0xa3, 0x18, 0xe1, 0xfe, 0x66, 0xc1, 0xfb, 0x14,
-	0x4f, 0xb9, 0x90, 0x98, 0x3c, 0x8f, 0x3c, 0xa4, 0x78, 0x3e, 0x43, 0x21, 0xc9, 0x13, 0x58, 0x3d,
-	0x66, 0x02, 0x1d, 0xab, 0x67, 0xf5, 0x3b, 0xc3, 0x8f, 0x06, 0xa5, 0x32, 0x26, 0xff, 0x81, 0x38,
-	0xdd, 0x65, 0x02, 0xa9, 0x46, 0x92, 0x2f, 0xa1, 0xc5, 0x3c, 0x2f, 0x41, 0x21, 0x9c, 0xc6, 0x15,
-	0x41, 0xdf, 0xa6, 0x18, 0x9a, 0x81, 0xc9, 0x1d, 0x68, 0x86, 0x91, 0x87, 0xe3, 0x91, 0x63, 0xf7,
-	0xac, 0xbe, 0x4d, 0x8d, 0xe5, 0xfe, 0x6a, 0xc1, 0xed, 0xf2, 0xce, 0x44, 0x1c, 0x85, 0x02, 0xc9,
-	0x53, 0x68, 0x0a, 0xc9, 0xe4, 0x4c, 0x98, 0xcd, 0xdd, 0xad, 0xad, 0xf3, 0x42, 0x43, 0xa8, 0x81,
-	0x92, 0x5d, 0xe8, 0xf0, 0x90, 0xcb, 0x49, 0xcc, 0x12, 0x16, 0x64, 0x3b, 0xdc, 0x1e, 0x54, 0xd8,
-	0x33, 0x44, 0x8d, 0x43, 0x2e, 0x0f, 0x35, 0x90, 0x02, 0xcf, 0xbf, 0xdd, 0xaf, 0xe1, 0x83, 0x7d,
-	0x94, 0x63, 0xc5, 0xb1, 0xca, 0x8e, 0x22, 0x23, 0xeb, 0x01, 0xbc, 0xa7, 0x99, 0xdf, 0x9d, 0x71,
-	0xdf, 0x1b, 0x8f, 0xd4, 0xc6, 0xec, 0xbe, 0x4d, 0xcb, 0x4e, 0xf7, 0x0f, 0x0b, 0xda, 0x3a, 0x78,
-	0x1c, 0x9e, 0x44, 0xe4, 0x19, 0xac, 0xa9, 0xad, 0xa5, 0x0c, 0x6f, 0x0e, 0xef, 0xd7, 0x36, 0x71,
-	0x59, 0x8b, 0xa6, 0x68, 0xe2, 0xc2, 0x46, 0x31, 0xab, 0x6e, 0xc4, 0xa6, 0x25, 0x1f, 0x71, 0xa0,
-	0xa5, 0xed, 0x9c, 0xd2, 0xcc, 0x24, 0xf7, 0x00, 0xd2, 0x11, 0x0a, 0x59, 0x80, 0xce, 0x6a, 0xcf,
-	0xea, 0xb7, 0x69, 0x5b, 0x7b, 0x9e, 0xb3, 0x00, 0xd5, 0x51, 0x24, 0xc8, 0x44, 0x14, 0x3a, 0x6b,
-	0x7a, 0xc9, 0x58, 0xee, 0x2f, 0x16, 0xdc, 0xa9, 0x76, 0x7e, 0x93, 0xc3, 0x78, 0x96, 0x06, 0xa1,
-	0x3a, 0x07, 0xbb, 0xdf, 0x19, 0xde, 0x1b, 0xcc, 0x4f, 0xf1, 0x20, 0xa7, 0x8a, 0x1a, 0xb0, 0xfb,
-	0xa6, 0x01, 0x64, 0x2f, 0x41, 0x26, 0x51, 0xaf, 0x65, 0xec, 0x57, 0x29, 0xb1, 0x6a, 0x28, 0x29,
-	0x37, 0xde, 0xa8, 0x36, 0xbe, 0x98, 0x31, 0x07, 0x5a, 0xaf, 0x30, 0x11, 0x3c, 0x0a, 0x35, 0x5d,
-	0x36, 0xcd, 0x4c, 0x72, 0x17, 0xda, 0x01, 0x4a, 0x36, 0x89, 0x99, 0x3c, 0x33, 0x7c, 0xad, 0x2b,
-	0xc7, 0x21, 0x93, 0x67, 0xaa, 0x9e, 0xc7, 0xcc, 0xa2, 0x70, 0x9a, 0x3d, 0x5b, 0xd5, 0x53, 0x1e,
-	0xb5, 0xaa, 0xa7, 0x51, 0x5e, 0xc4, 0x98, 0x4d, 0x63, 0x4b, 0xb3, 0xb0, 0x5d, 0x4b, 0xdd, 0xf7,
-	0x78, 0xf1, 0x03, 0xf3, 0x67, 0x78, 0xc8, 0x78, 0x42, 0x41, 0x45, 0xa5, 0xd3, 0x48, 0x46, 0xa6,
-	0xed, 0x2c, 0xc9, 0xfa, 0xb2, 0x49, 0x3a, 0x3a, 0xcc, 0xcc, 0xf4, 0xef, 0x0d, 0xd8, 0x4a, 0x49,
-	0xfa, 0xd7, 0x28, 0x2d, 0x73, 0xb3, 0xf6, 0x16, 0x6e, 0x9a, 0xff, 0x04, 0x37, 0xad, 0xbf, 0xc5,
-	0x4d, 0x00, 0xa4, 0x48, 0xcd, 0x4d, 0x26, 0x7e, 0x89, 0x6b, 0xeb, 0x7e, 0x03, 0x4e, 0x76, 0xc9,
-	0xbe, 0xe3, 0x3e, 0x6a, 0x36, 0xae, 0xa7, 0x30, 0xaf, 0x2d, 0xd8, 0x2a, 0xc5, 0x6b, 0xa5, 0x79,
-	0x57, 0x1b, 0x26, 0x7d, 0xb8, 0x95, 0xb2, 0x7c, 0xc2, 0x7d, 0x34, 0xc7, 0x69, 0xeb, 0xe3, 0xdc,
-	0xe4, 0xa5, 0x2e, 0xd4, 0xc6, 0x3e, 0xac, 0xe9, 0xed, 0x26, 0x8c, 0x8e, 0x00, 0x0a, 0x65, 0x53,
-	0x1d, 0xf9, 0x64, 0xa1, 0x8e, 0x14, 0x09, 0xa1, 0xed, 0x93, 0x7c, 0x63, 0x7f, 0x36, 0x8c, 0x26,
-	0x1f, 0xa0, 0x64, 0x4b, 0x8d, 0x7d, 0xae, 0xdb, 0x8d, 0x6b, 0xe9, 0xf6, 0x7d, 0xe8, 0x9c, 0x30,
-	0xee, 0x4f, 0x8c, 0xbe, 0xda, 0xfa, 0xba, 0x80, 0x72, 0x51, 0xed, 0x21, 0x5f, 0x81, 0x9d, 0xe0,
-	0xb9, 0x16, 0x99, 0x05, 0x8d, 0xcc, 0x5d, 0x53, 0xaa, 0x22, 0x6a, 0x4f, 0x61, 0xad, 0xee, 0x14,
-	0xc8, 0x36, 0x6c, 0x04, 0x2c, 0x79, 0x39, 0xf1, 0xd0, 0x47, 0x89, 0x9e, 0xd3, 0xec, 0x59, 0xfd,
-	0x75, 0xda, 0x51, 0xbe, 0x51, 0xea, 0x2a, 0xfc, 0x8c, 0x5b, 0xc5, 0x9f, 0x71, 0x51, 0x06, 0xd7,
-	0xcb, 0x32, 0xd8, 0x85, 0xf5, 0x04, 0xa7, 0x17, 0x53, 0x1f, 0x3d, 0xa7, 0xad, 0x13, 0xe6, 0xb6,
-	0xfb, 0x08, 0x6e, 0x8d, 0x92, 0x28, 0x2e, 0x49, 0x4b, 0x41, 0x17, 0xac, 0x92, 0x2e, 0x0c, 0xdf,
-	0x34, 0x01, 0x34, 0x74, 0x4f, 0xbd, 0x6f, 0x48, 0x0c, 0x64, 0x1f, 0xe5, 0x5e, 0x14, 0xc4, 0x51,
-	0x88, 0xa1, 0x4c, 0xff, 0x3b, 0xe4, 0xc9, 0x82, 0x5f, 0xf6, 0x3c, 0xd4, 0x14, 0xec, 0x3e, 0x5c,
-	0x10, 0x51, 0x81, 0xbb, 0x2b, 0x24, 0xd0, 0x15, 0x8f, 0x78, 0x80, 0x47, 0x7c, 0xfa, 0x72, 0xef,
-	0x8c, 0x85, 0x21, 0xfa, 0x57, 0x55, 0xac, 0x40, 0xb3, 0x8a, 0x1f, 0x97, 0x23, 0x8c, 0xf1, 0x42,
-	0x26, 0x3c, 0x3c, 0xcd, 0x86, 0xde, 0x5d, 0x21, 0xe7, 0x70, 0x7b, 0x1f, 0x75, 0x75, 0x2e, 0x24,
-	0x9f, 0x8a, 0xac, 0xe0, 0x70, 0x71, 0xc1, 0x39, 0xf0, 0x35, 0x4b, 0xfe, 0x04, 0x70, 0x39, 0x45,
-	0x64, 0xb9, 0x29, 0x9b, 0x27, 0xb0, 0x0a, 0xcb, 0xd3, 0x73, 0xd8, 0x2c, 0x3f, 0x13, 0xc8, 0x67,
-	0x75, 0xb1, 0xb5, 0x8f, 0xa8, 0xee, 0xe7, 0xcb, 0x40, 0xf3, 0x52, 0x09, 0x6c, 0xcd, 0x09, 0x0a,
-	0x79, 0x74, 0x55, 0x8a, 0xaa, 0xa6, 0x76, 0x1f, 0x2f, 0x89, 0xce, 0x6b, 0x1e, 0x42, 0x3b, 0x1f,
-	0x67, 0xf2, 0xa0, 0x2e, 0xba, 0x3a, 0xed, 0xdd, 0xab, 0xa4, 0xcc, 0x5d, 0x21, 0x13, 0x80, 0x7d,
-	0x94, 0x07, 0x28, 0x13, 0x3e, 0x15, 0xe4, 0x61, 0xed, 0x21, 0x5e, 0x02, 0xb2, 0xa4, 0x9f, 0xbe,
-	0x15, 0x97, 0x6d, 0x79, 0xf8, 0x7a, 0xd5, 0xe8, 0x9b, 0x7a, 0x41, 0xff, 0x7f, 0xa5, 0xde, 0xc1,
-	0x95, 0x3a, 0x82, 0x4e, 0xe1, 0x4d, 0x4a, 0x6a, 0x2f, 0xcb, 0xfc, 0xa3, 0xf5, 0xbf, 0x1e, 0x8c,
-	0xdd, 0x2f, 0x7e, 0x1c, 0x9e, 0x72, 0x79, 0x36, 0x3b, 0x56, 0xa5, 0x77, 0x52, 0xe4, 0x63, 0x1e,
-	0x99, 0xaf, 0x9d, 0x8c, 0xa1, 0x1d, 0x9d, 0x69, 0x47, 0xb7, 0x11, 0x1f, 0x1f, 0x37, 0xb5, 0xf9,
-	0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x9d, 0x20, 0xf1, 0x89, 0x0e, 0x00, 0x00,
+	// 1503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x53, 0x1b, 0x37,
+	0x14, 0x67, 0x31, 0x06, 0xfb, 0x19, 0x8c, 0x51, 0x08, 0x75, 0x9c, 0x64, 0x42, 0xb6, 0xf9, 0xe3,
+	0xa6, 0x09, 0x64, 0x48, 0xd3, 0x9e, 0x3a, 0xd3, 0x60, 0x26, 0x0c, 0xd3, 0x21, 0x61, 0x16, 0x9a,
+	0x43, 0xa7, 0xad, 0x47, 0x78, 0x65, 0xd0, 0x64, 0x57, 0x5a, 0x24, 0x6d, 0x52, 0x72, 0xec, 0xf4,
+	0xde, 0x9e, 0xd2, 0x2f, 0xd2, 0xef, 0xd1, 0xe9, 0x37, 0xe9, 0xf4, 0xd8, 0x5e, 0x3a, 0xd2, 0xee,
+	0x1a, 0xef, 0x7a, 0x8d, 0x1d, 0x28, 0xe9, 0xa5, 0x37, 0xbf, 0xb7, 0xef, 0x9f, 0x7e, 0xd2, 0xfb,
+	0x3d, 0xc9, 0xb0, 0x40, 0x99, 0x4b, 0xbe, 0x6f, 0x77, 0x38, 0x17, 0xee, 0x4a, 0x20, 0xb8, 0xe2,
+	0x08, 0xf9, 0xd4, 0x7b, 0x15, 0xca, 0x48, 0x5a, 0x31, 0xdf, 0x1b, 0xb3, 0x1d, 0xee, 0xfb, 0x9c,
+	0x45, 0xba, 0x46, 0x95, 0x32, 0x45, 0x04, 0xc3, 0x5e, 0x2c, 0xcf, 0xf6, 0x7b, 0xd8, 0xbf, 0x58,
+	0x70, 0xc9, 0x21, 0x07, 0x54, 0x2a, 0x22, 0x9e, 0x71, 0x97, 0x38, 0xe4, 0x28, 0x24, 0x52, 0xa1,
+	0x87, 0x30, 0xb5, 0x8f, 0x25, 0xa9, 0x5b, 0xcb, 0x56, 0xb3, 0xb2, 0x76, 0x6d, 0x25, 0x95, 0x26,
+	0x8e, 0xbf, 0x2d, 0x0f, 0xd6, 0xb1, 0x24, 0x8e, 0xb1, 0x44, 0x9f, 0xc2, 0x0c, 0x76, 0x5d, 0x41,
+	0xa4, 0xac, 0x4f, 0x9e, 0xe2, 0xf4, 0x24, 0xb2, 0x71, 0x12, 0x63, 0xb4, 0x04, 0xd3, 0x8c, 0xbb,
+	0x64, 0x6b, 0xa3, 0x5e, 0x58, 0xb6, 0x9a, 0x05, 0x27, 0x96, 0xec, 0x9f, 0x2c, 0x58, 0x4c, 0x57,
+	0x26, 0x03, 0xce, 0x24, 0x41, 0x8f, 0x60, 0x5a, 0x2a, 0xac, 0x42, 0x19, 0x17, 0x77, 0x35, 0x37,
+	0xcf, 0xae, 0x31, 0x71, 0x62, 0x53, 0xb4, 0x0e, 0x15, 0xca, 0xa8, 0x6a, 0x07, 0x58, 0x60, 0x3f,
+	0xa9, 0xf0, 0xe6, 0x4a, 0x06, 0xbd, 0x18, 0xa8, 0x2d, 0x46, 0xd5, 0x8e, 0x31, 0x74, 0x80, 0xf6,
+	0x7e, 0xdb, 0x9f, 0xc3, 0xe5, 0x4d, 0xa2, 0xb6, 0x34, 0xc6, 0x3a, 0x3a, 0x91, 0x09, 0x58, 0xb7,
+	0x60, 0xce, 0x20, 0xbf, 0x1e, 0x52, 0xcf, 0xdd, 0xda, 0xd0, 0x85, 0x15, 0x9a, 0x05, 0x27, 0xad,
+	0xb4, 0xff, 0xb2, 0xa0, 0x6c, 0x9c, 0xb7, 0x58, 0x97, 0xa3, 0xc7, 0x50, 0xd4, 0xa5, 0x45, 0x08,
+	0x57, 0xd7, 0x6e, 0xe4, 0x2e, 0xe2, 0x24, 0x97, 0x13, 0x59, 0x23, 0x1b, 0x66, 0xfb, 0xa3, 0x9a,
+	0x85, 0x14, 0x9c, 0x94, 0x0e, 0xd5, 0x61, 0xc6, 0xc8, 0x3d, 0x48, 0x13, 0x11, 0x5d, 0x07, 0x88,
+	0x8e, 0x10, 0xc3, 0x3e, 0xa9, 0x4f, 0x2d, 0x5b, 0xcd, 0xb2, 0x53, 0x36, 0x9a, 0x67, 0xd8, 0x27,
+	0x7a, 0x2b, 0x04, 0xc1, 0x92, 0xb3, 0x7a, 0xd1, 0x7c, 0x8a, 0x25, 0xf4, 0x04, 0xa0, 0x8b, 0xa9,
+	0xd7, 0xee, 0x78, 0x58, 0xca, 0xfa, 0xb4, 0x29, 0xd8, 0x5e, 0x19, 0x3c, 0x79, 0x51, 0xbd, 0x4f,
+	0x31, 0xf5, 0x5a, 0xda, 0xd2, 0x29, 0x77, 0x93, 0x9f, 0xf6, 0x8f, 0x16, 0x2c, 0x65, 0xc1, 0x3b,
+	0xcf, 0x7e, 0x3e, 0x8e, 0x9c, 0x88, 0xde, 0xca, 0x42, 0xb3, 0xb2, 0x76, 0x7d, 0x68, 0x39, 0x1a,
+	0x6d, 0x27, 0x36, 0xb6, 0x7f, 0x9f, 0x04, 0xd4, 0x12, 0x04, 0x2b, 0x62, 0xbe, 0x25, 0x1b, 0x98,
+	0x45, 0xd5, 0xca, 0x41, 0x35, 0x8d, 0xdd, 0x64, 0x16, 0xbb, 0xe1, 0xa0, 0xd7, 0x61, 0xe6, 0x15,
+	0x11, 0x92, 0x72, 0x66, 0x10, 0x2f, 0x38, 0x89, 0x88, 0xae, 0x42, 0xd9, 0x27, 0x0a, 0xb7, 0x03,
+	0xac, 0x0e, 0x63, 0xc8, 0x4b, 0x5a, 0xb1, 0x83, 0xd5, 0xa1, 0xce, 0xe7, 0xe2, 0xf8, 0xa3, 0x06,
+	0xbd, 0xa0, 0xf3, 0xb9, 0x38, 0xfa, 0x6a, 0x0e, 0xb4, 0x3a, 0x0e, 0x48, 0x72, 0xa0, 0x67, 0x96,
+	0x0b, 0x83, 0x07, 0x3a, 0x86, 0xee, 0x4b, 0x72, 0xfc, 0x02, 0x7b, 0x21, 0xd9, 0xc1, 0x54, 0x38,
+	0xa0, 0xbd, 0xa2, 0x03, 0x8d, 0x36, 0xe2, 0x65, 0x27, 0x41, 0x4a, 0xe3, 0x06, 0xa9, 0x18, 0xb7,
+	0xb8, 0x2d, 0xfe, 0x9c, 0x84, 0x85, 0x08, 0xa4, 0xf7, 0x06, 0x69, 0x1a, 0x9b, 0xe2, 0x08, 0x6c,
+	0xa6, 0xff, 0x0d, 0x6c, 0x66, 0xce, 0x82, 0x0d, 0xba, 0x02, 0x25, 0x16, 0xfa, 0x6d, 0xc1, 0x5f,
+	0x6b, 0x74, 0xcd, 0x1a, 0x58, 0xe8, 0x3b, 0xfc, 0xb5, 0xd4, 0x00, 0x75, 0xb8, 0xe7, 0x91, 0x8e,
+	0xa2, 0x9c, 0x6d, 0x6d, 0xd4, 0xcb, 0x11, 0x40, 0xfd, 0x3a, 0x8d, 0x40, 0x97, 0x12, 0x83, 0x1f,
+	0x44, 0xde, 0xb1, 0x68, 0xfb, 0x80, 0xfa, 0x31, 0x3f, 0x4f, 0x2b, 0x8d, 0x41, 0x29, 0xf6, 0x17,
+	0x50, 0x4f, 0xba, 0xf7, 0x29, 0xf5, 0x88, 0x81, 0xf9, 0xdd, 0xd8, 0xef, 0xad, 0x05, 0x0b, 0x29,
+	0x7f, 0xc3, 0x82, 0x17, 0x55, 0x30, 0x6a, 0x42, 0x2d, 0xda, 0xbe, 0x2e, 0xf5, 0x48, 0x7c, 0x4e,
+	0x0a, 0xe6, 0x9c, 0x54, 0x69, 0x6a, 0x15, 0xba, 0xb0, 0x2b, 0x39, 0x6b, 0x3b, 0x0f, 0xa2, 0x1b,
+	0x00, 0x7d, 0x69, 0x23, 0x82, 0xba, 0x3d, 0x9c, 0x2f, 0xfb, 0x00, 0x71, 0xca, 0xdd, 0x5e, 0x61,
+	0xbf, 0x4d, 0xc5, 0xf3, 0x62, 0x9b, 0x28, 0x3c, 0x56, 0x3f, 0xf5, 0x66, 0xca, 0xe4, 0x3b, 0xcd,
+	0x94, 0x1b, 0x50, 0x31, 0xf4, 0x1e, 0x73, 0x7f, 0xc1, 0xf4, 0xa1, 0x61, 0x7c, 0xc7, 0x68, 0xd0,
+	0x67, 0x50, 0x10, 0xe4, 0xc8, 0xb0, 0xd7, 0x90, 0x85, 0x0c, 0xf4, 0xbf, 0xa3, 0x3d, 0x72, 0x77,
+	0xa1, 0x98, 0xb7, 0x0b, 0xe8, 0x26, 0xcc, 0xfa, 0x58, 0xbc, 0x6c, 0xbb, 0xc4, 0x23, 0x8a, 0xb8,
+	0x66, 0xc8, 0x94, 0x9c, 0x8a, 0xd6, 0x6d, 0x44, 0xaa, 0xbe, 0x8b, 0xc2, 0x4c, 0xff, 0x45, 0xa1,
+	0x9f, 0x5f, 0x4b, 0x69, 0x7e, 0x6d, 0x40, 0x49, 0x90, 0xce, 0x71, 0xc7, 0x23, 0xae, 0x69, 0xaf,
+	0x92, 0xd3, 0x93, 0xf5, 0xa2, 0x3b, 0x66, 0x10, 0xb4, 0x15, 0xf5, 0x49, 0xdc, 0x5e, 0x10, 0xa9,
+	0xf6, 0xa8, 0x4f, 0xd0, 0x6d, 0xa8, 0x76, 0xb9, 0xf0, 0xb1, 0x6a, 0x27, 0xd1, 0x2b, 0xcb, 0x56,
+	0xb3, 0xe8, 0xcc, 0x45, 0xda, 0x17, 0x71, 0x8e, 0xf4, 0x6c, 0x9c, 0x3d, 0xc3, 0x6c, 0xd4, 0xa5,
+	0x08, 0xa2, 0xc4, 0x71, 0xbb, 0xc3, 0x43, 0xa6, 0xea, 0x73, 0x26, 0x0d, 0x18, 0x55, 0x4b, 0x6b,
+	0x34, 0xdd, 0x45, 0x06, 0xa6, 0xd4, 0xaa, 0x29, 0xb5, 0x6c, 0x34, 0xa6, 0xd2, 0xbb, 0x30, 0x2f,
+	0x89, 0xa0, 0xd8, 0xa3, 0x6f, 0x88, 0xdb, 0x96, 0xf4, 0x0d, 0xa9, 0xcf, 0x1b, 0x9b, 0xea, 0x89,
+	0x7a, 0x97, 0xbe, 0x21, 0xf6, 0x7d, 0xa8, 0x6d, 0x08, 0x1e, 0xa4, 0x78, 0xba, 0x8f, 0x64, 0xad,
+	0x14, 0xc9, 0xda, 0x9b, 0x70, 0x69, 0x93, 0xa8, 0x3d, 0x2c, 0x5f, 0xee, 0x7a, 0x5c, 0xc9, 0x33,
+	0xdf, 0x0c, 0xed, 0x1f, 0x2c, 0x58, 0x4c, 0x47, 0x3a, 0x4f, 0x73, 0x2d, 0x42, 0x51, 0xea, 0x28,
+	0x71, 0xdb, 0x47, 0x82, 0xc6, 0xd0, 0x27, 0x3e, 0x17, 0xc7, 0xd1, 0xfa, 0xa3, 0x79, 0x01, 0x91,
+	0xca, 0xac, 0x9d, 0xc1, 0xd2, 0x0e, 0x0e, 0x65, 0x34, 0xf7, 0xcd, 0x71, 0x3d, 0xfb, 0x55, 0x37,
+	0x4b, 0xdd, 0x93, 0x83, 0xd4, 0x6d, 0x73, 0xf8, 0xc0, 0x21, 0x32, 0xf4, 0xdf, 0x5b, 0xc2, 0x9f,
+	0x2d, 0xb8, 0xb6, 0x4b, 0xd4, 0x49, 0xba, 0x1d, 0x41, 0xb9, 0xa0, 0xea, 0xf8, 0x42, 0xd3, 0xea,
+	0x1e, 0x0b, 0xe2, 0x44, 0x06, 0xf5, 0xa2, 0xd3, 0x93, 0xed, 0x00, 0x96, 0x5a, 0x3d, 0x5b, 0x53,
+	0x53, 0x8b, 0x33, 0x25, 0xb8, 0x37, 0x10, 0xd9, 0xca, 0x89, 0xbc, 0x04, 0xd3, 0x81, 0xde, 0x31,
+	0xd7, 0xe4, 0x2d, 0x39, 0xb1, 0x74, 0x6a, 0xc6, 0xa3, 0x13, 0x2e, 0xd7, 0xc7, 0x86, 0x4a, 0x45,
+	0x3b, 0xf2, 0x62, 0x71, 0xff, 0xd5, 0x82, 0xf9, 0x4c, 0xc2, 0xe1, 0x4d, 0x35, 0xc6, 0x95, 0x27,
+	0x19, 0xf8, 0x85, 0xd4, 0xc0, 0x37, 0x74, 0x44, 0x19, 0x95, 0x87, 0xc4, 0x8d, 0x79, 0x22, 0xba,
+	0x4c, 0xce, 0x25, 0xda, 0x88, 0x2a, 0x72, 0xb8, 0xa0, 0x98, 0xcb, 0x05, 0x6f, 0x2d, 0x68, 0xe4,
+	0x41, 0x75, 0x9e, 0xd6, 0x6c, 0x01, 0xc8, 0x5e, 0xa8, 0x78, 0xee, 0x7d, 0x38, 0x94, 0x0b, 0xfb,
+	0xb2, 0xf6, 0xb9, 0xdd, 0xf3, 0xa0, 0x9a, 0xa6, 0x4a, 0xb4, 0x00, 0x73, 0x3d, 0xe1, 0x19, 0x67,
+	0xa4, 0x36, 0x81, 0xe6, 0xa0, 0xbc, 0x27, 0x30, 0x93, 0x94, 0x30, 0x55, 0xb3, 0xd0, 0x3c, 0x54,
+	0x9e, 0x87, 0xea, 0x79, 0x77, 0xdb, 0xf4, 0x7b, 0x6d, 0x52, 0xbb, 0xb4, 0xb8, 0x10, 0x61, 0xa0,
+	0xd6, 0x29, 0xf3, 0xf8, 0x41, 0xad, 0x80, 0x16, 0xa1, 0xf6, 0x15, 0x93, 0x61, 0x10, 0x70, 0xa1,
+	0x88, 0x6b, 0xee, 0x67, 0xb5, 0xa9, 0xb5, 0x3f, 0xca, 0x00, 0x26, 0x5d, 0x4b, 0x3f, 0xaa, 0x51,
+	0x00, 0x68, 0x93, 0xa8, 0x16, 0xf7, 0x03, 0xce, 0x08, 0x53, 0xd1, 0x4b, 0x05, 0x3d, 0x1c, 0xf2,
+	0x4e, 0x1c, 0x34, 0x8d, 0x8f, 0x5a, 0xe3, 0xce, 0x10, 0x8f, 0x8c, 0xb9, 0x3d, 0x81, 0x7c, 0x93,
+	0x51, 0xf3, 0xf8, 0x1e, 0xed, 0xbc, 0x6c, 0x1d, 0x62, 0xc6, 0x88, 0x77, 0x5a, 0xc6, 0x8c, 0x69,
+	0x92, 0x31, 0x83, 0x73, 0x2c, 0xec, 0x2a, 0x41, 0xd9, 0x41, 0xb2, 0xab, 0xf6, 0x04, 0x3a, 0x32,
+	0x54, 0x7c, 0x02, 0x7d, 0x92, 0x70, 0x6d, 0x78, 0xc2, 0x01, 0xe3, 0x77, 0x4c, 0xf9, 0x2d, 0xc0,
+	0xc9, 0xf5, 0x00, 0x8d, 0x77, 0x7d, 0x68, 0xdc, 0x19, 0x65, 0xd6, 0x0b, 0x4f, 0xa1, 0x9a, 0x7e,
+	0x58, 0xa2, 0x8f, 0xf2, 0x7c, 0x73, 0x5f, 0xee, 0x8d, 0x7b, 0xe3, 0x98, 0xf6, 0x52, 0x09, 0x58,
+	0x18, 0xb8, 0x29, 0xa2, 0xfb, 0xa7, 0x85, 0xc8, 0x5e, 0x96, 0x1b, 0x0f, 0xc6, 0xb4, 0xee, 0xe5,
+	0xdc, 0x81, 0x72, 0x6f, 0x66, 0xa3, 0x5b, 0x79, 0xde, 0xd9, 0x91, 0xde, 0x38, 0xad, 0x57, 0xed,
+	0x09, 0xd4, 0x06, 0xd8, 0x24, 0x6a, 0x9b, 0x28, 0xa1, 0xa9, 0xea, 0x4e, 0xee, 0x26, 0x9e, 0x18,
+	0x24, 0x41, 0xef, 0x8e, 0xb4, 0xeb, 0x95, 0xfc, 0x0d, 0xcc, 0x67, 0x46, 0x2d, 0xca, 0xc5, 0x39,
+	0x7f, 0x1e, 0x8f, 0x2a, 0xff, 0x3b, 0xa8, 0x65, 0x07, 0x2b, 0xfa, 0x38, 0x2f, 0xfc, 0x90, 0xf1,
+	0x3b, 0x2a, 0xfe, 0x21, 0x5c, 0xce, 0x1d, 0xa3, 0xe8, 0x61, 0x5e, 0x92, 0xd3, 0x26, 0xee, 0xa8,
+	0x4c, 0xa1, 0x69, 0xfd, 0xec, 0xec, 0x78, 0x30, 0xea, 0x48, 0xa6, 0x86, 0x5a, 0x63, 0x65, 0x5c,
+	0xf3, 0x64, 0x7b, 0xd6, 0xfe, 0x4e, 0xde, 0x15, 0xfa, 0x5f, 0xb5, 0xff, 0x19, 0xef, 0x02, 0x18,
+	0x6f, 0x0f, 0x2a, 0x7d, 0x7f, 0x32, 0xa1, 0x5c, 0x2e, 0x1b, 0xfc, 0x17, 0xea, 0x3f, 0xef, 0xdb,
+	0x0e, 0xcc, 0xf6, 0x5f, 0xd3, 0xd1, 0xdd, 0x21, 0x47, 0x2b, 0xfb, 0x24, 0x68, 0x34, 0x47, 0x1b,
+	0x26, 0x49, 0xd6, 0x3f, 0xf9, 0x7a, 0xed, 0x80, 0xaa, 0xc3, 0x70, 0x5f, 0xaf, 0x6f, 0x35, 0xf2,
+	0x7b, 0x40, 0x79, 0xfc, 0x6b, 0x35, 0xd9, 0x86, 0x55, 0x13, 0x6a, 0xd5, 0x84, 0x0a, 0xf6, 0xf7,
+	0xa7, 0x8d, 0xf8, 0xe8, 0x9f, 0x01, 0x00, 0xa9, 0xdb, 0xc7, 0x66, 0x02, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -894,6 +1514,10 @@ type IndexCoordClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	PauseIndexBuild(ctx context.Context, in *PauseIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeIndexBuild(ctx context.Context, in *ResumeIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetIndexBuildPriority(ctx context.Context, in *SetIndexBuildPriorityRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error)
 }
 
 type indexCoordClient struct {
@@ -976,6 +1600,42 @@ func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetri
 	return out, nil
 }
 
+func (c *indexCoordClient) PauseIndexBuild(ctx context.Context, in *PauseIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/PauseIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ResumeIndexBuild(ctx context.Context, in *ResumeIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ResumeIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) SetIndexBuildPriority(ctx context.Context, in *SetIndexBuildPriorityRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/SetIndexBuildPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error) {
+	out := new(GetIndexStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexCoordServer is the server API for IndexCoord service.
 type IndexCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -987,6 +1647,10 @@ type IndexCoordServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	PauseIndexBuild(context.Context, *PauseIndexBuildRequest) (*commonpb.Status, error)
+	ResumeIndexBuild(context.Context, *ResumeIndexBuildRequest) (*commonpb.Status, error)
+	SetIndexBuildPriority(context.Context, *SetIndexBuildPriorityRequest) (*commonpb.Status, error)
+	GetIndexStatistics(context.Context, *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error)
 }
 
 // UnimplementedIndexCoordServer can be embedded to have forward compatible implementations.
@@ -1018,6 +1682,22 @@ func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvu
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedIndexCoordServer) PauseIndexBuild(ctx context.Context, req *PauseIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseIndexBuild not implemented")
+}
+
+func (*UnimplementedIndexCoordServer) ResumeIndexBuild(ctx context.Context, req *ResumeIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeIndexBuild not implemented")
+}
+
+func (*UnimplementedIndexCoordServer) SetIndexBuildPriority(ctx context.Context, req *SetIndexBuildPriorityRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexBuildPriority not implemented")
+}
+
+func (*UnimplementedIndexCoordServer) GetIndexStatistics(ctx context.Context, req *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+}
+
 func RegisterIndexCoordServer(s *grpc.Server, srv IndexCoordServer) {
 	s.RegisterService(&_IndexCoord_serviceDesc, srv)
 }
@@ -1166,6 +1846,78 @@ func _IndexCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_PauseIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).PauseIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/PauseIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).PauseIndexBuild(ctx, req.(*PauseIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ResumeIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ResumeIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ResumeIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ResumeIndexBuild(ctx, req.(*ResumeIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_SetIndexBuildPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndexBuildPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).SetIndexBuildPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/SetIndexBuildPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).SetIndexBuildPriority(ctx, req.(*SetIndexBuildPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).GetIndexStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/GetIndexStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).GetIndexStatistics(ctx, req.(*GetIndexStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.index.IndexCoord",
 	HandlerType: (*IndexCoordServer)(nil),
@@ -1202,6 +1954,22 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _IndexCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "PauseIndexBuild",
+			Handler:    _IndexCoord_PauseIndexBuild_Handler,
+		},
+		{
+			MethodName: "ResumeIndexBuild",
+			Handler:    _IndexCoord_ResumeIndexBuild_Handler,
+		},
+		{
+			MethodName: "SetIndexBuildPriority",
+			Handler:    _IndexCoord_SetIndexBuildPriority_Handler,
+		},
+		{
+			MethodName: "GetIndexStatistics",
+			Handler:    _IndexCoord_GetIndexStatistics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
@@ -1217,6 +1985,7 @@ type IndexNodeClient interface {
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	GetTaskSlots(ctx context.Context, in *GetTaskSlotsRequest, opts ...grpc.CallOption) (*GetTaskSlotsResponse, error)
 }
 
 type indexNodeClient struct {
@@ -1272,6 +2041,15 @@ func (c *indexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *indexNodeClient) GetTaskSlots(ctx context.Context, in *GetTaskSlotsRequest, opts ...grpc.CallOption) (*GetTaskSlotsResponse, error) {
+	out := new(GetTaskSlotsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetTaskSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexNodeServer is the server API for IndexNode service.
 type IndexNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -1280,6 +2058,7 @@ type IndexNodeServer interface {
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	GetTaskSlots(context.Context, *GetTaskSlotsRequest) (*GetTaskSlotsResponse, error)
 }
 
 // UnimplementedIndexNodeServer can be embedded to have forward compatible implementations.
@@ -1302,6 +2081,10 @@ func (*UnimplementedIndexNodeServer) GetMetrics(ctx context.Context, req *milvus
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedIndexNodeServer) GetTaskSlots(ctx context.Context, req *GetTaskSlotsRequest) (*GetTaskSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskSlots not implemented")
+}
+
 func RegisterIndexNodeServer(s *grpc.Server, srv IndexNodeServer) {
 	s.RegisterService(&_IndexNode_serviceDesc, srv)
 }
@@ -1396,6 +2179,24 @@ func _IndexNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_GetTaskSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).GetTaskSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/GetTaskSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).GetTaskSlots(ctx, req.(*GetTaskSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IndexNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.index.IndexNode",
 	HandlerType: (*IndexNodeServer)(nil),
@@ -1420,6 +2221,10 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _IndexNode_GetMetrics_Handler,
 		},
+		{
+			MethodName: "GetTaskSlots",
+			Handler:    _IndexNode_GetTaskSlots_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
@@ -50,6 +50,35 @@ func (StateCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_41f4a519b878ee3b, []int{0}
 }
 
+// the priority class of a search on the query nodes, the higher classes are scheduled first
+type ReadPriority int32
+
+const (
+	ReadPriority_Normal ReadPriority = 0
+	ReadPriority_High   ReadPriority = 1
+	ReadPriority_Low    ReadPriority = 2
+)
+
+var ReadPriority_name = map[int32]string{
+	0: "Normal",
+	1: "High",
+	2: "Low",
+}
+
+var ReadPriority_value = map[string]int32{
+	"Normal": 0,
+	"High":   1,
+	"Low":    2,
+}
+
+func (x ReadPriority) String() string {
+	return proto.EnumName(ReadPriority_name, int32(x))
+}
+
+func (ReadPriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{1}
+}
+
 type ComponentInfo struct {
 	NodeID               int64                    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Role                 string                   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
@@ -1059,15 +1088,20 @@ type SearchRequest struct {
 	PartitionIDs    []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl             string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// unix time in milliseconds after which the search is abandoned, 0 means no deadline
+	Deadline int64        `protobuf:"varint,13,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Priority ReadPriority `protobuf:"varint,14,opt,name=priority,proto3,enum=milvus.proto.internal.ReadPriority" json:"priority,omitempty"`
+	// the query nodes holding the segments to search, empty means all the query nodes of the collection
+	NodeIDs              []int64  `protobuf:"varint,15,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1179,6 +1213,27 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetPriority() ReadPriority {
+	if m != nil {
+		return m.Priority
+	}
+	return ReadPriority_Normal
+}
+
+func (m *SearchRequest) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
+	}
+	return nil
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1317,6 +1372,7 @@ type RetrieveRequest struct {
 	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
 	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Limit                int64             `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1410,6 +1466,13 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -2182,8 +2245,64 @@ func (m *ChannelTimeTickMsg) GetDefaultTimestamp() uint64 {
 	return 0
 }
 
+type EventNotificationMsg struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	EventType            string            `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload              string            `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EventNotificationMsg) Reset()         { *m = EventNotificationMsg{} }
+func (m *EventNotificationMsg) String() string { return proto.CompactTextString(m) }
+func (*EventNotificationMsg) ProtoMessage()    {}
+func (*EventNotificationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
+}
+
+func (m *EventNotificationMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventNotificationMsg.Unmarshal(m, b)
+}
+func (m *EventNotificationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventNotificationMsg.Marshal(b, m, deterministic)
+}
+func (m *EventNotificationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNotificationMsg.Merge(m, src)
+}
+func (m *EventNotificationMsg) XXX_Size() int {
+	return xxx_messageInfo_EventNotificationMsg.Size(m)
+}
+func (m *EventNotificationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNotificationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNotificationMsg proto.InternalMessageInfo
+
+func (m *EventNotificationMsg) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *EventNotificationMsg) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *EventNotificationMsg) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.StateCode", StateCode_name, StateCode_value)
+	proto.RegisterEnum("milvus.proto.internal.ReadPriority", ReadPriority_name, ReadPriority_value)
 	proto.RegisterType((*ComponentInfo)(nil), "milvus.proto.internal.ComponentInfo")
 	proto.RegisterType((*ComponentStates)(nil), "milvus.proto.internal.ComponentStates")
 	proto.RegisterType((*GetComponentStatesRequest)(nil), "milvus.proto.internal.GetComponentStatesRequest")
@@ -2215,133 +2334,142 @@ func init() {
 	proto.RegisterType((*QueryNodeStats)(nil), "milvus.proto.internal.QueryNodeStats")
 	proto.RegisterType((*MsgPosition)(nil), "milvus.proto.internal.MsgPosition")
 	proto.RegisterType((*ChannelTimeTickMsg)(nil), "milvus.proto.internal.ChannelTimeTickMsg")
+	proto.RegisterType((*EventNotificationMsg)(nil), "milvus.proto.internal.EventNotificationMsg")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5b, 0x6f, 0x1c, 0x49,
-	0x15, 0xa6, 0xa7, 0xc7, 0x9e, 0x99, 0xd3, 0x63, 0x67, 0x52, 0xf1, 0x66, 0xdb, 0x4e, 0x36, 0xf1,
-	0xf6, 0x2e, 0x60, 0x36, 0x22, 0x0e, 0x5e, 0x60, 0x57, 0x08, 0x91, 0x5d, 0x7b, 0x96, 0x30, 0xca,
-	0xda, 0x98, 0x76, 0x76, 0x25, 0x78, 0x69, 0xd5, 0x74, 0x97, 0xc7, 0x4d, 0xfa, 0xb6, 0x5d, 0xd5,
-	0x4e, 0x66, 0x9f, 0x10, 0xe2, 0x09, 0x04, 0x12, 0x48, 0xfc, 0x0d, 0x5e, 0x79, 0xe2, 0x22, 0x9e,
-	0x90, 0xf8, 0x05, 0xfc, 0x13, 0xc4, 0x13, 0xaa, 0x53, 0xd5, 0x97, 0x19, 0x8f, 0x8d, 0xe3, 0x08,
-	0x58, 0x04, 0x6f, 0x5d, 0xdf, 0x39, 0x75, 0x39, 0xdf, 0xb9, 0xd4, 0x99, 0x1a, 0x58, 0x0d, 0x13,
-	0xc1, 0xf2, 0x84, 0x46, 0xf7, 0xb3, 0x3c, 0x15, 0x29, 0x79, 0x25, 0x0e, 0xa3, 0xd3, 0x82, 0xab,
-	0xd1, 0xfd, 0x52, 0xb8, 0xd1, 0xf7, 0xd3, 0x38, 0x4e, 0x13, 0x05, 0x6f, 0xf4, 0xb9, 0x7f, 0xc2,
-	0x62, 0xaa, 0x46, 0xce, 0xef, 0x0d, 0x58, 0xd9, 0x4b, 0xe3, 0x2c, 0x4d, 0x58, 0x22, 0x46, 0xc9,
-	0x71, 0x4a, 0x6e, 0xc2, 0x72, 0x92, 0x06, 0x6c, 0x34, 0xb4, 0x8d, 0x4d, 0x63, 0xcb, 0x74, 0xf5,
-	0x88, 0x10, 0x68, 0xe7, 0x69, 0xc4, 0xec, 0xd6, 0xa6, 0xb1, 0xd5, 0x73, 0xf1, 0x9b, 0x3c, 0x04,
-	0xe0, 0x82, 0x0a, 0xe6, 0xf9, 0x69, 0xc0, 0x6c, 0x73, 0xd3, 0xd8, 0x5a, 0xdd, 0xd9, 0xbc, 0xbf,
-	0xf0, 0x14, 0xf7, 0x8f, 0xa4, 0xe2, 0x5e, 0x1a, 0x30, 0xb7, 0xc7, 0xcb, 0x4f, 0xf2, 0x1e, 0x00,
-	0x7b, 0x2e, 0x72, 0xea, 0x85, 0xc9, 0x71, 0x6a, 0xb7, 0x37, 0xcd, 0x2d, 0x6b, 0xe7, 0xf5, 0xd9,
-	0x05, 0xf4, 0xe1, 0x1f, 0xb3, 0xe9, 0xc7, 0x34, 0x2a, 0xd8, 0x21, 0x0d, 0x73, 0xb7, 0x87, 0x93,
-	0xe4, 0x71, 0x9d, 0xbf, 0x1a, 0x70, 0xad, 0x32, 0x00, 0xf7, 0xe0, 0xe4, 0x1b, 0xb0, 0x84, 0x5b,
-	0xa0, 0x05, 0xd6, 0xce, 0x9b, 0xe7, 0x9c, 0x68, 0xc6, 0x6e, 0x57, 0x4d, 0x21, 0x1f, 0xc1, 0x0d,
-	0x5e, 0x8c, 0xfd, 0x52, 0xe4, 0x21, 0xca, 0xed, 0x16, 0x1e, 0xed, 0x72, 0x2b, 0x91, 0xe6, 0x02,
-	0xfa, 0x48, 0x6f, 0xc3, 0xb2, 0x5c, 0xa9, 0xe0, 0xc8, 0x92, 0xb5, 0x73, 0x6b, 0xa1, 0x91, 0x47,
-	0xa8, 0xe2, 0x6a, 0x55, 0xe7, 0x16, 0xac, 0x3f, 0x62, 0x62, 0xce, 0x3a, 0x97, 0x7d, 0x52, 0x30,
-	0x2e, 0xb4, 0xf0, 0x49, 0x18, 0xb3, 0x27, 0xa1, 0xff, 0x74, 0xef, 0x84, 0x26, 0x09, 0x8b, 0x4a,
-	0xe1, 0x6b, 0x70, 0xeb, 0x11, 0xc3, 0x09, 0x21, 0x17, 0xa1, 0xcf, 0xe7, 0xc4, 0xaf, 0xc0, 0x8d,
-	0x47, 0x4c, 0x0c, 0x83, 0x39, 0xf8, 0x63, 0xe8, 0x1e, 0x48, 0x67, 0xcb, 0x30, 0xf8, 0x3a, 0x74,
-	0x68, 0x10, 0xe4, 0x8c, 0x73, 0xcd, 0xe2, 0xed, 0x85, 0x27, 0x7e, 0x5f, 0xe9, 0xb8, 0xa5, 0xf2,
-	0xa2, 0x30, 0x71, 0x7e, 0x08, 0x30, 0x4a, 0x42, 0x71, 0x48, 0x73, 0x1a, 0xf3, 0x73, 0x03, 0x6c,
-	0x08, 0x7d, 0x2e, 0x68, 0x2e, 0xbc, 0x0c, 0xf5, 0x34, 0xe5, 0x97, 0x88, 0x06, 0x0b, 0xa7, 0xa9,
-	0xd5, 0x9d, 0xef, 0x03, 0x1c, 0x89, 0x3c, 0x4c, 0x26, 0x1f, 0x86, 0x5c, 0xc8, 0xbd, 0x4e, 0xa5,
-	0x9e, 0x34, 0xc2, 0xdc, 0xea, 0xb9, 0x7a, 0xd4, 0x70, 0x47, 0xeb, 0xf2, 0xee, 0x78, 0x08, 0x56,
-	0x49, 0xf7, 0x3e, 0x9f, 0x90, 0x07, 0xd0, 0x1e, 0x53, 0xce, 0x2e, 0xa4, 0x67, 0x9f, 0x4f, 0x76,
-	0x29, 0x67, 0x2e, 0x6a, 0x3a, 0x3f, 0x35, 0xe1, 0xd5, 0xbd, 0x9c, 0x61, 0xf0, 0x47, 0x11, 0xf3,
-	0x45, 0x98, 0x26, 0x9a, 0xfb, 0x17, 0x5f, 0x8d, 0xbc, 0x0a, 0x9d, 0x60, 0xec, 0x25, 0x34, 0x2e,
-	0xc9, 0x5e, 0x0e, 0xc6, 0x07, 0x34, 0x66, 0xe4, 0x0b, 0xb0, 0xea, 0x57, 0xeb, 0x4b, 0x04, 0x63,
-	0xae, 0xe7, 0xce, 0xa1, 0xe4, 0x4d, 0x58, 0xc9, 0x68, 0x2e, 0xc2, 0x4a, 0xad, 0x8d, 0x6a, 0xb3,
-	0xa0, 0x74, 0x68, 0x30, 0x1e, 0x0d, 0xed, 0x25, 0x74, 0x16, 0x7e, 0x13, 0x07, 0xfa, 0xf5, 0x5a,
-	0xa3, 0xa1, 0xbd, 0x8c, 0xb2, 0x19, 0x8c, 0x6c, 0x82, 0x55, 0x2d, 0x34, 0x1a, 0xda, 0x1d, 0x54,
-	0x69, 0x42, 0xd2, 0x39, 0xaa, 0x16, 0xd9, 0xdd, 0x4d, 0x63, 0xab, 0xef, 0xea, 0x11, 0x79, 0x00,
-	0x37, 0x4e, 0xc3, 0x5c, 0x14, 0x34, 0xd2, 0xf1, 0x29, 0xcf, 0xc1, 0xed, 0x1e, 0x7a, 0x70, 0x91,
-	0x88, 0xec, 0xc0, 0x5a, 0x76, 0x32, 0xe5, 0xa1, 0x3f, 0x37, 0x05, 0x70, 0xca, 0x42, 0x99, 0xf3,
-	0x27, 0x03, 0x5e, 0x19, 0xe6, 0x69, 0xf6, 0x99, 0x70, 0x45, 0x49, 0x72, 0xfb, 0x02, 0x92, 0x97,
-	0xce, 0x92, 0xec, 0xfc, 0xbc, 0x05, 0x37, 0x55, 0x44, 0x1d, 0x96, 0xc4, 0xfe, 0x0b, 0xac, 0xf8,
-	0x22, 0x5c, 0xab, 0x77, 0x55, 0x0a, 0x8b, 0xcd, 0xf8, 0x3c, 0xac, 0x56, 0x0e, 0x56, 0x7a, 0xff,
-	0xde, 0x90, 0x72, 0x7e, 0xd6, 0x82, 0x35, 0xe9, 0xd4, 0xff, 0xb3, 0x21, 0xd9, 0xf8, 0x43, 0x0b,
-	0x88, 0x8a, 0x8e, 0x51, 0x12, 0xb0, 0xe7, 0xff, 0x49, 0x2e, 0x5e, 0x03, 0x38, 0x0e, 0x59, 0x14,
-	0x34, 0x79, 0xe8, 0x21, 0xf2, 0x52, 0x1c, 0xd8, 0xd0, 0xc1, 0x45, 0x2a, 0xfb, 0xcb, 0xa1, 0xbc,
-	0x4d, 0x54, 0x67, 0xa1, 0x6f, 0x93, 0xee, 0xa5, 0x6f, 0x13, 0x9c, 0xa6, 0x6f, 0x93, 0xdf, 0x98,
-	0xb0, 0x32, 0x4a, 0x38, 0xcb, 0xc5, 0xff, 0x72, 0x20, 0x91, 0xdb, 0xd0, 0xe3, 0x6c, 0x12, 0xcb,
-	0x06, 0x67, 0x88, 0xc5, 0xda, 0x74, 0x6b, 0x40, 0x4a, 0x7d, 0x55, 0x59, 0x47, 0x43, 0xbb, 0xa7,
-	0x5c, 0x5b, 0x01, 0xe4, 0x0e, 0x80, 0x08, 0x63, 0xc6, 0x05, 0x8d, 0x33, 0x55, 0x91, 0xdb, 0x6e,
-	0x03, 0x91, 0xb7, 0x40, 0x9e, 0x3e, 0x1b, 0x0d, 0xb9, 0x6d, 0x6d, 0x9a, 0xb2, 0x1d, 0x50, 0x23,
-	0xf2, 0x55, 0xe8, 0xe6, 0xe9, 0x33, 0x2f, 0xa0, 0x82, 0xda, 0x7d, 0x74, 0xde, 0xfa, 0x42, 0xb2,
-	0x77, 0xa3, 0x74, 0xec, 0x76, 0xf2, 0xf4, 0xd9, 0x90, 0x0a, 0xea, 0xfc, 0xcd, 0x84, 0x95, 0x23,
-	0x46, 0x73, 0xff, 0xe4, 0xea, 0x0e, 0xfb, 0x12, 0x0c, 0x72, 0xc6, 0x8b, 0x48, 0x78, 0xb5, 0x59,
-	0xca, 0x73, 0xd7, 0x14, 0xbe, 0x57, 0x19, 0x57, 0x52, 0x6e, 0x5e, 0x40, 0x79, 0x7b, 0x01, 0xe5,
-	0x0e, 0xf4, 0x1b, 0xfc, 0x72, 0x7b, 0x09, 0x4d, 0x9f, 0xc1, 0xc8, 0x00, 0xcc, 0x80, 0x47, 0xe8,
-	0xb1, 0x9e, 0x2b, 0x3f, 0xc9, 0x3d, 0xb8, 0x9e, 0x45, 0xd4, 0x67, 0x27, 0x69, 0x14, 0xb0, 0xdc,
-	0x9b, 0xe4, 0x69, 0x91, 0xa1, 0xbb, 0xfa, 0xee, 0xa0, 0x21, 0x78, 0x24, 0x71, 0xf2, 0x0e, 0x74,
-	0x03, 0x1e, 0x79, 0x62, 0x9a, 0x31, 0x74, 0xd9, 0xea, 0x39, 0xb6, 0x0f, 0x79, 0xf4, 0x64, 0x9a,
-	0x31, 0xb7, 0x13, 0xa8, 0x0f, 0xf2, 0x00, 0xd6, 0x38, 0xcb, 0x43, 0x1a, 0x85, 0x9f, 0xb2, 0xc0,
-	0x63, 0xcf, 0xb3, 0xdc, 0xcb, 0x22, 0x9a, 0xa0, 0x67, 0xfb, 0x2e, 0xa9, 0x65, 0x1f, 0x3c, 0xcf,
-	0xf2, 0xc3, 0x88, 0x26, 0x64, 0x0b, 0x06, 0x69, 0x21, 0xb2, 0x42, 0x78, 0x98, 0x7d, 0xdc, 0x0b,
-	0x03, 0x74, 0xb4, 0xe9, 0xae, 0x2a, 0xfc, 0xdb, 0x08, 0x8f, 0x02, 0x49, 0xad, 0xc8, 0xe9, 0x29,
-	0x8b, 0xbc, 0x2a, 0x02, 0x6c, 0x6b, 0xd3, 0xd8, 0x6a, 0xbb, 0xd7, 0x14, 0xfe, 0xa4, 0x84, 0xc9,
-	0x36, 0xdc, 0x98, 0x14, 0x34, 0xa7, 0x89, 0x60, 0xac, 0xa1, 0xdd, 0x47, 0x6d, 0x52, 0x89, 0xaa,
-	0x09, 0xce, 0x2f, 0xdb, 0xb5, 0xeb, 0xa5, 0x97, 0xf8, 0x15, 0x5c, 0x7f, 0x95, 0xbe, 0x70, 0x61,
-	0xbc, 0x98, 0x8b, 0xe3, 0xe5, 0x2e, 0x58, 0x31, 0x13, 0x79, 0xe8, 0x2b, 0xbf, 0xa8, 0x34, 0x06,
-	0x05, 0x21, 0xf9, 0x77, 0xc1, 0x4a, 0x8a, 0xd8, 0xfb, 0xa4, 0x60, 0x79, 0xc8, 0xb8, 0x4e, 0x65,
-	0x48, 0x8a, 0xf8, 0x7b, 0x0a, 0x21, 0x37, 0x60, 0x49, 0xa4, 0x99, 0xf7, 0x54, 0x67, 0x72, 0x5b,
-	0xa4, 0xd9, 0x63, 0xf2, 0x4d, 0xd8, 0xe0, 0x8c, 0x46, 0x2c, 0xf0, 0xaa, 0xac, 0xe4, 0x1e, 0x47,
-	0x2e, 0x58, 0x60, 0x77, 0xd0, 0x15, 0xb6, 0xd2, 0x38, 0xaa, 0x14, 0x8e, 0xb4, 0x5c, 0x32, 0x5d,
-	0x1d, 0xbc, 0x31, 0xad, 0x8b, 0xcd, 0x13, 0xa9, 0x45, 0xd5, 0x84, 0x77, 0xc1, 0x9e, 0x44, 0xe9,
-	0x98, 0x46, 0xde, 0x99, 0x5d, 0xb1, 0x4b, 0x33, 0xdd, 0x9b, 0x4a, 0x7e, 0x34, 0xb7, 0xa5, 0x34,
-	0x8f, 0x47, 0xa1, 0xcf, 0x02, 0x6f, 0x1c, 0xa5, 0x63, 0x1b, 0x30, 0xa4, 0x40, 0x41, 0x32, 0x91,
-	0x65, 0x28, 0x69, 0x05, 0x49, 0x83, 0x9f, 0x16, 0x89, 0xc0, 0x00, 0x31, 0xdd, 0x55, 0x85, 0x1f,
-	0x14, 0xf1, 0x9e, 0x44, 0xc9, 0x1b, 0xb0, 0xa2, 0x35, 0xd3, 0xe3, 0x63, 0xce, 0x04, 0x46, 0x86,
-	0xe9, 0xf6, 0x15, 0xf8, 0x5d, 0xc4, 0x9c, 0x1f, 0x9b, 0x70, 0xcd, 0x95, 0xec, 0xb2, 0x53, 0xf6,
-	0x5f, 0x5f, 0x10, 0xce, 0x4b, 0xcc, 0xe5, 0x17, 0x4a, 0xcc, 0xce, 0xa5, 0x13, 0xb3, 0xfb, 0x42,
-	0x89, 0xd9, 0x3b, 0x37, 0x31, 0x7f, 0x37, 0xe3, 0x84, 0xcf, 0x6a, 0x6a, 0xbe, 0x05, 0x66, 0x18,
-	0x70, 0x74, 0x8e, 0xb5, 0x63, 0xcf, 0x2e, 0xae, 0x9f, 0x4c, 0x46, 0x43, 0xee, 0x4a, 0x25, 0xf2,
-	0x10, 0x2c, 0x4d, 0x28, 0x5e, 0x4f, 0x4b, 0x78, 0x3d, 0xdd, 0x59, 0x38, 0x07, 0x19, 0x96, 0x57,
-	0x93, 0xab, 0x1a, 0x20, 0x2e, 0xbf, 0xc9, 0xb7, 0xe0, 0xd6, 0xd9, 0x84, 0xcd, 0x35, 0x47, 0x81,
-	0xbd, 0x8c, 0x3e, 0x5a, 0x9f, 0xcf, 0xd8, 0x92, 0xc4, 0x80, 0x7c, 0x05, 0xd6, 0x1a, 0x29, 0x5b,
-	0x4f, 0xec, 0xa8, 0xdf, 0x48, 0xb5, 0xac, 0x9e, 0x72, 0x51, 0xd2, 0x76, 0x2f, 0x4a, 0x5a, 0xe7,
-	0x2f, 0x06, 0xac, 0x0c, 0x59, 0xc4, 0xc4, 0x4b, 0xa4, 0xd0, 0x82, 0x5e, 0xa7, 0xb5, 0xb0, 0xd7,
-	0x99, 0x69, 0x26, 0xcc, 0x8b, 0x9b, 0x89, 0xf6, 0x99, 0x66, 0xe2, 0x75, 0xe8, 0x67, 0x79, 0x18,
-	0xd3, 0x7c, 0xea, 0x3d, 0x65, 0xd3, 0x32, 0x8d, 0x2c, 0x8d, 0x3d, 0x66, 0x53, 0xee, 0x24, 0xb0,
-	0xf1, 0x61, 0x4a, 0x83, 0x5d, 0x1a, 0xd1, 0xc4, 0x67, 0xda, 0x4c, 0x7e, 0x75, 0xcb, 0xee, 0x00,
-	0x34, 0x98, 0x6c, 0xe1, 0x86, 0x0d, 0xc4, 0xf9, 0xbb, 0x01, 0x3d, 0xb9, 0x21, 0xb6, 0xe0, 0x57,
-	0x58, 0x7f, 0xa6, 0xf7, 0x6a, 0x2d, 0xe8, 0xbd, 0xaa, 0x2e, 0xba, 0xa4, 0xab, 0x6e, 0xab, 0x1b,
-	0xed, 0x71, 0x7b, 0xb6, 0x3d, 0xbe, 0x0b, 0x56, 0x28, 0x0f, 0xe4, 0x65, 0x54, 0x9c, 0x28, 0x9e,
-	0x7a, 0x2e, 0x20, 0x74, 0x28, 0x11, 0xd9, 0x3f, 0x97, 0x0a, 0xd8, 0x3f, 0x2f, 0x5f, 0xba, 0x7f,
-	0xd6, 0x8b, 0x60, 0xff, 0xfc, 0xc7, 0x16, 0xd8, 0x9a, 0xe2, 0xfa, 0x31, 0xea, 0xa3, 0x2c, 0xc0,
-	0x37, 0xb1, 0xdb, 0xd0, 0xab, 0xa2, 0x4c, 0xbf, 0x05, 0xd5, 0x80, 0xe4, 0x75, 0x9f, 0xc5, 0x69,
-	0x3e, 0x3d, 0x0a, 0x3f, 0x65, 0xda, 0xf0, 0x06, 0x22, 0x6d, 0x3b, 0x28, 0x62, 0x37, 0x7d, 0xc6,
-	0x75, 0xb1, 0x2d, 0x87, 0xd2, 0x36, 0x1f, 0x7f, 0xf5, 0x60, 0x75, 0x42, 0xcb, 0xdb, 0x2e, 0x28,
-	0x48, 0x56, 0x25, 0xb2, 0x0e, 0x5d, 0x96, 0x04, 0x4a, 0xba, 0x84, 0xd2, 0x0e, 0x4b, 0x02, 0x14,
-	0x8d, 0x60, 0x55, 0x3f, 0x42, 0xa5, 0x1c, 0x0b, 0x2f, 0x56, 0x57, 0x6b, 0xc7, 0x39, 0xe7, 0xe5,
-	0x6f, 0x9f, 0x4f, 0x0e, 0xb5, 0xa6, 0xbb, 0xa2, 0xde, 0xa1, 0xf4, 0x90, 0x7c, 0x00, 0x7d, 0xb9,
-	0x4b, 0xb5, 0x50, 0xe7, 0xd2, 0x0b, 0x59, 0x2c, 0x09, 0xca, 0x81, 0xf3, 0x2b, 0x03, 0xae, 0x9f,
-	0xa1, 0xf0, 0x0a, 0x71, 0xf4, 0x18, 0xba, 0x47, 0x6c, 0x22, 0x97, 0x28, 0x9f, 0xd6, 0xb6, 0xcf,
-	0x7b, 0xa9, 0x3d, 0xc7, 0x61, 0x6e, 0xb5, 0x80, 0xf3, 0x13, 0x03, 0x00, 0x03, 0x1a, 0x87, 0x67,
-	0x82, 0xc5, 0xb8, 0x4a, 0xb0, 0xc8, 0xfb, 0x4d, 0x5e, 0xfa, 0x39, 0x8b, 0xa8, 0xa8, 0xeb, 0x13,
-	0xd7, 0xbe, 0x27, 0x49, 0x11, 0xbb, 0x4a, 0x54, 0x26, 0xad, 0xf3, 0x0b, 0x03, 0x00, 0x0b, 0xac,
-	0x3a, 0xc6, 0xfc, 0x45, 0x6b, 0x5c, 0xfc, 0x8b, 0xb1, 0x35, 0x9b, 0x12, 0xbb, 0x65, 0x4a, 0x70,
-	0xe4, 0xc8, 0x5c, 0x64, 0x43, 0xc5, 0x51, 0x6d, 0xbc, 0xce, 0x1a, 0xc5, 0xcb, 0xaf, 0x0d, 0xe8,
-	0x37, 0xe8, 0xe3, 0xb3, 0xd9, 0x6b, 0xcc, 0x67, 0x2f, 0xb6, 0x83, 0x32, 0xa2, 0x3d, 0xde, 0x08,
-	0xf2, 0xb8, 0x0e, 0xf2, 0x75, 0xe8, 0x22, 0x25, 0x8d, 0x28, 0x4f, 0x74, 0x94, 0xdf, 0x83, 0xeb,
-	0x39, 0xf3, 0x59, 0x22, 0xa2, 0xa9, 0x17, 0xa7, 0x41, 0x78, 0x1c, 0xb2, 0x00, 0x63, 0xbd, 0xeb,
-	0x0e, 0x4a, 0xc1, 0xbe, 0xc6, 0x9d, 0x3f, 0x1b, 0xb0, 0x2a, 0x3b, 0xc8, 0xe9, 0x41, 0x1a, 0x30,
-	0x75, 0xb2, 0x17, 0x8f, 0xa0, 0xf7, 0xd0, 0x16, 0x4d, 0x8f, 0x0a, 0xa1, 0x37, 0xfe, 0x79, 0x08,
-	0x71, 0xb7, 0xcb, 0x75, 0xd8, 0x48, 0x8a, 0xd5, 0x2b, 0xc0, 0x65, 0x28, 0xae, 0x1d, 0xab, 0xaf,
-	0x4e, 0x45, 0xf1, 0x8f, 0x0c, 0xb0, 0x1a, 0xc9, 0x22, 0x4b, 0xbe, 0xbe, 0x1f, 0xd4, 0xb5, 0x62,
-	0x60, 0x11, 0xb4, 0xfc, 0xfa, 0xad, 0x8f, 0xac, 0xc1, 0x52, 0xcc, 0x27, 0xda, 0xe3, 0x7d, 0x57,
-	0x0d, 0xc8, 0x06, 0x74, 0x63, 0x3e, 0xc1, 0x1f, 0x4b, 0xba, 0x72, 0x56, 0x63, 0xe9, 0xb6, 0xba,
-	0xb3, 0x51, 0x05, 0xa4, 0x06, 0x9c, 0xdf, 0x1a, 0x40, 0x74, 0xe3, 0xf0, 0x52, 0x0f, 0xc2, 0x18,
-	0xb0, 0xcd, 0xf7, 0xca, 0x16, 0x96, 0xe1, 0x19, 0x6c, 0xee, 0xca, 0x33, 0xcf, 0x5c, 0x79, 0xf7,
-	0xe0, 0x7a, 0xc0, 0x8e, 0xa9, 0xec, 0x71, 0xe6, 0x8f, 0x3c, 0xd0, 0x82, 0xaa, 0x15, 0x7b, 0xeb,
-	0x5d, 0xe8, 0x55, 0xff, 0xc3, 0x90, 0x01, 0xf4, 0x47, 0x49, 0x28, 0xb0, 0x69, 0x0c, 0x93, 0xc9,
-	0xe0, 0x73, 0xc4, 0x82, 0xce, 0x77, 0x18, 0x8d, 0xc4, 0xc9, 0x74, 0x60, 0x90, 0x3e, 0x74, 0xdf,
-	0x1f, 0x27, 0x69, 0x1e, 0xd3, 0x68, 0xd0, 0xda, 0x7d, 0xe7, 0x07, 0x5f, 0x9b, 0x84, 0xe2, 0xa4,
-	0x18, 0x4b, 0x4b, 0xb6, 0x95, 0x69, 0x5f, 0x0e, 0x53, 0xfd, 0xb5, 0x5d, 0x7a, 0x6d, 0x1b, 0xad,
-	0xad, 0x86, 0xd9, 0x78, 0xbc, 0x8c, 0xc8, 0xdb, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0x99, 0x69,
-	0x35, 0xab, 0xad, 0x1a, 0x00, 0x00,
+	// 2091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5f, 0x8f, 0x1b, 0x49,
+	0x11, 0xbf, 0xf1, 0x78, 0xd7, 0x76, 0xd9, 0xbb, 0xeb, 0x74, 0x36, 0xb9, 0xc9, 0x7f, 0xdf, 0xe4,
+	0x80, 0x25, 0xd1, 0x25, 0x61, 0x0f, 0xb8, 0x13, 0x42, 0xe4, 0x2e, 0xeb, 0x90, 0xb3, 0x92, 0x2c,
+	0xcb, 0x6c, 0xee, 0x24, 0x78, 0x19, 0xb5, 0x67, 0x7a, 0xbd, 0x4d, 0xe6, 0xdf, 0x4d, 0xb7, 0x37,
+	0xf1, 0x3d, 0x81, 0xc4, 0x13, 0x08, 0x24, 0x90, 0x90, 0xf8, 0x14, 0xbc, 0xf2, 0xc4, 0x1f, 0xf1,
+	0x84, 0xc4, 0x27, 0xe0, 0x53, 0xf0, 0xce, 0x13, 0xea, 0xea, 0x9e, 0xf1, 0xd8, 0x6b, 0x2f, 0x9b,
+	0x8d, 0x80, 0x43, 0xf0, 0xe6, 0xfe, 0x55, 0x75, 0x4d, 0xd7, 0xaf, 0xaa, 0xab, 0xab, 0xdb, 0xb0,
+	0xce, 0x13, 0xc9, 0xf2, 0x84, 0x46, 0x77, 0xb2, 0x3c, 0x95, 0x29, 0xb9, 0x10, 0xf3, 0xe8, 0x68,
+	0x2c, 0xf4, 0xe8, 0x4e, 0x21, 0xbc, 0xdc, 0x09, 0xd2, 0x38, 0x4e, 0x13, 0x0d, 0x5f, 0xee, 0x88,
+	0xe0, 0x90, 0xc5, 0x54, 0x8f, 0xdc, 0xdf, 0x5b, 0xb0, 0xb6, 0x93, 0xc6, 0x59, 0x9a, 0xb0, 0x44,
+	0x0e, 0x92, 0x83, 0x94, 0x5c, 0x84, 0xd5, 0x24, 0x0d, 0xd9, 0xa0, 0xef, 0x58, 0x3d, 0x6b, 0xcb,
+	0xf6, 0xcc, 0x88, 0x10, 0xa8, 0xe7, 0x69, 0xc4, 0x9c, 0x5a, 0xcf, 0xda, 0x6a, 0x79, 0xf8, 0x9b,
+	0xdc, 0x07, 0x10, 0x92, 0x4a, 0xe6, 0x07, 0x69, 0xc8, 0x1c, 0xbb, 0x67, 0x6d, 0xad, 0x6f, 0xf7,
+	0xee, 0x2c, 0x5c, 0xc5, 0x9d, 0x7d, 0xa5, 0xb8, 0x93, 0x86, 0xcc, 0x6b, 0x89, 0xe2, 0x27, 0xf9,
+	0x00, 0x80, 0xbd, 0x94, 0x39, 0xf5, 0x79, 0x72, 0x90, 0x3a, 0xf5, 0x9e, 0xbd, 0xd5, 0xde, 0x7e,
+	0x6b, 0xd6, 0x80, 0x59, 0xfc, 0x63, 0x36, 0xf9, 0x84, 0x46, 0x63, 0xb6, 0x47, 0x79, 0xee, 0xb5,
+	0x70, 0x92, 0x5a, 0xae, 0xfb, 0x57, 0x0b, 0x36, 0x4a, 0x07, 0xf0, 0x1b, 0x82, 0x7c, 0x03, 0x56,
+	0xf0, 0x13, 0xe8, 0x41, 0x7b, 0xfb, 0xed, 0x25, 0x2b, 0x9a, 0xf1, 0xdb, 0xd3, 0x53, 0xc8, 0xc7,
+	0x70, 0x5e, 0x8c, 0x87, 0x41, 0x21, 0xf2, 0x11, 0x15, 0x4e, 0xad, 0x67, 0x9f, 0xda, 0x12, 0xa9,
+	0x1a, 0x30, 0x4b, 0x7a, 0x17, 0x56, 0x95, 0xa5, 0xb1, 0x40, 0x96, 0xda, 0xdb, 0x57, 0x16, 0x3a,
+	0xb9, 0x8f, 0x2a, 0x9e, 0x51, 0x75, 0xaf, 0xc0, 0xa5, 0x47, 0x4c, 0xce, 0x79, 0xe7, 0xb1, 0x4f,
+	0xc7, 0x4c, 0x48, 0x23, 0x7c, 0xc6, 0x63, 0xf6, 0x8c, 0x07, 0xcf, 0x77, 0x0e, 0x69, 0x92, 0xb0,
+	0xa8, 0x10, 0x5e, 0x83, 0x2b, 0x8f, 0x18, 0x4e, 0xe0, 0x42, 0xf2, 0x40, 0xcc, 0x89, 0x2f, 0xc0,
+	0xf9, 0x47, 0x4c, 0xf6, 0xc3, 0x39, 0xf8, 0x13, 0x68, 0xee, 0xaa, 0x60, 0xab, 0x34, 0xf8, 0x3a,
+	0x34, 0x68, 0x18, 0xe6, 0x4c, 0x08, 0xc3, 0xe2, 0xd5, 0x85, 0x2b, 0xfe, 0x50, 0xeb, 0x78, 0x85,
+	0xf2, 0xa2, 0x34, 0x71, 0x7f, 0x00, 0x30, 0x48, 0xb8, 0xdc, 0xa3, 0x39, 0x8d, 0xc5, 0xd2, 0x04,
+	0xeb, 0x43, 0x47, 0x48, 0x9a, 0x4b, 0x3f, 0x43, 0x3d, 0xa7, 0x76, 0xda, 0x6c, 0x68, 0xe3, 0x34,
+	0x6d, 0xdd, 0xfd, 0x1e, 0xc0, 0xbe, 0xcc, 0x79, 0x32, 0x7a, 0xc2, 0x85, 0x54, 0xdf, 0x3a, 0x52,
+	0x7a, 0xca, 0x09, 0x7b, 0xab, 0xe5, 0x99, 0x51, 0x25, 0x1c, 0xb5, 0xd3, 0x87, 0xe3, 0x3e, 0xb4,
+	0x0b, 0xba, 0x9f, 0x8a, 0x11, 0xb9, 0x07, 0xf5, 0x21, 0x15, 0xec, 0x44, 0x7a, 0x9e, 0x8a, 0xd1,
+	0x03, 0x2a, 0x98, 0x87, 0x9a, 0xee, 0x4f, 0x6c, 0x78, 0x73, 0x27, 0x67, 0x98, 0xfc, 0x51, 0xc4,
+	0x02, 0xc9, 0xd3, 0xc4, 0x70, 0xff, 0xea, 0xd6, 0xc8, 0x9b, 0xd0, 0x08, 0x87, 0x7e, 0x42, 0xe3,
+	0x82, 0xec, 0xd5, 0x70, 0xb8, 0x4b, 0x63, 0x46, 0xbe, 0x08, 0xeb, 0x41, 0x69, 0x5f, 0x21, 0x98,
+	0x73, 0x2d, 0x6f, 0x0e, 0x25, 0x6f, 0xc3, 0x5a, 0x46, 0x73, 0xc9, 0x4b, 0xb5, 0x3a, 0xaa, 0xcd,
+	0x82, 0x2a, 0xa0, 0xe1, 0x70, 0xd0, 0x77, 0x56, 0x30, 0x58, 0xf8, 0x9b, 0xb8, 0xd0, 0x99, 0xda,
+	0x1a, 0xf4, 0x9d, 0x55, 0x94, 0xcd, 0x60, 0xa4, 0x07, 0xed, 0xd2, 0xd0, 0xa0, 0xef, 0x34, 0x50,
+	0xa5, 0x0a, 0xa9, 0xe0, 0xe8, 0x5a, 0xe4, 0x34, 0x7b, 0xd6, 0x56, 0xc7, 0x33, 0x23, 0x72, 0x0f,
+	0xce, 0x1f, 0xf1, 0x5c, 0x8e, 0x69, 0x64, 0xf2, 0x53, 0xad, 0x43, 0x38, 0x2d, 0x8c, 0xe0, 0x22,
+	0x11, 0xd9, 0x86, 0xcd, 0xec, 0x70, 0x22, 0x78, 0x30, 0x37, 0x05, 0x70, 0xca, 0x42, 0x99, 0xfb,
+	0x27, 0x0b, 0x2e, 0xf4, 0xf3, 0x34, 0xfb, 0x5c, 0x84, 0xa2, 0x20, 0xb9, 0x7e, 0x02, 0xc9, 0x2b,
+	0xc7, 0x49, 0x76, 0x7f, 0x56, 0x83, 0x8b, 0x3a, 0xa3, 0xf6, 0x0a, 0x62, 0xff, 0x05, 0x5e, 0x7c,
+	0x09, 0x36, 0xa6, 0x5f, 0xf5, 0x93, 0xe5, 0x6e, 0x7c, 0x01, 0xd6, 0xcb, 0x00, 0x6b, 0xbd, 0x7f,
+	0x6f, 0x4a, 0xb9, 0x3f, 0xad, 0xc1, 0xa6, 0x0a, 0xea, 0xff, 0xd9, 0x50, 0x6c, 0xfc, 0xa1, 0x06,
+	0x44, 0x67, 0xc7, 0x20, 0x09, 0xd9, 0xcb, 0xff, 0x24, 0x17, 0xd7, 0x00, 0x0e, 0x38, 0x8b, 0xc2,
+	0x2a, 0x0f, 0x2d, 0x44, 0x5e, 0x8b, 0x03, 0x07, 0x1a, 0x68, 0xa4, 0xf4, 0xbf, 0x18, 0xaa, 0xd3,
+	0x44, 0x77, 0x16, 0xe6, 0x34, 0x69, 0x9e, 0xfa, 0x34, 0xc1, 0x69, 0xe6, 0x34, 0xf9, 0x8d, 0x0d,
+	0x6b, 0x83, 0x44, 0xb0, 0x5c, 0xfe, 0x2f, 0x27, 0x12, 0xb9, 0x0a, 0x2d, 0xc1, 0x46, 0xb1, 0x6a,
+	0x70, 0xfa, 0x58, 0xac, 0x6d, 0x6f, 0x0a, 0x28, 0x69, 0xa0, 0x2b, 0xeb, 0xa0, 0xef, 0xb4, 0x74,
+	0x68, 0x4b, 0x80, 0x5c, 0x07, 0x90, 0x3c, 0x66, 0x42, 0xd2, 0x38, 0xd3, 0x15, 0xb9, 0xee, 0x55,
+	0x10, 0x75, 0x0a, 0xe4, 0xe9, 0x8b, 0x41, 0x5f, 0x38, 0xed, 0x9e, 0xad, 0xda, 0x01, 0x3d, 0x22,
+	0x5f, 0x85, 0x66, 0x9e, 0xbe, 0xf0, 0x43, 0x2a, 0xa9, 0xd3, 0xc1, 0xe0, 0x5d, 0x5a, 0x48, 0xf6,
+	0x83, 0x28, 0x1d, 0x7a, 0x8d, 0x3c, 0x7d, 0xd1, 0xa7, 0x92, 0xba, 0x7f, 0xab, 0xc3, 0xda, 0x3e,
+	0xa3, 0x79, 0x70, 0x78, 0xf6, 0x80, 0x7d, 0x19, 0xba, 0x39, 0x13, 0xe3, 0x48, 0xfa, 0x53, 0xb7,
+	0x74, 0xe4, 0x36, 0x34, 0xbe, 0x53, 0x3a, 0x57, 0x50, 0x6e, 0x9f, 0x40, 0x79, 0x7d, 0x01, 0xe5,
+	0x2e, 0x74, 0x2a, 0xfc, 0x0a, 0x67, 0x05, 0x5d, 0x9f, 0xc1, 0x48, 0x17, 0xec, 0x50, 0x44, 0x18,
+	0xb1, 0x96, 0xa7, 0x7e, 0x92, 0xdb, 0x70, 0x2e, 0x8b, 0x68, 0xc0, 0x0e, 0xd3, 0x28, 0x64, 0xb9,
+	0x3f, 0xca, 0xd3, 0x71, 0x86, 0xe1, 0xea, 0x78, 0xdd, 0x8a, 0xe0, 0x91, 0xc2, 0xc9, 0x7b, 0xd0,
+	0x0c, 0x45, 0xe4, 0xcb, 0x49, 0xc6, 0x30, 0x64, 0xeb, 0x4b, 0x7c, 0xef, 0x8b, 0xe8, 0xd9, 0x24,
+	0x63, 0x5e, 0x23, 0xd4, 0x3f, 0xc8, 0x3d, 0xd8, 0x14, 0x2c, 0xe7, 0x34, 0xe2, 0x9f, 0xb1, 0xd0,
+	0x67, 0x2f, 0xb3, 0xdc, 0xcf, 0x22, 0x9a, 0x60, 0x64, 0x3b, 0x1e, 0x99, 0xca, 0x1e, 0xbe, 0xcc,
+	0xf2, 0xbd, 0x88, 0x26, 0x64, 0x0b, 0xba, 0xe9, 0x58, 0x66, 0x63, 0xe9, 0xe3, 0xee, 0x13, 0x3e,
+	0x0f, 0x31, 0xd0, 0xb6, 0xb7, 0xae, 0xf1, 0x6f, 0x23, 0x3c, 0x08, 0x15, 0xb5, 0x32, 0xa7, 0x47,
+	0x2c, 0xf2, 0xcb, 0x0c, 0x70, 0xda, 0x3d, 0x6b, 0xab, 0xee, 0x6d, 0x68, 0xfc, 0x59, 0x01, 0x93,
+	0xbb, 0x70, 0x7e, 0x34, 0xa6, 0x39, 0x4d, 0x24, 0x63, 0x15, 0xed, 0x0e, 0x6a, 0x93, 0x52, 0x34,
+	0x9d, 0x70, 0x19, 0x9a, 0x21, 0xa3, 0x61, 0xc4, 0x13, 0xe6, 0xac, 0x21, 0xe7, 0xe5, 0x98, 0xdc,
+	0x87, 0x66, 0x96, 0xf3, 0x34, 0xe7, 0x72, 0xe2, 0xac, 0x23, 0x19, 0x37, 0x97, 0xb4, 0xf2, 0x1e,
+	0xa3, 0xe1, 0x9e, 0x51, 0xf5, 0xca, 0x49, 0xaa, 0xd0, 0xe8, 0x36, 0x55, 0x38, 0x1b, 0xe8, 0x59,
+	0x31, 0x74, 0x7f, 0x51, 0xc9, 0x38, 0x95, 0x1c, 0xe2, 0x0c, 0x19, 0x77, 0x96, 0x76, 0x74, 0x61,
+	0x9a, 0xda, 0x8b, 0xd3, 0xf4, 0x06, 0xb4, 0x63, 0x26, 0x73, 0x1e, 0xe8, 0x74, 0xd0, 0xd5, 0x03,
+	0x34, 0x84, 0x31, 0xbf, 0x01, 0xed, 0x64, 0x1c, 0xfb, 0x9f, 0x8e, 0x59, 0xce, 0x99, 0x30, 0x15,
+	0x04, 0x92, 0x71, 0xfc, 0x5d, 0x8d, 0x90, 0xf3, 0xb0, 0x22, 0xd3, 0xcc, 0x7f, 0x6e, 0x0a, 0x48,
+	0x5d, 0xa6, 0xd9, 0x63, 0xf2, 0x4d, 0xb8, 0x2c, 0x18, 0x8d, 0x58, 0xe8, 0x97, 0xc5, 0x40, 0xf8,
+	0x02, 0xb9, 0x60, 0xa1, 0xd3, 0x40, 0x9e, 0x1c, 0xad, 0xb1, 0x5f, 0x2a, 0xec, 0x1b, 0xb9, 0x0a,
+	0x70, 0xb9, 0xf0, 0xca, 0xb4, 0x26, 0xf6, 0x6c, 0x64, 0x2a, 0x2a, 0x27, 0xbc, 0x0f, 0xce, 0x28,
+	0x4a, 0x87, 0x34, 0xf2, 0x8f, 0x7d, 0x15, 0x9b, 0x43, 0xdb, 0xbb, 0xa8, 0xe5, 0xfb, 0x73, 0x9f,
+	0x54, 0xee, 0x89, 0x88, 0x07, 0x2c, 0xf4, 0x87, 0x51, 0x3a, 0x74, 0x00, 0x33, 0x19, 0x34, 0xa4,
+	0xea, 0x87, 0xca, 0x60, 0xa3, 0xa0, 0x68, 0x08, 0xd2, 0x71, 0x22, 0x31, 0x2f, 0x6d, 0x6f, 0x5d,
+	0xe3, 0xbb, 0xe3, 0x78, 0x47, 0xa1, 0xe4, 0x26, 0xac, 0x19, 0xcd, 0xf4, 0xe0, 0x40, 0x30, 0x89,
+	0x09, 0x69, 0x7b, 0x1d, 0x0d, 0x7e, 0x07, 0x31, 0xf7, 0xd7, 0x36, 0x6c, 0x78, 0x8a, 0x5d, 0x76,
+	0xc4, 0xfe, 0xeb, 0xeb, 0xd0, 0xb2, 0x7a, 0xb0, 0xfa, 0x4a, 0xf5, 0xa0, 0x71, 0xea, 0x7a, 0xd0,
+	0x7c, 0xa5, 0x7a, 0xd0, 0x5a, 0x5a, 0x0f, 0x36, 0x61, 0x25, 0xe2, 0x31, 0x97, 0x18, 0x6e, 0xdb,
+	0xd3, 0x03, 0xf7, 0x77, 0x33, 0xa1, 0xf9, 0xbc, 0x6e, 0xd8, 0x5b, 0x60, 0xf3, 0x50, 0x60, 0xc8,
+	0xda, 0xdb, 0xce, 0xac, 0x71, 0xf3, 0x7e, 0x33, 0xe8, 0x0b, 0x4f, 0x29, 0x91, 0xfb, 0xd0, 0x36,
+	0x34, 0xe3, 0x59, 0xb9, 0x82, 0x67, 0xe5, 0xf5, 0x85, 0x73, 0x90, 0x77, 0x75, 0x4e, 0x7a, 0xba,
+	0x1b, 0x13, 0xea, 0x37, 0xf9, 0x16, 0x5c, 0x39, 0xbe, 0x8d, 0x73, 0xc3, 0x51, 0xe8, 0xac, 0x62,
+	0xe4, 0x2e, 0xcd, 0xef, 0xe3, 0x82, 0xc4, 0x90, 0x7c, 0x05, 0x36, 0x2b, 0x1b, 0x79, 0x3a, 0xb1,
+	0xa1, 0x2f, 0x6c, 0x53, 0xd9, 0x74, 0xca, 0x49, 0x5b, 0xb9, 0x79, 0xd2, 0x56, 0x76, 0xff, 0x62,
+	0xc1, 0x5a, 0x9f, 0x45, 0x4c, 0xbe, 0xc6, 0xc6, 0x5a, 0xd0, 0x78, 0xd5, 0x16, 0x36, 0x5e, 0x33,
+	0x9d, 0x8d, 0x7d, 0x72, 0x67, 0x53, 0x3f, 0xd6, 0xd9, 0xbc, 0x05, 0x9d, 0x2c, 0xe7, 0x31, 0xcd,
+	0x27, 0xfe, 0x73, 0x36, 0x29, 0x36, 0x57, 0xdb, 0x60, 0x8f, 0xd9, 0x44, 0xb8, 0x09, 0x5c, 0x7e,
+	0x92, 0xd2, 0xf0, 0x01, 0x8d, 0x68, 0x12, 0x30, 0xe3, 0xa6, 0x38, 0xbb, 0x67, 0xd7, 0x01, 0x2a,
+	0x4c, 0xd6, 0xf0, 0x83, 0x15, 0xc4, 0xfd, 0xbb, 0x05, 0x2d, 0xf5, 0x41, 0xbc, 0x0f, 0x9c, 0xc1,
+	0xfe, 0x4c, 0x23, 0x58, 0x5b, 0xd0, 0x08, 0x96, 0x2d, 0x7d, 0x41, 0x57, 0x09, 0x54, 0x7b, 0xf5,
+	0xfa, 0x6c, 0xaf, 0x7e, 0x03, 0xda, 0x5c, 0x2d, 0xc8, 0xcf, 0xa8, 0x3c, 0xd4, 0x3c, 0xb5, 0x3c,
+	0x40, 0x68, 0x4f, 0x21, 0xaa, 0x99, 0x2f, 0x14, 0xb0, 0x99, 0x5f, 0x3d, 0x75, 0x33, 0x6f, 0x8c,
+	0x60, 0x33, 0xff, 0xc7, 0x1a, 0x38, 0x86, 0xe2, 0xe9, 0xcb, 0xd8, 0xc7, 0x59, 0x88, 0x0f, 0x74,
+	0x57, 0xa1, 0x55, 0x66, 0x99, 0x79, 0x98, 0x9a, 0x02, 0x8a, 0xd7, 0xa7, 0x2c, 0x4e, 0xf3, 0xc9,
+	0x3e, 0xff, 0x8c, 0x19, 0xc7, 0x2b, 0x88, 0xf2, 0x6d, 0x77, 0x1c, 0x7b, 0xe9, 0x0b, 0x61, 0x4a,
+	0x70, 0x31, 0x54, 0xbe, 0x05, 0x78, 0x05, 0xc3, 0x9a, 0x85, 0x9e, 0xd7, 0x3d, 0xd0, 0x90, 0xaa,
+	0x55, 0xe4, 0x12, 0x34, 0x59, 0x12, 0x6a, 0xe9, 0x0a, 0x4a, 0x1b, 0x2c, 0x09, 0x51, 0x34, 0x80,
+	0x75, 0xf3, 0x22, 0x96, 0x0a, 0x2c, 0xc7, 0x58, 0x73, 0xdb, 0xdb, 0xee, 0x92, 0xde, 0xe5, 0xa9,
+	0x18, 0xed, 0x19, 0x4d, 0x6f, 0x4d, 0x3f, 0x8a, 0x99, 0x21, 0x79, 0x08, 0x1d, 0xf5, 0x95, 0xd2,
+	0x50, 0xe3, 0xd4, 0x86, 0xda, 0x2c, 0x09, 0x8b, 0x81, 0xfb, 0x4b, 0x0b, 0xce, 0x1d, 0xa3, 0xf0,
+	0x0c, 0x79, 0xf4, 0x18, 0x9a, 0xfb, 0x6c, 0xa4, 0x4c, 0x14, 0xef, 0x7c, 0x77, 0x97, 0x3d, 0x1b,
+	0x2f, 0x09, 0x98, 0x57, 0x1a, 0x70, 0x7f, 0x6c, 0xa9, 0xf7, 0xc5, 0x90, 0xbd, 0xc4, 0xe1, 0xb1,
+	0x64, 0xb1, 0xce, 0x92, 0x2c, 0xea, 0xd4, 0x53, 0xad, 0x40, 0xce, 0x22, 0x2a, 0xa7, 0xf5, 0x49,
+	0x98, 0xd8, 0x93, 0x64, 0x1c, 0x7b, 0x5a, 0x54, 0x6c, 0x5a, 0xf7, 0xe7, 0x16, 0x00, 0x16, 0x58,
+	0xbd, 0x8c, 0xf9, 0xe3, 0xd7, 0x3a, 0xf9, 0xfa, 0x5a, 0x9b, 0xdd, 0x12, 0x0f, 0x8a, 0x2d, 0x21,
+	0x90, 0x23, 0x7b, 0x91, 0x0f, 0x25, 0x47, 0x53, 0xe7, 0xcd, 0xae, 0xd1, 0xbc, 0xfc, 0xca, 0x82,
+	0x4e, 0x85, 0x3e, 0x31, 0xbb, 0x7b, 0xad, 0xf9, 0xdd, 0x8b, 0x4d, 0xa2, 0xca, 0x68, 0x5f, 0x54,
+	0x92, 0x3c, 0x9e, 0x26, 0xf9, 0x25, 0x68, 0x22, 0x25, 0x95, 0x2c, 0x4f, 0x4c, 0x96, 0xdf, 0x86,
+	0x73, 0x39, 0x0b, 0x58, 0x22, 0xa3, 0x89, 0x1f, 0xa7, 0x21, 0x3f, 0xe0, 0x2c, 0xc4, 0x5c, 0x6f,
+	0x7a, 0xdd, 0x42, 0xf0, 0xd4, 0xe0, 0xee, 0x9f, 0x2d, 0x58, 0x57, 0x7d, 0xe5, 0x44, 0x3d, 0x36,
+	0xeb, 0x95, 0xbd, 0x7a, 0x06, 0x7d, 0x80, 0xbe, 0xf8, 0xa2, 0x92, 0x42, 0x37, 0xff, 0x79, 0x0a,
+	0x09, 0xaf, 0x29, 0x4c, 0xda, 0x28, 0x8a, 0xf5, 0x93, 0xc4, 0x69, 0x28, 0x9e, 0x06, 0xd6, 0x1c,
+	0x9d, 0x9a, 0xe2, 0x1f, 0x5a, 0xd0, 0xae, 0x6c, 0x16, 0x55, 0xf2, 0xcd, 0xf9, 0xa0, 0x8f, 0x15,
+	0x0b, 0x8b, 0x60, 0x3b, 0x98, 0x3e, 0x3c, 0xaa, 0xb6, 0x24, 0x16, 0x23, 0x13, 0xf1, 0x8e, 0xa7,
+	0x07, 0xea, 0xf2, 0x12, 0x8b, 0x11, 0xde, 0xdc, 0x4c, 0xe5, 0x2c, 0xc7, 0x2a, 0x6c, 0xd3, 0x7e,
+	0x47, 0x17, 0x90, 0x29, 0xe0, 0xfe, 0xd6, 0x02, 0x62, 0x1a, 0x87, 0xd7, 0x7a, 0x9d, 0xc6, 0x84,
+	0xad, 0x3e, 0x9e, 0xd6, 0xb0, 0x0c, 0xcf, 0x60, 0x73, 0x47, 0x9e, 0x7d, 0xec, 0xc8, 0xbb, 0x0d,
+	0xe7, 0x42, 0x76, 0x40, 0x55, 0x8f, 0x33, 0xbf, 0xe4, 0xae, 0x11, 0x94, 0x0d, 0x9a, 0xfb, 0x23,
+	0x0b, 0x36, 0x1f, 0x1e, 0xb1, 0x44, 0xee, 0xa6, 0x92, 0x1f, 0xf0, 0x80, 0x2a, 0x0a, 0xcf, 0xb6,
+	0xf6, 0x6b, 0x00, 0x4c, 0x59, 0xd2, 0xf7, 0x1b, 0x7d, 0x98, 0xb7, 0x10, 0xc1, 0xeb, 0x8d, 0x03,
+	0x8d, 0x8c, 0x4e, 0xa2, 0x94, 0x86, 0x86, 0xdc, 0x62, 0x78, 0xeb, 0x7d, 0x68, 0x95, 0x7f, 0x4c,
+	0x91, 0x2e, 0x74, 0xd4, 0xff, 0x14, 0xd8, 0xce, 0xf2, 0x64, 0xd4, 0x7d, 0x83, 0xb4, 0xa1, 0xf1,
+	0x11, 0xa3, 0x91, 0x3c, 0x9c, 0x74, 0x2d, 0xd2, 0x81, 0xe6, 0x87, 0xc3, 0x24, 0xcd, 0x63, 0x1a,
+	0x75, 0x6b, 0xb7, 0xde, 0x81, 0x4e, 0xf5, 0xae, 0x48, 0x00, 0x56, 0x77, 0xb5, 0xec, 0x0d, 0xd2,
+	0x84, 0xfa, 0x47, 0x7c, 0x74, 0xd8, 0xb5, 0x48, 0x03, 0xec, 0x27, 0xe9, 0x8b, 0x6e, 0xed, 0xc1,
+	0x7b, 0xdf, 0xff, 0xda, 0x88, 0xcb, 0xc3, 0xf1, 0x50, 0x39, 0x70, 0x57, 0x7b, 0xf4, 0x0e, 0x4f,
+	0xcd, 0xaf, 0xbb, 0x45, 0xa2, 0xdd, 0x45, 0x27, 0xcb, 0x61, 0x36, 0x1c, 0xae, 0x22, 0xf2, 0xee,
+	0x3f, 0x06, 0x00, 0x3f, 0x12, 0x8c, 0x9c, 0xed, 0x1b, 0x00, 0x00,
 }
@@ -57,18 +57,21 @@ const (
 	PlaceholderType_None         PlaceholderType = 0
 	PlaceholderType_BinaryVector PlaceholderType = 100
 	PlaceholderType_FloatVector  PlaceholderType = 101
+	PlaceholderType_String       PlaceholderType = 20
 )
 
 var PlaceholderType_name = map[int32]string{
 	0:   "None",
 	100: "BinaryVector",
 	101: "FloatVector",
+	20:  "String",
 }
 
 var PlaceholderType_value = map[string]int32{
 	"None":         0,
 	"BinaryVector": 100,
 	"FloatVector":  101,
+	"String":       20,
 }
 
 func (x PlaceholderType) String() string {
@@ -79,7 +82,35 @@ func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
-//*
+type LoadState int32
+
+const (
+	LoadState_NotLoaded LoadState = 0
+	LoadState_Loading   LoadState = 1
+	LoadState_Loaded    LoadState = 2
+)
+
+var LoadState_name = map[int32]string{
+	0: "NotLoaded",
+	1: "Loading",
+	2: "Loaded",
+}
+
+var LoadState_value = map[string]int32{
+	"NotLoaded": 0,
+	"Loading":   1,
+	"Loaded":    2,
+}
+
+func (x LoadState) String() string {
+	return proto.EnumName(LoadState_name, int32(x))
+}
+
+func (LoadState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
+// *
 // Create collection in milvus
 type CreateCollectionRequest struct {
 	// Not useful for now
@@ -449,6 +480,7 @@ type DescribeCollectionResponse struct {
 	CreatedTimestamp     uint64                     `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 	CreatedUtcTimestamp  uint64                     `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
 	ShardsNum            int32                      `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,9,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -535,6 +567,13 @@ func (m *DescribeCollectionResponse) GetShardsNum() int32 {
 	return 0
 }
 
+func (m *DescribeCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type LoadCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -703,6 +742,7 @@ func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 type GetCollectionStatisticsResponse struct {
 	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	Storage              *CollectionStorageStats  `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -747,15 +787,27 @@ func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *GetCollectionStatisticsResponse) GetStorage() *CollectionStorageStats {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 type ShowCollectionsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	TimeStamp            uint64            `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	Type                 ShowType          `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TimeStamp            uint64                   `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	Type                 ShowType                 `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	CollectionNames      []string                 `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	PropertyFilter       []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=property_filter,json=propertyFilter,proto3" json:"property_filter,omitempty"`
+	NamePattern          string                   `protobuf:"bytes,7,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Limit                int64                    `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken            string                   `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithDetails          bool                     `protobuf:"varint,10,opt,name=with_details,json=withDetails,proto3" json:"with_details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
@@ -818,16 +870,54 @@ func (m *ShowCollectionsRequest) GetCollectionNames() []string {
 	return nil
 }
 
+func (m *ShowCollectionsRequest) GetPropertyFilter() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.PropertyFilter
+	}
+	return nil
+}
+
+func (m *ShowCollectionsRequest) GetNamePattern() string {
+	if m != nil {
+		return m.NamePattern
+	}
+	return ""
+}
+
+func (m *ShowCollectionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ShowCollectionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ShowCollectionsRequest) GetWithDetails() bool {
+	if m != nil {
+		return m.WithDetails
+	}
+	return false
+}
+
 type ShowCollectionsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CollectionNames      []string         `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	CollectionIds        []int64          `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
-	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionNames      []string            `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionIds        []int64             `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	CreatedTimestamps    []uint64            `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	CreatedUtcTimestamps []uint64            `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	InMemoryPercentages  []int64             `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	WarmupPercentages    []int64             `protobuf:"varint,7,rep,packed,name=warmup_percentages,json=warmupPercentages,proto3" json:"warmup_percentages,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Details              []*CollectionDetail `protobuf:"bytes,9,rep,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
@@ -897,6 +987,27 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
+func (m *ShowCollectionsResponse) GetWarmupPercentages() []int64 {
+	if m != nil {
+		return m.WarmupPercentages
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ShowCollectionsResponse) GetDetails() []*CollectionDetail {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
 type CreatePartitionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -1217,6 +1328,7 @@ type GetPartitionStatisticsRequest struct {
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Flush                bool              `protobuf:"varint,5,opt,name=flush,proto3" json:"flush,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1275,6 +1387,13 @@ func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
 	return ""
 }
 
+func (m *GetPartitionStatisticsRequest) GetFlush() bool {
+	if m != nil {
+		return m.Flush
+	}
+	return false
+}
+
 type GetPartitionStatisticsResponse struct {
 	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
@@ -1847,6 +1966,8 @@ type IndexDescription struct {
 	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
 	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	State                commonpb.IndexState      `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string                   `protobuf:"bytes,6,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1905,6 +2026,20 @@ func (m *IndexDescription) GetFieldName() string {
 	return ""
 }
 
+func (m *IndexDescription) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexDescription) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
 type DescribeIndexResponse struct {
 	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
@@ -2644,6 +2779,7 @@ type SearchRequest struct {
 	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
 	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ExprTemplateValues   []*schemapb.FieldData    `protobuf:"bytes,12,rep,name=expr_template_values,json=exprTemplateValues,proto3" json:"expr_template_values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -2751,6 +2887,13 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetExprTemplateValues() []*schemapb.FieldData {
+	if m != nil {
+		return m.ExprTemplateValues
+	}
+	return nil
+}
+
 type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
@@ -2809,6 +2952,8 @@ func (m *Hits) GetScores() []float32 {
 type SearchResults struct {
 	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	PartialResults       bool                       `protobuf:"varint,3,opt,name=partial_results,json=partialResults,proto3" json:"partial_results,omitempty"`
+	UnreachableShards    []string                   `protobuf:"bytes,4,rep,name=unreachable_shards,json=unreachableShards,proto3" json:"unreachable_shards,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -2853,6 +2998,20 @@ func (m *SearchResults) GetResults() *schemapb.SearchResultData {
 	return nil
 }
 
+func (m *SearchResults) GetPartialResults() bool {
+	if m != nil {
+		return m.PartialResults
+	}
+	return false
+}
+
+func (m *SearchResults) GetUnreachableShards() []string {
+	if m != nil {
+		return m.UnreachableShards
+	}
+	return nil
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2964,17 +3123,18 @@ func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
 }
 
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string                `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string              `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string              `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64                `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ExprTemplateValues   []*schemapb.FieldData `protobuf:"bytes,9,rep,name=expr_template_values,json=exprTemplateValues,proto3" json:"expr_template_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
@@ -3058,6 +3218,13 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetExprTemplateValues() []*schemapb.FieldData {
+	if m != nil {
+		return m.ExprTemplateValues
+	}
+	return nil
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
@@ -4021,316 +4188,2747 @@ func (m *GetMetricsResponse) GetComponentName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
-	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
-	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
-	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
-	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
-	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
-	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
-	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
-	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
-	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
-	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
-	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
-	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
-	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
-	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
-	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
-	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
-	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
-	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
-	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
-	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
-	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
-	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
-	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
-	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
-	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
-	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
-	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
-	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
-	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
-	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
-	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
-	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
-	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
-	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
-	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
-	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
-	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
-	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
-	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
-	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
-	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
-	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
-	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
-	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
-	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
-	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
-	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
-	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
-	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
-	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
-	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+type CheckHealthRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
+func (m *CheckHealthRequest) Reset()         { *m = CheckHealthRequest{} }
+func (m *CheckHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckHealthRequest) ProtoMessage()    {}
+func (*CheckHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
+}
 
-var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xd1, 0x9c, 0x5d, 0xee, 0xab, 0x76, 0x96, 0x5c, 0x35, 0x1f, 0x5a, 0xaf, 0x25, 0x8b, 0x1c, 0x7f,
-	0xb2, 0x29, 0xc9, 0x96, 0x2c, 0xca, 0xfe, 0xec, 0xcf, 0xfe, 0x12, 0x5b, 0x12, 0x63, 0x89, 0xb0,
-	0xe4, 0xd0, 0x43, 0xdb, 0x80, 0x63, 0x18, 0x83, 0xe1, 0x4e, 0x73, 0x39, 0xe0, 0xec, 0xcc, 0x66,
-	0xba, 0x57, 0xd4, 0xfa, 0x14, 0xc0, 0x49, 0x80, 0xc0, 0x8e, 0x8d, 0x20, 0x41, 0x82, 0x5c, 0x72,
-	0x48, 0xe2, 0x43, 0x6e, 0x71, 0x12, 0x20, 0x41, 0x0e, 0x41, 0x0e, 0x3e, 0xe4, 0x10, 0x20, 0x8f,
-	0x43, 0xce, 0xb9, 0xe4, 0xe8, 0x7f, 0x90, 0x43, 0xd0, 0x8f, 0x99, 0x9d, 0x59, 0xf6, 0x2c, 0x97,
-	0x5a, 0x3b, 0x24, 0x6f, 0x33, 0xd5, 0x55, 0xdd, 0x55, 0xd5, 0xd5, 0xd5, 0x55, 0x5d, 0x05, 0x7a,
-	0xc7, 0xf5, 0xee, 0xf5, 0xc8, 0xe5, 0x6e, 0x18, 0xd0, 0x00, 0xcd, 0x25, 0xff, 0x2e, 0x8b, 0x9f,
-	0xa6, 0xde, 0x0a, 0x3a, 0x9d, 0xc0, 0x17, 0xc0, 0xa6, 0x4e, 0x5a, 0x3b, 0xb8, 0x63, 0x8b, 0x3f,
-	0xe3, 0x53, 0x0d, 0x4e, 0xdf, 0x0c, 0xb1, 0x4d, 0xf1, 0xcd, 0xc0, 0xf3, 0x70, 0x8b, 0xba, 0x81,
-	0x6f, 0xe2, 0xaf, 0xf7, 0x30, 0xa1, 0xe8, 0x29, 0x98, 0xde, 0xb2, 0x09, 0x6e, 0x68, 0x4b, 0xda,
-	0x4a, 0x75, 0xf5, 0xcc, 0xe5, 0xd4, 0xdc, 0x72, 0xce, 0xbb, 0xa4, 0x7d, 0xc3, 0x26, 0xd8, 0xe4,
-	0x98, 0xe8, 0x34, 0x94, 0x9c, 0x2d, 0xcb, 0xb7, 0x3b, 0xb8, 0x91, 0x5b, 0xd2, 0x56, 0x2a, 0x66,
-	0xd1, 0xd9, 0x7a, 0xd5, 0xee, 0x60, 0xf4, 0x38, 0xcc, 0xb6, 0xe2, 0xf9, 0x05, 0x42, 0x9e, 0x23,
-	0xcc, 0x0c, 0xc0, 0x1c, 0x71, 0x11, 0x8a, 0x82, 0xbf, 0xc6, 0xf4, 0x92, 0xb6, 0xa2, 0x9b, 0xf2,
-	0x0f, 0x9d, 0x05, 0x20, 0x3b, 0x76, 0xe8, 0x10, 0xcb, 0xef, 0x75, 0x1a, 0x85, 0x25, 0x6d, 0xa5,
-	0x60, 0x56, 0x04, 0xe4, 0xd5, 0x5e, 0xc7, 0x78, 0x5f, 0x83, 0x85, 0xb5, 0x30, 0xe8, 0x1e, 0x0b,
-	0x21, 0x8c, 0x5f, 0x68, 0x30, 0x7f, 0xdb, 0x26, 0xc7, 0x43, 0xa3, 0x67, 0x01, 0xa8, 0xdb, 0xc1,
-	0x16, 0xa1, 0x76, 0xa7, 0xcb, 0xb5, 0x3a, 0x6d, 0x56, 0x18, 0x64, 0x93, 0x01, 0x8c, 0xb7, 0x40,
-	0xbf, 0x11, 0x04, 0x9e, 0x89, 0x49, 0x37, 0xf0, 0x09, 0x46, 0xd7, 0xa0, 0x48, 0xa8, 0x4d, 0x7b,
-	0x44, 0x32, 0xf9, 0xb0, 0x92, 0xc9, 0x4d, 0x8e, 0x62, 0x4a, 0x54, 0x34, 0x0f, 0x85, 0x7b, 0xb6,
-	0xd7, 0x13, 0x3c, 0x96, 0x4d, 0xf1, 0x63, 0xbc, 0x0d, 0x33, 0x9b, 0x34, 0x74, 0xfd, 0xf6, 0xe7,
-	0x38, 0x79, 0x25, 0x9a, 0xfc, 0xef, 0x1a, 0x3c, 0xb4, 0x86, 0x49, 0x2b, 0x74, 0xb7, 0x8e, 0x89,
-	0xe9, 0x1a, 0xa0, 0x0f, 0x20, 0xeb, 0x6b, 0x5c, 0xd5, 0x79, 0x33, 0x05, 0x1b, 0xda, 0x8c, 0xc2,
-	0xf0, 0x66, 0xfc, 0x24, 0x0f, 0x4d, 0x95, 0x50, 0x93, 0xa8, 0xef, 0x4b, 0xf1, 0x89, 0xca, 0x71,
-	0xa2, 0xf3, 0x69, 0x22, 0xe9, 0x0d, 0x06, 0xab, 0x6d, 0x72, 0x40, 0x7c, 0xf0, 0x86, 0xa5, 0xca,
-	0x2b, 0xa4, 0x5a, 0x85, 0x85, 0x7b, 0x6e, 0x48, 0x7b, 0xb6, 0x67, 0xb5, 0x76, 0x6c, 0xdf, 0xc7,
-	0x1e, 0xd7, 0x13, 0x69, 0x4c, 0x2f, 0xe5, 0x57, 0x2a, 0xe6, 0x9c, 0x1c, 0xbc, 0x29, 0xc6, 0x98,
-	0xb2, 0x08, 0x7a, 0x1a, 0x16, 0xbb, 0x3b, 0x7d, 0xe2, 0xb6, 0xf6, 0x11, 0x15, 0x38, 0xd1, 0x7c,
-	0x34, 0x9a, 0xa2, 0xba, 0x04, 0xa7, 0x5a, 0xdc, 0x5b, 0x39, 0x16, 0xd3, 0x9a, 0x50, 0x63, 0x91,
-	0xab, 0xb1, 0x2e, 0x07, 0x5e, 0x8f, 0xe0, 0x8c, 0xad, 0x08, 0xb9, 0x47, 0x5b, 0x09, 0x82, 0x12,
-	0x27, 0x98, 0x93, 0x83, 0x6f, 0xd0, 0xd6, 0x80, 0x26, 0xed, 0x67, 0xca, 0x2a, 0x3f, 0x73, 0x27,
-	0xb0, 0x9d, 0xe3, 0xe1, 0x67, 0x3e, 0xd4, 0xa0, 0x61, 0x62, 0x0f, 0xdb, 0xe4, 0x78, 0x1c, 0x01,
-	0xe3, 0x07, 0x1a, 0x3c, 0x72, 0x0b, 0xd3, 0x84, 0x31, 0x51, 0x9b, 0xba, 0x84, 0xba, 0x2d, 0x72,
-	0x94, 0x6c, 0x7d, 0xa4, 0xc1, 0xb9, 0x4c, 0xb6, 0x26, 0x39, 0x5b, 0xcf, 0x42, 0x81, 0x7d, 0x91,
-	0x46, 0x6e, 0x29, 0xbf, 0x52, 0x5d, 0x5d, 0x56, 0xd2, 0xbc, 0x82, 0xfb, 0x6f, 0x32, 0x97, 0xb5,
-	0x61, 0xbb, 0xa1, 0x29, 0xf0, 0x8d, 0x7f, 0x6a, 0xb0, 0xb8, 0xb9, 0x13, 0xec, 0x0d, 0x58, 0xfa,
-	0x22, 0x14, 0x94, 0xf6, 0x36, 0xf9, 0x21, 0x6f, 0x83, 0xae, 0xc2, 0x34, 0xed, 0x77, 0x31, 0x77,
-	0x54, 0x33, 0xab, 0x67, 0x2f, 0x2b, 0x62, 0x87, 0xcb, 0x8c, 0xc9, 0xd7, 0xfb, 0x5d, 0x6c, 0x72,
-	0x54, 0x74, 0x01, 0xea, 0x43, 0x2a, 0x8f, 0xce, 0xeb, 0x6c, 0x5a, 0xe7, 0xc4, 0xf8, 0x5d, 0x0e,
-	0x4e, 0xef, 0x13, 0x71, 0x12, 0x65, 0xab, 0xd6, 0xce, 0x29, 0xd7, 0x46, 0xe7, 0x21, 0x61, 0x02,
-	0x96, 0xeb, 0x90, 0x46, 0x7e, 0x29, 0xbf, 0x92, 0x37, 0x6b, 0x09, 0xb7, 0xe5, 0x10, 0xf4, 0x24,
-	0xa0, 0x7d, 0xde, 0x44, 0x38, 0xad, 0x69, 0xf3, 0xd4, 0xb0, 0x3b, 0xe1, 0x2e, 0x4b, 0xe9, 0x4f,
-	0x84, 0x0a, 0xa6, 0xcd, 0x79, 0x85, 0x43, 0x21, 0xe8, 0x2a, 0xcc, 0xbb, 0xfe, 0x5d, 0xdc, 0x09,
-	0xc2, 0xbe, 0xd5, 0xc5, 0x61, 0x0b, 0xfb, 0xd4, 0x6e, 0x63, 0xd2, 0x28, 0x72, 0x8e, 0xe6, 0xa2,
-	0xb1, 0x8d, 0xc1, 0x90, 0xf1, 0x6b, 0x0d, 0x16, 0x45, 0x50, 0xb6, 0x61, 0x87, 0xd4, 0x3d, 0xea,
-	0x8b, 0xed, 0x3c, 0xcc, 0x74, 0x23, 0x3e, 0x04, 0xde, 0x34, 0xc7, 0xab, 0xc5, 0x50, 0x7e, 0xca,
-	0x3e, 0xd1, 0x60, 0x9e, 0xc5, 0x60, 0x27, 0x89, 0xe7, 0x5f, 0x6a, 0x30, 0x77, 0xdb, 0x26, 0x27,
-	0x89, 0xe5, 0xdf, 0xc8, 0x2b, 0x28, 0xe6, 0xf9, 0x28, 0x5d, 0x2b, 0x43, 0x4c, 0x33, 0x1d, 0x5d,
-	0xfa, 0x33, 0x29, 0xae, 0x89, 0xf1, 0xdb, 0xc1, 0x5d, 0x75, 0xc2, 0x38, 0xff, 0xbd, 0x06, 0x67,
-	0x6f, 0x61, 0x1a, 0x73, 0x7d, 0x2c, 0xee, 0xb4, 0x71, 0xad, 0xe5, 0x43, 0x71, 0x23, 0x2b, 0x99,
-	0x3f, 0x92, 0x9b, 0xef, 0xfd, 0x1c, 0x2c, 0xb0, 0x6b, 0xe1, 0x78, 0x18, 0xc1, 0x38, 0x31, 0xbb,
-	0xc2, 0x50, 0x0a, 0x2a, 0x43, 0x89, 0xef, 0xd3, 0xe2, 0xd8, 0xf7, 0xa9, 0xf1, 0xab, 0x9c, 0x88,
-	0x03, 0x92, 0xda, 0x98, 0x64, 0x5b, 0x14, 0xbc, 0xe6, 0x94, 0xbc, 0x1a, 0xa0, 0xc7, 0x90, 0xf5,
-	0xb5, 0xe8, 0x7e, 0x4c, 0xc1, 0x8e, 0xed, 0xf5, 0xf8, 0x81, 0x06, 0x8b, 0x51, 0x96, 0xb4, 0x89,
-	0xdb, 0x1d, 0xec, 0xd3, 0x07, 0xb7, 0xa1, 0x61, 0x0b, 0xc8, 0x29, 0x2c, 0xe0, 0x0c, 0x54, 0x88,
-	0x58, 0x27, 0x4e, 0x80, 0x06, 0x00, 0xe3, 0x63, 0x0d, 0x4e, 0xef, 0x63, 0x67, 0x92, 0x4d, 0x6c,
-	0x40, 0xc9, 0xf5, 0x1d, 0x7c, 0x3f, 0xe6, 0x26, 0xfa, 0x65, 0x23, 0x5b, 0x3d, 0xd7, 0x73, 0x62,
-	0x36, 0xa2, 0x5f, 0xb4, 0x0c, 0x3a, 0xf6, 0xed, 0x2d, 0x0f, 0x5b, 0x1c, 0x97, 0x1b, 0x72, 0xd9,
-	0xac, 0x0a, 0xd8, 0x3a, 0x03, 0x19, 0xdf, 0xd5, 0x60, 0x8e, 0xd9, 0x9a, 0xe4, 0x91, 0x7c, 0xb1,
-	0x3a, 0x5b, 0x82, 0x6a, 0xc2, 0x98, 0x24, 0xbb, 0x49, 0x90, 0xb1, 0x0b, 0xf3, 0x69, 0x76, 0x26,
-	0xd1, 0xd9, 0x23, 0x00, 0xf1, 0x8e, 0x08, 0x9b, 0xcf, 0x9b, 0x09, 0x88, 0xf1, 0x99, 0x06, 0x48,
-	0x84, 0x54, 0x5c, 0x19, 0x47, 0xfc, 0x20, 0xb3, 0xed, 0x62, 0xcf, 0x49, 0x7a, 0xed, 0x0a, 0x87,
-	0xf0, 0xe1, 0x35, 0xd0, 0xf1, 0x7d, 0x1a, 0xda, 0x56, 0xd7, 0x0e, 0xed, 0x8e, 0x38, 0x3c, 0x63,
-	0x39, 0xd8, 0x2a, 0x27, 0xdb, 0xe0, 0x54, 0xc6, 0x9f, 0x58, 0x30, 0x26, 0x8d, 0xf2, 0xb8, 0x4b,
-	0x7c, 0x16, 0x80, 0x1b, 0xad, 0x18, 0x2e, 0x88, 0x61, 0x0e, 0xe1, 0x57, 0xd8, 0xc7, 0x1a, 0xd4,
-	0xb9, 0x08, 0x42, 0x9e, 0x2e, 0x9b, 0x76, 0x88, 0x46, 0x1b, 0xa2, 0x19, 0x71, 0x84, 0xfe, 0x0f,
-	0x8a, 0x52, 0xb1, 0xf9, 0x71, 0x15, 0x2b, 0x09, 0x0e, 0x10, 0xc3, 0xf8, 0xa9, 0x06, 0x0b, 0x43,
-	0x2a, 0x9f, 0xc4, 0xa2, 0x5f, 0x07, 0x24, 0x24, 0x74, 0x06, 0x62, 0x47, 0xd7, 0xed, 0x79, 0xe5,
-	0xdd, 0x32, 0xac, 0x24, 0xf3, 0x94, 0x3b, 0x04, 0x21, 0xc6, 0x5f, 0x35, 0x38, 0x73, 0x0b, 0x53,
-	0x8e, 0x7a, 0x83, 0xf9, 0x8e, 0x8d, 0x30, 0x68, 0x87, 0x98, 0x90, 0x93, 0x6b, 0x1f, 0x3f, 0x14,
-	0xf1, 0x99, 0x4a, 0xa4, 0x49, 0xf4, 0xbf, 0x0c, 0x3a, 0x5f, 0x03, 0x3b, 0x56, 0x18, 0xec, 0x11,
-	0x69, 0x47, 0x55, 0x09, 0x33, 0x83, 0x3d, 0x6e, 0x10, 0x34, 0xa0, 0xb6, 0x27, 0x10, 0xe4, 0xc5,
-	0xc0, 0x21, 0x6c, 0x98, 0x9f, 0xc1, 0x88, 0x31, 0x36, 0x39, 0x3e, 0xb9, 0x3a, 0xfe, 0xb9, 0x06,
-	0x0b, 0x43, 0xa2, 0x4c, 0xa2, 0xdb, 0x67, 0x44, 0xf4, 0x28, 0x84, 0x99, 0x59, 0x3d, 0xa7, 0xa4,
-	0x49, 0x2c, 0x26, 0xb0, 0xd1, 0x39, 0xa8, 0x6e, 0xdb, 0xae, 0x67, 0x85, 0xd8, 0x26, 0x81, 0x2f,
-	0x05, 0x05, 0x06, 0x32, 0x39, 0xc4, 0xf8, 0x54, 0x83, 0x3a, 0x4b, 0x41, 0x4f, 0xb8, 0xc7, 0xfb,
-	0x59, 0x0e, 0x6a, 0xeb, 0x3e, 0xc1, 0x21, 0x3d, 0xfe, 0x19, 0x06, 0x7a, 0x11, 0xaa, 0x5c, 0x30,
-	0x62, 0x39, 0x36, 0xb5, 0xe5, 0x75, 0xf5, 0x88, 0xf2, 0x91, 0xf9, 0x65, 0x86, 0xb7, 0x66, 0x53,
-	0xdb, 0x14, 0xda, 0x21, 0xec, 0x1b, 0x3d, 0x0c, 0x95, 0x1d, 0x9b, 0xec, 0x58, 0xbb, 0xb8, 0x2f,
-	0xc2, 0xbe, 0x9a, 0x59, 0x66, 0x80, 0x57, 0x70, 0x9f, 0xa0, 0x87, 0xa0, 0xec, 0xf7, 0x3a, 0xe2,
-	0x80, 0x95, 0x96, 0xb4, 0x95, 0x9a, 0x59, 0xf2, 0x7b, 0x1d, 0x7e, 0xbc, 0xfe, 0x9c, 0x83, 0x99,
-	0xbb, 0x3d, 0x96, 0xcf, 0xf0, 0x27, 0xf2, 0x9e, 0x47, 0x1f, 0xcc, 0x18, 0x2f, 0x42, 0x5e, 0xc4,
-	0x0c, 0x8c, 0xa2, 0xa1, 0x64, 0x7c, 0x7d, 0x8d, 0x98, 0x0c, 0x89, 0x3f, 0x0f, 0xf7, 0x5a, 0x2d,
-	0x19, 0x64, 0xe5, 0x39, 0xb3, 0x15, 0x06, 0xe1, 0x16, 0xc7, 0x44, 0xc1, 0x61, 0x18, 0x87, 0x60,
-	0x5c, 0x14, 0x1c, 0x86, 0x62, 0xd0, 0x00, 0xdd, 0x6e, 0xed, 0xfa, 0xc1, 0x9e, 0x87, 0x9d, 0x36,
-	0x76, 0xf8, 0xb6, 0x97, 0xcd, 0x14, 0x4c, 0x18, 0x06, 0xdb, 0x78, 0xab, 0xe5, 0x53, 0x9e, 0x48,
-	0xe4, 0x99, 0x61, 0x30, 0xc8, 0x4d, 0x9f, 0xb2, 0x61, 0x07, 0x7b, 0x98, 0x62, 0x3e, 0x5c, 0x12,
-	0xc3, 0x02, 0x22, 0x87, 0x7b, 0xdd, 0x98, 0xba, 0x2c, 0x86, 0x05, 0x84, 0x0d, 0x9f, 0x81, 0xca,
-	0xe0, 0x0d, 0xbc, 0x32, 0x78, 0x0d, 0xe4, 0x00, 0xe3, 0x0f, 0x1a, 0xd4, 0xd6, 0xf8, 0x54, 0x27,
-	0xc0, 0xe8, 0x10, 0x4c, 0xe3, 0xfb, 0xdd, 0x50, 0x1e, 0x1d, 0xfe, 0x6d, 0xdc, 0x83, 0xfa, 0x86,
-	0x67, 0xb7, 0xf0, 0x4e, 0xe0, 0x39, 0x38, 0xe4, 0xd7, 0x37, 0xaa, 0x43, 0x9e, 0xda, 0x6d, 0x19,
-	0x1f, 0xb0, 0x4f, 0xf4, 0x9c, 0x4c, 0xd2, 0x84, 0xe7, 0xf9, 0x1f, 0xe5, 0x45, 0x9a, 0x98, 0x26,
-	0xf1, 0xf6, 0xb9, 0x08, 0x45, 0x5e, 0x7a, 0x12, 0x91, 0x83, 0x6e, 0xca, 0x3f, 0xe3, 0x9d, 0xd4,
-	0xba, 0xb7, 0xc2, 0xa0, 0xd7, 0x45, 0xeb, 0xa0, 0x77, 0x07, 0x30, 0x66, 0x8e, 0xd9, 0xd7, 0xf6,
-	0x30, 0xd3, 0x66, 0x8a, 0xd4, 0xf8, 0x2c, 0x0f, 0xb5, 0x4d, 0x6c, 0x87, 0xad, 0x9d, 0x93, 0xf0,
-	0x5a, 0xc2, 0x34, 0xee, 0x10, 0x4f, 0x6e, 0x0c, 0xfb, 0x44, 0x97, 0xe0, 0x54, 0x42, 0x20, 0xab,
-	0xcd, 0x14, 0xc4, 0x4d, 0x5b, 0x37, 0xeb, 0xdd, 0x61, 0xc5, 0x3d, 0x0b, 0x65, 0x87, 0x78, 0x16,
-	0xdf, 0xa2, 0x12, 0xdf, 0x22, 0xb5, 0x7c, 0x6b, 0xc4, 0xe3, 0x5b, 0x53, 0x72, 0xc4, 0x07, 0x7a,
-	0x14, 0x6a, 0x41, 0x8f, 0x76, 0x7b, 0xd4, 0x12, 0xae, 0xa5, 0x51, 0xe6, 0xec, 0xe9, 0x02, 0xc8,
-	0x3d, 0x0f, 0x41, 0x2f, 0x43, 0x8d, 0x70, 0x55, 0x46, 0xc1, 0x75, 0x65, 0xdc, 0x18, 0x50, 0x17,
-	0x74, 0x22, 0xba, 0x46, 0x17, 0xa0, 0x4e, 0x43, 0xfb, 0x1e, 0xf6, 0x12, 0x45, 0x25, 0xe0, 0x07,
-	0x6a, 0x56, 0xc0, 0x07, 0x05, 0xa5, 0x2b, 0x30, 0xd7, 0xee, 0xd9, 0xa1, 0xed, 0x53, 0x8c, 0x13,
-	0xd8, 0x55, 0x8e, 0x8d, 0xe2, 0xa1, 0x98, 0xc0, 0x78, 0x05, 0xa6, 0x6f, 0xbb, 0x94, 0x2b, 0x92,
-	0xb9, 0x25, 0x8d, 0xa7, 0x32, 0xdc, 0xf9, 0x3c, 0x04, 0xe5, 0x30, 0xd8, 0x13, 0x6e, 0x36, 0xc7,
-	0x4d, 0xb0, 0x14, 0x06, 0x7b, 0xdc, 0x87, 0xf2, 0xb2, 0x79, 0x10, 0x4a, 0xdb, 0xcc, 0x99, 0xf2,
-	0xcf, 0xf8, 0x96, 0x36, 0x30, 0x1e, 0xe6, 0x21, 0xc9, 0x83, 0xb9, 0xc8, 0x17, 0xa1, 0x14, 0x0a,
-	0xfa, 0x91, 0x45, 0xc4, 0xe4, 0x4a, 0xdc, 0xcd, 0x47, 0x54, 0xc6, 0x37, 0x35, 0xd0, 0x5f, 0xf6,
-	0x7a, 0xe4, 0x8b, 0xb0, 0x61, 0x55, 0x5d, 0x20, 0xaf, 0xae, 0x49, 0x7c, 0x2f, 0x07, 0x35, 0xc9,
-	0xc6, 0x24, 0xe1, 0x4b, 0x26, 0x2b, 0x9b, 0x50, 0x65, 0x4b, 0x5a, 0x04, 0xb7, 0xa3, 0x47, 0x95,
-	0xea, 0xea, 0xaa, 0xf2, 0xd4, 0xa7, 0xd8, 0xe0, 0xe5, 0xd7, 0x4d, 0x4e, 0xf4, 0x15, 0x9f, 0x86,
-	0x7d, 0x13, 0x5a, 0x31, 0xa0, 0xf9, 0x0e, 0xcc, 0x0e, 0x0d, 0x33, 0xdb, 0xd8, 0xc5, 0xfd, 0xc8,
-	0xad, 0xed, 0xe2, 0x3e, 0x7a, 0x3a, 0x59, 0x24, 0xcf, 0xba, 0x7f, 0xef, 0x04, 0x7e, 0xfb, 0x7a,
-	0x18, 0xda, 0x7d, 0x59, 0x44, 0x7f, 0x3e, 0xf7, 0x9c, 0x66, 0xfc, 0x31, 0x07, 0xfa, 0x6b, 0x3d,
-	0x1c, 0xf6, 0x8f, 0xd2, 0xbd, 0x44, 0xfe, 0x7c, 0x7a, 0xe0, 0xcf, 0xf7, 0x9f, 0xe8, 0x82, 0xe2,
-	0x44, 0x2b, 0xfc, 0x52, 0x51, 0xe9, 0x97, 0x54, 0x47, 0xb6, 0x74, 0xa8, 0x23, 0x5b, 0xce, 0x3c,
-	0xb2, 0xcc, 0xba, 0xa5, 0x0a, 0x27, 0x3a, 0x64, 0xa9, 0x40, 0x2a, 0x77, 0xd8, 0x40, 0xca, 0xf8,
-	0x44, 0x83, 0xca, 0x9b, 0xb8, 0x45, 0x83, 0x90, 0x79, 0x0b, 0x85, 0xee, 0xb5, 0x31, 0x62, 0xd5,
-	0xdc, 0x70, 0xac, 0x7a, 0x0d, 0xca, 0xae, 0x63, 0xd9, 0xcc, 0x6c, 0xf8, 0xe6, 0x8d, 0x8a, 0x91,
-	0x4a, 0xae, 0xc3, 0xed, 0x6b, 0xfc, 0xc7, 0xf5, 0x1f, 0x69, 0xa0, 0x0b, 0x9e, 0x89, 0xa0, 0x7c,
-	0x21, 0xb1, 0x9c, 0xa6, 0xb2, 0x65, 0xf9, 0x13, 0x0b, 0x7a, 0x7b, 0x6a, 0xb0, 0xec, 0x75, 0x00,
-	0xa6, 0x3b, 0x49, 0x2e, 0x8e, 0xc2, 0x92, 0x92, 0x5b, 0x41, 0xce, 0xf5, 0x78, 0x7b, 0xca, 0xac,
-	0x30, 0x2a, 0x3e, 0xc5, 0x8d, 0x12, 0x14, 0x38, 0xb5, 0xf1, 0x6f, 0x0d, 0xe6, 0x6e, 0xda, 0x5e,
-	0x6b, 0xcd, 0x25, 0xd4, 0xf6, 0x5b, 0x13, 0x44, 0x45, 0xcf, 0x43, 0x29, 0xe8, 0x5a, 0x1e, 0xde,
-	0xa6, 0x92, 0xa5, 0xe5, 0x11, 0x12, 0x09, 0x35, 0x98, 0xc5, 0xa0, 0x7b, 0x07, 0x6f, 0x53, 0xf4,
-	0xff, 0x50, 0x0e, 0xba, 0x56, 0xe8, 0xb6, 0x77, 0xa8, 0xd4, 0xfe, 0x18, 0xc4, 0xa5, 0xa0, 0x6b,
-	0x32, 0x8a, 0xc4, 0x63, 0xc7, 0xf4, 0x21, 0x1f, 0x3b, 0x8c, 0xbf, 0xed, 0x13, 0x7f, 0x02, 0xd3,
-	0x7e, 0x1e, 0xca, 0xae, 0x4f, 0x2d, 0xc7, 0x25, 0x91, 0x0a, 0xce, 0xaa, 0x6d, 0xc8, 0xa7, 0x5c,
-	0x02, 0xbe, 0xa7, 0x3e, 0x65, 0x6b, 0xa3, 0x97, 0x00, 0xb6, 0xbd, 0xc0, 0x96, 0xd4, 0x42, 0x07,
-	0xe7, 0xd4, 0xa7, 0x82, 0xa1, 0x45, 0xf4, 0x15, 0x4e, 0xc4, 0x66, 0x18, 0x6c, 0xe9, 0x5f, 0x34,
-	0x58, 0xd8, 0xc0, 0x21, 0x71, 0x09, 0xc5, 0x3e, 0x95, 0x0f, 0x8f, 0xeb, 0xfe, 0x76, 0x90, 0x7e,
-	0xe1, 0xd5, 0x86, 0x5e, 0x78, 0x3f, 0x9f, 0xf7, 0xce, 0x54, 0x2a, 0x23, 0xea, 0x0c, 0x51, 0x2a,
-	0x13, 0x55, 0x53, 0x44, 0x2a, 0x38, 0x93, 0xb1, 0x4d, 0x92, 0xdf, 0x64, 0x46, 0x6c, 0x7c, 0x5f,
-	0x74, 0x36, 0x28, 0x85, 0x7a, 0x70, 0x83, 0x5d, 0x04, 0xe9, 0xc0, 0x87, 0xdc, 0xf9, 0x63, 0x30,
-	0xe4, 0x3b, 0x32, 0xfa, 0x2d, 0x7e, 0xac, 0xc1, 0x52, 0x36, 0x57, 0x93, 0xdc, 0xbc, 0x2f, 0x41,
-	0xc1, 0xf5, 0xb7, 0x83, 0xe8, 0x1d, 0xec, 0xa2, 0x3a, 0xa0, 0x56, 0xae, 0x2b, 0x08, 0x8d, 0x7f,
-	0x69, 0x50, 0xe7, 0xbe, 0xfa, 0x08, 0xb6, 0xbf, 0x83, 0x3b, 0x16, 0x71, 0xdf, 0xc5, 0xd1, 0xf6,
-	0x77, 0x70, 0x67, 0xd3, 0x7d, 0x17, 0xa7, 0x2c, 0xa3, 0x90, 0xb6, 0x8c, 0xf4, 0x4b, 0x41, 0x71,
-	0xc4, 0x3b, 0x67, 0x29, 0xf5, 0xce, 0x69, 0x7c, 0xa8, 0x41, 0xf3, 0x16, 0xa6, 0xc3, 0xa2, 0x1e,
-	0x9d, 0x51, 0x7c, 0xa4, 0xc1, 0xc3, 0x4a, 0x86, 0x26, 0xb1, 0x87, 0x17, 0xd2, 0xf6, 0xa0, 0x4e,
-	0xb0, 0xf6, 0x2d, 0x29, 0x4d, 0xe1, 0x2a, 0xe8, 0x6b, 0xbd, 0x4e, 0x27, 0x0e, 0x7c, 0x96, 0x41,
-	0x0f, 0xc5, 0xa7, 0xc8, 0x3f, 0xc4, 0x75, 0x59, 0x95, 0x30, 0x96, 0x65, 0x18, 0x97, 0xa0, 0x26,
-	0x49, 0x24, 0xd7, 0x4d, 0x28, 0x87, 0xf2, 0x5b, 0xe2, 0xc7, 0xff, 0xc6, 0x02, 0xcc, 0x99, 0xb8,
-	0xcd, 0x2c, 0x31, 0xbc, 0xe3, 0xfa, 0xbb, 0x72, 0x19, 0xe3, 0x3d, 0x0d, 0xe6, 0xd3, 0x70, 0x39,
-	0xd7, 0xff, 0x42, 0xc9, 0x76, 0x9c, 0x10, 0x13, 0x32, 0x72, 0x5b, 0xae, 0x0b, 0x1c, 0x33, 0x42,
-	0x4e, 0x68, 0x2e, 0x37, 0xb6, 0xe6, 0x0c, 0x0b, 0x4e, 0xdd, 0xc2, 0xf4, 0x2e, 0xa6, 0xe1, 0x44,
-	0x85, 0xec, 0x06, 0xcb, 0x0c, 0x38, 0xb1, 0x34, 0x8b, 0xe8, 0xd7, 0xf8, 0x40, 0x03, 0x94, 0x5c,
-	0x61, 0x92, 0x6d, 0x4e, 0x6a, 0x39, 0x97, 0xd6, 0xb2, 0xe8, 0xf5, 0xe9, 0x74, 0x03, 0x1f, 0xfb,
-	0x34, 0x19, 0x62, 0xd6, 0x62, 0x28, 0x33, 0xbf, 0x8b, 0xcb, 0x50, 0x8e, 0x6a, 0xaf, 0xa8, 0x04,
-	0xf9, 0xeb, 0x9e, 0x57, 0x9f, 0x42, 0x3a, 0x94, 0xd7, 0x65, 0x81, 0xb1, 0xae, 0x5d, 0xfc, 0x32,
-	0xcc, 0x0e, 0x65, 0xfe, 0xa8, 0x0c, 0xd3, 0xaf, 0x06, 0x3e, 0xae, 0x4f, 0xa1, 0x3a, 0xe8, 0x37,
-	0x5c, 0xdf, 0x0e, 0xfb, 0xe2, 0xa6, 0xad, 0x3b, 0x68, 0x16, 0xaa, 0xfc, 0xc6, 0x91, 0x00, 0xbc,
-	0xfa, 0x8f, 0x06, 0xd4, 0xee, 0x72, 0x61, 0x36, 0x71, 0x78, 0xcf, 0x6d, 0x61, 0x64, 0x41, 0x7d,
-	0xb8, 0xb9, 0x1a, 0x3d, 0xa1, 0xb4, 0xd1, 0x8c, 0x1e, 0xec, 0xe6, 0x28, 0xf5, 0x18, 0x53, 0xe8,
-	0x6d, 0x98, 0x49, 0xb7, 0x3d, 0x23, 0xb5, 0x4b, 0x54, 0xf6, 0x46, 0x1f, 0x34, 0xb9, 0x05, 0xb5,
-	0x54, 0x17, 0x33, 0xba, 0xa0, 0x9c, 0x5b, 0xd5, 0xe9, 0xdc, 0x54, 0x47, 0x29, 0xc9, 0x4e, 0x63,
-	0xc1, 0x7d, 0xba, 0x99, 0x32, 0x83, 0x7b, 0x65, 0xc7, 0xe5, 0x41, 0xdc, 0xdb, 0x70, 0x6a, 0x5f,
-	0x6f, 0x24, 0x7a, 0x52, 0x39, 0x7f, 0x56, 0x0f, 0xe5, 0x41, 0x4b, 0xec, 0x01, 0xda, 0xdf, 0xad,
-	0x8b, 0x2e, 0xab, 0x77, 0x20, 0xab, 0x57, 0xb9, 0x79, 0x65, 0x6c, 0xfc, 0x58, 0x71, 0xdf, 0xd6,
-	0xe0, 0x74, 0x46, 0x43, 0x23, 0xba, 0xa6, 0x9c, 0x6e, 0x74, 0x57, 0x66, 0xf3, 0xe9, 0xc3, 0x11,
-	0xc5, 0x8c, 0xf8, 0x30, 0x3b, 0xd4, 0xe3, 0x87, 0x2e, 0x65, 0xf6, 0x3d, 0xec, 0x6f, 0x76, 0x6c,
-	0x3e, 0x31, 0x1e, 0x72, 0xbc, 0x1e, 0xcb, 0x85, 0xd3, 0x8d, 0x71, 0x19, 0xeb, 0xa9, 0xdb, 0xe7,
-	0x0e, 0xda, 0xd0, 0xb7, 0xa0, 0x96, 0xea, 0x60, 0xcb, 0xb0, 0x78, 0x55, 0x97, 0xdb, 0x41, 0x53,
-	0xbf, 0x03, 0x7a, 0xb2, 0xd1, 0x0c, 0xad, 0x64, 0x9d, 0xa5, 0x7d, 0x13, 0x1f, 0xe6, 0x28, 0x0d,
-	0xfa, 0x48, 0x46, 0x1c, 0xa5, 0x7d, 0xad, 0x37, 0xe3, 0x1f, 0xa5, 0xc4, 0xfc, 0x23, 0x8f, 0xd2,
-	0xa1, 0x97, 0x78, 0x4f, 0x83, 0x45, 0x75, 0x9f, 0x12, 0x5a, 0xcd, 0xb2, 0xcd, 0xec, 0x8e, 0xac,
-	0xe6, 0xb5, 0x43, 0xd1, 0xc4, 0x5a, 0xdc, 0x85, 0x99, 0x74, 0x37, 0x4e, 0x86, 0x16, 0x95, 0x0d,
-	0x4c, 0xcd, 0x4b, 0x63, 0xe1, 0xc6, 0x8b, 0xbd, 0x01, 0xd5, 0x44, 0x47, 0x02, 0x7a, 0x7c, 0x84,
-	0x1d, 0x27, 0xeb, 0x59, 0x07, 0x69, 0x72, 0x07, 0x6a, 0xa9, 0x2a, 0x74, 0x96, 0x0d, 0x2b, 0x9a,
-	0x03, 0x9a, 0x17, 0xc7, 0x41, 0x8d, 0x05, 0xd8, 0x81, 0x5a, 0xaa, 0x26, 0x98, 0xb1, 0x92, 0xaa,
-	0x04, 0x9a, 0xb1, 0x92, 0xb2, 0xc4, 0x68, 0x4c, 0xa1, 0x6f, 0x24, 0xca, 0x8f, 0xa9, 0x12, 0x2f,
-	0xba, 0x3a, 0x72, 0x1e, 0x55, 0x85, 0xbb, 0xb9, 0x7a, 0x18, 0x92, 0x98, 0x85, 0xd7, 0xa0, 0x12,
-	0x57, 0x16, 0xd1, 0xf9, 0x4c, 0xb7, 0x70, 0x98, 0x9d, 0xda, 0x84, 0xa2, 0xa8, 0xf2, 0x21, 0x23,
-	0xa3, 0x9e, 0x9f, 0x28, 0x01, 0x36, 0x1f, 0x55, 0xe2, 0xa4, 0x0b, 0x60, 0x62, 0x52, 0x51, 0xc5,
-	0xc9, 0x98, 0x34, 0x55, 0xe2, 0x19, 0x77, 0x52, 0x13, 0x8a, 0xe2, 0x6d, 0x37, 0x63, 0xd2, 0x54,
-	0x7d, 0xa2, 0x39, 0x1a, 0x47, 0x3c, 0x08, 0x4f, 0xa1, 0x0d, 0x28, 0xf0, 0x37, 0x50, 0xb4, 0x3c,
-	0xea, 0x7d, 0x74, 0xd4, 0x8c, 0xa9, 0x27, 0x54, 0x63, 0x0a, 0x7d, 0x15, 0x0a, 0x3c, 0xd4, 0xcf,
-	0x98, 0x31, 0xf9, 0xc8, 0xd9, 0x1c, 0x89, 0x12, 0xb1, 0xe8, 0x80, 0x9e, 0x7c, 0x02, 0xc9, 0xf0,
-	0xd9, 0x8a, 0x47, 0xa2, 0xe6, 0x38, 0x98, 0xd1, 0x2a, 0xdf, 0xd1, 0xa0, 0x91, 0x95, 0x2d, 0xa3,
-	0xcc, 0x8b, 0x79, 0x54, 0xca, 0xdf, 0x7c, 0xe6, 0x90, 0x54, 0xb1, 0x0a, 0xdf, 0x85, 0x39, 0x45,
-	0x8e, 0x86, 0xae, 0x64, 0xcd, 0x97, 0x91, 0x5e, 0x36, 0x9f, 0x1a, 0x9f, 0x20, 0x5e, 0x7b, 0x03,
-	0x0a, 0x3c, 0xb7, 0xca, 0xd8, 0xbe, 0x64, 0xaa, 0x96, 0x61, 0x10, 0xa9, 0xd4, 0xcc, 0x98, 0x42,
-	0x18, 0xf4, 0x64, 0xa2, 0x95, 0xb1, 0x7f, 0x8a, 0x1c, 0xad, 0x79, 0x61, 0x0c, 0xcc, 0x78, 0x19,
-	0x0b, 0x60, 0x90, 0xe8, 0xa0, 0xc7, 0xb2, 0x44, 0x4f, 0xe7, 0x5a, 0xcd, 0xc7, 0x0f, 0xc4, 0x8b,
-	0x16, 0x58, 0xed, 0x81, 0xbe, 0x11, 0x06, 0xf7, 0xfb, 0x51, 0x5a, 0xf1, 0xdf, 0x91, 0xeb, 0xc6,
-	0x33, 0x5f, 0xbb, 0xd6, 0x76, 0xe9, 0x4e, 0x6f, 0x8b, 0x79, 0xae, 0x2b, 0x02, 0xf7, 0x49, 0x37,
-	0x90, 0x5f, 0x57, 0x5c, 0x9f, 0xe2, 0xd0, 0xb7, 0xbd, 0x2b, 0x7c, 0x2e, 0x09, 0xed, 0x6e, 0x6d,
-	0x15, 0xf9, 0xff, 0xb5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x79, 0xfe, 0x2f, 0x81, 0x9a, 0x3a,
-	0x00, 0x00,
+func (m *CheckHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckHealthRequest.Unmarshal(m, b)
+}
+func (m *CheckHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckHealthRequest.Merge(m, src)
+}
+func (m *CheckHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckHealthRequest.Size(m)
+}
+func (m *CheckHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckHealthRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_CheckHealthRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CheckHealthRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
 
-// MilvusServiceClient is the client API for MilvusService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MilvusServiceClient interface {
-	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
-	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
-	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
-	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
-	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
-	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
-	// TODO: remove
+type ComponentHealth struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsHealthy            bool     `protobuf:"varint,3,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`
+	Reasons              []string `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComponentHealth) Reset()         { *m = ComponentHealth{} }
+func (m *ComponentHealth) String() string { return proto.CompactTextString(m) }
+func (*ComponentHealth) ProtoMessage()    {}
+func (*ComponentHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *ComponentHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComponentHealth.Unmarshal(m, b)
+}
+func (m *ComponentHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComponentHealth.Marshal(b, m, deterministic)
+}
+func (m *ComponentHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentHealth.Merge(m, src)
+}
+func (m *ComponentHealth) XXX_Size() int {
+	return xxx_messageInfo_ComponentHealth.Size(m)
+}
+func (m *ComponentHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComponentHealth proto.InternalMessageInfo
+
+func (m *ComponentHealth) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ComponentHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ComponentHealth) GetIsHealthy() bool {
+	if m != nil {
+		return m.IsHealthy
+	}
+	return false
+}
+
+func (m *ComponentHealth) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type CheckHealthResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsHealthy            bool               `protobuf:"varint,2,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`
+	Reasons              []string           `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Components           []*ComponentHealth `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CheckHealthResponse) Reset()         { *m = CheckHealthResponse{} }
+func (m *CheckHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckHealthResponse) ProtoMessage()    {}
+func (*CheckHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
+}
+
+func (m *CheckHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckHealthResponse.Unmarshal(m, b)
+}
+func (m *CheckHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckHealthResponse.Merge(m, src)
+}
+func (m *CheckHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckHealthResponse.Size(m)
+}
+func (m *CheckHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckHealthResponse proto.InternalMessageInfo
+
+func (m *CheckHealthResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CheckHealthResponse) GetIsHealthy() bool {
+	if m != nil {
+		return m.IsHealthy
+	}
+	return false
+}
+
+func (m *CheckHealthResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *CheckHealthResponse) GetComponents() []*ComponentHealth {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+type AlterCollectionRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	DeleteKeys           []string                 `protobuf:"bytes,5,rep,name=delete_keys,json=deleteKeys,proto3" json:"delete_keys,omitempty"`
+	ShardsNum            int32                    `protobuf:"varint,6,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
+}
+
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
+}
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
+}
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
+}
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
+
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *AlterCollectionRequest) GetDeleteKeys() []string {
+	if m != nil {
+		return m.DeleteKeys
+	}
+	return nil
+}
+
+func (m *AlterCollectionRequest) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+type GetCollectionRuntimeStatsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCollectionRuntimeStatsRequest) Reset()         { *m = GetCollectionRuntimeStatsRequest{} }
+func (m *GetCollectionRuntimeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionRuntimeStatsRequest) ProtoMessage()    {}
+func (*GetCollectionRuntimeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
+}
+
+func (m *GetCollectionRuntimeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionRuntimeStatsRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionRuntimeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionRuntimeStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionRuntimeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionRuntimeStatsRequest.Merge(m, src)
+}
+func (m *GetCollectionRuntimeStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionRuntimeStatsRequest.Size(m)
+}
+func (m *GetCollectionRuntimeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionRuntimeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionRuntimeStatsRequest proto.InternalMessageInfo
+
+func (m *GetCollectionRuntimeStatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCollectionRuntimeStatsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetCollectionRuntimeStatsRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
+}
+
+type CollectionRuntimeStats struct {
+	CollectionName       string   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Loaded               bool     `protobuf:"varint,3,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	LoadedMemoryBytes    int64    `protobuf:"varint,4,opt,name=loaded_memory_bytes,json=loadedMemoryBytes,proto3" json:"loaded_memory_bytes,omitempty"`
+	SealedSegments       int64    `protobuf:"varint,5,opt,name=sealed_segments,json=sealedSegments,proto3" json:"sealed_segments,omitempty"`
+	GrowingSegments      int64    `protobuf:"varint,6,opt,name=growing_segments,json=growingSegments,proto3" json:"growing_segments,omitempty"`
+	ReplicaNumber        int64    `protobuf:"varint,7,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	QueryQps             float64  `protobuf:"fixed64,8,opt,name=query_qps,json=queryQps,proto3" json:"query_qps,omitempty"`
+	NodeIDs              []int64  `protobuf:"varint,9,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionRuntimeStats) Reset()         { *m = CollectionRuntimeStats{} }
+func (m *CollectionRuntimeStats) String() string { return proto.CompactTextString(m) }
+func (*CollectionRuntimeStats) ProtoMessage()    {}
+func (*CollectionRuntimeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
+}
+
+func (m *CollectionRuntimeStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionRuntimeStats.Unmarshal(m, b)
+}
+func (m *CollectionRuntimeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionRuntimeStats.Marshal(b, m, deterministic)
+}
+func (m *CollectionRuntimeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRuntimeStats.Merge(m, src)
+}
+func (m *CollectionRuntimeStats) XXX_Size() int {
+	return xxx_messageInfo_CollectionRuntimeStats.Size(m)
+}
+func (m *CollectionRuntimeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRuntimeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionRuntimeStats proto.InternalMessageInfo
+
+func (m *CollectionRuntimeStats) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CollectionRuntimeStats) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetLoaded() bool {
+	if m != nil {
+		return m.Loaded
+	}
+	return false
+}
+
+func (m *CollectionRuntimeStats) GetLoadedMemoryBytes() int64 {
+	if m != nil {
+		return m.LoadedMemoryBytes
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetSealedSegments() int64 {
+	if m != nil {
+		return m.SealedSegments
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetGrowingSegments() int64 {
+	if m != nil {
+		return m.GrowingSegments
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetReplicaNumber() int64 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetQueryQps() float64 {
+	if m != nil {
+		return m.QueryQps
+	}
+	return 0
+}
+
+func (m *CollectionRuntimeStats) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
+	}
+	return nil
+}
+
+type GetCollectionRuntimeStatsResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stats                []*CollectionRuntimeStats `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCollectionRuntimeStatsResponse) Reset()         { *m = GetCollectionRuntimeStatsResponse{} }
+func (m *GetCollectionRuntimeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionRuntimeStatsResponse) ProtoMessage()    {}
+func (*GetCollectionRuntimeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
+}
+
+func (m *GetCollectionRuntimeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionRuntimeStatsResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionRuntimeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionRuntimeStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionRuntimeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionRuntimeStatsResponse.Merge(m, src)
+}
+func (m *GetCollectionRuntimeStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionRuntimeStatsResponse.Size(m)
+}
+func (m *GetCollectionRuntimeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionRuntimeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionRuntimeStatsResponse proto.InternalMessageInfo
+
+func (m *GetCollectionRuntimeStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionRuntimeStatsResponse) GetStats() []*CollectionRuntimeStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type StreamInsertResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*MutationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	AckedBatches         int64             `protobuf:"varint,3,opt,name=acked_batches,json=ackedBatches,proto3" json:"acked_batches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StreamInsertResponse) Reset()         { *m = StreamInsertResponse{} }
+func (m *StreamInsertResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamInsertResponse) ProtoMessage()    {}
+func (*StreamInsertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
+}
+
+func (m *StreamInsertResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamInsertResponse.Unmarshal(m, b)
+}
+func (m *StreamInsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamInsertResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamInsertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamInsertResponse.Merge(m, src)
+}
+func (m *StreamInsertResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamInsertResponse.Size(m)
+}
+func (m *StreamInsertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamInsertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamInsertResponse proto.InternalMessageInfo
+
+func (m *StreamInsertResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *StreamInsertResponse) GetResults() []*MutationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *StreamInsertResponse) GetAckedBatches() int64 {
+	if m != nil {
+		return m.AckedBatches
+	}
+	return 0
+}
+
+type QueryIteratorRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Query                *QueryRequest     `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	BatchSize            int64             `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Cursor               []byte            `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryIteratorRequest) Reset()         { *m = QueryIteratorRequest{} }
+func (m *QueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorRequest) ProtoMessage()    {}
+func (*QueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
+}
+
+func (m *QueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorRequest.Unmarshal(m, b)
+}
+func (m *QueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorRequest.Merge(m, src)
+}
+func (m *QueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorRequest.Size(m)
+}
+func (m *QueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorRequest proto.InternalMessageInfo
+
+func (m *QueryIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *QueryIteratorRequest) GetQuery() *QueryRequest {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *QueryIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *QueryIteratorRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type QueryIteratorResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	Cursor               []byte                `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Done                 bool                  `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryIteratorResults) Reset()         { *m = QueryIteratorResults{} }
+func (m *QueryIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorResults) ProtoMessage()    {}
+func (*QueryIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
+}
+
+func (m *QueryIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorResults.Unmarshal(m, b)
+}
+func (m *QueryIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorResults.Merge(m, src)
+}
+func (m *QueryIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorResults.Size(m)
+}
+func (m *QueryIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorResults proto.InternalMessageInfo
+
+func (m *QueryIteratorResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type SearchIteratorRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Search               *SearchRequest    `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	BatchSize            int64             `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Cursor               []byte            `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchIteratorRequest) Reset()         { *m = SearchIteratorRequest{} }
+func (m *SearchIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchIteratorRequest) ProtoMessage()    {}
+func (*SearchIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
+}
+
+func (m *SearchIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchIteratorRequest.Unmarshal(m, b)
+}
+func (m *SearchIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchIteratorRequest.Merge(m, src)
+}
+func (m *SearchIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchIteratorRequest.Size(m)
+}
+func (m *SearchIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchIteratorRequest proto.InternalMessageInfo
+
+func (m *SearchIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SearchIteratorRequest) GetSearch() *SearchRequest {
+	if m != nil {
+		return m.Search
+	}
+	return nil
+}
+
+func (m *SearchIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *SearchIteratorRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type SearchIteratorResults struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	Cursor               []byte                     `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Done                 bool                       `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SearchIteratorResults) Reset()         { *m = SearchIteratorResults{} }
+func (m *SearchIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*SearchIteratorResults) ProtoMessage()    {}
+func (*SearchIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{74}
+}
+
+func (m *SearchIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchIteratorResults.Unmarshal(m, b)
+}
+func (m *SearchIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *SearchIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchIteratorResults.Merge(m, src)
+}
+func (m *SearchIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_SearchIteratorResults.Size(m)
+}
+func (m *SearchIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchIteratorResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchIteratorResults proto.InternalMessageInfo
+
+func (m *SearchIteratorResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchIteratorResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchIteratorResults) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *SearchIteratorResults) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type WarmupCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WarmupCollectionRequest) Reset()         { *m = WarmupCollectionRequest{} }
+func (m *WarmupCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*WarmupCollectionRequest) ProtoMessage()    {}
+func (*WarmupCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
+}
+
+func (m *WarmupCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarmupCollectionRequest.Unmarshal(m, b)
+}
+func (m *WarmupCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarmupCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *WarmupCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarmupCollectionRequest.Merge(m, src)
+}
+func (m *WarmupCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_WarmupCollectionRequest.Size(m)
+}
+func (m *WarmupCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarmupCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarmupCollectionRequest proto.InternalMessageInfo
+
+func (m *WarmupCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *WarmupCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *WarmupCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Privileges           []string          `protobuf:"bytes,3,rep,name=privileges,proto3" json:"privileges,omitempty"`
+	RateLimit            float64           `protobuf:"fixed64,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
+}
+
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(m, src)
+}
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
+}
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
+
+func (m *CreateApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateApiKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateApiKeyRequest) GetPrivileges() []string {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
+func (m *CreateApiKeyRequest) GetRateLimit() float64 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+type RotateApiKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GracePeriod          int64             `protobuf:"varint,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RotateApiKeyRequest) Reset()         { *m = RotateApiKeyRequest{} }
+func (m *RotateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateApiKeyRequest) ProtoMessage()    {}
+func (*RotateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
+}
+
+func (m *RotateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateApiKeyRequest.Merge(m, src)
+}
+func (m *RotateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateApiKeyRequest.Size(m)
+}
+func (m *RotateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateApiKeyRequest proto.InternalMessageInfo
+
+func (m *RotateApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RotateApiKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RotateApiKeyRequest) GetGracePeriod() int64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+type DropApiKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropApiKeyRequest) Reset()         { *m = DropApiKeyRequest{} }
+func (m *DropApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*DropApiKeyRequest) ProtoMessage()    {}
+func (*DropApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
+}
+
+func (m *DropApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropApiKeyRequest.Unmarshal(m, b)
+}
+func (m *DropApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *DropApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropApiKeyRequest.Merge(m, src)
+}
+func (m *DropApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_DropApiKeyRequest.Size(m)
+}
+func (m *DropApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropApiKeyRequest proto.InternalMessageInfo
+
+func (m *DropApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropApiKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ApiKeyResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name                 string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ApiKey               string           `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ApiKeyResponse) Reset()         { *m = ApiKeyResponse{} }
+func (m *ApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyResponse) ProtoMessage()    {}
+func (*ApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
+}
+
+func (m *ApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyResponse.Unmarshal(m, b)
+}
+func (m *ApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *ApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyResponse.Merge(m, src)
+}
+func (m *ApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyResponse.Size(m)
+}
+func (m *ApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyResponse proto.InternalMessageInfo
+
+func (m *ApiKeyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ApiKeyResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKeyResponse) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Actor                string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	SourceIp             string   `protobuf:"bytes,5,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	Method               string   `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	CollectionName       string   `protobuf:"bytes,7,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Request              string   `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string   `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	PrevHash             string   `protobuf:"bytes,11,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash                 string   `protobuf:"bytes,12,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetPrevHash() string {
+	if m != nil {
+		return m.PrevHash
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type QueryAuditLogRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	StartTime            int64             `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64             `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Actor                string            `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Method               string            `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	CollectionName       string            `protobuf:"bytes,6,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Limit                int64             `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuditLogRequest.Unmarshal(m, b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAuditLogRequest.Size(m)
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *QueryAuditLogRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryAuditLogRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryAuditLogRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entries              []*AuditEntry    `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	IntegrityErrors      []string         `protobuf:"bytes,3,rep,name=integrity_errors,json=integrityErrors,proto3" json:"integrity_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
+}
+
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuditLogResponse.Unmarshal(m, b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAuditLogResponse.Size(m)
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryAuditLogResponse) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryAuditLogResponse) GetIntegrityErrors() []string {
+	if m != nil {
+		return m.IntegrityErrors
+	}
+	return nil
+}
+
+type GetChannelTimeTicksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	StalledOnly          bool              `protobuf:"varint,2,opt,name=stalled_only,json=stalledOnly,proto3" json:"stalled_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetChannelTimeTicksRequest) Reset()         { *m = GetChannelTimeTicksRequest{} }
+func (m *GetChannelTimeTicksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelTimeTicksRequest) ProtoMessage()    {}
+func (*GetChannelTimeTicksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
+}
+
+func (m *GetChannelTimeTicksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelTimeTicksRequest.Unmarshal(m, b)
+}
+func (m *GetChannelTimeTicksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelTimeTicksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelTimeTicksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelTimeTicksRequest.Merge(m, src)
+}
+func (m *GetChannelTimeTicksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelTimeTicksRequest.Size(m)
+}
+func (m *GetChannelTimeTicksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelTimeTicksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelTimeTicksRequest proto.InternalMessageInfo
+
+func (m *GetChannelTimeTicksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetChannelTimeTicksRequest) GetStalledOnly() bool {
+	if m != nil {
+		return m.StalledOnly
+	}
+	return false
+}
+
+type ChannelTimeTick struct {
+	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Timestamp            uint64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	LastAdvanceTime      int64    `protobuf:"varint,3,opt,name=last_advance_time,json=lastAdvanceTime,proto3" json:"last_advance_time,omitempty"`
+	Lag                  int64    `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	Stalled              bool     `protobuf:"varint,5,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	PendingMinTs         uint64   `protobuf:"varint,6,opt,name=pending_min_ts,json=pendingMinTs,proto3" json:"pending_min_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelTimeTick) Reset()         { *m = ChannelTimeTick{} }
+func (m *ChannelTimeTick) String() string { return proto.CompactTextString(m) }
+func (*ChannelTimeTick) ProtoMessage()    {}
+func (*ChannelTimeTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
+}
+
+func (m *ChannelTimeTick) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelTimeTick.Unmarshal(m, b)
+}
+func (m *ChannelTimeTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelTimeTick.Marshal(b, m, deterministic)
+}
+func (m *ChannelTimeTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTimeTick.Merge(m, src)
+}
+func (m *ChannelTimeTick) XXX_Size() int {
+	return xxx_messageInfo_ChannelTimeTick.Size(m)
+}
+func (m *ChannelTimeTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTimeTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTimeTick proto.InternalMessageInfo
+
+func (m *ChannelTimeTick) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChannelTimeTick) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ChannelTimeTick) GetLastAdvanceTime() int64 {
+	if m != nil {
+		return m.LastAdvanceTime
+	}
+	return 0
+}
+
+func (m *ChannelTimeTick) GetLag() int64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *ChannelTimeTick) GetStalled() bool {
+	if m != nil {
+		return m.Stalled
+	}
+	return false
+}
+
+func (m *ChannelTimeTick) GetPendingMinTs() uint64 {
+	if m != nil {
+		return m.PendingMinTs
+	}
+	return 0
+}
+
+type GetChannelTimeTicksResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Channels             []*ChannelTimeTick `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	Skew                 int64              `protobuf:"varint,3,opt,name=skew,proto3" json:"skew,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetChannelTimeTicksResponse) Reset()         { *m = GetChannelTimeTicksResponse{} }
+func (m *GetChannelTimeTicksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelTimeTicksResponse) ProtoMessage()    {}
+func (*GetChannelTimeTicksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *GetChannelTimeTicksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelTimeTicksResponse.Unmarshal(m, b)
+}
+func (m *GetChannelTimeTicksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelTimeTicksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelTimeTicksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelTimeTicksResponse.Merge(m, src)
+}
+func (m *GetChannelTimeTicksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelTimeTicksResponse.Size(m)
+}
+func (m *GetChannelTimeTicksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelTimeTicksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelTimeTicksResponse proto.InternalMessageInfo
+
+func (m *GetChannelTimeTicksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetChannelTimeTicksResponse) GetChannels() []*ChannelTimeTick {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *GetChannelTimeTicksResponse) GetSkew() int64 {
+	if m != nil {
+		return m.Skew
+	}
+	return 0
+}
+
+type GetQuotaUsageRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQuotaUsageRequest) Reset()         { *m = GetQuotaUsageRequest{} }
+func (m *GetQuotaUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuotaUsageRequest) ProtoMessage()    {}
+func (*GetQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *GetQuotaUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuotaUsageRequest.Unmarshal(m, b)
+}
+func (m *GetQuotaUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuotaUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuotaUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotaUsageRequest.Merge(m, src)
+}
+func (m *GetQuotaUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuotaUsageRequest.Size(m)
+}
+func (m *GetQuotaUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotaUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotaUsageRequest proto.InternalMessageInfo
+
+func (m *GetQuotaUsageRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetQuotaUsageRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type QuotaUsage struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CollectionName       string   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Used                 int64    `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
+}
+
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaUsage.Unmarshal(m, b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return xxx_messageInfo_QuotaUsage.Size(m)
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetQuotaUsageResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Usages               []*QuotaUsage    `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetQuotaUsageResponse) Reset()         { *m = GetQuotaUsageResponse{} }
+func (m *GetQuotaUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuotaUsageResponse) ProtoMessage()    {}
+func (*GetQuotaUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *GetQuotaUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuotaUsageResponse.Unmarshal(m, b)
+}
+func (m *GetQuotaUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuotaUsageResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuotaUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotaUsageResponse.Merge(m, src)
+}
+func (m *GetQuotaUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuotaUsageResponse.Size(m)
+}
+func (m *GetQuotaUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotaUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotaUsageResponse proto.InternalMessageInfo
+
+func (m *GetQuotaUsageResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetQuotaUsageResponse) GetUsages() []*QuotaUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+type UndropCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UndropCollectionRequest) Reset()         { *m = UndropCollectionRequest{} }
+func (m *UndropCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*UndropCollectionRequest) ProtoMessage()    {}
+func (*UndropCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
+}
+
+func (m *UndropCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UndropCollectionRequest.Unmarshal(m, b)
+}
+func (m *UndropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UndropCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *UndropCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndropCollectionRequest.Merge(m, src)
+}
+func (m *UndropCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_UndropCollectionRequest.Size(m)
+}
+func (m *UndropCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndropCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndropCollectionRequest proto.InternalMessageInfo
+
+func (m *UndropCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UndropCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UndropCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UndropCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ListDroppedCollectionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDroppedCollectionsRequest) Reset()         { *m = ListDroppedCollectionsRequest{} }
+func (m *ListDroppedCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsRequest) ProtoMessage()    {}
+func (*ListDroppedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
+}
+
+func (m *ListDroppedCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Unmarshal(m, b)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsRequest.Merge(m, src)
+}
+func (m *ListDroppedCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Size(m)
+}
+func (m *ListDroppedCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDroppedCollectionsRequest proto.InternalMessageInfo
+
+func (m *ListDroppedCollectionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListDroppedCollectionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DroppedCollection struct {
+	CollectionName       string   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DropTs               uint64   `protobuf:"varint,3,opt,name=drop_ts,json=dropTs,proto3" json:"drop_ts,omitempty"`
+	PurgeTs              uint64   `protobuf:"varint,4,opt,name=purge_ts,json=purgeTs,proto3" json:"purge_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedCollection) Reset()         { *m = DroppedCollection{} }
+func (m *DroppedCollection) String() string { return proto.CompactTextString(m) }
+func (*DroppedCollection) ProtoMessage()    {}
+func (*DroppedCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
+}
+
+func (m *DroppedCollection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedCollection.Unmarshal(m, b)
+}
+func (m *DroppedCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedCollection.Marshal(b, m, deterministic)
+}
+func (m *DroppedCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedCollection.Merge(m, src)
+}
+func (m *DroppedCollection) XXX_Size() int {
+	return xxx_messageInfo_DroppedCollection.Size(m)
+}
+func (m *DroppedCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedCollection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedCollection proto.InternalMessageInfo
+
+func (m *DroppedCollection) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DroppedCollection) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedCollection) GetDropTs() uint64 {
+	if m != nil {
+		return m.DropTs
+	}
+	return 0
+}
+
+func (m *DroppedCollection) GetPurgeTs() uint64 {
+	if m != nil {
+		return m.PurgeTs
+	}
+	return 0
+}
+
+type ListDroppedCollectionsResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          []*DroppedCollection `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListDroppedCollectionsResponse) Reset()         { *m = ListDroppedCollectionsResponse{} }
+func (m *ListDroppedCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsResponse) ProtoMessage()    {}
+func (*ListDroppedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *ListDroppedCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Unmarshal(m, b)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsResponse.Merge(m, src)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Size(m)
+}
+func (m *ListDroppedCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDroppedCollectionsResponse proto.InternalMessageInfo
+
+func (m *ListDroppedCollectionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDroppedCollectionsResponse) GetCollections() []*DroppedCollection {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+type CollectionDetail struct {
+	ShardsNum            int32                    `protobuf:"varint,1,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	LoadState            LoadState                `protobuf:"varint,3,opt,name=load_state,json=loadState,proto3,enum=milvus.proto.milvus.LoadState" json:"load_state,omitempty"`
+	LoadedPercentage     int64                    `protobuf:"varint,4,opt,name=loaded_percentage,json=loadedPercentage,proto3" json:"loaded_percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CollectionDetail) Reset()         { *m = CollectionDetail{} }
+func (m *CollectionDetail) String() string { return proto.CompactTextString(m) }
+func (*CollectionDetail) ProtoMessage()    {}
+func (*CollectionDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *CollectionDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDetail.Unmarshal(m, b)
+}
+func (m *CollectionDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDetail.Marshal(b, m, deterministic)
+}
+func (m *CollectionDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDetail.Merge(m, src)
+}
+func (m *CollectionDetail) XXX_Size() int {
+	return xxx_messageInfo_CollectionDetail.Size(m)
+}
+func (m *CollectionDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDetail proto.InternalMessageInfo
+
+func (m *CollectionDetail) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *CollectionDetail) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionDetail) GetLoadState() LoadState {
+	if m != nil {
+		return m.LoadState
+	}
+	return LoadState_NotLoaded
+}
+
+func (m *CollectionDetail) GetLoadedPercentage() int64 {
+	if m != nil {
+		return m.LoadedPercentage
+	}
+	return 0
+}
+
+type DescribePartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Flush                bool              `protobuf:"varint,5,opt,name=flush,proto3" json:"flush,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribePartitionRequest) Reset()         { *m = DescribePartitionRequest{} }
+func (m *DescribePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribePartitionRequest) ProtoMessage()    {}
+func (*DescribePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *DescribePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribePartitionRequest.Unmarshal(m, b)
+}
+func (m *DescribePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribePartitionRequest.Merge(m, src)
+}
+func (m *DescribePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribePartitionRequest.Size(m)
+}
+func (m *DescribePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribePartitionRequest proto.InternalMessageInfo
+
+func (m *DescribePartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DescribePartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DescribePartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DescribePartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DescribePartitionRequest) GetFlush() bool {
+	if m != nil {
+		return m.Flush
+	}
+	return false
+}
+
+type DescribePartitionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PartitionID          int64            `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CreatedTimestamp     uint64           `protobuf:"varint,3,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	CreatedUtcTimestamp  uint64           `protobuf:"varint,4,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	RowCount             int64            `protobuf:"varint,5,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentCount         int64            `protobuf:"varint,6,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	GrowingSegmentCount  int64            `protobuf:"varint,7,opt,name=growing_segment_count,json=growingSegmentCount,proto3" json:"growing_segment_count,omitempty"`
+	DataSize             int64            `protobuf:"varint,8,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DescribePartitionResponse) Reset()         { *m = DescribePartitionResponse{} }
+func (m *DescribePartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribePartitionResponse) ProtoMessage()    {}
+func (*DescribePartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *DescribePartitionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribePartitionResponse.Unmarshal(m, b)
+}
+func (m *DescribePartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribePartitionResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribePartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribePartitionResponse.Merge(m, src)
+}
+func (m *DescribePartitionResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribePartitionResponse.Size(m)
+}
+func (m *DescribePartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribePartitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribePartitionResponse proto.InternalMessageInfo
+
+func (m *DescribePartitionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribePartitionResponse) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetCreatedTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetCreatedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamp
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetSegmentCount() int64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetGrowingSegmentCount() int64 {
+	if m != nil {
+		return m.GrowingSegmentCount
+	}
+	return 0
+}
+
+func (m *DescribePartitionResponse) GetDataSize() int64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+type CollectionStorageStats struct {
+	GrowingRowCount      int64                `protobuf:"varint,1,opt,name=growing_row_count,json=growingRowCount,proto3" json:"growing_row_count,omitempty"`
+	SealedRowCount       int64                `protobuf:"varint,2,opt,name=sealed_row_count,json=sealedRowCount,proto3" json:"sealed_row_count,omitempty"`
+	BinlogSize           int64                `protobuf:"varint,3,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	StatslogSize         int64                `protobuf:"varint,4,opt,name=statslog_size,json=statslogSize,proto3" json:"statslog_size,omitempty"`
+	DeltalogSize         int64                `protobuf:"varint,5,opt,name=deltalog_size,json=deltalogSize,proto3" json:"deltalog_size,omitempty"`
+	IndexSize            int64                `protobuf:"varint,6,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	LoadedMemorySize     int64                `protobuf:"varint,7,opt,name=loaded_memory_size,json=loadedMemorySize,proto3" json:"loaded_memory_size,omitempty"`
+	Fields               []*FieldStorageStats `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CollectionStorageStats) Reset()         { *m = CollectionStorageStats{} }
+func (m *CollectionStorageStats) String() string { return proto.CompactTextString(m) }
+func (*CollectionStorageStats) ProtoMessage()    {}
+func (*CollectionStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *CollectionStorageStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionStorageStats.Unmarshal(m, b)
+}
+func (m *CollectionStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionStorageStats.Marshal(b, m, deterministic)
+}
+func (m *CollectionStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionStorageStats.Merge(m, src)
+}
+func (m *CollectionStorageStats) XXX_Size() int {
+	return xxx_messageInfo_CollectionStorageStats.Size(m)
+}
+func (m *CollectionStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionStorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionStorageStats proto.InternalMessageInfo
+
+func (m *CollectionStorageStats) GetGrowingRowCount() int64 {
+	if m != nil {
+		return m.GrowingRowCount
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetSealedRowCount() int64 {
+	if m != nil {
+		return m.SealedRowCount
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetStatslogSize() int64 {
+	if m != nil {
+		return m.StatslogSize
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetDeltalogSize() int64 {
+	if m != nil {
+		return m.DeltalogSize
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetLoadedMemorySize() int64 {
+	if m != nil {
+		return m.LoadedMemorySize
+	}
+	return 0
+}
+
+func (m *CollectionStorageStats) GetFields() []*FieldStorageStats {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type FieldStorageStats struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	FieldName            string   `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	BinlogSize           int64    `protobuf:"varint,3,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	EstimatedSize        int64    `protobuf:"varint,4,opt,name=estimated_size,json=estimatedSize,proto3" json:"estimated_size,omitempty"`
+	IndexSize            int64    `protobuf:"varint,5,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldStorageStats) Reset()         { *m = FieldStorageStats{} }
+func (m *FieldStorageStats) String() string { return proto.CompactTextString(m) }
+func (*FieldStorageStats) ProtoMessage()    {}
+func (*FieldStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *FieldStorageStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStorageStats.Unmarshal(m, b)
+}
+func (m *FieldStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStorageStats.Marshal(b, m, deterministic)
+}
+func (m *FieldStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStorageStats.Merge(m, src)
+}
+func (m *FieldStorageStats) XXX_Size() int {
+	return xxx_messageInfo_FieldStorageStats.Size(m)
+}
+func (m *FieldStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStorageStats proto.InternalMessageInfo
+
+func (m *FieldStorageStats) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldStorageStats) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *FieldStorageStats) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *FieldStorageStats) GetEstimatedSize() int64 {
+	if m != nil {
+		return m.EstimatedSize
+	}
+	return 0
+}
+
+func (m *FieldStorageStats) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterEnum("milvus.proto.milvus.LoadState", LoadState_name, LoadState_value)
+	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
+	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
+	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
+	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
+	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
+	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
+	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
+	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
+	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
+	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
+	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
+	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
+	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
+	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
+	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
+	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
+	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
+	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
+	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
+	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
+	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
+	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
+	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
+	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
+	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
+	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
+	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
+	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
+	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
+	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
+	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
+	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
+	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
+	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
+	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
+	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
+	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
+	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
+	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
+	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
+	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
+	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
+	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+	proto.RegisterType((*CheckHealthRequest)(nil), "milvus.proto.milvus.CheckHealthRequest")
+	proto.RegisterType((*ComponentHealth)(nil), "milvus.proto.milvus.ComponentHealth")
+	proto.RegisterType((*CheckHealthResponse)(nil), "milvus.proto.milvus.CheckHealthResponse")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.milvus.AlterCollectionRequest")
+	proto.RegisterType((*GetCollectionRuntimeStatsRequest)(nil), "milvus.proto.milvus.GetCollectionRuntimeStatsRequest")
+	proto.RegisterType((*CollectionRuntimeStats)(nil), "milvus.proto.milvus.CollectionRuntimeStats")
+	proto.RegisterType((*GetCollectionRuntimeStatsResponse)(nil), "milvus.proto.milvus.GetCollectionRuntimeStatsResponse")
+	proto.RegisterType((*StreamInsertResponse)(nil), "milvus.proto.milvus.StreamInsertResponse")
+	proto.RegisterType((*QueryIteratorRequest)(nil), "milvus.proto.milvus.QueryIteratorRequest")
+	proto.RegisterType((*QueryIteratorResults)(nil), "milvus.proto.milvus.QueryIteratorResults")
+	proto.RegisterType((*SearchIteratorRequest)(nil), "milvus.proto.milvus.SearchIteratorRequest")
+	proto.RegisterType((*SearchIteratorResults)(nil), "milvus.proto.milvus.SearchIteratorResults")
+	proto.RegisterType((*WarmupCollectionRequest)(nil), "milvus.proto.milvus.WarmupCollectionRequest")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "milvus.proto.milvus.CreateApiKeyRequest")
+	proto.RegisterType((*RotateApiKeyRequest)(nil), "milvus.proto.milvus.RotateApiKeyRequest")
+	proto.RegisterType((*DropApiKeyRequest)(nil), "milvus.proto.milvus.DropApiKeyRequest")
+	proto.RegisterType((*ApiKeyResponse)(nil), "milvus.proto.milvus.ApiKeyResponse")
+	proto.RegisterType((*AuditEntry)(nil), "milvus.proto.milvus.AuditEntry")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "milvus.proto.milvus.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "milvus.proto.milvus.QueryAuditLogResponse")
+	proto.RegisterType((*GetChannelTimeTicksRequest)(nil), "milvus.proto.milvus.GetChannelTimeTicksRequest")
+	proto.RegisterType((*ChannelTimeTick)(nil), "milvus.proto.milvus.ChannelTimeTick")
+	proto.RegisterType((*GetChannelTimeTicksResponse)(nil), "milvus.proto.milvus.GetChannelTimeTicksResponse")
+	proto.RegisterType((*GetQuotaUsageRequest)(nil), "milvus.proto.milvus.GetQuotaUsageRequest")
+	proto.RegisterType((*QuotaUsage)(nil), "milvus.proto.milvus.QuotaUsage")
+	proto.RegisterType((*GetQuotaUsageResponse)(nil), "milvus.proto.milvus.GetQuotaUsageResponse")
+	proto.RegisterType((*UndropCollectionRequest)(nil), "milvus.proto.milvus.UndropCollectionRequest")
+	proto.RegisterType((*ListDroppedCollectionsRequest)(nil), "milvus.proto.milvus.ListDroppedCollectionsRequest")
+	proto.RegisterType((*DroppedCollection)(nil), "milvus.proto.milvus.DroppedCollection")
+	proto.RegisterType((*ListDroppedCollectionsResponse)(nil), "milvus.proto.milvus.ListDroppedCollectionsResponse")
+	proto.RegisterType((*CollectionDetail)(nil), "milvus.proto.milvus.CollectionDetail")
+	proto.RegisterType((*DescribePartitionRequest)(nil), "milvus.proto.milvus.DescribePartitionRequest")
+	proto.RegisterType((*DescribePartitionResponse)(nil), "milvus.proto.milvus.DescribePartitionResponse")
+	proto.RegisterType((*CollectionStorageStats)(nil), "milvus.proto.milvus.CollectionStorageStats")
+	proto.RegisterType((*FieldStorageStats)(nil), "milvus.proto.milvus.FieldStorageStats")
+}
+
+func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
+
+var fileDescriptor_02345ba45cc0e303 = []byte{
+	// 5040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x5d, 0x6f, 0x1c, 0x47,
+	0x72, 0x9a, 0xdd, 0xe5, 0x7e, 0xd4, 0xee, 0x92, 0xcb, 0x21, 0x45, 0xad, 0xd7, 0x96, 0x25, 0xcd,
+	0x59, 0x36, 0x4d, 0xdb, 0x92, 0x4d, 0xd9, 0xe7, 0x3b, 0x5f, 0x2e, 0x3e, 0x49, 0xb4, 0x25, 0x9e,
+	0x25, 0x1f, 0x3d, 0x94, 0x1d, 0xf8, 0x0e, 0xc6, 0xa0, 0xb9, 0xd3, 0xda, 0x9d, 0xe3, 0xec, 0xcc,
+	0x68, 0xba, 0x57, 0xf4, 0xfa, 0x21, 0x08, 0x70, 0x97, 0x00, 0xc1, 0x7d, 0x21, 0x1f, 0xc8, 0xe7,
+	0x43, 0x80, 0x7c, 0x20, 0xc9, 0x53, 0x92, 0x4b, 0x80, 0x4b, 0x80, 0x20, 0x79, 0xb9, 0x87, 0x04,
+	0x09, 0x90, 0x4b, 0x1e, 0xf2, 0x16, 0x24, 0x41, 0x90, 0x7b, 0x0b, 0xf2, 0x07, 0x12, 0x20, 0xe8,
+	0x8f, 0x99, 0x9d, 0x99, 0xed, 0x59, 0x2e, 0xb9, 0x56, 0x48, 0xbd, 0x4d, 0x57, 0x57, 0x75, 0x57,
+	0x57, 0x57, 0x57, 0x57, 0x57, 0x57, 0x0f, 0x34, 0x06, 0x8e, 0xfb, 0x70, 0x48, 0xae, 0x04, 0xa1,
+	0x4f, 0x7d, 0x7d, 0x25, 0x59, 0xba, 0x22, 0x0a, 0x9d, 0x46, 0xd7, 0x1f, 0x0c, 0x7c, 0x4f, 0x00,
+	0x3b, 0x0d, 0xd2, 0xed, 0xe3, 0x01, 0x12, 0x25, 0xe3, 0x87, 0x1a, 0x9c, 0xbb, 0x19, 0x62, 0x44,
+	0xf1, 0x4d, 0xdf, 0x75, 0x71, 0x97, 0x3a, 0xbe, 0x67, 0xe2, 0x07, 0x43, 0x4c, 0xa8, 0xfe, 0x32,
+	0x94, 0xf6, 0x10, 0xc1, 0x6d, 0xed, 0xa2, 0xb6, 0x5e, 0xdf, 0x7c, 0xea, 0x4a, 0xaa, 0x6d, 0xd9,
+	0xe6, 0x5d, 0xd2, 0xbb, 0x81, 0x08, 0x36, 0x39, 0xa6, 0x7e, 0x0e, 0x2a, 0xf6, 0x9e, 0xe5, 0xa1,
+	0x01, 0x6e, 0x17, 0x2e, 0x6a, 0xeb, 0x35, 0xb3, 0x6c, 0xef, 0xbd, 0x8b, 0x06, 0x58, 0x7f, 0x0e,
+	0x96, 0xba, 0x71, 0xfb, 0x02, 0xa1, 0xc8, 0x11, 0x16, 0xc7, 0x60, 0x8e, 0xb8, 0x06, 0x65, 0xc1,
+	0x5f, 0xbb, 0x74, 0x51, 0x5b, 0x6f, 0x98, 0xb2, 0xa4, 0x9f, 0x07, 0x20, 0x7d, 0x14, 0xda, 0xc4,
+	0xf2, 0x86, 0x83, 0xf6, 0xc2, 0x45, 0x6d, 0x7d, 0xc1, 0xac, 0x09, 0xc8, 0xbb, 0xc3, 0x81, 0xf1,
+	0x2d, 0x0d, 0xce, 0x6e, 0x85, 0x7e, 0x70, 0x2a, 0x06, 0x61, 0xfc, 0xa1, 0x06, 0xab, 0xb7, 0x11,
+	0x39, 0x1d, 0x12, 0x3d, 0x0f, 0x40, 0x9d, 0x01, 0xb6, 0x08, 0x45, 0x83, 0x80, 0x4b, 0xb5, 0x64,
+	0xd6, 0x18, 0x64, 0x97, 0x01, 0x8c, 0x0f, 0xa1, 0x71, 0xc3, 0xf7, 0x5d, 0x13, 0x93, 0xc0, 0xf7,
+	0x08, 0xd6, 0xaf, 0x41, 0x99, 0x50, 0x44, 0x87, 0x44, 0x32, 0xf9, 0xa4, 0x92, 0xc9, 0x5d, 0x8e,
+	0x62, 0x4a, 0x54, 0x7d, 0x15, 0x16, 0x1e, 0x22, 0x77, 0x28, 0x78, 0xac, 0x9a, 0xa2, 0x60, 0x7c,
+	0x0d, 0x16, 0x77, 0x69, 0xe8, 0x78, 0xbd, 0x4f, 0xb1, 0xf1, 0x5a, 0xd4, 0xf8, 0x3f, 0x69, 0xf0,
+	0xc4, 0x16, 0x26, 0xdd, 0xd0, 0xd9, 0x3b, 0x25, 0xaa, 0x6b, 0x40, 0x63, 0x0c, 0xd9, 0xde, 0xe2,
+	0xa2, 0x2e, 0x9a, 0x29, 0x58, 0x66, 0x32, 0x16, 0xb2, 0x93, 0xf1, 0xef, 0x45, 0xe8, 0xa8, 0x06,
+	0x35, 0x8f, 0xf8, 0xbe, 0x18, 0xaf, 0xa8, 0x02, 0x27, 0xba, 0x9c, 0x26, 0x12, 0x75, 0x57, 0xc6,
+	0xbd, 0xed, 0x72, 0x40, 0xbc, 0xf0, 0xb2, 0xa3, 0x2a, 0x2a, 0x46, 0xb5, 0x09, 0x67, 0x1f, 0x3a,
+	0x21, 0x1d, 0x22, 0xd7, 0xea, 0xf6, 0x91, 0xe7, 0x61, 0x97, 0xcb, 0x89, 0xb4, 0x4b, 0x17, 0x8b,
+	0xeb, 0x35, 0x73, 0x45, 0x56, 0xde, 0x14, 0x75, 0x4c, 0x58, 0x44, 0x7f, 0x15, 0xd6, 0x82, 0xfe,
+	0x88, 0x38, 0xdd, 0x09, 0xa2, 0x05, 0x4e, 0xb4, 0x1a, 0xd5, 0xa6, 0xa8, 0x5e, 0x80, 0xe5, 0x2e,
+	0xb7, 0x56, 0xb6, 0xc5, 0xa4, 0x26, 0xc4, 0x58, 0xe6, 0x62, 0x6c, 0xc9, 0x8a, 0x7b, 0x11, 0x9c,
+	0xb1, 0x15, 0x21, 0x0f, 0x69, 0x37, 0x41, 0x50, 0xe1, 0x04, 0x2b, 0xb2, 0xf2, 0x7d, 0xda, 0x1d,
+	0xd3, 0xa4, 0xed, 0x4c, 0x35, 0x63, 0x67, 0xf4, 0xeb, 0x00, 0x41, 0xe8, 0x07, 0x38, 0xa4, 0x0e,
+	0x26, 0xed, 0xda, 0xc5, 0xe2, 0x7a, 0x7d, 0xf3, 0x92, 0x72, 0x16, 0xde, 0xc1, 0xa3, 0x0f, 0x98,
+	0xa2, 0xee, 0x20, 0x27, 0x34, 0x13, 0x44, 0xdc, 0x54, 0xdd, 0xf1, 0x91, 0x7d, 0x3a, 0x4c, 0xd5,
+	0x77, 0x35, 0x68, 0x9b, 0xd8, 0xc5, 0x88, 0x9c, 0x8e, 0x55, 0x64, 0xfc, 0xb2, 0x06, 0x4f, 0xdf,
+	0xc2, 0x34, 0xa1, 0x8f, 0x14, 0x51, 0x87, 0x50, 0xa7, 0x4b, 0x4e, 0x92, 0xad, 0x7f, 0xd6, 0xe0,
+	0x42, 0x2e, 0x5b, 0xf3, 0x2c, 0xcf, 0xd7, 0x61, 0x81, 0x7d, 0x91, 0x76, 0x61, 0x56, 0x65, 0x12,
+	0xf8, 0xfa, 0x5b, 0x50, 0x21, 0xd4, 0x0f, 0x51, 0x4f, 0xb0, 0x5c, 0xdf, 0x7c, 0xe1, 0x8a, 0x62,
+	0xf3, 0x4f, 0x2e, 0x6c, 0x81, 0xcd, 0xfa, 0x27, 0x66, 0x44, 0x6b, 0xfc, 0x7e, 0x11, 0xd6, 0x76,
+	0xfb, 0xfe, 0xc1, 0x18, 0xef, 0x51, 0xc8, 0x39, 0x6d, 0xf7, 0x8a, 0x19, 0xbb, 0xa7, 0xbf, 0x02,
+	0x25, 0x3a, 0x0a, 0x30, 0x37, 0x99, 0x8b, 0x9b, 0xe7, 0x95, 0x03, 0x61, 0x4c, 0xde, 0x1b, 0x05,
+	0xd8, 0xe4, 0xa8, 0xfa, 0xf3, 0xd0, 0xca, 0xcc, 0x5c, 0x64, 0x39, 0x96, 0xd2, 0x53, 0x47, 0xf4,
+	0x2f, 0xc3, 0x92, 0x5c, 0x7f, 0x23, 0xeb, 0xbe, 0xe3, 0x52, 0x1c, 0xb6, 0xcb, 0xb3, 0x0a, 0x7b,
+	0x31, 0xa2, 0x7c, 0x9b, 0x13, 0xea, 0x97, 0xa0, 0xc1, 0xfa, 0xb2, 0x02, 0x44, 0x29, 0x0e, 0x3d,
+	0x6e, 0x4a, 0x6a, 0x66, 0x9d, 0xc1, 0x76, 0x04, 0x88, 0xed, 0x57, 0xae, 0x33, 0x70, 0x28, 0xb7,
+	0x1e, 0x45, 0x53, 0x14, 0x98, 0x04, 0x02, 0xd4, 0xc3, 0x16, 0xf5, 0xf7, 0xb1, 0xd7, 0xae, 0x71,
+	0xb2, 0x1a, 0x83, 0xdc, 0x63, 0x00, 0xd6, 0xee, 0x81, 0x43, 0xfb, 0x96, 0x8d, 0x29, 0x72, 0x5c,
+	0xd2, 0x06, 0xbe, 0x91, 0xd6, 0x19, 0x6c, 0x4b, 0x80, 0x8c, 0x1f, 0x15, 0xe1, 0xdc, 0xc4, 0x4c,
+	0xcd, 0xa3, 0x7a, 0x2a, 0x11, 0x16, 0xd4, 0x22, 0xbc, 0x0c, 0x89, 0x05, 0x61, 0x39, 0x36, 0x69,
+	0x17, 0x2f, 0x16, 0xd7, 0x8b, 0x66, 0x33, 0xb1, 0x0f, 0xd8, 0x44, 0x7f, 0x09, 0xf4, 0x09, 0xf3,
+	0x2c, 0x76, 0x81, 0x92, 0xb9, 0x9c, 0xb5, 0xcf, 0x7c, 0x0f, 0x50, 0x1a, 0x68, 0x31, 0x93, 0x25,
+	0x73, 0x55, 0x61, 0xa1, 0x89, 0xfe, 0x0a, 0xac, 0x3a, 0xde, 0x5d, 0x3c, 0xf0, 0xc3, 0x91, 0x15,
+	0xe0, 0xb0, 0x8b, 0x3d, 0x8a, 0x7a, 0x98, 0xf0, 0x39, 0x2d, 0x9a, 0x2b, 0x51, 0xdd, 0xce, 0xb8,
+	0x8a, 0xf1, 0x75, 0x80, 0xc2, 0xc1, 0x30, 0x48, 0x11, 0x54, 0x38, 0xc1, 0xb2, 0xa8, 0x49, 0xa2,
+	0x3f, 0x0b, 0x4b, 0x1e, 0xfe, 0x98, 0x5a, 0x89, 0x09, 0xab, 0xf2, 0x09, 0x6b, 0x32, 0xf0, 0x4e,
+	0x3c, 0x69, 0x6f, 0x42, 0x25, 0x9a, 0x2f, 0xb1, 0x15, 0x5c, 0x3e, 0x64, 0x09, 0x8a, 0xa9, 0x34,
+	0x23, 0x2a, 0xe3, 0x4f, 0x35, 0x58, 0x13, 0xde, 0xf7, 0x0e, 0x0a, 0xa9, 0x73, 0xd2, 0x1e, 0xcc,
+	0x65, 0x58, 0x0c, 0x22, 0x3e, 0x04, 0x5e, 0x49, 0x0c, 0x3b, 0x86, 0x72, 0x5b, 0xf8, 0x27, 0x1a,
+	0xac, 0x32, 0x67, 0xfb, 0x71, 0xe2, 0xf9, 0x8f, 0x35, 0x58, 0xb9, 0x8d, 0xc8, 0xe3, 0xc4, 0xf2,
+	0x9f, 0x49, 0x47, 0x21, 0xe6, 0xf9, 0x24, 0x37, 0x40, 0x86, 0x98, 0x66, 0x3a, 0xf2, 0xee, 0x16,
+	0x53, 0x5c, 0x13, 0xe3, 0x07, 0x63, 0x8f, 0xe2, 0x31, 0xe3, 0xfc, 0x47, 0x1a, 0x9c, 0xbf, 0x85,
+	0x69, 0xcc, 0xf5, 0xa9, 0xf0, 0x3c, 0x66, 0xd4, 0x16, 0xb6, 0xeb, 0xdc, 0x77, 0x87, 0xa4, 0xcf,
+	0x0f, 0x15, 0x55, 0x53, 0x14, 0x8c, 0xef, 0x0a, 0x6f, 0x4a, 0x39, 0xa4, 0x93, 0xf0, 0x5a, 0x8c,
+	0x6f, 0x15, 0xe0, 0x2c, 0xdb, 0xc4, 0x4e, 0x87, 0x6a, 0xcc, 0x72, 0x64, 0x53, 0xa8, 0xcf, 0x82,
+	0x4a, 0x7d, 0x62, 0x27, 0xa6, 0x3c, 0xb3, 0x13, 0x63, 0x7c, 0xbf, 0x00, 0x6b, 0x59, 0x69, 0xcc,
+	0x33, 0x2d, 0x0a, 0x5e, 0x0b, 0x4a, 0x5e, 0x0d, 0x68, 0xc4, 0x90, 0xed, 0xad, 0x68, 0x37, 0x4f,
+	0xc1, 0x4e, 0xeb, 0x66, 0x6e, 0x7c, 0x5b, 0x83, 0xb5, 0xe8, 0x90, 0xbc, 0x8b, 0x7b, 0x03, 0xec,
+	0xd1, 0xe3, 0xeb, 0x50, 0x56, 0x03, 0x0a, 0x0a, 0x0d, 0x78, 0x0a, 0x6a, 0x44, 0xf4, 0x13, 0x9f,
+	0x7f, 0xc7, 0x00, 0xe3, 0xf7, 0x34, 0x38, 0x37, 0xc1, 0xce, 0x3c, 0x93, 0xd8, 0x86, 0x8a, 0xe3,
+	0xd9, 0xf8, 0xe3, 0x98, 0x9b, 0xa8, 0xc8, 0x6a, 0xf6, 0x86, 0x8e, 0x6b, 0xc7, 0x6c, 0x44, 0x45,
+	0xe6, 0x3e, 0x62, 0x0f, 0xed, 0xb9, 0xd8, 0xe2, 0xb8, 0x5c, 0x91, 0xab, 0x66, 0x5d, 0xc0, 0xb6,
+	0x19, 0xc8, 0xf8, 0x8e, 0x06, 0x2b, 0x4c, 0xd7, 0x24, 0x8f, 0xe4, 0xd1, 0xca, 0xec, 0x22, 0xd4,
+	0x13, 0xca, 0x24, 0xd9, 0x4d, 0x82, 0x8c, 0x7d, 0x58, 0x4d, 0xb3, 0x33, 0x8f, 0xcc, 0x9e, 0x06,
+	0x88, 0x67, 0x44, 0xe8, 0x7c, 0xd1, 0x4c, 0x40, 0x8c, 0xff, 0xd2, 0x40, 0x17, 0x8e, 0x16, 0x17,
+	0xc6, 0x09, 0xc7, 0xe3, 0xee, 0x3b, 0xd8, 0xb5, 0x93, 0xb6, 0xbc, 0xc6, 0x21, 0xbc, 0x7a, 0x0b,
+	0x1a, 0xf8, 0x63, 0x1a, 0x22, 0x2b, 0x40, 0x21, 0x1a, 0x88, 0xc5, 0x33, 0x93, 0x81, 0xad, 0x73,
+	0xb2, 0x1d, 0x4e, 0x65, 0xfc, 0x0d, 0x73, 0xd1, 0xa4, 0x52, 0x9e, 0xf6, 0x11, 0x9f, 0x07, 0xe0,
+	0x4a, 0x2b, 0xaa, 0x17, 0x44, 0x35, 0x87, 0xb0, 0x6a, 0xe3, 0x7f, 0x35, 0x68, 0xf1, 0x21, 0x88,
+	0xf1, 0x04, 0xac, 0xd9, 0x0c, 0x8d, 0x96, 0xa1, 0x99, 0xb2, 0x84, 0x3e, 0x0f, 0x65, 0x29, 0xd8,
+	0xe2, 0xac, 0x82, 0x95, 0x04, 0x87, 0x0d, 0xe3, 0x35, 0xb1, 0x25, 0x8a, 0x11, 0x2c, 0x6e, 0x5e,
+	0x50, 0x36, 0xcc, 0x07, 0xc2, 0x74, 0x17, 0x8b, 0x0d, 0x11, 0xeb, 0x17, 0xa0, 0x7e, 0x1f, 0x39,
+	0xae, 0x15, 0x62, 0x44, 0x7c, 0x8f, 0x6f, 0x1e, 0x35, 0x13, 0x18, 0xc8, 0xe4, 0x10, 0xe3, 0xb7,
+	0x59, 0x68, 0x3b, 0x3d, 0x95, 0xf3, 0xac, 0x94, 0x7b, 0xa0, 0x0b, 0xc9, 0xd9, 0x63, 0x71, 0x46,
+	0xdb, 0xb8, 0xfa, 0xf8, 0x92, 0x15, 0xbe, 0xb9, 0xec, 0x64, 0x20, 0xdc, 0x75, 0x7a, 0xea, 0x16,
+	0xa6, 0x1c, 0xf5, 0x06, 0xb3, 0x49, 0x3b, 0xa1, 0xdf, 0x0b, 0x31, 0x21, 0x8f, 0xaf, 0xde, 0xfd,
+	0x8a, 0xf0, 0x06, 0x55, 0x43, 0x9a, 0x47, 0xfe, 0x97, 0xa0, 0xc1, 0xfb, 0xc0, 0xb6, 0x15, 0xfa,
+	0x07, 0x44, 0xea, 0x67, 0x5d, 0xc2, 0x4c, 0xff, 0x80, 0x2b, 0x1a, 0xf5, 0x29, 0x72, 0x05, 0x82,
+	0xdc, 0x70, 0x38, 0x84, 0x55, 0xf3, 0xb5, 0x1d, 0x31, 0x26, 0x54, 0xe9, 0xb1, 0x95, 0xf1, 0xef,
+	0x6a, 0x70, 0x36, 0x33, 0x94, 0x79, 0x64, 0x1b, 0x2f, 0xc1, 0xc2, 0x3c, 0x4b, 0xb0, 0x38, 0xb1,
+	0x04, 0x7f, 0xa8, 0x41, 0x8b, 0x1d, 0x78, 0x1f, 0x73, 0x4b, 0xfa, 0x3b, 0x05, 0x68, 0x6e, 0x7b,
+	0x04, 0x87, 0xf4, 0x31, 0x38, 0xcf, 0xbc, 0x09, 0x75, 0x3e, 0x30, 0x62, 0xd9, 0x88, 0x22, 0xb9,
+	0x0d, 0x3e, 0xad, 0xbc, 0xbb, 0x78, 0x9b, 0xe1, 0x6d, 0x21, 0x8a, 0x4c, 0x21, 0x1d, 0xc2, 0xbe,
+	0xf5, 0x27, 0xa1, 0xd6, 0x47, 0xa4, 0x6f, 0xed, 0xe3, 0x91, 0x70, 0x27, 0x9b, 0x66, 0x95, 0x01,
+	0xde, 0xc1, 0x23, 0xa2, 0x3f, 0x01, 0x55, 0x6f, 0x38, 0x10, 0x0b, 0x8c, 0x85, 0xf0, 0x9a, 0x66,
+	0xc5, 0x1b, 0x0e, 0xf8, 0xf2, 0xfa, 0xfb, 0x02, 0x2c, 0xde, 0x1d, 0x52, 0x24, 0x6f, 0x5e, 0x86,
+	0x2e, 0x3d, 0x9e, 0x32, 0x6e, 0x40, 0x51, 0xf8, 0x22, 0x8c, 0xa2, 0xad, 0x64, 0x7c, 0x7b, 0x8b,
+	0x98, 0x0c, 0x89, 0x4d, 0x1c, 0x19, 0x76, 0xbb, 0xd2, 0x79, 0x2b, 0x72, 0x66, 0x6b, 0x0c, 0xc2,
+	0x35, 0x8e, 0x0d, 0x05, 0x87, 0x61, 0xec, 0xda, 0xf1, 0xa1, 0xe0, 0x30, 0x14, 0x95, 0x06, 0x34,
+	0x50, 0x77, 0xdf, 0xf3, 0x0f, 0x5c, 0x6c, 0xf7, 0xb0, 0x2d, 0xcf, 0x7f, 0x29, 0x98, 0x50, 0x0c,
+	0x36, 0xf1, 0x56, 0xd7, 0xa3, 0x7c, 0x8f, 0x29, 0x9a, 0x35, 0x01, 0xb9, 0xe9, 0xf1, 0xd8, 0xa4,
+	0x8d, 0x5d, 0x4c, 0x31, 0xaf, 0xae, 0x88, 0x6a, 0x01, 0x91, 0xd5, 0xc3, 0x20, 0xa6, 0x16, 0x51,
+	0xcd, 0x9a, 0x80, 0xb0, 0xea, 0xa7, 0xa0, 0x36, 0xbe, 0x5a, 0xa9, 0x8d, 0x43, 0xbb, 0x1c, 0x60,
+	0xfc, 0x95, 0x06, 0xcd, 0x2d, 0xde, 0xd4, 0x63, 0xa0, 0x74, 0x3a, 0x94, 0xf0, 0xc7, 0x41, 0x28,
+	0x97, 0x0e, 0xff, 0x36, 0x1e, 0x42, 0x6b, 0xc7, 0x45, 0x5d, 0xdc, 0xf7, 0x5d, 0x1b, 0x87, 0xdc,
+	0x2d, 0xd0, 0x5b, 0x50, 0xa4, 0xa8, 0x27, 0xfd, 0x0e, 0xf6, 0xa9, 0x7f, 0x4e, 0x1e, 0xfe, 0x84,
+	0xe5, 0x79, 0x46, 0xb9, 0x91, 0x26, 0x9a, 0x49, 0x04, 0xb2, 0xd7, 0xa0, 0xcc, 0x6f, 0x34, 0x85,
+	0x47, 0xd2, 0x30, 0x65, 0xc9, 0xf8, 0x28, 0xd5, 0xef, 0xad, 0xd0, 0x1f, 0x06, 0xfa, 0x36, 0x34,
+	0x82, 0x31, 0x8c, 0xa9, 0x63, 0xfe, 0xb6, 0x9d, 0x65, 0xda, 0x4c, 0x91, 0x1a, 0x7f, 0x5d, 0x82,
+	0xe6, 0x2e, 0x46, 0x61, 0xb7, 0xff, 0x38, 0xc4, 0x66, 0x98, 0xc4, 0x6d, 0xe2, 0xca, 0x89, 0x61,
+	0x9f, 0xec, 0x2a, 0x30, 0x31, 0x20, 0xab, 0xc7, 0x04, 0xc4, 0x55, 0xbb, 0x61, 0xb6, 0x82, 0xac,
+	0xe0, 0x5e, 0x87, 0xaa, 0x4d, 0x5c, 0x8b, 0x4f, 0x51, 0x85, 0x4f, 0x91, 0x7a, 0x7c, 0x5b, 0xc4,
+	0xe5, 0x53, 0x53, 0xb1, 0xc5, 0x87, 0xfe, 0x19, 0x68, 0xfa, 0x43, 0x1a, 0x0c, 0xa9, 0x25, 0x4c,
+	0x4b, 0xbb, 0xca, 0xd9, 0x6b, 0x08, 0x20, 0xb7, 0x3c, 0x44, 0x7f, 0x1b, 0x9a, 0x84, 0x8b, 0x32,
+	0x72, 0xda, 0x67, 0xbe, 0x18, 0x6c, 0x08, 0x3a, 0xe1, 0xb5, 0xb3, 0x80, 0x3c, 0x0d, 0xd1, 0x43,
+	0xec, 0x26, 0xee, 0x2a, 0x81, 0x2f, 0xa8, 0x25, 0x01, 0x1f, 0xdf, 0x53, 0x5e, 0x85, 0x95, 0xde,
+	0x10, 0x85, 0xc8, 0xa3, 0x18, 0x27, 0xb0, 0xeb, 0x1c, 0x5b, 0x8f, 0xab, 0xc6, 0x04, 0x3b, 0xb0,
+	0xca, 0xd4, 0xd9, 0xa2, 0x78, 0x10, 0xb8, 0x88, 0x62, 0x4b, 0x2a, 0x5d, 0x63, 0x26, 0xc3, 0xaa,
+	0x33, 0xda, 0x7b, 0x92, 0xf4, 0x03, 0xa1, 0xa0, 0xef, 0x40, 0xe9, 0xb6, 0x43, 0xf9, 0xd4, 0x6c,
+	0x6f, 0x09, 0x5d, 0x2c, 0x0a, 0x73, 0xf6, 0x04, 0x54, 0x43, 0xff, 0x40, 0x18, 0xee, 0x02, 0x57,
+	0xea, 0x4a, 0xe8, 0x1f, 0x70, 0xab, 0xcc, 0xf3, 0x3b, 0xfc, 0x50, 0x6a, 0x7b, 0xc1, 0x94, 0x25,
+	0xe3, 0x5f, 0xb4, 0xb1, 0x3a, 0x32, 0x9b, 0x4b, 0x8e, 0x67, 0x74, 0xdf, 0x84, 0x4a, 0x28, 0xe8,
+	0xa7, 0xde, 0x76, 0x27, 0x7b, 0xe2, 0xe3, 0x8b, 0xa8, 0x62, 0x85, 0x64, 0xde, 0x97, 0x6c, 0xa8,
+	0xc8, 0x0d, 0xea, 0xa2, 0x04, 0x47, 0xec, 0xbd, 0x04, 0xfa, 0xd0, 0x0b, 0x31, 0xea, 0xf6, 0xf9,
+	0xb1, 0x5b, 0x5c, 0x11, 0x4b, 0xe5, 0x5d, 0x4e, 0xd4, 0xec, 0xf2, 0x0a, 0xe3, 0x9b, 0x1a, 0x34,
+	0xde, 0x66, 0x21, 0xb9, 0x47, 0xb0, 0xda, 0x54, 0xf7, 0x38, 0x45, 0xe5, 0x3d, 0x8e, 0xf1, 0x0b,
+	0x05, 0x68, 0x4a, 0x36, 0xe6, 0x71, 0xb4, 0x72, 0x59, 0xd9, 0x85, 0x3a, 0xeb, 0xd2, 0x22, 0xb8,
+	0x17, 0x85, 0x95, 0xea, 0x9b, 0x9b, 0x4a, 0xfb, 0x94, 0x62, 0x83, 0xdf, 0x91, 0xec, 0x72, 0xa2,
+	0xb7, 0x3c, 0x1a, 0x8e, 0x4c, 0xe8, 0xc6, 0x80, 0xce, 0x47, 0xb0, 0x94, 0xa9, 0x66, 0x3a, 0xb7,
+	0x8f, 0x47, 0x91, 0x01, 0xde, 0xc7, 0x23, 0xfd, 0xd5, 0x64, 0x96, 0x48, 0x9e, 0x42, 0xdf, 0xf1,
+	0xbd, 0xde, 0xf5, 0x30, 0x44, 0x23, 0x99, 0x45, 0xf2, 0x46, 0xe1, 0x73, 0x9a, 0xf1, 0x8b, 0x45,
+	0x68, 0xbc, 0x37, 0xc4, 0xe1, 0xe8, 0x24, 0x0d, 0x61, 0xb4, 0xf3, 0x94, 0xc6, 0x3b, 0xcf, 0xa4,
+	0xed, 0x59, 0x50, 0xd8, 0x1e, 0x85, 0x05, 0x2d, 0x2b, 0x2d, 0xa8, 0xca, 0xb8, 0x54, 0x8e, 0x64,
+	0x5c, 0xaa, 0x47, 0x36, 0x2e, 0xb5, 0x63, 0x1b, 0x97, 0x6f, 0x6a, 0xf1, 0xa4, 0xcc, 0x65, 0x0e,
+	0x52, 0x4e, 0x64, 0xe1, 0xa8, 0x4e, 0x24, 0xbb, 0xea, 0xaa, 0x7d, 0x80, 0xbb, 0xd4, 0x0f, 0x99,
+	0x5d, 0x53, 0xcc, 0xa6, 0x36, 0x83, 0x9f, 0x5e, 0xc8, 0xfa, 0xe9, 0xd7, 0xa0, 0xea, 0xd8, 0x16,
+	0x62, 0x8a, 0xd8, 0x2e, 0x1e, 0xe2, 0x1f, 0x56, 0x1c, 0x9b, 0x6b, 0xec, 0xec, 0xd7, 0x18, 0xbf,
+	0xaa, 0x41, 0x43, 0xf0, 0x4c, 0x04, 0xe5, 0x17, 0x12, 0xdd, 0x69, 0xaa, 0xd5, 0x21, 0x0b, 0xf1,
+	0x40, 0x6f, 0x9f, 0x19, 0x77, 0x7b, 0x1d, 0x80, 0xc9, 0x4e, 0x92, 0x8b, 0xc5, 0x75, 0x51, 0xc9,
+	0xad, 0x20, 0xe7, 0x72, 0xbc, 0x7d, 0xc6, 0xac, 0x31, 0x2a, 0xde, 0xc4, 0x8d, 0x0a, 0x2c, 0x70,
+	0x6a, 0xe3, 0x7f, 0x34, 0x58, 0xb9, 0x89, 0xdc, 0xee, 0x96, 0x43, 0x28, 0xf2, 0xba, 0x73, 0x78,
+	0x84, 0x6f, 0x40, 0xc5, 0x0f, 0x2c, 0x17, 0xdf, 0xa7, 0x92, 0xa5, 0x4b, 0x53, 0x46, 0x24, 0xc4,
+	0x60, 0x96, 0xfd, 0xe0, 0x0e, 0xbe, 0x4f, 0xf5, 0x9f, 0x80, 0xaa, 0x1f, 0x58, 0xa1, 0xd3, 0xeb,
+	0xd3, 0x76, 0x71, 0x56, 0xe2, 0x8a, 0x1f, 0x98, 0x8c, 0x22, 0x11, 0x40, 0x2a, 0x1d, 0x31, 0x80,
+	0x64, 0xfc, 0xe3, 0xc4, 0xf0, 0xe7, 0x50, 0xed, 0x37, 0xa0, 0xea, 0x78, 0xd4, 0xb2, 0x1d, 0x12,
+	0x89, 0xe0, 0xbc, 0x5a, 0x87, 0x3c, 0xca, 0x47, 0xc0, 0xe7, 0xd4, 0xa3, 0xac, 0x6f, 0xfd, 0x4b,
+	0x00, 0xf7, 0x5d, 0x1f, 0x49, 0x6a, 0x21, 0x83, 0x0b, 0xea, 0x55, 0xc1, 0xd0, 0x22, 0xfa, 0x1a,
+	0x27, 0x62, 0x2d, 0x8c, 0xa7, 0xf4, 0x1f, 0x34, 0x38, 0xbb, 0x83, 0x43, 0xe2, 0x10, 0x8a, 0x3d,
+	0x2a, 0x83, 0xb9, 0xdb, 0xde, 0x7d, 0x3f, 0x1d, 0x35, 0xd7, 0x32, 0x51, 0xf3, 0x4f, 0x27, 0x86,
+	0x9c, 0x3a, 0xc6, 0x89, 0xbb, 0x9b, 0xe8, 0x18, 0x17, 0xdd, 0x50, 0x45, 0xe1, 0x38, 0xf5, 0x34,
+	0x49, 0x7e, 0x93, 0xd1, 0x00, 0xe3, 0x97, 0x44, 0xa6, 0x8f, 0x72, 0x50, 0xc7, 0x57, 0xd8, 0x35,
+	0x90, 0x5b, 0x42, 0x66, 0x83, 0x78, 0x16, 0x32, 0xb6, 0x23, 0x27, 0xff, 0xe8, 0xd7, 0x35, 0xb8,
+	0x98, 0xcf, 0xd5, 0x3c, 0x7b, 0xf9, 0x97, 0x60, 0xc1, 0xf1, 0xee, 0xfb, 0x51, 0x0c, 0x70, 0x43,
+	0x7d, 0x98, 0x50, 0xf6, 0x2b, 0x08, 0x8d, 0xff, 0xd4, 0xa0, 0xc5, 0x6d, 0xf5, 0x09, 0x4c, 0xff,
+	0x00, 0x0f, 0x2c, 0xe2, 0x7c, 0x82, 0xa3, 0xe9, 0x1f, 0xe0, 0xc1, 0xae, 0xf3, 0x09, 0x4e, 0x69,
+	0xc6, 0x42, 0x5a, 0x33, 0xd2, 0x51, 0x92, 0xf2, 0x94, 0xd8, 0x71, 0x25, 0x15, 0x3b, 0x66, 0x97,
+	0xa9, 0x9d, 0x5b, 0x98, 0x66, 0x87, 0x7a, 0x72, 0x4a, 0xf1, 0x3d, 0x0d, 0x9e, 0x54, 0x32, 0x34,
+	0x8f, 0x3e, 0x7c, 0x21, 0xad, 0x0f, 0xea, 0xc3, 0xe5, 0x44, 0x97, 0x52, 0x15, 0x5e, 0x81, 0xc6,
+	0xd6, 0x70, 0x30, 0x88, 0x5d, 0xa9, 0x4b, 0xd0, 0x08, 0xc5, 0xa7, 0x38, 0x7b, 0x89, 0xed, 0xb2,
+	0x2e, 0x61, 0xec, 0x84, 0x65, 0xbc, 0x00, 0x4d, 0x49, 0x22, 0xb9, 0xee, 0x40, 0x35, 0x94, 0xdf,
+	0x12, 0x3f, 0x2e, 0x1b, 0x67, 0x61, 0xc5, 0xc4, 0x3d, 0xa6, 0x89, 0xe1, 0x1d, 0xc7, 0xdb, 0x97,
+	0xdd, 0x18, 0xdf, 0xd0, 0x60, 0x35, 0x0d, 0x97, 0x6d, 0x7d, 0x16, 0x2a, 0xc8, 0xb6, 0x43, 0x4c,
+	0xc8, 0xd4, 0x69, 0xb9, 0x2e, 0x70, 0xcc, 0x08, 0x39, 0x21, 0xb9, 0xc2, 0xcc, 0x92, 0x33, 0x2c,
+	0x58, 0xbe, 0x85, 0xe9, 0x5d, 0x4c, 0xc3, 0xb9, 0x52, 0x06, 0xda, 0xec, 0x0c, 0xc3, 0x89, 0xa5,
+	0x5a, 0x44, 0x45, 0x76, 0xf3, 0xa9, 0x27, 0x7b, 0x98, 0x67, 0x9a, 0x93, 0x52, 0x2e, 0xa4, 0xa5,
+	0x2c, 0xb2, 0xbd, 0x06, 0x81, 0xef, 0x61, 0x8f, 0x26, 0x9d, 0xd6, 0x66, 0x0c, 0xe5, 0xea, 0xf7,
+	0x36, 0xe8, 0x37, 0xfb, 0xb8, 0xbb, 0x7f, 0x1b, 0x23, 0x97, 0x1e, 0xff, 0x60, 0x63, 0x84, 0xcc,
+	0xbf, 0x97, 0x0d, 0x8b, 0xb6, 0x98, 0x3b, 0x1c, 0xfa, 0x6e, 0x34, 0xff, 0xfc, 0x9b, 0xc1, 0x12,
+	0xee, 0x14, 0xff, 0xe6, 0x6b, 0x99, 0x58, 0x7d, 0x4e, 0x34, 0x92, 0x27, 0xb5, 0x9a, 0x43, 0x44,
+	0x2b, 0x23, 0x21, 0x4a, 0x44, 0x7c, 0x4f, 0xec, 0xd6, 0x35, 0x33, 0x2a, 0x1a, 0x7f, 0xcb, 0xf6,
+	0xe2, 0x24, 0xf3, 0xf3, 0xc8, 0x32, 0xcd, 0x45, 0x61, 0x0a, 0x17, 0xc5, 0x14, 0x17, 0xfa, 0x16,
+	0x40, 0x2c, 0xd2, 0xc8, 0xa1, 0x78, 0x26, 0x27, 0x87, 0x2c, 0x25, 0x20, 0x33, 0x41, 0x67, 0x7c,
+	0xaf, 0x00, 0x6b, 0xd7, 0x5d, 0x8a, 0xc3, 0xd3, 0x91, 0x07, 0x9f, 0xce, 0x91, 0x2e, 0x1d, 0x23,
+	0x47, 0x9a, 0x45, 0xe4, 0x65, 0x40, 0x92, 0x47, 0x6f, 0xc5, 0xb9, 0x47, 0xc6, 0x28, 0x79, 0xfc,
+	0x36, 0x9d, 0xa6, 0x5d, 0xce, 0x3e, 0x07, 0xf9, 0x35, 0xb1, 0x5b, 0x26, 0xe4, 0x31, 0xf4, 0x64,
+	0xb6, 0x29, 0x25, 0x27, 0x7b, 0x02, 0xff, 0xd7, 0x02, 0xac, 0xa9, 0xf9, 0x9a, 0xfd, 0x78, 0x31,
+	0xcb, 0xee, 0xb9, 0x06, 0x65, 0xd7, 0x47, 0x36, 0xb6, 0xe5, 0xaa, 0x90, 0x25, 0xfd, 0x0a, 0xac,
+	0x88, 0x2f, 0x6b, 0x20, 0xb2, 0x2e, 0xf6, 0x46, 0x14, 0x47, 0xde, 0xd3, 0xb2, 0xa8, 0x12, 0x39,
+	0x17, 0x37, 0x58, 0x05, 0x63, 0x8a, 0x60, 0xe4, 0x62, 0xdb, 0x92, 0xbb, 0x77, 0xb4, 0x9f, 0x2e,
+	0x0a, 0x70, 0x74, 0x7f, 0xcf, 0x64, 0xd0, 0x0b, 0xfd, 0x03, 0xc7, 0xeb, 0x8d, 0x31, 0x45, 0xa4,
+	0x79, 0x49, 0xc2, 0x63, 0xd4, 0xcb, 0xb0, 0x18, 0xe2, 0xc0, 0x75, 0xba, 0x88, 0x4d, 0xdf, 0x1e,
+	0x0e, 0xe5, 0x4e, 0xdb, 0x94, 0xd0, 0x77, 0x39, 0x90, 0x85, 0xbd, 0x1f, 0xb0, 0x7d, 0xc6, 0x7a,
+	0x10, 0x10, 0x7e, 0xf8, 0xd4, 0xcc, 0x2a, 0x07, 0xbc, 0x17, 0xf0, 0x2c, 0x09, 0xcf, 0xb7, 0xf1,
+	0xf6, 0x96, 0x38, 0x65, 0x16, 0xcd, 0xa8, 0x68, 0xfc, 0xa6, 0x06, 0x97, 0xa6, 0x4c, 0xfe, 0x3c,
+	0x0b, 0xfd, 0x7a, 0x3a, 0xed, 0xe9, 0xb0, 0x8c, 0xeb, 0x54, 0xc7, 0x82, 0xd2, 0xf8, 0x23, 0x0d,
+	0x56, 0x77, 0x69, 0x88, 0xd1, 0x20, 0xba, 0x8a, 0x99, 0xef, 0x71, 0x47, 0x22, 0xde, 0xc5, 0x58,
+	0xfa, 0x8c, 0x92, 0xa5, 0xf4, 0x7d, 0xc6, 0x38, 0xda, 0xf5, 0x19, 0x68, 0xa2, 0xee, 0x3e, 0xb6,
+	0xad, 0x3d, 0x44, 0xbb, 0x7d, 0x1c, 0x5d, 0x36, 0x36, 0x38, 0xf0, 0x86, 0x80, 0x19, 0x7f, 0xae,
+	0xc1, 0x2a, 0xdf, 0xef, 0xb7, 0x29, 0x0e, 0x11, 0xf5, 0xc3, 0xe3, 0x2f, 0xa0, 0xd7, 0x61, 0x81,
+	0x4f, 0xe0, 0xd4, 0x43, 0x5b, 0x32, 0x16, 0x63, 0x0a, 0x7c, 0xb6, 0xde, 0x39, 0x8b, 0xc2, 0xd7,
+	0x93, 0x57, 0xa2, 0x1c, 0xc2, 0xbd, 0xbd, 0x35, 0x28, 0x77, 0x87, 0x21, 0xf1, 0xc3, 0xe8, 0xd5,
+	0x98, 0x28, 0xa9, 0x58, 0x3f, 0xc1, 0x68, 0x42, 0x82, 0xcd, 0x62, 0x92, 0x4d, 0xb6, 0xb3, 0xd9,
+	0xbe, 0x87, 0x65, 0xd6, 0x0e, 0xff, 0x36, 0xfe, 0x52, 0x83, 0xb3, 0x22, 0x4c, 0x39, 0xbf, 0xd8,
+	0xdf, 0x80, 0xb2, 0x88, 0x33, 0x4b, 0xb9, 0x1b, 0xea, 0xdc, 0xb4, 0xe4, 0x6d, 0x80, 0x29, 0x29,
+	0x8e, 0x2b, 0xf9, 0xbf, 0x50, 0xb0, 0x7f, 0x92, 0x71, 0xdd, 0xa3, 0x88, 0xfe, 0x3b, 0x1a, 0x9c,
+	0xfb, 0x29, 0x9e, 0x14, 0x7e, 0x3a, 0x9e, 0xc4, 0xfc, 0x06, 0xf3, 0x55, 0x78, 0xf2, 0xd2, 0xf5,
+	0xc0, 0x79, 0x07, 0xcf, 0x11, 0xa7, 0x54, 0xb9, 0x50, 0x4f, 0xb3, 0xed, 0xda, 0x79, 0xe8, 0xb8,
+	0xb8, 0x17, 0xef, 0x5a, 0x09, 0x08, 0x53, 0x80, 0x90, 0x85, 0xf4, 0xc4, 0x9b, 0x86, 0x12, 0x37,
+	0xc3, 0x35, 0x06, 0xb9, 0xc3, 0x00, 0xc6, 0x4f, 0xc3, 0x8a, 0xe9, 0xd3, 0x47, 0xc4, 0xdb, 0x25,
+	0x68, 0xf4, 0x42, 0xd4, 0xc5, 0x2c, 0x35, 0xd0, 0xf1, 0xed, 0xe8, 0x0c, 0xc8, 0x61, 0x3b, 0x1c,
+	0x64, 0x7c, 0x08, 0xcb, 0xec, 0x6a, 0xfe, 0x11, 0xf4, 0x6e, 0x84, 0xb0, 0x18, 0x35, 0x3b, 0x8f,
+	0x8d, 0x56, 0x0d, 0xec, 0x1c, 0x54, 0x50, 0xe0, 0x30, 0xef, 0x46, 0xce, 0x79, 0x19, 0xf1, 0x9e,
+	0x8c, 0x1f, 0x14, 0x00, 0xae, 0x0f, 0x6d, 0x87, 0x8a, 0x38, 0xf7, 0x2a, 0x2c, 0x74, 0xfb, 0xc8,
+	0xf1, 0xa4, 0x23, 0x20, 0x0a, 0x2c, 0xfa, 0x4d, 0xf0, 0x03, 0xb9, 0xed, 0xb3, 0x4f, 0xd6, 0x07,
+	0xdb, 0x69, 0xa4, 0x80, 0xf8, 0x37, 0xa3, 0x45, 0x5d, 0xea, 0x47, 0x31, 0x65, 0x51, 0x60, 0x9b,
+	0x2a, 0xf1, 0x87, 0x61, 0x17, 0x5b, 0x4e, 0x20, 0xaf, 0xd3, 0xaa, 0x02, 0xb0, 0x1d, 0xb0, 0x55,
+	0x32, 0xc0, 0xb4, 0xef, 0xdb, 0xf2, 0x58, 0x2c, 0x4b, 0x2a, 0x55, 0xad, 0x28, 0x3d, 0x93, 0xc4,
+	0xd9, 0xa5, 0x9a, 0x3a, 0xbb, 0xb0, 0xa6, 0xa5, 0xe8, 0xc4, 0xdb, 0x17, 0x59, 0x62, 0x70, 0x99,
+	0x77, 0x01, 0x02, 0x2e, 0x4a, 0x8c, 0xcf, 0x20, 0xc4, 0x0f, 0x2d, 0x76, 0x65, 0xcf, 0xaf, 0xb5,
+	0x6a, 0x66, 0x95, 0x01, 0x6e, 0x23, 0xc2, 0x8f, 0x07, 0x1c, 0xde, 0x10, 0x22, 0x65, 0xdf, 0xc6,
+	0x7f, 0x47, 0xb6, 0x9e, 0x8b, 0xef, 0x8e, 0xdf, 0x3b, 0xbe, 0x32, 0x30, 0xef, 0x92, 0xa2, 0x90,
+	0xf2, 0xd8, 0xb7, 0x14, 0x73, 0x8d, 0x43, 0x58, 0xc8, 0x9b, 0xc5, 0x16, 0xb0, 0x67, 0x5b, 0x09,
+	0x81, 0x57, 0xb0, 0x67, 0xdf, 0xcb, 0x97, 0xf9, 0x58, 0xac, 0x0b, 0x87, 0x89, 0xb5, 0xac, 0x14,
+	0x6b, 0xfc, 0xa4, 0xa8, 0x92, 0x78, 0x52, 0x64, 0x7c, 0x5f, 0x83, 0xb3, 0x99, 0x11, 0xcf, 0xa3,
+	0xa7, 0x9f, 0x87, 0x0a, 0xf6, 0x68, 0xe8, 0xe0, 0xc8, 0x97, 0xb8, 0xa0, 0xdc, 0x26, 0xc6, 0xda,
+	0x69, 0x46, 0xf8, 0xcc, 0xf7, 0x73, 0x3c, 0x8a, 0x7b, 0xa1, 0x43, 0x47, 0x16, 0x0e, 0x43, 0x3f,
+	0x8c, 0xfd, 0xdf, 0x18, 0xfe, 0x16, 0x07, 0x1b, 0x0f, 0x78, 0x0c, 0x45, 0x3e, 0xea, 0x64, 0x32,
+	0xbb, 0xe7, 0x74, 0xf7, 0xe7, 0xf0, 0xc9, 0x2f, 0x41, 0x83, 0x50, 0xe4, 0x32, 0x07, 0xd5, 0xf7,
+	0xdc, 0xe8, 0xf4, 0x55, 0x97, 0xb0, 0xaf, 0x78, 0xee, 0x88, 0x25, 0xa7, 0x2d, 0x65, 0x3a, 0x64,
+	0x64, 0xc9, 0x57, 0xa7, 0x51, 0x60, 0xa2, 0x3b, 0x7e, 0x6c, 0x9a, 0xce, 0x6b, 0x28, 0x64, 0xf2,
+	0x1a, 0xf4, 0x0d, 0x58, 0x76, 0x11, 0xa1, 0x16, 0xb2, 0x1f, 0x22, 0xaf, 0x8b, 0x93, 0xda, 0xb0,
+	0xc4, 0x2a, 0xae, 0x0b, 0x38, 0xd7, 0x8a, 0x16, 0x14, 0x5d, 0xd4, 0x93, 0x3e, 0x36, 0xfb, 0x64,
+	0xeb, 0x44, 0x72, 0x28, 0xf3, 0x35, 0xa2, 0xa2, 0xfe, 0x0c, 0x2c, 0x06, 0xd8, 0xb3, 0x99, 0x1b,
+	0x3d, 0x70, 0x3c, 0x4b, 0x3a, 0xd1, 0x25, 0xb3, 0x21, 0xa1, 0x77, 0x1d, 0xef, 0x1e, 0x31, 0xfe,
+	0x40, 0x44, 0x7e, 0x26, 0xc5, 0x38, 0x5f, 0x24, 0xb0, 0x2a, 0xc7, 0x1f, 0x69, 0x40, 0xce, 0x59,
+	0x34, 0xdd, 0xab, 0x19, 0x53, 0xb1, 0x75, 0x49, 0xf6, 0xf1, 0x41, 0x64, 0x86, 0xd8, 0xb7, 0xf1,
+	0x80, 0x67, 0xab, 0xbd, 0x37, 0xf4, 0x29, 0x7a, 0x9f, 0xa0, 0xde, 0x1c, 0x41, 0x7f, 0xc5, 0x72,
+	0x29, 0x28, 0x37, 0x4c, 0x02, 0x30, 0xee, 0x2f, 0xb6, 0xbf, 0x5a, 0xc2, 0xfe, 0xce, 0xda, 0x14,
+	0x23, 0x1e, 0x12, 0x1c, 0xed, 0x3c, 0xfc, 0x7b, 0xbc, 0x1a, 0x4b, 0xc9, 0xd5, 0xf8, 0xb3, 0x22,
+	0x97, 0x2d, 0x39, 0xd0, 0xf9, 0x5e, 0x58, 0x94, 0x87, 0x84, 0xa7, 0xc2, 0x4f, 0x5b, 0x8c, 0x89,
+	0xde, 0x24, 0x3a, 0xbb, 0xb2, 0x3a, 0xf7, 0xbe, 0x67, 0x9f, 0x96, 0x9f, 0x21, 0xcc, 0xf2, 0xc6,
+	0xc2, 0xf8, 0x3a, 0x9c, 0xbf, 0xe3, 0x10, 0xca, 0x36, 0xf2, 0x00, 0xdb, 0x8f, 0xf4, 0x29, 0x2a,
+	0x7b, 0x60, 0xbc, 0x3c, 0xd1, 0xd1, 0xa7, 0x7b, 0xf6, 0x66, 0x7d, 0x87, 0x7e, 0x60, 0xc9, 0xe4,
+	0x81, 0x92, 0x59, 0x66, 0xc5, 0x7b, 0x3c, 0x31, 0x22, 0x18, 0x86, 0xec, 0x51, 0x21, 0x91, 0x7f,
+	0x62, 0xa8, 0xf0, 0xf2, 0x3d, 0x62, 0xfc, 0x96, 0x06, 0x4f, 0xe7, 0xc9, 0x60, 0x1e, 0x3d, 0xba,
+	0x2d, 0x6e, 0xe4, 0x65, 0x5b, 0x52, 0x99, 0x9e, 0x55, 0x2a, 0xd3, 0x44, 0xd7, 0x66, 0x92, 0xd4,
+	0xf8, 0x37, 0x0d, 0x5a, 0xd9, 0xa7, 0x8c, 0x99, 0x40, 0x8c, 0x36, 0xfd, 0xbd, 0x7c, 0xe1, 0x38,
+	0xb1, 0xa0, 0x2f, 0x02, 0xb0, 0xa8, 0x84, 0x25, 0x6e, 0x73, 0x8a, 0xfc, 0x36, 0x47, 0x7d, 0x7f,
+	0xc9, 0x1e, 0xcb, 0x89, 0xab, 0x9c, 0x9a, 0x1b, 0x7d, 0xb2, 0x34, 0x21, 0x19, 0xef, 0x18, 0x3f,
+	0x2f, 0x91, 0x3a, 0xd8, 0x12, 0x15, 0xe3, 0xb7, 0x25, 0xc6, 0xdf, 0x69, 0xd0, 0x8e, 0x72, 0xad,
+	0x1f, 0xa3, 0xa7, 0x82, 0x39, 0x8f, 0xbf, 0x7e, 0x5c, 0x80, 0x27, 0x14, 0xa3, 0x99, 0x47, 0x9b,
+	0x32, 0x77, 0x32, 0x85, 0xc9, 0x3b, 0x19, 0xe5, 0x1f, 0x1a, 0x8a, 0x47, 0xfd, 0x43, 0x43, 0x29,
+	0xff, 0x0f, 0x0d, 0x4f, 0x42, 0x8d, 0x25, 0x17, 0x75, 0xfd, 0xa1, 0x47, 0x65, 0x28, 0x8a, 0x65,
+	0x1b, 0xdd, 0x64, 0x65, 0x16, 0xd0, 0x90, 0xc1, 0x27, 0x89, 0x20, 0x22, 0x50, 0x0d, 0x09, 0x14,
+	0x48, 0x9b, 0x70, 0x36, 0x13, 0xa9, 0x92, 0xc8, 0xc2, 0xbb, 0x5a, 0x49, 0x87, 0xab, 0x04, 0xcd,
+	0x93, 0xc0, 0x2f, 0xb4, 0xc5, 0x29, 0x58, 0xa4, 0x40, 0x56, 0x19, 0x80, 0x1d, 0x82, 0x8d, 0x1f,
+	0xa7, 0x62, 0x7a, 0xc9, 0x77, 0xf6, 0xcc, 0x4d, 0x88, 0xfa, 0x1a, 0x73, 0xad, 0xa5, 0xc2, 0x62,
+	0x66, 0xc4, 0xfc, 0x3a, 0xb4, 0x64, 0xa8, 0x6d, 0x8c, 0x5a, 0x48, 0xc6, 0xda, 0x62, 0xcc, 0x0b,
+	0x50, 0xdf, 0x73, 0x3c, 0xd7, 0xef, 0x25, 0x4f, 0xe5, 0x20, 0x40, 0xfc, 0x58, 0xce, 0xe4, 0xc0,
+	0xfa, 0x8f, 0x51, 0xa4, 0xd5, 0x8d, 0x80, 0x11, 0x92, 0x8d, 0x5d, 0x8a, 0x62, 0x24, 0x21, 0xcd,
+	0x46, 0x04, 0xe4, 0x48, 0xf1, 0x6d, 0x19, 0xc7, 0x88, 0x53, 0x47, 0x59, 0x0e, 0x35, 0xab, 0x7e,
+	0x11, 0xf4, 0x74, 0x38, 0x91, 0xa3, 0x55, 0x92, 0xeb, 0x4b, 0x44, 0x13, 0x39, 0xf6, 0x4f, 0x42,
+	0x39, 0x91, 0x46, 0x97, 0x67, 0x87, 0x78, 0xf0, 0x24, 0x29, 0x45, 0x53, 0x52, 0xb1, 0xf7, 0xd2,
+	0xcb, 0x13, 0xb5, 0xcc, 0x99, 0xe2, 0xf5, 0xf1, 0x45, 0x63, 0x54, 0x3c, 0x2c, 0x0f, 0xe3, 0x50,
+	0x31, 0x5e, 0x86, 0x45, 0x4c, 0xa8, 0x33, 0xe0, 0x1a, 0x9a, 0x90, 0x63, 0x33, 0x86, 0x2a, 0x64,
+	0xb4, 0x90, 0x91, 0xd1, 0xc6, 0x25, 0xa8, 0x46, 0xef, 0xfe, 0xf4, 0x0a, 0x14, 0xaf, 0xbb, 0x6e,
+	0xeb, 0x8c, 0xde, 0x80, 0xea, 0xb6, 0x7c, 0xdc, 0xd6, 0xd2, 0x36, 0xbe, 0x0c, 0x4b, 0x99, 0xec,
+	0x50, 0xbd, 0x0a, 0xa5, 0x77, 0x7d, 0x0f, 0xb7, 0xce, 0xe8, 0x2d, 0x68, 0xdc, 0x70, 0x3c, 0x14,
+	0x8e, 0x44, 0x46, 0x42, 0xcb, 0xd6, 0x97, 0xa0, 0xce, 0x6f, 0xe6, 0x25, 0x00, 0xeb, 0x00, 0x65,
+	0xf1, 0xab, 0x9d, 0xd6, 0xea, 0xc6, 0x35, 0xa8, 0xc5, 0x96, 0x50, 0x6f, 0x42, 0xed, 0x5d, 0x9f,
+	0xde, 0xe1, 0x13, 0xd1, 0x3a, 0xa3, 0xd7, 0xa1, 0xc2, 0xbe, 0x19, 0xa2, 0xc6, 0x88, 0x64, 0x45,
+	0x61, 0xf3, 0x3f, 0x9e, 0x85, 0xe6, 0x5d, 0x2e, 0xfe, 0x5d, 0x1c, 0x3e, 0x74, 0xba, 0x58, 0xb7,
+	0xa0, 0x95, 0xfd, 0x31, 0x94, 0xfe, 0xa2, 0xda, 0x1f, 0x54, 0xff, 0x3f, 0xaa, 0x33, 0xcd, 0xa2,
+	0x18, 0x67, 0xf4, 0xaf, 0xc1, 0x62, 0xfa, 0x97, 0x4d, 0xfa, 0x46, 0xee, 0xb6, 0x74, 0xe4, 0xc6,
+	0x2d, 0x68, 0xa6, 0xfe, 0xc0, 0xa4, 0x3f, 0xaf, 0x6c, 0x5b, 0xf5, 0x97, 0xa6, 0x8e, 0x3a, 0x2c,
+	0x99, 0xfc, 0x4b, 0x92, 0xe0, 0x3e, 0xfd, 0x17, 0x97, 0x1c, 0xee, 0x95, 0xbf, 0x7a, 0x39, 0x8c,
+	0x7b, 0x04, 0xcb, 0x13, 0x3f, 0x65, 0xd1, 0x5f, 0x52, 0xb6, 0x9f, 0xf7, 0xf3, 0x96, 0xc3, 0xba,
+	0x38, 0x00, 0x7d, 0xf2, 0x4f, 0x43, 0xfa, 0x15, 0xf5, 0x0c, 0xe4, 0xfd, 0x67, 0xa9, 0x73, 0x75,
+	0x66, 0xfc, 0x58, 0x70, 0x3f, 0xa7, 0xc1, 0xb9, 0x9c, 0x3f, 0xa9, 0xe8, 0xd7, 0x94, 0xcd, 0x4d,
+	0xff, 0x1d, 0x4c, 0xe7, 0xd5, 0xa3, 0x11, 0xc5, 0x8c, 0x78, 0xb0, 0x94, 0xf9, 0x9d, 0x86, 0xfe,
+	0x42, 0xee, 0xa3, 0xdd, 0x49, 0x9f, 0xb4, 0xf3, 0xe2, 0x6c, 0xc8, 0x71, 0x7f, 0x2c, 0x8d, 0x31,
+	0xfd, 0xaf, 0x87, 0x9c, 0xfe, 0xd4, 0x7f, 0x84, 0x38, 0x6c, 0x42, 0x3f, 0x84, 0x66, 0xea, 0xa7,
+	0x0c, 0x39, 0x1a, 0xaf, 0xfa, 0x71, 0xc3, 0x61, 0x4d, 0x7f, 0x04, 0x8d, 0xe4, 0xbf, 0x13, 0xf4,
+	0xf5, 0xbc, 0xb5, 0x34, 0xd1, 0xf0, 0x51, 0x96, 0x52, 0x4c, 0x4c, 0xa6, 0x2c, 0xa5, 0x89, 0x77,
+	0xe3, 0xb3, 0x2f, 0xa5, 0x44, 0xfb, 0x53, 0x97, 0xd2, 0x91, 0xbb, 0xf8, 0x86, 0x06, 0x6b, 0xea,
+	0x47, 0xf6, 0xfa, 0x66, 0x9e, 0x6e, 0xe6, 0xff, 0x64, 0xa0, 0x73, 0xed, 0x48, 0x34, 0xb1, 0x14,
+	0xf7, 0x61, 0x31, 0xfd, 0x94, 0x3c, 0x47, 0x8a, 0xca, 0xd7, 0xf7, 0x9d, 0x17, 0x66, 0xc2, 0x8d,
+	0x3b, 0x7b, 0x1f, 0xea, 0x89, 0xe7, 0xb4, 0xfa, 0x73, 0x53, 0xf4, 0x38, 0xf9, 0x68, 0xea, 0x30,
+	0x49, 0xf6, 0xa1, 0x19, 0xd9, 0x0e, 0xd1, 0xf0, 0xf3, 0x53, 0xed, 0x4b, 0xaa, 0xe9, 0x8d, 0x59,
+	0x50, 0xe3, 0x01, 0xf4, 0xa1, 0x99, 0x7a, 0x78, 0x96, 0xd3, 0x93, 0xea, 0x9d, 0x5d, 0x67, 0x63,
+	0x16, 0xd4, 0xb8, 0xa7, 0x9f, 0x49, 0xbc, 0x71, 0x4b, 0xbd, 0x23, 0xd4, 0x5f, 0x99, 0xda, 0x8e,
+	0xea, 0x19, 0x65, 0x67, 0xf3, 0x28, 0x24, 0x31, 0x0b, 0xef, 0x41, 0x2d, 0x7e, 0xbe, 0xa6, 0x5f,
+	0xce, 0x35, 0x0b, 0x47, 0x99, 0xa9, 0x5d, 0x28, 0x8b, 0xfb, 0x4b, 0xdd, 0xc8, 0x79, 0x34, 0x9a,
+	0x78, 0x67, 0xd6, 0x99, 0xe5, 0x56, 0x52, 0x34, 0x2a, 0x9e, 0x0a, 0xe5, 0x34, 0x9a, 0x7a, 0x47,
+	0x34, 0x6b, 0xa3, 0x26, 0x94, 0xc5, 0xb5, 0x90, 0x3e, 0xc3, 0xb5, 0x57, 0x67, 0x3a, 0x0e, 0x6b,
+	0x92, 0x8d, 0x7e, 0x07, 0x16, 0x78, 0xfa, 0xba, 0x7e, 0x69, 0x5a, 0x6a, 0xfb, 0xb4, 0x16, 0x53,
+	0xd9, 0xef, 0xc6, 0x19, 0xfd, 0x2b, 0xb0, 0xc0, 0x43, 0xb9, 0xfa, 0xe1, 0x77, 0xa2, 0x9d, 0xa9,
+	0x28, 0x11, 0x8b, 0x36, 0x34, 0x92, 0xb9, 0xa6, 0x39, 0x36, 0x5b, 0x91, 0x8d, 0xdb, 0x99, 0x05,
+	0x33, 0xea, 0xe5, 0xe7, 0x35, 0x68, 0xe7, 0xa5, 0x25, 0xea, 0xb9, 0x1b, 0xf3, 0xb4, 0xdc, 0xca,
+	0xce, 0x6b, 0x47, 0xa4, 0x8a, 0x45, 0xf8, 0x09, 0xac, 0x28, 0x92, 0xe1, 0xf4, 0xab, 0x79, 0xed,
+	0xe5, 0xe4, 0xf1, 0x75, 0x5e, 0x9e, 0x9d, 0x20, 0xee, 0x7b, 0x07, 0x16, 0x78, 0x12, 0x5b, 0xce,
+	0xf4, 0x25, 0x73, 0xe2, 0x3a, 0xc6, 0x34, 0x94, 0xb8, 0x45, 0x0c, 0x8d, 0x64, 0x46, 0x5b, 0xce,
+	0xfc, 0x29, 0x92, 0xe1, 0x3a, 0xcf, 0xcf, 0x80, 0x19, 0x77, 0x63, 0x01, 0x8c, 0x33, 0xca, 0xf4,
+	0x67, 0xf3, 0x86, 0x9e, 0x4e, 0x6a, 0xeb, 0x3c, 0x77, 0x28, 0x5e, 0xdc, 0xc1, 0x1e, 0xd4, 0x13,
+	0x79, 0x56, 0x79, 0x3b, 0xc5, 0x44, 0x1a, 0x59, 0x67, 0xfd, 0x70, 0xc4, 0xa4, 0x67, 0x95, 0xc9,
+	0x7f, 0xca, 0xf1, 0xac, 0xd4, 0x59, 0x52, 0x87, 0xd9, 0xba, 0x6f, 0x6b, 0xf0, 0x44, 0x6e, 0x42,
+	0x89, 0xfe, 0xda, 0xe1, 0xee, 0xa7, 0x22, 0xfb, 0xa8, 0xf3, 0xd9, 0xa3, 0x92, 0xc5, 0xa3, 0xed,
+	0x42, 0x23, 0x99, 0x40, 0x32, 0x93, 0x01, 0x56, 0xeb, 0x84, 0x2a, 0x0f, 0xc5, 0x38, 0xb3, 0xae,
+	0xbd, 0xac, 0xe9, 0x5f, 0x85, 0x86, 0x30, 0x7a, 0x02, 0xe7, 0xd3, 0xb3, 0x9d, 0x2f, 0x6b, 0x7a,
+	0x0f, 0x9a, 0xa9, 0xa4, 0x8c, 0x9c, 0xbd, 0x57, 0x95, 0x73, 0xd2, 0x99, 0x09, 0x35, 0xb2, 0x4e,
+	0x5f, 0x87, 0xc5, 0x74, 0x0e, 0x42, 0x9e, 0x4b, 0xa4, 0xca, 0xb3, 0xe8, 0xcc, 0x86, 0x1b, 0xf5,
+	0x65, 0x41, 0x2b, 0x9b, 0x33, 0x90, 0x73, 0x5c, 0xce, 0x49, 0x2d, 0x38, 0xfc, 0x44, 0xdb, 0x48,
+	0x26, 0x01, 0xe4, 0x19, 0xf4, 0xc9, 0x3c, 0x81, 0x9c, 0x8d, 0x32, 0x7d, 0xb5, 0x2d, 0x3a, 0x48,
+	0xde, 0xe4, 0xe7, 0x59, 0x1c, 0x9f, 0x1e, 0xb7, 0x83, 0x5d, 0x80, 0xf1, 0x55, 0xbd, 0x9e, 0x1f,
+	0x83, 0x4e, 0x37, 0x7e, 0xb8, 0xcb, 0x98, 0xba, 0x03, 0x9d, 0xa6, 0x4c, 0x99, 0x9b, 0xe1, 0xce,
+	0xc6, 0x2c, 0xa8, 0x99, 0xfd, 0x25, 0x7b, 0xe5, 0x96, 0xbf, 0xbf, 0xe4, 0xdc, 0x71, 0x76, 0x5e,
+	0x9e, 0x9d, 0x20, 0xe3, 0xae, 0x26, 0x2e, 0xb5, 0x9e, 0xcf, 0xdf, 0xa4, 0x32, 0x17, 0x6d, 0x9d,
+	0x8d, 0x59, 0x50, 0x13, 0x5a, 0xd0, 0xca, 0xde, 0x1e, 0xe5, 0xe8, 0x71, 0xce, 0x25, 0xd3, 0x2c,
+	0xa7, 0x25, 0xf5, 0x45, 0x47, 0xce, 0x69, 0x69, 0xea, 0xcd, 0x50, 0xe7, 0xda, 0x91, 0x68, 0xe2,
+	0x61, 0x52, 0x58, 0x9e, 0x08, 0x8d, 0xe7, 0x1c, 0x0b, 0xf3, 0x2e, 0x04, 0x3a, 0x57, 0x66, 0x45,
+	0x8f, 0x7a, 0xdd, 0x1c, 0x42, 0x63, 0x27, 0xf4, 0x3f, 0x1e, 0x45, 0x31, 0xb6, 0xff, 0x9f, 0x4d,
+	0xfe, 0xc6, 0x6b, 0x5f, 0xbd, 0xd6, 0x73, 0x68, 0x7f, 0xb8, 0xc7, 0x26, 0xe3, 0xaa, 0xc0, 0x7d,
+	0xc9, 0xf1, 0xe5, 0xd7, 0x55, 0xc7, 0xa3, 0x38, 0xf4, 0x90, 0x7b, 0x95, 0xb7, 0x25, 0xa1, 0xc1,
+	0xde, 0x5e, 0x99, 0x97, 0xaf, 0xfd, 0xdf, 0x00, 0x31, 0x4f, 0x3f, 0xea, 0x63, 0x5e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MilvusServiceClient is the client API for MilvusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusServiceClient interface {
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
+	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
+	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
+	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCollectionRuntimeStats(ctx context.Context, in *GetCollectionRuntimeStatsRequest, opts ...grpc.CallOption) (*GetCollectionRuntimeStatsResponse, error)
+	StreamInsert(ctx context.Context, opts ...grpc.CallOption) (MilvusService_StreamInsertClient, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (MilvusService_SearchStreamClient, error)
+	QueryIterator(ctx context.Context, in *QueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error)
+	SearchIterator(ctx context.Context, in *SearchIteratorRequest, opts ...grpc.CallOption) (*SearchIteratorResults, error)
+	WarmupCollection(ctx context.Context, in *WarmupCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error)
+	DropApiKey(ctx context.Context, in *DropApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	GetChannelTimeTicks(ctx context.Context, in *GetChannelTimeTicksRequest, opts ...grpc.CallOption) (*GetChannelTimeTicksResponse, error)
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error)
+	UndropCollection(ctx context.Context, in *UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error)
+	DescribePartition(ctx context.Context, in *DescribePartitionRequest, opts ...grpc.CallOption) (*DescribePartitionResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -4620,6 +7218,204 @@ func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error) {
+	out := new(CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCollectionRuntimeStats(ctx context.Context, in *GetCollectionRuntimeStatsRequest, opts ...grpc.CallOption) (*GetCollectionRuntimeStatsResponse, error) {
+	out := new(GetCollectionRuntimeStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionRuntimeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) StreamInsert(ctx context.Context, opts ...grpc.CallOption) (MilvusService_StreamInsertClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MilvusService_serviceDesc.Streams[0], "/milvus.proto.milvus.MilvusService/StreamInsert", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &milvusServiceStreamInsertClient{stream}
+	return x, nil
+}
+
+type MilvusService_StreamInsertClient interface {
+	Send(*InsertRequest) error
+	Recv() (*StreamInsertResponse, error)
+	grpc.ClientStream
+}
+
+type milvusServiceStreamInsertClient struct {
+	grpc.ClientStream
+}
+
+func (x *milvusServiceStreamInsertClient) Send(m *InsertRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *milvusServiceStreamInsertClient) Recv() (*StreamInsertResponse, error) {
+	m := new(StreamInsertResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *milvusServiceClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (MilvusService_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MilvusService_serviceDesc.Streams[1], "/milvus.proto.milvus.MilvusService/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &milvusServiceSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MilvusService_SearchStreamClient interface {
+	Recv() (*SearchResults, error)
+	grpc.ClientStream
+}
+
+type milvusServiceSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *milvusServiceSearchStreamClient) Recv() (*SearchResults, error) {
+	m := new(SearchResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *milvusServiceClient) QueryIterator(ctx context.Context, in *QueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error) {
+	out := new(QueryIteratorResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/QueryIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SearchIterator(ctx context.Context, in *SearchIteratorRequest, opts ...grpc.CallOption) (*SearchIteratorResults, error) {
+	out := new(SearchIteratorResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SearchIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) WarmupCollection(ctx context.Context, in *WarmupCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/WarmupCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error) {
+	out := new(ApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error) {
+	out := new(ApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropApiKey(ctx context.Context, in *DropApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetChannelTimeTicks(ctx context.Context, in *GetChannelTimeTicksRequest, opts ...grpc.CallOption) (*GetChannelTimeTicksResponse, error) {
+	out := new(GetChannelTimeTicksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetChannelTimeTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error) {
+	out := new(GetQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UndropCollection(ctx context.Context, in *UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UndropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error) {
+	out := new(ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DescribePartition(ctx context.Context, in *DescribePartitionRequest, opts ...grpc.CallOption) (*DescribePartitionResponse, error) {
+	out := new(DescribePartitionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -4655,6 +7451,23 @@ type MilvusServiceServer interface {
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	GetCollectionRuntimeStats(context.Context, *GetCollectionRuntimeStatsRequest) (*GetCollectionRuntimeStatsResponse, error)
+	StreamInsert(MilvusService_StreamInsertServer) error
+	SearchStream(*SearchRequest, MilvusService_SearchStreamServer) error
+	QueryIterator(context.Context, *QueryIteratorRequest) (*QueryIteratorResults, error)
+	SearchIterator(context.Context, *SearchIteratorRequest) (*SearchIteratorResults, error)
+	WarmupCollection(context.Context, *WarmupCollectionRequest) (*commonpb.Status, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*ApiKeyResponse, error)
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*ApiKeyResponse, error)
+	DropApiKey(context.Context, *DropApiKeyRequest) (*commonpb.Status, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	GetChannelTimeTicks(context.Context, *GetChannelTimeTicksRequest) (*GetChannelTimeTicksResponse, error)
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error)
+	UndropCollection(context.Context, *UndropCollectionRequest) (*commonpb.Status, error)
+	ListDroppedCollections(context.Context, *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error)
+	DescribePartition(context.Context, *DescribePartitionRequest) (*DescribePartitionResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -4751,8 +7564,76 @@ func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRe
 func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *RegisterLinkRequest) (*RegisterLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterLink not implemented")
 }
-func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) CheckHealth(ctx context.Context, req *CheckHealthRequest) (*CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) AlterCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) GetCollectionRuntimeStats(ctx context.Context, req *GetCollectionRuntimeStatsRequest) (*GetCollectionRuntimeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionRuntimeStats not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) StreamInsert(srv MilvusService_StreamInsertServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInsert not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) SearchStream(req *SearchRequest, srv MilvusService_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) QueryIterator(ctx context.Context, req *QueryIteratorRequest) (*QueryIteratorResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIterator not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) SearchIterator(ctx context.Context, req *SearchIteratorRequest) (*SearchIteratorResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIterator not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) WarmupCollection(ctx context.Context, req *WarmupCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmupCollection not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) RotateApiKey(ctx context.Context, req *RotateApiKeyRequest) (*ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) DropApiKey(ctx context.Context, req *DropApiKeyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropApiKey not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) QueryAuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) GetChannelTimeTicks(ctx context.Context, req *GetChannelTimeTicksRequest) (*GetChannelTimeTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelTimeTicks not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) GetQuotaUsage(ctx context.Context, req *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) UndropCollection(ctx context.Context, req *UndropCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndropCollection not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) ListDroppedCollections(ctx context.Context, req *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+
+func (*UnimplementedMilvusServiceServer) DescribePartition(ctx context.Context, req *DescribePartitionRequest) (*DescribePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePartition not implemented")
 }
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
@@ -5317,6 +8198,323 @@ func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CheckHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CheckHealth(ctx, req.(*CheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCollectionRuntimeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRuntimeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCollectionRuntimeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionRuntimeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCollectionRuntimeStats(ctx, req.(*GetCollectionRuntimeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_StreamInsert_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MilvusServiceServer).StreamInsert(&milvusServiceStreamInsertServer{stream})
+}
+
+type MilvusService_StreamInsertServer interface {
+	Send(*StreamInsertResponse) error
+	Recv() (*InsertRequest, error)
+	grpc.ServerStream
+}
+
+type milvusServiceStreamInsertServer struct {
+	grpc.ServerStream
+}
+
+func (x *milvusServiceStreamInsertServer) Send(m *StreamInsertResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *milvusServiceStreamInsertServer) Recv() (*InsertRequest, error) {
+	m := new(InsertRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MilvusService_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MilvusServiceServer).SearchStream(m, &milvusServiceSearchStreamServer{stream})
+}
+
+type MilvusService_SearchStreamServer interface {
+	Send(*SearchResults) error
+	grpc.ServerStream
+}
+
+type milvusServiceSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *milvusServiceSearchStreamServer) Send(m *SearchResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MilvusService_QueryIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIteratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).QueryIterator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/QueryIterator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).QueryIterator(ctx, req.(*QueryIteratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SearchIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIteratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SearchIterator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SearchIterator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SearchIterator(ctx, req.(*SearchIteratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_WarmupCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmupCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).WarmupCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/WarmupCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).WarmupCollection(ctx, req.(*WarmupCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropApiKey(ctx, req.(*DropApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetChannelTimeTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelTimeTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetChannelTimeTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetChannelTimeTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetChannelTimeTicks(ctx, req.(*GetChannelTimeTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_UndropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).UndropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/UndropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).UndropCollection(ctx, req.(*UndropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDroppedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDroppedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, req.(*ListDroppedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DescribePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribePartition(ctx, req.(*DescribePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -5445,8 +8643,80 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _MilvusService_GetMetrics_Handler,
 		},
+		{
+			MethodName: "CheckHealth",
+			Handler:    _MilvusService_CheckHealth_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _MilvusService_AlterCollection_Handler,
+		},
+		{
+			MethodName: "GetCollectionRuntimeStats",
+			Handler:    _MilvusService_GetCollectionRuntimeStats_Handler,
+		},
+		{
+			MethodName: "QueryIterator",
+			Handler:    _MilvusService_QueryIterator_Handler,
+		},
+		{
+			MethodName: "SearchIterator",
+			Handler:    _MilvusService_SearchIterator_Handler,
+		},
+		{
+			MethodName: "WarmupCollection",
+			Handler:    _MilvusService_WarmupCollection_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _MilvusService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _MilvusService_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "DropApiKey",
+			Handler:    _MilvusService_DropApiKey_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _MilvusService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "GetChannelTimeTicks",
+			Handler:    _MilvusService_GetChannelTimeTicks_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _MilvusService_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "UndropCollection",
+			Handler:    _MilvusService_UndropCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _MilvusService_ListDroppedCollections_Handler,
+		},
+		{
+			MethodName: "DescribePartition",
+			Handler:    _MilvusService_DescribePartition_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamInsert",
+			Handler:       _MilvusService_StreamInsert_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SearchStream",
+			Handler:       _MilvusService_SearchStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
 }
 
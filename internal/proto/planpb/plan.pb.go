@@ -61,6 +61,43 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type ArithOpType int32
+
+const (
+	ArithOpType_Unknown ArithOpType = 0
+	ArithOpType_Add     ArithOpType = 1
+	ArithOpType_Sub     ArithOpType = 2
+	ArithOpType_Mul     ArithOpType = 3
+	ArithOpType_Div     ArithOpType = 4
+	ArithOpType_Mod     ArithOpType = 5
+)
+
+var ArithOpType_name = map[int32]string{
+	0: "Unknown",
+	1: "Add",
+	2: "Sub",
+	3: "Mul",
+	4: "Div",
+	5: "Mod",
+}
+
+var ArithOpType_value = map[string]int32{
+	"Unknown": 0,
+	"Add":     1,
+	"Sub":     2,
+	"Mul":     3,
+	"Div":     4,
+	"Mod":     5,
+}
+
+func (x ArithOpType) String() string {
+	return proto.EnumName(ArithOpType_name, int32(x))
+}
+
+func (ArithOpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -213,6 +250,8 @@ type QueryInfo struct {
 	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
 	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
 	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	// unix time in milliseconds after which segcore abandons the search, 0 means no deadline
+	Deadline             int64    `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -264,6 +303,13 @@ func (m *QueryInfo) GetSearchParams() string {
 	return ""
 }
 
+func (m *QueryInfo) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
 type ColumnInfo struct {
 	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
@@ -657,6 +703,77 @@ func (m *BinaryExpr) GetRight() *Expr {
 	return nil
 }
 
+type BinaryArithOpEvalRangeExpr struct {
+	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	ArithOp              ArithOpType   `protobuf:"varint,2,opt,name=arith_op,json=arithOp,proto3,enum=milvus.proto.plan.ArithOpType" json:"arith_op,omitempty"`
+	RightOperand         *GenericValue `protobuf:"bytes,3,opt,name=right_operand,json=rightOperand,proto3" json:"right_operand,omitempty"`
+	Op                   OpType        `protobuf:"varint,4,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	Value                *GenericValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRangeExpr{} }
+func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
+func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
+func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Unmarshal(m, b)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Marshal(b, m, deterministic)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.Merge(m, src)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Size() int {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Size(m)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryArithOpEvalRangeExpr proto.InternalMessageInfo
+
+func (m *BinaryArithOpEvalRangeExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetArithOp() ArithOpType {
+	if m != nil {
+		return m.ArithOp
+	}
+	return ArithOpType_Unknown
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetRightOperand() *GenericValue {
+	if m != nil {
+		return m.RightOperand
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetValue() *GenericValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type Expr struct {
 	// Types that are valid to be assigned to Expr:
 	//	*Expr_TermExpr
@@ -665,6 +782,7 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_BinaryArithOpEvalRangeExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -675,7 +793,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -724,6 +842,10 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_BinaryArithOpEvalRangeExpr struct {
+	BinaryArithOpEvalRangeExpr *BinaryArithOpEvalRangeExpr `protobuf:"bytes,7,opt,name=binary_arith_op_eval_range_expr,json=binaryArithOpEvalRangeExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -736,6 +858,8 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_BinaryArithOpEvalRangeExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -785,6 +909,13 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetBinaryArithOpEvalRangeExpr() *BinaryArithOpEvalRangeExpr {
+	if x, ok := m.GetExpr().(*Expr_BinaryArithOpEvalRangeExpr); ok {
+		return x.BinaryArithOpEvalRangeExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -794,6 +925,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_BinaryArithOpEvalRangeExpr)(nil),
 	}
 }
 
@@ -812,7 +944,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -874,6 +1006,7 @@ type PlanNode struct {
 	//	*PlanNode_Predicates
 	Node                 isPlanNode_Node `protobuf_oneof:"node"`
 	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	UdfPredicates        []*UDFExpr      `protobuf:"bytes,4,rep,name=udf_predicates,json=udfPredicates,proto3" json:"udf_predicates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -883,7 +1016,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -948,6 +1081,13 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetUdfPredicates() []*UDFExpr {
+	if m != nil {
+		return m.UdfPredicates
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -956,8 +1096,103 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+type UDFArg struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Values               []*GenericValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UDFArg) Reset()         { *m = UDFArg{} }
+func (m *UDFArg) String() string { return proto.CompactTextString(m) }
+func (*UDFArg) ProtoMessage()    {}
+func (*UDFArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
+}
+
+func (m *UDFArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UDFArg.Unmarshal(m, b)
+}
+func (m *UDFArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UDFArg.Marshal(b, m, deterministic)
+}
+func (m *UDFArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UDFArg.Merge(m, src)
+}
+func (m *UDFArg) XXX_Size() int {
+	return xxx_messageInfo_UDFArg.Size(m)
+}
+func (m *UDFArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UDFArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UDFArg proto.InternalMessageInfo
+
+func (m *UDFArg) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *UDFArg) GetValues() []*GenericValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type UDFExpr struct {
+	FunctionName         string    `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	Args                 []*UDFArg `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UDFExpr) Reset()         { *m = UDFExpr{} }
+func (m *UDFExpr) String() string { return proto.CompactTextString(m) }
+func (*UDFExpr) ProtoMessage()    {}
+func (*UDFExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
+}
+
+func (m *UDFExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UDFExpr.Unmarshal(m, b)
+}
+func (m *UDFExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UDFExpr.Marshal(b, m, deterministic)
+}
+func (m *UDFExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UDFExpr.Merge(m, src)
+}
+func (m *UDFExpr) XXX_Size() int {
+	return xxx_messageInfo_UDFExpr.Size(m)
+}
+func (m *UDFExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_UDFExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UDFExpr proto.InternalMessageInfo
+
+func (m *UDFExpr) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func (m *UDFExpr) GetArgs() []*UDFArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -969,80 +1204,98 @@ func init() {
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
+	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
 	proto.RegisterType((*VectorANNS)(nil), "milvus.proto.plan.VectorANNS")
 	proto.RegisterType((*PlanNode)(nil), "milvus.proto.plan.PlanNode")
+	proto.RegisterType((*UDFArg)(nil), "milvus.proto.plan.UDFArg")
+	proto.RegisterType((*UDFExpr)(nil), "milvus.proto.plan.UDFExpr")
 }
 
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xb6, 0x2c, 0xdb, 0x91, 0x8e, 0x5c, 0xdb, 0xd5, 0x0d, 0x29, 0xa1, 0x24, 0x88, 0x0e, 0x0d,
-	0x30, 0x4d, 0x86, 0xb6, 0xa4, 0x43, 0x19, 0x98, 0xfc, 0xb4, 0xc4, 0x1e, 0x4a, 0x12, 0x44, 0xc8,
-	0x05, 0x37, 0x9a, 0xb5, 0xb4, 0xb1, 0x77, 0xba, 0xd6, 0x2a, 0xab, 0x95, 0xa9, 0xaf, 0x79, 0x02,
-	0x5e, 0x02, 0xae, 0xe1, 0x8e, 0x77, 0xe0, 0x01, 0xb8, 0xe7, 0x45, 0x98, 0x3d, 0xab, 0xf8, 0xa7,
-	0xe3, 0xa4, 0x61, 0xa6, 0x77, 0xab, 0xb3, 0xe7, 0xef, 0xfb, 0xce, 0xcf, 0x0a, 0x20, 0xe3, 0x24,
-	0xdd, 0xca, 0xa4, 0x50, 0xc2, 0xbf, 0x3d, 0x62, 0x7c, 0x5c, 0xe4, 0xe6, 0x6b, 0x4b, 0x5f, 0xbc,
-	0xdb, 0xcc, 0xe3, 0x21, 0x1d, 0x11, 0x23, 0x0a, 0x32, 0x68, 0x1e, 0xd2, 0x94, 0x4a, 0x16, 0x9f,
-	0x11, 0x5e, 0x50, 0x7f, 0x0d, 0x9c, 0xbe, 0x10, 0x3c, 0x1a, 0x13, 0xbe, 0x6a, 0x6d, 0x58, 0x9b,
-	0x4e, 0xb7, 0x12, 0xae, 0x68, 0xc9, 0x19, 0xe1, 0xfe, 0x5d, 0x70, 0x59, 0xaa, 0x76, 0x1e, 0xe3,
-	0x6d, 0x75, 0xc3, 0xda, 0xb4, 0xbb, 0x95, 0xd0, 0x41, 0x51, 0x79, 0x7d, 0xce, 0x05, 0x51, 0x78,
-	0x6d, 0x6f, 0x58, 0x9b, 0x96, 0xbe, 0x46, 0xd1, 0x19, 0xe1, 0xfb, 0x75, 0xb0, 0xc7, 0x84, 0x07,
-	0x14, 0xdc, 0xef, 0x0b, 0x2a, 0x27, 0xbd, 0xf4, 0x5c, 0xf8, 0x3e, 0xd4, 0x94, 0xc8, 0x5e, 0x62,
-	0x28, 0x3b, 0xc4, 0xb3, 0xbf, 0x0e, 0xde, 0x88, 0x2a, 0xc9, 0xe2, 0x48, 0x4d, 0x32, 0x8a, 0x8e,
-	0xdc, 0x10, 0x8c, 0xe8, 0x74, 0x92, 0x51, 0xff, 0x43, 0xb8, 0x95, 0x53, 0x22, 0xe3, 0x61, 0x94,
-	0x11, 0x49, 0x46, 0xf9, 0x6a, 0x0d, 0x55, 0x9a, 0x46, 0x78, 0x82, 0xb2, 0xe0, 0x37, 0x0b, 0xe0,
-	0x40, 0xf0, 0x62, 0x94, 0x62, 0xa0, 0x3b, 0xe0, 0x9c, 0x33, 0xca, 0x93, 0x88, 0x25, 0x65, 0xb0,
-	0x15, 0xfc, 0xee, 0x25, 0xfe, 0x53, 0x70, 0x13, 0xa2, 0x88, 0x89, 0xa6, 0x51, 0xb5, 0x1e, 0xde,
-	0xdd, 0x5a, 0xe0, 0xad, 0x64, 0xec, 0x19, 0x51, 0x44, 0x27, 0x10, 0x3a, 0x49, 0x79, 0xf2, 0xef,
-	0x41, 0x8b, 0xe5, 0x51, 0x26, 0xd9, 0x88, 0xc8, 0x49, 0xf4, 0x92, 0x4e, 0x30, 0x5d, 0x27, 0x6c,
-	0xb2, 0xfc, 0xc4, 0x08, 0xbf, 0xa5, 0x13, 0x7f, 0x0d, 0x5c, 0x96, 0x47, 0xa4, 0x50, 0xa2, 0xf7,
-	0x0c, 0x93, 0x75, 0x42, 0x87, 0xe5, 0x7b, 0xf8, 0x1d, 0xfc, 0x69, 0x41, 0xeb, 0xc7, 0x94, 0xc8,
-	0x49, 0x48, 0xd2, 0x01, 0x7d, 0xfe, 0x2a, 0x93, 0xfe, 0xd7, 0xe0, 0xc5, 0x98, 0x7a, 0xc4, 0xd2,
-	0x73, 0x81, 0xf9, 0x7a, 0xaf, 0xe7, 0x84, 0x45, 0x9e, 0x01, 0x0c, 0x21, 0x9e, 0x81, 0xfd, 0x18,
-	0xaa, 0x22, 0x2b, 0xa1, 0xdc, 0x59, 0x62, 0x76, 0x9c, 0x21, 0x8c, 0xaa, 0xc8, 0xfc, 0xcf, 0xa1,
-	0x3e, 0xd6, 0x85, 0xc7, 0xbc, 0xbd, 0x87, 0xeb, 0x4b, 0xb4, 0xe7, 0xfb, 0x23, 0x34, 0xda, 0xc1,
-	0xef, 0x55, 0x68, 0xef, 0xb3, 0xb7, 0x9b, 0xf5, 0x7d, 0x68, 0x73, 0xf1, 0x33, 0x95, 0x11, 0x4b,
-	0x63, 0x5e, 0xe4, 0x6c, 0x6c, 0xaa, 0xe1, 0x84, 0x2d, 0x14, 0xf7, 0x2e, 0xa5, 0x5a, 0xb1, 0xc8,
-	0xb2, 0x05, 0x45, 0xc3, 0x7a, 0x0b, 0xc5, 0x33, 0xc5, 0x5d, 0xf0, 0x8c, 0x47, 0x03, 0xb1, 0x76,
-	0x33, 0x88, 0x80, 0x36, 0x66, 0x1c, 0x76, 0xc1, 0x33, 0xa1, 0x8c, 0x87, 0xfa, 0x0d, 0x3d, 0xa0,
-	0x0d, 0x9e, 0x83, 0xbf, 0x2d, 0xf0, 0x0e, 0xc4, 0x28, 0x23, 0xd2, 0xb0, 0x74, 0x08, 0x1d, 0x4e,
-	0xcf, 0x55, 0xf4, 0xbf, 0xa9, 0x6a, 0x69, 0xb3, 0xb9, 0x8e, 0xee, 0xc1, 0x6d, 0xc9, 0x06, 0xc3,
-	0x45, 0x4f, 0xd5, 0x9b, 0x78, 0x6a, 0xa3, 0xdd, 0xc1, 0xeb, 0xfd, 0x62, 0xdf, 0xa0, 0x5f, 0x82,
-	0x5f, 0x2c, 0x70, 0x4e, 0xa9, 0x1c, 0xbd, 0x95, 0x8a, 0x3f, 0x81, 0x06, 0xf2, 0x9a, 0xaf, 0x56,
-	0x37, 0xec, 0x9b, 0x10, 0x5b, 0xaa, 0x07, 0xbf, 0x5a, 0xe0, 0xe2, 0xcc, 0x60, 0x1a, 0x8f, 0x31,
-	0x7d, 0x0b, 0xd3, 0xbf, 0xb7, 0xc4, 0xc5, 0x54, 0xd3, 0x9c, 0x8e, 0x33, 0xec, 0xfc, 0x07, 0x50,
-	0x8f, 0x87, 0x8c, 0x27, 0x25, 0x67, 0xef, 0x2c, 0x31, 0xd4, 0x36, 0xa1, 0xd1, 0x0a, 0xd6, 0x61,
-	0xa5, 0xb4, 0xf6, 0x3d, 0x58, 0xe9, 0xa5, 0x63, 0xc2, 0x59, 0xd2, 0xa9, 0xf8, 0x2b, 0x60, 0x1f,
-	0x09, 0xd5, 0xb1, 0x82, 0x7f, 0x2c, 0x00, 0x33, 0x12, 0x98, 0xd4, 0xce, 0x5c, 0x52, 0x1f, 0x2d,
-	0xf1, 0x3d, 0x53, 0x2d, 0x8f, 0x65, 0x5a, 0x9f, 0x42, 0x4d, 0x17, 0xfa, 0x4d, 0x59, 0xa1, 0x92,
-	0xc6, 0x80, 0xb5, 0x2c, 0xa7, 0xf7, 0x6a, 0x0c, 0xa8, 0x15, 0xec, 0x80, 0x73, 0x19, 0x6b, 0x11,
-	0x44, 0x0b, 0xe0, 0x85, 0x18, 0xb0, 0x98, 0xf0, 0xbd, 0x34, 0xe9, 0x58, 0xfe, 0x2d, 0x70, 0xcb,
-	0xef, 0x63, 0xd9, 0xa9, 0x06, 0x7f, 0xd8, 0x50, 0x43, 0x50, 0x4f, 0xc1, 0x55, 0x54, 0x8e, 0x22,
-	0xfa, 0x2a, 0x93, 0x65, 0xb9, 0xd7, 0x96, 0xc4, 0xbc, 0x6c, 0x10, 0xbd, 0xfe, 0xd5, 0x65, 0xb3,
-	0x7c, 0x05, 0x50, 0xe8, 0xd8, 0xc6, 0xd8, 0xc0, 0x7b, 0xef, 0xba, 0x6a, 0x75, 0x2b, 0xa1, 0x5b,
-	0x4c, 0xf9, 0xdc, 0x05, 0xaf, 0xcf, 0x66, 0xf6, 0xf6, 0x95, 0xbd, 0x36, 0x23, 0xb6, 0x5b, 0x09,
-	0xa1, 0x3f, 0xab, 0xc8, 0x01, 0x34, 0x63, 0x33, 0x88, 0xc6, 0x85, 0x59, 0x07, 0xef, 0x2f, 0x6d,
-	0xd7, 0xe9, 0xbc, 0x76, 0x2b, 0xa1, 0x17, 0xcf, 0x8d, 0xef, 0x77, 0xd0, 0x31, 0x28, 0xa4, 0xde,
-	0x7b, 0xc6, 0x91, 0xd9, 0x0a, 0x1f, 0x5c, 0x85, 0x65, 0xba, 0x21, 0xbb, 0x95, 0xb0, 0x55, 0x2c,
-	0xee, 0xcc, 0x13, 0xb8, 0x5d, 0xa2, 0x9a, 0xf3, 0xd7, 0x40, 0x7f, 0xc1, 0x95, 0xd8, 0xe6, 0x1d,
-	0xb6, 0xfb, 0x8b, 0xa2, 0xfd, 0x06, 0xd4, 0xb4, 0x93, 0xe0, 0x5f, 0x0b, 0xe0, 0x8c, 0xc6, 0x4a,
-	0xc8, 0xbd, 0xa3, 0xa3, 0x1f, 0xca, 0x27, 0xc8, 0x28, 0x9b, 0x87, 0x5d, 0x3f, 0x41, 0xc6, 0xdf,
-	0xc2, 0xe3, 0x58, 0x5d, 0x7c, 0x1c, 0x9f, 0x00, 0x64, 0x92, 0x26, 0x2c, 0x26, 0x8a, 0xe6, 0x6f,
-	0x6a, 0xb3, 0x39, 0x55, 0xff, 0x4b, 0x80, 0x0b, 0xfd, 0xcc, 0x9b, 0xd5, 0x50, 0xbb, 0xb2, 0xdc,
-	0xd3, 0x7f, 0x81, 0xd0, 0xbd, 0x98, 0xfe, 0x16, 0xdc, 0x87, 0x76, 0xc6, 0x49, 0x4c, 0x87, 0x82,
-	0x27, 0x54, 0x46, 0x8a, 0x0c, 0x90, 0x64, 0x37, 0x6c, 0xcd, 0x89, 0x4f, 0xc9, 0x20, 0xf8, 0xcb,
-	0x02, 0xe7, 0x84, 0x93, 0xf4, 0x48, 0x24, 0xb8, 0xac, 0xc7, 0x88, 0x38, 0x22, 0x69, 0x9a, 0x5f,
-	0xb3, 0x8e, 0x66, 0xbc, 0xe8, 0x16, 0x31, 0x36, 0x7b, 0x69, 0x9a, 0xfb, 0x5f, 0x2c, 0xa0, 0xbd,
-	0x7e, 0x04, 0xb5, 0xe9, 0x1c, 0xde, 0x4d, 0xe8, 0x88, 0x42, 0x65, 0x85, 0x8a, 0x2e, 0xa9, 0xd4,
-	0x74, 0xd9, 0x9b, 0x76, 0xd8, 0x32, 0xf2, 0x6f, 0x0c, 0xa3, 0xb9, 0xae, 0x50, 0x2a, 0x12, 0xfa,
-	0x49, 0x0a, 0x0d, 0xb3, 0x58, 0x17, 0x67, 0xb1, 0x0d, 0xde, 0xa1, 0xa4, 0x44, 0x51, 0x79, 0x3a,
-	0x24, 0x69, 0xc7, 0xf2, 0x3b, 0xd0, 0x2c, 0x05, 0xcf, 0x2f, 0x0a, 0xc2, 0x3b, 0x55, 0xbf, 0x09,
-	0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6f, 0xe3, 0xb0, 0xd2, 0x3c, 0x37, 0x97, 0x35, 0xdf, 0x85, 0xba,
-	0x39, 0xd6, 0xb5, 0xde, 0x91, 0x50, 0xe6, 0xab, 0xb1, 0xff, 0xe8, 0xa7, 0xcf, 0x06, 0x4c, 0x0d,
-	0x8b, 0xfe, 0x56, 0x2c, 0x46, 0xdb, 0x06, 0xd4, 0x03, 0x26, 0xca, 0xd3, 0x36, 0x4b, 0x15, 0x95,
-	0x29, 0xe1, 0xdb, 0x88, 0x73, 0x5b, 0xe3, 0xcc, 0xfa, 0xfd, 0x06, 0x7e, 0x3d, 0xfa, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0x6e, 0xb0, 0x90, 0xcb, 0x55, 0x0a, 0x00, 0x00,
+	// 1298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x16, 0x45, 0xc9, 0xa2, 0x46, 0xb2, 0xcc, 0xec, 0xcd, 0x71, 0x9c, 0x93, 0xd8, 0x87, 0x27,
+	0x38, 0xc7, 0x4d, 0x61, 0x1b, 0x4d, 0xd2, 0x04, 0x49, 0xd1, 0xa2, 0xb2, 0x9d, 0xd8, 0x46, 0x13,
+	0xdb, 0x65, 0x1c, 0x5f, 0x14, 0x28, 0x88, 0x15, 0xb9, 0x92, 0x16, 0xa1, 0x76, 0x99, 0x25, 0xa9,
+	0x44, 0x97, 0x45, 0x9f, 0xa0, 0x2f, 0xd1, 0xde, 0xf7, 0x05, 0xfa, 0x02, 0x7d, 0x80, 0xde, 0xf7,
+	0x35, 0x7a, 0x51, 0xec, 0x2c, 0xf5, 0x17, 0xc8, 0x8e, 0x03, 0x04, 0xe8, 0xdd, 0xcc, 0xec, 0xcc,
+	0xb7, 0xf3, 0xcd, 0xce, 0xce, 0x2e, 0x40, 0x12, 0x53, 0xb1, 0x9d, 0x28, 0x99, 0x49, 0x72, 0x6d,
+	0xc0, 0xe3, 0x61, 0x9e, 0x1a, 0x6d, 0x5b, 0x2f, 0xac, 0x35, 0xd3, 0xb0, 0xcf, 0x06, 0xd4, 0x98,
+	0xbc, 0x04, 0x9a, 0x07, 0x4c, 0x30, 0xc5, 0xc3, 0x73, 0x1a, 0xe7, 0x8c, 0xdc, 0x00, 0xa7, 0x23,
+	0x65, 0x1c, 0x0c, 0x69, 0xbc, 0x6a, 0x6d, 0x58, 0x9b, 0xce, 0x61, 0xc9, 0xaf, 0x69, 0xcb, 0x39,
+	0x8d, 0xc9, 0x4d, 0xa8, 0x73, 0x91, 0x3d, 0xb8, 0x8f, 0xab, 0xe5, 0x0d, 0x6b, 0xd3, 0x3e, 0x2c,
+	0xf9, 0x0e, 0x9a, 0x8a, 0xe5, 0x6e, 0x2c, 0x69, 0x86, 0xcb, 0xf6, 0x86, 0xb5, 0x69, 0xe9, 0x65,
+	0x34, 0x9d, 0xd3, 0x78, 0xb7, 0x0a, 0xf6, 0x90, 0xc6, 0xde, 0x0f, 0x16, 0xd4, 0xbf, 0xcd, 0x99,
+	0x1a, 0x1d, 0x89, 0xae, 0x24, 0x04, 0x2a, 0x99, 0x4c, 0x5e, 0xe1, 0x5e, 0xb6, 0x8f, 0x32, 0x59,
+	0x87, 0xc6, 0x80, 0x65, 0x8a, 0x87, 0x41, 0x36, 0x4a, 0x18, 0x22, 0xd5, 0x7d, 0x30, 0xa6, 0xb3,
+	0x51, 0xc2, 0xc8, 0x7f, 0x61, 0x39, 0x65, 0x54, 0x85, 0xfd, 0x20, 0xa1, 0x8a, 0x0e, 0xd2, 0xd5,
+	0x0a, 0xba, 0x34, 0x8d, 0xf1, 0x14, 0x6d, 0x64, 0x0d, 0x9c, 0x88, 0xd1, 0x28, 0xe6, 0x82, 0xad,
+	0x56, 0x11, 0x7d, 0xa2, 0x7b, 0x3f, 0x5b, 0x00, 0x7b, 0x32, 0xce, 0x07, 0x02, 0x93, 0xb8, 0x0e,
+	0x4e, 0x97, 0xb3, 0x38, 0x0a, 0x78, 0x54, 0x24, 0x52, 0x43, 0xfd, 0x28, 0x22, 0x8f, 0xa1, 0x1e,
+	0xd1, 0x8c, 0x9a, 0x4c, 0x34, 0xe5, 0xd6, 0xdd, 0x9b, 0xdb, 0x73, 0x45, 0x2d, 0xca, 0xb9, 0x4f,
+	0x33, 0xaa, 0x93, 0xf3, 0x9d, 0xa8, 0x90, 0xc8, 0x6d, 0x68, 0xf1, 0x34, 0x48, 0x14, 0x1f, 0x50,
+	0x35, 0x0a, 0x5e, 0xb1, 0x11, 0x52, 0x71, 0xfc, 0x26, 0x4f, 0x4f, 0x8d, 0xf1, 0x1b, 0x36, 0x22,
+	0x37, 0xa0, 0xce, 0xd3, 0x80, 0xe6, 0x99, 0x3c, 0xda, 0x47, 0x22, 0x8e, 0xef, 0xf0, 0xb4, 0x8d,
+	0xba, 0xf7, 0xab, 0x05, 0xad, 0x97, 0x82, 0xaa, 0x91, 0x4f, 0x45, 0x8f, 0x3d, 0x79, 0x9b, 0x28,
+	0xf2, 0x15, 0x34, 0x42, 0x4c, 0x3d, 0xe0, 0xa2, 0x2b, 0x31, 0xdf, 0xc6, 0xbb, 0x39, 0x61, 0x07,
+	0x4c, 0x09, 0xfa, 0x10, 0x4e, 0xc9, 0x7e, 0x02, 0x65, 0x99, 0x14, 0x54, 0xae, 0x2f, 0x08, 0x3b,
+	0x49, 0x90, 0x46, 0x59, 0x26, 0xe4, 0x73, 0xa8, 0x0e, 0x75, 0x57, 0x60, 0xde, 0x8d, 0xbb, 0xeb,
+	0x0b, 0xbc, 0x67, 0x9b, 0xc7, 0x37, 0xde, 0xde, 0x2f, 0x65, 0x58, 0xd9, 0xe5, 0x1f, 0x37, 0xeb,
+	0xff, 0xc3, 0x4a, 0x2c, 0xdf, 0x30, 0x15, 0x70, 0x11, 0xc6, 0x79, 0xca, 0x87, 0xe6, 0x34, 0x1c,
+	0xbf, 0x85, 0xe6, 0xa3, 0xb1, 0x55, 0x3b, 0xe6, 0x49, 0x32, 0xe7, 0x68, 0xaa, 0xde, 0x42, 0xf3,
+	0xd4, 0xf1, 0x6b, 0x68, 0x18, 0x44, 0x43, 0xb1, 0x72, 0x35, 0x8a, 0x80, 0x31, 0x28, 0x6b, 0x04,
+	0xb3, 0x95, 0x41, 0xa8, 0x5e, 0x11, 0x01, 0x63, 0x50, 0xf6, 0x7e, 0xb7, 0xa0, 0xb1, 0x27, 0x07,
+	0x09, 0x55, 0xa6, 0x4a, 0x07, 0xe0, 0xc6, 0xac, 0x9b, 0x05, 0x1f, 0x5c, 0xaa, 0x96, 0x0e, 0x9b,
+	0xea, 0xe4, 0x08, 0xae, 0x29, 0xde, 0xeb, 0xcf, 0x23, 0x95, 0xaf, 0x82, 0xb4, 0x82, 0x71, 0x7b,
+	0xef, 0xf6, 0x8b, 0x7d, 0x85, 0x7e, 0xf1, 0x7e, 0xb4, 0xc0, 0x39, 0x63, 0x6a, 0xf0, 0x51, 0x4e,
+	0xfc, 0x21, 0x2c, 0x61, 0x5d, 0xd3, 0xd5, 0xf2, 0x86, 0x7d, 0x95, 0xc2, 0x16, 0xee, 0xde, 0x4f,
+	0x16, 0xd4, 0xf1, 0xce, 0x60, 0x1a, 0xf7, 0x31, 0x7d, 0x0b, 0xd3, 0xbf, 0xbd, 0x00, 0x62, 0xe2,
+	0x69, 0xa4, 0x93, 0x04, 0x3b, 0x7f, 0x0b, 0xaa, 0x61, 0x9f, 0xc7, 0x51, 0x51, 0xb3, 0x7f, 0x2d,
+	0x08, 0xd4, 0x31, 0xbe, 0xf1, 0xf2, 0xd6, 0xa1, 0x56, 0x44, 0x93, 0x06, 0xd4, 0x8e, 0xc4, 0x90,
+	0xc6, 0x3c, 0x72, 0x4b, 0xa4, 0x06, 0xf6, 0xb1, 0xcc, 0x5c, 0xcb, 0xfb, 0xc3, 0x02, 0x30, 0x57,
+	0x02, 0x93, 0x7a, 0x30, 0x93, 0xd4, 0xff, 0x16, 0x60, 0x4f, 0x5d, 0x0b, 0xb1, 0x48, 0xeb, 0x53,
+	0xa8, 0xe8, 0x83, 0x7e, 0x5f, 0x56, 0xe8, 0xa4, 0x39, 0xe0, 0x59, 0xae, 0xda, 0x97, 0x7b, 0x1b,
+	0x2f, 0xef, 0x01, 0x38, 0xbb, 0x7c, 0x11, 0x89, 0x16, 0xc0, 0x33, 0xd9, 0xe3, 0x21, 0x8d, 0xdb,
+	0x22, 0x72, 0x2d, 0xb2, 0x0c, 0xf5, 0x42, 0x3f, 0x51, 0x6e, 0xd9, 0xfb, 0xad, 0x0c, 0x6b, 0x26,
+	0xb0, 0xad, 0x78, 0xd6, 0x3f, 0x49, 0x9e, 0x0c, 0x69, 0xfc, 0xf1, 0x2e, 0xfe, 0x23, 0x70, 0xa8,
+	0xc6, 0x0d, 0x26, 0x43, 0xeb, 0xd6, 0x82, 0xe0, 0x62, 0x6b, 0xec, 0xc4, 0x1a, 0x35, 0x0a, 0xd9,
+	0x87, 0x65, 0x73, 0x09, 0x64, 0xc2, 0x14, 0x15, 0xd1, 0x55, 0xc7, 0x58, 0x13, 0xa3, 0x4e, 0x4c,
+	0x50, 0xd1, 0xff, 0x95, 0x0f, 0x9a, 0x97, 0xd5, 0x0f, 0x9b, 0x97, 0x15, 0xa8, 0x60, 0xad, 0x1e,
+	0x43, 0x3d, 0x63, 0x6a, 0x10, 0xb0, 0xb7, 0x89, 0x2a, 0x2a, 0x75, 0x63, 0x01, 0xc6, 0xf8, 0x8a,
+	0xe9, 0xd7, 0x35, 0x2b, 0x64, 0xf2, 0x25, 0x40, 0xae, 0x0f, 0xc1, 0x04, 0x9b, 0x06, 0xf9, 0xf7,
+	0x65, 0xfd, 0x7e, 0x58, 0xf2, 0xeb, 0xf9, 0x58, 0xd1, 0xb3, 0xac, 0xc3, 0xa7, 0xf1, 0xf6, 0x85,
+	0xc7, 0x34, 0x6d, 0xcd, 0xc3, 0x92, 0x0f, 0x9d, 0x89, 0x46, 0xf6, 0xa0, 0x19, 0x9a, 0x51, 0x66,
+	0x20, 0xcc, 0x40, 0xbd, 0xb5, 0xf0, 0xa4, 0x27, 0x13, 0xef, 0xb0, 0xe4, 0x37, 0xc2, 0xa9, 0x4a,
+	0x9e, 0x83, 0x6b, 0x58, 0x28, 0xdd, 0x40, 0x06, 0xc8, 0x14, 0xf3, 0x3f, 0x17, 0x71, 0x99, 0xb4,
+	0xda, 0x61, 0xc9, 0x6f, 0xe5, 0x73, 0x16, 0x72, 0x0a, 0xd7, 0x3a, 0xfc, 0x5d, 0xbc, 0x25, 0xc4,
+	0xf3, 0x2e, 0xe4, 0x36, 0x0b, 0xb8, 0xd2, 0x99, 0x37, 0x91, 0x0c, 0xd6, 0x0b, 0xc4, 0x71, 0x57,
+	0x06, 0x6c, 0x48, 0xe3, 0x59, 0xfc, 0x1a, 0xe2, 0x6f, 0x5d, 0x88, 0xbf, 0xe8, 0x9a, 0x1c, 0x96,
+	0xfc, 0xb5, 0xce, 0x85, 0xab, 0xbb, 0x4b, 0x50, 0xd1, 0xd0, 0xde, 0x9f, 0x16, 0xc0, 0x39, 0x0b,
+	0x33, 0xa9, 0xda, 0xc7, 0xc7, 0x2f, 0x8a, 0xaf, 0x83, 0x89, 0x5b, 0xb5, 0xc6, 0x5f, 0x07, 0xb3,
+	0xcb, 0xdc, 0xa7, 0xa6, 0x3c, 0xff, 0xa9, 0x79, 0x08, 0x90, 0x28, 0x16, 0xf1, 0x90, 0x66, 0x2c,
+	0x7d, 0xdf, 0x78, 0x98, 0x71, 0x25, 0x5f, 0x00, 0xbc, 0xd6, 0x5f, 0x37, 0x73, 0x97, 0x2b, 0x17,
+	0x36, 0xd9, 0xe4, 0x7f, 0xe7, 0xd7, 0x5f, 0x8f, 0x45, 0xfd, 0x32, 0x27, 0x31, 0x0d, 0x59, 0x5f,
+	0xc6, 0x11, 0x53, 0x41, 0x46, 0x7b, 0x78, 0xb4, 0x75, 0xbf, 0x35, 0x63, 0x3e, 0xa3, 0x3d, 0xef,
+	0x2f, 0x0b, 0x9c, 0xd3, 0x98, 0x8a, 0x63, 0x19, 0xe1, 0x23, 0x3b, 0x44, 0xc6, 0x01, 0x15, 0x22,
+	0xbd, 0x64, 0x7e, 0x4c, 0xeb, 0xa2, 0x1b, 0xd3, 0xc4, 0xb4, 0x85, 0x48, 0xc9, 0xa3, 0x39, 0xb6,
+	0x97, 0x8f, 0x4e, 0x1d, 0x3a, 0xc3, 0x77, 0x13, 0x5c, 0x99, 0x67, 0x49, 0x9e, 0x05, 0xe3, 0x52,
+	0xea, 0x72, 0xd9, 0x9b, 0xb6, 0xdf, 0x32, 0xf6, 0xa7, 0xa6, 0xa2, 0x29, 0x69, 0x43, 0x2b, 0x8f,
+	0xba, 0xc1, 0xcc, 0x46, 0x15, 0x7c, 0xb5, 0xd6, 0x16, 0xb5, 0xed, 0xfe, 0x53, 0xac, 0xec, 0x72,
+	0x1e, 0x75, 0x4f, 0x27, 0x01, 0xfa, 0x90, 0x85, 0x8c, 0x98, 0xfe, 0x20, 0x2f, 0xbd, 0xdc, 0x7f,
+	0xda, 0x56, 0xbd, 0x7f, 0xee, 0x0d, 0xfd, 0x1e, 0x6a, 0x45, 0x96, 0xfa, 0xb3, 0xdd, 0xcd, 0x45,
+	0x98, 0x71, 0x29, 0x02, 0x41, 0x07, 0x0c, 0xb3, 0xa8, 0xfb, 0xcd, 0xb1, 0xf1, 0x98, 0x0e, 0x18,
+	0xd9, 0x82, 0x0a, 0x55, 0xbd, 0xf1, 0x36, 0xd7, 0x17, 0x93, 0x6e, 0xab, 0x9e, 0x8f, 0x6e, 0x77,
+	0x04, 0x2c, 0x99, 0xb1, 0x39, 0xff, 0xd2, 0xac, 0x40, 0xe3, 0x40, 0x31, 0x9a, 0x31, 0x75, 0xd6,
+	0xa7, 0xc2, 0xb5, 0x88, 0x0b, 0xcd, 0xc2, 0xf0, 0xe4, 0x75, 0x4e, 0x63, 0xb7, 0x4c, 0x9a, 0xe0,
+	0x3c, 0x63, 0x69, 0x8a, 0xeb, 0x36, 0x3e, 0x45, 0x2c, 0x4d, 0xcd, 0x62, 0x85, 0xd4, 0xa1, 0x6a,
+	0xc4, 0xaa, 0xf6, 0x3b, 0x96, 0x99, 0xd1, 0x96, 0xee, 0x1c, 0x40, 0x63, 0xe6, 0x85, 0xd0, 0x9b,
+	0xbe, 0x14, 0xaf, 0x84, 0x7c, 0x23, 0xcc, 0x1b, 0xdd, 0x8e, 0xf4, 0xbb, 0x56, 0x03, 0xfb, 0x45,
+	0xde, 0x71, 0xcb, 0x5a, 0x78, 0x9e, 0xc7, 0xae, 0xad, 0x85, 0x7d, 0x3e, 0x74, 0x2b, 0x68, 0x91,
+	0x91, 0x5b, 0xdd, 0xbd, 0xf7, 0xdd, 0x67, 0x3d, 0x9e, 0xf5, 0xf3, 0xce, 0x76, 0x28, 0x07, 0x3b,
+	0x86, 0xe5, 0x16, 0x97, 0x85, 0xb4, 0xc3, 0x45, 0xc6, 0x94, 0xa0, 0xf1, 0x0e, 0x12, 0xdf, 0xd1,
+	0xc4, 0x93, 0x4e, 0x67, 0x09, 0xb5, 0x7b, 0x7f, 0x0f, 0x00, 0x43, 0x16, 0xa7, 0xb8, 0x99, 0x0d,
+	0x00, 0x00,
 }
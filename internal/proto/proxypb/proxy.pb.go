@@ -31,6 +31,8 @@ type InvalidateCollMetaCacheRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelsChanged      bool              `protobuf:"varint,5,opt,name=channels_changed,json=channelsChanged,proto3" json:"channels_changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -82,6 +84,20 @@ func (m *InvalidateCollMetaCacheRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *InvalidateCollMetaCacheRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *InvalidateCollMetaCacheRequest) GetChannelsChanged() bool {
+	if m != nil {
+		return m.ChannelsChanged
+	}
+	return false
+}
+
 type ReleaseDQLMessageStreamRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -137,41 +153,95 @@ func (m *ReleaseDQLMessageStreamRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type InvalidateSegmentDistributionRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 invalidates the segment distributions of all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateSegmentDistributionRequest) Reset()         { *m = InvalidateSegmentDistributionRequest{} }
+func (m *InvalidateSegmentDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateSegmentDistributionRequest) ProtoMessage()    {}
+func (*InvalidateSegmentDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
+func (m *InvalidateSegmentDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateSegmentDistributionRequest.Unmarshal(m, b)
+}
+func (m *InvalidateSegmentDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateSegmentDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidateSegmentDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateSegmentDistributionRequest.Merge(m, src)
+}
+func (m *InvalidateSegmentDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateSegmentDistributionRequest.Size(m)
+}
+func (m *InvalidateSegmentDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateSegmentDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateSegmentDistributionRequest proto.InternalMessageInfo
+
+func (m *InvalidateSegmentDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InvalidateSegmentDistributionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*ReleaseDQLMessageStreamRequest)(nil), "milvus.proto.proxy.ReleaseDQLMessageStreamRequest")
+	proto.RegisterType((*InvalidateSegmentDistributionRequest)(nil), "milvus.proto.proxy.InvalidateSegmentDistributionRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xd1, 0x0a, 0xd3, 0x30,
-	0x14, 0x5d, 0xdd, 0x9c, 0x98, 0x95, 0x09, 0x41, 0xd8, 0xa8, 0x3a, 0x46, 0x05, 0x1d, 0x82, 0xeb,
-	0xa8, 0x7e, 0xc1, 0x5a, 0x18, 0x03, 0x27, 0xda, 0xbd, 0xf9, 0x22, 0x69, 0x7b, 0xe9, 0x02, 0x69,
-	0xd2, 0x35, 0xe9, 0xd0, 0x5f, 0xf0, 0xd9, 0x1f, 0xf1, 0x0f, 0xa5, 0x69, 0xb7, 0xd9, 0x6d, 0x9d,
-	0xe8, 0x5b, 0xcf, 0xcd, 0xb9, 0x9c, 0x73, 0x6e, 0x0f, 0x1a, 0x64, 0xb9, 0xf8, 0xf6, 0x7d, 0x9e,
-	0xe5, 0x42, 0x09, 0x8c, 0x53, 0xca, 0x0e, 0x85, 0xac, 0xd0, 0x5c, 0xbf, 0x58, 0x66, 0x24, 0xd2,
-	0x54, 0xf0, 0x6a, 0x66, 0x0d, 0x29, 0x57, 0x90, 0x73, 0xc2, 0x6a, 0x6c, 0xfe, 0xb9, 0x61, 0xff,
-	0x34, 0xd0, 0x64, 0xcd, 0x0f, 0x84, 0xd1, 0x98, 0x28, 0xf0, 0x04, 0x63, 0x1b, 0x50, 0xc4, 0x23,
-	0xd1, 0x0e, 0x02, 0xd8, 0x17, 0x20, 0x15, 0x5e, 0xa0, 0x5e, 0x48, 0x24, 0x8c, 0x8d, 0xa9, 0x31,
-	0x1b, 0xb8, 0xcf, 0xe7, 0x0d, 0xc5, 0x5a, 0x6a, 0x23, 0x93, 0x25, 0x91, 0x10, 0x68, 0x26, 0x1e,
-	0xa1, 0x47, 0x71, 0xf8, 0x95, 0x93, 0x14, 0xc6, 0x0f, 0xa6, 0xc6, 0xec, 0x71, 0xd0, 0x8f, 0xc3,
-	0x8f, 0x24, 0x05, 0xfc, 0x1a, 0x3d, 0x89, 0x04, 0x63, 0x10, 0x29, 0x2a, 0x78, 0x45, 0xe8, 0x6a,
-	0xc2, 0xf0, 0x3c, 0x2e, 0x89, 0xf6, 0x0f, 0x03, 0x4d, 0x02, 0x60, 0x40, 0x24, 0xf8, 0x9f, 0x3f,
-	0x6c, 0x40, 0x4a, 0x92, 0xc0, 0x56, 0xe5, 0x40, 0xd2, 0xff, 0xb7, 0x85, 0x51, 0x2f, 0x0e, 0xd7,
-	0xbe, 0xf6, 0xd4, 0x0d, 0xf4, 0x37, 0xb6, 0x91, 0x79, 0x96, 0x5e, 0xfb, 0xda, 0x4e, 0x37, 0x68,
-	0xcc, 0xdc, 0x5f, 0x3d, 0xf4, 0xf0, 0x53, 0x79, 0x59, 0x9c, 0x21, 0xbc, 0x02, 0xe5, 0x89, 0x34,
-	0x13, 0x1c, 0xb8, 0xda, 0x2a, 0xa2, 0x40, 0xe2, 0x45, 0x53, 0xfb, 0x74, 0xef, 0x6b, 0x6a, 0xed,
-	0xdd, 0x7a, 0xd5, 0xb2, 0x71, 0x41, 0xb7, 0x3b, 0x78, 0x8f, 0x9e, 0xae, 0x40, 0x43, 0x2a, 0x15,
-	0x8d, 0xa4, 0xb7, 0x23, 0x9c, 0x03, 0xc3, 0x6e, 0xbb, 0xe6, 0x15, 0xf9, 0xa8, 0xfa, 0xb2, 0xb9,
-	0x53, 0x83, 0xad, 0xca, 0x29, 0x4f, 0x02, 0x90, 0x99, 0xe0, 0x12, 0xec, 0x0e, 0xce, 0xd1, 0x8b,
-	0x66, 0x23, 0xaa, 0x43, 0x9c, 0x7a, 0x71, 0xa9, 0x5d, 0xd5, 0xf1, 0x7e, 0x89, 0xac, 0x67, 0x37,
-	0xff, 0x4f, 0x69, 0xb5, 0x28, 0x63, 0x12, 0x64, 0xae, 0x40, 0xf9, 0xf1, 0x31, 0xde, 0x9b, 0xf6,
-	0x78, 0x27, 0xd2, 0x3f, 0xc6, 0x62, 0x68, 0xd4, 0xd2, 0xa8, 0xdb, 0x81, 0xee, 0xd7, 0xef, 0x2f,
-	0x81, 0x96, 0xef, 0xbf, 0xb8, 0x09, 0x55, 0xbb, 0x22, 0x2c, 0x5f, 0x9c, 0x8a, 0xfa, 0x96, 0x8a,
-	0xfa, 0xcb, 0x39, 0x06, 0x72, 0xf4, 0xb6, 0xa3, 0x15, 0xb3, 0x30, 0xec, 0x6b, 0xf8, 0xee, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0xe1, 0x7c, 0xd9, 0xe3, 0x03, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x5d, 0xd6, 0x76, 0x80, 0x57, 0x6d, 0xc8, 0x42, 0x5a, 0x55, 0xd8, 0x54, 0x05, 0x04, 0x05,
+	0x89, 0x76, 0x2a, 0x3c, 0xf0, 0xbc, 0x46, 0xaa, 0x2a, 0x51, 0x04, 0xe9, 0x1b, 0x2f, 0x93, 0x93,
+	0x5c, 0xa5, 0x96, 0x1c, 0x3b, 0x8b, 0x6f, 0xa6, 0x21, 0xf1, 0x05, 0xfc, 0x0a, 0x9f, 0xc5, 0x8f,
+	0xa0, 0x38, 0x69, 0x4b, 0x9a, 0x25, 0x13, 0x7d, 0xf3, 0x3d, 0x3e, 0x57, 0xe7, 0x9e, 0xeb, 0x63,
+	0x72, 0x1c, 0x27, 0xea, 0xee, 0xc7, 0x28, 0x4e, 0x14, 0x2a, 0x4a, 0x23, 0x2e, 0x6e, 0x53, 0x9d,
+	0x57, 0x23, 0x73, 0xd3, 0xef, 0xfa, 0x2a, 0x8a, 0x94, 0xcc, 0xb1, 0xfe, 0x09, 0x97, 0x08, 0x89,
+	0x64, 0xa2, 0xa8, 0xbb, 0xff, 0x76, 0xd8, 0x7f, 0x2c, 0x72, 0x31, 0x97, 0xb7, 0x4c, 0xf0, 0x80,
+	0x21, 0x4c, 0x95, 0x10, 0x0b, 0x40, 0x36, 0x65, 0xfe, 0x0a, 0x5c, 0xb8, 0x49, 0x41, 0x23, 0xbd,
+	0x24, 0x6d, 0x8f, 0x69, 0xe8, 0x59, 0x03, 0x6b, 0x78, 0x3c, 0x79, 0x31, 0x2a, 0x29, 0x16, 0x52,
+	0x0b, 0x1d, 0x5e, 0x31, 0x0d, 0xae, 0x61, 0xd2, 0x33, 0xf2, 0x28, 0xf0, 0xae, 0x25, 0x8b, 0xa0,
+	0x77, 0x38, 0xb0, 0x86, 0x4f, 0xdc, 0xa3, 0xc0, 0xfb, 0xc2, 0x22, 0xa0, 0x6f, 0xc8, 0xa9, 0xaf,
+	0x84, 0x00, 0x1f, 0xb9, 0x92, 0x39, 0xa1, 0x65, 0x08, 0x27, 0x5b, 0xd8, 0x10, 0x6d, 0xd2, 0xdd,
+	0x22, 0x73, 0xa7, 0xd7, 0x1e, 0x58, 0xc3, 0x96, 0x5b, 0xc2, 0xe8, 0x5b, 0xf2, 0xd4, 0x5f, 0x31,
+	0x29, 0x41, 0xe8, 0xeb, 0xec, 0x10, 0x42, 0xd0, 0xeb, 0x0c, 0xac, 0xe1, 0x63, 0xf7, 0x74, 0x8d,
+	0x4f, 0x73, 0xd8, 0xfe, 0x65, 0x91, 0x0b, 0x17, 0x04, 0x30, 0x0d, 0xce, 0xb7, 0xcf, 0x0b, 0xd0,
+	0x9a, 0x85, 0xb0, 0xc4, 0x04, 0x58, 0xb4, 0xbf, 0x4b, 0x4a, 0xda, 0x81, 0x37, 0x77, 0x8c, 0xc5,
+	0x96, 0x6b, 0xce, 0x95, 0xb9, 0x5b, 0xd5, 0xb9, 0xed, 0x9f, 0xe4, 0xd5, 0x76, 0xe3, 0x4b, 0x08,
+	0x23, 0x90, 0xe8, 0x70, 0x8d, 0x09, 0xf7, 0xd2, 0x8c, 0xb2, 0xff, 0x44, 0xbb, 0xea, 0x87, 0x55,
+	0xf5, 0xc9, 0xef, 0x0e, 0xe9, 0x7c, 0xcd, 0x62, 0x42, 0x63, 0x42, 0x67, 0x80, 0x53, 0x15, 0xc5,
+	0x4a, 0x82, 0xc4, 0x25, 0x32, 0x04, 0x4d, 0x2f, 0xcb, 0x3a, 0x9b, 0xf0, 0x54, 0xa9, 0xc5, 0x9c,
+	0xfd, 0xd7, 0x35, 0x1d, 0x3b, 0x74, 0xfb, 0x80, 0xde, 0x90, 0x67, 0x33, 0x30, 0x25, 0xd7, 0xc8,
+	0x7d, 0xf3, 0x3c, 0x12, 0x04, 0x9d, 0xd4, 0x6b, 0x56, 0xc8, 0x6b, 0xd5, 0x97, 0xe5, 0x9e, 0xa2,
+	0x58, 0x62, 0xc2, 0x65, 0xe8, 0x82, 0x8e, 0x95, 0xd4, 0x60, 0x1f, 0xd0, 0x84, 0x9c, 0x97, 0xe3,
+	0x9d, 0x2f, 0x62, 0x13, 0xf2, 0x5d, 0xed, 0xfc, 0x6f, 0x35, 0xff, 0x88, 0xfe, 0xf3, 0x7b, 0xdf,
+	0x22, 0x1b, 0x35, 0xcd, 0x6c, 0x32, 0xd2, 0x9d, 0x01, 0x3a, 0xc1, 0xda, 0xde, 0xbb, 0x7a, 0x7b,
+	0x1b, 0xd2, 0x7f, 0xda, 0x12, 0xe4, 0xac, 0x26, 0xcf, 0xf7, 0x1b, 0x6a, 0x0e, 0xff, 0x43, 0x86,
+	0xee, 0xc8, 0x79, 0x63, 0x62, 0xe9, 0xa7, 0xe6, 0x25, 0xd6, 0x87, 0xfc, 0x01, 0xe5, 0xab, 0x8f,
+	0xdf, 0x27, 0x21, 0xc7, 0x55, 0xea, 0x65, 0x37, 0xe3, 0x9c, 0xfa, 0x9e, 0xab, 0xe2, 0x34, 0x5e,
+	0xaf, 0x72, 0x6c, 0xba, 0xc7, 0x46, 0x37, 0xf6, 0xbc, 0x23, 0x53, 0x7e, 0xf8, 0x3b, 0x00, 0x56,
+	0x4f, 0x9f, 0xbe, 0x2a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +261,7 @@ type ProxyClient interface {
 	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidateSegmentDistribution(ctx context.Context, in *InvalidateSegmentDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type proxyClient struct {
@@ -246,6 +317,15 @@ func (c *proxyClient) ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQ
 	return out, nil
 }
 
+func (c *proxyClient) InvalidateSegmentDistribution(ctx context.Context, in *InvalidateSegmentDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateSegmentDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 type ProxyServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -253,6 +333,7 @@ type ProxyServer interface {
 	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(context.Context, *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+	InvalidateSegmentDistribution(context.Context, *InvalidateSegmentDistributionRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedProxyServer can be embedded to have forward compatible implementations.
@@ -275,6 +356,10 @@ func (*UnimplementedProxyServer) ReleaseDQLMessageStream(ctx context.Context, re
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDQLMessageStream not implemented")
 }
 
+func (*UnimplementedProxyServer) InvalidateSegmentDistribution(ctx context.Context, req *InvalidateSegmentDistributionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateSegmentDistribution not implemented")
+}
+
 func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
 	s.RegisterService(&_Proxy_serviceDesc, srv)
 }
@@ -369,6 +454,24 @@ func _Proxy_ReleaseDQLMessageStream_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_InvalidateSegmentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateSegmentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateSegmentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateSegmentDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateSegmentDistribution(ctx, req.(*InvalidateSegmentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.proxy.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -393,6 +496,10 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseDQLMessageStream",
 			Handler:    _Proxy_ReleaseDQLMessageStream_Handler,
 		},
+		{
+			MethodName: "InvalidateSegmentDistribution",
+			Handler:    _Proxy_InvalidateSegmentDistribution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
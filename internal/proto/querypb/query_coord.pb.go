@@ -100,7 +100,7 @@ func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
 }
 
-//----------------etcd-----------------
+// ----------------etcd-----------------
 type SegmentState int32
 
 const (
@@ -160,7 +160,7 @@ func (LoadType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
-//--------------------query coordinator proto------------------
+// --------------------query coordinator proto------------------
 type ShowCollectionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -220,6 +220,7 @@ type ShowCollectionsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	CollectionIDs        []int64          `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
 	InMemoryPercentages  []int64          `protobuf:"varint,3,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	WarmupPercentages    []int64          `protobuf:"varint,4,rep,packed,name=warmup_percentages,json=warmupPercentages,proto3" json:"warmup_percentages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -271,6 +272,13 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
+func (m *ShowCollectionsResponse) GetWarmupPercentages() []int64 {
+	if m != nil {
+		return m.WarmupPercentages
+	}
+	return nil
+}
+
 type ShowPartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -1129,7 +1137,7 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
-//-----------------query node proto----------------
+// -----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1359,18 +1367,20 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
-//used for handoff task
+// used for handoff task
 type SegmentLoadInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID            int64                  `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                  `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64                  `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID                 int64                  `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime            int64                  `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths          []*datapb.FieldBinlog  `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                  `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	ClusteringInfo       *datapb.ClusteringInfo `protobuf:"bytes,8,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	Deltalogs            []*datapb.DeltaLogInfo `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
@@ -1447,6 +1457,20 @@ func (m *SegmentLoadInfo) GetNumOfRows() int64 {
 	return 0
 }
 
+func (m *SegmentLoadInfo) GetClusteringInfo() *datapb.ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetDeltalogs() []*datapb.DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1991,6 +2015,165 @@ func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+type GetSegmentDistributionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSegmentDistributionRequest) Reset()         { *m = GetSegmentDistributionRequest{} }
+func (m *GetSegmentDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentDistributionRequest) ProtoMessage()    {}
+func (*GetSegmentDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *GetSegmentDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentDistributionRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentDistributionRequest.Merge(m, src)
+}
+func (m *GetSegmentDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentDistributionRequest.Size(m)
+}
+func (m *GetSegmentDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentDistributionRequest proto.InternalMessageInfo
+
+func (m *GetSegmentDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentDistributionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetSegmentDistributionResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the query nodes watching the dm channels of the collection
+	ShardLeaders []*DmChannelInfo `protobuf:"bytes,2,rep,name=shard_leaders,json=shardLeaders,proto3" json:"shard_leaders,omitempty"`
+	// the sealed segments loaded on the query nodes
+	SegmentInfos         []*SegmentInfo `protobuf:"bytes,3,rep,name=segment_infos,json=segmentInfos,proto3" json:"segment_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetSegmentDistributionResponse) Reset()         { *m = GetSegmentDistributionResponse{} }
+func (m *GetSegmentDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentDistributionResponse) ProtoMessage()    {}
+func (*GetSegmentDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+}
+
+func (m *GetSegmentDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentDistributionResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentDistributionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentDistributionResponse.Merge(m, src)
+}
+func (m *GetSegmentDistributionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentDistributionResponse.Size(m)
+}
+func (m *GetSegmentDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentDistributionResponse proto.InternalMessageInfo
+
+func (m *GetSegmentDistributionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentDistributionResponse) GetShardLeaders() []*DmChannelInfo {
+	if m != nil {
+		return m.ShardLeaders
+	}
+	return nil
+}
+
+func (m *GetSegmentDistributionResponse) GetSegmentInfos() []*SegmentInfo {
+	if m != nil {
+		return m.SegmentInfos
+	}
+	return nil
+}
+
+type RefreshIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	FieldID              int64             `protobuf:"varint,3,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RefreshIndexRequest) Reset()         { *m = RefreshIndexRequest{} }
+func (m *RefreshIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshIndexRequest) ProtoMessage()    {}
+func (*RefreshIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+}
+
+func (m *RefreshIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshIndexRequest.Unmarshal(m, b)
+}
+func (m *RefreshIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshIndexRequest.Merge(m, src)
+}
+func (m *RefreshIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshIndexRequest.Size(m)
+}
+func (m *RefreshIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshIndexRequest proto.InternalMessageInfo
+
+func (m *RefreshIndexRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RefreshIndexRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
 	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
@@ -2024,136 +2207,151 @@ func init() {
 	proto.RegisterType((*HandoffSegments)(nil), "milvus.proto.query.HandoffSegments")
 	proto.RegisterType((*LoadBalanceSegmentInfo)(nil), "milvus.proto.query.LoadBalanceSegmentInfo")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*GetSegmentDistributionRequest)(nil), "milvus.proto.query.GetSegmentDistributionRequest")
+	proto.RegisterType((*GetSegmentDistributionResponse)(nil), "milvus.proto.query.GetSegmentDistributionResponse")
+	proto.RegisterType((*RefreshIndexRequest)(nil), "milvus.proto.query.RefreshIndexRequest")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0xdc, 0x48,
-	0x15, 0xb7, 0x66, 0xc6, 0xf3, 0xf1, 0xe6, 0x4b, 0xe9, 0xc4, 0x66, 0x32, 0x6c, 0xb2, 0x46, 0xd9,
-	0x6c, 0xb2, 0x5e, 0xd6, 0xde, 0x75, 0x96, 0x2a, 0x72, 0xe0, 0xb0, 0xf1, 0x6c, 0xcc, 0xc0, 0xc6,
-	0x31, 0xb2, 0x59, 0x8a, 0x54, 0xaa, 0x84, 0x46, 0x6a, 0x8f, 0x55, 0x2b, 0xa9, 0x27, 0x6a, 0x4d,
-	0x1c, 0xe7, 0xc0, 0x89, 0x1b, 0x67, 0x4e, 0x50, 0x54, 0x51, 0xc5, 0x47, 0x71, 0xe0, 0x1f, 0xe0,
-	0xb4, 0x17, 0xee, 0xfc, 0x03, 0x50, 0x45, 0xc1, 0x9d, 0x7f, 0x81, 0xea, 0x56, 0x4b, 0xa3, 0x8f,
-	0x1e, 0x7b, 0x62, 0x63, 0x92, 0xda, 0xe2, 0x36, 0xfd, 0xfa, 0xe9, 0xbd, 0xd7, 0xef, 0xe3, 0xd7,
-	0xaf, 0xdf, 0xc0, 0x95, 0x67, 0x53, 0x1c, 0x9c, 0x18, 0x16, 0x21, 0x81, 0xbd, 0x31, 0x09, 0x48,
-	0x48, 0x10, 0xf2, 0x1c, 0xf7, 0xf9, 0x94, 0x46, 0xab, 0x0d, 0xbe, 0xdf, 0x6f, 0x59, 0xc4, 0xf3,
-	0x88, 0x1f, 0xd1, 0xfa, 0xad, 0x34, 0x47, 0xbf, 0xe3, 0xf8, 0x21, 0x0e, 0x7c, 0xd3, 0x8d, 0x77,
-	0xa9, 0x75, 0x84, 0x3d, 0x53, 0xac, 0x54, 0xdb, 0x0c, 0xcd, 0xb4, 0x7c, 0xed, 0x67, 0x0a, 0xac,
-	0xee, 0x1f, 0x91, 0xe3, 0x6d, 0xe2, 0xba, 0xd8, 0x0a, 0x1d, 0xe2, 0x53, 0x1d, 0x3f, 0x9b, 0x62,
-	0x1a, 0xa2, 0x0f, 0xa1, 0x32, 0x32, 0x29, 0xee, 0x29, 0x6b, 0xca, 0xdd, 0xe6, 0xd6, 0x5b, 0x1b,
-	0x19, 0x4b, 0x84, 0x09, 0x8f, 0xe8, 0xf8, 0x81, 0x49, 0xb1, 0xce, 0x39, 0x11, 0x82, 0x8a, 0x3d,
-	0x1a, 0x0e, 0x7a, 0xa5, 0x35, 0xe5, 0x6e, 0x59, 0xe7, 0xbf, 0xd1, 0x3b, 0xd0, 0xb6, 0x12, 0xd9,
-	0xc3, 0x01, 0xed, 0x95, 0xd7, 0xca, 0x77, 0xcb, 0x7a, 0x96, 0xa8, 0xfd, 0x41, 0x81, 0xaf, 0x15,
-	0xcc, 0xa0, 0x13, 0xe2, 0x53, 0x8c, 0xee, 0x41, 0x95, 0x86, 0x66, 0x38, 0xa5, 0xc2, 0x92, 0xaf,
-	0x4b, 0x2d, 0xd9, 0xe7, 0x2c, 0xba, 0x60, 0x2d, 0xaa, 0x2d, 0x49, 0xd4, 0xa2, 0x8f, 0xe0, 0x9a,
-	0xe3, 0x3f, 0xc2, 0x1e, 0x09, 0x4e, 0x8c, 0x09, 0x0e, 0x2c, 0xec, 0x87, 0xe6, 0x18, 0xc7, 0x36,
-	0x5e, 0x8d, 0xf7, 0xf6, 0x66, 0x5b, 0xda, 0xef, 0x14, 0x58, 0x61, 0x96, 0xee, 0x99, 0x41, 0xe8,
-	0x5c, 0x82, 0xbf, 0x34, 0x68, 0xa5, 0x6d, 0xec, 0x95, 0xf9, 0x5e, 0x86, 0xc6, 0x78, 0x26, 0xb1,
-	0x7a, 0x76, 0xb6, 0x0a, 0x37, 0x37, 0x43, 0xd3, 0x7e, 0x2b, 0x02, 0x9b, 0xb6, 0xf3, 0x22, 0x0e,
-	0xcd, 0xeb, 0x2c, 0x15, 0x75, 0x9e, 0xc7, 0x9d, 0x5f, 0x2a, 0xb0, 0xf2, 0x19, 0x31, 0xed, 0x59,
-	0xe0, 0xff, 0xf7, 0xee, 0xfc, 0x0e, 0x54, 0xa3, 0x2a, 0xe9, 0x55, 0xb8, 0xae, 0xdb, 0x59, 0x5d,
-	0xa2, 0x82, 0x66, 0x16, 0xee, 0x73, 0x82, 0x2e, 0x3e, 0xd2, 0x7e, 0xa5, 0x40, 0x4f, 0xc7, 0x2e,
-	0x36, 0x29, 0x7e, 0x9d, 0xa7, 0x58, 0x85, 0xaa, 0x4f, 0x6c, 0x3c, 0x1c, 0xf0, 0x53, 0x94, 0x75,
-	0xb1, 0xd2, 0xfe, 0x25, 0x3c, 0xfc, 0x86, 0x27, 0x6c, 0x2a, 0x0a, 0xcb, 0xe7, 0x89, 0xc2, 0x97,
-	0xb3, 0x28, 0xbc, 0xe9, 0x27, 0x9d, 0x45, 0x6a, 0x39, 0x13, 0xa9, 0x1f, 0xc3, 0xf5, 0xed, 0x00,
-	0x9b, 0x21, 0xfe, 0x01, 0x83, 0xf9, 0xed, 0x23, 0xd3, 0xf7, 0xb1, 0x1b, 0x1f, 0x21, 0xaf, 0x5c,
-	0x91, 0x28, 0xef, 0x41, 0x6d, 0x12, 0x90, 0x17, 0x27, 0x89, 0xdd, 0xf1, 0x52, 0xfb, 0x8d, 0x02,
-	0x7d, 0x99, 0xec, 0x8b, 0x20, 0xc2, 0x1d, 0xe8, 0x06, 0x91, 0x71, 0x86, 0x15, 0xc9, 0xe3, 0x5a,
-	0x1b, 0x7a, 0x47, 0x90, 0x85, 0x16, 0x74, 0x1b, 0x3a, 0x01, 0xa6, 0x53, 0x77, 0xc6, 0x57, 0xe6,
-	0x7c, 0xed, 0x88, 0x2a, 0xd8, 0xb4, 0x3f, 0x2a, 0x70, 0x7d, 0x07, 0x87, 0x49, 0xf4, 0x98, 0x3a,
-	0xfc, 0x86, 0xa2, 0xeb, 0xaf, 0x15, 0xe8, 0xe6, 0x0c, 0x45, 0x6b, 0xd0, 0x4c, 0xf1, 0x88, 0x00,
-	0xa5, 0x49, 0xe8, 0xdb, 0xb0, 0xcc, 0x7c, 0x87, 0xb9, 0x49, 0x9d, 0x2d, 0x6d, 0xa3, 0x78, 0xb9,
-	0x6f, 0x64, 0xa5, 0xea, 0xd1, 0x07, 0x68, 0x13, 0xae, 0x4a, 0x90, 0x55, 0x98, 0x8f, 0x8a, 0xc0,
-	0xaa, 0xfd, 0x49, 0x81, 0xbe, 0xcc, 0x99, 0x17, 0x09, 0xf8, 0x13, 0x58, 0x4d, 0x4e, 0x63, 0xd8,
-	0x98, 0x5a, 0x81, 0x33, 0xe1, 0x65, 0xc6, 0x2f, 0x83, 0xe6, 0xd6, 0xad, 0xb3, 0xcf, 0x43, 0xf5,
-	0x95, 0x44, 0xc4, 0x20, 0x25, 0x41, 0x73, 0x60, 0x65, 0x07, 0x87, 0xfb, 0x78, 0xec, 0x61, 0x3f,
-	0x1c, 0xfa, 0x87, 0xe4, 0xfc, 0x71, 0xbf, 0x09, 0x40, 0x85, 0x9c, 0xe4, 0x9e, 0x4a, 0x51, 0xb4,
-	0xbf, 0x95, 0xa0, 0x99, 0x52, 0x84, 0xde, 0x82, 0x46, 0xb2, 0x2b, 0xa2, 0x36, 0x23, 0x14, 0x32,
-	0xa6, 0x24, 0xc9, 0x98, 0x5c, 0xe4, 0xcb, 0xc5, 0xc8, 0xcf, 0x01, 0x67, 0x74, 0x1d, 0xea, 0x1e,
-	0xf6, 0x0c, 0xea, 0xbc, 0xc4, 0x02, 0x0c, 0x6a, 0x1e, 0xf6, 0xf6, 0x9d, 0x97, 0x98, 0x6d, 0xf9,
-	0x53, 0xcf, 0x08, 0xc8, 0x31, 0xed, 0x55, 0xa3, 0x2d, 0x7f, 0xea, 0xe9, 0xe4, 0x98, 0xa2, 0x1b,
-	0x00, 0x8e, 0x6f, 0xe3, 0x17, 0x86, 0x6f, 0x7a, 0xb8, 0x57, 0xe3, 0xc5, 0xd4, 0xe0, 0x94, 0x5d,
-	0xd3, 0xc3, 0x0c, 0x06, 0xf8, 0x62, 0x38, 0xe8, 0xd5, 0xa3, 0x0f, 0xc5, 0x92, 0x1d, 0x55, 0x94,
-	0xe0, 0x70, 0xd0, 0x6b, 0x44, 0xdf, 0x25, 0x04, 0xf4, 0x29, 0xb4, 0xc5, 0xb9, 0x8d, 0x28, 0x4d,
-	0x81, 0xa7, 0xe9, 0x9a, 0x2c, 0xac, 0xc2, 0x81, 0x51, 0x92, 0xb6, 0x68, 0x6a, 0xc5, 0x5b, 0xca,
-	0x7c, 0x2c, 0x2f, 0x92, 0x76, 0xdf, 0x82, 0x65, 0xc7, 0x3f, 0x24, 0x71, 0x96, 0xbd, 0x7d, 0x8a,
-	0x39, 0x5c, 0x59, 0xc4, 0xad, 0xfd, 0x5d, 0x81, 0xd5, 0x4f, 0x6c, 0x5b, 0x86, 0xa5, 0xaf, 0x9e,
-	0x53, 0xb3, 0xf8, 0x95, 0x32, 0xf1, 0x5b, 0x04, 0x4f, 0xde, 0x87, 0x2b, 0x39, 0x9c, 0x14, 0x69,
-	0xd0, 0xd0, 0xd5, 0x2c, 0x52, 0x0e, 0x07, 0xe8, 0x3d, 0x50, 0xb3, 0x58, 0x29, 0x6e, 0x89, 0x86,
-	0xde, 0xcd, 0xa0, 0xe5, 0x70, 0xa0, 0xfd, 0x43, 0x81, 0xeb, 0x3a, 0xf6, 0xc8, 0x73, 0xfc, 0xd5,
-	0x3d, 0xe3, 0x3f, 0x4b, 0xb0, 0xfa, 0x23, 0x33, 0xb4, 0x8e, 0x06, 0x9e, 0x20, 0xd2, 0xd7, 0x73,
-	0xc0, 0x5c, 0x89, 0x57, 0x8a, 0x25, 0x9e, 0xa4, 0xe9, 0xb2, 0x2c, 0x4d, 0xd9, 0xc3, 0x6b, 0xe3,
-	0xf3, 0xf8, 0xbc, 0xb3, 0x34, 0x4d, 0xb5, 0x3d, 0xd5, 0x73, 0xb4, 0x3d, 0x68, 0x1b, 0xda, 0xf8,
-	0x85, 0xe5, 0x4e, 0x6d, 0x6c, 0x44, 0xda, 0x6b, 0x5c, 0xfb, 0x4d, 0x89, 0xf6, 0x74, 0x8d, 0xb4,
-	0xc4, 0x47, 0x43, 0x5e, 0x2a, 0x3f, 0x2f, 0x41, 0x57, 0xec, 0xb2, 0x4e, 0x71, 0x01, 0x54, 0xcc,
-	0xb9, 0xa3, 0x54, 0x74, 0xc7, 0x22, 0x4e, 0x8d, 0x6f, 0xe8, 0x4a, 0xea, 0x86, 0xbe, 0x01, 0x70,
-	0xe8, 0x4e, 0xe9, 0x91, 0x11, 0x3a, 0x5e, 0x8c, 0x89, 0x0d, 0x4e, 0x39, 0x70, 0x3c, 0x8c, 0x3e,
-	0x81, 0xd6, 0xc8, 0xf1, 0x5d, 0x32, 0x36, 0x26, 0x66, 0x78, 0xc4, 0x90, 0x71, 0xde, 0x71, 0x1f,
-	0x3a, 0xd8, 0xb5, 0x1f, 0x70, 0x5e, 0xbd, 0x19, 0x7d, 0xb3, 0xc7, 0x3e, 0x41, 0x37, 0xa1, 0xc9,
-	0x80, 0x95, 0x1c, 0x46, 0xd8, 0x5a, 0x8b, 0x54, 0xf8, 0x53, 0xef, 0xf1, 0x21, 0x43, 0x57, 0xed,
-	0xf7, 0x25, 0xb8, 0xca, 0xdc, 0x20, 0x3c, 0x72, 0x09, 0x09, 0x77, 0x3f, 0x4e, 0x95, 0xf2, 0xfc,
-	0x7b, 0x33, 0x17, 0x8f, 0x62, 0xba, 0x9c, 0xe7, 0xad, 0x82, 0xbe, 0x0f, 0x1d, 0x97, 0x98, 0xb6,
-	0x61, 0x11, 0xdf, 0xe6, 0x91, 0xe2, 0x1e, 0xee, 0x6c, 0xbd, 0x23, 0x33, 0xe1, 0x20, 0x70, 0xc6,
-	0x63, 0x1c, 0x6c, 0xc7, 0xbc, 0x7a, 0xdb, 0xe5, 0x2f, 0x35, 0xb1, 0xe4, 0x08, 0x2b, 0x5a, 0xee,
-	0xcb, 0xf3, 0x55, 0x9c, 0x23, 0xe5, 0x53, 0xba, 0xb8, 0xca, 0x02, 0x5d, 0xdc, 0xb2, 0xa4, 0x11,
-	0xcf, 0x76, 0x0a, 0xd5, 0x42, 0xa7, 0x70, 0x00, 0xed, 0x04, 0x77, 0x78, 0x51, 0xdc, 0x82, 0x76,
-	0x64, 0x96, 0xc1, 0x3c, 0x81, 0xed, 0xb8, 0x0b, 0x8f, 0x88, 0x9f, 0x71, 0x1a, 0x93, 0x9a, 0xe0,
-	0x5a, 0x74, 0x69, 0x35, 0xf4, 0x14, 0x45, 0xfb, 0x85, 0x02, 0x6a, 0x1a, 0xb1, 0xb9, 0xe4, 0x45,
-	0xda, 0xfb, 0x3b, 0xd0, 0x15, 0x03, 0xa2, 0x04, 0x36, 0x45, 0xc3, 0xfd, 0x2c, 0x2d, 0x6e, 0x80,
-	0x3e, 0x86, 0xd5, 0x88, 0xb1, 0x00, 0xb3, 0x51, 0xe3, 0x7d, 0x8d, 0xef, 0xea, 0x39, 0xac, 0xfd,
-	0x6b, 0x19, 0x3a, 0xb3, 0xc4, 0x59, 0xd8, 0xaa, 0x45, 0x06, 0x03, 0xbb, 0xa0, 0xce, 0x3a, 0x47,
-	0xde, 0x5b, 0x9c, 0x9a, 0xfb, 0xf9, 0x9e, 0xb1, 0x3b, 0xc9, 0xb5, 0xda, 0x0f, 0xa1, 0x2d, 0xce,
-	0x24, 0x50, 0xaf, 0xc2, 0x85, 0x7d, 0x43, 0x26, 0x2c, 0x13, 0x41, 0xbd, 0x95, 0x82, 0x60, 0x8a,
-	0xee, 0x43, 0x83, 0x97, 0x43, 0x78, 0x32, 0xc1, 0xa2, 0x12, 0xde, 0x92, 0xc9, 0x60, 0x91, 0x3d,
-	0x38, 0x99, 0x60, 0xbd, 0xee, 0x8a, 0x5f, 0x17, 0xc5, 0xed, 0x7b, 0xb0, 0x12, 0x44, 0xa5, 0x63,
-	0x1b, 0x19, 0xf7, 0xd5, 0xb8, 0xfb, 0xae, 0xc5, 0x9b, 0x7b, 0x69, 0x37, 0xce, 0x79, 0x05, 0xd4,
-	0xe7, 0xbe, 0x02, 0x7e, 0x0a, 0xdd, 0xef, 0x9a, 0xbe, 0x4d, 0x0e, 0x0f, 0xe3, 0x02, 0x3d, 0x47,
-	0x65, 0xde, 0xcf, 0xf6, 0x5f, 0xaf, 0x80, 0x56, 0xda, 0x2f, 0x4b, 0xb0, 0xca, 0x68, 0x0f, 0x4c,
-	0xd7, 0xf4, 0x2d, 0xbc, 0x78, 0xd7, 0xfd, 0xdf, 0xb9, 0x5f, 0x6e, 0x41, 0x9b, 0x92, 0x69, 0x60,
-	0x61, 0x23, 0xd3, 0x7c, 0xb7, 0x22, 0xe2, 0x6e, 0x04, 0x30, 0x37, 0x00, 0x6c, 0x1a, 0x1a, 0x99,
-	0x17, 0x79, 0xc3, 0xa6, 0xa1, 0xd8, 0x7e, 0x1b, 0x9a, 0x42, 0x86, 0x4d, 0x7c, 0xcc, 0x83, 0x5d,
-	0xd7, 0x21, 0x22, 0x0d, 0x88, 0xcf, 0xfb, 0x74, 0xf6, 0x3d, 0xdf, 0xad, 0xf1, 0xdd, 0x9a, 0x4d,
-	0x43, 0xbe, 0x75, 0x03, 0xe0, 0xb9, 0xe9, 0x3a, 0x36, 0x4f, 0x52, 0x1e, 0xa6, 0xba, 0xde, 0xe0,
-	0x14, 0xe6, 0x02, 0xed, 0xcf, 0x0a, 0xa0, 0x94, 0x77, 0xce, 0x8f, 0x9d, 0xb7, 0xa1, 0x93, 0x39,
-	0x67, 0x32, 0xed, 0x4c, 0x1f, 0x94, 0x32, 0xf0, 0x1f, 0x45, 0xaa, 0x8c, 0x00, 0x9b, 0x94, 0xf8,
-	0xdc, 0x69, 0x0b, 0x83, 0xff, 0x28, 0x36, 0x93, 0x7d, 0xba, 0xfe, 0x12, 0x3a, 0xd9, 0x32, 0x45,
-	0x2d, 0xa8, 0xef, 0x92, 0xf0, 0xd3, 0x17, 0x0e, 0x0d, 0xd5, 0x25, 0xd4, 0x01, 0xd8, 0x25, 0xe1,
-	0x5e, 0x80, 0x29, 0xf6, 0x43, 0x55, 0x41, 0x00, 0xd5, 0xc7, 0xfe, 0xc0, 0xa1, 0x5f, 0xa8, 0x25,
-	0x74, 0x55, 0x3c, 0x9e, 0x4d, 0x77, 0x28, 0x72, 0x56, 0x2d, 0xb3, 0xcf, 0x93, 0x55, 0x05, 0xa9,
-	0xd0, 0x4a, 0x58, 0x76, 0xf6, 0x7e, 0xa8, 0x2e, 0xa3, 0x06, 0x2c, 0x47, 0x3f, 0xab, 0xeb, 0x8f,
-	0x41, 0xcd, 0x9b, 0x87, 0x9a, 0x50, 0x3b, 0x8a, 0x52, 0x5d, 0x5d, 0x42, 0x5d, 0x68, 0xba, 0x33,
-	0xc7, 0xaa, 0x0a, 0x23, 0x8c, 0x83, 0x89, 0x25, 0x5c, 0xac, 0x96, 0x98, 0x36, 0xe6, 0xab, 0x01,
-	0x39, 0xf6, 0xd5, 0xf2, 0xfa, 0xf7, 0xa0, 0x95, 0x7e, 0xd0, 0xa0, 0x3a, 0x54, 0x76, 0x89, 0x8f,
-	0xd5, 0x25, 0x26, 0x76, 0x27, 0x20, 0xc7, 0x8e, 0x3f, 0x8e, 0xce, 0xf0, 0x30, 0x20, 0x2f, 0xb1,
-	0xaf, 0x96, 0xd8, 0x06, 0xc5, 0xa6, 0xcb, 0x36, 0xca, 0x6c, 0x83, 0x2d, 0xb0, 0xad, 0x56, 0xd6,
-	0x3f, 0x82, 0x7a, 0x0c, 0x17, 0xe8, 0x0a, 0xb4, 0x33, 0xa3, 0x37, 0x75, 0x09, 0xa1, 0xe8, 0x06,
-	0x9e, 0x01, 0x83, 0xaa, 0x6c, 0xfd, 0x1b, 0x00, 0xa2, 0x1b, 0x81, 0x90, 0xc0, 0x46, 0x13, 0x40,
-	0x3b, 0x38, 0xdc, 0x26, 0xde, 0x84, 0xf8, 0xb1, 0x49, 0x14, 0x7d, 0x98, 0x8d, 0x52, 0x32, 0xe7,
-	0x2f, 0xb2, 0x8a, 0x53, 0xf6, 0xdf, 0x9d, 0xf3, 0x45, 0x8e, 0x5d, 0x5b, 0x42, 0x1e, 0xd7, 0xc8,
-	0x1a, 0xac, 0x03, 0xc7, 0xfa, 0x22, 0x9e, 0xdb, 0x9c, 0xa2, 0x31, 0xc7, 0x1a, 0x6b, 0xcc, 0x61,
-	0x83, 0x58, 0xec, 0x87, 0x81, 0xe3, 0x8f, 0xe3, 0x47, 0xa0, 0xb6, 0x84, 0x9e, 0xc1, 0x35, 0xf6,
-	0x40, 0x0c, 0xcd, 0xd0, 0xa1, 0xa1, 0x63, 0xd1, 0x58, 0xe1, 0xd6, 0x7c, 0x85, 0x05, 0xe6, 0x57,
-	0x54, 0xe9, 0x42, 0x37, 0xf7, 0xff, 0x02, 0x5a, 0x97, 0x02, 0x99, 0xf4, 0xbf, 0x90, 0xfe, 0xfb,
-	0x0b, 0xf1, 0x26, 0xda, 0x1c, 0xe8, 0x64, 0x67, 0xef, 0xe8, 0xbd, 0x79, 0x02, 0x0a, 0xc3, 0xca,
-	0xfe, 0xfa, 0x22, 0xac, 0x89, 0xaa, 0x27, 0xd0, 0xc9, 0x4e, 0x77, 0xe5, 0xaa, 0xa4, 0x13, 0xe0,
-	0xfe, 0x69, 0xef, 0x6f, 0x6d, 0x09, 0xfd, 0x04, 0xae, 0x14, 0x46, 0xaa, 0xe8, 0x9b, 0x32, 0xf1,
-	0xf3, 0x26, 0xaf, 0x67, 0x69, 0x10, 0xd6, 0xcf, 0xbc, 0x38, 0xdf, 0xfa, 0xc2, 0x6c, 0x7d, 0x71,
-	0xeb, 0x53, 0xe2, 0x4f, 0xb3, 0xfe, 0x95, 0x35, 0x4c, 0x01, 0x15, 0x87, 0xaa, 0xe8, 0x03, 0x99,
-	0x8a, 0xb9, 0x83, 0xdd, 0xfe, 0xc6, 0xa2, 0xec, 0x49, 0xc8, 0xa7, 0xbc, 0x5a, 0xf3, 0xe3, 0x47,
-	0xa9, 0xda, 0xb9, 0xf3, 0x54, 0xb9, 0xda, 0xf9, 0x13, 0xc3, 0x28, 0xa9, 0xb3, 0x63, 0x1d, 0x79,
-	0xac, 0xa4, 0x63, 0x3c, 0x79, 0x52, 0xcb, 0xa7, 0x44, 0xda, 0x12, 0x32, 0x00, 0x76, 0x70, 0xf8,
-	0x08, 0x87, 0x81, 0x63, 0x51, 0xf4, 0xae, 0xb4, 0xc4, 0x67, 0x0c, 0xb1, 0x8e, 0x3b, 0x67, 0xf2,
-	0xc5, 0x0a, 0xb6, 0xfe, 0xd2, 0x80, 0x06, 0xf7, 0x2e, 0xbb, 0x1b, 0xff, 0x0f, 0xb8, 0x97, 0x00,
-	0xb8, 0x4f, 0xa1, 0x9b, 0x9b, 0xbe, 0xc9, 0x01, 0x57, 0x3e, 0xa2, 0x3b, 0xab, 0xf2, 0x46, 0x80,
-	0x8a, 0xa3, 0x2f, 0x79, 0x09, 0xcc, 0x1d, 0x91, 0x9d, 0xa5, 0xe3, 0x29, 0x74, 0x73, 0xa3, 0x27,
-	0xf9, 0x09, 0xe4, 0xf3, 0xa9, 0xb3, 0xa4, 0x7f, 0x0e, 0xad, 0xf4, 0x90, 0x01, 0xdd, 0x99, 0x87,
-	0x7b, 0xb9, 0xa7, 0xf5, 0xeb, 0x47, 0xbd, 0xcb, 0xbf, 0x15, 0x9e, 0x42, 0x37, 0x37, 0x57, 0x90,
-	0x7b, 0x5e, 0x3e, 0x7c, 0x38, 0x4b, 0xfa, 0x57, 0x08, 0xc7, 0x1e, 0x7c, 0xfc, 0x64, 0x6b, 0xec,
-	0x84, 0x47, 0xd3, 0x11, 0x3b, 0xe5, 0x66, 0xc4, 0xf9, 0x81, 0x43, 0xc4, 0xaf, 0xcd, 0xb8, 0xa0,
-	0x37, 0xb9, 0xa4, 0x4d, 0x6e, 0xed, 0x64, 0x34, 0xaa, 0xf2, 0xe5, 0xbd, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x70, 0xb6, 0x53, 0xcf, 0x70, 0x22, 0x00, 0x00,
+	// 2166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0x90, 0xd4, 0x83, 0xc5, 0xd7, 0xb8, 0x65, 0x29, 0x34, 0x63, 0x7b, 0x95, 0xf1, 0x7a,
+	0xed, 0xd5, 0xc6, 0xd2, 0x9a, 0xde, 0x00, 0xf1, 0x61, 0x0f, 0x6b, 0x71, 0xad, 0x70, 0x63, 0xcb,
+	0xca, 0x48, 0x71, 0x10, 0xc3, 0x00, 0x33, 0xe4, 0xb4, 0xc8, 0xc1, 0xce, 0x4c, 0xd3, 0xd3, 0x43,
+	0xcb, 0xf2, 0x21, 0x40, 0x80, 0xe4, 0x27, 0x24, 0x97, 0x3c, 0x80, 0x00, 0x49, 0x80, 0x1c, 0xf2,
+	0x07, 0x72, 0xda, 0x7b, 0x2e, 0x39, 0xe5, 0x96, 0x00, 0xc1, 0xe6, 0x87, 0x04, 0xfd, 0x98, 0xf7,
+	0x50, 0xa2, 0xa4, 0x28, 0x36, 0x16, 0x7b, 0x9b, 0xae, 0xae, 0xee, 0xaa, 0xae, 0xaa, 0xfe, 0xaa,
+	0xba, 0x06, 0x2e, 0xbd, 0x98, 0x60, 0xef, 0xa8, 0x37, 0x20, 0xc4, 0x33, 0x37, 0xc6, 0x1e, 0xf1,
+	0x09, 0x42, 0x8e, 0x65, 0xbf, 0x9c, 0x50, 0x31, 0xda, 0xe0, 0xf3, 0xad, 0xea, 0x80, 0x38, 0x0e,
+	0x71, 0x05, 0xad, 0x55, 0x8d, 0x73, 0xb4, 0xea, 0x96, 0xeb, 0x63, 0xcf, 0x35, 0xec, 0x60, 0x96,
+	0x0e, 0x46, 0xd8, 0x31, 0xe4, 0x48, 0x35, 0x0d, 0xdf, 0x88, 0xef, 0xaf, 0xfd, 0x5c, 0x81, 0xd5,
+	0xbd, 0x11, 0x39, 0xdc, 0x22, 0xb6, 0x8d, 0x07, 0xbe, 0x45, 0x5c, 0xaa, 0xe3, 0x17, 0x13, 0x4c,
+	0x7d, 0xf4, 0x21, 0x94, 0xfa, 0x06, 0xc5, 0x4d, 0x65, 0x4d, 0xb9, 0x5d, 0x69, 0x5f, 0xdd, 0x48,
+	0x68, 0x22, 0x55, 0x78, 0x4c, 0x87, 0x0f, 0x0c, 0x8a, 0x75, 0xce, 0x89, 0x10, 0x94, 0xcc, 0x7e,
+	0xb7, 0xd3, 0x2c, 0xac, 0x29, 0xb7, 0x8b, 0x3a, 0xff, 0x46, 0xef, 0x42, 0x6d, 0x10, 0xee, 0xdd,
+	0xed, 0xd0, 0x66, 0x71, 0xad, 0x78, 0xbb, 0xa8, 0x27, 0x89, 0xda, 0x3f, 0x14, 0xf8, 0x46, 0x46,
+	0x0d, 0x3a, 0x26, 0x2e, 0xc5, 0xe8, 0x1e, 0x2c, 0x50, 0xdf, 0xf0, 0x27, 0x54, 0x6a, 0xf2, 0xcd,
+	0x5c, 0x4d, 0xf6, 0x38, 0x8b, 0x2e, 0x59, 0xb3, 0x62, 0x0b, 0x39, 0x62, 0xd1, 0x5d, 0xb8, 0x6c,
+	0xb9, 0x8f, 0xb1, 0x43, 0xbc, 0xa3, 0xde, 0x18, 0x7b, 0x03, 0xec, 0xfa, 0xc6, 0x10, 0x07, 0x3a,
+	0x2e, 0x07, 0x73, 0xbb, 0xd1, 0x14, 0xba, 0x03, 0xe8, 0xd0, 0xf0, 0x9c, 0xc9, 0x38, 0xb1, 0xa0,
+	0xc4, 0x17, 0x5c, 0x12, 0x33, 0x31, 0x76, 0xed, 0x8f, 0x0a, 0xac, 0xb0, 0x83, 0xed, 0x1a, 0x9e,
+	0x6f, 0x5d, 0x80, 0x79, 0x35, 0xa8, 0xc6, 0x8f, 0xd4, 0x2c, 0xf2, 0xb9, 0x04, 0x8d, 0xf1, 0x8c,
+	0x03, 0xf1, 0xdd, 0x4e, 0xa0, 0x6c, 0x82, 0xa6, 0xfd, 0x41, 0xc6, 0x41, 0x5c, 0xcf, 0xf3, 0xd8,
+	0x3f, 0x2d, 0xb3, 0x90, 0x95, 0x79, 0x06, 0xeb, 0x6b, 0x5f, 0x28, 0xb0, 0xf2, 0x88, 0x18, 0x66,
+	0x14, 0x27, 0xff, 0x7f, 0x73, 0x7e, 0x0c, 0x0b, 0xe2, 0x52, 0x35, 0x4b, 0x5c, 0xd6, 0xcd, 0xa4,
+	0x2c, 0x31, 0xb7, 0x11, 0x69, 0xb8, 0xc7, 0x09, 0xba, 0x5c, 0xa4, 0xfd, 0x46, 0x81, 0xa6, 0x8e,
+	0x6d, 0x6c, 0x50, 0xfc, 0x26, 0x4f, 0xb1, 0x0a, 0x0b, 0x2e, 0x31, 0x71, 0xb7, 0xc3, 0x4f, 0x51,
+	0xd4, 0xe5, 0x48, 0xfb, 0x8f, 0xb4, 0xf0, 0x5b, 0x1e, 0xb0, 0x31, 0x2f, 0xcc, 0x9f, 0xc5, 0x0b,
+	0x5f, 0x44, 0x5e, 0x78, 0xdb, 0x4f, 0x1a, 0x79, 0x6a, 0x3e, 0xe1, 0xa9, 0x1f, 0xc3, 0x95, 0x2d,
+	0x0f, 0x1b, 0x3e, 0xfe, 0x01, 0xcb, 0x0a, 0x5b, 0x23, 0xc3, 0x75, 0xb1, 0x1d, 0x1c, 0x21, 0x2d,
+	0x5c, 0xc9, 0x11, 0xde, 0x84, 0xc5, 0xb1, 0x47, 0x5e, 0x1d, 0x85, 0x7a, 0x07, 0x43, 0xed, 0xf7,
+	0x0a, 0xb4, 0xf2, 0xf6, 0x3e, 0x0f, 0x22, 0xdc, 0x82, 0x86, 0x27, 0x94, 0xeb, 0x0d, 0xc4, 0x7e,
+	0x5c, 0x6a, 0x59, 0xaf, 0x4b, 0xb2, 0x94, 0x82, 0x6e, 0x42, 0xdd, 0xc3, 0x74, 0x62, 0x47, 0x7c,
+	0x45, 0xce, 0x57, 0x13, 0x54, 0xc9, 0xa6, 0xfd, 0x59, 0x81, 0x2b, 0xdb, 0xd8, 0x0f, 0xbd, 0xc7,
+	0xc4, 0xe1, 0xb7, 0x14, 0x5d, 0x7f, 0xa7, 0x40, 0x23, 0xa5, 0x28, 0x5a, 0x83, 0x4a, 0x8c, 0x47,
+	0x3a, 0x28, 0x4e, 0x42, 0xdf, 0x85, 0x79, 0x66, 0x3b, 0xcc, 0x55, 0xaa, 0xb7, 0xb5, 0x8d, 0x6c,
+	0x2d, 0xb0, 0x91, 0xdc, 0x55, 0x17, 0x0b, 0xd0, 0x26, 0x2c, 0xe7, 0x20, 0xab, 0x54, 0x1f, 0x65,
+	0x81, 0x55, 0xfb, 0x8b, 0x02, 0xad, 0x3c, 0x63, 0x9e, 0xc7, 0xe1, 0xcf, 0x60, 0x35, 0x3c, 0x4d,
+	0xcf, 0xc4, 0x74, 0xe0, 0x59, 0x63, 0xf6, 0x2d, 0x92, 0x41, 0xa5, 0x7d, 0xe3, 0xe4, 0xf3, 0x50,
+	0x7d, 0x25, 0xdc, 0xa2, 0x13, 0xdb, 0x41, 0xb3, 0x60, 0x65, 0x1b, 0xfb, 0x7b, 0x78, 0xe8, 0x60,
+	0xd7, 0xef, 0xba, 0x07, 0xe4, 0xec, 0x7e, 0xbf, 0x0e, 0x40, 0xe5, 0x3e, 0x61, 0x9e, 0x8a, 0x51,
+	0xb4, 0x7f, 0x16, 0xa0, 0x12, 0x13, 0x84, 0xae, 0x42, 0x39, 0x9c, 0x95, 0x5e, 0x8b, 0x08, 0x99,
+	0x88, 0x29, 0xe4, 0x44, 0x4c, 0xca, 0xf3, 0xc5, 0xac, 0xe7, 0xa7, 0x80, 0x33, 0xba, 0x02, 0x4b,
+	0x0e, 0x76, 0x7a, 0xd4, 0x7a, 0x8d, 0x25, 0x18, 0x2c, 0x3a, 0xd8, 0xd9, 0xb3, 0x5e, 0x63, 0x36,
+	0xe5, 0x4e, 0x9c, 0x9e, 0x47, 0x0e, 0x69, 0x73, 0x41, 0x4c, 0xb9, 0x13, 0x47, 0x27, 0x87, 0x14,
+	0x5d, 0x03, 0xb0, 0x5c, 0x13, 0xbf, 0xea, 0xb9, 0x86, 0x83, 0x9b, 0x8b, 0xfc, 0x32, 0x95, 0x39,
+	0x65, 0xc7, 0x70, 0x30, 0x83, 0x01, 0x3e, 0xe8, 0x76, 0x9a, 0x4b, 0x62, 0xa1, 0x1c, 0xb2, 0xa3,
+	0xca, 0x2b, 0xd8, 0xed, 0x34, 0xcb, 0x62, 0x5d, 0x48, 0x40, 0x9f, 0x42, 0x4d, 0x9e, 0xbb, 0x27,
+	0xc2, 0x14, 0x78, 0x98, 0xae, 0xe5, 0xb9, 0x55, 0x1a, 0x50, 0x04, 0x69, 0x95, 0xc6, 0x46, 0xbc,
+	0x02, 0x4d, 0xfb, 0xf2, 0x3c, 0x61, 0xf7, 0x1d, 0x98, 0xb7, 0xdc, 0x03, 0x12, 0x44, 0xd9, 0x3b,
+	0xc7, 0xa8, 0xc3, 0x85, 0x09, 0x6e, 0xed, 0x5f, 0x0a, 0xac, 0x7e, 0x62, 0x9a, 0x79, 0x58, 0x7a,
+	0xfa, 0x98, 0x8a, 0xfc, 0x57, 0x48, 0xf8, 0x6f, 0x16, 0x3c, 0xf9, 0x00, 0x2e, 0xa5, 0x70, 0x52,
+	0x86, 0x41, 0x59, 0x57, 0x93, 0x48, 0xd9, 0xed, 0xa0, 0xf7, 0x41, 0x4d, 0x62, 0xa5, 0xcc, 0x12,
+	0x65, 0xbd, 0x91, 0x40, 0xcb, 0x6e, 0x47, 0xfb, 0xb7, 0x02, 0x57, 0x74, 0xec, 0x90, 0x97, 0xf8,
+	0xab, 0x7b, 0xc6, 0x2f, 0x0b, 0xb0, 0xfa, 0x23, 0xc3, 0x1f, 0x8c, 0x3a, 0x8e, 0x24, 0xd2, 0x37,
+	0x73, 0xc0, 0xd4, 0x15, 0x2f, 0x65, 0xaf, 0x78, 0x18, 0xa6, 0xf3, 0x79, 0x61, 0xca, 0xde, 0x69,
+	0x1b, 0x4f, 0x83, 0xf3, 0x46, 0x61, 0x1a, 0x2b, 0x7b, 0x16, 0xce, 0x50, 0xf6, 0xa0, 0x2d, 0xa8,
+	0xe1, 0x57, 0x03, 0x7b, 0x62, 0xe2, 0x9e, 0x90, 0xbe, 0xc8, 0xa5, 0x5f, 0xcf, 0x91, 0x1e, 0xbf,
+	0x23, 0x55, 0xb9, 0xa8, 0xcb, 0xaf, 0xca, 0xaf, 0x8a, 0xd0, 0x90, 0xb3, 0xac, 0x52, 0x9c, 0x01,
+	0x15, 0x53, 0xe6, 0x28, 0x64, 0xcd, 0x31, 0x8b, 0x51, 0x83, 0x0c, 0x5d, 0x8a, 0x65, 0xe8, 0x6b,
+	0x00, 0x07, 0xf6, 0x84, 0x8e, 0x7a, 0xbe, 0xe5, 0x04, 0x98, 0x58, 0xe6, 0x94, 0x7d, 0xcb, 0xc1,
+	0xe8, 0x13, 0xa8, 0xf6, 0x2d, 0xd7, 0x26, 0xc3, 0xde, 0xd8, 0xf0, 0x47, 0x0c, 0x19, 0xa7, 0x1d,
+	0xf7, 0xa1, 0x85, 0x6d, 0xf3, 0x01, 0xe7, 0xd5, 0x2b, 0x62, 0xcd, 0x2e, 0x5b, 0x82, 0xae, 0x43,
+	0x85, 0x01, 0x2b, 0x39, 0x10, 0xd8, 0xba, 0x28, 0x44, 0xb8, 0x13, 0xe7, 0xc9, 0x01, 0x47, 0xd7,
+	0xcf, 0xa0, 0x31, 0xb0, 0x27, 0xd4, 0xc7, 0x9e, 0xe5, 0x0e, 0xb9, 0x55, 0x39, 0x8c, 0x56, 0xda,
+	0xdf, 0xca, 0x91, 0xb2, 0x15, 0x72, 0x72, 0xbb, 0xd6, 0x07, 0x89, 0x31, 0xfa, 0x18, 0xca, 0x26,
+	0xb6, 0x7d, 0xc3, 0x26, 0x43, 0xda, 0x2c, 0x4f, 0x0d, 0x8c, 0x0e, 0xe3, 0x79, 0x44, 0xc4, 0x1e,
+	0xd1, 0x0a, 0xed, 0x4f, 0x05, 0x58, 0x66, 0x1e, 0x91, 0xce, 0xb9, 0x80, 0xd8, 0xbf, 0x1f, 0x44,
+	0x6d, 0x71, 0x7a, 0x0a, 0x4f, 0x85, 0x46, 0x36, 0x72, 0xcf, 0xf2, 0x6c, 0x42, 0xdf, 0x87, 0xba,
+	0x4d, 0x0c, 0xb3, 0x37, 0x20, 0xae, 0xc9, 0x83, 0x86, 0x3b, 0xbb, 0xde, 0x7e, 0x37, 0x4f, 0x85,
+	0x7d, 0xcf, 0x1a, 0x0e, 0xb1, 0xb7, 0x15, 0xf0, 0xea, 0x35, 0x9b, 0x3f, 0x1a, 0xe5, 0x90, 0x83,
+	0xbd, 0xac, 0xfe, 0x2f, 0xce, 0x56, 0x41, 0xb8, 0x16, 0x8f, 0x29, 0x28, 0x4b, 0x33, 0x14, 0x94,
+	0xf3, 0x39, 0x6f, 0x82, 0x64, 0xd1, 0xb2, 0x90, 0x29, 0x5a, 0xf6, 0xa1, 0x16, 0x42, 0x20, 0x8f,
+	0xac, 0x1b, 0x50, 0x13, 0x6a, 0xf5, 0x98, 0x25, 0xb0, 0x19, 0x3c, 0x08, 0x04, 0xf1, 0x11, 0xa7,
+	0xb1, 0x5d, 0x43, 0x88, 0x15, 0xf9, 0xb3, 0xac, 0xc7, 0x28, 0xda, 0x2f, 0x15, 0x50, 0xe3, 0xc9,
+	0x83, 0xef, 0x3c, 0xcb, 0x4b, 0xe3, 0x16, 0x34, 0x64, 0x6b, 0x2b, 0x44, 0x70, 0x59, 0xfb, 0xbf,
+	0x88, 0x6f, 0xd7, 0x41, 0x1f, 0xc1, 0xaa, 0x60, 0xcc, 0x20, 0xbe, 0x78, 0x03, 0x5c, 0xe6, 0xb3,
+	0x7a, 0x0a, 0xf6, 0xff, 0x5e, 0x84, 0x7a, 0x14, 0x38, 0x33, 0x6b, 0x35, 0x4b, 0x8f, 0x62, 0x07,
+	0xd4, 0xa8, 0x88, 0xe5, 0x65, 0xce, 0xb1, 0xb1, 0x9f, 0x2e, 0x5f, 0x1b, 0xe3, 0x24, 0x01, 0x3d,
+	0x84, 0x9a, 0x3c, 0x93, 0x04, 0xe0, 0xd2, 0x5a, 0x31, 0x8b, 0x15, 0x62, 0xb3, 0x84, 0x07, 0xf5,
+	0x6a, 0x2c, 0x1b, 0x50, 0x74, 0x1f, 0xca, 0xfc, 0x3a, 0xf8, 0x47, 0x63, 0x2c, 0x6f, 0xc2, 0xd5,
+	0xbc, 0x3d, 0x98, 0x67, 0xf7, 0x8f, 0xc6, 0x58, 0x5f, 0xb2, 0xe5, 0xd7, 0x79, 0x53, 0xc8, 0x3d,
+	0x58, 0xf1, 0xc4, 0xd5, 0x31, 0x7b, 0x09, 0xf3, 0x2d, 0x72, 0xf3, 0x5d, 0x0e, 0x26, 0x77, 0xe3,
+	0x66, 0x9c, 0xf2, 0x20, 0x59, 0x9a, 0xfa, 0x20, 0xf9, 0x29, 0x34, 0xbe, 0x67, 0xb8, 0x26, 0x39,
+	0x38, 0x08, 0x2e, 0xe8, 0x19, 0x6e, 0xe6, 0xfd, 0x64, 0x29, 0x78, 0x0a, 0xb4, 0xd2, 0x7e, 0x5d,
+	0x80, 0x55, 0x46, 0x7b, 0x60, 0xd8, 0x86, 0x3b, 0xc0, 0xb3, 0x3f, 0x00, 0xfe, 0x37, 0xa9, 0xee,
+	0x06, 0xd4, 0x28, 0x99, 0x78, 0x03, 0xdc, 0x4b, 0xbc, 0x03, 0xaa, 0x82, 0xb8, 0xc3, 0x69, 0x2c,
+	0xf7, 0x99, 0xd4, 0xef, 0x25, 0x9a, 0x03, 0x65, 0x93, 0xfa, 0x72, 0xfa, 0x1d, 0xa8, 0xc8, 0x3d,
+	0x4c, 0xe2, 0x62, 0xee, 0xec, 0x25, 0x1d, 0x04, 0xa9, 0x43, 0x5c, 0xfe, 0x64, 0x60, 0xeb, 0xf9,
+	0xec, 0x22, 0x9f, 0x5d, 0x34, 0xa9, 0xcf, 0xa7, 0xae, 0x01, 0xbc, 0x34, 0x6c, 0xcb, 0x8c, 0xf2,
+	0xd9, 0x92, 0x5e, 0xe6, 0x14, 0x66, 0x02, 0xed, 0xaf, 0x0a, 0xa0, 0x98, 0x75, 0xce, 0x8e, 0x9d,
+	0x37, 0xa1, 0x9e, 0x38, 0x67, 0xd8, 0xa7, 0x8d, 0x1f, 0x94, 0x32, 0xf0, 0xef, 0x0b, 0x51, 0x3d,
+	0x0f, 0x1b, 0x94, 0xb8, 0xcd, 0xe2, 0x69, 0xc0, 0xbf, 0x1f, 0xa8, 0xc9, 0x96, 0x6a, 0x13, 0xb8,
+	0x16, 0xbd, 0x37, 0x3a, 0x16, 0xf5, 0x3d, 0xab, 0x3f, 0x39, 0x5f, 0x13, 0x6e, 0x86, 0x57, 0x9f,
+	0xf6, 0xa5, 0x02, 0xd7, 0xa7, 0xc9, 0x3d, 0xcf, 0x7b, 0xe7, 0x21, 0xd4, 0xe8, 0xc8, 0xf0, 0xcc,
+	0x9e, 0x8d, 0x0d, 0x13, 0x7b, 0x41, 0xb0, 0xcf, 0x82, 0x28, 0x7c, 0xdd, 0x23, 0xb1, 0x0c, 0x75,
+	0xa2, 0xe7, 0x5c, 0x3c, 0xc5, 0x9f, 0xf8, 0x7e, 0xaa, 0xd2, 0x68, 0x40, 0xb5, 0x5f, 0x28, 0xb0,
+	0xac, 0xe3, 0x03, 0x0f, 0xd3, 0x51, 0x97, 0xbd, 0x22, 0x2f, 0xd4, 0xa6, 0xec, 0xe9, 0x7a, 0xc0,
+	0xea, 0xb6, 0xf0, 0x16, 0x05, 0xc3, 0xf5, 0xd7, 0x50, 0x4f, 0x62, 0x31, 0xaa, 0xc2, 0xd2, 0x0e,
+	0xf1, 0x3f, 0x7d, 0x65, 0x51, 0x5f, 0x9d, 0x43, 0x75, 0x80, 0x1d, 0xe2, 0xef, 0x7a, 0x98, 0x62,
+	0xd7, 0x57, 0x15, 0x04, 0xb0, 0xf0, 0xc4, 0xed, 0x58, 0xf4, 0x73, 0xb5, 0x80, 0x96, 0x65, 0xb3,
+	0xc6, 0xb0, 0xbb, 0x12, 0x98, 0xd4, 0x22, 0x5b, 0x1e, 0x8e, 0x4a, 0x48, 0x85, 0x6a, 0xc8, 0xb2,
+	0xbd, 0xfb, 0x43, 0x75, 0x1e, 0x95, 0x61, 0x5e, 0x7c, 0x2e, 0xac, 0x3f, 0x01, 0x35, 0x1d, 0x83,
+	0xa8, 0x02, 0x8b, 0x23, 0x81, 0x67, 0xea, 0x1c, 0x6a, 0x40, 0xc5, 0x8e, 0x6e, 0x8f, 0xaa, 0x30,
+	0xc2, 0xd0, 0x1b, 0x0f, 0xa4, 0xb1, 0xd4, 0x02, 0x93, 0xc6, 0x2e, 0x44, 0x87, 0x1c, 0xba, 0x6a,
+	0x71, 0xfd, 0x33, 0xa8, 0xc6, 0x1f, 0xd0, 0x68, 0x09, 0x4a, 0x3b, 0xc4, 0xc5, 0xea, 0x1c, 0xdb,
+	0x76, 0xdb, 0x23, 0x87, 0x96, 0x3b, 0x14, 0x67, 0x78, 0xe8, 0x91, 0xd7, 0xd8, 0x55, 0x0b, 0x6c,
+	0x82, 0x62, 0xc3, 0x66, 0x13, 0x45, 0x36, 0xc1, 0x06, 0xd8, 0x54, 0x4b, 0xeb, 0x77, 0x61, 0x29,
+	0xc8, 0x09, 0xe8, 0x12, 0xd4, 0x12, 0xad, 0x5e, 0x75, 0x0e, 0x21, 0x51, 0x66, 0x45, 0xe8, 0xaf,
+	0x2a, 0xed, 0xbf, 0x55, 0x01, 0x44, 0xda, 0x67, 0x3f, 0x8e, 0xd0, 0x18, 0xd0, 0x36, 0xf6, 0xb7,
+	0x88, 0x33, 0x26, 0x6e, 0xa0, 0x12, 0x45, 0x1f, 0x26, 0x5d, 0x1a, 0xfe, 0x86, 0xca, 0xb2, 0xca,
+	0x53, 0xb6, 0xde, 0x9b, 0xb2, 0x22, 0xc5, 0xae, 0xcd, 0x21, 0x87, 0x4b, 0x64, 0x05, 0xfd, 0xbe,
+	0x35, 0xf8, 0x3c, 0xe8, 0x13, 0x1e, 0x23, 0x31, 0xc5, 0x1a, 0x48, 0x4c, 0x25, 0x00, 0x39, 0xd8,
+	0xf3, 0x59, 0xfd, 0x1d, 0x5c, 0x42, 0x6d, 0x0e, 0xbd, 0x80, 0xcb, 0xec, 0xa2, 0xfa, 0x86, 0x6f,
+	0x51, 0xdf, 0x1a, 0xd0, 0x40, 0x60, 0x7b, 0xba, 0xc0, 0x0c, 0xf3, 0x29, 0x45, 0xda, 0xd0, 0x48,
+	0xfd, 0xfe, 0x42, 0xeb, 0xb9, 0x17, 0x2f, 0xf7, 0x57, 0x5d, 0xeb, 0x83, 0x99, 0x78, 0x43, 0x69,
+	0x16, 0xd4, 0x93, 0xff, 0x7a, 0xd0, 0xfb, 0xd3, 0x36, 0xc8, 0x34, 0xc7, 0x5b, 0xeb, 0xb3, 0xb0,
+	0x86, 0xa2, 0x9e, 0x41, 0x3d, 0xf9, 0x37, 0x21, 0x5f, 0x54, 0xee, 0x1f, 0x87, 0xd6, 0x71, 0xf8,
+	0xa7, 0xcd, 0xa1, 0x9f, 0xc0, 0xa5, 0x4c, 0x0b, 0x1f, 0x7d, 0x3b, 0x6f, 0xfb, 0x69, 0x9d, 0xfe,
+	0x93, 0x24, 0x48, 0xed, 0x23, 0x2b, 0x4e, 0xd7, 0x3e, 0xf3, 0x2f, 0x67, 0x76, 0xed, 0x63, 0xdb,
+	0x1f, 0xa7, 0xfd, 0xa9, 0x25, 0x4c, 0x00, 0x65, 0x9b, 0xf8, 0xe8, 0x4e, 0x9e, 0x88, 0xa9, 0x3f,
+	0x12, 0x5a, 0x1b, 0xb3, 0xb2, 0x87, 0x2e, 0x9f, 0xf0, 0xdb, 0x9a, 0x6e, 0x77, 0xe7, 0x8a, 0x9d,
+	0xda, 0xbf, 0x6f, 0x6d, 0xcc, 0xca, 0x1e, 0x0f, 0xea, 0x64, 0x1b, 0x31, 0xdf, 0x57, 0xb9, 0x6d,
+	0xe3, 0xd6, 0xfa, 0x2c, 0xac, 0xa1, 0xa8, 0x1e, 0xc0, 0x36, 0xf6, 0x1f, 0x63, 0xdf, 0xb3, 0x06,
+	0x14, 0xbd, 0x97, 0x7b, 0xc5, 0x23, 0x86, 0x40, 0xc6, 0xad, 0x13, 0xf9, 0x42, 0x01, 0x3f, 0x4b,
+	0xf4, 0x44, 0xe3, 0xb5, 0x02, 0xba, 0x7b, 0xbc, 0xa6, 0x39, 0xf5, 0x4c, 0xab, 0x7d, 0x9a, 0x25,
+	0xa1, 0x0e, 0x4f, 0xa1, 0x1a, 0x4f, 0xe4, 0xe8, 0x56, 0x7e, 0x68, 0x66, 0x52, 0xfd, 0x09, 0x51,
+	0xd9, 0xfe, 0x2d, 0x40, 0x99, 0x47, 0x0e, 0x2b, 0xee, 0xbe, 0x4e, 0x26, 0x17, 0x90, 0x4c, 0x9e,
+	0x43, 0x23, 0xd5, 0xc9, 0xce, 0x4f, 0x26, 0xf9, 0xed, 0xee, 0x93, 0x50, 0xa5, 0x0f, 0x28, 0xdb,
+	0x46, 0xce, 0xbf, 0xde, 0x53, 0xdb, 0xcd, 0x27, 0xc9, 0x78, 0x0e, 0x8d, 0x54, 0x1b, 0x37, 0xff,
+	0x04, 0xf9, 0xbd, 0xde, 0x93, 0x76, 0x7f, 0x0a, 0xd5, 0x78, 0x97, 0x2c, 0x3f, 0xb2, 0x73, 0xfa,
+	0x68, 0x6f, 0x1e, 0xd1, 0x2f, 0x3e, 0xe3, 0x3d, 0x87, 0x46, 0xaa, 0x31, 0x96, 0x6f, 0xf9, 0xfc,
+	0xee, 0xd9, 0x49, 0xbb, 0x7f, 0x95, 0x30, 0xfa, 0x82, 0xf0, 0xf1, 0xc1, 0x47, 0xcf, 0xda, 0x43,
+	0xcb, 0x1f, 0x4d, 0xfa, 0x6c, 0x66, 0x53, 0xb0, 0xde, 0xb1, 0x88, 0xfc, 0xda, 0x0c, 0x80, 0x62,
+	0x93, 0xaf, 0xde, 0xe4, 0x62, 0xc6, 0xfd, 0xfe, 0x02, 0x1f, 0xde, 0xfb, 0xef, 0x00, 0x80, 0xfb,
+	0x79, 0x2b, 0x43, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2182,6 +2380,8 @@ type QueryCoordClient interface {
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	GetSegmentDistribution(ctx context.Context, in *GetSegmentDistributionRequest, opts ...grpc.CallOption) (*GetSegmentDistributionResponse, error)
+	RefreshIndex(ctx context.Context, in *RefreshIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryCoordClient struct {
@@ -2309,6 +2509,24 @@ func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetri
 	return out, nil
 }
 
+func (c *queryCoordClient) GetSegmentDistribution(ctx context.Context, in *GetSegmentDistributionRequest, opts ...grpc.CallOption) (*GetSegmentDistributionResponse, error) {
+	out := new(GetSegmentDistributionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetSegmentDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) RefreshIndex(ctx context.Context, in *RefreshIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/RefreshIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2325,6 +2543,8 @@ type QueryCoordServer interface {
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	GetSegmentDistribution(context.Context, *GetSegmentDistributionRequest) (*GetSegmentDistributionResponse, error)
+	RefreshIndex(context.Context, *RefreshIndexRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -2371,6 +2591,14 @@ func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvu
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedQueryCoordServer) GetSegmentDistribution(ctx context.Context, req *GetSegmentDistributionRequest) (*GetSegmentDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentDistribution not implemented")
+}
+
+func (*UnimplementedQueryCoordServer) RefreshIndex(ctx context.Context, req *RefreshIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshIndex not implemented")
+}
+
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
 }
@@ -2609,6 +2837,42 @@ func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_GetSegmentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetSegmentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetSegmentDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetSegmentDistribution(ctx, req.(*GetSegmentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_RefreshIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).RefreshIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/RefreshIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).RefreshIndex(ctx, req.(*RefreshIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -2665,6 +2929,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "GetSegmentDistribution",
+			Handler:    _QueryCoord_GetSegmentDistribution_Handler,
+		},
+		{
+			MethodName: "RefreshIndex",
+			Handler:    _QueryCoord_RefreshIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -2687,6 +2959,7 @@ type QueryNodeClient interface {
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	RefreshIndex(ctx context.Context, in *RefreshIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryNodeClient struct {
@@ -2805,6 +3078,15 @@ func (c *queryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *queryNodeClient) RefreshIndex(ctx context.Context, in *RefreshIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/RefreshIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 type QueryNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2820,6 +3102,7 @@ type QueryNodeServer interface {
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	RefreshIndex(context.Context, *RefreshIndexRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
@@ -2863,6 +3146,10 @@ func (*UnimplementedQueryNodeServer) GetMetrics(ctx context.Context, req *milvus
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedQueryNodeServer) RefreshIndex(ctx context.Context, req *RefreshIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshIndex not implemented")
+}
+
 func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
 	s.RegisterService(&_QueryNode_serviceDesc, srv)
 }
@@ -3083,6 +3370,24 @@ func _QueryNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_RefreshIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).RefreshIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/RefreshIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).RefreshIndex(ctx, req.(*RefreshIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryNode",
 	HandlerType: (*QueryNodeServer)(nil),
@@ -3135,6 +3440,10 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _QueryNode_GetMetrics_Handler,
 		},
+		{
+			MethodName: "RefreshIndex",
+			Handler:    _QueryNode_RefreshIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
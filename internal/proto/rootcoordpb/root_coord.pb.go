@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus/internal/proto/commonpb"
 	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+	etcdpb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	milvuspb "github.com/milvus-io/milvus/internal/proto/milvuspb"
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -233,67 +234,167 @@ func (m *AllocIDResponse) GetCount() uint32 {
 	return 0
 }
 
+type ListApiKeysRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListApiKeysRequest) Reset()         { *m = ListApiKeysRequest{} }
+func (m *ListApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysRequest) ProtoMessage()    {}
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *ListApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysRequest.Unmarshal(m, b)
+}
+func (m *ListApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysRequest.Merge(m, src)
+}
+func (m *ListApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysRequest.Size(m)
+}
+func (m *ListApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysRequest proto.InternalMessageInfo
+
+func (m *ListApiKeysRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListApiKeysResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Keys                 []*etcdpb.ApiKeyInfo `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListApiKeysResponse) Reset()         { *m = ListApiKeysResponse{} }
+func (m *ListApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysResponse) ProtoMessage()    {}
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *ListApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysResponse.Unmarshal(m, b)
+}
+func (m *ListApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysResponse.Merge(m, src)
+}
+func (m *ListApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysResponse.Size(m)
+}
+func (m *ListApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysResponse proto.InternalMessageInfo
+
+func (m *ListApiKeysResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListApiKeysResponse) GetKeys() []*etcdpb.ApiKeyInfo {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*ListApiKeysRequest)(nil), "milvus.proto.rootcoord.ListApiKeysRequest")
+	proto.RegisterType((*ListApiKeysResponse)(nil), "milvus.proto.rootcoord.ListApiKeysResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5d, 0x4f, 0xdb, 0x3e,
-	0x14, 0xc6, 0x69, 0xe1, 0xcf, 0x5f, 0x1c, 0xda, 0x82, 0x2c, 0x60, 0xa8, 0xe3, 0x82, 0x75, 0x1a,
-	0xb4, 0xbc, 0xa4, 0x08, 0xa4, 0x69, 0xb7, 0xa3, 0xd5, 0xa0, 0xd2, 0x2a, 0x8d, 0x14, 0xa4, 0xbd,
-	0xa1, 0xca, 0x4d, 0x8f, 0xda, 0x88, 0x24, 0x0e, 0xb1, 0x3b, 0xd8, 0xe5, 0xbe, 0xd9, 0x3e, 0xda,
-	0x94, 0x17, 0xa7, 0x49, 0x9a, 0x94, 0xa0, 0xed, 0xae, 0x8e, 0x7f, 0x7e, 0x1e, 0x1f, 0x3f, 0xa7,
-	0xb2, 0x61, 0xdd, 0x61, 0x4c, 0xf4, 0x35, 0xc6, 0x9c, 0xa1, 0x62, 0x3b, 0x4c, 0x30, 0xb2, 0x65,
-	0xea, 0xc6, 0x8f, 0x09, 0xf7, 0x47, 0x8a, 0x3b, 0xed, 0xcd, 0x56, 0x4b, 0x1a, 0x33, 0x4d, 0x66,
-	0xf9, 0xdf, 0xab, 0xa5, 0x28, 0x55, 0xad, 0xe8, 0x96, 0x40, 0xc7, 0xa2, 0x46, 0x30, 0x5e, 0xb5,
-	0x1d, 0xf6, 0xf8, 0x33, 0x18, 0xac, 0x0f, 0xa9, 0xa0, 0x51, 0x8b, 0x5a, 0x1f, 0x36, 0xdf, 0x1b,
-	0x06, 0xd3, 0xae, 0x75, 0x13, 0xb9, 0xa0, 0xa6, 0xad, 0xe2, 0xfd, 0x04, 0xb9, 0x20, 0x27, 0xb0,
-	0x34, 0xa0, 0x1c, 0xb7, 0x0b, 0xbb, 0x85, 0xfa, 0xea, 0xe9, 0x8e, 0x12, 0xdb, 0x4a, 0xe0, 0xdf,
-	0xe5, 0xa3, 0x73, 0xca, 0x51, 0xf5, 0x48, 0xb2, 0x01, 0xff, 0x69, 0x6c, 0x62, 0x89, 0xed, 0xc5,
-	0xdd, 0x42, 0xbd, 0xac, 0xfa, 0x83, 0xda, 0xaf, 0x02, 0x6c, 0x25, 0x1d, 0xb8, 0xcd, 0x2c, 0x8e,
-	0xe4, 0x0c, 0x96, 0xb9, 0xa0, 0x62, 0xc2, 0x03, 0x93, 0x97, 0xa9, 0x26, 0x3d, 0x0f, 0x51, 0x03,
-	0x94, 0xec, 0xc0, 0x8a, 0x90, 0x4a, 0xdb, 0xc5, 0xdd, 0x42, 0x7d, 0x49, 0x9d, 0x7e, 0xc8, 0xd8,
-	0xc3, 0x67, 0xa8, 0x78, 0x5b, 0xe8, 0xb4, 0xff, 0x41, 0x75, 0xc5, 0xa8, 0xb2, 0x01, 0x6b, 0xa1,
-	0xf2, 0xdf, 0x54, 0x55, 0x81, 0x62, 0xa7, 0xed, 0x49, 0x2f, 0xaa, 0xc5, 0x4e, 0x3b, 0xbd, 0x8e,
-	0xd3, 0xdf, 0x04, 0x56, 0x54, 0xc6, 0x44, 0xcb, 0x0d, 0x90, 0xd8, 0x40, 0x2e, 0x50, 0xb4, 0x98,
-	0x69, 0x33, 0x0b, 0x2d, 0xe1, 0x2a, 0x22, 0x27, 0x27, 0x71, 0xbb, 0xb0, 0x1b, 0x66, 0xd1, 0xe0,
-	0x2c, 0xaa, 0x7b, 0x19, 0x2b, 0x12, 0x78, 0x6d, 0x81, 0x98, 0x9e, 0xa3, 0x1b, 0xe4, 0xb5, 0xae,
-	0xdd, 0xb5, 0xc6, 0xd4, 0xb2, 0xd0, 0x98, 0xe7, 0x98, 0x40, 0xa5, 0xe3, 0xeb, 0xf8, 0x8a, 0x60,
-	0xd0, 0x13, 0x8e, 0x6e, 0x8d, 0xe4, 0x39, 0xd6, 0x16, 0xc8, 0x3d, 0x6c, 0x5c, 0xa0, 0xe7, 0xae,
-	0x73, 0xa1, 0x6b, 0x5c, 0x1a, 0x9e, 0x66, 0x1b, 0xce, 0xc0, 0xcf, 0xb4, 0xec, 0xc3, 0x7a, 0xcb,
-	0x41, 0x2a, 0xb0, 0xc5, 0x0c, 0x03, 0x35, 0xa1, 0x33, 0x8b, 0x1c, 0xa5, 0x2e, 0x4d, 0x62, 0xd2,
-	0x68, 0x5e, 0xdc, 0xb5, 0x05, 0xf2, 0x0d, 0x2a, 0x6d, 0x87, 0xd9, 0x11, 0xf9, 0x83, 0x54, 0xf9,
-	0x38, 0x94, 0x53, 0xbc, 0x0f, 0xe5, 0x4b, 0xca, 0x23, 0xda, 0x8d, 0x54, 0xed, 0x18, 0x23, 0xa5,
-	0x5f, 0xa5, 0xa2, 0xe7, 0x8c, 0x19, 0x91, 0xe3, 0x79, 0x00, 0xd2, 0x46, 0xae, 0x39, 0xfa, 0x20,
-	0x7a, 0x40, 0x4a, 0x7a, 0x05, 0x33, 0xa0, 0xb4, 0x6a, 0xe6, 0xe6, 0x43, 0x63, 0x0b, 0xd6, 0x7a,
-	0x63, 0xf6, 0x30, 0x9d, 0xe3, 0xe4, 0x30, 0x3d, 0xd1, 0x38, 0x25, 0x2d, 0x8f, 0xf2, 0xc1, 0xa1,
-	0xdf, 0x2d, 0xac, 0xf9, 0x01, 0x7f, 0xa2, 0x8e, 0xd0, 0xbd, 0x2a, 0x0f, 0xe7, 0xb4, 0x41, 0x48,
-	0xe5, 0x0c, 0xea, 0x0b, 0x94, 0xdd, 0x80, 0xa7, 0xe2, 0x8d, 0xcc, 0x26, 0x78, 0xae, 0xf4, 0x2d,
-	0x94, 0x2e, 0x29, 0x9f, 0x2a, 0xd7, 0xb3, 0x5a, 0x60, 0x46, 0x38, 0x57, 0x07, 0xdc, 0x41, 0xc5,
-	0x3d, 0xb5, 0x70, 0x31, 0xcf, 0xe8, 0xdf, 0x38, 0x24, 0x2d, 0x0e, 0x73, 0xb1, 0xd1, 0xd4, 0x65,
-	0x57, 0xf4, 0x70, 0x64, 0xa2, 0x25, 0x32, 0x52, 0x48, 0x50, 0xf3, 0x53, 0x9f, 0x81, 0x43, 0x3f,
-	0x84, 0x92, 0xbb, 0x97, 0x60, 0x82, 0x67, 0x9c, 0x5d, 0x14, 0x91, 0x4e, 0x8d, 0x1c, 0x64, 0x68,
-	0x73, 0x03, 0xab, 0x7e, 0xdb, 0x74, 0xac, 0x21, 0x3e, 0x92, 0xfd, 0x39, 0x8d, 0xe5, 0x11, 0x39,
-	0x93, 0x1f, 0x43, 0x59, 0x96, 0xe6, 0x0b, 0x37, 0xe6, 0x96, 0x1f, 0x93, 0x3e, 0xc8, 0x83, 0x86,
-	0x05, 0x5c, 0xc1, 0x8a, 0xdb, 0x9a, 0xbe, 0xcb, 0x9b, 0xcc, 0xd6, 0x7d, 0xce, 0xe6, 0xef, 0x83,
-	0x2b, 0x3a, 0x7c, 0x25, 0x90, 0x63, 0x25, 0xfd, 0xf5, 0xa3, 0xa4, 0xbe, 0x57, 0xaa, 0x4a, 0x5e,
-	0x3c, 0xac, 0xe2, 0x3b, 0xfc, 0x1f, 0xdc, 0xdd, 0x64, 0x6f, 0xee, 0xe2, 0xf0, 0xd9, 0x50, 0xdd,
-	0x7f, 0x92, 0x0b, 0xd5, 0x29, 0x6c, 0xde, 0xd8, 0x43, 0xf7, 0x8a, 0xf0, 0x2f, 0x22, 0x79, 0x15,
-	0x26, 0x53, 0x99, 0x5e, 0xb7, 0x71, 0xae, 0xcb, 0x47, 0x4f, 0x9d, 0x99, 0x01, 0x2f, 0x54, 0x34,
-	0x90, 0x72, 0x6c, 0x5f, 0x7d, 0xec, 0x22, 0xe7, 0x74, 0x84, 0x3d, 0xe1, 0x20, 0x35, 0x93, 0x57,
-	0xa4, 0xff, 0x06, 0xcc, 0x80, 0x73, 0x26, 0xa4, 0xc1, 0x66, 0xd0, 0xcb, 0x1f, 0x8c, 0x09, 0x1f,
-	0xbb, 0xaf, 0x03, 0x03, 0x05, 0x0e, 0x93, 0x7f, 0x49, 0xf7, 0x89, 0xa9, 0xa4, 0x92, 0x39, 0x4a,
-	0xea, 0x03, 0x5c, 0xa0, 0xe8, 0xa2, 0x70, 0x74, 0x8d, 0x27, 0x63, 0x09, 0x06, 0x53, 0x20, 0x23,
-	0x96, 0x14, 0x4e, 0xc6, 0x72, 0xfe, 0xee, 0xeb, 0xdb, 0x91, 0x2e, 0xc6, 0x93, 0x81, 0x6b, 0xdd,
-	0xf4, 0xc9, 0x63, 0x9d, 0x05, 0xbf, 0x9a, 0x32, 0x8d, 0xa6, 0xa7, 0xd4, 0x0c, 0x03, 0xb6, 0x07,
-	0x83, 0x65, 0xef, 0xd3, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x2e, 0x6c, 0xa3, 0xa7,
-	0x0b, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5b, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0x63, 0x27, 0xeb, 0x90, 0xe3, 0x4b, 0x02, 0xae, 0xc9, 0x0c, 0xaf, 0x03, 0x32, 0x0f,
+	0x4b, 0xed, 0xa4, 0xb5, 0x3b, 0x07, 0x18, 0xf6, 0x9a, 0xd8, 0x68, 0x6a, 0xac, 0x01, 0x16, 0xb9,
+	0x01, 0x76, 0x2b, 0x0c, 0x5a, 0x3e, 0xb3, 0x85, 0x48, 0xa2, 0x22, 0xd2, 0x6b, 0xf3, 0xb0, 0x87,
+	0x61, 0x9f, 0x78, 0xdf, 0x60, 0xd0, 0xd5, 0x94, 0x2c, 0x2a, 0xf2, 0xb2, 0x37, 0xd3, 0xfa, 0xf1,
+	0xff, 0xe7, 0xe1, 0x39, 0x24, 0x0f, 0xec, 0xbb, 0x8c, 0x89, 0x89, 0xce, 0x98, 0x3b, 0xeb, 0x3a,
+	0x2e, 0x13, 0x8c, 0x1c, 0x5a, 0x86, 0xf9, 0xc7, 0x92, 0x07, 0xa3, 0xae, 0xf7, 0xd9, 0xff, 0xda,
+	0xac, 0xea, 0xcc, 0xb2, 0x98, 0x1d, 0xfc, 0xdf, 0xac, 0xca, 0x54, 0xb3, 0x6e, 0xd8, 0x02, 0x5d,
+	0x9b, 0x9a, 0xe1, 0xb8, 0xe2, 0xb8, 0xec, 0xe3, 0x7d, 0x38, 0xd8, 0x9f, 0x51, 0x41, 0x65, 0x8b,
+	0xe6, 0x1e, 0x0a, 0x7d, 0x36, 0xb1, 0x50, 0xd0, 0xe0, 0x8f, 0xd6, 0x04, 0x0e, 0xce, 0x4d, 0x93,
+	0xe9, 0xef, 0x0c, 0x0b, 0xb9, 0xa0, 0x96, 0xa3, 0xe1, 0xdd, 0x12, 0xb9, 0x20, 0xaf, 0x60, 0x67,
+	0x4a, 0x39, 0x36, 0x4a, 0x47, 0xa5, 0x76, 0xa5, 0xff, 0xac, 0x9b, 0x58, 0x5b, 0xb8, 0xa0, 0x2b,
+	0x3e, 0xbf, 0xa0, 0x1c, 0x35, 0x9f, 0x24, 0x4f, 0xe1, 0x13, 0x9d, 0x2d, 0x6d, 0xd1, 0xd8, 0x3e,
+	0x2a, 0xb5, 0x6b, 0x5a, 0x30, 0x68, 0xfd, 0x55, 0x82, 0xc3, 0xb4, 0x03, 0x77, 0x98, 0xcd, 0x91,
+	0x9c, 0xc1, 0x13, 0x2e, 0xa8, 0x58, 0xf2, 0xd0, 0xe4, 0x8b, 0x4c, 0x93, 0xb1, 0x8f, 0x68, 0x21,
+	0x4a, 0x9e, 0xc1, 0xae, 0x88, 0x94, 0x1a, 0xe5, 0xa3, 0x52, 0x7b, 0x47, 0x5b, 0xfd, 0xa1, 0x58,
+	0xc3, 0x4f, 0x50, 0xf7, 0x97, 0x30, 0x1a, 0xfe, 0x0f, 0xd1, 0x95, 0x65, 0x65, 0x13, 0xf6, 0x62,
+	0xe5, 0xc7, 0x44, 0x55, 0x87, 0xf2, 0x68, 0xe8, 0x4b, 0x6f, 0x6b, 0xe5, 0xd1, 0x50, 0x11, 0xc7,
+	0x6b, 0x20, 0x6f, 0x0d, 0x2e, 0xce, 0x1d, 0xe3, 0x07, 0xbc, 0xe7, 0xff, 0x39, 0x96, 0xd6, 0x9f,
+	0xf0, 0x59, 0x42, 0xe7, 0x31, 0x2b, 0xff, 0x16, 0x76, 0x6e, 0xf1, 0x9e, 0x37, 0xca, 0x47, 0xdb,
+	0xed, 0x4a, 0xff, 0xcb, 0xe4, 0x14, 0xaf, 0xda, 0xba, 0x81, 0xcd, 0xc8, 0xfe, 0x9d, 0x69, 0x3e,
+	0xda, 0xff, 0xa7, 0x01, 0xbb, 0x1a, 0x63, 0x62, 0xe0, 0x15, 0x26, 0x71, 0x80, 0x5c, 0xa2, 0x18,
+	0x30, 0xcb, 0x61, 0x36, 0xda, 0xc2, 0x93, 0x47, 0x4e, 0x5e, 0x25, 0x85, 0xe2, 0x2a, 0x5f, 0x47,
+	0xc3, 0x6d, 0x68, 0x1e, 0x2b, 0x66, 0xa4, 0xf0, 0xd6, 0x16, 0xb1, 0x7c, 0x47, 0xaf, 0x1e, 0xdf,
+	0x19, 0xfa, 0xed, 0x60, 0x41, 0x6d, 0x1b, 0xcd, 0x3c, 0xc7, 0x14, 0x1a, 0x39, 0x7e, 0x9d, 0x9c,
+	0x11, 0x0e, 0xc6, 0xc2, 0x35, 0xec, 0x79, 0xb4, 0xa9, 0xad, 0x2d, 0x72, 0x07, 0x4f, 0x2f, 0xd1,
+	0x77, 0x37, 0xb8, 0x30, 0x74, 0x1e, 0x19, 0xf6, 0xd5, 0x86, 0x6b, 0xf0, 0x86, 0x96, 0x13, 0xd8,
+	0x1f, 0xb8, 0x48, 0x05, 0x0e, 0x98, 0x69, 0xa2, 0x2e, 0x0c, 0x66, 0x93, 0x17, 0x99, 0x53, 0xd3,
+	0x58, 0x64, 0x94, 0x97, 0xfb, 0xd6, 0x16, 0xf9, 0x15, 0xea, 0x43, 0x97, 0x39, 0x92, 0xfc, 0x49,
+	0xa6, 0x7c, 0x12, 0x2a, 0x28, 0x3e, 0x81, 0xda, 0x1b, 0xca, 0x25, 0xed, 0x4e, 0xa6, 0x76, 0x82,
+	0x89, 0xa4, 0xbf, 0xca, 0x44, 0x2f, 0x18, 0x33, 0xa5, 0xed, 0xf9, 0x00, 0x64, 0x88, 0x5c, 0x77,
+	0x8d, 0xa9, 0xbc, 0x41, 0xdd, 0xec, 0x08, 0xd6, 0xc0, 0xc8, 0xaa, 0x57, 0x98, 0x8f, 0x8d, 0x6d,
+	0xd8, 0x1b, 0x2f, 0xd8, 0x87, 0xd5, 0x37, 0x4e, 0x4e, 0xb3, 0x33, 0x9a, 0xa4, 0x22, 0xcb, 0x17,
+	0xc5, 0xe0, 0xd8, 0xef, 0x3d, 0xec, 0x05, 0x09, 0xfe, 0x91, 0xba, 0xc2, 0xf0, 0xa3, 0x3c, 0xcd,
+	0x29, 0x83, 0x98, 0x2a, 0x98, 0xa8, 0x9f, 0xa1, 0xe6, 0x25, 0x78, 0x25, 0xde, 0x51, 0x16, 0xc1,
+	0xa6, 0xd2, 0xef, 0xa1, 0xfa, 0x86, 0xf2, 0x95, 0x72, 0x5b, 0x55, 0x02, 0x6b, 0xc2, 0x85, 0x2a,
+	0xe0, 0x16, 0xea, 0xde, 0xae, 0xc5, 0x93, 0xb9, 0xa2, 0x7e, 0x93, 0x50, 0x64, 0x71, 0x5a, 0x88,
+	0x95, 0xb3, 0x1e, 0x55, 0xc5, 0x18, 0xe7, 0x16, 0xda, 0x42, 0x91, 0x85, 0x14, 0x95, 0x9f, 0xf5,
+	0x35, 0x38, 0xf6, 0x43, 0xa8, 0x7a, 0x6b, 0x09, 0x3f, 0x70, 0xc5, 0xde, 0xc9, 0x48, 0xe4, 0xd4,
+	0x29, 0x40, 0xc6, 0x36, 0x37, 0x50, 0x09, 0xca, 0x66, 0x64, 0xcf, 0xf0, 0x23, 0x79, 0x9e, 0x53,
+	0x58, 0x3e, 0x51, 0x30, 0xf3, 0x0b, 0xa8, 0x45, 0xa1, 0x05, 0xc2, 0x9d, 0xdc, 0xf0, 0x13, 0xd2,
+	0x27, 0x45, 0xd0, 0x38, 0x80, 0x6b, 0xd8, 0xf5, 0x4a, 0x33, 0x70, 0xf9, 0x46, 0x59, 0xba, 0x9b,
+	0x2c, 0xfe, 0x2e, 0xec, 0x34, 0xe2, 0x66, 0x87, 0xbc, 0xec, 0x66, 0x77, 0x75, 0xdd, 0xcc, 0xb6,
+	0xab, 0xd9, 0x2d, 0x8a, 0xc7, 0x51, 0xfc, 0x06, 0x9f, 0x86, 0x2d, 0x08, 0x39, 0xce, 0x9d, 0x1c,
+	0x77, 0x3f, 0xcd, 0xe7, 0x0f, 0x72, 0xb1, 0x3a, 0x85, 0x83, 0x1b, 0x67, 0xe6, 0x3d, 0x11, 0xc1,
+	0x43, 0x14, 0x3d, 0x85, 0xa4, 0xa3, 0x78, 0xbd, 0x52, 0xdc, 0x15, 0x9f, 0x3f, 0xb4, 0x67, 0x26,
+	0x7c, 0xae, 0xa1, 0x89, 0x94, 0xe3, 0xf0, 0xfa, 0xed, 0x15, 0x72, 0x4e, 0xe7, 0x38, 0x16, 0x2e,
+	0x52, 0x2b, 0xfd, 0x44, 0x06, 0xbd, 0xad, 0x02, 0x2e, 0x98, 0x21, 0x1d, 0x0e, 0xc2, 0x5a, 0x7e,
+	0x6d, 0x2e, 0xf9, 0xc2, 0xeb, 0x0e, 0x4c, 0x14, 0x38, 0x4b, 0x1f, 0x49, 0xaf, 0x75, 0xee, 0x66,
+	0x92, 0x05, 0x42, 0x9a, 0x00, 0x5c, 0xa2, 0xb8, 0x42, 0xe1, 0x1a, 0x3a, 0x4f, 0xa7, 0x25, 0x1c,
+	0xac, 0x00, 0x45, 0x5a, 0x32, 0x38, 0xf9, 0x62, 0x3f, 0x37, 0x05, 0xba, 0xd2, 0xf3, 0x95, 0x7d,
+	0xa5, 0xa4, 0xa8, 0xc2, 0x2f, 0x70, 0x35, 0x38, 0xb8, 0x41, 0xef, 0xa6, 0xb8, 0x41, 0x64, 0x24,
+	0xbf, 0x41, 0x89, 0x18, 0xa9, 0x41, 0xa9, 0x6a, 0x4c, 0x3c, 0x64, 0x20, 0x23, 0x1b, 0x1a, 0x8c,
+	0x01, 0xbc, 0xb3, 0x1b, 0xca, 0x1f, 0x2b, 0x0f, 0x77, 0x52, 0xfc, 0xc1, 0xab, 0xa9, 0x22, 0xf5,
+	0xcd, 0xe4, 0x44, 0x75, 0x8c, 0xd6, 0x9b, 0xf4, 0xe6, 0x69, 0x21, 0x36, 0x5e, 0xfe, 0x02, 0x6a,
+	0x97, 0x28, 0xae, 0x97, 0x4c, 0xd0, 0x1b, 0xaf, 0xc4, 0x15, 0x97, 0x60, 0x82, 0xc9, 0xbf, 0x04,
+	0x53, 0xa8, 0xdc, 0x2a, 0xde, 0xd8, 0xb3, 0x64, 0x2f, 0x97, 0xfd, 0xe0, 0xa4, 0xb1, 0x82, 0x9b,
+	0xf6, 0x77, 0x09, 0x0e, 0xbd, 0x20, 0xbd, 0xdd, 0x76, 0x70, 0x26, 0xf7, 0x3e, 0xfd, 0x4c, 0x9f,
+	0x6c, 0x38, 0x72, 0x3b, 0xdb, 0x68, 0x4e, 0x14, 0xe6, 0xc5, 0xf7, 0xbf, 0x7c, 0x37, 0x37, 0xc4,
+	0x62, 0x39, 0xf5, 0xd6, 0xd7, 0x0b, 0x66, 0xbd, 0x34, 0x58, 0xf8, 0xab, 0x17, 0x5d, 0x5f, 0x3d,
+	0x5f, 0xb5, 0x17, 0xa7, 0xc7, 0x99, 0x4e, 0x9f, 0xf8, 0x7f, 0x9d, 0xfd, 0x3b, 0x00, 0xe2, 0x46,
+	0x87, 0x4c, 0xb0, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +479,14 @@ type RootCoordClient interface {
 	SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateApiKey(ctx context.Context, in *milvuspb.CreateApiKeyRequest, opts ...grpc.CallOption) (*milvuspb.ApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, in *milvuspb.RotateApiKeyRequest, opts ...grpc.CallOption) (*milvuspb.ApiKeyResponse, error)
+	DropApiKey(ctx context.Context, in *milvuspb.DropApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	GetQuotaUsage(ctx context.Context, in *milvuspb.GetQuotaUsageRequest, opts ...grpc.CallOption) (*milvuspb.GetQuotaUsageResponse, error)
+	UndropCollection(ctx context.Context, in *milvuspb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error)
 }
 
 type rootCoordClient struct {
@@ -595,6 +704,78 @@ func (c *rootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateApiKey(ctx context.Context, in *milvuspb.CreateApiKeyRequest, opts ...grpc.CallOption) (*milvuspb.ApiKeyResponse, error) {
+	out := new(milvuspb.ApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RotateApiKey(ctx context.Context, in *milvuspb.RotateApiKeyRequest, opts ...grpc.CallOption) (*milvuspb.ApiKeyResponse, error) {
+	out := new(milvuspb.ApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropApiKey(ctx context.Context, in *milvuspb.DropApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetQuotaUsage(ctx context.Context, in *milvuspb.GetQuotaUsageRequest, opts ...grpc.CallOption) (*milvuspb.GetQuotaUsageResponse, error) {
+	out := new(milvuspb.GetQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) UndropCollection(ctx context.Context, in *milvuspb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UndropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	out := new(milvuspb.ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -667,6 +848,14 @@ type RootCoordServer interface {
 	SegmentFlushCompleted(context.Context, *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+	CreateApiKey(context.Context, *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error)
+	RotateApiKey(context.Context, *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error)
+	DropApiKey(context.Context, *milvuspb.DropApiKeyRequest) (*commonpb.Status, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	GetQuotaUsage(context.Context, *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error)
+	UndropCollection(context.Context, *milvuspb.UndropCollectionRequest) (*commonpb.Status, error)
+	ListDroppedCollections(context.Context, *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -743,6 +932,38 @@ func (*UnimplementedRootCoordServer) GetMetrics(ctx context.Context, req *milvus
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
 
+func (*UnimplementedRootCoordServer) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+
+func (*UnimplementedRootCoordServer) CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+
+func (*UnimplementedRootCoordServer) RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+
+func (*UnimplementedRootCoordServer) DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropApiKey not implemented")
+}
+
+func (*UnimplementedRootCoordServer) ListApiKeys(ctx context.Context, req *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+
+func (*UnimplementedRootCoordServer) GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+
+func (*UnimplementedRootCoordServer) UndropCollection(ctx context.Context, req *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndropCollection not implemented")
+}
+
+func (*UnimplementedRootCoordServer) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
 }
@@ -1161,6 +1382,150 @@ func _RootCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateApiKey(ctx, req.(*milvuspb.CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RotateApiKey(ctx, req.(*milvuspb.RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropApiKey(ctx, req.(*milvuspb.DropApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetQuotaUsage(ctx, req.(*milvuspb.GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_UndropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.UndropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).UndropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UndropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).UndropCollection(ctx, req.(*milvuspb.UndropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDroppedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, req.(*milvuspb.ListDroppedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -1257,6 +1622,38 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _RootCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _RootCoord_AlterCollection_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _RootCoord_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _RootCoord_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "DropApiKey",
+			Handler:    _RootCoord_DropApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _RootCoord_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _RootCoord_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "UndropCollection",
+			Handler:    _RootCoord_UndropCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _RootCoord_ListDroppedCollections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
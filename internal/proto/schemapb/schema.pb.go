@@ -26,17 +26,18 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type DataType int32
 
 const (
-	DataType_None         DataType = 0
-	DataType_Bool         DataType = 1
-	DataType_Int8         DataType = 2
-	DataType_Int16        DataType = 3
-	DataType_Int32        DataType = 4
-	DataType_Int64        DataType = 5
-	DataType_Float        DataType = 10
-	DataType_Double       DataType = 11
-	DataType_String       DataType = 20
-	DataType_BinaryVector DataType = 100
-	DataType_FloatVector  DataType = 101
+	DataType_None              DataType = 0
+	DataType_Bool              DataType = 1
+	DataType_Int8              DataType = 2
+	DataType_Int16             DataType = 3
+	DataType_Int32             DataType = 4
+	DataType_Int64             DataType = 5
+	DataType_Float             DataType = 10
+	DataType_Double            DataType = 11
+	DataType_String            DataType = 20
+	DataType_BinaryVector      DataType = 100
+	DataType_FloatVector       DataType = 101
+	DataType_SparseFloatVector DataType = 104
 )
 
 var DataType_name = map[int32]string{
@@ -51,20 +52,22 @@ var DataType_name = map[int32]string{
 	20:  "String",
 	100: "BinaryVector",
 	101: "FloatVector",
+	104: "SparseFloatVector",
 }
 
 var DataType_value = map[string]int32{
-	"None":         0,
-	"Bool":         1,
-	"Int8":         2,
-	"Int16":        3,
-	"Int32":        4,
-	"Int64":        5,
-	"Float":        10,
-	"Double":       11,
-	"String":       20,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"Bool":              1,
+	"Int8":              2,
+	"Int16":             3,
+	"Int32":             4,
+	"Int64":             5,
+	"Float":             10,
+	"Double":            11,
+	"String":            20,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"SparseFloatVector": 104,
 }
 
 func (x DataType) String() string {
@@ -175,13 +178,14 @@ func (m *FieldSchema) GetAutoID() bool {
 //*
 // @brief Collection schema
 type CollectionSchema struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AutoID               bool              `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Fields               []*FieldSchema    `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Functions            []*FunctionSchema `protobuf:"bytes,5,rep,name=functions,proto3" json:"functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
@@ -237,6 +241,13 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetFunctions() []*FunctionSchema {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -671,6 +682,7 @@ type VectorField struct {
 	// Types that are valid to be assigned to Data:
 	//	*VectorField_FloatVector
 	//	*VectorField_BinaryVector
+	//	*VectorField_SparseFloatVector
 	Data                 isVectorField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -721,10 +733,16 @@ type VectorField_BinaryVector struct {
 	BinaryVector []byte `protobuf:"bytes,3,opt,name=binary_vector,json=binaryVector,proto3,oneof"`
 }
 
+type VectorField_SparseFloatVector struct {
+	SparseFloatVector *SparseFloatArray `protobuf:"bytes,4,opt,name=sparse_float_vector,json=sparseFloatVector,proto3,oneof"`
+}
+
 func (*VectorField_FloatVector) isVectorField_Data() {}
 
 func (*VectorField_BinaryVector) isVectorField_Data() {}
 
+func (*VectorField_SparseFloatVector) isVectorField_Data() {}
+
 func (m *VectorField) GetData() isVectorField_Data {
 	if m != nil {
 		return m.Data
@@ -746,11 +764,19 @@ func (m *VectorField) GetBinaryVector() []byte {
 	return nil
 }
 
+func (m *VectorField) GetSparseFloatVector() *SparseFloatArray {
+	if x, ok := m.GetData().(*VectorField_SparseFloatVector); ok {
+		return x.SparseFloatVector
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VectorField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VectorField_FloatVector)(nil),
 		(*VectorField_BinaryVector)(nil),
+		(*VectorField_SparseFloatVector)(nil),
 	}
 }
 
@@ -1017,6 +1043,120 @@ func (m *SearchResultData) GetTopks() []int64 {
 	return nil
 }
 
+type FunctionSchema struct {
+	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InputName            string                   `protobuf:"bytes,2,opt,name=input_name,json=inputName,proto3" json:"input_name,omitempty"`
+	OutputFieldName      string                   `protobuf:"bytes,3,opt,name=output_field_name,json=outputFieldName,proto3" json:"output_field_name,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *FunctionSchema) Reset()         { *m = FunctionSchema{} }
+func (m *FunctionSchema) String() string { return proto.CompactTextString(m) }
+func (*FunctionSchema) ProtoMessage()    {}
+func (*FunctionSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
+}
+
+func (m *FunctionSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionSchema.Unmarshal(m, b)
+}
+func (m *FunctionSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionSchema.Marshal(b, m, deterministic)
+}
+func (m *FunctionSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSchema.Merge(m, src)
+}
+func (m *FunctionSchema) XXX_Size() int {
+	return xxx_messageInfo_FunctionSchema.Size(m)
+}
+func (m *FunctionSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionSchema proto.InternalMessageInfo
+
+func (m *FunctionSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FunctionSchema) GetInputName() string {
+	if m != nil {
+		return m.InputName
+	}
+	return ""
+}
+
+func (m *FunctionSchema) GetOutputFieldName() string {
+	if m != nil {
+		return m.OutputFieldName
+	}
+	return ""
+}
+
+func (m *FunctionSchema) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+//*
+// @brief Sparse float vectors, each of contents is a row of the (uint32 index, float32 value) pairs
+// sorted by the index, encoded in little endian
+type SparseFloatArray struct {
+	Contents [][]byte `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	// the max index plus one of the rows
+	Dim                  int64    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SparseFloatArray) Reset()         { *m = SparseFloatArray{} }
+func (m *SparseFloatArray) String() string { return proto.CompactTextString(m) }
+func (*SparseFloatArray) ProtoMessage()    {}
+func (*SparseFloatArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
+}
+
+func (m *SparseFloatArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SparseFloatArray.Unmarshal(m, b)
+}
+func (m *SparseFloatArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SparseFloatArray.Marshal(b, m, deterministic)
+}
+func (m *SparseFloatArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SparseFloatArray.Merge(m, src)
+}
+func (m *SparseFloatArray) XXX_Size() int {
+	return xxx_messageInfo_SparseFloatArray.Size(m)
+}
+func (m *SparseFloatArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_SparseFloatArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SparseFloatArray proto.InternalMessageInfo
+
+func (m *SparseFloatArray) GetContents() [][]byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *SparseFloatArray) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
@@ -1033,71 +1173,80 @@ func init() {
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
 	proto.RegisterType((*IDs)(nil), "milvus.proto.schema.IDs")
 	proto.RegisterType((*SearchResultData)(nil), "milvus.proto.schema.SearchResultData")
+	proto.RegisterType((*FunctionSchema)(nil), "milvus.proto.schema.FunctionSchema")
+	proto.RegisterType((*SparseFloatArray)(nil), "milvus.proto.schema.SparseFloatArray")
 }
 
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xb6, 0x2c, 0xcb, 0x96, 0x8e, 0xbc, 0x54, 0x61, 0xba, 0x41, 0x2b, 0x90, 0xc6, 0xf5, 0x56,
+	0xc0, 0x08, 0xb0, 0x04, 0x4d, 0xb6, 0xae, 0x2d, 0x56, 0xac, 0x75, 0x8d, 0x20, 0x46, 0x86, 0x22,
+	0x53, 0x86, 0x0e, 0xd8, 0x8d, 0x21, 0x5b, 0x4c, 0x42, 0x44, 0x26, 0x35, 0x91, 0x2a, 0xe6, 0x07,
+	0xd8, 0xab, 0xec, 0x62, 0xef, 0xd4, 0x8b, 0x61, 0xd7, 0x7b, 0x84, 0x01, 0x05, 0x7f, 0x6c, 0xcb,
+	0x89, 0x6a, 0xf8, 0xee, 0x90, 0x3c, 0xdf, 0x47, 0xf2, 0x3b, 0x7f, 0xd0, 0xe6, 0x93, 0x6b, 0x3c,
+	0x8d, 0x0f, 0xb2, 0x9c, 0x09, 0x86, 0x76, 0xa6, 0x24, 0x7d, 0x5f, 0x70, 0xbd, 0x3a, 0xd0, 0x47,
+	0x0f, 0xda, 0x13, 0x36, 0x9d, 0x32, 0xaa, 0x37, 0xbb, 0xff, 0xd4, 0xc1, 0x3f, 0x21, 0x38, 0x4d,
+	0x2e, 0xd4, 0x29, 0x0a, 0xa1, 0x75, 0x29, 0x97, 0xc3, 0x41, 0x68, 0x75, 0xac, 0x9e, 0x1d, 0xcd,
+	0x97, 0x08, 0x41, 0x83, 0xc6, 0x53, 0x1c, 0xd6, 0x3b, 0x56, 0xcf, 0x8b, 0x94, 0x8d, 0xbe, 0x86,
+	0x2d, 0xc2, 0x47, 0x59, 0x4e, 0xa6, 0x71, 0x3e, 0x1b, 0xdd, 0xe0, 0x59, 0x68, 0x77, 0xac, 0x9e,
+	0x1b, 0xb5, 0x09, 0x3f, 0xd7, 0x9b, 0x67, 0x78, 0x86, 0x3a, 0xe0, 0x27, 0x98, 0x4f, 0x72, 0x92,
+	0x09, 0xc2, 0x68, 0xd8, 0x50, 0x04, 0xe5, 0x2d, 0xf4, 0x02, 0xbc, 0x24, 0x16, 0xf1, 0x48, 0xcc,
+	0x32, 0x1c, 0x3a, 0x1d, 0xab, 0xb7, 0x75, 0xb4, 0x7b, 0x50, 0xf1, 0xf8, 0x83, 0x41, 0x2c, 0xe2,
+	0x5f, 0x66, 0x19, 0x8e, 0xdc, 0xc4, 0x58, 0xa8, 0x0f, 0xbe, 0x84, 0x8d, 0xb2, 0x38, 0x8f, 0xa7,
+	0x3c, 0x6c, 0x76, 0xec, 0x9e, 0x7f, 0xf4, 0x68, 0x15, 0x6d, 0xbe, 0x7c, 0x86, 0x67, 0xef, 0xe2,
+	0xb4, 0xc0, 0xe7, 0x31, 0xc9, 0x23, 0x90, 0xa8, 0x73, 0x05, 0x42, 0x03, 0x68, 0x13, 0x9a, 0xe0,
+	0x3f, 0xe6, 0x24, 0xad, 0x4d, 0x49, 0x7c, 0x05, 0x33, 0x2c, 0x5f, 0x40, 0x33, 0x2e, 0x04, 0x1b,
+	0x0e, 0x42, 0x57, 0xa9, 0x60, 0x56, 0xdd, 0x0f, 0x16, 0x04, 0x6f, 0x58, 0x9a, 0xe2, 0x89, 0xfc,
+	0xac, 0x11, 0x7a, 0x2e, 0xa7, 0x55, 0x92, 0xf3, 0x96, 0x50, 0xf5, 0xbb, 0x42, 0x2d, 0xaf, 0xb0,
+	0xcb, 0x57, 0xa0, 0x67, 0xd0, 0x54, 0x71, 0xe2, 0x61, 0x43, 0x3d, 0xbd, 0x53, 0xa9, 0x5e, 0x29,
+	0xd0, 0x91, 0xf1, 0x47, 0xaf, 0xc1, 0xbb, 0x2c, 0xa8, 0x7a, 0x19, 0x0f, 0x1d, 0x05, 0xfe, 0xaa,
+	0x1a, 0x6c, 0xbc, 0x0c, 0x7e, 0x89, 0xea, 0xee, 0x81, 0xd7, 0x67, 0x2c, 0x7d, 0x9d, 0xe7, 0xf1,
+	0x4c, 0xfe, 0x4b, 0x86, 0x26, 0xb4, 0x3a, 0x76, 0xcf, 0x8d, 0x94, 0xdd, 0x7d, 0x08, 0xee, 0x90,
+	0x8a, 0xbb, 0xe7, 0x8e, 0x39, 0xdf, 0x03, 0xef, 0x27, 0x46, 0xaf, 0xee, 0x3a, 0xd8, 0xc6, 0xa1,
+	0x03, 0x70, 0x92, 0xb2, 0xb8, 0x82, 0xa2, 0x6e, 0x3c, 0x1e, 0x81, 0x3f, 0x60, 0xc5, 0x38, 0xc5,
+	0x77, 0x5d, 0xac, 0x25, 0x49, 0x7f, 0x26, 0x30, 0xbf, 0xeb, 0xd1, 0x5e, 0x92, 0x5c, 0x88, 0x9c,
+	0x54, 0xbd, 0xc4, 0x33, 0x2e, 0x1f, 0x6c, 0xf0, 0x2f, 0x26, 0x71, 0x1a, 0xe7, 0x4a, 0x4c, 0xf4,
+	0x12, 0xbc, 0x31, 0x63, 0xe9, 0xc8, 0x38, 0x5a, 0x3d, 0xff, 0xe8, 0x61, 0xa5, 0x7c, 0x0b, 0x85,
+	0x4e, 0x6b, 0x91, 0x2b, 0x21, 0x32, 0x95, 0xd1, 0x0b, 0x70, 0x09, 0x15, 0x1a, 0x5d, 0x57, 0xe8,
+	0xea, 0xbc, 0x9f, 0xcb, 0x77, 0x5a, 0x8b, 0x5a, 0x84, 0x0a, 0x85, 0x7d, 0x09, 0x5e, 0xca, 0xe8,
+	0x95, 0x06, 0xdb, 0x6b, 0xae, 0x5e, 0x68, 0x2b, 0xaf, 0x96, 0x10, 0x05, 0x7f, 0x05, 0x70, 0x29,
+	0x35, 0xd5, 0xf8, 0x86, 0xc2, 0xef, 0x55, 0x47, 0x7e, 0x21, 0xfd, 0x69, 0x2d, 0xf2, 0x14, 0x48,
+	0x31, 0xbc, 0x01, 0x3f, 0x51, 0x9a, 0x6b, 0x0a, 0xa7, 0x63, 0x7d, 0x32, 0xf3, 0x4a, 0xb1, 0x39,
+	0xad, 0x45, 0xa0, 0x61, 0x73, 0x12, 0xae, 0x34, 0xd7, 0x24, 0xcd, 0x35, 0x24, 0xa5, 0xd8, 0x48,
+	0x12, 0x0d, 0x9b, 0xff, 0x65, 0x2c, 0x43, 0xab, 0x39, 0x5a, 0x6b, 0xfe, 0xb2, 0xcc, 0x00, 0xf9,
+	0x17, 0x05, 0x92, 0x0c, 0xfd, 0xa6, 0x8e, 0x75, 0xf7, 0x3f, 0x0b, 0xfc, 0x77, 0x78, 0x22, 0x98,
+	0x89, 0x6f, 0x00, 0x76, 0x42, 0xa6, 0xa6, 0x17, 0x4a, 0x53, 0xf6, 0x0a, 0xad, 0xdb, 0x7b, 0xe5,
+	0x16, 0xd6, 0xd7, 0xdc, 0xb6, 0xa2, 0x9c, 0xaf, 0x60, 0x9a, 0x1c, 0x3d, 0x86, 0xcf, 0xc6, 0x84,
+	0xca, 0xae, 0x69, 0x68, 0x64, 0x00, 0xdb, 0xa7, 0xb5, 0xa8, 0xad, 0xb7, 0x8d, 0xdb, 0xaf, 0xb0,
+	0xc3, 0xb3, 0x38, 0xe7, 0x78, 0xb4, 0x72, 0xa7, 0x8e, 0xd6, 0xe3, 0x6a, 0x95, 0x94, 0xff, 0xca,
+	0xcd, 0xdb, 0x7c, 0xb9, 0xa7, 0x89, 0x17, 0xff, 0xfd, 0xdf, 0x02, 0x4f, 0xfd, 0x54, 0xe9, 0xf8,
+	0x04, 0x1a, 0xaa, 0x05, 0x5b, 0x9b, 0xb4, 0x60, 0xe5, 0x8a, 0x76, 0x01, 0x54, 0x27, 0x19, 0x95,
+	0x86, 0x83, 0xa7, 0x76, 0xde, 0xca, 0x96, 0xf6, 0x03, 0xb4, 0xb8, 0x2a, 0x17, 0x1e, 0xda, 0xeb,
+	0x42, 0xbb, 0x2c, 0x29, 0x99, 0xe2, 0x06, 0x22, 0xd1, 0xfa, 0xc7, 0x3c, 0x6c, 0xac, 0x41, 0x97,
+	0x02, 0x26, 0xd1, 0x06, 0x82, 0xbe, 0x04, 0x57, 0x3f, 0x8d, 0x24, 0xa1, 0x53, 0x1e, 0x66, 0x49,
+	0xbf, 0x05, 0x8e, 0x32, 0xbb, 0x7f, 0x5a, 0x60, 0x0f, 0x07, 0x1c, 0x7d, 0x0f, 0x4d, 0x59, 0x88,
+	0x24, 0x09, 0xad, 0x0d, 0x2b, 0xc9, 0x21, 0x54, 0x0c, 0x13, 0xf4, 0x1c, 0x9a, 0x5c, 0xe4, 0x12,
+	0x58, 0xdf, 0x38, 0x75, 0x1d, 0x2e, 0xf2, 0x61, 0xd2, 0x07, 0x70, 0x49, 0x32, 0xd2, 0xef, 0xf8,
+	0xd7, 0x82, 0xe0, 0x02, 0xc7, 0xf9, 0xe4, 0x3a, 0xc2, 0xbc, 0x48, 0x75, 0x81, 0xed, 0x81, 0x4f,
+	0x8b, 0xe9, 0xe8, 0xf7, 0x02, 0xe7, 0x04, 0x73, 0x93, 0x84, 0x40, 0x8b, 0xe9, 0xcf, 0x7a, 0x07,
+	0xed, 0x80, 0x23, 0x58, 0x36, 0xba, 0x51, 0x77, 0xdb, 0x51, 0x43, 0xb0, 0xec, 0x0c, 0xfd, 0x08,
+	0xbe, 0xee, 0xed, 0xf3, 0xce, 0x60, 0x7f, 0xf2, 0x3f, 0x8b, 0xc8, 0x47, 0x3a, 0x88, 0xaa, 0x16,
+	0xe4, 0x90, 0xe1, 0x13, 0x96, 0x63, 0x3d, 0x4c, 0xea, 0x91, 0x59, 0xa1, 0x7d, 0xb0, 0x49, 0xc2,
+	0x4d, 0x9d, 0x87, 0xd5, 0x7d, 0x6a, 0xc0, 0x23, 0xe9, 0x84, 0xee, 0xab, 0x97, 0xdd, 0xe8, 0x79,
+	0x6c, 0x47, 0x7a, 0xd1, 0xfd, 0xdb, 0x82, 0xad, 0xd5, 0x39, 0x52, 0x39, 0x07, 0x77, 0x01, 0x08,
+	0xcd, 0x0a, 0xb1, 0x92, 0x53, 0x6a, 0x47, 0xe5, 0xd4, 0x3e, 0x6c, 0xb3, 0x42, 0xc8, 0xf3, 0x52,
+	0xe6, 0xd9, 0xca, 0xeb, 0x9e, 0x3e, 0x38, 0x59, 0xe4, 0xdf, 0x73, 0x68, 0x9a, 0x99, 0xde, 0xd8,
+	0x74, 0xa6, 0x1b, 0x40, 0xf7, 0x15, 0x04, 0xb7, 0x6b, 0x09, 0x3d, 0x00, 0x77, 0xc2, 0xa8, 0xc0,
+	0x54, 0x70, 0x33, 0x39, 0x16, 0xeb, 0x79, 0xab, 0xa8, 0x2f, 0x5a, 0xc5, 0xfe, 0x5f, 0x16, 0xb8,
+	0xf3, 0x72, 0x41, 0x2e, 0x34, 0xde, 0x32, 0x8a, 0x83, 0x9a, 0xb4, 0xe4, 0x34, 0x08, 0x2c, 0x69,
+	0x0d, 0xa9, 0x78, 0x16, 0xd4, 0x91, 0x07, 0xce, 0x90, 0x8a, 0x27, 0x4f, 0x03, 0xdb, 0x98, 0xc7,
+	0x47, 0x41, 0xc3, 0x98, 0x4f, 0xbf, 0x0d, 0x1c, 0x69, 0xaa, 0x77, 0x04, 0x80, 0x00, 0x9a, 0xba,
+	0x9f, 0x06, 0xbe, 0xb4, 0x75, 0x6e, 0x05, 0xf7, 0x51, 0x00, 0xed, 0x7e, 0xa9, 0x79, 0x04, 0x09,
+	0xba, 0x07, 0x7e, 0xa9, 0xe8, 0x03, 0x8c, 0x3e, 0x87, 0xed, 0x8b, 0xdb, 0xbd, 0x20, 0xb8, 0xee,
+	0x7f, 0xf7, 0xdb, 0xf1, 0x15, 0x11, 0xd7, 0xc5, 0x58, 0x0a, 0x72, 0xa8, 0x15, 0xfa, 0x86, 0x30,
+	0x63, 0x1d, 0x12, 0x2a, 0x70, 0x4e, 0xe3, 0xf4, 0x50, 0x89, 0x76, 0xa8, 0x63, 0x9d, 0x8d, 0xc7,
+	0x4d, 0xb5, 0x3e, 0xfe, 0x38, 0x00, 0x59, 0xfa, 0xfc, 0xe5, 0x76, 0x0a, 0x00, 0x00,
 }
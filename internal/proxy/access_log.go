@@ -0,0 +1,299 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+const (
+	// AccessLogFileSink writes the access log to a local file rotated by size
+	AccessLogFileSink = "file"
+	// AccessLogMinioSink uploads the access log to minio as objects
+	AccessLogMinioSink = "minio"
+
+	// DefaultAccessLogFormat is the access log format used if proxy.accessLog.format is empty
+	DefaultAccessLogFormat = `{{.Time}} {{.User}} {{.RemoteAddr}} {{.Method}} {{.Collection}} {{.Status}} {{.LatencyMs}}ms {{.TraceID}} {{.RequestID}} {{printf "%q" .Error}}`
+
+	// accessLogUserKey is the grpc metadata key of the user name sent by the clients
+	accessLogUserKey = "user"
+	// milvusServicePrefix is the prefix of the methods called by the clients, the internal ones are not logged
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+)
+
+// AccessLogConfig is the config of the access log of the client requests
+type AccessLogConfig struct {
+	Enable         bool
+	Format         string
+	Sink           string
+	File           log.FileLogConfig
+	MinioPath      string
+	UploadInterval time.Duration
+	Minio          miniokv.Option
+}
+
+// AccessInfo is an entry of the access log, its fields can be referenced in the access log format
+type AccessInfo struct {
+	Time       string
+	User       string
+	RemoteAddr string
+	Method     string
+	Collection string
+	Status     string
+	LatencyMs  int64
+	TraceID    string
+	RequestID  string
+	Error      string
+}
+
+// collectionRequest is implemented by the requests on a collection
+type collectionRequest interface {
+	GetCollectionName() string
+}
+
+// newAccessInfo collects the access info of a finished request
+func newAccessInfo(ctx context.Context, fullMethod string, req interface{}, resp interface{}, err error, latency time.Duration) *AccessInfo {
+	info := &AccessInfo{
+		Time:      time.Now().Format(time.RFC3339Nano),
+		Method:    path.Base(fullMethod),
+		Status:    commonpb.ErrorCode_Success.String(),
+		LatencyMs: latency.Milliseconds(),
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if users := md.Get(accessLogUserKey); len(users) > 0 {
+			info.User = users[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.RemoteAddr = p.Addr.String()
+	}
+	if r, ok := req.(collectionRequest); ok {
+		info.Collection = r.GetCollectionName()
+	}
+	info.TraceID, _, _ = trace.InfoFromContext(ctx)
+	info.RequestID = requestIDFromContext(ctx)
+
+	var status *commonpb.Status
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		status = r
+	case statusResponse:
+		status = r.GetStatus()
+	}
+	if status != nil {
+		info.Status = status.ErrorCode.String()
+		info.Error = status.Reason
+	}
+	if err != nil {
+		info.Status = commonpb.ErrorCode_UnexpectedError.String()
+		info.Error = err.Error()
+	}
+	return info
+}
+
+// AccessLogger formats the access info of the client requests and writes them to the sink
+type AccessLogger struct {
+	mu     sync.Mutex
+	format *template.Template
+	writer io.WriteCloser
+}
+
+// NewAccessLogger returns an access logger writing to the sink of the config
+func NewAccessLogger(ctx context.Context, cfg *AccessLogConfig) (*AccessLogger, error) {
+	format := cfg.Format
+	if format == "" {
+		format = DefaultAccessLogFormat
+	}
+	tmpl, err := template.New("access").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access log format: %w", err)
+	}
+
+	var writer io.WriteCloser
+	switch cfg.Sink {
+	case AccessLogFileSink:
+		writer = newFileAccessLogWriter(&cfg.File)
+	case AccessLogMinioSink:
+		writer, err = newMinioAccessLogWriter(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown access log sink %s", cfg.Sink)
+	}
+	return &AccessLogger{
+		format: tmpl,
+		writer: writer,
+	}, nil
+}
+
+// Log writes an entry of the access log
+func (l *AccessLogger) Log(info *AccessInfo) {
+	var buf bytes.Buffer
+	if err := l.format.Execute(&buf, info); err != nil {
+		log.Warn("format access log failed", zap.Error(err))
+		return
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.writer.Write(buf.Bytes()); err != nil {
+		log.Warn("write access log failed", zap.Error(err))
+	}
+}
+
+// Close flushes the access log and releases the sink
+func (l *AccessLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.writer.Close()
+}
+
+// AccessLogInterceptor returns a grpc interceptor which writes every client request to the access log
+func AccessLogInterceptor(logger *AccessLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logger.Log(newAccessInfo(ctx, info.FullMethod, req, resp, err, time.Since(start)))
+		return resp, err
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// newFileAccessLogWriter returns a writer rotating the access log file by size, the access log is written
+// to stdout if the file name is empty
+func newFileAccessLogWriter(cfg *log.FileLogConfig) io.WriteCloser {
+	if cfg.Filename == "" {
+		return nopCloser{Writer: os.Stdout}
+	}
+	return &lumberjack.Logger{
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxDays,
+		LocalTime:  true,
+	}
+}
+
+// minioAccessLogWriter buffers the access log in memory and uploads it to minio as an object
+// when the buffer exceeds the max size or on every upload interval
+type minioAccessLogWriter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	kv      *miniokv.MinIOKV
+	prefix  string
+	maxSize int
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func newMinioAccessLogWriter(ctx context.Context, cfg *AccessLogConfig) (*minioAccessLogWriter, error) {
+	kv, err := miniokv.NewMinIOKV(ctx, &cfg.Minio)
+	if err != nil {
+		return nil, err
+	}
+	ctx1, cancel := context.WithCancel(ctx)
+	w := &minioAccessLogWriter{
+		ctx:     ctx1,
+		cancel:  cancel,
+		kv:      kv,
+		prefix:  cfg.MinioPath,
+		maxSize: cfg.File.MaxSize * 1024 * 1024,
+	}
+	w.wg.Add(1)
+	go w.uploadLoop(cfg.UploadInterval)
+	return w, nil
+}
+
+func (w *minioAccessLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n, _ := w.buf.Write(p)
+	if w.buf.Len() >= w.maxSize {
+		return n, w.upload()
+	}
+	return n, nil
+}
+
+// upload must be called with the lock held, the buffered access log is dropped if it fails to upload
+func (w *minioAccessLogWriter) upload() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	key := path.Join(w.prefix, fmt.Sprintf("%s.log", time.Now().Format("20060102-150405.000000")))
+	err := w.kv.Save(key, w.buf.String())
+	w.buf.Reset()
+	return err
+}
+
+func (w *minioAccessLogWriter) uploadLoop(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			log.Debug("Proxy access log upload loop exit")
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			if err := w.upload(); err != nil {
+				log.Warn("upload access log failed", zap.Error(err))
+			}
+			w.mu.Unlock()
+		}
+	}
+}
+
+func (w *minioAccessLogWriter) Close() error {
+	w.cancel()
+	w.wg.Wait()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.upload()
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestNewAccessInfo(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessLogUserKey, "alice"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 19530}})
+	req := &milvuspb.HasCollectionRequest{CollectionName: "coll"}
+	resp := &milvuspb.BoolResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"}}
+
+	info := newAccessInfo(ctx, milvusServicePrefix+"HasCollection", req, resp, nil, 10*time.Millisecond)
+	assert.Equal(t, "alice", info.User)
+	assert.Equal(t, "127.0.0.1:19530", info.RemoteAddr)
+	assert.Equal(t, "HasCollection", info.Method)
+	assert.Equal(t, "coll", info.Collection)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError.String(), info.Status)
+	assert.Equal(t, "mock", info.Error)
+	assert.Equal(t, int64(10), info.LatencyMs)
+
+	info = newAccessInfo(context.Background(), milvusServicePrefix+"ShowCollections", &milvuspb.ShowCollectionsRequest{}, nil, errors.New("mock"), 0)
+	assert.Equal(t, "", info.User)
+	assert.Equal(t, "", info.Collection)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError.String(), info.Status)
+	assert.Equal(t, "mock", info.Error)
+}
+
+func TestAccessLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "access_log")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := &AccessLogConfig{
+		Format: "{{.Method}} {{.Collection}} {{.Status}}",
+		Sink:   AccessLogFileSink,
+		File:   log.FileLogConfig{Filename: path.Join(dir, "access.log"), MaxSize: 1},
+	}
+	logger, err := NewAccessLogger(context.Background(), cfg)
+	assert.Nil(t, err)
+
+	interceptor := AccessLogInterceptor(logger)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	_, err = interceptor(context.Background(), &milvuspb.DropCollectionRequest{CollectionName: "coll"},
+		&grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "DropCollection"}, handler)
+	assert.Nil(t, err)
+	// the internal methods are not logged
+	_, err = interceptor(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}, handler)
+	assert.Nil(t, err)
+	assert.Nil(t, logger.Close())
+
+	content, err := ioutil.ReadFile(cfg.File.Filename)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Equal(t, []string{"DropCollection coll Success"}, lines)
+
+	cfg.Format = "{{.Method"
+	_, err = NewAccessLogger(context.Background(), cfg)
+	assert.Error(t, err)
+
+	cfg.Format = ""
+	cfg.Sink = "kafka"
+	_, err = NewAccessLogger(context.Background(), cfg)
+	assert.Error(t, err)
+}
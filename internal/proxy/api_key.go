@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/apikey"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+const (
+	// DMLGroup is the group of the rpcs writing data
+	DMLGroup = apikey.PrivilegeDML
+	// DQLGroup is the group of the rpcs reading data
+	DQLGroup = apikey.PrivilegeDQL
+	// AdminGroup is the group of the other rpcs of the milvus service, such as ddl, index, load and metrics
+	AdminGroup = apikey.PrivilegeAdmin
+
+	// minApiKeyRefreshInterval limits the refreshes of the api keys triggered by the unknown keys
+	minApiKeyRefreshInterval = time.Second
+)
+
+var methodGroups = map[string]string{
+	"Insert":         DMLGroup,
+	"StreamInsert":   DMLGroup,
+	"Delete":         DMLGroup,
+	"Flush":          DMLGroup,
+	"Search":         DQLGroup,
+	"SearchStream":   DQLGroup,
+	"Query":          DQLGroup,
+	"QueryIterator":  DQLGroup,
+	"SearchIterator": DQLGroup,
+	"CalcDistance":   DQLGroup,
+}
+
+// MethodGroup returns the group of a milvus service rpc, or an empty string for the internal rpcs, the groups
+// are also the privileges of the api keys
+func MethodGroup(fullMethod string) string {
+	if !strings.HasPrefix(fullMethod, milvusServicePrefix) {
+		return ""
+	}
+	if group, ok := methodGroups[path.Base(fullMethod)]; ok {
+		return group
+	}
+	return AdminGroup
+}
+
+// rateLimiter is a token bucket refilled by rate tokens per second, which holds at most max(1, rate) tokens
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, now time.Time) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		tokens: math.Max(1, rate),
+		last:   now,
+	}
+}
+
+func (l *rateLimiter) setRate(rate float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = rate
+	l.tokens = math.Min(l.tokens, math.Max(1, rate))
+}
+
+func (l *rateLimiter) allow(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens = math.Min(math.Max(1, l.rate), l.tokens+elapsed*l.rate)
+		l.last = now
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+type apiKeyEntry struct {
+	info *etcdpb.ApiKeyInfo
+	// previous tells whether the key is the previous one of a rotated api key
+	previous bool
+}
+
+// apiKeyAuthenticator authenticates the api keys by their hashes listed from RootCoord, the other credentials are
+// authenticated by next. The keys are listed again once they are older than refreshInterval, or on an unknown key,
+// so the created keys are accepted at once and the dropped ones are rejected after refreshInterval at most
+type apiKeyAuthenticator struct {
+	next            Authenticator
+	list            func(ctx context.Context) ([]*etcdpb.ApiKeyInfo, error)
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]apiKeyEntry // hash -> key
+	limiters  map[string]*rateLimiter
+	refreshed time.Time
+}
+
+func newApiKeyAuthenticator(next Authenticator, list func(ctx context.Context) ([]*etcdpb.ApiKeyInfo, error),
+	refreshInterval time.Duration) *apiKeyAuthenticator {
+	return &apiKeyAuthenticator{
+		next:            next,
+		list:            list,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+		keys:            make(map[string]apiKeyEntry),
+		limiters:        make(map[string]*rateLimiter),
+	}
+}
+
+// NewApiKeyAuthenticator returns the Authenticator of the api keys created in RootCoord, the other credentials are
+// authenticated by next, or rejected if next is nil
+func (node *Proxy) NewApiKeyAuthenticator(next Authenticator) Authenticator {
+	node.apiKeyAuth = newApiKeyAuthenticator(next, func(ctx context.Context) ([]*etcdpb.ApiKeyInfo, error) {
+		resp, err := node.rootCoord.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ListApiKeys,
+				SourceID: Params.ProxyID,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.Status.Reason)
+		}
+		return resp.Keys, nil
+	}, Params.ApiKeyRefreshInterval)
+	return node.apiKeyAuth
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, cred *Credential) (string, error) {
+	if cred.ApiKey == "" {
+		if a.next == nil {
+			return "", errors.New("only the api keys are accepted")
+		}
+		return a.next.Authenticate(ctx, cred)
+	}
+
+	now := a.now()
+	entry, limiter, ok := a.lookup(ctx, apikey.Hash(cred.ApiKey), now)
+	if !ok || entry.previous && now.Unix() >= entry.info.PreviousExpireTime {
+		return "", errors.New("invalid api key")
+	}
+	group := MethodGroup(cred.Method)
+	if !hasPrivilege(entry.info.Privileges, group) {
+		return "", merr.Errorf(merr.ErrPermissionDenied, "api key %s has no %s privilege for %s",
+			entry.info.Name, group, path.Base(cred.Method))
+	}
+	if limiter != nil && !limiter.allow(now) {
+		return "", merr.Errorf(merr.ErrRateLimited, "api key %s exceeds its rate limit of %v requests per second",
+			entry.info.Name, entry.info.RateLimit)
+	}
+	return entry.info.Name, nil
+}
+
+// lookup returns the key of hash and its rate limiter, which is nil if the key is not rate limited
+func (a *apiKeyAuthenticator) lookup(ctx context.Context, hash string, now time.Time) (apiKeyEntry, *rateLimiter, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if now.Sub(a.refreshed) >= a.refreshInterval {
+		a.refresh(ctx, now)
+	}
+	entry, ok := a.keys[hash]
+	if !ok && now.Sub(a.refreshed) >= minApiKeyRefreshInterval {
+		a.refresh(ctx, now)
+		entry, ok = a.keys[hash]
+	}
+	if !ok {
+		return apiKeyEntry{}, nil, false
+	}
+	return entry, a.limiters[entry.info.Name], true
+}
+
+// refresh lists the keys, the listed keys are kept if the listing fails
+func (a *apiKeyAuthenticator) refresh(ctx context.Context, now time.Time) {
+	a.refreshed = now
+	infos, err := a.list(ctx)
+	if err != nil {
+		log.Warn("list api keys failed", zap.Error(err))
+		return
+	}
+	keys := make(map[string]apiKeyEntry, len(infos))
+	limiters := make(map[string]*rateLimiter)
+	for _, info := range infos {
+		keys[info.Hash] = apiKeyEntry{info: info}
+		if info.PreviousHash != "" {
+			keys[info.PreviousHash] = apiKeyEntry{info: info, previous: true}
+		}
+		if info.RateLimit <= 0 {
+			continue
+		}
+		// the rate limiters are kept across the refreshes, so that a refresh doesn't refill them
+		if limiter, ok := a.limiters[info.Name]; ok {
+			limiter.setRate(info.RateLimit)
+			limiters[info.Name] = limiter
+		} else {
+			limiters[info.Name] = newRateLimiter(info.RateLimit, now)
+		}
+	}
+	a.keys, a.limiters = keys, limiters
+}
+
+// invalidate makes the keys listed again by the next api key, it's called when the keys are changed by this proxy
+func (a *apiKeyAuthenticator) invalidate() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.refreshed = time.Time{}
+}
+
+func hasPrivilege(privileges []string, group string) bool {
+	for _, p := range privileges {
+		if p == group {
+			return true
+		}
+	}
+	return false
+}
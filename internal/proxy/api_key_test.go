@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/apikey"
+)
+
+func TestMethodGroup(t *testing.T) {
+	assert.Equal(t, DMLGroup, MethodGroup(milvusServicePrefix+"Insert"))
+	assert.Equal(t, DQLGroup, MethodGroup(milvusServicePrefix+"Search"))
+	assert.Equal(t, AdminGroup, MethodGroup(milvusServicePrefix+"CreateApiKey"))
+	assert.Equal(t, "", MethodGroup("/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"))
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(2, now)
+	assert.True(t, l.allow(now))
+	assert.True(t, l.allow(now))
+	assert.False(t, l.allow(now))
+	assert.True(t, l.allow(now.Add(500*time.Millisecond)))
+	assert.False(t, l.allow(now.Add(500*time.Millisecond)))
+
+	// the burst of the rates under 1 is 1
+	l = newRateLimiter(0.5, now)
+	assert.True(t, l.allow(now))
+	assert.False(t, l.allow(now.Add(time.Second)))
+	assert.True(t, l.allow(now.Add(2*time.Second)))
+}
+
+func TestApiKeyAuthenticator(t *testing.T) {
+	key, err := apikey.Generate()
+	assert.Nil(t, err)
+	previous, err := apikey.Generate()
+	assert.Nil(t, err)
+
+	now := time.Now()
+	infos := []*etcdpb.ApiKeyInfo{
+		{Name: "ingest", Hash: apikey.Hash(key), Privileges: []string{DMLGroup}, RateLimit: 1,
+			PreviousHash: apikey.Hash(previous), PreviousExpireTime: now.Unix() + 60},
+	}
+	var listErr error
+	lists := 0
+	list := func(ctx context.Context) ([]*etcdpb.ApiKeyInfo, error) {
+		lists++
+		return infos, listErr
+	}
+	next := &staticAuthenticator{users: map[string]string{"alice": "secret"}}
+	a := newApiKeyAuthenticator(next, list, time.Minute)
+	a.now = func() time.Time { return now }
+	insert := milvusServicePrefix + "Insert"
+
+	user, err := a.Authenticate(context.Background(), &Credential{ApiKey: key, Method: insert})
+	assert.Nil(t, err)
+	assert.Equal(t, "ingest", user)
+	assert.Equal(t, 1, lists)
+
+	// limited to 1 request per second
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: key, Method: insert})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	now = now.Add(time.Second)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: key, Method: milvusServicePrefix + "Search"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the previous key is accepted until the end of the grace period
+	now = now.Add(time.Second)
+	user, err = a.Authenticate(context.Background(), &Credential{ApiKey: previous, Method: insert})
+	assert.Nil(t, err)
+	assert.Equal(t, "ingest", user)
+	now = now.Add(time.Minute)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: previous, Method: insert})
+	assert.NotNil(t, err)
+
+	// the unknown keys trigger a refresh, at most once per second
+	lists = 0
+	now = now.Add(time.Second)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: "mvk_unknown", Method: insert})
+	assert.NotNil(t, err)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: "mvk_unknown", Method: insert})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, lists)
+
+	// the listed keys are kept if a refresh fails
+	listErr = errors.New("rootcoord unavailable")
+	infos = nil
+	a.invalidate()
+	now = now.Add(time.Second)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: key, Method: insert})
+	assert.Nil(t, err)
+
+	// the dropped key is rejected after a refresh
+	listErr = nil
+	a.invalidate()
+	now = now.Add(time.Second)
+	_, err = a.Authenticate(context.Background(), &Credential{ApiKey: key, Method: insert})
+	assert.NotNil(t, err)
+
+	// the other credentials are authenticated by next
+	user, err = a.Authenticate(context.Background(), &Credential{Username: "alice", Password: "secret", Method: insert})
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", user)
+	a.next = nil
+	_, err = a.Authenticate(context.Background(), &Credential{Username: "alice", Password: "secret", Method: insert})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,349 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// auditedMethods are the DDL and admin rpcs written to the audit log, the read-only ones are not audited
+var auditedMethods = map[string]bool{
+	"CreateCollection":  true,
+	"DropCollection":    true,
+	"AlterCollection":   true,
+	"UndropCollection":  true,
+	"LoadCollection":    true,
+	"ReleaseCollection": true,
+	"WarmupCollection":  true,
+	"CreatePartition":   true,
+	"DropPartition":     true,
+	"LoadPartitions":    true,
+	"ReleasePartitions": true,
+	"CreateIndex":       true,
+	"DropIndex":         true,
+	"CreateApiKey":      true,
+	"RotateApiKey":      true,
+	"DropApiKey":        true,
+	"QueryAuditLog":     true,
+}
+
+// AuditLogConfig is the config of the audit log of the DDL and admin operations
+type AuditLogConfig struct {
+	Enable bool
+	// MinioPath is shared by all the proxies, so that the audit log of all of them is queried by any of them
+	MinioPath      string
+	UploadInterval time.Duration
+	Minio          miniokv.Option
+}
+
+// auditStore is where the audit log is uploaded to, it's implemented by MinIOKV
+type auditStore interface {
+	Save(key, value string) error
+	LoadWithPrefix(key string) ([]string, []string, error)
+}
+
+// auditRecord is an entry of the audit log, saved as a line of json
+type auditRecord struct {
+	Chain      string `json:"chain"`
+	Seq        int64  `json:"seq"`
+	Time       int64  `json:"time"`
+	Actor      string `json:"actor"`
+	SourceIP   string `json:"sourceIP"`
+	Method     string `json:"method"`
+	Collection string `json:"collection,omitempty"`
+	Request    string `json:"request,omitempty"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+	PrevHash   string `json:"prevHash"`
+	Hash       string `json:"hash"`
+}
+
+// hash returns the hash of the record, which covers all the fields but Hash, including the hash of the previous record
+func (r *auditRecord) hash() string {
+	unhashed := *r
+	unhashed.Hash = ""
+	data, _ := json.Marshal(&unhashed)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *auditRecord) toEntry() *milvuspb.AuditEntry {
+	return &milvuspb.AuditEntry{
+		Chain:          r.Chain,
+		Seq:            r.Seq,
+		Time:           r.Time,
+		Actor:          r.Actor,
+		SourceIp:       r.SourceIP,
+		Method:         r.Method,
+		CollectionName: r.Collection,
+		Request:        r.Request,
+		Status:         r.Status,
+		Reason:         r.Reason,
+		PrevHash:       r.PrevHash,
+		Hash:           r.Hash,
+	}
+}
+
+// newAuditRecord collects the audit record of a finished request
+func newAuditRecord(ctx context.Context, fullMethod string, req interface{}, resp interface{}, err error) *auditRecord {
+	info := newAccessInfo(ctx, fullMethod, req, resp, err, 0)
+	r := &auditRecord{
+		Time:       time.Now().UnixNano() / int64(time.Millisecond),
+		Actor:      info.User,
+		SourceIP:   info.RemoteAddr,
+		Method:     info.Method,
+		Collection: info.Collection,
+		Status:     info.Status,
+		Reason:     info.Error,
+	}
+	if host, _, err := net.SplitHostPort(info.RemoteAddr); err == nil {
+		r.SourceIP = host
+	}
+	if m, ok := req.(proto.Message); ok {
+		r.Request = proto.CompactTextString(m)
+	}
+	return r
+}
+
+// AuditLogger chains the audit records of the proxy by their hashes and uploads them as immutable objects, so that
+// a modified, removed or reordered record breaks the chain. The records failed to upload are kept and uploaded again
+type AuditLogger struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	store  auditStore
+	prefix string
+	chain  string
+
+	mu       sync.Mutex
+	seq      int64
+	lastHash string
+	pending  []*auditRecord
+}
+
+// NewAuditLogger returns an audit logger uploading the audit log to the minio of the config
+func NewAuditLogger(ctx context.Context, cfg *AuditLogConfig) (*AuditLogger, error) {
+	kv, err := miniokv.NewMinIOKV(ctx, &cfg.Minio)
+	if err != nil {
+		return nil, err
+	}
+	return newAuditLogger(ctx, kv, cfg.MinioPath, cfg.UploadInterval)
+}
+
+func newAuditLogger(ctx context.Context, store auditStore, prefix string, interval time.Duration) (*AuditLogger, error) {
+	// the chain of a proxy starts when it starts, it's unique across the restarts and the proxies
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	ctx1, cancel := context.WithCancel(ctx)
+	l := &AuditLogger{
+		ctx:    ctx1,
+		cancel: cancel,
+		store:  store,
+		prefix: prefix,
+		chain:  time.Now().Format("20060102150405") + "-" + hex.EncodeToString(b),
+	}
+	l.wg.Add(1)
+	go l.uploadLoop(interval)
+	return l, nil
+}
+
+// Log appends a record to the chain, it's uploaded by the next upload
+func (l *AuditLogger) Log(r *auditRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r.Chain = l.chain
+	r.Seq = l.seq
+	r.PrevHash = l.lastHash
+	r.Hash = r.hash()
+	l.seq++
+	l.lastHash = r.Hash
+	l.pending = append(l.pending, r)
+}
+
+// upload must be called with the lock held, an object is named by the chain and the seq of its first record
+func (l *AuditLogger) upload() error {
+	if len(l.pending) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, r := range l.pending {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	key := path.Join(l.prefix, fmt.Sprintf("%s-%020d.log", l.chain, l.pending[0].Seq))
+	if err := l.store.Save(key, buf.String()); err != nil {
+		return err
+	}
+	l.pending = nil
+	return nil
+}
+
+func (l *AuditLogger) uploadLoop(interval time.Duration) {
+	defer l.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.ctx.Done():
+			log.Debug("Proxy audit log upload loop exit")
+			return
+		case <-ticker.C:
+			l.mu.Lock()
+			if err := l.upload(); err != nil {
+				log.Warn("upload audit log failed", zap.Int("pending", len(l.pending)), zap.Error(err))
+			}
+			l.mu.Unlock()
+		}
+	}
+}
+
+// Close uploads the pending records
+func (l *AuditLogger) Close() error {
+	l.cancel()
+	l.wg.Wait()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.upload()
+}
+
+// Query returns the records matching req ordered by time, and the integrity errors found by verifying the chains.
+// The pending records of this proxy are uploaded first, the ones of the other proxies are returned once uploaded
+func (l *AuditLogger) Query(req *milvuspb.QueryAuditLogRequest) ([]*milvuspb.AuditEntry, []string, error) {
+	l.mu.Lock()
+	err := l.upload()
+	ownSeq, ownHash := l.seq, l.lastHash
+	l.mu.Unlock()
+	if err != nil {
+		return nil, nil, fmt.Errorf("upload audit log failed: %w", err)
+	}
+
+	keys, values, err := l.store.LoadWithPrefix(l.prefix + "/")
+	if err != nil {
+		return nil, nil, err
+	}
+	integrityErrors := make([]string, 0)
+	chains := make(map[string][]*auditRecord)
+	for i, value := range values {
+		for j, line := range strings.Split(strings.TrimSuffix(value, "\n"), "\n") {
+			r := &auditRecord{}
+			if err := json.Unmarshal([]byte(line), r); err != nil {
+				integrityErrors = append(integrityErrors, fmt.Sprintf("%s: malformed record at line %d", keys[i], j+1))
+				continue
+			}
+			if j == 0 && path.Base(keys[i]) != fmt.Sprintf("%s-%020d.log", r.Chain, r.Seq) {
+				integrityErrors = append(integrityErrors, fmt.Sprintf("%s: doesn't start with its first record", keys[i]))
+			}
+			chains[r.Chain] = append(chains[r.Chain], r)
+		}
+	}
+
+	records := make([]*auditRecord, 0)
+	for chain, rs := range chains {
+		integrityErrors = append(integrityErrors, verifyAuditChain(chain, rs)...)
+		// the records at the end of the chain of this proxy are known
+		if chain == l.chain && (len(rs) == 0 || rs[len(rs)-1].Seq+1 != ownSeq || rs[len(rs)-1].Hash != ownHash) {
+			integrityErrors = append(integrityErrors, fmt.Sprintf("chain %s: the latest records are missing", chain))
+		}
+		for _, r := range rs {
+			if matchAuditRecord(r, req) {
+				records = append(records, r)
+			}
+		}
+	}
+	sort.Strings(integrityErrors)
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Time != records[j].Time {
+			return records[i].Time < records[j].Time
+		}
+		if records[i].Chain != records[j].Chain {
+			return records[i].Chain < records[j].Chain
+		}
+		return records[i].Seq < records[j].Seq
+	})
+	if req.Limit > 0 && int64(len(records)) > req.Limit {
+		records = records[:req.Limit]
+	}
+	entries := make([]*milvuspb.AuditEntry, 0, len(records))
+	for _, r := range records {
+		entries = append(entries, r.toEntry())
+	}
+	return entries, integrityErrors, nil
+}
+
+// verifyAuditChain sorts the records of a chain by seq and returns the breaks of the chain
+func verifyAuditChain(chain string, records []*auditRecord) []string {
+	sort.Slice(records, func(i, j int) bool { return records[i].Seq < records[j].Seq })
+	errs := make([]string, 0)
+	prevHash := ""
+	for i, r := range records {
+		if r.Seq != int64(i) {
+			errs = append(errs, fmt.Sprintf("chain %s: records before seq %d are missing or duplicated", chain, r.Seq))
+			return errs
+		}
+		if r.PrevHash != prevHash {
+			errs = append(errs, fmt.Sprintf("chain %s: record %d doesn't follow the previous record", chain, r.Seq))
+		}
+		if r.Hash != r.hash() {
+			errs = append(errs, fmt.Sprintf("chain %s: record %d is modified", chain, r.Seq))
+		}
+		prevHash = r.Hash
+	}
+	return errs
+}
+
+func matchAuditRecord(r *auditRecord, req *milvuspb.QueryAuditLogRequest) bool {
+	return (req.StartTime == 0 || r.Time >= req.StartTime) &&
+		(req.EndTime == 0 || r.Time < req.EndTime) &&
+		(req.Actor == "" || r.Actor == req.Actor) &&
+		(req.Method == "" || r.Method == req.Method) &&
+		(req.CollectionName == "" || r.Collection == req.CollectionName)
+}
+
+// AuditLogInterceptor returns a grpc interceptor which writes the DDL and admin requests to the audit log, it's
+// placed after the authentication, so that the actor is the authenticated user
+func AuditLogInterceptor(logger *AuditLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, milvusServicePrefix) || !auditedMethods[path.Base(info.FullMethod)] {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		logger.Log(newAuditRecord(ctx, info.FullMethod, req, resp, err))
+		return resp, err
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// memAuditStore keeps the objects in memory, Save fails if err is set
+type memAuditStore struct {
+	mu      sync.Mutex
+	objects map[string]string
+	err     error
+}
+
+func (m *memAuditStore) Save(key, value string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.objects[key] = value
+	return nil
+}
+
+func (m *memAuditStore) LoadWithPrefix(key string) ([]string, []string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0)
+	for k := range m.objects {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m.objects[k])
+	}
+	return keys, values, nil
+}
+
+func TestAuditLogger(t *testing.T) {
+	store := &memAuditStore{objects: make(map[string]string)}
+	logger, err := newAuditLogger(context.Background(), store, "audit_log", time.Hour)
+	assert.Nil(t, err)
+
+	interceptor := AuditLogInterceptor(logger)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessLogUserKey, "alice"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 40000}})
+	_, err = interceptor(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"},
+		&grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "DropCollection"}, handler)
+	assert.Nil(t, err)
+	// the read-only and internal methods are not audited
+	_, err = interceptor(ctx, &milvuspb.HasCollectionRequest{CollectionName: "coll"},
+		&grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "HasCollection"}, handler)
+	assert.Nil(t, err)
+	_, err = interceptor(ctx, nil,
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}, handler)
+	assert.Nil(t, err)
+	_, err = interceptor(context.Background(), &milvuspb.CreateIndexRequest{CollectionName: "coll2"},
+		&grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "CreateIndex"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("mock")
+		})
+	assert.NotNil(t, err)
+
+	// the failed uploads are retried
+	store.err = errors.New("mock")
+	_, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.NotNil(t, err)
+	store.err = nil
+
+	entries, integrityErrors, err := logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, integrityErrors)
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, "alice", entries[0].Actor)
+	assert.Equal(t, "10.0.0.1", entries[0].SourceIp)
+	assert.Equal(t, "DropCollection", entries[0].Method)
+	assert.Equal(t, "coll", entries[0].CollectionName)
+	assert.Equal(t, commonpb.ErrorCode_Success.String(), entries[0].Status)
+	assert.Contains(t, entries[0].Request, `collection_name:"coll"`)
+	assert.Equal(t, "", entries[0].PrevHash)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError.String(), entries[1].Status)
+	assert.Equal(t, "mock", entries[1].Reason)
+
+	entries, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{Actor: "alice"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	entries, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{CollectionName: "coll2", Method: "CreateIndex"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	entries, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{EndTime: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(entries))
+	entries, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "DropCollection", entries[0].Method)
+
+	logger.Log(&auditRecord{Method: "CreateCollection"})
+	assert.Nil(t, logger.Close())
+	assert.Equal(t, 2, len(store.objects))
+
+	// another proxy queries the audit log of both
+	other, err := newAuditLogger(context.Background(), store, "audit_log", time.Hour)
+	assert.Nil(t, err)
+	defer other.Close()
+	entries, integrityErrors, err = other.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, integrityErrors)
+	assert.Equal(t, 3, len(entries))
+}
+
+func TestAuditLoggerIntegrity(t *testing.T) {
+	store := &memAuditStore{objects: make(map[string]string)}
+	logger, err := newAuditLogger(context.Background(), store, "audit_log", time.Hour)
+	assert.Nil(t, err)
+	defer logger.Close()
+	for i := 0; i < 3; i++ {
+		logger.Log(&auditRecord{Actor: "alice", Method: "DropCollection"})
+		_, _, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+		assert.Nil(t, err)
+	}
+	keys, values, err := store.LoadWithPrefix("audit_log/")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(keys))
+
+	// modified
+	store.objects[keys[1]] = strings.Replace(values[1], "alice", "bob", 1)
+	_, integrityErrors, err := logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(integrityErrors))
+	assert.Contains(t, integrityErrors[0], "record 1 is modified")
+	store.objects[keys[1]] = values[1]
+
+	// removed
+	delete(store.objects, keys[1])
+	_, integrityErrors, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(integrityErrors))
+	assert.Contains(t, integrityErrors[0], "missing")
+	store.objects[keys[1]] = values[1]
+
+	// the latest records of this proxy are removed
+	delete(store.objects, keys[2])
+	_, integrityErrors, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(integrityErrors))
+	assert.Contains(t, integrityErrors[0], "latest records are missing")
+	store.objects[keys[2]] = values[2]
+
+	// moved
+	store.objects[keys[0]] = values[1]
+	_, integrityErrors, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, integrityErrors)
+	store.objects[keys[0]] = values[0]
+
+	store.objects[keys[0]] = "{"
+	_, integrityErrors, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, integrityErrors)
+	store.objects[keys[0]] = values[0]
+
+	_, integrityErrors, err = logger.Query(&milvuspb.QueryAuditLogRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, integrityErrors)
+}
@@ -0,0 +1,441 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"path"
+	"plugin"
+	"strings"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/apikey"
+)
+
+const (
+	// AuthenticationStatic authenticates the users by the passwords of proxy.authentication.static.users
+	AuthenticationStatic = "static"
+	// AuthenticationJWT authenticates the users by the bearer tokens signed by the configured key,
+	// such as the id tokens issued by an OIDC provider
+	AuthenticationJWT = "jwt"
+	// AuthenticationPlugin authenticates the users by a go plugin, such as the one binding to LDAP
+	AuthenticationPlugin = "plugin"
+
+	// authorizationKey is the grpc metadata key of the credential, which is `Basic base64(user:password)`,
+	// `Bearer token` or `ApiKey key`
+	authorizationKey = "authorization"
+
+	// pluginAuthenticateSymbol is the function authenticating the credentials exported by the plugins, of type
+	// func(ctx context.Context, username, password, token string) (string, error)
+	pluginAuthenticateSymbol = "Authenticate"
+	// pluginInitSymbol is the optional function initializing the plugins with the plugin params, of type
+	// func(params map[string]string) error
+	pluginInitSymbol = "Init"
+)
+
+var errNoCredential = errors.New("no credential")
+
+// AuthenticationConfig is the config of the authentication of the client requests
+type AuthenticationConfig struct {
+	Enable bool
+	Type   string
+	// StaticUsers maps the user names to their passwords
+	StaticUsers map[string]string
+	JWT         JWTConfig
+	PluginPath  string
+	// PluginParams are passed to the Init of the plugin
+	PluginParams map[string]string
+}
+
+// JWTConfig is the config of the validation of the bearer tokens, the tokens are signed by Secret with HS256,
+// HS384 or HS512, or by the private key of PublicKeyFile with RS256, RS384 or RS512
+type JWTConfig struct {
+	Secret        string
+	PublicKeyFile string
+	// the iss and aud claims of the tokens are not checked if empty
+	Issuer   string
+	Audience string
+	// UserClaim is the claim of the user name
+	UserClaim string
+}
+
+// Credential is the credential sent by a client in the grpc metadata
+type Credential struct {
+	Username string
+	Password string
+	Token    string
+	ApiKey   string
+	// Method is the full name of the rpc of the request
+	Method string
+}
+
+// Authenticator authenticates the credentials of the client requests
+type Authenticator interface {
+	// Authenticate returns the name of the user of the credential, or an error if the credential is not accepted
+	Authenticate(ctx context.Context, cred *Credential) (string, error)
+}
+
+// NewAuthenticator returns the Authenticator of the type of cfg
+func NewAuthenticator(cfg *AuthenticationConfig) (Authenticator, error) {
+	switch cfg.Type {
+	case AuthenticationStatic:
+		return &staticAuthenticator{users: cfg.StaticUsers}, nil
+	case AuthenticationJWT:
+		return newJWTAuthenticator(&cfg.JWT)
+	case AuthenticationPlugin:
+		return newPluginAuthenticator(cfg.PluginPath, cfg.PluginParams)
+	default:
+		return nil, fmt.Errorf("unknown authentication type %s", cfg.Type)
+	}
+}
+
+// credentialFromContext parses the credential in the grpc metadata of ctx
+func credentialFromContext(ctx context.Context) (*Credential, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errNoCredential
+	}
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return nil, errNoCredential
+	}
+	scheme, value := values[0], ""
+	if i := strings.IndexByte(scheme, ' '); i > 0 {
+		scheme, value = scheme[:i], strings.TrimSpace(scheme[i+1:])
+	}
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("malformed basic credential: %w", err)
+		}
+		i := strings.IndexByte(string(decoded), ':')
+		if i < 0 {
+			return nil, errors.New("malformed basic credential: no password")
+		}
+		return &Credential{Username: string(decoded[:i]), Password: string(decoded[i+1:])}, nil
+	case "bearer":
+		if value == "" {
+			return nil, errors.New("empty bearer token")
+		}
+		// the api keys are also accepted as bearer tokens, so that the clients only supporting the tokens use them
+		if apikey.IsKey(value) {
+			return &Credential{ApiKey: value}, nil
+		}
+		return &Credential{Token: value}, nil
+	case "apikey":
+		if value == "" {
+			return nil, errors.New("empty api key")
+		}
+		return &Credential{ApiKey: value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported authorization scheme %s", scheme)
+	}
+}
+
+// authenticate authenticates the credential of the client request, the authenticated user replaces the user sent
+// by the client in the metadata of the returned context, so that it's the user recorded by the access log
+func authenticate(ctx context.Context, auth Authenticator, fullMethod string) (context.Context, error) {
+	cred, err := credentialFromContext(ctx)
+	if err == nil {
+		cred.Method = fullMethod
+		var user string
+		if user, err = auth.Authenticate(ctx, cred); err == nil {
+			md, _ := metadata.FromIncomingContext(ctx)
+			md = md.Copy()
+			md.Set(accessLogUserKey, user)
+			return metadata.NewIncomingContext(ctx, md), nil
+		}
+	}
+	remoteAddr := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	log.Warn("proxy authentication failed", zap.String("method", path.Base(fullMethod)),
+		zap.String("remoteAddr", remoteAddr), zap.Error(err))
+	// such as the permission denied and the exhausted rate limit of the api keys
+	if _, ok := status.FromError(err); ok {
+		return nil, err
+	}
+	return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
+}
+
+// AuthenticationInterceptor returns a grpc interceptor which rejects the client requests not authenticated by auth,
+// the requests of the internal components are not authenticated
+func AuthenticationInterceptor(auth Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, auth, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthenticationStreamInterceptor is the AuthenticationInterceptor of the streaming rpcs
+func AuthenticationStreamInterceptor(auth Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), auth, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+type staticAuthenticator struct {
+	users map[string]string
+}
+
+func (a *staticAuthenticator) Authenticate(ctx context.Context, cred *Credential) (string, error) {
+	if cred.Username == "" {
+		return "", errors.New("the static authentication requires a basic credential")
+	}
+	password, ok := a.users[cred.Username]
+	if !ok || subtle.ConstantTimeCompare([]byte(password), []byte(cred.Password)) != 1 {
+		return "", errors.New("invalid user name or password")
+	}
+	return cred.Username, nil
+}
+
+type jwtAuthenticator struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	issuer    string
+	audience  string
+	userClaim string
+}
+
+func newJWTAuthenticator(cfg *JWTConfig) (*jwtAuthenticator, error) {
+	a := &jwtAuthenticator{
+		secret:    []byte(cfg.Secret),
+		issuer:    cfg.Issuer,
+		audience:  cfg.Audience,
+		userClaim: cfg.UserClaim,
+	}
+	if a.userClaim == "" {
+		a.userClaim = "sub"
+	}
+	if cfg.PublicKeyFile != "" {
+		data, err := ioutil.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if a.publicKey, err = parseRSAPublicKey(data); err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", cfg.PublicKeyFile, err)
+		}
+	}
+	if len(a.secret) == 0 && a.publicKey == nil {
+		return nil, errors.New("the jwt authentication requires a secret or a public key")
+	}
+	return a, nil
+}
+
+// parseRSAPublicKey parses the PEM encoded PKIX public key or certificate
+func parseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	var key interface{}
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = cert.PublicKey
+	} else {
+		var err error
+		if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, err
+		}
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not a rsa public key")
+	}
+	return rsaKey, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(ctx context.Context, cred *Credential) (string, error) {
+	if cred.Token == "" {
+		return "", errors.New("the jwt authentication requires a bearer token")
+	}
+	parts := strings.Split(cred.Token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeTokenPart(parts[0], &header); err != nil {
+		return "", err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed token signature: %w", err)
+	}
+	if err = a.verify(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return "", err
+	}
+
+	claims := make(map[string]interface{})
+	if err = decodeTokenPart(parts[1], &claims); err != nil {
+		return "", err
+	}
+	now := float64(time.Now().Unix())
+	if exp, ok := claims["exp"].(float64); ok && now >= exp {
+		return "", errors.New("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now < nbf {
+		return "", errors.New("token not valid yet")
+	}
+	if a.issuer != "" && claims["iss"] != a.issuer {
+		return "", fmt.Errorf("unexpected token issuer %v", claims["iss"])
+	}
+	if a.audience != "" && !hasAudience(claims["aud"], a.audience) {
+		return "", fmt.Errorf("unexpected token audience %v", claims["aud"])
+	}
+	user, ok := claims[a.userClaim].(string)
+	if !ok || user == "" {
+		return "", fmt.Errorf("no user claim %s in token", a.userClaim)
+	}
+	return user, nil
+}
+
+func (a *jwtAuthenticator) verify(alg string, signed string, signature []byte) error {
+	var hashFn func() hash.Hash
+	var cryptoHash crypto.Hash
+	switch alg {
+	case "HS256", "RS256":
+		hashFn, cryptoHash = sha256.New, crypto.SHA256
+	case "HS384", "RS384":
+		hashFn, cryptoHash = sha512.New384, crypto.SHA384
+	case "HS512", "RS512":
+		hashFn, cryptoHash = sha512.New, crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported token algorithm %s", alg)
+	}
+	if strings.HasPrefix(alg, "HS") {
+		if len(a.secret) == 0 {
+			return fmt.Errorf("no secret to verify the %s token", alg)
+		}
+		mac := hmac.New(hashFn, a.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	}
+	if a.publicKey == nil {
+		return fmt.Errorf("no public key to verify the %s token", alg)
+	}
+	h := hashFn()
+	h.Write([]byte(signed))
+	if err := rsa.VerifyPKCS1v15(a.publicKey, cryptoHash, h.Sum(nil), signature); err != nil {
+		return errors.New("invalid token signature")
+	}
+	return nil
+}
+
+func decodeTokenPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return fmt.Errorf("malformed token: %w", err)
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("malformed token: %w", err)
+	}
+	return nil
+}
+
+// hasAudience tells whether the aud claim, which is a string or an array of strings, has the audience
+func hasAudience(aud interface{}, audience string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == audience
+	case []interface{}:
+		for _, a := range v {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type pluginAuthenticator struct {
+	authenticate func(ctx context.Context, username, password, token string) (string, error)
+}
+
+// newPluginAuthenticator opens the go plugin of path, the plugin is built with the same go version and the same
+// versions of the shared dependencies as the proxy
+func newPluginAuthenticator(path string, params map[string]string) (*pluginAuthenticator, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if sym, err := p.Lookup(pluginInitSymbol); err == nil {
+		initFn, ok := sym.(func(map[string]string) error)
+		if !ok {
+			return nil, fmt.Errorf("%s of plugin %s is not a func(map[string]string) error", pluginInitSymbol, path)
+		}
+		if err = initFn(params); err != nil {
+			return nil, fmt.Errorf("init plugin %s failed: %w", path, err)
+		}
+	}
+	sym, err := p.Lookup(pluginAuthenticateSymbol)
+	if err != nil {
+		return nil, err
+	}
+	authenticate, ok := sym.(func(context.Context, string, string, string) (string, error))
+	if !ok {
+		return nil, fmt.Errorf("%s of plugin %s is not a func(context.Context, string, string, string) (string, error)",
+			pluginAuthenticateSymbol, path)
+	}
+	return &pluginAuthenticator{authenticate: authenticate}, nil
+}
+
+func (a *pluginAuthenticator) Authenticate(ctx context.Context, cred *Credential) (string, error) {
+	return a.authenticate(ctx, cred.Username, cred.Password, cred.Token)
+}
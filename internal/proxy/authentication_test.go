@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func basicCredential(user, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+}
+
+func signToken(t *testing.T, alg string, claims map[string]interface{}, sign func(signed []byte) []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	assert.Nil(t, err)
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(signed)))
+}
+
+func TestCredentialFromContext(t *testing.T) {
+	_, err := credentialFromContext(context.Background())
+	assert.Equal(t, errNoCredential, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, basicCredential("alice", "a:b")))
+	cred, err := credentialFromContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, &Credential{Username: "alice", Password: "a:b"}, cred)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer abc.def.ghi"))
+	cred, err = credentialFromContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, &Credential{Token: "abc.def.ghi"}, cred)
+
+	for _, value := range []string{"ApiKey mvk_abc", "Bearer mvk_abc"} {
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, value))
+		cred, err = credentialFromContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, &Credential{ApiKey: "mvk_abc"}, cred)
+	}
+
+	for _, value := range []string{"Basic !!!", "Basic " + base64.StdEncoding.EncodeToString([]byte("alice")), "Bearer", "ApiKey", "Digest abc"} {
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, value))
+		_, err = credentialFromContext(ctx)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestStaticAuthenticator(t *testing.T) {
+	auth, err := NewAuthenticator(&AuthenticationConfig{
+		Type:        AuthenticationStatic,
+		StaticUsers: map[string]string{"alice": "secret"},
+	})
+	assert.Nil(t, err)
+
+	user, err := auth.Authenticate(context.Background(), &Credential{Username: "alice", Password: "secret"})
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", user)
+
+	_, err = auth.Authenticate(context.Background(), &Credential{Username: "alice", Password: "wrong"})
+	assert.NotNil(t, err)
+	_, err = auth.Authenticate(context.Background(), &Credential{Username: "bob", Password: "secret"})
+	assert.NotNil(t, err)
+	_, err = auth.Authenticate(context.Background(), &Credential{Token: "abc"})
+	assert.NotNil(t, err)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	_, err := NewAuthenticator(&AuthenticationConfig{Type: AuthenticationJWT})
+	assert.NotNil(t, err)
+
+	secret := []byte("jwt-secret")
+	hs256 := func(signed []byte) []byte {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(signed)
+		return mac.Sum(nil)
+	}
+	auth, err := NewAuthenticator(&AuthenticationConfig{
+		Type: AuthenticationJWT,
+		JWT:  JWTConfig{Secret: string(secret), Issuer: "https://idp", Audience: "milvus"},
+	})
+	assert.Nil(t, err)
+
+	now := time.Now().Unix()
+	token := signToken(t, "HS256", map[string]interface{}{
+		"sub": "alice",
+		"iss": "https://idp",
+		"aud": []string{"milvus", "other"},
+		"exp": now + 60,
+	}, hs256)
+	user, err := auth.Authenticate(context.Background(), &Credential{Token: token})
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", user)
+
+	invalid := []map[string]interface{}{
+		{"sub": "alice", "iss": "https://idp", "aud": "milvus", "exp": now - 1},
+		{"sub": "alice", "iss": "https://idp", "aud": "milvus", "nbf": now + 60},
+		{"sub": "alice", "iss": "https://other", "aud": "milvus"},
+		{"sub": "alice", "iss": "https://idp", "aud": "other"},
+		{"iss": "https://idp", "aud": "milvus"},
+	}
+	for _, claims := range invalid {
+		_, err = auth.Authenticate(context.Background(), &Credential{Token: signToken(t, "HS256", claims, hs256)})
+		assert.NotNil(t, err, claims)
+	}
+
+	// the tampered tokens and the ones of unsupported algorithms are rejected
+	_, err = auth.Authenticate(context.Background(), &Credential{Token: token + "x"})
+	assert.NotNil(t, err)
+	_, err = auth.Authenticate(context.Background(), &Credential{Token: signToken(t, "none",
+		map[string]interface{}{"sub": "alice"}, func([]byte) []byte { return nil })})
+	assert.NotNil(t, err)
+	_, err = auth.Authenticate(context.Background(), &Credential{Username: "alice", Password: "secret"})
+	assert.NotNil(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.Nil(t, err)
+	dir, err := ioutil.TempDir("", "proxy_authentication")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	keyFile := path.Join(dir, "public.pem")
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	auth, err = NewAuthenticator(&AuthenticationConfig{
+		Type: AuthenticationJWT,
+		JWT:  JWTConfig{PublicKeyFile: keyFile, UserClaim: "email"},
+	})
+	assert.Nil(t, err)
+	rs256 := func(signed []byte) []byte {
+		digest := sha256.Sum256(signed)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		assert.Nil(t, err)
+		return signature
+	}
+	user, err = auth.Authenticate(context.Background(), &Credential{Token: signToken(t, "RS256",
+		map[string]interface{}{"sub": "1234", "email": "alice@example.com"}, rs256)})
+	assert.Nil(t, err)
+	assert.Equal(t, "alice@example.com", user)
+
+	// no secret to verify the HS256 tokens
+	_, err = auth.Authenticate(context.Background(), &Credential{Token: signToken(t, "HS256",
+		map[string]interface{}{"email": "alice@example.com"}, hs256)})
+	assert.NotNil(t, err)
+}
+
+func TestPluginAuthenticator(t *testing.T) {
+	_, err := NewAuthenticator(&AuthenticationConfig{Type: AuthenticationPlugin, PluginPath: "/not/exist.so"})
+	assert.NotNil(t, err)
+
+	_, err = NewAuthenticator(&AuthenticationConfig{Type: "ldap"})
+	assert.NotNil(t, err)
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	auth := &staticAuthenticator{users: map[string]string{"alice": "secret"}}
+	interceptor := AuthenticationInterceptor(auth)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return md.Get(accessLogUserKey), nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "Search"}
+
+	md := metadata.Pairs(authorizationKey, basicCredential("alice", "secret"), accessLogUserKey, "mallory")
+	resp, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice"}, resp)
+
+	md = metadata.Pairs(authorizationKey, basicCredential("alice", "wrong"))
+	_, err = interceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the requests of the internal components are not authenticated
+	internal := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}
+	_, err = interceptor(context.Background(), nil, internal, handler)
+	assert.Nil(t, err)
+}
@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 )
 
@@ -31,11 +33,43 @@ type channelsTimeTicker interface {
 	removePChan(pchan pChan) error
 	getLastTick(pchan pChan) (Timestamp, error)
 	getMinTsStatistics() (map[pChan]Timestamp, error)
+	getTimeTickStatistics() map[pChan]pChanTimeTick
+}
+
+// pChanTimeTick is the time tick statistics of a pchan
+type pChanTimeTick struct {
+	minTs           Timestamp // the time tick of the pchan sent to rootcoord
+	lastAdvanceTime time.Time // when minTs advanced last time
+	pendingMinTs    Timestamp // the min ts of the dml tasks on the pchan which hold minTs, 0 if there isn't any
+	stalled         bool      // minTs hasn't advanced for the stall threshold
+}
+
+// timeTickSkew returns the difference between the max and the min time ticks of the pchans which have ticked
+func timeTickSkew(tss map[pChan]Timestamp) time.Duration {
+	var minTs, maxTs Timestamp
+	for _, ts := range tss {
+		if ts == 0 {
+			continue
+		}
+		if minTs == 0 || ts < minTs {
+			minTs = ts
+		}
+		if ts > maxTs {
+			maxTs = ts
+		}
+	}
+	if minTs == 0 {
+		return 0
+	}
+	minPhysical, _ := tsoutil.ParseTS(minTs)
+	maxPhysical, _ := tsoutil.ParseTS(maxTs)
+	return maxPhysical.Sub(minPhysical)
 }
 
 type channelsTimeTickerImpl struct {
-	interval          time.Duration       // interval to synchronize
-	minTsStatistics   map[pChan]Timestamp // pchan -> min Timestamp
+	interval          time.Duration // interval to synchronize
+	stallThreshold    time.Duration // the pchans not advanced for it are stalled, not detected if 0
+	minTsStatistics   map[pChan]*pChanTimeTick
 	statisticsMtx     sync.RWMutex
 	getStatisticsFunc getPChanStatisticsFuncType
 	tso               tsoAllocator
@@ -52,20 +86,71 @@ func (ticker *channelsTimeTickerImpl) getMinTsStatistics() (map[pChan]Timestamp,
 
 	ret := make(map[pChan]Timestamp)
 	for k, v := range ticker.minTsStatistics {
-		if v > 0 {
-			ret[k] = v
+		if v.minTs > 0 {
+			ret[k] = v.minTs
 		}
 	}
 	return ret, nil
 }
 
+// getTimeTickStatistics returns the time tick statistics of all the pchans, including the ones never ticked
+func (ticker *channelsTimeTickerImpl) getTimeTickStatistics() map[pChan]pChanTimeTick {
+	ticker.statisticsMtx.RLock()
+	defer ticker.statisticsMtx.RUnlock()
+
+	ret := make(map[pChan]pChanTimeTick, len(ticker.minTsStatistics))
+	for k, v := range ticker.minTsStatistics {
+		ret[k] = *v
+	}
+	return ret
+}
+
 func (ticker *channelsTimeTickerImpl) initStatistics() {
 	ticker.statisticsMtx.Lock()
 	defer ticker.statisticsMtx.Unlock()
 
+	now := time.Now()
 	for pchan := range ticker.minTsStatistics {
-		ticker.minTsStatistics[pchan] = 0
+		ticker.minTsStatistics[pchan] = &pChanTimeTick{lastAdvanceTime: now}
+	}
+}
+
+// updateMinTs updates the time tick of pchan, the caller holds statisticsMtx
+func (ticker *channelsTimeTickerImpl) updateMinTs(pchan pChan, minTs Timestamp, pendingMinTs Timestamp, now time.Time) {
+	stat := ticker.minTsStatistics[pchan]
+	if minTs != stat.minTs {
+		stat.minTs = minTs
+		stat.lastAdvanceTime = now
+	}
+	stat.pendingMinTs = pendingMinTs
+}
+
+// detectStalls marks the pchans whose time tick hasn't advanced for the stall threshold stalled, and updates the
+// metrics of the stalled pchans and the skew, the caller holds statisticsMtx
+func (ticker *channelsTimeTickerImpl) detectStalls(now time.Time) {
+	numStalled := 0
+	tss := make(map[pChan]Timestamp, len(ticker.minTsStatistics))
+	for pchan, stat := range ticker.minTsStatistics {
+		tss[pchan] = stat.minTs
+		stalled := ticker.stallThreshold > 0 && now.Sub(stat.lastAdvanceTime) > ticker.stallThreshold
+		if stalled && !stat.stalled {
+			log.Warn("the time tick of the dml channel is stalled",
+				zap.String("pchan", pchan),
+				zap.Uint64("minTs", stat.minTs),
+				zap.Uint64("pendingMinTs", stat.pendingMinTs),
+				zap.Time("lastAdvanceTime", stat.lastAdvanceTime))
+		} else if !stalled && stat.stalled {
+			log.Info("the time tick of the dml channel advances again",
+				zap.String("pchan", pchan),
+				zap.Uint64("minTs", stat.minTs))
+		}
+		stat.stalled = stalled
+		if stalled {
+			numStalled++
+		}
 	}
+	metrics.ProxyStalledDmlChannelNum.Set(float64(numStalled))
+	metrics.ProxyDmlChannelTimeTickSkew.Set(float64(timeTickSkew(tss).Milliseconds()))
 }
 
 func (ticker *channelsTimeTickerImpl) initCurrents(current Timestamp) {
@@ -96,24 +181,29 @@ func (ticker *channelsTimeTickerImpl) tick() error {
 	ticker.currentsMtx.Lock()
 	defer ticker.currentsMtx.Unlock()
 
+	tickTime := time.Now()
 	for pchan := range ticker.currents {
 		current := ticker.currents[pchan]
 		stat, ok := stats[pchan]
 
 		if !ok {
-			ticker.minTsStatistics[pchan] = current
+			ticker.updateMinTs(pchan, current, 0, tickTime)
 			ticker.currents[pchan] = now
 		} else {
 			if stat.minTs > current {
-				ticker.minTsStatistics[pchan] = stat.minTs - 1
+				ticker.updateMinTs(pchan, stat.minTs-1, stat.minTs, tickTime)
 				next := now + Timestamp(sendTimeTickMsgInterval)
 				if next > stat.maxTs {
 					next = stat.maxTs
 				}
 				ticker.currents[pchan] = next
+			} else {
+				// the dml tasks on the pchan hold the time tick
+				ticker.minTsStatistics[pchan].pendingMinTs = stat.minTs
 			}
 		}
 	}
+	ticker.detectStalls(tickTime)
 
 	return nil
 }
@@ -164,7 +254,7 @@ func (ticker *channelsTimeTickerImpl) addPChan(pchan pChan) error {
 		ticker.statisticsMtx.Unlock()
 		return fmt.Errorf("pChan %v already exist in minTsStatistics", pchan)
 	}
-	ticker.minTsStatistics[pchan] = 0
+	ticker.minTsStatistics[pchan] = &pChanTimeTick{lastAdvanceTime: time.Now()}
 	ticker.statisticsMtx.Unlock()
 
 	ticker.currentsMtx.Lock()
@@ -202,17 +292,18 @@ func (ticker *channelsTimeTickerImpl) getLastTick(pchan pChan) (Timestamp, error
 	ticker.statisticsMtx.RLock()
 	defer ticker.statisticsMtx.RUnlock()
 
-	ts, ok := ticker.minTsStatistics[pchan]
+	stat, ok := ticker.minTsStatistics[pchan]
 	if !ok {
 		return 0, fmt.Errorf("pChan %v not found", pchan)
 	}
 
-	return ts, nil
+	return stat.minTs, nil
 }
 
 func newChannelsTimeTicker(
 	ctx context.Context,
 	interval time.Duration,
+	stallThreshold time.Duration,
 	pchans []pChan,
 	getStatisticsFunc getPChanStatisticsFuncType,
 	tso tsoAllocator,
@@ -222,7 +313,8 @@ func newChannelsTimeTicker(
 
 	ticker := &channelsTimeTickerImpl{
 		interval:          interval,
-		minTsStatistics:   make(map[pChan]Timestamp),
+		stallThreshold:    stallThreshold,
+		minTsStatistics:   make(map[pChan]*pChanTimeTick),
 		getStatisticsFunc: getStatisticsFunc,
 		tso:               tso,
 		currents:          make(map[pChan]Timestamp),
@@ -230,8 +322,9 @@ func newChannelsTimeTicker(
 		cancel:            cancel,
 	}
 
+	now := time.Now()
 	for _, pchan := range pchans {
-		ticker.minTsStatistics[pchan] = 0
+		ticker.minTsStatistics[pchan] = &pChanTimeTick{lastAdvanceTime: now}
 		ticker.currents[pchan] = 0
 	}
 
@@ -18,7 +18,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 
 	"go.uber.org/zap"
 
@@ -59,7 +63,7 @@ func TestChannelsTimeTickerImpl_start(t *testing.T) {
 	tso := newMockTsoAllocator()
 	ctx := context.Background()
 
-	ticker := newChannelsTimeTicker(ctx, interval, pchans, newGetStatisticsFunc(pchans), tso)
+	ticker := newChannelsTimeTicker(ctx, interval, 0, pchans, newGetStatisticsFunc(pchans), tso)
 	err := ticker.start()
 	assert.Equal(t, nil, err)
 
@@ -81,7 +85,7 @@ func TestChannelsTimeTickerImpl_close(t *testing.T) {
 	tso := newMockTsoAllocator()
 	ctx := context.Background()
 
-	ticker := newChannelsTimeTicker(ctx, interval, pchans, newGetStatisticsFunc(pchans), tso)
+	ticker := newChannelsTimeTicker(ctx, interval, 0, pchans, newGetStatisticsFunc(pchans), tso)
 	err := ticker.start()
 	assert.Equal(t, nil, err)
 
@@ -103,7 +107,7 @@ func TestChannelsTimeTickerImpl_addPChan(t *testing.T) {
 	tso := newMockTsoAllocator()
 	ctx := context.Background()
 
-	ticker := newChannelsTimeTicker(ctx, interval, pchans, newGetStatisticsFunc(pchans), tso)
+	ticker := newChannelsTimeTicker(ctx, interval, 0, pchans, newGetStatisticsFunc(pchans), tso)
 	err := ticker.start()
 	assert.Equal(t, nil, err)
 
@@ -131,7 +135,7 @@ func TestChannelsTimeTickerImpl_getLastTick(t *testing.T) {
 	tso := newMockTsoAllocator()
 	ctx := context.Background()
 
-	ticker := newChannelsTimeTicker(ctx, interval, pchans, newGetStatisticsFunc(pchans), tso)
+	ticker := newChannelsTimeTicker(ctx, interval, 0, pchans, newGetStatisticsFunc(pchans), tso)
 	err := ticker.start()
 	assert.Equal(t, nil, err)
 
@@ -178,7 +182,7 @@ func TestChannelsTimeTickerImpl_getMinTsStatistics(t *testing.T) {
 	tso := newMockTsoAllocator()
 	ctx := context.Background()
 
-	ticker := newChannelsTimeTicker(ctx, interval, pchans, newGetStatisticsFunc(pchans), tso)
+	ticker := newChannelsTimeTicker(ctx, interval, 0, pchans, newGetStatisticsFunc(pchans), tso)
 	err := ticker.start()
 	assert.Equal(t, nil, err)
 
@@ -214,3 +218,52 @@ func TestChannelsTimeTickerImpl_getMinTsStatistics(t *testing.T) {
 
 	time.Sleep(time.Second)
 }
+
+func TestChannelsTimeTickerImpl_stalled(t *testing.T) {
+	held, free := funcutil.GenRandomStr(), funcutil.GenRandomStr()
+	getStatisticsFunc := func() (map[pChan]*pChanStatistics, error) {
+		// the dml task on held never finishes
+		return map[pChan]*pChanStatistics{held: {minTs: 1, maxTs: 1}}, nil
+	}
+	ticker := newChannelsTimeTicker(context.Background(), time.Millisecond*10, time.Millisecond*100,
+		[]pChan{held, free}, getStatisticsFunc, newMockTsoAllocator())
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, ticker.tick())
+	}
+	stats := ticker.getTimeTickStatistics()
+	assert.False(t, stats[held].stalled)
+	assert.Equal(t, Timestamp(1), stats[held].pendingMinTs)
+
+	time.Sleep(time.Millisecond * 200)
+	assert.Nil(t, ticker.tick())
+	stats = ticker.getTimeTickStatistics()
+	assert.True(t, stats[held].stalled)
+	assert.Equal(t, Timestamp(0), stats[held].minTs)
+	assert.False(t, stats[free].stalled)
+	assert.NotZero(t, stats[free].minTs)
+
+	node := &Proxy{chTicker: ticker}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+	resp, err := node.GetChannelTimeTicks(context.Background(), &milvuspb.GetChannelTimeTicksRequest{StalledOnly: true})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Len(t, resp.Channels, 1)
+	assert.Equal(t, held, resp.Channels[0].ChannelName)
+	assert.True(t, resp.Channels[0].Stalled)
+
+	resp, err = node.GetChannelTimeTicks(context.Background(), &milvuspb.GetChannelTimeTicksRequest{})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Channels, 2)
+}
+
+func TestTimeTickSkew(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) Timestamp {
+		return tsoutil.ComposeTS(now.Add(d).UnixNano()/int64(time.Millisecond), 0)
+	}
+	assert.Equal(t, time.Duration(0), timeTickSkew(nil))
+	// the pchans never ticked are ignored
+	assert.Equal(t, time.Duration(0), timeTickSkew(map[pChan]Timestamp{"p1": ts(0), "p2": 0}))
+	assert.Equal(t, 3*time.Second, timeTickSkew(map[pChan]Timestamp{"p1": ts(0), "p2": ts(-3 * time.Second), "p3": ts(-time.Second)}))
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+var errRowNumNotEqual = errors.New("the row num of different column is not equal")
+
+// columnView is a read only view of a column of an insert request, it encodes the values of a row into the
+// row based data in place, without boxing the values or copying the column
+type columnView struct {
+	name  string
+	rows  int
+	width int // bytes of a row
+	// encode writes the value of the row into dst, which has width bytes
+	encode func(dst []byte, row int)
+}
+
+// newColumnView returns the view of field, or nil if the field has no data
+func newColumnView(field *schemapb.FieldData) (*columnView, error) {
+	col := &columnView{name: field.FieldName}
+	mismatch := func() error {
+		return fmt.Errorf("the data of field %s does not match its type %s", field.FieldName, field.Type.String())
+	}
+	endian := binary.LittleEndian
+
+	switch fd := field.Field.(type) {
+	case *schemapb.FieldData_Scalars:
+		switch sd := fd.Scalars.Data.(type) {
+		case *schemapb.ScalarField_BoolData:
+			if field.Type != schemapb.DataType_Bool {
+				return nil, mismatch()
+			}
+			data := sd.BoolData.GetData()
+			col.rows, col.width = len(data), 1
+			col.encode = func(dst []byte, row int) {
+				if data[row] {
+					dst[0] = 1
+				} else {
+					dst[0] = 0
+				}
+			}
+		case *schemapb.ScalarField_IntData:
+			data := sd.IntData.GetData()
+			col.rows = len(data)
+			switch field.Type {
+			case schemapb.DataType_Int8:
+				col.width = 1
+				col.encode = func(dst []byte, row int) { dst[0] = byte(int8(data[row])) }
+			case schemapb.DataType_Int16:
+				col.width = 2
+				col.encode = func(dst []byte, row int) { endian.PutUint16(dst, uint16(int16(data[row]))) }
+			case schemapb.DataType_Int32:
+				col.width = 4
+				col.encode = func(dst []byte, row int) { endian.PutUint32(dst, uint32(data[row])) }
+			default:
+				return nil, mismatch()
+			}
+		case *schemapb.ScalarField_LongData:
+			if field.Type != schemapb.DataType_Int64 {
+				return nil, mismatch()
+			}
+			data := sd.LongData.GetData()
+			col.rows, col.width = len(data), 8
+			col.encode = func(dst []byte, row int) { endian.PutUint64(dst, uint64(data[row])) }
+		case *schemapb.ScalarField_FloatData:
+			if field.Type != schemapb.DataType_Float {
+				return nil, mismatch()
+			}
+			data := sd.FloatData.GetData()
+			col.rows, col.width = len(data), 4
+			col.encode = func(dst []byte, row int) { endian.PutUint32(dst, math.Float32bits(data[row])) }
+		case *schemapb.ScalarField_DoubleData:
+			if field.Type != schemapb.DataType_Double {
+				return nil, mismatch()
+			}
+			data := sd.DoubleData.GetData()
+			col.rows, col.width = len(data), 8
+			col.encode = func(dst []byte, row int) { endian.PutUint64(dst, math.Float64bits(data[row])) }
+		case *schemapb.ScalarField_BytesData:
+			return nil, errors.New("bytes field is not supported now")
+		case *schemapb.ScalarField_StringData:
+			return nil, errors.New("string field is not supported now")
+		default:
+			return nil, nil
+		}
+	case *schemapb.FieldData_Vectors:
+		dim := int(fd.Vectors.GetDim())
+		switch vd := fd.Vectors.Data.(type) {
+		case *schemapb.VectorField_FloatVector:
+			if field.Type != schemapb.DataType_FloatVector {
+				return nil, mismatch()
+			}
+			if dim <= 0 {
+				return nil, errDimLessThanOrEqualToZero(dim)
+			}
+			data := vd.FloatVector.GetData()
+			if len(data)%dim != 0 {
+				return nil, errors.New("invalid vectors")
+			}
+			col.rows, col.width = len(data)/dim, dim*4
+			col.encode = func(dst []byte, row int) {
+				for i, v := range data[row*dim : (row+1)*dim] {
+					endian.PutUint32(dst[i*4:], math.Float32bits(v))
+				}
+			}
+		case *schemapb.VectorField_BinaryVector:
+			if field.Type != schemapb.DataType_BinaryVector {
+				return nil, mismatch()
+			}
+			if dim <= 0 {
+				return nil, errDimLessThanOrEqualToZero(dim)
+			}
+			if dim%8 != 0 {
+				return nil, errors.New("invalid dim")
+			}
+			data := vd.BinaryVector
+			width := dim / 8
+			if len(data)%width != 0 {
+				return nil, errors.New("invalid vectors")
+			}
+			col.rows, col.width = len(data)/width, width
+			col.encode = func(dst []byte, row int) { copy(dst, data[row*width:(row+1)*width]) }
+		default:
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	return col, nil
+}
+
+// encodeRows encodes the columns into the row based data, the values of a row are concatenated in the order
+// of the columns in little endian. All the rows are encoded into one buffer, the blobs are views of it
+func encodeRows(columns []*columnView) ([]*commonpb.Blob, error) {
+	if len(columns) == 0 {
+		return []*commonpb.Blob{}, nil
+	}
+	rowNum := columns[0].rows
+	rowSize := 0
+	for _, col := range columns {
+		if col.rows != rowNum {
+			return nil, errRowNumNotEqual
+		}
+		rowSize += col.width
+	}
+
+	buffer := make([]byte, rowNum*rowSize)
+	blobs := make([]commonpb.Blob, rowNum)
+	rows := make([]*commonpb.Blob, rowNum)
+	for i := 0; i < rowNum; i++ {
+		// limit the capacity so that appending to a row never overwrites the next one
+		row := buffer[i*rowSize : (i+1)*rowSize : (i+1)*rowSize]
+		offset := 0
+		for _, col := range columns {
+			col.encode(row[offset:offset+col.width], i)
+			offset += col.width
+		}
+		blobs[i].Value = row
+		rows[i] = &blobs[i]
+	}
+	return rows, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestEncodeRows(t *testing.T) {
+	numRows, dim := 10, 16
+	fields := []*schemapb.FieldData{
+		newScalarFieldData(schemapb.DataType_Bool, "bool", numRows),
+		newScalarFieldData(schemapb.DataType_Int8, "int8", numRows),
+		newScalarFieldData(schemapb.DataType_Int16, "int16", numRows),
+		newScalarFieldData(schemapb.DataType_Int32, "int32", numRows),
+		newScalarFieldData(schemapb.DataType_Int64, "int64", numRows),
+		newScalarFieldData(schemapb.DataType_Float, "float", numRows),
+		newScalarFieldData(schemapb.DataType_Double, "double", numRows),
+		newFloatVectorFieldData("fvec", numRows, dim),
+		newBinaryVectorFieldData("bvec", numRows, dim),
+		{FieldName: "empty"},
+	}
+
+	columns := make([]*columnView, 0, len(fields))
+	for _, field := range fields {
+		col, err := newColumnView(field)
+		assert.Nil(t, err)
+		if col != nil {
+			columns = append(columns, col)
+		}
+	}
+	assert.Equal(t, 9, len(columns))
+	rows, err := encodeRows(columns)
+	assert.Nil(t, err)
+	assert.Equal(t, numRows, len(rows))
+
+	// the same as encoding the values one by one with binary.Write
+	for i, row := range rows {
+		var buffer bytes.Buffer
+		endian := binary.LittleEndian
+		assert.Nil(t, binary.Write(&buffer, endian, fields[0].GetScalars().GetBoolData().Data[i]))
+		assert.Nil(t, binary.Write(&buffer, endian, int8(fields[1].GetScalars().GetIntData().Data[i])))
+		assert.Nil(t, binary.Write(&buffer, endian, int16(fields[2].GetScalars().GetIntData().Data[i])))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[3].GetScalars().GetIntData().Data[i]))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[4].GetScalars().GetLongData().Data[i]))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[5].GetScalars().GetFloatData().Data[i]))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[6].GetScalars().GetDoubleData().Data[i]))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[7].GetVectors().GetFloatVector().Data[i*dim:(i+1)*dim]))
+		assert.Nil(t, binary.Write(&buffer, endian, fields[8].GetVectors().GetBinaryVector()[i*dim/8:(i+1)*dim/8]))
+		assert.Equal(t, buffer.Bytes(), row.Value)
+	}
+
+	// appending to a row does not overwrite the next one
+	next := append([]byte{}, rows[1].Value...)
+	rows[0].Value = append(rows[0].Value, 1, 2, 3)
+	assert.Equal(t, next, rows[1].Value)
+
+	rows, err = encodeRows(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rows))
+
+	columns = append(columns, &columnView{rows: numRows + 1, width: 1})
+	_, err = encodeRows(columns)
+	assert.Equal(t, errRowNumNotEqual, err)
+}
+
+func TestNewColumnView(t *testing.T) {
+	field := newScalarFieldData(schemapb.DataType_Int64, "int64", 10)
+	field.Type = schemapb.DataType_Int32
+	_, err := newColumnView(field)
+	assert.NotNil(t, err)
+
+	field = newScalarFieldData(schemapb.DataType_Int32, "int32", 10)
+	field.Type = schemapb.DataType_Int64
+	_, err = newColumnView(field)
+	assert.NotNil(t, err)
+
+	field = newFloatVectorFieldData("fvec", 10, 16)
+	field.GetVectors().Dim = 0
+	_, err = newColumnView(field)
+	assert.NotNil(t, err)
+	field.GetVectors().Dim = 7
+	_, err = newColumnView(field)
+	assert.NotNil(t, err)
+
+	field = newBinaryVectorFieldData("bvec", 10, 16)
+	field.GetVectors().Dim = 12
+	_, err = newColumnView(field)
+	assert.NotNil(t, err)
+	field.GetVectors().Dim = 24
+	_, err = newColumnView(field)
+	assert.NotNil(t, err)
+
+	_, err = newColumnView(&schemapb.FieldData{
+		Type:  schemapb.DataType_String,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{}}},
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,291 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	mirrorDrainCheckInterval = 10 * time.Millisecond
+	mirrorRetryAttempts      = 5
+)
+
+// DMLMirrorTarget is the Milvus endpoint which receives the mirrored dml requests
+type DMLMirrorTarget interface {
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
+}
+
+type mirrorTask struct {
+	enqueueTime time.Time
+	insert      *milvuspb.InsertRequest
+	delete      *milvuspb.DeleteRequest
+}
+
+// dmlMirrorState is the json response of the dml mirror admin request
+type dmlMirrorState struct {
+	Enabled     bool     `json:"enabled"`
+	Collections []string `json:"collections"`
+	Pending     int64    `json:"pending"`
+	Mirrored    int64    `json:"mirrored"`
+	Failed      int64    `json:"failed"`
+	LagMs       int64    `json:"lag_ms"`
+}
+
+// dmlMirror asynchronously replays the successful dml requests of selected collections on another Milvus,
+// it is used to migrate to a new cluster without changing the applications. The mirrored collections
+// should not use auto id, otherwise the primary keys generated by the two clusters are different
+type dmlMirror struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	target      DMLMirrorTarget
+	collections map[string]struct{}
+	queue       chan *mirrorTask
+
+	// enabled is set to 0 after cutover, the following dml requests are not mirrored any more,
+	// enabledMtx makes sure no request is enqueued after cutover starts draining
+	enabledMtx sync.RWMutex
+	enabled    int32
+	pending    int64
+	mirrored   int64
+	failed     int64
+	lagMs      int64
+}
+
+func newDMLMirror(ctx context.Context, target DMLMirrorTarget, collections []string, bufSize int64) *dmlMirror {
+	ctx1, cancel := context.WithCancel(ctx)
+	m := &dmlMirror{
+		ctx:         ctx1,
+		cancel:      cancel,
+		target:      target,
+		collections: make(map[string]struct{}),
+		queue:       make(chan *mirrorTask, bufSize),
+		enabled:     1,
+	}
+	for _, name := range collections {
+		m.collections[name] = struct{}{}
+	}
+	return m
+}
+
+func (m *dmlMirror) start() {
+	m.wg.Add(1)
+	go m.sendLoop()
+}
+
+func (m *dmlMirror) close() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *dmlMirror) isMirrored(collectionName string) bool {
+	_, ok := m.collections[collectionName]
+	return ok
+}
+
+func (m *dmlMirror) enqueue(task *mirrorTask) {
+	m.enabledMtx.RLock()
+	defer m.enabledMtx.RUnlock()
+	if atomic.LoadInt32(&m.enabled) == 0 {
+		return
+	}
+	atomic.AddInt64(&m.pending, 1)
+	metrics.ProxyDMLMirrorPending.Inc()
+	select {
+	case m.queue <- task:
+	case <-m.ctx.Done():
+		atomic.AddInt64(&m.pending, -1)
+		metrics.ProxyDMLMirrorPending.Dec()
+	}
+}
+
+// newInsertTask copies the request before the insert task runs since it may modify the request,
+// it returns nil if the collection is not mirrored
+func (m *dmlMirror) newInsertTask(request *milvuspb.InsertRequest) *mirrorTask {
+	if !m.isMirrored(request.CollectionName) {
+		return nil
+	}
+	return &mirrorTask{
+		insert: proto.Clone(request).(*milvuspb.InsertRequest),
+	}
+}
+
+// newDeleteTask returns nil if the collection is not mirrored
+func (m *dmlMirror) newDeleteTask(request *milvuspb.DeleteRequest) *mirrorTask {
+	if !m.isMirrored(request.CollectionName) {
+		return nil
+	}
+	return &mirrorTask{
+		delete: proto.Clone(request).(*milvuspb.DeleteRequest),
+	}
+}
+
+// mirror must be called only after the dml request succeeds locally
+func (m *dmlMirror) mirror(task *mirrorTask) {
+	if task == nil {
+		return
+	}
+	task.enqueueTime = time.Now()
+	m.enqueue(task)
+}
+
+func (m *dmlMirror) sendLoop() {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("Proxy dml mirror send loop exit")
+			return
+		case task := <-m.queue:
+			m.send(task)
+		}
+	}
+}
+
+func (m *dmlMirror) send(task *mirrorTask) {
+	msgType := commonpb.MsgType_Insert
+	if task.delete != nil {
+		msgType = commonpb.MsgType_Delete
+	}
+	err := retry.Do(m.ctx, func() error {
+		var result *milvuspb.MutationResult
+		var err error
+		if task.insert != nil {
+			result, err = m.target.Insert(m.ctx, task.insert)
+		} else {
+			result, err = m.target.Delete(m.ctx, task.delete)
+		}
+		if err != nil {
+			return err
+		}
+		if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(result.Status.Reason)
+		}
+		return nil
+	}, retry.Attempts(mirrorRetryAttempts))
+
+	lag := time.Since(task.enqueueTime).Milliseconds()
+	atomic.StoreInt64(&m.lagMs, lag)
+	metrics.ProxyDMLMirrorLag.Set(float64(lag))
+	if err != nil {
+		atomic.AddInt64(&m.failed, 1)
+		metrics.ProxyDMLMirrorCounter.WithLabelValues(msgType.String(), "fail").Inc()
+		log.Warn("Proxy failed to mirror dml request", zap.String("type", msgType.String()), zap.Error(err))
+	} else {
+		atomic.AddInt64(&m.mirrored, 1)
+		metrics.ProxyDMLMirrorCounter.WithLabelValues(msgType.String(), "success").Inc()
+	}
+	atomic.AddInt64(&m.pending, -1)
+	metrics.ProxyDMLMirrorPending.Dec()
+}
+
+// drain waits until all the queued dml requests are sent to the target
+func (m *dmlMirror) drain(ctx context.Context) error {
+	ticker := time.NewTicker(mirrorDrainCheckInterval)
+	defer ticker.Stop()
+	for atomic.LoadInt64(&m.pending) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.ctx.Done():
+			return m.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// cutover stops mirroring new dml requests and waits for the queued ones, after it returns
+// the applications can be switched to the target
+func (m *dmlMirror) cutover(ctx context.Context) error {
+	m.enabledMtx.Lock()
+	atomic.StoreInt32(&m.enabled, 0)
+	m.enabledMtx.Unlock()
+	log.Debug("Proxy dml mirror cutover, draining", zap.Int64("pending", atomic.LoadInt64(&m.pending)))
+	return m.drain(ctx)
+}
+
+func (m *dmlMirror) state() *dmlMirrorState {
+	collections := make([]string, 0, len(m.collections))
+	for name := range m.collections {
+		collections = append(collections, name)
+	}
+	return &dmlMirrorState{
+		Enabled:     atomic.LoadInt32(&m.enabled) == 1,
+		Collections: collections,
+		Pending:     atomic.LoadInt64(&m.pending),
+		Mirrored:    atomic.LoadInt64(&m.mirrored),
+		Failed:      atomic.LoadInt64(&m.failed),
+		LagMs:       atomic.LoadInt64(&m.lagMs),
+	}
+}
+
+// getDMLMirrorMetrics returns the mirror state, and cuts over first if the request asks for it
+func (node *Proxy) getDMLMirrorMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if node.mirror == nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "dml mirror is not enabled",
+			},
+		}, nil
+	}
+
+	cutover, err := metricsinfo.ParseDMLMirrorCutover(req.Request)
+	if err == nil && cutover {
+		err = node.mirror.cutover(ctx)
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	resp, err := json.Marshal(node.mirror.state())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
+	}, nil
+}
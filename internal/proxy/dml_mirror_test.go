@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+type mockMirrorTarget struct {
+	mu       sync.Mutex
+	inserts  []*milvuspb.InsertRequest
+	deletes  []*milvuspb.DeleteRequest
+	failOnce bool
+}
+
+func (target *mockMirrorTarget) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	if target.failOnce {
+		target.failOnce = false
+		return nil, errors.New("mock failure")
+	}
+	target.inserts = append(target.inserts, request)
+	return &milvuspb.MutationResult{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (target *mockMirrorTarget) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	target.deletes = append(target.deletes, request)
+	return &milvuspb.MutationResult{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func TestDMLMirror(t *testing.T) {
+	ctx := context.Background()
+	target := &mockMirrorTarget{failOnce: true}
+	m := newDMLMirror(ctx, target, []string{"mirrored"}, 4)
+	m.start()
+	defer m.close()
+
+	assert.Nil(t, m.newInsertTask(&milvuspb.InsertRequest{CollectionName: "other"}))
+	assert.Nil(t, m.newDeleteTask(&milvuspb.DeleteRequest{CollectionName: "other"}))
+
+	request := &milvuspb.InsertRequest{CollectionName: "mirrored", NumRows: 10}
+	task := m.newInsertTask(request)
+	assert.NotNil(t, task)
+	// the request is copied, modification after that is not mirrored
+	request.NumRows = 20
+	m.mirror(task)
+	m.mirror(m.newDeleteTask(&milvuspb.DeleteRequest{CollectionName: "mirrored", Expr: "pk in [1]"}))
+	m.mirror(nil)
+
+	drainCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	err := m.drain(drainCtx)
+	assert.Nil(t, err)
+
+	target.mu.Lock()
+	assert.Equal(t, 1, len(target.inserts))
+	assert.Equal(t, uint32(10), target.inserts[0].NumRows)
+	assert.Equal(t, 1, len(target.deletes))
+	target.mu.Unlock()
+
+	state := m.state()
+	assert.True(t, state.Enabled)
+	assert.Equal(t, int64(0), state.Pending)
+	assert.Equal(t, int64(2), state.Mirrored)
+	assert.Equal(t, int64(0), state.Failed)
+
+	err = m.cutover(drainCtx)
+	assert.Nil(t, err)
+	assert.False(t, m.state().Enabled)
+
+	// nothing is mirrored after cutover
+	m.mirror(m.newInsertTask(&milvuspb.InsertRequest{CollectionName: "mirrored"}))
+	assert.Equal(t, int64(0), m.state().Pending)
+	target.mu.Lock()
+	assert.Equal(t, 1, len(target.inserts))
+	target.mu.Unlock()
+}
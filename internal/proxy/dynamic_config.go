@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/dynconfig"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+var errDynamicConfigNotStarted = errors.New("dynamic config is not started")
+
+// watchDynamicConfigs applies the changes of the dynamic configs of proxy at runtime
+func (node *Proxy) watchDynamicConfigs() error {
+	err := node.dynConfig.Watch("proxy.slowLog.threshold", strconv.FormatInt(Params.SlowLogThreshold.Milliseconds(), 10), func(value string) {
+		threshold, _ := strconv.ParseInt(value, 10, 64)
+		node.slowLogger.SetThreshold(time.Duration(threshold) * time.Millisecond)
+	})
+	if err != nil {
+		return err
+	}
+	return node.dynConfig.Watch("proxy.shadow.sampleRatio", strconv.FormatFloat(Params.ShadowSampleRatio, 'f', -1, 64), func(value string) {
+		if node.shadow == nil {
+			log.Warn("search shadow is disabled since proxy.shadow.address is empty, ignore the sample ratio")
+			return
+		}
+		sampleRatio, _ := strconv.ParseFloat(value, 64)
+		node.shadow.setSampleRatio(sampleRatio)
+	})
+}
+
+// getListConfigMetrics returns all the dynamic configs, with the effective values of the ones watched by proxy
+func (node *Proxy) getListConfigMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if node.dynConfig == nil {
+		return dynamicConfigResponse(nil, errDynamicConfigNotStarted)
+	}
+	return dynamicConfigResponse(node.dynConfig.List(), nil)
+}
+
+// getUpdateConfigMetrics saves a dynamic config in etcd, the components watching the key apply it asynchronously
+func (node *Proxy) getUpdateConfigMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if node.dynConfig == nil {
+		return dynamicConfigResponse(nil, errDynamicConfigNotStarted)
+	}
+	updateReq, err := metricsinfo.ParseUpdateConfigRequest(req.Request)
+	if err != nil {
+		return dynamicConfigResponse(nil, err)
+	}
+	if err = node.dynConfig.Update(updateReq.Key, updateReq.Value); err != nil {
+		return dynamicConfigResponse(nil, err)
+	}
+	log.Info("Proxy update dynamic config", zap.String("key", updateReq.Key), zap.String("value", updateReq.Value))
+	return dynamicConfigResponse(&dynconfig.Config{
+		Key:     updateReq.Key,
+		Value:   updateReq.Value,
+		Updated: updateReq.Value != "",
+	}, nil)
+}
+
+func dynamicConfigResponse(configs interface{}, err error) (*milvuspb.GetMetricsResponse, error) {
+	var resp []byte
+	if err == nil {
+		resp, err = json.Marshal(configs)
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
+	}, nil
+}
@@ -0,0 +1,319 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	embeddingProviderKey = "provider"
+	embeddingEndpointKey = "endpoint"
+
+	httpEmbeddingProvider = "http"
+)
+
+// embeddingProvider maps the texts to the vectors
+type embeddingProvider interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// httpEmbedding posts the texts to the endpoint as {"texts": [...]},
+// which responds the vectors in the same order as {"embeddings": [[...], ...]}
+type httpEmbedding struct {
+	endpoint string
+	client   *http.Client
+}
+
+type httpEmbeddingRequest struct {
+	Texts []string `json:"texts"`
+}
+
+type httpEmbeddingResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (e *httpEmbedding) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(&httpEmbeddingRequest{Texts: texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding endpoint %s responds %s: %s", e.endpoint, resp.Status, string(respBody))
+	}
+	result := &httpEmbeddingResponse{}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, fmt.Errorf("invalid response of embedding endpoint %s: %w", e.endpoint, err)
+	}
+	return result.Embeddings, nil
+}
+
+func newEmbeddingProvider(function *schemapb.FunctionSchema) (embeddingProvider, error) {
+	params, err := RepeatedKeyValToMap(function.Params)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := params[embeddingProviderKey]
+	if !ok {
+		provider = httpEmbeddingProvider
+	}
+	switch provider {
+	case httpEmbeddingProvider:
+		endpoint := params[embeddingEndpointKey]
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint %q of function %s", endpoint, function.Name)
+		}
+		return &httpEmbedding{
+			endpoint: endpoint,
+			client:   &http.Client{Timeout: Params.EmbeddingTimeout},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported embedding provider %s of function %s", provider, function.Name)
+	}
+}
+
+// embeddingFunction maps the texts of its input to the vectors of its output field
+type embeddingFunction struct {
+	function *schemapb.FunctionSchema
+	field    *schemapb.FieldSchema
+	dim      int64
+	provider embeddingProvider
+}
+
+func newEmbeddingFunction(schema *schemapb.CollectionSchema, function *schemapb.FunctionSchema) (*embeddingFunction, error) {
+	var field *schemapb.FieldSchema
+	for _, f := range schema.Fields {
+		if f.Name == function.OutputFieldName {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return nil, fmt.Errorf("output field %s of function %s not exist", function.OutputFieldName, function.Name)
+	}
+	if field.DataType != schemapb.DataType_FloatVector {
+		return nil, fmt.Errorf("output field %s of function %s should be a float vector, got %s",
+			field.Name, function.Name, field.DataType.String())
+	}
+	dimStr, err := GetAttrByKeyFromRepeatedKV("dim", field.TypeParams)
+	if err != nil {
+		return nil, err
+	}
+	dim, err := strconv.ParseInt(dimStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := newEmbeddingProvider(function)
+	if err != nil {
+		return nil, err
+	}
+	return &embeddingFunction{
+		function: function,
+		field:    field,
+		dim:      dim,
+		provider: provider,
+	}, nil
+}
+
+// embed returns the vectors of the texts concatenated
+func (f *embeddingFunction) embed(ctx context.Context, texts []string) ([]float32, error) {
+	vectors, err := f.provider.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("function %s: %w", f.function.Name, err)
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("function %s returns %d vectors of %d texts", f.function.Name, len(vectors), len(texts))
+	}
+	data := make([]float32, 0, int64(len(texts))*f.dim)
+	for i, vector := range vectors {
+		if int64(len(vector)) != f.dim {
+			return nil, fmt.Errorf("function %s returns vector %d of dim %d, the dim of field %s is %d",
+				f.function.Name, i, len(vector), f.field.Name, f.dim)
+		}
+		data = append(data, vector...)
+	}
+	return data, nil
+}
+
+// ValidateFunctions checks the functions of a new collection, the inputs are not the fields and the outputs are
+// the float vector fields, each of which is the output of one function at most
+func ValidateFunctions(coll *schemapb.CollectionSchema) error {
+	names := make(map[string]struct{}, len(coll.Fields)+len(coll.Functions))
+	for _, field := range coll.Fields {
+		names[field.Name] = struct{}{}
+	}
+	functions := make(map[string]struct{}, len(coll.Functions))
+	outputs := make(map[string]struct{}, len(coll.Functions))
+	for _, function := range coll.Functions {
+		if err := ValidateFieldName(function.Name); err != nil {
+			return fmt.Errorf("invalid function name %s: %w", function.Name, err)
+		}
+		if _, ok := functions[function.Name]; ok {
+			return fmt.Errorf("duplicated function name %s", function.Name)
+		}
+		functions[function.Name] = struct{}{}
+
+		if err := ValidateFieldName(function.InputName); err != nil {
+			return fmt.Errorf("invalid input name %s of function %s: %w", function.InputName, function.Name, err)
+		}
+		if _, ok := names[function.InputName]; ok {
+			return fmt.Errorf("input %s of function %s duplicates a field or another input", function.InputName, function.Name)
+		}
+		names[function.InputName] = struct{}{}
+
+		if _, ok := outputs[function.OutputFieldName]; ok {
+			return fmt.Errorf("field %s is the output of more than one function", function.OutputFieldName)
+		}
+		outputs[function.OutputFieldName] = struct{}{}
+		if _, err := newEmbeddingFunction(coll, function); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// embedFieldsData replaces the texts of the function inputs by the vectors of the output fields in place,
+// since the rows are encoded in the order of the fields data
+func embedFieldsData(ctx context.Context, schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, numRows uint32) ([]*schemapb.FieldData, error) {
+	if len(schema.Functions) == 0 {
+		return fieldsData, nil
+	}
+	functions := make(map[string]*schemapb.FunctionSchema, len(schema.Functions))
+	for _, function := range schema.Functions {
+		functions[function.InputName] = function
+	}
+	sent := make(map[string]struct{}, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		sent[fieldData.FieldName] = struct{}{}
+	}
+
+	ret := make([]*schemapb.FieldData, 0, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		function, ok := functions[fieldData.FieldName]
+		if !ok {
+			ret = append(ret, fieldData)
+			continue
+		}
+		if _, ok := sent[function.OutputFieldName]; ok {
+			return nil, fmt.Errorf("both the input %s of function %s and its output field %s are sent",
+				function.InputName, function.Name, function.OutputFieldName)
+		}
+		if fieldData.GetScalars().GetStringData() == nil {
+			return nil, fmt.Errorf("input %s of function %s should be texts", function.InputName, function.Name)
+		}
+		texts := fieldData.GetScalars().GetStringData().GetData()
+		if uint32(len(texts)) != numRows {
+			return nil, fmt.Errorf("input %s of function %s has %d texts, expected %d rows",
+				function.InputName, function.Name, len(texts), numRows)
+		}
+		f, err := newEmbeddingFunction(schema, function)
+		if err != nil {
+			return nil, err
+		}
+		data, err := f.embed(ctx, texts)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: f.field.Name,
+			FieldId:   f.field.FieldID,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  f.dim,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+				},
+			},
+		})
+	}
+	return ret, nil
+}
+
+// embedPlaceholderGroup replaces the texts to search by the vectors of the function of annsField
+func embedPlaceholderGroup(ctx context.Context, schema *schemapb.CollectionSchema, annsField string, placeholderGroup []byte) ([]byte, error) {
+	var function *schemapb.FunctionSchema
+	for _, fn := range schema.Functions {
+		if fn.OutputFieldName == annsField {
+			function = fn
+			break
+		}
+	}
+	if function == nil {
+		return placeholderGroup, nil
+	}
+
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroup, group); err != nil {
+		return nil, err
+	}
+	embedded := false
+	for _, placeholder := range group.Placeholders {
+		if placeholder.Type != milvuspb.PlaceholderType_String {
+			continue
+		}
+		texts := make([]string, 0, len(placeholder.Values))
+		for _, value := range placeholder.Values {
+			texts = append(texts, string(value))
+		}
+		f, err := newEmbeddingFunction(schema, function)
+		if err != nil {
+			return nil, err
+		}
+		data, err := f.embed(ctx, texts)
+		if err != nil {
+			return nil, err
+		}
+		placeholder.Type = milvuspb.PlaceholderType_FloatVector
+		placeholder.Values = make([][]byte, 0, len(texts))
+		for i := int64(0); i < int64(len(texts)); i++ {
+			var buffer bytes.Buffer
+			if err := binary.Write(&buffer, binary.LittleEndian, data[i*f.dim:(i+1)*f.dim]); err != nil {
+				return nil, err
+			}
+			placeholder.Values = append(placeholder.Values, buffer.Bytes())
+		}
+		embedded = true
+	}
+	if !embedded {
+		return placeholderGroup, nil
+	}
+	return proto.Marshal(group)
+}
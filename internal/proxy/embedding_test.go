@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// newTestEmbeddingServer embeds a text to the vector of dim, whose elements are the length of the text
+func newTestEmbeddingServer(dim int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &httpEmbeddingRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := &httpEmbeddingResponse{}
+		for _, text := range req.Texts {
+			if text == "fail" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			vector := make([]float32, dim)
+			for i := range vector {
+				vector[i] = float32(len(text))
+			}
+			resp.Embeddings = append(resp.Embeddings, vector)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func newTestEmbeddingSchema(endpoint string) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+		},
+		Functions: []*schemapb.FunctionSchema{{
+			Name:            "embed",
+			InputName:       "text",
+			OutputFieldName: "vec",
+			Params:          []*commonpb.KeyValuePair{{Key: embeddingEndpointKey, Value: endpoint}},
+		}},
+	}
+}
+
+func TestValidateFunctions(t *testing.T) {
+	schema := newTestEmbeddingSchema("http://localhost:8080/embed")
+	assert.Nil(t, ValidateFunctions(schema))
+
+	invalidSchemas := []func(schema *schemapb.CollectionSchema){
+		func(schema *schemapb.CollectionSchema) { schema.Functions[0].Name = "1embed" },
+		func(schema *schemapb.CollectionSchema) { schema.Functions[0].InputName = "id" },
+		func(schema *schemapb.CollectionSchema) { schema.Functions[0].OutputFieldName = "id" },
+		func(schema *schemapb.CollectionSchema) { schema.Functions[0].OutputFieldName = "unknown" },
+		func(schema *schemapb.CollectionSchema) { schema.Functions[0].Params = nil },
+		func(schema *schemapb.CollectionSchema) {
+			schema.Functions[0].Params[0].Value = "localhost:8080"
+		},
+		func(schema *schemapb.CollectionSchema) {
+			schema.Functions[0].Params = append(schema.Functions[0].Params, &commonpb.KeyValuePair{Key: embeddingProviderKey, Value: "wasm"})
+		},
+		func(schema *schemapb.CollectionSchema) {
+			schema.Functions = append(schema.Functions, proto.Clone(schema.Functions[0]).(*schemapb.FunctionSchema))
+		},
+		func(schema *schemapb.CollectionSchema) {
+			another := proto.Clone(schema.Functions[0]).(*schemapb.FunctionSchema)
+			another.Name = "another"
+			another.InputName = "another_text"
+			schema.Functions = append(schema.Functions, another)
+		},
+	}
+	for i, invalid := range invalidSchemas {
+		schema := newTestEmbeddingSchema("http://localhost:8080/embed")
+		invalid(schema)
+		assert.Error(t, ValidateFunctions(schema), i)
+	}
+}
+
+func TestEmbedFieldsData(t *testing.T) {
+	server := newTestEmbeddingServer(2)
+	defer server.Close()
+	schema := newTestEmbeddingSchema(server.URL)
+	ctx := context.Background()
+
+	texts := func(data ...string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_String,
+			FieldName: "text",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}},
+			},
+		}
+	}
+	ids := &schemapb.FieldData{
+		Type:      schemapb.DataType_Int64,
+		FieldName: "id",
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}}},
+		},
+	}
+
+	fieldsData, err := embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, texts("a", "abc")}, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(fieldsData))
+	assert.Equal(t, ids, fieldsData[0])
+	assert.Equal(t, "vec", fieldsData[1].FieldName)
+	assert.Equal(t, int64(101), fieldsData[1].FieldId)
+	assert.Equal(t, int64(2), fieldsData[1].GetVectors().Dim)
+	assert.Equal(t, []float32{1, 1, 3, 3}, fieldsData[1].GetVectors().GetFloatVector().Data)
+
+	// the vectors are sent
+	vectors := &schemapb.FieldData{FieldName: "vec"}
+	fieldsData, err = embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, vectors}, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []*schemapb.FieldData{ids, vectors}, fieldsData)
+
+	_, err = embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, texts("a", "b"), vectors}, 2)
+	assert.Error(t, err)
+	_, err = embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, texts("a")}, 2)
+	assert.Error(t, err)
+	_, err = embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, {FieldName: "text"}}, 2)
+	assert.Error(t, err)
+	_, err = embedFieldsData(ctx, schema, []*schemapb.FieldData{ids, texts("a", "fail")}, 2)
+	assert.Error(t, err)
+
+	// the provider returns the vectors of another dim
+	server3 := newTestEmbeddingServer(3)
+	defer server3.Close()
+	_, err = embedFieldsData(ctx, newTestEmbeddingSchema(server3.URL), []*schemapb.FieldData{ids, texts("a", "b")}, 2)
+	assert.Error(t, err)
+}
+
+func TestEmbedPlaceholderGroup(t *testing.T) {
+	server := newTestEmbeddingServer(2)
+	defer server.Close()
+	schema := newTestEmbeddingSchema(server.URL)
+	ctx := context.Background()
+
+	group := &milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   milvuspb.PlaceholderType_String,
+			Values: [][]byte{[]byte("ab"), []byte("abcd")},
+		}},
+	}
+	serialized, err := proto.Marshal(group)
+	assert.Nil(t, err)
+
+	embedded, err := embedPlaceholderGroup(ctx, schema, "vec", serialized)
+	assert.Nil(t, err)
+	result := &milvuspb.PlaceholderGroup{}
+	assert.Nil(t, proto.Unmarshal(embedded, result))
+	placeholder := result.Placeholders[0]
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, placeholder.Type)
+	assert.Equal(t, 2, len(placeholder.Values))
+	assert.Equal(t, 8, len(placeholder.Values[1]))
+	assert.Equal(t, float32(4), math.Float32frombits(binary.LittleEndian.Uint32(placeholder.Values[1][4:])))
+
+	// the vectors are not embedded again
+	unchanged, err := embedPlaceholderGroup(ctx, schema, "vec", embedded)
+	assert.Nil(t, err)
+	assert.Equal(t, embedded, unchanged)
+
+	// no function of the field
+	unchanged, err = embedPlaceholderGroup(ctx, schema, "id", serialized)
+	assert.Nil(t, err)
+	assert.Equal(t, serialized, unchanged)
+
+	_, err = embedPlaceholderGroup(ctx, schema, "vec", []byte{1, 2, 3})
+	assert.Error(t, err)
+	group.Placeholders[0].Values = [][]byte{[]byte("fail")}
+	serialized, err = proto.Marshal(group)
+	assert.Nil(t, err)
+	_, err = embedPlaceholderGroup(ctx, schema, "vec", serialized)
+	assert.Error(t, err)
+}
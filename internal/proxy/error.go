@@ -14,6 +14,7 @@ package proxy
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -65,3 +66,10 @@ func msgProxyIsUnhealthy(id UniqueID) string {
 func errProxyIsUnhealthy(id UniqueID) error {
 	return errors.New(msgProxyIsUnhealthy(id))
 }
+
+func errRowSizeExceeded(oversized int, reported []string, maxRowSize int64) error {
+	if oversized > len(reported) {
+		reported = append(reported, fmt.Sprintf("and %d more", oversized-len(reported)))
+	}
+	return fmt.Errorf("%d rows exceed the max row size %d bytes: %s", oversized, maxRowSize, strings.Join(reported, ", "))
+}
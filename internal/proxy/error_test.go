@@ -145,3 +145,8 @@ func Test_errProxyIsUnhealthy(t *testing.T) {
 			zap.Error(errProxyIsUnhealthy(id)))
 	}
 }
+
+func Test_errRowSizeExceeded(t *testing.T) {
+	log.Info("Test_errRowSizeExceeded",
+		zap.Error(errRowSizeExceeded(1, []string{"row 0 is 2048 bytes"}, 1024)))
+}
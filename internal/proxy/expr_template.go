@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// the placeholder {name} of a template is rewritten to the identifier $name,
+// the names of the fields never start with $
+const placeholderPrefix = "$"
+
+func isPlaceholder(node ant_ast.Node) (string, bool) {
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok || !strings.HasPrefix(idNode.Value, placeholderPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(idNode.Value, placeholderPrefix), true
+}
+
+func isPlaceholderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// rewriteExprTemplate replaces the placeholders {name} of the template by identifiers the parser accepts
+func rewriteExprTemplate(template string) (string, error) {
+	if strings.Contains(template, placeholderPrefix) {
+		return "", fmt.Errorf("invalid character %s in expression template", placeholderPrefix)
+	}
+	var sb strings.Builder
+	for {
+		begin := strings.IndexByte(template, '{')
+		if begin < 0 {
+			sb.WriteString(template)
+			return sb.String(), nil
+		}
+		end := strings.IndexByte(template[begin:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unclosed placeholder in expression template")
+		}
+		name := template[begin+1 : begin+end]
+		if !isPlaceholderName(name) {
+			return "", fmt.Errorf("invalid placeholder {%s} in expression template", name)
+		}
+		sb.WriteString(template[:begin])
+		sb.WriteString(placeholderPrefix)
+		sb.WriteString(name)
+		template = template[begin+end+1:]
+	}
+}
+
+// parseExprTemplate returns the optimized ast of the template, which is not modified by the ParserContext
+func parseExprTemplate(template string) (ant_ast.Node, error) {
+	exprStr, err := rewriteExprTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	return parseExprNode(exprStr)
+}
+
+type exprTemplateEntry struct {
+	template string
+	node     ant_ast.Node
+}
+
+// exprTemplateCache keeps the asts of the recently used templates, so that a hot template is only parsed once
+type exprTemplateCache struct {
+	mu       sync.Mutex
+	capacity int
+	lru      *list.List
+	entries  map[string]*list.Element
+}
+
+func newExprTemplateCache(capacity int) *exprTemplateCache {
+	return &exprTemplateCache{
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// globalExprTemplateCache is set by Proxy.Init, the templates are parsed per request if it is nil
+var globalExprTemplateCache *exprTemplateCache
+
+func (c *exprTemplateCache) get(template string) (ant_ast.Node, error) {
+	if c == nil || c.capacity <= 0 {
+		return parseExprTemplate(template)
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[template]; ok {
+		c.lru.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*exprTemplateEntry).node, nil
+	}
+	c.mu.Unlock()
+
+	node, err := parseExprTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[template]; !ok {
+		c.entries[template] = c.lru.PushFront(&exprTemplateEntry{template: template, node: node})
+		for c.lru.Len() > c.capacity {
+			back := c.lru.Back()
+			c.lru.Remove(back)
+			delete(c.entries, back.Value.(*exprTemplateEntry).template)
+		}
+	}
+	return node, nil
+}
+
+// parseQueryExprTemplate parses the template with placeholders {name} and binds the values of the placeholders,
+// the values are checked against the types of the fields they are compared with
+func parseQueryExprTemplate(schema *typeutil.SchemaHelper, template string, values []*schemapb.FieldData) (*planpb.Expr, error) {
+	expr, calls, err := parseQueryPredicates(schema, template, values)
+	if err != nil {
+		return nil, err
+	}
+	if len(calls) > 0 {
+		return nil, fmt.Errorf("user-defined function %s is only supported by query", calls[0].FunctionName)
+	}
+	return expr, nil
+}
+
+// parseQueryPredicates parses the template like parseQueryExprTemplate,
+// and returns the calls of the user-defined functions combined with the other conditions by and
+func parseQueryPredicates(schema *typeutil.SchemaHelper, template string, values []*schemapb.FieldData) (*planpb.Expr, []*planpb.UDFExpr, error) {
+	if template == "" {
+		return nil, nil, nil
+	}
+	var node ant_ast.Node
+	var err error
+	if len(values) == 0 {
+		node, err = parseExprNode(template)
+	} else {
+		node, err = globalExprTemplateCache.get(template)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	context := ParserContext{
+		schema: schema,
+		values: make(map[string]*schemapb.FieldData, len(values)),
+		used:   make(map[string]struct{}, len(values)),
+	}
+	for _, value := range values {
+		if _, ok := context.values[value.GetFieldName()]; ok {
+			return nil, nil, fmt.Errorf("duplicated template value %s", value.GetFieldName())
+		}
+		context.values[value.GetFieldName()] = value
+	}
+
+	expr, calls, err := context.handlePredicates(&node)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, value := range values {
+		if _, ok := context.used[value.GetFieldName()]; !ok {
+			return nil, nil, fmt.Errorf("template value %s is not used by the expression", value.GetFieldName())
+		}
+	}
+	return expr, calls, nil
+}
+
+// handleTemplateValues returns the values bound to the placeholder as the values of dataType
+func (context *ParserContext) handleTemplateValues(name string, dataType schemapb.DataType) ([]*planpb.GenericValue, error) {
+	value, ok := context.values[name]
+	if !ok {
+		return nil, fmt.Errorf("no value of placeholder {%s}", name)
+	}
+	context.used[name] = struct{}{}
+
+	mismatch := fmt.Errorf("type mismatch of template value %s, the field is of %s", name, dataType.String())
+	var gvs []*planpb.GenericValue
+	switch data := value.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		if dataType != schemapb.DataType_Bool {
+			return nil, mismatch
+		}
+		for _, v := range data.BoolData.GetData() {
+			gvs = append(gvs, &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: v}})
+		}
+	case *schemapb.ScalarField_IntData:
+		for _, v := range data.IntData.GetData() {
+			gv, err := integerGenericValue(int64(v), dataType)
+			if err != nil {
+				return nil, mismatch
+			}
+			gvs = append(gvs, gv)
+		}
+	case *schemapb.ScalarField_LongData:
+		for _, v := range data.LongData.GetData() {
+			gv, err := integerGenericValue(v, dataType)
+			if err != nil {
+				return nil, mismatch
+			}
+			gvs = append(gvs, gv)
+		}
+	case *schemapb.ScalarField_FloatData:
+		if !typeutil.IsFloatingType(dataType) {
+			return nil, mismatch
+		}
+		for _, v := range data.FloatData.GetData() {
+			gvs = append(gvs, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: float64(v)}})
+		}
+	case *schemapb.ScalarField_DoubleData:
+		if !typeutil.IsFloatingType(dataType) {
+			return nil, mismatch
+		}
+		for _, v := range data.DoubleData.GetData() {
+			gvs = append(gvs, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type of template value %s", name)
+	}
+	return gvs, nil
+}
+
+// handleTemplateValue returns the single value bound to the placeholder
+func (context *ParserContext) handleTemplateValue(name string, dataType schemapb.DataType) (*planpb.GenericValue, error) {
+	gvs, err := context.handleTemplateValues(name, dataType)
+	if err != nil {
+		return nil, err
+	}
+	if len(gvs) != 1 {
+		return nil, fmt.Errorf("template value %s should be a single value, got %d values", name, len(gvs))
+	}
+	return gvs[0], nil
+}
+
+func integerGenericValue(v int64, dataType schemapb.DataType) (*planpb.GenericValue, error) {
+	if typeutil.IsIntegerType(dataType) {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}, nil
+	}
+	if typeutil.IsFloatingType(dataType) {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: float64(v)}}, nil
+	}
+	return nil, fmt.Errorf("type mismatch")
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func longTemplateValue(name string, data ...int64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Int64,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+			},
+		},
+	}
+}
+
+func doubleTemplateValue(name string, data ...float64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Double,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}},
+			},
+		},
+	}
+}
+
+func TestRewriteExprTemplate(t *testing.T) {
+	exprStr, err := rewriteExprTemplate("id in {list} && {low} < age < {high_2}")
+	assert.Nil(t, err)
+	assert.Equal(t, "id in $list && $low < age < $high_2", exprStr)
+
+	exprStr, err = rewriteExprTemplate("age > 1")
+	assert.Nil(t, err)
+	assert.Equal(t, "age > 1", exprStr)
+
+	invalidTemplates := []string{
+		"id in {list",
+		"id in {}",
+		"id in {1a}",
+		"id in {a b}",
+		"id in $list",
+	}
+	for _, template := range invalidTemplates {
+		_, err = rewriteExprTemplate(template)
+		assert.Error(t, err, template)
+	}
+}
+
+func TestExprTemplateCache(t *testing.T) {
+	c := newExprTemplateCache(2)
+	node, err := c.get("a > {x}")
+	assert.Nil(t, err)
+	cached, err := c.get("a > {x}")
+	assert.Nil(t, err)
+	assert.Same(t, node, cached)
+
+	_, err = c.get("b > {x}")
+	assert.Nil(t, err)
+	_, err = c.get("c > {x}")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c.lru.Len())
+	_, ok := c.entries["a > {x}"]
+	assert.False(t, ok)
+
+	_, err = c.get("a > {x")
+	assert.Error(t, err)
+	assert.Equal(t, 2, c.lru.Len())
+
+	var disabled *exprTemplateCache
+	_, err = disabled.get("a > {x}")
+	assert.Nil(t, err)
+}
+
+func TestParseQueryExprTemplate(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int32},
+		{FieldID: 103, Name: "score", DataType: schemapb.DataType_Double},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "test", Fields: fields})
+	assert.Nil(t, err)
+
+	expr, err := parseQueryExprTemplate(schema, "id in {ids}", []*schemapb.FieldData{longTemplateValue("ids", 1, 2, 3)})
+	assert.Nil(t, err)
+	termExpr := expr.GetTermExpr()
+	assert.NotNil(t, termExpr)
+	assert.Equal(t, int64(101), termExpr.ColumnInfo.FieldId)
+	assert.Equal(t, 3, len(termExpr.Values))
+	assert.Equal(t, int64(3), termExpr.Values[2].GetInt64Val())
+
+	expr, err = parseQueryExprTemplate(schema, "{low} < age <= {high}", []*schemapb.FieldData{
+		longTemplateValue("low", 10),
+		longTemplateValue("high", 20),
+	})
+	assert.Nil(t, err)
+	rangeExpr := expr.GetBinaryRangeExpr()
+	assert.NotNil(t, rangeExpr)
+	assert.Equal(t, int64(10), rangeExpr.LowerValue.GetInt64Val())
+	assert.Equal(t, int64(20), rangeExpr.UpperValue.GetInt64Val())
+	assert.False(t, rangeExpr.LowerInclusive)
+	assert.True(t, rangeExpr.UpperInclusive)
+
+	// the integers are converted to the floating field
+	expr, err = parseQueryExprTemplate(schema, "score > {s} && age % {m} == 1", []*schemapb.FieldData{
+		longTemplateValue("s", 3),
+		longTemplateValue("m", 2),
+	})
+	assert.Nil(t, err)
+	binaryExpr := expr.GetBinaryExpr()
+	assert.NotNil(t, binaryExpr)
+	assert.Equal(t, float64(3), binaryExpr.Left.GetUnaryRangeExpr().Value.GetFloatVal())
+	assert.Equal(t, planpb.OpType_GreaterThan, binaryExpr.Left.GetUnaryRangeExpr().Op)
+	assert.Equal(t, int64(2), binaryExpr.Right.GetBinaryArithOpEvalRangeExpr().RightOperand.GetInt64Val())
+
+	// the same template is bound to other values
+	expr, err = parseQueryExprTemplate(schema, "id in {ids}", []*schemapb.FieldData{longTemplateValue("ids", 4)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(expr.GetTermExpr().Values))
+	assert.Equal(t, int64(4), expr.GetTermExpr().Values[0].GetInt64Val())
+
+	// a placeholder of a single value in a constant array
+	expr, err = parseQueryExprTemplate(schema, "id in [1, {x}]", []*schemapb.FieldData{longTemplateValue("x", 5)})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(expr.GetTermExpr().Values))
+	assert.Equal(t, int64(5), expr.GetTermExpr().Values[1].GetInt64Val())
+
+	invalidCases := []struct {
+		template string
+		values   []*schemapb.FieldData
+	}{
+		{"id in {ids}", []*schemapb.FieldData{doubleTemplateValue("ids", 1.5)}},
+		{"id in {ids}", []*schemapb.FieldData{longTemplateValue("other", 1)}},
+		{"id in {ids}", []*schemapb.FieldData{longTemplateValue("ids", 1), longTemplateValue("other", 1)}},
+		{"id in {ids}", []*schemapb.FieldData{longTemplateValue("ids", 1), longTemplateValue("ids", 2)}},
+		{"id in {ids}", []*schemapb.FieldData{{FieldName: "ids"}}},
+		{"age > {x}", []*schemapb.FieldData{longTemplateValue("x", 1, 2)}},
+		{"{x} > 1", []*schemapb.FieldData{longTemplateValue("x", 1)}},
+		{"{x} in [1, 2]", []*schemapb.FieldData{longTemplateValue("x", 1)}},
+		{"age > -{x}", []*schemapb.FieldData{longTemplateValue("x", 1)}},
+		{"id in [1, {x}]", []*schemapb.FieldData{longTemplateValue("x", 1, 2)}},
+	}
+	for _, c := range invalidCases {
+		_, err = parseQueryExprTemplate(schema, c.template, c.values)
+		assert.Error(t, err, c.template)
+	}
+}
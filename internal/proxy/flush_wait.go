@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+const (
+	// flushWaitInterval is the interval of checking whether the sealed segments are flushed
+	flushWaitInterval = 200 * time.Millisecond
+	// flushWaitTimeout is the max time of waiting for the sealed segments flushed
+	flushWaitTimeout = time.Minute
+)
+
+// flushAndWait seals the segments of the collection and waits until they are flushed, so that the row counts of
+// the collection in data coord are the ones of the binlogs instead of the ones reported by the data nodes
+// periodically
+func flushAndWait(ctx context.Context, dataCoord types.DataCoord, collectionID UniqueID) error {
+	ctx, cancel := context.WithTimeout(ctx, flushWaitTimeout)
+	defer cancel()
+
+	resp, err := dataCoord.Flush(ctx, &datapb.FlushRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Flush,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+
+	segmentIDs := resp.SegmentIDs
+	ticker := time.NewTicker(flushWaitInterval)
+	defer ticker.Stop()
+	for len(segmentIDs) > 0 {
+		statesResp, err := dataCoord.GetSegmentStates(ctx, &datapb.GetSegmentStatesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_SegmentInfo,
+				SourceID: Params.ProxyID,
+			},
+			SegmentIDs: segmentIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if statesResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(statesResp.Status.Reason)
+		}
+		unflushed := make([]UniqueID, 0, len(segmentIDs))
+		for _, state := range statesResp.States {
+			// the compacted segments are dropped after flushed, and the missing ones are purged
+			switch state.State {
+			case commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed, commonpb.SegmentState_Flushing:
+				unflushed = append(unflushed, state.SegmentID)
+			}
+		}
+		segmentIDs = unflushed
+		if len(segmentIDs) == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("segments %v of collection %d are not flushed in time: %w", segmentIDs, collectionID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type flushWaitDataCoordMock struct {
+	types.DataCoord
+	sealed []UniqueID
+	// checks is the number of the state checks before the sealed segments are flushed
+	checks int
+}
+
+func (coord *flushWaitDataCoordMock) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	return &datapb.FlushResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: req.CollectionID,
+		SegmentIDs:   coord.sealed,
+	}, nil
+}
+
+func (coord *flushWaitDataCoordMock) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+	state := commonpb.SegmentState_Sealed
+	if coord.checks == 0 {
+		state = commonpb.SegmentState_Flushed
+	} else {
+		coord.checks--
+	}
+	resp := &datapb.GetSegmentStatesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, segmentID := range req.SegmentIDs {
+		resp.States = append(resp.States, &datapb.SegmentStateInfo{SegmentID: segmentID, State: state})
+	}
+	return resp, nil
+}
+
+func TestFlushAndWait(t *testing.T) {
+	// nothing to flush
+	assert.Nil(t, flushAndWait(context.Background(), &flushWaitDataCoordMock{}, 1))
+
+	dc := &flushWaitDataCoordMock{sealed: []UniqueID{1, 2}, checks: 2}
+	assert.Nil(t, flushAndWait(context.Background(), dc, 1))
+	assert.Equal(t, 0, dc.checks)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dc = &flushWaitDataCoordMock{sealed: []UniqueID{1, 2}, checks: 2}
+	assert.NotNil(t, flushAndWait(ctx, dc, 1))
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// metricsProvider is implemented by the coordinators which report the topology of their nodes
+type metricsProvider interface {
+	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+}
+
+func newComponentHealth(role string, name string, reasons []string) *milvuspb.ComponentHealth {
+	return &milvuspb.ComponentHealth{
+		Role:      role,
+		Name:      name,
+		IsHealthy: len(reasons) == 0,
+		Reasons:   reasons,
+	}
+}
+
+// checkSelfHealth checks the state and the time tick lag of dml channels of proxy itself
+func (node *Proxy) checkSelfHealth() *milvuspb.ComponentHealth {
+	name := metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID)
+	reasons := make([]string, 0)
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		reasons = append(reasons, fmt.Sprintf("%s state is %s", name, code.String()))
+	}
+	if node.chTicker != nil && Params.HealthCheckMaxTimeTickLag > 0 {
+		stats, err := node.chTicker.getMinTsStatistics()
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("%s failed to get time tick: %s", name, err.Error()))
+		}
+		for pchan, ts := range stats {
+			physical, _ := tsoutil.ParseTS(ts)
+			lag := time.Since(physical)
+			if lag > Params.HealthCheckMaxTimeTickLag {
+				reasons = append(reasons, fmt.Sprintf("%s tt lag %ds on %s", name, int64(lag.Seconds()), pchan))
+			}
+		}
+	}
+	return newComponentHealth(typeutil.ProxyRole, name, reasons)
+}
+
+// checkComponentStates checks the state of a coordinator and its sub components
+func checkComponentStates(ctx context.Context, role string, component types.Component) []*milvuspb.ComponentHealth {
+	states, err := component.GetComponentStates(ctx)
+	if err != nil {
+		return []*milvuspb.ComponentHealth{newComponentHealth(role, role, []string{fmt.Sprintf("%s is unreachable: %s", role, err.Error())})}
+	}
+	if states.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return []*milvuspb.ComponentHealth{newComponentHealth(role, role, []string{fmt.Sprintf("%s failed to get states: %s", role, states.Status.Reason)})}
+	}
+
+	ret := make([]*milvuspb.ComponentHealth, 0, len(states.SubcomponentStates)+1)
+	infos := append([]*internalpb.ComponentInfo{states.State}, states.SubcomponentStates...)
+	for _, info := range infos {
+		if info == nil {
+			continue
+		}
+		infoRole := info.Role
+		if infoRole == "" {
+			infoRole = role
+		}
+		name := metricsinfo.ConstructComponentName(infoRole, info.NodeID)
+		reasons := make([]string, 0)
+		if info.StateCode != internalpb.StateCode_Healthy {
+			reasons = append(reasons, fmt.Sprintf("%s state is %s", name, info.StateCode.String()))
+		}
+		ret = append(ret, newComponentHealth(infoRole, name, reasons))
+	}
+	return ret
+}
+
+// checkNodesHealth checks the nodes in the topology reported by a coordinator
+func checkNodesHealth(ctx context.Context, role string, provider metricsProvider) []*milvuspb.ComponentHealth {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil
+	}
+	resp, err := provider.GetMetrics(ctx, req)
+	if err != nil || resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		// the coordinator itself is checked by checkComponentStates
+		return nil
+	}
+
+	var nodes []metricsinfo.BaseComponentInfos
+	switch role {
+	case typeutil.DataCoordRole:
+		topology := metricsinfo.DataCoordTopology{}
+		if metricsinfo.UnmarshalTopology(resp.Response, &topology) == nil {
+			for _, info := range topology.Cluster.ConnectedNodes {
+				nodes = append(nodes, info.BaseComponentInfos)
+			}
+		}
+	case typeutil.QueryCoordRole:
+		topology := metricsinfo.QueryCoordTopology{}
+		if metricsinfo.UnmarshalTopology(resp.Response, &topology) == nil {
+			for _, info := range topology.Cluster.ConnectedNodes {
+				nodes = append(nodes, info.BaseComponentInfos)
+			}
+		}
+	case typeutil.IndexCoordRole:
+		topology := metricsinfo.IndexCoordTopology{}
+		if metricsinfo.UnmarshalTopology(resp.Response, &topology) == nil {
+			for _, info := range topology.Cluster.ConnectedNodes {
+				nodes = append(nodes, info.BaseComponentInfos)
+			}
+		}
+	}
+
+	ret := make([]*milvuspb.ComponentHealth, 0, len(nodes))
+	for _, info := range nodes {
+		reasons := make([]string, 0)
+		if info.HasError {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", info.Name, info.ErrorReason))
+		}
+		ret = append(ret, newComponentHealth(info.Type, info.Name, reasons))
+	}
+	return ret
+}
+
+// checkClusterHealth fans out to all the coordinators and their nodes and aggregates the unhealthy reasons
+func (node *Proxy) checkClusterHealth(ctx context.Context) *milvuspb.CheckHealthResponse {
+	ctx, cancel := context.WithTimeout(ctx, Params.HealthCheckTimeout)
+	defer cancel()
+
+	type coordinator struct {
+		role      string
+		component types.Component
+		provider  metricsProvider
+	}
+	coordinators := make([]coordinator, 0, 4)
+	if node.rootCoord != nil {
+		coordinators = append(coordinators, coordinator{typeutil.RootCoordRole, node.rootCoord, nil})
+	}
+	if node.dataCoord != nil {
+		coordinators = append(coordinators, coordinator{typeutil.DataCoordRole, node.dataCoord, node.dataCoord})
+	}
+	if node.queryCoord != nil {
+		coordinators = append(coordinators, coordinator{typeutil.QueryCoordRole, node.queryCoord, node.queryCoord})
+	}
+	if node.indexCoord != nil {
+		coordinators = append(coordinators, coordinator{typeutil.IndexCoordRole, node.indexCoord, node.indexCoord})
+	}
+
+	results := make([][]*milvuspb.ComponentHealth, len(coordinators))
+	var wg sync.WaitGroup
+	for i, coord := range coordinators {
+		wg.Add(1)
+		go func(i int, coord coordinator) {
+			defer wg.Done()
+			results[i] = checkComponentStates(ctx, coord.role, coord.component)
+			if coord.provider != nil {
+				results[i] = append(results[i], checkNodesHealth(ctx, coord.role, coord.provider)...)
+			}
+		}(i, coord)
+	}
+	wg.Wait()
+
+	resp := &milvuspb.CheckHealthResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Reasons:    make([]string, 0),
+		Components: []*milvuspb.ComponentHealth{node.checkSelfHealth()},
+	}
+	for _, result := range results {
+		resp.Components = append(resp.Components, result...)
+	}
+	for _, component := range resp.Components {
+		resp.Reasons = append(resp.Reasons, component.Reasons...)
+	}
+	resp.IsHealthy = len(resp.Reasons) == 0
+	return resp
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type healthCheckDataCoordMock struct {
+	types.DataCoord
+	statesErr error
+	nodes     []metricsinfo.DataNodeInfos
+}
+
+func (coord *healthCheckDataCoordMock) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
+	if coord.statesErr != nil {
+		return nil, coord.statesErr
+	}
+	return &internalpb.ComponentStates{
+		State: &internalpb.ComponentInfo{
+			NodeID:    1,
+			Role:      typeutil.DataCoordRole,
+			StateCode: internalpb.StateCode_Healthy,
+		},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (coord *healthCheckDataCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	topology := metricsinfo.DataCoordTopology{
+		Cluster: metricsinfo.DataClusterTopology{ConnectedNodes: coord.nodes},
+	}
+	resp, err := metricsinfo.MarshalTopology(topology)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Response: resp,
+	}, nil
+}
+
+func TestProxy_CheckHealth(t *testing.T) {
+	Params.HealthCheckTimeout = time.Second
+	Params.HealthCheckMaxTimeTickLag = time.Minute
+
+	rc := NewRootCoordMock()
+	rc.updateState(internalpb.StateCode_Healthy)
+	dc := &healthCheckDataCoordMock{
+		nodes: []metricsinfo.DataNodeInfos{
+			{BaseComponentInfos: metricsinfo.BaseComponentInfos{Name: "datanode3", Type: typeutil.DataNodeRole}},
+		},
+	}
+	node := &Proxy{rootCoord: rc, dataCoord: dc}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	ctx := context.Background()
+	resp, err := node.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.True(t, resp.IsHealthy)
+	assert.Equal(t, 0, len(resp.Reasons))
+	// proxy, root coord, data coord and data node
+	assert.Equal(t, 4, len(resp.Components))
+
+	dc.nodes[0].HasError = true
+	dc.nodes[0].ErrorReason = "tt lag 600s"
+	rc.updateState(internalpb.StateCode_Abnormal)
+	node.stateCode.Store(internalpb.StateCode_Initializing)
+	resp, err = node.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	assert.Nil(t, err)
+	assert.False(t, resp.IsHealthy)
+	assert.Equal(t, 3, len(resp.Reasons))
+	assert.Contains(t, resp.Reasons, "datanode3: tt lag 600s")
+	for _, component := range resp.Components {
+		if component.Role == typeutil.DataCoordRole {
+			assert.True(t, component.IsHealthy)
+		} else {
+			assert.False(t, component.IsHealthy)
+		}
+	}
+
+	dc.statesErr = errors.New("mock")
+	resp, err = node.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	assert.Nil(t, err)
+	assert.False(t, resp.IsHealthy)
+	assert.Equal(t, 4, len(resp.Reasons))
+}
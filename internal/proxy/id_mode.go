@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/bits-and-blooms/bloom/v3"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// The id generation of a collection is configured by the type params of its primary field
+const (
+	// IDModeKey selects the allocator of the auto ids and the row ids of a collection
+	IDModeKey = "id_mode"
+	// GlobalIDMode allocates the ids from rootcoord, which is the default
+	GlobalIDMode = "global"
+	// SnowflakeIDMode allocates the ids on the proxy without a round trip to rootcoord
+	SnowflakeIDMode = "snowflake"
+
+	// PKCheckKey selects the check of the primary keys supplied by the clients, only if auto id is disabled
+	PKCheckKey = "pk_check"
+	// NoPKCheck accepts any primary key, which is the default
+	NoPKCheck = "none"
+	// StrictPKCheck rejects the negative primary keys, the duplicated ones in an insert request, and
+	// the ones which may have been inserted through this proxy according to a bloom filter
+	StrictPKCheck = "strict"
+)
+
+// idConfig is the id generation of a collection
+type idConfig struct {
+	mode    string
+	pkCheck string
+}
+
+// getIDConfig returns the id generation configured on the primary field of the schema
+func getIDConfig(schema *schemapb.CollectionSchema) (*idConfig, error) {
+	cfg := &idConfig{
+		mode:    GlobalIDMode,
+		pkCheck: NoPKCheck,
+	}
+	for _, field := range schema.Fields {
+		if !field.IsPrimaryKey {
+			continue
+		}
+		for _, param := range field.TypeParams {
+			switch param.Key {
+			case IDModeKey:
+				if param.Value != GlobalIDMode && param.Value != SnowflakeIDMode {
+					return nil, fmt.Errorf("invalid %s %s of field %s, should be %s or %s",
+						IDModeKey, param.Value, field.Name, GlobalIDMode, SnowflakeIDMode)
+				}
+				cfg.mode = param.Value
+			case PKCheckKey:
+				if param.Value != NoPKCheck && param.Value != StrictPKCheck {
+					return nil, fmt.Errorf("invalid %s %s of field %s, should be %s or %s",
+						PKCheckKey, param.Value, field.Name, NoPKCheck, StrictPKCheck)
+				}
+				if param.Value == StrictPKCheck && field.AutoID {
+					return nil, fmt.Errorf("%s %s of field %s requires auto id disabled", PKCheckKey, param.Value, field.Name)
+				}
+				cfg.pkCheck = param.Value
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// pkChecker remembers the primary keys inserted through the proxy in a bloom filter per collection.
+// The primary keys of the running inserts are reserved until the inserts finish, so that the concurrent
+// inserts of the same keys are rejected too, the keys are only added to the filter if the insert succeeds
+type pkChecker struct {
+	mu sync.Mutex
+
+	capacity          uint
+	falsePositiveRate float64
+
+	filters  map[UniqueID]*bloom.BloomFilter
+	reserved map[UniqueID]map[int64]struct{}
+}
+
+func newPKChecker(capacity uint, falsePositiveRate float64) *pkChecker {
+	return &pkChecker{
+		capacity:          capacity,
+		falsePositiveRate: falsePositiveRate,
+		filters:           make(map[UniqueID]*bloom.BloomFilter),
+		reserved:          make(map[UniqueID]map[int64]struct{}),
+	}
+}
+
+// reserve returns an error if any of the primary keys is invalid or may have been inserted before
+func (c *pkChecker) reserve(collectionID UniqueID, pks []int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filter := c.filters[collectionID]
+	reserved := c.reserved[collectionID]
+	seen := make(map[int64]struct{}, len(pks))
+	for _, pk := range pks {
+		if pk < 0 {
+			return fmt.Errorf("primary key %d is negative", pk)
+		}
+		if _, ok := seen[pk]; ok {
+			return fmt.Errorf("primary key %d is duplicated in the request", pk)
+		}
+		seen[pk] = struct{}{}
+		if _, ok := reserved[pk]; ok {
+			return fmt.Errorf("primary key %d is being inserted by another request", pk)
+		}
+		if filter != nil && filter.Test(pkToBytes(pk)) {
+			return fmt.Errorf("primary key %d may have been inserted", pk)
+		}
+	}
+
+	if reserved == nil {
+		reserved = make(map[int64]struct{}, len(pks))
+		c.reserved[collectionID] = reserved
+	}
+	for pk := range seen {
+		reserved[pk] = struct{}{}
+	}
+	return nil
+}
+
+// release releases the reserved primary keys, which are added to the filter if the insert succeeds
+func (c *pkChecker) release(collectionID UniqueID, pks []int64, inserted bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	reserved := c.reserved[collectionID]
+	for _, pk := range pks {
+		delete(reserved, pk)
+	}
+	if len(reserved) == 0 {
+		delete(c.reserved, collectionID)
+	}
+	if !inserted {
+		return
+	}
+
+	filter, ok := c.filters[collectionID]
+	if !ok {
+		filter = bloom.NewWithEstimates(c.capacity, c.falsePositiveRate)
+		c.filters[collectionID] = filter
+	}
+	for _, pk := range pks {
+		filter.Add(pkToBytes(pk))
+	}
+}
+
+// removeCollection drops the filter of a dropped collection
+func (c *pkChecker) removeCollection(collectionID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.filters, collectionID)
+	delete(c.reserved, collectionID)
+}
+
+func pkToBytes(pk int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(pk))
+	return b
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestGetIDConfig(t *testing.T) {
+	newSchema := func(autoID bool, params ...*commonpb.KeyValuePair) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{Name: "pk", IsPrimaryKey: true, AutoID: autoID, DataType: schemapb.DataType_Int64, TypeParams: params},
+				{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			},
+		}
+	}
+
+	cfg, err := getIDConfig(newSchema(true))
+	assert.Nil(t, err)
+	assert.Equal(t, GlobalIDMode, cfg.mode)
+	assert.Equal(t, NoPKCheck, cfg.pkCheck)
+
+	cfg, err = getIDConfig(newSchema(true, &commonpb.KeyValuePair{Key: IDModeKey, Value: SnowflakeIDMode}))
+	assert.Nil(t, err)
+	assert.Equal(t, SnowflakeIDMode, cfg.mode)
+
+	cfg, err = getIDConfig(newSchema(false, &commonpb.KeyValuePair{Key: PKCheckKey, Value: StrictPKCheck}))
+	assert.Nil(t, err)
+	assert.Equal(t, StrictPKCheck, cfg.pkCheck)
+
+	_, err = getIDConfig(newSchema(true, &commonpb.KeyValuePair{Key: PKCheckKey, Value: StrictPKCheck}))
+	assert.Error(t, err)
+	_, err = getIDConfig(newSchema(true, &commonpb.KeyValuePair{Key: IDModeKey, Value: "uuid"}))
+	assert.Error(t, err)
+	_, err = getIDConfig(newSchema(false, &commonpb.KeyValuePair{Key: PKCheckKey, Value: "loose"}))
+	assert.Error(t, err)
+}
+
+func TestPKChecker(t *testing.T) {
+	checker := newPKChecker(1000, 0.001)
+	collID := UniqueID(1)
+
+	assert.Error(t, checker.reserve(collID, []int64{1, -2}))
+	assert.Error(t, checker.reserve(collID, []int64{1, 2, 1}))
+
+	assert.Nil(t, checker.reserve(collID, []int64{1, 2, 3}))
+	// reserved by the running insert
+	assert.Error(t, checker.reserve(collID, []int64{3, 4}))
+	// the keys of a failed insert can be inserted again
+	checker.release(collID, []int64{1, 2, 3}, false)
+	assert.Nil(t, checker.reserve(collID, []int64{3, 4}))
+	checker.release(collID, []int64{3, 4}, true)
+
+	assert.Error(t, checker.reserve(collID, []int64{4}))
+	assert.Nil(t, checker.reserve(collID, []int64{1, 2}))
+	checker.release(collID, []int64{1, 2}, true)
+	// the filters are per collection
+	assert.Nil(t, checker.reserve(2, []int64{4}))
+
+	checker.removeCollection(collID)
+	assert.Nil(t, checker.reserve(collID, []int64{4}))
+}
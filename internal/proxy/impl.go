@@ -13,16 +13,22 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -32,6 +38,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -83,6 +92,10 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	if globalMetaCache != nil {
 		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
 	}
+	// the shards of the collection are changed, the dml stream is created again with the new channels by the next insert
+	if request.ChannelsChanged && node.chMgr != nil {
+		_ = node.chMgr.removeDMLStream(request.CollectionID)
+	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
 		zap.String("db", request.DbName),
@@ -94,6 +107,21 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+// InvalidateSegmentDistribution is called by the query coordinator once the segments of a collection are loaded,
+// released or moved between the query nodes
+func (node *Proxy) InvalidateSegmentDistribution(ctx context.Context, request *proxypb.InvalidateSegmentDistributionRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateSegmentDistribution",
+		zap.String("role", Params.RoleName),
+		zap.Int64("collectionID", request.CollectionID))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveSegmentDistribution(ctx, request.CollectionID)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	log.Debug("ReleaseDQLMessageStream",
 		zap.Any("role", Params.RoleName),
@@ -136,7 +164,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	err := node.sched.ddQueue.Enqueue(cct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -161,7 +189,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	err = cct.WaitToFinish()
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -180,6 +208,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 		rootCoord:             node.rootCoord,
 		chMgr:                 node.chMgr,
 		chTicker:              node.chTicker,
+		pkChecker:             node.pkChecker,
 	}
 
 	log.Debug("DropCollection enqueue",
@@ -189,7 +218,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	err := node.sched.ddQueue.Enqueue(dct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -242,7 +271,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 	if err != nil {
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -295,7 +324,7 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	err := node.sched.ddQueue.Enqueue(lct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -346,7 +375,7 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	err := node.sched.ddQueue.Enqueue(rct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -378,6 +407,48 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	return rct.result, nil
 }
 
+// WarmupCollection starts warming up the loaded segments of the collection on the query nodes,
+// the progress is returned by ShowCollections in the warmup percentages
+func (node *Proxy) WarmupCollection(ctx context.Context, request *milvuspb.WarmupCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	log.Debug("WarmupCollection",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.CollectionName)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	metricsReq, err := metricsinfo.ConstructWarmupCollectionRequest(collectionID)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	metricsResp, err := node.queryCoord.GetMetrics(ctx, metricsReq)
+	if err == nil && metricsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(metricsResp.Status.Reason)
+	}
+	if err != nil {
+		log.Debug("WarmupCollection failed to start warmup job on query coord", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.DescribeCollectionResponse{
@@ -399,7 +470,7 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 	if err != nil {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -445,6 +516,8 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 		Condition:                      NewTaskCondition(ctx),
 		GetCollectionStatisticsRequest: request,
 		dataCoord:                      node.dataCoord,
+		indexCoord:                     node.indexCoord,
+		queryCoord:                     node.queryCoord,
 	}
 
 	log.Debug("GetCollectionStatistics enqueue",
@@ -455,7 +528,7 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 	if err != nil {
 		return &milvuspb.GetCollectionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -511,7 +584,7 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 	if err != nil {
 		return &milvuspb.ShowCollectionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -544,6 +617,58 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 	return sct.result, nil
 }
 
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	act := &alterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	log.Debug("AlterCollection enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.Properties),
+		zap.Strings("delete_keys", request.DeleteKeys))
+	err := node.sched.ddQueue.Enqueue(act)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: merr.Code(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AlterCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("AlterCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = act.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return act.result, nil
+}
+
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
@@ -564,7 +689,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	err := node.sched.ddQueue.Enqueue(cpt)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -617,7 +742,7 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	err := node.sched.ddQueue.Enqueue(dpt)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -673,7 +798,7 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 	if err != nil {
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 			Value: false,
@@ -730,7 +855,7 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	err := node.sched.ddQueue.Enqueue(lpt)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -783,7 +908,7 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	err := node.sched.ddQueue.Enqueue(rpt)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -823,6 +948,21 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	// flush before enqueued, waiting for it in the serial dd queue blocks the other requests
+	if request.Flush {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, request.CollectionName)
+		if err == nil {
+			err = flushAndWait(ctx, node.dataCoord, collectionID)
+		}
+		if err != nil {
+			return &milvuspb.GetPartitionStatisticsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: merr.Code(err),
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
 	g := &getPartitionStatisticsTask{
 		ctx:                           ctx,
 		Condition:                     NewTaskCondition(ctx),
@@ -839,7 +979,7 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 	if err != nil {
 		return &milvuspb.GetPartitionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -898,7 +1038,7 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 	if err != nil {
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -950,7 +1090,7 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	err := node.sched.ddQueue.Enqueue(cit)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1009,7 +1149,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 	if err != nil {
 		return &milvuspb.DescribeIndexResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1073,7 +1213,7 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1135,7 +1275,7 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 	if err != nil {
 		return &milvuspb.GetIndexBuildProgressResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1200,7 +1340,7 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 	if err != nil {
 		return &milvuspb.GetIndexStateResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1264,12 +1404,18 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 				// RowData: transfer column based request to this
 			},
 		},
-		rowIDAllocator: node.idAllocator,
-		segIDAssigner:  node.segAssigner,
-		chMgr:          node.chMgr,
-		chTicker:       node.chTicker,
+		rowIDAllocator:     node.idAllocator,
+		snowflakeAllocator: node.snowflakeAllocator,
+		pkChecker:          node.pkChecker,
+		segIDAssigner:      node.segAssigner,
+		chMgr:              node.chMgr,
+		chTicker:           node.chTicker,
 	}
 	var err error
+	var mirrorTask *mirrorTask
+	if node.mirror != nil {
+		mirrorTask = node.mirror.newInsertTask(request)
+	}
 
 	log.Debug("Insert",
 		zap.String("role", Params.RoleName),
@@ -1314,7 +1460,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	)
 
 	if err != nil {
-		result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		result.Status.ErrorCode = merr.Code(err)
 		result.Status.Reason = err.Error()
 		numRows := it.req.NumRows
 		errIndex := make([]uint32, numRows)
@@ -1327,7 +1473,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 
 	err = it.WaitToFinish()
 	if err != nil {
-		result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		result.Status.ErrorCode = merr.Code(err)
 		result.Status.Reason = err.Error()
 		numRows := it.req.NumRows
 		errIndex := make([]uint32, numRows)
@@ -1344,11 +1490,117 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			errIndex[i] = i
 		}
 		it.result.ErrIndex = errIndex
+	} else {
+		metrics.ProxyInsertRowsCounter.Add(float64(request.NumRows))
+		metrics.ProxyInsertBytesCounter.Add(float64(proto.Size(request)))
+		if node.mirror != nil {
+			node.mirror.mirror(mirrorTask)
+		}
 	}
 	it.result.InsertCnt = int64(it.req.NumRows)
 	return it.result, nil
 }
 
+// StreamInsert merges the consecutive batches of the stream into one insert, the merged batches are inserted and
+// acknowledged once Params.StreamInsertBatchRows rows are pending, Params.StreamInsertAckInterval elapsed, a batch
+// can not be merged or the client closes the send direction of the stream
+func (node *Proxy) StreamInsert(stream milvuspb.MilvusService_StreamInsertServer) error {
+	if !node.checkHealthy() {
+		return stream.Send(&milvuspb.StreamInsertResponse{
+			Status: unhealthyStatus(),
+		})
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	reqCh := make(chan *milvuspb.InsertRequest)
+	recvErrCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErrCh <- err
+				return
+			}
+			select {
+			case reqCh <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	batcher := newInsertBatcher(Params.StreamInsertBatchRows)
+	var acked int64
+	ack := func() error {
+		if batcher.empty() {
+			return nil
+		}
+		batches := batcher.take()
+		results := node.insertBatches(ctx, batches)
+		acked += int64(len(batches))
+		return stream.Send(&milvuspb.StreamInsertResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Results:      results,
+			AckedBatches: acked,
+		})
+	}
+
+	ticker := time.NewTicker(Params.StreamInsertAckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErrCh:
+			if err == io.EOF {
+				return ack()
+			}
+			return err
+		case req := <-reqCh:
+			if !batcher.accepts(req) {
+				if err := ack(); err != nil {
+					return err
+				}
+			}
+			batcher.add(req)
+			if batcher.full() {
+				if err := ack(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := ack(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// insertBatches inserts the batches of a stream insert as one request, the batches are inserted one by one
+// if their columns can not be merged
+func (node *Proxy) insertBatches(ctx context.Context, batches []*milvuspb.InsertRequest) []*milvuspb.MutationResult {
+	merged, err := mergeInsertRequests(batches)
+	if err != nil {
+		log.Debug("StreamInsert failed to merge batches", zap.Int("batches", len(batches)), zap.Error(err))
+		results := make([]*milvuspb.MutationResult, 0, len(batches))
+		for _, batch := range batches {
+			results = append(results, node.insertBatches(ctx, []*milvuspb.InsertRequest{batch})...)
+		}
+		return results
+	}
+	result, err := node.Insert(ctx, merged)
+	if err != nil {
+		return failedMutationResults(batches, &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		})
+	}
+	return splitMutationResult(result, batches)
+}
+
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.MutationResult{
@@ -1361,6 +1613,10 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		Condition:     NewTaskCondition(ctx),
 		DeleteRequest: request,
 	}
+	var mirrorTask *mirrorTask
+	if node.mirror != nil {
+		mirrorTask = node.mirror.newDeleteTask(request)
+	}
 
 	log.Debug("Delete enqueue",
 		zap.String("role", Params.RoleName),
@@ -1372,7 +1628,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	if err != nil {
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1407,17 +1663,15 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 			},
 		}, nil
 	}
+	if node.mirror != nil && (dt.result == nil || dt.result.Status.ErrorCode == commonpb.ErrorCode_Success) {
+		node.mirror.mirror(mirrorTask)
+	}
 
 	return dt.result, nil
 }
 
-func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
-	if !node.checkHealthy() {
-		return &milvuspb.SearchResults{
-			Status: unhealthyStatus(),
-		}, nil
-	}
-	qt := &searchTask{
+func (node *Proxy) newSearchTask(ctx context.Context, request *milvuspb.SearchRequest) *searchTask {
+	return &searchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
 		SearchRequest: &internalpb.SearchRequest{
@@ -1427,11 +1681,29 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			},
 			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 		},
-		resultBuf: make(chan []*internalpb.SearchResults),
-		query:     request,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
+		resultBuf:  make(chan *internalpb.SearchResults),
+		resultDone: make(chan struct{}),
+		query:      request,
+		chMgr:      node.chMgr,
+		qc:         node.queryCoord,
+		stages:     slowlog.NewStages(),
+	}
+}
+
+func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if node.merger != nil {
+		return node.merger.search(ctx, request)
 	}
+	return node.search(ctx, request)
+}
+
+func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	qt := node.newSearchTask(ctx, request)
 
 	log.Debug("Search enqueue",
 		zap.String("role", Params.RoleName),
@@ -1441,11 +1713,16 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		zap.Any("dsl", request.Dsl),
 		zap.Any("len(PlaceholderGroup)", len(request.PlaceholderGroup)),
 		zap.Any("OutputFields", request.OutputFields))
+	var shadowRequest *milvuspb.SearchRequest
+	if node.shadow != nil {
+		shadowRequest = node.shadow.sample(request)
+	}
+	start := time.Now()
 	err := node.sched.dqQueue.Enqueue(qt)
 	if err != nil {
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1486,19 +1763,83 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		zap.Any("partitions", request.PartitionNames),
 		zap.Any("dsl", request.Dsl),
 		zap.Any("len(PlaceholderGroup)", len(request.PlaceholderGroup)))
+	node.logSlowSearch(qt, err)
 
 	if err != nil {
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
+	if qt.result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		metrics.ProxySearchVectorsCounter.Add(float64(qt.result.GetResults().GetNumQueries()))
+		if node.shadow != nil {
+			node.shadow.shadow(shadowRequest, qt.result, time.Since(start))
+		}
+	}
 	return qt.result, nil
 }
 
+// SearchStream sends the results in chunks of at most Params.SearchStreamChunkHits hits, each chunk is reduced
+// and sent before the next one, so the results of a large nq*topk are never held in one response
+func (node *Proxy) SearchStream(request *milvuspb.SearchRequest, stream milvuspb.MilvusService_SearchStreamServer) error {
+	if !node.checkHealthy() {
+		return stream.Send(&milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		})
+	}
+	qt := node.newSearchTask(stream.Context(), request)
+	chunks := 0
+	qt.sendChunk = func(chunk *milvuspb.SearchResults) error {
+		chunks++
+		return stream.Send(chunk)
+	}
+
+	err := node.sched.dqQueue.Enqueue(qt)
+	if err != nil {
+		return stream.Send(&milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    err.Error(),
+			},
+		})
+	}
+	log.Debug("SearchStream",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", qt.Base.MsgID),
+		zap.Uint64("timestamp", qt.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	err = qt.WaitToFinish()
+	log.Debug("SearchStream Finished",
+		zap.Error(err),
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", qt.Base.MsgID),
+		zap.Int("chunks", chunks))
+	node.logSlowSearch(qt, err)
+
+	if err != nil {
+		return stream.Send(&milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		})
+	}
+	if qt.result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		metrics.ProxySearchVectorsCounter.Add(float64(qt.result.GetResults().GetNumQueries()))
+	}
+	// the results are empty, or the search failed without an error
+	if chunks == 0 {
+		return stream.Send(qt.result)
+	}
+	return nil
+}
+
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
 		Status: &commonpb.Status{
@@ -1581,6 +1922,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		query:     queryRequest,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		stages:    slowlog.NewStages(),
 	}
 
 	log.Debug("Query enqueue",
@@ -1593,7 +1935,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	if err != nil {
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1617,10 +1959,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}()
 
 	err = qt.WaitToFinish()
+	node.logSlowQuery(qt, err)
 	if err != nil {
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1632,41 +1975,262 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}, nil
 }
 
-func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
-	param, _ := GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
-	metric, err := distance.ValidateMetricType(param)
-	if err != nil {
-		return &milvuspb.CalcDistanceResults{
+// QueryIterator returns the rows of a query in batches ordered by the primary keys, each batch resumes the query
+// after the last primary key of the cursor at the travel timestamp of the first batch, so the batches are stable
+// and no offset is scanned
+func (node *Proxy) QueryIterator(ctx context.Context, request *milvuspb.QueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.QueryIteratorResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	failed := func(err error) (*milvuspb.QueryIteratorResults, error) {
+		return &milvuspb.QueryIteratorResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
-		outputFields := []string{ids.FieldName}
+	query := request.GetQuery()
+	if query == nil {
+		return failed(errors.New("query iterator request has no query"))
+	}
+	batchSize, err := iteratorBatchSize(request.BatchSize)
+	if err != nil {
+		return failed(err)
+	}
+	cursor, err := unmarshalIteratorCursor(request.Cursor)
+	if err != nil {
+		return failed(err)
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, query.CollectionName)
+	if err != nil {
+		return failed(err)
+	}
+	pkField, err := iteratorPrimaryField(schema)
+	if err != nil {
+		return failed(err)
+	}
 
-		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
-			CollectionName: ids.CollectionName,
-			PartitionNames: ids.PartitionNames,
-			OutputFields:   outputFields,
-		}
+	queryRequest := &milvuspb.QueryRequest{
+		DbName:             query.DbName,
+		CollectionName:     query.CollectionName,
+		PartitionNames:     query.PartitionNames,
+		Expr:               queryIteratorExpr(query.Expr, pkField.Name, cursor),
+		OutputFields:       query.OutputFields,
+		TravelTimestamp:    cursor.Timestamp,
+		GuaranteeTimestamp: cursor.Timestamp,
+	}
 
-		qt := &queryTask{
-			ctx:       ctx,
-			Condition: NewTaskCondition(ctx),
-			RetrieveRequest: &internalpb.RetrieveRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:  commonpb.MsgType_Retrieve,
-					SourceID: Params.ProxyID,
-				},
-				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
-			},
-			resultBuf: make(chan []*internalpb.RetrieveResults),
-			query:     queryRequest,
-			chMgr:     node.chMgr,
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyID,
+			},
+			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
+			Limit:           batchSize,
+		},
+		resultBuf: make(chan []*internalpb.RetrieveResults),
+		query:     queryRequest,
+		chMgr:     node.chMgr,
+		qc:        node.queryCoord,
+		stages:    slowlog.NewStages(),
+	}
+
+	err = node.sched.dqQueue.Enqueue(qt)
+	if err != nil {
+		return failed(err)
+	}
+	log.Debug("QueryIterator",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", qt.Base.MsgID),
+		zap.Uint64("travelTimestamp", cursor.Timestamp),
+		zap.String("collection", queryRequest.CollectionName),
+		zap.String("expr", queryRequest.Expr),
+		zap.Int64("batchSize", batchSize))
+
+	err = qt.WaitToFinish()
+	node.logSlowQuery(qt, err)
+	if err != nil {
+		return failed(err)
+	}
+
+	next := &iteratorCursor{
+		Timestamp: qt.TravelTimestamp,
+		Started:   cursor.Started,
+		LastPK:    cursor.LastPK,
+	}
+	if qt.result.Status.ErrorCode == commonpb.ErrorCode_EmptyCollection {
+		return &milvuspb.QueryIteratorResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Cursor: next.marshal(),
+			Done:   true,
+		}, nil
+	}
+	if qt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return &milvuspb.QueryIteratorResults{
+			Status: qt.result.Status,
+		}, nil
+	}
+	rows, lastPK, err := lastPrimaryKey(qt.result.FieldsData, pkField)
+	if err != nil {
+		return failed(err)
+	}
+	if rows > 0 {
+		next.Started = true
+		next.LastPK = lastPK
+	}
+	return &milvuspb.QueryIteratorResults{
+		Status:     qt.result.Status,
+		FieldsData: qt.result.FieldsData,
+		Cursor:     next.marshal(),
+		Done:       int64(rows) < batchSize,
+	}, nil
+}
+
+// SearchIterator returns the hits of a single vector search in batches of decreasing similarity, each batch
+// excludes the primary keys returned by the batches before it at the travel timestamp of the first batch
+func (node *Proxy) SearchIterator(ctx context.Context, request *milvuspb.SearchIteratorRequest) (*milvuspb.SearchIteratorResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchIteratorResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	failed := func(err error) (*milvuspb.SearchIteratorResults, error) {
+		return &milvuspb.SearchIteratorResults{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	search := request.GetSearch()
+	if search == nil {
+		return failed(errors.New("search iterator request has no search"))
+	}
+	if search.DslType != commonpb.DslType_BoolExprV1 {
+		return failed(errors.New("search iterator requires a boolean expression dsl"))
+	}
+	batchSize, err := iteratorBatchSize(request.BatchSize)
+	if err != nil {
+		return failed(err)
+	}
+	cursor, err := unmarshalIteratorCursor(request.Cursor)
+	if err != nil {
+		return failed(err)
+	}
+	remaining := Params.IteratorMaxSearchHits - int64(len(cursor.Returned))
+	if remaining <= 0 {
+		return failed(fmt.Errorf("search iterator returned more than %d hits", Params.IteratorMaxSearchHits))
+	}
+	if batchSize > remaining {
+		batchSize = remaining
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, search.CollectionName)
+	if err != nil {
+		return failed(err)
+	}
+	pkField, err := iteratorPrimaryField(schema)
+	if err != nil {
+		return failed(err)
+	}
+
+	searchRequest := proto.Clone(search).(*milvuspb.SearchRequest)
+	searchRequest.Dsl = searchIteratorExpr(search.Dsl, pkField.Name, cursor)
+	searchRequest.SearchParams = withTopK(search.SearchParams, batchSize)
+	searchRequest.TravelTimestamp = cursor.Timestamp
+	searchRequest.GuaranteeTimestamp = cursor.Timestamp
+	qt := node.newSearchTask(ctx, searchRequest)
+
+	err = node.sched.dqQueue.Enqueue(qt)
+	if err != nil {
+		return failed(err)
+	}
+	log.Debug("SearchIterator",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", qt.Base.MsgID),
+		zap.Uint64("travelTimestamp", cursor.Timestamp),
+		zap.String("collection", searchRequest.CollectionName),
+		zap.Int("returned", len(cursor.Returned)),
+		zap.Int64("batchSize", batchSize))
+
+	err = qt.WaitToFinish()
+	node.logSlowSearch(qt, err)
+	if err != nil {
+		return failed(err)
+	}
+	if qt.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return &milvuspb.SearchIteratorResults{
+			Status: qt.result.GetStatus(),
+		}, nil
+	}
+
+	results := qt.result.GetResults()
+	next := &iteratorCursor{
+		Timestamp: qt.SearchRequest.TravelTimestamp,
+		Returned:  cursor.Returned,
+	}
+	if results == nil {
+		return &milvuspb.SearchIteratorResults{
+			Status: qt.result.Status,
+			Cursor: next.marshal(),
+			Done:   true,
+		}, nil
+	}
+	if results.NumQueries != 1 {
+		return failed(fmt.Errorf("search iterator requires a single query, got %d", results.NumQueries))
+	}
+	ids := results.GetIds().GetIntId().GetData()
+	next.Returned = append(append(make([]int64, 0, len(cursor.Returned)+len(ids)), cursor.Returned...), ids...)
+	return &milvuspb.SearchIteratorResults{
+		Status:  qt.result.Status,
+		Results: results,
+		Cursor:  next.marshal(),
+		Done:    int64(len(ids)) < batchSize || int64(len(next.Returned)) >= Params.IteratorMaxSearchHits,
+	}, nil
+}
+
+func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+	param, _ := GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
+	metric, err := distance.ValidateMetricType(param)
+	if err != nil {
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		outputFields := []string{ids.FieldName}
+
+		queryRequest := &milvuspb.QueryRequest{
+			DbName:         "",
+			CollectionName: ids.CollectionName,
+			PartitionNames: ids.PartitionNames,
+			OutputFields:   outputFields,
+		}
+
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
+			},
+			resultBuf: make(chan []*internalpb.RetrieveResults),
+			query:     queryRequest,
+			chMgr:     node.chMgr,
 			qc:        node.queryCoord,
 			ids:       ids.IdArray,
 		}
@@ -1675,7 +2239,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		if err != nil {
 			return &milvuspb.QueryResults{
 				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					ErrorCode: merr.Code(err),
 					Reason:    err.Error(),
 				},
 			}, err
@@ -1868,6 +2432,27 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 	}
 
 	if vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetBinaryVector() != nil {
+		if metric == distance.JACCARD || metric == distance.SUBSTRUCTURE || metric == distance.SUPERSTRUCTURE {
+			distances, err := distance.CalcBinaryDistance(vectorsLeft.Dim, vectorsLeft.GetBinaryVector(), vectorsRight.GetBinaryVector(), metric)
+			if err != nil {
+				return &milvuspb.CalcDistanceResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    err.Error(),
+					},
+				}, nil
+			}
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+				Array: &milvuspb.CalcDistanceResults_FloatDist{
+					FloatDist: &schemapb.FloatArray{
+						Data: distances,
+					},
+				},
+			}, nil
+		}
+
 		hamming, err := distance.CalcHammingDistance(vectorsLeft.Dim, vectorsLeft.GetBinaryVector(), vectorsRight.GetBinaryVector())
 		if err != nil {
 			return &milvuspb.CalcDistanceResults{
@@ -1943,25 +2528,27 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
-	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
 	if err != nil {
-		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
+		resp.Status.Reason = fmt.Errorf("getCollectionID, err:%w", err).Error()
 		return resp, nil
 	}
-	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+	// list the flushed segments of all the partitions at once instead of a ShowSegments per partition
+	infoResp, err := node.dataCoord.ListSegments(ctx, &datapb.ListSegmentsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_SegmentInfo,
 			MsgID:     0,
 			Timestamp: 0,
 			SourceID:  Params.ProxyID,
 		},
-		SegmentIDs: segments,
+		CollectionID: collectionID,
+		States:       []commonpb.SegmentState{commonpb.SegmentState_Flushed},
 	})
-	log.Debug("GetPersistentSegmentInfo ", zap.Any("infos", infoResp.Infos), zap.Any("status", infoResp.Status))
 	if err != nil {
-		resp.Status.Reason = fmt.Errorf("dataCoord:GetSegmentInfo, err:%w", err).Error()
+		resp.Status.Reason = fmt.Errorf("dataCoord:ListSegments, err:%w", err).Error()
 		return resp, nil
 	}
+	log.Debug("GetPersistentSegmentInfo ", zap.Any("infos", infoResp.Infos), zap.Any("status", infoResp.Status))
 	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
 		resp.Status.Reason = infoResp.Status.Reason
 		return resp, nil
@@ -2039,6 +2626,99 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 	return resp, nil
 }
 
+// GetCollectionRuntimeStats returns the loaded memory, the segments, the replicas and the recent query qps
+// of the collections aggregated by query coord, all the loaded collections of the db if no collection is asked
+func (node *Proxy) GetCollectionRuntimeStats(ctx context.Context, req *milvuspb.GetCollectionRuntimeStatsRequest) (*milvuspb.GetCollectionRuntimeStatsResponse, error) {
+	log.Debug("GetCollectionRuntimeStats",
+		zap.String("role", Params.RoleName),
+		zap.String("db", req.DbName),
+		zap.Strings("collections", req.CollectionNames))
+
+	resp := &milvuspb.GetCollectionRuntimeStatsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	names := make(map[UniqueID]string)
+	collectionIDs := make([]UniqueID, 0, len(req.CollectionNames))
+	if len(req.CollectionNames) == 0 {
+		showResp, err := node.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ShowCollections,
+				SourceID: Params.ProxyID,
+			},
+			DbName: req.DbName,
+		})
+		if err == nil && showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			err = errors.New(showResp.Status.Reason)
+		}
+		if err != nil {
+			log.Debug("GetCollectionRuntimeStats failed to show collections", zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		for i, collectionID := range showResp.CollectionIds {
+			names[collectionID] = showResp.CollectionNames[i]
+		}
+	}
+	for _, collectionName := range req.CollectionNames {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		if err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		names[collectionID] = collectionName
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	metricsReq, err := metricsinfo.ConstructCollectionRuntimeStatsRequest(collectionIDs)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	metricsResp, err := node.queryCoord.GetMetrics(ctx, metricsReq)
+	if err == nil && metricsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(metricsResp.Status.Reason)
+	}
+	if err != nil {
+		log.Debug("GetCollectionRuntimeStats failed to get metrics of query coord", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	stats := make([]*metricsinfo.CollectionRuntimeStats, 0)
+	if err := json.Unmarshal([]byte(metricsResp.Response), &stats); err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Stats = make([]*milvuspb.CollectionRuntimeStats, 0, len(stats))
+	for _, s := range stats {
+		// the loaded collections of the other dbs
+		collectionName, ok := names[s.CollectionID]
+		if !ok {
+			continue
+		}
+		resp.Stats = append(resp.Stats, &milvuspb.CollectionRuntimeStats{
+			CollectionName:    collectionName,
+			CollectionID:      s.CollectionID,
+			Loaded:            len(s.NodeIDs) > 0,
+			LoadedMemoryBytes: s.LoadedMemoryBytes,
+			SealedSegments:    int64(s.SealedSegments),
+			GrowingSegments:   int64(s.GrowingSegments),
+			ReplicaNumber:     int64(s.ReplicaNumber),
+			QueryQps:          s.QueryQPS,
+			NodeIDs:           s.NodeIDs,
+		})
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 func (node *Proxy) getSegmentsOfCollection(ctx context.Context, dbName string, collectionName string) ([]UniqueID, error) {
 	describeCollectionResponse, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
@@ -2098,6 +2778,320 @@ func (node *Proxy) getSegmentsOfCollection(ctx context.Context, dbName string, c
 	return ret, nil
 }
 
+// CreateApiKey creates an api key in RootCoord, the key is only returned in the response
+func (node *Proxy) CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ApiKeyResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("CreateApiKey", zap.String("role", Params.RoleName), zap.String("name", req.Name),
+		zap.Strings("privileges", req.Privileges), zap.Float64("rate limit", req.RateLimit))
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_CreateApiKey,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.CreateApiKey(ctx, req)
+	if err != nil {
+		log.Debug("CreateApiKey failed", zap.String("name", req.Name), zap.Error(err))
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	node.apiKeyAuth.invalidate()
+	return resp, nil
+}
+
+// RotateApiKey replaces an api key with a new one, the previous key is accepted during the grace period
+func (node *Proxy) RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ApiKeyResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("RotateApiKey", zap.String("role", Params.RoleName), zap.String("name", req.Name),
+		zap.Int64("grace period", req.GracePeriod))
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_RotateApiKey,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.RotateApiKey(ctx, req)
+	if err != nil {
+		log.Debug("RotateApiKey failed", zap.String("name", req.Name), zap.Error(err))
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	node.apiKeyAuth.invalidate()
+	return resp, nil
+}
+
+// DropApiKey drops an api key, the other proxies reject it once they refresh the api keys
+func (node *Proxy) DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	log.Debug("DropApiKey", zap.String("role", Params.RoleName), zap.String("name", req.Name))
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_DropApiKey,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.DropApiKey(ctx, req)
+	if err != nil {
+		log.Debug("DropApiKey failed", zap.String("name", req.Name), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	node.apiKeyAuth.invalidate()
+	return status, nil
+}
+
+func (node *Proxy) QueryAuditLog(ctx context.Context, req *milvuspb.QueryAuditLogRequest) (*milvuspb.QueryAuditLogResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.QueryAuditLogResponse{Status: unhealthyStatus()}, nil
+	}
+	if node.auditLogger == nil {
+		return &milvuspb.QueryAuditLogResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "audit log is not enabled",
+			},
+		}, nil
+	}
+	entries, integrityErrors, err := node.auditLogger.Query(req)
+	if err != nil {
+		log.Debug("QueryAuditLog failed", zap.Error(err))
+		return &milvuspb.QueryAuditLogResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if len(integrityErrors) > 0 {
+		log.Warn("QueryAuditLog found integrity errors", zap.Strings("errors", integrityErrors))
+	}
+	return &milvuspb.QueryAuditLogResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Entries:         entries,
+		IntegrityErrors: integrityErrors,
+	}, nil
+}
+
+// GetChannelTimeTicks returns the time ticks of the dml channels of the proxy, to find the stalled channels which
+// hold the searches of the strong consistency
+func (node *Proxy) GetChannelTimeTicks(ctx context.Context, req *milvuspb.GetChannelTimeTicksRequest) (*milvuspb.GetChannelTimeTicksResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.GetChannelTimeTicksResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("GetChannelTimeTicks", zap.String("role", Params.RoleName), zap.Bool("stalled only", req.StalledOnly))
+
+	stats := node.chTicker.getTimeTickStatistics()
+	pchans := make([]pChan, 0, len(stats))
+	tss := make(map[pChan]Timestamp, len(stats))
+	for pchan, stat := range stats {
+		pchans = append(pchans, pchan)
+		tss[pchan] = stat.minTs
+	}
+	sort.Strings(pchans)
+
+	now := time.Now()
+	channels := make([]*milvuspb.ChannelTimeTick, 0, len(pchans))
+	for _, pchan := range pchans {
+		stat := stats[pchan]
+		if req.StalledOnly && !stat.stalled {
+			continue
+		}
+		var lag int64
+		if stat.minTs > 0 {
+			physical, _ := tsoutil.ParseTS(stat.minTs)
+			lag = now.Sub(physical).Milliseconds()
+		}
+		channels = append(channels, &milvuspb.ChannelTimeTick{
+			ChannelName:     pchan,
+			Timestamp:       stat.minTs,
+			LastAdvanceTime: stat.lastAdvanceTime.UnixNano() / int64(time.Millisecond),
+			Lag:             lag,
+			Stalled:         stat.stalled,
+			PendingMinTs:    stat.pendingMinTs,
+		})
+	}
+	return &milvuspb.GetChannelTimeTicksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Channels: channels,
+		Skew:     timeTickSkew(tss).Milliseconds(),
+	}, nil
+}
+
+// GetQuotaUsage returns the usages of the collection, partition and field quotas enforced by rootcoord
+func (node *Proxy) GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.GetQuotaUsageResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("GetQuotaUsage", zap.String("role", Params.RoleName), zap.String("collection", req.CollectionName))
+	req.Base = &commonpb.MsgBase{
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.GetQuotaUsage(ctx, req)
+	if err != nil {
+		log.Debug("GetQuotaUsage failed", zap.String("collection", req.CollectionName), zap.Error(err))
+		return &milvuspb.GetQuotaUsageResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// UndropCollection restores a dropped collection which isn't purged yet, it has to be loaded again to be searched
+func (node *Proxy) UndropCollection(ctx context.Context, request *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	uct := &undropCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		UndropCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	log.Debug("UndropCollection enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int64("collection_id", request.CollectionID))
+	err := node.sched.ddQueue.Enqueue(uct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: merr.Code(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("UndropCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("UndropCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = uct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return uct.result, nil
+}
+
+// ListDroppedCollections lists the dropped collections which can be restored by UndropCollection
+func (node *Proxy) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDroppedCollectionsResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("ListDroppedCollections", zap.String("role", Params.RoleName), zap.String("db", req.DbName))
+	req.Base = &commonpb.MsgBase{
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.ListDroppedCollections(ctx, req)
+	if err != nil {
+		log.Debug("ListDroppedCollections failed", zap.String("db", req.DbName), zap.Error(err))
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// DescribePartition returns the row count, the segment counts and the estimated data size of a partition, which
+// are computed from the segments in data coord
+func (node *Proxy) DescribePartition(ctx context.Context, req *milvuspb.DescribePartitionRequest) (*milvuspb.DescribePartitionResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.DescribePartitionResponse{Status: unhealthyStatus()}, nil
+	}
+	log.Debug("DescribePartition",
+		zap.String("role", Params.RoleName),
+		zap.String("db", req.DbName),
+		zap.String("collection", req.CollectionName),
+		zap.String("partition", req.PartitionName),
+		zap.Bool("flush", req.Flush))
+
+	failed := func(err error) (*milvuspb.DescribePartitionResponse, error) {
+		log.Debug("DescribePartition failed", zap.String("collection", req.CollectionName),
+			zap.String("partition", req.PartitionName), zap.Error(err))
+		return &milvuspb.DescribePartitionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	if err != nil {
+		return failed(err)
+	}
+	partInfo, err := globalMetaCache.GetPartitionInfo(ctx, req.CollectionName, req.PartitionName)
+	if err != nil {
+		return failed(err)
+	}
+	if req.Flush {
+		if err := flushAndWait(ctx, node.dataCoord, collectionID); err != nil {
+			return failed(err)
+		}
+	}
+	statsResp, err := node.dataCoord.GetPartitionStatistics(ctx, &datapb.GetPartitionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetPartitionStatistics,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+		PartitionID:  partInfo.partitionID,
+	})
+	if err == nil && statsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(statsResp.Status.Reason)
+	}
+	if err != nil {
+		return failed(err)
+	}
+	stats := statsResp.GetStatistics()
+	return &milvuspb.DescribePartitionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		PartitionID:         partInfo.partitionID,
+		CreatedTimestamp:    partInfo.createdTimestamp,
+		CreatedUtcTimestamp: partInfo.createdUtcTimestamp,
+		RowCount:            stats.GetRowCount(),
+		SegmentCount:        stats.GetSegmentCount(),
+		GrowingSegmentCount: stats.GetGrowingSegmentCount(),
+		DataSize:            stats.GetDataSize(),
+	}, nil
+}
+
 func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	failedResponse := &milvuspb.DummyResponse{
 		Response: `{"status": "fail"}`,
@@ -2225,6 +3219,38 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 		return metrics, err
 	}
 
+	if metricType == metricsinfo.DMLMirrorMetrics {
+		return node.getDMLMirrorMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.SearchShadowMetrics {
+		return node.getSearchShadowMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID), &Params)
+	}
+
+	if metricType == metricsinfo.ListConfigMetrics {
+		return node.getListConfigMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.UpdateConfigMetrics {
+		return node.getUpdateConfigMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.SystemTopologyMetrics {
+		return node.getSystemTopologyMetrics(ctx, req)
+	}
+
+	if metricType == metricsinfo.ProxyTasksMetrics {
+		return node.getProxyTasksMetrics(ctx, req)
+	}
+
 	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.ProxyID),
 		zap.String("req", req.Request),
@@ -2239,6 +3265,21 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 	}, nil
 }
 
+// CheckHealth checks the health of proxy, all the coordinators and the nodes connected to them.
+// It is not gated by the state of proxy, so an unhealthy proxy is reported in the response instead.
+func (node *Proxy) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	log.Debug("Proxy.CheckHealth",
+		zap.Int64("node_id", Params.ProxyID))
+
+	resp := node.checkClusterHealth(ctx)
+	if !resp.IsHealthy {
+		log.Warn("Proxy.CheckHealth, cluster is unhealthy",
+			zap.Int64("node_id", Params.ProxyID),
+			zap.Strings("reasons", resp.Reasons))
+	}
+	return resp, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -2246,8 +3287,5 @@ func (node *Proxy) checkHealthy() bool {
 }
 
 func unhealthyStatus() *commonpb.Status {
-	return &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		Reason:    "proxy not healthy",
-	}
+	return merr.Status(merr.Errorf(merr.ErrServiceNotReady, "proxy not healthy"))
 }
@@ -0,0 +1,219 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// iteratorCursor is the position of a query or search iterator, it is marshaled into the cursor of the responses
+// so that any proxy serves the next batch
+type iteratorCursor struct {
+	// Timestamp is the travel timestamp of all the batches, the one of the first batch
+	Timestamp Timestamp
+	// LastPK is the primary key of the last row returned by a query iterator, if Started
+	Started bool
+	LastPK  int64
+	// Returned are the primary keys returned by a search iterator, which are excluded from the next batches
+	Returned []int64
+}
+
+func (c *iteratorCursor) marshal() []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	data := make([]byte, 0, 2*binary.MaxVarintLen64+len(c.Returned)*2)
+	putUvarint := func(v uint64) {
+		n := binary.PutUvarint(buf, v)
+		data = append(data, buf[:n]...)
+	}
+
+	putUvarint(c.Timestamp)
+	if c.Started {
+		data = append(data, 1)
+		n := binary.PutVarint(buf, c.LastPK)
+		data = append(data, buf[:n]...)
+	} else {
+		data = append(data, 0)
+	}
+	// the sorted keys are encoded by their deltas
+	returned := make([]int64, len(c.Returned))
+	copy(returned, c.Returned)
+	sort.Slice(returned, func(i, j int) bool { return returned[i] < returned[j] })
+	putUvarint(uint64(len(returned)))
+	for i, pk := range returned {
+		if i == 0 {
+			n := binary.PutVarint(buf, pk)
+			data = append(data, buf[:n]...)
+			continue
+		}
+		putUvarint(uint64(pk - returned[i-1]))
+	}
+	return data
+}
+
+var errInvalidCursor = errors.New("invalid iterator cursor")
+
+// unmarshalIteratorCursor returns the cursor of the first batch if data is empty
+func unmarshalIteratorCursor(data []byte) (*iteratorCursor, error) {
+	c := &iteratorCursor{}
+	if len(data) == 0 {
+		return c, nil
+	}
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, errInvalidCursor
+		}
+		data = data[n:]
+		return v, nil
+	}
+	readVarint := func() (int64, error) {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			return 0, errInvalidCursor
+		}
+		data = data[n:]
+		return v, nil
+	}
+
+	var err error
+	if c.Timestamp, err = readUvarint(); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errInvalidCursor
+	}
+	c.Started = data[0] == 1
+	data = data[1:]
+	if c.Started {
+		if c.LastPK, err = readVarint(); err != nil {
+			return nil, err
+		}
+	}
+	num, err := readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if num > uint64(len(data)) {
+		return nil, errInvalidCursor
+	}
+	c.Returned = make([]int64, 0, num)
+	for i := uint64(0); i < num; i++ {
+		if i == 0 {
+			pk, err := readVarint()
+			if err != nil {
+				return nil, err
+			}
+			c.Returned = append(c.Returned, pk)
+			continue
+		}
+		delta, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		c.Returned = append(c.Returned, c.Returned[i-1]+int64(delta))
+	}
+	if len(data) != 0 {
+		return nil, errInvalidCursor
+	}
+	return c, nil
+}
+
+// iteratorBatchSize returns the batch size of a request, Params.IteratorMaxBatchSize if it is 0
+func iteratorBatchSize(batchSize int64) (int64, error) {
+	if batchSize == 0 {
+		return Params.IteratorMaxBatchSize, nil
+	}
+	if batchSize < 0 || batchSize > Params.IteratorMaxBatchSize {
+		return 0, fmt.Errorf("batch size should be in (0, %d], got %d", Params.IteratorMaxBatchSize, batchSize)
+	}
+	return batchSize, nil
+}
+
+// iteratorPrimaryField returns the primary field of the collection, the iterators are ordered by the int64 keys
+func iteratorPrimaryField(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			if field.DataType != schemapb.DataType_Int64 {
+				return nil, fmt.Errorf("the iterators require an int64 primary field, got %s", field.DataType.String())
+			}
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("collection %s has no primary field", schema.Name)
+}
+
+// queryIteratorExpr returns the expr of the rows after the cursor
+func queryIteratorExpr(expr string, pkName string, cursor *iteratorCursor) string {
+	conds := make([]string, 0, 2)
+	if expr != "" {
+		conds = append(conds, "("+expr+")")
+	}
+	if cursor.Started {
+		conds = append(conds, fmt.Sprintf("%s > %d", pkName, cursor.LastPK))
+	}
+	if len(conds) == 0 {
+		// the literal of MinInt64 overflows
+		return fmt.Sprintf("%s >= %d - 1", pkName, math.MinInt64+1)
+	}
+	return strings.Join(conds, " && ")
+}
+
+// searchIteratorExpr returns the expr excluding the entities returned before the cursor
+func searchIteratorExpr(expr string, pkName string, cursor *iteratorCursor) string {
+	if len(cursor.Returned) == 0 {
+		return expr
+	}
+	pks := make([]string, 0, len(cursor.Returned))
+	for _, pk := range cursor.Returned {
+		pks = append(pks, strconv.FormatInt(pk, 10))
+	}
+	exclusion := fmt.Sprintf("%s not in [%s]", pkName, strings.Join(pks, ", "))
+	if expr == "" {
+		return exclusion
+	}
+	return "(" + expr + ") && " + exclusion
+}
+
+// withTopK returns the search params with the topk replaced
+func withTopK(params []*commonpb.KeyValuePair, topk int64) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(params)+1)
+	for _, kv := range params {
+		if kv.Key != TopKKey {
+			ret = append(ret, kv)
+		}
+	}
+	return append(ret, &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(topk, 10)})
+}
+
+// lastPrimaryKey returns the num of rows and the last primary key of the results of a query iterator
+func lastPrimaryKey(fieldsData []*schemapb.FieldData, pkField *schemapb.FieldSchema) (int, int64, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.FieldId != pkField.FieldID {
+			continue
+		}
+		pks := fieldData.GetScalars().GetLongData().GetData()
+		if len(pks) == 0 {
+			return 0, 0, nil
+		}
+		return len(pks), pks[len(pks)-1], nil
+	}
+	return 0, 0, errors.New("the primary field is not retrieved")
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestIteratorCursor(t *testing.T) {
+	cursor, err := unmarshalIteratorCursor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &iteratorCursor{}, cursor)
+
+	cursors := []*iteratorCursor{
+		{Timestamp: 100},
+		{Timestamp: 100, Started: true, LastPK: math.MinInt64},
+		{Timestamp: 100, Started: true, LastPK: -1},
+		{Timestamp: 100, Returned: []int64{-5, 3, 3, math.MaxInt64}},
+	}
+	for _, c := range cursors {
+		got, err := unmarshalIteratorCursor(c.marshal())
+		assert.NoError(t, err)
+		assert.Equal(t, c.Timestamp, got.Timestamp)
+		assert.Equal(t, c.Started, got.Started)
+		assert.Equal(t, c.LastPK, got.LastPK)
+		assert.ElementsMatch(t, c.Returned, got.Returned)
+	}
+
+	// the returned keys are sorted by marshal
+	got, err := unmarshalIteratorCursor((&iteratorCursor{Returned: []int64{9, 1, 5}}).marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 5, 9}, got.Returned)
+
+	data := (&iteratorCursor{Timestamp: 100, Started: true, LastPK: 7, Returned: []int64{1, 2}}).marshal()
+	for i := 1; i < len(data); i++ {
+		_, err = unmarshalIteratorCursor(data[:i])
+		assert.Error(t, err)
+	}
+	_, err = unmarshalIteratorCursor(append(data, 0))
+	assert.Error(t, err)
+}
+
+func TestIteratorBatchSize(t *testing.T) {
+	batchSize, err := iteratorBatchSize(0)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.IteratorMaxBatchSize, batchSize)
+
+	batchSize, err = iteratorBatchSize(10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), batchSize)
+
+	_, err = iteratorBatchSize(-1)
+	assert.Error(t, err)
+	_, err = iteratorBatchSize(Params.IteratorMaxBatchSize + 1)
+	assert.Error(t, err)
+}
+
+func TestIteratorExpr(t *testing.T) {
+	assert.Equal(t, "pk >= -9223372036854775807 - 1", queryIteratorExpr("", "pk", &iteratorCursor{}))
+	assert.Equal(t, "(age > 10)", queryIteratorExpr("age > 10", "pk", &iteratorCursor{}))
+	assert.Equal(t, "(age > 10 || age < 5) && pk > 100",
+		queryIteratorExpr("age > 10 || age < 5", "pk", &iteratorCursor{Started: true, LastPK: 100}))
+	assert.Equal(t, "pk > -3", queryIteratorExpr("", "pk", &iteratorCursor{Started: true, LastPK: -3}))
+
+	assert.Equal(t, "age > 10", searchIteratorExpr("age > 10", "pk", &iteratorCursor{}))
+	assert.Equal(t, "pk not in [1, 2]", searchIteratorExpr("", "pk", &iteratorCursor{Returned: []int64{1, 2}}))
+	assert.Equal(t, "(age > 10) && pk not in [1]", searchIteratorExpr("age > 10", "pk", &iteratorCursor{Returned: []int64{1}}))
+
+	params := withTopK([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "100"},
+		{Key: "metric_type", Value: "L2"},
+	}, 10)
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: "metric_type", Value: "L2"},
+		{Key: TopKKey, Value: "10"},
+	}, params)
+}
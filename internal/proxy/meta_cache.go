@@ -16,14 +16,18 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/merr"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -36,6 +40,8 @@ type Cache interface {
 	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
 	RemoveCollection(ctx context.Context, collectionName string)
 	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetSegmentDistribution(ctx context.Context, collectionID typeutil.UniqueID) (*segmentDistribution, error)
+	RemoveSegmentDistribution(ctx context.Context, collectionID typeutil.UniqueID)
 }
 
 type collectionInfo struct {
@@ -44,6 +50,9 @@ type collectionInfo struct {
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	properties          []*commonpb.KeyValuePair
+	// expireAt is when the collection is described again, zero never
+	expireAt time.Time
 }
 
 type partitionInfo struct {
@@ -52,48 +61,79 @@ type partitionInfo struct {
 	createdUtcTimestamp uint64
 }
 
+// maxNotFoundEntries is the max num of the missing collections and partitions remembered
+const maxNotFoundEntries = 1024
+
+// notFoundKey is a missing collection, or a missing partition of it if partitionName is not empty
+type notFoundKey struct {
+	collectionName string
+	partitionName  string
+}
+
+type notFoundEntry struct {
+	err      error
+	expireAt time.Time
+}
+
 type MetaCache struct {
-	client types.RootCoord
+	client      types.RootCoord
+	queryClient types.QueryCoord
+
+	// the collections are described again after ttl, and the missing ones are remembered for negativeTTL, so that
+	// the requests of them don't hit rootcoord. Both are invalidated by rootcoord on each ddl
+	ttl         time.Duration
+	negativeTTL time.Duration
 
 	collInfo map[string]*collectionInfo
-	mu       sync.RWMutex
+	notFound map[notFoundKey]*notFoundEntry
+	// version is increased by each invalidation, the collections and partitions fetched before are not cached
+	version uint64
+	mu      sync.RWMutex
+
+	distributions map[typeutil.UniqueID]*segmentDistribution
+	// distributionVersion is increased by each invalidation, the distributions fetched before are not cached
+	distributionVersion uint64
+	distributionMu      sync.RWMutex
 }
 
 var globalMetaCache Cache
 
-func InitMetaCache(client types.RootCoord) error {
+func InitMetaCache(client types.RootCoord, queryClient types.QueryCoord) error {
 	var err error
-	globalMetaCache, err = NewMetaCache(client)
+	globalMetaCache, err = NewMetaCache(client, queryClient)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
+func NewMetaCache(client types.RootCoord, queryClient types.QueryCoord) (*MetaCache, error) {
 	return &MetaCache{
-		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		client:        client,
+		queryClient:   queryClient,
+		ttl:           Params.MetaCacheTTL,
+		negativeTTL:   Params.MetaCacheNegativeTTL,
+		collInfo:      map[string]*collectionInfo{},
+		notFound:      map[notFoundKey]*notFoundEntry{},
+		distributions: map[typeutil.UniqueID]*segmentDistribution{},
 	}, nil
 }
 
 func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionID", metrics.CacheMissLabel).Inc()
+		collInfo, err := m.loadCollection(ctx, collectionName)
 		if err != nil {
 			return 0, err
 		}
-		m.mu.Lock()
-		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
+	metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionID", metrics.CacheHitLabel).Inc()
 
 	return collInfo.collID, nil
 }
@@ -101,59 +141,140 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionInfo", metrics.CacheMissLabel).Inc()
+		var err error
+		collInfo, err = m.loadCollection(ctx, collectionName)
 		if err != nil {
 			return nil, err
 		}
-		m.mu.Lock()
-		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+	} else {
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionInfo", metrics.CacheHitLabel).Inc()
 	}
 
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	return &collectionInfo{
 		collID:              collInfo.collID,
 		schema:              collInfo.schema,
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		properties:          collInfo.properties,
 	}, nil
 }
 
 func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionSchema", metrics.CacheMissLabel).Inc()
+		collInfo, err := m.loadCollection(ctx, collectionName)
 		if err != nil {
 			return nil, err
 		}
-		m.mu.Lock()
-		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
 		return collInfo.schema, nil
 	}
 	defer m.mu.RUnlock()
+	metrics.ProxyMetaCacheCounter.WithLabelValues("GetCollectionSchema", metrics.CacheHitLabel).Inc()
 
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
-	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+// getCollection returns the cached collection, an expired one is missing. It's called under m.mu
+func (m *MetaCache) getCollection(collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[collectionName]
+	if !ok || (!collInfo.expireAt.IsZero() && time.Now().After(collInfo.expireAt)) {
+		return nil, false
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	return collInfo, true
+}
+
+// getNotFound returns the entry of a collection or partition remembered missing. It's called under m.mu
+func (m *MetaCache) getNotFound(key notFoundKey) (*notFoundEntry, bool) {
+	entry, ok := m.notFound[key]
+	if !ok || time.Now().After(entry.expireAt) {
+		return nil, false
+	}
+	return entry, true
+}
+
+// cacheNotFound remembers a collection or partition missing for negativeTTL. The expired entries are purged once
+// maxNotFoundEntries are remembered, and all the entries if none expired. It's called under m.mu
+func (m *MetaCache) cacheNotFound(key notFoundKey, err error) {
+	if m.negativeTTL <= 0 {
+		return
+	}
+	now := time.Now()
+	if len(m.notFound) >= maxNotFoundEntries {
+		for k, entry := range m.notFound {
+			if now.After(entry.expireAt) {
+				delete(m.notFound, k)
+			}
+		}
+		if len(m.notFound) >= maxNotFoundEntries {
+			m.notFound = map[notFoundKey]*notFoundEntry{}
+		}
+	}
+	m.notFound[key] = &notFoundEntry{err: err, expireAt: now.Add(m.negativeTTL)}
+}
+
+// loadCollection describes a collection and caches it, or remembers it's missing. Neither is cached if the cache is
+// invalidated meanwhile, since the collection described may be the one before the ddl
+func (m *MetaCache) loadCollection(ctx context.Context, collectionName string) (*collectionInfo, error) {
+	key := notFoundKey{collectionName: collectionName}
+	m.mu.RLock()
+	entry, ok := m.getNotFound(key)
+	version := m.version
+	m.mu.RUnlock()
+	if ok {
+		return nil, entry.err
+	}
+
+	coll, err := m.describeCollection(ctx, collectionName)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		if merr.Code(err) == commonpb.ErrorCode_CollectionNotExists && version == m.version {
+			m.cacheNotFound(key, err)
+		}
+		return nil, err
+	}
+	if version != m.version {
+		return newCollectionInfo(coll), nil
+	}
+	return m.updateCollection(coll, collectionName), nil
+}
+
+func newCollectionInfo(coll *milvuspb.DescribeCollectionResponse) *collectionInfo {
+	return &collectionInfo{
+		collID:              coll.CollectionID,
+		schema:              coll.Schema,
+		createdTimestamp:    coll.CreatedTimestamp,
+		createdUtcTimestamp: coll.CreatedUtcTimestamp,
+		properties:          coll.Properties,
+	}
+}
+
+// updateCollection caches a collection described, the partitions cached are kept unless the collection expired or
+// is created again. It's called under m.mu
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) *collectionInfo {
+	collInfo := newCollectionInfo(coll)
+	if old, ok := m.getCollection(collectionName); ok && old.collID == coll.CollectionID {
+		collInfo.partInfo = old.partInfo
+	}
+	if m.ttl > 0 {
+		collInfo.expireAt = time.Now().Add(m.ttl)
+	}
+	m.collInfo[collectionName] = collInfo
+	return collInfo
 }
 
 func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
@@ -172,14 +293,16 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
 	}
 
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
+		version := m.version
 		m.mu.RUnlock()
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetPartitions", metrics.CacheMissLabel).Inc()
 
 		partitions, err := m.showPartitions(ctx, collectionName)
 		if err != nil {
@@ -189,10 +312,9 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updatePartitions(partitions, collectionName)
+		partInfo := m.updatePartitions(partitions, collectionName, version)
 
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -200,10 +322,10 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 
 	}
 	defer m.mu.RUnlock()
+	metrics.ProxyMetaCacheCounter.WithLabelValues("GetPartitions", metrics.CacheHitLabel).Inc()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v.partitionID
 	}
 
@@ -216,9 +338,10 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 		return nil, err
 	}
 
+	key := notFoundKey{collectionName: collectionName, partitionName: partitionName}
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -226,9 +349,16 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 
 	var partInfo *partitionInfo
 	partInfo, ok = collInfo.partInfo[partitionName]
+	entry, notFound := m.getNotFound(key)
+	version := m.version
 	m.mu.RUnlock()
 
+	if !ok && notFound {
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetPartitionInfo", metrics.CacheHitLabel).Inc()
+		return nil, entry.err
+	}
 	if !ok {
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetPartitionInfo", metrics.CacheMissLabel).Inc()
 		partitions, err := m.showPartitions(ctx, collectionName)
 		if err != nil {
 			return nil, err
@@ -237,13 +367,19 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 		m.mu.Lock()
 		defer m.mu.Unlock()
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions before update", partitions), zap.Any("collectionName", collectionName))
-		m.updatePartitions(partitions, collectionName)
+		partInfos := m.updatePartitions(partitions, collectionName, version)
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		partInfo, ok = partInfos[partitionName]
 		if !ok {
-			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
+			err := fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
+			if version == m.version {
+				m.cacheNotFound(key, err)
+			}
+			return nil, err
 		}
+	} else {
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetPartitionInfo", metrics.CacheHitLabel).Inc()
 	}
 	return &partitionInfo{
 		partitionID:         partInfo.partitionID,
@@ -264,7 +400,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		return nil, err
 	}
 	if coll.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, errors.New(coll.Status.Reason)
+		return nil, merr.Error(coll.Status)
 	}
 	resp := &milvuspb.DescribeCollectionResponse{
 		Status: coll.Status,
@@ -301,7 +437,7 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 		return nil, err
 	}
 	if partitions.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, fmt.Errorf("%s", partitions.Status.Reason)
+		return nil, merr.Error(partitions.Status)
 	}
 
 	if len(partitions.PartitionIDs) != len(partitions.PartitionNames) {
@@ -312,14 +448,15 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
-	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
-			partInfo: map[string]*partitionInfo{},
-		}
+// updatePartitions caches the partitions shown and returns the partitions of the collection. They're not cached if
+// the collection is not cached or the cache is invalidated since version. It's called under m.mu
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string, version uint64) map[string]*partitionInfo {
+	var partInfo map[string]*partitionInfo
+	collInfo, ok := m.getCollection(collectionName)
+	cached := ok && version == m.version
+	if cached {
+		partInfo = collInfo.partInfo
 	}
-	partInfo := m.collInfo[collectionName].partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -333,18 +470,30 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	if cached {
+		collInfo.partInfo = partInfo
+	}
+	return partInfo
 }
 
+// RemoveCollection invalidates a collection, the partitions of it and the ones remembered missing
 func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	delete(m.collInfo, collectionName)
+	for key := range m.notFound {
+		if key.collectionName == collectionName {
+			delete(m.notFound, key)
+		}
+	}
+	m.version++
 }
 
 func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	delete(m.notFound, notFoundKey{collectionName: collectionName, partitionName: partitionName})
+	m.version++
 	_, ok := m.collInfo[collectionName]
 	if !ok {
 		return
@@ -355,3 +504,53 @@ func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partiti
 	}
 	delete(partInfo, partitionName)
 }
+
+// GetSegmentDistribution returns the query nodes serving a collection, it's cached until the query coordinator
+// invalidates it
+func (m *MetaCache) GetSegmentDistribution(ctx context.Context, collectionID typeutil.UniqueID) (*segmentDistribution, error) {
+	m.distributionMu.RLock()
+	d, ok := m.distributions[collectionID]
+	version := m.distributionVersion
+	m.distributionMu.RUnlock()
+	if ok {
+		metrics.ProxyMetaCacheCounter.WithLabelValues("GetSegmentDistribution", metrics.CacheHitLabel).Inc()
+		return d, nil
+	}
+
+	metrics.ProxyMetaCacheCounter.WithLabelValues("GetSegmentDistribution", metrics.CacheMissLabel).Inc()
+	if m.queryClient == nil {
+		return nil, errors.New("query coordinator is not set")
+	}
+	resp, err := m.queryClient.GetSegmentDistribution(ctx, &querypb.GetSegmentDistributionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SegmentInfo,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, merr.Error(resp.Status)
+	}
+	d = newSegmentDistribution(resp)
+
+	m.distributionMu.Lock()
+	defer m.distributionMu.Unlock()
+	if version == m.distributionVersion {
+		m.distributions[collectionID] = d
+	}
+	return d, nil
+}
+
+// RemoveSegmentDistribution invalidates the segment distribution of a collection, 0 invalidates all the collections
+func (m *MetaCache) RemoveSegmentDistribution(ctx context.Context, collectionID typeutil.UniqueID) {
+	m.distributionMu.Lock()
+	defer m.distributionMu.Unlock()
+	if collectionID == 0 {
+		m.distributions = map[typeutil.UniqueID]*segmentDistribution{}
+	} else {
+		delete(m.distributions, collectionID)
+	}
+	m.distributionVersion++
+}
@@ -72,7 +72,7 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
-	err := InitMetaCache(client)
+	err := InitMetaCache(client, nil)
 	assert.Nil(t, err)
 
 	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
@@ -94,7 +94,7 @@ func TestMetaCache_GetCollection(t *testing.T) {
 func TestMetaCache_GetPartitionID(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
-	err := InitMetaCache(client)
+	err := InitMetaCache(client, nil)
 	assert.Nil(t, err)
 
 	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
@@ -114,3 +114,200 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
 */
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type mockMetaCacheRootCoord struct {
+	types.RootCoord
+	collections   map[string]typeutil.UniqueID
+	partitions    []string
+	describeCalls int
+	showCalls     int
+	unavailable   bool
+	// onDescribe is called before a collection is described
+	onDescribe func()
+}
+
+func (m *mockMetaCacheRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	m.describeCalls++
+	if m.onDescribe != nil {
+		m.onDescribe()
+	}
+	if m.unavailable {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "rootcoord unavailable"},
+		}, nil
+	}
+	collID, ok := m.collections[req.CollectionName]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(req.CollectionName)),
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema:       &schemapb.CollectionSchema{Name: req.CollectionName},
+		CollectionID: collID,
+	}, nil
+}
+
+func (m *mockMetaCacheRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	m.showCalls++
+	resp := &milvuspb.ShowPartitionsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	for i, name := range m.partitions {
+		resp.PartitionNames = append(resp.PartitionNames, name)
+		resp.PartitionIDs = append(resp.PartitionIDs, typeutil.UniqueID(i+1))
+		resp.CreatedTimestamps = append(resp.CreatedTimestamps, 0)
+		resp.CreatedUtcTimestamps = append(resp.CreatedUtcTimestamps, 0)
+	}
+	return resp, nil
+}
+
+func TestMetaCache_TTL(t *testing.T) {
+	ctx := context.Background()
+	rc := &mockMetaCacheRootCoord{
+		collections: map[string]typeutil.UniqueID{"coll": 1},
+		partitions:  []string{"_default"},
+	}
+	cache, err := NewMetaCache(rc, nil)
+	assert.NoError(t, err)
+	cache.ttl = time.Hour
+
+	collID, err := cache.GetCollectionID(ctx, "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collID)
+	_, err = cache.GetPartitionID(ctx, "coll", "_default")
+	assert.NoError(t, err)
+	_, err = cache.GetCollectionSchema(ctx, "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rc.describeCalls)
+	assert.Equal(t, 1, rc.showCalls)
+
+	// described again once expired, the partitions are kept if it's the same collection
+	cache.collInfo["coll"].expireAt = time.Now().Add(-time.Second)
+	_, err = cache.GetCollectionInfo(ctx, "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rc.describeCalls)
+	_, err = cache.GetPartitionID(ctx, "coll", "_default")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rc.showCalls)
+
+	// recreated with the partitions shown again
+	rc.collections["coll"] = 2
+	cache.collInfo["coll"].expireAt = time.Now().Add(-time.Second)
+	collID, err = cache.GetCollectionID(ctx, "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), collID)
+	assert.Empty(t, cache.collInfo["coll"].partInfo)
+
+	// never expires without the ttl
+	cache.ttl = 0
+	cache.RemoveCollection(ctx, "coll")
+	_, err = cache.GetCollectionID(ctx, "coll")
+	assert.NoError(t, err)
+	assert.True(t, cache.collInfo["coll"].expireAt.IsZero())
+}
+
+func TestMetaCache_NotFound(t *testing.T) {
+	ctx := context.Background()
+	rc := &mockMetaCacheRootCoord{
+		collections: map[string]typeutil.UniqueID{"coll": 1},
+		partitions:  []string{"_default"},
+	}
+	cache, err := NewMetaCache(rc, nil)
+	assert.NoError(t, err)
+	cache.negativeTTL = time.Hour
+
+	_, err = cache.GetCollectionID(ctx, "missing")
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, merr.Code(err))
+	_, err = cache.GetCollectionSchema(ctx, "missing")
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, merr.Code(err))
+	assert.Equal(t, 1, rc.describeCalls)
+
+	// the invalidation by the creation is seen at once
+	rc.collections["missing"] = 2
+	cache.RemoveCollection(ctx, "missing")
+	collID, err := cache.GetCollectionID(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), collID)
+	assert.Equal(t, 2, rc.describeCalls)
+
+	_, err = cache.GetPartitionID(ctx, "coll", "part")
+	assert.Error(t, err)
+	_, err = cache.GetPartitionID(ctx, "coll", "part")
+	assert.Error(t, err)
+	assert.Equal(t, 1, rc.showCalls)
+
+	rc.partitions = append(rc.partitions, "part")
+	cache.RemoveCollection(ctx, "coll")
+	partID, err := cache.GetPartitionID(ctx, "coll", "part")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), partID)
+	assert.Equal(t, 2, rc.showCalls)
+
+	// the other errors aren't remembered
+	cache.RemoveCollection(ctx, "coll")
+	rc.unavailable = true
+	_, err = cache.GetCollectionID(ctx, "coll")
+	assert.Error(t, err)
+	_, ok := cache.getNotFound(notFoundKey{collectionName: "coll"})
+	assert.False(t, ok)
+}
+
+func TestMetaCache_NotFoundBounded(t *testing.T) {
+	cache, err := NewMetaCache(nil, nil)
+	assert.NoError(t, err)
+	cache.negativeTTL = time.Hour
+	for i := 0; i <= maxNotFoundEntries; i++ {
+		cache.cacheNotFound(notFoundKey{collectionName: fmt.Sprintf("coll%d", i)}, merr.ErrCollectionNotFound)
+	}
+	assert.LessOrEqual(t, len(cache.notFound), maxNotFoundEntries)
+	_, ok := cache.getNotFound(notFoundKey{collectionName: fmt.Sprintf("coll%d", maxNotFoundEntries)})
+	assert.True(t, ok)
+
+	// disabled without the negative ttl
+	cache.negativeTTL = 0
+	cache.cacheNotFound(notFoundKey{collectionName: "coll"}, merr.ErrCollectionNotFound)
+	_, ok = cache.getNotFound(notFoundKey{collectionName: "coll"})
+	assert.False(t, ok)
+}
+
+func TestMetaCache_InvalidatedWhileLoading(t *testing.T) {
+	ctx := context.Background()
+	rc := &mockMetaCacheRootCoord{
+		collections: map[string]typeutil.UniqueID{"coll": 1},
+	}
+	cache, err := NewMetaCache(rc, nil)
+	assert.NoError(t, err)
+	cache.negativeTTL = time.Hour
+
+	// the collection described before the invalidation is returned, but not cached
+	rc.onDescribe = func() { cache.RemoveCollection(ctx, "coll") }
+	collID, err := cache.GetCollectionID(ctx, "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collID)
+	_, ok := cache.collInfo["coll"]
+	assert.False(t, ok)
+
+	rc.onDescribe = func() { cache.RemoveCollection(ctx, "missing") }
+	_, err = cache.GetCollectionID(ctx, "missing")
+	assert.Error(t, err)
+	_, ok = cache.getNotFound(notFoundKey{collectionName: "missing"})
+	assert.False(t, ok)
+}
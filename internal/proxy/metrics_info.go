@@ -13,6 +13,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
@@ -406,3 +407,25 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
 	}, nil
 }
+
+func (node *Proxy) getProxyTasksMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	componentName := metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID)
+	resp, err := json.Marshal(node.sched.taskInfos())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}, nil
+}
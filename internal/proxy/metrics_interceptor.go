@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// requestCounters maps the grpc methods of proxy to their counters
+var requestCounters = map[string]*prometheus.CounterVec{
+	"CreateCollection":              metrics.ProxyCreateCollectionCounter,
+	"DropCollection":                metrics.ProxyDropCollectionCounter,
+	"HasCollection":                 metrics.ProxyHasCollectionCounter,
+	"LoadCollection":                metrics.ProxyLoadCollectionCounter,
+	"ReleaseCollection":             metrics.ProxyReleaseCollectionCounter,
+	"DescribeCollection":            metrics.ProxyDescribeCollectionCounter,
+	"GetCollectionStatistics":       metrics.ProxyGetCollectionStatisticsCounter,
+	"ShowCollections":               metrics.ProxyShowCollectionsCounter,
+	"CreatePartition":               metrics.ProxyCreatePartitionCounter,
+	"DropPartition":                 metrics.ProxyDropPartitionCounter,
+	"HasPartition":                  metrics.ProxyHasPartitionCounter,
+	"LoadPartitions":                metrics.ProxyLoadPartitionsCounter,
+	"ReleasePartitions":             metrics.ProxyReleasePartitionsCounter,
+	"GetPartitionStatistics":        metrics.ProxyGetPartitionStatisticsCounter,
+	"ShowPartitions":                metrics.ProxyShowPartitionsCounter,
+	"CreateIndex":                   metrics.ProxyCreateIndexCounter,
+	"DescribeIndex":                 metrics.ProxyDescribeIndexCounter,
+	"GetIndexState":                 metrics.ProxyGetIndexStateCounter,
+	"GetIndexBuildProgress":         metrics.ProxyGetIndexBuildProgressCounter,
+	"DropIndex":                     metrics.ProxyDropIndexCounter,
+	"Insert":                        metrics.ProxyInsertCounter,
+	"Search":                        metrics.ProxySearchCounter,
+	"Flush":                         metrics.ProxyFlushCounter,
+	"Query":                         metrics.ProxyQueryCounter,
+	"GetPersistentSegmentInfo":      metrics.ProxyGetPersistentSegmentInfoCounter,
+	"GetQuerySegmentInfo":           metrics.ProxyGetQuerySegmentInfoCounter,
+	"Dummy":                         metrics.ProxyDummyCounter,
+	"RegisterLink":                  metrics.ProxyRegisterLinkCounter,
+	"GetComponentStates":            metrics.ProxyGetComponentStatesCounter,
+	"GetStatisticsChannel":          metrics.ProxyGetStatisticsChannelCounter,
+	"InvalidateCollectionMetaCache": metrics.ProxyInvalidateCollectionMetaCacheCounter,
+	"GetDdChannel":                  metrics.ProxyGetDdChannelCounter,
+	"ReleaseDQLMessageStream":       metrics.ProxyReleaseDQLMessageStreamCounter,
+}
+
+// statusResponse is implemented by the responses which carry a status
+type statusResponse interface {
+	GetStatus() *commonpb.Status
+}
+
+// requestStatus returns the label of the result of a request
+func requestStatus(resp interface{}, err error) string {
+	if err != nil {
+		return metrics.FailLabel
+	}
+	var status *commonpb.Status
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		status = r
+	case statusResponse:
+		status = r.GetStatus()
+	}
+	if status != nil && status.ErrorCode != commonpb.ErrorCode_Success {
+		return metrics.FailLabel
+	}
+	return metrics.SuccessLabel
+}
+
+// UnaryServerInterceptor returns a grpc interceptor which counts the requests to proxy and records their latency
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		counter, ok := requestCounters[method]
+		if ok {
+			counter.WithLabelValues(metrics.TotalLabel).Inc()
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		status := requestStatus(resp, err)
+
+		if ok {
+			counter.WithLabelValues(status).Inc()
+		}
+		metrics.ProxyRequestLatency.WithLabelValues(method, status).Observe(float64(time.Since(start).Milliseconds()))
+		return resp, err
+	}
+}
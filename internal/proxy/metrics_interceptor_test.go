@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestRequestStatus(t *testing.T) {
+	success := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	fail := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+
+	assert.Equal(t, metrics.SuccessLabel, requestStatus(success, nil))
+	assert.Equal(t, metrics.FailLabel, requestStatus(fail, nil))
+	assert.Equal(t, metrics.SuccessLabel, requestStatus(&milvuspb.BoolResponse{Status: success}, nil))
+	assert.Equal(t, metrics.FailLabel, requestStatus(&milvuspb.BoolResponse{Status: fail}, nil))
+	assert.Equal(t, metrics.FailLabel, requestStatus(nil, errors.New("mock")))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection"}
+	total := testutil.ToFloat64(metrics.ProxyHasCollectionCounter.WithLabelValues(metrics.TotalLabel))
+	failed := testutil.ToFloat64(metrics.ProxyHasCollectionCounter.WithLabelValues(metrics.FailLabel))
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.BoolResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}, nil
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, total+1, testutil.ToFloat64(metrics.ProxyHasCollectionCounter.WithLabelValues(metrics.TotalLabel)))
+	assert.Equal(t, failed+1, testutil.ToFloat64(metrics.ProxyHasCollectionCounter.WithLabelValues(metrics.FailLabel)))
+}
@@ -19,6 +19,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/allocator"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
@@ -44,26 +46,97 @@ type ParamTable struct {
 	MetaRootPath     string
 	RootCoordAddress string
 	PulsarAddress    string
+	MinIOAddress     string // only used to check the reachability of the object store
 
 	RocksmqPath string // not used in Proxy
 
 	ProxyID                    UniqueID
 	TimeTickInterval           time.Duration
+	TimeTickStallThreshold     time.Duration
 	SearchResultChannelNames   []string
 	RetrieveResultChannelNames []string
 	ProxySubName               string
 	ProxyTimeTickChannelNames  []string
 	MsgStreamTimeTickBufSize   int64
 	MaxNameLength              int64
-	MaxFieldNum                int64
 	MaxShardNum                int32
-	MaxDimension               int64
+	Limits                     RequestLimits
+	TaskQueue                  TaskQueueConfig
 	DefaultPartitionName       string
 	DefaultIndexName           string
 
 	PulsarMaxMessageSize int
-	Log                  log.Config
-	RoleName             string
+	// TimestampAllocBatchSize is the num of timestamps fetched from rootcoord per rpc, the batch is discarded
+	// after TimestampSyncInterval. IDAllocBatchSize is the num of ids fetched per rpc
+	TimestampAllocBatchSize uint32
+	TimestampSyncInterval   time.Duration
+	IDAllocBatchSize        uint32
+
+	// OutputFieldOrder is the order of the output fields in the search and query results
+	OutputFieldOrder string
+
+	// the batches of a stream insert are merged until StreamInsertBatchRows rows are pending,
+	// and acknowledged at least every StreamInsertAckInterval
+	StreamInsertBatchRows   uint32
+	StreamInsertAckInterval time.Duration
+
+	// a shard of a search is retried SearchShardRetryTimes times if it fails or does not answer in SearchShardTimeout,
+	// the results of the other shards are returned as partial results if SearchPartialResults and a minority failed
+	SearchShardTimeout    time.Duration
+	SearchShardRetryTimes int
+	SearchPartialResults  bool
+	// SearchStreamChunkHits is the max num of hits of a chunk of SearchStream, a chunk holds at least one query
+	SearchStreamChunkHits int64
+	// the concurrent searches are merged up to SearchMergeMaxNQ queries, a merged search waits at most SearchMergeMaxWait
+	SearchMergeMaxNQ   int64
+	SearchMergeMaxWait time.Duration
+	// a batch of the iterators holds at most IteratorMaxBatchSize rows or hits,
+	// a search iterator returns at most IteratorMaxSearchHits hits since they are excluded by the cursor
+	IteratorMaxBatchSize  int64
+	IteratorMaxSearchHits int64
+	// ExprTemplateCacheSize is the max num of the parsed expression templates kept by the proxy
+	ExprTemplateCacheSize int
+	// EmbeddingTimeout is the timeout of a request to the embedding provider of a function
+	EmbeddingTimeout time.Duration
+
+	// MaxRowSize is the max bytes of a serialized row, it can be lowered per collection by the max_row_size property
+	MaxRowSize int64
+	Log        log.Config
+	RoleName   string
+
+	MirrorAddress     string
+	MirrorCollections []string
+	MirrorBufSize     int64
+
+	ShadowAddress     string
+	ShadowSampleRatio float64
+	ShadowMinRecall   float64
+	ShadowTimeout     time.Duration
+	ShadowBufSize     int64
+
+	SlowLogThreshold time.Duration
+	SlowLogFile      log.FileLogConfig
+
+	AccessLog      AccessLogConfig
+	AuditLog       AuditLogConfig
+	Authentication AuthenticationConfig
+
+	ApiKeyEnabled         bool
+	ApiKeyRefreshInterval time.Duration
+
+	HealthCheckTimeout        time.Duration
+	HealthCheckMaxTimeTickLag time.Duration
+
+	// MetaCacheTTL is how long a collection stays in the meta cache, 0 keeps it until it's invalidated
+	MetaCacheTTL time.Duration
+	// MetaCacheNegativeTTL is how long a missing collection or partition is remembered, 0 disables it
+	MetaCacheNegativeTTL time.Duration
+
+	ConsoleEnabled bool
+	RESTfulEnabled bool
+
+	PKCheckBloomCapacity     uint
+	PKCheckFalsePositiveRate float64
 }
 
 var Params ParamTable
@@ -85,8 +158,10 @@ func (pt *ParamTable) initParams() {
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
 	pt.initPulsarAddress()
+	pt.initMinIOAddress()
 	pt.initRocksmqPath()
 	pt.initTimeTickInterval()
+	pt.initTimeTickStallThreshold()
 	pt.initProxySubName()
 	pt.initProxyTimeTickChannelNames()
 	pt.initMsgStreamTimeTickBufSize()
@@ -94,11 +169,46 @@ func (pt *ParamTable) initParams() {
 	pt.initMaxFieldNum()
 	pt.initMaxShardNum()
 	pt.initMaxDimension()
+	pt.initMaxNQ()
+	pt.initMaxTopK()
+	pt.initMaxExprLength()
+	pt.initTaskQueue()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
 
 	pt.initPulsarMaxMessageSize()
+	pt.initMaxRowSize()
+	pt.initTimestampAlloc()
+	pt.initOutputFieldOrder()
+	pt.initStreamInsert()
+	pt.initSearchShard()
+	pt.initSearchStreamChunkHits()
+	pt.initSearchMerge()
+	pt.initIterator()
+	pt.initExprTemplateCacheSize()
+	pt.initEmbeddingTimeout()
+	pt.initIDAllocBatchSize()
 	pt.initRoleName()
+
+	pt.initMirrorAddress()
+	pt.initMirrorCollections()
+	pt.initMirrorBufSize()
+	pt.initShadowAddress()
+	pt.initShadowSampleRatio()
+	pt.initShadowMinRecall()
+	pt.initShadowTimeout()
+	pt.initShadowBufSize()
+	pt.initSlowLog()
+	pt.initAccessLog()
+	pt.initAuditLog()
+	pt.initAuthentication()
+	pt.initApiKey()
+	pt.initHealthCheckTimeout()
+	pt.initHealthCheckMaxTimeTickLag()
+	pt.initMetaCache()
+	pt.initPKCheck()
+	pt.initConsoleEnabled()
+	pt.initRESTfulEnabled()
 }
 
 func (pt *ParamTable) InitAlias(alias string) {
@@ -113,6 +223,14 @@ func (pt *ParamTable) initPulsarAddress() {
 	pt.PulsarAddress = ret
 }
 
+func (pt *ParamTable) initMinIOAddress() {
+	ret, err := pt.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	pt.MinIOAddress = ret
+}
+
 func (pt *ParamTable) initRocksmqPath() {
 	path, err := pt.Load("_RocksmqPath")
 	if err != nil {
@@ -133,6 +251,21 @@ func (pt *ParamTable) initTimeTickInterval() {
 	pt.TimeTickInterval = time.Duration(interval) * time.Millisecond
 }
 
+func (pt *ParamTable) initTimeTickStallThreshold() {
+	str, err := pt.LoadWithDefault("proxy.timeTickStallThreshold", "10")
+	if err != nil {
+		panic(err)
+	}
+	threshold, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if threshold < 0 {
+		panic(fmt.Errorf("proxy.timeTickStallThreshold must not be negative, got %d", threshold))
+	}
+	pt.TimeTickStallThreshold = time.Duration(threshold) * time.Second
+}
+
 func (pt *ParamTable) initProxySubName() {
 	prefix, err := pt.Load("msgChannel.subNamePrefix.proxySubNamePrefix")
 	if err != nil {
@@ -187,7 +320,7 @@ func (pt *ParamTable) initMaxFieldNum() {
 	if err != nil {
 		panic(err)
 	}
-	pt.MaxFieldNum = maxFieldNum
+	pt.Limits.MaxFieldNum = maxFieldNum
 }
 
 func (pt *ParamTable) initMaxDimension() {
@@ -199,7 +332,40 @@ func (pt *ParamTable) initMaxDimension() {
 	if err != nil {
 		panic(err)
 	}
-	pt.MaxDimension = maxDimension
+	pt.Limits.MaxDimension = maxDimension
+}
+
+func (pt *ParamTable) loadPositiveLimit(key string, defaultValue string) int64 {
+	str, err := pt.LoadWithDefault(key, defaultValue)
+	if err != nil {
+		panic(err)
+	}
+	limit, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if limit <= 0 {
+		panic(fmt.Errorf("%s must be positive, got %d", key, limit))
+	}
+	return limit
+}
+
+func (pt *ParamTable) initMaxNQ() {
+	pt.Limits.MaxNQ = pt.loadPositiveLimit("proxy.maxNQ", "16384")
+}
+
+func (pt *ParamTable) initMaxTopK() {
+	pt.Limits.MaxTopK = pt.loadPositiveLimit("proxy.maxTopK", "16384")
+}
+
+func (pt *ParamTable) initMaxExprLength() {
+	pt.Limits.MaxExprLength = pt.loadPositiveLimit("proxy.maxExprLength", "65536")
+}
+
+func (pt *ParamTable) initTaskQueue() {
+	pt.TaskQueue.MaxTaskNum = pt.loadPositiveLimit("proxy.taskQueue.maxTaskNum", "1024")
+	pt.TaskQueue.MaxDmlConcurrency = pt.loadPositiveLimit("proxy.taskQueue.maxDmlConcurrency", "256")
+	pt.TaskQueue.MaxDqlConcurrency = pt.loadPositiveLimit("proxy.taskQueue.maxDqlConcurrency", "256")
 }
 
 func (pt *ParamTable) initDefaultPartitionName() {
@@ -218,6 +384,434 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initMirrorAddress() {
+	address, err := pt.LoadWithDefault("proxy.mirror.address", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.MirrorAddress = address
+}
+
+func (pt *ParamTable) initMirrorCollections() {
+	str, err := pt.LoadWithDefault("proxy.mirror.collections", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.MirrorCollections = []string{}
+	for _, name := range strings.Split(str, ",") {
+		name = strings.TrimSpace(name)
+		if len(name) > 0 {
+			pt.MirrorCollections = append(pt.MirrorCollections, name)
+		}
+	}
+}
+
+func (pt *ParamTable) initMirrorBufSize() {
+	str, err := pt.LoadWithDefault("proxy.mirror.bufSize", "1024")
+	if err != nil {
+		panic(err)
+	}
+	bufSize, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.MirrorBufSize = bufSize
+}
+
+func (pt *ParamTable) initShadowAddress() {
+	address, err := pt.LoadWithDefault("proxy.shadow.address", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.ShadowAddress = address
+}
+
+func (pt *ParamTable) initShadowSampleRatio() {
+	str, err := pt.LoadWithDefault("proxy.shadow.sampleRatio", "0")
+	if err != nil {
+		panic(err)
+	}
+	ratio, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if ratio < 0 || ratio > 1 {
+		panic("proxy.shadow.sampleRatio should be in [0, 1]")
+	}
+	pt.ShadowSampleRatio = ratio
+}
+
+func (pt *ParamTable) initShadowMinRecall() {
+	str, err := pt.LoadWithDefault("proxy.shadow.minRecall", "0.9")
+	if err != nil {
+		panic(err)
+	}
+	recall, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.ShadowMinRecall = recall
+}
+
+func (pt *ParamTable) initShadowTimeout() {
+	str, err := pt.LoadWithDefault("proxy.shadow.timeout", "10000")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.ShadowTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initShadowBufSize() {
+	str, err := pt.LoadWithDefault("proxy.shadow.bufSize", "1024")
+	if err != nil {
+		panic(err)
+	}
+	bufSize, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.ShadowBufSize = bufSize
+}
+
+func (pt *ParamTable) initHealthCheckTimeout() {
+	str, err := pt.LoadWithDefault("proxy.healthCheck.timeout", "3000")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.HealthCheckTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initHealthCheckMaxTimeTickLag() {
+	str, err := pt.LoadWithDefault("proxy.healthCheck.maxTimeTickLag", "600")
+	if err != nil {
+		panic(err)
+	}
+	lag, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.HealthCheckMaxTimeTickLag = time.Duration(lag) * time.Second
+}
+
+func (pt *ParamTable) initMetaCache() {
+	str, err := pt.LoadWithDefault("proxy.metaCache.ttl", "600")
+	if err != nil {
+		panic(err)
+	}
+	ttl, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if ttl < 0 {
+		panic(fmt.Sprintf("proxy.metaCache.ttl should not be negative, got %d", ttl))
+	}
+	pt.MetaCacheTTL = time.Duration(ttl) * time.Second
+
+	str, err = pt.LoadWithDefault("proxy.metaCache.negativeTTL", "10")
+	if err != nil {
+		panic(err)
+	}
+	negativeTTL, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if negativeTTL < 0 {
+		panic(fmt.Sprintf("proxy.metaCache.negativeTTL should not be negative, got %d", negativeTTL))
+	}
+	pt.MetaCacheNegativeTTL = time.Duration(negativeTTL) * time.Second
+}
+
+func (pt *ParamTable) initConsoleEnabled() {
+	str, err := pt.LoadWithDefault("proxy.console.enabled", "true")
+	if err != nil {
+		panic(err)
+	}
+	pt.ConsoleEnabled, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initMaxRowSize() {
+	str, err := pt.LoadWithDefault("proxy.maxRowSize", "1048576")
+	if err != nil {
+		panic(err)
+	}
+	maxRowSize, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	// a row is never split into several messages
+	if maxRowSize <= 0 || maxRowSize > int64(pt.PulsarMaxMessageSize) {
+		panic(fmt.Errorf("proxy.maxRowSize should be in (0, %d], got %d", pt.PulsarMaxMessageSize, maxRowSize))
+	}
+	pt.MaxRowSize = maxRowSize
+}
+
+func (pt *ParamTable) initTimestampAlloc() {
+	str, err := pt.LoadWithDefault("proxy.timestampAlloc.batchSize", "10000")
+	if err != nil {
+		panic(err)
+	}
+	batchSize, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		panic(err)
+	}
+	if batchSize == 0 || batchSize > maxTimestampBatchSize {
+		panic(fmt.Errorf("proxy.timestampAlloc.batchSize should be in [1, %d], got %d", maxTimestampBatchSize, batchSize))
+	}
+	pt.TimestampAllocBatchSize = uint32(batchSize)
+
+	str, err = pt.LoadWithDefault("proxy.timestampAlloc.syncInterval", "50")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval < 0 {
+		panic(fmt.Errorf("proxy.timestampAlloc.syncInterval should not be negative, got %d", interval))
+	}
+	pt.TimestampSyncInterval = time.Duration(interval) * time.Millisecond
+}
+
+func (pt *ParamTable) initOutputFieldOrder() {
+	order, err := pt.LoadWithDefault("proxy.outputFieldOrder", OutputFieldOrderRequest)
+	if err != nil {
+		panic(err)
+	}
+	if order != OutputFieldOrderRequest && order != OutputFieldOrderSchema {
+		panic(fmt.Errorf("proxy.outputFieldOrder should be %s or %s, got %s", OutputFieldOrderRequest, OutputFieldOrderSchema, order))
+	}
+	pt.OutputFieldOrder = order
+}
+
+func (pt *ParamTable) initStreamInsert() {
+	str, err := pt.LoadWithDefault("proxy.streamInsert.batchRows", "10000")
+	if err != nil {
+		panic(err)
+	}
+	batchRows, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		panic(err)
+	}
+	if batchRows == 0 {
+		panic(fmt.Errorf("proxy.streamInsert.batchRows should be positive, got %d", batchRows))
+	}
+	pt.StreamInsertBatchRows = uint32(batchRows)
+
+	str, err = pt.LoadWithDefault("proxy.streamInsert.ackInterval", "100")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval <= 0 {
+		panic(fmt.Errorf("proxy.streamInsert.ackInterval should be positive, got %d", interval))
+	}
+	pt.StreamInsertAckInterval = time.Duration(interval) * time.Millisecond
+}
+
+func (pt *ParamTable) initSearchShard() {
+	str, err := pt.LoadWithDefault("proxy.search.shardTimeout", "5000")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if timeout <= 0 {
+		panic(fmt.Errorf("proxy.search.shardTimeout should be positive, got %d", timeout))
+	}
+	pt.SearchShardTimeout = time.Duration(timeout) * time.Millisecond
+
+	str, err = pt.LoadWithDefault("proxy.search.shardRetryTimes", "1")
+	if err != nil {
+		panic(err)
+	}
+	retryTimes, err := strconv.Atoi(str)
+	if err != nil {
+		panic(err)
+	}
+	if retryTimes < 0 {
+		panic(fmt.Errorf("proxy.search.shardRetryTimes should not be negative, got %d", retryTimes))
+	}
+	pt.SearchShardRetryTimes = retryTimes
+
+	str, err = pt.LoadWithDefault("proxy.search.partialResults", "false")
+	if err != nil {
+		panic(err)
+	}
+	pt.SearchPartialResults, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initSearchMerge() {
+	str, err := pt.LoadWithDefault("proxy.searchMerge.maxNQ", "0")
+	if err != nil {
+		panic(err)
+	}
+	maxNQ, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if maxNQ < 0 {
+		panic(fmt.Errorf("proxy.searchMerge.maxNQ should not be negative, got %d", maxNQ))
+	}
+	pt.SearchMergeMaxNQ = maxNQ
+
+	str, err = pt.LoadWithDefault("proxy.searchMerge.maxWait", "10")
+	if err != nil {
+		panic(err)
+	}
+	maxWait, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if maxWait <= 0 {
+		panic(fmt.Errorf("proxy.searchMerge.maxWait should be positive, got %d", maxWait))
+	}
+	pt.SearchMergeMaxWait = time.Duration(maxWait) * time.Millisecond
+}
+
+func (pt *ParamTable) initSearchStreamChunkHits() {
+	str, err := pt.LoadWithDefault("proxy.searchStream.chunkHits", "65536")
+	if err != nil {
+		panic(err)
+	}
+	chunkHits, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if chunkHits <= 0 {
+		panic(fmt.Errorf("proxy.searchStream.chunkHits should be positive, got %d", chunkHits))
+	}
+	pt.SearchStreamChunkHits = chunkHits
+}
+
+func (pt *ParamTable) initIterator() {
+	str, err := pt.LoadWithDefault("proxy.iterator.maxBatchSize", "10000")
+	if err != nil {
+		panic(err)
+	}
+	pt.IteratorMaxBatchSize, err = strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if pt.IteratorMaxBatchSize <= 0 {
+		panic(fmt.Errorf("proxy.iterator.maxBatchSize should be positive, got %d", pt.IteratorMaxBatchSize))
+	}
+
+	str, err = pt.LoadWithDefault("proxy.iterator.maxSearchHits", "100000")
+	if err != nil {
+		panic(err)
+	}
+	pt.IteratorMaxSearchHits, err = strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if pt.IteratorMaxSearchHits <= 0 {
+		panic(fmt.Errorf("proxy.iterator.maxSearchHits should be positive, got %d", pt.IteratorMaxSearchHits))
+	}
+}
+
+func (pt *ParamTable) initExprTemplateCacheSize() {
+	str, err := pt.LoadWithDefault("proxy.exprTemplate.cacheSize", "1024")
+	if err != nil {
+		panic(err)
+	}
+	pt.ExprTemplateCacheSize, err = strconv.Atoi(str)
+	if err != nil {
+		panic(err)
+	}
+	if pt.ExprTemplateCacheSize < 0 {
+		panic(fmt.Errorf("proxy.exprTemplate.cacheSize should not be negative, got %d", pt.ExprTemplateCacheSize))
+	}
+}
+
+func (pt *ParamTable) initEmbeddingTimeout() {
+	str, err := pt.LoadWithDefault("proxy.embedding.timeout", "10000")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if timeout <= 0 {
+		panic(fmt.Errorf("proxy.embedding.timeout should be positive, got %d", timeout))
+	}
+	pt.EmbeddingTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initIDAllocBatchSize() {
+	str, err := pt.LoadWithDefault("proxy.idAlloc.batchSize", strconv.Itoa(allocator.IDCountPerRPC))
+	if err != nil {
+		panic(err)
+	}
+	batchSize, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		panic(err)
+	}
+	if batchSize == 0 {
+		panic(fmt.Errorf("proxy.idAlloc.batchSize should be positive"))
+	}
+	pt.IDAllocBatchSize = uint32(batchSize)
+}
+
+func (pt *ParamTable) initRESTfulEnabled() {
+	str, err := pt.LoadWithDefault("proxy.restful.enabled", "true")
+	if err != nil {
+		panic(err)
+	}
+	pt.RESTfulEnabled, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initPKCheck() {
+	str, err := pt.LoadWithDefault("proxy.pkCheck.bloomCapacity", "1000000")
+	if err != nil {
+		panic(err)
+	}
+	capacity, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if capacity == 0 {
+		panic("proxy.pkCheck.bloomCapacity should be positive")
+	}
+	pt.PKCheckBloomCapacity = uint(capacity)
+
+	str, err = pt.LoadWithDefault("proxy.pkCheck.falsePositiveRate", "0.001")
+	if err != nil {
+		panic(err)
+	}
+	rate, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if rate <= 0 || rate >= 1 {
+		panic(fmt.Sprintf("proxy.pkCheck.falsePositiveRate should be in (0, 1), got %s", str))
+	}
+	pt.PKCheckFalsePositiveRate = rate
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
@@ -280,6 +874,248 @@ func (pt *ParamTable) initLogCfg() {
 	}
 }
 
+func (pt *ParamTable) initSlowLog() {
+	str, err := pt.LoadWithDefault("proxy.slowLog.threshold", "3000")
+	if err != nil {
+		panic(err)
+	}
+	threshold, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.SlowLogThreshold = time.Duration(threshold) * time.Millisecond
+
+	pt.SlowLogFile = pt.Log.File
+	if len(pt.Log.File.Filename) != 0 {
+		pt.SlowLogFile.Filename = path.Join(path.Dir(pt.Log.File.Filename), fmt.Sprintf("proxy-slow-%s.log", pt.Alias))
+	}
+}
+
+func (pt *ParamTable) initAccessLog() {
+	str, err := pt.LoadWithDefault("proxy.accessLog.enable", "false")
+	if err != nil {
+		panic(err)
+	}
+	pt.AccessLog.Enable, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+
+	pt.AccessLog.Format, err = pt.LoadWithDefault("proxy.accessLog.format", "")
+	if err != nil {
+		panic(err)
+	}
+
+	pt.AccessLog.Sink, err = pt.LoadWithDefault("proxy.accessLog.sink", AccessLogFileSink)
+	if err != nil {
+		panic(err)
+	}
+	if pt.AccessLog.Sink != AccessLogFileSink && pt.AccessLog.Sink != AccessLogMinioSink {
+		panic(fmt.Sprintf("unknown proxy.accessLog.sink %s", pt.AccessLog.Sink))
+	}
+
+	pt.AccessLog.File = pt.Log.File
+	if len(pt.Log.File.Filename) != 0 {
+		pt.AccessLog.File.Filename = path.Join(path.Dir(pt.Log.File.Filename), fmt.Sprintf("proxy-access-%s.log", pt.Alias))
+	}
+	str, err = pt.LoadWithDefault("proxy.accessLog.maxSize", "64")
+	if err != nil {
+		panic(err)
+	}
+	pt.AccessLog.File.MaxSize, err = strconv.Atoi(str)
+	if err != nil {
+		panic(err)
+	}
+	if pt.AccessLog.File.MaxSize <= 0 {
+		panic(fmt.Sprintf("proxy.accessLog.maxSize must be positive, got %d", pt.AccessLog.File.MaxSize))
+	}
+
+	pt.AccessLog.MinioPath, err = pt.LoadWithDefault("proxy.accessLog.minioPath", "access_log")
+	if err != nil {
+		panic(err)
+	}
+	pt.AccessLog.MinioPath = path.Join(pt.AccessLog.MinioPath, pt.Alias)
+	str, err = pt.LoadWithDefault("proxy.accessLog.uploadInterval", "60")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("proxy.accessLog.uploadInterval must be positive, got %d", interval))
+	}
+	pt.AccessLog.UploadInterval = time.Duration(interval) * time.Second
+
+	if pt.AccessLog.Enable && pt.AccessLog.Sink == AccessLogMinioSink {
+		pt.AccessLog.Minio = pt.loadMinioOption()
+	}
+}
+
+// loadMinioOption loads the minio option of the logs uploaded by the proxy
+func (pt *ParamTable) loadMinioOption() miniokv.Option {
+	address, err := pt.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	accessKeyID, err := pt.Load("minio.accessKeyID")
+	if err != nil {
+		panic(err)
+	}
+	secretAccessKey, err := pt.Load("minio.secretAccessKey")
+	if err != nil {
+		panic(err)
+	}
+	useSSL, err := pt.Load("minio.useSSL")
+	if err != nil {
+		panic(err)
+	}
+	bucketName, err := pt.Load("minio.bucketName")
+	if err != nil {
+		panic(err)
+	}
+	option := miniokv.Option{
+		Address:           address,
+		AccessKeyID:       accessKeyID,
+		SecretAccessKeyID: secretAccessKey,
+		BucketName:        bucketName,
+		CreateBucket:      true,
+	}
+	option.UseSSL, _ = strconv.ParseBool(useSSL)
+	return option
+}
+
+func (pt *ParamTable) initAuditLog() {
+	str, err := pt.LoadWithDefault("proxy.auditLog.enable", "false")
+	if err != nil {
+		panic(err)
+	}
+	pt.AuditLog.Enable, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+
+	pt.AuditLog.MinioPath, err = pt.LoadWithDefault("proxy.auditLog.minioPath", "audit_log")
+	if err != nil {
+		panic(err)
+	}
+	str, err = pt.LoadWithDefault("proxy.auditLog.uploadInterval", "10")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("proxy.auditLog.uploadInterval must be positive, got %d", interval))
+	}
+	pt.AuditLog.UploadInterval = time.Duration(interval) * time.Second
+
+	if pt.AuditLog.Enable {
+		pt.AuditLog.Minio = pt.loadMinioOption()
+	}
+}
+
+func (pt *ParamTable) initAuthentication() {
+	str, err := pt.LoadWithDefault("proxy.authentication.enable", "false")
+	if err != nil {
+		panic(err)
+	}
+	pt.Authentication.Enable, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+
+	pt.Authentication.Type, err = pt.LoadWithDefault("proxy.authentication.type", AuthenticationStatic)
+	if err != nil {
+		panic(err)
+	}
+	switch pt.Authentication.Type {
+	case AuthenticationStatic, AuthenticationJWT, AuthenticationPlugin:
+	default:
+		panic(fmt.Sprintf("unknown proxy.authentication.type %s", pt.Authentication.Type))
+	}
+
+	str, err = pt.LoadWithDefault("proxy.authentication.static.users", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.Authentication.StaticUsers = make(map[string]string)
+	for _, user := range strings.Split(str, ",") {
+		if user = strings.TrimSpace(user); user == "" {
+			continue
+		}
+		i := strings.IndexByte(user, ':')
+		if i <= 0 {
+			panic(fmt.Sprintf("proxy.authentication.static.users must be name:password pairs, got %s", user))
+		}
+		pt.Authentication.StaticUsers[user[:i]] = user[i+1:]
+	}
+
+	jwt := &pt.Authentication.JWT
+	if jwt.Secret, err = pt.LoadWithDefault("proxy.authentication.jwt.secret", ""); err != nil {
+		panic(err)
+	}
+	if jwt.PublicKeyFile, err = pt.LoadWithDefault("proxy.authentication.jwt.publicKeyFile", ""); err != nil {
+		panic(err)
+	}
+	if jwt.Issuer, err = pt.LoadWithDefault("proxy.authentication.jwt.issuer", ""); err != nil {
+		panic(err)
+	}
+	if jwt.Audience, err = pt.LoadWithDefault("proxy.authentication.jwt.audience", ""); err != nil {
+		panic(err)
+	}
+	if jwt.UserClaim, err = pt.LoadWithDefault("proxy.authentication.jwt.userClaim", "sub"); err != nil {
+		panic(err)
+	}
+
+	pt.Authentication.PluginPath, err = pt.LoadWithDefault("proxy.authentication.plugin.path", "")
+	if err != nil {
+		panic(err)
+	}
+	str, err = pt.LoadWithDefault("proxy.authentication.plugin.params", "")
+	if err != nil {
+		panic(err)
+	}
+	pt.Authentication.PluginParams = make(map[string]string)
+	for _, param := range strings.Split(str, ",") {
+		if param = strings.TrimSpace(param); param == "" {
+			continue
+		}
+		i := strings.IndexByte(param, '=')
+		if i <= 0 {
+			panic(fmt.Sprintf("proxy.authentication.plugin.params must be key=value pairs, got %s", param))
+		}
+		pt.Authentication.PluginParams[param[:i]] = param[i+1:]
+	}
+}
+
+func (pt *ParamTable) initApiKey() {
+	str, err := pt.LoadWithDefault("proxy.apiKey.enable", "false")
+	if err != nil {
+		panic(err)
+	}
+	pt.ApiKeyEnabled, err = strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+
+	str, err = pt.LoadWithDefault("proxy.apiKey.refreshInterval", "10")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("proxy.apiKey.refreshInterval should be positive, got %d", interval))
+	}
+	pt.ApiKeyRefreshInterval = time.Duration(interval) * time.Second
+}
+
 func (pt *ParamTable) initRoleName() {
 	pt.RoleName = fmt.Sprintf("%s-%s", "Proxy", pt.Alias)
 }
@@ -12,7 +12,11 @@
 package proxy
 
 import (
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParamTable_Normal(t *testing.T) {
@@ -30,6 +34,10 @@ func TestParamTable_Normal(t *testing.T) {
 		t.Logf("PulsarAddress: %s", Params.PulsarAddress)
 	})
 
+	t.Run("MinIOAddress", func(t *testing.T) {
+		t.Logf("MinIOAddress: %s", Params.MinIOAddress)
+	})
+
 	t.Run("RocksmqPath", func(t *testing.T) {
 		t.Logf("RocksmqPath: %s", Params.RocksmqPath)
 	})
@@ -38,6 +46,17 @@ func TestParamTable_Normal(t *testing.T) {
 		t.Logf("TimeTickInterval: %v", Params.TimeTickInterval)
 	})
 
+	t.Run("TimeTickStallThreshold", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, Params.TimeTickStallThreshold)
+
+		Params.Save("proxy.timeTickStallThreshold", "0")
+		Params.initTimeTickStallThreshold()
+		assert.Equal(t, time.Duration(0), Params.TimeTickStallThreshold)
+
+		Params.Save("proxy.timeTickStallThreshold", "10")
+		Params.initTimeTickStallThreshold()
+	})
+
 	t.Run("ProxySubName", func(t *testing.T) {
 		t.Logf("ProxySubName: %s", Params.ProxySubName)
 	})
@@ -55,7 +74,7 @@ func TestParamTable_Normal(t *testing.T) {
 	})
 
 	t.Run("MaxFieldNum", func(t *testing.T) {
-		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum)
+		t.Logf("MaxFieldNum: %d", Params.Limits.MaxFieldNum)
 	})
 
 	t.Run("MaxShardNum", func(t *testing.T) {
@@ -63,7 +82,25 @@ func TestParamTable_Normal(t *testing.T) {
 	})
 
 	t.Run("MaxDimension", func(t *testing.T) {
-		t.Logf("MaxDimension: %d", Params.MaxDimension)
+		t.Logf("MaxDimension: %d", Params.Limits.MaxDimension)
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		assert.Equal(t, int64(16384), Params.Limits.MaxNQ)
+		assert.Equal(t, int64(16384), Params.Limits.MaxTopK)
+		assert.Equal(t, int64(65536), Params.Limits.MaxExprLength)
+
+		Params.Save("proxy.maxTopK", "1024")
+		Params.initMaxTopK()
+		assert.Equal(t, int64(1024), Params.Limits.MaxTopK)
+		Params.Save("proxy.maxTopK", "16384")
+		Params.initMaxTopK()
+	})
+
+	t.Run("TaskQueue", func(t *testing.T) {
+		assert.Equal(t, int64(1024), Params.TaskQueue.MaxTaskNum)
+		assert.Equal(t, int64(256), Params.TaskQueue.MaxDmlConcurrency)
+		assert.Equal(t, int64(256), Params.TaskQueue.MaxDqlConcurrency)
 	})
 
 	t.Run("DefaultPartitionName", func(t *testing.T) {
@@ -81,6 +118,260 @@ func TestParamTable_Normal(t *testing.T) {
 	t.Run("RoleName", func(t *testing.T) {
 		t.Logf("RoleName: %s", Params.RoleName)
 	})
+
+	t.Run("Mirror", func(t *testing.T) {
+		t.Logf("MirrorAddress: %s", Params.MirrorAddress)
+		t.Logf("MirrorCollections: %v", Params.MirrorCollections)
+		t.Logf("MirrorBufSize: %d", Params.MirrorBufSize)
+
+		Params.Save("proxy.mirror.collections", "c1, c2,")
+		Params.initMirrorCollections()
+		assert.Equal(t, []string{"c1", "c2"}, Params.MirrorCollections)
+		Params.Save("proxy.mirror.collections", "")
+		Params.initMirrorCollections()
+		assert.Equal(t, 0, len(Params.MirrorCollections))
+	})
+
+	t.Run("Shadow", func(t *testing.T) {
+		t.Logf("ShadowAddress: %s", Params.ShadowAddress)
+		t.Logf("ShadowSampleRatio: %v", Params.ShadowSampleRatio)
+		t.Logf("ShadowMinRecall: %v", Params.ShadowMinRecall)
+		t.Logf("ShadowTimeout: %v", Params.ShadowTimeout)
+		t.Logf("ShadowBufSize: %d", Params.ShadowBufSize)
+
+		Params.Save("proxy.shadow.sampleRatio", "0.05")
+		Params.initShadowSampleRatio()
+		assert.Equal(t, 0.05, Params.ShadowSampleRatio)
+		Params.Save("proxy.shadow.sampleRatio", "0")
+		Params.initShadowSampleRatio()
+	})
+
+	t.Run("SlowLog", func(t *testing.T) {
+		t.Logf("SlowLogThreshold: %v", Params.SlowLogThreshold)
+		t.Logf("SlowLogFile: %s", Params.SlowLogFile.Filename)
+
+		Params.Save("proxy.slowLog.threshold", "500")
+		Params.initSlowLog()
+		assert.Equal(t, 500*time.Millisecond, Params.SlowLogThreshold)
+		assert.Equal(t, Params.Log.File.MaxSize, Params.SlowLogFile.MaxSize)
+	})
+
+	t.Run("AccessLog", func(t *testing.T) {
+		t.Logf("AccessLog: %+v", Params.AccessLog)
+
+		Params.Save("proxy.accessLog.maxSize", "16")
+		Params.initAccessLog()
+		assert.Equal(t, AccessLogFileSink, Params.AccessLog.Sink)
+		assert.Equal(t, 16, Params.AccessLog.File.MaxSize)
+		assert.Equal(t, Params.Log.File.MaxDays, Params.AccessLog.File.MaxDays)
+	})
+
+	t.Run("AuditLog", func(t *testing.T) {
+		assert.False(t, Params.AuditLog.Enable)
+		assert.Equal(t, "audit_log", Params.AuditLog.MinioPath)
+		assert.Equal(t, 10*time.Second, Params.AuditLog.UploadInterval)
+
+		Params.Save("proxy.auditLog.enable", "true")
+		Params.initAuditLog()
+		assert.True(t, Params.AuditLog.Enable)
+		assert.True(t, Params.AuditLog.Minio.CreateBucket)
+		assert.NotEmpty(t, Params.AuditLog.Minio.BucketName)
+		Params.Save("proxy.auditLog.enable", "false")
+		Params.initAuditLog()
+	})
+
+	t.Run("Authentication", func(t *testing.T) {
+		assert.False(t, Params.Authentication.Enable)
+		assert.Equal(t, AuthenticationStatic, Params.Authentication.Type)
+
+		Params.Save("proxy.authentication.static.users", "alice:a:b, bob:secret")
+		Params.Save("proxy.authentication.plugin.params", "url=ldap://localhost:389,baseDN=dc=milvus")
+		Params.initAuthentication()
+		assert.Equal(t, map[string]string{"alice": "a:b", "bob": "secret"}, Params.Authentication.StaticUsers)
+		assert.Equal(t, map[string]string{"url": "ldap://localhost:389", "baseDN": "dc=milvus"}, Params.Authentication.PluginParams)
+		assert.Equal(t, "sub", Params.Authentication.JWT.UserClaim)
+	})
+
+	t.Run("ApiKey", func(t *testing.T) {
+		assert.False(t, Params.ApiKeyEnabled)
+		assert.Equal(t, 10*time.Second, Params.ApiKeyRefreshInterval)
+
+		Params.Save("proxy.apiKey.refreshInterval", "30")
+		Params.initApiKey()
+		assert.Equal(t, 30*time.Second, Params.ApiKeyRefreshInterval)
+	})
+
+	t.Run("HealthCheck", func(t *testing.T) {
+		t.Logf("HealthCheckTimeout: %v", Params.HealthCheckTimeout)
+		t.Logf("HealthCheckMaxTimeTickLag: %v", Params.HealthCheckMaxTimeTickLag)
+
+		Params.Save("proxy.healthCheck.maxTimeTickLag", "60")
+		Params.initHealthCheckMaxTimeTickLag()
+		assert.Equal(t, time.Minute, Params.HealthCheckMaxTimeTickLag)
+	})
+
+	t.Run("MetaCache", func(t *testing.T) {
+		assert.Equal(t, 10*time.Minute, Params.MetaCacheTTL)
+		assert.Equal(t, 10*time.Second, Params.MetaCacheNegativeTTL)
+
+		Params.Save("proxy.metaCache.ttl", "0")
+		Params.Save("proxy.metaCache.negativeTTL", "0")
+		Params.initMetaCache()
+		assert.Equal(t, time.Duration(0), Params.MetaCacheTTL)
+		assert.Equal(t, time.Duration(0), Params.MetaCacheNegativeTTL)
+		Params.Save("proxy.metaCache.ttl", "600")
+		Params.Save("proxy.metaCache.negativeTTL", "10")
+		Params.initMetaCache()
+	})
+
+	t.Run("Console", func(t *testing.T) {
+		assert.True(t, Params.ConsoleEnabled)
+
+		Params.Save("proxy.console.enabled", "false")
+		Params.initConsoleEnabled()
+		assert.False(t, Params.ConsoleEnabled)
+	})
+
+	t.Run("MaxRowSize", func(t *testing.T) {
+		assert.Equal(t, int64(1048576), Params.MaxRowSize)
+
+		Params.Save("proxy.maxRowSize", "4096")
+		Params.initMaxRowSize()
+		assert.Equal(t, int64(4096), Params.MaxRowSize)
+		Params.Save("proxy.maxRowSize", "1048576")
+		Params.initMaxRowSize()
+	})
+
+	t.Run("TimestampAlloc", func(t *testing.T) {
+		assert.Equal(t, uint32(10000), Params.TimestampAllocBatchSize)
+		assert.Equal(t, 50*time.Millisecond, Params.TimestampSyncInterval)
+		assert.Equal(t, uint32(200000), Params.IDAllocBatchSize)
+
+		Params.Save("proxy.timestampAlloc.batchSize", "1")
+		Params.Save("proxy.timestampAlloc.syncInterval", "0")
+		Params.initTimestampAlloc()
+		assert.Equal(t, uint32(1), Params.TimestampAllocBatchSize)
+		assert.Equal(t, time.Duration(0), Params.TimestampSyncInterval)
+		Params.Save("proxy.timestampAlloc.batchSize", "10000")
+		Params.Save("proxy.timestampAlloc.syncInterval", "50")
+		Params.initTimestampAlloc()
+	})
+
+	t.Run("OutputFieldOrder", func(t *testing.T) {
+		assert.Equal(t, OutputFieldOrderRequest, Params.OutputFieldOrder)
+
+		Params.Save("proxy.outputFieldOrder", OutputFieldOrderSchema)
+		Params.initOutputFieldOrder()
+		assert.Equal(t, OutputFieldOrderSchema, Params.OutputFieldOrder)
+		Params.Save("proxy.outputFieldOrder", OutputFieldOrderRequest)
+		Params.initOutputFieldOrder()
+	})
+
+	t.Run("StreamInsert", func(t *testing.T) {
+		assert.Equal(t, uint32(10000), Params.StreamInsertBatchRows)
+		assert.Equal(t, 100*time.Millisecond, Params.StreamInsertAckInterval)
+
+		Params.Save("proxy.streamInsert.batchRows", "1")
+		Params.Save("proxy.streamInsert.ackInterval", "10")
+		Params.initStreamInsert()
+		assert.Equal(t, uint32(1), Params.StreamInsertBatchRows)
+		assert.Equal(t, 10*time.Millisecond, Params.StreamInsertAckInterval)
+		Params.Save("proxy.streamInsert.batchRows", "10000")
+		Params.Save("proxy.streamInsert.ackInterval", "100")
+		Params.initStreamInsert()
+	})
+
+	t.Run("SearchShard", func(t *testing.T) {
+		assert.Equal(t, 5*time.Second, Params.SearchShardTimeout)
+		assert.Equal(t, 1, Params.SearchShardRetryTimes)
+		assert.False(t, Params.SearchPartialResults)
+
+		Params.Save("proxy.search.shardTimeout", "10")
+		Params.Save("proxy.search.shardRetryTimes", "0")
+		Params.Save("proxy.search.partialResults", "true")
+		Params.initSearchShard()
+		assert.Equal(t, 10*time.Millisecond, Params.SearchShardTimeout)
+		assert.Equal(t, 0, Params.SearchShardRetryTimes)
+		assert.True(t, Params.SearchPartialResults)
+		Params.Save("proxy.search.shardTimeout", "5000")
+		Params.Save("proxy.search.shardRetryTimes", "1")
+		Params.Save("proxy.search.partialResults", "false")
+		Params.initSearchShard()
+	})
+
+	t.Run("SearchStreamChunkHits", func(t *testing.T) {
+		assert.Equal(t, int64(65536), Params.SearchStreamChunkHits)
+
+		Params.Save("proxy.searchStream.chunkHits", "100")
+		Params.initSearchStreamChunkHits()
+		assert.Equal(t, int64(100), Params.SearchStreamChunkHits)
+		Params.Save("proxy.searchStream.chunkHits", "65536")
+		Params.initSearchStreamChunkHits()
+	})
+
+	t.Run("SearchMerge", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.SearchMergeMaxNQ)
+		assert.Equal(t, 10*time.Millisecond, Params.SearchMergeMaxWait)
+
+		Params.Save("proxy.searchMerge.maxNQ", "16")
+		Params.initSearchMerge()
+		assert.Equal(t, int64(16), Params.SearchMergeMaxNQ)
+		Params.Save("proxy.searchMerge.maxNQ", "0")
+		Params.initSearchMerge()
+	})
+
+	t.Run("Iterator", func(t *testing.T) {
+		assert.Equal(t, int64(10000), Params.IteratorMaxBatchSize)
+		assert.Equal(t, int64(100000), Params.IteratorMaxSearchHits)
+
+		Params.Save("proxy.iterator.maxBatchSize", "100")
+		Params.Save("proxy.iterator.maxSearchHits", "1000")
+		Params.initIterator()
+		assert.Equal(t, int64(100), Params.IteratorMaxBatchSize)
+		assert.Equal(t, int64(1000), Params.IteratorMaxSearchHits)
+		Params.Save("proxy.iterator.maxBatchSize", "10000")
+		Params.Save("proxy.iterator.maxSearchHits", "100000")
+		Params.initIterator()
+	})
+
+	t.Run("ExprTemplateCacheSize", func(t *testing.T) {
+		assert.Equal(t, 1024, Params.ExprTemplateCacheSize)
+
+		Params.Save("proxy.exprTemplate.cacheSize", "0")
+		Params.initExprTemplateCacheSize()
+		assert.Equal(t, 0, Params.ExprTemplateCacheSize)
+		Params.Save("proxy.exprTemplate.cacheSize", "1024")
+		Params.initExprTemplateCacheSize()
+	})
+
+	t.Run("EmbeddingTimeout", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, Params.EmbeddingTimeout)
+
+		Params.Save("proxy.embedding.timeout", "100")
+		Params.initEmbeddingTimeout()
+		assert.Equal(t, 100*time.Millisecond, Params.EmbeddingTimeout)
+		Params.Save("proxy.embedding.timeout", "10000")
+		Params.initEmbeddingTimeout()
+	})
+
+	t.Run("RESTful", func(t *testing.T) {
+		assert.True(t, Params.RESTfulEnabled)
+
+		Params.Save("proxy.restful.enabled", "false")
+		Params.initRESTfulEnabled()
+		assert.False(t, Params.RESTfulEnabled)
+	})
+
+	t.Run("PKCheck", func(t *testing.T) {
+		t.Logf("PKCheckBloomCapacity: %d", Params.PKCheckBloomCapacity)
+		t.Logf("PKCheckFalsePositiveRate: %v", Params.PKCheckFalsePositiveRate)
+
+		Params.Save("proxy.pkCheck.falsePositiveRate", "0.01")
+		Params.initPKCheck()
+		assert.Equal(t, 0.01, Params.PKCheckFalsePositiveRate)
+		Params.Save("proxy.pkCheck.falsePositiveRate", "0.001")
+		Params.initPKCheck()
+	})
 }
 
 func shouldPanic(t *testing.T, name string, f func()) {
@@ -110,6 +401,163 @@ func TestParamTable_Panics(t *testing.T) {
 		Params.initMsgStreamTimeTickBufSize()
 	})
 
+	shouldPanic(t, "proxy.mirror.bufSize", func() {
+		Params.Save("proxy.mirror.bufSize", "abc")
+		Params.initMirrorBufSize()
+	})
+
+	shouldPanic(t, "proxy.shadow.sampleRatio", func() {
+		Params.Save("proxy.shadow.sampleRatio", "2")
+		Params.initShadowSampleRatio()
+	})
+
+	shouldPanic(t, "proxy.shadow.timeout", func() {
+		Params.Save("proxy.shadow.timeout", "abc")
+		Params.initShadowTimeout()
+	})
+
+	shouldPanic(t, "proxy.accessLog.sink", func() {
+		Params.Save("proxy.accessLog.sink", "kafka")
+		Params.initAccessLog()
+	})
+
+	shouldPanic(t, "proxy.auditLog.uploadInterval", func() {
+		Params.Save("proxy.auditLog.uploadInterval", "0")
+		Params.initAuditLog()
+	})
+
+	shouldPanic(t, "proxy.authentication.type", func() {
+		Params.Save("proxy.authentication.type", "kerberos")
+		Params.initAuthentication()
+	})
+
+	shouldPanic(t, "proxy.authentication.static.users", func() {
+		Params.Save("proxy.authentication.type", AuthenticationStatic)
+		Params.Save("proxy.authentication.static.users", "alice")
+		Params.initAuthentication()
+	})
+
+	shouldPanic(t, "proxy.apiKey.refreshInterval", func() {
+		Params.Save("proxy.apiKey.refreshInterval", "0")
+		Params.initApiKey()
+	})
+
+	shouldPanic(t, "proxy.healthCheck.timeout", func() {
+		Params.Save("proxy.healthCheck.timeout", "abc")
+		Params.initHealthCheckTimeout()
+	})
+
+	shouldPanic(t, "proxy.metaCache.ttl", func() {
+		Params.Save("proxy.metaCache.ttl", "-1")
+		Params.initMetaCache()
+	})
+
+	shouldPanic(t, "proxy.metaCache.negativeTTL", func() {
+		Params.Save("proxy.metaCache.ttl", "600")
+		Params.Save("proxy.metaCache.negativeTTL", "abc")
+		Params.initMetaCache()
+	})
+
+	shouldPanic(t, "proxy.console.enabled", func() {
+		Params.Save("proxy.console.enabled", "abc")
+		Params.initConsoleEnabled()
+	})
+
+	shouldPanic(t, "proxy.maxRowSize", func() {
+		Params.Save("proxy.maxRowSize", "0")
+		Params.initMaxRowSize()
+	})
+
+	shouldPanic(t, "proxy.maxRowSize", func() {
+		Params.Save("proxy.maxRowSize", strconv.Itoa(Params.PulsarMaxMessageSize+1))
+		Params.initMaxRowSize()
+	})
+
+	shouldPanic(t, "proxy.timestampAlloc.batchSize", func() {
+		Params.Save("proxy.timestampAlloc.batchSize", strconv.Itoa(maxTimestampBatchSize+1))
+		Params.initTimestampAlloc()
+	})
+
+	shouldPanic(t, "proxy.timestampAlloc.syncInterval", func() {
+		Params.Save("proxy.timestampAlloc.batchSize", "10000")
+		Params.Save("proxy.timestampAlloc.syncInterval", "-1")
+		Params.initTimestampAlloc()
+	})
+
+	shouldPanic(t, "proxy.outputFieldOrder", func() {
+		Params.Save("proxy.outputFieldOrder", "random")
+		Params.initOutputFieldOrder()
+	})
+
+	shouldPanic(t, "proxy.streamInsert.batchRows", func() {
+		Params.Save("proxy.streamInsert.batchRows", "0")
+		Params.initStreamInsert()
+	})
+
+	shouldPanic(t, "proxy.streamInsert.ackInterval", func() {
+		Params.Save("proxy.streamInsert.batchRows", "10000")
+		Params.Save("proxy.streamInsert.ackInterval", "0")
+		Params.initStreamInsert()
+	})
+
+	shouldPanic(t, "proxy.search.shardTimeout", func() {
+		Params.Save("proxy.search.shardTimeout", "0")
+		Params.initSearchShard()
+	})
+
+	shouldPanic(t, "proxy.search.shardRetryTimes", func() {
+		Params.Save("proxy.search.shardTimeout", "5000")
+		Params.Save("proxy.search.shardRetryTimes", "-1")
+		Params.initSearchShard()
+	})
+
+	shouldPanic(t, "proxy.search.partialResults", func() {
+		Params.Save("proxy.search.shardRetryTimes", "1")
+		Params.Save("proxy.search.partialResults", "abc")
+		Params.initSearchShard()
+	})
+
+	shouldPanic(t, "proxy.searchStream.chunkHits", func() {
+		Params.Save("proxy.searchStream.chunkHits", "0")
+		Params.initSearchStreamChunkHits()
+	})
+
+	shouldPanic(t, "proxy.searchMerge.maxWait", func() {
+		Params.Save("proxy.searchMerge.maxWait", "0")
+		Params.initSearchMerge()
+	})
+	Params.Save("proxy.searchMerge.maxWait", "10")
+
+	shouldPanic(t, "proxy.iterator.maxBatchSize", func() {
+		Params.Save("proxy.iterator.maxBatchSize", "0")
+		Params.initIterator()
+	})
+
+	shouldPanic(t, "proxy.exprTemplate.cacheSize", func() {
+		Params.Save("proxy.exprTemplate.cacheSize", "-1")
+		Params.initExprTemplateCacheSize()
+	})
+
+	shouldPanic(t, "proxy.embedding.timeout", func() {
+		Params.Save("proxy.embedding.timeout", "0")
+		Params.initEmbeddingTimeout()
+	})
+
+	shouldPanic(t, "proxy.idAlloc.batchSize", func() {
+		Params.Save("proxy.idAlloc.batchSize", "0")
+		Params.initIDAllocBatchSize()
+	})
+
+	shouldPanic(t, "proxy.restful.enabled", func() {
+		Params.Save("proxy.restful.enabled", "abc")
+		Params.initRESTfulEnabled()
+	})
+
+	shouldPanic(t, "proxy.pkCheck.falsePositiveRate", func() {
+		Params.Save("proxy.pkCheck.falsePositiveRate", "1")
+		Params.initPKCheck()
+	})
+
 	shouldPanic(t, "proxy.maxNameLength", func() {
 		Params.Remove("proxy.maxNameLength")
 		Params.initMaxNameLength()
@@ -149,4 +597,24 @@ func TestParamTable_Panics(t *testing.T) {
 		Params.Save("proxy.maxDimension", "-asdf")
 		Params.initMaxDimension()
 	})
+
+	shouldPanic(t, "proxy.maxNQ", func() {
+		Params.Save("proxy.maxNQ", "0")
+		Params.initMaxNQ()
+	})
+
+	shouldPanic(t, "proxy.maxExprLength", func() {
+		Params.Save("proxy.maxExprLength", "abc")
+		Params.initMaxExprLength()
+	})
+
+	shouldPanic(t, "proxy.taskQueue.maxDqlConcurrency", func() {
+		Params.Save("proxy.taskQueue.maxDqlConcurrency", "0")
+		Params.initTaskQueue()
+	})
+
+	shouldPanic(t, "proxy.timeTickStallThreshold", func() {
+		Params.Save("proxy.timeTickStallThreshold", "-1")
+		Params.initTimeTickStallThreshold()
+	})
 }
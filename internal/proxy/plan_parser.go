@@ -32,6 +32,9 @@ func parseQueryExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr
 
 type ParserContext struct {
 	schema *typeutil.SchemaHelper
+	// values of the placeholders of a template, and the placeholders used by the expression
+	values map[string]*schemapb.FieldData
+	used   map[string]struct{}
 }
 
 type optimizer struct {
@@ -69,6 +72,10 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 		}
 
 	case *ant_ast.BinaryNode:
+		// the arithmetic on a field is evaluated by the query nodes
+		if _, ok := node.Left.(*ant_ast.IdentifierNode); ok {
+			return
+		}
 		floatNodeLeft, leftFloat := node.Left.(*ant_ast.FloatNode)
 		integerNodeLeft, leftInteger := node.Left.(*ant_ast.IntegerNode)
 		floatNodeRight, rightFloat := node.Right.(*ant_ast.FloatNode)
@@ -167,7 +174,8 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 	}
 }
 
-func parseQueryExprAdvanced(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
+// parseExprNode returns the optimized ast of exprStr
+func parseExprNode(exprStr string) (ant_ast.Node, error) {
 	ast, err := ant_parser.Parse(exprStr)
 	if err != nil {
 		return nil, err
@@ -178,10 +186,18 @@ func parseQueryExprAdvanced(schema *typeutil.SchemaHelper, exprStr string) (*pla
 	if optimizer.err != nil {
 		return nil, optimizer.err
 	}
+	return ast.Node, nil
+}
+
+func parseQueryExprAdvanced(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
+	node, err := parseExprNode(exprStr)
+	if err != nil {
+		return nil, err
+	}
 
-	context := ParserContext{schema}
+	context := ParserContext{schema: schema}
 
-	expr, err := context.handleExpr(&ast.Node)
+	expr, err := context.handleExpr(&node)
 	if err != nil {
 		return nil, err
 	}
@@ -245,6 +261,19 @@ func getCompareOpType(opStr string, reverse bool) planpb.OpType {
 	return op
 }
 
+var arithOpTypes = map[string]planpb.ArithOpType{
+	"+": planpb.ArithOpType_Add,
+	"-": planpb.ArithOpType_Sub,
+	"*": planpb.ArithOpType_Mul,
+	"/": planpb.ArithOpType_Div,
+	"%": planpb.ArithOpType_Mod,
+}
+
+func isArithOp(opStr string) bool {
+	_, ok := arithOpTypes[opStr]
+	return ok
+}
+
 func getLogicalOpType(opStr string) planpb.BinaryExpr_BinaryOp {
 	switch opStr {
 	case "&&", "and":
@@ -256,9 +285,73 @@ func getLogicalOpType(opStr string) planpb.BinaryExpr_BinaryOp {
 	}
 }
 
+// createBinaryArithOpEvalRangeExpr creates the expr comparing `field arith_op operand` with the value,
+// the field is the right operand of the comparison if reverse
+func (context *ParserContext) createBinaryArithOpEvalRangeExpr(arithNode *ant_ast.BinaryNode, valueNode *ant_ast.Node, operator string, reverse bool) (*planpb.Expr, error) {
+	idNode, ok := arithNode.Left.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, fmt.Errorf("the left operand of the arithmetic operator(%s) must be a field", arithNode.Operator)
+	}
+	field, err := context.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsIntegerType(field.DataType) && !typeutil.IsFloatingType(field.DataType) {
+		return nil, fmt.Errorf("arithmetic operator(%s) is not supported on field %s of %s", arithNode.Operator, field.Name, field.DataType.String())
+	}
+
+	operand, err := context.handleLeafValue(&arithNode.Right, field.DataType)
+	if err != nil {
+		return nil, err
+	}
+	arithOp := arithOpTypes[arithNode.Operator]
+	if arithOp == planpb.ArithOpType_Mod && !typeutil.IsIntegerType(field.DataType) {
+		return nil, fmt.Errorf("can only modulus two integer")
+	}
+	if arithOp == planpb.ArithOpType_Div || arithOp == planpb.ArithOpType_Mod {
+		if operand.GetInt64Val() == 0 && operand.GetFloatVal() == 0 {
+			return nil, fmt.Errorf("number divide by zero")
+		}
+	}
+	val, err := context.handleLeafValue(valueNode, field.DataType)
+	if err != nil {
+		return nil, err
+	}
+	op := getCompareOpType(operator, reverse)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
+			BinaryArithOpEvalRangeExpr: &planpb.BinaryArithOpEvalRangeExpr{
+				ColumnInfo:   context.createColumnInfo(field),
+				ArithOp:      arithOp,
+				RightOperand: operand,
+				Op:           op,
+				Value:        val,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (context *ParserContext) createCmpExpr(left, right ant_ast.Node, operator string) (*planpb.Expr, error) {
+	if arithNode, ok := left.(*ant_ast.BinaryNode); ok && isArithOp(arithNode.Operator) {
+		return context.createBinaryArithOpEvalRangeExpr(arithNode, &right, operator, false)
+	}
+	if arithNode, ok := right.(*ant_ast.BinaryNode); ok && isArithOp(arithNode.Operator) {
+		return context.createBinaryArithOpEvalRangeExpr(arithNode, &left, operator, true)
+	}
+
 	idNodeLeft, leftIDNode := left.(*ant_ast.IdentifierNode)
 	idNodeRight, rightIDNode := right.(*ant_ast.IdentifierNode)
+	if _, ok := isPlaceholder(left); ok {
+		leftIDNode = false
+	}
+	if _, ok := isPlaceholder(right); ok {
+		rightIDNode = false
+	}
 
 	if leftIDNode && rightIDNode {
 		leftField, err := context.handleIdentifier(idNodeLeft)
@@ -360,6 +453,9 @@ func (context *ParserContext) handleLogicalExpr(node *ant_ast.BinaryNode) (*plan
 }
 
 func (context *ParserContext) handleArrayExpr(node *ant_ast.Node, dataType schemapb.DataType) ([]*planpb.GenericValue, error) {
+	if name, ok := isPlaceholder(*node); ok {
+		return context.handleTemplateValues(name, dataType)
+	}
 	arrayNode, ok2 := (*node).(*ant_ast.ArrayNode)
 	if !ok2 {
 		return nil, fmt.Errorf("right operand of the InExpr must be array")
@@ -436,7 +532,7 @@ func (context *ParserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*pla
 	// handle multiple relational operator
 	for {
 		binNodeLeft, LeftOk := curNode.Left.(*ant_ast.BinaryNode)
-		if !LeftOk {
+		if !LeftOk || isArithOp(binNodeLeft.Operator) {
 			expr, err := context.handleCmpExpr(curNode)
 			if err != nil {
 				return nil, err
@@ -516,6 +612,9 @@ func (context *ParserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Exp
 }
 
 func (context *ParserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemapb.DataType) (gv *planpb.GenericValue, err error) {
+	if name, ok := isPlaceholder(*nodeRaw); ok {
+		return context.handleTemplateValue(name, dataType)
+	}
 	switch node := (*nodeRaw).(type) {
 	case *ant_ast.FloatNode:
 		if typeutil.IsFloatingType(dataType) {
@@ -562,6 +661,9 @@ func (context *ParserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType sc
 
 func (context *ParserContext) handleIdentifier(node *ant_ast.IdentifierNode) (*schemapb.FieldSchema, error) {
 	fieldName := node.Value
+	if name, ok := isPlaceholder(node); ok {
+		return nil, fmt.Errorf("placeholder {%s} can only be a value", name)
+	}
 	field, err := context.schema.GetFieldFromName(fieldName)
 	return field, err
 }
@@ -594,18 +696,21 @@ func (context *ParserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, e
 		return expr, nil
 	case *ant_ast.BinaryNode:
 		return context.handleBinaryExpr(node)
+	case *ant_ast.FunctionNode:
+		return nil, fmt.Errorf("function %s can only be combined with the other conditions by and", node.Name)
 	default:
 		return nil, fmt.Errorf("unsupported node (%s)", node.Type().String())
 	}
 }
 
-func CreateQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
+// CreateQueryPlan returns the plan of a search, exprStr is a template if templateValues is not empty
+func CreateQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vectorFieldName string, queryInfo *planpb.QueryInfo, templateValues []*schemapb.FieldData) (*planpb.PlanNode, error) {
 	schema, err := typeutil.CreateSchemaHelper(schemaPb)
 	if err != nil {
 		return nil, err
 	}
 
-	expr, err := parseQueryExpr(schema, exprStr)
+	expr, err := parseQueryExprTemplate(schema, exprStr, templateValues)
 	if err != nil {
 		return nil, err
 	}
@@ -634,21 +739,29 @@ func CreateQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vector
 	return planNode, nil
 }
 
-func CreateExprQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string) (*planpb.PlanNode, error) {
+// CreateExprQueryPlan returns the plan of a query, exprStr is a template if templateValues is not empty
+func CreateExprQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, templateValues []*schemapb.FieldData) (*planpb.PlanNode, error) {
 	schema, err := typeutil.CreateSchemaHelper(schemaPb)
 	if err != nil {
 		return nil, err
 	}
 
-	expr, err := parseQueryExpr(schema, exprStr)
+	expr, calls, err := parseQueryPredicates(schema, exprStr, templateValues)
 	if err != nil {
 		return nil, err
 	}
+	if expr == nil && len(calls) > 0 {
+		expr, err = createTruePredicate(schema)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	planNode := &planpb.PlanNode{
 		Node: &planpb.PlanNode_Predicates{
 			Predicates: expr,
 		},
+		UdfPredicates: calls,
 	}
 	return planNode, nil
 }
@@ -81,7 +81,7 @@ func TestParsePlanNode_Naive(t *testing.T) {
 	// TODO: change it to better solution
 	for offset, exprStr := range exprStrs {
 		fmt.Printf("case %d: %s\n", offset, exprStr)
-		planProto, err := CreateQueryPlan(schema, exprStr, "FloatVectorField", queryInfo)
+		planProto, err := CreateQueryPlan(schema, exprStr, "FloatVectorField", queryInfo, nil)
 		assert.Nil(t, err)
 		dbgStr := proto.MarshalTextString(planProto)
 		println(dbgStr)
@@ -117,7 +117,7 @@ func TestExprPlan_Str(t *testing.T) {
 	}
 
 	// without filter
-	planProto, err := CreateQueryPlan(schema, "", "fakevec", queryInfo)
+	planProto, err := CreateQueryPlan(schema, "", "fakevec", queryInfo, nil)
 	assert.Nil(t, err)
 	dbgStr := proto.MarshalTextString(planProto)
 	println(dbgStr)
@@ -130,7 +130,7 @@ func TestExprPlan_Str(t *testing.T) {
 
 	for offset, exprStr := range exprStrs {
 		fmt.Printf("case %d: %s\n", offset, exprStr)
-		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo, nil)
 		assert.Nil(t, err)
 		dbgStr := proto.MarshalTextString(planProto)
 		println(dbgStr)
@@ -173,7 +173,7 @@ func TestExprMultiRange_Str(t *testing.T) {
 
 	for offset, exprStr := range exprStrs {
 		fmt.Printf("case %d: %s\n", offset, exprStr)
-		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo, nil)
 		assert.Nil(t, err)
 		dbgStr := proto.MarshalTextString(planProto)
 		println(dbgStr)
@@ -208,9 +208,80 @@ func TestExprFieldCompare_Str(t *testing.T) {
 
 	for offset, exprStr := range exprStrs {
 		fmt.Printf("case %d: %s\n", offset, exprStr)
-		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo, nil)
 		assert.Nil(t, err)
 		dbgStr := proto.MarshalTextString(planProto)
 		println(dbgStr)
 	}
 }
+
+func TestExprBinaryArithOp(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+		{FieldID: 102, Name: "FloatN", DataType: schemapb.DataType_Double},
+		{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool},
+	}
+	schemaPb := &schemapb.CollectionSchema{
+		Name:   "default-collection",
+		AutoID: true,
+		Fields: fields,
+	}
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	expr, err := parseQueryExpr(schema, "age % 10 == 3")
+	assert.Nil(t, err)
+	arithExpr := expr.GetBinaryArithOpEvalRangeExpr()
+	assert.NotNil(t, arithExpr)
+	assert.Equal(t, int64(101), arithExpr.ColumnInfo.FieldId)
+	assert.Equal(t, planpb.ArithOpType_Mod, arithExpr.ArithOp)
+	assert.Equal(t, int64(10), arithExpr.RightOperand.GetInt64Val())
+	assert.Equal(t, planpb.OpType_Equal, arithExpr.Op)
+	assert.Equal(t, int64(3), arithExpr.Value.GetInt64Val())
+
+	// the field is on the right of the comparison
+	expr, err = parseQueryExpr(schema, "2 * 3 < FloatN * 1.5")
+	assert.Nil(t, err)
+	arithExpr = expr.GetBinaryArithOpEvalRangeExpr()
+	assert.NotNil(t, arithExpr)
+	assert.Equal(t, planpb.ArithOpType_Mul, arithExpr.ArithOp)
+	assert.Equal(t, 1.5, arithExpr.RightOperand.GetFloatVal())
+	assert.Equal(t, planpb.OpType_GreaterThan, arithExpr.Op)
+	assert.Equal(t, float64(6), arithExpr.Value.GetFloatVal())
+
+	// the arithmetic is not a range of a multi-range expr
+	expr, err = parseQueryExpr(schema, "age - 1 > 5")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetBinaryArithOpEvalRangeExpr().GetOp())
+
+	expr, err = parseQueryExpr(schema, "1 < age + 1 < 5")
+	assert.Nil(t, err)
+	binaryExpr := expr.GetBinaryExpr()
+	assert.NotNil(t, binaryExpr)
+	assert.ElementsMatch(t, []planpb.OpType{planpb.OpType_GreaterThan, planpb.OpType_LessThan}, []planpb.OpType{
+		binaryExpr.Left.GetBinaryArithOpEvalRangeExpr().GetOp(),
+		binaryExpr.Right.GetBinaryArithOpEvalRangeExpr().GetOp(),
+	})
+
+	expr, err = parseQueryExpr(schema, "not (age % 2 == 0) && age in [1, 3, 5]")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.UnaryExpr_Not, expr.GetBinaryExpr().Left.GetUnaryExpr().GetOp())
+	assert.NotNil(t, expr.GetBinaryExpr().Right.GetTermExpr())
+
+	invalidExprs := []string{
+		"age % 0 == 1",
+		"age / 0 > 1",
+		"age % 1.5 == 1",
+		"FloatN % 2 == 1",
+		"age ** 2 > 4",
+		"flag + 1 > 0",
+		"2 * age > 10",
+		"age + FloatN > 10",
+		"age % 2",
+	}
+	for _, exprStr := range invalidExprs {
+		_, err := parseQueryExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
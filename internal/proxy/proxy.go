@@ -34,8 +34,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/dynconfig"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -72,8 +74,30 @@ type Proxy struct {
 	tsoAllocator *TimestampAllocator
 	segAssigner  *SegIDAssigner
 
+	// snowflakeAllocator allocates the ids of the collections in the snowflake id mode
+	snowflakeAllocator *allocator.SnowflakeIDAllocator
+	// pkChecker checks the primary keys of the collections with the strict primary key check
+	pkChecker *pkChecker
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	mirrorTarget DMLMirrorTarget
+	mirror       *dmlMirror
+
+	shadowTarget SearchShadowTarget
+	shadow       *searchShadow
+
+	merger *searchMerger
+
+	slowLogger *slowlog.Logger
+
+	dynConfig *dynconfig.Manager
+
+	// apiKeyAuth is invalidated when the api keys are changed by this proxy
+	apiKeyAuth *apiKeyAuthenticator
+
+	auditLogger *AuditLogger
+
 	session *sessionutil.Session
 
 	msFactory msgstream.Factory
@@ -172,12 +196,16 @@ func (node *Proxy) Init() error {
 		return err
 	}
 
+	idAllocator.SetCountPerRPC(Params.IDAllocBatchSize)
 	node.idAllocator = idAllocator
+	node.snowflakeAllocator = allocator.NewSnowflakeIDAllocator(Params.ProxyID)
+	node.pkChecker = newPKChecker(Params.PKCheckBloomCapacity, Params.PKCheckFalsePositiveRate)
 
 	tsoAllocator, err := NewTimestampAllocator(node.ctx, node.rootCoord, Params.ProxyID)
 	if err != nil {
 		return err
 	}
+	tsoAllocator.SetBatch(Params.TimestampAllocBatchSize, Params.TimestampSyncInterval)
 	node.tsoAllocator = tsoAllocator
 
 	segAssigner, err := NewSegIDAssigner(node.ctx, node.dataCoord, node.lastTick)
@@ -256,14 +284,14 @@ func (node *Proxy) Init() error {
 	chMgr := newChannelsMgrImpl(getDmlChannelsFunc, defaultInsertRepackFunc, getDqlChannelsFunc, nil, node.msFactory)
 	node.chMgr = chMgr
 
-	node.sched, err = newTaskScheduler(node.ctx, node.idAllocator, node.tsoAllocator, node.msFactory)
+	node.sched, err = newTaskScheduler(node.ctx, node.idAllocator, node.tsoAllocator, node.msFactory, &Params.TaskQueue)
 	if err != nil {
 		return err
 	}
 
 	node.tick = newTimeTick(node.ctx, node.tsoAllocator, time.Millisecond*200, node.sched.TaskDoneTest, node.msFactory)
 
-	node.chTicker = newChannelsTimeTicker(node.ctx, channelMgrTickerInterval, []string{}, node.sched.getPChanStatistics, tsoAllocator)
+	node.chTicker = newChannelsTimeTicker(node.ctx, channelMgrTickerInterval, Params.TimeTickStallThreshold, []string{}, node.sched.getPChanStatistics, tsoAllocator)
 
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
@@ -342,11 +370,12 @@ func (node *Proxy) sendChannelsTimeTickLoop() {
 }
 
 func (node *Proxy) Start() error {
-	err := InitMetaCache(node.rootCoord)
+	err := InitMetaCache(node.rootCoord, node.queryCoord)
 	if err != nil {
 		return err
 	}
 	log.Debug("init global meta cache ...")
+	globalExprTemplateCache = newExprTemplateCache(Params.ExprTemplateCacheSize)
 
 	if err := node.sched.Start(); err != nil {
 		return err
@@ -376,6 +405,41 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	if node.mirrorTarget != nil && len(Params.MirrorCollections) > 0 {
+		node.mirror = newDMLMirror(node.ctx, node.mirrorTarget, Params.MirrorCollections, Params.MirrorBufSize)
+		node.mirror.start()
+		log.Debug("start dml mirror", zap.Strings("collections", Params.MirrorCollections))
+	}
+
+	node.slowLogger, err = slowlog.NewLogger(typeutil.ProxyRole, Params.SlowLogThreshold, Params.SlowLogFile)
+	if err != nil {
+		return err
+	}
+
+	// the shadow is started even if the sample ratio is 0, since it can be changed at runtime
+	if node.shadowTarget != nil {
+		node.shadow = newSearchShadow(node.ctx, node.shadowTarget, Params.ShadowSampleRatio, Params.ShadowMinRecall, Params.ShadowTimeout, Params.ShadowBufSize)
+		node.shadow.start()
+		log.Debug("start search shadow", zap.Float64("sampleRatio", Params.ShadowSampleRatio))
+	}
+
+	if Params.SearchMergeMaxNQ > 0 {
+		node.merger = newSearchMerger(Params.SearchMergeMaxNQ, Params.SearchMergeMaxWait, node.search)
+		log.Debug("start search merger", zap.Int64("maxNQ", Params.SearchMergeMaxNQ), zap.Duration("maxWait", Params.SearchMergeMaxWait))
+	}
+
+	node.dynConfig, err = dynconfig.NewManager(node.ctx, Params.EtcdEndpoints, Params.MetaRootPath)
+	if err != nil {
+		return err
+	}
+	if err := node.watchDynamicConfigs(); err != nil {
+		return err
+	}
+	if err := node.dynConfig.Start(); err != nil {
+		return err
+	}
+	log.Debug("start dynamic config ...")
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -408,6 +472,15 @@ func (node *Proxy) Stop() error {
 			return err
 		}
 	}
+	if node.mirror != nil {
+		node.mirror.close()
+	}
+	if node.shadow != nil {
+		node.shadow.close()
+	}
+	if node.dynConfig != nil {
+		node.dynConfig.Close()
+	}
 
 	node.wg.Wait()
 
@@ -447,3 +520,18 @@ func (node *Proxy) SetDataCoordClient(cli types.DataCoord) {
 func (node *Proxy) SetQueryCoordClient(cli types.QueryCoord) {
 	node.queryCoord = cli
 }
+
+// SetDMLMirrorTarget sets the Milvus which the dml requests of Params.MirrorCollections are mirrored to
+func (node *Proxy) SetDMLMirrorTarget(target DMLMirrorTarget) {
+	node.mirrorTarget = target
+}
+
+// SetSearchShadowTarget sets the proxy of the canary group which a sample of search requests are shadowed to
+func (node *Proxy) SetSearchShadowTarget(target SearchShadowTarget) {
+	node.shadowTarget = target
+}
+
+// SetAuditLogger sets the audit logger which QueryAuditLog queries
+func (node *Proxy) SetAuditLogger(logger *AuditLogger) {
+	node.auditLogger = logger
+}
@@ -719,6 +719,9 @@ func TestProxy(t *testing.T) {
 		})
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.NotNil(t, resp.Storage)
+		// the row id field and the timestamp field are added by root coord
+		assert.Equal(t, len(schema.Fields)+2, len(resp.Storage.Fields))
 		// TODO(dragondriver): check num rows
 
 		// get statistics of other collection -> fail
@@ -742,6 +745,112 @@ func TestProxy(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 		assert.Equal(t, 1, len(resp.CollectionNames))
+
+		resp, err = proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base:        nil,
+			DbName:      dbName,
+			Type:        milvuspb.ShowType_All,
+			NamePattern: collectionName,
+			Limit:       1,
+			WithDetails: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, []string{collectionName}, resp.CollectionNames)
+		assert.Equal(t, "", resp.NextPageToken)
+		assert.Equal(t, 1, len(resp.Details))
+		assert.Equal(t, shardsNum, resp.Details[0].ShardsNum)
+		assert.Equal(t, milvuspb.LoadState_NotLoaded, resp.Details[0].LoadState)
+
+		// pagination of in-memory collections -> fail
+		resp, err = proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base:   nil,
+			DbName: dbName,
+			Type:   milvuspb.ShowType_InMemory,
+			Limit:  1,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("alter collection", func(t *testing.T) {
+		resp, err := proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: "owner", Value: "search"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		describeResp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, describeResp.Status.ErrorCode)
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: "owner", Value: "search"},
+			{Key: MaxRowSizeKey, Value: strconv.FormatInt(Params.MaxRowSize, 10)},
+		}, describeResp.Properties)
+
+		showResp, err := proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base:           nil,
+			DbName:         dbName,
+			Type:           milvuspb.ShowType_All,
+			PropertyFilter: []*commonpb.KeyValuePair{{Key: "owner", Value: "search"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, showResp.Status.ErrorCode)
+		assert.Equal(t, []string{collectionName}, showResp.CollectionNames)
+
+		// invalid max row size -> fail
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: "0"}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// nothing to alter -> fail
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// negative or too many shards -> fail
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			ShardsNum:      -1,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			ShardsNum:      Params.MaxShardNum + 1,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// alter other collection -> fail
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: otherCollectionName,
+			DeleteKeys:     []string{"owner"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
 	t.Run("create partition", func(t *testing.T) {
@@ -838,6 +947,33 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("describe partition", func(t *testing.T) {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+		assert.NoError(t, err)
+
+		resp, err := proxy.DescribePartition(ctx, &milvuspb.DescribePartitionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			Flush:          true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, partitionID, resp.PartitionID)
+		assert.Equal(t, int64(0), resp.GrowingSegmentCount)
+
+		// non-exist partition -> fail
+		resp, err = proxy.DescribePartition(ctx, &milvuspb.DescribePartitionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  otherPartitionName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("show partitions", func(t *testing.T) {
 		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
 		assert.NoError(t, err)
@@ -879,6 +1015,21 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, int64(rowNum), resp.InsertCnt)
 	})
 
+	t.Run("stream insert", func(t *testing.T) {
+		stream := newMockStreamInsertServer(ctx, constructInsertRequest(), constructInsertRequest())
+		err := proxy.StreamInsert(stream)
+		assert.NoError(t, err)
+
+		results := stream.results()
+		assert.Equal(t, 2, len(results))
+		for _, result := range results {
+			assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+			assert.Equal(t, rowNum, len(result.SuccIndex))
+			assert.Equal(t, int64(rowNum), result.InsertCnt)
+		}
+		assert.Equal(t, int64(2), stream.responses[len(stream.responses)-1].AckedBatches)
+	})
+
 	// TODO(dragondriver): proxy.Delete()
 
 	t.Run("create index", func(t *testing.T) {
@@ -1131,6 +1282,13 @@ func TestProxy(t *testing.T) {
 		// TODO(dragondriver): compare search result
 	})
 
+	t.Run("search stream", func(t *testing.T) {
+		stream := newMockSearchStreamServer(ctx)
+		err := proxy.SearchStream(constructSearchRequest(), stream)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, stream.chunks)
+	})
+
 	t.Run("query", func(t *testing.T) {
 		//resp, err := proxy.Query(ctx, &milvuspb.QueryRequest{
 		_, err := proxy.Query(ctx, &milvuspb.QueryRequest{
@@ -1149,6 +1307,40 @@ func TestProxy(t *testing.T) {
 		// TODO(dragondriver): compare query result
 	})
 
+	t.Run("query iterator", func(t *testing.T) {
+		resp, err := proxy.QueryIterator(ctx, &milvuspb.QueryIteratorRequest{
+			Query: &milvuspb.QueryRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Expr:           expr,
+			},
+			BatchSize: 10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.NotEmpty(t, resp.Cursor)
+
+		resp, err = proxy.QueryIterator(ctx, &milvuspb.QueryIteratorRequest{
+			Query: &milvuspb.QueryRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+			},
+			BatchSize: Params.IteratorMaxBatchSize + 1,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("search iterator", func(t *testing.T) {
+		resp, err := proxy.SearchIterator(ctx, &milvuspb.SearchIteratorRequest{
+			Search:    constructSearchRequest(),
+			BatchSize: 10,
+		})
+		assert.NoError(t, err)
+		// the iterator requires a single query
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("calculate distance", func(t *testing.T) {
 		opLeft := &milvuspb.VectorsArray{
 			Array: &milvuspb.VectorsArray_DataArray{
@@ -1222,6 +1414,53 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("get collection runtime stats", func(t *testing.T) {
+		resp, err := proxy.GetCollectionRuntimeStats(ctx, &milvuspb.GetCollectionRuntimeStatsRequest{
+			Base:            nil,
+			DbName:          dbName,
+			CollectionNames: []string{collectionName},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 1, len(resp.Stats))
+		assert.Equal(t, collectionName, resp.Stats[0].CollectionName)
+		assert.True(t, resp.Stats[0].Loaded)
+
+		resp, err = proxy.GetCollectionRuntimeStats(ctx, &milvuspb.GetCollectionRuntimeStatsRequest{
+			Base:   nil,
+			DbName: dbName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.NotEqual(t, 0, len(resp.Stats))
+
+		resp, err = proxy.GetCollectionRuntimeStats(ctx, &milvuspb.GetCollectionRuntimeStatsRequest{
+			Base:            nil,
+			DbName:          dbName,
+			CollectionNames: []string{"not_exist_collection"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("warmup collection", func(t *testing.T) {
+		resp, err := proxy.WarmupCollection(ctx, &milvuspb.WarmupCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.WarmupCollection(ctx, &milvuspb.WarmupCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: "not_exist_collection",
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	// TODO(dragondriver): dummy
 
 	t.Run("register link", func(t *testing.T) {
@@ -1230,6 +1469,20 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("get quota usage", func(t *testing.T) {
+		resp, err := proxy.GetQuotaUsage(ctx, &milvuspb.GetQuotaUsageRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 2, len(resp.Usages))
+		for _, usage := range resp.Usages {
+			assert.Equal(t, collectionName, usage.CollectionName)
+			assert.LessOrEqual(t, usage.Used, usage.Limit)
+		}
+	})
+
 	t.Run("get metrics", func(t *testing.T) {
 		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
 		assert.NoError(t, err)
@@ -1446,6 +1699,19 @@ func TestProxy(t *testing.T) {
 		assert.False(t, resp.Value)
 	})
 
+	t.Run("list dropped collections", func(t *testing.T) {
+		resp, err := proxy.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{
+			DbName: dbName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		names := make([]string, 0, len(resp.Collections))
+		for _, coll := range resp.Collections {
+			names = append(names, coll.CollectionName)
+		}
+		assert.Contains(t, names, collectionName)
+	})
+
 	t.Run("show all collections after drop collection", func(t *testing.T) {
 		resp, err := proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
 			Base:            nil,
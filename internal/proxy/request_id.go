@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+const (
+	// requestIDKey is the grpc metadata key of the request id. A client sets it to retry a request safely, the ddl
+	// requests of the same request id are executed once by rootcoord. It's generated if not set, and returned to
+	// the client in the response header
+	requestIDKey = "request-id"
+	// maxRequestIDLength is the max length of the request ids set by the clients
+	maxRequestIDLength = 128
+)
+
+type requestIDCtxKey struct{}
+
+// baseRequest is implemented by the tasks whose requests have a MsgBase
+type baseRequest interface {
+	GetBase() *commonpb.MsgBase
+}
+
+// newRequestID returns a random request id
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// requestIDFromContext returns the request id of the client request of ctx, it's empty if the request
+// isn't from a client
+func requestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return requestID
+}
+
+// contextWithRequestID returns the context of a client request, the request id is accepted from the grpc
+// metadata of ctx or generated
+func contextWithRequestID(ctx context.Context) (context.Context, string, error) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDKey); len(ids) > 0 {
+			requestID = ids[0]
+		}
+	}
+	if len(requestID) > maxRequestIDLength {
+		return nil, "", merr.Errorf(merr.ErrIllegalArgument, "the length of the request id exceeds %d", maxRequestIDLength)
+	}
+	if requestID == "" {
+		var err error
+		if requestID, err = newRequestID(); err != nil {
+			return nil, "", err
+		}
+	}
+	return context.WithValue(ctx, requestIDCtxKey{}, requestID), requestID, nil
+}
+
+// setRequestID sets the request id of the context of t to the MsgBase of its request, which is passed on to the
+// other components by the rpcs and the msgstream messages
+func setRequestID(t task) {
+	if r, ok := t.(baseRequest); ok && r.GetBase() != nil {
+		r.GetBase().RequestID = requestIDFromContext(t.TraceCtx())
+	}
+}
+
+// RequestIDInterceptor returns a grpc interceptor which sets the request id of the client requests to their
+// contexts and to the response headers
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+			return handler(ctx, req)
+		}
+		ctx, requestID, err := contextWithRequestID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// the header is sent with the response, a failure only means the client doesn't get it
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, requestID))
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+func TestContextWithRequestID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDKey, "client-request-1"))
+	ctx, requestID, err := contextWithRequestID(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "client-request-1", requestID)
+	assert.Equal(t, "client-request-1", requestIDFromContext(ctx))
+
+	// generated if the client doesn't set it
+	ctx, requestID, err = contextWithRequestID(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, requestID, 32)
+	assert.Equal(t, requestID, requestIDFromContext(ctx))
+	_, another, err := contextWithRequestID(context.Background())
+	assert.Nil(t, err)
+	assert.NotEqual(t, requestID, another)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDKey, strings.Repeat("a", maxRequestIDLength+1)))
+	_, _, err = contextWithRequestID(ctx)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(err))
+
+	assert.Equal(t, "", requestIDFromContext(context.Background()))
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	interceptor := RequestIDInterceptor()
+	var requestID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestID = requestIDFromContext(ctx)
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDKey, "client-request-1"))
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "CreateCollection"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "client-request-1", requestID)
+
+	// the internal rpcs are not client requests
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/GetComponentStates"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "", requestID)
+}
+
+func TestSetRequestID(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestIDCtxKey{}, "client-request-1")
+
+	cct := &createCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{},
+	}
+	queue := newDdTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface(), testMaxTaskNum)
+	assert.Nil(t, queue.Enqueue(cct))
+	assert.Equal(t, "client-request-1", cct.Base.RequestID)
+
+	it := &insertTask{ctx: ctx, BaseInsertTask: msgstream.InsertMsg{InsertRequest: internalpb.InsertRequest{}}}
+	assert.Nil(t, it.OnEnqueue())
+	setRequestID(it)
+	assert.Equal(t, "client-request-1", it.Base.RequestID)
+
+	st := &searchTask{ctx: ctx, SearchRequest: &internalpb.SearchRequest{}}
+	assert.Nil(t, st.OnEnqueue())
+	setRequestID(st)
+	assert.Equal(t, "client-request-1", st.Base.RequestID)
+}
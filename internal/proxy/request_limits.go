@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+// RequestLimits are the limits of the client requests, they are checked by the insert, search, query and create
+// collection tasks before the requests are sent to the other components
+type RequestLimits struct {
+	MaxNQ         int64
+	MaxTopK       int64
+	MaxExprLength int64
+	MaxFieldNum   int64
+	MaxDimension  int64
+}
+
+// CheckNQ checks the number of the queries of a search
+func (l *RequestLimits) CheckNQ(nq int64) error {
+	if nq <= 0 || nq > l.MaxNQ {
+		return merr.Errorf(merr.ErrIllegalArgument, "invalid nq: %d. should be in range 1 ~ %d", nq, l.MaxNQ)
+	}
+	return nil
+}
+
+// CheckTopK checks the topk of a search
+func (l *RequestLimits) CheckTopK(topk int64) error {
+	if topk <= 0 || topk > l.MaxTopK {
+		return merr.Errorf(merr.ErrIllegalTopK, "invalid topk: %d. should be in range 1 ~ %d", topk, l.MaxTopK)
+	}
+	return nil
+}
+
+// CheckExpr checks the length of the expression of a search or a query
+func (l *RequestLimits) CheckExpr(expr string) error {
+	if int64(len(expr)) > l.MaxExprLength {
+		return merr.Errorf(merr.ErrIllegalArgument, "the length %d of the expression exceeds the limit %d", len(expr), l.MaxExprLength)
+	}
+	return nil
+}
+
+// CheckFieldNum checks the number of the fields of a schema or an insert
+func (l *RequestLimits) CheckFieldNum(num int) error {
+	if int64(num) > l.MaxFieldNum {
+		return merr.Errorf(merr.ErrIllegalArgument, "maximum field's number should be limited to %d", l.MaxFieldNum)
+	}
+	return nil
+}
+
+// CheckDimension checks the dimension of a vector field, the dimension of a binary vector is a multiple of 8
+func (l *RequestLimits) CheckDimension(dim int64, isBinary bool) error {
+	if dim <= 0 || dim > l.MaxDimension {
+		return merr.Errorf(merr.ErrIllegalDimension, "invalid dimension: %d. should be in range 1 ~ %d", dim, l.MaxDimension)
+	}
+	if isBinary && dim%8 != 0 {
+		return merr.Errorf(merr.ErrIllegalDimension, "invalid dimension: %d. should be multiple of 8. ", dim)
+	}
+	return nil
+}
+
+// CheckFieldsData checks the number of the fields and the dimensions of the vectors of an insert
+func (l *RequestLimits) CheckFieldsData(fieldsData []*schemapb.FieldData) error {
+	if err := l.CheckFieldNum(len(fieldsData)); err != nil {
+		return err
+	}
+	for _, fieldData := range fieldsData {
+		vectors := fieldData.GetVectors()
+		if vectors == nil {
+			continue
+		}
+		if err := l.CheckDimension(vectors.Dim, fieldData.Type == schemapb.DataType_BinaryVector); err != nil {
+			return fmt.Errorf("field %s: %w", fieldData.FieldName, err)
+		}
+	}
+	return nil
+}
+
+// CheckPlaceholderGroup checks the nq of a search, which is the number of the values of the placeholders
+func (l *RequestLimits) CheckPlaceholderGroup(placeholderGroup []byte) error {
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroup, group); err != nil {
+		return err
+	}
+	nq := int64(0)
+	for _, placeholder := range group.Placeholders {
+		nq += int64(len(placeholder.Values))
+	}
+	return l.CheckNQ(nq)
+}
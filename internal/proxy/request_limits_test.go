@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+func TestRequestLimits(t *testing.T) {
+	limits := &RequestLimits{MaxNQ: 10, MaxTopK: 100, MaxExprLength: 16, MaxFieldNum: 2, MaxDimension: 128}
+
+	assert.Nil(t, limits.CheckNQ(10))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(limits.CheckNQ(11)))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(limits.CheckNQ(0)))
+
+	assert.Nil(t, limits.CheckTopK(100))
+	assert.Equal(t, commonpb.ErrorCode_IllegalTOPK, merr.Code(limits.CheckTopK(101)))
+	assert.Equal(t, commonpb.ErrorCode_IllegalTOPK, merr.Code(limits.CheckTopK(-1)))
+
+	assert.Nil(t, limits.CheckExpr("id in [1, 2, 3]"))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(limits.CheckExpr(strings.Repeat("a", 17))))
+
+	assert.Nil(t, limits.CheckFieldNum(2))
+	assert.NotNil(t, limits.CheckFieldNum(3))
+
+	assert.Nil(t, limits.CheckDimension(128, false))
+	assert.Nil(t, limits.CheckDimension(64, true))
+	assert.Equal(t, commonpb.ErrorCode_IllegalDimension, merr.Code(limits.CheckDimension(129, false)))
+	assert.Equal(t, commonpb.ErrorCode_IllegalDimension, merr.Code(limits.CheckDimension(12, true)))
+
+	// the other errors are unexpected
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, merr.Code(errors.New("mock")))
+	assert.Equal(t, commonpb.ErrorCode_IllegalTOPK, merr.Code(fmt.Errorf("search: %w", limits.CheckTopK(0))))
+}
+
+func TestRequestLimits_CheckFieldsData(t *testing.T) {
+	limits := &RequestLimits{MaxFieldNum: 2, MaxDimension: 128}
+	vectorField := func(dim int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: "vec",
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{Dim: dim},
+			},
+		}
+	}
+	scalarField := &schemapb.FieldData{Type: schemapb.DataType_Int64, FieldName: "id"}
+
+	assert.Nil(t, limits.CheckFieldsData([]*schemapb.FieldData{scalarField, vectorField(128)}))
+	err := limits.CheckFieldsData([]*schemapb.FieldData{scalarField, vectorField(256)})
+	assert.Equal(t, commonpb.ErrorCode_IllegalDimension, merr.Code(err))
+	assert.Contains(t, err.Error(), "vec")
+	assert.NotNil(t, limits.CheckFieldsData([]*schemapb.FieldData{scalarField, scalarField, vectorField(128)}))
+}
+
+func TestRequestLimits_CheckPlaceholderGroup(t *testing.T) {
+	limits := &RequestLimits{MaxNQ: 2}
+	group := func(nq int) []byte {
+		values := make([][]byte, 0, nq)
+		for i := 0; i < nq; i++ {
+			values = append(values, []byte{0, 0, 0, 0})
+		}
+		blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+			Placeholders: []*milvuspb.PlaceholderValue{{Tag: "$0", Type: milvuspb.PlaceholderType_FloatVector, Values: values}},
+		})
+		assert.Nil(t, err)
+		return blob
+	}
+
+	assert.Nil(t, limits.CheckPlaceholderGroup(group(2)))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(limits.CheckPlaceholderGroup(group(3))))
+	assert.NotNil(t, limits.CheckPlaceholderGroup([]byte{1, 2, 3}))
+}
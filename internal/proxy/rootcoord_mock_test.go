@@ -52,6 +52,7 @@ type collectionMeta struct {
 	physicalChannelNames []string
 	createdTimestamp     uint64
 	createdUtcTimestamp  uint64
+	properties           []*commonpb.KeyValuePair
 }
 
 type partitionMeta struct {
@@ -296,6 +297,7 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		PhysicalChannelNames: meta.physicalChannelNames,
 		CreatedTimestamp:     meta.createdUtcTimestamp,
 		CreatedUtcTimestamp:  meta.createdUtcTimestamp,
+		Properties:           meta.properties,
 	}, nil
 }
 
@@ -332,6 +334,41 @@ func (coord *RootCoordMock) ShowCollections(ctx context.Context, req *milvuspb.S
 	}, nil
 }
 
+func (coord *RootCoordMock) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+
+	deleted := make(map[string]bool)
+	for _, key := range req.DeleteKeys {
+		deleted[key] = true
+	}
+	for _, kv := range req.Properties {
+		deleted[kv.Key] = true
+	}
+	meta := coord.collID2Meta[collID]
+	properties := make([]*commonpb.KeyValuePair, 0, len(meta.properties)+len(req.Properties))
+	for _, kv := range meta.properties {
+		if !deleted[kv.Key] {
+			properties = append(properties, kv)
+		}
+	}
+	meta.properties = append(properties, req.Properties...)
+	coord.collID2Meta[collID] = meta
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (coord *RootCoordMock) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
@@ -575,6 +612,99 @@ func (coord *RootCoordMock) SegmentFlushCompleted(ctx context.Context, in *datap
 	}, nil
 }
 
+func (coord *RootCoordMock) CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return &milvuspb.ApiKeyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Name: req.Name,
+	}, nil
+}
+
+func (coord *RootCoordMock) RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	return &milvuspb.ApiKeyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Name: req.Name,
+	}, nil
+}
+
+func (coord *RootCoordMock) DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return &rootcoordpb.ListApiKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+	}, nil
+}
+
+func (coord *RootCoordMock) GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.GetQuotaUsageResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+	return &milvuspb.GetQuotaUsageResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usages: []*milvuspb.QuotaUsage{
+			{Name: "collections", Used: int64(len(coord.collName2ID)), Limit: 65536},
+		},
+	}, nil
+}
+
+// UndropCollection fails since the dropped collections are not kept by the mock
+func (coord *RootCoordMock) UndropCollection(ctx context.Context, req *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+		Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+	}, nil
+}
+
+func (coord *RootCoordMock) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	return &milvuspb.ListDroppedCollectionsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Collections: []*milvuspb.DroppedCollection{},
+	}, nil
+}
+
 func (coord *RootCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	rootCoordTopology := metricsinfo.RootCoordTopology{
 		Self: metricsinfo.RootCoordInfos{
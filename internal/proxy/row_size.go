@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// MaxRowSizeKey is the collection property lowering proxy.maxRowSize for the collection
+const MaxRowSizeKey = "max_row_size"
+
+// maxReportedRows is the number of the oversized rows listed in the error of an insert
+const maxReportedRows = 5
+
+// parseMaxRowSize parses the max_row_size property, which is a positive number of bytes
+func parseMaxRowSize(value string) (int64, error) {
+	maxRowSize, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || maxRowSize <= 0 {
+		return 0, fmt.Errorf("invalid %s %s, should be a positive number of bytes", MaxRowSizeKey, value)
+	}
+	return maxRowSize, nil
+}
+
+// getMaxRowSize returns the max row size of the collection, which is the max_row_size property
+// if it's lower than proxy.maxRowSize
+func getMaxRowSize(properties []*commonpb.KeyValuePair) (int64, error) {
+	maxRowSize := Params.MaxRowSize
+	for _, kv := range properties {
+		if kv.Key != MaxRowSizeKey {
+			continue
+		}
+		value, err := parseMaxRowSize(kv.Value)
+		if err != nil {
+			return 0, err
+		}
+		if value < maxRowSize {
+			maxRowSize = value
+		}
+	}
+	return maxRowSize, nil
+}
+
+// withMaxRowSize returns the properties with the max_row_size in effect, so that the clients know the limit
+func withMaxRowSize(properties []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	maxRowSize, err := getMaxRowSize(properties)
+	if err != nil {
+		// the invalid property is returned as is
+		return properties
+	}
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties)+1)
+	for _, kv := range properties {
+		if kv.Key != MaxRowSizeKey {
+			ret = append(ret, kv)
+		}
+	}
+	return append(ret, &commonpb.KeyValuePair{Key: MaxRowSizeKey, Value: strconv.FormatInt(maxRowSize, 10)})
+}
+
+// checkRowSize rejects the rows larger than maxRowSize, the first oversized rows are listed in the error
+func checkRowSize(rows []*commonpb.Blob, maxRowSize int64) error {
+	oversized := 0
+	var reported []string
+	for i, row := range rows {
+		if int64(len(row.Value)) <= maxRowSize {
+			continue
+		}
+		oversized++
+		if len(reported) < maxReportedRows {
+			reported = append(reported, fmt.Sprintf("row %d is %d bytes", i, len(row.Value)))
+		}
+	}
+	if oversized == 0 {
+		return nil
+	}
+	return errRowSizeExceeded(oversized, reported, maxRowSize)
+}
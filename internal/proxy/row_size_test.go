@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestGetMaxRowSize(t *testing.T) {
+	Params.Init()
+	maxRowSize, err := getMaxRowSize(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, Params.MaxRowSize, maxRowSize)
+
+	maxRowSize, err = getMaxRowSize([]*commonpb.KeyValuePair{{Key: "owner", Value: "search"}, {Key: MaxRowSizeKey, Value: "1024"}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1024), maxRowSize)
+
+	// the property can't raise proxy.maxRowSize
+	maxRowSize, err = getMaxRowSize([]*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: strconv.FormatInt(Params.MaxRowSize*2, 10)}})
+	assert.Nil(t, err)
+	assert.Equal(t, Params.MaxRowSize, maxRowSize)
+
+	_, err = getMaxRowSize([]*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: "-1"}})
+	assert.NotNil(t, err)
+	_, err = getMaxRowSize([]*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: "1MB"}})
+	assert.NotNil(t, err)
+}
+
+func TestWithMaxRowSize(t *testing.T) {
+	Params.Init()
+	maxRowSize := strconv.FormatInt(Params.MaxRowSize, 10)
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: maxRowSize}}, withMaxRowSize(nil))
+
+	properties := []*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: "1024"}, {Key: "owner", Value: "search"}}
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: "owner", Value: "search"}, {Key: MaxRowSizeKey, Value: "1024"}},
+		withMaxRowSize(properties))
+
+	properties = []*commonpb.KeyValuePair{{Key: MaxRowSizeKey, Value: "abc"}}
+	assert.Equal(t, properties, withMaxRowSize(properties))
+}
+
+func TestCheckRowSize(t *testing.T) {
+	rows := make([]*commonpb.Blob, 0, 10)
+	for i := 0; i < 10; i++ {
+		rows = append(rows, &commonpb.Blob{Value: make([]byte, 8)})
+	}
+	assert.Nil(t, checkRowSize(rows, 8))
+
+	rows[3].Value = make([]byte, 9)
+	err := checkRowSize(rows, 8)
+	assert.EqualError(t, err, "1 rows exceed the max row size 8 bytes: row 3 is 9 bytes")
+
+	for _, row := range rows {
+		row.Value = make([]byte, 16)
+	}
+	err = checkRowSize(rows, 8)
+	assert.EqualError(t, err, "10 rows exceed the max row size 8 bytes: row 0 is 16 bytes, row 1 is 16 bytes, "+
+		"row 2 is 16 bytes, row 3 is 16 bytes, row 4 is 16 bytes, and 5 more")
+}
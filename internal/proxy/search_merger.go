@@ -0,0 +1,307 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// searchMerger merges the concurrent searches of a collection which only differ in their vectors into one search.
+// A search is sent at once if no other search of the same key is running, otherwise it waits for the running one
+// along with the following ones, and they are sent as one search of at most maxNQ queries once the running one is
+// done or maxWait elapsed. The results of the merged search are split back to each search by its queries
+type searchMerger struct {
+	maxNQ      int64
+	maxWait    time.Duration
+	searchFunc func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+
+	mu     sync.Mutex
+	groups map[string]*searchMergeGroup
+}
+
+// searchMergeGroup is the state of the searches of a key
+type searchMergeGroup struct {
+	running int
+	pending *searchMergeBatch
+}
+
+type searchMergeBatch struct {
+	searches []*mergedSearch
+	nq       int64
+	timer    *time.Timer
+}
+
+type mergedSearch struct {
+	ctx     context.Context
+	request *milvuspb.SearchRequest
+	nq      int64
+	done    chan *milvuspb.SearchResults
+}
+
+func newSearchMerger(maxNQ int64, maxWait time.Duration,
+	search func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)) *searchMerger {
+	return &searchMerger{
+		maxNQ:      maxNQ,
+		maxWait:    maxWait,
+		searchFunc: search,
+		groups:     make(map[string]*searchMergeGroup),
+	}
+}
+
+// searchMergeKey returns the num of queries of a search and the key of the searches it can be merged with,
+// the key is empty if it can't be merged
+func searchMergeKey(request *milvuspb.SearchRequest) (int64, string) {
+	if request.GetDslType() != commonpb.DslType_BoolExprV1 {
+		return 0, ""
+	}
+	var group milvuspb.PlaceholderGroup
+	if err := proto.Unmarshal(request.PlaceholderGroup, &group); err != nil || len(group.Placeholders) != 1 {
+		return 0, ""
+	}
+	placeholder := group.Placeholders[0]
+
+	params := proto.Clone(request).(*milvuspb.SearchRequest)
+	params.Base = nil
+	params.PlaceholderGroup = nil
+	b, err := proto.Marshal(params)
+	if err != nil {
+		return 0, ""
+	}
+	return int64(len(placeholder.Values)), fmt.Sprintf("%s|%d|%s", placeholder.Tag, placeholder.Type, b)
+}
+
+func (m *searchMerger) search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	nq, key := searchMergeKey(request)
+	if key == "" || nq > m.maxNQ {
+		return m.searchFunc(ctx, request)
+	}
+
+	s := &mergedSearch{
+		ctx:     ctx,
+		request: request,
+		nq:      nq,
+		done:    make(chan *milvuspb.SearchResults, 1),
+	}
+	m.add(key, s)
+	select {
+	case result := <-s.done:
+		return result, nil
+	case <-ctx.Done():
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("search of collection %s failed: %s", request.CollectionName, ctx.Err()),
+			},
+		}, nil
+	}
+}
+
+func (m *searchMerger) add(key string, s *mergedSearch) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.groups[key]
+	if !ok {
+		g = &searchMergeGroup{}
+		m.groups[key] = g
+	}
+	if g.running == 0 && g.pending == nil {
+		g.running++
+		go m.run(key, []*mergedSearch{s})
+		return
+	}
+
+	if g.pending != nil && g.pending.nq+s.nq > m.maxNQ {
+		m.sendPending(key, g)
+	}
+	if g.pending == nil {
+		batch := &searchMergeBatch{}
+		batch.timer = time.AfterFunc(m.maxWait, func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			if g.pending == batch {
+				m.sendPending(key, g)
+			}
+		})
+		g.pending = batch
+	}
+	g.pending.searches = append(g.pending.searches, s)
+	g.pending.nq += s.nq
+}
+
+// sendPending sends the pending searches of a group, it's called with mu held
+func (m *searchMerger) sendPending(key string, g *searchMergeGroup) {
+	batch := g.pending
+	g.pending = nil
+	batch.timer.Stop()
+	g.running++
+	go m.run(key, batch.searches)
+}
+
+// run sends the merged search, and then the pending searches of the group if any
+func (m *searchMerger) run(key string, searches []*mergedSearch) {
+	m.execute(searches)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g := m.groups[key]
+	g.running--
+	if g.pending != nil {
+		m.sendPending(key, g)
+	} else if g.running == 0 {
+		delete(m.groups, key)
+	}
+}
+
+func (m *searchMerger) execute(searches []*mergedSearch) {
+	if len(searches) == 1 {
+		s := searches[0]
+		result, err := m.searchFunc(s.ctx, s.request)
+		s.done <- searchResultOrError(result, err)
+		return
+	}
+
+	request, nqs, err := mergeSearchRequests(searches)
+	var result *milvuspb.SearchResults
+	if err == nil {
+		ctx, cancel := mergedSearchContext(searches)
+		result, err = m.searchFunc(ctx, request)
+		cancel()
+	}
+	result = searchResultOrError(result, err)
+	if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		for _, s := range searches {
+			s.done <- result
+		}
+		return
+	}
+	results, err := splitSearchResults(result, nqs)
+	for i, s := range searches {
+		if err != nil {
+			s.done <- searchResultOrError(nil, err)
+			continue
+		}
+		s.done <- results[i]
+	}
+}
+
+func searchResultOrError(result *milvuspb.SearchResults, err error) *milvuspb.SearchResults {
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	return result
+}
+
+// mergedSearchContext returns the context of a merged search, of the latest deadline of the searches,
+// or of no deadline if any of them has none
+func mergedSearchContext(searches []*mergedSearch) (context.Context, context.CancelFunc) {
+	var latest time.Time
+	for _, s := range searches {
+		deadline, ok := s.ctx.Deadline()
+		if !ok {
+			return context.WithCancel(context.Background())
+		}
+		if deadline.After(latest) {
+			latest = deadline
+		}
+	}
+	return context.WithDeadline(context.Background(), latest)
+}
+
+// mergeSearchRequests returns the search of the vectors of all the searches, and the num of queries of each search
+func mergeSearchRequests(searches []*mergedSearch) (*milvuspb.SearchRequest, []int64, error) {
+	var merged *milvuspb.PlaceholderValue
+	nqs := make([]int64, 0, len(searches))
+	for _, s := range searches {
+		var group milvuspb.PlaceholderGroup
+		if err := proto.Unmarshal(s.request.PlaceholderGroup, &group); err != nil {
+			return nil, nil, err
+		}
+		placeholder := group.Placeholders[0]
+		if merged == nil {
+			merged = &milvuspb.PlaceholderValue{Tag: placeholder.Tag, Type: placeholder.Type}
+		}
+		merged.Values = append(merged.Values, placeholder.Values...)
+		nqs = append(nqs, int64(len(placeholder.Values)))
+	}
+	blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{Placeholders: []*milvuspb.PlaceholderValue{merged}})
+	if err != nil {
+		return nil, nil, err
+	}
+	request := proto.Clone(searches[0].request).(*milvuspb.SearchRequest)
+	request.PlaceholderGroup = blob
+	return request, nqs, nil
+}
+
+// splitSearchResults splits the results of a merged search into the results of each search by their num of queries
+func splitSearchResults(result *milvuspb.SearchResults, nqs []int64) ([]*milvuspb.SearchResults, error) {
+	data := result.GetResults()
+	var total int64
+	for _, nq := range nqs {
+		total += nq
+	}
+	if data == nil || data.NumQueries != total || int64(len(data.Topks)) != total {
+		return nil, fmt.Errorf("the merged search of %d queries returns the results of %d queries", total, data.GetNumQueries())
+	}
+
+	results := make([]*milvuspb.SearchResults, 0, len(nqs))
+	var query, hit int64
+	for _, nq := range nqs {
+		begin := hit
+		for _, topk := range data.Topks[query : query+nq] {
+			hit += topk
+		}
+		offsets := make([]int, 0, hit-begin)
+		for i := begin; i < hit; i++ {
+			offsets = append(offsets, int(i))
+		}
+		fieldsData, err := typeutil.SelectFieldData(data.FieldsData, offsets)
+		if err != nil {
+			return nil, err
+		}
+		sub := &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       data.TopK,
+			FieldsData: fieldsData,
+			Scores:     data.Scores[begin:hit],
+			Topks:      data.Topks[query : query+nq],
+		}
+		switch ids := data.GetIds().GetIdField().(type) {
+		case *schemapb.IDs_IntId:
+			sub.Ids = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids.IntId.Data[begin:hit]}}}
+		case *schemapb.IDs_StrId:
+			sub.Ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids.StrId.Data[begin:hit]}}}
+		}
+		results = append(results, &milvuspb.SearchResults{
+			Status:            result.Status,
+			Results:           sub,
+			PartialResults:    result.PartialResults,
+			UnreachableShards: result.UnreachableShards,
+		})
+		query += nq
+	}
+	return results, nil
+}
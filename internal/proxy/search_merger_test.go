@@ -0,0 +1,278 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newMergeSearchRequest(t *testing.T, collection string, vectors ...byte) *milvuspb.SearchRequest {
+	values := make([][]byte, 0, len(vectors))
+	for _, v := range vectors {
+		values = append(values, []byte{v})
+	}
+	blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{Tag: "$0", Type: milvuspb.PlaceholderType_FloatVector, Values: values},
+		},
+	})
+	assert.NoError(t, err)
+	return &milvuspb.SearchRequest{
+		CollectionName:   collection,
+		DslType:          commonpb.DslType_BoolExprV1,
+		PlaceholderGroup: blob,
+	}
+}
+
+// echoSearch returns two hits for each query, of the ids of the vector and the vector plus 100
+func echoSearch(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	var group milvuspb.PlaceholderGroup
+	if err := proto.Unmarshal(request.PlaceholderGroup, &group); err != nil {
+		return nil, err
+	}
+	values := group.Placeholders[0].Values
+	data := &schemapb.SearchResultData{
+		NumQueries: int64(len(values)),
+		TopK:       2,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "age",
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}},
+					},
+				},
+			},
+		},
+	}
+	ids := data.Ids.GetIntId()
+	ages := data.FieldsData[0].GetScalars().GetLongData()
+	for _, v := range values {
+		for _, id := range []int64{int64(v[0]), int64(v[0]) + 100} {
+			ids.Data = append(ids.Data, id)
+			ages.Data = append(ages.Data, id*2)
+			data.Scores = append(data.Scores, float32(id))
+		}
+		data.Topks = append(data.Topks, 2)
+	}
+	return &milvuspb.SearchResults{
+		Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Results: data,
+	}, nil
+}
+
+func TestSearchMergeKey(t *testing.T) {
+	nq, key := searchMergeKey(newMergeSearchRequest(t, "c1", 1, 2))
+	assert.Equal(t, int64(2), nq)
+	assert.NotEmpty(t, key)
+
+	_, key1 := searchMergeKey(newMergeSearchRequest(t, "c1", 3))
+	assert.Equal(t, key, key1)
+	_, key2 := searchMergeKey(newMergeSearchRequest(t, "c2", 3))
+	assert.NotEqual(t, key, key2)
+
+	request := newMergeSearchRequest(t, "c1", 1)
+	request.Dsl = "age > 1"
+	_, key3 := searchMergeKey(request)
+	assert.NotEqual(t, key, key3)
+
+	request.DslType = commonpb.DslType_Dsl
+	_, key4 := searchMergeKey(request)
+	assert.Empty(t, key4)
+
+	request = newMergeSearchRequest(t, "c1", 1)
+	request.PlaceholderGroup = []byte{1, 2, 3}
+	_, key5 := searchMergeKey(request)
+	assert.Empty(t, key5)
+}
+
+func TestSplitSearchResults(t *testing.T) {
+	request, nqs, err := mergeSearchRequests([]*mergedSearch{
+		{request: newMergeSearchRequest(t, "c1", 1)},
+		{request: newMergeSearchRequest(t, "c1", 2, 3)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, nqs)
+
+	result, err := echoSearch(context.Background(), request)
+	assert.NoError(t, err)
+	results, err := splitSearchResults(result, nqs)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+
+	assert.Equal(t, int64(1), results[0].Results.NumQueries)
+	assert.Equal(t, []int64{1, 101}, results[0].Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{1, 101}, results[0].Results.Scores)
+	assert.Equal(t, []int64{2, 202}, results[0].Results.FieldsData[0].GetScalars().GetLongData().Data)
+
+	assert.Equal(t, int64(2), results[1].Results.NumQueries)
+	assert.Equal(t, []int64{2, 2}, results[1].Results.Topks)
+	assert.Equal(t, []int64{2, 102, 3, 103}, results[1].Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{4, 204, 6, 206}, results[1].Results.FieldsData[0].GetScalars().GetLongData().Data)
+
+	_, err = splitSearchResults(result, []int64{1, 1})
+	assert.Error(t, err)
+}
+
+func TestSearchMerger(t *testing.T) {
+	var mu sync.Mutex
+	var nqs []int
+	release := make(chan struct{})
+	first := true
+	merger := newSearchMerger(8, time.Minute, func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		mu.Lock()
+		wait := first
+		first = false
+		mu.Unlock()
+		if wait {
+			<-release
+		}
+		result, err := echoSearch(ctx, request)
+		mu.Lock()
+		nqs = append(nqs, int(result.Results.NumQueries))
+		mu.Unlock()
+		return result, err
+	})
+
+	var wg sync.WaitGroup
+	search := func(v byte) {
+		defer wg.Done()
+		result, err := merger.search(context.Background(), newMergeSearchRequest(t, "c1", v))
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, []int64{int64(v), int64(v) + 100}, result.Results.Ids.GetIntId().Data)
+	}
+
+	// the searches are queued behind the running one and sent as one search when it's done
+	wg.Add(1)
+	go search(0)
+	assert.Eventually(t, func() bool {
+		merger.mu.Lock()
+		defer merger.mu.Unlock()
+		return len(merger.groups) == 1
+	}, time.Second, time.Millisecond)
+	for v := byte(1); v <= 3; v++ {
+		wg.Add(1)
+		go search(v)
+	}
+	assert.Eventually(t, func() bool {
+		merger.mu.Lock()
+		defer merger.mu.Unlock()
+		for _, g := range merger.groups {
+			return g.pending != nil && g.pending.nq == 3
+		}
+		return false
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 3}, nqs)
+	assert.Eventually(t, func() bool {
+		merger.mu.Lock()
+		defer merger.mu.Unlock()
+		return len(merger.groups) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestSearchMerger_MaxWait(t *testing.T) {
+	release := make(chan struct{})
+	calls := 0
+	var mu sync.Mutex
+	merger := newSearchMerger(8, time.Millisecond, func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		mu.Lock()
+		calls++
+		wait := calls == 1
+		mu.Unlock()
+		if wait {
+			<-release
+		}
+		return echoSearch(ctx, request)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = merger.search(context.Background(), newMergeSearchRequest(t, "c1", 0))
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		merger.mu.Lock()
+		defer merger.mu.Unlock()
+		return len(merger.groups) == 1
+	}, time.Second, time.Millisecond)
+
+	// the waiting search is sent once maxWait elapsed, without waiting for the running one
+	result, err := merger.search(context.Background(), newMergeSearchRequest(t, "c1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 101}, result.Results.Ids.GetIntId().Data)
+	close(release)
+	<-done
+}
+
+func TestSearchMerger_Failed(t *testing.T) {
+	release := make(chan struct{})
+	merger := newSearchMerger(8, time.Minute, func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		<-release
+		return nil, errors.New("shard unavailable")
+	})
+
+	var wg sync.WaitGroup
+	for v := byte(0); v < 3; v++ {
+		wg.Add(1)
+		go func(v byte) {
+			defer wg.Done()
+			result, err := merger.search(context.Background(), newMergeSearchRequest(t, "c1", v))
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+			assert.Equal(t, "shard unavailable", result.Status.Reason)
+		}(v)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// the canceled search returns at once
+	release = make(chan struct{})
+	go func() {
+		_, _ = merger.search(context.Background(), newMergeSearchRequest(t, "c1", 0))
+	}()
+	assert.Eventually(t, func() bool {
+		merger.mu.Lock()
+		defer merger.mu.Unlock()
+		return len(merger.groups) == 1
+	}, time.Second, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := merger.search(ctx, newMergeSearchRequest(t, "c1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+	close(release)
+
+	// the search of more than maxNQ queries isn't merged
+	merger = newSearchMerger(1, time.Minute, echoSearch)
+	result, err = merger.search(context.Background(), newMergeSearchRequest(t, "c1", 1, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), result.Results.NumQueries)
+	assert.Equal(t, 0, len(merger.groups))
+}
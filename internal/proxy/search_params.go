@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	NprobeKey        = "nprobe"
+	EfKey            = "ef"
+	SearchKKey       = "search_k"
+	SearchTimeoutKey = "timeout"
+	PriorityKey      = "priority"
+)
+
+// searchParamOverrideKeys are the index search params which a search request may give in its search_params,
+// they override the ones in the params of the request
+var searchParamOverrideKeys = []string{NprobeKey, EfKey, SearchKKey}
+
+// overrideSearchParams merges the nprobe, ef and search_k in the search_params of a request into its index params
+func overrideSearchParams(searchParams string, kvs []*commonpb.KeyValuePair) (string, error) {
+	overrides := make(map[string]int64)
+	for _, key := range searchParamOverrideKeys {
+		value, err := GetAttrByKeyFromRepeatedKV(key, kvs)
+		if err != nil {
+			continue
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v <= 0 {
+			return "", fmt.Errorf("%s %s is not a positive integer", key, value)
+		}
+		overrides[key] = v
+	}
+	if len(overrides) == 0 {
+		return searchParams, nil
+	}
+
+	params := make(map[string]interface{})
+	if searchParams != "" {
+		decoder := json.NewDecoder(bytes.NewReader([]byte(searchParams)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&params); err != nil {
+			return "", fmt.Errorf("invalid %s: %s", SearchParamsKey, err.Error())
+		}
+	}
+	for key, v := range overrides {
+		params[key] = v
+	}
+	ret, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// searchDeadline returns the unix time in milliseconds after which the query nodes abandon a search, 0 if it
+// has no deadline. The timeout in the search_params is in milliseconds, the deadline of ctx is used if it is earlier
+func searchDeadline(ctx context.Context, kvs []*commonpb.KeyValuePair, now time.Time) (int64, error) {
+	var deadline time.Time
+	if value, err := GetAttrByKeyFromRepeatedKV(SearchTimeoutKey, kvs); err == nil {
+		timeout, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || timeout <= 0 {
+			return 0, fmt.Errorf("%s %s is not a positive integer", SearchTimeoutKey, value)
+		}
+		deadline = now.Add(time.Duration(timeout) * time.Millisecond)
+	}
+	if ctxDeadline, ok := ctx.Deadline(); ok && (deadline.IsZero() || ctxDeadline.Before(deadline)) {
+		deadline = ctxDeadline
+	}
+	if deadline.IsZero() {
+		return 0, nil
+	}
+	return deadline.UnixNano() / int64(time.Millisecond), nil
+}
+
+// searchPriority returns the priority class of a search on the query nodes, which is high, normal or low
+// in the search_params, normal by default
+func searchPriority(kvs []*commonpb.KeyValuePair) (internalpb.ReadPriority, error) {
+	value, err := GetAttrByKeyFromRepeatedKV(PriorityKey, kvs)
+	if err != nil {
+		return internalpb.ReadPriority_Normal, nil
+	}
+	for v, name := range internalpb.ReadPriority_name {
+		if strings.EqualFold(value, name) {
+			return internalpb.ReadPriority(v), nil
+		}
+	}
+	return internalpb.ReadPriority_Normal, fmt.Errorf("invalid %s %s, it should be high, normal or low", PriorityKey, value)
+}
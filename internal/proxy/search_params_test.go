@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestOverrideSearchParams(t *testing.T) {
+	params, err := overrideSearchParams(`{"nprobe": 10}`, []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"nprobe": 10}`, params)
+
+	params, err = overrideSearchParams(`{"nprobe": 10, "radius": 0.5}`, []*commonpb.KeyValuePair{
+		{Key: NprobeKey, Value: "32"},
+		{Key: EfKey, Value: "64"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ef":64,"nprobe":32,"radius":0.5}`, params)
+
+	params, err = overrideSearchParams("", []*commonpb.KeyValuePair{{Key: SearchKKey, Value: "100"}})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"search_k":100}`, params)
+
+	_, err = overrideSearchParams(`{}`, []*commonpb.KeyValuePair{{Key: NprobeKey, Value: "0"}})
+	assert.NotNil(t, err)
+	_, err = overrideSearchParams(`{}`, []*commonpb.KeyValuePair{{Key: EfKey, Value: "many"}})
+	assert.NotNil(t, err)
+	_, err = overrideSearchParams(`not json`, []*commonpb.KeyValuePair{{Key: EfKey, Value: "16"}})
+	assert.NotNil(t, err)
+}
+
+func TestSearchDeadline(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ms := func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) }
+
+	deadline, err := searchDeadline(context.Background(), nil, now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), deadline)
+
+	deadline, err = searchDeadline(context.Background(), []*commonpb.KeyValuePair{{Key: SearchTimeoutKey, Value: "500"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, ms(now)+500, deadline)
+
+	ctx, cancel := context.WithDeadline(context.Background(), now.Add(time.Second))
+	defer cancel()
+	deadline, err = searchDeadline(ctx, nil, now)
+	assert.Nil(t, err)
+	assert.Equal(t, ms(now)+1000, deadline)
+
+	deadline, err = searchDeadline(ctx, []*commonpb.KeyValuePair{{Key: SearchTimeoutKey, Value: "500"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, ms(now)+500, deadline)
+
+	deadline, err = searchDeadline(ctx, []*commonpb.KeyValuePair{{Key: SearchTimeoutKey, Value: "5000"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, ms(now)+1000, deadline)
+
+	_, err = searchDeadline(ctx, []*commonpb.KeyValuePair{{Key: SearchTimeoutKey, Value: "-1"}}, now)
+	assert.NotNil(t, err)
+}
+
+func TestSearchPriority(t *testing.T) {
+	priority, err := searchPriority(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, internalpb.ReadPriority_Normal, priority)
+
+	priority, err = searchPriority([]*commonpb.KeyValuePair{{Key: PriorityKey, Value: "high"}})
+	assert.Nil(t, err)
+	assert.Equal(t, internalpb.ReadPriority_High, priority)
+
+	priority, err = searchPriority([]*commonpb.KeyValuePair{{Key: PriorityKey, Value: "Low"}})
+	assert.Nil(t, err)
+	assert.Equal(t, internalpb.ReadPriority_Low, priority)
+
+	_, err = searchPriority([]*commonpb.KeyValuePair{{Key: PriorityKey, Value: "urgent"}})
+	assert.NotNil(t, err)
+}
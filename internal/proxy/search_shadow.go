@@ -0,0 +1,317 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	shadowWorkerNum = 4
+
+	shadowPrimaryGroup = "primary"
+	shadowCanaryGroup  = "canary"
+	shadowDroppedLabel = "dropped"
+	shadowDivergeLabel = "diverged"
+)
+
+// SearchShadowTarget is the proxy of the canary group which receives the shadowed search requests
+type SearchShadowTarget interface {
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+}
+
+type shadowTask struct {
+	request        *milvuspb.SearchRequest
+	primary        *milvuspb.SearchResults
+	primaryLatency time.Duration
+}
+
+// searchShadowState is the json response of the search shadow admin request
+type searchShadowState struct {
+	SampleRatio       float64 `json:"sample_ratio"`
+	MinRecall         float64 `json:"min_recall"`
+	Shadowed          int64   `json:"shadowed"`
+	Dropped           int64   `json:"dropped"`
+	Failed            int64   `json:"failed"`
+	Diverged          int64   `json:"diverged"`
+	AvgRecall         float64 `json:"avg_recall"`
+	AvgPrimaryLatency int64   `json:"avg_primary_latency_ms"`
+	AvgCanaryLatency  int64   `json:"avg_canary_latency_ms"`
+}
+
+// searchShadow duplicates a sample of the successful search requests to a canary group running a newer build,
+// the canary responses are discarded after their latency and recall are compared with the primary ones,
+// so that an upgrade of segcore can be validated with the real traffic
+type searchShadow struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	target    SearchShadowTarget
+	minRecall float64
+	timeout   time.Duration
+	queue     chan *shadowTask
+
+	mu               sync.Mutex
+	sampleRatio      float64 // can be changed at runtime by proxy.shadow.sampleRatio
+	shadowed         int64
+	dropped          int64
+	failed           int64
+	diverged         int64
+	recallSum        float64
+	primaryLatencyMs int64
+	canaryLatencyMs  int64
+}
+
+func newSearchShadow(ctx context.Context, target SearchShadowTarget, sampleRatio float64, minRecall float64, timeout time.Duration, bufSize int64) *searchShadow {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &searchShadow{
+		ctx:         ctx1,
+		cancel:      cancel,
+		target:      target,
+		sampleRatio: sampleRatio,
+		minRecall:   minRecall,
+		timeout:     timeout,
+		queue:       make(chan *shadowTask, bufSize),
+	}
+}
+
+func (s *searchShadow) start() {
+	for i := 0; i < shadowWorkerNum; i++ {
+		s.wg.Add(1)
+		go s.sendLoop()
+	}
+}
+
+func (s *searchShadow) close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// sample copies the request before the search task runs if it is chosen to be shadowed, otherwise it returns nil
+func (s *searchShadow) sample(request *milvuspb.SearchRequest) *milvuspb.SearchRequest {
+	s.mu.Lock()
+	sampleRatio := s.sampleRatio
+	s.mu.Unlock()
+	if sampleRatio <= 0 || rand.Float64() >= sampleRatio {
+		return nil
+	}
+	return proto.Clone(request).(*milvuspb.SearchRequest)
+}
+
+func (s *searchShadow) setSampleRatio(sampleRatio float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sampleRatio = sampleRatio
+}
+
+// shadow must be called only after the search request succeeds on the primary group, the task is dropped
+// if the canary group falls behind
+func (s *searchShadow) shadow(request *milvuspb.SearchRequest, primary *milvuspb.SearchResults, primaryLatency time.Duration) {
+	if request == nil {
+		return
+	}
+	task := &shadowTask{
+		request:        request,
+		primary:        primary,
+		primaryLatency: primaryLatency,
+	}
+	select {
+	case s.queue <- task:
+	default:
+		s.mu.Lock()
+		s.dropped++
+		s.mu.Unlock()
+		metrics.ProxySearchShadowCounter.WithLabelValues(shadowDroppedLabel).Inc()
+	}
+}
+
+func (s *searchShadow) sendLoop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Proxy search shadow send loop exit")
+			return
+		case task := <-s.queue:
+			s.send(task)
+		}
+	}
+}
+
+func (s *searchShadow) send(task *shadowTask) {
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+	start := time.Now()
+	canary, err := s.target.Search(ctx, task.request)
+	canaryLatency := time.Since(start)
+	if err == nil && canary.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(canary.Status.Reason)
+	}
+	if err != nil {
+		s.mu.Lock()
+		s.failed++
+		s.mu.Unlock()
+		metrics.ProxySearchShadowCounter.WithLabelValues(metrics.FailLabel).Inc()
+		log.Warn("Proxy failed to shadow search request",
+			zap.String("collection", task.request.CollectionName), zap.Error(err))
+		return
+	}
+
+	recall := searchRecall(task.primary.GetResults(), canary.GetResults())
+	diverged := recall < s.minRecall
+
+	s.mu.Lock()
+	s.shadowed++
+	s.recallSum += recall
+	s.primaryLatencyMs += task.primaryLatency.Milliseconds()
+	s.canaryLatencyMs += canaryLatency.Milliseconds()
+	if diverged {
+		s.diverged++
+	}
+	s.mu.Unlock()
+
+	metrics.ProxySearchShadowCounter.WithLabelValues(metrics.SuccessLabel).Inc()
+	metrics.ProxySearchShadowLatency.WithLabelValues(shadowPrimaryGroup).Observe(float64(task.primaryLatency.Milliseconds()))
+	metrics.ProxySearchShadowLatency.WithLabelValues(shadowCanaryGroup).Observe(float64(canaryLatency.Milliseconds()))
+	metrics.ProxySearchShadowRecall.Observe(recall)
+	if diverged {
+		metrics.ProxySearchShadowCounter.WithLabelValues(shadowDivergeLabel).Inc()
+		log.Warn("Proxy search shadow diverged",
+			zap.String("collection", task.request.CollectionName),
+			zap.Float64("recall", recall),
+			zap.Duration("primaryLatency", task.primaryLatency),
+			zap.Duration("canaryLatency", canaryLatency))
+	}
+}
+
+func (s *searchShadow) state() *searchShadowState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := &searchShadowState{
+		SampleRatio: s.sampleRatio,
+		MinRecall:   s.minRecall,
+		Shadowed:    s.shadowed,
+		Dropped:     s.dropped,
+		Failed:      s.failed,
+		Diverged:    s.diverged,
+	}
+	if s.shadowed > 0 {
+		state.AvgRecall = s.recallSum / float64(s.shadowed)
+		state.AvgPrimaryLatency = s.primaryLatencyMs / s.shadowed
+		state.AvgCanaryLatency = s.canaryLatencyMs / s.shadowed
+	}
+	return state
+}
+
+// searchResultIDs splits the ids of the search result by queries
+func searchResultIDs(data *schemapb.SearchResultData) [][]string {
+	if data == nil {
+		return nil
+	}
+	ids := make([]string, 0)
+	for _, id := range data.GetIds().GetIntId().GetData() {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	ids = append(ids, data.GetIds().GetStrId().GetData()...)
+
+	topks := data.Topks
+	if len(topks) == 0 {
+		topks = make([]int64, data.NumQueries)
+		for i := range topks {
+			topks[i] = data.TopK
+		}
+	}
+	ret := make([][]string, 0, len(topks))
+	offset := int64(0)
+	for _, topk := range topks {
+		end := offset + topk
+		if end > int64(len(ids)) {
+			end = int64(len(ids))
+		}
+		ret = append(ret, ids[offset:end])
+		offset = end
+	}
+	return ret
+}
+
+// searchRecall returns the ratio of the primary hits which are also hit by the canary group, query by query
+func searchRecall(primary *schemapb.SearchResultData, canary *schemapb.SearchResultData) float64 {
+	primaryIDs := searchResultIDs(primary)
+	canaryIDs := searchResultIDs(canary)
+	total, matched := 0, 0
+	for i, ids := range primaryIDs {
+		total += len(ids)
+		if i >= len(canaryIDs) {
+			continue
+		}
+		hits := make(map[string]struct{}, len(canaryIDs[i]))
+		for _, id := range canaryIDs[i] {
+			hits[id] = struct{}{}
+		}
+		for _, id := range ids {
+			if _, ok := hits[id]; ok {
+				matched++
+			}
+		}
+	}
+	if total == 0 {
+		return 1
+	}
+	return float64(matched) / float64(total)
+}
+
+// getSearchShadowMetrics returns the state of the search shadow
+func (node *Proxy) getSearchShadowMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if node.shadow == nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "search shadow is not enabled",
+			},
+		}, nil
+	}
+
+	resp, err := json.Marshal(node.shadow.state())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newIntSearchResults(topks []int64, ids []int64) *milvuspb.SearchResults {
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Results: &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Topks:      topks,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: ids},
+				},
+			},
+		},
+	}
+}
+
+type mockShadowTarget struct {
+	mu       sync.Mutex
+	requests []*milvuspb.SearchRequest
+	result   *milvuspb.SearchResults
+	failOnce bool
+}
+
+func (target *mockShadowTarget) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	target.requests = append(target.requests, request)
+	if target.failOnce {
+		target.failOnce = false
+		return nil, errors.New("mock failure")
+	}
+	return target.result, nil
+}
+
+func TestSearchRecall(t *testing.T) {
+	primary := newIntSearchResults([]int64{2, 2}, []int64{1, 2, 3, 4}).Results
+	assert.Equal(t, float64(1), searchRecall(primary, primary))
+
+	// the second query of canary misses 4, the id 1 in the second query is not counted
+	canary := newIntSearchResults([]int64{2, 2}, []int64{2, 1, 3, 1}).Results
+	assert.Equal(t, 0.75, searchRecall(primary, canary))
+
+	assert.Equal(t, float64(0), searchRecall(primary, nil))
+	assert.Equal(t, float64(1), searchRecall(nil, canary))
+
+	// topks is missing in the old versions
+	strResult := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       1,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{Data: []string{"a", "b"}},
+			},
+		},
+	}
+	assert.Equal(t, [][]string{{"a"}, {"b"}}, searchResultIDs(strResult))
+}
+
+func TestSearchShadow(t *testing.T) {
+	ctx := context.Background()
+	target := &mockShadowTarget{
+		result:   newIntSearchResults([]int64{2}, []int64{1, 3}),
+		failOnce: true,
+	}
+	s := newSearchShadow(ctx, target, 1, 0.9, time.Second, 4)
+	s.start()
+	defer s.close()
+
+	request := &milvuspb.SearchRequest{CollectionName: "shadowed", Dsl: "dsl"}
+	shadowRequest := s.sample(request)
+	assert.NotNil(t, shadowRequest)
+	// the request is copied, modification after that is not shadowed
+	request.Dsl = "modified"
+	primary := newIntSearchResults([]int64{2}, []int64{1, 2})
+	s.shadow(shadowRequest, primary, 10*time.Millisecond)
+	s.shadow(s.sample(request), primary, 10*time.Millisecond)
+	s.shadow(nil, primary, 0)
+
+	assert.Eventually(t, func() bool {
+		state := s.state()
+		return state.Shadowed+state.Failed == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	state := s.state()
+	assert.Equal(t, int64(1), state.Failed)
+	assert.Equal(t, int64(1), state.Shadowed)
+	assert.Equal(t, int64(1), state.Diverged)
+	assert.Equal(t, 0.5, state.AvgRecall)
+	assert.Equal(t, int64(10), state.AvgPrimaryLatency)
+
+	target.mu.Lock()
+	assert.Equal(t, 2, len(target.requests))
+	assert.ElementsMatch(t, []string{"dsl", "modified"}, []string{target.requests[0].Dsl, target.requests[1].Dsl})
+	target.mu.Unlock()
+
+	disabled := newSearchShadow(ctx, target, 0, 0.9, time.Second, 4)
+	assert.Nil(t, disabled.sample(request))
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	// maxLeaderChangeRetries is the max num of the retries of a shard not counted in its attempts, a retry is
+	// granted if the shard leader has changed since the attempt failed
+	maxLeaderChangeRetries = 3
+	// leaderWaitInterval is the interval of refreshing the leader of a shard without one
+	leaderWaitInterval = 200 * time.Millisecond
+)
+
+// searchShard is the progress of a shard of a search request, the shards are the virtual channels of the collection
+type searchShard struct {
+	answered bool
+	// attempts is the num of times the request was sent for the shard
+	attempts int
+	deadline time.Time
+	// failed is set if the last attempt returned an error, the shard is retried before its deadline
+	failed bool
+	reason string
+	// leader is the query node watching the shard when the request was sent for it, 0 if unknown
+	leader UniqueID
+	// leaderRetries is the num of the retries granted by the leader changes
+	leaderRetries int
+	// waiting is set while a failed shard has no leader, such as the query node watching it is restarting, it's
+	// retried once the leader is assigned or waitUntil
+	waiting   bool
+	waitUntil time.Time
+}
+
+// searchShardSet collects the partial results of a search request per shard. The request is sent to all the shards
+// at once, a shard failing or not answering before its timeout is retried up to retryTimes, and it is unreachable
+// once all the retries failed
+type searchShardSet struct {
+	shards     map[vChan]*searchShard
+	timeout    time.Duration
+	retryTimes int
+
+	receivedSealedSegmentIDs map[UniqueID]struct{}
+	globalSealedSegmentIDs   map[UniqueID]struct{}
+
+	results []*internalpb.SearchResults
+}
+
+func newSearchShardSet(vchans []vChan, timeout time.Duration, retryTimes int, now time.Time) *searchShardSet {
+	s := &searchShardSet{
+		shards:                   make(map[vChan]*searchShard, len(vchans)),
+		timeout:                  timeout,
+		retryTimes:               retryTimes,
+		receivedSealedSegmentIDs: make(map[UniqueID]struct{}),
+		globalSealedSegmentIDs:   make(map[UniqueID]struct{}),
+		results:                  make([]*internalpb.SearchResults, 0, len(vchans)),
+	}
+	for _, vchan := range vchans {
+		s.shards[vchan] = &searchShard{
+			attempts: 1,
+			deadline: now.Add(timeout),
+		}
+	}
+	return s
+}
+
+// route records the leaders of the shards the request is sent to
+func (s *searchShardSet) route(leaders map[vChan]UniqueID) {
+	for vchan, shard := range s.shards {
+		shard.leader = leaders[vchan]
+	}
+}
+
+// add records a partial result. A failed result fails the shards it searched, or all the pending shards if it
+// does not name them. The results searching neither a new shard nor a new sealed segment are ignored, they are duplicated by a retry
+func (s *searchShardSet) add(result *internalpb.SearchResults) {
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		vchans := result.ChannelIDsSearched
+		if len(vchans) == 0 {
+			vchans = s.pending()
+		}
+		for _, vchan := range vchans {
+			if shard, ok := s.shards[vchan]; ok && !shard.answered {
+				shard.failed = true
+				shard.reason = result.Status.Reason
+			}
+		}
+		return
+	}
+
+	fresh := false
+	for _, vchan := range result.ChannelIDsSearched {
+		if shard, ok := s.shards[vchan]; ok && !shard.answered {
+			shard.answered = true
+			shard.failed = false
+			fresh = true
+		}
+	}
+	for _, segmentID := range result.SealedSegmentIDsSearched {
+		if _, ok := s.receivedSealedSegmentIDs[segmentID]; !ok {
+			s.receivedSealedSegmentIDs[segmentID] = struct{}{}
+			fresh = true
+		}
+	}
+	if !fresh {
+		return
+	}
+	for _, segmentID := range result.GlobalSealedSegmentIDs {
+		s.globalSealedSegmentIDs[segmentID] = struct{}{}
+	}
+	s.results = append(s.results, result)
+}
+
+// pending returns the shards neither answered nor unreachable
+func (s *searchShardSet) pending() []vChan {
+	ret := make([]vChan, 0)
+	for vchan, shard := range s.shards {
+		if !shard.answered && !s.isUnreachable(shard) {
+			ret = append(ret, vchan)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func (s *searchShardSet) isUnreachable(shard *searchShard) bool {
+	return shard.attempts > s.retryTimes && shard.failed && !shard.waiting
+}
+
+// failed returns whether a shard failed and is not answered yet
+func (s *searchShardSet) failed() bool {
+	for _, shard := range s.shards {
+		if !shard.answered && shard.failed {
+			return true
+		}
+	}
+	return false
+}
+
+// expire fails the pending shards past their deadlines
+func (s *searchShardSet) expire(now time.Time) {
+	for _, shard := range s.shards {
+		if !shard.answered && !shard.failed && !now.Before(shard.deadline) {
+			shard.failed = true
+			shard.reason = "timeout"
+		}
+	}
+}
+
+// retry returns the failed shards to send the request again, and restarts their timeouts. leaders are the shard
+// leaders refreshed from query coord, nil if unknown. A shard whose leader has changed since its attempt is retried
+// without counting the attempt, and a shard without a leader waits for one up to the timeout
+func (s *searchShardSet) retry(now time.Time, leaders map[vChan]UniqueID) []vChan {
+	ret := make([]vChan, 0)
+	for vchan, shard := range s.shards {
+		if shard.answered || !shard.failed {
+			continue
+		}
+		leader, ok := leaders[vchan]
+		if leaders != nil && !ok {
+			if shard.waitUntil.IsZero() {
+				shard.waitUntil = now.Add(s.timeout)
+			}
+			shard.waiting = now.Before(shard.waitUntil)
+			if shard.waiting {
+				shard.deadline = now.Add(leaderWaitInterval)
+				continue
+			}
+		}
+		changed := ok && shard.leader != 0 && leader != shard.leader && shard.leaderRetries < maxLeaderChangeRetries
+		if !changed && shard.attempts > s.retryTimes {
+			continue
+		}
+		if changed {
+			shard.leaderRetries++
+		} else {
+			shard.attempts++
+		}
+		if ok {
+			shard.leader = leader
+		}
+		shard.failed = false
+		shard.waiting = false
+		shard.waitUntil = time.Time{}
+		shard.deadline = now.Add(s.timeout)
+		ret = append(ret, vchan)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// unreachable returns the shards failed in all the attempts and the reasons of their last failures
+func (s *searchShardSet) unreachable() ([]vChan, []string) {
+	vchans := make([]vChan, 0)
+	for vchan, shard := range s.shards {
+		if !shard.answered && s.isUnreachable(shard) {
+			vchans = append(vchans, vchan)
+		}
+	}
+	sort.Strings(vchans)
+	reasons := make([]string, 0, len(vchans))
+	for _, vchan := range vchans {
+		reasons = append(reasons, vchan+": "+s.shards[vchan].reason)
+	}
+	return vchans, reasons
+}
+
+// complete returns whether all the shards answered and all the sealed segments were searched
+func (s *searchShardSet) complete() bool {
+	if len(s.results) == 0 {
+		return false
+	}
+	for _, shard := range s.shards {
+		if !shard.answered {
+			return false
+		}
+	}
+	for segmentID := range s.globalSealedSegmentIDs {
+		if _, ok := s.receivedSealedSegmentIDs[segmentID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// settled returns whether every shard answered or is unreachable, nothing is worth waiting for
+func (s *searchShardSet) settled() bool {
+	return len(s.pending()) == 0
+}
+
+// tolerable returns whether the unreachable shards are a minority, so that the results of the others can be
+// returned as partial results
+func (s *searchShardSet) tolerable() bool {
+	unreachable, _ := s.unreachable()
+	return len(unreachable) > 0 && len(unreachable)*2 < len(s.shards)
+}
+
+// nextDeadline returns the earliest deadline of the pending shards, which is when the leader is refreshed again for
+// a shard waiting for its leader
+func (s *searchShardSet) nextDeadline() (time.Time, bool) {
+	var next time.Time
+	found := false
+	for _, shard := range s.shards {
+		if shard.answered || (shard.failed && !shard.waiting) {
+			continue
+		}
+		if !found || shard.deadline.Before(next) {
+			next = shard.deadline
+			found = true
+		}
+	}
+	return next, found
+}
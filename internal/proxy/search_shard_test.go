@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func newShardResult(code commonpb.ErrorCode, vchans []vChan, sealed, global []UniqueID) *internalpb.SearchResults {
+	return &internalpb.SearchResults{
+		Status:                   &commonpb.Status{ErrorCode: code, Reason: "search failed"},
+		ChannelIDsSearched:       vchans,
+		SealedSegmentIDsSearched: sealed,
+		GlobalSealedSegmentIDs:   global,
+	}
+}
+
+func TestSearchShardSet_Complete(t *testing.T) {
+	now := time.Now()
+	shards := newSearchShardSet([]vChan{"v0", "v1"}, time.Second, 1, now)
+	assert.False(t, shards.complete())
+
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v0"}, []UniqueID{1}, []UniqueID{1, 2}))
+	assert.False(t, shards.complete())
+	assert.Equal(t, []vChan{"v1"}, shards.pending())
+
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v1"}, nil, []UniqueID{1, 2}))
+	// segment 2 is not searched yet
+	assert.False(t, shards.complete())
+	assert.True(t, shards.settled())
+	_, ok := shards.nextDeadline()
+	assert.False(t, ok)
+
+	// a query node watching no channel
+	shards.add(newShardResult(commonpb.ErrorCode_Success, nil, []UniqueID{2}, []UniqueID{1, 2}))
+	assert.True(t, shards.complete())
+	assert.Equal(t, 3, len(shards.results))
+
+	// duplicated by a retry
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v1"}, nil, []UniqueID{1, 2}))
+	assert.Equal(t, 3, len(shards.results))
+}
+
+func TestSearchShardSet_Retry(t *testing.T) {
+	now := time.Now()
+	shards := newSearchShardSet([]vChan{"v0", "v1", "v2"}, time.Second, 1, now)
+	deadline, ok := shards.nextDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Second), deadline)
+
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v0"}, nil, nil))
+	shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, []vChan{"v1"}, nil, nil))
+	shards.expire(now)
+	assert.Equal(t, []vChan{"v1"}, shards.retry(now, nil))
+	assert.Empty(t, shards.retry(now, nil))
+
+	// v2 times out, v1 fails again
+	later := now.Add(time.Second)
+	shards.expire(later)
+	assert.Equal(t, []vChan{"v2"}, shards.retry(later, nil))
+	shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, []vChan{"v1"}, nil, nil))
+	assert.Empty(t, shards.retry(later, nil))
+	unreachable, reasons := shards.unreachable()
+	assert.Equal(t, []vChan{"v1"}, unreachable)
+	assert.Equal(t, []string{"v1: search failed"}, reasons)
+	assert.False(t, shards.settled())
+
+	deadline, ok = shards.nextDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, later.Add(time.Second), deadline)
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v2"}, nil, nil))
+	assert.True(t, shards.settled())
+	assert.False(t, shards.complete())
+	assert.True(t, shards.tolerable())
+	assert.Equal(t, 2, len(shards.results))
+}
+
+func TestSearchShardSet_Unreachable(t *testing.T) {
+	now := time.Now()
+	shards := newSearchShardSet([]vChan{"v0", "v1"}, time.Second, 0, now)
+
+	// a failed result without its shards fails all the pending ones
+	shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, nil, nil, nil))
+	assert.Empty(t, shards.retry(now, nil))
+	unreachable, _ := shards.unreachable()
+	assert.Equal(t, []vChan{"v0", "v1"}, unreachable)
+	assert.True(t, shards.settled())
+	assert.False(t, shards.tolerable())
+
+	shards = newSearchShardSet([]vChan{"v0", "v1"}, time.Second, 0, now)
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v0"}, nil, nil))
+	shards.expire(now.Add(time.Second))
+	unreachable, reasons := shards.unreachable()
+	assert.Equal(t, []vChan{"v1"}, unreachable)
+	assert.Equal(t, []string{"v1: timeout"}, reasons)
+	// half of the shards are not a minority
+	assert.False(t, shards.tolerable())
+}
+
+func TestSearchShardSet_LeaderChanged(t *testing.T) {
+	now := time.Now()
+	shards := newSearchShardSet([]vChan{"v0", "v1"}, time.Second, 0, now)
+	shards.route(map[vChan]UniqueID{"v0": 1, "v1": 2})
+
+	// v1 is retried without counting the attempt since its leader has changed
+	shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, []vChan{"v0", "v1"}, nil, nil))
+	assert.True(t, shards.failed())
+	assert.Equal(t, []vChan{"v1"}, shards.retry(now, map[vChan]UniqueID{"v0": 1, "v1": 3}))
+	unreachable, _ := shards.unreachable()
+	assert.Equal(t, []vChan{"v0"}, unreachable)
+
+	// up to maxLeaderChangeRetries
+	for i := 1; i < maxLeaderChangeRetries; i++ {
+		shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, []vChan{"v1"}, nil, nil))
+		assert.Equal(t, []vChan{"v1"}, shards.retry(now, map[vChan]UniqueID{"v0": 1, "v1": UniqueID(3 + i)}))
+	}
+	shards.add(newShardResult(commonpb.ErrorCode_UnexpectedError, []vChan{"v1"}, nil, nil))
+	assert.Empty(t, shards.retry(now, map[vChan]UniqueID{"v0": 1, "v1": 10}))
+	unreachable, _ = shards.unreachable()
+	assert.Equal(t, []vChan{"v0", "v1"}, unreachable)
+}
+
+func TestSearchShardSet_WaitLeader(t *testing.T) {
+	now := time.Now()
+	shards := newSearchShardSet([]vChan{"v0", "v1"}, time.Second, 0, now)
+	shards.route(map[vChan]UniqueID{"v0": 1, "v1": 2})
+	shards.add(newShardResult(commonpb.ErrorCode_Success, []vChan{"v0"}, nil, nil))
+
+	// v1 waits for its leader
+	shards.expire(now.Add(time.Second))
+	later := now.Add(time.Second)
+	assert.Empty(t, shards.retry(later, map[vChan]UniqueID{"v0": 1}))
+	assert.False(t, shards.settled())
+	deadline, ok := shards.nextDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, later.Add(leaderWaitInterval), deadline)
+
+	// retried once the leader is assigned
+	later = later.Add(leaderWaitInterval)
+	assert.Equal(t, []vChan{"v1"}, shards.retry(later, map[vChan]UniqueID{"v0": 1, "v1": 3}))
+
+	// unreachable if no leader is assigned in the timeout
+	shards.expire(later.Add(time.Second))
+	later = later.Add(time.Second)
+	assert.Empty(t, shards.retry(later, map[vChan]UniqueID{"v0": 1}))
+	assert.Empty(t, shards.retry(later.Add(time.Second), map[vChan]UniqueID{"v0": 1}))
+	unreachable, _ := shards.unreachable()
+	assert.Equal(t, []vChan{"v1"}, unreachable)
+	assert.True(t, shards.settled())
+}
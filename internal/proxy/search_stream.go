@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// searchChunkQueries returns the num of queries of a chunk holding at most chunkHits hits, at least one
+func searchChunkQueries(topk int64, chunkHits int64) int64 {
+	if topk <= 0 || chunkHits <= topk {
+		return 1
+	}
+	return chunkHits / topk
+}
+
+// reduceChunks reduces the results of the queries chunk by chunk and sends every chunk once it is reduced,
+// the result of the task only keeps the num of queries and the topk
+func (st *searchTask) reduceChunks(results []*schemapb.SearchResultData, availableQueryNodeNum int64,
+	first *internalpb.SearchResults, schema *schemapb.CollectionSchema) error {
+	nq, topk := first.NumQueries, first.TopK
+	step := searchChunkQueries(topk, Params.SearchStreamChunkHits)
+	for start := int64(0); start < nq; start += step {
+		end := start + step
+		if end > nq {
+			end = nq
+		}
+		chunk, err := reduceSearchResultDataRange(results, availableQueryNodeNum, nq, topk, first.MetricType, start, end)
+		if err != nil {
+			return err
+		}
+		st.fillOutputFields(chunk, schema)
+		st.markPartial(chunk)
+		if err := st.sendChunk(chunk); err != nil {
+			return err
+		}
+	}
+
+	st.result = &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       topk,
+		},
+	}
+	return nil
+}
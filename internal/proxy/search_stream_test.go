@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type mockSearchStreamServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	mu     sync.Mutex
+	chunks []*milvuspb.SearchResults
+}
+
+func newMockSearchStreamServer(ctx context.Context) *mockSearchStreamServer {
+	return &mockSearchStreamServer{ctx: ctx}
+}
+
+func (s *mockSearchStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockSearchStreamServer) Send(chunk *milvuspb.SearchResults) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// newSearchResultData returns the results of nq queries, the scores of a query descend from base
+func newSearchResultData(nq, topk int64, idBase int64, base float32) *schemapb.SearchResultData {
+	ids := make([]int64, 0, nq*topk)
+	scores := make([]float32, 0, nq*topk)
+	for i := int64(0); i < nq; i++ {
+		for j := int64(0); j < topk; j++ {
+			ids = append(ids, idBase+i*topk+j)
+			scores = append(scores, base-float32(j))
+		}
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: ids},
+			},
+		},
+	}
+}
+
+func TestSearchChunkQueries(t *testing.T) {
+	assert.Equal(t, int64(1), searchChunkQueries(100, 10))
+	assert.Equal(t, int64(1), searchChunkQueries(100, 100))
+	assert.Equal(t, int64(3), searchChunkQueries(100, 350))
+	assert.Equal(t, int64(1), searchChunkQueries(0, 350))
+}
+
+func TestSearchTask_reduceChunks(t *testing.T) {
+	Params.Init()
+	chunkHits := Params.SearchStreamChunkHits
+	defer func() { Params.SearchStreamChunkHits = chunkHits }()
+	Params.SearchStreamChunkHits = 4
+
+	nq, topk := int64(5), int64(2)
+	results := []*schemapb.SearchResultData{
+		newSearchResultData(nq, topk, 0, 10),
+		newSearchResultData(nq, topk, 100, 9.5),
+	}
+	first := &internalpb.SearchResults{NumQueries: nq, TopK: topk, MetricType: "IP"}
+
+	stream := newMockSearchStreamServer(context.Background())
+	st := &searchTask{
+		query:             &milvuspb.SearchRequest{},
+		sendChunk:         stream.Send,
+		unreachableShards: []vChan{"v1"},
+	}
+	err := st.reduceChunks(results, int64(len(results)), first, &schemapb.CollectionSchema{})
+	assert.NoError(t, err)
+	assert.Equal(t, nq, st.result.Results.NumQueries)
+
+	whole, err := reduceSearchResultData(results, int64(len(results)), nq, topk, "IP")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(stream.chunks))
+	var ids []int64
+	var scores []float32
+	var topks []int64
+	for i, chunk := range stream.chunks {
+		assert.Equal(t, commonpb.ErrorCode_Success, chunk.Status.ErrorCode)
+		assert.True(t, chunk.PartialResults)
+		assert.Equal(t, []vChan{"v1"}, chunk.UnreachableShards)
+		if i < 2 {
+			assert.Equal(t, int64(2), chunk.Results.NumQueries)
+		} else {
+			assert.Equal(t, int64(1), chunk.Results.NumQueries)
+		}
+		ids = append(ids, chunk.Results.Ids.GetIntId().Data...)
+		scores = append(scores, chunk.Results.Scores...)
+		topks = append(topks, chunk.Results.Topks...)
+	}
+	assert.Equal(t, whole.Results.Ids.GetIntId().Data, ids)
+	assert.Equal(t, whole.Results.Scores, scores)
+	assert.Equal(t, whole.Results.Topks, topks)
+	assert.Equal(t, []int64{0, 100, 2, 102}, ids[:4])
+}
+
+func TestReduceSearchResultData_lessThanTopK(t *testing.T) {
+	nq, topk := int64(1), int64(3)
+	matched := newSearchResultData(nq, topk, 0, 10)
+	// only 1 entity matched, e.g. a SUBSTRUCTURE search
+	matched.Ids.GetIntId().Data = []int64{1, -1, -1}
+	matched.Scores = []float32{10, -math.MaxFloat32, -math.MaxFloat32}
+	results := []*schemapb.SearchResultData{
+		matched,
+		newSearchResultData(nq, topk, 100, 9.5),
+	}
+
+	ret, err := reduceSearchResultData(results, int64(len(results)), nq, topk, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 100, 101}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{10, 9.5, 8.5}, ret.Results.Scores)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+
+	results[1].Ids.GetIntId().Data = []int64{-1, -1, -1}
+	ret, err = reduceSearchResultData(results, int64(len(results)), nq, topk, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{1}, ret.Results.Topks)
+}
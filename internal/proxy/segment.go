@@ -90,7 +90,10 @@ func (info *segInfo) Assign(ts Timestamp, count uint32) uint32 {
 }
 
 func (info *assignInfo) RemoveExpired(ts Timestamp) {
-	for e := info.segInfos.Front(); e != nil; e = e.Next() {
+	var next *list.Element
+	// list.Remove clears the links of the element, keep the next one before removing
+	for e := info.segInfos.Front(); e != nil; e = next {
+		next = e.Next()
 		segInfo, ok := e.Value.(*segInfo)
 		if !ok {
 			log.Warn("can not cast to segInfo")
@@ -168,7 +171,9 @@ func NewSegIDAssigner(ctx context.Context, dataCoord DataCoord, getTickFunc func
 func (sa *SegIDAssigner) collectExpired() {
 	ts := sa.getTickFunc()
 	for _, info := range sa.assignInfos {
-		for e := info.Front(); e != nil; e = e.Next() {
+		var next *list.Element
+		for e := info.Front(); e != nil; e = next {
+			next = e.Next()
 			assign := e.Value.(*assignInfo)
 			assign.RemoveExpired(ts)
 			if assign.Capacity(ts) == 0 {
@@ -371,6 +376,7 @@ func (sa *SegIDAssigner) processFunc(req allocator.Request) error {
 	}
 	result, err2 := assign.Assign(segRequest.timestamp, segRequest.count)
 	segRequest.segInfo = result
+	assign.lastInsertTime = time.Now()
 	return err2
 }
 
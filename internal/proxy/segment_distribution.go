@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentDistribution is the query nodes serving a collection
+type segmentDistribution struct {
+	// shardLeaders are the query nodes watching the dm channels, which search the growing segments
+	shardLeaders []typeutil.UniqueID
+	// channelLeaders is the shard leader of each dm channel
+	channelLeaders map[string]typeutil.UniqueID
+	// partitionNodes are the query nodes holding the sealed segments of each partition
+	partitionNodes map[typeutil.UniqueID][]typeutil.UniqueID
+}
+
+func newSegmentDistribution(resp *querypb.GetSegmentDistributionResponse) *segmentDistribution {
+	d := &segmentDistribution{
+		shardLeaders:   make([]typeutil.UniqueID, 0, len(resp.ShardLeaders)),
+		channelLeaders: make(map[string]typeutil.UniqueID),
+		partitionNodes: make(map[typeutil.UniqueID][]typeutil.UniqueID),
+	}
+	for _, leader := range resp.ShardLeaders {
+		d.shardLeaders = append(d.shardLeaders, leader.NodeIDLoaded)
+		for _, channel := range leader.ChannelIDs {
+			d.channelLeaders[channel] = leader.NodeIDLoaded
+		}
+	}
+	for _, info := range resp.SegmentInfos {
+		d.partitionNodes[info.PartitionID] = append(d.partitionNodes[info.PartitionID], info.NodeID)
+	}
+	return d
+}
+
+// queryNodes returns the query nodes to search the partitions, which are the shard leaders and the query nodes
+// holding the sealed segments of the partitions
+func (d *segmentDistribution) queryNodes(partitionIDs []typeutil.UniqueID) []typeutil.UniqueID {
+	nodes := make(map[typeutil.UniqueID]struct{})
+	for _, nodeID := range d.shardLeaders {
+		nodes[nodeID] = struct{}{}
+	}
+	for _, partitionID := range partitionIDs {
+		for _, nodeID := range d.partitionNodes[partitionID] {
+			nodes[nodeID] = struct{}{}
+		}
+	}
+	ret := make([]typeutil.UniqueID, 0, len(nodes))
+	for nodeID := range nodes {
+		ret = append(ret, nodeID)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
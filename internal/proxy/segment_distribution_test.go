@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type mockDistributionQueryCoord struct {
+	types.QueryCoord
+	calls int
+}
+
+func (m *mockDistributionQueryCoord) GetSegmentDistribution(ctx context.Context, req *querypb.GetSegmentDistributionRequest) (*querypb.GetSegmentDistributionResponse, error) {
+	m.calls++
+	if req.CollectionID != 1 {
+		return &querypb.GetSegmentDistributionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "collection not loaded",
+			},
+		}, nil
+	}
+	return &querypb.GetSegmentDistributionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ShardLeaders: []*querypb.DmChannelInfo{
+			{NodeIDLoaded: 1, ChannelIDs: []string{"dml_0"}},
+			{NodeIDLoaded: 2, ChannelIDs: []string{"dml_1"}},
+		},
+		SegmentInfos: []*querypb.SegmentInfo{
+			{SegmentID: 100, PartitionID: 10, NodeID: 3},
+			{SegmentID: 101, PartitionID: 10, NodeID: 1},
+			{SegmentID: 102, PartitionID: 11, NodeID: 4},
+			{SegmentID: 103, PartitionID: 12, NodeID: 3},
+		},
+	}, nil
+}
+
+func TestSegmentDistribution_QueryNodes(t *testing.T) {
+	resp, err := (&mockDistributionQueryCoord{}).GetSegmentDistribution(context.Background(), &querypb.GetSegmentDistributionRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	d := newSegmentDistribution(resp)
+
+	assert.Equal(t, []typeutil.UniqueID{1, 2, 3}, d.queryNodes([]typeutil.UniqueID{10}))
+	assert.Equal(t, []typeutil.UniqueID{1, 2, 3, 4}, d.queryNodes([]typeutil.UniqueID{11, 12}))
+	assert.Equal(t, []typeutil.UniqueID{1, 2}, d.queryNodes([]typeutil.UniqueID{13}))
+	assert.Equal(t, map[string]typeutil.UniqueID{"dml_0": 1, "dml_1": 2}, d.channelLeaders)
+}
+
+func TestMetaCache_GetSegmentDistribution(t *testing.T) {
+	ctx := context.Background()
+	queryCoord := &mockDistributionQueryCoord{}
+	cache, err := NewMetaCache(nil, queryCoord)
+	assert.NoError(t, err)
+
+	d, err := cache.GetSegmentDistribution(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []typeutil.UniqueID{1, 2, 4}, d.queryNodes([]typeutil.UniqueID{11}))
+	_, err = cache.GetSegmentDistribution(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, queryCoord.calls)
+
+	cache.RemoveSegmentDistribution(ctx, 1)
+	_, err = cache.GetSegmentDistribution(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, queryCoord.calls)
+
+	cache.RemoveSegmentDistribution(ctx, 0)
+	_, err = cache.GetSegmentDistribution(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, queryCoord.calls)
+
+	_, err = cache.GetSegmentDistribution(ctx, 2)
+	assert.Error(t, err)
+
+	cache, err = NewMetaCache(nil, nil)
+	assert.NoError(t, err)
+	_, err = cache.GetSegmentDistribution(ctx, 1)
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@
 package proxy
 
 import (
+	"container/list"
 	"context"
 	"fmt"
 	"math/rand"
@@ -315,3 +316,20 @@ func TestSegmentAllocator6(t *testing.T) {
 	assert.True(t, success)
 
 }
+
+func TestAssignInfo_RemoveExpired(t *testing.T) {
+	segInfos := list.New()
+	segInfos.PushBack(&segInfo{segID: 1, count: 10, expireTime: 100})
+	segInfos.PushBack(&segInfo{segID: 2, count: 10, expireTime: 100})
+	segInfos.PushBack(&segInfo{segID: 3, count: 0, expireTime: 300})
+	segInfos.PushBack(&segInfo{segID: 4, count: 10, expireTime: 300})
+	info := &assignInfo{segInfos: segInfos}
+
+	info.RemoveExpired(200)
+	assert.Equal(t, 1, info.segInfos.Len())
+	assert.Equal(t, UniqueID(4), info.segInfos.Front().Value.(*segInfo).segID)
+	assert.Equal(t, uint32(10), info.Capacity(200))
+
+	info.RemoveExpired(400)
+	assert.Equal(t, 0, info.segInfos.Len())
+}
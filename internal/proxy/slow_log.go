@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
+)
+
+// stagedTask is implemented by the tasks which record the time of their stages for the slow log
+type stagedTask interface {
+	getStages() *slowlog.Stages
+}
+
+// recordStage records the time since the last stage of the task, it does nothing if the task is not staged
+func recordStage(t task, name string) {
+	if st, ok := t.(stagedTask); ok {
+		st.getStages().Record(name)
+	}
+}
+
+func (st *searchTask) getStages() *slowlog.Stages {
+	return st.stages
+}
+
+func (qt *queryTask) getStages() *slowlog.Stages {
+	return qt.stages
+}
+
+// logSlowSearch writes the search task to the slow log if it takes longer than Params.SlowLogThreshold
+func (node *Proxy) logSlowSearch(st *searchTask, err error) {
+	latency := st.stages.Elapse()
+	if !node.slowLogger.IsSlow(latency) {
+		return
+	}
+	record := &slowlog.Record{
+		Type:               slowlog.SearchType,
+		MsgID:              st.Base.MsgID,
+		Collection:         st.query.CollectionName,
+		Partitions:         st.query.PartitionNames,
+		Expr:               st.query.Dsl,
+		Nq:                 st.result.GetResults().GetNumQueries(),
+		TopK:               st.result.GetResults().GetTopK(),
+		ConsistencyLevel:   slowlog.ConsistencyLevel(st.query.GuaranteeTimestamp, st.BeginTs()),
+		GuaranteeTimestamp: st.SearchRequest.GuaranteeTimestamp,
+		TravelTimestamp:    st.SearchRequest.TravelTimestamp,
+		Latency:            latency,
+		Stages:             st.stages.Stages(),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else if st.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		record.Error = st.result.GetStatus().GetReason()
+	}
+	node.slowLogger.Log(record)
+}
+
+// logSlowQuery writes the query task to the slow log if it takes longer than Params.SlowLogThreshold
+func (node *Proxy) logSlowQuery(qt *queryTask, err error) {
+	latency := qt.stages.Elapse()
+	if !node.slowLogger.IsSlow(latency) {
+		return
+	}
+	record := &slowlog.Record{
+		Type:               slowlog.QueryType,
+		MsgID:              qt.Base.MsgID,
+		Collection:         qt.query.CollectionName,
+		Partitions:         qt.query.PartitionNames,
+		Expr:               qt.query.Expr,
+		ConsistencyLevel:   slowlog.ConsistencyLevel(qt.query.GuaranteeTimestamp, qt.BeginTs()),
+		GuaranteeTimestamp: qt.RetrieveRequest.GuaranteeTimestamp,
+		TravelTimestamp:    qt.RetrieveRequest.TravelTimestamp,
+		Latency:            latency,
+		Stages:             qt.stages.Stages(),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else if qt.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		record.Error = qt.result.GetStatus().GetReason()
+	}
+	node.slowLogger.Log(record)
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// insertBatcher collects the consecutive batches of a stream insert which can be merged into one insert request,
+// so that they are validated, hashed to the channels and assigned to the segments once
+type insertBatcher struct {
+	maxRows uint32
+	batches []*milvuspb.InsertRequest
+	rows    uint32
+}
+
+func newInsertBatcher(maxRows uint32) *insertBatcher {
+	return &insertBatcher{maxRows: maxRows}
+}
+
+func (b *insertBatcher) empty() bool {
+	return len(b.batches) == 0
+}
+
+func (b *insertBatcher) full() bool {
+	return b.rows >= b.maxRows
+}
+
+// accepts returns whether req can be merged with the pending batches, the batches should insert into the same
+// partition with the same fields, and the num of rows of every batch is required to split the result
+func (b *insertBatcher) accepts(req *milvuspb.InsertRequest) bool {
+	if b.empty() {
+		return true
+	}
+	first := b.batches[0]
+	if req.NumRows == 0 || first.NumRows == 0 || b.rows+req.NumRows > b.maxRows {
+		return false
+	}
+	if req.DbName != first.DbName || req.CollectionName != first.CollectionName || req.PartitionName != first.PartitionName {
+		return false
+	}
+	if (len(req.HashKeys) == 0) != (len(first.HashKeys) == 0) || len(req.FieldsData) != len(first.FieldsData) {
+		return false
+	}
+	for i, fd := range req.FieldsData {
+		other := first.FieldsData[i]
+		if fd.FieldName != other.FieldName || fd.Type != other.Type || fd.GetVectors().GetDim() != other.GetVectors().GetDim() {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *insertBatcher) add(req *milvuspb.InsertRequest) {
+	b.batches = append(b.batches, req)
+	b.rows += req.NumRows
+}
+
+// take returns the pending batches and resets the batcher
+func (b *insertBatcher) take() []*milvuspb.InsertRequest {
+	batches := b.batches
+	b.batches = nil
+	b.rows = 0
+	return batches
+}
+
+// mergeInsertRequests concatenates the columns of the batches accepted by an insertBatcher
+func mergeInsertRequests(batches []*milvuspb.InsertRequest) (*milvuspb.InsertRequest, error) {
+	if len(batches) == 1 {
+		return batches[0], nil
+	}
+	first := batches[0]
+	merged := &milvuspb.InsertRequest{
+		Base:           first.Base,
+		DbName:         first.DbName,
+		CollectionName: first.CollectionName,
+		PartitionName:  first.PartitionName,
+		FieldsData:     make([]*schemapb.FieldData, len(first.FieldsData)),
+	}
+	for _, batch := range batches {
+		merged.NumRows += batch.NumRows
+		merged.HashKeys = append(merged.HashKeys, batch.HashKeys...)
+	}
+	columns := make([]*schemapb.FieldData, len(batches))
+	for i := range first.FieldsData {
+		for j, batch := range batches {
+			columns[j] = batch.FieldsData[i]
+		}
+		fd, err := mergeFieldData(columns)
+		if err != nil {
+			return nil, err
+		}
+		merged.FieldsData[i] = fd
+	}
+	return merged, nil
+}
+
+func mergeFieldData(columns []*schemapb.FieldData) (*schemapb.FieldData, error) {
+	first := columns[0]
+	for _, c := range columns[1:] {
+		if !sameFieldKind(first, c) {
+			return nil, fmt.Errorf("the batches have different data of field %s", first.FieldName)
+		}
+	}
+	merged := &schemapb.FieldData{
+		Type:      first.Type,
+		FieldName: first.FieldName,
+		FieldId:   first.FieldId,
+	}
+	switch first.Field.(type) {
+	case *schemapb.FieldData_Scalars:
+		scalars := &schemapb.ScalarField{}
+		switch first.GetScalars().Data.(type) {
+		case *schemapb.ScalarField_BoolData:
+			var data []bool
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetBoolData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+		case *schemapb.ScalarField_IntData:
+			var data []int32
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetIntData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+		case *schemapb.ScalarField_LongData:
+			var data []int64
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetLongData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+		case *schemapb.ScalarField_FloatData:
+			var data []float32
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetFloatData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+		case *schemapb.ScalarField_DoubleData:
+			var data []float64
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetDoubleData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+		case *schemapb.ScalarField_StringData:
+			var data []string
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetStringData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+		case *schemapb.ScalarField_BytesData:
+			var data [][]byte
+			for _, c := range columns {
+				data = append(data, c.GetScalars().GetBytesData().GetData()...)
+			}
+			scalars.Data = &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: data}}
+		default:
+			return nil, fmt.Errorf("unsupported scalar data of field %s", first.FieldName)
+		}
+		merged.Field = &schemapb.FieldData_Scalars{Scalars: scalars}
+	case *schemapb.FieldData_Vectors:
+		vectors := &schemapb.VectorField{Dim: first.GetVectors().GetDim()}
+		switch first.GetVectors().Data.(type) {
+		case *schemapb.VectorField_FloatVector:
+			var data []float32
+			for _, c := range columns {
+				data = append(data, c.GetVectors().GetFloatVector().GetData()...)
+			}
+			vectors.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}}
+		case *schemapb.VectorField_BinaryVector:
+			var data []byte
+			for _, c := range columns {
+				data = append(data, c.GetVectors().GetBinaryVector()...)
+			}
+			vectors.Data = &schemapb.VectorField_BinaryVector{BinaryVector: data}
+		default:
+			return nil, fmt.Errorf("unsupported vector data of field %s", first.FieldName)
+		}
+		merged.Field = &schemapb.FieldData_Vectors{Vectors: vectors}
+	default:
+		return nil, fmt.Errorf("no data in field %s", first.FieldName)
+	}
+	return merged, nil
+}
+
+func sameFieldKind(a, b *schemapb.FieldData) bool {
+	return reflect.TypeOf(a.Field) == reflect.TypeOf(b.Field) &&
+		reflect.TypeOf(a.GetScalars().GetData()) == reflect.TypeOf(b.GetScalars().GetData()) &&
+		reflect.TypeOf(a.GetVectors().GetData()) == reflect.TypeOf(b.GetVectors().GetData())
+}
+
+// splitMutationResult splits the result of a merged insert into the results of the batches
+func splitMutationResult(result *milvuspb.MutationResult, batches []*milvuspb.InsertRequest) []*milvuspb.MutationResult {
+	if len(batches) == 1 {
+		return []*milvuspb.MutationResult{result}
+	}
+	results := make([]*milvuspb.MutationResult, 0, len(batches))
+	offset := uint32(0)
+	for _, batch := range batches {
+		end := offset + batch.NumRows
+		r := &milvuspb.MutationResult{
+			Status:    result.Status,
+			SuccIndex: sliceIndexRange(result.SuccIndex, offset, end),
+			ErrIndex:  sliceIndexRange(result.ErrIndex, offset, end),
+			InsertCnt: int64(batch.NumRows),
+			Timestamp: result.Timestamp,
+		}
+		switch ids := result.GetIDs().GetIdField().(type) {
+		case *schemapb.IDs_IntId:
+			if int(end) <= len(ids.IntId.Data) {
+				r.IDs = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids.IntId.Data[offset:end]}}}
+			}
+		case *schemapb.IDs_StrId:
+			if int(end) <= len(ids.StrId.Data) {
+				r.IDs = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids.StrId.Data[offset:end]}}}
+			}
+		}
+		results = append(results, r)
+		offset = end
+	}
+	return results
+}
+
+// sliceIndexRange returns the indexes in [start, end) relative to start
+func sliceIndexRange(indexes []uint32, start, end uint32) []uint32 {
+	var ret []uint32
+	for _, idx := range indexes {
+		if idx >= start && idx < end {
+			ret = append(ret, idx-start)
+		}
+	}
+	return ret
+}
+
+// failedMutationResults returns the results of the batches which are not inserted
+func failedMutationResults(batches []*milvuspb.InsertRequest, status *commonpb.Status) []*milvuspb.MutationResult {
+	results := make([]*milvuspb.MutationResult, 0, len(batches))
+	for _, batch := range batches {
+		errIndex := make([]uint32, batch.NumRows)
+		for i := range errIndex {
+			errIndex[i] = uint32(i)
+		}
+		results = append(results, &milvuspb.MutationResult{
+			Status:   status,
+			ErrIndex: errIndex,
+		})
+	}
+	return results
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type mockStreamInsertServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	mu        sync.Mutex
+	requests  []*milvuspb.InsertRequest
+	responses []*milvuspb.StreamInsertResponse
+}
+
+func newMockStreamInsertServer(ctx context.Context, requests ...*milvuspb.InsertRequest) *mockStreamInsertServer {
+	return &mockStreamInsertServer{ctx: ctx, requests: requests}
+}
+
+func (s *mockStreamInsertServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockStreamInsertServer) Recv() (*milvuspb.InsertRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *mockStreamInsertServer) Send(resp *milvuspb.StreamInsertResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *mockStreamInsertServer) results() []*milvuspb.MutationResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var results []*milvuspb.MutationResult
+	for _, resp := range s.responses {
+		results = append(results, resp.Results...)
+	}
+	return results
+}
+
+func newStreamInsertBatch(collection string, numRows int) *milvuspb.InsertRequest {
+	return &milvuspb.InsertRequest{
+		CollectionName: collection,
+		FieldsData: []*schemapb.FieldData{
+			newScalarFieldData(schemapb.DataType_Int64, "pk", numRows),
+			newFloatVectorFieldData("vec", numRows, 8),
+			newBinaryVectorFieldData("bin", numRows, 16),
+		},
+		HashKeys: generateHashKeys(numRows),
+		NumRows:  uint32(numRows),
+	}
+}
+
+func TestInsertBatcher(t *testing.T) {
+	b := newInsertBatcher(10)
+	assert.True(t, b.empty())
+	assert.True(t, b.accepts(newStreamInsertBatch("c1", 4)))
+
+	b.add(newStreamInsertBatch("c1", 4))
+	assert.True(t, b.accepts(newStreamInsertBatch("c1", 6)))
+	assert.False(t, b.accepts(newStreamInsertBatch("c1", 7)))
+	assert.False(t, b.accepts(newStreamInsertBatch("c2", 1)))
+	assert.False(t, b.accepts(&milvuspb.InsertRequest{CollectionName: "c1"}))
+
+	other := newStreamInsertBatch("c1", 1)
+	other.FieldsData[1] = newFloatVectorFieldData("vec", 1, 16)
+	assert.False(t, b.accepts(other))
+	other = newStreamInsertBatch("c1", 1)
+	other.HashKeys = nil
+	assert.False(t, b.accepts(other))
+
+	b.add(newStreamInsertBatch("c1", 6))
+	assert.True(t, b.full())
+	assert.Equal(t, 2, len(b.take()))
+	assert.True(t, b.empty())
+	assert.False(t, b.full())
+}
+
+func TestMergeInsertRequests(t *testing.T) {
+	batches := []*milvuspb.InsertRequest{newStreamInsertBatch("c1", 2), newStreamInsertBatch("c1", 3)}
+	merged, err := mergeInsertRequests(batches)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(5), merged.NumRows)
+	assert.Equal(t, append(batches[0].HashKeys, batches[1].HashKeys...), merged.HashKeys)
+	assert.Equal(t, append(batches[0].FieldsData[0].GetScalars().GetLongData().Data, batches[1].FieldsData[0].GetScalars().GetLongData().Data...),
+		merged.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, 5*8, len(merged.FieldsData[1].GetVectors().GetFloatVector().Data))
+	assert.Equal(t, int64(8), merged.FieldsData[1].GetVectors().Dim)
+	assert.Equal(t, append(batches[0].FieldsData[2].GetVectors().GetBinaryVector(), batches[1].FieldsData[2].GetVectors().GetBinaryVector()...),
+		merged.FieldsData[2].GetVectors().GetBinaryVector())
+	// the batches are not changed
+	assert.Equal(t, 2, len(batches[0].FieldsData[0].GetScalars().GetLongData().Data))
+
+	merged, err = mergeInsertRequests(batches[:1])
+	assert.Nil(t, err)
+	assert.Equal(t, batches[0], merged)
+
+	batches[1].FieldsData[0] = newScalarFieldData(schemapb.DataType_Int32, "pk", 3)
+	batches[1].FieldsData[0].Type = schemapb.DataType_Int64
+	_, err = mergeInsertRequests(batches)
+	assert.NotNil(t, err)
+}
+
+func TestSplitMutationResult(t *testing.T) {
+	batches := []*milvuspb.InsertRequest{newStreamInsertBatch("c1", 2), newStreamInsertBatch("c1", 3)}
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	results := splitMutationResult(&milvuspb.MutationResult{
+		Status:    status,
+		IDs:       &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{10, 11, 12, 13, 14}}}},
+		SuccIndex: []uint32{0, 1, 2, 3, 4},
+		InsertCnt: 5,
+		Timestamp: 100,
+	}, batches)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, []int64{10, 11}, results[0].IDs.GetIntId().Data)
+	assert.Equal(t, []uint32{0, 1}, results[0].SuccIndex)
+	assert.Equal(t, int64(2), results[0].InsertCnt)
+	assert.Equal(t, []int64{12, 13, 14}, results[1].IDs.GetIntId().Data)
+	assert.Equal(t, []uint32{0, 1, 2}, results[1].SuccIndex)
+	assert.Equal(t, int64(3), results[1].InsertCnt)
+	assert.Equal(t, uint64(100), results[1].Timestamp)
+
+	failed := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+	results = splitMutationResult(&milvuspb.MutationResult{Status: failed, ErrIndex: []uint32{0, 1, 2, 3, 4}}, batches)
+	assert.Nil(t, results[0].IDs)
+	assert.Equal(t, []uint32{0, 1, 2}, results[1].ErrIndex)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, results[1].Status.ErrorCode)
+
+	results = failedMutationResults(batches, failed)
+	assert.Equal(t, []uint32{0, 1}, results[0].ErrIndex)
+	assert.Equal(t, []uint32{0, 1, 2}, results[1].ErrIndex)
+}
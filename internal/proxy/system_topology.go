@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// topologyCoordRoles are the coordinators composed into the cluster topology, in order
+var topologyCoordRoles = []string{
+	typeutil.RootCoordRole,
+	typeutil.DataCoordRole,
+	typeutil.QueryCoordRole,
+	typeutil.IndexCoordRole,
+}
+
+// roleDependencies are the external dependencies used by each role
+var roleDependencies = map[string][]string{
+	typeutil.ProxyRole:      {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency},
+	typeutil.RootCoordRole:  {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency},
+	typeutil.DataCoordRole:  {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency},
+	typeutil.DataNodeRole:   {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency, metricsinfo.ObjectStoreDependency},
+	typeutil.QueryCoordRole: {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency},
+	typeutil.QueryNodeRole:  {metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency, metricsinfo.ObjectStoreDependency},
+	typeutil.IndexCoordRole: {metricsinfo.EtcdDependency, metricsinfo.ObjectStoreDependency},
+	typeutil.IndexNodeRole:  {metricsinfo.EtcdDependency, metricsinfo.ObjectStoreDependency},
+}
+
+// coordTopology is the common part of the system info metrics of all the coordinators, root coord
+// reports itself as Self while the others report their cluster
+type coordTopology struct {
+	Cluster struct {
+		Self           metricsinfo.BaseComponentInfos   `json:"self"`
+		ConnectedNodes []metricsinfo.BaseComponentInfos `json:"connected_nodes"`
+	} `json:"cluster"`
+	Self        *metricsinfo.BaseComponentInfos `json:"self"`
+	Connections metricsinfo.ConnTopology        `json:"connections"`
+}
+
+// coordMetrics is the system info metrics response of a coordinator
+type coordMetrics struct {
+	role string
+	resp *milvuspb.GetMetricsResponse
+	err  error
+}
+
+// dependencyState is the reachability of an external dependency
+type dependencyState struct {
+	name    string
+	address string
+	err     error
+}
+
+func componentState(infos *metricsinfo.BaseComponentInfos) (string, string) {
+	if infos.HasError {
+		return metricsinfo.AbnormalState, infos.ErrorReason
+	}
+	return metricsinfo.HealthyState, ""
+}
+
+// composeClusterTopology composes the graph from the metrics of the coordinators and the states of the dependencies,
+// an unreachable coordinator is added as a node named by its role
+func composeClusterTopology(proxyName, proxyAddress string, coords []coordMetrics, dependencies []dependencyState) *metricsinfo.ClusterTopology {
+	topology := &metricsinfo.ClusterTopology{
+		Nodes: make([]metricsinfo.ClusterTopologyNode, 0),
+		Edges: make([]metricsinfo.ClusterTopologyEdge, 0),
+	}
+	topology.AddNode(metricsinfo.ClusterTopologyNode{
+		Name:    proxyName,
+		Type:    typeutil.ProxyRole,
+		State:   metricsinfo.HealthyState,
+		Address: proxyAddress,
+	})
+	roleOfNode := map[string]string{proxyName: typeutil.ProxyRole}
+
+	coordNames := make(map[string]string)
+	coordConnections := make(map[string][]metricsinfo.ConnectionInfo)
+	for _, coord := range coords {
+		err := coord.err
+		if err == nil && coord.resp == nil {
+			err = errors.New("empty response")
+		}
+		if err == nil && coord.resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			err = errors.New(coord.resp.Status.Reason)
+		}
+		if err != nil {
+			coordNames[coord.role] = coord.role
+			topology.AddNode(metricsinfo.ClusterTopologyNode{
+				Name:   coord.role,
+				Type:   coord.role,
+				State:  metricsinfo.UnreachableState,
+				Reason: err.Error(),
+			})
+			topology.AddEdge(proxyName, coord.role, metricsinfo.Forward)
+			roleOfNode[coord.role] = coord.role
+			continue
+		}
+
+		name := coord.resp.ComponentName
+		coordNames[coord.role] = name
+		roleOfNode[name] = coord.role
+		topology.AddEdge(proxyName, name, metricsinfo.Forward)
+
+		ct := &coordTopology{}
+		if err := metricsinfo.UnmarshalTopology(coord.resp.Response, ct); err != nil {
+			topology.AddNode(metricsinfo.ClusterTopologyNode{
+				Name:   name,
+				Type:   coord.role,
+				State:  metricsinfo.AbnormalState,
+				Reason: "failed to decode the metrics: " + err.Error(),
+			})
+			continue
+		}
+
+		self := &ct.Cluster.Self
+		if ct.Self != nil {
+			self = ct.Self
+		}
+		state, reason := componentState(self)
+		topology.AddNode(metricsinfo.ClusterTopologyNode{
+			Name:    name,
+			Type:    coord.role,
+			State:   state,
+			Reason:  reason,
+			Address: self.HardwareInfos.IP,
+		})
+		coordConnections[name] = ct.Connections.ConnectedComponents
+
+		for i := range ct.Cluster.ConnectedNodes {
+			infos := &ct.Cluster.ConnectedNodes[i]
+			state, reason := componentState(infos)
+			topology.AddNode(metricsinfo.ClusterTopologyNode{
+				Name:    infos.Name,
+				Type:    infos.Type,
+				State:   state,
+				Reason:  reason,
+				Address: infos.HardwareInfos.IP,
+			})
+			topology.AddEdge(name, infos.Name, metricsinfo.CoordConnectToNode)
+			roleOfNode[infos.Name] = infos.Type
+		}
+	}
+
+	// the connections between the coordinators are reported by their roles
+	for _, role := range topologyCoordRoles {
+		name, ok := coordNames[role]
+		if !ok {
+			continue
+		}
+		for _, conn := range coordConnections[name] {
+			if target, ok := coordNames[conn.TargetType]; ok {
+				topology.AddEdge(name, target, metricsinfo.Forward)
+			}
+		}
+	}
+
+	for _, dep := range dependencies {
+		node := metricsinfo.ClusterTopologyNode{
+			Name:    dep.name,
+			Type:    dep.name,
+			State:   metricsinfo.HealthyState,
+			Address: dep.address,
+		}
+		if dep.err != nil {
+			node.State = metricsinfo.UnreachableState
+			node.Reason = dep.err.Error()
+		}
+		topology.AddNode(node)
+	}
+	for _, node := range topology.Nodes {
+		for _, dep := range roleDependencies[roleOfNode[node.Name]] {
+			if topology.HasNode(dep) {
+				topology.AddEdge(node.Name, dep, metricsinfo.DependOn)
+			}
+		}
+	}
+	return topology
+}
+
+// checkDependencies checks the reachability of the external dependencies concurrently, a dependency with
+// several addresses such as the etcd cluster is reachable if any of them is
+func checkDependencies(addresses map[string][]string, timeout time.Duration) []dependencyState {
+	names := []string{metricsinfo.EtcdDependency, metricsinfo.MessageQueueDependency, metricsinfo.ObjectStoreDependency}
+	states := make([]dependencyState, 0, len(names))
+	for _, name := range names {
+		if len(addresses[name]) == 0 {
+			continue
+		}
+		states = append(states, dependencyState{
+			name:    name,
+			address: strings.Join(addresses[name], ","),
+		})
+	}
+
+	var wg sync.WaitGroup
+	for i := range states {
+		wg.Add(1)
+		go func(state *dependencyState) {
+			defer wg.Done()
+			for _, address := range addresses[state.name] {
+				state.err = metricsinfo.CheckReachable(address, timeout)
+				if state.err == nil {
+					return
+				}
+			}
+		}(&states[i])
+	}
+	wg.Wait()
+	return states
+}
+
+func (node *Proxy) getSystemTopologyMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	proxyName := metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID)
+	infoReq, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: proxyName,
+		}, nil
+	}
+
+	getMetrics := map[string]func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error){
+		typeutil.RootCoordRole:  node.rootCoord.GetMetrics,
+		typeutil.DataCoordRole:  node.dataCoord.GetMetrics,
+		typeutil.QueryCoordRole: node.queryCoord.GetMetrics,
+		typeutil.IndexCoordRole: node.indexCoord.GetMetrics,
+	}
+	ctx, cancel := context.WithTimeout(ctx, Params.HealthCheckTimeout)
+	defer cancel()
+
+	coords := make([]coordMetrics, len(topologyCoordRoles))
+	var wg sync.WaitGroup
+	for i, role := range topologyCoordRoles {
+		wg.Add(1)
+		go func(i int, role string) {
+			defer wg.Done()
+			resp, err := getMetrics[role](ctx, infoReq)
+			coords[i] = coordMetrics{role: role, resp: resp, err: err}
+		}(i, role)
+	}
+	dependencies := checkDependencies(map[string][]string{
+		metricsinfo.EtcdDependency:         Params.EtcdEndpoints,
+		metricsinfo.MessageQueueDependency: {Params.PulsarAddress},
+		metricsinfo.ObjectStoreDependency:  {Params.MinIOAddress},
+	}, Params.HealthCheckTimeout)
+	wg.Wait()
+
+	topology := composeClusterTopology(proxyName, node.session.Address, coords, dependencies)
+	resp, err := metricsinfo.MarshalTopology(topology)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: proxyName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      resp,
+		ComponentName: proxyName,
+	}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func findTopologyNode(topology *metricsinfo.ClusterTopology, name string) *metricsinfo.ClusterTopologyNode {
+	for i := range topology.Nodes {
+		if topology.Nodes[i].Name == name {
+			return &topology.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func hasTopologyEdge(topology *metricsinfo.ClusterTopology, source, target string, connType metricsinfo.ConnectionType) bool {
+	for _, edge := range topology.Edges {
+		if edge.Source == source && edge.Target == target && edge.Type == connType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestComposeClusterTopology(t *testing.T) {
+	queryCoordTopology := metricsinfo.QueryCoordTopology{
+		Cluster: metricsinfo.QueryClusterTopology{
+			Self: metricsinfo.QueryCoordInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{Name: "QueryCoord1", Type: typeutil.QueryCoordRole},
+			},
+			ConnectedNodes: []metricsinfo.QueryNodeInfos{
+				{BaseComponentInfos: metricsinfo.BaseComponentInfos{Name: "QueryNode2", Type: typeutil.QueryNodeRole}},
+				{BaseComponentInfos: metricsinfo.BaseComponentInfos{Name: "QueryNode3", Type: typeutil.QueryNodeRole,
+					HasError: true, ErrorReason: "mock"}},
+			},
+		},
+		Connections: metricsinfo.ConnTopology{
+			Name: "QueryCoord1",
+			ConnectedComponents: []metricsinfo.ConnectionInfo{
+				{TargetType: typeutil.RootCoordRole},
+				{TargetType: typeutil.DataCoordRole},
+			},
+		},
+	}
+	queryCoordResp, err := metricsinfo.MarshalTopology(queryCoordTopology)
+	assert.Nil(t, err)
+	rootCoordTopology := metricsinfo.RootCoordTopology{
+		Self: metricsinfo.RootCoordInfos{
+			BaseComponentInfos: metricsinfo.BaseComponentInfos{Name: "RootCoord4", Type: typeutil.RootCoordRole},
+		},
+		Connections: metricsinfo.ConnTopology{
+			ConnectedComponents: []metricsinfo.ConnectionInfo{{TargetType: typeutil.IndexCoordRole}},
+		},
+	}
+	rootCoordResp, err := metricsinfo.MarshalTopology(rootCoordTopology)
+	assert.Nil(t, err)
+
+	success := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	coords := []coordMetrics{
+		{role: typeutil.RootCoordRole, resp: &milvuspb.GetMetricsResponse{Status: success, Response: rootCoordResp, ComponentName: "RootCoord4"}},
+		{role: typeutil.DataCoordRole, err: errors.New("mock")},
+		{role: typeutil.QueryCoordRole, resp: &milvuspb.GetMetricsResponse{Status: success, Response: queryCoordResp, ComponentName: "QueryCoord1"}},
+		{role: typeutil.IndexCoordRole, resp: &milvuspb.GetMetricsResponse{Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"}}},
+	}
+	dependencies := []dependencyState{
+		{name: metricsinfo.EtcdDependency, address: "localhost:2379"},
+		{name: metricsinfo.ObjectStoreDependency, address: "localhost:9000", err: errors.New("mock")},
+	}
+
+	topology := composeClusterTopology("Proxy5", "localhost:19530", coords, dependencies)
+	assert.Equal(t, 9, len(topology.Nodes))
+	assert.Equal(t, metricsinfo.HealthyState, findTopologyNode(topology, "Proxy5").State)
+	assert.Equal(t, metricsinfo.HealthyState, findTopologyNode(topology, "RootCoord4").State)
+	assert.Equal(t, metricsinfo.UnreachableState, findTopologyNode(topology, typeutil.DataCoordRole).State)
+	assert.Equal(t, metricsinfo.UnreachableState, findTopologyNode(topology, typeutil.IndexCoordRole).State)
+	assert.Equal(t, metricsinfo.HealthyState, findTopologyNode(topology, "QueryNode2").State)
+	assert.Equal(t, metricsinfo.AbnormalState, findTopologyNode(topology, "QueryNode3").State)
+	assert.Equal(t, metricsinfo.UnreachableState, findTopologyNode(topology, metricsinfo.ObjectStoreDependency).State)
+	assert.Nil(t, findTopologyNode(topology, metricsinfo.MessageQueueDependency))
+
+	assert.True(t, hasTopologyEdge(topology, "Proxy5", "QueryCoord1", metricsinfo.Forward))
+	assert.True(t, hasTopologyEdge(topology, "Proxy5", typeutil.DataCoordRole, metricsinfo.Forward))
+	assert.True(t, hasTopologyEdge(topology, "QueryCoord1", "QueryNode2", metricsinfo.CoordConnectToNode))
+	assert.True(t, hasTopologyEdge(topology, "QueryCoord1", "RootCoord4", metricsinfo.Forward))
+	assert.True(t, hasTopologyEdge(topology, "QueryCoord1", typeutil.DataCoordRole, metricsinfo.Forward))
+	assert.True(t, hasTopologyEdge(topology, "RootCoord4", typeutil.IndexCoordRole, metricsinfo.Forward))
+	assert.True(t, hasTopologyEdge(topology, "QueryNode2", metricsinfo.ObjectStoreDependency, metricsinfo.DependOn))
+	assert.True(t, hasTopologyEdge(topology, "Proxy5", metricsinfo.EtcdDependency, metricsinfo.DependOn))
+	assert.False(t, hasTopologyEdge(topology, "Proxy5", metricsinfo.ObjectStoreDependency, metricsinfo.DependOn))
+}
+
+func TestCheckDependencies(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	closedAddress := closed.Addr().String()
+	closed.Close()
+
+	states := checkDependencies(map[string][]string{
+		metricsinfo.EtcdDependency:         {closedAddress, l.Addr().String()},
+		metricsinfo.MessageQueueDependency: {"pulsar://" + closedAddress},
+	}, time.Second)
+	assert.Equal(t, 2, len(states))
+	assert.Equal(t, metricsinfo.EtcdDependency, states[0].name)
+	assert.Nil(t, states[0].err)
+	assert.Equal(t, metricsinfo.MessageQueueDependency, states[1].name)
+	assert.Error(t, states[1].err)
+}
@@ -12,9 +12,8 @@
 package proxy
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -44,6 +43,9 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -63,6 +65,8 @@ const (
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
 	GetPartitionStatisticsTaskName  = "GetPartitionStatisticsTask"
 	ShowCollectionTaskName          = "ShowCollectionTask"
+	AlterCollectionTaskName         = "AlterCollectionTask"
+	UndropCollectionTaskName        = "UndropCollectionTask"
 	CreatePartitionTaskName         = "CreatePartitionTask"
 	DropPartitionTaskName           = "DropPartitionTask"
 	HasPartitionTaskName            = "HasPartitionTask"
@@ -110,15 +114,22 @@ type insertTask struct {
 	Condition
 	ctx context.Context
 
-	result         *milvuspb.MutationResult
-	dataCoord      types.DataCoord
-	rowIDAllocator *allocator.IDAllocator
-	segIDAssigner  *SegIDAssigner
-	chMgr          channelsMgr
-	chTicker       channelsTimeTicker
-	vChannels      []vChan
-	pChannels      []pChan
-	schema         *schemapb.CollectionSchema
+	result             *milvuspb.MutationResult
+	dataCoord          types.DataCoord
+	rowIDAllocator     *allocator.IDAllocator
+	snowflakeAllocator *allocator.SnowflakeIDAllocator
+	pkChecker          *pkChecker
+	segIDAssigner      *SegIDAssigner
+	chMgr              channelsMgr
+	chTicker           channelsTimeTicker
+	vChannels          []vChan
+	pChannels          []pChan
+	schema             *schemapb.CollectionSchema
+	idConfig           *idConfig
+
+	// reservedPKs are the primary keys reserved in pkChecker, which are released after the insert finishes
+	reservedPKs          []int64
+	reservedCollectionID UniqueID
 }
 
 func (it *insertTask) TraceCtx() context.Context {
@@ -336,243 +347,25 @@ func (it *insertTask) checkRowNums() error {
 
 // TODO(dragondriver): ignore the order of fields in request, use the order of CollectionSchema to reorganize data
 func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
-	dTypes := make([]schemapb.DataType, 0, len(it.req.FieldsData))
-	datas := make([][]interface{}, 0, len(it.req.FieldsData))
-	rowNum := 0
-
-	appendScalarField := func(getDataFunc func() interface{}) error {
-		fieldDatas := reflect.ValueOf(getDataFunc())
-		if rowNum != 0 && rowNum != fieldDatas.Len() {
-			return errors.New("the row num of different column is not equal")
-		}
-		rowNum = fieldDatas.Len()
-		datas = append(datas, make([]interface{}, 0, rowNum))
-		idx := len(datas) - 1
-		for i := 0; i < rowNum; i++ {
-			datas[idx] = append(datas[idx], fieldDatas.Index(i).Interface())
-		}
-
-		return nil
-	}
-
-	appendFloatVectorField := func(fDatas []float32, dim int64) error {
-		l := len(fDatas)
-		if int64(l)%dim != 0 {
-			return errors.New("invalid vectors")
-		}
-		r := int64(l) / dim
-		if rowNum != 0 && rowNum != int(r) {
-			return errors.New("the row num of different column is not equal")
-		}
-		rowNum = int(r)
-		datas = append(datas, make([]interface{}, 0, rowNum))
-		idx := len(datas) - 1
-		vector := make([]float32, 0, dim)
-		for i := 0; i < l; i++ {
-			vector = append(vector, fDatas[i])
-			if int64(i+1)%dim == 0 {
-				datas[idx] = append(datas[idx], vector)
-				vector = make([]float32, 0, dim)
-			}
-		}
-
-		return nil
-	}
-
-	appendBinaryVectorField := func(bDatas []byte, dim int64) error {
-		l := len(bDatas)
-		if dim%8 != 0 {
-			return errors.New("invalid dim")
-		}
-		if (8*int64(l))%dim != 0 {
-			return errors.New("invalid vectors")
-		}
-		r := (8 * int64(l)) / dim
-		if rowNum != 0 && rowNum != int(r) {
-			return errors.New("the row num of different column is not equal")
+	columns := make([]*columnView, 0, len(it.req.FieldsData))
+	for _, field := range it.req.FieldsData {
+		col, err := newColumnView(field)
+		if err != nil {
+			return err
 		}
-		rowNum = int(r)
-		datas = append(datas, make([]interface{}, 0, rowNum))
-		idx := len(datas) - 1
-		vector := make([]byte, 0, dim)
-		for i := 0; i < l; i++ {
-			vector = append(vector, bDatas[i])
-			if (8*int64(i+1))%dim == 0 {
-				datas[idx] = append(datas[idx], vector)
-				vector = make([]byte, 0, dim)
-			}
+		if col != nil {
+			columns = append(columns, col)
 		}
-
-		return nil
 	}
 
-	for _, field := range it.req.FieldsData {
-		switch field.Field.(type) {
-		case *schemapb.FieldData_Scalars:
-			scalarField := field.GetScalars()
-			switch scalarField.Data.(type) {
-			case *schemapb.ScalarField_BoolData:
-				err := appendScalarField(func() interface{} {
-					return scalarField.GetBoolData().Data
-				})
-				if err != nil {
-					return err
-				}
-			case *schemapb.ScalarField_IntData:
-				err := appendScalarField(func() interface{} {
-					return scalarField.GetIntData().Data
-				})
-				if err != nil {
-					return err
-				}
-			case *schemapb.ScalarField_LongData:
-				err := appendScalarField(func() interface{} {
-					return scalarField.GetLongData().Data
-				})
-				if err != nil {
-					return err
-				}
-			case *schemapb.ScalarField_FloatData:
-				err := appendScalarField(func() interface{} {
-					return scalarField.GetFloatData().Data
-				})
-				if err != nil {
-					return err
-				}
-			case *schemapb.ScalarField_DoubleData:
-				err := appendScalarField(func() interface{} {
-					return scalarField.GetDoubleData().Data
-				})
-				if err != nil {
-					return err
-				}
-			case *schemapb.ScalarField_BytesData:
-				return errors.New("bytes field is not supported now")
-			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
-			case nil:
-				continue
-			default:
-				continue
-			}
-		case *schemapb.FieldData_Vectors:
-			vectorField := field.GetVectors()
-			switch vectorField.Data.(type) {
-			case *schemapb.VectorField_FloatVector:
-				floatVectorFieldData := vectorField.GetFloatVector().Data
-				dim := vectorField.GetDim()
-				err := appendFloatVectorField(floatVectorFieldData, dim)
-				if err != nil {
-					return err
-				}
-			case *schemapb.VectorField_BinaryVector:
-				binaryVectorFieldData := vectorField.GetBinaryVector()
-				dim := vectorField.GetDim()
-				err := appendBinaryVectorField(binaryVectorFieldData, dim)
-				if err != nil {
-					return err
-				}
-			case nil:
-				continue
-			default:
-				continue
-			}
-		case nil:
-			continue
-		default:
-			continue
-		}
-
-		dTypes = append(dTypes, field.Type)
+	rows, err := encodeRows(columns)
+	if err != nil {
+		return err
 	}
-
-	it.RowData = make([]*commonpb.Blob, 0, rowNum)
-	l := len(dTypes)
-	// TODO(dragondriver): big endian or little endian?
-	endian := binary.LittleEndian
-	printed := false
-	for i := 0; i < rowNum; i++ {
-		blob := &commonpb.Blob{
-			Value: make([]byte, 0),
-		}
-
-		for j := 0; j < l; j++ {
-			var buffer bytes.Buffer
-			switch dTypes[j] {
-			case schemapb.DataType_Bool:
-				d := datas[j][i].(bool)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Int8:
-				d := int8(datas[j][i].(int32))
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Int16:
-				d := int16(datas[j][i].(int32))
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Int32:
-				d := datas[j][i].(int32)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Int64:
-				d := datas[j][i].(int64)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Float:
-				d := datas[j][i].(float32)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_Double:
-				d := datas[j][i].(float64)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_FloatVector:
-				d := datas[j][i].([]float32)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			case schemapb.DataType_BinaryVector:
-				d := datas[j][i].([]byte)
-				err := binary.Write(&buffer, endian, d)
-				if err != nil {
-					log.Warn("ConvertData", zap.Error(err))
-				}
-				blob.Value = append(blob.Value, buffer.Bytes()...)
-			default:
-				log.Warn("unsupported data type")
-			}
-		}
-		if !printed {
-			log.Debug("Proxy, transform", zap.Any("ID", it.ID()), zap.Any("BlobLen", len(blob.Value)), zap.Any("dTypes", dTypes))
-			printed = true
-		}
-		it.RowData = append(it.RowData, blob)
+	it.RowData = rows
+	if len(rows) > 0 {
+		log.Debug("Proxy, transform", zap.Any("ID", it.ID()), zap.Any("BlobLen", len(rows[0].Value)), zap.Int("columns", len(columns)))
 	}
-
 	return nil
 }
 
@@ -647,16 +440,11 @@ func (it *insertTask) checkFieldAutoID() error {
 		}
 	}
 
-	var rowIDBegin UniqueID
-	var rowIDEnd UniqueID
-
-	rowIDBegin, rowIDEnd, _ = it.rowIDAllocator.Alloc(rowNums)
-
-	it.BaseInsertTask.RowIDs = make([]UniqueID, rowNums)
-	for i := rowIDBegin; i < rowIDEnd; i++ {
-		offset := i - rowIDBegin
-		it.BaseInsertTask.RowIDs[offset] = i
+	rowIDs, err := it.allocRowIDs(rowNums)
+	if err != nil {
+		return err
 	}
+	it.BaseInsertTask.RowIDs = rowIDs
 
 	if autoIDLoc >= 0 {
 		fieldData := schemapb.FieldData{
@@ -723,6 +511,44 @@ func (it *insertTask) checkFieldAutoID() error {
 	return nil
 }
 
+// allocRowIDs allocates the row ids, which are the auto ids as well, by the id mode of the collection
+func (it *insertTask) allocRowIDs(count uint32) ([]UniqueID, error) {
+	if it.idConfig != nil && it.idConfig.mode == SnowflakeIDMode {
+		if it.snowflakeAllocator == nil {
+			return nil, fmt.Errorf("snowflake id allocator is not set")
+		}
+		return it.snowflakeAllocator.Alloc(count)
+	}
+
+	rowIDBegin, rowIDEnd, err := it.rowIDAllocator.Alloc(count)
+	if err != nil {
+		return nil, err
+	}
+	rowIDs := make([]UniqueID, 0, count)
+	for i := rowIDBegin; i < rowIDEnd; i++ {
+		rowIDs = append(rowIDs, i)
+	}
+	return rowIDs, nil
+}
+
+// reservePrimaryKeys checks the primary keys supplied by the client if the collection requires it
+func (it *insertTask) reservePrimaryKeys(ctx context.Context) error {
+	if it.idConfig == nil || it.idConfig.pkCheck != StrictPKCheck || it.pkChecker == nil {
+		return nil
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.CollectionName)
+	if err != nil {
+		return err
+	}
+	pks := it.result.IDs.GetIntId().GetData()
+	if err := it.pkChecker.reserve(collID, pks); err != nil {
+		return err
+	}
+	it.reservedPKs = pks
+	it.reservedCollectionID = collID
+	return nil
+}
+
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	it.Base.MsgType = commonpb.MsgType_Insert
 	it.Base.SourceID = Params.ProxyID
@@ -754,6 +580,20 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.schema = collSchema
 
+	it.idConfig, err = getIDConfig(collSchema)
+	if err != nil {
+		return err
+	}
+
+	// the texts of the functions are sent instead of the vectors
+	it.req.FieldsData, err = embedFieldsData(ctx, collSchema, it.req.FieldsData, it.req.NumRows)
+	if err != nil {
+		return err
+	}
+	if err := Params.Limits.CheckFieldsData(it.req.FieldsData); err != nil {
+		return err
+	}
+
 	err = it.checkRowNums()
 	if err != nil {
 		return err
@@ -769,13 +609,27 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// reject the wide rows before they fail the mq and the flush
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	maxRowSize, err := getMaxRowSize(collInfo.properties)
+	if err != nil {
+		return err
+	}
+	if err = checkRowSize(it.RowData, maxRowSize); err != nil {
+		return fmt.Errorf("insert into collection %s: %w", collectionName, err)
+	}
+
 	rowNum := len(it.RowData)
 	it.Timestamps = make([]uint64, rowNum)
 	for index := range it.Timestamps {
 		it.Timestamps[index] = it.BeginTimestamp
 	}
 
-	return nil
+	// reserve the primary keys at last, they are released in Execute
+	return it.reservePrimaryKeys(ctx)
 }
 
 func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstream.MsgPack) (*msgstream.MsgPack, error) {
@@ -929,6 +783,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		partitionID := insertRequest.PartitionID
 		partitionName := insertRequest.PartitionName
 		proxyID := insertRequest.Base.SourceID
+		requestID := insertRequest.Base.RequestID
 		for index, key := range keys {
 			ts := insertRequest.Timestamps[index]
 			rowID := insertRequest.RowIDs[index]
@@ -945,6 +800,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 						MsgID:     reqID,
 						Timestamp: ts,
 						SourceID:  proxyID,
+						RequestID: requestID,
 					},
 					CollectionID:   collectionID,
 					PartitionID:    partitionID,
@@ -990,7 +846,13 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	return newPack, nil
 }
 
-func (it *insertTask) Execute(ctx context.Context) error {
+func (it *insertTask) Execute(ctx context.Context) (err error) {
+	if it.reservedPKs != nil {
+		defer func() {
+			it.pkChecker.release(it.reservedCollectionID, it.reservedPKs, err == nil)
+		}()
+	}
+
 	collectionName := it.BaseInsertTask.CollectionName
 	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
 	if err != nil {
@@ -1131,8 +993,8 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("maximum shards's number should be limited to %d", Params.MaxShardNum)
 	}
 
-	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
-		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
+	if err := Params.Limits.CheckFieldNum(len(cct.schema.Fields)); err != nil {
+		return err
 	}
 
 	// validate collection name
@@ -1152,11 +1014,23 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if _, err := getIDConfig(cct.schema); err != nil {
+		return err
+	}
+
+	if err := ValidateFunctions(cct.schema); err != nil {
+		return err
+	}
+
 	// validate field name
 	for _, field := range cct.schema.Fields {
 		if err := ValidateFieldName(field.Name); err != nil {
 			return err
 		}
+		// the sparse rows are serialized in the binlogs, but the segcore of query nodes can't load them yet
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			return fmt.Errorf("sparse float vector field %s is not supported by the query nodes yet", field.Name)
+		}
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			exist := false
 			var dim int64 = 0
@@ -1207,6 +1081,7 @@ type dropCollectionTask struct {
 	result    *commonpb.Status
 	chMgr     channelsMgr
 	chTicker  channelsTimeTicker
+	pkChecker *pkChecker
 }
 
 func (dct *dropCollectionTask) TraceCtx() context.Context {
@@ -1266,6 +1141,9 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if dct.pkChecker != nil {
+		dct.pkChecker.removeCollection(collID)
+	}
 
 	pchans, _ := dct.chMgr.getChannels(collID)
 	for _, pchan := range pchans {
@@ -1283,6 +1161,14 @@ func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// The orders of the output fields in the results
+const (
+	// OutputFieldOrderRequest keeps the requested order, a wildcard expands to the fields in the schema order
+	OutputFieldOrderRequest = "request"
+	// OutputFieldOrderSchema sorts the output fields in the schema order
+	OutputFieldOrderSchema = "schema"
+)
+
 // Support wildcard in output fields:
 //   "*" - all scalar fields
 //   "%" - all vector fields
@@ -1292,10 +1178,13 @@ func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
 //   output_fields=["*","%"] ==> [A,B,C,D]
 //   output_fields=["*",A] 	 ==> [A,B]
 //   output_fields=["*",C]   ==> [A,B,C]
+//   output_fields=[C,"*"]   ==> [C,A,B]
+// The fields keep the requested order, a wildcard expands at its position, and the primary field is appended
+// if addPrimary is set but it is not requested.
 func translateOutputFields(outputFields []string, schema *schemapb.CollectionSchema, addPrimary bool) ([]string, error) {
 	var primaryFieldName string
-	scalarFieldNameMap := make(map[string]bool)
-	vectorFieldNameMap := make(map[string]bool)
+	scalarFieldNames := make([]string, 0)
+	vectorFieldNames := make([]string, 0)
 	resultFieldNameMap := make(map[string]bool)
 	resultFieldNames := make([]string, 0)
 
@@ -1304,46 +1193,100 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 			primaryFieldName = field.Name
 		}
 		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
-			vectorFieldNameMap[field.Name] = true
+			vectorFieldNames = append(vectorFieldNames, field.Name)
 		} else {
-			scalarFieldNameMap[field.Name] = true
+			scalarFieldNames = append(scalarFieldNames, field.Name)
 		}
 	}
 
+	addField := func(fieldName string) {
+		if !resultFieldNameMap[fieldName] {
+			resultFieldNameMap[fieldName] = true
+			resultFieldNames = append(resultFieldNames, fieldName)
+		}
+	}
 	for _, outputFieldName := range outputFields {
 		outputFieldName = strings.TrimSpace(outputFieldName)
 		if outputFieldName == "*" {
-			for fieldName := range scalarFieldNameMap {
-				resultFieldNameMap[fieldName] = true
+			for _, fieldName := range scalarFieldNames {
+				addField(fieldName)
 			}
 		} else if outputFieldName == "%" {
-			for fieldName := range vectorFieldNameMap {
-				resultFieldNameMap[fieldName] = true
+			for _, fieldName := range vectorFieldNames {
+				addField(fieldName)
 			}
 		} else {
-			resultFieldNameMap[outputFieldName] = true
+			addField(outputFieldName)
 		}
 	}
 
-	if addPrimary {
-		resultFieldNameMap[primaryFieldName] = true
+	if addPrimary && primaryFieldName != "" {
+		addField(primaryFieldName)
+	}
+	return resultFieldNames, nil
+}
+
+// sortOutputFields sorts the output fields in the schema order if proxy.outputFieldOrder asks so,
+// the fields not in the schema are kept at the end to be reported as not exist
+func sortOutputFields(fieldNames []string, schema *schemapb.CollectionSchema) []string {
+	if Params.OutputFieldOrder != OutputFieldOrderSchema {
+		return fieldNames
 	}
+	position := make(map[string]int, len(schema.Fields))
+	for i, field := range schema.Fields {
+		position[field.Name] = i
+	}
+	sorted := make([]string, len(fieldNames))
+	copy(sorted, fieldNames)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, ok := position[sorted[i]]
+		if !ok {
+			return false
+		}
+		pj, ok := position[sorted[j]]
+		if !ok {
+			return true
+		}
+		return pi < pj
+	})
+	return sorted
+}
 
-	for fieldName := range resultFieldNameMap {
-		resultFieldNames = append(resultFieldNames, fieldName)
+// getOutputFieldIDs returns the ids of the output fields in the same order
+func getOutputFieldIDs(fieldNames []string, schema *schemapb.CollectionSchema) ([]int64, error) {
+	fieldIDs := make([]int64, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		found := false
+		for _, field := range schema.Fields {
+			if field.Name == name {
+				fieldIDs = append(fieldIDs, field.FieldID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("Field " + name + " not exist")
+		}
 	}
-	return resultFieldNames, nil
+	return fieldIDs, nil
 }
 
 type searchTask struct {
 	Condition
 	*internalpb.SearchRequest
-	ctx       context.Context
-	resultBuf chan []*internalpb.SearchResults
-	result    *milvuspb.SearchResults
-	query     *milvuspb.SearchRequest
-	chMgr     channelsMgr
-	qc        types.QueryCoord
+	ctx context.Context
+	// resultBuf receives the partial results of the query nodes one by one until resultDone is closed
+	resultBuf  chan *internalpb.SearchResults
+	resultDone chan struct{}
+	result     *milvuspb.SearchResults
+	query      *milvuspb.SearchRequest
+	chMgr      channelsMgr
+	qc         types.QueryCoord
+	stages     *slowlog.Stages
+
+	unreachableShards []vChan
+	// sendChunk streams the results in chunks instead of setting result if it is set
+	sendChunk func(*milvuspb.SearchResults) error
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1474,8 +1417,17 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("translate output fields", zap.Any("OutputFields", outputFields))
-	st.query.OutputFields = outputFields
+	st.query.OutputFields = sortOutputFields(outputFields, schema)
+	log.Debug("translate output fields", zap.Any("OutputFields", st.query.OutputFields))
+
+	st.SearchRequest.Deadline, err = searchDeadline(ctx, st.query.SearchParams, time.Now())
+	if err != nil {
+		return err
+	}
+	st.SearchRequest.Priority, err = searchPriority(st.query.SearchParams)
+	if err != nil {
+		return err
+	}
 
 	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
@@ -1491,6 +1443,12 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
+		if err := Params.Limits.CheckTopK(int64(topK)); err != nil {
+			return err
+		}
+		if err := Params.Limits.CheckExpr(st.query.Dsl); err != nil {
+			return err
+		}
 
 		metricType, err := GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
 		if err != nil {
@@ -1501,18 +1459,38 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return errors.New(SearchParamsKey + " not found in search_params")
 		}
+		searchParams, err = overrideSearchParams(searchParams, st.query.SearchParams)
+		if err != nil {
+			return err
+		}
 
 		queryInfo := &planpb.QueryInfo{
 			Topk:         int64(topK),
 			MetricType:   metricType,
 			SearchParams: searchParams,
+			Deadline:     st.SearchRequest.Deadline,
 		}
 
-		plan, err := CreateQueryPlan(schema, st.query.Dsl, annsField, queryInfo)
+		plan, err := CreateQueryPlan(schema, st.query.Dsl, annsField, queryInfo, st.query.ExprTemplateValues)
 		if err != nil {
 			//return errors.New("invalid expression: " + st.query.Dsl)
 			return err
 		}
+		for _, field := range schema.Fields {
+			if field.Name == annsField {
+				if err := ValidateMetricType(field.DataType, metricType); err != nil {
+					return err
+				}
+				break
+			}
+		}
+		st.query.PlaceholderGroup, err = embedPlaceholderGroup(ctx, schema, annsField, st.query.PlaceholderGroup)
+		if err != nil {
+			return err
+		}
+		if err := Params.Limits.CheckPlaceholderGroup(st.query.PlaceholderGroup); err != nil {
+			return err
+		}
 		for _, name := range st.query.OutputFields {
 			hitField := false
 			for _, field := range schema.Fields {
@@ -1588,6 +1566,9 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(errMsg)
 		}
 	}
+	if len(st.PartitionIDs) > 0 {
+		st.SearchRequest.NodeIDs = st.targetQueryNodes(ctx)
+	}
 
 	st.SearchRequest.Dsl = st.query.Dsl
 	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
@@ -1595,7 +1576,52 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// targetQueryNodes returns the query nodes holding the segments of the partitions to search,
+// nil means the search is sent to all the query nodes of the collection
+func (st *searchTask) targetQueryNodes(ctx context.Context) []UniqueID {
+	distribution, err := globalMetaCache.GetSegmentDistribution(ctx, st.CollectionID)
+	if err != nil {
+		log.Debug("Proxy Search get segment distribution failed, search all the query nodes",
+			zap.Int64("collectionID", st.CollectionID), zap.Error(err))
+		return nil
+	}
+	nodeIDs := distribution.queryNodes(st.PartitionIDs)
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+	return nodeIDs
+}
+
+// shardLeaders returns the query nodes watching the shards of the collection, nil if unknown. The cached ones are
+// refreshed from query coord if refresh
+func (st *searchTask) shardLeaders(ctx context.Context, refresh bool) map[vChan]UniqueID {
+	if refresh {
+		globalMetaCache.RemoveSegmentDistribution(ctx, st.CollectionID)
+	}
+	distribution, err := globalMetaCache.GetSegmentDistribution(ctx, st.CollectionID)
+	if err != nil {
+		log.Debug("Proxy Search get shard leaders failed",
+			zap.Int64("collectionID", st.CollectionID), zap.Error(err))
+		return nil
+	}
+	return distribution.channelLeaders
+}
+
+// searchAllQueryNodes sends the search to all the query nodes of the collection again, since the cached segment
+// distribution may be stale
+func (st *searchTask) searchAllQueryNodes(ctx context.Context) error {
+	st.SearchRequest.NodeIDs = nil
+	globalMetaCache.RemoveSegmentDistribution(ctx, st.CollectionID)
+	return st.send(ctx)
+}
+
 func (st *searchTask) Execute(ctx context.Context) error {
+	return st.send(ctx)
+}
+
+// send produces the search request to the query channel of the collection, which is consumed by all the query
+// nodes at once. It is sent again to retry the failed shards, answered by the query nodes watching them by then
+func (st *searchTask) send(ctx context.Context) error {
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1688,12 +1714,19 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType),
 		zap.Int("maxParallel", maxParallel))
 
+	return reduceSearchResultDataRange(searchResultData, availableQueryNodeNum, nq, topk, metricType, 0, nq)
+}
+
+// reduceSearchResultDataRange reduces the results of the queries in [start, end)
+func reduceSearchResultDataRange(searchResultData []*schemapb.SearchResultData, availableQueryNodeNum int64,
+	nq int64, topk int64, metricType string, start, end int64) (*milvuspb.SearchResults, error) {
+
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
 			ErrorCode: 0,
 		},
 		Results: &schemapb.SearchResultData{
-			NumQueries: nq,
+			NumQueries: end - start,
 			TopK:       topk,
 			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
 			Scores:     make([]float32, 0),
@@ -1734,12 +1767,12 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 	var realTopK int64 = -1
 	var idx int64
 	var j int64
-	for idx = 0; idx < nq; idx++ {
+	for idx = start; idx < end; idx++ {
 		locs := make([]int64, availableQueryNodeNum)
 
 		j = 0
 		for ; j < topk; j++ {
-			valid := true
+			valid := false
 			choice, maxDistance := 0, minFloat32
 			for q, loc := range locs { // query num, the number of ways to merge
 				if loc >= topk {
@@ -1747,14 +1780,16 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 				}
 				curIdx := idx*topk + loc
 				id := searchResultData[q].Ids.GetIntId().Data[curIdx]
+				// the rest of the way are invalid if the way has less than topk results,
+				// e.g. the SUBSTRUCTURE and SUPERSTRUCTURE searches only return the matched entities
 				if id == -1 {
-					valid = false
-				} else {
-					distance := searchResultData[q].Scores[curIdx]
-					if distance > maxDistance {
-						choice = q
-						maxDistance = distance
-					}
+					continue
+				}
+				distance := searchResultData[q].Scores[curIdx]
+				if !valid || distance > maxDistance {
+					choice = q
+					maxDistance = distance
+					valid = true
 				}
 			}
 			if !valid {
@@ -1930,99 +1965,201 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, avail
 func (st *searchTask) PostExecute(ctx context.Context) error {
 	t0 := time.Now()
 	defer func() {
+		close(st.resultDone)
 		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
 	}()
+
+	vchans, err := st.getVChannels()
+	if err != nil {
+		return err
+	}
+	shards := newSearchShardSet(vchans, Params.SearchShardTimeout, Params.SearchShardRetryTimes, t0)
+	shards.route(st.shardLeaders(ctx, false))
+	timer := time.NewTimer(Params.SearchShardTimeout)
+	defer timer.Stop()
 	for {
 		select {
 		case <-st.TraceCtx().Done():
 			log.Debug("Proxy", zap.Int64("searchTask PostExecute Loop exit caused by ctx.Done", st.ID()))
 			return fmt.Errorf("searchTask:wait to finish failed, timeout: %d", st.ID())
-		case searchResults := <-st.resultBuf:
-			// fmt.Println("searchResults: ", searchResults)
-			filterSearchResult := make([]*internalpb.SearchResults, 0)
-			var filterReason string
-			for _, partialSearchResult := range searchResults {
-				if partialSearchResult.Status.ErrorCode == commonpb.ErrorCode_Success {
-					filterSearchResult = append(filterSearchResult, partialSearchResult)
-					// For debugging, please don't delete.
-					// printSearchResult(partialSearchResult)
-				} else {
-					filterReason += partialSearchResult.Status.Reason + "\n"
-				}
+		case result := <-st.resultBuf:
+			shards.add(result)
+		case <-timer.C:
+		}
+
+		if shards.complete() {
+			return st.reduceResults(ctx, shards.results)
+		}
+		now := time.Now()
+		shards.expire(now)
+		var leaders map[vChan]UniqueID
+		if shards.failed() {
+			// the shard leaders may have changed, such as a query node restarted
+			leaders = st.shardLeaders(ctx, true)
+		}
+		if retry := shards.retry(now, leaders); len(retry) > 0 {
+			log.Debug("Proxy Search retry the shards", zap.Int64("msgID", st.ID()), zap.Strings("shards", retry))
+			send := st.send
+			if len(st.SearchRequest.NodeIDs) > 0 && leaders != nil {
+				st.SearchRequest.NodeIDs = st.targetQueryNodes(ctx)
+			} else if len(st.SearchRequest.NodeIDs) > 0 {
+				send = st.searchAllQueryNodes
 			}
+			if err := send(ctx); err != nil {
+				log.Warn("Proxy Search retry failed", zap.Int64("msgID", st.ID()), zap.Error(err))
+			}
+		}
 
-			availableQueryNodeNum := len(filterSearchResult)
-			log.Debug("Proxy Search PostExecute stage1", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
-			if availableQueryNodeNum <= 0 {
-				log.Debug("Proxy Search PostExecute failed", zap.Any("filterReason", filterReason))
+		unreachable, reasons := shards.unreachable()
+		if shards.settled() && len(unreachable) == 0 && len(st.SearchRequest.NodeIDs) > 0 {
+			// all the shards answered, the sealed segments not searched yet are on the query nodes not sent to
+			log.Debug("Proxy Search sealed segments missed by the target query nodes, search all the query nodes",
+				zap.Int64("msgID", st.ID()), zap.Int64s("nodeIDs", st.SearchRequest.NodeIDs))
+			if err := st.searchAllQueryNodes(ctx); err != nil {
+				log.Warn("Proxy Search all the query nodes failed", zap.Int64("msgID", st.ID()), zap.Error(err))
+			}
+		}
+		if shards.settled() && len(unreachable) > 0 {
+			reason := fmt.Sprintf("shards unreachable: %s", strings.Join(reasons, "; "))
+			if !Params.SearchPartialResults || !shards.tolerable() {
+				log.Debug("Proxy Search PostExecute failed", zap.Int64("msgID", st.ID()), zap.String("reason", reason))
 				st.result = &milvuspb.SearchResults{
 					Status: &commonpb.Status{
 						ErrorCode: commonpb.ErrorCode_UnexpectedError,
-						Reason:    filterReason,
+						Reason:    reason,
 					},
+					UnreachableShards: unreachable,
 				}
-				return errors.New(filterReason)
+				return errors.New(reason)
 			}
+			log.Warn("Proxy Search returns partial results", zap.Int64("msgID", st.ID()), zap.String("reason", reason))
+			st.unreachableShards = unreachable
+			if err := st.reduceResults(ctx, shards.results); err != nil {
+				return err
+			}
+			st.markPartial(st.result)
+			return nil
+		}
+
+		// wait for the pending shards, or for the query nodes of the sealed segments not searched yet
+		// if all the shards answered
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if deadline, ok := shards.nextDeadline(); ok {
+			timer.Reset(time.Until(deadline))
+		}
+	}
+}
+
+// reduceResults merges the partial results of the query nodes into the result of the task
+func (st *searchTask) reduceResults(ctx context.Context, searchResults []*internalpb.SearchResults) error {
+	filterSearchResult := make([]*internalpb.SearchResults, 0)
+	var filterReason string
+	// the code of the first failed query node is returned if all of them failed
+	filterCode := commonpb.ErrorCode_UnexpectedError
+	for _, partialSearchResult := range searchResults {
+		if partialSearchResult.Status.ErrorCode == commonpb.ErrorCode_Success {
+			filterSearchResult = append(filterSearchResult, partialSearchResult)
+			// For debugging, please don't delete.
+			// printSearchResult(partialSearchResult)
+		} else {
+			if filterReason == "" {
+				filterCode = partialSearchResult.Status.ErrorCode
+			}
+			filterReason += partialSearchResult.Status.Reason + "\n"
+		}
+	}
+
+	availableQueryNodeNum := len(filterSearchResult)
+	log.Debug("Proxy Search PostExecute stage1", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
+	if availableQueryNodeNum <= 0 {
+		log.Debug("Proxy Search PostExecute failed", zap.Any("filterReason", filterReason))
+		st.result = &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: filterCode,
+				Reason:    filterReason,
+			},
+		}
+		return merr.Error(st.result.Status)
+	}
 
-			availableQueryNodeNum = 0
-			for _, partialSearchResult := range filterSearchResult {
-				if partialSearchResult.SlicedBlob == nil {
-					filterReason += "empty search result\n"
-				} else {
-					availableQueryNodeNum++
-				}
-			}
-			log.Debug("Proxy Search PostExecute stage2", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
+	availableQueryNodeNum = 0
+	for _, partialSearchResult := range filterSearchResult {
+		if partialSearchResult.SlicedBlob == nil {
+			filterReason += "empty search result\n"
+		} else {
+			availableQueryNodeNum++
+		}
+	}
+	log.Debug("Proxy Search PostExecute stage2", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
 
-			if availableQueryNodeNum <= 0 {
-				log.Debug("Proxy Search PostExecute stage2 failed", zap.Any("filterReason", filterReason))
+	if availableQueryNodeNum <= 0 {
+		log.Debug("Proxy Search PostExecute stage2 failed", zap.Any("filterReason", filterReason))
 
-				st.result = &milvuspb.SearchResults{
-					Status: &commonpb.Status{
-						ErrorCode: commonpb.ErrorCode_Success,
-						Reason:    filterReason,
-					},
-					Results: &schemapb.SearchResultData{
-						NumQueries: searchResults[0].NumQueries,
-						Topks:      make([]int64, searchResults[0].NumQueries),
-					},
-				}
-				return nil
-			}
+		st.result = &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				Reason:    filterReason,
+			},
+			Results: &schemapb.SearchResultData{
+				NumQueries: searchResults[0].NumQueries,
+				Topks:      make([]int64, searchResults[0].NumQueries),
+			},
+		}
+		return nil
+	}
 
-			results, err := decodeSearchResults(filterSearchResult)
-			log.Debug("Proxy Search PostExecute decodeSearchResults", zap.Error(err))
-			if err != nil {
-				return err
-			}
+	results, err := decodeSearchResults(filterSearchResult)
+	log.Debug("Proxy Search PostExecute decodeSearchResults", zap.Error(err))
+	if err != nil {
+		return err
+	}
 
-			st.result, err = reduceSearchResultData(results, int64(availableQueryNodeNum),
-				searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
-			if err != nil {
-				return err
-			}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+	if err != nil {
+		return err
+	}
+	if st.sendChunk != nil {
+		return st.reduceChunks(results, int64(availableQueryNodeNum), searchResults[0], schema)
+	}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
-			if err != nil {
-				return err
-			}
-			if len(st.query.OutputFields) != 0 && len(st.result.Results.FieldsData) != 0 {
-				for k, fieldName := range st.query.OutputFields {
-					for _, field := range schema.Fields {
-						if st.result.Results.FieldsData[k] != nil && field.Name == fieldName {
-							st.result.Results.FieldsData[k].FieldName = field.Name
-							st.result.Results.FieldsData[k].FieldId = field.FieldID
-							st.result.Results.FieldsData[k].Type = field.DataType
-						}
-					}
+	st.result, err = reduceSearchResultData(results, int64(availableQueryNodeNum),
+		searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
+	if err != nil {
+		return err
+	}
+	st.fillOutputFields(st.result, schema)
+	log.Debug("Proxy Search PostExecute Done")
+	return nil
+}
+
+// fillOutputFields sets the names, ids and types of the output fields of result
+func (st *searchTask) fillOutputFields(result *milvuspb.SearchResults, schema *schemapb.CollectionSchema) {
+	if len(st.query.OutputFields) != 0 && len(result.Results.FieldsData) != 0 {
+		for k, fieldName := range st.query.OutputFields {
+			for _, field := range schema.Fields {
+				if result.Results.FieldsData[k] != nil && field.Name == fieldName {
+					result.Results.FieldsData[k].FieldName = field.Name
+					result.Results.FieldsData[k].FieldId = field.FieldID
+					result.Results.FieldsData[k].Type = field.DataType
 				}
 			}
-			log.Debug("Proxy Search PostExecute Done")
-			return nil
 		}
 	}
 }
 
+// markPartial flags result as partial if some shards are unreachable
+func (st *searchTask) markPartial(result *milvuspb.SearchResults) {
+	if result != nil && len(st.unreachableShards) != 0 {
+		result.PartialResults = true
+		result.UnreachableShards = st.unreachableShards
+	}
+}
+
 type queryTask struct {
 	Condition
 	*internalpb.RetrieveRequest
@@ -2033,6 +2170,7 @@ type queryTask struct {
 	chMgr     channelsMgr
 	qc        types.QueryCoord
 	ids       *schemapb.IDs
+	stages    *slowlog.Stages
 }
 
 func (qt *queryTask) TraceCtx() context.Context {
@@ -2225,6 +2363,12 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	// 	}
 	// }
 
+	// the expression of the ids is generated by the proxy
+	if qt.ids == nil {
+		if err := Params.Limits.CheckExpr(qt.query.Expr); err != nil {
+			return err
+		}
+	}
 	if qt.ids != nil {
 		pkField := ""
 		for _, field := range schema.Fields {
@@ -2240,7 +2384,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf(errMsg)
 	}
 
-	plan, err := CreateExprQueryPlan(schema, qt.query.Expr)
+	plan, err := CreateExprQueryPlan(schema, qt.query.Expr, qt.query.ExprTemplateValues)
 	if err != nil {
 		//return errors.New("invalid expression: " + st.query.Dsl)
 		return err
@@ -2249,39 +2393,14 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	qt.query.OutputFields = sortOutputFields(qt.query.OutputFields, schema)
 	log.Debug("translate output fields", zap.Any("OutputFields", qt.query.OutputFields))
-	if len(qt.query.OutputFields) == 0 {
-		for _, field := range schema.Fields {
-			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-				qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
-			}
-		}
-	} else {
-		addPrimaryKey := false
-		for _, reqField := range qt.query.OutputFields {
-			findField := false
-			for _, field := range schema.Fields {
-				if reqField == field.Name {
-					if field.IsPrimaryKey {
-						addPrimaryKey = true
-					}
-					findField = true
-					qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
-					plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
-				} else {
-					if field.IsPrimaryKey && !addPrimaryKey {
-						qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
-						plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
-						addPrimaryKey = true
-					}
-				}
-			}
-			if !findField {
-				errMsg := "Field " + reqField + " not exist"
-				return errors.New(errMsg)
-			}
-		}
+	// only the output fields are retrieved from the segments, in the same order
+	qt.OutputFieldsId, err = getOutputFieldIDs(qt.query.OutputFields, schema)
+	if err != nil {
+		return err
 	}
+	plan.OutputFieldIds = qt.OutputFieldsId
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
 	qt.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(plan)
@@ -2396,6 +2515,26 @@ func (qt *queryTask) Execute(ctx context.Context) error {
 	return err
 }
 
+// limitResults keeps the rows of the Limit smallest primary keys among the results of all the query nodes
+func (qt *queryTask) limitResults(schema *schemapb.CollectionSchema) error {
+	for i, fieldID := range qt.OutputFieldsId {
+		for _, field := range schema.Fields {
+			if field.FieldID != fieldID || !field.IsPrimaryKey {
+				continue
+			}
+			pks := qt.result.FieldsData[i].GetScalars().GetLongData().GetData()
+			offsets := typeutil.SmallestKeys(pks, int(qt.Limit))
+			fieldsData, err := typeutil.SelectFieldData(qt.result.FieldsData, offsets)
+			if err != nil {
+				return err
+			}
+			qt.result.FieldsData = fieldsData
+			return nil
+		}
+	}
+	return errors.New("the primary field is not retrieved")
+}
+
 func (qt *queryTask) PostExecute(ctx context.Context) error {
 	t0 := time.Now()
 	defer func() {
@@ -2408,10 +2547,15 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 	case retrieveResults := <-qt.resultBuf:
 		retrieveResult := make([]*internalpb.RetrieveResults, 0)
 		var reason string
+		// the code of the first failed query node is returned if all of them failed
+		code := commonpb.ErrorCode_UnexpectedError
 		for _, partialRetrieveResult := range retrieveResults {
 			if partialRetrieveResult.Status.ErrorCode == commonpb.ErrorCode_Success {
 				retrieveResult = append(retrieveResult, partialRetrieveResult)
 			} else {
+				if reason == "" {
+					code = partialRetrieveResult.Status.ErrorCode
+				}
 				reason += partialRetrieveResult.Status.Reason + "\n"
 			}
 		}
@@ -2419,13 +2563,13 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 		if len(retrieveResult) == 0 {
 			qt.result = &milvuspb.QueryResults{
 				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					ErrorCode: code,
 					Reason:    reason,
 				},
 			}
 			log.Debug("Query failed on all querynodes.",
 				zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
-			return errors.New(reason)
+			return merr.Error(qt.result.Status)
 		}
 
 		availableQueryNodeNum := 0
@@ -2513,6 +2657,11 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+		if qt.Limit > 0 {
+			if err := qt.limitResults(schema); err != nil {
+				return err
+			}
+		}
 	}
 
 	log.Info("Query PostExecute done.",
@@ -2680,6 +2829,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
 		dct.result.CreatedTimestamp = result.CreatedTimestamp
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
+		dct.result.Properties = withMaxRowSize(result.Properties)
 
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserFieldID replacing 100
@@ -2703,12 +2853,171 @@ func (dct *describeCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type alterCollectionTask struct {
+	Condition
+	*milvuspb.AlterCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (act *alterCollectionTask) TraceCtx() context.Context {
+	return act.ctx
+}
+
+func (act *alterCollectionTask) ID() UniqueID {
+	return act.Base.MsgID
+}
+
+func (act *alterCollectionTask) SetID(uid UniqueID) {
+	act.Base.MsgID = uid
+}
+
+func (act *alterCollectionTask) Name() string {
+	return AlterCollectionTaskName
+}
+
+func (act *alterCollectionTask) Type() commonpb.MsgType {
+	return act.Base.MsgType
+}
+
+func (act *alterCollectionTask) BeginTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *alterCollectionTask) EndTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *alterCollectionTask) SetTs(ts Timestamp) {
+	act.Base.Timestamp = ts
+}
+
+func (act *alterCollectionTask) OnEnqueue() error {
+	act.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (act *alterCollectionTask) PreExecute(ctx context.Context) error {
+	act.Base.MsgType = commonpb.MsgType_AlterCollection
+	act.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(act.CollectionName); err != nil {
+		return err
+	}
+	if len(act.Properties) == 0 && len(act.DeleteKeys) == 0 && act.ShardsNum == 0 {
+		return errors.New("no property to alter")
+	}
+	if act.ShardsNum < 0 {
+		return fmt.Errorf("the shards num should not be negative, %d", act.ShardsNum)
+	}
+	if act.ShardsNum > Params.MaxShardNum {
+		return fmt.Errorf("maximum shards's number should be limited to %d", Params.MaxShardNum)
+	}
+	for _, kv := range act.Properties {
+		if kv.Key == "" {
+			return errors.New("property key should not be empty")
+		}
+		if kv.Key == MaxRowSizeKey {
+			if _, err := parseMaxRowSize(kv.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (act *alterCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	act.result, err = act.rootCoord.AlterCollection(ctx, act.AlterCollectionRequest)
+	if act.result == nil {
+		return errors.New("alter collection resp is nil")
+	}
+	if act.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(act.result.Reason)
+	}
+	return err
+}
+
+func (act *alterCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type undropCollectionTask struct {
+	Condition
+	*milvuspb.UndropCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (uct *undropCollectionTask) TraceCtx() context.Context {
+	return uct.ctx
+}
+
+func (uct *undropCollectionTask) ID() UniqueID {
+	return uct.Base.MsgID
+}
+
+func (uct *undropCollectionTask) SetID(uid UniqueID) {
+	uct.Base.MsgID = uid
+}
+
+func (uct *undropCollectionTask) Name() string {
+	return UndropCollectionTaskName
+}
+
+func (uct *undropCollectionTask) Type() commonpb.MsgType {
+	return uct.Base.MsgType
+}
+
+func (uct *undropCollectionTask) BeginTs() Timestamp {
+	return uct.Base.Timestamp
+}
+
+func (uct *undropCollectionTask) EndTs() Timestamp {
+	return uct.Base.Timestamp
+}
+
+func (uct *undropCollectionTask) SetTs(ts Timestamp) {
+	uct.Base.Timestamp = ts
+}
+
+func (uct *undropCollectionTask) OnEnqueue() error {
+	uct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (uct *undropCollectionTask) PreExecute(ctx context.Context) error {
+	uct.Base.MsgType = commonpb.MsgType_UndropCollection
+	uct.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(uct.CollectionName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (uct *undropCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	uct.result, err = uct.rootCoord.UndropCollection(ctx, uct.UndropCollectionRequest)
+	return err
+}
+
+func (uct *undropCollectionTask) PostExecute(ctx context.Context) error {
+	// a collection of the same name may be cached if it's created and dropped since
+	globalMetaCache.RemoveCollection(ctx, uct.CollectionName)
+	return nil
+}
+
 type getCollectionStatisticsTask struct {
 	Condition
 	*milvuspb.GetCollectionStatisticsRequest
-	ctx       context.Context
-	dataCoord types.DataCoord
-	result    *milvuspb.GetCollectionStatisticsResponse
+	ctx        context.Context
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+	queryCoord types.QueryCoord
+	result     *milvuspb.GetCollectionStatisticsResponse
 }
 
 func (g *getCollectionStatisticsTask) TraceCtx() context.Context {
@@ -2776,16 +3085,102 @@ func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
 	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
 		return errors.New(result.Status.Reason)
 	}
+
+	indexResp, err := g.indexCoord.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{
+		Base:         req.Base,
+		CollectionID: collID,
+	})
+	if err != nil {
+		return err
+	}
+	if indexResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(indexResp.Status.Reason)
+	}
+	loadedMemorySize, err := g.getLoadedMemorySize(ctx, collID)
+	if err != nil {
+		return err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, g.CollectionName)
+	if err != nil {
+		return err
+	}
+
+	storage := collectionStorageStats(result.Statistics, indexResp.Statistics, schema)
+	storage.LoadedMemorySize = loadedMemorySize
 	g.result = &milvuspb.GetCollectionStatisticsResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 			Reason:    "",
 		},
-		Stats: result.Stats,
+		Stats: append(result.Stats,
+			&commonpb.KeyValuePair{Key: "index_size", Value: strconv.FormatInt(storage.IndexSize, 10)},
+			&commonpb.KeyValuePair{Key: "loaded_memory_size", Value: strconv.FormatInt(storage.LoadedMemorySize, 10)}),
+		Storage: storage,
 	}
 	return nil
 }
 
+// getLoadedMemorySize returns the memory of the collection loaded on the query nodes, 0 if it is not loaded
+func (g *getCollectionStatisticsTask) getLoadedMemorySize(ctx context.Context, collID UniqueID) (int64, error) {
+	metricsReq, err := metricsinfo.ConstructCollectionRuntimeStatsRequest([]int64{collID})
+	if err != nil {
+		return 0, err
+	}
+	metricsResp, err := g.queryCoord.GetMetrics(ctx, metricsReq)
+	if err != nil {
+		return 0, err
+	}
+	if metricsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, errors.New(metricsResp.Status.Reason)
+	}
+	stats := make([]*metricsinfo.CollectionRuntimeStats, 0)
+	if err := json.Unmarshal([]byte(metricsResp.Response), &stats); err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, s := range stats {
+		if s.CollectionID == collID {
+			size += s.LoadedMemoryBytes
+		}
+	}
+	return size, nil
+}
+
+// collectionStorageStats joins the binlog statistics of data coord and the index statistics of index coord by the
+// fields of the schema, the indexes of unknown fields are only counted in the index size of the collection
+func collectionStorageStats(dataStats *datapb.CollectionStatistics, indexStats []*indexpb.IndexStatistics,
+	schema *schemapb.CollectionSchema) *milvuspb.CollectionStorageStats {
+	storage := &milvuspb.CollectionStorageStats{
+		GrowingRowCount: dataStats.GetGrowingRowCount(),
+		SealedRowCount:  dataStats.GetSealedRowCount(),
+		BinlogSize:      dataStats.GetBinlogSize(),
+		StatslogSize:    dataStats.GetStatslogSize(),
+		DeltalogSize:    dataStats.GetDeltalogSize(),
+	}
+	fields := make(map[UniqueID]*milvuspb.FieldStorageStats)
+	for _, field := range schema.GetFields() {
+		fieldStats := &milvuspb.FieldStorageStats{
+			FieldID:   field.FieldID,
+			FieldName: field.Name,
+		}
+		fields[field.FieldID] = fieldStats
+		storage.Fields = append(storage.Fields, fieldStats)
+	}
+	for _, field := range dataStats.GetFields() {
+		if fieldStats, ok := fields[field.FieldID]; ok {
+			fieldStats.BinlogSize = field.BinlogSize
+			fieldStats.EstimatedSize = field.EstimatedSize
+		}
+	}
+	for _, index := range indexStats {
+		storage.IndexSize += index.SerializedSize
+		if fieldStats, ok := fields[index.FieldID]; ok {
+			fieldStats.IndexSize += index.SerializedSize
+		}
+	}
+	return storage
+}
+
 func (g *getCollectionStatisticsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -2937,8 +3332,53 @@ func (sct *showCollectionsTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		if sct.GetNamePattern() != "" || sct.GetLimit() != 0 || sct.GetPageToken() != "" {
+			return merr.Errorf(merr.ErrIllegalArgument, "the name pattern and the pagination are only supported by ShowType_All")
+		}
+	}
+	if sct.GetLimit() < 0 {
+		return merr.Errorf(merr.ErrIllegalArgument, "invalid limit %d", sct.GetLimit())
+	}
+
+	return nil
+}
+
+// loadState returns the load state of a collection by whether it's loaded and its load percentage on query nodes
+func loadState(loaded bool, percentage int64) milvuspb.LoadState {
+	if !loaded {
+		return milvuspb.LoadState_NotLoaded
+	}
+	if percentage < 100 {
+		return milvuspb.LoadState_Loading
 	}
+	return milvuspb.LoadState_Loaded
+}
 
+// fillLoadStates fills the load states of the collection details returned by root coord from query coord
+func (sct *showCollectionsTask) fillLoadStates(ctx context.Context) error {
+	resp, err := sct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     sct.Base.MsgID,
+			Timestamp: sct.Base.Timestamp,
+			SourceID:  sct.Base.SourceID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	percentages := make(map[UniqueID]int64, len(resp.CollectionIDs))
+	for offset, id := range resp.CollectionIDs {
+		percentages[id] = resp.InMemoryPercentages[offset]
+	}
+	for offset, detail := range sct.result.Details {
+		percentage, ok := percentages[sct.result.CollectionIds[offset]]
+		detail.LoadState = loadState(ok, percentage)
+		detail.LoadedPercentage = percentage
+	}
 	return nil
 }
 
@@ -2998,6 +3438,11 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			return errors.New(resp.Status.Reason)
 		}
 
+		IDs2Details := make(map[UniqueID]*milvuspb.CollectionDetail)
+		for offset, detail := range respFromRootCoord.Details {
+			IDs2Details[respFromRootCoord.CollectionIds[offset]] = detail
+		}
+
 		sct.result = &milvuspb.ShowCollectionsResponse{
 			Status:               resp.Status,
 			CollectionNames:      make([]string, 0, len(resp.CollectionIDs)),
@@ -3005,6 +3450,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			CreatedTimestamps:    make([]uint64, 0, len(resp.CollectionIDs)),
 			CreatedUtcTimestamps: make([]uint64, 0, len(resp.CollectionIDs)),
 			InMemoryPercentages:  make([]int64, 0, len(resp.CollectionIDs)),
+			WarmupPercentages:    make([]int64, 0, len(resp.CollectionIDs)),
 		}
 
 		for offset, id := range resp.CollectionIDs {
@@ -3025,9 +3471,27 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			sct.result.CreatedTimestamps = append(sct.result.CreatedTimestamps, collectionInfo.createdTimestamp)
 			sct.result.CreatedUtcTimestamps = append(sct.result.CreatedUtcTimestamps, collectionInfo.createdUtcTimestamp)
 			sct.result.InMemoryPercentages = append(sct.result.InMemoryPercentages, resp.InMemoryPercentages[offset])
+			// the query coords before warmup support return no warmup percentages
+			if len(resp.WarmupPercentages) > offset {
+				sct.result.WarmupPercentages = append(sct.result.WarmupPercentages, resp.WarmupPercentages[offset])
+			}
+			if sct.GetWithDetails() {
+				detail, ok := IDs2Details[id]
+				if !ok {
+					detail = &milvuspb.CollectionDetail{Properties: collectionInfo.properties}
+				}
+				detail.LoadState = loadState(true, resp.InMemoryPercentages[offset])
+				detail.LoadedPercentage = resp.InMemoryPercentages[offset]
+				sct.result.Details = append(sct.result.Details, detail)
+			}
 		}
 	} else {
 		sct.result = respFromRootCoord
+		if sct.GetWithDetails() {
+			if err := sct.fillLoadStates(ctx); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -3811,6 +4275,16 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	foundIndexID := false
 	for _, desc := range indexDescriptionResp.IndexDescriptions {
 		if desc.IndexName == gibpt.IndexName {
+			// the builds failed permanently are not retried, the progress would never complete
+			if desc.State == commonpb.IndexState_Failed {
+				gibpt.result = &milvuspb.GetIndexBuildProgressResponse{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_BuildIndexError,
+						Reason:    desc.FailReason,
+					},
+				}
+				return nil
+			}
 			matchIndexID = desc.IndexID
 			foundIndexID = true
 			break
@@ -4032,6 +4506,16 @@ func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	foundIndexID := false
 	for _, desc := range indexDescriptionResp.IndexDescriptions {
 		if desc.IndexName == gist.IndexName {
+			if desc.State == commonpb.IndexState_Failed {
+				gist.result = &milvuspb.GetIndexStateResponse{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_Success,
+					},
+					State:      commonpb.IndexState_Failed,
+					FailReason: desc.FailReason,
+				}
+				return nil
+			}
 			matchIndexID = desc.IndexID
 			foundIndexID = true
 			break
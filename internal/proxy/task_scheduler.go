@@ -14,24 +14,34 @@ package proxy
 import (
 	"container/list"
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
 )
 
+// schedulerLogModule is the log module of the task scheduler, whose level can be changed apart from the global one
+const schedulerLogModule = "proxy.scheduler"
+
+func schedulerLog() *zap.Logger {
+	return log.Module(schedulerLogModule)
+}
+
 type taskQueue interface {
 	utChan() <-chan int
 	utEmpty() bool
@@ -44,13 +54,25 @@ type taskQueue interface {
 	getTaskByReqID(reqID UniqueID) task
 	TaskDoneTest(ts Timestamp) bool
 	Enqueue(t task) error
+	taskInfos() []metricsinfo.TaskInfo
 }
 
-// TODO(dragondriver): load from config
-const maxTaskNum = 1024
+// TaskQueueConfig is the config of the task queues of the scheduler
+type TaskQueueConfig struct {
+	// MaxTaskNum is the capacity of each queue, the tasks enqueued into a full queue are rejected
+	MaxTaskNum int64
+	// MaxDmlConcurrency and MaxDqlConcurrency are the max numbers of the dml and the dql tasks running
+	// concurrently, the other tasks wait in their queues
+	MaxDmlConcurrency int64
+	MaxDqlConcurrency int64
+}
 
 type baseTaskQueue struct {
+	// name is the label of the queue in metrics
+	name string
+
 	unissuedTasks *list.List
+	enqueueTimes  map[UniqueID]time.Time
 	activeTasks   map[UniqueID]task
 	utLock        sync.RWMutex
 	atLock        sync.RWMutex
@@ -83,19 +105,26 @@ func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 	defer queue.utLock.Unlock()
 
 	if queue.utFull() {
-		return errors.New("task queue is full")
+		metrics.ProxyRejectedTaskCounter.WithLabelValues(queue.name).Inc()
+		return merr.Errorf(merr.ErrRateLimited, "%s is full, the max num of the tasks is %d", queue.name, queue.maxTaskNum)
 	}
 	queue.unissuedTasks.PushBack(t)
+	queue.enqueueTimes[t.ID()] = time.Now()
 	queue.utBufChan <- 1
+	queue.setLengthMetric(metricsinfo.UnissuedTaskState, queue.unissuedTasks.Len())
 	return nil
 }
 
+func (queue *baseTaskQueue) setLengthMetric(state string, length int) {
+	metrics.ProxyTaskQueueLength.WithLabelValues(queue.name, state).Set(float64(length))
+}
+
 func (queue *baseTaskQueue) FrontUnissuedTask() task {
 	queue.utLock.RLock()
 	defer queue.utLock.RUnlock()
 
 	if queue.unissuedTasks.Len() <= 0 {
-		log.Warn("sorry, but the unissued task list is empty!")
+		schedulerLog().Warn("sorry, but the unissued task list is empty!")
 		return nil
 	}
 
@@ -107,14 +136,21 @@ func (queue *baseTaskQueue) PopUnissuedTask() task {
 	defer queue.utLock.Unlock()
 
 	if queue.unissuedTasks.Len() <= 0 {
-		log.Warn("sorry, but the unissued task list is empty!")
+		schedulerLog().Warn("sorry, but the unissued task list is empty!")
 		return nil
 	}
 
 	ft := queue.unissuedTasks.Front()
 	queue.unissuedTasks.Remove(ft)
+	queue.setLengthMetric(metricsinfo.UnissuedTaskState, queue.unissuedTasks.Len())
 
-	return ft.Value.(task)
+	t := ft.Value.(task)
+	if enqueueTime, ok := queue.enqueueTimes[t.ID()]; ok {
+		delete(queue.enqueueTimes, t.ID())
+		metrics.ProxyQueueWaitLatency.WithLabelValues(queue.name).Observe(float64(time.Since(enqueueTime).Milliseconds()))
+	}
+
+	return t
 }
 
 func (queue *baseTaskQueue) AddActiveTask(t task) {
@@ -123,10 +159,11 @@ func (queue *baseTaskQueue) AddActiveTask(t task) {
 	tID := t.ID()
 	_, ok := queue.activeTasks[tID]
 	if ok {
-		log.Debug("Proxy task with tID already in active task list!", zap.Any("ID", tID))
+		schedulerLog().Debug("Proxy task with tID already in active task list!", zap.Any("ID", tID))
 	}
 
 	queue.activeTasks[tID] = t
+	queue.setLengthMetric(metricsinfo.ActiveTaskState, len(queue.activeTasks))
 }
 
 func (queue *baseTaskQueue) PopActiveTask(tID UniqueID) task {
@@ -135,10 +172,11 @@ func (queue *baseTaskQueue) PopActiveTask(tID UniqueID) task {
 	t, ok := queue.activeTasks[tID]
 	if ok {
 		delete(queue.activeTasks, tID)
+		queue.setLengthMetric(metricsinfo.ActiveTaskState, len(queue.activeTasks))
 		return t
 	}
 
-	log.Debug("Proxy task not in active task list! ts", zap.Any("tID", tID))
+	schedulerLog().Debug("Proxy task not in active task list! ts", zap.Any("tID", tID))
 	return t
 }
 
@@ -162,6 +200,40 @@ func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
 	return nil
 }
 
+// taskInfos returns the unissued tasks in order, then the active ones
+func (queue *baseTaskQueue) taskInfos() []metricsinfo.TaskInfo {
+	ret := make([]metricsinfo.TaskInfo, 0)
+	queue.utLock.RLock()
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		t := e.Value.(task)
+		info := metricsinfo.TaskInfo{
+			ID:    t.ID(),
+			Name:  t.Name(),
+			Type:  t.Type().String(),
+			Queue: queue.name,
+			State: metricsinfo.UnissuedTaskState,
+		}
+		if enqueueTime, ok := queue.enqueueTimes[t.ID()]; ok {
+			info.EnqueueTime = enqueueTime.Format(time.RFC3339)
+		}
+		ret = append(ret, info)
+	}
+	queue.utLock.RUnlock()
+
+	queue.atLock.RLock()
+	defer queue.atLock.RUnlock()
+	for _, t := range queue.activeTasks {
+		ret = append(ret, metricsinfo.TaskInfo{
+			ID:    t.ID(),
+			Name:  t.Name(),
+			Type:  t.Type().String(),
+			Queue: queue.name,
+			State: metricsinfo.ActiveTaskState,
+		})
+	}
+	return ret
+}
+
 func (queue *baseTaskQueue) TaskDoneTest(ts Timestamp) bool {
 	queue.utLock.RLock()
 	defer queue.utLock.RUnlock()
@@ -187,6 +259,7 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 	if err != nil {
 		return err
 	}
+	setRequestID(t)
 
 	ts, err := queue.tsoAllocatorIns.AllocOne()
 	if err != nil {
@@ -203,9 +276,10 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 	return queue.addUnissuedTask(t)
 }
 
-func newBaseTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *baseTaskQueue {
+func newBaseTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface, maxTaskNum int64) *baseTaskQueue {
 	return &baseTaskQueue{
 		unissuedTasks:   list.New(),
+		enqueueTimes:    make(map[UniqueID]time.Time),
 		activeTasks:     make(map[UniqueID]task),
 		utLock:          sync.RWMutex{},
 		atLock:          sync.RWMutex{},
@@ -253,10 +327,11 @@ func (queue *dmTaskQueue) PopActiveTask(tID UniqueID) task {
 	t, ok := queue.activeTasks[tID]
 	if ok {
 		delete(queue.activeTasks, tID)
-		log.Debug("Proxy dmTaskQueue popPChanStats", zap.Any("tID", t.ID()))
+		queue.setLengthMetric(metricsinfo.ActiveTaskState, len(queue.activeTasks))
+		schedulerLog().Debug("Proxy dmTaskQueue popPChanStats", zap.Any("tID", t.ID()))
 		queue.popPChanStats(t)
 	} else {
-		log.Debug("Proxy task not in active task list!", zap.Any("tID", tID))
+		schedulerLog().Debug("Proxy task not in active task list!", zap.Any("tID", tID))
 	}
 	return t
 }
@@ -265,7 +340,7 @@ func (queue *dmTaskQueue) addPChanStats(t task) error {
 	if dmT, ok := t.(dmlTask); ok {
 		stats, err := dmT.getPChanStats()
 		if err != nil {
-			log.Debug("Proxy dmTaskQueue addPChanStats", zap.Any("tID", t.ID()),
+			schedulerLog().Debug("Proxy dmTaskQueue addPChanStats", zap.Any("tID", t.ID()),
 				zap.Any("stats", stats), zap.Error(err))
 			return err
 		}
@@ -352,23 +427,29 @@ func (queue *ddTaskQueue) Enqueue(t task) error {
 	return queue.baseTaskQueue.Enqueue(t)
 }
 
-func newDdTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *ddTaskQueue {
-	return &ddTaskQueue{
-		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+func newDdTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface, maxTaskNum int64) *ddTaskQueue {
+	queue := &ddTaskQueue{
+		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns, maxTaskNum),
 	}
+	queue.name = "ddQueue"
+	return queue
 }
 
-func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dmTaskQueue {
-	return &dmTaskQueue{
-		baseTaskQueue:        newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface, maxTaskNum int64) *dmTaskQueue {
+	queue := &dmTaskQueue{
+		baseTaskQueue:        newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns, maxTaskNum),
 		pChanStatisticsInfos: make(map[pChan]*pChanStatInfo),
 	}
+	queue.name = "dmQueue"
+	return queue
 }
 
-func newDqTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dqTaskQueue {
-	return &dqTaskQueue{
-		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+func newDqTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface, maxTaskNum int64) *dqTaskQueue {
+	queue := &dqTaskQueue{
+		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns, maxTaskNum),
 	}
+	queue.name = "dqQueue"
+	return queue
 }
 
 type taskScheduler struct {
@@ -376,6 +457,12 @@ type taskScheduler struct {
 	dmQueue *dmTaskQueue
 	dqQueue taskQueue
 
+	// ddSerializer runs the dd tasks of a collection one by one
+	ddSerializer *collectionSerializer
+	// dmSlots and dqSlots limit the concurrency of the dm and dq tasks
+	dmSlots chan struct{}
+	dqSlots chan struct{}
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -386,16 +473,20 @@ type taskScheduler struct {
 func newTaskScheduler(ctx context.Context,
 	idAllocatorIns idAllocatorInterface,
 	tsoAllocatorIns tsoAllocator,
-	factory msgstream.Factory) (*taskScheduler, error) {
+	factory msgstream.Factory,
+	cfg *TaskQueueConfig) (*taskScheduler, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &taskScheduler{
-		ctx:       ctx1,
-		cancel:    cancel,
-		msFactory: factory,
+		ddSerializer: newCollectionSerializer(),
+		dmSlots:      make(chan struct{}, cfg.MaxDmlConcurrency),
+		dqSlots:      make(chan struct{}, cfg.MaxDqlConcurrency),
+		ctx:          ctx1,
+		cancel:       cancel,
+		msFactory:    factory,
 	}
-	s.ddQueue = newDdTaskQueue(tsoAllocatorIns, idAllocatorIns)
-	s.dmQueue = newDmTaskQueue(tsoAllocatorIns, idAllocatorIns)
-	s.dqQueue = newDqTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	s.ddQueue = newDdTaskQueue(tsoAllocatorIns, idAllocatorIns, cfg.MaxTaskNum)
+	s.dmQueue = newDmTaskQueue(tsoAllocatorIns, idAllocatorIns, cfg.MaxTaskNum)
+	s.dqQueue = newDqTaskQueue(tsoAllocatorIns, idAllocatorIns, cfg.MaxTaskNum)
 
 	return s, nil
 }
@@ -425,6 +516,14 @@ func (sched *taskScheduler) getTaskByReqID(collMeta UniqueID) task {
 	return nil
 }
 
+// taskInfos returns the tasks of the dd, dm and dq queues
+func (sched *taskScheduler) taskInfos() []metricsinfo.TaskInfo {
+	ret := sched.ddQueue.taskInfos()
+	ret = append(ret, sched.dmQueue.taskInfos()...)
+	return append(ret, sched.dqQueue.taskInfos()...)
+}
+
+// processTask processes an active task of q, and pops it from the active tasks once it's done
 func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
 		opentracing.Tags{
@@ -433,8 +532,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		})
 	defer span.Finish()
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
-	q.AddActiveTask(t)
+	recordStage(t, "queue")
 
 	defer func() {
 		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
@@ -443,6 +541,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
 
 	err := t.PreExecute(ctx)
+	recordStage(t, "preExecute")
 
 	defer func() {
 		t.Notify(err)
@@ -454,6 +553,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
 	err = t.Execute(ctx)
+	recordStage(t, "execute")
 	if err != nil {
 		trace.LogError(span, err)
 		return
@@ -461,6 +561,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
 	err = t.PostExecute(ctx)
+	recordStage(t, "postExecute")
 }
 
 func (sched *taskScheduler) definitionLoop() {
@@ -472,12 +573,36 @@ func (sched *taskScheduler) definitionLoop() {
 		case <-sched.ddQueue.utChan():
 			if !sched.ddQueue.utEmpty() {
 				t := sched.scheduleDdTask()
-				sched.processTask(t, sched.ddQueue)
+				// it's active until it's done, so that it's taken into account by TaskDoneTest while it waits
+				// for the running task of its collection
+				sched.ddQueue.AddActiveTask(t)
+				sched.ddSerializer.submit(ddTaskCollection(t), t, func(t task) {
+					sched.processTask(t, sched.ddQueue)
+				})
 			}
 		}
 	}
 }
 
+// ddTaskCollection returns the collection of a dd task, the tasks without a collection such as ShowCollections
+// are serialized as the ones of the same collection
+func ddTaskCollection(t task) string {
+	if r, ok := t.(collectionRequest); ok {
+		return r.GetCollectionName()
+	}
+	return ""
+}
+
+// acquireSlot blocks until a slot of slots is free, it returns false if the scheduler is closed
+func (sched *taskScheduler) acquireSlot(slots chan struct{}) bool {
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-sched.ctx.Done():
+		return false
+	}
+}
+
 func (sched *taskScheduler) manipulationLoop() {
 	defer sched.wg.Done()
 	for {
@@ -486,8 +611,16 @@ func (sched *taskScheduler) manipulationLoop() {
 			return
 		case <-sched.dmQueue.utChan():
 			if !sched.dmQueue.utEmpty() {
+				// the tasks wait in the queue while the concurrency is reached
+				if !sched.acquireSlot(sched.dmSlots) {
+					return
+				}
 				t := sched.scheduleDmTask()
-				go sched.processTask(t, sched.dmQueue)
+				sched.dmQueue.AddActiveTask(t)
+				go func() {
+					defer func() { <-sched.dmSlots }()
+					sched.processTask(t, sched.dmQueue)
+				}()
 			}
 		}
 	}
@@ -502,15 +635,69 @@ func (sched *taskScheduler) queryLoop() {
 			return
 		case <-sched.dqQueue.utChan():
 			if !sched.dqQueue.utEmpty() {
+				if !sched.acquireSlot(sched.dqSlots) {
+					return
+				}
 				t := sched.scheduleDqTask()
-				go sched.processTask(t, sched.dqQueue)
+				sched.dqQueue.AddActiveTask(t)
+				go func() {
+					defer func() { <-sched.dqSlots }()
+					sched.processTask(t, sched.dqQueue)
+				}()
 			} else {
-				log.Debug("query queue is empty ...")
+				schedulerLog().Debug("query queue is empty ...")
 			}
 		}
 	}
 }
 
+// collectionSerializer runs the tasks of a collection one by one in their submitted order, the tasks of
+// the different collections run concurrently
+type collectionSerializer struct {
+	mu sync.Mutex
+	// pending are the tasks waiting for the running task of their collections, a collection has an entry
+	// while one of its tasks is running
+	pending map[string][]task
+}
+
+func newCollectionSerializer() *collectionSerializer {
+	return &collectionSerializer{
+		pending: make(map[string][]task),
+	}
+}
+
+// submit runs t by run once the submitted tasks of the collection are done
+func (s *collectionSerializer) submit(collection string, t task, run func(t task)) {
+	s.mu.Lock()
+	if waiting, ok := s.pending[collection]; ok {
+		s.pending[collection] = append(waiting, t)
+		s.mu.Unlock()
+		return
+	}
+	s.pending[collection] = nil
+	s.mu.Unlock()
+
+	go func() {
+		for t != nil {
+			run(t)
+			t = s.next(collection)
+		}
+	}()
+}
+
+// next returns the next task of the collection, it's nil if there isn't one
+func (s *collectionSerializer) next(collection string) task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	waiting := s.pending[collection]
+	if len(waiting) == 0 {
+		delete(s.pending, collection)
+		return nil
+	}
+	s.pending[collection] = waiting[1:]
+	return waiting[0]
+}
+
 type resultBufHeader struct {
 	usedVChans                  map[interface{}]struct{} // set of vChan
 	receivedVChansSet           map[interface{}]struct{} // set of vChan
@@ -519,29 +706,11 @@ type resultBufHeader struct {
 	haveError                   bool
 }
 
-type searchResultBuf struct {
-	resultBufHeader
-	resultBuf []*internalpb.SearchResults
-}
-
 type queryResultBuf struct {
 	resultBufHeader
 	resultBuf []*internalpb.RetrieveResults
 }
 
-func newSearchResultBuf() *searchResultBuf {
-	return &searchResultBuf{
-		resultBufHeader: resultBufHeader{
-			usedVChans:                  make(map[interface{}]struct{}),
-			receivedVChansSet:           make(map[interface{}]struct{}),
-			receivedSealedSegmentIDsSet: make(map[interface{}]struct{}),
-			receivedGlobalSegmentIDsSet: make(map[interface{}]struct{}),
-			haveError:                   false,
-		},
-		resultBuf: make([]*internalpb.SearchResults, 0),
-	}
-}
-
 func newQueryResultBuf() *queryResultBuf {
 	return &queryResultBuf{
 		resultBufHeader: resultBufHeader{
@@ -557,7 +726,7 @@ func newQueryResultBuf() *queryResultBuf {
 
 func (sr *resultBufHeader) readyToReduce() bool {
 	if sr.haveError {
-		log.Debug("Proxy searchResultBuf readyToReduce", zap.Any("haveError", true))
+		schedulerLog().Debug("Proxy searchResultBuf readyToReduce", zap.Any("haveError", true))
 		return true
 	}
 
@@ -584,7 +753,7 @@ func (sr *resultBufHeader) readyToReduce() bool {
 	}
 
 	ret1 := funcutil.SetContain(sr.receivedVChansSet, sr.usedVChans)
-	log.Debug("Proxy searchResultBuf readyToReduce", zap.Any("receivedVChansSet", receivedVChansSetStrMap),
+	schedulerLog().Debug("Proxy searchResultBuf readyToReduce", zap.Any("receivedVChansSet", receivedVChansSetStrMap),
 		zap.Any("usedVChans", usedVChansSetStrMap),
 		zap.Any("receivedSealedSegmentIDsSet", sealedSegmentIDsStrMap),
 		zap.Any("receivedGlobalSegmentIDsSet", sealedGlobalSegmentIDsStrMap),
@@ -594,7 +763,7 @@ func (sr *resultBufHeader) readyToReduce() bool {
 		return false
 	}
 	ret := funcutil.SetContain(sr.receivedSealedSegmentIDsSet, sr.receivedGlobalSegmentIDsSet)
-	log.Debug("Proxy searchResultBuf readyToReduce", zap.Any("ret", ret))
+	schedulerLog().Debug("Proxy searchResultBuf readyToReduce", zap.Any("ret", ret))
 	return ret
 }
 
@@ -613,16 +782,6 @@ func (sr *resultBufHeader) addPartialResult(vchans []vChan, searchSegIDs, global
 	}
 }
 
-func (sr *searchResultBuf) addPartialResult(result *internalpb.SearchResults) {
-	sr.resultBuf = append(sr.resultBuf, result)
-	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		sr.haveError = true
-		return
-	}
-	sr.resultBufHeader.addPartialResult(result.ChannelIDsSearched, result.SealedSegmentIDsSearched,
-		result.GlobalSealedSegmentIDs)
-}
-
 func (qr *queryResultBuf) addPartialResult(result *internalpb.RetrieveResults) {
 	qr.resultBuf = append(qr.resultBuf, result)
 	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
@@ -638,14 +797,12 @@ func (sched *taskScheduler) collectResultLoop() {
 
 	queryResultMsgStream, _ := sched.msFactory.NewQueryMsgStream(sched.ctx)
 	queryResultMsgStream.AsConsumer(Params.SearchResultChannelNames, Params.ProxySubName)
-	log.Debug("Proxy", zap.Strings("SearchResultChannelNames", Params.SearchResultChannelNames),
+	schedulerLog().Debug("Proxy", zap.Strings("SearchResultChannelNames", Params.SearchResultChannelNames),
 		zap.Any("ProxySubName", Params.ProxySubName))
 
 	queryResultMsgStream.Start()
 	defer queryResultMsgStream.Close()
 
-	searchResultBufs := make(map[UniqueID]*searchResultBuf)
-	searchResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
 	queryResultBufs := make(map[UniqueID]*queryResultBuf)
 	queryResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
 
@@ -653,7 +810,7 @@ func (sched *taskScheduler) collectResultLoop() {
 		select {
 		case msgPack, ok := <-queryResultMsgStream.Chan():
 			if !ok {
-				log.Debug("Proxy collectResultLoop exit Chan closed")
+				schedulerLog().Debug("Proxy collectResultLoop exit Chan closed")
 				return
 			}
 			if msgPack == nil {
@@ -666,67 +823,24 @@ func (sched *taskScheduler) collectResultLoop() {
 				if searchResultMsg, srOk := tsMsg.(*msgstream.SearchResultMsg); srOk {
 					reqID := searchResultMsg.Base.MsgID
 					reqIDStr := strconv.FormatInt(reqID, 10)
-					ignoreThisResult, ok := searchResultBufFlags[reqID]
-					if !ok {
-						searchResultBufFlags[reqID] = false
-						ignoreThisResult = false
-					}
-					if ignoreThisResult {
-						log.Debug("Proxy collectResultLoop Got a SearchResultMsg, but we should ignore", zap.Any("ReqID", reqID))
-						continue
-					}
 					t := sched.getTaskByReqID(reqID)
-					log.Debug("Proxy collectResultLoop Got a SearchResultMsg", zap.Any("ReqID", reqID))
+					schedulerLog().Debug("Proxy collectResultLoop Got a SearchResultMsg", zap.Any("ReqID", reqID))
 					if t == nil {
-						log.Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						schedulerLog().Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
 						continue
 					}
 
 					st, ok := t.(*searchTask)
 					if !ok {
-						log.Debug("Proxy collectResultLoop type assert t as searchTask failed", zap.Any("ReqID", reqID))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						schedulerLog().Debug("Proxy collectResultLoop type assert t as searchTask failed", zap.Any("ReqID", reqID))
 						continue
 					}
 
-					resultBuf, ok := searchResultBufs[reqID]
-					if !ok {
-						resultBuf = newSearchResultBuf()
-						vchans, err := st.getVChannels()
-						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
-							zap.Error(err))
-						if err != nil {
-							delete(searchResultBufs, reqID)
-							continue
-						}
-						for _, vchan := range vchans {
-							resultBuf.usedVChans[vchan] = struct{}{}
-						}
-						pchans, err := st.getChannels()
-						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
-							zap.Error(err))
-						if err != nil {
-							delete(searchResultBufs, reqID)
-							continue
-						}
-						searchResultBufs[reqID] = resultBuf
-					}
-					resultBuf.addPartialResult(&searchResultMsg.SearchResults)
-
-					//t := sched.getTaskByReqID(reqID)
-					{
-						colName := t.(*searchTask).query.CollectionName
-						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(searchResultBufs[reqID].resultBuf)))
-					}
-
-					if resultBuf.readyToReduce() {
-						log.Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
-						searchResultBufFlags[reqID] = true
-						st.resultBuf <- resultBuf.resultBuf
-						delete(searchResultBufs, reqID)
+					// the shards of the results are tracked by the task, which retries the failed ones
+					select {
+					case st.resultBuf <- &searchResultMsg.SearchResults:
+					case <-st.resultDone:
+						schedulerLog().Debug("Proxy collectResultLoop task is done, drop the SearchResultMsg", zap.Any("ReqID", reqID))
 					}
 
 					sp.Finish()
@@ -736,7 +850,7 @@ func (sched *taskScheduler) collectResultLoop() {
 					//reqIDStr := strconv.FormatInt(reqID, 10)
 					//t := sched.getTaskByReqID(reqID)
 					//if t == nil {
-					//	log.Debug("proxy", zap.String("RetrieveResult GetTaskByReqID failed, reqID = ", reqIDStr))
+					//	schedulerLog().Debug("proxy", zap.String("RetrieveResult GetTaskByReqID failed, reqID = ", reqIDStr))
 					//	delete(queryResultBufs, reqID)
 					//	continue
 					//}
@@ -749,7 +863,7 @@ func (sched *taskScheduler) collectResultLoop() {
 					//
 					//{
 					//	colName := t.(*RetrieveTask).retrieve.CollectionName
-					//	log.Debug("Getcollection", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[reqID])))
+					//	schedulerLog().Debug("Getcollection", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[reqID])))
 					//}
 					//if len(queryResultBufs[reqID]) == queryNodeNum {
 					//	t := sched.getTaskByReqID(reqID)
@@ -771,13 +885,13 @@ func (sched *taskScheduler) collectResultLoop() {
 						ignoreThisResult = false
 					}
 					if ignoreThisResult {
-						log.Debug("Proxy collectResultLoop Got a queryResultMsg, but we should ignore", zap.Any("ReqID", reqID))
+						schedulerLog().Debug("Proxy collectResultLoop Got a queryResultMsg, but we should ignore", zap.Any("ReqID", reqID))
 						continue
 					}
 					t := sched.getTaskByReqID(reqID)
-					log.Debug("Proxy collectResultLoop Got a queryResultMsg", zap.Any("ReqID", reqID))
+					schedulerLog().Debug("Proxy collectResultLoop Got a queryResultMsg", zap.Any("ReqID", reqID))
 					if t == nil {
-						log.Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
+						schedulerLog().Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
 						delete(queryResultBufs, reqID)
 						queryResultBufFlags[reqID] = true
 						continue
@@ -785,7 +899,7 @@ func (sched *taskScheduler) collectResultLoop() {
 
 					st, ok := t.(*queryTask)
 					if !ok {
-						log.Debug("Proxy collectResultLoop type assert t as queryTask failed")
+						schedulerLog().Debug("Proxy collectResultLoop type assert t as queryTask failed")
 						delete(queryResultBufs, reqID)
 						queryResultBufFlags[reqID] = true
 						continue
@@ -795,7 +909,7 @@ func (sched *taskScheduler) collectResultLoop() {
 					if !ok {
 						resultBuf = newQueryResultBuf()
 						vchans, err := st.getVChannels()
-						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
+						schedulerLog().Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
 							zap.Error(err))
 						if err != nil {
 							delete(queryResultBufs, reqID)
@@ -805,7 +919,7 @@ func (sched *taskScheduler) collectResultLoop() {
 							resultBuf.usedVChans[vchan] = struct{}{}
 						}
 						pchans, err := st.getChannels()
-						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
+						schedulerLog().Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
 							zap.Error(err))
 						if err != nil {
 							delete(queryResultBufs, reqID)
@@ -818,11 +932,11 @@ func (sched *taskScheduler) collectResultLoop() {
 					//t := sched.getTaskByReqID(reqID)
 					{
 						colName := t.(*queryTask).query.CollectionName
-						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[reqID].resultBuf)))
+						schedulerLog().Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[reqID].resultBuf)))
 					}
 
 					if resultBuf.readyToReduce() {
-						log.Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
+						schedulerLog().Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
 						queryResultBufFlags[reqID] = true
 						st.resultBuf <- resultBuf.resultBuf
 						delete(queryResultBufs, reqID)
@@ -831,7 +945,7 @@ func (sched *taskScheduler) collectResultLoop() {
 				}
 			}
 		case <-sched.ctx.Done():
-			log.Debug("Proxy collectResultLoop is closed ...")
+			schedulerLog().Debug("Proxy collectResultLoop is closed ...")
 			return
 		}
 	}
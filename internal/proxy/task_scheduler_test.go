@@ -19,8 +19,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
+const testMaxTaskNum = 1024
+
 func TestBaseTaskQueue(t *testing.T) {
 	var err error
 	var unissuedTask task
@@ -29,7 +35,7 @@ func TestBaseTaskQueue(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns, testMaxTaskNum)
 	assert.NotNil(t, queue)
 
 	assert.True(t, queue.utEmpty())
@@ -115,7 +121,28 @@ func TestBaseTaskQueue(t *testing.T) {
 	}
 	assert.True(t, queue.utFull())
 	err = queue.Enqueue(newDefaultMockTask())
-	assert.NotNil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimited, merr.Code(err))
+}
+
+func TestBaseTaskQueue_TaskInfos(t *testing.T) {
+	queue := newBaseTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface(), testMaxTaskNum)
+	queue.name = "testQueue"
+	assert.Equal(t, 0, len(queue.taskInfos()))
+
+	active := newDefaultMockTask()
+	unissued := newDefaultMockTask()
+	assert.NoError(t, queue.Enqueue(active))
+	assert.NoError(t, queue.Enqueue(unissued))
+	queue.AddActiveTask(queue.PopUnissuedTask())
+
+	infos := queue.taskInfos()
+	assert.Equal(t, 2, len(infos))
+	assert.Equal(t, unissued.ID(), infos[0].ID)
+	assert.Equal(t, metricsinfo.UnissuedTaskState, infos[0].State)
+	assert.Equal(t, "testQueue", infos[0].Queue)
+	assert.NotEmpty(t, infos[0].EnqueueTime)
+	assert.Equal(t, active.ID(), infos[1].ID)
+	assert.Equal(t, metricsinfo.ActiveTaskState, infos[1].State)
 }
 
 func TestDdTaskQueue(t *testing.T) {
@@ -126,7 +153,7 @@ func TestDdTaskQueue(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newDdTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newDdTaskQueue(tsoAllocatorIns, idAllocatorIns, testMaxTaskNum)
 	assert.NotNil(t, queue)
 
 	assert.True(t, queue.utEmpty())
@@ -224,7 +251,7 @@ func TestDmTaskQueue_Basic(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newDmTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newDmTaskQueue(tsoAllocatorIns, idAllocatorIns, testMaxTaskNum)
 	assert.NotNil(t, queue)
 
 	assert.True(t, queue.utEmpty())
@@ -320,7 +347,7 @@ func TestDmTaskQueue_TimestampStatistics(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newDmTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newDmTaskQueue(tsoAllocatorIns, idAllocatorIns, testMaxTaskNum)
 	assert.NotNil(t, queue)
 
 	st := newDefaultMockDmlTask()
@@ -360,7 +387,7 @@ func TestDqTaskQueue(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newDqTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newDqTaskQueue(tsoAllocatorIns, idAllocatorIns, testMaxTaskNum)
 	assert.NotNil(t, queue)
 
 	assert.True(t, queue.utEmpty())
@@ -457,7 +484,8 @@ func TestTaskScheduler(t *testing.T) {
 	idAllocatorIns := newMockIDAllocatorInterface()
 	factory := newSimpleMockMsgStreamFactory()
 
-	sched, err := newTaskScheduler(ctx, idAllocatorIns, tsoAllocatorIns, factory)
+	sched, err := newTaskScheduler(ctx, idAllocatorIns, tsoAllocatorIns, factory,
+		&TaskQueueConfig{MaxTaskNum: testMaxTaskNum, MaxDmlConcurrency: 4, MaxDqlConcurrency: 4})
 	assert.NoError(t, err)
 	assert.NotNil(t, sched)
 
@@ -524,3 +552,84 @@ func TestTaskScheduler(t *testing.T) {
 
 	wg.Wait()
 }
+
+type blockingMockTask struct {
+	*mockTask
+	block chan struct{}
+}
+
+func (m *blockingMockTask) Execute(ctx context.Context) error {
+	<-m.block
+	return nil
+}
+
+func TestTaskScheduler_Concurrency(t *testing.T) {
+	sched, err := newTaskScheduler(context.Background(), newMockIDAllocatorInterface(), newMockTsoAllocator(),
+		newSimpleMockMsgStreamFactory(), &TaskQueueConfig{MaxTaskNum: testMaxTaskNum, MaxDmlConcurrency: 1, MaxDqlConcurrency: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, sched.Start())
+	defer sched.Close()
+
+	first := &blockingMockTask{mockTask: newDefaultMockTask(), block: make(chan struct{})}
+	second := &blockingMockTask{mockTask: newDefaultMockTask(), block: make(chan struct{})}
+	close(second.block)
+	assert.NoError(t, sched.dqQueue.Enqueue(first))
+	assert.NoError(t, sched.dqQueue.Enqueue(second))
+
+	// the second one waits in the queue while the first one is running
+	assert.Eventually(t, func() bool {
+		return sched.dqQueue.getTaskByReqID(first.ID()) != nil && !sched.dqQueue.utEmpty()
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, sched.dqQueue.utEmpty())
+
+	close(first.block)
+	assert.NoError(t, first.WaitToFinish())
+	assert.NoError(t, second.WaitToFinish())
+}
+
+func TestCollectionSerializer(t *testing.T) {
+	s := newCollectionSerializer()
+	submitted := []struct {
+		collection string
+		name       string
+	}{{"c1", "c1-1"}, {"c1", "c1-2"}, {"c1", "c1-3"}, {"c2", "c2-1"}}
+	names := make(map[task]string)
+	tasks := make([]task, 0, len(submitted))
+	for _, sub := range submitted {
+		task := newDefaultMockTask()
+		names[task] = sub.name
+		tasks = append(tasks, task)
+	}
+
+	var mu sync.Mutex
+	var order []string
+	block := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(len(tasks))
+	run := func(t task) {
+		defer wg.Done()
+		// c1-1 is blocked until c2-1 is done
+		if names[t] == "c1-1" {
+			<-block
+		}
+		mu.Lock()
+		order = append(order, names[t])
+		mu.Unlock()
+		if names[t] == "c2-1" {
+			close(block)
+		}
+	}
+	for i, sub := range submitted {
+		s.submit(sub.collection, tasks[i], run)
+	}
+	wg.Wait()
+
+	// the tasks of c1 keep their order
+	assert.Equal(t, []string{"c2-1", "c1-1", "c1-2", "c1-3"}, order)
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.pending) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -3,6 +3,8 @@ package proxy
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -507,6 +509,96 @@ func TestTranslateOutputFields(t *testing.T) {
 	outputFields, err = translateOutputFields([]string{"%", idFieldName}, schema, true)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
+
+	//=========================================================================
+	// the requested order is kept
+	outputFields, err = translateOutputFields([]string{tsFieldName}, schema, true)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tsFieldName, idFieldName}, outputFields)
+
+	outputFields, err = translateOutputFields([]string{binaryVectorFieldName, "*", tsFieldName}, schema, false)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{binaryVectorFieldName, idFieldName, tsFieldName}, outputFields)
+}
+
+func TestSortOutputFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestSortOutputFields",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	order := Params.OutputFieldOrder
+	defer func() {
+		Params.OutputFieldOrder = order
+	}()
+
+	Params.OutputFieldOrder = OutputFieldOrderRequest
+	assert.Equal(t, []string{"float_vector", "id"}, sortOutputFields([]string{"float_vector", "id"}, schema))
+
+	Params.OutputFieldOrder = OutputFieldOrderSchema
+	fieldNames := []string{"not_exist", "float_vector", "id"}
+	assert.Equal(t, []string{"id", "float_vector", "not_exist"}, sortOutputFields(fieldNames, schema))
+	assert.Equal(t, []string{"not_exist", "float_vector", "id"}, fieldNames)
+
+	fieldIDs, err := getOutputFieldIDs([]string{"float_vector", "id"}, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{102, 100}, fieldIDs)
+
+	_, err = getOutputFieldIDs(fieldNames, schema)
+	assert.Error(t, err)
+}
+
+func TestLoadState(t *testing.T) {
+	assert.Equal(t, milvuspb.LoadState_NotLoaded, loadState(false, 0))
+	assert.Equal(t, milvuspb.LoadState_Loading, loadState(true, 0))
+	assert.Equal(t, milvuspb.LoadState_Loading, loadState(true, 60))
+	assert.Equal(t, milvuspb.LoadState_Loaded, loadState(true, 100))
+}
+
+func TestCollectionStorageStats(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	dataStats := &datapb.CollectionStatistics{
+		RowCount:        30,
+		GrowingRowCount: 10,
+		SealedRowCount:  20,
+		BinlogSize:      1000,
+		StatslogSize:    10,
+		DeltalogSize:    5,
+		Fields: []*datapb.FieldStatistics{
+			{FieldID: 0, BinlogSize: 200},
+			{FieldID: 100, BinlogSize: 100, EstimatedSize: 240},
+			{FieldID: 101, BinlogSize: 700, EstimatedSize: 960},
+		},
+	}
+	indexStats := []*indexpb.IndexStatistics{
+		{IndexID: 1, FieldID: 101, FinishedCount: 2, SerializedSize: 800},
+		// the index built before the field ids are recorded
+		{IndexID: 2, FieldID: 0, FinishedCount: 1, SerializedSize: 50},
+	}
+
+	storage := collectionStorageStats(dataStats, indexStats, schema)
+	assert.EqualValues(t, 10, storage.GrowingRowCount)
+	assert.EqualValues(t, 20, storage.SealedRowCount)
+	assert.EqualValues(t, 1000, storage.BinlogSize)
+	assert.EqualValues(t, 10, storage.StatslogSize)
+	assert.EqualValues(t, 5, storage.DeltalogSize)
+	assert.EqualValues(t, 850, storage.IndexSize)
+	assert.Equal(t, []*milvuspb.FieldStorageStats{
+		{FieldID: 100, FieldName: "pk", BinlogSize: 100, EstimatedSize: 240},
+		{FieldID: 101, FieldName: "vec", BinlogSize: 700, EstimatedSize: 960, IndexSize: 800},
+	}, storage.Fields)
+
+	storage = collectionStorageStats(&datapb.CollectionStatistics{}, nil, schema)
+	assert.Equal(t, 2, len(storage.Fields))
+	assert.EqualValues(t, 0, storage.IndexSize)
 }
 
 func TestCreateCollectionTask(t *testing.T) {
@@ -14,28 +14,86 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 )
 
+// maxTimestampBatchSize is half of the logical timestamps of a physical millisecond of the rootcoord tso
+const maxTimestampBatchSize = 1 << 17
+
+// TimestampAllocator allocates the timestamps from rootcoord, it serves them from a batch in memory if
+// the batch size is set larger than 1, so that not every request waits for an AllocTimestamp rpc
 type TimestampAllocator struct {
 	ctx    context.Context
 	tso    timestampAllocatorInterface
 	peerID UniqueID
+
+	// the batch is discarded syncInterval after it is fetched, so that a timestamp
+	// lags the physical time of rootcoord by at most syncInterval
+	batchSize    uint32
+	syncInterval time.Duration
+
+	mu        sync.Mutex // guards the batch
+	next      Timestamp
+	end       Timestamp
+	fetchTime time.Time
 }
 
 func NewTimestampAllocator(ctx context.Context, tso timestampAllocatorInterface, peerID UniqueID) (*TimestampAllocator, error) {
 	a := &TimestampAllocator{
-		ctx:    ctx,
-		peerID: peerID,
-		tso:    tso,
+		ctx:       ctx,
+		peerID:    peerID,
+		tso:       tso,
+		batchSize: 1,
 	}
 	return a, nil
 }
 
+// SetBatch makes the allocator fetch batchSize timestamps per rpc and renew the batch every syncInterval,
+// it should be called before the allocator is used
+func (ta *TimestampAllocator) SetBatch(batchSize uint32, syncInterval time.Duration) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	ta.batchSize = batchSize
+	ta.syncInterval = syncInterval
+}
+
 func (ta *TimestampAllocator) Alloc(count uint32) ([]Timestamp, error) {
+	if ta.batchSize <= 1 {
+		start, cnt, err := ta.syncTimestamp(count)
+		if err != nil {
+			return nil, err
+		}
+		return makeTimestamps(start, cnt), nil
+	}
+
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	now := time.Now()
+	if uint64(count) > ta.end-ta.next || now.Sub(ta.fetchTime) >= ta.syncInterval {
+		need := count
+		if need < ta.batchSize {
+			need = ta.batchSize
+		}
+		start, cnt, err := ta.syncTimestamp(need)
+		if err != nil {
+			return nil, err
+		}
+		if cnt < count {
+			return nil, fmt.Errorf("syncTimestamp Failed: %d timestamps are allocated, %d are needed", cnt, count)
+		}
+		ta.next, ta.end, ta.fetchTime = start, start+uint64(cnt), now
+	}
+	ret := makeTimestamps(ta.next, count)
+	ta.next += uint64(count)
+	return ret, nil
+}
+
+func (ta *TimestampAllocator) syncTimestamp(count uint32) (Timestamp, uint32, error) {
 	ctx, cancel := context.WithTimeout(ta.ctx, 5*time.Second)
 	req := &rootcoordpb.AllocTimestampRequest{
 		Base: &commonpb.MsgBase{
@@ -51,18 +109,20 @@ func (ta *TimestampAllocator) Alloc(count uint32) ([]Timestamp, error) {
 	defer cancel()
 
 	if err != nil {
-		return nil, fmt.Errorf("syncTimestamp Failed:%w", err)
+		return 0, 0, fmt.Errorf("syncTimestamp Failed:%w", err)
 	}
 	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, fmt.Errorf("syncTimeStamp Failed:%s", resp.Status.Reason)
+		return 0, 0, fmt.Errorf("syncTimeStamp Failed:%s", resp.Status.Reason)
 	}
-	start, cnt := resp.Timestamp, resp.Count
+	return resp.Timestamp, resp.Count, nil
+}
+
+func makeTimestamps(start Timestamp, count uint32) []Timestamp {
 	var ret []Timestamp
-	for i := uint32(0); i < cnt; i++ {
+	for i := uint32(0); i < count; i++ {
 		ret = append(ret, start+uint64(i))
 	}
-
-	return ret, nil
+	return ret
 }
 
 func (ta *TimestampAllocator) AllocOne() (Timestamp, error) {
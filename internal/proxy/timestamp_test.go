@@ -15,7 +15,9 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/stretchr/testify/assert"
@@ -58,3 +60,53 @@ func TestTimestampAllocator_AllocOne(t *testing.T) {
 	_, err = tsAllocator.AllocOne()
 	assert.Nil(t, err)
 }
+
+type countingTimestampAllocator struct {
+	timestampAllocatorInterface
+	calls int
+}
+
+func (c *countingTimestampAllocator) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
+	c.calls++
+	return c.timestampAllocatorInterface.AllocTimestamp(ctx, req)
+}
+
+func TestTimestampAllocator_Batch(t *testing.T) {
+	ctx := context.Background()
+	tso := &countingTimestampAllocator{timestampAllocatorInterface: newMockTimestampAllocatorInterface()}
+	peerID := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+
+	tsAllocator, err := NewTimestampAllocator(ctx, tso, peerID)
+	assert.Nil(t, err)
+	tsAllocator.SetBatch(100, time.Hour)
+
+	var last Timestamp
+	for i := 0; i < 100; i++ {
+		ts, err := tsAllocator.AllocOne()
+		assert.Nil(t, err)
+		assert.Greater(t, ts, last)
+		last = ts
+	}
+	assert.Equal(t, 1, tso.calls)
+
+	// the batch is used up
+	ret, err := tsAllocator.Alloc(10)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(ret))
+	assert.Greater(t, ret[0], last)
+	assert.Equal(t, 2, tso.calls)
+
+	// larger than the batch size
+	ret, err = tsAllocator.Alloc(200)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, len(ret))
+	assert.Equal(t, 3, tso.calls)
+
+	// the batch is renewed after the sync interval
+	tsAllocator.SetBatch(100, 0)
+	_, err = tsAllocator.AllocOne()
+	assert.Nil(t, err)
+	_, err = tsAllocator.AllocOne()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, tso.calls)
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/internal/util/udf"
+)
+
+// handlePredicates returns the expr of the conditions evaluated by the segments,
+// and the calls of the user-defined functions combined with them by and, which are evaluated by the query nodes
+func (context *ParserContext) handlePredicates(nodeRaw *ant_ast.Node) (*planpb.Expr, []*planpb.UDFExpr, error) {
+	switch node := (*nodeRaw).(type) {
+	case *ant_ast.FunctionNode:
+		call, err := context.handleUDFCall(node)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, []*planpb.UDFExpr{call}, nil
+	case *ant_ast.BinaryNode:
+		if getLogicalOpType(node.Operator) != planpb.BinaryExpr_LogicalAnd {
+			break
+		}
+		leftExpr, leftCalls, err := context.handlePredicates(&node.Left)
+		if err != nil {
+			return nil, nil, err
+		}
+		rightExpr, rightCalls, err := context.handlePredicates(&node.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		calls := append(leftCalls, rightCalls...)
+		if leftExpr == nil {
+			return rightExpr, calls, nil
+		}
+		if rightExpr == nil {
+			return leftExpr, calls, nil
+		}
+		expr := &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  leftExpr,
+					Right: rightExpr,
+				},
+			},
+		}
+		return expr, calls, nil
+	}
+
+	expr, err := context.handleExpr(nodeRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return expr, nil, nil
+}
+
+// handleUDFCall checks the arguments of the call against the registered function
+func (context *ParserContext) handleUDFCall(node *ant_ast.FunctionNode) (*planpb.UDFExpr, error) {
+	fn, ok := udf.Get(node.Name)
+	if !ok {
+		return nil, fmt.Errorf("function %s is not registered", node.Name)
+	}
+	if len(node.Arguments) != len(fn.Args) {
+		return nil, fmt.Errorf("function %s expects %d arguments, got %d", fn.Name, len(fn.Args), len(node.Arguments))
+	}
+
+	call := &planpb.UDFExpr{
+		FunctionName: fn.Name,
+		Args:         make([]*planpb.UDFArg, 0, len(fn.Args)),
+	}
+	for i, argType := range fn.Args {
+		argNode := &node.Arguments[i]
+		if idNode, ok := (*argNode).(*ant_ast.IdentifierNode); ok {
+			if _, placeholder := isPlaceholder(idNode); !placeholder {
+				field, err := context.handleIdentifier(idNode)
+				if err != nil {
+					return nil, err
+				}
+				if argType == udf.FloatArray ||
+					(argType == udf.Int && !typeutil.IsIntegerType(field.DataType)) ||
+					(argType == udf.Float && !typeutil.IsIntegerType(field.DataType) && !typeutil.IsFloatingType(field.DataType)) {
+					return nil, fmt.Errorf("argument %d of function %s expects %s, got field %s of %s",
+						i, fn.Name, argType.String(), field.Name, field.DataType.String())
+				}
+				call.Args = append(call.Args, &planpb.UDFArg{ColumnInfo: context.createColumnInfo(field)})
+				continue
+			}
+		}
+
+		var values []*planpb.GenericValue
+		switch argType {
+		case udf.Int, udf.Float:
+			dataType := schemapb.DataType_Int64
+			if argType == udf.Float {
+				dataType = schemapb.DataType_Double
+			}
+			value, err := context.handleLeafValue(argNode, dataType)
+			// the parser takes a bool literal as a floating value
+			if _, ok := value.GetVal().(*planpb.GenericValue_BoolVal); ok {
+				err = fmt.Errorf("type mismatch")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("argument %d of function %s expects %s: %w", i, fn.Name, argType.String(), err)
+			}
+			values = []*planpb.GenericValue{value}
+		case udf.FloatArray:
+			array, err := context.handleArrayExpr(argNode, schemapb.DataType_Double)
+			if err != nil {
+				return nil, fmt.Errorf("argument %d of function %s expects %s: %w", i, fn.Name, argType.String(), err)
+			}
+			values = array
+		}
+		call.Args = append(call.Args, &planpb.UDFArg{Values: values})
+	}
+	return call, nil
+}
+
+// createTruePredicate returns the predicate matching all the entities,
+// for the queries only filtered by the user-defined functions
+func createTruePredicate(schema *typeutil.SchemaHelper) (*planpb.Expr, error) {
+	pkField, err := schema.GetPrimaryKeyField()
+	if err != nil {
+		return nil, err
+	}
+	context := ParserContext{schema: schema}
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: context.createColumnInfo(pkField),
+				Op:         planpb.OpType_GreaterEqual,
+				Value: &planpb.GenericValue{
+					Val: &planpb.GenericValue_Int64Val{Int64Val: math.MinInt64},
+				},
+			},
+		},
+	}
+	return expr, nil
+}
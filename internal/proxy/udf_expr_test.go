@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestCreateExprQueryPlan_UDF(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 101, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 102, Name: "lat", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "lon", DataType: schemapb.DataType_Float},
+		},
+	}
+
+	plan, err := CreateExprQueryPlan(schema, "id > 10 && geo_within(lat, lon, [0, 0, 0, 10, 10.5, 10]) && id < 20", nil)
+	assert.Nil(t, err)
+	rangeExpr := plan.GetPredicates().GetBinaryExpr()
+	assert.NotNil(t, rangeExpr)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, rangeExpr.Op)
+	assert.Equal(t, 1, len(plan.UdfPredicates))
+	call := plan.UdfPredicates[0]
+	assert.Equal(t, "geo_within", call.FunctionName)
+	assert.Equal(t, 3, len(call.Args))
+	assert.Equal(t, int64(102), call.Args[0].ColumnInfo.FieldId)
+	assert.Equal(t, int64(103), call.Args[1].ColumnInfo.FieldId)
+	assert.Nil(t, call.Args[2].ColumnInfo)
+	assert.Equal(t, 6, len(call.Args[2].Values))
+	assert.Equal(t, 10.5, call.Args[2].Values[4].GetFloatVal())
+
+	// all the entities are filtered by the functions
+	plan, err = CreateExprQueryPlan(schema, "geo_within(lat, 1, {polygon})", []*schemapb.FieldData{
+		doubleTemplateValue("polygon", 0, 0, 0, 10, 10, 10),
+	})
+	assert.Nil(t, err)
+	unaryExpr := plan.GetPredicates().GetUnaryRangeExpr()
+	assert.NotNil(t, unaryExpr)
+	assert.Equal(t, int64(101), unaryExpr.ColumnInfo.FieldId)
+	assert.Equal(t, int64(math.MinInt64), unaryExpr.Value.GetInt64Val())
+	assert.Equal(t, 1, len(plan.UdfPredicates))
+	assert.Equal(t, float64(1), plan.UdfPredicates[0].Args[1].Values[0].GetFloatVal())
+	assert.Equal(t, 6, len(plan.UdfPredicates[0].Args[2].Values))
+
+	invalidExprs := []string{
+		"not_registered(lat)",
+		"geo_within(lat, lon)",
+		"geo_within(lat, lon, lat)",
+		"geo_within(lat, unknown, [0, 0, 0, 10, 10, 10])",
+		"geo_within(fakevec, lon, [0, 0, 0, 10, 10, 10])",
+		"geo_within(lat, true, [0, 0, 0, 10, 10, 10])",
+		"geo_within(lat, lon, 1)",
+		"id > 10 || geo_within(lat, lon, [0, 0, 0, 10, 10, 10])",
+		"not geo_within(lat, lon, [0, 0, 0, 10, 10, 10])",
+	}
+	for _, expr := range invalidExprs {
+		_, err = CreateExprQueryPlan(schema, expr, nil)
+		assert.Error(t, err, expr)
+	}
+
+	// the functions are not evaluated by the searches
+	_, err = CreateQueryPlan(schema, "geo_within(lat, lon, [0, 0, 0, 10, 10, 10])", "fakevec", &planpb.QueryInfo{Topk: 10}, nil)
+	assert.Error(t, err)
+}
@@ -132,13 +132,7 @@ func ValidateFieldName(fieldName string) error {
 }
 
 func ValidateDimension(dim int64, isBinary bool) error {
-	if dim <= 0 || dim > Params.MaxDimension {
-		return fmt.Errorf("invalid dimension: %d. should be in range 1 ~ %d", dim, Params.MaxDimension)
-	}
-	if isBinary && dim%8 != 0 {
-		return fmt.Errorf("invalid dimension: %d. should be multiple of 8. ", dim)
-	}
-	return nil
+	return Params.Limits.CheckDimension(dim, isBinary)
 }
 
 func ValidateVectorFieldMetricType(field *schemapb.FieldSchema) error {
@@ -235,7 +229,7 @@ func ValidateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) err
 		if dataType == schemapb.DataType_FloatVector {
 			return nil
 		}
-	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUBPERSTURCTURE":
+	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUPERSTRUCTURE":
 		if dataType == schemapb.DataType_BinaryVector {
 			return nil
 		}
@@ -95,13 +95,13 @@ func TestValidateFieldName(t *testing.T) {
 
 func TestValidateDimension(t *testing.T) {
 	assert.Nil(t, ValidateDimension(1, false))
-	assert.Nil(t, ValidateDimension(Params.MaxDimension, false))
+	assert.Nil(t, ValidateDimension(Params.Limits.MaxDimension, false))
 	assert.Nil(t, ValidateDimension(8, true))
-	assert.Nil(t, ValidateDimension(Params.MaxDimension, true))
+	assert.Nil(t, ValidateDimension(Params.Limits.MaxDimension, true))
 
 	// invalid dim
 	assert.NotNil(t, ValidateDimension(-1, false))
-	assert.NotNil(t, ValidateDimension(Params.MaxDimension+1, false))
+	assert.NotNil(t, ValidateDimension(Params.Limits.MaxDimension+1, false))
 	assert.NotNil(t, ValidateDimension(9, true))
 }
 
@@ -131,6 +131,18 @@ func TestValidateVectorFieldMetricType(t *testing.T) {
 	assert.Nil(t, ValidateVectorFieldMetricType(field1))
 }
 
+func TestValidateMetricType(t *testing.T) {
+	for _, metric := range []string{"L2", "ip"} {
+		assert.Nil(t, ValidateMetricType(schemapb.DataType_FloatVector, metric))
+		assert.NotNil(t, ValidateMetricType(schemapb.DataType_BinaryVector, metric))
+	}
+	for _, metric := range []string{"JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "superstructure"} {
+		assert.Nil(t, ValidateMetricType(schemapb.DataType_BinaryVector, metric))
+		assert.NotNil(t, ValidateMetricType(schemapb.DataType_FloatVector, metric))
+	}
+	assert.NotNil(t, ValidateMetricType(schemapb.DataType_BinaryVector, "SUBPERSTURCTURE"))
+}
+
 func TestValidateDuplicatedFieldName(t *testing.T) {
 	fields := []*schemapb.FieldSchema{
 		{Name: "abc"},
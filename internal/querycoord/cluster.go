@@ -19,21 +19,33 @@ import (
 	"path/filepath"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/decisionlog"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
 const (
 	queryNodeMetaPrefix = "queryCoord-queryNodeMeta"
 	queryNodeInfoPrefix = "queryCoord-queryNodeInfo"
+
+	// the types of the decisions recorded in the decision log
+	decisionTypeBalance = "balance"
+	decisionTypeAssign  = "assign"
+	// the corrections made by the distribution observer
+	decisionTypeReconcile = "reconcile"
+
+	warmupTimeout = 60 * time.Second
 )
 
 type Cluster interface {
@@ -42,6 +54,7 @@ type Cluster interface {
 
 	loadSegments(ctx context.Context, nodeID int64, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, nodeID int64, in *querypb.ReleaseSegmentsRequest) error
+	refreshIndex(ctx context.Context, nodeID int64, in *querypb.RefreshIndexRequest) error
 	getNumSegments(nodeID int64) (int, error)
 
 	watchDmChannels(ctx context.Context, nodeID int64, in *querypb.WatchDmChannelsRequest) error
@@ -74,6 +87,9 @@ type queryNodeCluster struct {
 	clusterMeta Meta
 	nodes       map[int64]Node
 	newNodeFn   newQueryNodeFn
+
+	// decisionLog records the balance and assignment decisions, queried by GetMetrics
+	decisionLog *decisionlog.Log
 }
 
 func newQueryNodeCluster(clusterMeta Meta, kv *etcdkv.EtcdKV, newNodeFn newQueryNodeFn) (*queryNodeCluster, error) {
@@ -83,6 +99,7 @@ func newQueryNodeCluster(clusterMeta Meta, kv *etcdkv.EtcdKV, newNodeFn newQuery
 		clusterMeta: clusterMeta,
 		nodes:       nodes,
 		newNodeFn:   newNodeFn,
+		decisionLog: decisionlog.NewLog(Params.DecisionLogCapacity),
 	}
 	err := c.reloadFromKV()
 	if err != nil {
@@ -194,11 +211,74 @@ func (c *queryNodeCluster) loadSegments(ctx context.Context, nodeID int64, in *q
 			log.Debug("LoadSegments: queryNode load segments error", zap.Int64("nodeID", nodeID), zap.String("error info", err.Error()))
 			return err
 		}
+		collectionIDs := make(map[UniqueID]struct{})
+		for _, info := range in.Infos {
+			collectionIDs[info.CollectionID] = struct{}{}
+		}
+		for collectionID := range collectionIDs {
+			if queries := c.clusterMeta.getWarmupQueries(collectionID); len(queries) > 0 {
+				go warmup(node, nodeID, collectionID, queries)
+			}
+		}
 		return nil
 	}
 	return errors.New("LoadSegments: Can't find query node by nodeID ")
 }
 
+// warmup runs the warm-up queries of the collection on the query node which just loaded segments
+func warmup(node Node, nodeID int64, collectionID UniqueID, queries []*metricsinfo.WarmupQuery) {
+	ctx, cancel := context.WithTimeout(context.Background(), warmupTimeout)
+	defer cancel()
+
+	req, err := metricsinfo.ConstructWarmupRequest(collectionID, queries)
+	if err != nil {
+		log.Warn("warmup: failed to construct request", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	resp, err := node.getMetrics(ctx, req)
+	if err == nil && resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(resp.Status.Reason)
+	}
+	if err != nil {
+		log.Warn("warmup: query node failed to run warmup queries",
+			zap.Int64("nodeID", nodeID),
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+		return
+	}
+	log.Debug("warmup: query node warmed up", zap.Int64("nodeID", nodeID), zap.String("result", resp.Response))
+}
+
+// warmupSegment touches the pages of the data and indexes of a sealed segment on the query node holding it
+func (c *queryNodeCluster) warmupSegment(ctx context.Context, nodeID int64, collectionID UniqueID, segmentID UniqueID) error {
+	node, err := c.getNodeByID(nodeID)
+	if err != nil {
+		return err
+	}
+	req, err := metricsinfo.ConstructWarmupSegmentsRequest(collectionID, []UniqueID{segmentID})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, warmupTimeout)
+	defer cancel()
+	resp, err := node.getMetrics(ctx, req)
+	if err == nil && resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(resp.Status.Reason)
+	}
+	if err != nil {
+		return err
+	}
+	result := &metricsinfo.WarmupSegmentsResult{}
+	if err := json.Unmarshal([]byte(resp.Response), result); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return errors.New(result.Reason)
+	}
+	return nil
+}
+
 func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in *querypb.ReleaseSegmentsRequest) error {
 	c.Lock()
 	defer c.Unlock()
@@ -214,7 +294,11 @@ func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in
 			return err
 		}
 
+		// the segments held by the other nodes in the meta keep their infos, the released ones are the stale copies
 		for _, segmentID := range in.SegmentIDs {
+			if info, err := c.clusterMeta.getSegmentInfoByID(segmentID); err == nil && info.NodeID != nodeID {
+				continue
+			}
 			c.clusterMeta.deleteSegmentInfoByID(segmentID)
 		}
 		return nil
@@ -223,6 +307,22 @@ func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in
 	return errors.New("ReleaseSegments: Can't find query node by nodeID ")
 }
 
+func (c *queryNodeCluster) refreshIndex(ctx context.Context, nodeID int64, in *querypb.RefreshIndexRequest) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		err := node.refreshIndex(ctx, in)
+		if err != nil {
+			log.Debug("RefreshIndex: queryNode refresh index error", zap.Int64("nodeID", nodeID), zap.String("error info", err.Error()))
+			return err
+		}
+		return nil
+	}
+
+	return errors.New("RefreshIndex: Can't find query node by nodeID ")
+}
+
 func (c *queryNodeCluster) watchDmChannels(ctx context.Context, nodeID int64, in *querypb.WatchDmChannelsRequest) error {
 	c.Lock()
 	defer c.Unlock()
@@ -496,6 +596,63 @@ func (c *queryNodeCluster) stopNode(nodeID int64) {
 	}
 }
 
+// getNodeLoads returns the num of segments and dm channels on each query node in service,
+// they are the inputs of the assignment decisions
+func (c *queryNodeCluster) getNodeLoads() map[int64]map[string]int {
+	ret := make(map[int64]map[string]int)
+	nodes, err := c.onServiceNodes()
+	if err != nil {
+		return ret
+	}
+	for nodeID := range nodes {
+		numSegments, _ := c.getNumSegments(nodeID)
+		numChannels, _ := c.getNumDmChannels(nodeID)
+		ret[nodeID] = map[string]int{
+			"segments":    numSegments,
+			"dm_channels": numChannels,
+		}
+	}
+	return ret
+}
+
+// getMemoryStates returns the memory states reported by the query nodes, the nodes failing to report are absent
+func getMemoryStates(ctx context.Context, nodes map[int64]Node) map[int64]string {
+	states := make(map[int64]string, len(nodes))
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return states
+	}
+	for nodeID, node := range nodes {
+		resp, err := node.getMetrics(ctx, req)
+		if err != nil || resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			log.Warn("failed to get the memory state of query node", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		infos := metricsinfo.QueryNodeInfos{}
+		if err := metricsinfo.UnmarshalComponentInfos(resp.Response, &infos); err != nil {
+			continue
+		}
+		states[nodeID] = infos.MemoryInfos.State
+	}
+	return states
+}
+
+// excludeEvictableNodes returns the nodes accepting new segments by their memory states, the nodes of unknown
+// states are kept. All the nodes are returned if none accepts, they reject the loads running out of memory
+func excludeEvictableNodes(nodes map[int64]Node, states map[int64]string) map[int64]Node {
+	ret := make(map[int64]Node, len(nodes))
+	for nodeID, node := range nodes {
+		if state, ok := states[nodeID]; ok && state != "" && state != metricsinfo.MemoryStateNormal {
+			continue
+		}
+		ret[nodeID] = node
+	}
+	if len(ret) == 0 {
+		return nodes
+	}
+	return ret
+}
+
 func (c *queryNodeCluster) onServiceNodes() (map[int64]Node, error) {
 	c.RLock()
 	defer c.RUnlock()
@@ -23,6 +23,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
@@ -66,3 +67,27 @@ func TestReloadClusterFromKV(t *testing.T) {
 	collection := cluster.getCollectionInfosByID(context.Background(), 100)
 	assert.Equal(t, defaultCollectionID, collection[0].CollectionID)
 }
+
+func TestExcludeEvictableNodes(t *testing.T) {
+	nodes := map[int64]Node{
+		1: &queryNode{id: 1},
+		2: &queryNode{id: 2},
+		3: &queryNode{id: 3},
+	}
+	states := map[int64]string{
+		1: metricsinfo.MemoryStateNormal,
+		2: metricsinfo.MemoryStateEvictable,
+	}
+	ret := excludeEvictableNodes(nodes, states)
+	assert.Equal(t, 2, len(ret))
+	assert.Contains(t, ret, int64(1))
+	assert.Contains(t, ret, int64(3))
+
+	// all the nodes are kept if none accepts new segments
+	states = map[int64]string{
+		1: metricsinfo.MemoryStateOverloaded,
+		2: metricsinfo.MemoryStateEvictable,
+		3: metricsinfo.MemoryStateEvictable,
+	}
+	assert.Equal(t, nodes, excludeEvictableNodes(nodes, states))
+}
@@ -0,0 +1,394 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+type distributionFixType int
+
+const (
+	// reloads a sealed segment on the query node holding it in the meta
+	fixReloadSegment distributionFixType = iota
+	// releases the copy of a segment held by another query node in the meta
+	fixReleaseSegment
+	// releases a collection which is not loaded
+	fixReleaseCollection
+)
+
+// distributionFix is a correction of the distribution reported by a query node
+type distributionFix struct {
+	fixType      distributionFixType
+	nodeID       int64
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+}
+
+// diffDistribution returns the fixes turning the distribution reported by the query nodes into the one of the meta.
+// A sealed segment in the meta which is not reported by its query node is reloaded on it, and the copy of a segment
+// on a query node other than the one in the meta is released, so are the collections not loaded. The segments still
+// being loaded are not sealed yet, and the query nodes without reports are skipped, their segments are moved by the
+// load balance tasks once they're down
+func diffDistribution(collections []*querypb.CollectionInfo, segments []*querypb.SegmentInfo,
+	reports []*metricsinfo.NodeCollectionRuntimeStats) []distributionFix {
+	loaded := make(map[UniqueID]struct{}, len(collections))
+	for _, info := range collections {
+		loaded[info.CollectionID] = struct{}{}
+	}
+	segmentInfos := make(map[UniqueID]*querypb.SegmentInfo, len(segments))
+	for _, info := range segments {
+		segmentInfos[info.SegmentID] = info
+	}
+
+	// the sealed segments reported by each query node
+	reported := make(map[int64]map[UniqueID]struct{}, len(reports))
+	fixes := make([]distributionFix, 0)
+	for _, report := range reports {
+		nodeSegments := make(map[UniqueID]struct{})
+		reported[report.NodeID] = nodeSegments
+		for _, stats := range report.Collections {
+			if _, ok := loaded[stats.CollectionID]; !ok {
+				fixes = append(fixes, distributionFix{
+					fixType:      fixReleaseCollection,
+					nodeID:       report.NodeID,
+					collectionID: stats.CollectionID,
+				})
+				continue
+			}
+			for _, segmentID := range stats.SealedSegmentIDs {
+				nodeSegments[segmentID] = struct{}{}
+				info, ok := segmentInfos[segmentID]
+				if !ok || info.NodeID == report.NodeID || info.SegmentState != querypb.SegmentState_sealed {
+					continue
+				}
+				fixes = append(fixes, distributionFix{
+					fixType:      fixReleaseSegment,
+					nodeID:       report.NodeID,
+					collectionID: stats.CollectionID,
+					partitionID:  info.PartitionID,
+					segmentID:    segmentID,
+				})
+			}
+		}
+	}
+
+	for _, info := range segments {
+		if _, ok := loaded[info.CollectionID]; !ok || info.SegmentState != querypb.SegmentState_sealed {
+			continue
+		}
+		nodeSegments, ok := reported[info.NodeID]
+		if !ok {
+			continue
+		}
+		if _, ok := nodeSegments[info.SegmentID]; !ok {
+			fixes = append(fixes, distributionFix{
+				fixType:      fixReloadSegment,
+				nodeID:       info.NodeID,
+				collectionID: info.CollectionID,
+				partitionID:  info.PartitionID,
+				segmentID:    info.SegmentID,
+			})
+		}
+	}
+
+	sort.Slice(fixes, func(i, j int) bool {
+		if fixes[i].fixType != fixes[j].fixType {
+			return fixes[i].fixType < fixes[j].fixType
+		}
+		if fixes[i].nodeID != fixes[j].nodeID {
+			return fixes[i].nodeID < fixes[j].nodeID
+		}
+		if fixes[i].collectionID != fixes[j].collectionID {
+			return fixes[i].collectionID < fixes[j].collectionID
+		}
+		return fixes[i].segmentID < fixes[j].segmentID
+	})
+	return fixes
+}
+
+// distributionObserver compares the loaded collections and segments in the meta with the ones reported by the query
+// nodes periodically, and enqueues the trigger tasks applying the fixes. A fix is applied only if it's observed in two
+// rounds in a row, the differences made by the running tasks are gone by then
+type distributionObserver struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	interval  time.Duration
+	meta      Meta
+	cluster   *queryNodeCluster
+	scheduler *TaskScheduler
+	dataCoord types.DataCoord
+
+	// the fixes observed in the last round
+	lastFixes map[distributionFix]struct{}
+}
+
+func newDistributionObserver(ctx context.Context, interval time.Duration, meta Meta, cluster *queryNodeCluster,
+	scheduler *TaskScheduler, dataCoord types.DataCoord) *distributionObserver {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &distributionObserver{
+		ctx:       ctx1,
+		cancel:    cancel,
+		interval:  interval,
+		meta:      meta,
+		cluster:   cluster,
+		scheduler: scheduler,
+		dataCoord: dataCoord,
+		lastFixes: make(map[distributionFix]struct{}),
+	}
+}
+
+func (o *distributionObserver) start() {
+	o.wg.Add(1)
+	go o.observeLoop()
+}
+
+func (o *distributionObserver) close() {
+	o.cancel()
+	o.wg.Wait()
+}
+
+func (o *distributionObserver) observeLoop() {
+	defer o.wg.Done()
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.ctx.Done():
+			return
+		case <-ticker.C:
+			o.observe()
+		}
+	}
+}
+
+// observe applies the fixes observed in the last round too, and waits for the tasks applying them
+func (o *distributionObserver) observe() {
+	if !o.scheduler.triggerTaskQueue.taskEmpty() {
+		log.Debug("distributionObserver: skip observing, the distribution is changing by the trigger tasks")
+		return
+	}
+	req, err := metricsinfo.ConstructCollectionRuntimeStatsRequest(nil)
+	if err != nil {
+		log.Warn("distributionObserver: failed to construct collection runtime stats request", zap.Error(err))
+		return
+	}
+	reports := getNodeCollectionRuntimeStats(o.ctx, o.cluster, req)
+	collections := o.meta.showCollections()
+	segments := make([]*querypb.SegmentInfo, 0)
+	for _, info := range collections {
+		segments = append(segments, o.meta.showSegmentInfos(info.CollectionID, nil)...)
+	}
+
+	fixes := make([]distributionFix, 0)
+	observed := make(map[distributionFix]struct{})
+	for _, fix := range diffDistribution(collections, segments, reports) {
+		observed[fix] = struct{}{}
+		if _, ok := o.lastFixes[fix]; ok {
+			fixes = append(fixes, fix)
+		}
+	}
+	o.lastFixes = observed
+	if len(fixes) == 0 {
+		return
+	}
+
+	tasks := o.fixTasks(fixes)
+	if len(tasks) == 0 {
+		return
+	}
+	o.cluster.decisionLog.Record(decisionTypeReconcile,
+		fmt.Sprintf("apply %d fixes of the distribution reported by the query nodes", len(fixes)),
+		map[string]interface{}{
+			"fixes": len(fixes),
+			"tasks": len(tasks),
+		})
+	o.scheduler.Enqueue(tasks)
+	for _, t := range tasks {
+		if err := t.WaitToFinish(); err != nil {
+			log.Warn("distributionObserver: failed to fix the distribution", zap.Int64("taskID", t.ID()), zap.Error(err))
+		}
+	}
+	// observed again from scratch for the distribution changed by the tasks
+	o.lastFixes = make(map[distributionFix]struct{})
+}
+
+// fixTasks returns the trigger tasks applying the fixes, the segments of a collection on a query node are reloaded
+// or released by one task
+func (o *distributionObserver) fixTasks(fixes []distributionFix) []task {
+	type nodeCollection struct {
+		nodeID       int64
+		collectionID UniqueID
+	}
+	reloads := make(map[nodeCollection][]distributionFix)
+	releases := make(map[nodeCollection][]UniqueID)
+	tasks := make([]task, 0)
+	for _, fix := range fixes {
+		key := nodeCollection{nodeID: fix.nodeID, collectionID: fix.collectionID}
+		switch fix.fixType {
+		case fixReloadSegment:
+			reloads[key] = append(reloads[key], fix)
+		case fixReleaseSegment:
+			releases[key] = append(releases[key], fix.segmentID)
+		case fixReleaseCollection:
+			log.Debug("distributionObserver: release the collection not loaded",
+				zap.Int64("nodeID", fix.nodeID),
+				zap.Int64("collectionID", fix.collectionID))
+			tasks = append(tasks, &ReleaseCollectionTask{
+				BaseTask: BaseTask{
+					ctx:              o.ctx,
+					Condition:        NewTaskCondition(o.ctx),
+					triggerCondition: querypb.TriggerCondition_loadBalance,
+				},
+				ReleaseCollectionRequest: &querypb.ReleaseCollectionRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_ReleaseCollection,
+					},
+					CollectionID: fix.collectionID,
+					NodeID:       fix.nodeID,
+				},
+				cluster: o.cluster,
+				meta:    o.meta,
+			})
+		}
+	}
+
+	for key, segmentIDs := range releases {
+		log.Debug("distributionObserver: release the copies of the segments held by the other query nodes",
+			zap.Int64("nodeID", key.nodeID),
+			zap.Int64("collectionID", key.collectionID),
+			zap.Int64s("segmentIDs", segmentIDs))
+		tasks = append(tasks, &ReleaseSegmentTask{
+			BaseTask: BaseTask{
+				ctx:              o.ctx,
+				Condition:        NewTaskCondition(o.ctx),
+				triggerCondition: querypb.TriggerCondition_loadBalance,
+			},
+			ReleaseSegmentsRequest: &querypb.ReleaseSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ReleaseSegments,
+				},
+				NodeID:       key.nodeID,
+				CollectionID: key.collectionID,
+				SegmentIDs:   segmentIDs,
+			},
+			cluster: o.cluster,
+		})
+	}
+
+	for key, reloadFixes := range reloads {
+		t, err := o.reloadTask(key.nodeID, key.collectionID, reloadFixes)
+		if err != nil {
+			log.Warn("distributionObserver: failed to reload the missing segments",
+				zap.Int64("nodeID", key.nodeID),
+				zap.Int64("collectionID", key.collectionID),
+				zap.Error(err))
+			continue
+		}
+		if t != nil {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks
+}
+
+// reloadTask returns the task loading the missing segments of the collection on the query node, the segments not
+// recovered by data coord any more are skipped, nil is returned if none is left
+func (o *distributionObserver) reloadTask(nodeID int64, collectionID UniqueID, fixes []distributionFix) (task, error) {
+	collectionInfo, err := o.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	partitionSegments := make(map[UniqueID]map[UniqueID]struct{})
+	for _, fix := range fixes {
+		if _, ok := partitionSegments[fix.partitionID]; !ok {
+			partitionSegments[fix.partitionID] = make(map[UniqueID]struct{})
+		}
+		partitionSegments[fix.partitionID][fix.segmentID] = struct{}{}
+	}
+
+	infos := make([]*querypb.SegmentLoadInfo, 0, len(fixes))
+	segmentIDs := make([]UniqueID, 0, len(fixes))
+	for partitionID, missing := range partitionSegments {
+		recoveryInfo, err := o.dataCoord.GetRecoveryInfo(o.ctx, &datapb.GetRecoveryInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadSegments,
+			},
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to get recovery info of partition %d, %s", partitionID, recoveryInfo.Status.Reason)
+		}
+		for _, segmentBinlog := range recoveryInfo.Binlogs {
+			if _, ok := missing[segmentBinlog.SegmentID]; !ok {
+				continue
+			}
+			infos = append(infos, &querypb.SegmentLoadInfo{
+				SegmentID:      segmentBinlog.SegmentID,
+				PartitionID:    partitionID,
+				CollectionID:   collectionID,
+				BinlogPaths:    segmentBinlog.FieldBinlogs,
+				NumOfRows:      segmentBinlog.NumOfRows,
+				ClusteringInfo: segmentBinlog.ClusteringInfo,
+				Deltalogs:      segmentBinlog.Deltalogs,
+			})
+			segmentIDs = append(segmentIDs, segmentBinlog.SegmentID)
+		}
+	}
+	if len(infos) == 0 {
+		return nil, nil
+	}
+
+	log.Debug("distributionObserver: reload the missing segments",
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", segmentIDs))
+	return &LoadSegmentTask{
+		BaseTask: BaseTask{
+			ctx:              o.ctx,
+			Condition:        NewTaskCondition(o.ctx),
+			triggerCondition: querypb.TriggerCondition_nodeDown,
+		},
+		LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadSegments,
+			},
+			NodeID:        nodeID,
+			Infos:         infos,
+			Schema:        collectionInfo.Schema,
+			LoadCondition: querypb.TriggerCondition_nodeDown,
+		},
+		meta:    o.meta,
+		cluster: o.cluster,
+	}, nil
+}
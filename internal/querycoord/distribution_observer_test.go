@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestDiffDistribution(t *testing.T) {
+	collections := []*querypb.CollectionInfo{{CollectionID: 1}}
+	segments := []*querypb.SegmentInfo{
+		{SegmentID: 10, CollectionID: 1, PartitionID: 100, NodeID: 1, SegmentState: querypb.SegmentState_sealed},
+		{SegmentID: 11, CollectionID: 1, PartitionID: 100, NodeID: 1, SegmentState: querypb.SegmentState_sealed},
+		// still being loaded
+		{SegmentID: 12, CollectionID: 1, PartitionID: 100, NodeID: 1, SegmentState: querypb.SegmentState_sealing},
+		// the node is not reported
+		{SegmentID: 13, CollectionID: 1, PartitionID: 100, NodeID: 3, SegmentState: querypb.SegmentState_sealed},
+	}
+
+	t.Run("consistent", func(t *testing.T) {
+		reports := []*metricsinfo.NodeCollectionRuntimeStats{
+			{NodeID: 1, Collections: []*metricsinfo.NodeCollectionStats{{CollectionID: 1, SealedSegmentIDs: []int64{10, 11}}}},
+			{NodeID: 2},
+		}
+		assert.Empty(t, diffDistribution(collections, segments, reports))
+	})
+
+	t.Run("fixes", func(t *testing.T) {
+		reports := []*metricsinfo.NodeCollectionRuntimeStats{
+			{NodeID: 1, Collections: []*metricsinfo.NodeCollectionStats{{CollectionID: 1, SealedSegmentIDs: []int64{10}}}},
+			{NodeID: 2, Collections: []*metricsinfo.NodeCollectionStats{
+				{CollectionID: 1, SealedSegmentIDs: []int64{10, 20}},
+				{CollectionID: 2, SealedSegmentIDs: []int64{30}},
+			}},
+		}
+		fixes := diffDistribution(collections, segments, reports)
+		assert.Equal(t, []distributionFix{
+			{fixType: fixReloadSegment, nodeID: 1, collectionID: 1, partitionID: 100, segmentID: 11},
+			{fixType: fixReleaseSegment, nodeID: 2, collectionID: 1, partitionID: 100, segmentID: 10},
+			{fixType: fixReleaseCollection, nodeID: 2, collectionID: 2},
+		}, fixes)
+	})
+
+	t.Run("collection released", func(t *testing.T) {
+		reports := []*metricsinfo.NodeCollectionRuntimeStats{
+			{NodeID: 1, Collections: []*metricsinfo.NodeCollectionStats{{CollectionID: 1, SealedSegmentIDs: []int64{10, 11}}}},
+		}
+		fixes := diffDistribution(nil, segments, reports)
+		assert.Equal(t, []distributionFix{
+			{fixType: fixReleaseCollection, nodeID: 1, collectionID: 1},
+		}, fixes)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func (qc *QueryCoord) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
@@ -94,15 +95,18 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 		inMemoryCollectionIDs = append(inMemoryCollectionIDs, info.CollectionID)
 	}
 	inMemoryPercentages := make([]int64, 0)
+	warmupPercentages := make([]int64, 0)
 	if len(req.CollectionIDs) == 0 {
 		for _, id := range inMemoryCollectionIDs {
 			inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+			warmupPercentages = append(warmupPercentages, qc.warmupJobs.percentage(id))
 		}
 		log.Debug("show collection end", zap.Int64s("collections", inMemoryCollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 		return &querypb.ShowCollectionsResponse{
 			Status:              status,
 			CollectionIDs:       inMemoryCollectionIDs,
 			InMemoryPercentages: inMemoryPercentages,
+			WarmupPercentages:   warmupPercentages,
 		}, nil
 	}
 	for _, id := range req.CollectionIDs {
@@ -115,12 +119,14 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 			}, err
 		}
 		inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+		warmupPercentages = append(warmupPercentages, qc.warmupJobs.percentage(id))
 	}
 	log.Debug("show collection end", zap.Int64s("collections", req.CollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 	return &querypb.ShowCollectionsResponse{
 		Status:              status,
 		CollectionIDs:       req.CollectionIDs,
 		InMemoryPercentages: inMemoryPercentages,
+		WarmupPercentages:   warmupPercentages,
 	}, nil
 }
 
@@ -205,6 +211,7 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		status.Reason = err.Error()
 		return status, err
 	}
+	qc.warmupJobs.remove(collectionID)
 
 	log.Debug("ReleaseCollectionRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", collectionID))
 	//qc.MetaReplica.printMeta()
@@ -516,6 +523,76 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 	}, nil
 }
 
+// GetSegmentDistribution returns the query nodes watching the dm channels of a collection and the sealed segments
+// loaded on each query node, the proxies cache it to only search the query nodes holding the partitions to search
+func (qc *QueryCoord) GetSegmentDistribution(ctx context.Context, req *querypb.GetSegmentDistributionRequest) (*querypb.GetSegmentDistributionResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getSegmentDistribution end with query coordinator not healthy")
+		return &querypb.GetSegmentDistributionResponse{
+			Status: status,
+		}, err
+	}
+
+	info, err := qc.meta.getCollectionInfoByID(req.CollectionID)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return &querypb.GetSegmentDistributionResponse{
+			Status: status,
+		}, err
+	}
+	segmentInfos := qc.meta.showSegmentInfos(req.CollectionID, nil)
+	log.Debug("getSegmentDistribution", zap.Int64("collectionID", req.CollectionID),
+		zap.Int("shard leaders", len(info.ChannelInfos)), zap.Int("segments", len(segmentInfos)))
+	return &querypb.GetSegmentDistributionResponse{
+		Status:       status,
+		ShardLeaders: info.ChannelInfos,
+		SegmentInfos: segmentInfos,
+	}, nil
+}
+
+// RefreshIndex notifies the query nodes holding the sealed segments of a collection to load the index swapped in
+// on the field, the old index serves until the new one is loaded
+func (qc *QueryCoord) RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error) {
+	log.Debug("refreshIndexRequest received", zap.String("role", Params.RoleName), zap.Int64("collectionID", req.CollectionID), zap.Int64("fieldID", req.FieldID))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("refreshIndex end with query coordinator not healthy")
+		return status, err
+	}
+
+	if !qc.meta.hasCollection(req.CollectionID) {
+		log.Debug("refreshIndex end, collection not loaded", zap.Int64("collectionID", req.CollectionID))
+		return status, nil
+	}
+
+	nodeIDs := make(map[int64]struct{})
+	for _, info := range qc.meta.showSegmentInfos(req.CollectionID, nil) {
+		nodeIDs[info.NodeID] = struct{}{}
+	}
+	for nodeID := range nodeIDs {
+		err := qc.cluster.refreshIndex(ctx, nodeID, req)
+		if err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = err.Error()
+			return status, err
+		}
+	}
+	log.Debug("refreshIndexRequest completed", zap.String("role", Params.RoleName), zap.Int64("collectionID", req.CollectionID), zap.Int("nodes", len(nodeIDs)))
+	return status, nil
+}
+
 func (qc *QueryCoord) isHealthy() bool {
 	code := qc.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
@@ -581,6 +658,35 @@ func (qc *QueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 
 		return metrics, err
 	}
+
+	if metricType == metricsinfo.DecisionLogMetrics {
+		return getDecisionLogMetrics(ctx, req, qc)
+	}
+
+	if metricType == metricsinfo.WarmupQueriesMetrics {
+		return getWarmupQueriesMetrics(ctx, req, qc)
+	}
+
+	if metricType == metricsinfo.LoadSimulationMetrics {
+		return getLoadSimulationMetrics(ctx, req, qc)
+	}
+
+	if metricType == metricsinfo.CollectionRuntimeStatsMetrics {
+		return getCollectionRuntimeStatsMetrics(ctx, req, qc)
+	}
+
+	if metricType == metricsinfo.WarmupCollectionMetrics {
+		return getWarmupCollectionMetrics(ctx, req, qc)
+	}
+
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID), &Params)
+	}
+
 	err = errors.New(metricsinfo.MsgUnimplementedMetric)
 	log.Debug("QueryCoord.GetMetrics failed",
 		zap.Int64("node_id", Params.QueryCoordID),
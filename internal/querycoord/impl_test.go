@@ -175,6 +175,17 @@ func TestGrpcTask(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test RefreshIndex", func(t *testing.T) {
+		status, err := queryCoord.RefreshIndex(ctx, &querypb.RefreshIndexRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadIndex,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test ReleaseParOfNotLoadedCol", func(t *testing.T) {
 		status, err := queryCoord.ReleasePartitions(ctx, &querypb.ReleasePartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -509,6 +520,17 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("Test RefreshIndex", func(t *testing.T) {
+		status, err := unHealthyCoord.RefreshIndex(ctx, &querypb.RefreshIndexRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadIndex,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("Test GetComponentStates", func(t *testing.T) {
 		states, err := unHealthyCoord.GetComponentStates(ctx)
 		assert.Equal(t, commonpb.ErrorCode_Success, states.Status.ErrorCode)
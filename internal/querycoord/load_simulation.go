@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// simulateLoad distributes the segments of the hypothetical collection over the nodes by the same placement as
+// the load tasks, each replica is placed after the previous one. The nodes must carry their current segment num
+// and memory, the load is feasible if no node exceeds the memory watermark
+func simulateLoad(req *metricsinfo.LoadSimulationRequest, nodes []*metricsinfo.SimulatedNodeLoad, watermark float64) *metricsinfo.LoadSimulationResult {
+	result := &metricsinfo.LoadSimulationResult{
+		Feasible:    true,
+		SegmentSize: req.CollectionSize / int64(req.NumSegments),
+		Nodes:       nodes,
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeID < nodes[j].NodeID
+	})
+	if len(nodes) == 0 {
+		result.Feasible = false
+		result.Reason = "no query node is on service"
+		return result
+	}
+	if len(nodes) < req.ReplicaNumber {
+		result.Feasible = false
+		result.Reason = fmt.Sprintf("%d replicas need at least %d query nodes, but only %d on service",
+			req.ReplicaNumber, req.ReplicaNumber, len(nodes))
+	}
+
+	nodeByID := make(map[int64]*metricsinfo.SimulatedNodeLoad, len(nodes))
+	numSegments := make(map[int64]int, len(nodes))
+	for _, node := range nodes {
+		nodeByID[node.NodeID] = node
+		numSegments[node.NodeID] = node.ExistingSegments
+	}
+	for i := 0; i < req.ReplicaNumber; i++ {
+		for _, nodeID := range assignSegmentsByNum(req.NumSegments, numSegments) {
+			numSegments[nodeID]++
+			nodeByID[nodeID].AssignedSegments++
+		}
+	}
+
+	for _, node := range nodes {
+		node.EstimatedUsage = node.MemoryUsage + uint64(node.AssignedSegments)*uint64(result.SegmentSize)
+		if node.Memory == 0 {
+			if node.AssignedSegments > 0 && result.Feasible {
+				result.Feasible = false
+				result.Reason = fmt.Sprintf("memory of query node %d is unknown", node.NodeID)
+			}
+			continue
+		}
+		node.Overloaded = float64(node.EstimatedUsage) > float64(node.Memory)*watermark
+		if node.Overloaded && result.Feasible {
+			result.Feasible = false
+			result.Reason = fmt.Sprintf("estimated memory usage %d of query node %d exceeds %v of its memory %d",
+				node.EstimatedUsage, node.NodeID, watermark, node.Memory)
+		}
+	}
+	return result
+}
+
+// getSimulatedNodeLoads returns the current segment num and memory of the query nodes on service
+func (qc *QueryCoord) getSimulatedNodeLoads(ctx context.Context) ([]*metricsinfo.SimulatedNodeLoad, error) {
+	nodes, err := qc.cluster.onServiceNodes()
+	if err != nil {
+		return nil, err
+	}
+	infoReq, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	loads := make([]*metricsinfo.SimulatedNodeLoad, 0, len(nodes))
+	for nodeID, node := range nodes {
+		load := &metricsinfo.SimulatedNodeLoad{NodeID: nodeID}
+		load.ExistingSegments, _ = qc.cluster.getNumSegments(nodeID)
+		resp, err := node.getMetrics(ctx, infoReq)
+		if err == nil && resp.Status.ErrorCode == commonpb.ErrorCode_Success {
+			infos := metricsinfo.QueryNodeInfos{}
+			if err := metricsinfo.UnmarshalComponentInfos(resp.Response, &infos); err == nil {
+				load.Memory = infos.HardwareInfos.Memory
+				load.MemoryUsage = infos.HardwareInfos.MemoryUsage
+			}
+		} else {
+			log.Warn("failed to get the metrics of query node for load simulation", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+		loads = append(loads, load)
+	}
+	return loads, nil
+}
+
+func getLoadSimulationMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (*milvuspb.GetMetricsResponse, error) {
+	componentName := metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID)
+	simulationReq, err := metricsinfo.ParseLoadSimulationRequest(req.Request)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	nodes, err := qc.getSimulatedNodeLoads(ctx)
+	if err != nil {
+		log.Warn("failed to get the query nodes for load simulation", zap.Error(err))
+		nodes = nil
+	}
+	result := simulateLoad(simulationReq, nodes, Params.LoadSimulationMemoryWatermark)
+	resp, err := json.Marshal(result)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}, nil
+}
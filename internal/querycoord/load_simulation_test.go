@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestAssignSegmentsByNum(t *testing.T) {
+	assert.Empty(t, assignSegmentsByNum(3, map[int64]int{}))
+	assert.Empty(t, assignSegmentsByNum(0, map[int64]int{1: 0}))
+
+	// the less loaded nodes are filled first
+	res := assignSegmentsByNum(2, map[int64]int{1: 5, 2: 0, 3: 0})
+	assert.ElementsMatch(t, []int64{2, 3}, res)
+
+	res = assignSegmentsByNum(4, map[int64]int{1: 0, 2: 0})
+	assert.Equal(t, 4, len(res))
+	counts := map[int64]int{}
+	for _, nodeID := range res {
+		counts[nodeID]++
+	}
+	assert.Equal(t, map[int64]int{1: 2, 2: 2}, counts)
+}
+
+func TestSimulateLoad(t *testing.T) {
+	newNodes := func() []*metricsinfo.SimulatedNodeLoad {
+		return []*metricsinfo.SimulatedNodeLoad{
+			{NodeID: 2, Memory: 1000, MemoryUsage: 100},
+			{NodeID: 1, Memory: 1000, MemoryUsage: 100},
+		}
+	}
+
+	t.Run("feasible", func(t *testing.T) {
+		req := &metricsinfo.LoadSimulationRequest{CollectionSize: 800, NumSegments: 4, ReplicaNumber: 1}
+		result := simulateLoad(req, newNodes(), 0.9)
+		assert.True(t, result.Feasible)
+		assert.Equal(t, int64(200), result.SegmentSize)
+		assert.Equal(t, int64(1), result.Nodes[0].NodeID)
+		assert.Equal(t, 2, result.Nodes[0].AssignedSegments)
+		assert.Equal(t, 2, result.Nodes[1].AssignedSegments)
+		assert.Equal(t, uint64(500), result.Nodes[0].EstimatedUsage)
+		assert.False(t, result.Nodes[0].Overloaded)
+	})
+
+	t.Run("overloaded", func(t *testing.T) {
+		req := &metricsinfo.LoadSimulationRequest{CollectionSize: 1000, NumSegments: 4, ReplicaNumber: 2}
+		result := simulateLoad(req, newNodes(), 0.9)
+		assert.False(t, result.Feasible)
+		assert.Equal(t, 4, result.Nodes[0].AssignedSegments)
+		assert.True(t, result.Nodes[0].Overloaded)
+		assert.NotEmpty(t, result.Reason)
+	})
+
+	t.Run("too many replicas", func(t *testing.T) {
+		req := &metricsinfo.LoadSimulationRequest{CollectionSize: 8, NumSegments: 4, ReplicaNumber: 3}
+		result := simulateLoad(req, newNodes(), 0.9)
+		assert.False(t, result.Feasible)
+	})
+
+	t.Run("unknown memory", func(t *testing.T) {
+		req := &metricsinfo.LoadSimulationRequest{CollectionSize: 8, NumSegments: 1, ReplicaNumber: 1}
+		result := simulateLoad(req, []*metricsinfo.SimulatedNodeLoad{{NodeID: 1}}, 0.9)
+		assert.False(t, result.Feasible)
+	})
+
+	t.Run("no node", func(t *testing.T) {
+		req := &metricsinfo.LoadSimulationRequest{CollectionSize: 8, NumSegments: 1, ReplicaNumber: 1}
+		result := simulateLoad(req, nil, 0.9)
+		assert.False(t, result.Feasible)
+	})
+}
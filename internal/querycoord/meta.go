@@ -12,6 +12,7 @@
 package querycoord
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -25,12 +26,14 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 const (
 	collectionMetaPrefix   = "queryCoord-collectionMeta"
 	segmentMetaPrefix      = "queryCoord-segmentMeta"
 	queryChannelMetaPrefix = "queryCoord-queryChannel"
+	warmupQueryMetaPrefix  = "queryCoord-warmupQuery"
 )
 
 type Meta interface {
@@ -67,6 +70,9 @@ type Meta interface {
 	setLoadType(collectionID UniqueID, loadType querypb.LoadType) error
 	getLoadType(collectionID UniqueID) (querypb.LoadType, error)
 	setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error
+
+	setWarmupQueries(collectionID UniqueID, queries []*metricsinfo.WarmupQuery) error
+	getWarmupQueries(collectionID UniqueID) []*metricsinfo.WarmupQuery
 	printMeta()
 }
 
@@ -77,6 +83,8 @@ type MetaReplica struct {
 	collectionInfos   map[UniqueID]*querypb.CollectionInfo
 	segmentInfos      map[UniqueID]*querypb.SegmentInfo
 	queryChannelInfos map[UniqueID]*querypb.QueryChannelInfo
+	// warmupQueries are kept after the collection is released, they are run on the next load
+	warmupQueries map[UniqueID][]*metricsinfo.WarmupQuery
 
 	//partitionStates map[UniqueID]*querypb.PartitionStates
 }
@@ -91,6 +99,7 @@ func newMeta(kv *etcdkv.EtcdKV) (Meta, error) {
 		collectionInfos:   collectionInfos,
 		segmentInfos:      segmentInfos,
 		queryChannelInfos: queryChannelInfos,
+		warmupQueries:     make(map[UniqueID][]*metricsinfo.WarmupQuery),
 	}
 
 	err := m.reloadFromKV()
@@ -152,6 +161,23 @@ func (m *MetaReplica) reloadFromKV() error {
 		}
 		m.queryChannelInfos[collectionID] = queryChannelInfo
 	}
+
+	warmupQueryKeys, warmupQueryValues, err := m.client.LoadWithPrefix(warmupQueryMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for index := range warmupQueryKeys {
+		collectionID, err := strconv.ParseInt(filepath.Base(warmupQueryKeys[index]), 10, 64)
+		if err != nil {
+			return err
+		}
+		queries := make([]*metricsinfo.WarmupQuery, 0)
+		err = json.Unmarshal([]byte(warmupQueryValues[index]), &queries)
+		if err != nil {
+			return err
+		}
+		m.warmupQueries[collectionID] = queries
+	}
 	//TODO::update partition states
 
 	return nil
@@ -305,16 +331,22 @@ func (m *MetaReplica) deleteSegmentInfoByNodeID(nodeID UniqueID) error {
 	m.Lock()
 	defer m.Unlock()
 
+	segmentIDs := make([]UniqueID, 0)
+	removals := make([]string, 0)
 	for segmentID, info := range m.segmentInfos {
 		if info.NodeID == nodeID {
-			err := removeSegmentInfo(segmentID, m.client)
-			if err != nil {
-				log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
-				return err
-			}
-			delete(m.segmentInfos, segmentID)
+			segmentIDs = append(segmentIDs, segmentID)
+			removals = append(removals, segmentInfoKey(segmentID))
 		}
 	}
+	err := m.client.MultiRemove(removals)
+	if err != nil {
+		log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("nodeID", nodeID))
+		return err
+	}
+	for _, segmentID := range segmentIDs {
+		delete(m.segmentInfos, segmentID)
+	}
 
 	return nil
 }
@@ -420,25 +452,28 @@ func (m *MetaReplica) releaseCollection(collectionID UniqueID) error {
 	m.Lock()
 	defer m.Unlock()
 
-	delete(m.collectionInfos, collectionID)
+	// collection, segment and query channel infos are removed in one transaction,
+	// so a crash of query coordinator never leaves a half released collection in etcd
+	segmentIDs := make([]UniqueID, 0)
+	removals := []string{collectionInfoKey(collectionID), queryChannelInfoKey(collectionID)}
 	for id, info := range m.segmentInfos {
 		if info.CollectionID == collectionID {
-			err := removeSegmentInfo(id, m.client)
-			if err != nil {
-				log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", id))
-				return err
-			}
-			delete(m.segmentInfos, id)
+			segmentIDs = append(segmentIDs, id)
+			removals = append(removals, segmentInfoKey(id))
 		}
 	}
-
-	delete(m.queryChannelInfos, collectionID)
-	err := removeGlobalCollectionInfo(collectionID, m.client)
+	err := m.client.MultiRemove(removals)
 	if err != nil {
 		log.Error("remove collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
 		return err
 	}
 
+	delete(m.collectionInfos, collectionID)
+	for _, id := range segmentIDs {
+		delete(m.segmentInfos, id)
+	}
+	delete(m.queryChannelInfos, collectionID)
+
 	return nil
 }
 
@@ -446,6 +481,7 @@ func (m *MetaReplica) releasePartition(collectionID UniqueID, partitionID Unique
 	m.Lock()
 	defer m.Unlock()
 
+	saves := make(map[string]string)
 	if info, ok := m.collectionInfos[collectionID]; ok {
 		newPartitionIDs := make([]UniqueID, 0)
 		newPartitionStates := make([]*querypb.PartitionStates, 0)
@@ -474,22 +510,24 @@ func (m *MetaReplica) releasePartition(collectionID UniqueID, partitionID Unique
 		// So if releasing partition, inMemoryPercentage should be set to 0.
 		info.InMemoryPercentage = 0
 
-		err := saveGlobalCollectionInfo(collectionID, info, m.client)
-		if err != nil {
-			log.Error("save collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
-			return err
-		}
+		saves[collectionInfoKey(collectionID)] = proto.MarshalTextString(info)
 	}
+	segmentIDs := make([]UniqueID, 0)
+	removals := make([]string, 0)
 	for id, info := range m.segmentInfos {
 		if info.PartitionID == partitionID {
-			err := removeSegmentInfo(id, m.client)
-			if err != nil {
-				log.Error("delete segmentInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID), zap.Int64("segmentID", id))
-				return err
-			}
-			delete(m.segmentInfos, id)
+			segmentIDs = append(segmentIDs, id)
+			removals = append(removals, segmentInfoKey(id))
 		}
 	}
+	err := m.client.MultiSaveAndRemove(saves, removals)
+	if err != nil {
+		log.Error("release partition meta error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID))
+		return err
+	}
+	for _, id := range segmentIDs {
+		delete(m.segmentInfos, id)
+	}
 
 	return nil
 }
@@ -679,6 +717,40 @@ func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID Uniqu
 	return nil
 }
 
+// setWarmupQueries replaces the warm-up queries of the collection, the queries are removed if empty
+func (m *MetaReplica) setWarmupQueries(collectionID UniqueID, queries []*metricsinfo.WarmupQuery) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if len(queries) == 0 {
+		err := m.client.Remove(warmupQueryKey(collectionID))
+		if err != nil {
+			return err
+		}
+		delete(m.warmupQueries, collectionID)
+		return nil
+	}
+
+	value, err := json.Marshal(queries)
+	if err != nil {
+		return err
+	}
+	err = m.client.Save(warmupQueryKey(collectionID), string(value))
+	if err != nil {
+		log.Error("save warmup queries error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
+		return err
+	}
+	m.warmupQueries[collectionID] = queries
+	return nil
+}
+
+func (m *MetaReplica) getWarmupQueries(collectionID UniqueID) []*metricsinfo.WarmupQuery {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.warmupQueries[collectionID]
+}
+
 func (m *MetaReplica) printMeta() {
 	m.RLock()
 	defer m.RUnlock()
@@ -695,38 +767,44 @@ func (m *MetaReplica) printMeta() {
 	}
 }
 
-func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv *etcdkv.EtcdKV) error {
-	infoBytes := proto.MarshalTextString(info)
+func collectionInfoKey(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
+}
+
+func segmentInfoKey(segmentID UniqueID) string {
+	return fmt.Sprintf("%s/%d", segmentMetaPrefix, segmentID)
+}
+
+func queryChannelInfoKey(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
+}
 
-	key := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
-	return kv.Save(key, infoBytes)
+func warmupQueryKey(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d", warmupQueryMetaPrefix, collectionID)
 }
 
-func removeGlobalCollectionInfo(collectionID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
-	return kv.Remove(key)
+func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv *etcdkv.EtcdKV) error {
+	infoBytes := proto.MarshalTextString(info)
+
+	return kv.Save(collectionInfoKey(collectionID), infoBytes)
 }
 
 func saveSegmentInfo(segmentID UniqueID, info *querypb.SegmentInfo, kv *etcdkv.EtcdKV) error {
 	infoBytes := proto.MarshalTextString(info)
 
-	key := fmt.Sprintf("%s/%d", segmentMetaPrefix, segmentID)
-	return kv.Save(key, infoBytes)
+	return kv.Save(segmentInfoKey(segmentID), infoBytes)
 }
 
 func removeSegmentInfo(segmentID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", segmentMetaPrefix, segmentID)
-	return kv.Remove(key)
+	return kv.Remove(segmentInfoKey(segmentID))
 }
 
 func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo, kv *etcdkv.EtcdKV) error {
 	infoBytes := proto.MarshalTextString(info)
 
-	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
-	return kv.Save(key, infoBytes)
+	return kv.Save(queryChannelInfoKey(collectionID), infoBytes)
 }
 
 func removeQueryChannelInfo(collectionID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
-	return kv.Remove(key)
+	return kv.Remove(queryChannelInfoKey(collectionID))
 }
@@ -21,6 +21,7 @@ import (
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 func TestReplica_Release(t *testing.T) {
@@ -53,6 +54,36 @@ func TestReplica_Release(t *testing.T) {
 	meta.releaseCollection(1)
 }
 
+func TestReplica_ReleaseRemovesKV(t *testing.T) {
+	refreshParams()
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(etcdKV)
+	assert.Nil(t, err)
+	err = meta.addCollection(defaultCollectionID, nil)
+	require.NoError(t, err)
+	err = meta.addPartition(defaultCollectionID, defaultPartitionID)
+	require.NoError(t, err)
+	err = meta.setSegmentInfo(defaultSegmentID, &querypb.SegmentInfo{
+		SegmentID:    defaultSegmentID,
+		CollectionID: defaultCollectionID,
+		PartitionID:  defaultPartitionID,
+	})
+	require.NoError(t, err)
+
+	err = meta.releasePartition(defaultCollectionID, defaultPartitionID)
+	assert.NoError(t, err)
+	assert.False(t, meta.hasSegmentInfo(defaultSegmentID))
+	_, err = etcdKV.Load(segmentInfoKey(defaultSegmentID))
+	assert.Error(t, err)
+
+	err = meta.releaseCollection(defaultCollectionID)
+	assert.NoError(t, err)
+	keys, _, err := etcdKV.LoadWithPrefix(collectionInfoKey(defaultCollectionID))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
 func TestReloadMetaFromKV(t *testing.T) {
 	refreshParams()
 	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
@@ -102,3 +133,27 @@ func TestReloadMetaFromKV(t *testing.T) {
 	_, ok = meta.queryChannelInfos[defaultCollectionID]
 	assert.Equal(t, true, ok)
 }
+
+func TestReplica_WarmupQueries(t *testing.T) {
+	refreshParams()
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(etcdKV)
+	assert.Nil(t, err)
+
+	queries := []*metricsinfo.WarmupQuery{{Dsl: "{}", Vectors: [][]float32{{1, 2}}}}
+	err = meta.setWarmupQueries(defaultCollectionID, queries)
+	assert.Nil(t, err)
+	assert.Equal(t, queries, meta.getWarmupQueries(defaultCollectionID))
+
+	// the queries are reloaded from etcd
+	reloaded, err := newMeta(etcdKV)
+	assert.Nil(t, err)
+	assert.Equal(t, queries, reloaded.getWarmupQueries(defaultCollectionID))
+
+	err = meta.setWarmupQueries(defaultCollectionID, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, meta.getWarmupQueries(defaultCollectionID))
+	_, err = etcdKV.Load(warmupQueryKey(defaultCollectionID))
+	assert.Error(t, err)
+}
@@ -13,7 +13,11 @@ package querycoord
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -138,3 +142,252 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
 	}, nil
 }
+
+// getDecisionLogMetrics returns the balance and assignment decisions recorded in the decision log
+func getDecisionLogMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (*milvuspb.GetMetricsResponse, error) {
+	logReq, err := metricsinfo.ParseDecisionLogRequest(req.Request)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	var since time.Time
+	if logReq.Since > 0 {
+		since = time.Unix(logReq.Since, 0)
+	}
+	events := qc.cluster.decisionLog.Query(logReq.Type, since, logReq.Limit)
+	resp, err := json.Marshal(events)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+	}, nil
+}
+
+func getWarmupQueriesMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (*milvuspb.GetMetricsResponse, error) {
+	warmupReq, err := metricsinfo.ParseWarmupQueriesRequest(req.Request)
+	if err == nil && (warmupReq.Clear || len(warmupReq.Queries) > 0) {
+		log.Debug("QueryCoord set warmup queries",
+			zap.Int64("collectionID", warmupReq.CollectionID),
+			zap.Int("queries", len(warmupReq.Queries)))
+		if warmupReq.Clear {
+			warmupReq.Queries = nil
+		}
+		err = qc.meta.setWarmupQueries(warmupReq.CollectionID, warmupReq.Queries)
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	queries := qc.meta.getWarmupQueries(warmupReq.CollectionID)
+	if queries == nil {
+		queries = make([]*metricsinfo.WarmupQuery, 0)
+	}
+	resp, err := json.Marshal(queries)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+	}, nil
+}
+
+// getCollectionRuntimeStatsMetrics aggregates the runtime statistics of the collections reported by the query nodes,
+// the loaded collections are reported if the request asks no collection
+func getCollectionRuntimeStatsMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (*milvuspb.GetMetricsResponse, error) {
+	statsReq, err := metricsinfo.ParseCollectionRuntimeStatsRequest(req.Request)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	collectionIDs := statsReq.CollectionIDs
+	if len(collectionIDs) == 0 {
+		for _, info := range qc.meta.showCollections() {
+			collectionIDs = append(collectionIDs, info.CollectionID)
+		}
+	}
+
+	resp, err := json.Marshal(mergeCollectionRuntimeStats(collectionIDs, getNodeCollectionRuntimeStats(ctx, qc.cluster, req)))
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+	}, nil
+}
+
+// getNodeCollectionRuntimeStats returns the runtime statistics of the collections reported by the query nodes,
+// the nodes failed to report are skipped
+func getNodeCollectionRuntimeStats(ctx context.Context, cluster *queryNodeCluster, req *milvuspb.GetMetricsRequest) []*metricsinfo.NodeCollectionRuntimeStats {
+	nodeStats := make([]*metricsinfo.NodeCollectionRuntimeStats, 0)
+	for _, nodeMetrics := range cluster.getMetrics(ctx, req) {
+		if nodeMetrics.err != nil {
+			log.Warn("failed to get collection runtime stats of query node", zap.Error(nodeMetrics.err))
+			continue
+		}
+		if nodeMetrics.resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			log.Warn("failed to get collection runtime stats of query node",
+				zap.String("component_name", nodeMetrics.resp.ComponentName),
+				zap.String("error_reason", nodeMetrics.resp.Status.Reason))
+			continue
+		}
+		stats := &metricsinfo.NodeCollectionRuntimeStats{}
+		if err := json.Unmarshal([]byte(nodeMetrics.resp.Response), stats); err != nil {
+			log.Warn("invalid collection runtime stats of query node was found",
+				zap.String("component_name", nodeMetrics.resp.ComponentName),
+				zap.Error(err))
+			continue
+		}
+		nodeStats = append(nodeStats, stats)
+	}
+	return nodeStats
+}
+
+// getWarmupCollectionMetrics starts warming up the sealed segments of the loaded collection on the query nodes,
+// and returns the progress of the running job
+func getWarmupCollectionMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, qc *QueryCoord) (*milvuspb.GetMetricsResponse, error) {
+	warmupReq, err := metricsinfo.ParseWarmupCollectionRequest(req.Request)
+	if err == nil && !qc.meta.hasCollection(warmupReq.CollectionID) {
+		err = fmt.Errorf("collection %d has not been loaded to memory", warmupReq.CollectionID)
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	nodeSegments := make(map[int64][]UniqueID)
+	for _, info := range qc.meta.showSegmentInfos(warmupReq.CollectionID, nil) {
+		nodeSegments[info.NodeID] = append(nodeSegments[info.NodeID], info.SegmentID)
+	}
+	progress := qc.warmupJobs.start(warmupReq.CollectionID, nodeSegments)
+
+	resp, err := json.Marshal(progress)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryCoordRole, Params.QueryCoordID),
+	}, nil
+}
+
+// mergeCollectionRuntimeStats sums the statistics of each collection across the nodes, the sealed segments
+// loaded by several nodes are counted once and the replica number is the min copies of them
+func mergeCollectionRuntimeStats(collectionIDs []int64, nodeStats []*metricsinfo.NodeCollectionRuntimeStats) []*metricsinfo.CollectionRuntimeStats {
+	ret := make([]*metricsinfo.CollectionRuntimeStats, 0, len(collectionIDs))
+	merged := make(map[int64]*metricsinfo.CollectionRuntimeStats)
+	copies := make(map[int64]map[int64]int)
+	for _, collectionID := range collectionIDs {
+		if _, ok := merged[collectionID]; ok {
+			continue
+		}
+		stats := &metricsinfo.CollectionRuntimeStats{
+			CollectionID: collectionID,
+			NodeIDs:      make([]int64, 0),
+		}
+		merged[collectionID] = stats
+		copies[collectionID] = make(map[int64]int)
+		ret = append(ret, stats)
+	}
+
+	for _, node := range nodeStats {
+		for _, nodeCollection := range node.Collections {
+			stats, ok := merged[nodeCollection.CollectionID]
+			if !ok {
+				continue
+			}
+			stats.LoadedMemoryBytes += nodeCollection.LoadedMemoryBytes
+			stats.GrowingSegments += nodeCollection.GrowingSegments
+			stats.QueryQPS += nodeCollection.QueryQPS
+			stats.NodeIDs = append(stats.NodeIDs, node.NodeID)
+			for _, segmentID := range nodeCollection.SealedSegmentIDs {
+				copies[nodeCollection.CollectionID][segmentID]++
+			}
+		}
+	}
+
+	for _, stats := range ret {
+		sort.Slice(stats.NodeIDs, func(i, j int) bool { return stats.NodeIDs[i] < stats.NodeIDs[j] })
+		segmentCopies := copies[stats.CollectionID]
+		stats.SealedSegments = len(segmentCopies)
+		minCopies := 0
+		for _, n := range segmentCopies {
+			if minCopies == 0 || n < minCopies {
+				minCopies = n
+			}
+		}
+		if minCopies > 0 {
+			stats.ReplicaNumber = minCopies
+		} else if len(stats.NodeIDs) > 0 {
+			// a collection with only the growing segments is served by one replica
+			stats.ReplicaNumber = 1
+		}
+	}
+	return ret
+}
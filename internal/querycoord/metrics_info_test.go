@@ -12,11 +12,101 @@
 package querycoord
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/decisionlog"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 func TestGetSystemInfoMetrics(t *testing.T) {
 	log.Info("TestGetSystemInfoMetrics, todo")
 }
+
+func TestGetDecisionLogMetrics(t *testing.T) {
+	ctx := context.Background()
+	qc := &QueryCoord{
+		cluster: &queryNodeCluster{decisionLog: decisionlog.NewLog(10)},
+	}
+	qc.cluster.decisionLog.Record(decisionTypeBalance, "move the segments and channels off query node 1", map[string]interface{}{"node_id": 1})
+	qc.cluster.decisionLog.Record(decisionTypeAssign, "assign 1 segments and 0 dm channels of collection 1", nil)
+
+	resp, err := getDecisionLogMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "decision_log", "type": "balance"}`}, qc)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	events := make([]decisionlog.Event, 0)
+	err = json.Unmarshal([]byte(resp.Response), &events)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, decisionTypeBalance, events[0].Type)
+	assert.Equal(t, float64(1), events[0].Inputs["node_id"])
+
+	resp, err = getDecisionLogMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "decision_log"}`}, qc)
+	assert.Nil(t, err)
+	err = json.Unmarshal([]byte(resp.Response), &events)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+
+	resp, err = getDecisionLogMetrics(ctx, &milvuspb.GetMetricsRequest{Request: "not in json format"}, qc)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
+
+func TestMergeCollectionRuntimeStats(t *testing.T) {
+	nodeStats := []*metricsinfo.NodeCollectionRuntimeStats{
+		{
+			NodeID: 2,
+			Collections: []*metricsinfo.NodeCollectionStats{
+				{CollectionID: 1, LoadedMemoryBytes: 100, SealedSegmentIDs: []int64{10, 11}, GrowingSegments: 1, QueryQPS: 1.5},
+				{CollectionID: 2, GrowingSegments: 2},
+				{CollectionID: 3, LoadedMemoryBytes: 100, SealedSegmentIDs: []int64{30}},
+			},
+		},
+		{
+			NodeID: 1,
+			Collections: []*metricsinfo.NodeCollectionStats{
+				{CollectionID: 1, LoadedMemoryBytes: 200, SealedSegmentIDs: []int64{10, 11, 12}, QueryQPS: 0.5},
+			},
+		},
+		{
+			NodeID: 3,
+			Collections: []*metricsinfo.NodeCollectionStats{
+				{CollectionID: 1, LoadedMemoryBytes: 100, SealedSegmentIDs: []int64{12}},
+			},
+		},
+	}
+
+	stats := mergeCollectionRuntimeStats([]int64{1, 2, 4, 1}, nodeStats)
+	assert.Equal(t, 3, len(stats))
+	assert.Equal(t, &metricsinfo.CollectionRuntimeStats{
+		CollectionID:      1,
+		LoadedMemoryBytes: 400,
+		SealedSegments:    3,
+		GrowingSegments:   1,
+		ReplicaNumber:     2,
+		QueryQPS:          2,
+		NodeIDs:           []int64{1, 2, 3},
+	}, stats[0])
+	assert.Equal(t, &metricsinfo.CollectionRuntimeStats{
+		CollectionID:    2,
+		GrowingSegments: 2,
+		ReplicaNumber:   1,
+		NodeIDs:         []int64{2},
+	}, stats[1])
+	assert.Equal(t, &metricsinfo.CollectionRuntimeStats{
+		CollectionID: 4,
+		NodeIDs:      []int64{},
+	}, stats[2])
+}
+
+func TestGetCollectionRuntimeStatsMetrics(t *testing.T) {
+	resp, err := getCollectionRuntimeStatsMetrics(context.Background(), &milvuspb.GetMetricsRequest{Request: "not in json format"}, &QueryCoord{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
@@ -131,6 +131,10 @@ func (client *queryNodeClientMock) GetSegmentInfo(ctx context.Context, req *quer
 	return client.grpcClient.GetSegmentInfo(ctx, req)
 }
 
+func (client *queryNodeClientMock) RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error) {
+	return client.grpcClient.RefreshIndex(ctx, req)
+}
+
 func (client *queryNodeClientMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return client.grpcClient.GetMetrics(ctx, req)
 }
@@ -165,6 +165,12 @@ func (qs *queryNodeServerMock) GetSegmentInfo(context.Context, *querypb.GetSegme
 	}, nil
 }
 
+func (qs *queryNodeServerMock) RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (qs *queryNodeServerMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{
 		Status: &commonpb.Status{
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -58,6 +59,15 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+
+	// the num of decisions kept in the decision log
+	DecisionLogCapacity int
+
+	// the fraction of the memory of a query node which can be used by the simulated loads
+	LoadSimulationMemoryWatermark float64
+
+	// the interval to compare the loaded segments with the ones reported by the query nodes, 0 disables it
+	DistributionObserveInterval time.Duration
 }
 
 var Params ParamTable
@@ -96,6 +106,10 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSLStr()
 		p.initMinioBucketName()
+
+		p.initDecisionLogCapacity()
+		p.initLoadSimulationMemoryWatermark()
+		p.initDistributionObserveInterval()
 	})
 }
 
@@ -247,3 +261,43 @@ func (p *ParamTable) initMinioBucketName() {
 	}
 	p.MinioBucketName = bucketName
 }
+
+func (p *ParamTable) initDecisionLogCapacity() {
+	capacity, err := p.LoadWithDefault("queryCoord.decisionLogCapacity", "1024")
+	if err != nil {
+		panic(err)
+	}
+	p.DecisionLogCapacity, err = strconv.Atoi(capacity)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initLoadSimulationMemoryWatermark() {
+	watermark, err := p.LoadWithDefault("queryCoord.loadSimulation.memoryWatermark", "0.9")
+	if err != nil {
+		panic(err)
+	}
+	p.LoadSimulationMemoryWatermark, err = strconv.ParseFloat(watermark, 64)
+	if err != nil {
+		panic(err)
+	}
+	if p.LoadSimulationMemoryWatermark <= 0 || p.LoadSimulationMemoryWatermark > 1 {
+		panic(fmt.Sprintf("invalid queryCoord.loadSimulation.memoryWatermark %v, should be in (0, 1]", p.LoadSimulationMemoryWatermark))
+	}
+}
+
+func (p *ParamTable) initDistributionObserveInterval() {
+	interval, err := p.LoadWithDefault("queryCoord.distributionObserver.interval", "10")
+	if err != nil {
+		panic(err)
+	}
+	seconds, err := strconv.Atoi(interval)
+	if err != nil {
+		panic(err)
+	}
+	if seconds < 0 {
+		panic(fmt.Sprintf("invalid queryCoord.distributionObserver.interval %d, should not be negative", seconds))
+	}
+	p.DistributionObserveInterval = time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+const (
+	proxyNotifyTimeout = 3 * time.Second
+	proxyNotifyBufSize = 1024
+)
+
+// proxyNotifier pushes the invalidations of the segment distributions cached by the proxies,
+// once the segments of a collection are loaded, released or balanced
+type proxyNotifier struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	listProxies    func() ([]*sessionutil.Session, error)
+	newProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
+	clients        map[int64]types.Proxy
+
+	collectionIDs chan UniqueID
+}
+
+func newProxyNotifier(ctx context.Context, listProxies func() ([]*sessionutil.Session, error),
+	newProxyClient func(sess *sessionutil.Session) (types.Proxy, error)) *proxyNotifier {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &proxyNotifier{
+		ctx:            ctx1,
+		cancel:         cancel,
+		listProxies:    listProxies,
+		newProxyClient: newProxyClient,
+		clients:        make(map[int64]types.Proxy),
+		collectionIDs:  make(chan UniqueID, proxyNotifyBufSize),
+	}
+}
+
+func (n *proxyNotifier) start() {
+	n.wg.Add(1)
+	go n.notifyLoop()
+}
+
+func (n *proxyNotifier) close() {
+	n.cancel()
+	n.wg.Wait()
+}
+
+// invalidate queues the invalidation of a collection, 0 invalidates all the collections
+func (n *proxyNotifier) invalidate(collectionID UniqueID) {
+	select {
+	case n.collectionIDs <- collectionID:
+	default:
+		log.Warn("proxy notifier is full, drop the invalidation of segment distribution", zap.Int64("collectionID", collectionID))
+	}
+}
+
+func (n *proxyNotifier) notifyLoop() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case collectionID := <-n.collectionIDs:
+			n.notify(collectionID)
+		}
+	}
+}
+
+// notify sends the invalidation to all the proxies alive
+func (n *proxyNotifier) notify(collectionID UniqueID) {
+	sessions, err := n.listProxies()
+	if err != nil {
+		log.Warn("list proxies failed", zap.Error(err))
+		return
+	}
+	alive := make(map[int64]struct{}, len(sessions))
+	for _, sess := range sessions {
+		alive[sess.ServerID] = struct{}{}
+		if _, ok := n.clients[sess.ServerID]; ok {
+			continue
+		}
+		client, err := n.newProxyClient(sess)
+		if err != nil {
+			log.Warn("create proxy client failed", zap.String("proxy address", sess.Address), zap.Int64("proxy id", sess.ServerID), zap.Error(err))
+			continue
+		}
+		n.clients[sess.ServerID] = client
+	}
+	for id := range n.clients {
+		if _, ok := alive[id]; !ok {
+			delete(n.clients, id)
+		}
+	}
+
+	for id, client := range n.clients {
+		if err := n.notifyProxy(client, collectionID); err != nil {
+			log.Warn("invalidate segment distribution of proxy failed", zap.Int64("proxy id", id), zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+}
+
+func (n *proxyNotifier) notifyProxy(client types.Proxy, collectionID UniqueID) error {
+	ctx, cancel := context.WithTimeout(n.ctx, proxyNotifyTimeout)
+	defer cancel()
+	status, err := client.InvalidateSegmentDistribution(ctx, &proxypb.InvalidateSegmentDistributionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SegmentInfo,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return fmt.Errorf("message = %s", status.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+type mockNotifiedProxy struct {
+	types.Proxy
+	mu            sync.Mutex
+	collectionIDs []UniqueID
+	fail          bool
+}
+
+func (p *mockNotifiedProxy) InvalidateSegmentDistribution(ctx context.Context, req *proxypb.InvalidateSegmentDistributionRequest) (*commonpb.Status, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.collectionIDs = append(p.collectionIDs, req.CollectionID)
+	if p.fail {
+		return nil, errors.New("proxy is down")
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (p *mockNotifiedProxy) notified() []UniqueID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]UniqueID(nil), p.collectionIDs...)
+}
+
+func TestProxyNotifier(t *testing.T) {
+	proxies := map[int64]*mockNotifiedProxy{
+		1: {},
+		2: {fail: true},
+	}
+	var mu sync.Mutex
+	sessions := []*sessionutil.Session{{ServerID: 1}, {ServerID: 2}, {ServerID: 3}}
+	listProxies := func() ([]*sessionutil.Session, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return sessions, nil
+	}
+	newProxyClient := func(sess *sessionutil.Session) (types.Proxy, error) {
+		if p, ok := proxies[sess.ServerID]; ok {
+			return p, nil
+		}
+		return nil, errors.New("proxy not found")
+	}
+
+	n := newProxyNotifier(context.Background(), listProxies, newProxyClient)
+	n.start()
+	defer n.close()
+
+	n.invalidate(100)
+	n.invalidate(0)
+	assert.Eventually(t, func() bool {
+		return len(proxies[1].notified()) == 2 && len(proxies[2].notified()) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []UniqueID{100, 0}, proxies[1].notified())
+
+	// the proxies gone are not notified any more
+	mu.Lock()
+	sessions = []*sessionutil.Session{{ServerID: 2}}
+	mu.Unlock()
+	n.invalidate(101)
+	assert.Eventually(t, func() bool {
+		return len(proxies[2].notified()) == 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, len(proxies[1].notified()))
+}
@@ -13,6 +13,7 @@ package querycoord
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"path/filepath"
 	"strconv"
@@ -28,11 +29,13 @@ import (
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/notify"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -40,6 +43,8 @@ import (
 
 type Timestamp = typeutil.Timestamp
 
+const metricsUpdateInterval = 15 * time.Second
+
 type queryChannelInfo struct {
 	requestChannel  string
 	responseChannel string
@@ -58,10 +63,16 @@ type QueryCoord struct {
 	scheduler    *TaskScheduler
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
+	warmupJobs          *warmupJobs
 
 	dataCoordClient types.DataCoord
 	rootCoordClient types.RootCoord
 
+	newProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
+	proxyNotifier  *proxyNotifier
+	// reloads the missing segments and releases the orphans reported by the query nodes, nil if not enabled
+	distributionObserver *distributionObserver
+
 	session   *sessionutil.Session
 	eventChan <-chan *sessionutil.SessionEvent
 
@@ -70,6 +81,8 @@ type QueryCoord struct {
 	enableGrpc bool
 
 	msFactory msgstream.Factory
+	// sends the down querynodes to the configured webhooks, nil if not enabled
+	notifier *notify.Notifier
 }
 
 // Register register query service at etcd
@@ -115,7 +128,24 @@ func (qc *QueryCoord) Init() error {
 		return err
 	}
 
+	if qc.newProxyClient != nil {
+		qc.proxyNotifier = newProxyNotifier(qc.loopCtx, qc.listProxies, qc.newProxyClient)
+		qc.scheduler.proxyNotifier = qc.proxyNotifier
+	}
+
+	if Params.DistributionObserveInterval > 0 {
+		qc.distributionObserver = newDistributionObserver(qc.loopCtx, Params.DistributionObserveInterval,
+			qc.meta, qc.cluster, qc.scheduler, qc.dataCoordClient)
+	}
+
 	qc.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
+	qc.warmupJobs = newWarmupJobs(qc.loopCtx, qc.cluster.warmupSegment)
+
+	// the params of the msg stream factory are not set in QueryCoord, the events are only sent to the webhooks
+	qc.notifier, err = notify.NewNotifierFromConfig(qc.loopCtx, typeutil.QueryCoordRole, notify.LoadConfig(&Params.BaseTable), nil)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -123,6 +153,15 @@ func (qc *QueryCoord) Init() error {
 func (qc *QueryCoord) Start() error {
 	qc.scheduler.Start()
 	log.Debug("start scheduler ...")
+	if qc.proxyNotifier != nil {
+		qc.proxyNotifier.start()
+		log.Debug("start proxy notifier ...")
+	}
+	if qc.distributionObserver != nil {
+		qc.distributionObserver.start()
+		log.Debug("start distribution observer ...")
+	}
+	qc.notifier.Start()
 	qc.UpdateStateCode(internalpb.StateCode_Healthy)
 
 	qc.loopWg.Add(1)
@@ -131,16 +170,27 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.watchMetaLoop()
 
+	qc.loopWg.Add(1)
+	go qc.updateMetricsLoop()
+
 	return nil
 }
 
 func (qc *QueryCoord) Stop() error {
+	// closed before the scheduler, the tasks enqueued by the observer are waited for
+	if qc.distributionObserver != nil {
+		qc.distributionObserver.close()
+	}
 	qc.scheduler.Close()
 	log.Debug("close scheduler ...")
+	if qc.proxyNotifier != nil {
+		qc.proxyNotifier.close()
+	}
 	qc.loopCancel()
 	qc.UpdateStateCode(internalpb.StateCode_Abnormal)
 
 	qc.loopWg.Wait()
+	qc.notifier.Stop()
 	return nil
 }
 
@@ -183,6 +233,24 @@ func (qc *QueryCoord) SetDataCoord(dataCoord types.DataCoord) {
 	qc.dataCoordClient = dataCoord
 }
 
+// SetNewProxyClient sets the func to create the clients of the proxies, which are notified to invalidate
+// their cached segment distributions
+func (qc *QueryCoord) SetNewProxyClient(f func(sess *sessionutil.Session) (types.Proxy, error)) {
+	qc.newProxyClient = f
+}
+
+func (qc *QueryCoord) listProxies() ([]*sessionutil.Session, error) {
+	sessions, _, err := qc.session.GetSessions(typeutil.ProxyRole)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*sessionutil.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		ret = append(ret, sess)
+	}
+	return ret, nil
+}
+
 func (qc *QueryCoord) watchNodeLoop() {
 	ctx, cancel := context.WithCancel(qc.loopCtx)
 	defer cancel()
@@ -208,6 +276,13 @@ func (qc *QueryCoord) watchNodeLoop() {
 	for nodeID := range qc.cluster.nodes {
 		if _, ok := sessionMap[nodeID]; !ok {
 			qc.cluster.stopNode(nodeID)
+			qc.cluster.decisionLog.Record(decisionTypeBalance,
+				fmt.Sprintf("move the segments and channels off query node %d", nodeID),
+				map[string]interface{}{
+					"node_id": nodeID,
+					"trigger": querypb.TriggerCondition_nodeDown.String(),
+					"reason":  "session of the node not found when query coord starts",
+				})
 			loadBalanceSegment := &querypb.LoadBalanceRequest{
 				Base: &commonpb.MsgBase{
 					MsgType:  commonpb.MsgType_LoadBalanceSegments,
@@ -257,6 +332,18 @@ func (qc *QueryCoord) watchNodeLoop() {
 				}
 
 				qc.cluster.stopNode(serverID)
+				qc.notifier.Notify(notify.NodeDown, map[string]interface{}{
+					"role":      typeutil.QueryNodeRole,
+					"server_id": serverID,
+					"address":   event.Session.Address,
+				})
+				qc.cluster.decisionLog.Record(decisionTypeBalance,
+					fmt.Sprintf("move the segments and channels off query node %d", serverID),
+					map[string]interface{}{
+						"node_id": serverID,
+						"trigger": querypb.TriggerCondition_nodeDown.String(),
+						"reason":  "session of the node deleted",
+					})
 				loadBalanceSegment := &querypb.LoadBalanceRequest{
 					Base: &commonpb.MsgBase{
 						MsgType:  commonpb.MsgType_LoadBalanceSegments,
@@ -285,6 +372,37 @@ func (qc *QueryCoord) watchNodeLoop() {
 	}
 }
 
+// updateMetricsLoop updates the metrics of query nodes and tasks periodically
+func (qc *QueryCoord) updateMetricsLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+
+	defer cancel()
+	defer qc.loopWg.Done()
+	ticker := time.NewTicker(metricsUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.updateMetrics()
+		}
+	}
+}
+
+func (qc *QueryCoord) updateMetrics() {
+	// reset to remove the offline nodes and the finished task types
+	metrics.QueryCoordLoadedSegmentNum.Reset()
+	for nodeID, loads := range qc.cluster.getNodeLoads() {
+		metrics.QueryCoordLoadedSegmentNum.WithLabelValues(strconv.FormatInt(nodeID, 10)).Set(float64(loads["segments"]))
+	}
+	metrics.QueryCoordTriggerTaskNum.Reset()
+	for msgType, num := range qc.scheduler.triggerTaskQueue.taskNums() {
+		metrics.QueryCoordTriggerTaskNum.WithLabelValues(msgType.String()).Set(float64(num))
+	}
+}
+
 func (qc *QueryCoord) watchMetaLoop() {
 	ctx, cancel := context.WithCancel(qc.loopCtx)
 
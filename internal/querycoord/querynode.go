@@ -59,6 +59,7 @@ type Node interface {
 	getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, in *querypb.ReleaseSegmentsRequest) error
+	refreshIndex(ctx context.Context, in *querypb.RefreshIndexRequest) error
 	getComponentInfo(ctx context.Context) *internalpb.ComponentInfo
 
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -526,10 +527,11 @@ func (qn *queryNode) getComponentInfo(ctx context.Context) *internalpb.Component
 
 func (qn *queryNode) getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	qn.serviceLock.RLock()
-	if !qn.onService {
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
 		return nil, errQueryNodeIsNotOnService(qn.id)
 	}
-	qn.serviceLock.RUnlock()
 
 	return qn.client.GetMetrics(ctx, in)
 }
@@ -582,6 +584,25 @@ func (qn *queryNode) releaseSegments(ctx context.Context, in *querypb.ReleaseSeg
 	return nil
 }
 
+func (qn *queryNode) refreshIndex(ctx context.Context, in *querypb.RefreshIndexRequest) error {
+	qn.serviceLock.RLock()
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
+		return errors.New("RefreshIndex: queryNode is offline")
+	}
+
+	status, err := qn.client.RefreshIndex(ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+
+	return nil
+}
+
 //****************************************************//
 
 func saveNodeCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, nodeID int64, kv *etcdkv.EtcdKV) error {
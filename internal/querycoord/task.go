@@ -37,6 +37,10 @@ const (
 	activeTaskPrefix      = "queryCoord-activeTask"
 	taskInfoPrefix        = "queryCoord-taskInfo"
 	loadBalanceInfoPrefix = "queryCoord-loadBalanceInfo"
+	// taskParentPrefix keeps the parent trigger task of each active task
+	taskParentPrefix = "queryCoord-taskParent"
+	// taskCheckpointPrefix keeps the steps of the trigger tasks decided before the meta is changed
+	taskCheckpointPrefix = "queryCoord-taskCheckpoint"
 )
 
 type taskState int
@@ -62,6 +66,7 @@ type task interface {
 	Notify(err error)
 	TaskPriority() querypb.TriggerCondition
 	GetParentTask() task
+	SetParentTask(t task)
 	GetChildTask() []task
 	AddChildTask(t task)
 	IsValid() bool
@@ -71,6 +76,24 @@ type task interface {
 	SetState(state taskState)
 }
 
+// loadCheckpoint is the steps of a load task, which are decided from the meta before the task changes it. A load
+// task restored from etcd in the middle of its execution resumes with the same steps instead of deciding them again
+// from the meta changed by itself
+type loadCheckpoint struct {
+	PartitionIDs []UniqueID `json:"partition_ids"`
+	// AddCollection is set if the collection is added to the meta by the task
+	AddCollection bool `json:"add_collection"`
+}
+
+// checkpointTask is a trigger task saving its steps, see loadCheckpoint
+type checkpointTask interface {
+	task
+	// plan decides the steps of the task if they're not restored
+	plan(ctx context.Context) error
+	getCheckpoint() *loadCheckpoint
+	setCheckpoint(cp *loadCheckpoint)
+}
+
 type BaseTask struct {
 	Condition
 	ctx    context.Context
@@ -104,6 +127,10 @@ func (bt *BaseTask) GetParentTask() task {
 	return bt.parentTask
 }
 
+func (bt *BaseTask) SetParentTask(t task) {
+	bt.parentTask = t
+}
+
 func (bt *BaseTask) GetChildTask() []task {
 	return bt.childTasks
 }
@@ -132,10 +159,11 @@ func (bt *BaseTask) SetState(state taskState) {
 type LoadCollectionTask struct {
 	BaseTask
 	*querypb.LoadCollectionRequest
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      Meta
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	cluster    *queryNodeCluster
+	meta       Meta
+	checkpoint *loadCheckpoint
 }
 
 func (lct *LoadCollectionTask) MsgBase() *commonpb.MsgBase {
@@ -168,7 +196,19 @@ func (lct *LoadCollectionTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
-func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
+func (lct *LoadCollectionTask) getCheckpoint() *loadCheckpoint {
+	return lct.checkpoint
+}
+
+func (lct *LoadCollectionTask) setCheckpoint(cp *loadCheckpoint) {
+	lct.checkpoint = cp
+}
+
+// plan decides the partitions to load, which are all the partitions of a new collection, or the ones not loaded yet
+func (lct *LoadCollectionTask) plan(ctx context.Context) error {
+	if lct.checkpoint != nil {
+		return nil
+	}
 	collectionID := lct.CollectionID
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -191,9 +231,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	partitionIDs := showPartitionResponse.PartitionIDs
 	toLoadPartitionIDs := make([]UniqueID, 0)
 	hasCollection := lct.meta.hasCollection(collectionID)
-	watchPartition := false
 	if hasCollection {
-		watchPartition = true
 		loadType, _ := lct.meta.getLoadType(collectionID)
 		if loadType == querypb.LoadType_loadCollection {
 			for _, partitionID := range partitionIDs {
@@ -213,6 +251,24 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	} else {
 		toLoadPartitionIDs = partitionIDs
 	}
+	lct.checkpoint = &loadCheckpoint{
+		PartitionIDs:  toLoadPartitionIDs,
+		AddCollection: !hasCollection,
+	}
+	return nil
+}
+
+func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
+	collectionID := lct.CollectionID
+	if err := lct.plan(ctx); err != nil {
+		return err
+	}
+	toLoadPartitionIDs := lct.checkpoint.PartitionIDs
+	// the channels of a collection loaded before are watched per partition
+	watchPartition := !lct.checkpoint.AddCollection
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
 
 	log.Debug("loadCollectionTask: toLoadPartitionIDs", zap.Int64s("partitionIDs", toLoadPartitionIDs))
 	lct.meta.addCollection(collectionID, lct.Schema)
@@ -241,11 +297,13 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 		for _, segmentBingLog := range recoveryInfo.Binlogs {
 			segmentID := segmentBingLog.SegmentID
 			segmentLoadInfo := &querypb.SegmentLoadInfo{
-				SegmentID:    segmentID,
-				PartitionID:  partitionID,
-				CollectionID: collectionID,
-				BinlogPaths:  segmentBingLog.FieldBinlogs,
-				NumOfRows:    segmentBingLog.NumOfRows,
+				SegmentID:      segmentID,
+				PartitionID:    partitionID,
+				CollectionID:   collectionID,
+				BinlogPaths:    segmentBingLog.FieldBinlogs,
+				NumOfRows:      segmentBingLog.NumOfRows,
+				ClusteringInfo: segmentBingLog.ClusteringInfo,
+				Deltalogs:      segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
@@ -351,8 +409,11 @@ func (lct *LoadCollectionTask) PostExecute(ctx context.Context) error {
 			lct.AddChildTask(releaseCollectionTask)
 			log.Debug("loadCollectionTask: add a releaseCollectionTask to loadCollectionTask's childTask", zap.Any("task", releaseCollectionTask))
 		}
+		// rolled back, the collection is released by the query nodes
+		lct.meta.releaseCollection(collectionID)
+	} else {
+		lct.meta.addCollection(collectionID, lct.Schema)
 	}
-	lct.meta.addCollection(collectionID, lct.Schema)
 	log.Debug("LoadCollectionTask postExecute done",
 		zap.Int64("msgID", lct.ID()),
 		zap.Int64("collectionID", collectionID))
@@ -476,10 +537,10 @@ func (rct *ReleaseCollectionTask) PostExecute(context.Context) error {
 type LoadPartitionTask struct {
 	BaseTask
 	*querypb.LoadPartitionsRequest
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      Meta
-	addCol    bool
+	dataCoord  types.DataCoord
+	cluster    *queryNodeCluster
+	meta       Meta
+	checkpoint *loadCheckpoint
 }
 
 func (lpt *LoadPartitionTask) MsgBase() *commonpb.MsgBase {
@@ -510,13 +571,32 @@ func (lpt *LoadPartitionTask) PreExecute(context.Context) error {
 	return nil
 }
 
+func (lpt *LoadPartitionTask) getCheckpoint() *loadCheckpoint {
+	return lpt.checkpoint
+}
+
+func (lpt *LoadPartitionTask) setCheckpoint(cp *loadCheckpoint) {
+	lpt.checkpoint = cp
+}
+
+// plan decides whether the collection is added by the task, the partitions to load are the ones requested
+func (lpt *LoadPartitionTask) plan(context.Context) error {
+	if lpt.checkpoint == nil {
+		lpt.checkpoint = &loadCheckpoint{
+			PartitionIDs:  lpt.PartitionIDs,
+			AddCollection: !lpt.meta.hasCollection(lpt.CollectionID),
+		}
+	}
+	return nil
+}
+
 func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	collectionID := lpt.CollectionID
 	partitionIDs := lpt.PartitionIDs
 
-	if !lpt.meta.hasCollection(collectionID) {
+	lpt.plan(ctx)
+	if lpt.checkpoint.AddCollection {
 		lpt.meta.addCollection(collectionID, lpt.Schema)
-		lpt.addCol = true
 	}
 	for _, id := range partitionIDs {
 		lpt.meta.addPartition(collectionID, id)
@@ -545,11 +625,13 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 		for _, segmentBingLog := range recoveryInfo.Binlogs {
 			segmentID := segmentBingLog.SegmentID
 			segmentLoadInfo := &querypb.SegmentLoadInfo{
-				SegmentID:    segmentID,
-				PartitionID:  partitionID,
-				CollectionID: collectionID,
-				BinlogPaths:  segmentBingLog.FieldBinlogs,
-				NumOfRows:    segmentBingLog.NumOfRows,
+				SegmentID:      segmentID,
+				PartitionID:    partitionID,
+				CollectionID:   collectionID,
+				BinlogPaths:    segmentBingLog.FieldBinlogs,
+				NumOfRows:      segmentBingLog.NumOfRows,
+				ClusteringInfo: segmentBingLog.ClusteringInfo,
+				Deltalogs:      segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lpt.Base).(*commonpb.MsgBase)
@@ -605,7 +687,7 @@ func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 	}
 	if lpt.result.ErrorCode != commonpb.ErrorCode_Success {
 		lpt.childTasks = make([]task, 0)
-		if lpt.addCol {
+		if lpt.checkpoint != nil && lpt.checkpoint.AddCollection {
 			nodes, err := lpt.cluster.onServiceNodes()
 			if err != nil {
 				log.Debug(err.Error())
@@ -634,6 +716,8 @@ func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 				lpt.AddChildTask(releaseCollectionTask)
 				log.Debug("loadPartitionTask: add a releaseCollectionTask to loadPartitionTask's childTask", zap.Any("task", releaseCollectionTask))
 			}
+			// rolled back, the collection is released by the query nodes
+			lpt.meta.releaseCollection(collectionID)
 		} else {
 			nodes, err := lpt.cluster.onServiceNodes()
 			if err != nil {
@@ -666,6 +750,9 @@ func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 				lpt.AddChildTask(releasePartitionTask)
 				log.Debug("loadPartitionTask: add a releasePartitionTask to loadPartitionTask's childTask", zap.Any("task", releasePartitionTask))
 			}
+			for _, id := range partitionIDs {
+				lpt.meta.releasePartition(collectionID, id)
+			}
 		}
 	}
 	log.Debug("LoadPartitionTask postExecute done",
@@ -845,7 +932,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 	for _, info := range lst.Infos {
 		segmentIDs = append(segmentIDs, info.SegmentID)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster)
+	segment2Nodes := shuffleSegmentsToQueryNode(lst.ctx, segmentIDs, lst.cluster)
 	node2segmentInfos := make(map[int64][]*querypb.SegmentLoadInfo)
 	for index, info := range lst.Infos {
 		nodeID := segment2Nodes[index]
@@ -1283,11 +1370,13 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 					for _, segmentBingLog := range recoveryInfo.Binlogs {
 						segmentID := segmentBingLog.SegmentID
 						segmentLoadInfo := &querypb.SegmentLoadInfo{
-							SegmentID:    segmentID,
-							PartitionID:  partitionID,
-							CollectionID: collectionID,
-							BinlogPaths:  segmentBingLog.FieldBinlogs,
-							NumOfRows:    segmentBingLog.NumOfRows,
+							SegmentID:      segmentID,
+							PartitionID:    partitionID,
+							CollectionID:   collectionID,
+							BinlogPaths:    segmentBingLog.FieldBinlogs,
+							NumOfRows:      segmentBingLog.NumOfRows,
+							ClusteringInfo: segmentBingLog.ClusteringInfo,
+							Deltalogs:      segmentBingLog.Deltalogs,
 						}
 
 						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
@@ -1435,8 +1524,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 }
 
-func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster) []int64 {
-	maxNumSegments := 0
+func shuffleSegmentsToQueryNode(ctx context.Context, segmentIDs []UniqueID, cluster *queryNodeCluster) []int64 {
 	nodes := make(map[int64]Node)
 	var err error
 	for {
@@ -1448,15 +1536,26 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 		}
 		break
 	}
+	nodes = excludeEvictableNodes(nodes, getMemoryStates(ctx, nodes))
+	numSegments := make(map[int64]int, len(nodes))
 	for nodeID := range nodes {
-		numSegments, _ := cluster.getNumSegments(nodeID)
-		if numSegments > maxNumSegments {
-			maxNumSegments = numSegments
+		numSegments[nodeID], _ = cluster.getNumSegments(nodeID)
+	}
+	return assignSegmentsByNum(len(segmentIDs), numSegments)
+}
+
+// assignSegmentsByNum returns the nodes of numToAssign segments, the nodes with fewer segments than the
+// most loaded one are filled first, then the segments are spread over all the nodes
+func assignSegmentsByNum(numToAssign int, numSegments map[int64]int) []int64 {
+	maxNumSegments := 0
+	for _, num := range numSegments {
+		if num > maxNumSegments {
+			maxNumSegments = num
 		}
 	}
 	res := make([]int64, 0)
 
-	if len(segmentIDs) == 0 {
+	if numToAssign == 0 || len(numSegments) == 0 {
 		return res
 	}
 
@@ -1465,22 +1564,21 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 	for {
 		lastOffset := offset
 		if !loopAll {
-			for nodeID := range nodes {
-				numSegments, _ := cluster.getNumSegments(nodeID)
-				if numSegments >= maxNumSegments {
+			for nodeID, num := range numSegments {
+				if num >= maxNumSegments {
 					continue
 				}
 				res = append(res, nodeID)
 				offset++
-				if offset == len(segmentIDs) {
+				if offset == numToAssign {
 					return res
 				}
 			}
 		} else {
-			for nodeID := range nodes {
+			for nodeID := range numSegments {
 				res = append(res, nodeID)
 				offset++
-				if offset == len(segmentIDs) {
+				if offset == numToAssign {
 					return res
 				}
 			}
@@ -1540,8 +1638,20 @@ func assignInternalTask(ctx context.Context,
 	for _, req := range watchDmChannelRequests {
 		channelsToWatch = append(channelsToWatch, req.Infos[0].ChannelName)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentsToLoad, cluster)
+	nodeLoads := cluster.getNodeLoads()
+	segment2Nodes := shuffleSegmentsToQueryNode(ctx, segmentsToLoad, cluster)
 	watchRequest2Nodes := shuffleChannelsToQueryNode(channelsToWatch, cluster)
+	cluster.decisionLog.Record(decisionTypeAssign,
+		fmt.Sprintf("assign %d segments and %d dm channels of collection %d", len(segmentsToLoad), len(channelsToWatch), collectionID),
+		map[string]interface{}{
+			"collection_id": collectionID,
+			"trigger":       parentTask.TaskPriority().String(),
+			"node_loads":    nodeLoads,
+			"segment_ids":   segmentsToLoad,
+			"segment_nodes": segment2Nodes,
+			"dm_channels":   channelsToWatch,
+			"channel_nodes": watchRequest2Nodes,
+		})
 	log.Debug("assignInternalTask: segment to node", zap.Any("segments map", segment2Nodes), zap.Int64("collectionID", collectionID))
 	log.Debug("assignInternalTask: watch request to node", zap.Any("request map", watchRequest2Nodes), zap.Int64("collectionID", collectionID))
 
@@ -14,6 +14,7 @@ package querycoord
 import (
 	"container/list"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -110,6 +111,18 @@ func (queue *TaskQueue) PopTask() task {
 	return ft.Value.(task)
 }
 
+// taskNums returns the num of tasks of each type in the queue
+func (queue *TaskQueue) taskNums() map[commonpb.MsgType]int {
+	queue.Lock()
+	defer queue.Unlock()
+
+	ret := make(map[commonpb.MsgType]int)
+	for e := queue.tasks.Front(); e != nil; e = e.Next() {
+		ret[e.Value.(task).Type()]++
+	}
+	return ret
+}
+
 func NewTaskQueue() *TaskQueue {
 	return &TaskQueue{
 		tasks:    list.New(),
@@ -129,6 +142,9 @@ type TaskScheduler struct {
 	rootCoord types.RootCoord
 	dataCoord types.DataCoord
 
+	// proxyNotifier is nil if the proxies are not notified
+	proxyNotifier *proxyNotifier
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -181,6 +197,14 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 	if err != nil {
 		return err
 	}
+	parentKeys, parentValues, err := scheduler.client.LoadWithPrefix(taskParentPrefix)
+	if err != nil {
+		return err
+	}
+	checkpointKeys, checkpointValues, err := scheduler.client.LoadWithPrefix(taskCheckpointPrefix)
+	if err != nil {
+		return err
+	}
 
 	triggerTasks := make(map[int64]task)
 	for index := range triggerTaskIDKeys {
@@ -192,6 +216,7 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 		if err != nil {
 			return err
 		}
+		t.SetID(taskID)
 		triggerTasks[taskID] = t
 	}
 
@@ -205,9 +230,41 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 		if err != nil {
 			return err
 		}
+		t.SetID(taskID)
 		activeTasks[taskID] = t
 	}
 
+	parents := make(map[int64]int64)
+	for index := range parentKeys {
+		taskID, err := strconv.ParseInt(filepath.Base(parentKeys[index]), 10, 64)
+		if err != nil {
+			return err
+		}
+		parentID, err := strconv.ParseInt(parentValues[index], 10, 64)
+		if err != nil {
+			return err
+		}
+		parents[taskID] = parentID
+	}
+
+	for index := range checkpointKeys {
+		taskID, err := strconv.ParseInt(filepath.Base(checkpointKeys[index]), 10, 64)
+		if err != nil {
+			return err
+		}
+		t, ok := triggerTasks[taskID].(checkpointTask)
+		if !ok {
+			log.Warn("reloadFromKV: remove the checkpoint of an unknown trigger task", zap.Int64("taskID", taskID))
+			scheduler.removeTaskKeys([]string{fmt.Sprintf("%s/%d", taskCheckpointPrefix, taskID)})
+			continue
+		}
+		cp := &loadCheckpoint{}
+		if err := json.Unmarshal([]byte(checkpointValues[index]), cp); err != nil {
+			return err
+		}
+		t.setCheckpoint(cp)
+	}
+
 	taskInfos := make(map[int64]taskState)
 	for index := range taskInfoKeys {
 		taskID, err := strconv.ParseInt(filepath.Base(taskInfoKeys[index]), 10, 64)
@@ -220,18 +277,44 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 		}
 		state := taskState(value)
 		taskInfos[taskID] = state
-		if _, ok := triggerTasks[taskID]; !ok {
-			log.Error("reloadFromKV: taskStateInfo and triggerTaskInfo are inconsistent")
+		if t, ok := triggerTasks[taskID]; ok {
+			t.SetState(state)
 			continue
 		}
-		triggerTasks[taskID].SetState(state)
+		if t, ok := activeTasks[taskID]; ok {
+			t.SetState(state)
+			continue
+		}
+		log.Error("reloadFromKV: taskStateInfo and triggerTaskInfo are inconsistent", zap.Int64("taskID", taskID))
 	}
 
+	// the active tasks of a trigger task are all saved before it's done, so the active tasks of the undone trigger
+	// tasks are discarded and generated again when the trigger tasks resume, and the ones of the removed trigger tasks
+	// are orphaned. The active tasks saved without the parent are attached to the done trigger task as before
 	var doneTriggerTask task = nil
+	unlinkedTasks := make([]task, 0)
+	for id, childTask := range activeTasks {
+		parentID, ok := parents[id]
+		if !ok {
+			unlinkedTasks = append(unlinkedTasks, childTask)
+			continue
+		}
+		parentTask, ok := triggerTasks[parentID]
+		if !ok || taskInfos[parentID] != taskDone {
+			log.Warn("reloadFromKV: remove the active task of an undone trigger task",
+				zap.Int64("taskID", id),
+				zap.Int64("parent taskID", parentID))
+			scheduler.removeTaskKeys(activeTaskKeys(id))
+			continue
+		}
+		childTask.SetParentTask(parentTask)
+		parentTask.AddChildTask(childTask)
+	}
 	for id, t := range triggerTasks {
 		if taskInfos[id] == taskDone {
 			doneTriggerTask = t
-			for _, childTask := range activeTasks {
+			for _, childTask := range unlinkedTasks {
+				childTask.SetParentTask(t)
 				t.AddChildTask(childTask)
 			}
 			continue
@@ -246,6 +329,23 @@ func (scheduler *TaskScheduler) reloadFromKV() error {
 	return nil
 }
 
+// removeTaskKeys removes the keys of the tasks which are not restored from etcd
+func (scheduler *TaskScheduler) removeTaskKeys(keys []string) {
+	err := scheduler.client.MultiRemove(keys)
+	if err != nil {
+		log.Error("reloadFromKV: error when remove task from etcd", zap.Strings("keys", keys), zap.Error(err))
+	}
+}
+
+// activeTaskKeys returns the keys of an active task saved in etcd
+func activeTaskKeys(taskID UniqueID) []string {
+	return []string{
+		fmt.Sprintf("%s/%d", activeTaskPrefix, taskID),
+		fmt.Sprintf("%s/%d", taskInfoPrefix, taskID),
+		fmt.Sprintf("%s/%d", taskParentPrefix, taskID),
+	}
+}
+
 func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 	header := commonpb.MsgHeader{}
 	err := proto.Unmarshal([]byte(t), &header)
@@ -457,7 +557,26 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 	t.PreExecute(ctx)
 
 	key := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
-	err := scheduler.client.Save(key, strconv.Itoa(int(taskDoing)))
+	kvs := map[string]string{
+		key: strconv.Itoa(int(taskDoing)),
+	}
+	// the steps are saved with the state, a trigger task resumed from etcd executes with the same steps
+	if cpt, ok := t.(checkpointTask); ok && cpt.getCheckpoint() == nil {
+		err := cpt.plan(ctx)
+		if err != nil {
+			log.Debug("processTask: plan task err", zap.String("reason", err.Error()), zap.Int64("taskID", t.ID()))
+			trace.LogError(span, err)
+			return err
+		}
+		blobs, err := json.Marshal(cpt.getCheckpoint())
+		if err != nil {
+			log.Error("processTask: marshal task checkpoint err", zap.String("reason", err.Error()), zap.Int64("taskID", t.ID()))
+			trace.LogError(span, err)
+			return err
+		}
+		kvs[fmt.Sprintf("%s/%d", taskCheckpointPrefix, t.ID())] = string(blobs)
+	}
+	err := scheduler.client.MultiSave(kvs)
 	if err != nil {
 		log.Error("processTask: update task state err", zap.String("reason", err.Error()), zap.Int64("taskID", t.ID()))
 		trace.LogError(span, err)
@@ -484,6 +603,7 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 			return err
 		}
 		childTask.SetID(id)
+		childTask.SetParentTask(t)
 		kvs := make(map[string]string)
 		taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, childTask.ID())
 		blobs, err := childTask.Marshal()
@@ -495,6 +615,8 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 		kvs[taskKey] = string(blobs)
 		stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, childTask.ID())
 		kvs[stateKey] = strconv.Itoa(int(taskUndo))
+		parentKey := fmt.Sprintf("%s/%d", taskParentPrefix, childTask.ID())
+		kvs[parentKey] = strconv.FormatInt(t.ID(), 10)
 		err = scheduler.client.MultiSave(kvs)
 		if err != nil {
 			log.Error("processTask: save active task info err", zap.String("reason", err.Error()))
@@ -558,8 +680,10 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 			keys := make([]string, 0)
 			taskKey := fmt.Sprintf("%s/%d", triggerTaskPrefix, t.ID())
 			stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
+			checkpointKey := fmt.Sprintf("%s/%d", taskCheckpointPrefix, t.ID())
 			keys = append(keys, taskKey)
 			keys = append(keys, stateKey)
+			keys = append(keys, checkpointKey)
 			err = scheduler.client.MultiRemove(keys)
 			if err != nil {
 				log.Error("scheduleLoop: error when remove trigger task to etcd", zap.Int64("taskID", t.ID()))
@@ -567,11 +691,38 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 				continue
 			}
 			log.Debug("scheduleLoop: trigger task done and delete from etcd", zap.Int64("taskID", t.ID()))
+			scheduler.invalidateSegmentDistribution(t)
 			t.Notify(err)
 		}
 	}
 }
 
+// invalidateSegmentDistribution notifies the proxies that the segments of the collection of a trigger task
+// may be moved, the load balance tasks invalidate all the collections
+func (scheduler *TaskScheduler) invalidateSegmentDistribution(t task) {
+	if scheduler.proxyNotifier == nil {
+		return
+	}
+	switch t := t.(type) {
+	case *LoadCollectionTask:
+		scheduler.proxyNotifier.invalidate(t.CollectionID)
+	case *LoadPartitionTask:
+		scheduler.proxyNotifier.invalidate(t.CollectionID)
+	case *ReleaseCollectionTask:
+		scheduler.proxyNotifier.invalidate(t.CollectionID)
+	case *ReleasePartitionTask:
+		scheduler.proxyNotifier.invalidate(t.CollectionID)
+	case *LoadSegmentTask:
+		if len(t.Infos) > 0 {
+			scheduler.proxyNotifier.invalidate(t.Infos[0].CollectionID)
+		}
+	case *ReleaseSegmentTask:
+		scheduler.proxyNotifier.invalidate(t.CollectionID)
+	case *LoadBalanceTask:
+		scheduler.proxyNotifier.invalidate(0)
+	}
+}
+
 func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task) {
 	defer wg.Done()
 	err := t.WaitToFinish()
@@ -584,11 +735,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 					log.Error(err.Error())
 					return
 				}
-				removes := make([]string, 0)
-				taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, t.ID())
-				removes = append(removes, taskKey)
-				stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
-				removes = append(removes, stateKey)
+				removes := activeTaskKeys(t.ID())
 
 				saves := make(map[string]string)
 				reSchedID := make([]int64, 0)
@@ -600,6 +747,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 							continue
 						}
 						rt.SetID(id)
+						rt.SetParentTask(t.GetParentTask())
 						taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, rt.ID())
 						blobs, err := rt.Marshal()
 						if err != nil {
@@ -610,6 +758,10 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 						saves[taskKey] = string(blobs)
 						stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, rt.ID())
 						saves[stateKey] = strconv.Itoa(int(taskUndo))
+						if parentTask := t.GetParentTask(); parentTask != nil {
+							parentKey := fmt.Sprintf("%s/%d", taskParentPrefix, rt.ID())
+							saves[parentKey] = strconv.FormatInt(parentTask.ID(), 10)
+						}
 						reSchedID = append(reSchedID, rt.ID())
 					}
 				}
@@ -644,12 +796,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 				wg.Add(1)
 				go scheduler.waitActivateTaskDone(wg, t)
 			} else {
-				removes := make([]string, 0)
-				taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, t.ID())
-				removes = append(removes, taskKey)
-				stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
-				removes = append(removes, stateKey)
-				err = scheduler.client.MultiRemove(removes)
+				err = scheduler.client.MultiRemove(activeTaskKeys(t.ID()))
 				if err != nil {
 					log.Error("waitActivateTaskDone: error when remove task from etcd", zap.Int64("taskID", t.ID()))
 				}
@@ -673,12 +820,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 			//TODO:: case commonpb.MsgType_RemoveDmChannels:
 		}
 	} else {
-		keys := make([]string, 0)
-		taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, t.ID())
-		stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
-		keys = append(keys, taskKey)
-		keys = append(keys, stateKey)
-		err = scheduler.client.MultiRemove(keys)
+		err = scheduler.client.MultiRemove(activeTaskKeys(t.ID()))
 		if err != nil {
 			log.Error("waitActivateTaskDone: error when remove task from etcd", zap.Int64("taskID", t.ID()))
 		}
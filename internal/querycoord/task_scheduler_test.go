@@ -2,6 +2,7 @@ package querycoord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
@@ -398,3 +399,115 @@ func TestReloadTaskFromKV(t *testing.T) {
 	assert.Equal(t, taskDone, task.State())
 	assert.Equal(t, 1, len(task.GetChildTask()))
 }
+
+func TestReloadTaskFromKV_Resume(t *testing.T) {
+	refreshParams()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	taskScheduler := &TaskScheduler{
+		client:           kv,
+		triggerTaskQueue: NewTaskQueue(),
+	}
+
+	kvs := make(map[string]string)
+	triggerTask := &LoadPartitionTask{
+		LoadPartitionsRequest: &querypb.LoadPartitionsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: 1,
+				MsgType:   commonpb.MsgType_LoadPartitions,
+			},
+			CollectionID: defaultCollectionID,
+			PartitionIDs: []UniqueID{defaultPartitionID},
+		},
+	}
+	triggerBlobs, err := triggerTask.Marshal()
+	assert.Nil(t, err)
+	kvs[fmt.Sprintf("%s/%d", triggerTaskPrefix, 200)] = string(triggerBlobs)
+	kvs[fmt.Sprintf("%s/%d", taskInfoPrefix, 200)] = strconv.Itoa(int(taskDoing))
+	checkpoint, err := json.Marshal(&loadCheckpoint{
+		PartitionIDs:  []UniqueID{defaultPartitionID},
+		AddCollection: true,
+	})
+	assert.Nil(t, err)
+	kvs[fmt.Sprintf("%s/%d", taskCheckpointPrefix, 200)] = string(checkpoint)
+
+	activeTask := &LoadSegmentTask{
+		LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: 2,
+				MsgType:   commonpb.MsgType_LoadSegments,
+			},
+		},
+	}
+	activeBlobs, err := activeTask.Marshal()
+	assert.Nil(t, err)
+	// saved before the trigger task is done
+	kvs[fmt.Sprintf("%s/%d", activeTaskPrefix, 201)] = string(activeBlobs)
+	kvs[fmt.Sprintf("%s/%d", taskInfoPrefix, 201)] = strconv.Itoa(int(taskUndo))
+	kvs[fmt.Sprintf("%s/%d", taskParentPrefix, 201)] = "200"
+	// the trigger task is removed
+	kvs[fmt.Sprintf("%s/%d", activeTaskPrefix, 202)] = string(activeBlobs)
+	kvs[fmt.Sprintf("%s/%d", taskInfoPrefix, 202)] = strconv.Itoa(int(taskUndo))
+	kvs[fmt.Sprintf("%s/%d", taskParentPrefix, 202)] = "299"
+	err = kv.MultiSave(kvs)
+	assert.Nil(t, err)
+
+	err = taskScheduler.reloadFromKV()
+	assert.Nil(t, err)
+
+	var resumed *LoadPartitionTask
+	for !taskScheduler.triggerTaskQueue.taskEmpty() {
+		task := taskScheduler.triggerTaskQueue.PopTask()
+		if task.ID() == 200 {
+			resumed = task.(*LoadPartitionTask)
+		}
+	}
+	assert.NotNil(t, resumed)
+	assert.Equal(t, taskDoing, resumed.State())
+	assert.Equal(t, 0, len(resumed.GetChildTask()))
+	assert.True(t, resumed.getCheckpoint().AddCollection)
+	assert.Equal(t, []UniqueID{defaultPartitionID}, resumed.getCheckpoint().PartitionIDs)
+
+	for _, id := range []UniqueID{201, 202} {
+		for _, key := range activeTaskKeys(id) {
+			_, err = kv.Load(key)
+			assert.NotNil(t, err)
+		}
+	}
+
+	err = kv.MultiRemove([]string{
+		fmt.Sprintf("%s/%d", triggerTaskPrefix, 200),
+		fmt.Sprintf("%s/%d", taskInfoPrefix, 200),
+		fmt.Sprintf("%s/%d", taskCheckpointPrefix, 200),
+	})
+	assert.Nil(t, err)
+}
+
+func TestTaskQueue_TaskNums(t *testing.T) {
+	queue := NewTaskQueue()
+	loadCollectionTask := &LoadCollectionTask{
+		LoadCollectionRequest: &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+		},
+	}
+	loadBalanceTask := &LoadBalanceTask{
+		LoadBalanceRequest: &querypb.LoadBalanceRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadBalanceSegments,
+			},
+		},
+	}
+	queue.addTask([]task{loadCollectionTask, loadBalanceTask, loadBalanceTask})
+
+	nums := queue.taskNums()
+	assert.Equal(t, 2, len(nums))
+	assert.Equal(t, 1, nums[commonpb.MsgType_LoadCollection])
+	assert.Equal(t, 2, nums[commonpb.MsgType_LoadBalanceSegments])
+
+	queue.PopTask()
+	nums = queue.taskNums()
+	assert.Equal(t, 1, len(nums))
+	assert.Equal(t, 2, nums[commonpb.MsgType_LoadBalanceSegments])
+}
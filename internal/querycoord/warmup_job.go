@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// warmupSegmentFn warms up a sealed segment of the collection on the query node
+type warmupSegmentFn func(ctx context.Context, nodeID int64, collectionID UniqueID, segmentID UniqueID) error
+
+// warmupJob warms up the sealed segments of a collection on the query nodes holding them,
+// the segments of a node are warmed up one by one and the nodes in parallel
+type warmupJob struct {
+	mu       sync.Mutex
+	progress metricsinfo.WarmupProgress
+}
+
+func (job *warmupJob) getProgress() metricsinfo.WarmupProgress {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	return job.progress
+}
+
+func (job *warmupJob) done(err error) {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	if err != nil {
+		job.progress.Failed++
+		job.progress.Reason = err.Error()
+	} else {
+		job.progress.Warmed++
+	}
+	finished := job.progress.Warmed + job.progress.Failed
+	job.progress.Percentage = int64(finished * 100 / job.progress.Segments)
+	job.progress.Done = finished == job.progress.Segments
+}
+
+// warmupJobs are the warmup jobs of the collections, the progress of a collection is kept until it is released
+type warmupJobs struct {
+	ctx    context.Context
+	warmup warmupSegmentFn
+	mu     sync.Mutex
+	jobs   map[UniqueID]*warmupJob
+}
+
+func newWarmupJobs(ctx context.Context, warmup warmupSegmentFn) *warmupJobs {
+	return &warmupJobs{
+		ctx:    ctx,
+		warmup: warmup,
+		jobs:   make(map[UniqueID]*warmupJob),
+	}
+}
+
+// start warms up the segments of the collection grouped by the query nodes, the running job of the collection
+// is returned instead if there is one
+func (w *warmupJobs) start(collectionID UniqueID, nodeSegments map[int64][]UniqueID) metricsinfo.WarmupProgress {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if job, ok := w.jobs[collectionID]; ok && !job.getProgress().Done {
+		return job.getProgress()
+	}
+
+	job := &warmupJob{
+		progress: metricsinfo.WarmupProgress{
+			CollectionID: collectionID,
+		},
+	}
+	for _, segmentIDs := range nodeSegments {
+		job.progress.Segments += len(segmentIDs)
+	}
+	if job.progress.Segments == 0 {
+		job.progress.Percentage = 100
+		job.progress.Done = true
+	}
+	w.jobs[collectionID] = job
+
+	for nodeID, segmentIDs := range nodeSegments {
+		go func(nodeID int64, segmentIDs []UniqueID) {
+			for _, segmentID := range segmentIDs {
+				err := w.warmup(w.ctx, nodeID, collectionID, segmentID)
+				if err != nil {
+					log.Warn("warmup: query node failed to warm up segment",
+						zap.Int64("nodeID", nodeID),
+						zap.Int64("collectionID", collectionID),
+						zap.Int64("segmentID", segmentID),
+						zap.Error(err))
+				}
+				job.done(err)
+			}
+		}(nodeID, segmentIDs)
+	}
+	log.Debug("warmup: start warming up collection",
+		zap.Int64("collectionID", collectionID),
+		zap.Int("segments", job.progress.Segments),
+		zap.Int("nodes", len(nodeSegments)))
+	return job.getProgress()
+}
+
+// getProgress returns the progress of the last warmup job of the collection
+func (w *warmupJobs) getProgress(collectionID UniqueID) (metricsinfo.WarmupProgress, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	job, ok := w.jobs[collectionID]
+	if !ok {
+		return metricsinfo.WarmupProgress{CollectionID: collectionID}, false
+	}
+	return job.getProgress(), true
+}
+
+// percentage returns the warmup percentage of the collection, 0 if it is not warmed up
+func (w *warmupJobs) percentage(collectionID UniqueID) int64 {
+	if w == nil {
+		return 0
+	}
+	progress, _ := w.getProgress(collectionID)
+	return progress.Percentage
+}
+
+// remove drops the progress of the released collection, the running job is left to fail
+func (w *warmupJobs) remove(collectionID UniqueID) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.jobs, collectionID)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarmupJobs(t *testing.T) {
+	var mu sync.Mutex
+	warmed := make(map[UniqueID]int64)
+	gate := make(chan struct{})
+	warmup := func(ctx context.Context, nodeID int64, collectionID UniqueID, segmentID UniqueID) error {
+		<-gate
+		mu.Lock()
+		defer mu.Unlock()
+		warmed[segmentID] = nodeID
+		if segmentID == 4 {
+			return errors.New("segment not loaded")
+		}
+		return nil
+	}
+	jobs := newWarmupJobs(context.Background(), warmup)
+
+	progress := jobs.start(1, map[int64][]UniqueID{1: {1, 2}, 2: {3, 4}})
+	assert.Equal(t, 4, progress.Segments)
+	assert.False(t, progress.Done)
+	assert.Equal(t, int64(0), jobs.percentage(1))
+
+	// the running job is returned instead of starting a new one
+	progress = jobs.start(1, map[int64][]UniqueID{1: {1}})
+	assert.Equal(t, 4, progress.Segments)
+
+	close(gate)
+	assert.Eventually(t, func() bool {
+		progress, ok := jobs.getProgress(1)
+		return ok && progress.Done
+	}, time.Second, 10*time.Millisecond)
+	progress, _ = jobs.getProgress(1)
+	assert.Equal(t, 3, progress.Warmed)
+	assert.Equal(t, 1, progress.Failed)
+	assert.Equal(t, "segment not loaded", progress.Reason)
+	assert.Equal(t, int64(100), jobs.percentage(1))
+	mu.Lock()
+	assert.Equal(t, map[UniqueID]int64{1: 1, 2: 1, 3: 2, 4: 2}, warmed)
+	mu.Unlock()
+
+	// a collection without sealed segments is warmed up at once
+	progress = jobs.start(2, nil)
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(100), progress.Percentage)
+
+	jobs.remove(1)
+	_, ok := jobs.getProgress(1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), jobs.percentage(1))
+
+	var nilJobs *warmupJobs
+	assert.Equal(t, int64(0), nilJobs.percentage(1))
+	nilJobs.remove(1)
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// cpuAdmission rejects the lowest priority read requests, the queries, while the cpu usage of the query node
+// exceeds the watermark, so that the searches are still served during load spikes
+type cpuAdmission struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	interval time.Duration
+	getUsage func() float64
+
+	mu        sync.RWMutex
+	watermark float64 // can be changed at runtime by queryNode.admission.cpuWatermark
+	usage     float64
+}
+
+func newCPUAdmission(ctx context.Context, watermark float64, interval time.Duration) *cpuAdmission {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &cpuAdmission{
+		ctx:       ctx1,
+		cancel:    cancel,
+		watermark: watermark,
+		interval:  interval,
+		getUsage:  metricsinfo.GetCPUUsage,
+	}
+}
+
+// start runs the sample loop even if the admission is disabled, since the watermark can be set at runtime
+func (a *cpuAdmission) start() {
+	a.wg.Add(1)
+	go a.sampleLoop()
+}
+
+func (a *cpuAdmission) close() {
+	a.cancel()
+	a.wg.Wait()
+}
+
+func (a *cpuAdmission) sampleLoop() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			log.Debug("query node cpu admission sample loop exit")
+			return
+		case <-ticker.C:
+			a.update()
+		}
+	}
+}
+
+func (a *cpuAdmission) update() {
+	a.mu.RLock()
+	watermark := a.watermark
+	a.mu.RUnlock()
+	if watermark <= 0 {
+		return
+	}
+
+	usage := a.getUsage()
+	a.mu.Lock()
+	wasOverloaded := a.usage >= a.watermark
+	a.usage = usage
+	a.mu.Unlock()
+
+	metrics.QueryNodeCPUUsage.Set(usage)
+	if overloaded := a.overloaded(); overloaded != wasOverloaded {
+		log.Warn("query node cpu admission state changed",
+			zap.Bool("overloaded", overloaded),
+			zap.Float64("cpuUsage", usage),
+			zap.Float64("watermark", watermark))
+	}
+}
+
+// setWatermark changes the watermark, the admission is disabled if it is not positive
+func (a *cpuAdmission) setWatermark(watermark float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.watermark = watermark
+}
+
+// overloaded returns whether the last sampled cpu usage exceeds the watermark
+func (a *cpuAdmission) overloaded() bool {
+	if a == nil {
+		return false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.watermark > 0 && a.usage >= a.watermark
+}
+
+// admit returns an error if the read request is rejected, the client is expected to retry it later
+func (a *cpuAdmission) admit(msgType commonpb.MsgType) error {
+	if msgType != commonpb.MsgType_Retrieve || !a.overloaded() {
+		return nil
+	}
+	a.mu.RLock()
+	usage, watermark := a.usage, a.watermark
+	a.mu.RUnlock()
+	metrics.QueryNodeRejectedReadCounter.WithLabelValues(msgType.String()).Inc()
+	return fmt.Errorf("query node %d is overloaded, cpu usage %.1f%% exceeds the watermark %.1f%%, please retry later",
+		Params.QueryNodeID, usage, watermark)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestCPUAdmission(t *testing.T) {
+	usage := float64(50)
+	admission := newCPUAdmission(context.Background(), 80, time.Millisecond)
+	admission.getUsage = func() float64 { return usage }
+
+	admission.update()
+	assert.False(t, admission.overloaded())
+	assert.NoError(t, admission.admit(commonpb.MsgType_Retrieve))
+
+	usage = 90
+	admission.update()
+	assert.True(t, admission.overloaded())
+	assert.Error(t, admission.admit(commonpb.MsgType_Retrieve))
+	// the searches are never rejected
+	assert.NoError(t, admission.admit(commonpb.MsgType_Search))
+
+	usage = 10
+	admission.update()
+	assert.NoError(t, admission.admit(commonpb.MsgType_Retrieve))
+	admission.close()
+
+	// admission control is disabled
+	var nilAdmission *cpuAdmission
+	assert.NoError(t, nilAdmission.admit(commonpb.MsgType_Retrieve))
+	disabled := newCPUAdmission(context.Background(), 0, time.Millisecond)
+	disabled.getUsage = func() float64 { return 100 }
+	disabled.start()
+	disabled.update()
+	assert.NoError(t, disabled.admit(commonpb.MsgType_Retrieve))
+	// enabled at runtime
+	disabled.setWatermark(80)
+	disabled.update()
+	assert.Error(t, disabled.admit(commonpb.MsgType_Retrieve))
+	disabled.setWatermark(0)
+	assert.NoError(t, disabled.admit(commonpb.MsgType_Retrieve))
+	disabled.close()
+}
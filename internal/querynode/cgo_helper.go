@@ -22,13 +22,14 @@ package querynode
 import "C"
 
 import (
-	"errors"
-	"fmt"
+	"unsafe"
+
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus/internal/log"
-	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
-	"unsafe"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 // ProtoCGo is protobuf created by go side,
@@ -60,29 +61,31 @@ func (protoCGo *ProtoCGo) destruct() {
 	protoCGo.blob = nil
 }
 
-func HandleCStatus(status *C.CStatus, extraInfo string) error {
+// HandleCStatus returns the structured error of a failed status of the C function op, nil if succeeded,
+// the message of the status is freed
+func HandleCStatus(status *C.CStatus, op string) error {
+	if err := newCStatusError(status, op); err != nil {
+		log.Warn("C runtime exception", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// newCStatusError is HandleCStatus returning the structured error, so that the caller could add the context
+func newCStatusError(status *C.CStatus, op string) *cgoerror.Error {
 	if status.error_code == 0 {
 		return nil
 	}
-	errorCode := status.error_code
-	errorName, ok := commonpb.ErrorCode_name[int32(errorCode)]
-	if !ok {
-		errorName = "UnknownError"
-	}
 	errorMsg := C.GoString(status.error_msg)
-	defer C.free(unsafe.Pointer(status.error_msg))
-
-	finalMsg := fmt.Sprintf("[%s] %s", errorName, errorMsg)
-	logMsg := fmt.Sprintf("%s, C Runtime Exception: %s\n", extraInfo, finalMsg)
-	log.Warn(logMsg)
-	return errors.New(finalMsg)
+	C.free(unsafe.Pointer(status.error_msg))
+	return cgoerror.New(op, int32(status.error_code), errorMsg)
 }
 
-func HandleCProtoResult(cRes *C.CProtoResult, msg proto.Message) error {
+func HandleCProtoResult(cRes *C.CProtoResult, op string, msg proto.Message) error {
 	// Standalone CProto is protobuf created by C side,
 	// Passed from c side
 	// memory is managed manually
-	err := HandleCStatus(&cRes.status, "")
+	err := HandleCStatus(&cRes.status, op)
 	if err != nil {
 		return err
 	}
@@ -98,7 +101,7 @@ func HandleCProtoResult(cRes *C.CProtoResult, msg proto.Message) error {
 func TestBoolArray(cpb *ProtoCGo) (*schemapb.BoolArray, error) {
 	res := C.CTestBoolArrayPb(cpb.CProto)
 	ba := new(schemapb.BoolArray)
-	err := HandleCProtoResult(&res, ba)
+	err := HandleCProtoResult(&res, "CTestBoolArrayPb", ba)
 
 	return ba, err
 }
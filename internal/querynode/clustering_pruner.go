@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"math"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// clusteringPruner skips the sealed segments whose key range of the clustering field can't match the
+// predicates of a plan, the segments which are not clustered are never skipped
+type clusteringPruner struct {
+	predicates *planpb.Expr
+}
+
+// newClusteringPruner returns the pruner of the serialized plan, nil if the plan has no predicates
+func newClusteringPruner(serializedPlan []byte) *clusteringPruner {
+	if len(serializedPlan) == 0 {
+		return nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil
+	}
+	var predicates *planpb.Expr
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		predicates = node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		predicates = node.Predicates
+	}
+	if predicates == nil {
+		return nil
+	}
+	return &clusteringPruner{predicates: predicates}
+}
+
+// canSkip tells whether no row of the segment matches the predicates
+func (p *clusteringPruner) canSkip(segment *Segment) bool {
+	if p == nil {
+		return false
+	}
+	info := segment.getClusteringInfo()
+	if info == nil {
+		return false
+	}
+	return !mayMatchKeyRange(p.predicates, info)
+}
+
+// mayMatchKeyRange returns false if no key in the range matches the expr, the exprs not on the
+// clustering field are assumed to match
+func mayMatchKeyRange(expr *planpb.Expr, info *datapb.ClusteringInfo) bool {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return mayMatchKeyRange(e.BinaryExpr.GetLeft(), info) && mayMatchKeyRange(e.BinaryExpr.GetRight(), info)
+		case planpb.BinaryExpr_LogicalOr:
+			return mayMatchKeyRange(e.BinaryExpr.GetLeft(), info) || mayMatchKeyRange(e.BinaryExpr.GetRight(), info)
+		}
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() != info.GetFieldID() {
+			return true
+		}
+		for _, value := range e.TermExpr.GetValues() {
+			cmpMin, cmpMax, ok := compareToKeyRange(value, info)
+			if !ok || (cmpMin >= 0 && cmpMax <= 0) {
+				return true
+			}
+		}
+		return false
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() != info.GetFieldID() {
+			return true
+		}
+		cmpMin, cmpMax, ok := compareToKeyRange(e.UnaryRangeExpr.GetValue(), info)
+		if !ok {
+			return true
+		}
+		switch e.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan:
+			return cmpMax < 0
+		case planpb.OpType_GreaterEqual:
+			return cmpMax <= 0
+		case planpb.OpType_LessThan:
+			return cmpMin > 0
+		case planpb.OpType_LessEqual:
+			return cmpMin >= 0
+		case planpb.OpType_Equal:
+			return cmpMin >= 0 && cmpMax <= 0
+		case planpb.OpType_NotEqual:
+			return cmpMin != 0 || cmpMax != 0
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		if e.BinaryRangeExpr.GetColumnInfo().GetFieldId() != info.GetFieldID() {
+			return true
+		}
+		_, lowerCmpMax, ok := compareToKeyRange(e.BinaryRangeExpr.GetLowerValue(), info)
+		if !ok {
+			return true
+		}
+		upperCmpMin, _, ok := compareToKeyRange(e.BinaryRangeExpr.GetUpperValue(), info)
+		if !ok {
+			return true
+		}
+		// the lower value isn't above the max, and the upper value isn't below the min
+		lowerMatched := lowerCmpMax < 0 || (lowerCmpMax == 0 && e.BinaryRangeExpr.GetLowerInclusive())
+		upperMatched := upperCmpMin > 0 || (upperCmpMin == 0 && e.BinaryRangeExpr.GetUpperInclusive())
+		return lowerMatched && upperMatched
+	}
+	return true
+}
+
+// compareToKeyRange compares the value to the min and the max of the key range, returns -1, 0 or +1 for each.
+// ok is false if the value can't be compared with the keys the same way the segments evaluate the exprs
+func compareToKeyRange(value *planpb.GenericValue, info *datapb.ClusteringInfo) (int, int, bool) {
+	switch info.GetDataType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		v, ok := value.GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok || !isInIntRange(v.Int64Val, info.GetDataType()) {
+			return 0, 0, false
+		}
+		return compareInt64(v.Int64Val, info.GetIntMin()), compareInt64(v.Int64Val, info.GetIntMax()), true
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var v float64
+		switch val := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			v = float64(val.Int64Val)
+		case *planpb.GenericValue_FloatVal:
+			v = val.FloatVal
+		default:
+			return 0, 0, false
+		}
+		if math.IsNaN(v) {
+			return 0, 0, false
+		}
+		// the values are compared with the float keys in single precision
+		if info.GetDataType() == schemapb.DataType_Float {
+			v = float64(float32(v))
+		}
+		return compareFloat64(v, info.GetFloatMin()), compareFloat64(v, info.GetFloatMax()), true
+	default:
+		return 0, 0, false
+	}
+}
+
+// isInIntRange tells whether the value is in the range of the integer type, the values out of range
+// are converted by the segments
+func isInIntRange(value int64, dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8:
+		return value >= math.MinInt8 && value <= math.MaxInt8
+	case schemapb.DataType_Int16:
+		return value >= math.MinInt16 && value <= math.MaxInt16
+	case schemapb.DataType_Int32:
+		return value >= math.MinInt32 && value <= math.MaxInt32
+	default:
+		return true
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
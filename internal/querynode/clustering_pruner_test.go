@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func floatValue(v float64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+}
+
+func unaryRangeExpr(fieldID int64, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+		Op:         op,
+		Value:      value,
+	}}}
+}
+
+func binaryExpr(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: op, Left: left, Right: right}}}
+}
+
+func TestClusteringPruner(t *testing.T) {
+	intInfo := &datapb.ClusteringInfo{FieldID: 100, DataType: schemapb.DataType_Int64, IntMin: 10, IntMax: 20}
+	floatInfo := &datapb.ClusteringInfo{FieldID: 101, DataType: schemapb.DataType_Float, FloatMin: float64(float32(0.1)), FloatMax: 0.5}
+
+	t.Run("unary range", func(t *testing.T) {
+		cases := []struct {
+			op      planpb.OpType
+			value   int64
+			matched bool
+		}{
+			{planpb.OpType_GreaterThan, 19, true},
+			{planpb.OpType_GreaterThan, 20, false},
+			{planpb.OpType_GreaterEqual, 20, true},
+			{planpb.OpType_GreaterEqual, 21, false},
+			{planpb.OpType_LessThan, 11, true},
+			{planpb.OpType_LessThan, 10, false},
+			{planpb.OpType_LessEqual, 10, true},
+			{planpb.OpType_LessEqual, 9, false},
+			{planpb.OpType_Equal, 15, true},
+			{planpb.OpType_Equal, 21, false},
+			{planpb.OpType_NotEqual, 15, true},
+		}
+		for _, c := range cases {
+			assert.Equal(t, c.matched, mayMatchKeyRange(unaryRangeExpr(100, c.op, int64Value(c.value)), intInfo), c)
+		}
+		single := &datapb.ClusteringInfo{FieldID: 100, DataType: schemapb.DataType_Int64, IntMin: 10, IntMax: 10}
+		assert.False(t, mayMatchKeyRange(unaryRangeExpr(100, planpb.OpType_NotEqual, int64Value(10)), single))
+
+		// other fields and values not comparable
+		assert.True(t, mayMatchKeyRange(unaryRangeExpr(102, planpb.OpType_Equal, int64Value(0)), intInfo))
+		assert.True(t, mayMatchKeyRange(unaryRangeExpr(100, planpb.OpType_Equal, floatValue(0.5)), intInfo))
+		int8Info := &datapb.ClusteringInfo{FieldID: 100, DataType: schemapb.DataType_Int8, IntMin: 10, IntMax: 20}
+		assert.True(t, mayMatchKeyRange(unaryRangeExpr(100, planpb.OpType_Equal, int64Value(1000)), int8Info))
+	})
+
+	t.Run("float keys", func(t *testing.T) {
+		assert.True(t, mayMatchKeyRange(unaryRangeExpr(101, planpb.OpType_Equal, floatValue(0.1)), floatInfo))
+		assert.False(t, mayMatchKeyRange(unaryRangeExpr(101, planpb.OpType_LessThan, floatValue(0.1)), floatInfo))
+		assert.False(t, mayMatchKeyRange(unaryRangeExpr(101, planpb.OpType_GreaterThan, int64Value(1)), floatInfo))
+	})
+
+	t.Run("binary range and term", func(t *testing.T) {
+		rangeExpr := func(lower, upper int64, lowerInclusive, upperInclusive bool) *planpb.Expr {
+			return &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+				ColumnInfo:     &planpb.ColumnInfo{FieldId: 100},
+				LowerInclusive: lowerInclusive,
+				UpperInclusive: upperInclusive,
+				LowerValue:     int64Value(lower),
+				UpperValue:     int64Value(upper),
+			}}}
+		}
+		assert.True(t, mayMatchKeyRange(rangeExpr(0, 10, false, true), intInfo))
+		assert.False(t, mayMatchKeyRange(rangeExpr(0, 10, false, false), intInfo))
+		assert.True(t, mayMatchKeyRange(rangeExpr(20, 30, true, false), intInfo))
+		assert.False(t, mayMatchKeyRange(rangeExpr(20, 30, false, false), intInfo))
+		assert.True(t, mayMatchKeyRange(rangeExpr(12, 13, false, false), intInfo))
+
+		termExpr := func(values ...int64) *planpb.Expr {
+			term := &planpb.TermExpr{ColumnInfo: &planpb.ColumnInfo{FieldId: 100}}
+			for _, v := range values {
+				term.Values = append(term.Values, int64Value(v))
+			}
+			return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: term}}
+		}
+		assert.True(t, mayMatchKeyRange(termExpr(1, 15), intInfo))
+		assert.False(t, mayMatchKeyRange(termExpr(1, 25), intInfo))
+		assert.False(t, mayMatchKeyRange(termExpr(), intInfo))
+	})
+
+	t.Run("logical", func(t *testing.T) {
+		matched := unaryRangeExpr(100, planpb.OpType_Equal, int64Value(15))
+		unmatched := unaryRangeExpr(100, planpb.OpType_Equal, int64Value(25))
+		assert.False(t, mayMatchKeyRange(binaryExpr(planpb.BinaryExpr_LogicalAnd, matched, unmatched), intInfo))
+		assert.True(t, mayMatchKeyRange(binaryExpr(planpb.BinaryExpr_LogicalOr, matched, unmatched), intInfo))
+		not := &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: matched}}}
+		assert.True(t, mayMatchKeyRange(not, intInfo))
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		assert.Nil(t, newClusteringPruner(nil))
+		assert.Nil(t, newClusteringPruner([]byte("invalid")))
+
+		plan := &planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{FieldId: 102}}}
+		serialized, err := proto.Marshal(plan)
+		assert.NoError(t, err)
+		assert.Nil(t, newClusteringPruner(serialized))
+
+		plan.GetVectorAnns().Predicates = unaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20))
+		serialized, err = proto.Marshal(plan)
+		assert.NoError(t, err)
+		pruner := newClusteringPruner(serialized)
+		assert.NotNil(t, pruner)
+		assert.True(t, pruner.canSkip(&Segment{clusteringInfo: intInfo}))
+		assert.False(t, pruner.canSkip(&Segment{}))
+
+		plan = &planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: unaryRangeExpr(100, planpb.OpType_LessThan, int64Value(15))}}
+		serialized, err = proto.Marshal(plan)
+		assert.NoError(t, err)
+		pruner = newClusteringPruner(serialized)
+		assert.False(t, pruner.canSkip(&Segment{clusteringInfo: intInfo}))
+
+		pruner = nil
+		assert.False(t, pruner.canSkip(&Segment{clusteringInfo: intInfo}))
+	})
+}
@@ -49,6 +49,9 @@ type Collection struct {
 	releaseMu          sync.RWMutex // guards release
 	releasedPartitions map[UniqueID]struct{}
 	releaseTime        Timestamp
+
+	// deletes are the deletes of the delta logs of the loaded sealed segments
+	deletes *deleteRecords
 }
 
 func (c *Collection) ID() UniqueID {
@@ -202,6 +205,7 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 		vChannels:          make([]Channel, 0),
 		pChannels:          make([]Channel, 0),
 		releasedPartitions: make(map[UniqueID]struct{}),
+		deletes:            newDeleteRecords(),
 	}
 	C.free(unsafe.Pointer(cSchemaBlob))
 
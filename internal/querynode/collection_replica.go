@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
 )
 
 /*
@@ -425,7 +426,7 @@ func (colReplica *collectionReplica) getSegmentByID(segmentID UniqueID) (*Segmen
 func (colReplica *collectionReplica) getSegmentByIDPrivate(segmentID UniqueID) (*Segment, error) {
 	segment, ok := colReplica.segments[segmentID]
 	if !ok {
-		return nil, errors.New("cannot find segment in query node, id = " + strconv.FormatInt(segmentID, 10))
+		return nil, merr.Errorf(merr.ErrSegmentNotLoaded, "cannot find segment in query node, id = %d", segmentID)
 	}
 
 	return segment, nil
@@ -12,9 +12,12 @@
 package querynode
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/merr"
 )
 
 //----------------------------------------------------------------------------------------------------- collection
@@ -218,8 +221,10 @@ func TestCollectionReplica_getSegmentByID(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, targetSeg.segmentID, UniqueID(i))
 	}
+	_, err := node.historical.replica.getSegmentByID(UniqueID(segmentNum))
+	assert.True(t, errors.Is(err, merr.ErrSegmentNotLoaded))
 
-	err := node.Stop()
+	err = node.Stop()
 	assert.NoError(t, err)
 }
 
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+// cpuLimits returns GOMAXPROCS and the num of threads of a search in segcore. maxProcs of 0 is the usable cpus
+// of the cgroup, and segcoreThreads of 0 is the procs capped by the cpus of the largest NUMA node if numaAware,
+// so that a search of a container is not run by the threads of all the cpus of the host
+func cpuLimits(maxProcs int, segcoreThreads int, numaAware bool, usableCPUs int, numaNodes [][]int) (int, int) {
+	procs := maxProcs
+	if procs <= 0 {
+		procs = usableCPUs
+	}
+	if procs <= 0 {
+		procs = 1
+	}
+
+	threads := segcoreThreads
+	if threads <= 0 {
+		threads = procs
+		if numaAware {
+			largest := 0
+			for _, cpus := range numaNodes {
+				if len(cpus) > largest {
+					largest = len(cpus)
+				}
+			}
+			if largest > 0 && threads > largest {
+				threads = largest
+			}
+		}
+	}
+	return procs, threads
+}
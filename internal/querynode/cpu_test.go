@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCPULimits(t *testing.T) {
+	twoNodes := [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}}
+
+	// the cpu quota of the container
+	procs, threads := cpuLimits(0, 0, true, 2, twoNodes)
+	assert.Equal(t, 2, procs)
+	assert.Equal(t, 2, threads)
+
+	// the search threads are kept within a NUMA node
+	procs, threads = cpuLimits(0, 0, true, 8, twoNodes)
+	assert.Equal(t, 8, procs)
+	assert.Equal(t, 4, threads)
+
+	procs, threads = cpuLimits(0, 0, false, 8, twoNodes)
+	assert.Equal(t, 8, procs)
+	assert.Equal(t, 8, threads)
+
+	// unknown topology
+	procs, threads = cpuLimits(0, 0, true, 8, nil)
+	assert.Equal(t, 8, procs)
+	assert.Equal(t, 8, threads)
+
+	// the overrides
+	procs, threads = cpuLimits(6, 0, true, 8, twoNodes)
+	assert.Equal(t, 6, procs)
+	assert.Equal(t, 4, threads)
+
+	procs, threads = cpuLimits(6, 16, true, 8, twoNodes)
+	assert.Equal(t, 6, procs)
+	assert.Equal(t, 16, threads)
+
+	procs, threads = cpuLimits(0, 0, true, 0, nil)
+	assert.Equal(t, 1, procs)
+	assert.Equal(t, 1, threads)
+}
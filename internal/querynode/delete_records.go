@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// deleteRecords keeps the deletes of the delta logs of the sealed segments of a collection. The sealed segments
+// can't delete their rows, the deleted rows are filtered from the results of the queries instead
+type deleteRecords struct {
+	mu     sync.RWMutex
+	loaded map[string]struct{} // the paths of the loaded delta logs
+	tss    map[int64]Timestamp // the earliest delete timestamps of the pks
+}
+
+func newDeleteRecords() *deleteRecords {
+	return &deleteRecords{
+		loaded: make(map[string]struct{}),
+		tss:    make(map[int64]Timestamp),
+	}
+}
+
+// isLoaded tells whether the delta log of the path is loaded, the L0 delta logs are shared by the segments of
+// a channel
+func (r *deleteRecords) isLoaded(path string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.loaded[path]
+	return ok
+}
+
+// add adds the deletes of the delta log of the path
+func (r *deleteRecords) add(path string, data *storage.DeleteData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loaded[path] = struct{}{}
+	for i, pk := range data.Pks {
+		if ts, ok := r.tss[pk]; !ok || data.Tss[i] < ts {
+			r.tss[pk] = data.Tss[i]
+		}
+	}
+}
+
+// isDeleted tells whether the row of the pk is deleted at ts, the caller holds the read lock
+func (r *deleteRecords) isDeleted(pk int64, ts Timestamp) bool {
+	deleteTs, ok := r.tss[pk]
+	return ok && deleteTs <= ts
+}
+
+// filterSearchResult moves the deleted hits of each query to the end of its hits as invalid hits of id -1,
+// so that the results keep topK hits per query
+func (r *deleteRecords) filterSearchResult(result *schemapb.SearchResultData, ts Timestamp) (*schemapb.SearchResultData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := result.GetIds().GetIntId().GetData()
+	topK := int(result.GetTopK())
+	if len(r.tss) == 0 || topK == 0 {
+		return result, nil
+	}
+
+	offsets := make([]int, 0, len(ids))
+	filtered := make([]int64, 0, len(ids))
+	numDeleted := 0
+	for begin := 0; begin < len(ids); begin += topK {
+		end := begin + topK
+		if end > len(ids) {
+			end = len(ids)
+		}
+		deleted := make([]int, 0)
+		for i := begin; i < end; i++ {
+			if ids[i] != -1 && r.isDeleted(ids[i], ts) {
+				deleted = append(deleted, i)
+				continue
+			}
+			offsets = append(offsets, i)
+			filtered = append(filtered, ids[i])
+		}
+		for _, i := range deleted {
+			offsets = append(offsets, i)
+			filtered = append(filtered, -1)
+		}
+		numDeleted += len(deleted)
+	}
+	if numDeleted == 0 {
+		return result, nil
+	}
+
+	fieldsData, err := typeutil.SelectFieldData(result.FieldsData, offsets)
+	if err != nil {
+		return nil, err
+	}
+	scores := make([]float32, 0, len(offsets))
+	for _, offset := range offsets {
+		scores = append(scores, result.Scores[offset])
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: result.NumQueries,
+		TopK:       result.TopK,
+		FieldsData: fieldsData,
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: filtered,
+				},
+			},
+		},
+	}, nil
+}
+
+// filterRetrieveResults removes the rows deleted at ts from the merged results
+func (r *deleteRecords) filterRetrieveResults(result *segcorepb.RetrieveResults, ts Timestamp) (*segcorepb.RetrieveResults, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pks := result.GetIds().GetIntId().GetData()
+	if len(r.tss) == 0 || len(pks) == 0 {
+		return result, nil
+	}
+
+	offsets := make([]int, 0, len(pks))
+	ids := make([]int64, 0, len(pks))
+	for i, pk := range pks {
+		if !r.isDeleted(pk, ts) {
+			offsets = append(offsets, i)
+			ids = append(ids, pk)
+		}
+	}
+	if len(offsets) == len(pks) {
+		return result, nil
+	}
+	fieldsData, err := typeutil.SelectFieldData(result.FieldsData, offsets)
+	if err != nil {
+		return nil, err
+	}
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: ids,
+				},
+			},
+		},
+		FieldsData: fieldsData,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newDeleteRecordsTestField(data []int64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:    schemapb.DataType_Int64,
+		FieldId: 100,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+			},
+		},
+	}
+}
+
+func newDeleteRecordsTestIDs(ids []int64) *schemapb.IDs {
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}
+}
+
+func TestDeleteRecords(t *testing.T) {
+	records := newDeleteRecords()
+	assert.False(t, records.isLoaded("/delta/1"))
+	records.add("/delta/1", &storage.DeleteData{Pks: []int64{1, 2}, Tss: []Timestamp{10, 20}})
+	records.add("/delta/2", &storage.DeleteData{Pks: []int64{2, 3}, Tss: []Timestamp{15, 30}})
+	assert.True(t, records.isLoaded("/delta/1"))
+	assert.True(t, records.isLoaded("/delta/2"))
+	assert.True(t, records.isDeleted(1, 10))
+	assert.False(t, records.isDeleted(1, 9))
+	assert.True(t, records.isDeleted(2, 15))
+	assert.False(t, records.isDeleted(3, 20))
+	assert.False(t, records.isDeleted(4, 100))
+
+	t.Run("filter search result", func(t *testing.T) {
+		result := &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Ids:        newDeleteRecordsTestIDs([]int64{1, 4, 2, 3, 5, -1}),
+			Scores:     []float32{0.9, 0.8, 0.7, 0.6, 0.5, 0},
+			FieldsData: []*schemapb.FieldData{newDeleteRecordsTestField([]int64{10, 40, 20, 30, 50, 0})},
+		}
+		filtered, err := records.filterSearchResult(result, 20)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 2, filtered.GetNumQueries())
+		assert.EqualValues(t, 3, filtered.GetTopK())
+		assert.Equal(t, []int64{4, -1, -1, 3, 5, -1}, filtered.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.8, 0.9, 0.7, 0.6, 0.5, 0}, filtered.GetScores())
+		assert.Equal(t, []int64{40, 10, 20, 30, 50, 0}, filtered.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		// nothing is deleted before the deletes
+		filtered, err = records.filterSearchResult(result, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, result, filtered)
+		filtered, err = newDeleteRecords().filterSearchResult(result, 20)
+		assert.Nil(t, err)
+		assert.Equal(t, result, filtered)
+	})
+
+	t.Run("filter retrieve results", func(t *testing.T) {
+		result := &segcorepb.RetrieveResults{
+			Ids:        newDeleteRecordsTestIDs([]int64{1, 2, 3, 4}),
+			FieldsData: []*schemapb.FieldData{newDeleteRecordsTestField([]int64{10, 20, 30, 40})},
+		}
+		filtered, err := records.filterRetrieveResults(result, 30)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{4}, filtered.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{40}, filtered.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		filtered, err = records.filterRetrieveResults(result, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, result, filtered)
+		filtered, err = records.filterRetrieveResults(&segcorepb.RetrieveResults{}, 30)
+		assert.Nil(t, err)
+		assert.Empty(t, filtered.GetIds().GetIntId().GetData())
+	})
+}
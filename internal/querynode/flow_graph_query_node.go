@@ -114,6 +114,7 @@ func (q *queryNodeFlowGraph) newDmInputNode(ctx context.Context, factory msgstre
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	node := flowgraph.NewInputNode(&insertStream, "dmlInputNode", maxQueueLength, maxParallelism)
+	node.SetSkipMode(Params.FlowGraphSkipModeIdleTicks, Params.FlowGraphSkipModeInterval)
 	return node
 }
 
@@ -179,8 +179,35 @@ func (h *historical) removeGlobalSegmentIDsByPartitionIds(partitionIDs []UniqueI
 	}
 }
 
+// pinSegments loads the sealed segments of the partitions into the segment cache on demand,
+// and keeps them from being evicted until the returned release is called
+func (h *historical) pinSegments(collID UniqueID, partIDs []UniqueID) (func(), error) {
+	if h.loader.cache == nil {
+		return func() {}, nil
+	}
+	if len(partIDs) == 0 {
+		hisPartIDs, err := h.replica.getPartitionIDs(collID)
+		if err != nil {
+			return nil, err
+		}
+		partIDs = hisPartIDs
+	}
+	segmentIDs := make([]UniqueID, 0)
+	for _, partID := range partIDs {
+		// the released partitions are checked by the search and retrieve
+		segIDs, err := h.replica.getSegmentIDs(partID)
+		if err != nil {
+			continue
+		}
+		segmentIDs = append(segmentIDs, segIDs...)
+	}
+	return h.loader.cache.pin(segmentIDs)
+}
+
+// retrieve retrieves the sealed segments of the partitions, the segments skipped by the pruner are
+// returned as retrieved without results
 func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
-	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+	plan *RetrievePlan, pruner *clusteringPruner) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
 
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
@@ -212,6 +239,10 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if pruner.canSkip(seg) {
+				retrieveSegmentIDs = append(retrieveSegmentIDs, segID)
+				continue
+			}
 			result, err := seg.getEntityByIds(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
@@ -227,8 +258,10 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 	return retrieveResults, retrieveSegmentIDs, nil
 }
 
+// search searches the sealed segments of the partitions, the segments skipped by the pruner are
+// returned as searched without results
 func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
-	searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
+	searchTs Timestamp, pruner *clusteringPruner) ([]*SearchResult, []UniqueID, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	searchSegmentIDs := make([]UniqueID, 0)
@@ -295,6 +328,10 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 			if !seg.getOnService() {
 				continue
 			}
+			if pruner.canSkip(seg) {
+				searchSegmentIDs = append(searchSegmentIDs, seg.segmentID)
+				continue
+			}
 			searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
 			if err != nil {
 				return searchResults, searchSegmentIDs, err
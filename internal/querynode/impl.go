@@ -482,6 +482,65 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 	}, nil
 }
 
+// RefreshIndex loads the index swapped in on the field for the sealed segments of the collection
+func (node *QueryNode) RefreshIndex(ctx context.Context, in *queryPb.RefreshIndexRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeID)
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+
+	replica := node.historical.replica
+	if !replica.hasCollection(in.CollectionID) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		}, nil
+	}
+	partitionIDs, err := replica.getPartitionIDs(in.CollectionID)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+	var lastErr error
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(partitionID)
+		if err != nil {
+			continue
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				continue
+			}
+			refreshed, err := node.historical.loader.indexLoader.refreshIndex(in.CollectionID, segment, in.FieldID)
+			if err != nil {
+				log.Warn("refresh index failed", zap.Int64("segmentID", segmentID), zap.Int64("fieldID", in.FieldID), zap.Error(err))
+				lastErr = err
+				continue
+			}
+			log.Debug("refresh index done", zap.Int64("segmentID", segmentID), zap.Int64("fieldID", in.FieldID),
+				zap.Bool("refreshed", refreshed), zap.Int64("buildID", segment.getBuildID(in.FieldID)))
+		}
+	}
+	if lastErr != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    lastErr.Error(),
+		}
+		return status, lastErr
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *QueryNode) isHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
@@ -540,6 +599,30 @@ func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsR
 		return metrics, err
 	}
 
+	if metricType == metricsinfo.LocalCacheMetrics {
+		return getLocalCacheMetrics(ctx, req, node)
+	}
+
+	if metricType == metricsinfo.WarmupMetrics {
+		return getWarmupMetrics(ctx, req, node)
+	}
+
+	if metricType == metricsinfo.WarmupSegmentsMetrics {
+		return getWarmupSegmentsMetrics(ctx, req, node)
+	}
+
+	if metricType == metricsinfo.CollectionRuntimeStatsMetrics {
+		return getCollectionRuntimeStatsMetrics(ctx, req, node)
+	}
+
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID), &Params)
+	}
+
 	log.Debug("QueryNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.QueryNodeID),
 		zap.String("req", req.Request),
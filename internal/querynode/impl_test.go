@@ -231,6 +231,38 @@ func TestImpl_GetSegmentInfo(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
 }
 
+func TestImpl_RefreshIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	req := &queryPb.RefreshIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadIndex,
+			MsgID:   rand.Int63(),
+		},
+		CollectionID: defaultCollectionID + 1,
+		FieldID:      simpleVecField.id,
+	}
+
+	// the collection is not loaded
+	status, err := node.RefreshIndex(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	// no coordinators to describe the index of the segments
+	req.CollectionID = defaultCollectionID
+	status, err = node.RefreshIndex(ctx, req)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+	node.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err = node.RefreshIndex(ctx, req)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+}
+
 func TestImpl_isHealthy(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
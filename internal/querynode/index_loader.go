@@ -188,6 +188,30 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 	return nil
 }
 
+// refreshIndex loads the index swapped in on the field of a sealed segment, the index loaded before keeps
+// serving until the new one is loaded, return whether a new index is loaded
+func (loader *indexLoader) refreshIndex(collectionID UniqueID, segment *Segment, fieldID UniqueID) (bool, error) {
+	segment.paramMutex.RLock()
+	oldInfo, hasIndex := segment.indexInfos[fieldID]
+	segment.paramMutex.RUnlock()
+
+	err := loader.setIndexInfo(collectionID, segment, fieldID)
+	if err != nil {
+		return false, err
+	}
+	if hasIndex && segment.getBuildID(fieldID) == oldInfo.getBuildID() {
+		return false, nil
+	}
+	err = loader.loadIndex(segment, fieldID)
+	if err != nil {
+		if hasIndex {
+			_ = segment.setIndexInfo(fieldID, oldInfo)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
 	option := &minioKV.Option{
 		Address:           Params.MinioEndPoint,
@@ -50,6 +50,55 @@ func TestIndexLoader_setIndexInfo(t *testing.T) {
 	})
 }
 
+func TestIndexLoader_refreshIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("test load failed", func(t *testing.T) {
+		historical, err := genSimpleHistorical(ctx)
+		assert.NoError(t, err)
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+
+		historical.loader.indexLoader.rootCoord = newMockRootCoord()
+		historical.loader.indexLoader.indexCoord = newMockIndexCoord()
+
+		refreshed, err := historical.loader.indexLoader.refreshIndex(defaultCollectionID, segment, rowIDFieldID)
+		assert.Error(t, err)
+		assert.False(t, refreshed)
+	})
+
+	t.Run("test same build", func(t *testing.T) {
+		historical, err := genSimpleHistorical(ctx)
+		assert.NoError(t, err)
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+
+		historical.loader.indexLoader.rootCoord = newMockRootCoord()
+		historical.loader.indexLoader.indexCoord = newMockIndexCoord()
+
+		err = historical.loader.indexLoader.setIndexInfo(defaultCollectionID, segment, rowIDFieldID)
+		assert.NoError(t, err)
+
+		refreshed, err := historical.loader.indexLoader.refreshIndex(defaultCollectionID, segment, rowIDFieldID)
+		assert.NoError(t, err)
+		assert.False(t, refreshed)
+	})
+
+	t.Run("test nil root and index", func(t *testing.T) {
+		historical, err := genSimpleHistorical(ctx)
+		assert.NoError(t, err)
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+
+		_, err = historical.loader.indexLoader.refreshIndex(defaultCollectionID, segment, rowIDFieldID)
+		assert.Error(t, err)
+	})
+}
+
 func TestIndexLoader_getIndexBinlog(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
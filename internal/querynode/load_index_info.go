@@ -20,14 +20,14 @@ package querynode
 */
 import "C"
 import (
-	"errors"
 	"path/filepath"
-	"strconv"
 	"unsafe"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 type LoadIndexInfo struct {
@@ -37,12 +37,8 @@ type LoadIndexInfo struct {
 func newLoadIndexInfo() (*LoadIndexInfo, error) {
 	var cLoadIndexInfo C.CLoadIndexInfo
 	status := C.NewLoadIndexInfo(&cLoadIndexInfo)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return nil, errors.New("NewLoadIndexInfo failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "NewLoadIndexInfo"); err != nil {
+		return nil, err
 	}
 	return &LoadIndexInfo{cLoadIndexInfo: cLoadIndexInfo}, nil
 }
@@ -57,12 +53,8 @@ func (li *LoadIndexInfo) appendIndexParam(indexKey string, indexValue string) er
 	cIndexValue := C.CString(indexValue)
 	defer C.free(unsafe.Pointer(cIndexValue))
 	status := C.AppendIndexParam(li.cLoadIndexInfo, cIndexKey, cIndexValue)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("AppendIndexParam failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "AppendIndexParam"); err != nil {
+		return err.WithParam(indexKey, indexValue)
 	}
 	return nil
 }
@@ -70,53 +62,45 @@ func (li *LoadIndexInfo) appendIndexParam(indexKey string, indexValue string) er
 func (li *LoadIndexInfo) appendFieldInfo(fieldID int64) error {
 	cFieldID := C.long(fieldID)
 	status := C.AppendFieldInfo(li.cLoadIndexInfo, cFieldID)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("AppendFieldInfo failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "AppendFieldInfo"); err != nil {
+		return err.WithField(fieldID)
 	}
 	return nil
 }
 
 func (li *LoadIndexInfo) appendIndex(bytesIndex [][]byte, indexKeys []string) error {
+	if len(bytesIndex) > len(indexKeys) {
+		return cgoerror.Newf("AppendBinaryIndex", commonpb.ErrorCode_IllegalArgument,
+			"%d index files, but %d index paths", len(bytesIndex), len(indexKeys))
+	}
+
 	var cBinarySet C.CBinarySet
 	status := C.NewBinarySet(&cBinarySet)
-	defer C.DeleteBinarySet(cBinarySet)
-
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("newBinarySet failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "NewBinarySet"); err != nil {
+		return err
 	}
+	defer C.DeleteBinarySet(cBinarySet)
 
 	for i, byteIndex := range bytesIndex {
+		binarySetKey := filepath.Base(indexKeys[i])
+		if len(byteIndex) == 0 {
+			return cgoerror.Newf("AppendBinaryIndex", commonpb.ErrorCode_IllegalArgument, "empty index file").
+				WithParam("indexFile", indexKeys[i])
+		}
 		indexPtr := unsafe.Pointer(&byteIndex[0])
 		indexLen := C.long(len(byteIndex))
-		binarySetKey := filepath.Base(indexKeys[i])
 		log.Debug("", zap.String("index key", binarySetKey))
 		indexKey := C.CString(binarySetKey)
 		status = C.AppendBinaryIndex(cBinarySet, indexPtr, indexLen, indexKey)
 		C.free(unsafe.Pointer(indexKey))
-		errorCode = status.error_code
-		if errorCode != 0 {
-			break
+		if err := newCStatusError(&status, "AppendBinaryIndex"); err != nil {
+			return err.WithParam("indexFile", indexKeys[i])
 		}
 	}
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("AppendBinaryIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
-	}
 
 	status = C.AppendIndex(li.cLoadIndexInfo, cBinarySet)
-	errorCode = status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("AppendIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "AppendIndex"); err != nil {
+		return err
 	}
 
 	return nil
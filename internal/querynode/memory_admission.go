@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// searchHitBytes is the estimated temporary memory of a hit of a segment, its id, score and offset
+const searchHitBytes = 16
+
+// memoryAdmission protects the query node from OOM by the estimated memory of the loaded segments and of the
+// running reads. Above the high watermark the loads are rejected and the reads wait for the running ones to
+// release their memory, above the low watermark the node reports itself as evictable so that query coord stops
+// assigning segments to it
+type memoryAdmission struct {
+	totalMemory func() uint64
+	loadedBytes func() int64
+
+	lowWatermark  float64 // percent of the total memory
+	highWatermark float64 // percent of the total memory, the admission is disabled if it is 0
+	queueTimeout  time.Duration
+
+	mu       sync.Mutex
+	inflight int64
+	released chan struct{} // closed and renewed once a read releases its memory
+}
+
+func newMemoryAdmission(lowWatermark, highWatermark float64, queueTimeout time.Duration, replicas ...ReplicaInterface) *memoryAdmission {
+	return &memoryAdmission{
+		totalMemory: metricsinfo.GetMemoryCount,
+		loadedBytes: func() int64 {
+			return getLoadedMemoryBytes(replicas...)
+		},
+		lowWatermark:  lowWatermark,
+		highWatermark: highWatermark,
+		queueTimeout:  queueTimeout,
+		released:      make(chan struct{}),
+	}
+}
+
+// getLoadedMemoryBytes returns the estimated memory of all the segments in replicas
+func getLoadedMemoryBytes(replicas ...ReplicaInterface) int64 {
+	var loaded int64
+	for _, replica := range replicas {
+		for _, collectionID := range replica.getCollectionIDs() {
+			stats := &metricsinfo.NodeCollectionStats{}
+			collectSegmentStats(replica, collectionID, stats)
+			loaded += stats.LoadedMemoryBytes
+		}
+	}
+	return loaded
+}
+
+func (a *memoryAdmission) enabled() bool {
+	return a != nil && a.highWatermark > 0
+}
+
+func (a *memoryAdmission) watermarkBytes(watermark float64) int64 {
+	return int64(float64(a.totalMemory()) * watermark / 100)
+}
+
+// admitLoad returns an error if loading the segments of size bytes would exceed the high watermark
+func (a *memoryAdmission) admitLoad(size int64) error {
+	if !a.enabled() {
+		return nil
+	}
+	loaded := a.loadedBytes()
+	high := a.watermarkBytes(a.highWatermark)
+	a.mu.Lock()
+	inflight := a.inflight
+	a.mu.Unlock()
+	if loaded+inflight+size > high {
+		return fmt.Errorf("load segment failed, query node %d would be out of memory, "+
+			"%d bytes of segments to load, %d bytes loaded and %d bytes of running reads exceed the high watermark %d bytes",
+			Params.QueryNodeID, size, loaded, inflight, high)
+	}
+	return nil
+}
+
+// acquire reserves size bytes for a read, it waits for the running reads to release their memory up to the
+// queue timeout above the high watermark. The returned function releases the memory once the read is done
+func (a *memoryAdmission) acquire(msgType commonpb.MsgType, size int64) (func(), error) {
+	if !a.enabled() {
+		return func() {}, nil
+	}
+	timer := time.NewTimer(a.queueTimeout)
+	defer timer.Stop()
+	for {
+		loaded := a.loadedBytes()
+		high := a.watermarkBytes(a.highWatermark)
+		a.mu.Lock()
+		inflight, released := a.inflight, a.released
+		if loaded+inflight+size <= high {
+			a.inflight += size
+			a.mu.Unlock()
+			return func() { a.release(size) }, nil
+		}
+		a.mu.Unlock()
+
+		// nothing would be released if no read is running
+		if inflight > 0 {
+			select {
+			case <-released:
+				continue
+			case <-timer.C:
+			}
+		}
+		metrics.QueryNodeRejectedReadCounter.WithLabelValues(msgType.String()).Inc()
+		return nil, fmt.Errorf("query node %d is out of memory, %d bytes of the read, %d bytes loaded and %d bytes of running reads "+
+			"exceed the high watermark %d bytes, please retry later", Params.QueryNodeID, size, loaded, inflight, high)
+	}
+}
+
+func (a *memoryAdmission) release(size int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inflight -= size
+	close(a.released)
+	a.released = make(chan struct{})
+}
+
+// infos returns the estimated memory and the memory state of the query node reported to query coord
+func (a *memoryAdmission) infos() metricsinfo.QueryNodeMemoryInfos {
+	infos := metricsinfo.QueryNodeMemoryInfos{State: metricsinfo.MemoryStateNormal}
+	if !a.enabled() {
+		return infos
+	}
+	infos.LoadedBytes = a.loadedBytes()
+	a.mu.Lock()
+	infos.InflightBytes = a.inflight
+	a.mu.Unlock()
+	infos.LowWatermarkBytes = a.watermarkBytes(a.lowWatermark)
+	infos.HighWatermarkBytes = a.watermarkBytes(a.highWatermark)
+
+	used := infos.LoadedBytes + infos.InflightBytes
+	switch {
+	case used > infos.HighWatermarkBytes:
+		infos.State = metricsinfo.MemoryStateOverloaded
+	case used > infos.LowWatermarkBytes:
+		infos.State = metricsinfo.MemoryStateEvictable
+	}
+	return infos
+}
+
+// estimateReadMemory returns the estimated temporary memory of the reads over numSegments segments, the searches
+// reserve their vectors and topk hits of each segment, the memory of the queries is unknown and not counted
+func estimateReadMemory(msgs []queryMsg, numSegments int) int64 {
+	var size int64
+	for _, msg := range msgs {
+		searchMsg, ok := msg.(*msgstream.SearchMsg)
+		if !ok {
+			continue
+		}
+		size += int64(len(searchMsg.PlaceholderGroup))
+		var plan planpb.PlanNode
+		if err := proto.Unmarshal(searchMsg.SerializedExprPlan, &plan); err != nil {
+			continue
+		}
+		var group milvuspb.PlaceholderGroup
+		if err := proto.Unmarshal(searchMsg.PlaceholderGroup, &group); err != nil {
+			continue
+		}
+		topK := plan.GetVectorAnns().GetQueryInfo().GetTopk()
+		for _, placeholder := range group.Placeholders {
+			size += int64(len(placeholder.Values)) * topK * searchHitBytes * int64(numSegments)
+		}
+	}
+	return size
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestMemoryAdmission(t *testing.T) {
+	loaded := int64(0)
+	admission := newMemoryAdmission(50, 80, 20*time.Millisecond)
+	admission.totalMemory = func() uint64 { return 1000 }
+	admission.loadedBytes = func() int64 { return loaded }
+
+	assert.NoError(t, admission.admitLoad(700))
+	assert.Error(t, admission.admitLoad(900))
+	assert.Equal(t, metricsinfo.MemoryStateNormal, admission.infos().State)
+	loaded = 600
+	assert.Equal(t, metricsinfo.MemoryStateEvictable, admission.infos().State)
+	loaded = 850
+	infos := admission.infos()
+	assert.Equal(t, metricsinfo.MemoryStateOverloaded, infos.State)
+	assert.Equal(t, int64(500), infos.LowWatermarkBytes)
+	assert.Equal(t, int64(800), infos.HighWatermarkBytes)
+
+	// rejected at once if no read is running
+	_, err := admission.acquire(commonpb.MsgType_Search, 0)
+	assert.Error(t, err)
+
+	loaded = 700
+	release, err := admission.acquire(commonpb.MsgType_Search, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), admission.infos().InflightBytes)
+	assert.Error(t, admission.admitLoad(60))
+
+	// queued until the running read releases its memory, or rejected after the queue timeout
+	_, err = admission.acquire(commonpb.MsgType_Retrieve, 100)
+	assert.Error(t, err)
+	done := make(chan error)
+	go func() {
+		release, err := admission.acquire(commonpb.MsgType_Search, 100)
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	release()
+	assert.NoError(t, <-done)
+	assert.Equal(t, int64(0), admission.infos().InflightBytes)
+
+	// disabled
+	var nilAdmission *memoryAdmission
+	release, err = nilAdmission.acquire(commonpb.MsgType_Search, 1<<40)
+	assert.NoError(t, err)
+	release()
+	assert.NoError(t, nilAdmission.admitLoad(1<<40))
+	assert.Equal(t, metricsinfo.MemoryStateNormal, nilAdmission.infos().State)
+	disabled := newMemoryAdmission(0, 0, time.Second)
+	assert.NoError(t, disabled.admitLoad(1<<40))
+}
+
+func TestEstimateReadMemory(t *testing.T) {
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				QueryInfo: &planpb.QueryInfo{Topk: 10},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	placeholderGroup, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   milvuspb.PlaceholderType_FloatVector,
+			Values: [][]byte{make([]byte, 16), make([]byte, 16)},
+		}},
+	})
+	assert.NoError(t, err)
+	searchMsg := &msgstream.SearchMsg{
+		SearchRequest: internalpb.SearchRequest{
+			SerializedExprPlan: plan,
+			PlaceholderGroup:   placeholderGroup,
+		},
+	}
+	retrieveMsg := &msgstream.RetrieveMsg{}
+
+	size := int64(len(placeholderGroup)) + 2*10*searchHitBytes*3
+	assert.Equal(t, size, estimateReadMemory([]queryMsg{searchMsg}, 3))
+	assert.Equal(t, 2*size, estimateReadMemory([]queryMsg{searchMsg, searchMsg, retrieveMsg}, 3))
+	assert.Equal(t, int64(0), estimateReadMemory([]queryMsg{retrieveMsg}, 3))
+}
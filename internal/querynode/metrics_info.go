@@ -13,7 +13,14 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -50,6 +57,10 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 			RetrieveResultReceiveBufSize: Params.RetrieveResultReceiveBufSize,
 		},
 	}
+	if node.queryService != nil {
+		nodeInfos.Overloaded = node.queryService.admission.overloaded()
+	}
+	nodeInfos.MemoryInfos = node.memory.infos()
 	resp, err := metricsinfo.MarshalComponentInfos(nodeInfos)
 	if err != nil {
 		return &milvuspb.GetMetricsResponse{
@@ -71,3 +82,230 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
 	}, nil
 }
+
+// getLocalCacheMetrics purges or resizes the local disk cache as the request asks, and returns the statistics of the cache
+func getLocalCacheMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	if node.queryService == nil || node.queryService.localCache == nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "local cache is not initialized",
+			},
+		}, nil
+	}
+	localCache := node.queryService.localCache
+
+	cacheReq, err := metricsinfo.ParseLocalCacheRequest(req.Request)
+	if err == nil && cacheReq.Capacity != nil {
+		log.Debug("QueryNode resize local cache",
+			zap.Int64("node_id", Params.QueryNodeID),
+			zap.Int64("capacity", *cacheReq.Capacity))
+		localCache.Resize(*cacheReq.Capacity)
+	}
+	if err == nil && cacheReq.Purge {
+		log.Debug("QueryNode purge local cache",
+			zap.Int64("node_id", Params.QueryNodeID))
+		err = localCache.Purge()
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	resp, err := json.Marshal(localCache.Stats())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+	}, nil
+}
+
+func getWarmupMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	warmupReq, err := metricsinfo.ParseWarmupQueriesRequest(req.Request)
+	if err == nil && node.historical == nil {
+		err = errors.New("historical is not initialized")
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	result := node.historical.warmup(warmupReq.CollectionID, warmupReq.Queries)
+	log.Debug("QueryNode warmup done",
+		zap.Int64("node_id", Params.QueryNodeID),
+		zap.Int64("collectionID", result.CollectionID),
+		zap.Int("queries", result.Queries),
+		zap.Int("failed", result.Failed),
+		zap.Int64("elapsed_ms", result.ElapsedMs))
+
+	resp, err := json.Marshal(result)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+	}, nil
+}
+
+// getCollectionRuntimeStatsMetrics returns the loaded memory, the segments and the query qps of the collections on the node
+func getCollectionRuntimeStatsMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	statsReq, err := metricsinfo.ParseCollectionRuntimeStatsRequest(req.Request)
+	if err == nil && (node.historical == nil || node.streaming == nil) {
+		err = errors.New("historical or streaming is not initialized")
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+		}, nil
+	}
+
+	collectionIDs := statsReq.CollectionIDs
+	if len(collectionIDs) == 0 {
+		collectionIDs = append(node.historical.replica.getCollectionIDs(), node.streaming.replica.getCollectionIDs()...)
+	}
+	nodeStats := metricsinfo.NodeCollectionRuntimeStats{
+		NodeID:      Params.QueryNodeID,
+		Collections: make([]*metricsinfo.NodeCollectionStats, 0, len(collectionIDs)),
+	}
+	now := time.Now()
+	visited := make(map[UniqueID]struct{})
+	for _, collectionID := range collectionIDs {
+		if _, ok := visited[collectionID]; ok {
+			continue
+		}
+		visited[collectionID] = struct{}{}
+		if !node.historical.replica.hasCollection(collectionID) && !node.streaming.replica.hasCollection(collectionID) {
+			continue
+		}
+		stats := &metricsinfo.NodeCollectionStats{
+			CollectionID:     collectionID,
+			SealedSegmentIDs: make([]int64, 0),
+		}
+		collectSegmentStats(node.historical.replica, collectionID, stats)
+		collectSegmentStats(node.streaming.replica, collectionID, stats)
+		if node.queryService != nil {
+			stats.QueryQPS = node.queryService.queryCounter.qps(collectionID, now)
+		}
+		nodeStats.Collections = append(nodeStats.Collections, stats)
+	}
+
+	resp, err := json.Marshal(nodeStats)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+	}, nil
+}
+
+// collectSegmentStats adds the memory and the segments of the collection in replica to stats,
+// the indexing segments are counted as sealed
+func collectSegmentStats(replica ReplicaInterface, collectionID UniqueID, stats *metricsinfo.NodeCollectionStats) {
+	partitionIDs, err := replica.getPartitionIDs(collectionID)
+	if err != nil {
+		return
+	}
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(partitionID)
+		if err != nil {
+			continue
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				continue
+			}
+			if size := segment.getMemSize(); size > 0 {
+				stats.LoadedMemoryBytes += size
+			}
+			switch segment.getType() {
+			case segmentTypeGrowing:
+				stats.GrowingSegments++
+			case segmentTypeSealed, segmentTypeIndexing:
+				stats.SealedSegmentIDs = append(stats.SealedSegmentIDs, segmentID)
+			}
+		}
+	}
+}
+
+func getWarmupSegmentsMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	warmupReq, err := metricsinfo.ParseWarmupSegmentsRequest(req.Request)
+	if err == nil && node.historical == nil {
+		err = errors.New("historical is not initialized")
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	result := node.historical.warmupSegments(warmupReq.CollectionID, warmupReq.SegmentIDs)
+	log.Debug("QueryNode warmup segments done",
+		zap.Int64("node_id", Params.QueryNodeID),
+		zap.Int64("collectionID", result.CollectionID),
+		zap.Int("segments", result.Segments),
+		zap.Int("failed", result.Failed),
+		zap.Int64("touched_bytes", result.TouchedBytes),
+		zap.Int64("elapsed_ms", result.ElapsedMs))
+
+	resp, err := json.Marshal(result)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
+	}, nil
+}
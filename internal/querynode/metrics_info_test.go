@@ -13,12 +13,17 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
@@ -38,3 +43,74 @@ func TestGetSystemInfoMetrics(t *testing.T) {
 	assert.NoError(t, err)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 }
+
+func TestGetLocalCacheMetrics(t *testing.T) {
+	ctx := context.Background()
+	node := &QueryNode{}
+	resp, err := getLocalCacheMetrics(ctx, &milvuspb.GetMetricsRequest{}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+	cachePath := "/tmp/milvus/test_local_cache"
+	defer os.RemoveAll(cachePath)
+	node.queryService = &queryService{localCache: storage.NewLocalCache(cachePath, 0)}
+	err = node.queryService.localCache.Write("1", []byte{1, 2, 3})
+	assert.NoError(t, err)
+
+	resp, err = getLocalCacheMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "local_cache", "capacity": 1024}`}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	stats := storage.LocalCacheStats{}
+	err = json.Unmarshal([]byte(resp.Response), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), stats.Capacity)
+	assert.Equal(t, int64(3), stats.Size)
+
+	resp, err = getLocalCacheMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "local_cache", "purge": true}`}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	err = json.Unmarshal([]byte(resp.Response), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Size)
+
+	resp, err = getLocalCacheMetrics(ctx, &milvuspb.GetMetricsRequest{Request: "not in json format"}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
+
+func TestGetCollectionRuntimeStatsMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := getCollectionRuntimeStatsMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "collection_runtime_stats"}`}, &QueryNode{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	node.queryService.queryCounter.add(defaultCollectionID, time.Now())
+
+	req, err := metricsinfo.ConstructCollectionRuntimeStatsRequest(nil)
+	assert.NoError(t, err)
+	resp, err = getCollectionRuntimeStatsMetrics(ctx, req, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	stats := metricsinfo.NodeCollectionRuntimeStats{}
+	err = json.Unmarshal([]byte(resp.Response), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.QueryNodeID, stats.NodeID)
+	assert.Equal(t, 1, len(stats.Collections))
+	assert.Equal(t, defaultCollectionID, stats.Collections[0].CollectionID)
+	assert.Equal(t, []int64{defaultSegmentID}, stats.Collections[0].SealedSegmentIDs)
+	assert.Equal(t, 1, stats.Collections[0].GrowingSegments)
+	assert.True(t, stats.Collections[0].LoadedMemoryBytes > 0)
+	assert.True(t, stats.Collections[0].QueryQPS > 0)
+
+	req, err = metricsinfo.ConstructCollectionRuntimeStatsRequest([]int64{defaultCollectionID + 1})
+	assert.NoError(t, err)
+	resp, err = getCollectionRuntimeStatsMetrics(ctx, req, node)
+	assert.NoError(t, err)
+	err = json.Unmarshal([]byte(resp.Response), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(stats.Collections))
+}
@@ -86,6 +86,10 @@ func (m *mockRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowC
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoord) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -151,6 +155,27 @@ func (m *mockRootCoord) ReleaseDQLMessageStream(ctx context.Context, req *proxyp
 func (m *mockRootCoord) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
+func (m *mockRootCoord) CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) UndropCollection(ctx context.Context, req *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+func (m *mockRootCoord) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
 func (m *mockRootCoord) AddNewSegment(ctx context.Context, in *datapb.SegmentMsg) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -211,3 +236,19 @@ func (m *mockIndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.Get
 func (m *mockIndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	panic("not implemented") // TODO: Implement
 }
+
+func (m *mockIndexCoord) PauseIndexBuild(ctx context.Context, req *indexpb.PauseIndexBuildRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockIndexCoord) ResumeIndexBuild(ctx context.Context, req *indexpb.ResumeIndexBuildRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockIndexCoord) SetIndexBuildPriority(ctx context.Context, req *indexpb.SetIndexBuildPriorityRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockIndexCoord) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -38,6 +39,10 @@ type ParamTable struct {
 
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
+	// the input node of an idle flowgraph passes one of FlowGraphSkipModeInterval empty msg packs after
+	// FlowGraphSkipModeIdleTicks empty msg packs in a row, skip mode is disabled if either is 0
+	FlowGraphSkipModeIdleTicks int32
+	FlowGraphSkipModeInterval  int32
 
 	// minio
 	MinioEndPoint        string
@@ -68,7 +73,35 @@ type ParamTable struct {
 	MsgChannelSubName string
 	SliceIndex        int
 
+	// MaxGuaranteeWait is how long a search or a query waits for the tsafe to reach its guarantee timestamp,
+	// it waits with no limit if it's 0
+	MaxGuaranteeWait time.Duration
+
 	Log log.Config
+
+	SlowLogThreshold time.Duration
+	SlowLogFile      log.FileLogConfig
+
+	// admission
+	CPUWatermark           float64
+	AdmissionCheckInterval time.Duration
+	MemoryLowWatermark     float64
+	MemoryHighWatermark    float64
+	MemoryQueueTimeout     time.Duration
+
+	// read scheduler
+	ReadWorkers                     int
+	MaxReadConcurrencyPerCollection int
+	MaxSearchBatchNQ                int64
+
+	// segment cache
+	SegmentCacheEnabled  bool
+	SegmentCacheCapacity int64
+
+	// cpu, 0 is detected from the cgroup and the NUMA topology
+	MaxProcs       int
+	SegcoreThreads int
+	NUMAAware      bool
 }
 
 var Params ParamTable
@@ -100,9 +133,11 @@ func (p *ParamTable) Init() {
 		p.initMetaRootPath()
 
 		p.initGracefulTime()
+		p.initMaxGuaranteeWait()
 
 		p.initFlowGraphMaxQueueLength()
 		p.initFlowGraphMaxParallelism()
+		p.initFlowGraphSkipMode()
 
 		p.initSearchReceiveBufSize()
 		p.initSearchPulsarBufSize()
@@ -112,6 +147,11 @@ func (p *ParamTable) Init() {
 		p.initStatsChannelName()
 
 		p.initLogCfg()
+		p.initSlowLog()
+		p.initAdmission()
+		p.initReadScheduler()
+		p.initSegmentCache()
+		p.initCPU()
 	})
 }
 
@@ -200,6 +240,25 @@ func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("queryNode.dataSync.flowGraph.maxParallelism")
 }
 
+func (p *ParamTable) initFlowGraphSkipMode() {
+	load := func(key string, defaultValue string) int32 {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.ParseInt(str, 10, 32)
+		if err != nil {
+			panic(err)
+		}
+		if v < 0 {
+			panic(fmt.Sprintf("queryNode must not be negative, got %d", key, v))
+		}
+		return int32(v)
+	}
+	p.FlowGraphSkipModeIdleTicks = load("queryNode.dataSync.flowGraph.skipMode.idleTicks", "50")
+	p.FlowGraphSkipModeInterval = load("queryNode.dataSync.flowGraph.skipMode.skipInterval", "5")
+}
+
 // msgStream
 func (p *ParamTable) initSearchReceiveBufSize() {
 	p.SearchReceiveBufSize = p.ParseInt64("queryNode.msgStream.search.recvBufSize")
@@ -237,6 +296,21 @@ func (p *ParamTable) initGracefulTime() {
 	p.GracefulTime = p.ParseInt64("queryNode.gracefulTime")
 }
 
+func (p *ParamTable) initMaxGuaranteeWait() {
+	str, err := p.LoadWithDefault("queryNode.maxGuaranteeWait", "30000")
+	if err != nil {
+		panic(err)
+	}
+	wait, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if wait < 0 {
+		panic(fmt.Errorf("queryNode.maxGuaranteeWait must not be negative, got %d", wait))
+	}
+	p.MaxGuaranteeWait = time.Duration(wait) * time.Millisecond
+}
+
 func (p *ParamTable) initMsgChannelSubName() {
 	namePrefix, err := p.Load("msgChannel.subNamePrefix.queryNodeSubNamePrefix")
 	if err != nil {
@@ -279,3 +353,161 @@ func (p *ParamTable) initLogCfg() {
 		p.Log.File.Filename = ""
 	}
 }
+
+func (p *ParamTable) initAdmission() {
+	str, err := p.LoadWithDefault("queryNode.admission.cpuWatermark", "0")
+	if err != nil {
+		panic(err)
+	}
+	watermark, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if watermark < 0 || watermark > 100 {
+		panic(fmt.Sprintf("queryNode.admission.cpuWatermark must be in [0, 100], got %v", watermark))
+	}
+	p.CPUWatermark = watermark
+
+	str, err = p.LoadWithDefault("queryNode.admission.checkInterval", "1000")
+	if err != nil {
+		panic(err)
+	}
+	interval, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("queryNode.admission.checkInterval must be positive, got %d", interval))
+	}
+	p.AdmissionCheckInterval = time.Duration(interval) * time.Millisecond
+
+	str, err = p.LoadWithDefault("queryNode.admission.memoryHighWatermark", "90")
+	if err != nil {
+		panic(err)
+	}
+	high, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if high < 0 || high > 100 {
+		panic(fmt.Sprintf("queryNode.admission.memoryHighWatermark must be in [0, 100], got %v", high))
+	}
+	p.MemoryHighWatermark = high
+
+	str, err = p.LoadWithDefault("queryNode.admission.memoryLowWatermark", "80")
+	if err != nil {
+		panic(err)
+	}
+	low, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		panic(err)
+	}
+	if low < 0 || (high > 0 && low > high) {
+		panic(fmt.Sprintf("queryNode.admission.memoryLowWatermark must be in [0, memoryHighWatermark], got %v", low))
+	}
+	p.MemoryLowWatermark = low
+
+	str, err = p.LoadWithDefault("queryNode.admission.memoryQueueTimeout", "3000")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if timeout < 0 {
+		panic(fmt.Sprintf("queryNode.admission.memoryQueueTimeout must not be negative, got %d", timeout))
+	}
+	p.MemoryQueueTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (p *ParamTable) initReadScheduler() {
+	load := func(key string, defaultValue string) int64 {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		if v < 0 {
+			panic(fmt.Sprintf("%s must not be negative, got %d", key, v))
+		}
+		return v
+	}
+	p.ReadWorkers = int(load("queryNode.scheduler.workers", "0"))
+	p.MaxReadConcurrencyPerCollection = int(load("queryNode.scheduler.maxConcurrencyPerCollection", "0"))
+	p.MaxSearchBatchNQ = load("queryNode.scheduler.maxBatchNQ", "64")
+}
+
+func (p *ParamTable) initSegmentCache() {
+	str, err := p.LoadWithDefault("queryNode.segmentCache.enabled", "false")
+	if err != nil {
+		panic(err)
+	}
+	enabled, err := strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+	p.SegmentCacheEnabled = enabled
+
+	str, err = p.LoadWithDefault("queryNode.segmentCache.capacity", "4096")
+	if err != nil {
+		panic(err)
+	}
+	capacity, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	if enabled && capacity <= 0 {
+		panic(fmt.Sprintf("queryNode.segmentCache.capacity must be positive, got %d", capacity))
+	}
+	p.SegmentCacheCapacity = capacity * 1024 * 1024
+}
+
+func (p *ParamTable) initCPU() {
+	load := func(key string, defaultValue string) int {
+		str, err := p.LoadWithDefault(key, defaultValue)
+		if err != nil {
+			panic(err)
+		}
+		v, err := strconv.Atoi(str)
+		if err != nil {
+			panic(err)
+		}
+		if v < 0 {
+			panic(fmt.Sprintf("%s must not be negative, got %d", key, v))
+		}
+		return v
+	}
+	p.MaxProcs = load("queryNode.cpu.maxProcs", "0")
+	p.SegcoreThreads = load("queryNode.cpu.segcoreThreads", "0")
+
+	str, err := p.LoadWithDefault("queryNode.cpu.numaAware", "true")
+	if err != nil {
+		panic(err)
+	}
+	numaAware, err := strconv.ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+	p.NUMAAware = numaAware
+}
+
+func (p *ParamTable) initSlowLog() {
+	str, err := p.LoadWithDefault("queryNode.slowLog.threshold", "3000")
+	if err != nil {
+		panic(err)
+	}
+	threshold, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.SlowLogThreshold = time.Duration(threshold) * time.Millisecond
+
+	p.SlowLogFile = p.Log.File
+	if len(p.Log.File.Filename) != 0 {
+		p.SlowLogFile.Filename = path.Join(path.Dir(p.Log.File.Filename), fmt.Sprintf("querynode-slow-%s.log", p.Alias))
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -86,6 +87,16 @@ func TestParamTable_flowGraphMaxParallelism(t *testing.T) {
 	assert.Equal(t, int32(1024), maxParallelism)
 }
 
+func TestParamTable_flowGraphSkipMode(t *testing.T) {
+	assert.Equal(t, int32(50), Params.FlowGraphSkipModeIdleTicks)
+	assert.Equal(t, int32(5), Params.FlowGraphSkipModeInterval)
+
+	Params.Save("queryNode.dataSync.flowGraph.skipMode.skipInterval", "-1")
+	assert.Panics(t, func() { Params.initFlowGraphSkipMode() })
+	Params.Save("queryNode.dataSync.flowGraph.skipMode.skipInterval", "5")
+	Params.initFlowGraphSkipMode()
+}
+
 func TestParamTable_msgChannelSubName(t *testing.T) {
 	Params.initMsgChannelSubName()
 	name := Params.MsgChannelSubName
@@ -103,3 +114,81 @@ func TestParamTable_metaRootPath(t *testing.T) {
 	path := Params.MetaRootPath
 	fmt.Println(path)
 }
+
+func TestParamTable_slowLog(t *testing.T) {
+	Params.Save("queryNode.slowLog.threshold", "500")
+	Params.initSlowLog()
+	assert.Equal(t, 500*time.Millisecond, Params.SlowLogThreshold)
+	assert.Equal(t, Params.Log.File.MaxSize, Params.SlowLogFile.MaxSize)
+}
+
+func TestParamTable_maxGuaranteeWait(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Params.MaxGuaranteeWait)
+
+	Params.Save("queryNode.maxGuaranteeWait", "0")
+	Params.initMaxGuaranteeWait()
+	assert.Equal(t, time.Duration(0), Params.MaxGuaranteeWait)
+
+	Params.Save("queryNode.maxGuaranteeWait", "-1")
+	assert.Panics(t, func() { Params.initMaxGuaranteeWait() })
+
+	Params.Save("queryNode.maxGuaranteeWait", "30000")
+	Params.initMaxGuaranteeWait()
+}
+
+func TestParamTable_admission(t *testing.T) {
+	Params.Save("queryNode.admission.cpuWatermark", "85")
+	Params.initAdmission()
+	assert.Equal(t, float64(85), Params.CPUWatermark)
+	assert.Equal(t, time.Second, Params.AdmissionCheckInterval)
+
+	Params.Save("queryNode.admission.cpuWatermark", "0")
+	Params.initAdmission()
+	assert.Equal(t, float64(0), Params.CPUWatermark)
+	assert.Equal(t, float64(80), Params.MemoryLowWatermark)
+	assert.Equal(t, float64(90), Params.MemoryHighWatermark)
+	assert.Equal(t, 3*time.Second, Params.MemoryQueueTimeout)
+
+	Params.Save("queryNode.admission.memoryLowWatermark", "95")
+	assert.Panics(t, func() { Params.initAdmission() })
+	Params.Save("queryNode.admission.memoryLowWatermark", "80")
+	Params.initAdmission()
+}
+
+func TestParamTable_readScheduler(t *testing.T) {
+	Params.initReadScheduler()
+	assert.Equal(t, 0, Params.ReadWorkers)
+	assert.Equal(t, 0, Params.MaxReadConcurrencyPerCollection)
+	assert.Equal(t, int64(64), Params.MaxSearchBatchNQ)
+
+	Params.Save("queryNode.scheduler.maxConcurrencyPerCollection", "2")
+	Params.initReadScheduler()
+	assert.Equal(t, 2, Params.MaxReadConcurrencyPerCollection)
+	Params.Save("queryNode.scheduler.maxConcurrencyPerCollection", "0")
+}
+
+func TestParamTable_segmentCache(t *testing.T) {
+	Params.initSegmentCache()
+	assert.False(t, Params.SegmentCacheEnabled)
+	assert.Equal(t, int64(4096*1024*1024), Params.SegmentCacheCapacity)
+
+	Params.Save("queryNode.segmentCache.enabled", "true")
+	Params.Save("queryNode.segmentCache.capacity", "0")
+	assert.Panics(t, func() { Params.initSegmentCache() })
+
+	Params.Save("queryNode.segmentCache.enabled", "false")
+	Params.Save("queryNode.segmentCache.capacity", "4096")
+	Params.initSegmentCache()
+}
+
+func TestParamTable_cpu(t *testing.T) {
+	Params.initCPU()
+	assert.Equal(t, 0, Params.MaxProcs)
+	assert.Equal(t, 0, Params.SegcoreThreads)
+	assert.True(t, Params.NUMAAware)
+
+	Params.Save("queryNode.cpu.segcoreThreads", "-1")
+	assert.Panics(t, func() { Params.initCPU() })
+	Params.Save("queryNode.cpu.segcoreThreads", "0")
+	Params.initCPU()
+}
@@ -23,11 +23,17 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"time"
 	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	// deadline is the unix time in milliseconds after which the search is abandoned, 0 means no deadline
+	deadline int64
 }
 
 func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
@@ -40,9 +46,8 @@ func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlan(col.collectionPtr, cDsl, &cPlan)
 
-	err1 := HandleCStatus(&status, "Create Plan failed")
-	if err1 != nil {
-		return nil, err1
+	if err := newCStatusError(&status, "CreateSearchPlan"); err != nil {
+		return nil, err.WithCollection(col.id)
 	}
 
 	var newPlan = &SearchPlan{cSearchPlan: cPlan}
@@ -54,12 +59,15 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
 
+	if len(expr) == 0 {
+		return nil, cgoerror.Newf("CreateSearchPlanByExpr", commonpb.ErrorCode_IllegalArgument, "empty expression plan").WithCollection(col.id)
+	}
+
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlanByExpr(col.collectionPtr, (*C.char)(unsafe.Pointer(&expr[0])), (C.int64_t)(len(expr)), &cPlan)
 
-	err1 := HandleCStatus(&status, "Create Plan by expr failed")
-	if err1 != nil {
-		return nil, err1
+	if err := newCStatusError(&status, "CreateSearchPlanByExpr"); err != nil {
+		return nil, err.WithCollection(col.id)
 	}
 
 	var newPlan = &SearchPlan{cSearchPlan: cPlan}
@@ -78,6 +86,15 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// checkDeadline returns an error if the deadline of the search has passed, so that the remaining segments are not searched
+func (plan *SearchPlan) checkDeadline(now time.Time) *cgoerror.Error {
+	if plan.deadline == 0 || now.UnixNano()/int64(time.Millisecond) < plan.deadline {
+		return nil
+	}
+	return cgoerror.Newf("Search", commonpb.ErrorCode_UnexpectedError, "search deadline exceeded").
+		WithParam("deadline", time.Unix(0, plan.deadline*int64(time.Millisecond)).Format(time.RFC3339Nano))
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -95,7 +112,7 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*searchRequ
 	var cPlaceholderGroup C.CPlaceholderGroup
 	status := C.ParsePlaceholderGroup(plan.cSearchPlan, blobPtr, blobSize, &cPlaceholderGroup)
 
-	if err := HandleCStatus(&status, "parser searchRequest failed"); err != nil {
+	if err := HandleCStatus(&status, "ParsePlaceholderGroup"); err != nil {
 		return nil, err
 	}
 
@@ -133,13 +150,19 @@ type RetrievePlan struct {
 // }
 
 func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp uint64) (*RetrievePlan, error) {
+	if col.collectionPtr == nil {
+		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
+	}
+	if len(expr) == 0 {
+		return nil, cgoerror.Newf("CreateRetrievePlanByExpr", commonpb.ErrorCode_IllegalArgument, "empty expression plan").WithCollection(col.id)
+	}
+
 	var cPlan C.CRetrievePlan
 	status := C.CreateRetrievePlanByExpr(col.collectionPtr, (*C.char)(unsafe.Pointer(&expr[0])),
 		(C.int64_t)(len(expr)), &cPlan)
 
-	err := HandleCStatus(&status, "Create retrieve plan by expr failed")
-	if err != nil {
-		return nil, err
+	if err := newCStatusError(&status, "CreateRetrievePlanByExpr"); err != nil {
+		return nil, err.WithCollection(col.id)
 	}
 
 	var newPlan = &RetrievePlan{
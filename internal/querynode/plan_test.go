@@ -15,6 +15,7 @@ import (
 	"encoding/binary"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -101,3 +102,17 @@ func TestPlan_PlaceholderGroup(t *testing.T) {
 	holder.delete()
 	deleteCollection(collection)
 }
+
+func TestPlan_CheckDeadline(t *testing.T) {
+	now := time.Unix(1000, 0)
+	plan := &SearchPlan{}
+	assert.Nil(t, plan.checkDeadline(now))
+
+	plan.deadline = 1000*1000 + 1
+	assert.Nil(t, plan.checkDeadline(now))
+
+	plan.deadline = 1000 * 1000
+	err := plan.checkDeadline(now)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Search", err.Op)
+}
@@ -17,7 +17,10 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 	"unsafe"
 
 	oplog "github.com/opentracing/opentracing-go/log"
@@ -25,6 +28,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -33,6 +37,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -54,8 +60,10 @@ type queryCollection struct {
 	historical   *historical
 	streaming    *streaming
 
-	unsolvedMsgMu sync.Mutex // guards unsolvedMsg
+	unsolvedMsgMu sync.Mutex // guards unsolvedMsg and unsolvedSince
 	unsolvedMsg   []queryMsg
+	// unsolvedSince are the times the unsolved messages started waiting for their guarantee timestamps
+	unsolvedSince map[UniqueID]time.Time
 
 	tSafeWatchers     map[Channel]*tSafeWatcher
 	watcherSelectCase []reflect.SelectCase
@@ -68,8 +76,16 @@ type queryCollection struct {
 
 	localChunkManager  storage.ChunkManager
 	remoteChunkManager storage.ChunkManager
+	vcmMu              sync.Mutex // guards vectorChunkManager
 	vectorChunkManager storage.ChunkManager
 	localCacheEnabled  bool
+
+	slowLogger   *slowlog.Logger
+	admission    *cpuAdmission
+	memory       *memoryAdmission // nil if not served by queryService
+	queryCounter *queryCounter    // nil if not served by queryService
+	// scheduler runs the searches and queries, they are run in place if it is nil
+	scheduler *readScheduler
 }
 
 type ResultEntityIds []UniqueID
@@ -103,7 +119,8 @@ func newQueryCollection(releaseCtx context.Context,
 
 		tSafeWatchers: make(map[Channel]*tSafeWatcher),
 
-		unsolvedMsg: unsolvedMsg,
+		unsolvedMsg:   unsolvedMsg,
+		unsolvedSince: make(map[UniqueID]time.Time),
 
 		queryMsgStream:       queryStream,
 		queryResultMsgStream: queryResultStream,
@@ -131,6 +148,9 @@ func (q *queryCollection) close() {
 	if q.queryResultMsgStream != nil {
 		q.queryResultMsgStream.Close()
 	}
+	for channel := range q.tSafeWatchers {
+		metrics.QueryNodeTSafeLag.DeleteLabelValues(channel)
+	}
 }
 
 func (q *queryCollection) register() {
@@ -160,6 +180,27 @@ func (q *queryCollection) addToUnsolvedMsg(msg queryMsg) {
 	q.unsolvedMsgMu.Lock()
 	defer q.unsolvedMsgMu.Unlock()
 	q.unsolvedMsg = append(q.unsolvedMsg, msg)
+	if _, ok := q.unsolvedSince[msg.ID()]; !ok {
+		q.unsolvedSince[msg.ID()] = time.Now()
+	}
+}
+
+// unsolvedWaitTime returns how long msg has been waiting for its guarantee timestamp
+func (q *queryCollection) unsolvedWaitTime(msg queryMsg) time.Duration {
+	q.unsolvedMsgMu.Lock()
+	defer q.unsolvedMsgMu.Unlock()
+	since, ok := q.unsolvedSince[msg.ID()]
+	if !ok {
+		return 0
+	}
+	return time.Since(since)
+}
+
+// forgetUnsolvedMsg forgets the waiting time of msg once it's solved or failed
+func (q *queryCollection) forgetUnsolvedMsg(msg queryMsg) {
+	q.unsolvedMsgMu.Lock()
+	defer q.unsolvedMsgMu.Unlock()
+	delete(q.unsolvedSince, msg.ID())
 }
 
 func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
@@ -171,24 +212,77 @@ func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
 	return ret
 }
 
+// tSafeCheckInterval is the interval of checking the unsolved messages and the tsafe lags while the tsafe of
+// the channels doesn't change
+const tSafeCheckInterval = time.Second
+
 func (q *queryCollection) waitNewTSafe() Timestamp {
-	// block until any vChannel updating tSafe
-	_, _, recvOK := reflect.Select(q.watcherSelectCase)
-	if !recvOK {
+	// block until any vChannel updating tSafe, or the check interval elapsed
+	timer := time.NewTimer(tSafeCheckInterval)
+	defer timer.Stop()
+	numWatchers := len(q.watcherSelectCase)
+	cases := append(q.watcherSelectCase[:numWatchers:numWatchers], reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(timer.C),
+	})
+	chosen, _, recvOK := reflect.Select(cases)
+	if chosen < numWatchers && !recvOK {
 		//log.Warn("tSafe has been closed", zap.Any("collectionID", q.collectionID))
 		return Timestamp(math.MaxInt64)
 	}
 	//log.Debug("wait new tSafe", zap.Any("collectionID", s.collectionID))
 	t := Timestamp(math.MaxInt64)
+	now := time.Now()
 	for channel := range q.tSafeWatchers {
 		ts := q.streaming.tSafeReplica.getTSafe(channel)
 		if ts <= t {
 			t = ts
 		}
+		if ts > 0 {
+			physical, _ := tsoutil.ParseTS(ts)
+			metrics.QueryNodeTSafeLag.WithLabelValues(channel).Set(float64(now.Sub(physical).Milliseconds()))
+		}
 	}
 	return t
 }
 
+// guaranteeTimeoutError returns the error of msg waiting too long for its guarantee timestamp, which tells the
+// channels whose tsafe lag behind it
+func (q *queryCollection) guaranteeTimeoutError(msg queryMsg, wait time.Duration) error {
+	guaranteeTs := msg.GuaranteeTs()
+	gt, _ := tsoutil.ParseTS(guaranteeTs)
+	lagging := make([]string, 0)
+	for channel := range q.tSafeWatchers {
+		ts := q.streaming.tSafeReplica.getTSafe(channel)
+		if ts >= guaranteeTs {
+			continue
+		}
+		if ts == 0 {
+			lagging = append(lagging, fmt.Sprintf("%s (no tsafe)", channel))
+			continue
+		}
+		physical, _ := tsoutil.ParseTS(ts)
+		lagging = append(lagging, fmt.Sprintf("%s (%s behind)", channel, gt.Sub(physical)))
+	}
+	sort.Strings(lagging)
+	return merr.Errorf(merr.ErrTimeTickLagging, "%s %d waited %s for the guarantee timestamp %s, the lagging channels: %s",
+		msg.Type().String(), msg.ID(), wait.Round(time.Millisecond), gt.Format(time.RFC3339Nano), strings.Join(lagging, ", "))
+}
+
+// timeoutUnsolvedMsg fails msg which waited too long for its guarantee timestamp
+func (q *queryCollection) timeoutUnsolvedMsg(msg queryMsg, wait time.Duration) {
+	q.forgetUnsolvedMsg(msg)
+	metrics.QueryNodeGuaranteeTimeoutCounter.WithLabelValues(msg.Type().String()).Inc()
+	err := q.guaranteeTimeoutError(msg, wait)
+	log.Warn("the guarantee timestamp is not reached in time",
+		zap.Int64("collectionID", q.collectionID),
+		zap.Int64("msgID", msg.ID()),
+		zap.Error(err))
+	if publishErr := q.publishFailedQueryResult(msg, err); publishErr != nil {
+		log.Warn(publishErr.Error())
+	}
+}
+
 func (q *queryCollection) getServiceableTime() Timestamp {
 	q.serviceableTimeMutex.Lock()
 	defer q.serviceableTimeMutex.Unlock()
@@ -289,6 +383,9 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//err := fmt.Errorf("not target collection query request, collectionID = %d, targetCollectionID = %d, msgID = %d", q.collectionID, collectionID, msg.ID())
 		return nil
 	}
+	if msgType == commonpb.MsgType_Search && !isTargetNode(msg.(*msgstream.SearchMsg).NodeIDs, Params.QueryNodeID) {
+		return nil
+	}
 
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -297,7 +394,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	// check if collection has been released
 	collection, err := q.historical.replica.getCollectionByID(collectionID)
 	if err != nil {
-		publishErr := q.publishFailedQueryResult(msg, err.Error())
+		publishErr := q.publishFailedQueryResult(msg, err)
 		if publishErr != nil {
 			finalErr := fmt.Errorf("first err = %s, second err = %s", err, publishErr)
 			return finalErr
@@ -312,7 +409,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	guaranteeTs := msg.GuaranteeTs()
 	if guaranteeTs >= collection.getReleaseTime() {
 		err = fmt.Errorf("retrieve failed, collection has been released, msgID = %d, collectionID = %d", msg.ID(), collectionID)
-		publishErr := q.publishFailedQueryResult(msg, err.Error())
+		publishErr := q.publishFailedQueryResult(msg, err)
 		if publishErr != nil {
 			finalErr := fmt.Errorf("first err = %s, second err = %s", err, publishErr)
 			return finalErr
@@ -325,6 +422,20 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		return err
 	}
 
+	if err = q.admission.admit(msgType); err != nil {
+		publishErr := q.publishFailedQueryResult(msg, err)
+		if publishErr != nil {
+			finalErr := fmt.Errorf("first err = %s, second err = %s", err, publishErr)
+			return finalErr
+		}
+		log.Debug("reject query in receiveQueryMsg, publish failed query result",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("msgID", msg.ID()),
+			zap.String("msgType", msgTypeStr),
+		)
+		return err
+	}
+
 	serviceTime := q.getServiceableTime()
 	if guaranteeTs > serviceTime && len(collection.getVChannels()) > 0 {
 		gt, _ := tsoutil.ParseTS(guaranteeTs)
@@ -349,6 +460,13 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	}
 	tr.Record("get searchable time done")
 
+	if q.scheduler != nil {
+		q.scheduler.add(newReadTask(q, collectionID, msg))
+		tr.Record("add to read scheduler done")
+		sp.Finish()
+		return nil
+	}
+
 	log.Debug("doing query in receiveQueryMsg...",
 		zap.Int64("collectionID", collectionID),
 		zap.Int64("msgID", msg.ID()),
@@ -364,9 +482,12 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		return err
 	}
 	tr.Record("operation done")
+	if q.queryCounter != nil {
+		q.queryCounter.add(collectionID, time.Now())
+	}
 
 	if err != nil {
-		publishErr := q.publishFailedQueryResult(msg, err.Error())
+		publishErr := q.publishFailedQueryResult(msg, err)
 		if publishErr != nil {
 			finalErr := fmt.Errorf("first err = %s, second err = %s", err, publishErr)
 			return finalErr
@@ -388,6 +509,20 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	return nil
 }
 
+// isTargetNode returns whether a search is sent to the query node, the proxy only sends the searches of some
+// partitions to the query nodes holding their segments
+func isTargetNode(nodeIDs []UniqueID, nodeID UniqueID) bool {
+	if len(nodeIDs) == 0 {
+		return true
+	}
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
 func (q *queryCollection) doUnsolvedQueryMsg() {
 	log.Debug("starting doUnsolvedMsg...", zap.Any("collectionID", q.collectionID))
 	for {
@@ -422,9 +557,14 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 					zap.Any("serviceTime_l", serviceTime),
 				)
 				if guaranteeTs <= serviceTime {
+					q.forgetUnsolvedMsg(m)
 					unSolvedMsg = append(unSolvedMsg, m)
 					continue
 				}
+				if wait := q.unsolvedWaitTime(m); Params.MaxGuaranteeWait > 0 && wait > Params.MaxGuaranteeWait {
+					q.timeoutUnsolvedMsg(m, wait)
+					continue
+				}
 				log.Debug("query node::doUnsolvedMsg: add to unsolvedMsg",
 					zap.Any("collectionID", q.collectionID),
 					zap.Any("sm.BeginTs", gt),
@@ -439,6 +579,10 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 				continue
 			}
 			for _, m := range unSolvedMsg {
+				if q.scheduler != nil {
+					q.scheduler.add(newReadTask(q, q.collectionID, m))
+					continue
+				}
 				msgType := m.Type()
 				var err error
 				sp, ctx := trace.StartSpanFromContext(m.TraceCtx())
@@ -460,7 +604,7 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 
 				if err != nil {
 					log.Warn(err.Error())
-					err = q.publishFailedQueryResult(m, err.Error())
+					err = q.publishFailedQueryResult(m, err)
 					if err != nil {
 						log.Warn(err.Error())
 					} else {
@@ -481,6 +625,88 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 	}
 }
 
+// executeReadTasks runs a query, or a batch of searches merged by the readScheduler, once their estimated
+// memory is admitted, and publishes the failed results if it fails
+func executeReadTasks(tasks []*readTask) {
+	q := tasks[0].q
+	var size int64
+	if q.memory.enabled() {
+		msgs := make([]queryMsg, 0, len(tasks))
+		for _, t := range tasks {
+			msgs = append(msgs, t.msg)
+		}
+		size = estimateReadMemory(msgs, q.getNumSegments())
+	}
+	release, err := q.memory.acquire(tasks[0].msg.Type(), size)
+	if err == nil {
+		err = runReadTasks(q, tasks)
+		release()
+	}
+
+	now := time.Now()
+	for _, t := range tasks {
+		if q.queryCounter != nil {
+			q.queryCounter.add(q.collectionID, now)
+		}
+		if err == nil {
+			continue
+		}
+		if publishErr := q.publishFailedQueryResult(t.msg, err); publishErr != nil {
+			log.Warn(fmt.Sprintf("first err = %s, second err = %s", err, publishErr))
+			continue
+		}
+		log.Debug("do query failed in read scheduler, publish failed query result",
+			zap.Int64("collectionID", q.collectionID),
+			zap.Int64("msgID", t.msg.ID()),
+			zap.Error(err),
+		)
+	}
+}
+
+func runReadTasks(q *queryCollection, tasks []*readTask) error {
+	switch msg := tasks[0].msg.(type) {
+	case *msgstream.RetrieveMsg:
+		return q.retrieve(msg)
+	case *msgstream.SearchMsg:
+		searchMsgs := make([]*msgstream.SearchMsg, 0, len(tasks))
+		for _, t := range tasks {
+			searchMsgs = append(searchMsgs, t.msg.(*msgstream.SearchMsg))
+		}
+		return q.searchBatch(searchMsgs)
+	default:
+		return fmt.Errorf("receive invalid msgType = %d", msg.Type())
+	}
+}
+
+// getNumSegments returns the num of the historical and streaming segments of the collection
+func (q *queryCollection) getNumSegments() int {
+	numSegments := 0
+	for _, replica := range []ReplicaInterface{q.historical.replica, q.streaming.replica} {
+		partitionIDs, err := replica.getPartitionIDs(q.collectionID)
+		if err != nil {
+			continue
+		}
+		for _, partitionID := range partitionIDs {
+			segmentIDs, err := replica.getSegmentIDs(partitionID)
+			if err != nil {
+				continue
+			}
+			numSegments += len(segmentIDs)
+		}
+	}
+	return numSegments
+}
+
+// getDeleteRecords returns the deletes of the sealed segments of the collection, which are loaded by the
+// historical replica
+func (q *queryCollection) getDeleteRecords() *deleteRecords {
+	collection, err := q.historical.replica.getCollectionByID(q.collectionID)
+	if err != nil {
+		return newDeleteRecords()
+	}
+	return collection.deletes
+}
+
 func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]byte) (*schemapb.SearchResultData, error) {
 	log.Debug("translateHits:", zap.Any("lenOfFieldIDs", len(fieldIDs)), zap.Any("lenOfRawHits", len(rawHits)))
 	if len(rawHits) == 0 {
@@ -758,14 +984,18 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	return finalResult, nil
 }
 
-// TODO:: cache map[dsl]plan
-// TODO: reBatched search requests
 func (q *queryCollection) search(msg queryMsg) error {
-	searchMsg := msg.(*msgstream.SearchMsg)
+	return q.searchBatch([]*msgstream.SearchMsg{msg.(*msgstream.SearchMsg)})
+}
+
+// TODO:: cache map[dsl]plan
+// searchBatch runs the searches merged by the readScheduler in one segcore call,
+// the hits are split into the results of the searches by their num of queries
+func (q *queryCollection) searchBatch(msgs []*msgstream.SearchMsg) error {
+	searchMsg := msgs[0]
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
 	defer sp.Finish()
 	searchMsg.SetTraceCtx(ctx)
-	searchTimestamp := searchMsg.BeginTs()
 	travelTimestamp := searchMsg.TravelTimestamp
 
 	schema, err := typeutil.CreateSchemaHelper(q.collection.schema)
@@ -774,12 +1004,14 @@ func (q *queryCollection) search(msg queryMsg) error {
 	}
 
 	var plan *SearchPlan
+	var pruner *clusteringPruner
+	expr, deadline := batchSearchPlan(msgs)
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
-		expr := searchMsg.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(q.collection, expr)
 		if err != nil {
 			return err
 		}
+		pruner = newClusteringPruner(expr)
 	} else {
 		dsl := searchMsg.Dsl
 		plan, err = createSearchPlan(q.collection, dsl)
@@ -787,6 +1019,11 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	plan.deadline = deadline
+	if err := plan.checkDeadline(time.Now()); err != nil {
+		plan.delete()
+		return err.WithCollection(q.collection.id)
+	}
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
@@ -794,12 +1031,18 @@ func (q *queryCollection) search(msg queryMsg) error {
 	if topK >= 16385 {
 		return fmt.Errorf("limit %d is too large", topK)
 	}
-	searchRequestBlob := searchMsg.PlaceholderGroup
+	searchRequestBlob, nqs, err := mergePlaceholderGroups(msgs)
+	if err != nil {
+		return err
+	}
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
 		return err
 	}
 	queryNum := searchReq.getNumOfQuery()
+	if nqs == nil {
+		nqs = []int64{queryNum}
+	}
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
 
@@ -814,6 +1057,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 	}
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search %d(nq=%d, k=%d)", searchMsg.CollectionID, queryNum, topK))
+	stages := slowlog.NewStages()
 
 	// get global sealed segments
 	var globalSealedSegments []UniqueID
@@ -825,20 +1069,34 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	searchResults := make([]*SearchResult, 0)
 
+	// the search results refer to the data of the segments until they are deleted
+	release, err := q.historical.pinSegments(q.collection.id, searchMsg.PartitionIDs)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+	defer release()
+
 	// historical search
-	hisSearchResults, sealedSegmentSearched, err1 := q.historical.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
+	hisSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "historical search")
+	hisSearchResults, sealedSegmentSearched, err1 := q.historical.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, plan, travelTimestamp, pruner)
+	hisSp.Finish()
 	if err1 != nil {
 		log.Warn(err1.Error())
 		return err1
 	}
 	searchResults = append(searchResults, hisSearchResults...)
 	tr.Record("historical search done")
+	stages.Record("historicalSearch")
 
 	// streaming search
 	var err2 error
 	for _, channel := range q.collection.getVChannels() {
 		var strSearchResults []*SearchResult
+		strSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "streaming search")
+		strSp.SetTag("channel", channel)
 		strSearchResults, err2 = q.streaming.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
+		strSp.Finish()
 		if err2 != nil {
 			log.Warn(err2.Error())
 			return err2
@@ -846,24 +1104,25 @@ func (q *queryCollection) search(msg queryMsg) error {
 		searchResults = append(searchResults, strSearchResults...)
 	}
 	tr.Record("streaming search done")
+	stages.Record("streamingSearch")
 
 	sp.LogFields(oplog.String("statistical time", "segment search end"))
 	if len(searchResults) <= 0 {
-		for range searchRequests {
+		for i, m := range msgs {
 			resultChannelInt := 0
 			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+				BaseMsg: msgstream.BaseMsg{Ctx: m.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
 				SearchResults: internalpb.SearchResults{
 					Base: &commonpb.MsgBase{
 						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
+						MsgID:     m.Base.MsgID,
+						Timestamp: m.BeginTs(),
+						SourceID:  m.Base.SourceID,
 					},
 					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
+					ResultChannelID:          m.ResultChannelID,
 					MetricType:               plan.getMetricType(),
-					NumQueries:               queryNum,
+					NumQueries:               nqs[i],
 					TopK:                     topK,
 					SlicedBlob:               nil,
 					SlicedOffset:             1,
@@ -875,23 +1134,29 @@ func (q *queryCollection) search(msg queryMsg) error {
 			}
 			log.Debug("QueryNode Empty SearchResultMsg",
 				zap.Any("collectionID", q.collection.id),
-				zap.Any("msgID", searchMsg.ID()),
+				zap.Any("msgID", m.ID()),
 				zap.Any("vChannels", q.collection.getVChannels()),
 				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
 			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+			err = q.publishQueryResult(searchResultMsg, m.CollectionID)
 			if err != nil {
 				return err
 			}
-			tr.Record("publish empty search result done")
-			tr.Elapse("all done")
-			return nil
 		}
+		tr.Record("publish empty search result done")
+		tr.Elapse("all done")
+		stages.Record("publish")
+		for i, m := range msgs {
+			q.logSlowSearch(m, stages, nqs[i], topK)
+		}
+		return nil
 	}
 
 	numSegment := int64(len(searchResults))
 	var marshaledHits *MarshaledHits = nil
+	reduceSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "reduce search results")
 	err = reduceSearchResultsAndFillData(plan, searchResults, numSegment)
+	reduceSp.Finish()
 	sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
 	if err != nil {
 		return err
@@ -908,7 +1173,9 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return err
 	}
 	tr.Record("reduce result done")
+	stages.Record("reduce")
 
+	deletes := q.getDeleteRecords()
 	var offset int64 = 0
 	for index := range searchRequests {
 		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
@@ -933,62 +1200,71 @@ func (q *queryCollection) search(msg queryMsg) error {
 		// TODO: Currently add a translate layer from hits to SearchResultData
 		// TODO: hits marshal and unmarshal is likely bottleneck
 
-		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
-		if err != nil {
-			return err
-		}
-		byteBlobs, err := proto.Marshal(transformed)
-		if err != nil {
-			return err
-		}
+		var begin int64
+		for i, m := range msgs {
+			transformed, err := translateHits(schema, m.OutputFieldsId, hits[begin:begin+nqs[i]])
+			begin += nqs[i]
+			if err != nil {
+				return err
+			}
+			transformed, err = deletes.filterSearchResult(transformed, travelTimestamp)
+			if err != nil {
+				return err
+			}
+			byteBlobs, err := proto.Marshal(transformed)
+			if err != nil {
+				return err
+			}
 
-		resultChannelInt := 0
-		searchResultMsg := &msgstream.SearchResultMsg{
-			BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-			SearchResults: internalpb.SearchResults{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_SearchResult,
-					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
-					SourceID:  searchMsg.Base.SourceID,
+			resultChannelInt := 0
+			searchResultMsg := &msgstream.SearchResultMsg{
+				BaseMsg: msgstream.BaseMsg{Ctx: m.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+				SearchResults: internalpb.SearchResults{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_SearchResult,
+						MsgID:     m.Base.MsgID,
+						Timestamp: m.BeginTs(),
+						SourceID:  m.Base.SourceID,
+					},
+					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+					ResultChannelID:          m.ResultChannelID,
+					MetricType:               plan.getMetricType(),
+					NumQueries:               nqs[i],
+					TopK:                     topK,
+					SlicedBlob:               byteBlobs,
+					SlicedOffset:             1,
+					SlicedNumCount:           1,
+					SealedSegmentIDsSearched: sealedSegmentSearched,
+					ChannelIDsSearched:       q.collection.getVChannels(),
+					GlobalSealedSegmentIDs:   globalSealedSegments,
 				},
-				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-				ResultChannelID:          searchMsg.ResultChannelID,
-				MetricType:               plan.getMetricType(),
-				NumQueries:               queryNum,
-				TopK:                     topK,
-				SlicedBlob:               byteBlobs,
-				SlicedOffset:             1,
-				SlicedNumCount:           1,
-				SealedSegmentIDsSearched: sealedSegmentSearched,
-				ChannelIDsSearched:       q.collection.getVChannels(),
-				GlobalSealedSegmentIDs:   globalSealedSegments,
-			},
-		}
-		log.Debug("QueryNode SearchResultMsg",
-			zap.Any("collectionID", q.collection.id),
-			zap.Any("msgID", searchMsg.ID()),
-			zap.Any("vChannels", q.collection.getVChannels()),
-			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-		)
+			}
+			log.Debug("QueryNode SearchResultMsg",
+				zap.Any("collectionID", q.collection.id),
+				zap.Any("msgID", m.ID()),
+				zap.Any("vChannels", q.collection.getVChannels()),
+				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+			)
 
-		// For debugging, please don't delete.
-		//fmt.Println("==================== search result ======================")
-		//for i := 0; i < len(hits); i++ {
-		//	testHits := milvuspb.Hits{}
-		//	err := proto.Unmarshal(hits[i], &testHits)
-		//	if err != nil {
-		//		panic(err)
-		//	}
-		//	fmt.Println(testHits.IDs)
-		//	fmt.Println(testHits.Scores)
-		//}
-		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-		if err != nil {
-			return err
+			// For debugging, please don't delete.
+			//fmt.Println("==================== search result ======================")
+			//for i := 0; i < len(hits); i++ {
+			//	testHits := milvuspb.Hits{}
+			//	err := proto.Unmarshal(hits[i], &testHits)
+			//	if err != nil {
+			//		panic(err)
+			//	}
+			//	fmt.Println(testHits.IDs)
+			//	fmt.Println(testHits.Scores)
+			//}
+			err = q.publishQueryResult(searchResultMsg, m.CollectionID)
+			if err != nil {
+				return err
+			}
 		}
 		tr.Record("publish search result")
 	}
+	stages.Record("publish")
 
 	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
 	deleteSearchResults(searchResults)
@@ -997,9 +1273,33 @@ func (q *queryCollection) search(msg queryMsg) error {
 	plan.delete()
 	searchReq.delete()
 	tr.Elapse("all done")
+	for i, m := range msgs {
+		q.logSlowSearch(m, stages, nqs[i], topK)
+	}
 	return nil
 }
 
+// getVectorChunkManager returns the vector chunk manager shared by the retrieves of the collection,
+// so that the concurrent reads of the same vector binlog share a single download
+func (q *queryCollection) getVectorChunkManager(collection *Collection) (storage.ChunkManager, error) {
+	q.vcmMu.Lock()
+	defer q.vcmMu.Unlock()
+	if q.vectorChunkManager == nil {
+		if q.localChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
+		}
+		if q.remoteChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil")
+		}
+		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
+			&etcdpb.CollectionMeta{
+				ID:     collection.id,
+				Schema: collection.schema,
+			}, q.localCacheEnabled)
+	}
+	return q.vectorChunkManager, nil
+}
+
 func (q *queryCollection) retrieve(msg queryMsg) error {
 	// TODO(yukun)
 	// step 1: get retrieve object and defer destruction
@@ -1019,7 +1319,11 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		return err
 	}
 
-	expr := retrieveMsg.SerializedExprPlan
+	// the segments also retrieve the columns of the user-defined functions, which are evaluated after the merge
+	filter, expr, err := newUDFFilter(retrieveMsg.SerializedExprPlan)
+	if err != nil {
+		return err
+	}
 	plan, err := createRetrievePlanByExpr(collection, expr, timestamp)
 	if err != nil {
 		return err
@@ -1027,6 +1331,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	defer plan.delete()
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("retrieve %d", retrieveMsg.CollectionID))
+	stages := slowlog.NewStages()
 
 	var globalSealedSegments []UniqueID
 	if len(retrieveMsg.PartitionIDs) > 0 {
@@ -1037,27 +1342,27 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	var mergeList []*segcorepb.RetrieveResults
 
-	if q.vectorChunkManager == nil {
-		if q.localChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
-		}
-		if q.remoteChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil")
-		}
-		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
-			&etcdpb.CollectionMeta{
-				ID:     collection.id,
-				Schema: collection.schema,
-			}, q.localCacheEnabled)
+	vcm, err := q.getVectorChunkManager(collection)
+	if err != nil {
+		return err
 	}
+	release, err := q.historical.pinSegments(collectionID, retrieveMsg.PartitionIDs)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+	defer release()
+
 	// historical retrieve
-	hisRetrieveResults, sealedSegmentRetrieved, err1 := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
+	hisRetrieveResults, sealedSegmentRetrieved, err1 := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, vcm, plan,
+		newClusteringPruner(retrieveMsg.SerializedExprPlan))
 	if err1 != nil {
 		log.Warn(err1.Error())
 		return err1
 	}
 	mergeList = append(mergeList, hisRetrieveResults...)
 	tr.Record("historical retrieve done")
+	stages.Record("historicalRetrieve")
 
 	// streaming retrieve
 	strRetrieveResults, _, err2 := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
@@ -1067,12 +1372,31 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	}
 	mergeList = append(mergeList, strRetrieveResults...)
 	tr.Record("streaming retrieve done")
+	stages.Record("streamingRetrieve")
 
 	result, err := mergeRetrieveResults(mergeList)
 	if err != nil {
 		return err
 	}
+	result, err = q.getDeleteRecords().filterRetrieveResults(result, timestamp)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		result, err = filter.apply(result)
+		if err != nil {
+			return err
+		}
+		stages.Record("udfFilter")
+	}
+	if retrieveMsg.Limit > 0 {
+		result, err = limitRetrieveResults(result, retrieveMsg.Limit)
+		if err != nil {
+			return err
+		}
+	}
 	tr.Record("merge result done")
+	stages.Record("merge")
 
 	resultChannelInt := 0
 	retrieveResultMsg := &msgstream.RetrieveResultMsg{
@@ -1103,6 +1427,8 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		zap.Any("sealedSegmentRetrieved", sealedSegmentRetrieved),
 	)
 	tr.Elapse("all done")
+	stages.Record("publish")
+	q.logSlowRetrieve(retrieveMsg, stages)
 	return nil
 }
 
@@ -1161,6 +1487,33 @@ func mergeRetrieveResults(dataArr []*segcorepb.RetrieveResults) (*segcorepb.Retr
 	return final, nil
 }
 
+// limitRetrieveResults keeps the rows of the limit smallest primary keys, in the ascending order of the keys
+func limitRetrieveResults(result *segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+	pks := result.GetIds().GetIntId().GetData()
+	if int64(len(pks)) <= limit {
+		return result, nil
+	}
+	offsets := typeutil.SmallestKeys(pks, int(limit))
+	fieldsData, err := typeutil.SelectFieldData(result.FieldsData, offsets)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(offsets))
+	for _, offset := range offsets {
+		ids = append(ids, pks[offset])
+	}
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: ids,
+				},
+			},
+		},
+		FieldsData: fieldsData,
+	}, nil
+}
+
 func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID UniqueID) error {
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	defer span.Finish()
@@ -1175,7 +1528,9 @@ func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID U
 	return err
 }
 
-func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg string) error {
+// publishFailedQueryResult publishes the error of msg, the error code is the one of the C++ core if err is returned by it
+func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, err error) error {
+	status := merr.Status(err)
 	msgType := msg.Type()
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	defer span.Finish()
@@ -1200,7 +1555,7 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 			BaseMsg: baseMsg,
 			RetrieveResults: internalpb.RetrieveResults{
 				Base:            baseResult,
-				Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: errMsg},
+				Status:          status,
 				ResultChannelID: retrieveMsg.ResultChannelID,
 				Ids:             nil,
 				FieldsData:      nil,
@@ -1214,16 +1569,20 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 			BaseMsg: baseMsg,
 			SearchResults: internalpb.SearchResults{
 				Base:            baseResult,
-				Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: errMsg},
+				Status:          status,
 				ResultChannelID: searchMsg.ResultChannelID,
 			},
 		}
+		// the proxy retries the shards of the failed result
+		if q.collection != nil {
+			searchResultMsg.ChannelIDsSearched = q.collection.getVChannels()
+		}
 		msgPack.Msgs = append(msgPack.Msgs, searchResultMsg)
 	default:
 		return fmt.Errorf("publish invalid msgType %d", msgType)
 	}
 
-	err := q.queryResultMsgStream.Produce(&msgPack)
+	err = q.queryResultMsgStream.Produce(&msgPack)
 	if err != nil {
 		return err
 	}
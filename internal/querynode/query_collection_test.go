@@ -20,6 +20,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -225,6 +228,12 @@ func TestGetSegmentsByPKs(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestIsTargetNode(t *testing.T) {
+	assert.True(t, isTargetNode(nil, 1))
+	assert.True(t, isTargetNode([]UniqueID{1, 2}, 2))
+	assert.False(t, isTargetNode([]UniqueID{1, 2}, 3))
+}
+
 func TestQueryCollection_unsolvedMsg(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -241,6 +250,33 @@ func TestQueryCollection_unsolvedMsg(t *testing.T) {
 	assert.Len(t, res, 1)
 }
 
+func TestQueryCollection_guaranteeTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	queryCollection, err := genSimpleQueryCollection(ctx, cancel)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	queryCollection.streaming.tSafeReplica.setTSafe(defaultVChannel, defaultCollectionID, tsoutil.ComposeTS(now.Add(-3*time.Second).UnixNano()/int64(time.Millisecond), 0))
+
+	// the tsafe doesn't change, it's checked at the interval
+	tSafe := queryCollection.waitNewTSafe()
+	assert.Equal(t, queryCollection.streaming.tSafeReplica.getTSafe(defaultVChannel), tSafe)
+
+	qm, err := genSimpleSearchMsg()
+	assert.NoError(t, err)
+	qm.GuaranteeTimestamp = tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0)
+	queryCollection.addToUnsolvedMsg(qm)
+	assert.NotZero(t, queryCollection.unsolvedWaitTime(qm))
+
+	err = queryCollection.guaranteeTimeoutError(qm, time.Minute)
+	assert.Equal(t, commonpb.ErrorCode_TimeTickLagging, merr.Code(err))
+	assert.Contains(t, err.Error(), defaultVChannel+" (3s behind)")
+
+	queryCollection.forgetUnsolvedMsg(qm)
+	assert.Zero(t, queryCollection.unsolvedWaitTime(qm))
+}
+
 func TestQueryCollection_consumeQuery(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -480,3 +516,33 @@ func TestQueryCollection_AddPopUnsolvedMsg(t *testing.T) {
 		assert.EqualValues(t, i, unsolved[i].ID())
 	}
 }
+
+func TestLimitRetrieveResults(t *testing.T) {
+	result := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{3, 1, 2},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{30, 10, 20}}},
+				}},
+			},
+		},
+	}
+
+	limited, err := limitRetrieveResults(result, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, result, limited)
+
+	limited, err = limitRetrieveResults(result, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, limited.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{10, 20}, limited.FieldsData[0].GetScalars().GetLongData().Data)
+}
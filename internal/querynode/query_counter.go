@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+	"time"
+)
+
+// queryQPSWindow is the window of the query qps reported in the runtime statistics of the collections
+const queryQPSWindow = 60 * time.Second
+
+// queryCounter counts the searches and queries of each collection in per second buckets,
+// the buckets older than the window are reused
+type queryCounter struct {
+	mu      sync.Mutex
+	window  int64 // in seconds
+	buckets map[UniqueID][]queryBucket
+}
+
+type queryBucket struct {
+	second int64
+	count  int64
+}
+
+func newQueryCounter(window time.Duration) *queryCounter {
+	seconds := int64(window / time.Second)
+	if seconds <= 0 {
+		seconds = 1
+	}
+	return &queryCounter{
+		window:  seconds,
+		buckets: make(map[UniqueID][]queryBucket),
+	}
+}
+
+// add counts a search or query of the collection served at now
+func (c *queryCounter) add(collectionID UniqueID, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buckets, ok := c.buckets[collectionID]
+	if !ok {
+		buckets = make([]queryBucket, c.window)
+		c.buckets[collectionID] = buckets
+	}
+	second := now.Unix()
+	b := &buckets[second%c.window]
+	if b.second != second {
+		b.second = second
+		b.count = 0
+	}
+	b.count++
+}
+
+// qps returns the average queries per second of the collection in the window before now
+func (c *queryCounter) qps(collectionID UniqueID, now time.Time) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var total int64
+	second := now.Unix()
+	for _, b := range c.buckets[collectionID] {
+		if b.second > second-c.window && b.second <= second {
+			total += b.count
+		}
+	}
+	return float64(total) / float64(c.window)
+}
+
+func (c *queryCounter) remove(collectionID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.buckets, collectionID)
+}
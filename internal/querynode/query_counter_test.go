@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCounter(t *testing.T) {
+	c := newQueryCounter(10 * time.Second)
+	now := time.Unix(1000, 0)
+	assert.Equal(t, float64(0), c.qps(defaultCollectionID, now))
+
+	for i := 0; i < 10; i++ {
+		c.add(defaultCollectionID, now)
+	}
+	c.add(defaultCollectionID, now.Add(5*time.Second))
+	c.add(defaultCollectionID, now.Add(5*time.Second))
+	assert.Equal(t, float64(1), c.qps(defaultCollectionID, now))
+	assert.Equal(t, 1.2, c.qps(defaultCollectionID, now.Add(5*time.Second)))
+	assert.Equal(t, 0.2, c.qps(defaultCollectionID, now.Add(10*time.Second)))
+	assert.Equal(t, float64(0), c.qps(defaultCollectionID, now.Add(15*time.Second)))
+
+	// the bucket of the same slot is reset after the window
+	c.add(defaultCollectionID, now.Add(10*time.Second))
+	assert.Equal(t, 0.3, c.qps(defaultCollectionID, now.Add(10*time.Second)))
+	assert.Equal(t, float64(0), c.qps(defaultCollectionID+1, now))
+
+	c.remove(defaultCollectionID)
+	assert.Equal(t, float64(0), c.qps(defaultCollectionID, now.Add(10*time.Second)))
+
+	c = newQueryCounter(0)
+	c.add(defaultCollectionID, now)
+	assert.Equal(t, float64(1), c.qps(defaultCollectionID, now))
+}
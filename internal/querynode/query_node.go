@@ -27,8 +27,10 @@ import "C"
 import (
 	"context"
 	"errors"
+	"runtime"
 	"strconv"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"go.uber.org/zap"
@@ -39,6 +41,8 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/dynconfig"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -53,6 +57,8 @@ type QueryNode struct {
 	// internal components
 	historical *historical
 	streaming  *streaming
+	// memory rejects the loads and queues the reads above the memory watermark
+	memory *memoryAdmission
 
 	// internal services
 	queryService *queryService
@@ -68,6 +74,8 @@ type QueryNode struct {
 
 	minioKV kv.BaseKV // minio minioKV
 	etcdKV  *etcdkv.EtcdKV
+
+	dynConfig *dynconfig.Manager
 }
 
 func NewQueryNode(ctx context.Context, factory msgstream.Factory) *QueryNode {
@@ -123,11 +131,23 @@ func (node *QueryNode) Init() error {
 		node.msFactory,
 		node.etcdKV)
 	node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV)
+	node.memory = newMemoryAdmission(Params.MemoryLowWatermark, Params.MemoryHighWatermark, Params.MemoryQueueTimeout,
+		node.historical.replica, node.streaming.replica)
+	node.historical.loader.memory = node.memory
 
 	cConfigDir := C.CString(Params.BaseTable.GetConfigDir())
 	C.SegcoreInit(cConfigDir)
 	C.free(unsafe.Pointer(cConfigDir))
 
+	procs, threads := cpuLimits(Params.MaxProcs, Params.SegcoreThreads, Params.NUMAAware,
+		metricsinfo.GetUsableCPUCount(), metricsinfo.GetNUMANodes())
+	runtime.GOMAXPROCS(procs)
+	C.SegcoreSetThreadNum(C.int64_t(threads))
+	log.Debug("queryNode sized the cpu limits",
+		zap.Float64("cpuQuota", metricsinfo.GetCPUQuota()),
+		zap.Int("GOMAXPROCS", procs),
+		zap.Int("segcoreThreads", threads))
+
 	if node.rootCoord == nil {
 		log.Error("null root coordinator detected")
 	}
@@ -156,6 +176,18 @@ func (node *QueryNode) Start() error {
 		node.historical,
 		node.streaming,
 		node.msFactory)
+	node.queryService.memory = node.memory
+
+	node.dynConfig, err = dynconfig.NewManager(node.queryNodeLoopCtx, Params.EtcdEndpoints, Params.MetaRootPath)
+	if err != nil {
+		return err
+	}
+	if err = node.watchDynamicConfigs(); err != nil {
+		return err
+	}
+	if err = node.dynConfig.Start(); err != nil {
+		return err
+	}
 
 	// start task scheduler
 	go node.scheduler.Start()
@@ -166,10 +198,29 @@ func (node *QueryNode) Start() error {
 	return nil
 }
 
+// watchDynamicConfigs applies the changes of the dynamic configs of query node at runtime
+func (node *QueryNode) watchDynamicConfigs() error {
+	err := node.dynConfig.Watch("queryNode.slowLog.threshold", strconv.FormatInt(Params.SlowLogThreshold.Milliseconds(), 10), func(value string) {
+		threshold, _ := strconv.ParseInt(value, 10, 64)
+		node.queryService.slowLogger.SetThreshold(time.Duration(threshold) * time.Millisecond)
+	})
+	if err != nil {
+		return err
+	}
+	return node.dynConfig.Watch("queryNode.admission.cpuWatermark", strconv.FormatFloat(Params.CPUWatermark, 'f', -1, 64), func(value string) {
+		watermark, _ := strconv.ParseFloat(value, 64)
+		node.queryService.admission.setWatermark(watermark)
+	})
+}
+
 func (node *QueryNode) Stop() error {
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	node.queryNodeLoopCancel()
 
+	if node.dynConfig != nil {
+		node.dynConfig.Close()
+	}
+
 	// close services
 	if node.historical != nil {
 		node.historical.close()
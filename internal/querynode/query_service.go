@@ -22,6 +22,8 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type queryService struct {
@@ -35,9 +37,16 @@ type queryService struct {
 
 	factory msgstream.Factory
 
+	localCache         *storage.LocalCache
 	localChunkManager  storage.ChunkManager
 	remoteChunkManager storage.ChunkManager
 	localCacheEnabled  bool
+
+	slowLogger   *slowlog.Logger
+	admission    *cpuAdmission
+	memory       *memoryAdmission
+	queryCounter *queryCounter
+	scheduler    *readScheduler
 }
 
 func newQueryService(ctx context.Context,
@@ -54,8 +63,16 @@ func newQueryService(ctx context.Context,
 	}
 	enabled, _ := Params.Load("localStorage.enabled")
 	localCacheEnabled, _ := strconv.ParseBool(enabled)
+	capacity, err := Params.LoadWithDefault("localStorage.capacity", "0")
+	if err != nil {
+		panic(err)
+	}
+	localCacheCapacity, err := strconv.ParseInt(capacity, 10, 64)
+	if err != nil {
+		panic(err)
+	}
 
-	localChunkManager := storage.NewLocalChunkManager(path)
+	localCache := storage.NewLocalCache(path, localCacheCapacity*1024*1024)
 
 	option := &miniokv.Option{
 		Address:           Params.MinioEndPoint,
@@ -72,6 +89,17 @@ func newQueryService(ctx context.Context,
 	}
 	remoteChunkManager := storage.NewMinioChunkManager(client)
 
+	slowLogger, err := slowlog.NewLogger(typeutil.QueryNodeRole, Params.SlowLogThreshold, Params.SlowLogFile)
+	if err != nil {
+		panic(err)
+	}
+
+	admission := newCPUAdmission(queryServiceCtx, Params.CPUWatermark, Params.AdmissionCheckInterval)
+	admission.start()
+
+	scheduler := newReadScheduler(Params.ReadWorkers, Params.MaxReadConcurrencyPerCollection, Params.MaxSearchBatchNQ, executeReadTasks)
+	scheduler.start()
+
 	return &queryService{
 		ctx:    queryServiceCtx,
 		cancel: queryServiceCancel,
@@ -83,9 +111,15 @@ func newQueryService(ctx context.Context,
 
 		factory: factory,
 
-		localChunkManager:  localChunkManager,
+		localCache:         localCache,
+		localChunkManager:  localCache,
 		remoteChunkManager: remoteChunkManager,
 		localCacheEnabled:  localCacheEnabled,
+
+		slowLogger:   slowLogger,
+		admission:    admission,
+		queryCounter: newQueryCounter(queryQPSWindow),
+		scheduler:    scheduler,
 	}
 }
 
@@ -96,6 +130,8 @@ func (q *queryService) close() {
 	}
 	q.queryCollections = make(map[UniqueID]*queryCollection)
 	q.cancel()
+	q.admission.close()
+	q.scheduler.close()
 }
 
 func (q *queryService) addQueryCollection(collectionID UniqueID) {
@@ -114,6 +150,11 @@ func (q *queryService) addQueryCollection(collectionID UniqueID) {
 		q.remoteChunkManager,
 		q.localCacheEnabled,
 	)
+	qc.slowLogger = q.slowLogger
+	qc.admission = q.admission
+	qc.memory = q.memory
+	qc.queryCounter = q.queryCounter
+	qc.scheduler = q.scheduler
 	q.queryCollections[collectionID] = qc
 }
 
@@ -131,4 +172,6 @@ func (q *queryService) stopQueryCollection(collectionID UniqueID) {
 	sc.close()
 	sc.cancel()
 	delete(q.queryCollections, collectionID)
+	q.queryCounter.remove(collectionID)
+	q.scheduler.drop(collectionID)
 }
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// the ranks of the priority classes, the queue of a lower rank is scheduled first
+const (
+	highPriorityRank = iota
+	normalPriorityRank
+	lowPriorityRank
+	numPriorityRanks
+)
+
+// readTask is a search or a query of a collection waiting for a worker of the readScheduler
+type readTask struct {
+	q            *queryCollection
+	collectionID UniqueID
+	msg          queryMsg
+	rank         int
+	// nq and batchKey are set for the searches which can be merged into one segcore call,
+	// the searches of the same batchKey only differ in their vectors, deadlines and priorities
+	nq       int64
+	batchKey string
+}
+
+func newReadTask(q *queryCollection, collectionID UniqueID, msg queryMsg) *readTask {
+	t := &readTask{
+		q:            q,
+		collectionID: collectionID,
+		msg:          msg,
+		rank:         normalPriorityRank,
+	}
+	searchMsg, ok := msg.(*msgstream.SearchMsg)
+	if !ok {
+		return t
+	}
+	switch searchMsg.Priority {
+	case internalpb.ReadPriority_High:
+		t.rank = highPriorityRank
+	case internalpb.ReadPriority_Low:
+		t.rank = lowPriorityRank
+	}
+	t.nq, t.batchKey = searchBatchKey(searchMsg)
+	return t
+}
+
+// searchBatchKey returns the num of queries of a search and the key of the searches it can be merged with,
+// the key is empty if it can't be merged
+func searchBatchKey(msg *msgstream.SearchMsg) (int64, string) {
+	if msg.GetDslType() != commonpb.DslType_BoolExprV1 {
+		return 0, ""
+	}
+	var group milvuspb.PlaceholderGroup
+	if err := proto.Unmarshal(msg.PlaceholderGroup, &group); err != nil || len(group.Placeholders) != 1 {
+		return 0, ""
+	}
+	placeholder := group.Placeholders[0]
+
+	var plan planpb.PlanNode
+	if err := proto.Unmarshal(msg.SerializedExprPlan, &plan); err != nil || plan.GetVectorAnns() == nil {
+		return 0, ""
+	}
+	if queryInfo := plan.GetVectorAnns().GetQueryInfo(); queryInfo != nil {
+		queryInfo.Deadline = 0
+	}
+	normalized, err := proto.Marshal(&plan)
+	if err != nil {
+		return 0, ""
+	}
+	key := fmt.Sprintf("%d|%v|%v|%s|%d|%s", msg.TravelTimestamp, msg.PartitionIDs, msg.OutputFieldsId,
+		placeholder.Tag, placeholder.Type, normalized)
+	return int64(len(placeholder.Values)), key
+}
+
+// readScheduler runs the searches and queries of all the collections of the query node on a pool of workers.
+// The tasks are scheduled by their priority classes, and in the order they arrive in a class. A collection runs
+// at most maxConcurrencyPerCollection tasks at once, and the searches of a class which only differ in their
+// vectors are merged up to maxBatchNQ queries
+type readScheduler struct {
+	wg sync.WaitGroup
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queues  [numPriorityRanks][]*readTask
+	running map[UniqueID]int
+	closed  bool
+
+	workers                     int
+	maxConcurrencyPerCollection int // 0 is unlimited
+	maxBatchNQ                  int64
+	execute                     func(tasks []*readTask)
+}
+
+// newReadScheduler returns a scheduler of workers goroutines, GOMAXPROCS if workers is not positive
+func newReadScheduler(workers int, maxConcurrencyPerCollection int, maxBatchNQ int64, execute func(tasks []*readTask)) *readScheduler {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	s := &readScheduler{
+		running:                     make(map[UniqueID]int),
+		workers:                     workers,
+		maxConcurrencyPerCollection: maxConcurrencyPerCollection,
+		maxBatchNQ:                  maxBatchNQ,
+		execute:                     execute,
+	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+func (s *readScheduler) start() {
+	for i := 0; i < s.workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+}
+
+// close stops the workers once their running tasks are done, the pending tasks are dropped
+func (s *readScheduler) close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	s.cond.Broadcast()
+	s.wg.Wait()
+}
+
+func (s *readScheduler) work() {
+	defer s.wg.Done()
+	for {
+		tasks := s.next()
+		if tasks == nil {
+			return
+		}
+		s.execute(tasks)
+		s.done(tasks[0].collectionID)
+	}
+}
+
+// add queues a task
+func (s *readScheduler) add(t *readTask) {
+	s.mu.Lock()
+	s.queues[t.rank] = append(s.queues[t.rank], t)
+	s.mu.Unlock()
+	s.cond.Signal()
+}
+
+// drop removes the pending tasks of a released collection
+func (s *readScheduler) drop(collectionID UniqueID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for rank, queue := range s.queues {
+		kept := queue[:0]
+		for _, t := range queue {
+			if t.collectionID != collectionID {
+				kept = append(kept, t)
+			}
+		}
+		for i := len(kept); i < len(queue); i++ {
+			queue[i] = nil
+		}
+		s.queues[rank] = kept
+	}
+	log.Debug("drop the pending reads of the released collection", zap.Int64("collectionID", collectionID))
+}
+
+// next blocks until a task can be run, and returns it with the searches merged into it. It returns nil once closed
+func (s *readScheduler) next() []*readTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			return nil
+		}
+		if tasks := s.pop(); tasks != nil {
+			s.running[tasks[0].collectionID]++
+			return tasks
+		}
+		s.cond.Wait()
+	}
+}
+
+// pop removes the first task of the highest class whose collection has not reached its concurrency limit,
+// along with the searches merged into it
+func (s *readScheduler) pop() []*readTask {
+	for rank, queue := range s.queues {
+		for i, t := range queue {
+			if s.maxConcurrencyPerCollection > 0 && s.running[t.collectionID] >= s.maxConcurrencyPerCollection {
+				continue
+			}
+			tasks := []*readTask{t}
+			kept := append(make([]*readTask, 0, len(queue)-1), queue[:i]...)
+			nq := t.nq
+			for _, other := range queue[i+1:] {
+				if t.batchKey != "" && other.batchKey == t.batchKey && other.collectionID == t.collectionID &&
+					nq+other.nq <= s.maxBatchNQ {
+					tasks = append(tasks, other)
+					nq += other.nq
+					continue
+				}
+				kept = append(kept, other)
+			}
+			s.queues[rank] = kept
+			return tasks
+		}
+	}
+	return nil
+}
+
+// done releases the slot of the collection taken by next
+func (s *readScheduler) done(collectionID UniqueID) {
+	s.mu.Lock()
+	s.running[collectionID]--
+	if s.running[collectionID] <= 0 {
+		delete(s.running, collectionID)
+	}
+	s.mu.Unlock()
+	s.cond.Broadcast()
+}
+
+// batchSearchPlan returns the serialized plan and the deadline of a batch of searches, the one of the latest
+// deadline, so that none of the searches is abandoned before its own deadline
+func batchSearchPlan(msgs []*msgstream.SearchMsg) ([]byte, int64) {
+	expr, deadline := msgs[0].SerializedExprPlan, msgs[0].Deadline
+	for _, m := range msgs[1:] {
+		if deadline != 0 && (m.Deadline == 0 || m.Deadline > deadline) {
+			expr, deadline = m.SerializedExprPlan, m.Deadline
+		}
+	}
+	return expr, deadline
+}
+
+// mergePlaceholderGroups concatenates the vectors of a batch of searches into one placeholder group,
+// and returns the num of queries of each search. The nqs are nil if the batch has only one search
+func mergePlaceholderGroups(msgs []*msgstream.SearchMsg) ([]byte, []int64, error) {
+	if len(msgs) == 1 {
+		return msgs[0].PlaceholderGroup, nil, nil
+	}
+	var merged *milvuspb.PlaceholderValue
+	nqs := make([]int64, 0, len(msgs))
+	for _, m := range msgs {
+		var group milvuspb.PlaceholderGroup
+		if err := proto.Unmarshal(m.PlaceholderGroup, &group); err != nil {
+			return nil, nil, err
+		}
+		if len(group.Placeholders) != 1 {
+			return nil, nil, fmt.Errorf("can't merge the search %d of %d placeholders", m.ID(), len(group.Placeholders))
+		}
+		placeholder := group.Placeholders[0]
+		if merged == nil {
+			merged = &milvuspb.PlaceholderValue{Tag: placeholder.Tag, Type: placeholder.Type}
+		}
+		merged.Values = append(merged.Values, placeholder.Values...)
+		nqs = append(nqs, int64(len(placeholder.Values)))
+	}
+	blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{Placeholders: []*milvuspb.PlaceholderValue{merged}})
+	if err != nil {
+		return nil, nil, err
+	}
+	return blob, nqs, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func genBatchSearchMsg(t *testing.T, msgID UniqueID, nq int, deadline int64) *msgstream.SearchMsg {
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId:        100,
+				QueryInfo:      &planpb.QueryInfo{Topk: 10, MetricType: "L2", SearchParams: `{"nprobe": 10}`, Deadline: deadline},
+				PlaceholderTag: "$0",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	values := make([][]byte, nq)
+	for i := range values {
+		values[i] = []byte{byte(msgID), byte(i)}
+	}
+	group, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{{Tag: "$0", Type: milvuspb.PlaceholderType_FloatVector, Values: values}},
+	})
+	assert.NoError(t, err)
+	return &msgstream.SearchMsg{
+		SearchRequest: internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{MsgType: commonpb.MsgType_Search, MsgID: msgID},
+			CollectionID:       1,
+			DslType:            commonpb.DslType_BoolExprV1,
+			SerializedExprPlan: plan,
+			PlaceholderGroup:   group,
+			TravelTimestamp:    100,
+			Deadline:           deadline,
+		},
+	}
+}
+
+func TestReadScheduler_pop(t *testing.T) {
+	s := newReadScheduler(1, 1, 4, nil)
+	task := func(collectionID UniqueID, msgID UniqueID, priority internalpb.ReadPriority) *readTask {
+		msg := genBatchSearchMsg(t, msgID, 1, 0)
+		msg.CollectionID = collectionID
+		msg.Priority = priority
+		msg.TravelTimestamp = Timestamp(msgID) // not merged
+		return newReadTask(nil, collectionID, msg)
+	}
+	s.add(task(1, 1, internalpb.ReadPriority_Low))
+	s.add(task(1, 2, internalpb.ReadPriority_Normal))
+	s.add(task(1, 3, internalpb.ReadPriority_High))
+	s.add(task(2, 4, internalpb.ReadPriority_Normal))
+
+	// the high priority ones first
+	tasks := s.pop()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, UniqueID(3), tasks[0].msg.ID())
+	s.running[1]++
+
+	// collection 1 is at its concurrency limit
+	tasks = s.pop()
+	assert.Equal(t, UniqueID(4), tasks[0].msg.ID())
+	s.running[2]++
+	assert.Nil(t, s.pop())
+
+	s.done(1)
+	tasks = s.pop()
+	assert.Equal(t, UniqueID(2), tasks[0].msg.ID())
+
+	s.add(task(2, 5, internalpb.ReadPriority_Normal))
+	s.drop(1)
+	s.done(2)
+	tasks = s.pop()
+	assert.Equal(t, UniqueID(5), tasks[0].msg.ID())
+	assert.Nil(t, s.pop())
+}
+
+func TestReadScheduler_batch(t *testing.T) {
+	s := newReadScheduler(1, 0, 4, nil)
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 1, 2, 1000)))
+	s.add(newReadTask(nil, 1, &msgstream.RetrieveMsg{RetrieveRequest: internalpb.RetrieveRequest{Base: &commonpb.MsgBase{MsgID: 2}}}))
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 3, 1, 2000)))
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 4, 2, 0)))
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 5, 1, 0)))
+	other := genBatchSearchMsg(t, 6, 1, 0)
+	other.PartitionIDs = []UniqueID{10}
+	s.add(newReadTask(nil, 1, other))
+
+	// the searches differing in their deadlines are merged up to 4 queries
+	tasks := s.pop()
+	assert.Equal(t, 3, len(tasks))
+	assert.Equal(t, UniqueID(1), tasks[0].msg.ID())
+	assert.Equal(t, UniqueID(3), tasks[1].msg.ID())
+	assert.Equal(t, UniqueID(5), tasks[2].msg.ID())
+
+	tasks = s.pop()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, UniqueID(2), tasks[0].msg.ID())
+	tasks = s.pop()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, UniqueID(4), tasks[0].msg.ID())
+	tasks = s.pop()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, UniqueID(6), tasks[0].msg.ID())
+
+	// not merged if maxBatchNQ is 0
+	s = newReadScheduler(1, 0, 0, nil)
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 1, 1, 0)))
+	s.add(newReadTask(nil, 1, genBatchSearchMsg(t, 2, 1, 0)))
+	assert.Equal(t, 1, len(s.pop()))
+}
+
+func TestReadScheduler_workers(t *testing.T) {
+	var mu sync.Mutex
+	executed := make([]UniqueID, 0)
+	s := newReadScheduler(2, 1, 0, func(tasks []*readTask) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, task := range tasks {
+			executed = append(executed, task.msg.ID())
+		}
+	})
+	s.start()
+	for i := 1; i <= 10; i++ {
+		s.add(newReadTask(nil, UniqueID(i%3), genBatchSearchMsg(t, UniqueID(i), 1, 0)))
+	}
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(executed) == 10
+	}, 5*time.Second, 10*time.Millisecond)
+	s.close()
+	assert.Nil(t, s.next())
+}
+
+func TestBatchSearch(t *testing.T) {
+	msgs := []*msgstream.SearchMsg{
+		genBatchSearchMsg(t, 1, 2, 1000),
+		genBatchSearchMsg(t, 2, 1, 3000),
+		genBatchSearchMsg(t, 3, 3, 2000),
+	}
+	expr, deadline := batchSearchPlan(msgs)
+	assert.Equal(t, int64(3000), deadline)
+	assert.Equal(t, msgs[1].SerializedExprPlan, expr)
+
+	msgs[2].Deadline = 0
+	_, deadline = batchSearchPlan(msgs)
+	assert.Equal(t, int64(0), deadline)
+
+	blob, nqs, err := mergePlaceholderGroups(msgs)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1, 3}, nqs)
+	var group milvuspb.PlaceholderGroup
+	assert.NoError(t, proto.Unmarshal(blob, &group))
+	assert.Equal(t, 1, len(group.Placeholders))
+	assert.Equal(t, [][]byte{{1, 0}, {1, 1}, {2, 0}, {3, 0}, {3, 1}, {3, 2}}, group.Placeholders[0].Values)
+
+	blob, nqs, err = mergePlaceholderGroups(msgs[:1])
+	assert.NoError(t, err)
+	assert.Nil(t, nqs)
+	assert.Equal(t, msgs[0].PlaceholderGroup, blob)
+}
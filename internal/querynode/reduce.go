@@ -24,6 +24,9 @@ import (
 	"errors"
 	"strconv"
 	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 type SearchResult struct {
@@ -38,6 +41,9 @@ func reduceSearchResultsAndFillData(plan *SearchPlan, searchResults []*SearchRes
 	if plan.cSearchPlan == nil {
 		return errors.New("nil search plan")
 	}
+	if len(searchResults) == 0 {
+		return cgoerror.Newf("ReduceSearchResultsAndFillData", commonpb.ErrorCode_IllegalArgument, "no search result to reduce")
+	}
 
 	cSearchResults := make([]C.CSearchResult, 0)
 	for _, res := range searchResults {
@@ -47,17 +53,16 @@ func reduceSearchResultsAndFillData(plan *SearchPlan, searchResults []*SearchRes
 	cNumSegments := C.long(numSegments)
 
 	status := C.ReduceSearchResultsAndFillData(plan.cSearchPlan, cSearchResultPtr, cNumSegments)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("reduceSearchResults failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "ReduceSearchResultsAndFillData"); err != nil {
+		return err.WithParam("numSegments", strconv.FormatInt(numSegments, 10))
 	}
 	return nil
 }
 
 func reorganizeSearchResults(searchResults []*SearchResult, numSegments int64) (*MarshaledHits, error) {
+	if len(searchResults) == 0 {
+		return nil, cgoerror.Newf("ReorganizeSearchResults", commonpb.ErrorCode_IllegalArgument, "no search result to reorganize")
+	}
 	cSearchResults := make([]C.CSearchResult, 0)
 	for _, res := range searchResults {
 		cSearchResults = append(cSearchResults, res.cSearchResult)
@@ -68,12 +73,8 @@ func reorganizeSearchResults(searchResults []*SearchResult, numSegments int64) (
 	var cMarshaledHits C.CMarshaledHits
 
 	status := C.ReorganizeSearchResults(&cMarshaledHits, cSearchResultPtr, cNumSegments)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return nil, errors.New("reorganizeSearchResults failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "ReorganizeSearchResults"); err != nil {
+		return nil, err.WithParam("numSegments", strconv.FormatInt(numSegments, 10))
 	}
 	return &MarshaledHits{cMarshaledHits: cMarshaledHits}, nil
 }
@@ -27,10 +27,10 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/bits-and-blooms/bloom/v3"
-	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 type segmentType int32
@@ -93,6 +94,8 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	clusteringInfo *datapb.ClusteringInfo // key range of the clustering field, nil if the segment is not clustered
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -120,6 +123,14 @@ func (s *Segment) getIDBinlogRowSizes() []int64 {
 	return s.idBinlogRowSizes
 }
 
+func (s *Segment) setClusteringInfo(info *datapb.ClusteringInfo) {
+	s.clusteringInfo = info
+}
+
+func (s *Segment) getClusteringInfo() *datapb.ClusteringInfo {
+	return s.clusteringInfo
+}
+
 func (s *Segment) setRecentlyModified(modify bool) {
 	s.rmMutex.Lock()
 	defer s.rmMutex.Unlock()
@@ -223,6 +234,34 @@ func deleteSegment(segment *Segment) {
 	segment = nil
 }
 
+// resetSealed drops the loaded data of a sealed segment by replacing its segcore segment with an empty one,
+// so that the segment can be loaded again. A deleted segment is kept deleted
+func (s *Segment) resetSealed(collection *Collection) {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if s.segmentPtr == nil {
+		return
+	}
+	C.DeleteSegment(s.segmentPtr)
+	s.segmentPtr = C.NewSegment(collection.collectionPtr, C.ulong(s.segmentID), C.Sealed)
+	s.setType(segmentTypeSealed)
+
+	s.paramMutex.Lock()
+	s.indexInfos = make(map[int64]*indexInfo)
+	s.paramMutex.Unlock()
+	s.vectorFieldMutex.Lock()
+	s.vectorFieldInfos = make(map[UniqueID]*VectorFieldInfo)
+	s.vectorFieldMutex.Unlock()
+	log.Debug("reset sealed segment", zap.Int64("segmentID", s.ID()))
+}
+
+// isDeleted returns whether the segcore segment has been deleted
+func (s *Segment) isDeleted() bool {
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	return s.segmentPtr == nil
+}
+
 func (s *Segment) getRowCount() int64 {
 	/*
 		long int
@@ -283,6 +322,9 @@ func (s *Segment) search(plan *SearchPlan,
 	if s.segmentPtr == nil {
 		return nil, errors.New("null seg core pointer")
 	}
+	if err := plan.checkDeadline(time.Now()); err != nil {
+		return nil, err.WithCollection(s.collectionID).WithSegment(s.segmentID)
+	}
 	cPlaceholderGroups := make([]C.CPlaceholderGroup, 0)
 	for _, pg := range searchRequests {
 		cPlaceholderGroups = append(cPlaceholderGroups, (*pg).cPlaceholderGroup)
@@ -294,12 +336,8 @@ func (s *Segment) search(plan *SearchPlan,
 
 	log.Debug("do search on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
 	var status = C.Search(s.segmentPtr, plan.cSearchPlan, cPlaceHolderGroup, ts, &searchResult.cSearchResult)
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return nil, errors.New("Search failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "Search"); err != nil {
+		return nil, err.WithCollection(s.collectionID).WithSegment(s.segmentID)
 	}
 
 	return &searchResult, nil
@@ -313,8 +351,11 @@ func (s *Segment) getEntityByIds(plan *RetrievePlan) (*segcorepb.RetrieveResults
 	}
 	resProto := C.Retrieve(s.segmentPtr, plan.cRetrievePlan, C.uint64_t(plan.Timestamp))
 	result := new(segcorepb.RetrieveResults)
-	err := HandleCProtoResult(&resProto, result)
+	err := HandleCProtoResult(&resProto, "Retrieve", result)
 	if err != nil {
+		if cErr, ok := cgoerror.As(err); ok {
+			cErr.WithCollection(s.collectionID).WithSegment(s.segmentID)
+		}
 		return nil, err
 	}
 	return result, nil
@@ -549,13 +590,8 @@ func (s *Segment) segmentPreInsert(numOfRecords int) (int64, error) {
 	var offset int64
 	cOffset := (*C.long)(&offset)
 	status := C.PreInsert(s.segmentPtr, C.long(int64(numOfRecords)), cOffset)
-
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return 0, errors.New("PreInsert failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "PreInsert"); err != nil {
+		return 0, err.WithCollection(s.collectionID).WithSegment(s.segmentID)
 	}
 	return offset, nil
 }
@@ -596,13 +632,21 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 	}
 	// Blobs to one big blob
 	var numOfRow = len(*entityIDs)
+	if numOfRow == 0 || len(*records) != numOfRow || len(*timestamps) != numOfRow {
+		return cgoerror.Newf("Insert", commonpb.ErrorCode_IllegalArgument,
+			"%d primary keys, %d rows and %d timestamps", numOfRow, len(*records), len(*timestamps)).
+			WithCollection(s.collectionID).WithSegment(s.segmentID)
+	}
 	var sizeofPerRow = len((*records)[0].Value)
 
-	assert.Equal(nil, numOfRow, len(*records))
-
 	var rawData = make([]byte, numOfRow*sizeofPerRow)
 	var copyOffset = 0
 	for i := 0; i < len(*records); i++ {
+		if len((*records)[i].Value) != sizeofPerRow || sizeofPerRow == 0 {
+			return cgoerror.Newf("Insert", commonpb.ErrorCode_IllegalArgument,
+				"row %d has %d bytes, but row 0 has %d bytes", i, len((*records)[i].Value), sizeofPerRow).
+				WithCollection(s.collectionID).WithSegment(s.segmentID)
+		}
 		copy(rawData[copyOffset:], (*records)[i].Value)
 		copyOffset += sizeofPerRow
 	}
@@ -623,13 +667,9 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		cSizeofPerRow,
 		cNumOfRows)
 
-	errorCode := status.error_code
-	log.Debug("QueryNode::Segment::InsertEnd", zap.Any("errorCode", errorCode))
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		err := errors.New("Insert failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	log.Debug("QueryNode::Segment::InsertEnd", zap.Any("errorCode", status.error_code))
+	if err := newCStatusError(&status, "Insert"); err != nil {
+		err.WithCollection(s.collectionID).WithSegment(s.segmentID)
 		log.Debug("QueryNode::Segment::InsertEnd failed", zap.Error(err))
 		return err
 	}
@@ -652,19 +692,19 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 	if s.segmentPtr == nil {
 		return errors.New("null seg core pointer")
 	}
+	if len(*entityIDs) == 0 || len(*entityIDs) != len(*timestamps) {
+		return cgoerror.Newf("Delete", commonpb.ErrorCode_IllegalArgument,
+			"%d primary keys and %d timestamps", len(*entityIDs), len(*timestamps)).
+			WithCollection(s.collectionID).WithSegment(s.segmentID)
+	}
 	var cOffset = C.long(offset)
 	var cSize = C.long(len(*entityIDs))
 	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
 	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
 
 	var status = C.Delete(s.segmentPtr, cOffset, cSize, cEntityIdsPtr, cTimestampsPtr)
-
-	errorCode := status.error_code
-
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("Delete failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "Delete"); err != nil {
+		return err.WithCollection(s.collectionID).WithSegment(s.segmentID)
 	}
 
 	return nil
@@ -751,11 +791,9 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	}
 
 	var status = C.LoadFieldData(s.segmentPtr, loadInfo)
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("LoadFieldData failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "LoadFieldData"); err != nil {
+		return err.WithCollection(s.collectionID).WithSegment(s.segmentID).WithField(fieldID).
+			WithParam("rowCount", strconv.Itoa(rowCount))
 	}
 
 	log.Debug("load field done",
@@ -782,11 +820,8 @@ func (s *Segment) dropFieldData(fieldID int64) error {
 	}
 
 	var status = C.DropFieldData(s.segmentPtr, C.long(fieldID))
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("dropFieldData failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "DropFieldData"); err != nil {
+		return err.WithCollection(s.collectionID).WithSegment(s.segmentID).WithField(fieldID)
 	}
 
 	log.Debug("dropFieldData done", zap.Int64("fieldID", fieldID), zap.Int64("segmentID", s.ID()))
@@ -794,27 +829,59 @@ func (s *Segment) dropFieldData(fieldID int64) error {
 	return nil
 }
 
+// warmup touches the pages of the loaded field data and indexes of a sealed segment, and returns the touched bytes
+func (s *Segment) warmup() (int64, error) {
+	/*
+		CStatus
+		WarmupSegment(CSegmentInterface c_segment, int64_t* touched_bytes);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	if s.segmentPtr == nil {
+		return 0, errors.New("null seg core pointer")
+	}
+	segType := s.getType()
+	if segType != segmentTypeSealed && segType != segmentTypeIndexing {
+		errMsg := fmt.Sprintln("warmup failed, illegal segment type ", segType, "segmentID = ", s.ID())
+		return 0, errors.New(errMsg)
+	}
+
+	var touchedBytes int64
+	var status = C.WarmupSegment(s.segmentPtr, (*C.long)(&touchedBytes))
+	if err := newCStatusError(&status, "WarmupSegment"); err != nil {
+		return 0, err.WithCollection(s.collectionID).WithSegment(s.segmentID)
+	}
+	return touchedBytes, nil
+}
+
 func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) error {
+	indexParams := s.getIndexParams(fieldID)
+	// the context of the errors to find the malformed index
+	withContext := func(err error) error {
+		if cErr, ok := cgoerror.As(err); ok {
+			cErr.WithCollection(s.collectionID).WithSegment(s.segmentID).WithField(fieldID).WithParams(indexParams)
+		}
+		return err
+	}
 	loadIndexInfo, err := newLoadIndexInfo()
-	defer deleteLoadIndexInfo(loadIndexInfo)
 	if err != nil {
-		return err
+		return withContext(err)
 	}
+	defer deleteLoadIndexInfo(loadIndexInfo)
 	err = loadIndexInfo.appendFieldInfo(fieldID)
 	if err != nil {
-		return err
+		return withContext(err)
 	}
-	indexParams := s.getIndexParams(fieldID)
 	for k, v := range indexParams {
 		err = loadIndexInfo.appendIndexParam(k, v)
 		if err != nil {
-			return err
+			return withContext(err)
 		}
 	}
 	indexPaths := s.getIndexPaths(fieldID)
 	err = loadIndexInfo.appendIndex(bytesIndex, indexPaths)
 	if err != nil {
-		return err
+		return withContext(err)
 	}
 
 	s.segPtrMu.RLock()
@@ -823,17 +890,15 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 		return errors.New("null seg core pointer")
 	}
 
-	if s.segmentType != segmentTypeSealed {
+	// an indexing segment updates the index swapped in on the field
+	if s.segmentType != segmentTypeSealed && s.segmentType != segmentTypeIndexing {
 		errMsg := fmt.Sprintln("updateSegmentIndex failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
 
 	status := C.UpdateSealedSegmentIndex(s.segmentPtr, loadIndexInfo.cLoadIndexInfo)
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("updateSegmentIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "UpdateSealedSegmentIndex"); err != nil {
+		return err.WithCollection(s.collectionID).WithSegment(s.segmentID).WithField(fieldID).WithParams(indexParams)
 	}
 
 	s.setType(segmentTypeIndexing)
@@ -858,11 +923,8 @@ func (s *Segment) dropSegmentIndex(fieldID int64) error {
 	}
 
 	var status = C.DropSealedSegmentIndex(s.segmentPtr, C.long(fieldID))
-	errorCode := status.error_code
-	if errorCode != 0 {
-		errorMsg := C.GoString(status.error_msg)
-		defer C.free(unsafe.Pointer(status.error_msg))
-		return errors.New("dropSegmentIndex failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	if err := newCStatusError(&status, "DropSealedSegmentIndex"); err != nil {
+		return err.WithCollection(s.collectionID).WithSegment(s.segmentID).WithField(fieldID)
 	}
 
 	log.Debug("dropSegmentIndex done", zap.Int64("fieldID", fieldID), zap.Int64("segmentID", s.ID()))
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// segmentCacheEntry is a sealed segment loaded on demand, it is resident while its data is loaded
+type segmentCacheEntry struct {
+	segment *Segment
+	info    *querypb.SegmentLoadInfo
+	size    int64 // estimated memory of the data
+
+	loadMu   sync.Mutex // serializes the loads of the segment
+	resident bool
+	pins     int           // num of the reads using the segment, a pinned segment is never evicted
+	elem     *list.Element // in the lru while resident
+}
+
+// segmentCache loads the data of the sealed segments on demand from the object storage, the least recently used
+// segments are evicted when the estimated size of the resident segments exceeds the capacity, so that the
+// collections larger than the memory of the query node can still be searched
+type segmentCache struct {
+	capacity int64 // in bytes
+	load     func(segment *Segment, info *querypb.SegmentLoadInfo) error
+	evict    func(segment *Segment)
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List // of the resident entries, the front is the most recently used
+	entries map[UniqueID]*segmentCacheEntry
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+func newSegmentCache(capacity int64, load func(segment *Segment, info *querypb.SegmentLoadInfo) error, evict func(segment *Segment)) *segmentCache {
+	return &segmentCache{
+		capacity: capacity,
+		load:     load,
+		evict:    evict,
+		lru:      list.New(),
+		entries:  make(map[UniqueID]*segmentCacheEntry),
+	}
+}
+
+// add registers an empty sealed segment whose data is loaded by info once it is pinned
+func (c *segmentCache) add(segment *Segment, info *querypb.SegmentLoadInfo, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the segments released from the replica are deleted, they are dropped from the cache here
+	for segmentID, e := range c.entries {
+		if e.segment.isDeleted() || segmentID == segment.ID() {
+			c.removeLocked(e)
+		}
+	}
+	c.entries[segment.ID()] = &segmentCacheEntry{
+		segment: segment,
+		info:    info,
+		size:    size,
+	}
+}
+
+func (c *segmentCache) removeLocked(e *segmentCacheEntry) {
+	if e.resident {
+		c.lru.Remove(e.elem)
+		e.elem = nil
+		e.resident = false
+		c.size -= e.size
+		metrics.QueryNodeSegmentCacheSize.Set(float64(c.size))
+	}
+	if c.entries[e.segment.ID()] == e {
+		delete(c.entries, e.segment.ID())
+	}
+}
+
+// pin makes the cached segments of segmentIDs resident until the returned function is called, the segments
+// not in the cache are ignored. The pinned segments may exceed the capacity, they are evicted once unpinned
+func (c *segmentCache) pin(segmentIDs []UniqueID) (func(), error) {
+	if c == nil {
+		return func() {}, nil
+	}
+	c.mu.Lock()
+	entries := make([]*segmentCacheEntry, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		if e, ok := c.entries[segmentID]; ok {
+			e.pins++
+			entries = append(entries, e)
+		}
+	}
+	c.mu.Unlock()
+
+	unpin := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, e := range entries {
+			e.pins--
+		}
+		c.evictLocked()
+	}
+	for _, e := range entries {
+		if err := c.makeResident(e); err != nil {
+			unpin()
+			return nil, err
+		}
+	}
+	return unpin, nil
+}
+
+func (c *segmentCache) makeResident(e *segmentCacheEntry) error {
+	e.loadMu.Lock()
+	defer e.loadMu.Unlock()
+
+	c.mu.Lock()
+	if e.resident {
+		c.lru.MoveToFront(e.elem)
+		c.hits++
+		c.mu.Unlock()
+		metrics.QueryNodeSegmentCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return nil
+	}
+	c.misses++
+	c.mu.Unlock()
+	metrics.QueryNodeSegmentCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+
+	if err := c.load(e.segment, e.info); err != nil {
+		// drop the partially loaded data
+		c.evict(e.segment)
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[e.segment.ID()] != e || e.segment.isDeleted() {
+		// released or replaced while loading
+		c.evict(e.segment)
+		return nil
+	}
+	e.resident = true
+	e.elem = c.lru.PushFront(e)
+	c.size += e.size
+	c.evictLocked()
+	return nil
+}
+
+// evictLocked drops the data of the least recently used unpinned segments until the size is under the capacity
+func (c *segmentCache) evictLocked() {
+	for elem := c.lru.Back(); elem != nil && c.size > c.capacity; {
+		prev := elem.Prev()
+		e := elem.Value.(*segmentCacheEntry)
+		if e.segment.isDeleted() {
+			c.removeLocked(e)
+		} else if e.pins == 0 {
+			c.evict(e.segment)
+			c.lru.Remove(elem)
+			e.elem = nil
+			e.resident = false
+			c.size -= e.size
+			c.evictions++
+			metrics.QueryNodeSegmentCacheEvictionCounter.Inc()
+			log.Debug("evict segment from segment cache", zap.Int64("segmentID", e.segment.ID()), zap.Int64("size", e.size))
+		}
+		elem = prev
+	}
+	metrics.QueryNodeSegmentCacheSize.Set(float64(c.size))
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+type mockSegmentStorage struct {
+	mu      sync.Mutex
+	loads   map[UniqueID]int
+	evicts  map[UniqueID]int
+	loadErr error
+}
+
+func newMockSegmentStorage() *mockSegmentStorage {
+	return &mockSegmentStorage{
+		loads:  make(map[UniqueID]int),
+		evicts: make(map[UniqueID]int),
+	}
+}
+
+func (m *mockSegmentStorage) load(segment *Segment, info *querypb.SegmentLoadInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.loadErr != nil {
+		return m.loadErr
+	}
+	m.loads[segment.ID()]++
+	return nil
+}
+
+func (m *mockSegmentStorage) evict(segment *Segment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evicts[segment.ID()]++
+}
+
+func genCacheSegments(t *testing.T, cache *segmentCache, segmentIDs ...UniqueID) []*Segment {
+	_, schema := genSimpleSchema()
+	col := newCollection(defaultCollectionID, schema)
+	segments := make([]*Segment, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment := newSegment(col, segmentID, defaultPartitionID, defaultCollectionID, "", segmentTypeSealed, true)
+		cache.add(segment, &querypb.SegmentLoadInfo{SegmentID: segmentID}, 100)
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+func TestSegmentCache_pin(t *testing.T) {
+	storage := newMockSegmentStorage()
+	cache := newSegmentCache(200, storage.load, storage.evict)
+	genCacheSegments(t, cache, 1, 2, 3)
+
+	release, err := cache.pin([]UniqueID{1, 2, 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, storage.loads[1])
+	assert.Equal(t, 1, storage.loads[2])
+	release()
+
+	// hit
+	release, err = cache.pin([]UniqueID{1})
+	assert.NoError(t, err)
+	release()
+	assert.Equal(t, 1, storage.loads[1])
+	assert.Equal(t, int64(1), cache.hits)
+	assert.Equal(t, int64(2), cache.misses)
+
+	// segment 2 is the least recently used
+	release, err = cache.pin([]UniqueID{3})
+	assert.NoError(t, err)
+	release()
+	assert.Equal(t, 1, storage.evicts[2])
+	assert.Equal(t, 0, storage.evicts[1])
+	assert.Equal(t, int64(200), cache.size)
+	assert.Equal(t, int64(1), cache.evictions)
+}
+
+func TestSegmentCache_pinnedNotEvicted(t *testing.T) {
+	storage := newMockSegmentStorage()
+	cache := newSegmentCache(100, storage.load, storage.evict)
+	genCacheSegments(t, cache, 1, 2)
+
+	release, err := cache.pin([]UniqueID{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(storage.evicts))
+	assert.Equal(t, int64(200), cache.size)
+
+	release()
+	assert.Equal(t, 1, len(storage.evicts))
+	assert.Equal(t, int64(100), cache.size)
+}
+
+func TestSegmentCache_loadFailed(t *testing.T) {
+	storage := newMockSegmentStorage()
+	storage.loadErr = errors.New("mock error")
+	cache := newSegmentCache(100, storage.load, storage.evict)
+	genCacheSegments(t, cache, 1)
+
+	_, err := cache.pin([]UniqueID{1})
+	assert.Error(t, err)
+	assert.Equal(t, 1, storage.evicts[1])
+	assert.Equal(t, int64(0), cache.size)
+
+	storage.loadErr = nil
+	release, err := cache.pin([]UniqueID{1})
+	assert.NoError(t, err)
+	release()
+	assert.Equal(t, 1, storage.loads[1])
+}
+
+func TestSegmentCache_deletedSegment(t *testing.T) {
+	storage := newMockSegmentStorage()
+	cache := newSegmentCache(100, storage.load, storage.evict)
+	segments := genCacheSegments(t, cache, 1)
+
+	release, err := cache.pin([]UniqueID{1})
+	assert.NoError(t, err)
+	release()
+
+	deleteSegment(segments[0])
+	genCacheSegments(t, cache, 2)
+	assert.Equal(t, 1, len(cache.entries))
+	assert.Equal(t, int64(0), cache.size)
+}
+
+func TestSegmentCache_concurrentPin(t *testing.T) {
+	storage := newMockSegmentStorage()
+	cache := newSegmentCache(200, storage.load, storage.evict)
+	genCacheSegments(t, cache, 1, 2, 3, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			release, err := cache.pin([]UniqueID{UniqueID(i%4 + 1)})
+			assert.NoError(t, err)
+			release()
+		}(i)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, cache.size, int64(200))
+}
+
+func TestSegmentCache_nil(t *testing.T) {
+	var cache *segmentCache
+	release, err := cache.pin([]UniqueID{1})
+	assert.NoError(t, err)
+	release()
+}
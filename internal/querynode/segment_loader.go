@@ -48,6 +48,10 @@ type segmentLoader struct {
 	etcdKV  *etcdkv.EtcdKV
 
 	indexLoader *indexLoader
+	// memory is nil if the memory admission of the query node is not set
+	memory *memoryAdmission
+	// cache loads the sealed segments on demand, it is nil if the segments are loaded at once
+	cache *segmentCache
 }
 
 func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest) error {
@@ -72,12 +76,16 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 		return nil
 	}
 
-	err := loader.checkSegmentMemory(req.Infos)
-	if err != nil {
-		return err
+	// the segments loaded on demand are bounded by the capacity of the cache instead
+	if loader.cache == nil {
+		err := loader.checkSegmentMemory(req.Infos)
+		if err != nil {
+			return err
+		}
 	}
 
 	newSegments := make([]*Segment, 0)
+	newSegmentInfos := make(map[UniqueID]*querypb.SegmentLoadInfo)
 	segmentGC := func() {
 		for _, s := range newSegments {
 			deleteSegment(s)
@@ -89,6 +97,10 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			if err != nil {
 				log.Warn(err.Error())
 				deleteSegment(s)
+				continue
+			}
+			if loader.cache != nil {
+				loader.cache.add(s, newSegmentInfos[s.ID()], loader.estimateSegmentSize(newSegmentInfos[s.ID()]))
 			}
 		}
 	}
@@ -106,7 +118,19 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			return err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentTypeSealed, onService)
-		err = loader.loadSegmentInternal(collectionID, segment, info)
+		segment.setClusteringInfo(info.GetClusteringInfo())
+		err = loader.loadDeltalogs(collection, info.GetDeltalogs())
+		if err != nil {
+			deleteSegment(segment)
+			log.Warn(err.Error())
+			segmentGC()
+			return err
+		}
+		if loader.cache != nil {
+			newSegmentInfos[segmentID] = info
+		} else {
+			err = loader.loadSegmentInternal(collectionID, segment, info)
+		}
 		if err != nil {
 			deleteSegment(segment)
 			log.Warn(err.Error())
@@ -193,6 +217,57 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 	return nil
 }
 
+// loadDeltalogs loads the deletes of the delta logs of a segment into the delete records of its collection, the
+// delta logs already loaded for the other segments are skipped
+func (loader *segmentLoader) loadDeltalogs(collection *Collection, deltalogs []*datapb.DeltaLogInfo) error {
+	dCodec := storage.NewDeleteCodec(collection.ID())
+	for _, deltalog := range deltalogs {
+		path := deltalog.GetDeltaLogPath()
+		if collection.deletes.isLoaded(path) {
+			continue
+		}
+		value, err := loader.minioKV.Load(path)
+		if err != nil {
+			return err
+		}
+		_, _, data, err := dCodec.Deserialize([]*storage.Blob{{Key: path, Value: []byte(value)}})
+		if err != nil {
+			return err
+		}
+		collection.deletes.add(path, data)
+	}
+	return nil
+}
+
+// loadCachedSegment loads the data of a segment pinned in the segment cache
+func (loader *segmentLoader) loadCachedSegment(segment *Segment, info *querypb.SegmentLoadInfo) error {
+	return loader.loadSegmentInternal(segment.collectionID, segment, info)
+}
+
+// evictCachedSegment drops the data of a segment evicted from the segment cache
+func (loader *segmentLoader) evictCachedSegment(segment *Segment) {
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		// the segments of a released collection are deleted
+		log.Warn("failed to evict segment", zap.Int64("segmentID", segment.ID()), zap.Error(err))
+		return
+	}
+	segment.resetSealed(collection)
+}
+
+// estimateSegmentSize returns the estimated memory of the data of a segment, 0 if unknown
+func (loader *segmentLoader) estimateSegmentSize(info *querypb.SegmentLoadInfo) int64 {
+	collection, err := loader.historicalReplica.getCollectionByID(info.CollectionID)
+	if err != nil {
+		return 0
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(collection.schema)
+	if err != nil {
+		return 0
+	}
+	return int64(sizePerRecord) * info.NumOfRows
+}
+
 func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.SegmentLoadInfo) error {
 	totalRAM := metricsinfo.GetMemoryCount()
 	usedRAM := metricsinfo.GetUsedMemoryCount()
@@ -232,7 +307,7 @@ func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.Segm
 		}
 	}
 
-	return nil
+	return loader.memory.admitLoad(int64(segmentTotalSize))
 }
 
 //func (loader *segmentLoader) GetSegmentStates(segmentID UniqueID) (*datapb.GetSegmentStatesResponse, error) {
@@ -371,7 +446,7 @@ func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord
 	}
 
 	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, replica)
-	return &segmentLoader{
+	loader := &segmentLoader{
 		historicalReplica: replica,
 
 		minioKV: client,
@@ -379,4 +454,8 @@ func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord
 
 		indexLoader: iLoader,
 	}
+	if Params.SegmentCacheEnabled {
+		loader.cache = newSegmentCache(Params.SegmentCacheCapacity, loader.loadCachedSegment, loader.evictCachedSegment)
+	}
+	return loader
 }
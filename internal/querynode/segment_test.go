@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
 )
 
 //-------------------------------------------------------------------------------------- constructor and destructor
@@ -399,6 +400,30 @@ func TestSegment_segmentInsert(t *testing.T) {
 		err = segment.segmentInsert(0, nil, nil, nil)
 		assert.NoError(t, err)
 	})
+
+	t.Run("test malformed rows", func(t *testing.T) {
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+		segment.setType(segmentTypeGrowing)
+		ids := []int64{1, 2}
+		timestamps := []uint64{0}
+		records := []*commonpb.Blob{{Value: []byte{1}}, {Value: []byte{2}}}
+		err = segment.segmentInsert(0, &ids, &timestamps, &records)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, cgoerror.ErrorCode(err))
+
+		timestamps = []uint64{0, 0}
+		records[1].Value = []byte{2, 3}
+		err = segment.segmentInsert(0, &ids, &timestamps, &records)
+		assert.Error(t, err)
+		cErr, ok := cgoerror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, segment.segmentID, cErr.SegmentID)
+
+		err = segment.segmentDelete(0, &ids, &[]uint64{0})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, cgoerror.ErrorCode(err))
+	})
 }
 
 func TestSegment_segmentDelete(t *testing.T) {
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/slowlog"
+)
+
+// exprString returns the readable expression of a search or retrieve request
+func exprString(dslType commonpb.DslType, dsl string, serializedExprPlan []byte) string {
+	if dslType != commonpb.DslType_BoolExprV1 && len(serializedExprPlan) == 0 {
+		return dsl
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedExprPlan, plan); err != nil {
+		return ""
+	}
+	return plan.String()
+}
+
+// logSlowSearch writes the search request to the slow log if it takes longer than Params.SlowLogThreshold
+func (q *queryCollection) logSlowSearch(searchMsg *msgstream.SearchMsg, stages *slowlog.Stages, nq int64, topK int64) {
+	latency := stages.Elapse()
+	if !q.slowLogger.IsSlow(latency) {
+		return
+	}
+	q.slowLogger.Log(&slowlog.Record{
+		Type:               slowlog.SearchType,
+		MsgID:              searchMsg.ID(),
+		Collection:         q.collection.schema.GetName(),
+		Expr:               exprString(searchMsg.DslType, searchMsg.Dsl, searchMsg.SerializedExprPlan),
+		Nq:                 nq,
+		TopK:               topK,
+		ConsistencyLevel:   slowlog.ConsistencyLevel(searchMsg.GuaranteeTimestamp, searchMsg.BeginTs()),
+		GuaranteeTimestamp: searchMsg.GuaranteeTimestamp,
+		TravelTimestamp:    searchMsg.TravelTimestamp,
+		Latency:            latency,
+		Stages:             stages.Stages(),
+	})
+}
+
+// logSlowRetrieve writes the retrieve request to the slow log if it takes longer than Params.SlowLogThreshold
+func (q *queryCollection) logSlowRetrieve(retrieveMsg *msgstream.RetrieveMsg, stages *slowlog.Stages) {
+	latency := stages.Elapse()
+	if !q.slowLogger.IsSlow(latency) {
+		return
+	}
+	q.slowLogger.Log(&slowlog.Record{
+		Type:               slowlog.QueryType,
+		MsgID:              retrieveMsg.ID(),
+		Collection:         q.collection.schema.GetName(),
+		Expr:               exprString(commonpb.DslType_BoolExprV1, "", retrieveMsg.SerializedExprPlan),
+		ConsistencyLevel:   slowlog.ConsistencyLevel(retrieveMsg.GuaranteeTimestamp, retrieveMsg.BeginTs()),
+		GuaranteeTimestamp: retrieveMsg.GuaranteeTimestamp,
+		TravelTimestamp:    retrieveMsg.TravelTimestamp,
+		Latency:            latency,
+		Stages:             stages.Stages(),
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/internal/util/udf"
+)
+
+// udfFilter evaluates the user-defined functions of a retrieve plan on the retrieved rows
+type udfFilter struct {
+	predicates []*planpb.UDFExpr
+	// the fields retrieved from the segments, the output fields of the request first
+	fieldIDs        []int64
+	numOutputFields int
+}
+
+// newUDFFilter returns the filter of the plan and the plan retrieving the columns of the functions as well,
+// the filter is nil if the plan has no user-defined functions
+func newUDFFilter(serializedPlan []byte) (*udfFilter, []byte, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, nil, err
+	}
+	if len(plan.UdfPredicates) == 0 {
+		return nil, serializedPlan, nil
+	}
+
+	f := &udfFilter{
+		predicates:      plan.UdfPredicates,
+		fieldIDs:        append([]int64(nil), plan.OutputFieldIds...),
+		numOutputFields: len(plan.OutputFieldIds),
+	}
+	retrieved := make(map[int64]struct{}, len(f.fieldIDs))
+	for _, fieldID := range f.fieldIDs {
+		retrieved[fieldID] = struct{}{}
+	}
+	for _, call := range f.predicates {
+		for _, arg := range call.Args {
+			if arg.ColumnInfo == nil {
+				continue
+			}
+			if _, ok := retrieved[arg.ColumnInfo.FieldId]; !ok {
+				retrieved[arg.ColumnInfo.FieldId] = struct{}{}
+				f.fieldIDs = append(f.fieldIDs, arg.ColumnInfo.FieldId)
+			}
+		}
+	}
+
+	plan.OutputFieldIds = f.fieldIDs
+	plan.UdfPredicates = nil
+	serialized, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, serialized, nil
+}
+
+// apply keeps the rows matching the functions, and drops the columns which are not the output fields
+func (f *udfFilter) apply(result *segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	pks := result.GetIds().GetIntId().GetData()
+	if len(result.FieldsData) != len(f.fieldIDs) {
+		// no row is retrieved
+		return result, nil
+	}
+	columns := make(map[int64]*schemapb.FieldData, len(f.fieldIDs))
+	for i, fieldID := range f.fieldIDs {
+		columns[fieldID] = result.FieldsData[i]
+	}
+
+	offsets, err := udf.Filter(f.predicates, columns, len(pks))
+	if err != nil {
+		return nil, err
+	}
+	fieldsData, err := typeutil.SelectFieldData(result.FieldsData[:f.numOutputFields], offsets)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(offsets))
+	for _, offset := range offsets {
+		ids = append(ids, pks[offset])
+	}
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: ids,
+				},
+			},
+		},
+		FieldsData: fieldsData,
+	}, nil
+}
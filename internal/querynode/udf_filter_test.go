@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+)
+
+func TestUDFFilter(t *testing.T) {
+	plan := &planpb.PlanNode{OutputFieldIds: []int64{100}}
+	serialized, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+	filter, expr, err := newUDFFilter(serialized)
+	assert.NoError(t, err)
+	assert.Nil(t, filter)
+	assert.Equal(t, serialized, expr)
+
+	_, _, err = newUDFFilter([]byte{1, 2, 3})
+	assert.Error(t, err)
+
+	polygon := &planpb.UDFArg{}
+	for _, v := range []float64{0, 0, 0, 10, 10, 10, 10, 0} {
+		polygon.Values = append(polygon.Values, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}})
+	}
+	plan.UdfPredicates = []*planpb.UDFExpr{{
+		FunctionName: "geo_within",
+		Args: []*planpb.UDFArg{
+			{ColumnInfo: &planpb.ColumnInfo{FieldId: 101}},
+			{ColumnInfo: &planpb.ColumnInfo{FieldId: 100}},
+			polygon,
+		},
+	}}
+	serialized, err = proto.Marshal(plan)
+	assert.NoError(t, err)
+	filter, expr, err = newUDFFilter(serialized)
+	assert.NoError(t, err)
+	assert.NotNil(t, filter)
+	retrievePlan := &planpb.PlanNode{}
+	assert.NoError(t, proto.Unmarshal(expr, retrievePlan))
+	assert.Equal(t, []int64{100, 101}, retrievePlan.OutputFieldIds)
+	assert.Empty(t, retrievePlan.UdfPredicates)
+
+	doubleField := func(data ...float64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type: schemapb.DataType_Double,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}},
+			}},
+		}
+	}
+	result := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{1, 2, 3},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{doubleField(5, 20, 1), doubleField(5, 5, 1)},
+	}
+	filtered, err := filter.apply(result)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, filtered.Ids.GetIntId().Data)
+	assert.Equal(t, 1, len(filtered.FieldsData))
+	assert.Equal(t, []float64{5, 1}, filtered.FieldsData[0].GetScalars().GetDoubleData().Data)
+
+	empty := &segcorepb.RetrieveResults{FieldsData: []*schemapb.FieldData{}}
+	filtered, err = filter.apply(empty)
+	assert.NoError(t, err)
+	assert.Equal(t, empty, filtered)
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// genWarmupPlaceholderGroup returns the serialized placeholder group of the vectors of a warm-up query
+func genWarmupPlaceholderGroup(query *metricsinfo.WarmupQuery) ([]byte, error) {
+	placeholderValue := &milvuspb.PlaceholderValue{
+		Tag:    "$0",
+		Values: make([][]byte, 0),
+	}
+	switch {
+	case len(query.Vectors) > 0:
+		placeholderValue.Type = milvuspb.PlaceholderType_FloatVector
+		for _, vec := range query.Vectors {
+			rawData := make([]byte, 4*len(vec))
+			for i, ele := range vec {
+				binary.LittleEndian.PutUint32(rawData[4*i:], math.Float32bits(ele))
+			}
+			placeholderValue.Values = append(placeholderValue.Values, rawData)
+		}
+	case len(query.BinaryVectors) > 0:
+		placeholderValue.Type = milvuspb.PlaceholderType_BinaryVector
+		placeholderValue.Values = append(placeholderValue.Values, query.BinaryVectors...)
+	default:
+		return nil, errors.New("no vectors in warmup query")
+	}
+
+	placeholderGroup := &milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{placeholderValue},
+	}
+	return proto.Marshal(placeholderGroup)
+}
+
+// warmupQuery searches the sealed segments of the collection with a warm-up query, the results are dropped
+func (h *historical) warmupQuery(collectionID UniqueID, query *metricsinfo.WarmupQuery) error {
+	collection, err := h.replica.getCollectionByID(collectionID)
+	if err != nil {
+		return err
+	}
+	plan, err := createSearchPlan(collection, query.Dsl)
+	if err != nil {
+		return err
+	}
+	defer plan.delete()
+
+	placeholderGroup, err := genWarmupPlaceholderGroup(query)
+	if err != nil {
+		return err
+	}
+	searchReq, err := parseSearchRequest(plan, placeholderGroup)
+	if err != nil {
+		return err
+	}
+	defer searchReq.delete()
+
+	searchResults, _, err := h.search([]*searchRequest{searchReq}, collectionID, nil, plan, math.MaxUint64, nil)
+	if err != nil {
+		return err
+	}
+	deleteSearchResults(searchResults)
+	return nil
+}
+
+// warmup runs the warm-up queries on the sealed segments of the collection, so that the page cache and
+// the index structures are warmed before the real traffic comes
+func (h *historical) warmup(collectionID UniqueID, queries []*metricsinfo.WarmupQuery) *metricsinfo.WarmupResult {
+	result := &metricsinfo.WarmupResult{
+		CollectionID: collectionID,
+		Queries:      len(queries),
+	}
+	start := time.Now()
+	for _, query := range queries {
+		if err := h.warmupQuery(collectionID, query); err != nil {
+			log.Warn("warmup query failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+			result.Failed++
+			result.Reason = err.Error()
+		}
+	}
+	result.ElapsedMs = time.Since(start).Milliseconds()
+	return result
+}
+
+// warmupSegments touches the pages of the loaded data and indexes of the sealed segments of the collection,
+// all the sealed segments of the collection if segmentIDs is empty, so that the first searches after load don't
+// pay the page faults. The segments loaded on demand are loaded into the segment cache first
+func (h *historical) warmupSegments(collectionID UniqueID, segmentIDs []UniqueID) *metricsinfo.WarmupSegmentsResult {
+	result := &metricsinfo.WarmupSegmentsResult{
+		CollectionID: collectionID,
+	}
+	start := time.Now()
+	defer func() {
+		result.ElapsedMs = time.Since(start).Milliseconds()
+	}()
+
+	if len(segmentIDs) == 0 {
+		partitionIDs, err := h.replica.getPartitionIDs(collectionID)
+		if err != nil {
+			result.Reason = err.Error()
+			return result
+		}
+		for _, partitionID := range partitionIDs {
+			ids, err := h.replica.getSegmentIDs(partitionID)
+			if err != nil {
+				result.Reason = err.Error()
+				return result
+			}
+			segmentIDs = append(segmentIDs, ids...)
+		}
+	}
+	result.Segments = len(segmentIDs)
+
+	release, err := h.loader.cache.pin(segmentIDs)
+	if err != nil {
+		result.Failed = len(segmentIDs)
+		result.Reason = err.Error()
+		return result
+	}
+	defer release()
+
+	for _, segmentID := range segmentIDs {
+		segment, err := h.replica.getSegmentByID(segmentID)
+		if err == nil && segment.collectionID != collectionID {
+			err = fmt.Errorf("segment %d is not of collection %d", segmentID, collectionID)
+		}
+		var touchedBytes int64
+		if err == nil {
+			touchedBytes, err = segment.warmup()
+		}
+		if err != nil {
+			log.Warn("warmup segment failed",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("segmentID", segmentID),
+				zap.Error(err))
+			result.Failed++
+			result.Reason = err.Error()
+			continue
+		}
+		result.TouchedBytes += touchedBytes
+	}
+	return result
+}
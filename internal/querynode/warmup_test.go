@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestGenWarmupPlaceholderGroup(t *testing.T) {
+	blob, err := genWarmupPlaceholderGroup(&metricsinfo.WarmupQuery{Vectors: [][]float32{{1, 2}, {3, 4}}})
+	assert.NoError(t, err)
+	placeholderGroup := &milvuspb.PlaceholderGroup{}
+	err = proto.Unmarshal(blob, placeholderGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(placeholderGroup.Placeholders))
+	value := placeholderGroup.Placeholders[0]
+	assert.Equal(t, "$0", value.Tag)
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, value.Type)
+	assert.Equal(t, 2, len(value.Values))
+	assert.Equal(t, float32(4), math.Float32frombits(binary.LittleEndian.Uint32(value.Values[1][4:])))
+
+	blob, err = genWarmupPlaceholderGroup(&metricsinfo.WarmupQuery{BinaryVectors: [][]byte{{1}}})
+	assert.NoError(t, err)
+	err = proto.Unmarshal(blob, placeholderGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.PlaceholderType_BinaryVector, placeholderGroup.Placeholders[0].Type)
+
+	_, err = genWarmupPlaceholderGroup(&metricsinfo.WarmupQuery{Dsl: "{}"})
+	assert.Error(t, err)
+}
+
+func TestHistorical_Warmup(t *testing.T) {
+	node := newQueryNodeMock()
+	initTestMeta(t, node, defaultCollectionID, 0)
+
+	// the collection is not loaded
+	result := node.historical.warmup(defaultCollectionID+1, []*metricsinfo.WarmupQuery{{Dsl: "{}", Vectors: [][]float32{{1}}}})
+	assert.Equal(t, int64(defaultCollectionID+1), result.CollectionID)
+	assert.Equal(t, 1, result.Queries)
+	assert.Equal(t, 1, result.Failed)
+	assert.NotEmpty(t, result.Reason)
+}
+
+func TestHistorical_WarmupSegments(t *testing.T) {
+	node := newQueryNodeMock()
+	initTestMeta(t, node, defaultCollectionID, 0)
+
+	// the growing segment can't be warmed up
+	result := node.historical.warmupSegments(defaultCollectionID, nil)
+	assert.Equal(t, 1, result.Segments)
+	assert.Equal(t, 1, result.Failed)
+
+	segment, err := genSimpleSealedSegment()
+	assert.NoError(t, err)
+	err = node.historical.replica.setSegment(segment)
+	assert.NoError(t, err)
+	result = node.historical.warmupSegments(defaultCollectionID, []UniqueID{defaultSegmentID})
+	assert.Equal(t, 1, result.Segments)
+	assert.Equal(t, 0, result.Failed)
+	assert.Greater(t, result.TouchedBytes, int64(0))
+
+	// the collection is not loaded
+	result = node.historical.warmupSegments(defaultCollectionID+1, nil)
+	assert.Equal(t, 0, result.Segments)
+	assert.NotEmpty(t, result.Reason)
+}
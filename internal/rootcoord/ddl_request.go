@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"go.uber.org/zap"
+)
+
+// ddlRequest is a ddl request being executed or succeeded, done is closed once it's finished
+type ddlRequest struct {
+	msgType    commonpb.MsgType
+	done       chan struct{}
+	err        error
+	finishTime time.Time
+}
+
+// ddlRequests makes the ddl requests idempotent by the request ids set by the proxy. A request retried by the
+// client, e.g. after a timeout, is not executed again but waits for the first one and returns its result, so that
+// a retried CreateCollection doesn't fail because the collection of the first one already exists
+type ddlRequests struct {
+	mu        sync.Mutex
+	retention time.Duration
+	requests  map[string]*ddlRequest
+}
+
+func newDdlRequests(retention time.Duration) *ddlRequests {
+	return &ddlRequests{
+		retention: retention,
+		requests:  make(map[string]*ddlRequest),
+	}
+}
+
+// execute executes t if it's the first request of the request id. The failed requests are forgotten, so that they
+// are executed again if retried, the succeeded ones are kept for the retention
+func (d *ddlRequests) execute(requestID string, t reqTask) error {
+	if requestID == "" {
+		return executeTask(t)
+	}
+
+	d.mu.Lock()
+	d.expire(time.Now())
+	r, ok := d.requests[requestID]
+	if !ok {
+		r = &ddlRequest{msgType: t.Type(), done: make(chan struct{})}
+		d.requests[requestID] = r
+		// executed regardless of the context of the request, the retried requests wait for its result
+		go func() {
+			err := t.Execute(t.Ctx())
+			d.finish(requestID, r, err)
+		}()
+	}
+	d.mu.Unlock()
+
+	if r.msgType != t.Type() {
+		return merr.Errorf(merr.ErrIllegalArgument, "request id %s is used by a %s request", requestID, r.msgType.String())
+	}
+	if ok {
+		log.Debug("wait for the ddl request of the same request id", zap.String("requestID", requestID), zap.String("type", r.msgType.String()))
+	}
+
+	select {
+	case <-t.Core().ctx.Done():
+		return fmt.Errorf("context canceled")
+	case <-t.Ctx().Done():
+		return fmt.Errorf("context canceled")
+	case <-r.done:
+		return r.err
+	}
+}
+
+func (d *ddlRequests) finish(requestID string, r *ddlRequest, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r.err = err
+	r.finishTime = time.Now()
+	if err != nil {
+		delete(d.requests, requestID)
+	}
+	close(r.done)
+}
+
+// expire forgets the requests finished before the retention
+func (d *ddlRequests) expire(now time.Time) {
+	for requestID, r := range d.requests {
+		if !r.finishTime.IsZero() && now.Sub(r.finishTime) > d.retention {
+			delete(d.requests, requestID)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+type mockDdlTask struct {
+	baseReqTask
+	msgType  commonpb.MsgType
+	executed *atomic.Int32
+	block    chan struct{}
+	err      error
+}
+
+func (t *mockDdlTask) Type() commonpb.MsgType {
+	return t.msgType
+}
+
+func (t *mockDdlTask) Execute(ctx context.Context) error {
+	t.executed.Inc()
+	if t.block != nil {
+		<-t.block
+	}
+	return t.err
+}
+
+func TestDdlRequests(t *testing.T) {
+	core := &Core{ctx: context.Background()}
+	executed := atomic.NewInt32(0)
+	newTask := func(ctx context.Context, msgType commonpb.MsgType, err error) *mockDdlTask {
+		return &mockDdlTask{
+			baseReqTask: baseReqTask{ctx: ctx, core: core},
+			msgType:     msgType,
+			executed:    executed,
+			err:         err,
+		}
+	}
+	d := newDdlRequests(time.Minute)
+
+	// a retried request isn't executed again
+	assert.Nil(t, d.execute("r1", newTask(context.Background(), commonpb.MsgType_CreateCollection, nil)))
+	assert.Nil(t, d.execute("r1", newTask(context.Background(), commonpb.MsgType_CreateCollection, errors.New("already exists"))))
+	assert.Equal(t, int32(1), executed.Load())
+
+	// the request id is used by another type of request
+	err := d.execute("r1", newTask(context.Background(), commonpb.MsgType_DropCollection, nil))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(err))
+
+	// the requests without request id are always executed
+	assert.Nil(t, d.execute("", newTask(context.Background(), commonpb.MsgType_CreateCollection, nil)))
+	assert.Nil(t, d.execute("", newTask(context.Background(), commonpb.MsgType_CreateCollection, nil)))
+	assert.Equal(t, int32(3), executed.Load())
+
+	// a failed request is executed again if retried
+	assert.NotNil(t, d.execute("r2", newTask(context.Background(), commonpb.MsgType_CreatePartition, errors.New("mock"))))
+	assert.Nil(t, d.execute("r2", newTask(context.Background(), commonpb.MsgType_CreatePartition, nil)))
+	assert.Equal(t, int32(5), executed.Load())
+
+	// the request timed out is still executed, the retried one waits for its result
+	ctx, cancel := context.WithCancel(context.Background())
+	first := newTask(ctx, commonpb.MsgType_CreateCollection, nil)
+	first.block = make(chan struct{})
+	cancel()
+	assert.NotNil(t, d.execute("r3", first))
+	retried := make(chan error, 1)
+	go func() {
+		retried <- d.execute("r3", newTask(context.Background(), commonpb.MsgType_CreateCollection, errors.New("already exists")))
+	}()
+	close(first.block)
+	assert.Nil(t, <-retried)
+	assert.Equal(t, int32(6), executed.Load())
+
+	// the results are forgotten after the retention
+	d.mu.Lock()
+	d.expire(time.Now().Add(2 * time.Minute))
+	assert.Empty(t, d.requests)
+	d.mu.Unlock()
+}
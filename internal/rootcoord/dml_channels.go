@@ -13,25 +13,107 @@ package rootcoord
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
+const (
+	// dmlChannelPolicyCollection creates the physical channels for each collection
+	dmlChannelPolicyCollection = "collection"
+	// dmlChannelPolicyHash assigns the physical channels of the pool to a collection by the hash of its name
+	dmlChannelPolicyHash = "hash"
+	// dmlChannelPolicyLeastLoaded assigns the physical channels of the pool with the fewest virtual channels
+	dmlChannelPolicyLeastLoaded = "leastLoaded"
+)
+
 type dmlChannels struct {
 	core *Core
 	lock sync.RWMutex
 	dml  map[string]msgstream.MsgStream
+	// refcnt is the num of the virtual channels on each physical channel, the producer is closed once it's 0
+	refcnt map[string]int
 }
 
 func newDMLChannels(c *Core) *dmlChannels {
 	return &dmlChannels{
-		core: c,
-		lock: sync.RWMutex{},
-		dml:  make(map[string]msgstream.MsgStream),
+		core:   c,
+		lock:   sync.RWMutex{},
+		dml:    make(map[string]msgstream.MsgStream),
+		refcnt: make(map[string]int),
+	}
+}
+
+// poolChannels returns the physical channels shared by the collections if the policy isn't collection
+func poolChannels() []string {
+	names := make([]string, Params.DmlChannelNum)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s_%d", Params.DmlChannelName, i)
 	}
+	return names
+}
+
+// AssignChannels returns the virtual and the physical channels of the new shards of a collection by the dml channel
+// policy, pchans are the physical channels of its existing shards. A virtual channel of the pool is named after its
+// physical channel, the other components get the physical channel by ToPhysicalChannel
+func (d *dmlChannels) AssignChannels(collName string, collID typeutil.UniqueID, pchans []string, shardsNum int32) ([]string, []string, error) {
+	first := int32(len(pchans))
+	vchanNames := make([]string, 0, shardsNum-first)
+	chanNames := make([]string, 0, shardsNum-first)
+	if Params.DmlChannelPolicy == dmlChannelPolicyCollection {
+		for i := first; i < shardsNum; i++ {
+			vchanName := fmt.Sprintf("%s_%d_%d_v%d", collName, collID, i, i)
+			vchanNames = append(vchanNames, vchanName)
+			chanNames = append(chanNames, ToPhysicalChannel(vchanName))
+		}
+		return vchanNames, chanNames, nil
+	}
+
+	// the shards of a collection are on the different physical channels
+	pool := poolChannels()
+	if int(shardsNum) > len(pool) {
+		return nil, nil, merr.Errorf(merr.ErrIllegalArgument, "the shards num %d exceeds rootcoord.dmlChannelNum %d", shardsNum, len(pool))
+	}
+	switch Params.DmlChannelPolicy {
+	case dmlChannelPolicyHash:
+		h, err := typeutil.Hash32String(collName)
+		if err != nil {
+			return nil, nil, err
+		}
+		start := int(h % int64(len(pool)))
+		for i := first; i < shardsNum; i++ {
+			chanNames = append(chanNames, pool[(start+int(i))%len(pool)])
+		}
+	case dmlChannelPolicyLeastLoaded:
+		used := make(map[string]bool, len(pchans))
+		for _, pchan := range pchans {
+			used[pchan] = true
+		}
+		d.lock.RLock()
+		sort.SliceStable(pool, func(i, j int) bool {
+			return d.refcnt[pool[i]] < d.refcnt[pool[j]]
+		})
+		d.lock.RUnlock()
+		for _, pchan := range pool {
+			if int32(len(chanNames)) == shardsNum-first {
+				break
+			}
+			if !used[pchan] {
+				chanNames = append(chanNames, pchan)
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown dml channel policy %s", Params.DmlChannelPolicy)
+	}
+	for i, chanName := range chanNames {
+		vchanNames = append(vchanNames, fmt.Sprintf("%s_%dv%d", chanName, collID, first+int32(i)))
+	}
+	return vchanNames, chanNames, nil
 }
 
 // GetNumChannels get current dml channel count
@@ -101,7 +183,7 @@ func (d *dmlChannels) BroadcastAll(channels []string, pack *msgstream.MsgPack) e
 	return nil
 }
 
-// AddProducerChannels add named channels as producer
+// AddProducerChannels add named channels as producer, a name is given once for each virtual channel on it
 func (d *dmlChannels) AddProducerChannels(names ...string) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -118,18 +200,25 @@ func (d *dmlChannels) AddProducerChannels(names ...string) {
 			ms.AsProducer([]string{name})
 			d.dml[name] = ms
 		}
+		d.refcnt[name]++
 	}
 }
 
-// RemoveProducerChannels removes specified channels
+// RemoveProducerChannels removes specified channels, a channel shared by the collections is closed once all the
+// virtual channels on it are removed
 func (d *dmlChannels) RemoveProducerChannels(names ...string) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
 	for _, name := range names {
 		if ds, ok := d.dml[name]; ok {
+			d.refcnt[name]--
+			if d.refcnt[name] > 0 {
+				continue
+			}
 			ds.Close()
 			delete(d.dml, name)
+			delete(d.refcnt, name)
 		}
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/merr"
+)
+
+type mockDmlStream struct {
+	msgstream.MsgStream
+	closed bool
+}
+
+func (s *mockDmlStream) Close() {
+	s.closed = true
+}
+
+func TestDmlChannels_AssignChannels(t *testing.T) {
+	Params.Init()
+	defer func(policy string, num int64) {
+		Params.DmlChannelPolicy = policy
+		Params.DmlChannelNum = num
+	}(Params.DmlChannelPolicy, Params.DmlChannelNum)
+	Params.DmlChannelNum = 4
+	d := newDMLChannels(nil)
+
+	t.Run("collection", func(t *testing.T) {
+		Params.DmlChannelPolicy = dmlChannelPolicyCollection
+		vchans, pchans, err := d.AssignChannels("coll", 100, nil, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"coll_100_0_v0", "coll_100_1_v1"}, vchans)
+		assert.Equal(t, []string{"coll_100_0", "coll_100_1"}, pchans)
+
+		// the shards added later
+		vchans, pchans, err = d.AssignChannels("coll", 100, pchans, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"coll_100_2_v2"}, vchans)
+		assert.Equal(t, []string{"coll_100_2"}, pchans)
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		Params.DmlChannelPolicy = dmlChannelPolicyHash
+		vchans, pchans, err := d.AssignChannels("coll", 100, nil, 3)
+		assert.Nil(t, err)
+		pool := poolChannels()
+		for i := range pchans {
+			assert.Contains(t, pool, pchans[i])
+			assert.Equal(t, pchans[i], ToPhysicalChannel(vchans[i]))
+		}
+		assert.NotEqual(t, pchans[0], pchans[1])
+		assert.NotEqual(t, pchans[1], pchans[2])
+
+		// the same collection name is assigned the same physical channels
+		_, again, err := d.AssignChannels("coll", 101, nil, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, pchans, again)
+
+		_, _, err = d.AssignChannels("coll", 100, nil, 5)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(err))
+
+		// the shards added later continue after the existing ones
+		vchans, added, err := d.AssignChannels("coll", 100, pchans[:2], 3)
+		assert.Nil(t, err)
+		assert.Equal(t, pchans[2:], added)
+		assert.Equal(t, pchans[2]+"_100v2", vchans[0])
+	})
+
+	t.Run("leastLoaded", func(t *testing.T) {
+		Params.DmlChannelPolicy = dmlChannelPolicyLeastLoaded
+		pool := poolChannels()
+		d.refcnt[pool[0]] = 2
+		d.refcnt[pool[2]] = 1
+		vchans, pchans, err := d.AssignChannels("coll", 100, nil, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{pool[1], pool[3]}, pchans)
+		assert.Equal(t, pool[1]+"_100v0", vchans[0])
+
+		_, pchans, err = d.AssignChannels("coll", 101, nil, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{pool[1], pool[3], pool[2]}, pchans)
+
+		// the physical channels of the existing shards are not assigned again
+		_, added, err := d.AssignChannels("coll", 100, []string{pool[1], pool[3]}, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{pool[2], pool[0]}, added)
+	})
+}
+
+func TestDmlChannels_RefCount(t *testing.T) {
+	d := newDMLChannels(nil)
+	s := &mockDmlStream{}
+	d.dml["p0"] = s
+
+	// shared by two virtual channels
+	d.AddProducerChannels("p0", "p0")
+	d.RemoveProducerChannels("p0")
+	assert.False(t, s.closed)
+	assert.Equal(t, 1, d.GetNumChannels())
+
+	d.RemoveProducerChannels("p0")
+	assert.True(t, s.closed)
+	assert.Equal(t, 0, d.GetNumChannels())
+}
@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -24,17 +25,22 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/apikey"
+	"github.com/milvus-io/milvus/internal/util/merr"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
-	ComponentPrefix        = "root-coord"
-	TenantMetaPrefix       = ComponentPrefix + "/tenant"
-	ProxyMetaPrefix        = ComponentPrefix + "/proxy"
-	CollectionMetaPrefix   = ComponentPrefix + "/collection"
-	SegmentIndexMetaPrefix = ComponentPrefix + "/segment-index"
-	IndexMetaPrefix        = ComponentPrefix + "/index"
+	ComponentPrefix             = "root-coord"
+	TenantMetaPrefix            = ComponentPrefix + "/tenant"
+	ProxyMetaPrefix             = ComponentPrefix + "/proxy"
+	CollectionMetaPrefix        = ComponentPrefix + "/collection"
+	DroppedCollectionMetaPrefix = ComponentPrefix + "/dropped-collection"
+	SegmentIndexMetaPrefix      = ComponentPrefix + "/segment-index"
+	IndexMetaPrefix             = ComponentPrefix + "/index"
+	ApiKeyMetaPrefix            = ComponentPrefix + "/api-key"
 
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
@@ -53,13 +59,16 @@ type metaTable struct {
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
 	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
+	droppedID2Meta  map[typeutil.UniqueID]pb.CollectionInfo                         // dropped collection id -> meta, kept until it's purged
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	apiKeys         map[string]pb.ApiKeyInfo                                        // api key name -> meta
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	apiKeyLock sync.RWMutex
 }
 
 func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
@@ -68,6 +77,7 @@ func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		apiKeyLock: sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -82,9 +92,11 @@ func (mt *metaTable) reloadFromKV() error {
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
 	mt.collName2ID = make(map[string]typeutil.UniqueID)
+	mt.droppedID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.apiKeys = make(map[string]pb.ApiKeyInfo)
 
 	_, values, err := mt.client.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -129,6 +141,20 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
 	}
 
+	_, values, err = mt.client.LoadWithPrefix(DroppedCollectionMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		collInfo := pb.CollectionInfo{}
+		err = proto.UnmarshalText(value, &collInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
+		}
+		mt.droppedID2Meta[collInfo.ID] = collInfo
+	}
+
 	_, values, err = mt.client.LoadWithPrefix(SegmentIndexMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -174,6 +200,19 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.indexID2Meta[meta.IndexID] = meta
 	}
 
+	_, values, err = mt.client.LoadWithPrefix(ApiKeyMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		info := pb.ApiKeyInfo{}
+		err = proto.UnmarshalText(value, &info)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.ApiKeyInfo err:%w", err)
+		}
+		mt.apiKeys[info.Name] = info
+	}
+
 	return nil
 }
 
@@ -235,6 +274,9 @@ func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
+	if int64(len(mt.collID2Meta)) >= Params.MaxCollectionNum {
+		return merr.Errorf(merr.ErrQuotaExceeded, "maximum collection's number should be limit to %d", Params.MaxCollectionNum)
+	}
 	if len(coll.FieldIndexes) != len(idx) {
 		return fmt.Errorf("incorrect index id when creating collection")
 	}
@@ -279,14 +321,17 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	// a dropped collection is deleted once it's purged
 	collMeta, ok := mt.collID2Meta[collID]
-	if !ok {
-		return fmt.Errorf("can't find collection. id = %d", collID)
+	if ok {
+		delete(mt.collID2Meta, collID)
+		delete(mt.collName2ID, collMeta.Schema.Name)
+	} else if collMeta, ok = mt.droppedID2Meta[collID]; ok {
+		delete(mt.droppedID2Meta, collID)
+	} else {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find collection. id = %d", collID)
 	}
 
-	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
-
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
 		if segIDMap, ok := mt.partID2SegID[typeutil.UniqueID(partID)]; ok {
@@ -314,6 +359,7 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 		fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID),
 		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
 		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID),
 	}
 
 	// save ddOpStr into etcd
@@ -328,6 +374,89 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	return nil
 }
 
+// SoftDeleteCollection moves the collection to the dropped collections, which can be restored by RestoreCollection
+// until they are purged by DeleteCollection. Its indexes are kept with it
+func (mt *metaTable) SoftDeleteCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find collection. id = %d", collID)
+	}
+
+	delete(mt.collID2Meta, collID)
+	delete(mt.collName2ID, collMeta.Schema.Name)
+	collMeta.DropTs = ts
+	mt.droppedID2Meta[collID] = collMeta
+
+	saveMeta := map[string]string{
+		fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID): proto.MarshalTextString(&collMeta),
+	}
+	delMetakeys := []string{fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	return nil
+}
+
+// RestoreCollection restores a dropped collection, it fails if a collection of the same name has been created since
+// the drop
+func (mt *metaTable) RestoreCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.droppedID2Meta[collID]
+	if !ok {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find dropped collection. id = %d", collID)
+	}
+	if _, ok := mt.collName2ID[collMeta.Schema.Name]; ok {
+		return merr.Errorf(merr.ErrIllegalArgument, "collection %s has been created since the drop", collMeta.Schema.Name)
+	}
+	if int64(len(mt.collID2Meta)) >= Params.MaxCollectionNum {
+		return merr.Errorf(merr.ErrQuotaExceeded, "maximum collection's number should be limit to %d", Params.MaxCollectionNum)
+	}
+
+	delete(mt.droppedID2Meta, collID)
+	collMeta.DropTs = 0
+	mt.collID2Meta[collID] = collMeta
+	mt.collName2ID[collMeta.Schema.Name] = collID
+
+	saveMeta := map[string]string{
+		fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID): proto.MarshalTextString(&collMeta),
+	}
+	delMetakeys := []string{fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID)}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	return nil
+}
+
+// ListDroppedCollections lists the dropped collections not purged yet, ordered by the drop timestamps
+func (mt *metaTable) ListDroppedCollections() []*pb.CollectionInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	colls := make([]*pb.CollectionInfo, 0, len(mt.droppedID2Meta))
+	for _, coll := range mt.droppedID2Meta {
+		colCopy := proto.Clone(&coll)
+		colls = append(colls, colCopy.(*pb.CollectionInfo))
+	}
+	sort.Slice(colls, func(i, j int) bool {
+		if colls[i].DropTs == colls[j].DropTs {
+			return colls[i].ID < colls[j].ID
+		}
+		return colls[i].DropTs < colls[j].DropTs
+	})
+	return colls
+}
+
 func (mt *metaTable) HasCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -347,7 +476,7 @@ func (mt *metaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	if ts == 0 {
 		col, ok := mt.collID2Meta[collectionID]
 		if !ok {
-			return nil, fmt.Errorf("can't find collection id : %d", collectionID)
+			return nil, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection id : %d", collectionID)
 		}
 		colCopy := proto.Clone(&col)
 		return colCopy.(*pb.CollectionInfo), nil
@@ -372,11 +501,11 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 	if ts == 0 {
 		vid, ok := mt.collName2ID[collectionName]
 		if !ok {
-			return nil, fmt.Errorf("can't find collection: " + collectionName)
+			return nil, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection: %s", collectionName)
 		}
 		col, ok := mt.collID2Meta[vid]
 		if !ok {
-			return nil, fmt.Errorf("can't find collection: " + collectionName)
+			return nil, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection: %s", collectionName)
 		}
 		colCopy := proto.Clone(&col)
 		return colCopy.(*pb.CollectionInfo), nil
@@ -396,7 +525,7 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			return &collMeta, nil
 		}
 	}
-	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
+	return nil, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
 func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
@@ -440,7 +569,8 @@ func (mt *metaTable) ListCollectionVirtualChannels() []string {
 	return vlist
 }
 
-// ListCollectionPhysicalChannels list physical channel of all the collection
+// ListCollectionPhysicalChannels list physical channel of all the collection, including the dropped ones whose
+// channels are kept until they are purged
 func (mt *metaTable) ListCollectionPhysicalChannels() []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -449,6 +579,9 @@ func (mt *metaTable) ListCollectionPhysicalChannels() []string {
 	for _, c := range mt.collID2Meta {
 		plist = append(plist, c.PhysicalChannelNames...)
 	}
+	for _, c := range mt.droppedID2Meta {
+		plist = append(plist, c.PhysicalChannelNames...)
+	}
 	return plist
 }
 
@@ -457,12 +590,12 @@ func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	defer mt.ddLock.Unlock()
 	coll, ok := mt.collID2Meta[collID]
 	if !ok {
-		return fmt.Errorf("can't find collection. id = %d", collID)
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find collection. id = %d", collID)
 	}
 
 	// number of partition tags (except _default) should be limited to 4096 by default
 	if int64(len(coll.PartitionIDs)) >= Params.MaxPartitionNum {
-		return fmt.Errorf("maximum partition's number should be limit to %d", Params.MaxPartitionNum)
+		return merr.Errorf(merr.ErrQuotaExceeded, "maximum partition's number should be limit to %d", Params.MaxPartitionNum)
 	}
 
 	if len(coll.PartitionIDs) != len(coll.PartitionNames) {
@@ -518,14 +651,14 @@ func (mt *metaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID,
 		defer mt.ddLock.RUnlock()
 		collMeta, ok := mt.collID2Meta[collID]
 		if !ok {
-			return "", fmt.Errorf("can't find collection id = %d", collID)
+			return "", merr.Errorf(merr.ErrCollectionNotFound, "can't find collection id = %d", collID)
 		}
 		for idx := range collMeta.PartitionIDs {
 			if collMeta.PartitionIDs[idx] == partitionID {
 				return collMeta.PartitionNames[idx], nil
 			}
 		}
-		return "", fmt.Errorf("partition %d does not exist", partitionID)
+		return "", merr.Errorf(merr.ErrPartitionNotFound, "partition %d does not exist", partitionID)
 	}
 	collKey := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
 	collVal, err := mt.client.Load(collKey, ts)
@@ -542,21 +675,21 @@ func (mt *metaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID,
 			return collMeta.PartitionNames[idx], nil
 		}
 	}
-	return "", fmt.Errorf("partition %d does not exist", partitionID)
+	return "", merr.Errorf(merr.ErrPartitionNotFound, "partition %d does not exist", partitionID)
 }
 
 func (mt *metaTable) getPartitionByName(collID typeutil.UniqueID, partitionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
 	if ts == 0 {
 		collMeta, ok := mt.collID2Meta[collID]
 		if !ok {
-			return 0, fmt.Errorf("can't find collection id = %d", collID)
+			return 0, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection id = %d", collID)
 		}
 		for idx := range collMeta.PartitionIDs {
 			if collMeta.PartitionNames[idx] == partitionName {
 				return collMeta.PartitionIDs[idx], nil
 			}
 		}
-		return 0, fmt.Errorf("partition %s does not exist", partitionName)
+		return 0, merr.Errorf(merr.ErrPartitionNotFound, "partition %s does not exist", partitionName)
 	}
 	collKey := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
 	collVal, err := mt.client.Load(collKey, ts)
@@ -573,7 +706,7 @@ func (mt *metaTable) getPartitionByName(collID typeutil.UniqueID, partitionName
 			return collMeta.PartitionIDs[idx], nil
 		}
 	}
-	return 0, fmt.Errorf("partition %s does not exist", partitionName)
+	return 0, merr.Errorf(merr.ErrPartitionNotFound, "partition %s does not exist", partitionName)
 }
 
 func (mt *metaTable) GetPartitionByName(collID typeutil.UniqueID, partitionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
@@ -599,7 +732,7 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
-		return 0, fmt.Errorf("can't find collection id = %d", collID)
+		return 0, merr.Errorf(merr.ErrCollectionNotFound, "can't find collection id = %d", collID)
 	}
 
 	// check tag exists
@@ -620,7 +753,7 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 		}
 	}
 	if !exist {
-		return 0, fmt.Errorf("partition %s does not exist", partitionName)
+		return 0, merr.Errorf(merr.ErrPartitionNotFound, "partition %s does not exist", partitionName)
 	}
 	collMeta.PartitionIDs = pd
 	collMeta.PartitionNames = pn
@@ -653,13 +786,122 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	return partID, nil
 }
 
+// AlterCollection sets the properties of a collection, then deletes the properties of deleteKeys
+func (mt *metaTable) AlterCollection(collID typeutil.UniqueID, properties []*commonpb.KeyValuePair, deleteKeys []string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find collection. id = %d", collID)
+	}
+
+	for _, kv := range properties {
+		if kv.Key == "" {
+			return fmt.Errorf("the key of a collection property should not be empty")
+		}
+		if len(kv.Key) > Params.MaxPropertyLength || len(kv.Value) > Params.MaxPropertyLength {
+			return fmt.Errorf("the length of the key and the value of collection property %s should be limit to %d", kv.Key, Params.MaxPropertyLength)
+		}
+	}
+	altered := AlterProperties(coll.Properties, properties, deleteKeys)
+	if len(altered) > Params.MaxPropertyNum {
+		return fmt.Errorf("maximum collection property's number should be limit to %d", Params.MaxPropertyNum)
+	}
+	coll.Properties = altered
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v := proto.MarshalTextString(&coll)
+	err := mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[collID] = coll
+	return nil
+}
+
+// GetQuotaUsage returns the usages of the quotas of the cluster and all the collections, or only the ones of a
+// collection if collName is set
+func (mt *metaTable) GetQuotaUsage(collName string) ([]*milvuspb.QuotaUsage, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	var usages []*milvuspb.QuotaUsage
+	collNames := make([]string, 0, len(mt.collName2ID))
+	if collName != "" {
+		if _, ok := mt.collName2ID[collName]; !ok {
+			return nil, merr.WrapErrCollectionNotFound(collName)
+		}
+		collNames = append(collNames, collName)
+	} else {
+		usages = append(usages, &milvuspb.QuotaUsage{
+			Name:  "collections",
+			Used:  int64(len(mt.collID2Meta)),
+			Limit: Params.MaxCollectionNum,
+		})
+		for name := range mt.collName2ID {
+			collNames = append(collNames, name)
+		}
+		sort.Strings(collNames)
+	}
+
+	for _, name := range collNames {
+		coll := mt.collID2Meta[mt.collName2ID[name]]
+		var fieldNum int64
+		for _, field := range coll.Schema.Fields {
+			if field.FieldID >= StartOfUserFieldID {
+				fieldNum++
+			}
+		}
+		usages = append(usages, &milvuspb.QuotaUsage{
+			Name:           "partitions",
+			CollectionName: name,
+			Used:           int64(len(coll.PartitionIDs)),
+			Limit:          Params.MaxPartitionNum,
+		}, &milvuspb.QuotaUsage{
+			Name:           "fields",
+			CollectionName: name,
+			Used:           fieldNum,
+			Limit:          Params.MaxFieldNum,
+		})
+	}
+	return usages, nil
+}
+
+// AddCollectionShards appends the channels of the new shards to a collection
+func (mt *metaTable) AddCollectionShards(collID typeutil.UniqueID, vchanNames []string, chanNames []string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find collection. id = %d", collID)
+	}
+	if len(vchanNames) != len(chanNames) {
+		return fmt.Errorf("the num of the virtual channels %d and the physical channels %d are different", len(vchanNames), len(chanNames))
+	}
+
+	coll.VirtualChannelNames = append(coll.VirtualChannelNames, vchanNames...)
+	coll.PhysicalChannelNames = append(coll.PhysicalChannelNames, chanNames...)
+	coll.ShardsNum = int32(len(coll.VirtualChannelNames))
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v := proto.MarshalTextString(&coll)
+	err := mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[collID] = coll
+	return nil
+}
+
 func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	collMeta, ok := mt.collID2Meta[segIdxInfo.CollectionID]
 	if !ok {
-		return fmt.Errorf("collection id = %d not found", segIdxInfo.CollectionID)
+		return merr.Errorf(merr.ErrCollectionNotFound, "collection id = %d not found", segIdxInfo.CollectionID)
 	}
 	exist := false
 	for _, fidx := range collMeta.FieldIndexes {
@@ -669,7 +911,7 @@ func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Times
 		}
 	}
 	if !exist {
-		return fmt.Errorf("index id = %d not found", segIdxInfo.IndexID)
+		return merr.Errorf(merr.ErrIndexNotFound, "index id = %d not found", segIdxInfo.IndexID)
 	}
 
 	segIdxMap, ok := mt.segID2IndexMeta[segIdxInfo.SegmentID]
@@ -714,7 +956,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 
 	collID, ok := mt.collName2ID[collName]
 	if !ok {
-		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
+		return 0, false, merr.Errorf(merr.ErrCollectionNotFound, "collection name = %s not exist", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -737,7 +979,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 			log.Warn("index id not has meta", zap.Int64("index id", info.IndexID))
 			continue
 		}
-		if idxMeta.IndexName != indexName {
+		if idxMeta.IndexName != indexName || idxMeta.ReplacedIndexID != 0 {
 			fieldIdxInfo = append(fieldIdxInfo, info)
 			continue
 		}
@@ -801,7 +1043,7 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 	if filedID == -1 && idxName == "" { // return default index
 		for _, seg := range segIdxMap {
 			info, ok := mt.indexID2Meta[seg.IndexID]
-			if ok && info.IndexName == Params.DefaultIndexName {
+			if ok && info.IndexName == Params.DefaultIndexName && info.ReplacedIndexID == 0 {
 				return seg, nil
 			}
 		}
@@ -809,7 +1051,7 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 		for idxID, seg := range segIdxMap {
 			idxMeta, ok := mt.indexID2Meta[idxID]
 			if ok {
-				if idxMeta.IndexName != idxName {
+				if idxMeta.IndexName != idxName || idxMeta.ReplacedIndexID != 0 {
 					continue
 				}
 				if seg.FieldID != filedID {
@@ -832,11 +1074,11 @@ func (mt *metaTable) GetFieldSchema(collName string, fieldName string) (schemapb
 func (mt *metaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
 	collID, ok := mt.collName2ID[collName]
 	if !ok {
-		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
+		return schemapb.FieldSchema{}, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
-		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
+		return schemapb.FieldSchema{}, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 
 	for _, field := range collMeta.Schema.Fields {
@@ -886,11 +1128,11 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	}
 	collID, ok := mt.collName2ID[collName]
 	if !ok {
-		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
+		return nil, schemapb.FieldSchema{}, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
-		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
+		return nil, schemapb.FieldSchema{}, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
 	if err != nil {
@@ -898,10 +1140,12 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	}
 
 	var dupIdx typeutil.UniqueID = 0
+	var dupField typeutil.UniqueID = 0
 	for _, f := range collMeta.FieldIndexes {
 		if info, ok := mt.indexID2Meta[f.IndexID]; ok {
-			if info.IndexName == idxInfo.IndexName {
+			if info.IndexName == idxInfo.IndexName && info.ReplacedIndexID == 0 {
 				dupIdx = info.IndexID
+				dupField = f.FiledID
 				break
 			}
 		}
@@ -909,18 +1153,31 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 
 	exist := false
 	var existInfo pb.IndexInfo
+	var pendingInfo *pb.IndexInfo
 	for _, f := range collMeta.FieldIndexes {
 		if f.FiledID == fieldSchema.FieldID {
-			existInfo, ok = mt.indexID2Meta[f.IndexID]
+			info, ok := mt.indexID2Meta[f.IndexID]
 			if !ok {
-				return nil, schemapb.FieldSchema{}, fmt.Errorf("index id = %d not found", f.IndexID)
+				return nil, schemapb.FieldSchema{}, merr.Errorf(merr.ErrIndexNotFound, "index id = %d not found", f.IndexID)
+			}
+			if info.ReplacedIndexID != 0 && info.IndexName == idxInfo.IndexName {
+				pendingInfo = &info
 			}
-			if EqualKeyPairArray(existInfo.IndexParams, idxInfo.IndexParams) {
+			if !exist && EqualKeyPairArray(info.IndexParams, idxInfo.IndexParams) {
+				existInfo = info
 				exist = true
-				break
 			}
 		}
 	}
+	// only one rebuild of a serving index is allowed at a time, the pending one has to be swapped in first
+	if pendingInfo != nil && !EqualKeyPairArray(pendingInfo.IndexParams, idxInfo.IndexParams) {
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("index %s on field %s is being rebuilt, index id = %d", idxInfo.IndexName, fieldName, pendingInfo.IndexID)
+	}
+	if !exist && dupIdx != 0 && dupField == fieldSchema.FieldID {
+		// the old index keeps serving until the new one is built on all the segments
+		idxInfo.ReplacedIndexID = dupIdx
+		dupIdx = 0
+	}
 	if !exist {
 		idx := &pb.FieldIndexInfo{
 			FiledID: fieldSchema.FieldID,
@@ -989,18 +1246,21 @@ func (mt *metaTable) GetIndexByName(collName, indexName string) (pb.CollectionIn
 
 	collID, ok := mt.collName2ID[collName]
 	if !ok {
-		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
+		return pb.CollectionInfo{}, nil, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
-		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
+		return pb.CollectionInfo{}, nil, merr.Errorf(merr.ErrCollectionNotFound, "collection %s not found", collName)
 	}
 
 	rstIndex := make([]pb.IndexInfo, 0, len(collMeta.FieldIndexes))
 	for _, idx := range collMeta.FieldIndexes {
 		idxInfo, ok := mt.indexID2Meta[idx.IndexID]
 		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("index id = %d not found", idx.IndexID)
+			return pb.CollectionInfo{}, nil, merr.Errorf(merr.ErrIndexNotFound, "index id = %d not found", idx.IndexID)
+		}
+		if idxInfo.ReplacedIndexID != 0 {
+			continue
 		}
 		if indexName == "" || idxInfo.IndexName == indexName {
 			rstIndex = append(rstIndex, idxInfo)
@@ -1020,6 +1280,73 @@ func (mt *metaTable) GetIndexByID(indexID typeutil.UniqueID) (*pb.IndexInfo, err
 	return &indexInfo, nil
 }
 
+// GetIndexBuildIDs returns the build ids of the index on the segments which need the index built
+func (mt *metaTable) GetIndexBuildIDs(indexID typeutil.UniqueID) []typeutil.UniqueID {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	var buildIDs []typeutil.UniqueID
+	for _, segIdxMeta := range mt.segID2IndexMeta {
+		if segIdxInfo, ok := segIdxMeta[indexID]; ok && segIdxInfo.EnableIndex {
+			buildIDs = append(buildIDs, segIdxInfo.BuildID)
+		}
+	}
+	return buildIDs
+}
+
+// SwapIndex makes the rebuilt index serve in place of the one it replaces, and drops the replaced index,
+// return the replaced index id
+func (mt *metaTable) SwapIndex(collID typeutil.UniqueID, indexID typeutil.UniqueID, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return 0, merr.Errorf(merr.ErrCollectionNotFound, "collection id = %d not found", collID)
+	}
+	idxInfo, ok := mt.indexID2Meta[indexID]
+	if !ok {
+		return 0, merr.Errorf(merr.ErrIndexNotFound, "index id = %d not found", indexID)
+	}
+	oldIdxID := idxInfo.ReplacedIndexID
+	if oldIdxID == 0 {
+		return 0, fmt.Errorf("index id = %d doesn't replace any index", indexID)
+	}
+	idxInfo.ReplacedIndexID = 0
+	mt.indexID2Meta[indexID] = idxInfo
+
+	fieldIdxInfo := make([]*pb.FieldIndexInfo, 0, len(collMeta.FieldIndexes))
+	for _, info := range collMeta.FieldIndexes {
+		if info.IndexID != oldIdxID {
+			fieldIdxInfo = append(fieldIdxInfo, info)
+		}
+	}
+	collMeta.FieldIndexes = fieldIdxInfo
+	mt.collID2Meta[collID] = collMeta
+
+	delete(mt.indexID2Meta, oldIdxID)
+	for _, segIndexInfos := range mt.segID2IndexMeta {
+		delete(segIndexInfos, oldIdxID)
+	}
+
+	saveMeta := map[string]string{
+		path.Join(CollectionMetaPrefix, strconv.FormatInt(collID, 10)): proto.MarshalTextString(&collMeta),
+		path.Join(IndexMetaPrefix, strconv.FormatInt(indexID, 10)):     proto.MarshalTextString(&idxInfo),
+	}
+	delMeta := []string{
+		fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collID, oldIdxID),
+		fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collID, oldIdxID),
+	}
+
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMeta, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	return oldIdxID, nil
+}
+
 func (mt *metaTable) dupMeta() (
 	map[typeutil.UniqueID]pb.CollectionInfo,
 	map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo,
@@ -1045,3 +1372,80 @@ func (mt *metaTable) dupMeta() (
 	}
 	return collID2Meta, segID2IndexMeta, indexID2Meta
 }
+
+// apiKeyMetaKey returns the kv key of the api key, the names are hashed to keys of the same length, so that removing
+// the key of a name by prefix never removes the ones of the other names
+func apiKeyMetaKey(name string) string {
+	return fmt.Sprintf("%s/%s", ApiKeyMetaPrefix, apikey.Hash(name))
+}
+
+// AddApiKey saves a new api key, fails if the name is used by another key
+func (mt *metaTable) AddApiKey(info *pb.ApiKeyInfo, ts typeutil.Timestamp) error {
+	mt.apiKeyLock.Lock()
+	defer mt.apiKeyLock.Unlock()
+	if _, ok := mt.apiKeys[info.Name]; ok {
+		return fmt.Errorf("api key %s already exists", info.Name)
+	}
+
+	err := mt.client.Save(apiKeyMetaKey(info.Name), proto.MarshalTextString(info), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.apiKeys[info.Name] = *info
+	return nil
+}
+
+// RotateApiKey replaces the hash of an api key, the previous key is accepted until now+gracePeriod, in seconds
+func (mt *metaTable) RotateApiKey(name string, hash string, now int64, gracePeriod int64, ts typeutil.Timestamp) error {
+	mt.apiKeyLock.Lock()
+	defer mt.apiKeyLock.Unlock()
+	info, ok := mt.apiKeys[name]
+	if !ok {
+		return fmt.Errorf("api key %s does not exist", name)
+	}
+
+	info.PreviousHash, info.PreviousExpireTime = "", 0
+	if gracePeriod > 0 {
+		info.PreviousHash, info.PreviousExpireTime = info.Hash, now+gracePeriod
+	}
+	info.Hash = hash
+	info.RotateTime = now
+
+	err := mt.client.Save(apiKeyMetaKey(name), proto.MarshalTextString(&info), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.apiKeys[name] = info
+	return nil
+}
+
+// DeleteApiKey removes an api key, the key is rejected by the proxies once they refresh the api keys
+func (mt *metaTable) DeleteApiKey(name string, ts typeutil.Timestamp) error {
+	mt.apiKeyLock.Lock()
+	defer mt.apiKeyLock.Unlock()
+	if _, ok := mt.apiKeys[name]; !ok {
+		return fmt.Errorf("api key %s does not exist", name)
+	}
+
+	err := mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{apiKeyMetaKey(name)}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.apiKeys, name)
+	return nil
+}
+
+// ListApiKeys returns all the api keys
+func (mt *metaTable) ListApiKeys() []*pb.ApiKeyInfo {
+	mt.apiKeyLock.RLock()
+	defer mt.apiKeyLock.RUnlock()
+	keys := make([]*pb.ApiKeyInfo, 0, len(mt.apiKeys))
+	for _, info := range mt.apiKeys {
+		info := info
+		keys = append(keys, &info)
+	}
+	return keys
+}
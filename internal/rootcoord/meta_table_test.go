@@ -11,8 +11,10 @@
 package rootcoord
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,7 +22,9 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -425,6 +429,244 @@ func TestMetaTable(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("swap index", func(t *testing.T) {
+		idxInfo := &pb.IndexInfo{
+			IndexName: "field110-1",
+			IndexID:   2002,
+			IndexParams: []*commonpb.KeyValuePair{
+				{
+					Key:   "field110-i3",
+					Value: "field110-v3",
+				},
+			},
+		}
+		seg, _, err := mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID}, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []typeutil.UniqueID{segID}, seg)
+		assert.Equal(t, int64(2001), idxInfo.ReplacedIndexID)
+
+		// the replaced index keeps serving until the swap
+		_, idxs, err := mt.GetIndexByName("testColl", "field110-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(idxs))
+		assert.Equal(t, int64(2001), idxs[0].IndexID)
+
+		// only one rebuild of the index at a time
+		another := &pb.IndexInfo{
+			IndexName: "field110-1",
+			IndexID:   2003,
+			IndexParams: []*commonpb.KeyValuePair{
+				{
+					Key:   "field110-i4",
+					Value: "field110-v4",
+				},
+			},
+		}
+		_, _, err = mt.GetNotIndexedSegments("testColl", "field110", another, nil, 0)
+		assert.NotNil(t, err)
+
+		_, err = mt.SwapIndex(collID, 2001, 0)
+		assert.NotNil(t, err)
+		oldIdxID, err := mt.SwapIndex(collID, 2002, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2001), oldIdxID)
+
+		_, idxs, err = mt.GetIndexByName("testColl", "field110-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(idxs))
+		assert.Equal(t, int64(2002), idxs[0].IndexID)
+		_, err = mt.GetIndexByID(2001)
+		assert.NotNil(t, err)
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(collMeta.FieldIndexes))
+		assert.Equal(t, int64(2002), collMeta.FieldIndexes[0].IndexID)
+	})
+
+	t.Run("alter collection", func(t *testing.T) {
+		ts := ftso()
+		err := mt.AlterCollection(collID, []*commonpb.KeyValuePair{
+			{Key: "owner", Value: "search"},
+			{Key: "env", Value: "dev"},
+		}, nil, ts)
+		assert.Nil(t, err)
+		ts = ftso()
+		err = mt.AlterCollection(collID, []*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}, []string{"owner"}, ts)
+		assert.Nil(t, err)
+
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		assert.True(t, EqualKeyPairArray([]*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}, collMeta.Properties))
+		collMeta, err = mt.GetCollectionByID(collID, ts)
+		assert.Nil(t, err)
+		assert.True(t, EqualKeyPairArray([]*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}, collMeta.Properties))
+
+		err = mt.AlterCollection(collIDInvalid, []*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}, nil, ftso())
+		assert.NotNil(t, err)
+		err = mt.AlterCollection(collID, []*commonpb.KeyValuePair{{Key: "", Value: "prod"}}, nil, ftso())
+		assert.NotNil(t, err)
+		err = mt.AlterCollection(collID, []*commonpb.KeyValuePair{{Key: "env", Value: strings.Repeat("a", Params.MaxPropertyLength+1)}}, nil, ftso())
+		assert.NotNil(t, err)
+		properties := make([]*commonpb.KeyValuePair, 0, Params.MaxPropertyNum)
+		for i := 0; i < Params.MaxPropertyNum; i++ {
+			properties = append(properties, &commonpb.KeyValuePair{Key: fmt.Sprintf("key%d", i), Value: "value"})
+		}
+		err = mt.AlterCollection(collID, properties, nil, ftso())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add collection shards", func(t *testing.T) {
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		shardsNum := len(collMeta.VirtualChannelNames)
+
+		err = mt.AddCollectionShards(collID, []string{"new_vchan"}, []string{"new_pchan"}, ftso())
+		assert.Nil(t, err)
+		collMeta, err = mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, shardsNum+1, len(collMeta.VirtualChannelNames))
+		assert.Equal(t, "new_vchan", collMeta.VirtualChannelNames[shardsNum])
+		assert.Equal(t, "new_pchan", collMeta.PhysicalChannelNames[shardsNum])
+		assert.Equal(t, int32(shardsNum+1), collMeta.ShardsNum)
+
+		err = mt.AddCollectionShards(collIDInvalid, []string{"new_vchan"}, []string{"new_pchan"}, ftso())
+		assert.NotNil(t, err)
+		err = mt.AddCollectionShards(collID, []string{"new_vchan"}, nil, ftso())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("quota", func(t *testing.T) {
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		usages, err := mt.GetQuotaUsage("")
+		assert.Nil(t, err)
+		assert.Equal(t, []*milvuspb.QuotaUsage{
+			{Name: "collections", Used: 1, Limit: Params.MaxCollectionNum},
+			{Name: "partitions", CollectionName: collName, Used: int64(len(collMeta.PartitionIDs)), Limit: Params.MaxPartitionNum},
+			{Name: "fields", CollectionName: collName, Used: 1, Limit: Params.MaxFieldNum},
+		}, usages)
+		usages, err = mt.GetQuotaUsage(collName)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(usages))
+		_, err = mt.GetQuotaUsage("testColl-not-exist")
+		assert.True(t, errors.Is(err, merr.ErrCollectionNotFound))
+
+		maxCollectionNum := Params.MaxCollectionNum
+		defer func() { Params.MaxCollectionNum = maxCollectionNum }()
+		Params.MaxCollectionNum = 1
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.ID = collIDInvalid
+		coll.Schema.Name = "testColl-quota"
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, merr.Code(err))
+	})
+
+	t.Run("soft delete collection", func(t *testing.T) {
+		ts := ftso()
+		err := mt.SoftDeleteCollection(collID, ts)
+		assert.Nil(t, err)
+		assert.False(t, mt.HasCollection(collID, 0))
+		_, err = mt.GetCollectionByName(collName, 0)
+		assert.True(t, errors.Is(err, merr.ErrCollectionNotFound))
+		err = mt.SoftDeleteCollection(collID, ftso())
+		assert.True(t, errors.Is(err, merr.ErrCollectionNotFound))
+		dropped := mt.ListDroppedCollections()
+		assert.Equal(t, 1, len(dropped))
+		assert.Equal(t, collID, dropped[0].ID)
+		assert.Equal(t, ts, dropped[0].DropTs)
+		// the channels are kept until it's purged
+		assert.ElementsMatch(t, dropped[0].PhysicalChannelNames, mt.ListCollectionPhysicalChannels())
+
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.False(t, mt2.HasCollection(collID, 0))
+		assert.Equal(t, 1, len(mt2.ListDroppedCollections()))
+
+		err = mt.RestoreCollection(collIDInvalid, ftso())
+		assert.True(t, errors.Is(err, merr.ErrCollectionNotFound))
+		err = mt.RestoreCollection(collID, ftso())
+		assert.Nil(t, err)
+		collMeta, err := mt.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), collMeta.DropTs)
+		assert.Empty(t, mt.ListDroppedCollections())
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.True(t, mt2.HasCollection(collID, 0))
+		assert.Empty(t, mt2.ListDroppedCollections())
+
+		// a collection of the same name is created since the drop
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.ID = 3
+		coll.Schema.Name = "testColl-recycle"
+		coll.FieldIndexes = nil
+		err = mt.AddCollection(coll, ftso(), nil, ddOp)
+		assert.Nil(t, err)
+		err = mt.SoftDeleteCollection(3, ftso())
+		assert.Nil(t, err)
+		coll.ID = 4
+		err = mt.AddCollection(coll, ftso(), nil, ddOp)
+		assert.Nil(t, err)
+		err = mt.RestoreCollection(3, ftso())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(err))
+
+		// purged
+		err = mt.DeleteCollection(3, ftso(), ddOp)
+		assert.Nil(t, err)
+		assert.Empty(t, mt.ListDroppedCollections())
+		err = mt.DeleteCollection(4, ftso(), ddOp)
+		assert.Nil(t, err)
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.Empty(t, mt2.ListDroppedCollections())
+		assert.False(t, mt2.HasCollection(4, 0))
+	})
+
+	t.Run("api key", func(t *testing.T) {
+		info := &pb.ApiKeyInfo{
+			Name:       "ingest",
+			Hash:       "hash1",
+			Privileges: []string{"dml"},
+			CreateTime: 100,
+		}
+		err := mt.AddApiKey(info, ftso())
+		assert.Nil(t, err)
+		err = mt.AddApiKey(info, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.RotateApiKey("ingest", "hash2", 200, 60, ftso())
+		assert.Nil(t, err)
+		err = mt.RotateApiKey("other", "hash2", 200, 60, ftso())
+		assert.NotNil(t, err)
+		keys := mt.ListApiKeys()
+		assert.Equal(t, 1, len(keys))
+		assert.Equal(t, "hash2", keys[0].Hash)
+		assert.Equal(t, "hash1", keys[0].PreviousHash)
+		assert.Equal(t, int64(260), keys[0].PreviousExpireTime)
+		assert.Equal(t, int64(200), keys[0].RotateTime)
+
+		err = mt.RotateApiKey("ingest", "hash3", 300, 0, ftso())
+		assert.Nil(t, err)
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		keys = mt2.ListApiKeys()
+		assert.Equal(t, 1, len(keys))
+		assert.Equal(t, "hash3", keys[0].Hash)
+		assert.Equal(t, "", keys[0].PreviousHash)
+
+		err = mt.AddApiKey(&pb.ApiKeyInfo{Name: "ingest-2", Hash: "hash4"}, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteApiKey("ingest", ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteApiKey("ingest", ftso())
+		assert.NotNil(t, err)
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		keys = mt2.ListApiKeys()
+		assert.Equal(t, 1, len(keys))
+		assert.Equal(t, "ingest-2", keys[0].Name)
+	})
+
 	t.Run("drop partition", func(t *testing.T) {
 		ts := ftso()
 		id, err := mt.DeletePartition(collID, partName, ts, nil)
@@ -512,6 +754,7 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection: %s", collInfo.Schema.Name))
+		assert.True(t, errors.Is(err, merr.ErrCollectionNotFound))
 
 	})
 
@@ -543,6 +786,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddPartition(coll.ID, "no-part", 22, ts, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("maximum partition's number should be limit to %d", Params.MaxPartitionNum))
+		assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, merr.Code(err))
 
 		coll.PartitionIDs = []int64{partID}
 		coll.PartitionNames = []string{partName}
@@ -634,6 +878,7 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.DeletePartition(collInfo.ID, "abc", ts, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "partition abc does not exist")
+		assert.True(t, errors.Is(err, merr.ErrPartitionNotFound))
 
 		mockKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save and remove with prefix error")
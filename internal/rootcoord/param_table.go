@@ -12,9 +12,11 @@
 package rootcoord
 
 import (
+	"fmt"
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -39,7 +41,12 @@ type ParamTable struct {
 	DmlChannelName    string
 
 	DmlChannelNum               int64
+	DmlChannelPolicy            string
+	MaxCollectionNum            int64
 	MaxPartitionNum             int64
+	MaxFieldNum                 int64
+	MaxPropertyNum              int
+	MaxPropertyLength           int
 	DefaultPartitionName        string
 	DefaultIndexName            string
 	MinSegmentSizeToEnableIndex int64
@@ -47,6 +54,13 @@ type ParamTable struct {
 	Timeout          int
 	TimeTickInterval int
 
+	// RequestIDRetention is how long the results of the ddl requests are kept for the retried requests of the same
+	// request id
+	RequestIDRetention time.Duration
+	// CollectionDropRetention is how long the dropped collections are kept to be restored before they are purged,
+	// the collections are purged at once if it's 0
+	CollectionDropRetention time.Duration
+
 	Log log.Config
 
 	RoleName string
@@ -69,14 +83,23 @@ func (p *ParamTable) Init() {
 		p.initMsgChannelSubName()
 		p.initTimeTickChannel()
 		p.initStatisticsChannelName()
+		p.initDmlChannelName()
+		p.initDmlChannelNum()
+		p.initDmlChannelPolicy()
 
+		p.initMaxCollectionNum()
 		p.initMaxPartitionNum()
+		p.initMaxFieldNum()
+		p.initMaxPropertyNum()
+		p.initMaxPropertyLength()
 		p.initMinSegmentSizeToEnableIndex()
 		p.initDefaultPartitionName()
 		p.initDefaultIndexName()
 
 		p.initTimeout()
 		p.initTimeTickInterval()
+		p.initRequestIDRetention()
+		p.initCollectionDropRetention()
 
 		p.initLogCfg()
 		p.initRoleName()
@@ -147,10 +170,60 @@ func (p *ParamTable) initStatisticsChannelName() {
 	p.StatisticsChannel = channel
 }
 
+func (p *ParamTable) initDmlChannelName() {
+	channel, err := p.Load("msgChannel.chanNamePrefix.rootCoordDml")
+	if err != nil {
+		panic(err)
+	}
+	p.DmlChannelName = channel
+}
+
+func (p *ParamTable) initDmlChannelNum() {
+	p.DmlChannelNum = p.ParseInt64("rootcoord.dmlChannelNum")
+	if p.DmlChannelNum <= 0 {
+		panic(fmt.Errorf("rootcoord.dmlChannelNum must be positive, got %d", p.DmlChannelNum))
+	}
+}
+
+func (p *ParamTable) initDmlChannelPolicy() {
+	policy, err := p.LoadWithDefault("rootcoord.dmlChannelPolicy", dmlChannelPolicyCollection)
+	if err != nil {
+		panic(err)
+	}
+	switch policy {
+	case dmlChannelPolicyCollection, dmlChannelPolicyHash, dmlChannelPolicyLeastLoaded:
+	default:
+		panic(fmt.Errorf("unknown rootcoord.dmlChannelPolicy %s", policy))
+	}
+	p.DmlChannelPolicy = policy
+}
+
+func (p *ParamTable) initMaxCollectionNum() {
+	p.MaxCollectionNum = p.ParseInt64("rootcoord.maxCollectionNum")
+	if p.MaxCollectionNum <= 0 {
+		panic(fmt.Errorf("rootcoord.maxCollectionNum must be positive, got %d", p.MaxCollectionNum))
+	}
+}
+
 func (p *ParamTable) initMaxPartitionNum() {
 	p.MaxPartitionNum = p.ParseInt64("rootcoord.maxPartitionNum")
 }
 
+func (p *ParamTable) initMaxFieldNum() {
+	p.MaxFieldNum = p.ParseInt64("rootcoord.maxFieldNum")
+	if p.MaxFieldNum <= 0 {
+		panic(fmt.Errorf("rootcoord.maxFieldNum must be positive, got %d", p.MaxFieldNum))
+	}
+}
+
+func (p *ParamTable) initMaxPropertyNum() {
+	p.MaxPropertyNum = p.ParseInt("rootcoord.maxPropertyNum")
+}
+
+func (p *ParamTable) initMaxPropertyLength() {
+	p.MaxPropertyLength = p.ParseInt("rootcoord.maxPropertyLength")
+}
+
 func (p *ParamTable) initMinSegmentSizeToEnableIndex() {
 	p.MinSegmentSizeToEnableIndex = p.ParseInt64("rootcoord.minSegmentSizeToEnableIndex")
 }
@@ -179,6 +252,18 @@ func (p *ParamTable) initTimeTickInterval() {
 	p.TimeTickInterval = p.ParseInt("rootcoord.timeTickInterval")
 }
 
+func (p *ParamTable) initRequestIDRetention() {
+	p.RequestIDRetention = time.Duration(p.ParseInt("rootcoord.requestIDRetention")) * time.Second
+}
+
+func (p *ParamTable) initCollectionDropRetention() {
+	retention := p.ParseInt64("rootcoord.collectionDropRetention")
+	if retention < 0 {
+		panic(fmt.Errorf("rootcoord.collectionDropRetention must not be negative, got %d", retention))
+	}
+	p.CollectionDropRetention = time.Duration(retention) * time.Second
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
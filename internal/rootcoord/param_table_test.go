@@ -13,6 +13,7 @@ package rootcoord
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,9 +42,19 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.StatisticsChannel, "")
 	t.Logf("master statistics channel = %s", Params.StatisticsChannel)
 
+	assert.Equal(t, int64(65536), Params.MaxCollectionNum)
+
 	assert.NotEqual(t, Params.MaxPartitionNum, 0)
 	t.Logf("master MaxPartitionNum = %d", Params.MaxPartitionNum)
 
+	assert.Equal(t, int64(64), Params.MaxFieldNum)
+
+	assert.NotEqual(t, Params.MaxPropertyNum, 0)
+	t.Logf("master MaxPropertyNum = %d", Params.MaxPropertyNum)
+
+	assert.NotEqual(t, Params.MaxPropertyLength, 0)
+	t.Logf("master MaxPropertyLength = %d", Params.MaxPropertyLength)
+
 	assert.NotEqual(t, Params.MinSegmentSizeToEnableIndex, 0)
 	t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 
@@ -58,4 +69,33 @@ func TestParamTable(t *testing.T) {
 
 	assert.NotZero(t, Params.TimeTickInterval)
 	t.Logf("master timetickerInterval = %d", Params.TimeTickInterval)
+
+	assert.Equal(t, 600*time.Second, Params.RequestIDRetention)
+	assert.Equal(t, 24*time.Hour, Params.CollectionDropRetention)
+
+	assert.Equal(t, "rootcoord-dml", Params.DmlChannelName)
+	assert.Equal(t, int64(256), Params.DmlChannelNum)
+	assert.Equal(t, dmlChannelPolicyCollection, Params.DmlChannelPolicy)
+
+	Params.Save("rootcoord.dmlChannelPolicy", dmlChannelPolicyLeastLoaded)
+	Params.initDmlChannelPolicy()
+	assert.Equal(t, dmlChannelPolicyLeastLoaded, Params.DmlChannelPolicy)
+	Params.Save("rootcoord.dmlChannelPolicy", "random")
+	assert.Panics(t, func() { Params.initDmlChannelPolicy() })
+	Params.Save("rootcoord.dmlChannelPolicy", dmlChannelPolicyCollection)
+	Params.initDmlChannelPolicy()
+
+	Params.Save("rootcoord.maxCollectionNum", "0")
+	assert.Panics(t, func() { Params.initMaxCollectionNum() })
+	Params.Save("rootcoord.maxCollectionNum", "65536")
+	Params.initMaxCollectionNum()
+	Params.Save("rootcoord.maxFieldNum", "-1")
+	assert.Panics(t, func() { Params.initMaxFieldNum() })
+	Params.Save("rootcoord.maxFieldNum", "64")
+	Params.initMaxFieldNum()
+
+	Params.Save("rootcoord.collectionDropRetention", "-1")
+	assert.Panics(t, func() { Params.initCollectionDropRetention() })
+	Params.Save("rootcoord.collectionDropRetention", "86400")
+	Params.initCollectionDropRetention()
 }
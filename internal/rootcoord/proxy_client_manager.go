@@ -15,15 +15,24 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"go.uber.org/zap"
 )
 
+const (
+	// invalidateMetaCacheAttempts is the num of attempts of sending an invalidation to a proxy
+	invalidateMetaCacheAttempts = 3
+	// invalidateMetaCacheRetrySleep is the interval before the first retry, doubled by each of the others
+	invalidateMetaCacheRetrySleep = 100 * time.Millisecond
+)
+
 type proxyClientManager struct {
 	core        *Core
 	lock        sync.Mutex
@@ -85,39 +94,50 @@ func (p *proxyClientManager) DelProxyClient(s *sessionutil.Session) {
 	log.Debug("remove proxy client", zap.String("proxy address", s.Address), zap.Int64("proxy id", s.ServerID))
 }
 
+// InvalidateCollectionMetaCache sends the invalidation to all the proxies concurrently, a proxy failed is retried
+// a few times, and its cache expires by the ttl if it's still unreachable
 func (p *proxyClientManager) InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) {
 	p.lock.Lock()
-	defer p.lock.Unlock()
+	clients := make(map[int64]types.Proxy, len(p.proxyClient))
+	for k, f := range p.proxyClient {
+		clients[k] = f
+	}
+	p.lock.Unlock()
 
-	if len(p.proxyClient) == 0 {
+	if len(clients) == 0 {
 		log.Debug("proxy client is empty,InvalidateCollectionMetaCache will not send to any client")
 		return
 	}
 
-	for k, f := range p.proxyClient {
-		err := func() error {
-			defer func() {
-				if err := recover(); err != nil {
-					log.Debug("call InvalidateCollectionMetaCache panic", zap.Int64("proxy id", k), zap.Any("msg", err))
+	var wg sync.WaitGroup
+	for k, f := range clients {
+		wg.Add(1)
+		go func(k int64, f types.Proxy) {
+			defer wg.Done()
+			err := retry.Do(ctx, func() error {
+				defer func() {
+					if err := recover(); err != nil {
+						log.Debug("call InvalidateCollectionMetaCache panic", zap.Int64("proxy id", k), zap.Any("msg", err))
+					}
+
+				}()
+				sta, err := f.InvalidateCollectionMetaCache(ctx, request)
+				if err != nil {
+					return fmt.Errorf("grpc fail,error=%w", err)
 				}
-
-			}()
-			sta, err := f.InvalidateCollectionMetaCache(ctx, request)
+				if sta.ErrorCode != commonpb.ErrorCode_Success {
+					return fmt.Errorf("message = %s", sta.Reason)
+				}
+				return nil
+			}, retry.Attempts(invalidateMetaCacheAttempts), retry.Sleep(invalidateMetaCacheRetrySleep))
 			if err != nil {
-				return fmt.Errorf("grpc fail,error=%w", err)
-			}
-			if sta.ErrorCode != commonpb.ErrorCode_Success {
-				return fmt.Errorf("message = %s", sta.Reason)
+				log.Error("call invalidate collection meta failed", zap.Int64("proxy id", k), zap.Error(err))
+			} else {
+				log.Debug("send invalidate collection meta cache to proxy node", zap.Int64("node id", k))
 			}
-			return nil
-		}()
-		if err != nil {
-			log.Error("call invalidate collection meta failed", zap.Int64("proxy id", k), zap.Error(err))
-		} else {
-			log.Debug("send invalidate collection meta cache to proxy node", zap.Int64("node id", k))
-		}
-
+		}(k, f)
 	}
+	wg.Wait()
 }
 
 func (p *proxyClientManager) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
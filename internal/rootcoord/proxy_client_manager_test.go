@@ -14,8 +14,11 @@ package rootcoord
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/stretchr/testify/assert"
@@ -103,6 +106,40 @@ func TestProxyClientManager_InvalidateCollectionMetaCache(t *testing.T) {
 	pcm.InvalidateCollectionMetaCache(ctx, nil)
 }
 
+type flakyProxyMock struct {
+	types.Proxy
+	mutex    sync.Mutex
+	failures int
+	calls    int
+}
+
+func (p *flakyProxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.calls++
+	if p.calls <= p.failures {
+		return nil, errors.New("proxy unavailable")
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestProxyClientManager_InvalidateCollectionMetaCacheRetry(t *testing.T) {
+	ctx := context.Background()
+	healthy := &flakyProxyMock{}
+	flaky := &flakyProxyMock{failures: invalidateMetaCacheAttempts - 1}
+	down := &flakyProxyMock{failures: invalidateMetaCacheAttempts}
+	pcm := &proxyClientManager{
+		proxyClient: map[int64]types.Proxy{1: healthy, 2: flaky, 3: down},
+	}
+
+	pcm.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{CollectionName: "coll"})
+	assert.Equal(t, 1, healthy.calls)
+	// retried until it succeeds
+	assert.Equal(t, invalidateMetaCacheAttempts, flaky.calls)
+	// given up after the attempts
+	assert.Equal(t, invalidateMetaCacheAttempts, down.calls)
+}
+
 func TestProxyClientManager_ReleaseDQLMessageStream(t *testing.T) {
 	Params.Init()
 	ctx := context.Background()
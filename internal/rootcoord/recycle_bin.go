@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// collectionPurgeInterval is the interval of checking the dropped collections whose retention has expired
+	collectionPurgeInterval = time.Minute
+	// collectionPurgeAttempts is the attempts of removing the binlogs of a purged collection by data coord
+	collectionPurgeAttempts = 10
+)
+
+// dropCollection deletes the meta of the collection and sends the drop collection dd msg to its dml channels, so
+// that the data nodes destroy the flowgraphs of the collection, then the channels are closed
+func (c *Core) dropCollection(ctx context.Context, collMeta *etcdpb.CollectionInfo, ddReq *internalpb.DropCollectionRequest, ts typeutil.Timestamp) error {
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(ddReq, DropCollectionDDType)
+	}
+
+	reason := fmt.Sprintf("drop collection %d", collMeta.ID)
+
+	// use lambda function here to guarantee all resources to be released
+	dropCollectionFn := func() error {
+		// lock for ddl operation
+		c.ddlLock.Lock()
+		defer c.ddlLock.Unlock()
+
+		c.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		err := c.MetaTable.DeleteCollection(collMeta.ID, ts, ddOp)
+		if err != nil {
+			return err
+		}
+
+		err = c.SendDdDropCollectionReq(ctx, ddReq, collMeta.PhysicalChannelNames)
+		if err != nil {
+			return err
+		}
+
+		c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		c.SendTimeTick(ts, reason)
+
+		for _, chanName := range collMeta.PhysicalChannelNames {
+			// send tt into deleted channels to tell data_node to clear flowgragh
+			c.chanTimeTick.SendChannelTimeTick(chanName, ts)
+		}
+
+		// remove dml channel after send dd msg
+		c.dmlChannels.RemoveProducerChannels(collMeta.PhysicalChannelNames...)
+		return nil
+	}
+
+	if err := dropCollectionFn(); err != nil {
+		return err
+	}
+
+	// Update DDOperation in etcd
+	return c.setDdMsgSendFlag(true)
+}
+
+// purgeCollectionBinlogs removes the segments and the binlogs of a dropped collection by data coord. It's called
+// after the dd msg of the drop is sent, the data nodes destroy the flowgraphs of the collection without flushing
+// the buffered rows, so no binlog is added after it
+func (c *Core) purgeCollectionBinlogs(ctx context.Context, collID typeutil.UniqueID) {
+	err := retry.Do(ctx, func() error {
+		return c.CallPurgeCollectionService(ctx, collID)
+	}, retry.Attempts(collectionPurgeAttempts))
+	if err != nil {
+		log.Warn("purge collection in data coord failed, its binlogs are left", zap.Int64("collection_id", collID), zap.Error(err))
+	}
+}
+
+func (c *Core) purgeCollectionLoop() {
+	ticker := time.NewTicker(collectionPurgeInterval)
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit purgeCollectionLoop")
+			return
+		case <-ticker.C:
+			c.purgeDroppedCollections(c.ctx, time.Now())
+		}
+	}
+}
+
+// purgeDroppedCollections purges the dropped collections whose retention has expired by now, they can't be restored
+// after it
+func (c *Core) purgeDroppedCollections(ctx context.Context, now time.Time) {
+	for _, collMeta := range c.MetaTable.ListDroppedCollections() {
+		dropTime, _ := tsoutil.ParseTS(collMeta.DropTs)
+		// ordered by the drop timestamps, the following ones are not expired either
+		if now.Before(dropTime.Add(Params.CollectionDropRetention)) {
+			return
+		}
+
+		ts, err := c.TSOAllocator(1)
+		if err != nil {
+			log.Warn("TSO alloc fail", zap.Error(err))
+			return
+		}
+		ddReq := internalpb.DropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropCollection,
+				MsgID:     0, //TODO, msg id
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			CollectionName: collMeta.Schema.Name,
+			DbID:           0, //not used
+			CollectionID:   collMeta.ID,
+		}
+		if err = c.dropCollection(ctx, collMeta, &ddReq, ts); err != nil {
+			log.Warn("purge dropped collection failed", zap.String("collection_name", collMeta.Schema.Name),
+				zap.Int64("collection_id", collMeta.ID), zap.Error(err))
+			continue
+		}
+		log.Debug("purge dropped collection", zap.String("collection_name", collMeta.Schema.Name),
+			zap.Int64("collection_id", collMeta.ID), zap.Uint64("drop_ts", collMeta.DropTs))
+		c.purgeCollectionBinlogs(ctx, collMeta.ID)
+	}
+}
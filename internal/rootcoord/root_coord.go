@@ -26,6 +26,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -42,7 +43,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/notify"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/secret"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -113,16 +117,25 @@ type Core struct {
 	CallGetNumRowsService         func(ctx context.Context, segID typeutil.UniqueID, isFromFlushedChan bool) (int64, error)
 	CallGetFlushedSegmentsService func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error)
 
+	//notify data service to remove the segments and the binlogs of a purged collection
+	CallPurgeCollectionService func(ctx context.Context, collID typeutil.UniqueID) error
+
 	//call index builder's client to build index, return build id
-	CallBuildIndexService func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
+	CallBuildIndexService func(ctx context.Context, collID typeutil.UniqueID, binlog []string, numRows int64, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
 	CallDropIndexService  func(ctx context.Context, indexID typeutil.UniqueID) error
 
+	//get the states of the index builds from index builder
+	CallGetIndexStatesService func(ctx context.Context, buildIDs []typeutil.UniqueID) ([]*indexpb.IndexInfo, error)
+
 	NewProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
 
 	//query service interface, notify query service to release collection
 	CallReleaseCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
 	CallReleasePartitionService  func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
 
+	//query service interface, notify query service to load the swapped index of the field
+	CallRefreshIndexService func(ctx context.Context, collectionID, fieldID typeutil.UniqueID) error
+
 	//dml channels
 	dmlChannels *dmlChannels
 
@@ -153,6 +166,15 @@ type Core struct {
 	sessCloseCh <-chan bool
 
 	msFactory ms.Factory
+
+	// sends the events to the configured webhooks and topic, nil if the notification is disabled
+	notifier *notify.Notifier
+
+	// secretKV encrypts the credentials in meta, it's nil if the encryption is disabled
+	secretKV *secretKV
+
+	// ddlRequests are the recent ddl requests by their request ids, the retried ones are not executed again
+	ddlRequests *ddlRequests
 }
 
 // --------------------- function --------------------------
@@ -225,9 +247,15 @@ func (c *Core) checkInit() error {
 	if c.CallDropIndexService == nil {
 		return fmt.Errorf("CallDropIndexService is nil")
 	}
+	if c.CallGetIndexStatesService == nil {
+		return fmt.Errorf("CallGetIndexStatesService is nil")
+	}
 	if c.CallGetFlushedSegmentsService == nil {
 		return fmt.Errorf("CallGetFlushedSegments is nil")
 	}
+	if c.CallPurgeCollectionService == nil {
+		return fmt.Errorf("CallPurgeCollectionService is nil")
+	}
 	if c.NewProxyClient == nil {
 		return fmt.Errorf("NewProxyClient is nil")
 	}
@@ -237,6 +265,9 @@ func (c *Core) checkInit() error {
 	if c.CallReleasePartitionService == nil {
 		return fmt.Errorf("CallReleasePartitionService is nil")
 	}
+	if c.CallRefreshIndexService == nil {
+		return fmt.Errorf("CallRefreshIndexService is nil")
+	}
 
 	return nil
 }
@@ -366,7 +397,7 @@ func (c *Core) checkFlushedSegments(ctx context.Context) {
 							zap.Int64("segment_id", segID),
 							zap.Int64("index_id", indexMeta.IndexID),
 							zap.Int64("collection_id", collMeta.ID))
-						info.BuildID, err = c.BuildIndex(ctx2, segID, field, &indexMeta, false)
+						info.BuildID, err = c.BuildIndex(ctx2, collMeta.ID, segID, field, &indexMeta, false)
 						if err != nil {
 							log.Debug("build index failed",
 								zap.Int64("segment_id", segID),
@@ -393,6 +424,120 @@ func (c *Core) checkFlushedSegments(ctx context.Context) {
 	}
 }
 
+func (c *Core) swapIndexLoop() {
+	ticker := time.NewTicker(time.Minute)
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit swapIndexLoop")
+			return
+		case <-ticker.C:
+			c.swapIndexes(c.ctx)
+		}
+	}
+}
+
+// swapIndexes swaps in the rebuilt indexes which are built on all the flushed segments,
+// the replaced index keeps serving until then so that no brute force search happens during the rebuild
+func (c *Core) swapIndexes(ctx context.Context) {
+	collID2Meta, segID2IndexMeta, indexID2Meta := c.MetaTable.dupMeta()
+	for _, collMeta := range collID2Meta {
+		for _, fieldIdx := range collMeta.FieldIndexes {
+			idxInfo, ok := indexID2Meta[fieldIdx.IndexID]
+			if !ok || idxInfo.ReplacedIndexID == 0 {
+				continue
+			}
+			ctx2, cancel2 := context.WithTimeout(ctx, 3*time.Minute)
+			built, err := c.isIndexBuilt(ctx2, &collMeta, idxInfo.IndexID, segID2IndexMeta)
+			if err != nil || !built {
+				log.Debug("index rebuild not finished",
+					zap.Int64("collection_id", collMeta.ID),
+					zap.Int64("index_id", idxInfo.IndexID),
+					zap.Int64("replaced_index_id", idxInfo.ReplacedIndexID),
+					zap.Error(err))
+				cancel2()
+				continue
+			}
+			ts, _ := c.TSOAllocator(1)
+			oldIdxID, err := c.MetaTable.SwapIndex(collMeta.ID, idxInfo.IndexID, ts)
+			if err != nil {
+				log.Warn("swap index failed", zap.Int64("collection_id", collMeta.ID), zap.Int64("index_id", idxInfo.IndexID), zap.Error(err))
+				cancel2()
+				continue
+			}
+			log.Debug("swap index",
+				zap.Int64("collection_id", collMeta.ID),
+				zap.Int64("field_id", fieldIdx.FiledID),
+				zap.Int64("index_id", idxInfo.IndexID),
+				zap.Int64("replaced_index_id", oldIdxID))
+			if err := c.CallRefreshIndexService(ctx2, collMeta.ID, fieldIdx.FiledID); err != nil {
+				log.Warn("refresh index on query nodes failed", zap.Int64("collection_id", collMeta.ID), zap.Int64("field_id", fieldIdx.FiledID), zap.Error(err))
+			}
+			if err := c.CallDropIndexService(ctx2, oldIdxID); err != nil {
+				log.Warn("drop replaced index failed", zap.Int64("index_id", oldIdxID), zap.Error(err))
+			}
+			cancel2()
+		}
+	}
+}
+
+// isIndexBuilt checks that every flushed segment of the collection has the index built or doesn't need one
+func (c *Core) isIndexBuilt(ctx context.Context, collMeta *etcdpb.CollectionInfo, indexID typeutil.UniqueID, segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]etcdpb.SegmentIndexInfo) (bool, error) {
+	segIDs, err := c.CallGetFlushedSegmentsService(ctx, collMeta.ID, -1)
+	if err != nil {
+		return false, err
+	}
+	buildIDs := make([]typeutil.UniqueID, 0, len(segIDs))
+	for _, segID := range segIDs {
+		segIdxInfo, ok := segID2IndexMeta[segID][indexID]
+		if !ok {
+			return false, nil
+		}
+		if segIdxInfo.EnableIndex {
+			buildIDs = append(buildIDs, segIdxInfo.BuildID)
+		}
+	}
+	if len(buildIDs) == 0 {
+		return true, nil
+	}
+	states, err := c.CallGetIndexStatesService(ctx, buildIDs)
+	if err != nil {
+		return false, err
+	}
+	if len(states) != len(buildIDs) {
+		return false, fmt.Errorf("get %d index states of %d builds", len(states), len(buildIDs))
+	}
+	for _, state := range states {
+		if state.State != commonpb.IndexState_Finished {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getIndexBuildState returns the state of the index builds of the segments, which is Failed with the reason of
+// the first build failed permanently, the builds failed of transient causes are retried by indexcoord
+func (c *Core) getIndexBuildState(ctx context.Context, indexID typeutil.UniqueID) (commonpb.IndexState, string, error) {
+	buildIDs := c.MetaTable.GetIndexBuildIDs(indexID)
+	if len(buildIDs) == 0 {
+		return commonpb.IndexState_Finished, "", nil
+	}
+	states, err := c.CallGetIndexStatesService(ctx, buildIDs)
+	if err != nil {
+		return commonpb.IndexState_IndexStateNone, "", err
+	}
+	state := commonpb.IndexState_Finished
+	for _, s := range states {
+		if s.State == commonpb.IndexState_Failed {
+			return commonpb.IndexState_Failed, s.Reason, nil
+		}
+		if s.State != commonpb.IndexState_Finished {
+			state = commonpb.IndexState_InProgress
+		}
+	}
+	return state, "", nil
+}
+
 func (c *Core) getSegments(ctx context.Context, collID typeutil.UniqueID) (map[typeutil.UniqueID]typeutil.UniqueID, error) {
 	collMeta, err := c.MetaTable.GetCollectionByID(collID, 0)
 	if err != nil {
@@ -682,6 +827,32 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		return rsp.Segments, nil
 	}
 
+	c.CallPurgeCollectionService = func(ctx context.Context, collID typeutil.UniqueID) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("purge collection in data coord panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &datapb.PurgeCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO,msg type
+				MsgID:     0,
+				Timestamp: 0,
+				SourceID:  c.session.ServerID,
+			},
+			CollectionID: collID,
+		}
+		rsp, err := s.PurgeCollection(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("purge collection in data coord failed, reason = %s", rsp.Reason)
+		}
+		return nil
+	}
+
 	return nil
 }
 
@@ -700,7 +871,7 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		}
 	}()
 
-	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (retID typeutil.UniqueID, retErr error) {
+	c.CallBuildIndexService = func(ctx context.Context, collID typeutil.UniqueID, binlog []string, numRows int64, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (retID typeutil.UniqueID, retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
 				retErr = fmt.Errorf("build index panic, msg = %v", err)
@@ -708,11 +879,14 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		}()
 		<-initCh
 		rsp, err := s.BuildIndex(ctx, &indexpb.BuildIndexRequest{
-			DataPaths:   binlog,
-			TypeParams:  field.TypeParams,
-			IndexParams: idxInfo.IndexParams,
-			IndexID:     idxInfo.IndexID,
-			IndexName:   idxInfo.IndexName,
+			DataPaths:    binlog,
+			TypeParams:   field.TypeParams,
+			IndexParams:  idxInfo.IndexParams,
+			IndexID:      idxInfo.IndexID,
+			IndexName:    idxInfo.IndexName,
+			NumRows:      numRows,
+			CollectionID: collID,
+			FieldID:      field.FieldID,
 		})
 		if err != nil {
 			return retID, err
@@ -742,6 +916,25 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		return nil
 	}
 
+	c.CallGetIndexStatesService = func(ctx context.Context, buildIDs []typeutil.UniqueID) (retStates []*indexpb.IndexInfo, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get index states from index service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		rsp, err := s.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{
+			IndexBuildIDs: buildIDs,
+		})
+		if err != nil {
+			return retStates, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return retStates, fmt.Errorf("GetIndexStates from index service failed, error = %s", rsp.Status.Reason)
+		}
+		return rsp.States, nil
+	}
+
 	return nil
 }
 
@@ -812,11 +1005,36 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 		}
 		return nil
 	}
+	c.CallRefreshIndexService = func(ctx context.Context, collectionID, fieldID typeutil.UniqueID) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("refresh index from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &querypb.RefreshIndexRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_LoadIndex,
+				MsgID:    0, //TODO, msg ID
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			FieldID:      fieldID,
+		}
+		rsp, err := s.RefreshIndex(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("RefreshIndex from query service failed, error = %s", rsp.Reason)
+		}
+		return nil
+	}
 	return nil
 }
 
 // BuildIndex will check row num and call build index service
-func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, isFlush bool) (typeutil.UniqueID, error) {
+func (c *Core) BuildIndex(ctx context.Context, collID typeutil.UniqueID, segID typeutil.UniqueID, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, isFlush bool) (typeutil.UniqueID, error) {
 	sp, ctx := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	if c.MetaTable.IsSegmentIndexed(segID, field, idxInfo.IndexParams) {
@@ -834,7 +1052,7 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 		if err != nil {
 			return 0, err
 		}
-		bldID, err = c.CallBuildIndexService(ctx, binlogs, field, idxInfo)
+		bldID, err = c.CallBuildIndexService(ctx, collID, binlogs, rows, field, idxInfo)
 		if err != nil {
 			return 0, err
 		}
@@ -858,6 +1076,12 @@ func (c *Core) Register() error {
 func (c *Core) Init() error {
 	var initError error = nil
 	c.initOnce.Do(func() {
+		c.ddlRequests = newDdlRequests(Params.RequestIDRetention)
+		var cipher *secret.Cipher
+		if cipher, initError = secret.NewCipherFromConfig(secret.LoadConfig(&Params.BaseTable)); initError != nil {
+			log.Error("RootCoord, Failed to new Cipher", zap.Any("reason", initError))
+			return
+		}
 		connectEtcdFn := func() error {
 			if c.etcdCli, initError = clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints, DialTimeout: 5 * time.Second}); initError != nil {
 				log.Error("RootCoord, Failed to new Etcd client", zap.Any("reason", initError))
@@ -869,7 +1093,12 @@ func (c *Core) Init() error {
 				log.Error("RootCoord, Failed to new MetaSnapshot", zap.Any("reason", initError))
 				return initError
 			}
-			if c.MetaTable, initError = NewMetaTable(ms); initError != nil {
+			var snapshot kv.SnapShotKV = ms
+			if cipher != nil {
+				c.secretKV = newSecretKV(ms, cipher, secretMetaPrefixes...)
+				snapshot = c.secretKV
+			}
+			if c.MetaTable, initError = NewMetaTable(snapshot); initError != nil {
 				log.Error("RootCoord, Failed to new MetaTable", zap.Any("reason", initError))
 				return initError
 			}
@@ -948,6 +1177,11 @@ func (c *Core) Init() error {
 
 		c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
+		c.notifier, initError = notify.NewNotifierFromConfig(c.ctx, typeutil.RootCoordRole, notify.LoadConfig(&Params.BaseTable), c.msFactory)
+		if initError != nil {
+			return
+		}
+
 		initError = c.setMsgStreams()
 		if initError != nil {
 			return
@@ -1089,11 +1323,25 @@ func (c *Core) Start() error {
 			log.Debug("RootCoord Start reSendDdMsg failed", zap.Error(err))
 			return
 		}
+		if c.secretKV != nil {
+			ts, err := c.TSOAllocator(1)
+			if err != nil {
+				log.Debug("RootCoord Start TSOAllocator failed", zap.Error(err))
+				return
+			}
+			if err := c.secretKV.rewrap(ts); err != nil {
+				log.Debug("RootCoord Start rewrap secrets failed", zap.Error(err))
+				return
+			}
+		}
 		go c.startTimeTickLoop()
 		go c.tsLoop()
 		go c.sessionLoop()
 		go c.chanTimeTick.StartWatch()
 		go c.checkFlushedSegmentsLoop()
+		go c.swapIndexLoop()
+		go c.purgeCollectionLoop()
+		c.notifier.Start()
 		c.stateCode.Store(internalpb.StateCode_Healthy)
 	})
 	log.Debug(typeutil.RootCoordRole, zap.String("State Code", internalpb.StateCode_name[int32(internalpb.StateCode_Healthy)]))
@@ -1102,6 +1350,7 @@ func (c *Core) Start() error {
 
 func (c *Core) Stop() error {
 	c.cancel()
+	c.notifier.Stop()
 	c.stateCode.Store(internalpb.StateCode_Abnormal)
 	return nil
 }
@@ -1157,11 +1406,11 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("CreateCollection ", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("CreateCollection ", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &CreateCollectionReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1169,11 +1418,11 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("CreateCollection failed", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "Create collection failed: " + err.Error(),
 		}, nil
 	}
@@ -1190,11 +1439,11 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("DropCollection", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("DropCollection", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &DropCollectionReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1202,11 +1451,11 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("DropCollection Failed", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "Drop collection failed: " + err.Error(),
 		}, nil
 	}
@@ -1224,7 +1473,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			Value: false,
@@ -1244,7 +1493,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 		log.Debug("HasCollection Failed", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "Has collection failed: " + err.Error(),
 			},
 			Value: false,
@@ -1267,7 +1516,7 @@ func (c *Core) DescribeCollection(ctx context.Context, in *milvuspb.DescribeColl
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			Schema:       nil,
@@ -1288,7 +1537,7 @@ func (c *Core) DescribeCollection(ctx context.Context, in *milvuspb.DescribeColl
 		log.Debug("DescribeCollection Failed", zap.String("name", in.CollectionName), zap.Error(err), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "describe collection failed: " + err.Error(),
 			},
 			Schema: nil,
@@ -1310,7 +1559,7 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.ShowCollectionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			CollectionNames: nil,
@@ -1334,7 +1583,7 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 		return &milvuspb.ShowCollectionsResponse{
 			CollectionNames: nil,
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "ShowCollections failed: " + err.Error(),
 			},
 		}, nil
@@ -1348,16 +1597,50 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAlterCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AlterCollection", zap.String("name", in.CollectionName), zap.Any("properties", in.Properties),
+		zap.Strings("delete keys", in.DeleteKeys), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
+	t := &AlterCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
+	if err != nil {
+		log.Debug("AlterCollection Failed", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+		return &commonpb.Status{
+			ErrorCode: merr.Code(err),
+			Reason:    "Alter collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AlterCollection Success", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAlterCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreatePartitionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("CreatePartition", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("CreatePartition", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &CreatePartitionReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1365,11 +1648,11 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("CreatePartition Failed", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "create partition failed: " + err.Error(),
 		}, nil
 	}
@@ -1386,11 +1669,11 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("DropPartition", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("DropPartition", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &DropPartitionReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1398,11 +1681,11 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("DropPartition Failed", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "DropPartition failed: " + err.Error(),
 		}, nil
 	}
@@ -1420,7 +1703,7 @@ func (c *Core) HasPartition(ctx context.Context, in *milvuspb.HasPartitionReques
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			Value: false,
@@ -1440,7 +1723,7 @@ func (c *Core) HasPartition(ctx context.Context, in *milvuspb.HasPartitionReques
 		log.Debug("HasPartition Failed", zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "HasPartition failed: " + err.Error(),
 			},
 			Value: false,
@@ -1467,7 +1750,7 @@ func (c *Core) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRe
 			zap.Int64("msgID", in.Base.MsgID), zap.String("state", internalpb.StateCode_name[int32(code)]))
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("rootcoord is not healthy, state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			PartitionNames: nil,
@@ -1491,7 +1774,7 @@ func (c *Core) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRe
 		return &milvuspb.ShowPartitionsResponse{
 			PartitionNames: nil,
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1511,11 +1794,11 @@ func (c *Core) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest)
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("CreateIndex", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("CreateIndex", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &CreateIndexReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1523,13 +1806,13 @@ func (c *Core) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest)
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("CreateIndex Failed", zap.String("collection name", in.CollectionName),
 			zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID),
 			zap.Error(err))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "CreateIndex failed, error = " + err.Error(),
 		}, nil
 	}
@@ -1547,7 +1830,7 @@ func (c *Core) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequ
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.DescribeIndexResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			IndexDescriptions: nil,
@@ -1570,7 +1853,7 @@ func (c *Core) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequ
 		log.Debug("DescribeIndex Failed", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.DescribeIndexResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "DescribeIndex failed, error = " + err.Error(),
 			},
 			IndexDescriptions: nil,
@@ -1601,11 +1884,11 @@ func (c *Core) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (*c
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
-	log.Debug("DropIndex", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.String("index name", in.IndexName), zap.Int64("msgID", in.Base.MsgID))
+	log.Debug("DropIndex", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.String("index name", in.IndexName), zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
 	t := &DropIndexReqTask{
 		baseReqTask: baseReqTask{
 			ctx:  ctx,
@@ -1613,11 +1896,11 @@ func (c *Core) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (*c
 		},
 		Req: in,
 	}
-	err := executeTask(t)
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
 	if err != nil {
 		log.Debug("DropIndex Failed", zap.String("collection name", in.CollectionName), zap.String("field name", in.FieldName), zap.String("index name", in.IndexName), zap.Int64("msgID", in.Base.MsgID))
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: merr.Code(err),
 			Reason:    "DropIndex failed, error = " + err.Error(),
 		}, nil
 	}
@@ -1635,7 +1918,7 @@ func (c *Core) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegment
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.DescribeSegmentResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			IndexID: 0,
@@ -1658,7 +1941,7 @@ func (c *Core) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegment
 		log.Debug("DescribeSegment Failed", zap.Int64("collection id", in.CollectionID), zap.Int64("segment id", in.SegmentID), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.DescribeSegmentResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "DescribeSegment failed, error = " + err.Error(),
 			},
 			IndexID: 0,
@@ -1679,7 +1962,7 @@ func (c *Core) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsReques
 	if code != internalpb.StateCode_Healthy {
 		return &milvuspb.ShowSegmentsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			SegmentIDs: nil,
@@ -1702,7 +1985,7 @@ func (c *Core) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsReques
 		log.Debug("ShowSegments Failed", zap.Int64("collection id", in.CollectionID), zap.Int64("partition id", in.PartitionID), zap.Int64("msgID", in.Base.MsgID))
 		return &milvuspb.ShowSegmentsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: merr.Code(err),
 				Reason:    "ShowSegments failed, error: " + err.Error(),
 			},
 			SegmentIDs: nil,
@@ -1717,12 +2000,233 @@ func (c *Core) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsReques
 	return t.Rsp, nil
 }
 
+func (c *Core) CreateApiKey(ctx context.Context, in *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("CreateApiKey", zap.String("name", in.Name), zap.Strings("privileges", in.Privileges),
+		zap.Float64("rate limit", in.RateLimit), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateApiKeyReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ApiKeyResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateApiKey Failed", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    "Create api key failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("CreateApiKey Success", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) RotateApiKey(ctx context.Context, in *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("RotateApiKey", zap.String("name", in.Name), zap.Int64("grace period", in.GracePeriod),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &RotateApiKeyReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ApiKeyResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RotateApiKey Failed", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    "Rotate api key failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("RotateApiKey Success", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) DropApiKey(ctx context.Context, in *milvuspb.DropApiKeyRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropApiKey", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropApiKeyReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropApiKey Failed", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: merr.Code(err),
+			Reason:    "Drop api key failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropApiKey Success", zap.String("name", in.Name), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListApiKeys returns the hashes of all the api keys, the proxies validate the api keys of the requests with them
+func (c *Core) ListApiKeys(ctx context.Context, in *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.ListApiKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	return &rootcoordpb.ListApiKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Keys: c.MetaTable.ListApiKeys(),
+	}, nil
+}
+
+// GetQuotaUsage returns the usages of the collection, partition and field quotas against their limits
+func (c *Core) GetQuotaUsage(ctx context.Context, in *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.GetQuotaUsageResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	usages, err := c.MetaTable.GetQuotaUsage(in.CollectionName)
+	if err != nil {
+		return &milvuspb.GetQuotaUsageResponse{
+			Status: &commonpb.Status{
+				ErrorCode: merr.Code(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetQuotaUsageResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usages: usages,
+	}, nil
+}
+
+func (c *Core) UndropCollection(ctx context.Context, in *milvuspb.UndropCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordUndropCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("UndropCollection", zap.String("name", in.CollectionName), zap.Int64("collection id", in.CollectionID),
+		zap.Int64("msgID", in.Base.MsgID), zap.String("requestID", in.Base.RequestID))
+	t := &UndropCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := c.ddlRequests.execute(in.Base.RequestID, t)
+	if err != nil {
+		log.Debug("UndropCollection Failed", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+		return &commonpb.Status{
+			ErrorCode: merr.Code(err),
+			Reason:    "Undrop collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("UndropCollection Success", zap.String("name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordUndropCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	colls := c.MetaTable.ListDroppedCollections()
+	dropped := make([]*milvuspb.DroppedCollection, 0, len(colls))
+	for _, coll := range colls {
+		dropTime, _ := tsoutil.ParseTS(coll.DropTs)
+		purgeTime := dropTime.Add(Params.CollectionDropRetention)
+		dropped = append(dropped, &milvuspb.DroppedCollection{
+			CollectionName: coll.Schema.Name,
+			CollectionID:   coll.ID,
+			DropTs:         coll.DropTs,
+			PurgeTs:        tsoutil.ComposeTS(purgeTime.UnixNano()/int64(time.Millisecond), 0),
+		})
+	}
+	return &milvuspb.ListDroppedCollectionsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Collections: dropped,
+	}, nil
+}
+
 func (c *Core) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &rootcoordpb.AllocTimestampResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			Timestamp: 0,
@@ -1760,7 +2264,7 @@ func (c *Core) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest) (*ro
 	if code != internalpb.StateCode_Healthy {
 		return &rootcoordpb.AllocIDResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 			},
 			ID:    0,
@@ -1795,7 +2299,7 @@ func (c *Core) UpdateChannelTimeTick(ctx context.Context, in *internalpb.Channel
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
@@ -1824,7 +2328,7 @@ func (c *Core) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseD
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
@@ -1835,7 +2339,7 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 	code := c.stateCode.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: commonpb.ErrorCode_NotReadyToServe,
 			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
 		}, nil
 	}
@@ -1882,7 +2386,7 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 			IndexID:      idxInfo.IndexID,
 			EnableIndex:  false,
 		}
-		info.BuildID, err = c.BuildIndex(ctx, segID, fieldSch, idxInfo, true)
+		info.BuildID, err = c.BuildIndex(ctx, in.Segment.CollectionID, segID, fieldSch, idxInfo, true)
 		if err == nil && info.BuildID != 0 {
 			info.EnableIndex = true
 		} else {
@@ -1963,6 +2467,18 @@ func (c *Core) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest)
 		return systemInfoMetrics, err
 	}
 
+	if metricType == metricsinfo.LogLevelMetrics {
+		return metricsinfo.GetLogLevelMetrics(req, metricsinfo.ConstructComponentName(typeutil.RootCoordRole, c.session.ServerID))
+	}
+
+	if metricType == metricsinfo.SystemConfigurationsMetrics {
+		return metricsinfo.GetConfigurationsMetrics(metricsinfo.ConstructComponentName(typeutil.RootCoordRole, c.session.ServerID), &Params)
+	}
+
+	if metricType == metricsinfo.TimeTickStatisticsMetrics {
+		return c.getTimeTickStatisticsMetrics(ctx, req)
+	}
+
 	log.Debug("RootCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", c.session.ServerID),
 		zap.String("req", req.Request),
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/util/apikey"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
 	"github.com/golang/protobuf/proto"
@@ -41,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -82,6 +84,7 @@ type dataMock struct {
 	randVal int
 	mu      sync.Mutex
 	segs    []typeutil.UniqueID
+	purged  []typeutil.UniqueID
 }
 
 func (d *dataMock) Init() error {
@@ -144,6 +147,16 @@ func (d *dataMock) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushe
 	return rsp, nil
 }
 
+func (d *dataMock) PurgeCollection(ctx context.Context, req *datapb.PurgeCollectionRequest) (*commonpb.Status, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.purged = append(d.purged, req.CollectionID)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 type queryMock struct {
 	types.QueryCoord
 	collID []typeutil.UniqueID
@@ -551,6 +564,7 @@ func TestRootCoord(t *testing.T) {
 				MsgID:     100,
 				Timestamp: 100,
 				SourceID:  100,
+				RequestID: "create-collection-100",
 			},
 			DbName:         dbName,
 			CollectionName: collName,
@@ -560,6 +574,8 @@ func TestRootCoord(t *testing.T) {
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		// the collection remembered missing by the proxies is invalidated
+		assert.Equal(t, []string{collName}, pnm.GetCollArray())
 
 		assert.Equal(t, shardsNum, int32(core.dmlChannels.GetNumChannels()))
 
@@ -572,6 +588,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, 1, len(msgs))
 		createMsg, ok := (msgs[0]).(*msgstream.CreateCollectionMsg)
 		assert.True(t, ok)
+		assert.Equal(t, "create-collection-100", createMsg.Base.RequestID)
 		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, createMeta.ID, createMsg.CollectionID)
@@ -625,7 +642,13 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, createMeta.ID, ddCollReq.CollectionID)
 		assert.Equal(t, createMeta.PartitionIDs[0], ddCollReq.PartitionID)
 
+		// the retried request of the same request id isn't executed again
+		status, err = core.CreateCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
 		// check invalid operation
+		req.Base.RequestID = ""
 		req.Base.MsgID = 101
 		req.Base.Timestamp = 101
 		req.Base.SourceID = 101
@@ -742,6 +765,186 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 		assert.ElementsMatch(t, rsp.CollectionNames, []string{collName, "testColl-again"})
 		assert.Equal(t, len(rsp.CollectionNames), 2)
+
+		// the pages are ordered by the names
+		req.Base.MsgID = 131
+		req.Limit = 1
+		req.WithDetails = true
+		rsp, err = core.ShowCollections(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{collName}, rsp.CollectionNames)
+		assert.Equal(t, collName, rsp.NextPageToken)
+		assert.Equal(t, 1, len(rsp.Details))
+		assert.Equal(t, shardsNum, rsp.Details[0].ShardsNum)
+
+		req.Base.MsgID = 132
+		req.PageToken = rsp.NextPageToken
+		rsp, err = core.ShowCollections(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{"testColl-again"}, rsp.CollectionNames)
+		assert.Equal(t, "", rsp.NextPageToken)
+
+		req.Base.MsgID = 133
+		req.Limit = 0
+		req.PageToken = ""
+		req.WithDetails = false
+		req.NamePattern = "*-again"
+		rsp, err = core.ShowCollections(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{"testColl-again"}, rsp.CollectionNames)
+		assert.Equal(t, 0, len(rsp.Details))
+
+		req.Base.MsgID = 134
+		req.NamePattern = "[testColl"
+		rsp, err = core.ShowCollections(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, rsp.Status.ErrorCode)
+	})
+
+	t.Run("alter collection", func(t *testing.T) {
+		req := &milvuspb.AlterCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterCollection,
+				MsgID:     135,
+				Timestamp: 135,
+				SourceID:  135,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			Properties:     []*commonpb.KeyValuePair{{Key: "owner", Value: "search"}},
+		}
+		status, err := core.AlterCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DescribeCollection,
+				MsgID:     136,
+				Timestamp: 136,
+				SourceID:  136,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.True(t, EqualKeyPairArray(req.Properties, rsp.Properties))
+
+		showRsp, err := core.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ShowCollections,
+				MsgID:     137,
+				Timestamp: 137,
+				SourceID:  137,
+			},
+			DbName:         dbName,
+			PropertyFilter: []*commonpb.KeyValuePair{{Key: "owner", Value: "search"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, showRsp.Status.ErrorCode)
+		assert.Equal(t, []string{collName}, showRsp.CollectionNames)
+
+		req.CollectionName = "testColl-not-exist"
+		status, err = core.AlterCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	t.Run("alter collection shards num", func(t *testing.T) {
+		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		req := &milvuspb.AlterCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterCollection,
+				MsgID:     138,
+				Timestamp: 138,
+				SourceID:  138,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			ShardsNum:      shardsNum + 2,
+		}
+		status, err := core.AlterCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		altered, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, shardsNum+2, altered.ShardsNum)
+		assert.Equal(t, shardsNum+2, int32(len(altered.VirtualChannelNames)))
+		assert.Equal(t, shardsNum+2, int32(len(altered.PhysicalChannelNames)))
+		// the existing shards are kept
+		assert.Equal(t, collMeta.VirtualChannelNames, altered.VirtualChannelNames[:shardsNum])
+		for i := shardsNum; i < shardsNum+2; i++ {
+			assert.Equal(t, altered.PhysicalChannelNames[i], ToPhysicalChannel(altered.VirtualChannelNames[i]))
+		}
+
+		// the shards num can't be decreased
+		req.ShardsNum = shardsNum
+		status, err = core.AlterCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.ErrorCode)
+	})
+
+	t.Run("quota", func(t *testing.T) {
+		rsp, err := core.GetQuotaUsage(ctx, &milvuspb.GetQuotaUsageRequest{CollectionName: collName})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []*milvuspb.QuotaUsage{
+			{Name: "partitions", CollectionName: collName, Used: 1, Limit: Params.MaxPartitionNum},
+			{Name: "fields", CollectionName: collName, Used: 1, Limit: Params.MaxFieldNum},
+		}, rsp.Usages)
+
+		rsp, err = core.GetQuotaUsage(ctx, &milvuspb.GetQuotaUsageRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, "collections", rsp.Usages[0].Name)
+		assert.Equal(t, int64(2), rsp.Usages[0].Used)
+		assert.Equal(t, Params.MaxCollectionNum, rsp.Usages[0].Limit)
+
+		rsp, err = core.GetQuotaUsage(ctx, &milvuspb.GetQuotaUsageRequest{CollectionName: "testColl-not-exist"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, rsp.Status.ErrorCode)
+
+		// too many fields
+		schema := schemapb.CollectionSchema{Name: "testColl-quota"}
+		for i := int64(0); i <= Params.MaxFieldNum; i++ {
+			schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+				Name:     fmt.Sprintf("field%d", i),
+				DataType: schemapb.DataType_Int64,
+			})
+		}
+		sbf, err := proto.Marshal(&schema)
+		assert.Nil(t, err)
+		createReq := &milvuspb.CreateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateCollection,
+				MsgID:     139,
+				Timestamp: 139,
+				SourceID:  139,
+			},
+			DbName:         dbName,
+			CollectionName: schema.Name,
+			Schema:         sbf,
+		}
+		status, err := core.CreateCollection(ctx, createReq)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, status.ErrorCode)
+
+		// too many collections
+		maxCollectionNum := Params.MaxCollectionNum
+		Params.MaxCollectionNum = 2
+		schema.Fields = schema.Fields[:1]
+		createReq.Schema, err = proto.Marshal(&schema)
+		assert.Nil(t, err)
+		status, err = core.CreateCollection(ctx, createReq)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, status.ErrorCode)
+		Params.MaxCollectionNum = maxCollectionNum
 	})
 
 	t.Run("create partition", func(t *testing.T) {
@@ -775,8 +978,9 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, collMeta.ID, partMsg.CollectionID)
 		assert.Equal(t, collMeta.PartitionIDs[1], partMsg.PartitionID)
 
-		assert.Equal(t, 1, len(pnm.GetCollArray()))
-		assert.Equal(t, collName, pnm.GetCollArray()[0])
+		// invalidated by the collections created and altered before
+		assert.Equal(t, 5, len(pnm.GetCollArray()))
+		assert.Equal(t, collName, pnm.GetCollArray()[4])
 
 		// check DD operation info
 		flag, err := core.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1110,8 +1314,8 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, collMeta.ID, dmsg.CollectionID)
 		assert.Equal(t, dropPartID, dmsg.PartitionID)
 
-		assert.Equal(t, 2, len(pnm.GetCollArray()))
-		assert.Equal(t, collName, pnm.GetCollArray()[1])
+		assert.Equal(t, 6, len(pnm.GetCollArray()))
+		assert.Equal(t, collName, pnm.GetCollArray()[5])
 
 		// check DD operation info
 		flag, err := core.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1167,6 +1371,69 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
+		// kept in the recycle bin, released and invalidated
+		_, err = core.MetaTable.GetCollectionByName(collName, 0)
+		assert.NotNil(t, err)
+		rsp, err := core.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(rsp.Collections))
+		assert.Equal(t, collMeta.ID, rsp.Collections[0].CollectionID)
+		dropTime, _ := tsoutil.ParseTS(rsp.Collections[0].DropTs)
+		purgeTime, _ := tsoutil.ParseTS(rsp.Collections[0].PurgeTs)
+		assert.Equal(t, Params.CollectionDropRetention, purgeTime.Sub(dropTime))
+		collArray := pnm.GetCollArray()
+		assert.Equal(t, 7, len(collArray))
+		assert.Equal(t, collName, collArray[6])
+		time.Sleep(100 * time.Millisecond)
+		qm.mutex.Lock()
+		assert.Equal(t, 1, len(qm.collID))
+		assert.Equal(t, collMeta.ID, qm.collID[0])
+		qm.mutex.Unlock()
+
+		status, err = core.UndropCollection(ctx, &milvuspb.UndropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_UndropCollection,
+				MsgID:     228,
+				Timestamp: 228,
+				SourceID:  228,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		restored, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collMeta.ID, restored.ID)
+		assert.Equal(t, 8, len(pnm.GetCollArray()))
+		status, err = core.UndropCollection(ctx, &milvuspb.UndropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_UndropCollection,
+				MsgID:     229,
+				Timestamp: 229,
+				SourceID:  229,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, status.ErrorCode)
+
+		req.Base.MsgID = 227
+		status, err = core.DropCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		// purged once the retention expires
+		core.purgeDroppedCollections(ctx, time.Now())
+		assert.Equal(t, 1, len(core.MetaTable.ListDroppedCollections()))
+		core.purgeDroppedCollections(ctx, time.Now().Add(Params.CollectionDropRetention+time.Minute))
+		assert.Empty(t, core.MetaTable.ListDroppedCollections())
+		dm.mu.Lock()
+		assert.Equal(t, []typeutil.UniqueID{collMeta.ID}, dm.purged)
+		dm.mu.Unlock()
+
 		vChanName := collMeta.VirtualChannelNames[0]
 		assert.Equal(t, collMeta.PhysicalChannelNames[0], ToPhysicalChannel(vChanName))
 
@@ -1175,15 +1442,9 @@ func TestRootCoord(t *testing.T) {
 		dmsg, ok := (msgs[0]).(*msgstream.DropCollectionMsg)
 		assert.True(t, ok)
 		assert.Equal(t, collMeta.ID, dmsg.CollectionID)
-		collArray := pnm.GetCollArray()
-		assert.Equal(t, 3, len(collArray))
-		assert.Equal(t, collName, collArray[2])
-
-		time.Sleep(100 * time.Millisecond)
-		qm.mutex.Lock()
-		assert.Equal(t, 1, len(qm.collID))
-		assert.Equal(t, collMeta.ID, qm.collID[0])
-		qm.mutex.Unlock()
+		collArray = pnm.GetCollArray()
+		assert.Equal(t, 9, len(collArray))
+		assert.Equal(t, collName, collArray[8])
 
 		req = &milvuspb.DropCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -1197,11 +1458,11 @@ func TestRootCoord(t *testing.T) {
 		}
 		status, err = core.DropCollection(ctx, req)
 		assert.Nil(t, err)
-		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, status.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 		collArray = pnm.GetCollArray()
-		assert.Equal(t, 3, len(collArray))
-		assert.Equal(t, collName, collArray[2])
+		assert.Equal(t, 9, len(collArray))
+		assert.Equal(t, collName, collArray[8])
 
 		// check DD operation info
 		flag, err := core.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1572,6 +1833,88 @@ func TestRootCoord(t *testing.T) {
 		assert.NotZero(t, rsp.ID)
 	})
 
+	t.Run("api key", func(t *testing.T) {
+		createReq := &milvuspb.CreateApiKeyRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateApiKey,
+				MsgID:     3002,
+				Timestamp: 3002,
+				SourceID:  3002,
+			},
+			Name:       "ingest",
+			Privileges: []string{apikey.PrivilegeDML},
+			RateLimit:  100,
+		}
+		rsp, err := core.CreateApiKey(ctx, createReq)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.True(t, apikey.IsKey(rsp.ApiKey))
+		key := rsp.ApiKey
+
+		// duplicated name
+		rsp, err = core.CreateApiKey(ctx, createReq)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, "", rsp.ApiKey)
+
+		rotateReq := &milvuspb.RotateApiKeyRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RotateApiKey,
+				MsgID:     3003,
+				Timestamp: 3003,
+				SourceID:  3003,
+			},
+			Name:        "ingest",
+			GracePeriod: 60,
+		}
+		rsp, err = core.RotateApiKey(ctx, rotateReq)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.NotEqual(t, key, rsp.ApiKey)
+
+		listRsp, err := core.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listRsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(listRsp.Keys))
+		assert.Equal(t, apikey.Hash(rsp.ApiKey), listRsp.Keys[0].Hash)
+		assert.Equal(t, apikey.Hash(key), listRsp.Keys[0].PreviousHash)
+		assert.Equal(t, []string{apikey.PrivilegeDML}, listRsp.Keys[0].Privileges)
+		assert.Equal(t, float64(100), listRsp.Keys[0].RateLimit)
+
+		dropReq := &milvuspb.DropApiKeyRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropApiKey,
+				MsgID:     3004,
+				Timestamp: 3004,
+				SourceID:  3004,
+			},
+			Name: "ingest",
+		}
+		status, err := core.DropApiKey(ctx, dropReq)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.DropApiKey(ctx, dropReq)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		listRsp, err = core.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(listRsp.Keys))
+
+		// invalid requests
+		createReq.Name = "ingest job"
+		rsp, err = core.CreateApiKey(ctx, createReq)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		createReq.Name, createReq.Privileges = "ingest", []string{"root"}
+		rsp, err = core.CreateApiKey(ctx, createReq)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		rotateReq.GracePeriod = -1
+		rsp, err = core.RotateApiKey(ctx, rotateReq)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+	})
+
 	t.Run("get_channels", func(t *testing.T) {
 		_, err := core.GetTimeTickChannel(ctx)
 		assert.Nil(t, err)
@@ -1870,6 +2213,21 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp8.Status.ErrorCode)
 
+		st, err = core.UndropCollection(ctx, &milvuspb.UndropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_UndropCollection,
+				MsgID:     4014,
+				Timestamp: 4014,
+				SourceID:  4014,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		rsp9, err := core.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp9.Status.ErrorCode)
+
 	})
 
 	t.Run("alloc_error", func(t *testing.T) {
@@ -2139,7 +2497,13 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
-	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error) {
+	c.CallPurgeCollectionService = func(ctx context.Context, collID typeutil.UniqueID) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallBuildIndexService = func(ctx context.Context, collID typeutil.UniqueID, binlog []string, numRows int64, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error) {
 		return 0, nil
 	}
 	err = c.checkInit()
@@ -2151,6 +2515,12 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.CallGetIndexStatesService = func(ctx context.Context, buildIDs []typeutil.UniqueID) ([]*indexpb.IndexInfo, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.NewProxyClient = func(*sessionutil.Session) (types.Proxy, error) {
 		return nil, nil
 	}
@@ -2167,6 +2537,12 @@ func TestCheckInit(t *testing.T) {
 		return nil
 	}
 	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallRefreshIndexService = func(ctx context.Context, collectionID, fieldID typeutil.UniqueID) error {
+		return nil
+	}
+	err = c.checkInit()
 	assert.Nil(t, err)
 	err = c.Stop()
 	assert.Nil(t, err)
@@ -2309,7 +2685,7 @@ func TestCheckFlushedSegments(t *testing.T) {
 		core.MetaTable.indexID2Meta[indexID] = etcdpb.IndexInfo{
 			IndexID: indexID,
 		}
-		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
+		core.CallBuildIndexService = func(_ context.Context, collID typeutil.UniqueID, binlog []string, numRows int64, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
 			assert.Equal(t, fieldID, field.FieldID)
 			assert.Equal(t, indexID, idx.IndexID)
 			return -1, errors.New("build index build")
@@ -2318,12 +2694,123 @@ func TestCheckFlushedSegments(t *testing.T) {
 		core.checkFlushedSegments(ctx)
 
 		var indexBuildID int64 = 10001
-		core.CallBuildIndexService = func(_ context.Context, binlog []string, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
+		core.CallBuildIndexService = func(_ context.Context, collID typeutil.UniqueID, binlog []string, numRows int64, field *schemapb.FieldSchema, idx *etcdpb.IndexInfo) (int64, error) {
 			return indexBuildID, nil
 		}
 		core.checkFlushedSegments(core.ctx)
 
 	})
+
+	t.Run("swap indexes", func(t *testing.T) {
+		ctx := context.Background()
+		var collID int64 = 11
+		var partID int64 = 12
+		var segID int64 = 1011
+		var fieldID int64 = 111
+		var oldIndexID int64 = 6011
+		var newIndexID int64 = 6012
+		var buildID int64 = 10011
+		core.MetaTable.collID2Meta[collID] = etcdpb.CollectionInfo{
+			ID:           collID,
+			PartitionIDs: []int64{partID},
+			FieldIndexes: []*etcdpb.FieldIndexInfo{
+				{
+					FiledID: fieldID,
+					IndexID: oldIndexID,
+				},
+				{
+					FiledID: fieldID,
+					IndexID: newIndexID,
+				},
+			},
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{
+						FieldID: fieldID,
+					},
+				},
+			},
+		}
+		core.MetaTable.indexID2Meta[oldIndexID] = etcdpb.IndexInfo{
+			IndexName: Params.DefaultIndexName,
+			IndexID:   oldIndexID,
+		}
+		core.MetaTable.indexID2Meta[newIndexID] = etcdpb.IndexInfo{
+			IndexName:       Params.DefaultIndexName,
+			IndexID:         newIndexID,
+			ReplacedIndexID: oldIndexID,
+		}
+		core.MetaTable.segID2IndexMeta[segID] = map[int64]etcdpb.SegmentIndexInfo{
+			oldIndexID: {
+				CollectionID: collID,
+				PartitionID:  partID,
+				SegmentID:    segID,
+				FieldID:      fieldID,
+				IndexID:      oldIndexID,
+				BuildID:      buildID - 1,
+				EnableIndex:  true,
+			},
+		}
+		core.CallGetFlushedSegmentsService = func(_ context.Context, cid, pid int64) ([]int64, error) {
+			assert.Equal(t, collID, cid)
+			return []int64{segID}, nil
+		}
+		refreshed := make([]int64, 0)
+		core.CallRefreshIndexService = func(_ context.Context, cid, fid int64) error {
+			assert.Equal(t, collID, cid)
+			refreshed = append(refreshed, fid)
+			return nil
+		}
+		dropped := make([]int64, 0)
+		core.CallDropIndexService = func(_ context.Context, indexID int64) error {
+			dropped = append(dropped, indexID)
+			return nil
+		}
+
+		// the new index is not built on the segment yet, the old one keeps serving
+		core.swapIndexes(ctx)
+		assert.Empty(t, refreshed)
+		info, err := core.MetaTable.GetSegmentIndexInfoByID(segID, -1, "")
+		assert.Nil(t, err)
+		assert.Equal(t, oldIndexID, info.IndexID)
+
+		core.MetaTable.segID2IndexMeta[segID][newIndexID] = etcdpb.SegmentIndexInfo{
+			CollectionID: collID,
+			PartitionID:  partID,
+			SegmentID:    segID,
+			FieldID:      fieldID,
+			IndexID:      newIndexID,
+			BuildID:      buildID,
+			EnableIndex:  true,
+		}
+		state := commonpb.IndexState_InProgress
+		core.CallGetIndexStatesService = func(_ context.Context, buildIDs []int64) ([]*indexpb.IndexInfo, error) {
+			assert.Equal(t, []int64{buildID}, buildIDs)
+			return []*indexpb.IndexInfo{{State: state, IndexBuildID: buildID, Reason: "corrupt binlog"}}, nil
+		}
+		core.swapIndexes(ctx)
+		assert.Empty(t, refreshed)
+		buildState, _, err := core.getIndexBuildState(ctx, newIndexID)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.IndexState_InProgress, buildState)
+
+		// the builds failed permanently are reported with the fail reason
+		state = commonpb.IndexState_Failed
+		buildState, reason, err := core.getIndexBuildState(ctx, newIndexID)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.IndexState_Failed, buildState)
+		assert.Equal(t, "corrupt binlog", reason)
+
+		state = commonpb.IndexState_Finished
+		core.swapIndexes(ctx)
+		assert.Equal(t, []int64{fieldID}, refreshed)
+		assert.Equal(t, []int64{oldIndexID}, dropped)
+		info, err = core.MetaTable.GetSegmentIndexInfoByID(segID, -1, "")
+		assert.Nil(t, err)
+		assert.Equal(t, newIndexID, info.IndexID)
+		_, err = core.MetaTable.GetIndexByID(oldIndexID)
+		assert.NotNil(t, err)
+	})
 	err = core.Stop()
 	assert.Nil(t, err)
 }
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/secret"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// secretMetaPrefixes are the meta prefixes of the credentials, their values are encrypted if the encryption is enabled
+var secretMetaPrefixes = []string{ApiKeyMetaPrefix}
+
+// secretKV encrypts the values of the keys under prefixes before saving them to the underlying kv, and decrypts the
+// encrypted values loaded from it, the plain values saved before the encryption is enabled are loaded as is
+type secretKV struct {
+	kv.SnapShotKV
+	cipher   *secret.Cipher
+	prefixes []string
+}
+
+func newSecretKV(snapshot kv.SnapShotKV, cipher *secret.Cipher, prefixes ...string) *secretKV {
+	return &secretKV{
+		SnapShotKV: snapshot,
+		cipher:     cipher,
+		prefixes:   prefixes,
+	}
+}
+
+func (s *secretKV) isSecret(key string) bool {
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *secretKV) encrypt(kvs map[string]string) (map[string]string, error) {
+	encrypted := make(map[string]string, len(kvs))
+	for key, value := range kvs {
+		if s.isSecret(key) {
+			var err error
+			if value, err = s.cipher.Encrypt(value); err != nil {
+				return nil, fmt.Errorf("encrypt %s failed: %w", key, err)
+			}
+		}
+		encrypted[key] = value
+	}
+	return encrypted, nil
+}
+
+func (s *secretKV) Save(key string, value string, ts typeutil.Timestamp) error {
+	kvs, err := s.encrypt(map[string]string{key: value})
+	if err != nil {
+		return err
+	}
+	return s.SnapShotKV.Save(key, kvs[key], ts)
+}
+
+func (s *secretKV) Load(key string, ts typeutil.Timestamp) (string, error) {
+	value, err := s.SnapShotKV.Load(key, ts)
+	if err != nil {
+		return "", err
+	}
+	return s.cipher.Decrypt(value)
+}
+
+func (s *secretKV) MultiSave(kvs map[string]string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error {
+	kvs, err := s.encrypt(kvs)
+	if err != nil {
+		return err
+	}
+	return s.SnapShotKV.MultiSave(kvs, ts, additions...)
+}
+
+func (s *secretKV) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+	keys, values, err := s.SnapShotKV.LoadWithPrefix(key, ts)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range values {
+		if values[i], err = s.cipher.Decrypt(values[i]); err != nil {
+			return nil, nil, fmt.Errorf("decrypt %s failed: %w", keys[i], err)
+		}
+	}
+	return keys, values, nil
+}
+
+func (s *secretKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error {
+	saves, err := s.encrypt(saves)
+	if err != nil {
+		return err
+	}
+	return s.SnapShotKV.MultiSaveAndRemoveWithPrefix(saves, removals, ts, additions...)
+}
+
+// rewrap wraps the data keys of the secret values by the current master key, the plain values are encrypted, it's
+// called by RootCoord on start, so a master key is rotated by restarting RootCoord with the new current key. The
+// previous key is kept until the older snapshots of the meta are compacted
+func (s *secretKV) rewrap(ts typeutil.Timestamp) error {
+	rewrapped := make(map[string]string)
+	for _, prefix := range s.prefixes {
+		keys, values, err := s.SnapShotKV.LoadWithPrefix(prefix, 0)
+		if err != nil {
+			return err
+		}
+		for i, value := range values {
+			value, changed, err := s.cipher.Rewrap(value)
+			if err != nil {
+				return fmt.Errorf("rewrap %s failed: %w", keys[i], err)
+			}
+			if changed {
+				rewrapped[keys[i]] = value
+			}
+		}
+	}
+	if len(rewrapped) == 0 {
+		return nil
+	}
+	if err := s.SnapShotKV.MultiSave(rewrapped, ts); err != nil {
+		return err
+	}
+	log.Debug("RootCoord rewrapped the secrets by the current master key", zap.Int("num", len(rewrapped)))
+	return nil
+}
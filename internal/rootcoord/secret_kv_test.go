@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/secret"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// memSnapshotKV keeps the latest values only
+type memSnapshotKV struct {
+	kvs map[string]string
+}
+
+func (m *memSnapshotKV) Save(key string, value string, ts typeutil.Timestamp) error {
+	m.kvs[key] = value
+	return nil
+}
+
+func (m *memSnapshotKV) Load(key string, ts typeutil.Timestamp) (string, error) {
+	return m.kvs[key], nil
+}
+
+func (m *memSnapshotKV) MultiSave(kvs map[string]string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error {
+	for key, value := range kvs {
+		m.kvs[key] = value
+	}
+	return nil
+}
+
+func (m *memSnapshotKV) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+	keys := make([]string, 0)
+	for k := range m.kvs {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m.kvs[k])
+	}
+	return keys, values, nil
+}
+
+func (m *memSnapshotKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error {
+	for key, value := range saves {
+		m.kvs[key] = value
+	}
+	for _, prefix := range removals {
+		for k := range m.kvs {
+			if strings.HasPrefix(k, prefix) {
+				delete(m.kvs, k)
+			}
+		}
+	}
+	return nil
+}
+
+func TestSecretKV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rootcoord_secret")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	lines := make([]string, 0, 2)
+	for _, id := range []string{"k1", "k2"} {
+		key := make([]byte, 32)
+		_, err = rand.Read(key)
+		assert.Nil(t, err)
+		lines = append(lines, id+":"+base64.StdEncoding.EncodeToString(key))
+	}
+	keyFile := path.Join(dir, "keys")
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(strings.Join(lines, "\n")), 0600))
+
+	// an api key saved before the encryption is enabled
+	mem := &memSnapshotKV{kvs: make(map[string]string)}
+	legacy := &pb.ApiKeyInfo{Name: "legacy", Hash: "hash0"}
+	assert.Nil(t, mem.Save(apiKeyMetaKey(legacy.Name), proto.MarshalTextString(legacy), 0))
+
+	p1, err := secret.NewKeyFileProvider(keyFile, "k1")
+	assert.Nil(t, err)
+	skv := newSecretKV(mem, secret.NewCipher(p1), secretMetaPrefixes...)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(mt.ListApiKeys()))
+
+	err = mt.AddApiKey(&pb.ApiKeyInfo{Name: "ingest", Hash: "hash1", Privileges: []string{"dml"}}, 1)
+	assert.Nil(t, err)
+	raw := mem.kvs[apiKeyMetaKey("ingest")]
+	assert.True(t, secret.IsEncrypted(raw))
+	assert.NotContains(t, raw, "hash1")
+
+	// the legacy value is encrypted, and the others are rewrapped by the current key
+	assert.Nil(t, skv.rewrap(2))
+	assert.True(t, secret.IsEncrypted(mem.kvs[apiKeyMetaKey("legacy")]))
+	assert.Equal(t, raw, mem.kvs[apiKeyMetaKey("ingest")])
+
+	p2, err := secret.NewKeyFileProvider(keyFile, "k2")
+	assert.Nil(t, err)
+	skv = newSecretKV(mem, secret.NewCipher(p2), secretMetaPrefixes...)
+	assert.Nil(t, skv.rewrap(3))
+	assert.NotEqual(t, raw, mem.kvs[apiKeyMetaKey("ingest")])
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	keys := mt.ListApiKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	assert.Equal(t, 2, len(keys))
+	assert.Equal(t, "hash1", keys[0].Hash)
+	assert.Equal(t, "hash0", keys[1].Hash)
+
+	// the values of k1 are no longer needed once rewrapped
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(lines[1]), 0600))
+	p2, err = secret.NewKeyFileProvider(keyFile, "")
+	assert.Nil(t, err)
+	_, err = NewMetaTable(newSecretKV(mem, secret.NewCipher(p2), secretMetaPrefixes...))
+	assert.Nil(t, err)
+
+	// the encrypted values can't be loaded without the encryption
+	_, err = NewMetaTable(mem)
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -25,6 +26,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/apikey"
+	"github.com/milvus-io/milvus/internal/util/merr"
+	"github.com/milvus-io/milvus/internal/util/notify"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -91,6 +95,9 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName != schema.Name {
 		return fmt.Errorf("collection name = %s, schema.Name=%s", t.Req.CollectionName, schema.Name)
 	}
+	if int64(len(schema.Fields)) > Params.MaxFieldNum {
+		return merr.Errorf(merr.ErrQuotaExceeded, "maximum field's number should be limit to %d", Params.MaxFieldNum)
+	}
 	if t.Req.ShardsNum <= 0 {
 		t.Req.ShardsNum = DefaultShardsNum
 	}
@@ -130,21 +137,12 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		zap.Int64("collection_id", collID),
 		zap.Int64("default partition id", partID))
 
-	vchanNames := make([]string, t.Req.ShardsNum)
-	chanNames := make([]string, t.Req.ShardsNum)
-	for i := int32(0); i < t.Req.ShardsNum; i++ {
-		vchanNames[i] = fmt.Sprintf("%s_%d_%d_v%d", t.Req.CollectionName, collID, i, i)
-		chanNames[i] = ToPhysicalChannel(vchanNames[i])
-	}
-
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
 		Schema:                     &schema,
 		PartitionIDs:               []typeutil.UniqueID{partID},
 		PartitionNames:             []string{Params.DefaultPartitionName},
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
-		VirtualChannelNames:        vchanNames,
-		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
 		PartitionCreatedTimestamps: []uint64{0},
 	}
@@ -159,16 +157,14 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	}
 
 	ddCollReq := internalpb.CreateCollectionRequest{
-		Base:                 t.Req.Base,
-		DbName:               t.Req.DbName,
-		CollectionName:       t.Req.CollectionName,
-		PartitionName:        Params.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
-		CollectionID:         collID,
-		PartitionID:          partID,
-		Schema:               schemaBytes,
-		VirtualChannelNames:  vchanNames,
-		PhysicalChannelNames: chanNames,
+		Base:           t.Req.Base,
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		PartitionName:  Params.DefaultPartitionName,
+		DbID:           0, //TODO,not used
+		CollectionID:   collID,
+		PartitionID:    partID,
+		Schema:         schemaBytes,
 	}
 
 	// build DdOperation and save it into etcd, when ddmsg send fail,
@@ -190,6 +186,16 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		t.core.ddlLock.Lock()
 		defer t.core.ddlLock.Unlock()
 
+		// assigned under the ddl lock, so that the collections created concurrently see the loads of each other
+		vchanNames, chanNames, err := t.core.dmlChannels.AssignChannels(t.Req.CollectionName, collID, nil, t.Req.ShardsNum)
+		if err != nil {
+			return err
+		}
+		collInfo.VirtualChannelNames = vchanNames
+		collInfo.PhysicalChannelNames = chanNames
+		ddCollReq.VirtualChannelNames = vchanNames
+		ddCollReq.PhysicalChannelNames = chanNames
+
 		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
 		// clear ddl timetick in all conditions
 		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
@@ -216,6 +222,26 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.core.notifier.Notify(notify.CollectionCreated, map[string]interface{}{
+		"collection_id":   collID,
+		"collection_name": t.Req.CollectionName,
+		"shards_num":      t.Req.ShardsNum,
+	})
+
+	// the proxies remember the collection missing for a while, and a collection of the name dropped may be cached
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		CollectionID:   collID,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -240,68 +266,89 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	ddReq := internalpb.DropCollectionRequest{
-		Base:           t.Req.Base,
-		DbName:         t.Req.DbName,
-		CollectionName: t.Req.CollectionName,
-		DbID:           0, //not used
-		CollectionID:   collMeta.ID,
-	}
-
-	// build DdOperation and save it into etcd, when ddmsg send fail,
-	// system can restore ddmsg from etcd and re-send
-	ddOp := func(ts typeutil.Timestamp) (string, error) {
-		ddReq.Base.Timestamp = ts
-		return EncodeDdOperation(&ddReq, DropCollectionDDType)
-	}
-
-	reason := fmt.Sprintf("drop collection %d", collMeta.ID)
 	ts, err := t.core.TSOAllocator(1)
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
 
-	// use lambda function here to guarantee all resources to be released
-	dropCollectionFn := func() error {
-		// lock for ddl operation
+	if Params.CollectionDropRetention > 0 {
+		// the collection is kept to be restored by UndropCollection, it's purged once the retention expires
 		t.core.ddlLock.Lock()
-		defer t.core.ddlLock.Unlock()
+		err = t.core.MetaTable.SoftDeleteCollection(collMeta.ID, ts)
+		t.core.ddlLock.Unlock()
+	} else {
+		ddReq := internalpb.DropCollectionRequest{
+			Base:           t.Req.Base,
+			DbName:         t.Req.DbName,
+			CollectionName: t.Req.CollectionName,
+			DbID:           0, //not used
+			CollectionID:   collMeta.ID,
+		}
+		err = t.core.dropCollection(ctx, collMeta, &ddReq, ts)
+	}
+	if err != nil {
+		return err
+	}
 
-		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
-		// clear ddl timetick in all conditions
-		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+	//notify query service to release collection
+	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, 0, collMeta.ID); err != nil {
+		log.Error("CallReleaseCollectionService failed", zap.String("error", err.Error()))
+		return err
+	}
 
-		err = t.core.MetaTable.DeleteCollection(collMeta.ID, ts, ddOp)
-		if err != nil {
-			return err
-		}
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 
-		err = t.core.SendDdDropCollectionReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
-		if err != nil {
-			return err
-		}
+	if Params.CollectionDropRetention == 0 {
+		t.core.purgeCollectionBinlogs(ctx, collMeta.ID)
+	}
+	return nil
+}
 
-		t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
-		t.core.SendTimeTick(ts, reason)
+type UndropCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.UndropCollectionRequest
+}
 
-		for _, chanName := range collMeta.PhysicalChannelNames {
-			// send tt into deleted channels to tell data_node to clear flowgragh
-			t.core.chanTimeTick.SendChannelTimeTick(chanName, ts)
-		}
+func (t *UndropCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
 
-		// remove dml channel after send dd msg
-		t.core.dmlChannels.RemoveProducerChannels(collMeta.PhysicalChannelNames...)
-		return nil
+func (t *UndropCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_UndropCollection {
+		return fmt.Errorf("undrop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
 
-	err = dropCollectionFn()
+	// the collection of the name dropped last if the id isn't set
+	var collMeta *etcdpb.CollectionInfo
+	for _, coll := range t.core.MetaTable.ListDroppedCollections() {
+		if coll.Schema.Name == t.Req.CollectionName && (t.Req.CollectionID == 0 || coll.ID == t.Req.CollectionID) {
+			collMeta = coll
+		}
+	}
+	if collMeta == nil {
+		return merr.Errorf(merr.ErrCollectionNotFound, "can't find dropped collection: %s", t.Req.CollectionName)
+	}
+
+	ts, err := t.core.TSOAllocator(1)
 	if err != nil {
-		return err
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
 
-	//notify query service to release collection
-	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, 0, collMeta.ID); err != nil {
-		log.Error("CallReleaseCollectionService failed", zap.String("error", err.Error()))
+	t.core.ddlLock.Lock()
+	err = t.core.MetaTable.RestoreCollection(collMeta.ID, ts)
+	t.core.ddlLock.Unlock()
+	if err != nil {
 		return err
 	}
 
@@ -317,9 +364,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 	// error doesn't matter here
 	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
-
-	// Update DDOperation in etcd
-	return t.core.setDdMsgSendFlag(true)
+	return nil
 }
 
 type HasCollectionReqTask struct {
@@ -382,6 +427,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 		collInfo.ShardsNum = int32(len(collInfo.VirtualChannelNames))
 	}
 	t.Rsp.ShardsNum = collInfo.ShardsNum
+	t.Rsp.Properties = collInfo.Properties
 
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
@@ -404,17 +450,110 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
+	if t.Req.Limit < 0 {
+		return merr.Errorf(merr.ErrIllegalArgument, "invalid limit %d", t.Req.Limit)
+	}
+	if _, err := MatchNamePattern("", t.Req.NamePattern); err != nil {
+		return err
+	}
 	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
+	names := make([]string, 0, len(coll))
 	for name, meta := range coll {
+		matched, _ := MatchNamePattern(name, t.Req.NamePattern)
+		if matched && MatchProperties(meta.Properties, t.Req.PropertyFilter) {
+			names = append(names, name)
+		}
+	}
+	names, t.Rsp.NextPageToken = PageNames(names, t.Req.PageToken, t.Req.Limit)
+	for _, name := range names {
+		meta := coll[name]
 		t.Rsp.CollectionNames = append(t.Rsp.CollectionNames, name)
 		t.Rsp.CollectionIds = append(t.Rsp.CollectionIds, meta.ID)
 		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, meta.CreateTime)
 		physical, _ := tsoutil.ParseHybridTs(meta.CreateTime)
 		t.Rsp.CreatedUtcTimestamps = append(t.Rsp.CreatedUtcTimestamps, physical)
+		if t.Req.WithDetails {
+			// the load states are filled by proxy from query coord
+			t.Rsp.Details = append(t.Rsp.Details, &milvuspb.CollectionDetail{
+				ShardsNum:  meta.ShardsNum,
+				Properties: meta.Properties,
+			})
+		}
+	}
+	return nil
+}
+
+type AlterCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterCollectionRequest
+}
+
+func (t *AlterCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *AlterCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterCollection {
+		return fmt.Errorf("alter collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// the properties and the shards are only kept in meta, no dd message is sent
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	// the new shards are watched by datacoord once the proxies assign segments on them, and by the query nodes once
+	// the collection is loaded again
+	var vchanNames, chanNames []string
+	shardsNum := int32(len(collMeta.VirtualChannelNames))
+	if t.Req.ShardsNum != 0 && t.Req.ShardsNum != shardsNum {
+		if t.Req.ShardsNum < shardsNum {
+			return merr.Errorf(merr.ErrIllegalArgument, "the shards num of collection %s can't be decreased from %d to %d",
+				t.Req.CollectionName, shardsNum, t.Req.ShardsNum)
+		}
+		vchanNames, chanNames, err = t.core.dmlChannels.AssignChannels(t.Req.CollectionName, collMeta.ID, collMeta.PhysicalChannelNames, t.Req.ShardsNum)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = t.core.MetaTable.AlterCollection(collMeta.ID, t.Req.Properties, t.Req.DeleteKeys, ts); err != nil {
+		return err
+	}
+	if len(vchanNames) > 0 {
+		if err = t.core.MetaTable.AddCollectionShards(collMeta.ID, vchanNames, chanNames, ts); err != nil {
+			return err
+		}
+		t.core.dmlChannels.AddProducerChannels(chanNames...)
+		log.Debug("add the shards of collection", zap.String("collection name", t.Req.CollectionName),
+			zap.Int32("shards num", t.Req.ShardsNum), zap.Strings("virtual channels", vchanNames))
+	}
+
+	// the proxies reload the properties, such as the max row size checked by the inserts, and the channels
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:          t.Req.DbName,
+		CollectionName:  t.Req.CollectionName,
+		CollectionID:    collMeta.ID,
+		ChannelsChanged: len(vchanNames) > 0,
 	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 	return nil
 }
 
@@ -808,7 +947,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 			IndexID:      idxInfo.IndexID,
 			EnableIndex:  false,
 		}
-		info.BuildID, err = t.core.BuildIndex(ctx, segID, &field, idxInfo, false)
+		info.BuildID, err = t.core.BuildIndex(ctx, collMeta.ID, segID, &field, idxInfo, false)
 		if err != nil {
 			return err
 		}
@@ -854,6 +993,10 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 			IndexID:   i.IndexID,
 			FieldName: f.Name,
 		}
+		desc.State, desc.FailReason, err = t.core.getIndexBuildState(ctx, i.IndexID)
+		if err != nil {
+			log.Warn("get index build state failed", zap.String("collection name", t.Req.CollectionName), zap.String("index name", i.IndexName), zap.Error(err))
+		}
 		t.Rsp.IndexDescriptions = append(t.Rsp.IndexDescriptions, desc)
 	}
 	return nil
@@ -891,3 +1034,105 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
 	return err
 }
+
+type CreateApiKeyReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateApiKeyRequest
+	Rsp *milvuspb.ApiKeyResponse
+}
+
+func (t *CreateApiKeyReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateApiKeyReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateApiKey {
+		return fmt.Errorf("create api key, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if err := apikey.CheckName(t.Req.Name); err != nil {
+		return err
+	}
+	privileges, err := apikey.NormalizePrivileges(t.Req.Privileges)
+	if err != nil {
+		return err
+	}
+	if t.Req.RateLimit < 0 {
+		return fmt.Errorf("the rate limit of an api key should not be negative, got %f", t.Req.RateLimit)
+	}
+	key, err := apikey.Generate()
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// only the hash is kept, the key is returned once
+	info := etcdpb.ApiKeyInfo{
+		Name:       t.Req.Name,
+		Hash:       apikey.Hash(key),
+		Privileges: privileges,
+		RateLimit:  t.Req.RateLimit,
+		CreateTime: time.Now().Unix(),
+	}
+	if err = t.core.MetaTable.AddApiKey(&info, ts); err != nil {
+		return err
+	}
+	t.Rsp.Name = t.Req.Name
+	t.Rsp.ApiKey = key
+	return nil
+}
+
+type RotateApiKeyReqTask struct {
+	baseReqTask
+	Req *milvuspb.RotateApiKeyRequest
+	Rsp *milvuspb.ApiKeyResponse
+}
+
+func (t *RotateApiKeyReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *RotateApiKeyReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RotateApiKey {
+		return fmt.Errorf("rotate api key, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.GracePeriod < 0 {
+		return fmt.Errorf("the grace period of the previous api key should not be negative, got %d", t.Req.GracePeriod)
+	}
+	key, err := apikey.Generate()
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.RotateApiKey(t.Req.Name, apikey.Hash(key), time.Now().Unix(), t.Req.GracePeriod, ts); err != nil {
+		return err
+	}
+	t.Rsp.Name = t.Req.Name
+	t.Rsp.ApiKey = key
+	return nil
+}
+
+type DropApiKeyReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropApiKeyRequest
+}
+
+func (t *DropApiKeyReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropApiKeyReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropApiKey {
+		return fmt.Errorf("drop api key, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	return t.core.MetaTable.DeleteApiKey(t.Req.Name, ts)
+}
@@ -14,13 +14,16 @@ package rootcoord
 import (
 	"fmt"
 	"math"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -42,6 +45,10 @@ type timetickSync struct {
 	ddlLock  sync.RWMutex
 	ddlMinTs typeutil.Timestamp
 	ddlTsSet map[typeutil.Timestamp]struct{}
+
+	// the statistics of the last time tick sent to each channel
+	statsLock    sync.RWMutex
+	channelStats map[string]metricsinfo.ChannelTimeTickStatistics
 }
 
 type channelTimeTickMsg struct {
@@ -78,6 +85,8 @@ func newTimeTickSync(core *Core) *timetickSync {
 		ddlLock:  sync.RWMutex{},
 		ddlMinTs: typeutil.Timestamp(math.MaxUint64),
 		ddlTsSet: make(map[typeutil.Timestamp]struct{}),
+
+		channelStats: make(map[string]metricsinfo.ChannelTimeTickStatistics),
 	}
 }
 
@@ -236,14 +245,9 @@ func (t *timetickSync) StartWatch() {
 			// reduce each channel to get min timestamp
 			mtt := ptt[t.core.session.ServerID]
 			for _, chanName := range mtt.in.ChannelNames {
-				mints := mtt.getTimetick(chanName)
-				for _, tt := range ptt {
-					ts := tt.getTimetick(chanName)
-					if ts < mints {
-						mints = ts
-					}
-				}
-				if err := t.SendChannelTimeTick(chanName, mints); err != nil {
+				stats := reduceChannelTimeTick(chanName, ptt)
+				t.updateStatistics(stats)
+				if err := t.SendChannelTimeTick(chanName, stats.MinTimestamp); err != nil {
 					log.Debug("SendChannelTimeTick fail", zap.Error(err))
 				}
 			}
@@ -251,6 +255,66 @@ func (t *timetickSync) StartWatch() {
 	}
 }
 
+// reduceChannelTimeTick returns the statistics of a channel over the time ticks of all the sources,
+// the min timestamp of them is the one sent to the channel
+func reduceChannelTimeTick(chanName string, ptt map[typeutil.UniqueID]*channelTimeTickMsg) metricsinfo.ChannelTimeTickStatistics {
+	stats := metricsinfo.ChannelTimeTickStatistics{
+		Channel: chanName,
+		Sources: make([]metricsinfo.SourceTimeTick, 0, len(ptt)),
+	}
+	for sourceID, tt := range ptt {
+		stats.Sources = append(stats.Sources, metricsinfo.SourceTimeTick{
+			SourceID:  sourceID,
+			Timestamp: tt.getTimetick(chanName),
+		})
+	}
+	sort.Slice(stats.Sources, func(i, j int) bool {
+		return stats.Sources[i].SourceID < stats.Sources[j].SourceID
+	})
+	for i, source := range stats.Sources {
+		if i == 0 || source.Timestamp < stats.MinTimestamp {
+			stats.MinTimestamp = source.Timestamp
+			stats.LaggingSource = source.SourceID
+		}
+		if source.Timestamp > stats.MaxTimestamp {
+			stats.MaxTimestamp = source.Timestamp
+		}
+	}
+
+	maxTime, _ := tsoutil.ParseTS(stats.MaxTimestamp)
+	minTime, _ := tsoutil.ParseTS(stats.MinTimestamp)
+	stats.SafeTime = minTime.Format(time.RFC3339Nano)
+	stats.Lag = maxTime.Sub(minTime).Milliseconds()
+	for i := range stats.Sources {
+		sourceTime, _ := tsoutil.ParseTS(stats.Sources[i].Timestamp)
+		stats.Sources[i].Lag = maxTime.Sub(sourceTime).Milliseconds()
+	}
+	return stats
+}
+
+func (t *timetickSync) updateStatistics(stats metricsinfo.ChannelTimeTickStatistics) {
+	t.statsLock.Lock()
+	defer t.statsLock.Unlock()
+	t.channelStats[stats.Channel] = stats
+	metrics.RootCoordDmlChannelTimeTickLag.WithLabelValues(stats.Channel).Set(float64(stats.Lag))
+}
+
+// GetTimeTickStatistics returns the statistics of the last time tick sent to each channel
+func (t *timetickSync) GetTimeTickStatistics() *metricsinfo.TimeTickStatistics {
+	t.statsLock.RLock()
+	defer t.statsLock.RUnlock()
+	ret := &metricsinfo.TimeTickStatistics{
+		Channels: make([]metricsinfo.ChannelTimeTickStatistics, 0, len(t.channelStats)),
+	}
+	for _, stats := range t.channelStats {
+		ret.Channels = append(ret.Channels, stats)
+	}
+	sort.Slice(ret.Channels, func(i, j int) bool {
+		return ret.Channels[i].Channel < ret.Channels[j].Channel
+	})
+	return ret
+}
+
 // SendChannelTimeTick send each channel's min timetick to msg stream
 func (t *timetickSync) SendChannelTimeTick(chanName string, ts typeutil.Timestamp) error {
 	msgPack := msgstream.MsgPack{}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestTimetickSync_Statistics(t *testing.T) {
+	ts := func(physical int64) typeutil.Timestamp {
+		return tsoutil.ComposeTS(physical, 0)
+	}
+	newMsg := func(sourceID int64, defaultTs typeutil.Timestamp, channels []string, timestamps []typeutil.Timestamp) *channelTimeTickMsg {
+		return newChannelTimeTickMsg(&internalpb.ChannelTimeTickMsg{
+			Base:             &commonpb.MsgBase{SourceID: sourceID},
+			ChannelNames:     channels,
+			Timestamps:       timestamps,
+			DefaultTimestamp: defaultTs,
+		})
+	}
+	ptt := map[typeutil.UniqueID]*channelTimeTickMsg{
+		1: newMsg(1, ts(3000), []string{"ch0", "ch1"}, []typeutil.Timestamp{ts(3000), ts(3000)}),
+		// proxy 2 lags on ch0, and reports ch1 by the default time tick
+		2: newMsg(2, ts(2800), []string{"ch0"}, []typeutil.Timestamp{ts(1000)}),
+		3: newMsg(3, ts(3000), nil, nil),
+	}
+
+	stats := reduceChannelTimeTick("ch0", ptt)
+	assert.Equal(t, "ch0", stats.Channel)
+	assert.Equal(t, ts(1000), stats.MinTimestamp)
+	assert.Equal(t, ts(3000), stats.MaxTimestamp)
+	assert.Equal(t, int64(2), stats.LaggingSource)
+	assert.Equal(t, int64(2000), stats.Lag)
+	assert.Equal(t, 3, len(stats.Sources))
+	for i, source := range stats.Sources {
+		assert.Equal(t, int64(i+1), source.SourceID)
+	}
+	assert.Equal(t, int64(0), stats.Sources[0].Lag)
+	assert.Equal(t, int64(2000), stats.Sources[1].Lag)
+
+	// the first source is reported if several ones lag the same
+	stats = reduceChannelTimeTick("ch1", ptt)
+	assert.Equal(t, ts(2800), stats.MinTimestamp)
+	assert.Equal(t, int64(2), stats.LaggingSource)
+	assert.Equal(t, int64(200), stats.Lag)
+
+	ptt[2] = newMsg(2, ts(3000), nil, nil)
+	stats = reduceChannelTimeTick("ch1", ptt)
+	assert.Equal(t, int64(1), stats.LaggingSource)
+	assert.Equal(t, int64(0), stats.Lag)
+
+	tt := newTimeTickSync(nil)
+	assert.Equal(t, 0, len(tt.GetTimeTickStatistics().Channels))
+	tt.updateStatistics(reduceChannelTimeTick("ch1", ptt))
+	tt.updateStatistics(reduceChannelTimeTick("ch0", ptt))
+	tt.updateStatistics(reduceChannelTimeTick("ch1", ptt))
+	channels := tt.GetTimeTickStatistics().Channels
+	assert.Equal(t, 2, len(channels))
+	assert.Equal(t, "ch0", channels[0].Channel)
+	assert.Equal(t, "ch1", channels[1].Channel)
+}
@@ -14,12 +14,15 @@ package rootcoord
 import (
 	"encoding/json"
 	"fmt"
+	"path"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -132,3 +135,80 @@ func ToPhysicalChannel(vchannel string) string {
 	}
 	return vchannel[:idx]
 }
+
+// AlterProperties returns the properties with set applied, then the keys of deleteKeys removed,
+// the existing properties keep their order and the new ones are appended
+func AlterProperties(properties []*commonpb.KeyValuePair, set []*commonpb.KeyValuePair, deleteKeys []string) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties)+len(set))
+	index := make(map[string]int, len(properties)+len(set))
+	for _, kv := range properties {
+		index[kv.Key] = len(ret)
+		ret = append(ret, &commonpb.KeyValuePair{Key: kv.Key, Value: kv.Value})
+	}
+	for _, kv := range set {
+		if i, ok := index[kv.Key]; ok {
+			ret[i].Value = kv.Value
+			continue
+		}
+		index[kv.Key] = len(ret)
+		ret = append(ret, &commonpb.KeyValuePair{Key: kv.Key, Value: kv.Value})
+	}
+
+	deleted := make(map[string]struct{}, len(deleteKeys))
+	for _, key := range deleteKeys {
+		deleted[key] = struct{}{}
+	}
+	kept := ret[:0]
+	for _, kv := range ret {
+		if _, ok := deleted[kv.Key]; !ok {
+			kept = append(kept, kv)
+		}
+	}
+	return kept
+}
+
+// MatchProperties returns whether the properties contain all the key value pairs of filter
+func MatchProperties(properties []*commonpb.KeyValuePair, filter []*commonpb.KeyValuePair) bool {
+	for _, f := range filter {
+		matched := false
+		for _, kv := range properties {
+			if kv.Key == f.Key && kv.Value == f.Value {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchNamePattern returns whether the name matches the pattern of the path.Match syntax, in which * matches any
+// characters and ? matches one character. An empty pattern matches all the names
+func MatchNamePattern(name string, pattern string) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+	matched, err := path.Match(pattern, name)
+	if err != nil {
+		return false, merr.Errorf(merr.ErrIllegalArgument, "invalid name pattern %s", pattern)
+	}
+	return matched, nil
+}
+
+// PageNames returns the page of the names after the page token, which is the last name of the previous page, so
+// a page isn't shifted by the names created or dropped since the previous one. The names are sorted in place, the
+// next page token is empty if it's the last page
+func PageNames(names []string, pageToken string, limit int64) ([]string, string) {
+	sort.Strings(names)
+	start := 0
+	if pageToken != "" {
+		start = sort.Search(len(names), func(i int) bool { return names[i] > pageToken })
+	}
+	names = names[start:]
+	if limit <= 0 || int64(len(names)) <= limit {
+		return names, ""
+	}
+	return names[:limit], names[limit-1]
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/merr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,6 +55,82 @@ func Test_EqualKeyPairArray(t *testing.T) {
 	assert.True(t, EqualKeyPairArray(p1, p2))
 }
 
+func Test_AlterProperties(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{
+		{Key: "owner", Value: "search"},
+		{Key: "env", Value: "dev"},
+	}
+	ret := AlterProperties(properties, []*commonpb.KeyValuePair{
+		{Key: "env", Value: "prod"},
+		{Key: "cost_center", Value: "1024"},
+	}, nil)
+	assert.True(t, EqualKeyPairArray(ret, []*commonpb.KeyValuePair{
+		{Key: "owner", Value: "search"},
+		{Key: "env", Value: "prod"},
+		{Key: "cost_center", Value: "1024"},
+	}))
+	assert.Equal(t, "cost_center", ret[2].Key)
+	// the input is not changed
+	assert.Equal(t, "dev", properties[1].Value)
+
+	ret = AlterProperties(ret, []*commonpb.KeyValuePair{{Key: "tmp", Value: "1"}}, []string{"owner", "tmp", "unknown"})
+	assert.True(t, EqualKeyPairArray(ret, []*commonpb.KeyValuePair{
+		{Key: "env", Value: "prod"},
+		{Key: "cost_center", Value: "1024"},
+	}))
+
+	assert.Equal(t, 0, len(AlterProperties(nil, nil, []string{"env"})))
+}
+
+func Test_MatchProperties(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{
+		{Key: "owner", Value: "search"},
+		{Key: "env", Value: "prod"},
+	}
+	assert.True(t, MatchProperties(properties, nil))
+	assert.True(t, MatchProperties(properties, []*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}))
+	assert.True(t, MatchProperties(properties, properties))
+	assert.False(t, MatchProperties(properties, []*commonpb.KeyValuePair{{Key: "env", Value: "dev"}}))
+	assert.False(t, MatchProperties(properties, []*commonpb.KeyValuePair{
+		{Key: "env", Value: "prod"},
+		{Key: "team", Value: "search"},
+	}))
+	assert.False(t, MatchProperties(nil, []*commonpb.KeyValuePair{{Key: "env", Value: "prod"}}))
+}
+
+func Test_MatchNamePattern(t *testing.T) {
+	matched, err := MatchNamePattern("coll_1", "")
+	assert.Nil(t, err)
+	assert.True(t, matched)
+	matched, err = MatchNamePattern("coll_1", "coll_*")
+	assert.Nil(t, err)
+	assert.True(t, matched)
+	matched, err = MatchNamePattern("coll_10", "coll_?")
+	assert.Nil(t, err)
+	assert.False(t, matched)
+	_, err = MatchNamePattern("coll_1", "coll_[")
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, merr.Code(err))
+}
+
+func Test_PageNames(t *testing.T) {
+	names, token := PageNames([]string{"c", "a", "b"}, "", 0)
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Equal(t, "", token)
+
+	names, token = PageNames([]string{"c", "a", "b"}, "", 2)
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, "b", token)
+
+	// the name of the token has been dropped since the previous page
+	names, token = PageNames([]string{"c", "a", "d"}, "b", 2)
+	assert.Equal(t, []string{"c", "d"}, names)
+	assert.Equal(t, "", token)
+
+	names, token = PageNames([]string{"a"}, "a", 2)
+	assert.Equal(t, 0, len(names))
+	assert.Equal(t, "", token)
+}
+
 func Test_GetFieldSchemaByID(t *testing.T) {
 	coll := &etcdpb.CollectionInfo{
 		Schema: &schemapb.CollectionSchema{
@@ -61,16 +61,27 @@ func (writer *baseBinlogWriter) GetRowNums() (int32, error) {
 	}
 
 	length := 0
+	lastRows := 0
 	for _, e := range writer.eventWriters {
 		rows, err := e.GetPayloadLengthFromWriter()
 		if err != nil {
 			return 0, err
 		}
-		length += rows
+		length += eventRows(e, rows, lastRows)
+		lastRows = rows
 	}
 	return int32(length), nil
 }
 
+// eventRows returns the number of rows an event adds to the binlog, the rows of a vector dedup event
+// replace the unique vectors of the insert event before it
+func eventRows(e EventWriter, rows int, lastRows int) int {
+	if _, ok := e.(*vectorDedupEventWriter); ok {
+		return rows - lastRows
+	}
+	return rows
+}
+
 func (writer *baseBinlogWriter) GetBinlogType() BinlogType {
 	return writer.binlogType
 }
@@ -104,6 +115,7 @@ func (writer *baseBinlogWriter) Close() error {
 	offset += writer.descriptorEvent.GetMemoryUsageInBytes()
 
 	writer.length = 0
+	lastRows := 0
 	for _, w := range writer.eventWriters {
 		w.SetOffset(offset)
 		if err := w.Finish(); err != nil {
@@ -121,7 +133,8 @@ func (writer *baseBinlogWriter) Close() error {
 		if err != nil {
 			return err
 		}
-		writer.length += int32(rows)
+		writer.length += int32(eventRows(w, rows, lastRows))
+		lastRows = rows
 		if err := w.ReleasePayloadWriter(); err != nil {
 			return err
 		}
@@ -145,6 +158,26 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter() (*insertEventWriter, e
 	return event, nil
 }
 
+// NextVectorDedupEventWriter returns the writer of the row indexes into the unique vectors written by
+// the last insert event writer
+func (writer *InsertBinlogWriter) NextVectorDedupEventWriter() (*vectorDedupEventWriter, error) {
+	if writer.isClosed() {
+		return nil, fmt.Errorf("binlog has closed")
+	}
+	if writer.PayloadDataType != schemapb.DataType_FloatVector && writer.PayloadDataType != schemapb.DataType_BinaryVector {
+		return nil, fmt.Errorf("vector dedup is not supported for data type %s", writer.PayloadDataType.String())
+	}
+	if len(writer.eventWriters) == 0 {
+		return nil, fmt.Errorf("vector dedup event must follow an insert event")
+	}
+	event, err := newVectorDedupEventWriter()
+	if err != nil {
+		return nil, err
+	}
+	writer.eventWriters = append(writer.eventWriters, event)
+	return event, nil
+}
+
 type DeleteBinlogWriter struct {
 	baseBinlogWriter
 }
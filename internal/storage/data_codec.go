@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/sparse"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -114,6 +115,13 @@ type FloatVectorFieldData struct {
 	Dim     int
 }
 
+// SparseFloatVectorFieldData is the rows of the sparse float vectors, Dim is the max dim of the rows
+type SparseFloatVectorFieldData struct {
+	NumRows  []int64
+	Contents [][]byte
+	Dim      int64
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -197,9 +205,30 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 		case schemapb.DataType_BinaryVector:
-			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
+			vectors, indexes := singleData.(*BinaryVectorFieldData).Data, []int32(nil)
+			if isVectorDedupEnabled(field) {
+				vectors, indexes = dedupBinaryVectors(vectors, singleData.(*BinaryVectorFieldData).Dim)
+			}
+			err = eventWriter.AddBinaryVectorToPayload(vectors, singleData.(*BinaryVectorFieldData).Dim)
+			if err == nil && indexes != nil {
+				err = addVectorDedupEvent(writer, indexes, startTs, endTs)
+			}
 		case schemapb.DataType_FloatVector:
-			err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
+			vectors, indexes := singleData.(*FloatVectorFieldData).Data, []int32(nil)
+			if isVectorDedupEnabled(field) {
+				vectors, indexes = dedupFloatVectors(vectors, singleData.(*FloatVectorFieldData).Dim)
+			}
+			err = eventWriter.AddFloatVectorToPayload(vectors, singleData.(*FloatVectorFieldData).Dim)
+			if err == nil && indexes != nil {
+				err = addVectorDedupEvent(writer, indexes, startTs, endTs)
+			}
+		case schemapb.DataType_SparseFloatVector:
+			for _, row := range singleData.(*SparseFloatVectorFieldData).Contents {
+				err = eventWriter.AddOneSparseFloatVectorToPayload(row)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
 		default:
 			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
@@ -223,12 +252,12 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 			Value: buffer,
 		})
 
-		// stats fields
-		statsWriter := &StatsWriter{}
-		switch field.DataType {
-		case schemapb.DataType_Int64:
-			err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
+		// stats of scalar fields
+		if !IsStatsSupported(field.DataType) {
+			continue
 		}
+		statsWriter := &StatsWriter{}
+		err = statsWriter.StatsField(field.FieldID, field.DataType, singleData)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -277,6 +306,16 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 			if eventReader == nil {
 				break
 			}
+			if eventReader.TypeCode == VectorDedupEventType {
+				indexes, err := eventReader.GetInt32FromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				if err = reconstructDedupVectors(resultData.Data[fieldID], indexes); err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				continue
+			}
 			switch dataType {
 			case schemapb.DataType_Bool:
 				if resultData.Data[fieldID] == nil {
@@ -452,6 +491,28 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 				totalLength += length
 				floatVectorFieldData.NumRows = append(floatVectorFieldData.NumRows, int64(length))
 				resultData.Data[fieldID] = floatVectorFieldData
+			case schemapb.DataType_SparseFloatVector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &SparseFloatVectorFieldData{}
+				}
+				sparseFieldData := resultData.Data[fieldID].(*SparseFloatVectorFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				sparseFieldData.NumRows = append(sparseFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					row, err := eventReader.GetOneSparseFloatVectorFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					if dim := sparse.Dim(row); dim > sparseFieldData.Dim {
+						sparseFieldData.Dim = dim
+					}
+					sparseFieldData.Contents = append(sparseFieldData.Contents, row)
+				}
+				resultData.Data[fieldID] = sparseFieldData
 			default:
 				return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("undefined data type %d", dataType)
 			}
@@ -478,6 +539,132 @@ func (insertCodec *InsertCodec) Close() error {
 	return nil
 }
 
+// DeleteData is the deleted pks of a segment and their timestamps
+type DeleteData struct {
+	Pks []int64
+	Tss []Timestamp
+}
+
+// Blob key example:
+// ${tenant}/delta_log/${collection_id}/${partition_id}/${segment_id}/${log_idx}
+type DeleteCodec struct {
+	collectionID int64
+}
+
+func NewDeleteCodec(collectionID int64) *DeleteCodec {
+	return &DeleteCodec{collectionID: collectionID}
+}
+
+// Serialize writes the deletes in one delete event, each delete is saved as the string "pk,ts"
+func (deleteCodec *DeleteCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
+	if data == nil || len(data.Pks) == 0 {
+		return nil, fmt.Errorf("no deletes of segment %d", segmentID)
+	}
+	if len(data.Pks) != len(data.Tss) {
+		return nil, fmt.Errorf("the num of pks %d and timestamps %d of segment %d are not equal", len(data.Pks), len(data.Tss), segmentID)
+	}
+	writer := NewDeleteBinlogWriter(schemapb.DataType_String, deleteCodec.collectionID)
+	writer.PartitionID = partitionID
+	writer.SegmentID = segmentID
+	eventWriter, err := writer.NextDeleteEventWriter()
+	if err != nil {
+		return nil, err
+	}
+	startTs, endTs := data.Tss[0], data.Tss[0]
+	for i, pk := range data.Pks {
+		ts := data.Tss[i]
+		if ts < startTs {
+			startTs = ts
+		}
+		if ts > endTs {
+			endTs = ts
+		}
+		err = eventWriter.AddOneStringToPayload(strconv.FormatInt(pk, 10) + "," + strconv.FormatUint(ts, 10))
+		if err != nil {
+			return nil, err
+		}
+	}
+	eventWriter.SetEventTimestamp(startTs, endTs)
+	writer.SetEventTimeStamp(startTs, endTs)
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := writer.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:   strconv.FormatInt(segmentID, 10),
+		Value: buffer,
+	}, nil
+}
+
+// Deserialize reads the deletes of the delta logs of a segment, the deletes of the blobs are concatenated
+func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
+	if len(blobs) == 0 {
+		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
+	}
+	partitionID, segmentID = InvalidUniqueID, InvalidUniqueID
+	result := &DeleteData{}
+	for _, blob := range blobs {
+		binlogReader, err := NewBinlogReader(blob.Value)
+		if err != nil {
+			return InvalidUniqueID, InvalidUniqueID, nil, err
+		}
+		partitionID, segmentID = binlogReader.PartitionID, binlogReader.SegmentID
+		for {
+			eventReader, err := binlogReader.NextEventReader()
+			if err != nil {
+				_ = binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			if eventReader == nil {
+				break
+			}
+			length, err := eventReader.GetPayloadLengthFromReader()
+			if err != nil {
+				_ = binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			for i := 0; i < length; i++ {
+				str, err := eventReader.GetOneStringFromPayload(i)
+				if err != nil {
+					_ = binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				pk, ts, err := parseDelete(str)
+				if err != nil {
+					_ = binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				result.Pks = append(result.Pks, pk)
+				result.Tss = append(result.Tss, ts)
+			}
+		}
+		if err := binlogReader.Close(); err != nil {
+			return InvalidUniqueID, InvalidUniqueID, nil, err
+		}
+	}
+	return partitionID, segmentID, result, nil
+}
+
+func parseDelete(str string) (int64, Timestamp, error) {
+	splits := strings.Split(str, ",")
+	if len(splits) != 2 {
+		return 0, 0, fmt.Errorf("invalid delete %s", str)
+	}
+	pk, err := strconv.ParseInt(splits[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid pk of delete %s: %w", str, err)
+	}
+	ts, err := strconv.ParseUint(splits[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid timestamp of delete %s: %w", str, err)
+	}
+	return pk, ts, nil
+}
+
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
 // ${tenant}/data_definition_log/${collection_id}/ddl/${log_idx}
@@ -673,13 +860,15 @@ func NewIndexCodec() *IndexCodec {
 
 func (indexCodec *IndexCodec) Serialize(blobs []*Blob, params map[string]string, indexName string, indexID UniqueID) ([]*Blob, error) {
 	paramsBytes, err := json.Marshal(struct {
-		Params    map[string]string
-		IndexName string
-		IndexID   UniqueID
+		Params        map[string]string
+		IndexName     string
+		IndexID       UniqueID
+		FormatVersion int32
 	}{
-		Params:    params,
-		IndexName: indexName,
-		IndexID:   indexID,
+		Params:        params,
+		IndexName:     indexName,
+		IndexID:       indexID,
+		FormatVersion: typeutil.IndexFormatVersion,
 	})
 	if err != nil {
 		return nil, err
@@ -702,13 +891,18 @@ func (indexCodec *IndexCodec) Deserialize(blobs []*Blob) ([]*Blob, map[string]st
 		return nil, nil, "", InvalidUniqueID, fmt.Errorf("can not find params blob")
 	}
 	info := struct {
-		Params    map[string]string
-		IndexName string
-		IndexID   UniqueID
+		Params        map[string]string
+		IndexName     string
+		IndexID       UniqueID
+		FormatVersion int32 // 0 for the files written before the versioning
 	}{}
 	if err := json.Unmarshal(file.Value, &info); err != nil {
 		return nil, nil, "", InvalidUniqueID, fmt.Errorf("json unmarshal error: %s", err.Error())
 	}
+	if info.FormatVersion > typeutil.IndexFormatVersion {
+		return nil, nil, "", InvalidUniqueID, fmt.Errorf("index format version %d is newer than the supported version %d",
+			info.FormatVersion, typeutil.IndexFormatVersion)
+	}
 
 	return blobs, info.Params, info.IndexName, info.IndexID, nil
 }
@@ -16,30 +16,44 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sparse"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
 
 const (
-	CollectionID      = 1
-	PartitionID       = 1
-	SegmentID         = 1
-	RowIDField        = 0
-	TimestampField    = 1
-	BoolField         = 100
-	Int8Field         = 101
-	Int16Field        = 102
-	Int32Field        = 103
-	Int64Field        = 104
-	FloatField        = 105
-	DoubleField       = 106
-	StringField       = 107
-	BinaryVectorField = 108
-	FloatVectorField  = 109
+	CollectionID           = 1
+	PartitionID            = 1
+	SegmentID              = 1
+	RowIDField             = 0
+	TimestampField         = 1
+	BoolField              = 100
+	Int8Field              = 101
+	Int16Field             = 102
+	Int32Field             = 103
+	Int64Field             = 104
+	FloatField             = 105
+	DoubleField            = 106
+	StringField            = 107
+	BinaryVectorField      = 108
+	FloatVectorField       = 109
+	SparseFloatVectorField = 110
 )
 
+func sparseRow(t *testing.T, indices ...uint32) []byte {
+	values := make([]float32, len(indices))
+	for i := range values {
+		values[i] = float32(i + 1)
+	}
+	row, err := sparse.Encode(indices, values)
+	assert.Nil(t, err)
+	return row
+}
+
 func TestInsertCodec(t *testing.T) {
 	schema := &etcdpb.CollectionMeta{
 		ID:            CollectionID,
@@ -135,6 +149,13 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      SparseFloatVectorField,
+					Name:         "field_sparse_float_vector",
+					IsPrimaryKey: false,
+					Description:  "sparse_float_vector",
+					DataType:     schemapb.DataType_SparseFloatVector,
+				},
 			},
 		},
 	}
@@ -191,6 +212,11 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			SparseFloatVectorField: &SparseFloatVectorFieldData{
+				NumRows:  []int64{2},
+				Contents: [][]byte{sparseRow(t, 0, 3), sparseRow(t, 4)},
+				Dim:      5,
+			},
 		},
 	}
 
@@ -246,6 +272,11 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			SparseFloatVectorField: &SparseFloatVectorFieldData{
+				NumRows:  []int64{2},
+				Contents: [][]byte{sparseRow(t, 1), sparseRow(t, 2, 9)},
+				Dim:      10,
+			},
 		},
 	}
 	Blobs1, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData1)
@@ -277,6 +308,7 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -289,6 +321,9 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{sparseRow(t, 1), sparseRow(t, 2, 9), sparseRow(t, 0, 3), sparseRow(t, 4)},
+		resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).Contents)
+	assert.Equal(t, int64(10), resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).Dim)
 	assert.Nil(t, insertCodec.Close())
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
@@ -297,6 +332,92 @@ func TestInsertCodec(t *testing.T) {
 	_, _, _, err = insertCodec.Deserialize(blobs)
 	assert.NotNil(t, err)
 }
+func TestInsertCodecVectorDedup(t *testing.T) {
+	dedupParams := []*commonpb.KeyValuePair{{Key: VectorDedupKey, Value: "true"}}
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: BinaryVectorField, Name: "field_binary_vector", DataType: schemapb.DataType_BinaryVector, TypeParams: dedupParams},
+				{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector, TypeParams: dedupParams},
+			},
+		},
+	}
+	insertCodec := NewInsertCodec(schema)
+	insertData1 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+			TimestampField: &Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+			BinaryVectorField: &BinaryVectorFieldData{
+				NumRows: []int64{4},
+				Data:    []byte{0, 255, 0, 0},
+				Dim:     8,
+			},
+			FloatVectorField: &FloatVectorFieldData{
+				NumRows: []int64{4},
+				Data:    []float32{0, 1, 2, 0, 1, 2, 3, 4, 5, 0, 1, 2},
+				Dim:     3,
+			},
+		},
+	}
+	insertData2 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{5, 6}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{5, 6}},
+			BinaryVectorField: &BinaryVectorFieldData{
+				NumRows: []int64{2},
+				Data:    []byte{1, 2},
+				Dim:     8,
+			},
+			FloatVectorField: &FloatVectorFieldData{
+				NumRows: []int64{2},
+				Data:    []float32{4, 5, 6, 7, 8, 9},
+				Dim:     3,
+			},
+		},
+	}
+	blobs1, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData1)
+	assert.Nil(t, err)
+	blobs2, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData2)
+	assert.Nil(t, err)
+
+	// only the vectors of the first insert data are duplicated
+	eventTypes := func(blob *Blob) []EventTypeCode {
+		reader, err := NewBinlogReader(blob.Value)
+		assert.Nil(t, err)
+		defer reader.Close()
+		codes := make([]EventTypeCode, 0)
+		for {
+			event, err := reader.NextEventReader()
+			assert.Nil(t, err)
+			if event == nil {
+				break
+			}
+			codes = append(codes, event.TypeCode)
+		}
+		return codes
+	}
+	for _, blob := range blobs1 {
+		if blob.Key == fmt.Sprintf("%d", FloatVectorField) || blob.Key == fmt.Sprintf("%d", BinaryVectorField) {
+			assert.Equal(t, []EventTypeCode{InsertEventType, VectorDedupEventType}, eventTypes(blob))
+		}
+	}
+	for _, blob := range blobs2 {
+		assert.Equal(t, []EventTypeCode{InsertEventType}, eventTypes(blob))
+	}
+
+	_, _, resultData, err := insertCodec.Deserialize(append(blobs1, blobs2...))
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
+	assert.Equal(t, []byte{0, 255, 0, 0, 1, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
+	assert.Equal(t, []int64{4, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []float32{0, 1, 2, 0, 1, 2, 3, 4, 5, 0, 1, 2, 4, 5, 6, 7, 8, 9}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Nil(t, insertCodec.Close())
+}
+
 func TestDDCodec(t *testing.T) {
 	dataDefinitionCodec := NewDataDefinitionCodec(int64(1))
 	ts := []Timestamp{1, 2, 3, 4}
@@ -328,6 +449,35 @@ func TestDDCodec(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDeleteCodec(t *testing.T) {
+	deleteCodec := NewDeleteCodec(1)
+	data := &DeleteData{Pks: []int64{3, -1, 5}, Tss: []Timestamp{100, 99, 101}}
+	blob, err := deleteCodec.Serialize(2, 3, data)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", blob.Key)
+
+	partitionID, segmentID, result, err := deleteCodec.Deserialize([]*Blob{blob, blob})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), partitionID)
+	assert.Equal(t, int64(3), segmentID)
+	assert.Equal(t, append(data.Pks, data.Pks...), result.Pks)
+	assert.Equal(t, append(data.Tss, data.Tss...), result.Tss)
+
+	_, err = deleteCodec.Serialize(2, 3, &DeleteData{})
+	assert.NotNil(t, err)
+	_, err = deleteCodec.Serialize(2, 3, &DeleteData{Pks: []int64{1}})
+	assert.NotNil(t, err)
+	_, _, _, err = deleteCodec.Deserialize(nil)
+	assert.NotNil(t, err)
+
+	_, _, err = parseDelete("1")
+	assert.NotNil(t, err)
+	_, _, err = parseDelete("a,1")
+	assert.NotNil(t, err)
+	_, _, err = parseDelete("1,-1")
+	assert.NotNil(t, err)
+}
+
 func TestIndexCodec(t *testing.T) {
 	indexCodec := NewIndexCodec()
 	blobs := []*Blob{
@@ -364,6 +514,15 @@ func TestIndexCodec(t *testing.T) {
 	blobs = []*Blob{}
 	_, _, _, _, err = indexCodec.Deserialize(blobs)
 	assert.NotNil(t, err)
+
+	// the params files written before the versioning have no format version
+	_, indexParamsOutput, _, _, err = indexCodec.Deserialize([]*Blob{{Key: IndexParamsFile,
+		Value: []byte(`{"Params":{"k1":"v1"},"IndexName":"index_test_name","IndexID":1234}`)}})
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[string]string{"k1": "v1"}, indexParamsOutput)
+	_, _, _, _, err = indexCodec.Deserialize([]*Blob{{Key: IndexParamsFile,
+		Value: []byte(fmt.Sprintf(`{"IndexID":1234,"FormatVersion":%d}`, typeutil.IndexFormatVersion+1))}})
+	assert.NotNil(t, err)
 }
 
 func TestTsError(t *testing.T) {
@@ -75,6 +75,9 @@ func (ds *DataSorter) Swap(i, j int) {
 		case schemapb.DataType_String:
 			data := singleData.(*StringFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_SparseFloatVector:
+			data := singleData.(*SparseFloatVectorFieldData).Contents
+			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_BinaryVector:
 			data := singleData.(*BinaryVectorFieldData).Data
 			dim := singleData.(*BinaryVectorFieldData).Dim
@@ -45,7 +45,7 @@ func (reader *EventReader) readData() error {
 	var data eventData
 	var err error
 	switch reader.TypeCode {
-	case InsertEventType:
+	case InsertEventType, VectorDedupEventType:
 		data, err = readInsertEventDataFixPart(reader.buffer)
 	case DeleteEventType:
 		data, err = readDeleteEventDataFixPart(reader.buffer)
@@ -94,6 +94,9 @@ func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventRea
 
 	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
 	payloadBuffer := buffer.Next(next)
+	if reader.TypeCode == VectorDedupEventType {
+		datatype = schemapb.DataType_Int32
+	}
 	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
 	if err != nil {
 		return nil, err
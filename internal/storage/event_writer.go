@@ -33,6 +33,11 @@ const (
 	EventTypeEnd
 )
 
+// VectorDedupEventType is the event which maps the rows of a vector binlog to the unique vectors written by
+// the insert event before it. It is kept out of the range of EventTypeEnd, so that the post header lengths
+// of the descriptor event, and hence the binlogs written without deduplication, are unchanged
+const VectorDedupEventType EventTypeCode = 100
+
 func (code EventTypeCode) String() string {
 	codes := map[EventTypeCode]string{
 		DescriptorEventType:       "DescriptorEventType",
@@ -42,6 +47,7 @@ func (code EventTypeCode) String() string {
 		DropCollectionEventType:   "DropCollectionEventType",
 		CreatePartitionEventType:  "CreatePartitionEventType",
 		DropPartitionEventType:    "DropPartitionEventType",
+		VectorDedupEventType:      "VectorDedupEventType",
 	}
 	if eventTypeStr, ok := codes[code]; ok {
 		return eventTypeStr
@@ -167,6 +173,11 @@ type insertEventWriter struct {
 	insertEventData
 }
 
+type vectorDedupEventWriter struct {
+	baseEventWriter
+	insertEventData
+}
+
 type deleteEventWriter struct {
 	baseEventWriter
 	deleteEventData
@@ -226,6 +237,28 @@ func newInsertEventWriter(dataType schemapb.DataType) (*insertEventWriter, error
 	return writer, nil
 }
 
+func newVectorDedupEventWriter() (*vectorDedupEventWriter, error) {
+	payloadWriter, err := NewPayloadWriter(schemapb.DataType_Int32)
+	if err != nil {
+		return nil, err
+	}
+	header := newEventHeader(VectorDedupEventType)
+	data := newInsertEventData()
+
+	writer := &vectorDedupEventWriter{
+		baseEventWriter: baseEventWriter{
+			eventHeader:            *header,
+			PayloadWriterInterface: payloadWriter,
+			isClosed:               false,
+			isFinish:               false,
+		},
+		insertEventData: *data,
+	}
+	writer.baseEventWriter.getEventDataSize = writer.insertEventData.GetEventDataFixPartSize
+	writer.baseEventWriter.writeEventData = writer.insertEventData.WriteEventData
+	return writer, nil
+}
+
 func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error) {
 	payloadWriter, err := NewPayloadWriter(dataType)
 	if err != nil {
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// LocalCacheStats is the statistics of a LocalCache
+type LocalCacheStats struct {
+	Capacity  int64 `json:"capacity"`
+	Size      int64 `json:"size"`
+	Files     int   `json:"files"`
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+}
+
+type localCacheEntry struct {
+	key  string
+	size int64
+}
+
+// LocalCache is a ChunkManager on local disk with a capacity, the least recently used files are
+// evicted when the total size of the files exceeds the capacity
+type LocalCache struct {
+	lcm *LocalChunkManager
+
+	mu       sync.Mutex
+	capacity int64 // in bytes, no limit if capacity <= 0
+	size     int64
+	lru      *list.List
+	entries  map[string]*list.Element
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// NewLocalCache returns a LocalCache on localPath, the files left in localPath are loaded into the cache
+func NewLocalCache(localPath string, capacity int64) *LocalCache {
+	lc := &LocalCache{
+		lcm:      NewLocalChunkManager(localPath),
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+
+	err := filepath.Walk(localPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(localPath, path)
+		if err != nil {
+			return nil
+		}
+		lc.add(key, info.Size())
+		return nil
+	})
+	if err != nil {
+		log.Warn("failed to load local cache", zap.String("path", localPath), zap.Error(err))
+	}
+	lc.evict()
+	return lc
+}
+
+func (lc *LocalCache) add(key string, size int64) {
+	if e, ok := lc.entries[key]; ok {
+		lc.size -= e.Value.(*localCacheEntry).size
+		lc.lru.Remove(e)
+	}
+	lc.entries[key] = lc.lru.PushFront(&localCacheEntry{key: key, size: size})
+	lc.size += size
+	metrics.QueryNodeLocalCacheSize.Set(float64(lc.size))
+}
+
+func (lc *LocalCache) remove(e *list.Element) error {
+	entry := e.Value.(*localCacheEntry)
+	if err := lc.lcm.Remove(entry.key); err != nil {
+		return err
+	}
+	lc.lru.Remove(e)
+	delete(lc.entries, entry.key)
+	lc.size -= entry.size
+	metrics.QueryNodeLocalCacheSize.Set(float64(lc.size))
+	return nil
+}
+
+// evict removes the least recently used files until the size is under the capacity
+func (lc *LocalCache) evict() {
+	for lc.capacity > 0 && lc.size > lc.capacity && lc.lru.Len() > 0 {
+		e := lc.lru.Back()
+		key := e.Value.(*localCacheEntry).key
+		if err := lc.remove(e); err != nil {
+			log.Warn("failed to evict local cache file", zap.String("key", key), zap.Error(err))
+			return
+		}
+		lc.evictions++
+		metrics.QueryNodeLocalCacheEvictionCounter.Inc()
+	}
+}
+
+func (lc *LocalCache) recordHit(key string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if e, ok := lc.entries[key]; ok {
+		lc.lru.MoveToFront(e)
+	}
+	lc.hits++
+	metrics.QueryNodeLocalCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+}
+
+func (lc *LocalCache) recordMiss(key string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.misses++
+	metrics.QueryNodeLocalCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+}
+
+func (lc *LocalCache) GetPath(key string) (string, error) {
+	return lc.lcm.GetPath(key)
+}
+
+// Write writes the file to local disk and evicts the least recently used files if the capacity is exceeded
+func (lc *LocalCache) Write(key string, content []byte) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if err := lc.lcm.Write(key, content); err != nil {
+		return err
+	}
+	lc.add(key, int64(len(content)))
+	lc.evict()
+	return nil
+}
+
+func (lc *LocalCache) Exist(key string) bool {
+	return lc.lcm.Exist(key)
+}
+
+func (lc *LocalCache) Read(key string) ([]byte, error) {
+	return lc.lcm.Read(key)
+}
+
+func (lc *LocalCache) ReadAt(key string, p []byte, off int64) (int, error) {
+	return lc.lcm.ReadAt(key, p, off)
+}
+
+// Purge removes all the files in the cache
+func (lc *LocalCache) Purge() error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	for lc.lru.Len() > 0 {
+		if err := lc.remove(lc.lru.Back()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resize changes the capacity of the cache, files are evicted if the new capacity is exceeded
+func (lc *LocalCache) Resize(capacity int64) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.capacity = capacity
+	lc.evict()
+}
+
+// Stats returns the statistics of the cache
+func (lc *LocalCache) Stats() LocalCacheStats {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return LocalCacheStats{
+		Capacity:  lc.capacity,
+		Size:      lc.size,
+		Files:     lc.lru.Len(),
+		Hits:      lc.hits,
+		Misses:    lc.misses,
+		Evictions: lc.evictions,
+	}
+}
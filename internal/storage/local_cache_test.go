@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalCache(t *testing.T) {
+	cachePath := path.Join(localPath, "local_cache")
+	defer os.RemoveAll(cachePath)
+
+	lcm := NewLocalChunkManager(cachePath)
+	err := lcm.Write("old", []byte{1, 2})
+	assert.Nil(t, err)
+
+	lc := NewLocalCache(cachePath, 6)
+	stats := lc.Stats()
+	assert.Equal(t, int64(2), stats.Size)
+	assert.Equal(t, 1, stats.Files)
+
+	err = lc.Write("a/1", []byte{1, 2})
+	assert.Nil(t, err)
+	err = lc.Write("a/2", []byte{1, 2})
+	assert.Nil(t, err)
+	lc.recordHit("old")
+	lc.recordMiss("a/3")
+
+	// a/1 is the least recently used
+	err = lc.Write("a/3", []byte{1, 2})
+	assert.Nil(t, err)
+	assert.False(t, lc.Exist("a/1"))
+	assert.True(t, lc.Exist("old"))
+	stats = lc.Stats()
+	assert.Equal(t, LocalCacheStats{Capacity: 6, Size: 6, Files: 3, Hits: 1, Misses: 1, Evictions: 1}, stats)
+
+	lc.Resize(2)
+	assert.False(t, lc.Exist("a/2"))
+	assert.False(t, lc.Exist("old"))
+	assert.True(t, lc.Exist("a/3"))
+	content, err := lc.Read("a/3")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, content)
+
+	lc.Resize(0)
+	err = lc.Write("a/4", make([]byte, 100))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(102), lc.Stats().Size)
+
+	err = lc.Purge()
+	assert.Nil(t, err)
+	assert.False(t, lc.Exist("a/3"))
+	assert.False(t, lc.Exist("a/4"))
+	stats = lc.Stats()
+	assert.Equal(t, int64(0), stats.Size)
+	assert.Equal(t, 0, stats.Files)
+	assert.Equal(t, int64(3), stats.Evictions)
+}
@@ -65,6 +65,15 @@ func (lcm *LocalChunkManager) Exist(key string) bool {
 	return true
 }
 
+func (lcm *LocalChunkManager) Remove(key string) error {
+	path := path.Join(lcm.localPath, key)
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
 	path := path.Join(lcm.localPath, key)
 	file, err := os.Open(path)
@@ -25,6 +25,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sparse"
 )
 
 type PayloadWriterInterface interface {
@@ -39,6 +40,7 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddOneSparseFloatVectorToPayload(row []byte) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -58,6 +60,7 @@ type PayloadReaderInterface interface {
 	GetOneStringFromPayload(idx int) (string, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader() error
 	Close() error
@@ -73,8 +76,17 @@ type PayloadReader struct {
 	colType          schemapb.DataType
 }
 
+// payloadColumnType returns the column type of the data type in parquet,
+// the sparse float vectors are variable length rows, which are stored as strings
+func payloadColumnType(colType schemapb.DataType) schemapb.DataType {
+	if colType == schemapb.DataType_SparseFloatVector {
+		return schemapb.DataType_String
+	}
+	return colType
+}
+
 func NewPayloadWriter(colType schemapb.DataType) (*PayloadWriter, error) {
-	w := C.NewPayloadWriter(C.int(colType))
+	w := C.NewPayloadWriter(C.int(payloadColumnType(colType)))
 	if w == nil {
 		return nil, errors.New("create Payload writer failed")
 	}
@@ -133,6 +145,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_SparseFloatVector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -318,6 +336,20 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return nil
 }
 
+// AddOneSparseFloatVectorToPayload adds a row of the sparse float vector, see the sparse package for the encoding
+func (w *PayloadWriter) AddOneSparseFloatVectorToPayload(row []byte) error {
+	if w.colType != schemapb.DataType_SparseFloatVector {
+		return errors.New("incorrect data type")
+	}
+	if len(row) == 0 {
+		return errors.New("can't add empty sparse row into payload")
+	}
+	if err := sparse.Validate(row); err != nil {
+		return err
+	}
+	return w.AddOneStringToPayload(string(row))
+}
+
 // dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -413,7 +445,7 @@ func NewPayloadReader(colType schemapb.DataType, buf []byte) (*PayloadReader, er
 	if len(buf) == 0 {
 		return nil, errors.New("create Payload reader failed, buffer is empty")
 	}
-	r := C.NewPayloadReader(C.int(colType), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.long(len(buf)))
+	r := C.NewPayloadReader(C.int(payloadColumnType(colType)), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.long(len(buf)))
 	if r == nil {
 		return nil, errors.New("failed to read parquet from buffer")
 	}
@@ -433,6 +465,9 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 		case schemapb.DataType_String:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_SparseFloatVector:
+			val, err := r.GetOneSparseFloatVectorFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -626,7 +661,22 @@ func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
 	if r.colType != schemapb.DataType_String {
 		return "", errors.New("incorrect data type")
 	}
+	return r.getOneStringFromPayload(idx)
+}
+
+// GetOneSparseFloatVectorFromPayload returns the row of the sparse float vector at idx
+func (r *PayloadReader) GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_SparseFloatVector {
+		return nil, errors.New("incorrect data type")
+	}
+	val, err := r.getOneStringFromPayload(idx)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(val), nil
+}
 
+func (r *PayloadReader) getOneStringFromPayload(idx int) (string, error) {
 	var cStr *C.char
 	var cSize C.int
 
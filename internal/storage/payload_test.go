@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sparse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -345,6 +346,52 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("TestAddOneSparseFloatVector", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_SparseFloatVector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		row0, err := sparse.Encode([]uint32{1, 100}, []float32{0.1, 0.2})
+		require.Nil(t, err)
+		row1, err := sparse.Encode([]uint32{0}, []float32{1})
+		require.Nil(t, err)
+		err = w.AddOneSparseFloatVectorToPayload(row0)
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(row1)
+		assert.Nil(t, err)
+		err = w.AddOneSparseFloatVectorToPayload(nil)
+		assert.NotNil(t, err)
+		err = w.AddOneSparseFloatVectorToPayload(row0[:5])
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_SparseFloatVector, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		got0, err := r.GetOneSparseFloatVectorFromPayload(0)
+		assert.Nil(t, err)
+		assert.Equal(t, row0, got0)
+		igot1, _, err := r.GetDataFromPayload(1)
+		assert.Nil(t, err)
+		assert.Equal(t, row1, igot1.([]byte))
+
+		_, err = r.GetOneStringFromPayload(0)
+		assert.NotNil(t, err)
+
+		err = r.ReleasePayloadReader()
+		assert.Nil(t, err)
+		err = w.ReleasePayloadWriter()
+		assert.Nil(t, err)
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
 		require.Nil(t, err)
@@ -20,6 +20,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/sparse"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
@@ -116,6 +117,19 @@ func printBinlogFile(filename string) error {
 			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
+		case VectorDedupEventType:
+			evd, ok := event.eventData.(*insertEventData)
+			if !ok {
+				return errors.New("incorrect event data type")
+			}
+			fmt.Printf("event %d vector dedup event:\n", eventNum)
+			physical, _ = tsoutil.ParseTS(evd.StartTimestamp)
+			fmt.Printf("\tStartTimestamp: %v\n", physical)
+			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
+			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			if err := printPayloadValues(schemapb.DataType_Int32, event.PayloadReaderInterface); err != nil {
+				return err
+			}
 		case DeleteEventType:
 			evd, ok := event.eventData.(*deleteEventData)
 			if !ok {
@@ -290,6 +304,26 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Println()
 		}
+	case schemapb.DataType_SparseFloatVector:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			row, err := reader.GetOneSparseFloatVectorFromPayload(i)
+			if err != nil {
+				return err
+			}
+			indices, values, err := sparse.Decode(row)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d :", i)
+			for j := range indices {
+				fmt.Printf(" %d:%f", indices[j], values[j])
+			}
+			fmt.Println()
+		}
 	default:
 		return errors.New("undefined data type")
 	}
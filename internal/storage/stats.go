@@ -13,6 +13,11 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 type Int64Stats struct {
@@ -20,6 +25,30 @@ type Int64Stats struct {
 	Min int64 `json:"min"`
 }
 
+// FieldStats is the statistics of a scalar field in a stats binlog. Max and Min are json numbers for the numeric
+// fields, json bools for the bool fields and json strings for the string fields, they are absent if there is no row
+// or a float field has NaN or Inf. The stats of an int64 field can be read as Int64Stats as well
+type FieldStats struct {
+	FieldID   int64             `json:"fieldID"`
+	DataType  schemapb.DataType `json:"dataType"`
+	Max       json.RawMessage   `json:"max,omitempty"`
+	Min       json.RawMessage   `json:"min,omitempty"`
+	NumRows   int64             `json:"numRows"`
+	NullCount int64             `json:"nullCount"` // no field is nullable yet
+	NDV       int64             `json:"ndv"`       // num of distinct values, estimated by the 64 bits hashes of them
+}
+
+// IsStatsSupported tells whether the stats of the fields of the data type are generated
+func IsStatsSupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String:
+		return true
+	default:
+		return false
+	}
+}
+
 type StatsWriter struct {
 	buffer []byte
 }
@@ -47,6 +76,132 @@ func (sw *StatsWriter) StatsInt64(msgs []int64) error {
 	return nil
 }
 
+// StatsField generates the FieldStats of the data of a scalar field
+func (sw *StatsWriter) StatsField(fieldID int64, dataType schemapb.DataType, data FieldData) error {
+	stats := &FieldStats{
+		FieldID:  fieldID,
+		DataType: dataType,
+	}
+	var min, max interface{}
+	hashes := make(map[uint64]struct{})
+
+	switch dataType {
+	case schemapb.DataType_Bool:
+		values := data.(*BoolFieldData).Data
+		for _, v := range values {
+			if min == nil {
+				min, max = v, v
+			}
+			if v {
+				max = true
+				hashes[1] = struct{}{}
+			} else {
+				min = false
+				hashes[0] = struct{}{}
+			}
+		}
+		stats.NumRows = int64(len(values))
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		values := int64Values(data)
+		for i, v := range values {
+			if i == 0 || v < min.(int64) {
+				min = v
+			}
+			if i == 0 || v > max.(int64) {
+				max = v
+			}
+			hashes[uint64(v)] = struct{}{}
+		}
+		stats.NumRows = int64(len(values))
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var values []float64
+		if dataType == schemapb.DataType_Float {
+			for _, v := range data.(*FloatFieldData).Data {
+				values = append(values, float64(v))
+			}
+		} else {
+			values = data.(*DoubleFieldData).Data
+		}
+		finite := true
+		for i, v := range values {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				finite = false
+			} else if finite && (i == 0 || v < min.(float64)) {
+				min = v
+			}
+			if finite && (i == 0 || v > max.(float64)) {
+				max = v
+			}
+			hashes[math.Float64bits(v)] = struct{}{}
+		}
+		if !finite {
+			min, max = nil, nil
+		}
+		stats.NumRows = int64(len(values))
+	case schemapb.DataType_String:
+		values := data.(*StringFieldData).Data
+		h := fnv.New64a()
+		for i, v := range values {
+			if i == 0 || v < min.(string) {
+				min = v
+			}
+			if i == 0 || v > max.(string) {
+				max = v
+			}
+			h.Reset()
+			_, _ = h.Write([]byte(v))
+			hashes[h.Sum64()] = struct{}{}
+		}
+		stats.NumRows = int64(len(values))
+	default:
+		return fmt.Errorf("no stats of data type %s", dataType.String())
+	}
+	stats.NDV = int64(len(hashes))
+
+	if min != nil {
+		var err error
+		if stats.Min, err = json.Marshal(min); err != nil {
+			return err
+		}
+		if stats.Max, err = json.Marshal(max); err != nil {
+			return err
+		}
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+	return nil
+}
+
+func int64Values(data FieldData) []int64 {
+	switch d := data.(type) {
+	case *Int8FieldData:
+		values := make([]int64, 0, len(d.Data))
+		for _, v := range d.Data {
+			values = append(values, int64(v))
+		}
+		return values
+	case *Int16FieldData:
+		values := make([]int64, 0, len(d.Data))
+		for _, v := range d.Data {
+			values = append(values, int64(v))
+		}
+		return values
+	case *Int32FieldData:
+		values := make([]int64, 0, len(d.Data))
+		for _, v := range d.Data {
+			values = append(values, int64(v))
+		}
+		return values
+	case *Int64FieldData:
+		return d.Data
+	default:
+		return nil
+	}
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -55,6 +210,15 @@ func (sr *StatsReader) SetBuffer(buffer []byte) {
 	sr.buffer = buffer
 }
 
+// GetFieldStats reads the FieldStats generated by StatsField
+func (sr *StatsReader) GetFieldStats() (*FieldStats, error) {
+	stats := &FieldStats{}
+	if err := json.Unmarshal(sr.buffer, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func (sr *StatsReader) GetInt64Stats() Int64Stats {
 	stats := Int64Stats{}
 	json.Unmarshal(sr.buffer, &stats)
@@ -12,9 +12,12 @@
 package storage
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestStatsWriter_StatsInt64(t *testing.T) {
@@ -39,3 +42,67 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(msgs)
 	assert.Nil(t, err)
 }
+
+func TestStatsWriter_StatsField(t *testing.T) {
+	sw := &StatsWriter{}
+	err := sw.StatsField(100, schemapb.DataType_Int32, &Int32FieldData{Data: []int32{3, 1, 2, 3, 9}})
+	assert.NoError(t, err)
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), stats.FieldID)
+	assert.Equal(t, schemapb.DataType_Int32, stats.DataType)
+	assert.Equal(t, "9", string(stats.Max))
+	assert.Equal(t, "1", string(stats.Min))
+	assert.Equal(t, int64(5), stats.NumRows)
+	assert.Equal(t, int64(0), stats.NullCount)
+	assert.Equal(t, int64(4), stats.NDV)
+
+	// the stats of int64 fields can be read as Int64Stats
+	err = sw.StatsField(101, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{5, -2, 7}})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	assert.Equal(t, Int64Stats{Max: 7, Min: -2}, sr.GetInt64Stats())
+
+	err = sw.StatsField(102, schemapb.DataType_String, &StringFieldData{Data: []string{"b", "a", "c", "a"}})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, `"c"`, string(stats.Max))
+	assert.Equal(t, `"a"`, string(stats.Min))
+	assert.Equal(t, int64(3), stats.NDV)
+
+	err = sw.StatsField(103, schemapb.DataType_Bool, &BoolFieldData{Data: []bool{true, true}})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, "true", string(stats.Max))
+	assert.Equal(t, "true", string(stats.Min))
+	assert.Equal(t, int64(1), stats.NDV)
+
+	// no min and max of the floats with NaN
+	err = sw.StatsField(104, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{1.5, math.NaN(), -1}})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Nil(t, stats.Max)
+	assert.Nil(t, stats.Min)
+	assert.Equal(t, int64(3), stats.NumRows)
+
+	err = sw.StatsField(105, schemapb.DataType_Float, &FloatFieldData{Data: []float32{1.5, -1}})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5", string(stats.Max))
+	assert.Equal(t, "-1", string(stats.Min))
+
+	err = sw.StatsField(106, schemapb.DataType_FloatVector, &FloatVectorFieldData{})
+	assert.Error(t, err)
+	assert.False(t, IsStatsSupported(schemapb.DataType_FloatVector))
+	assert.True(t, IsStatsSupported(schemapb.DataType_String))
+}
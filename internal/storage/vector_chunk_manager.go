@@ -16,10 +16,30 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"sync"
 
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 )
 
+// cacheRecorder is implemented by the local chunk managers which record the hits and misses of the cache
+type cacheRecorder interface {
+	recordHit(key string)
+	recordMiss(key string)
+}
+
+// vectorDownload is a download of a vector binlog shared by the concurrent reads of the binlog
+type vectorDownload struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// VectorChunkManager reads the vectors of the insert binlogs from the remote storage, and stages the
+// decoded vectors of the binlogs in the local chunk manager if the local cache is enabled, so that the
+// vectors of the retrieve results are not read from the remote storage again
 type VectorChunkManager struct {
 	localChunkManager  ChunkManager
 	remoteChunkManager ChunkManager
@@ -27,6 +47,9 @@ type VectorChunkManager struct {
 	schema *etcdpb.CollectionMeta
 
 	localCacheEnable bool
+
+	mu        sync.Mutex // guards downloads
+	downloads map[string]*vectorDownload
 }
 
 func NewVectorChunkManager(localChunkManager ChunkManager, remoteChunkManager ChunkManager, schema *etcdpb.CollectionMeta, localCacheEnable bool) *VectorChunkManager {
@@ -36,6 +59,8 @@ func NewVectorChunkManager(localChunkManager ChunkManager, remoteChunkManager Ch
 
 		schema:           schema,
 		localCacheEnable: localCacheEnable,
+
+		downloads: make(map[string]*vectorDownload),
 	}
 }
 
@@ -78,6 +103,33 @@ func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
 	return results, nil
 }
 
+// fetchVectorFile downloads the vectors of a binlog, the concurrent fetches of the same binlog share a
+// single download. The vectors are staged in the local cache if it is enabled, a failed write only loses the cache
+func (vcm *VectorChunkManager) fetchVectorFile(key string) ([]byte, error) {
+	vcm.mu.Lock()
+	if download, ok := vcm.downloads[key]; ok {
+		vcm.mu.Unlock()
+		<-download.done
+		return download.data, download.err
+	}
+	download := &vectorDownload{done: make(chan struct{})}
+	vcm.downloads[key] = download
+	vcm.mu.Unlock()
+
+	download.data, download.err = vcm.downloadVectorFile(key)
+	if download.err == nil && vcm.localCacheEnable {
+		if err := vcm.localChunkManager.Write(key, download.data); err != nil {
+			log.Warn("failed to write vector file to local cache", zap.String("key", key), zap.Error(err))
+		}
+	}
+
+	vcm.mu.Lock()
+	delete(vcm.downloads, key)
+	vcm.mu.Unlock()
+	close(download.done)
+	return download.data, download.err
+}
+
 func (vcm *VectorChunkManager) GetPath(key string) (string, error) {
 	if vcm.localChunkManager.Exist(key) && vcm.localCacheEnable {
 		return vcm.localChunkManager.GetPath(key)
@@ -99,41 +151,49 @@ func (vcm *VectorChunkManager) Exist(key string) bool {
 func (vcm *VectorChunkManager) Read(key string) ([]byte, error) {
 	if vcm.localCacheEnable {
 		if vcm.localChunkManager.Exist(key) {
-			return vcm.localChunkManager.Read(key)
-		}
-		bytes, err := vcm.downloadVectorFile(key)
-		if err != nil {
-			return nil, err
-		}
-		err = vcm.localChunkManager.Write(key, bytes)
-		if err != nil {
-			return nil, err
+			bytes, err := vcm.localChunkManager.Read(key)
+			if err == nil {
+				vcm.recordHit(key)
+				return bytes, nil
+			}
 		}
-		return vcm.localChunkManager.Read(key)
+		vcm.recordMiss(key)
 	}
-	return vcm.downloadVectorFile(key)
+	// the file may have been evicted right after written, so the downloaded data is returned
+	return vcm.fetchVectorFile(key)
 }
 
 func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	if vcm.localCacheEnable {
 		if vcm.localChunkManager.Exist(key) {
-			return vcm.localChunkManager.ReadAt(key, p, off)
-		}
-		bytes, err := vcm.downloadVectorFile(key)
-		if err != nil {
-			return -1, err
-		}
-		err = vcm.localChunkManager.Write(key, bytes)
-		if err != nil {
-			return -1, err
+			n, err := vcm.localChunkManager.ReadAt(key, p, off)
+			if err == nil || err == io.EOF {
+				vcm.recordHit(key)
+				return n, err
+			}
 		}
-		return vcm.localChunkManager.ReadAt(key, p, off)
+		vcm.recordMiss(key)
 	}
-	bytes, err := vcm.downloadVectorFile(key)
+	bytes, err := vcm.fetchVectorFile(key)
 	if err != nil {
 		return -1, err
 	}
+	return readBytesAt(bytes, p, off)
+}
+
+func (vcm *VectorChunkManager) recordHit(key string) {
+	if recorder, ok := vcm.localChunkManager.(cacheRecorder); ok {
+		recorder.recordHit(key)
+	}
+}
+
+func (vcm *VectorChunkManager) recordMiss(key string) {
+	if recorder, ok := vcm.localChunkManager.(cacheRecorder); ok {
+		recorder.recordMiss(key)
+	}
+}
 
+func readBytesAt(bytes []byte, p []byte, off int64) (int, error) {
 	if bytes == nil {
 		return 0, errors.New("vectorChunkManager: data downloaded is nil")
 	}
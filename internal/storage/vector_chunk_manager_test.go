@@ -16,7 +16,9 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -265,3 +267,64 @@ func TestVectorChunkManager_Read(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, -1, byteLen)
 }
+
+type countingChunkManager struct {
+	ChunkManager
+	mu    sync.Mutex
+	reads int
+	gate  chan struct{}
+}
+
+func (cm *countingChunkManager) Read(key string) ([]byte, error) {
+	cm.mu.Lock()
+	cm.reads++
+	cm.mu.Unlock()
+	<-cm.gate
+	return cm.ChunkManager.Read(key)
+}
+
+func TestVectorChunkManager_SharedDownload(t *testing.T) {
+	meta := initMeta()
+	lcm := NewLocalChunkManager(path.Join(localPath, "shared_download"))
+	rcm := &countingChunkManager{
+		ChunkManager: NewLocalChunkManager(path.Join(localPath, "shared_download_remote")),
+		gate:         make(chan struct{}),
+	}
+	for _, binlog := range initBinlogFile(meta) {
+		err := rcm.ChunkManager.Write(binlog.Key, binlog.Value)
+		assert.Nil(t, err)
+	}
+	vcm := NewVectorChunkManager(lcm, rcm, meta, true)
+
+	var wg sync.WaitGroup
+	readAt := func() {
+		defer wg.Done()
+		content := make([]byte, 1)
+		n, err := vcm.ReadAt("108", content, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []byte{255}, content)
+	}
+	wg.Add(1)
+	go readAt()
+	// the other reads wait for the download blocked in the remote read
+	assert.Eventually(t, func() bool {
+		rcm.mu.Lock()
+		defer rcm.mu.Unlock()
+		return rcm.reads == 1
+	}, time.Second, time.Millisecond)
+	for i := 0; i < 7; i++ {
+		wg.Add(1)
+		go readAt()
+	}
+	close(rcm.gate)
+	wg.Wait()
+	assert.Equal(t, 1, rcm.reads)
+
+	// staged in the local cache
+	content, err := vcm.Read("108")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 255}, content)
+	assert.Equal(t, 1, rcm.reads)
+	assert.True(t, lcm.Exist("108"))
+}
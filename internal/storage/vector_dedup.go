@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// VectorDedupKey is the type param of a vector field which enables the deduplication of the identical
+// vectors in its insert binlogs, e.g. {"key": "dedup", "value": "true"}
+const VectorDedupKey = "dedup"
+
+// isVectorDedupEnabled returns whether the identical vectors of the field are stored only once
+func isVectorDedupEnabled(field *schemapb.FieldSchema) bool {
+	if field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+		return false
+	}
+	for _, kv := range field.TypeParams {
+		if kv.Key == VectorDedupKey {
+			return kv.Value == "true"
+		}
+	}
+	return false
+}
+
+// dedupRows returns the offsets of the unique rows and the index of every row into the unique rows,
+// rowKey returns the content of the row i. The indexes are nil if there is no duplicate
+func dedupRows(rows int, rowKey func(i int) string) ([]int, []int32) {
+	uniqueRows := make([]int, 0, rows)
+	indexes := make([]int32, rows)
+	seen := make(map[string]int32, rows)
+	for i := 0; i < rows; i++ {
+		key := rowKey(i)
+		idx, ok := seen[key]
+		if !ok {
+			idx = int32(len(uniqueRows))
+			seen[key] = idx
+			uniqueRows = append(uniqueRows, i)
+		}
+		indexes[i] = idx
+	}
+	if len(uniqueRows) == rows {
+		return uniqueRows, nil
+	}
+	return uniqueRows, indexes
+}
+
+// dedupFloatVectors returns the unique vectors and the index of every vector into them,
+// the indexes are nil if there is no duplicate
+func dedupFloatVectors(data []float32, dim int) ([]float32, []int32) {
+	if dim <= 0 || len(data) == 0 {
+		return data, nil
+	}
+	buf := make([]byte, 4*dim)
+	uniqueRows, indexes := dedupRows(len(data)/dim, func(i int) string {
+		for j, v := range data[i*dim : (i+1)*dim] {
+			binary.LittleEndian.PutUint32(buf[4*j:], math.Float32bits(v))
+		}
+		return string(buf)
+	})
+	if indexes == nil {
+		return data, nil
+	}
+	unique := make([]float32, 0, len(uniqueRows)*dim)
+	for _, row := range uniqueRows {
+		unique = append(unique, data[row*dim:(row+1)*dim]...)
+	}
+	return unique, indexes
+}
+
+// dedupBinaryVectors returns the unique vectors and the index of every vector into them,
+// the indexes are nil if there is no duplicate
+func dedupBinaryVectors(data []byte, dim int) ([]byte, []int32) {
+	rowSize := dim / 8
+	if rowSize <= 0 || len(data) == 0 {
+		return data, nil
+	}
+	uniqueRows, indexes := dedupRows(len(data)/rowSize, func(i int) string {
+		return string(data[i*rowSize : (i+1)*rowSize])
+	})
+	if indexes == nil {
+		return data, nil
+	}
+	unique := make([]byte, 0, len(uniqueRows)*rowSize)
+	for _, row := range uniqueRows {
+		unique = append(unique, data[row*rowSize:(row+1)*rowSize]...)
+	}
+	return unique, indexes
+}
+
+// addVectorDedupEvent appends the event of the row indexes into the unique vectors of the last insert event
+func addVectorDedupEvent(writer *InsertBinlogWriter, indexes []int32, startTs, endTs int64) error {
+	eventWriter, err := writer.NextVectorDedupEventWriter()
+	if err != nil {
+		return err
+	}
+	eventWriter.SetEventTimestamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
+	return eventWriter.AddInt32ToPayload(indexes)
+}
+
+// reconstructDedupVectors replaces the unique vectors read from the last insert event of the field
+// with the vectors of all the rows
+func reconstructDedupVectors(fieldData FieldData, indexes []int32) error {
+	switch data := fieldData.(type) {
+	case *FloatVectorFieldData:
+		if len(data.NumRows) == 0 {
+			return fmt.Errorf("vector dedup event without vectors")
+		}
+		uniqueRows := int(data.NumRows[len(data.NumRows)-1])
+		offset := len(data.Data) - uniqueRows*data.Dim
+		unique := data.Data[offset:]
+		vectors := make([]float32, 0, len(indexes)*data.Dim)
+		for _, idx := range indexes {
+			if idx < 0 || int(idx) >= uniqueRows {
+				return fmt.Errorf("vector dedup index %d out of range %d", idx, uniqueRows)
+			}
+			vectors = append(vectors, unique[int(idx)*data.Dim:(int(idx)+1)*data.Dim]...)
+		}
+		data.Data = append(data.Data[:offset], vectors...)
+		data.NumRows[len(data.NumRows)-1] = int64(len(indexes))
+	case *BinaryVectorFieldData:
+		if len(data.NumRows) == 0 {
+			return fmt.Errorf("vector dedup event without vectors")
+		}
+		rowSize := data.Dim / 8
+		uniqueRows := int(data.NumRows[len(data.NumRows)-1])
+		offset := len(data.Data) - uniqueRows*rowSize
+		unique := data.Data[offset:]
+		vectors := make([]byte, 0, len(indexes)*rowSize)
+		for _, idx := range indexes {
+			if idx < 0 || int(idx) >= uniqueRows {
+				return fmt.Errorf("vector dedup index %d out of range %d", idx, uniqueRows)
+			}
+			vectors = append(vectors, unique[int(idx)*rowSize:(int(idx)+1)*rowSize]...)
+		}
+		data.Data = append(data.Data[:offset], vectors...)
+		data.NumRows[len(data.NumRows)-1] = int64(len(indexes))
+	default:
+		return fmt.Errorf("vector dedup is not supported for %T", fieldData)
+	}
+	return nil
+}
@@ -63,6 +63,13 @@ type DataCoord interface {
 	GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)
 	SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)
 	GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+	ListSegments(ctx context.Context, req *datapb.ListSegmentsRequest) (*datapb.ListSegmentsResponse, error)
+	Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error)
+	GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error)
+	ClusteringCompact(ctx context.Context, req *datapb.ClusteringCompactRequest) (*commonpb.Status, error)
+	GetDeleteStats(ctx context.Context, req *datapb.GetDeleteStatsRequest) (*datapb.GetDeleteStatsResponse, error)
+	PurgeDeletes(ctx context.Context, req *datapb.PurgeDeletesRequest) (*commonpb.Status, error)
+	PurgeCollection(ctx context.Context, req *datapb.PurgeCollectionRequest) (*commonpb.Status, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -73,6 +80,8 @@ type IndexNode interface {
 
 	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	// GetTaskSlots returns the resources of the node for the index builds
+	GetTaskSlots(ctx context.Context, req *indexpb.GetTaskSlotsRequest) (*indexpb.GetTaskSlotsResponse, error)
 }
 
 type IndexCoord interface {
@@ -84,6 +93,15 @@ type IndexCoord interface {
 	GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error)
 	GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error)
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// PauseIndexBuild holds back the index builds of the collection until ResumeIndexBuild is called
+	PauseIndexBuild(ctx context.Context, req *indexpb.PauseIndexBuildRequest) (*commonpb.Status, error)
+	ResumeIndexBuild(ctx context.Context, req *indexpb.ResumeIndexBuildRequest) (*commonpb.Status, error)
+	// SetIndexBuildPriority sets the priority of the index builds of the collection, the builds of higher
+	// priorities are assigned first
+	SetIndexBuildPriority(ctx context.Context, req *indexpb.SetIndexBuildPriorityRequest) (*commonpb.Status, error)
+	// GetIndexStatistics returns the sizes of the index files of the collection by the index
+	GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
 }
 
 type RootCoord interface {
@@ -96,6 +114,7 @@ type RootCoord interface {
 	HasCollection(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
 	DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
 	ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
@@ -117,6 +136,21 @@ type RootCoord interface {
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
 	SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error)
 
+	//api key
+	CreateApiKey(ctx context.Context, req *milvuspb.CreateApiKeyRequest) (*milvuspb.ApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, req *milvuspb.RotateApiKeyRequest) (*milvuspb.ApiKeyResponse, error)
+	DropApiKey(ctx context.Context, req *milvuspb.DropApiKeyRequest) (*commonpb.Status, error)
+	// ListApiKeys returns the hashes of the api keys
+	ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error)
+
+	// GetQuotaUsage returns the usages of the collection, partition and field quotas
+	GetQuotaUsage(ctx context.Context, req *milvuspb.GetQuotaUsageRequest) (*milvuspb.GetQuotaUsageResponse, error)
+
+	// UndropCollection restores a dropped collection before it's purged
+	UndropCollection(ctx context.Context, req *milvuspb.UndropCollectionRequest) (*commonpb.Status, error)
+	// ListDroppedCollections lists the dropped collections not purged yet
+	ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
+
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
 
@@ -138,6 +172,7 @@ type Proxy interface {
 
 	InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+	InvalidateSegmentDistribution(ctx context.Context, request *proxypb.InvalidateSegmentDistributionRequest) (*commonpb.Status, error)
 
 	//TODO: move to milvus service
 	/*
@@ -186,6 +221,8 @@ type QueryNode interface {
 	ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	// RefreshIndex loads the index swapped in on the field for the sealed segments of the collection
+	RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -203,6 +240,9 @@ type QueryCoord interface {
 	CreateQueryChannel(ctx context.Context, req *querypb.CreateQueryChannelRequest) (*querypb.CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	GetSegmentDistribution(ctx context.Context, req *querypb.GetSegmentDistributionRequest) (*querypb.GetSegmentDistributionResponse, error)
+	// RefreshIndex notifies the query nodes holding the segments of the collection to load the index swapped in on the field
+	RefreshIndex(ctx context.Context, req *querypb.RefreshIndexRequest) (*commonpb.Status, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
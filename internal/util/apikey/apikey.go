@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package apikey generates and hashes the long-lived api keys of the clients, the keys are created in RootCoord,
+// which only stores their hashes, and validated by the proxies.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PrivilegeDML allows the rpcs writing data, such as insert, delete and flush
+	PrivilegeDML = "dml"
+	// PrivilegeDQL allows the rpcs reading data, such as search and query
+	PrivilegeDQL = "dql"
+	// PrivilegeAdmin allows the other rpcs of the milvus service, such as ddl, index, load and the api keys
+	PrivilegeAdmin = "admin"
+
+	// keyPrefix tells the api keys from the other credentials, such as the bearer tokens
+	keyPrefix     = "mvk_"
+	keyBytes      = 32
+	maxNameLength = 255
+)
+
+// Privileges are all the privileges which can be bound to an api key
+var Privileges = []string{PrivilegeDML, PrivilegeDQL, PrivilegeAdmin}
+
+// Generate returns a new random api key
+func Generate() (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return keyPrefix + hex.EncodeToString(b), nil
+}
+
+// IsKey tells whether the credential looks like an api key
+func IsKey(credential string) bool {
+	return strings.HasPrefix(credential, keyPrefix)
+}
+
+// Hash returns the hash of the key, which is stored instead of the key
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckName checks the name of an api key, which is made of letters, digits, underscores and dashes
+func CheckName(name string) error {
+	if name == "" {
+		return fmt.Errorf("the name of an api key should not be empty")
+	}
+	if len(name) > maxNameLength {
+		return fmt.Errorf("the length of the name of an api key should be limit to %d", maxNameLength)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return fmt.Errorf("the name of an api key can only contain letters, digits, underscores and dashes, got %s", name)
+		}
+	}
+	return nil
+}
+
+// NormalizePrivileges checks the privileges and removes the duplicated ones, all the privileges are returned if
+// privileges is empty
+func NormalizePrivileges(privileges []string) ([]string, error) {
+	if len(privileges) == 0 {
+		return append([]string{}, Privileges...), nil
+	}
+	requested := make(map[string]bool)
+	for _, p := range privileges {
+		requested[p] = true
+	}
+	normalized := make([]string, 0, len(Privileges))
+	for _, p := range Privileges {
+		if requested[p] {
+			normalized = append(normalized, p)
+			delete(requested, p)
+		}
+	}
+	for p := range requested {
+		return nil, fmt.Errorf("unknown api key privilege %s, should be one of %s", p, strings.Join(Privileges, ", "))
+	}
+	return normalized, nil
+}
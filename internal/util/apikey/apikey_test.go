@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package apikey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	key1, err := Generate()
+	assert.Nil(t, err)
+	key2, err := Generate()
+	assert.Nil(t, err)
+	assert.NotEqual(t, key1, key2)
+	assert.True(t, IsKey(key1))
+	assert.False(t, IsKey("eyJhbGciOiJIUzI1NiJ9.e30.sig"))
+
+	assert.Equal(t, Hash(key1), Hash(key1))
+	assert.NotEqual(t, Hash(key1), Hash(key2))
+	assert.NotContains(t, Hash(key1), key1)
+}
+
+func TestCheckName(t *testing.T) {
+	assert.Nil(t, CheckName("ingest-job_1"))
+	assert.NotNil(t, CheckName(""))
+	assert.NotNil(t, CheckName("ingest job"))
+	assert.NotNil(t, CheckName("ingest/job"))
+	assert.NotNil(t, CheckName(strings.Repeat("a", maxNameLength+1)))
+}
+
+func TestNormalizePrivileges(t *testing.T) {
+	privileges, err := NormalizePrivileges(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, Privileges, privileges)
+
+	privileges, err = NormalizePrivileges([]string{PrivilegeDQL, PrivilegeDML, PrivilegeDQL})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{PrivilegeDML, PrivilegeDQL}, privileges)
+
+	_, err = NormalizePrivileges([]string{PrivilegeDQL, "root"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package cgoerror translates the failed statuses returned by the C++ core into structured errors,
+// which carry the failed operation, the error class and the context of the segment, field and parameters.
+package cgoerror
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// UnknownClass is the class of the error codes which are not defined in common.proto
+const UnknownClass = "UnknownError"
+
+// Error is a failed call to the C++ core
+type Error struct {
+	// Op is the name of the failed C function
+	Op string
+	// Code is the error code returned by the C function
+	Code commonpb.ErrorCode
+	// Message is the error message returned by the C function
+	Message string
+
+	// CollectionID, SegmentID and FieldID are 0 if not known
+	CollectionID int64
+	SegmentID    int64
+	FieldID      int64
+	// Params is the context of the call, such as the index params
+	Params map[string]string
+}
+
+// New returns the error of a failed C function by its code and message
+func New(op string, code int32, msg string) *Error {
+	return &Error{
+		Op:      op,
+		Code:    commonpb.ErrorCode(code),
+		Message: msg,
+	}
+}
+
+// Newf returns an error raised before calling the C function, for the arguments which would crash the C++ core
+func Newf(op string, code commonpb.ErrorCode, format string, a ...interface{}) *Error {
+	return &Error{
+		Op:      op,
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// Class returns the name of the error code
+func (e *Error) Class() string {
+	name, ok := commonpb.ErrorCode_name[int32(e.Code)]
+	if !ok {
+		return UnknownClass
+	}
+	return name
+}
+
+func (e *Error) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s failed: [%s] %s", e.Op, e.Class(), e.Message)
+
+	context := make([]string, 0, len(e.Params)+3)
+	if e.CollectionID != 0 {
+		context = append(context, fmt.Sprintf("collectionID=%d", e.CollectionID))
+	}
+	if e.SegmentID != 0 {
+		context = append(context, fmt.Sprintf("segmentID=%d", e.SegmentID))
+	}
+	if e.FieldID != 0 {
+		context = append(context, fmt.Sprintf("fieldID=%d", e.FieldID))
+	}
+	keys := make([]string, 0, len(e.Params))
+	for k := range e.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		context = append(context, fmt.Sprintf("%s=%s", k, e.Params[k]))
+	}
+	if len(context) > 0 {
+		fmt.Fprintf(&sb, " (%s)", strings.Join(context, ", "))
+	}
+	return sb.String()
+}
+
+// WithCollection sets the collection of the error
+func (e *Error) WithCollection(collectionID int64) *Error {
+	e.CollectionID = collectionID
+	return e
+}
+
+// WithSegment sets the segment of the error
+func (e *Error) WithSegment(segmentID int64) *Error {
+	e.SegmentID = segmentID
+	return e
+}
+
+// WithField sets the field of the error
+func (e *Error) WithField(fieldID int64) *Error {
+	e.FieldID = fieldID
+	return e
+}
+
+// WithParam adds a parameter to the context of the error
+func (e *Error) WithParam(key, value string) *Error {
+	if e.Params == nil {
+		e.Params = make(map[string]string)
+	}
+	e.Params[key] = value
+	return e
+}
+
+// WithParams adds the parameters to the context of the error
+func (e *Error) WithParams(params map[string]string) *Error {
+	for k, v := range params {
+		e.WithParam(k, v)
+	}
+	return e
+}
+
+// As returns the Error in the chain of err
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// ErrorCode returns the error code of err to report in a status,
+// which is UnexpectedError if err is not returned by the C++ core
+func ErrorCode(err error) commonpb.ErrorCode {
+	if e, ok := As(err); ok {
+		if _, defined := commonpb.ErrorCode_name[int32(e.Code)]; defined && e.Code != commonpb.ErrorCode_Success {
+			return e.Code
+		}
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package cgoerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestError(t *testing.T) {
+	err := New("Search", 5, "invalid search params")
+	assert.Equal(t, "IllegalArgument", err.Class())
+	assert.Equal(t, "Search failed: [IllegalArgument] invalid search params", err.Error())
+
+	err.WithCollection(1).WithSegment(100).WithField(101).WithParams(map[string]string{"nprobe": "0", "metric_type": "L2"})
+	assert.Equal(t, "Search failed: [IllegalArgument] invalid search params (collectionID=1, segmentID=100, fieldID=101, metric_type=L2, nprobe=0)", err.Error())
+
+	err = New("LoadIndex", 10000, "corrupted")
+	assert.Equal(t, UnknownClass, err.Class())
+	assert.Equal(t, "LoadIndex failed: [UnknownError] corrupted", err.Error())
+
+	err = Newf("Insert", commonpb.ErrorCode_IllegalArgument, "%d rows but %d timestamps", 2, 1)
+	assert.Equal(t, "Insert failed: [IllegalArgument] 2 rows but 1 timestamps", err.Error())
+}
+
+func TestErrorCode(t *testing.T) {
+	wrapped := fmt.Errorf("search collection 1 failed: %w", New("Search", 5, "invalid").WithSegment(2))
+	e, ok := As(wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), e.SegmentID)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, ErrorCode(wrapped))
+
+	_, ok = As(errors.New("not a core error"))
+	assert.False(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, ErrorCode(errors.New("not a core error")))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, ErrorCode(New("LoadIndex", 10000, "corrupted")))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, ErrorCode(New("LoadIndex", 0, "")))
+}
@@ -0,0 +1,348 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const (
+	// Path is the path of the management UI
+	Path = "/console/"
+	// APIPath is the prefix of the management APIs, all of them are read only and return json:
+	//   collections                the collections with their load percentages
+	//   collections/{name}         the schema, the persistent segments and the loaded segments of a collection
+	//   topology                   the cluster topology with the states of the components and the dependencies
+	//   system_info                the system info metrics of all the components
+	//   tasks                      the tasks queued or running on proxy
+	APIPath = "/console/api/"
+
+	requestTimeout = 10 * time.Second
+)
+
+var errNoService = errors.New("proxy is not registered to the console")
+
+// Service is the part of the milvus service used by the console, which is served by proxy
+type Service interface {
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	GetPersistentSegmentInfo(ctx context.Context, request *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error)
+	GetQuerySegmentInfo(ctx context.Context, request *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error)
+	GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+}
+
+// Collection is a collection in the console
+type Collection struct {
+	Name         string `json:"name"`
+	ID           int64  `json:"id"`
+	CreatedTime  string `json:"created_time"`
+	Loaded       bool   `json:"loaded"`
+	InMemoryRate int64  `json:"in_memory_percentage"`
+}
+
+// Field is a field of the schema of a collection
+type Field struct {
+	ID           int64             `json:"id"`
+	Name         string            `json:"name"`
+	DataType     string            `json:"data_type"`
+	IsPrimaryKey bool              `json:"is_primary_key"`
+	AutoID       bool              `json:"auto_id"`
+	TypeParams   map[string]string `json:"type_params,omitempty"`
+}
+
+// Segment is a persistent segment of a collection
+type Segment struct {
+	ID          int64  `json:"id"`
+	PartitionID int64  `json:"partition_id"`
+	NumRows     int64  `json:"num_rows"`
+	State       string `json:"state"`
+}
+
+// LoadedSegment is a segment loaded by the query nodes
+type LoadedSegment struct {
+	ID          int64  `json:"id"`
+	PartitionID int64  `json:"partition_id"`
+	NumRows     int64  `json:"num_rows"`
+	MemSize     int64  `json:"mem_size"`
+	IndexName   string `json:"index_name,omitempty"`
+}
+
+// CollectionDetail is the detail of a collection in the console
+type CollectionDetail struct {
+	Collection
+	ShardsNum      int32            `json:"shards_num"`
+	VChannels      []string         `json:"virtual_channels"`
+	Fields         []*Field         `json:"fields"`
+	Segments       []*Segment       `json:"segments"`
+	LoadedSegments []*LoadedSegment `json:"loaded_segments"`
+}
+
+// Console serves the management UI and APIs on top of the milvus service of proxy
+type Console struct {
+	mu      sync.RWMutex
+	service Service
+}
+
+var defaultConsole = NewConsole()
+
+// NewConsole returns a Console without service, the APIs fail until a service is registered
+func NewConsole() *Console {
+	return &Console{}
+}
+
+// Register sets the service of the default console
+func Register(service Service) {
+	defaultConsole.Register(service)
+}
+
+// Handle serves the default console on mux
+func Handle(mux *http.ServeMux) {
+	defaultConsole.Handle(mux)
+}
+
+// Register sets the service of the console
+func (c *Console) Register(service Service) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.service = service
+}
+
+func (c *Console) getService() Service {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.service
+}
+
+// Handle serves the console on mux
+func (c *Console) Handle(mux *http.ServeMux) {
+	mux.HandleFunc(Path, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != Path {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if _, err := w.Write([]byte(indexHTML)); err != nil {
+			log.Warn("failed to write the console page", zap.Error(err))
+		}
+	})
+	mux.HandleFunc(APIPath, c.serveAPI)
+}
+
+func (c *Console) serveAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	service := c.getService()
+	if service == nil {
+		http.Error(w, errNoService.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	api := strings.TrimPrefix(r.URL.Path, APIPath)
+	var resp interface{}
+	var err error
+	switch {
+	case api == "collections":
+		resp, err = listCollections(ctx, service)
+	case strings.HasPrefix(api, "collections/") && len(api) > len("collections/"):
+		resp, err = describeCollection(ctx, service, strings.TrimPrefix(api, "collections/"))
+	case api == "topology":
+		resp, err = getMetrics(ctx, service, metricsinfo.SystemTopologyMetrics)
+	case api == "system_info":
+		resp, err = getMetrics(ctx, service, metricsinfo.SystemInfoMetrics)
+	case api == "tasks":
+		resp, err = getMetrics(ctx, service, metricsinfo.ProxyTasksMetrics)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warn("failed to write the response of console", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
+
+func statusError(status *commonpb.Status) error {
+	if status == nil {
+		return errors.New("status is not reported")
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
+
+func formatTimestamp(utc uint64) string {
+	if utc == 0 {
+		return ""
+	}
+	return time.Unix(int64(utc), 0).UTC().Format(time.RFC3339)
+}
+
+func listCollections(ctx context.Context, service Service) ([]*Collection, error) {
+	all, err := service.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{Type: milvuspb.ShowType_All})
+	if err == nil {
+		err = statusError(all.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*Collection, 0, len(all.CollectionNames))
+	byName := make(map[string]*Collection, len(all.CollectionNames))
+	for i, name := range all.CollectionNames {
+		collection := &Collection{Name: name}
+		if i < len(all.CollectionIds) {
+			collection.ID = all.CollectionIds[i]
+		}
+		if i < len(all.CreatedUtcTimestamps) {
+			collection.CreatedTime = formatTimestamp(all.CreatedUtcTimestamps[i])
+		}
+		collections = append(collections, collection)
+		byName[name] = collection
+	}
+
+	// the collections not loaded are not in the response of query coord
+	loaded, err := service.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{Type: milvuspb.ShowType_InMemory})
+	if err == nil && statusError(loaded.Status) == nil {
+		for i, name := range loaded.CollectionNames {
+			collection, ok := byName[name]
+			if !ok {
+				continue
+			}
+			collection.Loaded = true
+			if i < len(loaded.InMemoryPercentages) {
+				collection.InMemoryRate = loaded.InMemoryPercentages[i]
+			}
+		}
+	}
+	return collections, nil
+}
+
+func describeCollection(ctx context.Context, service Service, name string) (*CollectionDetail, error) {
+	collections, err := listCollections(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	detail := &CollectionDetail{}
+	for _, collection := range collections {
+		if collection.Name == name {
+			detail.Collection = *collection
+		}
+	}
+
+	desc, err := service.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: name})
+	if err == nil {
+		err = statusError(desc.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	detail.Name = name
+	detail.ID = desc.CollectionID
+	detail.ShardsNum = desc.ShardsNum
+	detail.VChannels = desc.VirtualChannelNames
+	detail.Fields = make([]*Field, 0)
+	for _, field := range desc.GetSchema().GetFields() {
+		f := &Field{
+			ID:           field.FieldID,
+			Name:         field.Name,
+			DataType:     field.DataType.String(),
+			IsPrimaryKey: field.IsPrimaryKey,
+			AutoID:       field.AutoID,
+		}
+		if len(field.TypeParams) > 0 {
+			f.TypeParams = make(map[string]string, len(field.TypeParams))
+			for _, kv := range field.TypeParams {
+				f.TypeParams[kv.Key] = kv.Value
+			}
+		}
+		detail.Fields = append(detail.Fields, f)
+	}
+
+	segments, err := service.GetPersistentSegmentInfo(ctx, &milvuspb.GetPersistentSegmentInfoRequest{CollectionName: name})
+	if err == nil {
+		err = statusError(segments.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	detail.Segments = make([]*Segment, 0, len(segments.Infos))
+	for _, info := range segments.Infos {
+		detail.Segments = append(detail.Segments, &Segment{
+			ID:          info.SegmentID,
+			PartitionID: info.PartitionID,
+			NumRows:     info.NumRows,
+			State:       info.State.String(),
+		})
+	}
+
+	detail.LoadedSegments = make([]*LoadedSegment, 0)
+	if !detail.Loaded {
+		return detail, nil
+	}
+	loaded, err := service.GetQuerySegmentInfo(ctx, &milvuspb.GetQuerySegmentInfoRequest{CollectionName: name})
+	if err == nil {
+		err = statusError(loaded.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range loaded.Infos {
+		detail.LoadedSegments = append(detail.LoadedSegments, &LoadedSegment{
+			ID:          info.SegmentID,
+			PartitionID: info.PartitionID,
+			NumRows:     info.NumRows,
+			MemSize:     info.MemSize,
+			IndexName:   info.IndexName,
+		})
+	}
+	return detail, nil
+}
+
+// getMetrics returns the decoded response of a metric type of proxy
+func getMetrics(ctx context.Context, service Service, metricType string) (interface{}, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricType)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := service.GetMetrics(ctx, req)
+	if err == nil {
+		err = statusError(resp.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(resp.Response), nil
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+type mockService struct {
+	metricsErr bool
+}
+
+func success() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func (m *mockService) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	if request.Type == milvuspb.ShowType_InMemory {
+		return &milvuspb.ShowCollectionsResponse{
+			Status:              success(),
+			CollectionNames:     []string{"c1"},
+			CollectionIds:       []int64{1},
+			InMemoryPercentages: []int64{100},
+		}, nil
+	}
+	return &milvuspb.ShowCollectionsResponse{
+		Status:               success(),
+		CollectionNames:      []string{"c1", "c2"},
+		CollectionIds:        []int64{1, 2},
+		CreatedUtcTimestamps: []uint64{1600000000, 0},
+	}, nil
+}
+
+func (m *mockService) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	if request.CollectionName != "c1" && request.CollectionName != "c2" {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "collection not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:       success(),
+		CollectionID: 1,
+		ShardsNum:    2,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			},
+		},
+	}, nil
+}
+
+func (m *mockService) GetPersistentSegmentInfo(ctx context.Context, request *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error) {
+	return &milvuspb.GetPersistentSegmentInfoResponse{
+		Status: success(),
+		Infos: []*milvuspb.PersistentSegmentInfo{
+			{SegmentID: 10, PartitionID: 3, NumRows: 100, State: commonpb.SegmentState_Flushed},
+		},
+	}, nil
+}
+
+func (m *mockService) GetQuerySegmentInfo(ctx context.Context, request *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
+	return &milvuspb.GetQuerySegmentInfoResponse{
+		Status: success(),
+		Infos: []*milvuspb.QuerySegmentInfo{
+			{SegmentID: 10, PartitionID: 3, NumRows: 100, MemSize: 4096, IndexName: "_default_idx"},
+		},
+	}, nil
+}
+
+func (m *mockService) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	if m.metricsErr {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "metrics error"},
+		}, nil
+	}
+	metricType, err := metricsinfo.ParseMetricType(request.Request)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:   success(),
+		Response: `{"metric_type":"` + metricType + `"}`,
+	}, nil
+}
+
+func get(mux *http.ServeMux, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestConsole(t *testing.T) {
+	c := NewConsole()
+	mux := http.NewServeMux()
+	c.Handle(mux)
+
+	w := get(mux, APIPath+"collections")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = get(mux, Path)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Milvus Console")
+	w = get(mux, Path+"unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	service := &mockService{}
+	c.Register(service)
+
+	t.Run("collections", func(t *testing.T) {
+		w := get(mux, APIPath+"collections")
+		assert.Equal(t, http.StatusOK, w.Code)
+		var collections []*Collection
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &collections))
+		assert.Equal(t, []*Collection{
+			{Name: "c1", ID: 1, CreatedTime: "2020-09-13T12:26:40Z", Loaded: true, InMemoryRate: 100},
+			{Name: "c2", ID: 2},
+		}, collections)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		w := get(mux, APIPath+"collections/c1")
+		assert.Equal(t, http.StatusOK, w.Code)
+		detail := &CollectionDetail{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), detail))
+		assert.True(t, detail.Loaded)
+		assert.Equal(t, int32(2), detail.ShardsNum)
+		assert.Equal(t, 2, len(detail.Fields))
+		assert.Equal(t, "Int64", detail.Fields[0].DataType)
+		assert.True(t, detail.Fields[0].IsPrimaryKey)
+		assert.Equal(t, map[string]string{"dim": "8"}, detail.Fields[1].TypeParams)
+		assert.Equal(t, []*Segment{{ID: 10, PartitionID: 3, NumRows: 100, State: "Flushed"}}, detail.Segments)
+		assert.Equal(t, []*LoadedSegment{{ID: 10, PartitionID: 3, NumRows: 100, MemSize: 4096, IndexName: "_default_idx"}}, detail.LoadedSegments)
+
+		// the segments of a collection not loaded are not queried
+		w = get(mux, APIPath+"collections/c2")
+		assert.Equal(t, http.StatusOK, w.Code)
+		detail = &CollectionDetail{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), detail))
+		assert.False(t, detail.Loaded)
+		assert.Equal(t, 0, len(detail.LoadedSegments))
+
+		w = get(mux, APIPath+"collections/c3")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "collection not found")
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		for api, metricType := range map[string]string{
+			"topology":    metricsinfo.SystemTopologyMetrics,
+			"system_info": metricsinfo.SystemInfoMetrics,
+			"tasks":       metricsinfo.ProxyTasksMetrics,
+		} {
+			w := get(mux, APIPath+api)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.JSONEq(t, `{"metric_type":"`+metricType+`"}`, w.Body.String())
+		}
+
+		service.metricsErr = true
+		w := get(mux, APIPath+"topology")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "metrics error")
+		service.metricsErr = false
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		w := get(mux, APIPath+"unknown")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		w = get(mux, APIPath+"collections/")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, APIPath+"collections", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
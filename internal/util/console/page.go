@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package console
+
+// indexHTML is the management UI, it only renders the json of the APIs
+const indexHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Milvus Console</title>
+<style>
+body { font-family: sans-serif; margin: 20px; }
+nav a { margin-right: 16px; cursor: pointer; color: #0366d6; }
+table { border-collapse: collapse; margin-top: 12px; }
+th, td { border: 1px solid #ddd; padding: 4px 8px; text-align: left; }
+pre { background: #f6f8fa; padding: 12px; overflow: auto; }
+.Healthy { color: green; } .Abnormal { color: orange; } .Unreachable { color: red; }
+</style>
+</head>
+<body>
+<h2>Milvus Console</h2>
+<nav>
+<a onclick="collections()">Collections</a>
+<a onclick="topology()">Topology</a>
+<a onclick="raw('system_info')">System Info</a>
+<a onclick="raw('tasks')">Tasks</a>
+</nav>
+<div id="content"></div>
+<script>
+var api = "api/";
+var content = document.getElementById("content");
+
+function escape(s) {
+  return String(s).replace(/[&<>"]/g, function (c) {
+    return {"&": "&amp;", "<": "&lt;", ">": "&gt;", '"': "&quot;"}[c];
+  });
+}
+
+function get(path, render) {
+  fetch(api + path).then(function (resp) {
+    if (!resp.ok) {
+      return resp.text().then(function (text) { throw new Error(text); });
+    }
+    return resp.json();
+  }).then(render).catch(function (err) {
+    content.innerHTML = "<pre>" + escape(err.message) + "</pre>";
+  });
+}
+
+function table(columns, rows) {
+  var html = "<table><tr>" + columns.map(function (c) { return "<th>" + escape(c) + "</th>"; }).join("") + "</tr>";
+  rows.forEach(function (row) {
+    html += "<tr>" + row.map(function (v) { return "<td>" + v + "</td>"; }).join("") + "</tr>";
+  });
+  return html + "</table>";
+}
+
+function collections() {
+  get("collections", function (data) {
+    content.innerHTML = table(["Name", "ID", "Created", "Loaded", "In Memory %"], data.map(function (c) {
+      return ["<a onclick=\"collection('" + escape(c.name) + "')\">" + escape(c.name) + "</a>",
+        c.id, escape(c.created_time), c.loaded, c.in_memory_percentage];
+    }));
+  });
+}
+
+function collection(name) {
+  get("collections/" + encodeURIComponent(name), function (c) {
+    var html = "<h3>" + escape(c.name) + "</h3>";
+    html += table(["Field", "ID", "Type", "Primary Key", "Auto ID"], c.fields.map(function (f) {
+      return [escape(f.name), f.id, escape(f.data_type), f.is_primary_key, f.auto_id];
+    }));
+    html += "<h4>Segments</h4>" + table(["ID", "Partition", "Rows", "State"], c.segments.map(function (s) {
+      return [s.id, s.partition_id, s.num_rows, escape(s.state)];
+    }));
+    html += "<h4>Loaded Segments</h4>" + table(["ID", "Partition", "Rows", "Memory", "Index"], c.loaded_segments.map(function (s) {
+      return [s.id, s.partition_id, s.num_rows, s.mem_size, escape(s.index_name || "")];
+    }));
+    content.innerHTML = html;
+  });
+}
+
+function topology() {
+  get("topology", function (t) {
+    var html = table(["Name", "Type", "State", "Address", "Reason"], t.nodes.map(function (n) {
+      return [escape(n.name), escape(n.type), "<span class=\"" + escape(n.state) + "\">" + escape(n.state) + "</span>",
+        escape(n.address || ""), escape(n.reason || "")];
+    }));
+    html += table(["Source", "Target", "Type"], t.edges.map(function (e) {
+      return [escape(e.source), escape(e.target), escape(e.type)];
+    }));
+    content.innerHTML = html;
+  });
+}
+
+function raw(path) {
+  get(path, function (data) {
+    content.innerHTML = "<pre>" + escape(JSON.stringify(data, null, 2)) + "</pre>";
+  });
+}
+
+collections();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package decisionlog
+
+import (
+	"sync"
+	"time"
+)
+
+// Event is a decision made by a coordinator, together with the inputs the decision is based on
+type Event struct {
+	Time     time.Time              `json:"time"`
+	Type     string                 `json:"type"`
+	Decision string                 `json:"decision"`
+	Inputs   map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// Log keeps the latest decisions in a ring buffer, the oldest one is overwritten when the buffer is full
+type Log struct {
+	mu     sync.RWMutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// NewLog returns a Log which keeps at most capacity decisions
+func NewLog(capacity int) *Log {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &Log{
+		events: make([]Event, capacity),
+	}
+}
+
+// Record adds a decision to the log, it's a no-op on a nil Log
+func (l *Log) Record(eventType string, decision string, inputs map[string]interface{}) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events[l.next] = Event{
+		Time:     time.Now(),
+		Type:     eventType,
+		Decision: decision,
+		Inputs:   inputs,
+	}
+	l.next++
+	if l.next == len(l.events) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// Query returns the decisions of eventType made since the given time, from the oldest to the latest.
+// Decisions of all types are returned if eventType is empty, and only the latest limit ones are returned if limit > 0
+func (l *Log) Query(eventType string, since time.Time, limit int) []Event {
+	ret := make([]Event, 0)
+	if l == nil {
+		return ret
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	start, count := 0, l.next
+	if l.full {
+		start, count = l.next, len(l.events)
+	}
+	for i := 0; i < count; i++ {
+		event := l.events[(start+i)%len(l.events)]
+		if eventType != "" && event.Type != eventType {
+			continue
+		}
+		if event.Time.Before(since) {
+			continue
+		}
+		ret = append(ret, event)
+	}
+	if limit > 0 && len(ret) > limit {
+		ret = ret[len(ret)-limit:]
+	}
+	return ret
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package decisionlog
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decisions(events []Event) []string {
+	ret := make([]string, 0, len(events))
+	for _, event := range events {
+		ret = append(ret, event.Decision)
+	}
+	return ret
+}
+
+func TestLog(t *testing.T) {
+	var nilLog *Log
+	nilLog.Record("balance", "d0", nil)
+	assert.Equal(t, 0, len(nilLog.Query("", time.Time{}, 0)))
+
+	l := NewLog(3)
+	assert.Equal(t, 0, len(l.Query("", time.Time{}, 0)))
+
+	l.Record("balance", "d0", map[string]interface{}{"node_id": 1})
+	l.Record("assign", "d1", nil)
+	assert.Equal(t, []string{"d0", "d1"}, decisions(l.Query("", time.Time{}, 0)))
+	assert.Equal(t, 1, l.Query("balance", time.Time{}, 0)[0].Inputs["node_id"])
+
+	for i := 2; i < 5; i++ {
+		l.Record("assign", fmt.Sprintf("d%d", i), nil)
+	}
+	assert.Equal(t, []string{"d2", "d3", "d4"}, decisions(l.Query("", time.Time{}, 0)))
+	assert.Equal(t, 0, len(l.Query("balance", time.Time{}, 0)))
+	assert.Equal(t, []string{"d3", "d4"}, decisions(l.Query("assign", time.Time{}, 2)))
+	assert.Equal(t, 0, len(l.Query("", time.Now().Add(time.Second), 0)))
+
+	l = NewLog(0)
+	l.Record("balance", "d0", nil)
+	l.Record("balance", "d1", nil)
+	assert.Equal(t, []string{"d1"}, decisions(l.Query("", time.Time{}, 0)))
+}
@@ -18,10 +18,13 @@ import (
 )
 
 const (
-	L2       = "L2"
-	IP       = "IP"
-	HAMMING  = "HAMMING"
-	TANIMOTO = "TANIMOTO"
+	L2             = "L2"
+	IP             = "IP"
+	HAMMING        = "HAMMING"
+	TANIMOTO       = "TANIMOTO"
+	JACCARD        = "JACCARD"
+	SUBSTRUCTURE   = "SUBSTRUCTURE"
+	SUPERSTRUCTURE = "SUPERSTRUCTURE"
 )
 
 func ValidateMetricType(metric string) (string, error) {
@@ -31,7 +34,7 @@ func ValidateMetricType(metric string) (string, error) {
 	}
 
 	m := strings.ToUpper(metric)
-	if m == L2 || m == IP || m == HAMMING || m == TANIMOTO {
+	if m == L2 || m == IP || m == HAMMING || m == TANIMOTO || m == JACCARD || m == SUBSTRUCTURE || m == SUPERSTRUCTURE {
 		return m, nil
 	}
 
@@ -249,3 +252,111 @@ func CalcTanimotoCoefficient(dim int64, hamming []int32) ([]float32, error) {
 
 	return array, nil
 }
+
+// The bits of the i-th byte of a vector, the padding bits of the last byte are set to 0
+func maskedByte(dim int64, numBytes int64, i int64, b uint8) uint8 {
+	if i == numBytes-1 && numBytes*8 > dim {
+		offset := numBytes*8 - dim
+		return b & (255 << offset)
+	}
+	return b
+}
+
+// JACCARD distance, 1 - |left & right| / |left | right|, which is 1 if both vectors are all zero
+func CalcJaccard(dim int64, left []byte, lIndex int64, right []byte, rIndex int64) float32 {
+	numBytes := SingleBitLen(dim) / 8
+	lFrom := lIndex * numBytes
+	rFrom := rIndex * numBytes
+
+	var and, or int32 = 0, 0
+	for i := int64(0); i < numBytes; i++ {
+		l := maskedByte(dim, numBytes, i, left[lFrom+i])
+		r := maskedByte(dim, numBytes, i, right[rFrom+i])
+		and += CountOne(l & r)
+		or += CountOne(l | r)
+	}
+	if or == 0 {
+		return 1
+	}
+	return float32(or-and) / float32(or)
+}
+
+// IsSubstructure returns whether all the bits of left are set in right, i.e. left is a substructure of right
+func IsSubstructure(dim int64, left []byte, lIndex int64, right []byte, rIndex int64) bool {
+	numBytes := SingleBitLen(dim) / 8
+	lFrom := lIndex * numBytes
+	rFrom := rIndex * numBytes
+
+	for i := int64(0); i < numBytes; i++ {
+		l := maskedByte(dim, numBytes, i, left[lFrom+i])
+		r := maskedByte(dim, numBytes, i, right[rFrom+i])
+		if l&r != l {
+			return false
+		}
+	}
+	return true
+}
+
+func CalcBBBatch(dim int64, left []byte, lIndex int64, right []byte, metric string, result *[]float32) {
+	rightNum := VectorCount(dim, len(right))
+	for i := int64(0); i < rightNum; i++ {
+		var distance float32 = 1.0
+		switch metric {
+		case JACCARD:
+			distance = CalcJaccard(dim, left, lIndex, right, i)
+		case SUBSTRUCTURE:
+			if IsSubstructure(dim, left, lIndex, right, i) {
+				distance = 0
+			}
+		case SUPERSTRUCTURE:
+			if IsSubstructure(dim, right, i, left, lIndex) {
+				distance = 0
+			}
+		}
+		(*result)[lIndex*rightNum+i] = distance
+	}
+}
+
+// CalcBinaryDistance returns the JACCARD, SUBSTRUCTURE or SUPERSTRUCTURE distances between the left and the right vectors.
+// As the search of the query nodes with the left vectors as the queries, the SUBSTRUCTURE distance is 0 if the left
+// vector is a substructure of the right one, and the SUPERSTRUCTURE distance is 0 if the left vector is a superstructure
+// of the right one, otherwise they are 1.
+func CalcBinaryDistance(dim int64, left []byte, right []byte, metric string) ([]float32, error) {
+	if dim <= 0 {
+		err := errors.New("Invalid dimension")
+		return nil, err
+	}
+
+	metricUpper := strings.ToUpper(metric)
+	if metricUpper != JACCARD && metricUpper != SUBSTRUCTURE && metricUpper != SUPERSTRUCTURE {
+		err := errors.New("Invalid metric type")
+		return nil, err
+	}
+
+	err := ValidateBinaryArrayLength(dim, len(left))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ValidateBinaryArrayLength(dim, len(right))
+	if err != nil {
+		return nil, err
+	}
+
+	leftNum := VectorCount(dim, len(left))
+	rightNum := VectorCount(dim, len(right))
+	distArray := make([]float32, leftNum*rightNum)
+
+	var waitGroup sync.WaitGroup
+	CalcWorker := func(index int64) {
+		CalcBBBatch(dim, left, index, right, metricUpper, &distArray)
+		waitGroup.Done()
+	}
+	for i := int64(0); i < leftNum; i++ {
+		waitGroup.Add(1)
+		go CalcWorker(i)
+	}
+	waitGroup.Wait()
+
+	return distArray, nil
+}
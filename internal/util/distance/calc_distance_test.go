@@ -29,11 +29,12 @@ func TestValidateMetricType(t *testing.T) {
 		assert.Error(t, err)
 	}
 
-	validMetric := []string{"L2", "ip", "Hamming", "Tanimoto"}
+	validMetric := []string{"L2", "ip", "Hamming", "Tanimoto", "jaccard", "Substructure", "SUPERSTRUCTURE"}
 	for _, str := range validMetric {
 		metric, err := ValidateMetricType(str)
 		assert.Nil(t, err)
-		assert.True(t, metric == L2 || metric == IP || metric == HAMMING || metric == TANIMOTO)
+		assert.True(t, metric == L2 || metric == IP || metric == HAMMING || metric == TANIMOTO ||
+			metric == JACCARD || metric == SUBSTRUCTURE || metric == SUPERSTRUCTURE)
 	}
 }
 
@@ -281,3 +282,68 @@ func Test_CalcTanimotoCoefficient(t *testing.T) {
 	_, err = CalcTanimotoCoefficient(3, hamming)
 	assert.Error(t, err)
 }
+
+func Test_CalcJaccard(t *testing.T) {
+	var dim int64 = 22
+	// v1 = 00000010 00000110 00001000
+	v1 := []uint8{2, 6, 8}
+	// v2 = 00000001 00000111 00011011, the last 2 bits are padding
+	v2 := []uint8{1, 7, 27}
+	// and = 00000000 00000110 00001000, or = 00000011 00000111 00011000
+	d := CalcJaccard(dim, v1, 0, v2, 0)
+	assert.Less(t, math.Abs(float64(d)-float64(7-3)/7), float64(PRECISION))
+
+	assert.Equal(t, float32(0), CalcJaccard(dim, v1, 0, v1, 0))
+	zero := []uint8{0, 0, 0}
+	assert.Equal(t, float32(1), CalcJaccard(dim, zero, 0, zero, 0))
+	assert.Equal(t, float32(1), CalcJaccard(dim, v1, 0, zero, 0))
+}
+
+func Test_IsSubstructure(t *testing.T) {
+	var dim int64 = 22
+	sub := []uint8{2, 6, 8}
+	super := []uint8{3, 7, 8 | 3}
+	assert.True(t, IsSubstructure(dim, sub, 0, super, 0))
+	assert.False(t, IsSubstructure(dim, []uint8{2, 6, 16}, 0, super, 0))
+	assert.True(t, IsSubstructure(dim, sub, 0, sub, 0))
+
+	// v1 differs from super only in the padding bits
+	assert.True(t, IsSubstructure(dim, []uint8{3, 7, 8}, 0, super, 0))
+	assert.True(t, IsSubstructure(dim, super, 0, []uint8{3, 7, 8}, 0))
+	assert.False(t, IsSubstructure(dim, super, 0, sub, 0))
+}
+
+func Test_CalcBinaryDistance(t *testing.T) {
+	var dim int64 = 16
+	left := []uint8{1, 0, 3, 0}
+	right := []uint8{1, 0, 3, 0, 255, 255}
+
+	jaccard, err := CalcBinaryDistance(dim, left, right, "jaccard")
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(jaccard))
+	for i := int64(0); i < 2; i++ {
+		for j := int64(0); j < 3; j++ {
+			assert.Equal(t, CalcJaccard(dim, left, i, right, j), jaccard[i*3+j])
+		}
+	}
+	assert.Equal(t, float32(0), jaccard[0])
+	assert.Equal(t, float32(0), jaccard[4])
+
+	// 1 is a substructure of 3 and 255
+	substructure, err := CalcBinaryDistance(dim, left, right, SUBSTRUCTURE)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{0, 0, 0, 1, 0, 0}, substructure)
+
+	superstructure, err := CalcBinaryDistance(dim, left, right, SUPERSTRUCTURE)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{0, 1, 1, 0, 0, 1}, superstructure)
+
+	_, err = CalcBinaryDistance(0, left, right, JACCARD)
+	assert.Error(t, err)
+	_, err = CalcBinaryDistance(dim, left, right, HAMMING)
+	assert.Error(t, err)
+	_, err = CalcBinaryDistance(dim, left[:3], right, JACCARD)
+	assert.Error(t, err)
+	_, err = CalcBinaryDistance(dim, left, right[:3], JACCARD)
+	assert.Error(t, err)
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package dmlreplay re-consumes the dml messages of a collection between two timestamps from the message
+// queue and re-applies them to a collection, it is used to recover from accidental deletes with a backup
+// of the collection and the retention of the message queue.
+package dmlreplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type UniqueID = typeutil.UniqueID
+type Timestamp = typeutil.Timestamp
+
+// Target is the Milvus which the replayed rows are applied to, usually a proxy client
+type Target interface {
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+}
+
+type Config struct {
+	// SourceCollectionID and SourceCollectionName identify the dml messages to replay
+	SourceCollectionID   UniqueID
+	SourceCollectionName string
+	// TargetCollectionName is the collection to apply the messages to, the schema must be the same as the source
+	TargetCollectionName string
+	// the rows whose timestamp is in [StartTs, EndTs) are replayed
+	StartTs Timestamp
+	EndTs   Timestamp
+	// ReplayDelete decides whether the delete messages are replayed, it is false when recovering from accidental deletes
+	ReplayDelete bool
+}
+
+type Result struct {
+	// Inserted is the num of rows inserted into the target
+	Inserted int64
+	// Skipped is the num of rows not inserted since their primary keys already exist in the target
+	Skipped int64
+	// Deleted is the num of primary keys deleted from the target
+	Deleted int64
+}
+
+type Replayer struct {
+	stream  msgstream.MsgStream
+	target  Target
+	cfg     Config
+	decoder *rowDecoder
+}
+
+// NewReplayer creates a replayer, stream should be a tt msgstream consuming the dml channels of the source
+// collection from the earliest position, schema is the schema of the target collection
+func NewReplayer(stream msgstream.MsgStream, target Target, schema *schemapb.CollectionSchema, cfg Config) (*Replayer, error) {
+	if cfg.StartTs >= cfg.EndTs {
+		return nil, fmt.Errorf("start timestamp %d should be less than end timestamp %d", cfg.StartTs, cfg.EndTs)
+	}
+	decoder, err := newRowDecoder(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{
+		stream:  stream,
+		target:  target,
+		cfg:     cfg,
+		decoder: decoder,
+	}, nil
+}
+
+// Run replays the messages until a time tick reaches EndTs
+func (r *Replayer) Run(ctx context.Context) (*Result, error) {
+	result := &Result{}
+	for {
+		var pack *msgstream.MsgPack
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case pack, ok = <-r.stream.Chan():
+		}
+		if !ok || pack == nil {
+			return result, errors.New("msgstream closed before reaching the end timestamp")
+		}
+
+		for _, msg := range pack.Msgs {
+			var err error
+			switch msg.Type() {
+			case commonpb.MsgType_Insert:
+				err = r.replayInsert(ctx, msg.(*msgstream.InsertMsg), result)
+			case commonpb.MsgType_Delete:
+				err = r.replayDelete(ctx, msg.(*msgstream.DeleteMsg), result)
+			}
+			if err != nil {
+				return result, err
+			}
+		}
+
+		if pack.EndTs >= r.cfg.EndTs {
+			log.Debug("dml replay reaches the end timestamp",
+				zap.Uint64("endTs", r.cfg.EndTs),
+				zap.Int64("inserted", result.Inserted),
+				zap.Int64("skipped", result.Skipped),
+				zap.Int64("deleted", result.Deleted))
+			return result, nil
+		}
+	}
+}
+
+func (r *Replayer) inRange(ts Timestamp) bool {
+	return ts >= r.cfg.StartTs && ts < r.cfg.EndTs
+}
+
+func (r *Replayer) replayInsert(ctx context.Context, msg *msgstream.InsertMsg, result *Result) error {
+	if msg.CollectionID != r.cfg.SourceCollectionID {
+		return nil
+	}
+	if len(msg.Timestamps) != len(msg.RowData) {
+		return fmt.Errorf("insert msg %d has %d timestamps but %d rows", msg.ID(), len(msg.Timestamps), len(msg.RowData))
+	}
+
+	rows := make([]*commonpb.Blob, 0, len(msg.RowData))
+	pks := make([]int64, 0, len(msg.RowData))
+	for i, row := range msg.RowData {
+		if !r.inRange(msg.Timestamps[i]) {
+			continue
+		}
+		pk, err := r.decoder.primaryKey(row)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+		pks = append(pks, pk)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	existed, err := r.existedPrimaryKeys(ctx, pks)
+	if err != nil {
+		return err
+	}
+	missing := make([]*commonpb.Blob, 0, len(rows))
+	for i, row := range rows {
+		if _, ok := existed[pks[i]]; ok {
+			continue
+		}
+		// the same primary key may be inserted more than once in a message
+		existed[pks[i]] = struct{}{}
+		missing = append(missing, row)
+	}
+	result.Skipped += int64(len(rows) - len(missing))
+	if len(missing) == 0 {
+		return nil
+	}
+
+	resp, err := r.target.Insert(ctx, &milvuspb.InsertRequest{
+		CollectionName: r.cfg.TargetCollectionName,
+		PartitionName:  msg.PartitionName,
+		FieldsData:     r.decoder.decode(missing),
+		NumRows:        uint32(len(missing)),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	result.Inserted += int64(len(missing))
+	return nil
+}
+
+func (r *Replayer) replayDelete(ctx context.Context, msg *msgstream.DeleteMsg, result *Result) error {
+	if !r.cfg.ReplayDelete || msg.CollectionName != r.cfg.SourceCollectionName {
+		return nil
+	}
+	pks := make([]int64, 0, len(msg.PrimaryKeys))
+	for i, pk := range msg.PrimaryKeys {
+		if i < len(msg.Timestamps) && !r.inRange(msg.Timestamps[i]) {
+			continue
+		}
+		pks = append(pks, pk)
+	}
+	if len(pks) == 0 {
+		return nil
+	}
+
+	resp, err := r.target.Delete(ctx, &milvuspb.DeleteRequest{
+		CollectionName: r.cfg.TargetCollectionName,
+		Expr:           r.primaryKeysExpr(pks),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	result.Deleted += int64(len(pks))
+	return nil
+}
+
+// existedPrimaryKeys returns the primary keys already in the target collection
+func (r *Replayer) existedPrimaryKeys(ctx context.Context, pks []int64) (map[int64]struct{}, error) {
+	resp, err := r.target.Query(ctx, &milvuspb.QueryRequest{
+		CollectionName: r.cfg.TargetCollectionName,
+		Expr:           r.primaryKeysExpr(pks),
+		OutputFields:   []string{r.decoder.primaryKeyName()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	existed := make(map[int64]struct{})
+	for _, fieldData := range resp.FieldsData {
+		if fieldData.FieldName != r.decoder.primaryKeyName() {
+			continue
+		}
+		for _, pk := range fieldData.GetScalars().GetLongData().GetData() {
+			existed[pk] = struct{}{}
+		}
+	}
+	return existed, nil
+}
+
+func (r *Replayer) primaryKeysExpr(pks []int64) string {
+	strs := make([]string, 0, len(pks))
+	for _, pk := range pks {
+		strs = append(strs, strconv.FormatInt(pk, 10))
+	}
+	return fmt.Sprintf("%s in [%s]", r.decoder.primaryKeyName(), strings.Join(strs, ","))
+}
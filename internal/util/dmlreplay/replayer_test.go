@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package dmlreplay
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type mockStream struct {
+	msgstream.MsgStream
+	ch chan *msgstream.MsgPack
+}
+
+func (s *mockStream) Chan() <-chan *msgstream.MsgPack {
+	return s.ch
+}
+
+type mockTarget struct {
+	existed map[int64]struct{}
+	inserts []*milvuspb.InsertRequest
+	deletes []*milvuspb.DeleteRequest
+	queries []*milvuspb.QueryRequest
+}
+
+func successResult() *milvuspb.MutationResult {
+	return &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+}
+
+func (t *mockTarget) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	t.inserts = append(t.inserts, request)
+	return successResult(), nil
+}
+
+func (t *mockTarget) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	t.deletes = append(t.deletes, request)
+	return successResult(), nil
+}
+
+func (t *mockTarget) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	t.queries = append(t.queries, request)
+	pks := make([]int64, 0)
+	for pk := range t.existed {
+		pks = append(pks, pk)
+	}
+	return &milvuspb.QueryResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "pk",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+				}},
+			},
+		},
+	}, nil
+}
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int16},
+		},
+	}
+}
+
+func newTestRow(t *testing.T, pk int64, vec []float32, age int16) *commonpb.Blob {
+	var buffer bytes.Buffer
+	for _, v := range []interface{}{pk, vec, age} {
+		err := binary.Write(&buffer, binary.LittleEndian, v)
+		assert.Nil(t, err)
+	}
+	return &commonpb.Blob{Value: buffer.Bytes()}
+}
+
+func TestRowDecoder(t *testing.T) {
+	decoder, err := newRowDecoder(newTestSchema())
+	assert.Nil(t, err)
+	assert.Equal(t, 8+8+2, decoder.rowSize)
+
+	rows := []*commonpb.Blob{
+		newTestRow(t, 1, []float32{1.0, 2.0}, 10),
+		newTestRow(t, 2, []float32{3.0, 4.0}, -20),
+	}
+	pk, err := decoder.primaryKey(rows[1])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), pk)
+	_, err = decoder.primaryKey(&commonpb.Blob{Value: []byte{1}})
+	assert.NotNil(t, err)
+
+	fieldsData := decoder.decode(rows)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, []int64{1, 2}, fieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []float32{1.0, 2.0, 3.0, 4.0}, fieldsData[1].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, int64(2), fieldsData[1].GetVectors().Dim)
+	assert.Equal(t, []int32{10, -20}, fieldsData[2].GetScalars().GetIntData().Data)
+
+	schema := newTestSchema()
+	schema.Fields[2].AutoID = true
+	_, err = newRowDecoder(schema)
+	assert.NotNil(t, err)
+}
+
+func TestReplayer_Run(t *testing.T) {
+	stream := &mockStream{ch: make(chan *msgstream.MsgPack, 10)}
+	target := &mockTarget{existed: map[int64]struct{}{2: {}}}
+	cfg := Config{
+		SourceCollectionID:   1,
+		SourceCollectionName: "source",
+		TargetCollectionName: "restored",
+		StartTs:              100,
+		EndTs:                200,
+	}
+	_, err := NewReplayer(stream, target, newTestSchema(), Config{StartTs: 200, EndTs: 100})
+	assert.NotNil(t, err)
+	replayer, err := NewReplayer(stream, target, newTestSchema(), cfg)
+	assert.Nil(t, err)
+
+	insertMsg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			Base:          &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			CollectionID:  1,
+			PartitionName: "_default",
+			Timestamps:    []uint64{50, 100, 150, 200},
+			RowData: []*commonpb.Blob{
+				newTestRow(t, 1, []float32{1.0, 1.0}, 1),
+				newTestRow(t, 2, []float32{2.0, 2.0}, 2),
+				newTestRow(t, 3, []float32{3.0, 3.0}, 3),
+				newTestRow(t, 4, []float32{4.0, 4.0}, 4),
+			},
+		},
+	}
+	otherMsg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			CollectionID: 2,
+			Timestamps:   []uint64{150},
+			RowData:      []*commonpb.Blob{newTestRow(t, 5, []float32{5.0, 5.0}, 5)},
+		},
+	}
+	deleteMsg := &msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			CollectionName: "source",
+			Timestamps:     []uint64{160},
+			PrimaryKeys:    []int64{3},
+		},
+	}
+	stream.ch <- &msgstream.MsgPack{EndTs: 160, Msgs: []msgstream.TsMsg{insertMsg, otherMsg, deleteMsg}}
+	stream.ch <- &msgstream.MsgPack{EndTs: 200}
+
+	result, err := replayer.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Inserted)
+	assert.Equal(t, int64(1), result.Skipped)
+	assert.Equal(t, int64(0), result.Deleted)
+
+	assert.Equal(t, 1, len(target.queries))
+	assert.Equal(t, "pk in [2,3]", target.queries[0].Expr)
+	assert.Equal(t, 1, len(target.inserts))
+	assert.Equal(t, "restored", target.inserts[0].CollectionName)
+	assert.Equal(t, uint32(1), target.inserts[0].NumRows)
+	assert.Equal(t, []int64{3}, target.inserts[0].FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, 0, len(target.deletes))
+
+	cfg.ReplayDelete = true
+	replayer, err = NewReplayer(stream, target, newTestSchema(), cfg)
+	assert.Nil(t, err)
+	stream.ch <- &msgstream.MsgPack{EndTs: 200, Msgs: []msgstream.TsMsg{deleteMsg}}
+	result, err = replayer.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Deleted)
+	assert.Equal(t, "pk in [3]", target.deletes[0].Expr)
+
+	close(stream.ch)
+	_, err = replayer.Run(context.Background())
+	assert.NotNil(t, err)
+}
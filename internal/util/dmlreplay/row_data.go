@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package dmlreplay
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// startOfUserFieldID is the same as rootcoord.StartOfUserFieldID, fields before it are not in the row data
+const startOfUserFieldID = 100
+
+type fieldLayout struct {
+	field  *schemapb.FieldSchema
+	dim    int
+	offset int
+	size   int
+}
+
+// rowDecoder decodes the row based data of insert messages back to the column based data of milvus api,
+// the row layout is the same as the one used by proxy and datanode
+type rowDecoder struct {
+	fields  []*fieldLayout
+	pk      *fieldLayout
+	rowSize int
+}
+
+func newRowDecoder(schema *schemapb.CollectionSchema) (*rowDecoder, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	decoder := &rowDecoder{}
+	for _, field := range schema.Fields {
+		if field.FieldID < startOfUserFieldID {
+			continue
+		}
+		layout := &fieldLayout{field: field, offset: decoder.rowSize}
+		switch field.DataType {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8:
+			layout.size = 1
+		case schemapb.DataType_Int16:
+			layout.size = 2
+		case schemapb.DataType_Int32, schemapb.DataType_Float:
+			layout.size = 4
+		case schemapb.DataType_Int64, schemapb.DataType_Double:
+			layout.size = 8
+		case schemapb.DataType_FloatVector:
+			layout.dim, err = helper.GetVectorDimFromID(field.FieldID)
+			if err != nil {
+				return nil, err
+			}
+			layout.size = layout.dim * 4
+		case schemapb.DataType_BinaryVector:
+			layout.dim, err = helper.GetVectorDimFromID(field.FieldID)
+			if err != nil {
+				return nil, err
+			}
+			layout.size = layout.dim / 8
+		default:
+			return nil, fmt.Errorf("data type %s of field %s is not supported", field.DataType.String(), field.Name)
+		}
+		decoder.rowSize += layout.size
+		decoder.fields = append(decoder.fields, layout)
+		if field.IsPrimaryKey {
+			decoder.pk = layout
+		}
+	}
+	if decoder.pk == nil || decoder.pk.field.DataType != schemapb.DataType_Int64 {
+		return nil, fmt.Errorf("collection %s has no int64 primary key", schema.Name)
+	}
+	if decoder.pk.field.AutoID {
+		return nil, fmt.Errorf("primary key of collection %s is auto id, the replayed rows can not keep their primary keys", schema.Name)
+	}
+	return decoder, nil
+}
+
+func (d *rowDecoder) checkRow(row *commonpb.Blob) error {
+	if len(row.Value) != d.rowSize {
+		return fmt.Errorf("size of row data is %d, but the schema requires %d", len(row.Value), d.rowSize)
+	}
+	return nil
+}
+
+func (d *rowDecoder) primaryKey(row *commonpb.Blob) (int64, error) {
+	if err := d.checkRow(row); err != nil {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(row.Value[d.pk.offset:])), nil
+}
+
+func (d *rowDecoder) primaryKeyName() string {
+	return d.pk.field.Name
+}
+
+// decode converts the rows to fields data, rows should be checked by primaryKey before
+func (d *rowDecoder) decode(rows []*commonpb.Blob) []*schemapb.FieldData {
+	fieldsData := make([]*schemapb.FieldData, 0, len(d.fields))
+	for _, layout := range d.fields {
+		fieldData := &schemapb.FieldData{
+			Type:      layout.field.DataType,
+			FieldName: layout.field.Name,
+			FieldId:   layout.field.FieldID,
+		}
+		switch layout.field.DataType {
+		case schemapb.DataType_Bool:
+			data := make([]bool, 0, len(rows))
+			for _, row := range rows {
+				data = append(data, row.Value[layout.offset] != 0)
+			}
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}})
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+			data := make([]int32, 0, len(rows))
+			for _, row := range rows {
+				value := row.Value[layout.offset:]
+				switch layout.size {
+				case 1:
+					data = append(data, int32(int8(value[0])))
+				case 2:
+					data = append(data, int32(int16(binary.LittleEndian.Uint16(value))))
+				default:
+					data = append(data, int32(binary.LittleEndian.Uint32(value)))
+				}
+			}
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}})
+		case schemapb.DataType_Int64:
+			data := make([]int64, 0, len(rows))
+			for _, row := range rows {
+				data = append(data, int64(binary.LittleEndian.Uint64(row.Value[layout.offset:])))
+			}
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}})
+		case schemapb.DataType_Float:
+			data := make([]float32, 0, len(rows))
+			for _, row := range rows {
+				data = append(data, math.Float32frombits(binary.LittleEndian.Uint32(row.Value[layout.offset:])))
+			}
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}})
+		case schemapb.DataType_Double:
+			data := make([]float64, 0, len(rows))
+			for _, row := range rows {
+				data = append(data, math.Float64frombits(binary.LittleEndian.Uint64(row.Value[layout.offset:])))
+			}
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}})
+		case schemapb.DataType_FloatVector:
+			data := make([]float32, 0, len(rows)*layout.dim)
+			for _, row := range rows {
+				for i := 0; i < layout.dim; i++ {
+					data = append(data, math.Float32frombits(binary.LittleEndian.Uint32(row.Value[layout.offset+i*4:])))
+				}
+			}
+			fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  int64(layout.dim),
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+			}}
+		case schemapb.DataType_BinaryVector:
+			data := make([]byte, 0, len(rows)*layout.size)
+			for _, row := range rows {
+				data = append(data, row.Value[layout.offset:layout.offset+layout.size]...)
+			}
+			fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  int64(layout.dim),
+				Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+			}}
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData
+}
+
+func scalarField(field *schemapb.ScalarField) *schemapb.FieldData_Scalars {
+	return &schemapb.FieldData_Scalars{Scalars: field}
+}
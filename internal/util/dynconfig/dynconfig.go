@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package dynconfig
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// Prefix is the etcd path of the dynamic configs under the meta root path, a dynamic config is saved as
+// {metaRootPath}/config/{paramtable key} and overrides the value of the yaml files
+const Prefix = "config"
+
+// Validator returns an error if the value is invalid for the key
+type Validator func(value string) error
+
+// keys are the paramtable keys which can be changed at runtime without restarting the components
+var keys = map[string]Validator{
+	"proxy.slowLog.threshold":          intValidator(),
+	"proxy.shadow.sampleRatio":         floatValidator(0, 1),
+	"queryNode.slowLog.threshold":      intValidator(),
+	"queryNode.admission.cpuWatermark": floatValidator(0, 100),
+}
+
+func intValidator() Validator {
+	return func(value string) error {
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err
+	}
+}
+
+func floatValidator(min, max float64) Validator {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if v < min || v > max {
+			return fmt.Errorf("should be in [%v, %v]", min, max)
+		}
+		return nil
+	}
+}
+
+// Validate returns an error if the key can't be changed at runtime or the value is invalid
+func Validate(key, value string) error {
+	validator, ok := keys[key]
+	if !ok {
+		return fmt.Errorf("%s is not a dynamic config", key)
+	}
+	if err := validator(value); err != nil {
+		return fmt.Errorf("invalid value %s of %s: %w", value, key, err)
+	}
+	return nil
+}
+
+// Config is a dynamic config, Value is the effective value if the key is watched by the component,
+// otherwise the value saved in etcd
+type Config struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Default string `json:"default,omitempty"`
+	Updated bool   `json:"updated"` // whether the value is saved in etcd
+	Watched bool   `json:"watched"` // whether the key is watched by the component
+}
+
+type watcher struct {
+	defaultValue string
+	apply        func(value string)
+}
+
+// Manager watches the dynamic configs in etcd and applies their changes by the callbacks of the component
+type Manager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	kv *etcdkv.EtcdKV
+
+	mu       sync.RWMutex
+	watchers map[string]*watcher
+	values   map[string]string
+}
+
+func NewManager(ctx context.Context, etcdEndpoints []string, metaRootPath string) (*Manager, error) {
+	kv, err := etcdkv.NewEtcdKV(etcdEndpoints, path.Join(metaRootPath, Prefix))
+	if err != nil {
+		return nil, err
+	}
+	m := newManager(ctx)
+	m.kv = kv
+	return m, nil
+}
+
+func newManager(ctx context.Context) *Manager {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &Manager{
+		ctx:      ctx1,
+		cancel:   cancel,
+		watchers: make(map[string]*watcher),
+		values:   make(map[string]string),
+	}
+}
+
+// Watch registers the callback applying the changes of the key, defaultValue is applied if the value
+// in etcd is removed. It must be called before Start
+func (m *Manager) Watch(key string, defaultValue string, apply func(value string)) error {
+	if _, ok := keys[key]; !ok {
+		return fmt.Errorf("%s is not a dynamic config", key)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.watchers[key] = &watcher{
+		defaultValue: defaultValue,
+		apply:        apply,
+	}
+	return nil
+}
+
+// Start applies the values saved in etcd and watches their changes
+func (m *Manager) Start() error {
+	fullKeys, values, revision, err := m.kv.LoadWithRevision("")
+	if err != nil {
+		return err
+	}
+	for i, fullKey := range fullKeys {
+		m.update(m.trimKey(fullKey), values[i], false)
+	}
+
+	m.wg.Add(1)
+	go m.watchLoop(revision + 1)
+	return nil
+}
+
+func (m *Manager) Close() {
+	m.cancel()
+	m.wg.Wait()
+	if m.kv != nil {
+		m.kv.Close()
+	}
+}
+
+func (m *Manager) trimKey(fullKey string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(fullKey, m.kv.GetPath("")), "/")
+}
+
+func (m *Manager) watchLoop(revision int64) {
+	defer m.wg.Done()
+	watchChan := m.kv.WatchWithRevision("", revision)
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("dynamic config watch loop exit")
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				log.Warn("dynamic config watch channel closed")
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Warn("dynamic config watch failed", zap.Error(err))
+				continue
+			}
+			for _, event := range resp.Events {
+				key := m.trimKey(string(event.Kv.Key))
+				switch event.Type {
+				case mvccpb.PUT:
+					m.update(key, string(event.Kv.Value), false)
+				case mvccpb.DELETE:
+					m.update(key, "", true)
+				}
+			}
+		}
+	}
+}
+
+// update applies the value of the key if it is watched, the default value is applied if removed
+func (m *Manager) update(key string, value string, removed bool) {
+	if !removed {
+		if err := Validate(key, value); err != nil {
+			log.Warn("ignore invalid dynamic config", zap.Error(err))
+			return
+		}
+	}
+
+	m.mu.Lock()
+	if removed {
+		delete(m.values, key)
+	} else {
+		m.values[key] = value
+	}
+	w, ok := m.watchers[key]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if removed {
+		value = w.defaultValue
+	}
+	log.Info("apply dynamic config", zap.String("key", key), zap.String("value", value), zap.Bool("removed", removed))
+	w.apply(value)
+}
+
+// List returns all the dynamic configs sorted by key
+func (m *Manager) List() []*Config {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	configs := make([]*Config, 0, len(keys))
+	for key := range keys {
+		config := &Config{Key: key}
+		config.Value, config.Updated = m.values[key]
+		if w, ok := m.watchers[key]; ok {
+			config.Watched = true
+			config.Default = w.defaultValue
+			if !config.Updated {
+				config.Value = w.defaultValue
+			}
+		}
+		configs = append(configs, config)
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Key < configs[j].Key
+	})
+	return configs
+}
+
+// Update saves the value of the key in etcd, which is applied by all the components watching the key.
+// The value is removed if it is empty, then the components apply their default values
+func (m *Manager) Update(key string, value string) error {
+	if value == "" {
+		if _, ok := keys[key]; !ok {
+			return fmt.Errorf("%s is not a dynamic config", key)
+		}
+		return m.kv.Remove(key)
+	}
+	if err := Validate(key, value); err != nil {
+		return err
+	}
+	return m.kv.Save(key, value)
+}
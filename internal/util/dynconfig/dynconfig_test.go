@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package dynconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate("proxy.slowLog.threshold", "1000"))
+	assert.Error(t, Validate("proxy.slowLog.threshold", "1s"))
+	assert.Nil(t, Validate("proxy.shadow.sampleRatio", "0.5"))
+	assert.Error(t, Validate("proxy.shadow.sampleRatio", "2"))
+	assert.Error(t, Validate("proxy.port", "19530"))
+}
+
+func TestManager(t *testing.T) {
+	m := newManager(context.Background())
+	defer m.cancel()
+
+	var applied []string
+	assert.Nil(t, m.Watch("proxy.slowLog.threshold", "3000", func(value string) {
+		applied = append(applied, value)
+	}))
+	assert.Error(t, m.Watch("proxy.port", "19530", func(value string) {}))
+
+	m.update("proxy.slowLog.threshold", "500", false)
+	m.update("proxy.slowLog.threshold", "abc", false)
+	m.update("queryNode.slowLog.threshold", "100", false)
+	assert.Equal(t, []string{"500"}, applied)
+
+	configs := make(map[string]*Config)
+	for _, config := range m.List() {
+		configs[config.Key] = config
+	}
+	assert.Equal(t, len(keys), len(configs))
+	assert.Equal(t, &Config{Key: "proxy.slowLog.threshold", Value: "500", Default: "3000", Updated: true, Watched: true},
+		configs["proxy.slowLog.threshold"])
+	assert.Equal(t, &Config{Key: "queryNode.slowLog.threshold", Value: "100", Updated: true},
+		configs["queryNode.slowLog.threshold"])
+	assert.Equal(t, &Config{Key: "proxy.shadow.sampleRatio"}, configs["proxy.shadow.sampleRatio"])
+
+	m.update("proxy.slowLog.threshold", "", true)
+	assert.Equal(t, []string{"500", "3000"}, applied)
+	for _, config := range m.List() {
+		if config.Key == "proxy.slowLog.threshold" {
+			assert.Equal(t, "3000", config.Value)
+			assert.False(t, config.Updated)
+		}
+	}
+}
@@ -12,6 +12,9 @@
 package flowgraph
 
 import (
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
@@ -22,6 +25,12 @@ type InputNode struct {
 	BaseNode
 	inStream *msgstream.MsgStream
 	name     string
+
+	// skip mode, see SetSkipMode
+	idleThreshold int32
+	skipInterval  int32
+	numIdleTicks  int32
+	skipping      bool
 }
 
 func (inNode *InputNode) IsInputNode() bool {
@@ -40,6 +49,44 @@ func (inNode *InputNode) InStream() *msgstream.MsgStream {
 	return inNode.inStream
 }
 
+// SetSkipMode lets the input node switch to skip mode once idleThreshold msg packs without any message are consumed
+// in a row. In skip mode only one of skipInterval empty msg packs is passed to the downstream nodes, which still
+// advances their timestamps but saves the work of the idle channels, and the first msg pack with messages switches
+// the input node back. Skip mode is disabled if idleThreshold or skipInterval is not positive
+func (inNode *InputNode) SetSkipMode(idleThreshold int32, skipInterval int32) {
+	inNode.idleThreshold = idleThreshold
+	inNode.skipInterval = skipInterval
+	inNode.numIdleTicks = 0
+	inNode.skipping = false
+}
+
+// skip tells whether the msg pack is dropped in skip mode
+func (inNode *InputNode) skip(msgPack *msgstream.MsgPack) bool {
+	if inNode.idleThreshold <= 0 || inNode.skipInterval <= 0 || len(msgPack.Msgs) > 0 {
+		if inNode.skipping {
+			log.Debug("input node leaves skip mode", zap.String("node name", inNode.name))
+		}
+		inNode.skipping = false
+		inNode.numIdleTicks = 0
+		return false
+	}
+	inNode.numIdleTicks++
+	if !inNode.skipping {
+		if inNode.numIdleTicks < inNode.idleThreshold {
+			return false
+		}
+		log.Debug("input node enters skip mode", zap.String("node name", inNode.name))
+		inNode.skipping = true
+		inNode.numIdleTicks = 0
+		return false
+	}
+	if inNode.numIdleTicks < inNode.skipInterval {
+		return true
+	}
+	inNode.numIdleTicks = 0
+	return false
+}
+
 // empty input and return one *Msg
 func (inNode *InputNode) Operate(in []Msg) []Msg {
 	//fmt.Println("Do InputNode operation")
@@ -47,7 +94,7 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	msgPack := (*inNode.inStream).Consume()
 
 	// TODO: add status
-	if msgPack == nil {
+	if msgPack == nil || inNode.skip(msgPack) {
 		return nil
 	}
 	var spans []opentracing.Span
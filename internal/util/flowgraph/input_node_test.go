@@ -78,3 +78,30 @@ func TestNewInputNode(t *testing.T) {
 	assert.Equal(t, node.maxQueueLength, maxQueueLength)
 	assert.Equal(t, node.maxParallelism, maxParallelism)
 }
+
+func TestInputNode_skip(t *testing.T) {
+	node := NewInputNode(nil, "input_node", 0, 100)
+	emptyPack := &msgstream.MsgPack{}
+	msgPack := generateMsgPack()
+
+	// skip mode is disabled by default
+	for i := 0; i < 10; i++ {
+		assert.False(t, node.skip(emptyPack))
+	}
+
+	node.SetSkipMode(3, 4)
+	passed := 0
+	for i := 0; i < 3+4*5; i++ {
+		if !node.skip(emptyPack) {
+			passed++
+		}
+	}
+	// the first 3 packs, then one of every 4 packs
+	assert.Equal(t, 3+5, passed)
+	assert.True(t, node.skipping)
+
+	// a pack with messages leaves skip mode
+	assert.False(t, node.skip(&msgPack))
+	assert.False(t, node.skipping)
+	assert.False(t, node.skip(emptyPack))
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Backoff is the exponential backoff between the attempts of a call,
+// the delay of the nth retry is BaseDelay * Multiplier^n capped by MaxDelay, randomized by +/- Jitter
+type Backoff struct {
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Multiplier float64
+	Jitter     float64
+}
+
+// DefaultBackoff waits 100ms before the first retry and at most 3s
+var DefaultBackoff = Backoff{
+	BaseDelay:  100 * time.Millisecond,
+	MaxDelay:   3 * time.Second,
+	Multiplier: 2,
+	Jitter:     0.2,
+}
+
+var (
+	randMu sync.Mutex
+	random = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// Delay returns the delay before the retry, retries is the number of the retries already done
+func (b Backoff) Delay(retries int) time.Duration {
+	delay := float64(b.BaseDelay)
+	for i := 0; i < retries && delay < float64(b.MaxDelay); i++ {
+		delay *= b.Multiplier
+	}
+	if delay > float64(b.MaxDelay) {
+		delay = float64(b.MaxDelay)
+	}
+	// the jitter spreads the retries of the clients failed at the same time
+	randMu.Lock()
+	delay *= 1 + b.Jitter*(random.Float64()*2-1)
+	randMu.Unlock()
+	if delay < 0 {
+		return 0
+	}
+	return time.Duration(delay)
+}
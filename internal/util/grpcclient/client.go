@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package grpcclient dials the grpc connections of the internal clients, the addresses of a role are resolved
+// from the sessions and balanced on the client side, the failed calls are retried with an exponential backoff
+// by the retry policy and the retry budget of their call types. A Pool balances the calls of a client over
+// several connections
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+const (
+	// PickFirst sends the calls to the first available address, the others are the standbys of failover
+	PickFirst = "pick_first"
+	// RoundRobin balances the calls on all the available addresses
+	RoundRobin = "round_robin"
+)
+
+// Config is the config of a connection
+type Config struct {
+	// Role is the role of the server, such as RootCoord
+	Role string
+	// Address is dialed directly if not empty, otherwise the addresses are resolved from the sessions of Role
+	Address  string
+	Sessions SessionGetter
+	// Balancer is PickFirst or RoundRobin, it's PickFirst if empty
+	Balancer string
+	// Backoff and Policies are the retry policy of the calls, DefaultBackoff and DefaultPolicies if not set
+	Backoff  *Backoff
+	Policies map[CallType]RetryPolicy
+	// DialOptions are the options of the connection, such as the message sizes and the keepalive
+	DialOptions []grpc.DialOption
+}
+
+// Target returns the target dialed by the config
+func (cfg *Config) Target() string {
+	if cfg.Address != "" {
+		return cfg.Address
+	}
+	return SessionScheme + ":///" + cfg.Role
+}
+
+// Dial returns the connection of the config, it's blocked until the connection is ready if the options
+// contain grpc.WithBlock
+func Dial(ctx context.Context, cfg *Config) (*grpc.ClientConn, error) {
+	dialOpts, err := dialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.DialContext(ctx, cfg.Target(), dialOpts...)
+}
+
+// dialOptions returns the options of the connections of the config, the connections dialed with the same
+// options share the retry budgets
+func dialOptions(cfg *Config) ([]grpc.DialOption, error) {
+	if cfg.Address == "" && cfg.Sessions == nil {
+		return nil, fmt.Errorf("neither the address nor the sessions of %s is set", cfg.Role)
+	}
+	balancer := cfg.Balancer
+	if balancer == "" {
+		balancer = PickFirst
+	}
+	if balancer != PickFirst && balancer != RoundRobin {
+		return nil, fmt.Errorf("unsupported balancer %s of %s", balancer, cfg.Role)
+	}
+	backoff := DefaultBackoff
+	if cfg.Backoff != nil {
+		backoff = *cfg.Backoff
+	}
+	policies := cfg.Policies
+	if policies == nil {
+		policies = DefaultPolicies()
+	}
+
+	opts := trace.GetInterceptorOpts()
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingPolicy":"%s"}`, balancer)),
+		// the calls are retried by the interceptor
+		grpc.WithDisableRetry(),
+		grpc.WithUnaryInterceptor(
+			grpc_middleware.ChainUnaryClient(
+				UnaryClientInterceptor(cfg.Role, backoff, policies),
+				grpc_opentracing.UnaryClientInterceptor(opts...),
+			)),
+		grpc.WithStreamInterceptor(grpc_opentracing.StreamClientInterceptor(opts...)),
+	}, cfg.DialOptions...)
+	if cfg.Address == "" {
+		dialOpts = append(dialOpts, grpc.WithResolvers(&sessionResolverBuilder{sessions: cfg.Sessions}))
+	}
+	return dialOpts, nil
+}
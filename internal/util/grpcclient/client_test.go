@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+type mockSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*sessionutil.Session
+	err      error
+}
+
+func (m *mockSessions) GetSessions(prefix string) (map[string]*sessionutil.Session, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make(map[string]*sessionutil.Session, len(m.sessions))
+	for key, sess := range m.sessions {
+		res[key] = sess
+	}
+	return res, 0, m.err
+}
+
+func (m *mockSessions) set(sessions map[string]*sessionutil.Session) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions = sessions
+}
+
+func startHealthServer(t *testing.T) (*grpc.Server, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	return server, lis.Addr().String()
+}
+
+func TestResolveSessions(t *testing.T) {
+	sessions := &mockSessions{sessions: map[string]*sessionutil.Session{
+		"RootCoord-2": {ServerName: "RootCoord", Address: "b:1"},
+		"RootCoord":   {ServerName: "RootCoord", Address: "a:1", Exclusive: true},
+		"RootCoord-1": {ServerName: "RootCoord", Address: "c:1"},
+		"RootCoord-3": {ServerName: "RootCoord", Address: "c:1"},
+		"RootCoordX":  {ServerName: "RootCoordX", Address: "d:1"},
+	}}
+	addrs, err := ResolveSessions(sessions, "RootCoord")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a:1", "c:1", "b:1"}, addrs)
+
+	_, err = ResolveSessions(sessions, "DataCoord")
+	assert.NotNil(t, err)
+	sessions.err = errors.New("etcd failed")
+	_, err = ResolveSessions(sessions, "RootCoord")
+	assert.NotNil(t, err)
+}
+
+func TestDial(t *testing.T) {
+	ctx := context.Background()
+	_, err := Dial(ctx, &Config{Role: "RootCoord"})
+	assert.NotNil(t, err)
+	_, err = Dial(ctx, &Config{Role: "RootCoord", Address: "localhost:1", Balancer: "random"})
+	assert.NotNil(t, err)
+
+	server, addr := startHealthServer(t)
+	defer server.Stop()
+	conn, err := Dial(ctx, &Config{Role: "RootCoord", Address: addr, DialOptions: []grpc.DialOption{grpc.WithInsecure()}})
+	assert.Nil(t, err)
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.Nil(t, err)
+}
+
+func TestDialFailover(t *testing.T) {
+	refreshInterval = 100 * time.Millisecond
+	ctx := context.Background()
+	active, activeAddr := startHealthServer(t)
+	standby, standbyAddr := startHealthServer(t)
+	defer standby.Stop()
+
+	sessions := &mockSessions{sessions: map[string]*sessionutil.Session{
+		"RootCoord": {ServerName: "RootCoord", Address: activeAddr, Exclusive: true},
+	}}
+	dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	conn, err := Dial(dialCtx, &Config{
+		Role:        "RootCoord",
+		Sessions:    sessions,
+		Backoff:     &Backoff{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Multiplier: 2},
+		DialOptions: []grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()},
+	})
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.Nil(t, err)
+
+	// the standby takes over the session after the active one is down
+	active.Stop()
+	sessions.set(map[string]*sessionutil.Session{
+		"RootCoord": {ServerName: "RootCoord", Address: standbyAddr, Exclusive: true},
+	})
+	callCtx, callCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer callCancel()
+	_, err = client.Check(callCtx, &healthpb.HealthCheckRequest{})
+	assert.Nil(t, err)
+}
+
+func TestDialRoundRobin(t *testing.T) {
+	ctx := context.Background()
+	server1, addr1 := startHealthServer(t)
+	defer server1.Stop()
+	server2, addr2 := startHealthServer(t)
+	defer server2.Stop()
+
+	sessions := &mockSessions{sessions: map[string]*sessionutil.Session{
+		"IndexNode-1": {ServerName: "IndexNode", Address: addr1},
+		"IndexNode-2": {ServerName: "IndexNode", Address: addr2},
+	}}
+	dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	conn, err := Dial(dialCtx, &Config{
+		Role:        "IndexNode",
+		Sessions:    sessions,
+		Balancer:    RoundRobin,
+		DialOptions: []grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()},
+	})
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	// the calls are still served after one of the servers is down
+	server1.Stop()
+	client := healthpb.NewHealthClient(conn)
+	for i := 0; i < 4; i++ {
+		_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.Nil(t, err)
+	}
+}
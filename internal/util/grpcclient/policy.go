@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// CallType classifies the rpcs by their retry policy
+type CallType int
+
+const (
+	// ReadCall doesn't change the state of the server, such as Describe, Show and Get, it's safe to retry
+	ReadCall CallType = iota
+	// WriteCall changes the state of the server, it's only retried if the server surely didn't process it
+	WriteCall
+)
+
+func (t CallType) String() string {
+	if t == ReadCall {
+		return "read"
+	}
+	return "write"
+}
+
+var readPrefixes = []string{"Get", "Describe", "Show", "Has", "List", "Search", "Query", "Retrieve"}
+
+// CallTypeOf returns the call type of the full method name, such as /milvus.proto.rootcoord.RootCoord/DescribeCollection
+func CallTypeOf(fullMethod string) CallType {
+	method := path.Base(fullMethod)
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return ReadCall
+		}
+	}
+	return WriteCall
+}
+
+// RetryPolicy is the retry policy of a call type
+type RetryPolicy struct {
+	// MaxAttempts is the max attempts of a call including the first one
+	MaxAttempts int
+	// Codes are the status codes retried
+	Codes []codes.Code
+	// MaxTokens and TokenRatio are the retry budget of the call type, see RetryBudget
+	MaxTokens  float64
+	TokenRatio float64
+}
+
+func (p RetryPolicy) retryable(code codes.Code) bool {
+	for _, c := range p.Codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultPolicies retry the read calls on the failures of the server, and the write calls only if the server
+// is unavailable or aborted the call. The server is unavailable while a standby coordinator takes over
+func DefaultPolicies() map[CallType]RetryPolicy {
+	return map[CallType]RetryPolicy{
+		ReadCall: {
+			MaxAttempts: 5,
+			Codes:       []codes.Code{codes.Unavailable, codes.Aborted, codes.ResourceExhausted},
+			MaxTokens:   100,
+			TokenRatio:  0.1,
+		},
+		WriteCall: {
+			MaxAttempts: 3,
+			Codes:       []codes.Code{codes.Unavailable, codes.Aborted},
+			MaxTokens:   20,
+			TokenRatio:  0.1,
+		},
+	}
+}
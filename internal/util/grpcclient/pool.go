@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Pool is a pool of the connections to a role, the calls are balanced over the healthy connections round robin,
+// so that the calls of a high QPS client, such as the timestamp allocation of the proxy, don't share the streams
+// of a single http2 connection
+type Pool struct {
+	conns []*grpc.ClientConn
+	next  uint32
+}
+
+// DialPool dials size connections of the config, at least one
+func DialPool(ctx context.Context, cfg *Config, size int) (*Pool, error) {
+	if size <= 0 {
+		size = 1
+	}
+	dialOpts, err := dialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p := &Pool{conns: make([]*grpc.ClientConn, 0, size)}
+	for i := 0; i < size; i++ {
+		conn, err := grpc.DialContext(ctx, cfg.Target(), dialOpts...)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.conns = append(p.conns, conn)
+	}
+	return p, nil
+}
+
+// Size returns the number of the connections
+func (p *Pool) Size() int {
+	return len(p.conns)
+}
+
+// Conn returns the ith connection
+func (p *Pool) Conn(i int) *grpc.ClientConn {
+	return p.conns[i]
+}
+
+// healthy returns whether the connection can serve the calls, an idle connection reconnects on the next call
+func healthy(state connectivity.State) bool {
+	return state == connectivity.Ready || state == connectivity.Idle
+}
+
+// Pick returns the index of the next healthy connection round robin,
+// or the next connection if none is healthy, whose calls are retried until it reconnects
+func (p *Pool) Pick() int {
+	n := uint32(len(p.conns))
+	start := atomic.AddUint32(&p.next, 1)
+	for i := uint32(0); i < n; i++ {
+		idx := (start + i) % n
+		if healthy(p.conns[idx].GetState()) {
+			return int(idx)
+		}
+	}
+	return int(start % n)
+}
+
+// Close closes all the connections, the first error is returned
+func (p *Pool) Close() error {
+	var err error
+	for _, conn := range p.conns {
+		if cErr := conn.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestDialPool(t *testing.T) {
+	ctx := context.Background()
+	_, err := DialPool(ctx, &Config{Role: "RootCoord"}, 2)
+	assert.NotNil(t, err)
+
+	server, addr := startHealthServer(t)
+	defer server.Stop()
+	dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	pool, err := DialPool(dialCtx, &Config{
+		Role:        "RootCoord",
+		Address:     addr,
+		DialOptions: []grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()},
+	}, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pool.Size())
+
+	// round robin over the healthy connections
+	picked := make(map[int]int)
+	for i := 0; i < 6; i++ {
+		idx := pool.Pick()
+		picked[idx]++
+		_, err = healthpb.NewHealthClient(pool.Conn(idx)).Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[int]int{0: 2, 1: 2, 2: 2}, picked)
+
+	// the closed connection is skipped
+	assert.Nil(t, pool.Conn(1).Close())
+	for i := 0; i < 6; i++ {
+		assert.NotEqual(t, 1, pool.Pick())
+	}
+	assert.NotNil(t, pool.Close())
+
+	// at least one connection
+	pool, err = DialPool(dialCtx, &Config{Role: "RootCoord", Address: addr, DialOptions: []grpc.DialOption{grpc.WithInsecure()}}, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, pool.Size())
+	assert.Equal(t, 0, pool.Pick())
+	assert.Nil(t, pool.Close())
+}
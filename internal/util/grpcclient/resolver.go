@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+// SessionScheme is the scheme of the targets resolved from the sessions, such as session:///RootCoord
+const SessionScheme = "session"
+
+// refreshInterval is the interval of resolving the sessions besides the resolutions requested by grpc,
+// which are requested when a connection fails
+var refreshInterval = 10 * time.Second
+
+// SessionGetter returns the sessions registered in etcd, it's implemented by sessionutil.Session
+type SessionGetter interface {
+	GetSessions(prefix string) (map[string]*sessionutil.Session, int64, error)
+}
+
+// ResolveSessions returns the addresses of the servers of the role, the exclusive server such as the active
+// coordinator comes first, then the others sorted by their keys, that's the order of failover
+func ResolveSessions(sessions SessionGetter, role string) ([]string, error) {
+	sessMap, _, err := sessions.GetSessions(role)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(sessMap))
+	for key, sess := range sessMap {
+		if sess.ServerName == role {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if sessMap[keys[i]].Exclusive != sessMap[keys[j]].Exclusive {
+			return sessMap[keys[i]].Exclusive
+		}
+		return keys[i] < keys[j]
+	})
+
+	addrs := make([]string, 0, len(keys))
+	seen := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		addr := sessMap[key].Address
+		if _, ok := seen[addr]; ok || addr == "" {
+			continue
+		}
+		seen[addr] = struct{}{}
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no session of %s", role)
+	}
+	return addrs, nil
+}
+
+// sessionResolverBuilder builds the resolvers of the targets session:///<role>
+type sessionResolverBuilder struct {
+	sessions SessionGetter
+}
+
+func (b *sessionResolverBuilder) Scheme() string {
+	return SessionScheme
+}
+
+func (b *sessionResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &sessionResolver{
+		ctx:      ctx,
+		cancel:   cancel,
+		sessions: b.sessions,
+		role:     target.Endpoint,
+		cc:       cc,
+		resolve:  make(chan struct{}, 1),
+	}
+	r.resolveNow()
+	r.wg.Add(1)
+	go r.watch()
+	return r, nil
+}
+
+// sessionResolver updates the addresses of the connection with the sessions of the role
+type sessionResolver struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	sessions SessionGetter
+	role     string
+	cc       resolver.ClientConn
+	resolve  chan struct{}
+}
+
+func (r *sessionResolver) resolveNow() {
+	addrs, err := ResolveSessions(r.sessions, r.role)
+	if err != nil {
+		log.Debug("grpc client resolve sessions failed", zap.String("role", r.role), zap.Error(err))
+		r.cc.ReportError(err)
+		return
+	}
+	state := resolver.State{Addresses: make([]resolver.Address, 0, len(addrs))}
+	for _, addr := range addrs {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr})
+	}
+	r.cc.UpdateState(state)
+}
+
+func (r *sessionResolver) watch() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolve:
+		}
+		r.resolveNow()
+	}
+}
+
+// ResolveNow is called by grpc when a connection fails, such as the active coordinator is down
+func (r *sessionResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+	}
+}
+
+func (r *sessionResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
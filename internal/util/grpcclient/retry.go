@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// retrier retries the failed unary calls by the policy of their call types
+type retrier struct {
+	role     string
+	backoff  Backoff
+	policies map[CallType]RetryPolicy
+	budgets  map[CallType]*RetryBudget
+}
+
+func newRetrier(role string, backoff Backoff, policies map[CallType]RetryPolicy) *retrier {
+	r := &retrier{
+		role:     role,
+		backoff:  backoff,
+		policies: policies,
+		budgets:  make(map[CallType]*RetryBudget, len(policies)),
+	}
+	for callType, policy := range policies {
+		r.budgets[callType] = NewRetryBudget(policy.MaxTokens, policy.TokenRatio)
+	}
+	return r
+}
+
+// UnaryClientInterceptor returns the interceptor retrying the calls to the role by the policies,
+// the call types without a policy are not retried
+func UnaryClientInterceptor(role string, backoff Backoff, policies map[CallType]RetryPolicy) grpc.UnaryClientInterceptor {
+	return newRetrier(role, backoff, policies).intercept
+}
+
+func (r *retrier) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	callType := CallTypeOf(method)
+	policy, ok := r.policies[callType]
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	budget := r.budgets[callType]
+
+	for attempt := 1; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			budget.OnSuccess()
+			return nil
+		}
+		code := status.Code(err)
+		if !policy.retryable(code) || attempt >= policy.MaxAttempts {
+			return err
+		}
+		if !budget.AllowRetry() {
+			log.Warn("grpc client retry budget exhausted", zap.String("role", r.role), zap.String("method", method),
+				zap.Stringer("callType", callType), zap.Error(err))
+			return err
+		}
+
+		delay := r.backoff.Delay(attempt - 1)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return err
+		}
+		log.Debug("grpc client retry", zap.String("role", r.role), zap.String("method", method),
+			zap.Int("attempt", attempt), zap.Duration("delay", delay), zap.Error(err))
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	b := Backoff{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, b.Delay(0))
+	assert.Equal(t, 400*time.Millisecond, b.Delay(2))
+	assert.Equal(t, time.Second, b.Delay(10))
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := b.Delay(1)
+		assert.True(t, delay >= 100*time.Millisecond && delay <= 300*time.Millisecond)
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	budget := NewRetryBudget(4, 0.5)
+	assert.True(t, budget.AllowRetry())
+	assert.False(t, budget.AllowRetry())
+	assert.False(t, budget.AllowRetry())
+	assert.Equal(t, float64(1), budget.Tokens())
+
+	budget.OnSuccess()
+	budget.OnSuccess()
+	budget.OnSuccess()
+	assert.Equal(t, 2.5, budget.Tokens())
+	assert.False(t, budget.AllowRetry())
+	for i := 0; i < 10; i++ {
+		budget.OnSuccess()
+	}
+	assert.Equal(t, float64(4), budget.Tokens())
+}
+
+func TestCallTypeOf(t *testing.T) {
+	assert.Equal(t, ReadCall, CallTypeOf("/milvus.proto.rootcoord.RootCoord/DescribeCollection"))
+	assert.Equal(t, ReadCall, CallTypeOf("/milvus.proto.query.QueryCoord/GetSegmentInfo"))
+	assert.Equal(t, WriteCall, CallTypeOf("/milvus.proto.rootcoord.RootCoord/CreateCollection"))
+	assert.Equal(t, WriteCall, CallTypeOf("/milvus.proto.data.DataCoord/Flush"))
+	assert.Equal(t, "read", ReadCall.String())
+	assert.Equal(t, "write", WriteCall.String())
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	backoff := Backoff{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 2}
+	policies := map[CallType]RetryPolicy{
+		ReadCall:  {MaxAttempts: 3, Codes: []codes.Code{codes.Unavailable}, MaxTokens: 10, TokenRatio: 1},
+		WriteCall: {MaxAttempts: 1, Codes: []codes.Code{codes.Unavailable}, MaxTokens: 10, TokenRatio: 1},
+	}
+	interceptor := UnaryClientInterceptor("test", backoff, policies)
+
+	calls := 0
+	failing := func(failures int, code codes.Code) grpc.UnaryInvoker {
+		calls = 0
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls++
+			if calls <= failures {
+				return status.Error(code, "failed")
+			}
+			return nil
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("retried", func(t *testing.T) {
+		err := interceptor(ctx, "/test/GetA", nil, nil, nil, failing(2, codes.Unavailable))
+		assert.Nil(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		err := interceptor(ctx, "/test/GetA", nil, nil, nil, failing(3, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		err := interceptor(ctx, "/test/GetA", nil, nil, nil, failing(1, codes.InvalidArgument))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 1, calls)
+
+		err = interceptor(ctx, "/test/CreateA", nil, nil, nil, failing(1, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("budget exhausted", func(t *testing.T) {
+		r := newRetrier("test", backoff, policies)
+		for i := 0; i < 3; i++ {
+			_ = r.intercept(ctx, "/test/GetA", nil, nil, nil, failing(10, codes.Unavailable))
+		}
+		err := r.intercept(ctx, "/test/GetA", nil, nil, nil, failing(1, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		slow := Backoff{BaseDelay: time.Hour, MaxDelay: time.Hour, Multiplier: 2}
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		err := UnaryClientInterceptor("test", slow, policies)(ctx, "/test/GetA", nil, nil, nil, failing(1, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		err := UnaryClientInterceptor("test", backoff, nil)(ctx, "/test/GetA", nil, nil, nil, failing(1, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+}
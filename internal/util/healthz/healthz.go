@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	// LivenessPath is the path of the liveness probe, it fails if any component is abnormal
+	LivenessPath = "/healthz"
+	// ReadinessPath is the path of the readiness probe, it fails unless all the components are healthy
+	ReadinessPath = "/readyz"
+
+	checkTimeout = 3 * time.Second
+)
+
+// Indicator is implemented by the components whose states are reported by the probes
+type Indicator interface {
+	GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
+}
+
+// ComponentState is the state of a component in the response of the probes
+type ComponentState struct {
+	Role      string `json:"role"`
+	StateCode string `json:"state_code"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Response is the response of the probes
+type Response struct {
+	Healthy    bool             `json:"healthy"`
+	Components []ComponentState `json:"components"`
+}
+
+// HealthHandler serves the liveness and readiness probes of the registered components
+type HealthHandler struct {
+	mu         sync.RWMutex
+	roles      []string
+	indicators map[string]Indicator
+}
+
+var defaultHandler = NewHealthHandler()
+
+// NewHealthHandler returns a HealthHandler without any component
+func NewHealthHandler() *HealthHandler {
+	return &HealthHandler{
+		indicators: make(map[string]Indicator),
+	}
+}
+
+// Register adds a component to the probes of the default handler
+func Register(role string, indicator Indicator) {
+	defaultHandler.Register(role, indicator)
+}
+
+// Handle serves the probes of the default handler on mux
+func Handle(mux *http.ServeMux) {
+	defaultHandler.Handle(mux)
+}
+
+// Register adds a component to the probes
+func (h *HealthHandler) Register(role string, indicator Indicator) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.indicators[role]; !ok {
+		h.roles = append(h.roles, role)
+	}
+	h.indicators[role] = indicator
+}
+
+// Handle serves the probes on mux
+func (h *HealthHandler) Handle(mux *http.ServeMux) {
+	mux.HandleFunc(LivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, func(code internalpb.StateCode) bool {
+			return code != internalpb.StateCode_Abnormal
+		})
+	})
+	mux.HandleFunc(ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, func(code internalpb.StateCode) bool {
+			return code == internalpb.StateCode_Healthy
+		})
+	})
+}
+
+func (h *HealthHandler) check(ctx context.Context) []ComponentState {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ret := make([]ComponentState, 0, len(h.roles))
+	for _, role := range h.roles {
+		state := ComponentState{
+			Role:      role,
+			StateCode: internalpb.StateCode_Abnormal.String(),
+		}
+		states, err := h.indicators[role].GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+		switch {
+		case err != nil:
+			state.Reason = err.Error()
+		case states.Status.ErrorCode != commonpb.ErrorCode_Success:
+			state.Reason = states.Status.Reason
+		case states.State == nil:
+			state.Reason = "state is not reported"
+		default:
+			state.StateCode = states.State.StateCode.String()
+		}
+		ret = append(ret, state)
+	}
+	return ret
+}
+
+func (h *HealthHandler) serve(w http.ResponseWriter, r *http.Request, isHealthy func(code internalpb.StateCode) bool) {
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	resp := Response{
+		Healthy:    true,
+		Components: h.check(ctx),
+	}
+	for _, state := range resp.Components {
+		if !isHealthy(internalpb.StateCode(internalpb.StateCode_value[state.StateCode])) {
+			resp.Healthy = false
+		}
+	}
+	if r.URL.Path == ReadinessPath && len(resp.Components) == 0 {
+		resp.Healthy = false
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warn("failed to write the response of probe", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+type mockIndicator struct {
+	code internalpb.StateCode
+	err  error
+}
+
+func (m *mockIndicator) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &internalpb.ComponentStates{
+		State:  &internalpb.ComponentInfo{StateCode: m.code},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func probe(t *testing.T, mux *http.ServeMux, path string) (int, Response) {
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	resp := Response{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+	return w.Code, resp
+}
+
+func TestHealthHandler(t *testing.T) {
+	h := NewHealthHandler()
+	mux := http.NewServeMux()
+	h.Handle(mux)
+
+	code, _ := probe(t, mux, LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = probe(t, mux, ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	proxy := &mockIndicator{code: internalpb.StateCode_Initializing}
+	queryNode := &mockIndicator{code: internalpb.StateCode_Healthy}
+	h.Register("Proxy", proxy)
+	h.Register("QueryNode", queryNode)
+
+	code, resp := probe(t, mux, LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, resp.Healthy)
+	assert.Equal(t, []ComponentState{
+		{Role: "Proxy", StateCode: "Initializing"},
+		{Role: "QueryNode", StateCode: "Healthy"},
+	}, resp.Components)
+	code, resp = probe(t, mux, ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, resp.Healthy)
+
+	proxy.code = internalpb.StateCode_Healthy
+	code, _ = probe(t, mux, ReadinessPath)
+	assert.Equal(t, http.StatusOK, code)
+
+	queryNode.err = errors.New("mock")
+	code, resp = probe(t, mux, LivenessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, ComponentState{Role: "QueryNode", StateCode: "Abnormal", Reason: "mock"}, resp.Components[1])
+
+	queryNode.err = nil
+	queryNode.code = internalpb.StateCode_Abnormal
+	code, _ = probe(t, mux, LivenessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
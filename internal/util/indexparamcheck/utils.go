@@ -2,6 +2,7 @@ package indexparamcheck
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
@@ -28,3 +29,25 @@ func CheckStrByValues(params map[string]string, key string, container []string)
 
 	return funcutil.SliceContain(container, value)
 }
+
+// gpuIndexFallbacks are the index types which are only built on gpu, mapped to the cpu index types of the same
+// search params which are built instead when there is no gpu
+var gpuIndexFallbacks = map[IndexType]IndexType{
+	IndexFaissIvfSQ8H: IndexFaissIvfSQ8,
+}
+
+// IsGPUIndex returns whether the index of the index type and the index mode is built on gpu
+func IsGPUIndex(indexType IndexType, mode string) bool {
+	if _, ok := gpuIndexFallbacks[indexType]; ok {
+		return true
+	}
+	return strings.EqualFold(mode, GPUMode)
+}
+
+// CPUFallbackIndexType returns the index type built on cpu in place of the index type
+func CPUFallbackIndexType(indexType IndexType) IndexType {
+	if fallback, ok := gpuIndexFallbacks[indexType]; ok {
+		return fallback
+	}
+	return indexType
+}
@@ -85,3 +85,30 @@ func Test_CheckStrByValues(t *testing.T) {
 		}
 	}
 }
+
+func Test_IsGPUIndex(t *testing.T) {
+	cases := []struct {
+		indexType IndexType
+		mode      string
+		want      bool
+	}{
+		{IndexFaissIvfSQ8H, "", true},
+		{IndexFaissIvfPQ, GPUMode, true},
+		{IndexFaissIvfPQ, "gpu", true},
+		{IndexFaissIvfPQ, CPUMode, false},
+		{IndexHNSW, "", false},
+	}
+
+	for _, test := range cases {
+		if got := IsGPUIndex(test.indexType, test.mode); got != test.want {
+			t.Errorf("IsGPUIndex(%v, %v) = %v", test.indexType, test.mode, got)
+		}
+	}
+
+	if got := CPUFallbackIndexType(IndexFaissIvfSQ8H); got != IndexFaissIvfSQ8 {
+		t.Errorf("CPUFallbackIndexType(%v) = %v", IndexFaissIvfSQ8H, got)
+	}
+	if got := CPUFallbackIndexType(IndexHNSW); got != IndexHNSW {
+		t.Errorf("CPUFallbackIndexType(%v) = %v", IndexHNSW, got)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package merr defines the typed errors of the components. An error carries the error code returned to the clients
+// by the status of the responses, so that they branch on the code instead of the reason, and the code tells whether
+// the request may succeed if retried and which grpc code the error is mapped to
+package merr
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
+)
+
+// milvusError is an error of a code, the errors of the same code match each other by errors.Is
+type milvusError struct {
+	code commonpb.ErrorCode
+	msg  string
+}
+
+func newMilvusError(code commonpb.ErrorCode, msg string) *milvusError {
+	return &milvusError{code: code, msg: msg}
+}
+
+func (e *milvusError) Error() string {
+	return e.msg
+}
+
+func (e *milvusError) Is(target error) bool {
+	t, ok := target.(*milvusError)
+	return ok && t.code == e.code
+}
+
+// GRPCStatus is used by grpc, so that an error returned by a grpc handler or an interceptor has the grpc code of
+// its code
+func (e *milvusError) GRPCStatus() *status.Status {
+	return status.New(GRPCCode(e.code), e.msg)
+}
+
+var (
+	ErrServiceNotReady    = newMilvusError(commonpb.ErrorCode_NotReadyToServe, "service not ready")
+	ErrConnectFailed      = newMilvusError(commonpb.ErrorCode_ConnectFailed, "connect failed")
+	ErrPermissionDenied   = newMilvusError(commonpb.ErrorCode_PermissionDenied, "permission denied")
+	ErrRateLimited        = newMilvusError(commonpb.ErrorCode_RateLimited, "rate limited")
+	ErrCollectionNotFound = newMilvusError(commonpb.ErrorCode_CollectionNotExists, "collection not found")
+	ErrPartitionNotFound  = newMilvusError(commonpb.ErrorCode_PartitionNotExists, "partition not found")
+	ErrIndexNotFound      = newMilvusError(commonpb.ErrorCode_IndexNotExist, "index not found")
+	ErrSegmentNotLoaded   = newMilvusError(commonpb.ErrorCode_SegmentNotLoaded, "segment not loaded")
+	ErrTimeTickLagging    = newMilvusError(commonpb.ErrorCode_TimeTickLagging, "time tick lagging")
+	ErrQuotaExceeded      = newMilvusError(commonpb.ErrorCode_QuotaExceeded, "quota exceeded")
+	ErrIllegalArgument    = newMilvusError(commonpb.ErrorCode_IllegalArgument, "illegal argument")
+	ErrIllegalTopK        = newMilvusError(commonpb.ErrorCode_IllegalTOPK, "illegal topk")
+	ErrIllegalDimension   = newMilvusError(commonpb.ErrorCode_IllegalDimension, "illegal dimension")
+)
+
+// retriableCodes are the codes of the errors which are transient, the requests may succeed if retried later
+var retriableCodes = map[commonpb.ErrorCode]bool{
+	commonpb.ErrorCode_ConnectFailed:    true,
+	commonpb.ErrorCode_NotReadyToServe:  true,
+	commonpb.ErrorCode_RateLimited:      true,
+	commonpb.ErrorCode_SegmentNotLoaded: true,
+	commonpb.ErrorCode_TimeTickLagging:  true,
+	commonpb.ErrorCode_DDRequestRace:    true,
+}
+
+var grpcCodes = map[commonpb.ErrorCode]codes.Code{
+	commonpb.ErrorCode_Success:               codes.OK,
+	commonpb.ErrorCode_ConnectFailed:         codes.Unavailable,
+	commonpb.ErrorCode_NotReadyToServe:       codes.Unavailable,
+	commonpb.ErrorCode_SegmentNotLoaded:      codes.Unavailable,
+	commonpb.ErrorCode_TimeTickLagging:       codes.DeadlineExceeded,
+	commonpb.ErrorCode_PermissionDenied:      codes.PermissionDenied,
+	commonpb.ErrorCode_RateLimited:           codes.ResourceExhausted,
+	commonpb.ErrorCode_QuotaExceeded:         codes.ResourceExhausted,
+	commonpb.ErrorCode_OutOfMemory:           codes.ResourceExhausted,
+	commonpb.ErrorCode_CollectionNotExists:   codes.NotFound,
+	commonpb.ErrorCode_PartitionNotExists:    codes.NotFound,
+	commonpb.ErrorCode_IndexNotExist:         codes.NotFound,
+	commonpb.ErrorCode_FileNotFound:          codes.NotFound,
+	commonpb.ErrorCode_IllegalArgument:       codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalDimension:      codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalIndexType:      codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalCollectionName: codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalTOPK:           codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalRowRecord:      codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalVectorID:       codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalNLIST:          codes.InvalidArgument,
+	commonpb.ErrorCode_IllegalMetricType:     codes.InvalidArgument,
+	commonpb.ErrorCode_DDRequestRace:         codes.Aborted,
+}
+
+// Errorf returns an error of the code of base with the formatted message, it matches base by errors.Is
+func Errorf(base error, format string, args ...interface{}) error {
+	return newMilvusError(Code(base), fmt.Sprintf(format, args...))
+}
+
+func WrapErrServiceNotReady(role string) error {
+	return Errorf(ErrServiceNotReady, "%s not ready", role)
+}
+
+func WrapErrCollectionNotFound(collection string) error {
+	return Errorf(ErrCollectionNotFound, "collection %s not found", collection)
+}
+
+func WrapErrPartitionNotFound(partition string) error {
+	return Errorf(ErrPartitionNotFound, "partition %s not found", partition)
+}
+
+func WrapErrSegmentNotLoaded(segmentID int64) error {
+	return Errorf(ErrSegmentNotLoaded, "segment %d not loaded", segmentID)
+}
+
+// Code returns the code of err, the errors of the C functions have their codes as well, the others are unexpected
+func Code(err error) commonpb.ErrorCode {
+	if err == nil {
+		return commonpb.ErrorCode_Success
+	}
+	var e *milvusError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return cgoerror.ErrorCode(err)
+}
+
+// IsRetriable returns whether the request failed by err may succeed if retried
+func IsRetriable(err error) bool {
+	return err != nil && retriableCodes[Code(err)]
+}
+
+// IsRetriableCode returns whether the request failed with the code may succeed if retried
+func IsRetriableCode(code commonpb.ErrorCode) bool {
+	return retriableCodes[code]
+}
+
+// Status returns the status of err, which is a success if err is nil
+func Status(err error) *commonpb.Status {
+	if err == nil {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	return &commonpb.Status{ErrorCode: Code(err), Reason: err.Error()}
+}
+
+// Error returns the error of a status returned by a component, which is nil if it's a success. The error has the
+// code of the status, so that it's passed on to the clients
+func Error(status *commonpb.Status) error {
+	if status.GetErrorCode() == commonpb.ErrorCode_Success {
+		return nil
+	}
+	return newMilvusError(status.ErrorCode, status.Reason)
+}
+
+// GRPCCode returns the grpc code of an error code, the unexpected errors are Unknown
+func GRPCCode(code commonpb.ErrorCode) codes.Code {
+	if c, ok := grpcCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
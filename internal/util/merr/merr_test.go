@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package merr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/cgoerror"
+)
+
+func TestError(t *testing.T) {
+	err := WrapErrCollectionNotFound("coll")
+	assert.Equal(t, "collection coll not found", err.Error())
+	assert.True(t, errors.Is(err, ErrCollectionNotFound))
+	assert.False(t, errors.Is(err, ErrPartitionNotFound))
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, Code(err))
+	assert.False(t, IsRetriable(err))
+
+	// the code is kept through the wrapping
+	wrapped := fmt.Errorf("describe collection failed: %w", Errorf(ErrSegmentNotLoaded, "segment %d is released", 100))
+	assert.True(t, errors.Is(wrapped, ErrSegmentNotLoaded))
+	assert.Equal(t, commonpb.ErrorCode_SegmentNotLoaded, Code(wrapped))
+	assert.True(t, IsRetriable(wrapped))
+
+	assert.Equal(t, commonpb.ErrorCode_Success, Code(nil))
+	assert.False(t, IsRetriable(nil))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, Code(errors.New("mock")))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, Code(cgoerror.Newf("Search", commonpb.ErrorCode_IllegalArgument, "mock")))
+	assert.True(t, IsRetriableCode(commonpb.ErrorCode_RateLimited))
+	assert.True(t, IsRetriable(Errorf(ErrTimeTickLagging, "channel is lagging")))
+	// the quota isn't released by retrying
+	assert.False(t, IsRetriable(Errorf(ErrQuotaExceeded, "collection num exceeds the limit")))
+}
+
+func TestStatus(t *testing.T) {
+	assert.Equal(t, commonpb.ErrorCode_Success, Status(nil).ErrorCode)
+	assert.Nil(t, Error(Status(nil)))
+	assert.Nil(t, Error(nil))
+
+	s := Status(WrapErrPartitionNotFound("p1"))
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, s.ErrorCode)
+	assert.Equal(t, "partition p1 not found", s.Reason)
+
+	// the error of a status returned by another component matches the sentinel of its code
+	err := Error(&commonpb.Status{ErrorCode: commonpb.ErrorCode_NotReadyToServe, Reason: "query node 1 is not ready"})
+	assert.True(t, errors.Is(err, ErrServiceNotReady))
+	assert.True(t, IsRetriable(err))
+	assert.Equal(t, "query node 1 is not ready", err.Error())
+
+	s = Status(errors.New("mock"))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, s.ErrorCode)
+	assert.Equal(t, "mock", s.Reason)
+}
+
+func TestGRPCStatus(t *testing.T) {
+	st, ok := status.FromError(Errorf(ErrRateLimited, "api key ingest exceeds its rate limit"))
+	assert.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, "api key ingest exceeds its rate limit", st.Message())
+
+	assert.Equal(t, codes.NotFound, status.Code(WrapErrCollectionNotFound("coll")))
+	assert.Equal(t, codes.Unavailable, status.Code(WrapErrServiceNotReady("proxy")))
+	assert.Equal(t, codes.InvalidArgument, GRPCCode(commonpb.ErrorCode_IllegalTOPK))
+	assert.Equal(t, codes.DeadlineExceeded, GRPCCode(commonpb.ErrorCode_TimeTickLagging))
+	assert.Equal(t, codes.ResourceExhausted, GRPCCode(commonpb.ErrorCode_QuotaExceeded))
+	assert.Equal(t, codes.Unknown, GRPCCode(commonpb.ErrorCode_UnexpectedError))
+}
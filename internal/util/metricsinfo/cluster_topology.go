@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The external dependencies in the cluster topology graph
+const (
+	EtcdDependency         = "Etcd"
+	MessageQueueDependency = "MessageQueue"
+	ObjectStoreDependency  = "ObjectStore"
+)
+
+// DependOn is the edge from a component to an external dependency it uses
+const DependOn ConnectionType = "depend"
+
+// The states of the nodes in the cluster topology graph
+const (
+	HealthyState = "Healthy"
+	// AbnormalState is the state of a component which reports an error in its metrics
+	AbnormalState = "Abnormal"
+	// UnreachableState is the state of a component or a dependency which can't be connected to
+	UnreachableState = "Unreachable"
+)
+
+// ClusterTopologyNode is a component or an external dependency in the cluster topology graph,
+// the nodes are identified by their names
+type ClusterTopologyNode struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"` // role of the component such as QueryNode, or the kind of the dependency such as Etcd
+	State   string `json:"state"`
+	Reason  string `json:"reason,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+// ClusterTopologyEdge is a connection between two nodes of the cluster topology graph
+type ClusterTopologyEdge struct {
+	Source string         `json:"source"`
+	Target string         `json:"target"`
+	Type   ConnectionType `json:"type"`
+}
+
+// ClusterTopology is the full graph of the cluster composed by proxy, including the coordinators, the nodes
+// managed by them and the external dependencies with their reachability
+type ClusterTopology struct {
+	Nodes []ClusterTopologyNode `json:"nodes"`
+	Edges []ClusterTopologyEdge `json:"edges"`
+}
+
+// AddNode adds a node to the graph, the node replaces the one with the same name
+func (t *ClusterTopology) AddNode(node ClusterTopologyNode) {
+	for i := range t.Nodes {
+		if t.Nodes[i].Name == node.Name {
+			t.Nodes[i] = node
+			return
+		}
+	}
+	t.Nodes = append(t.Nodes, node)
+}
+
+// HasNode returns whether a node with the name is in the graph
+func (t *ClusterTopology) HasNode(name string) bool {
+	for _, node := range t.Nodes {
+		if node.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AddEdge adds an edge to the graph, the duplicated edges and the self loops are ignored
+func (t *ClusterTopology) AddEdge(source, target string, connType ConnectionType) {
+	if source == target {
+		return
+	}
+	for _, edge := range t.Edges {
+		if edge.Source == source && edge.Target == target && edge.Type == connType {
+			return
+		}
+	}
+	t.Edges = append(t.Edges, ClusterTopologyEdge{Source: source, Target: target, Type: connType})
+}
+
+// dependencyHost returns the host:port of an address, the scheme such as pulsar:// or http:// is trimmed
+func dependencyHost(address string) string {
+	if strings.Contains(address, "://") {
+		if u, err := url.Parse(address); err == nil && u.Host != "" {
+			return u.Host
+		}
+	}
+	return address
+}
+
+// CheckReachable returns an error if a tcp connection to the address can't be set up within the timeout
+func CheckReachable(address string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", dependencyHost(address), timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterTopology(t *testing.T) {
+	topology := &ClusterTopology{}
+	topology.AddNode(ClusterTopologyNode{Name: "Proxy1", State: UnreachableState})
+	topology.AddNode(ClusterTopologyNode{Name: "Proxy1", State: HealthyState})
+	topology.AddNode(ClusterTopologyNode{Name: EtcdDependency})
+	assert.Equal(t, 2, len(topology.Nodes))
+	assert.Equal(t, HealthyState, topology.Nodes[0].State)
+	assert.True(t, topology.HasNode(EtcdDependency))
+	assert.False(t, topology.HasNode("QueryNode1"))
+
+	topology.AddEdge("Proxy1", EtcdDependency, DependOn)
+	topology.AddEdge("Proxy1", EtcdDependency, DependOn)
+	topology.AddEdge("Proxy1", "Proxy1", Forward)
+	assert.Equal(t, []ClusterTopologyEdge{{Source: "Proxy1", Target: EtcdDependency, Type: DependOn}}, topology.Edges)
+
+	s, err := MarshalTopology(topology)
+	assert.Nil(t, err)
+	decoded := &ClusterTopology{}
+	assert.Nil(t, UnmarshalTopology(s, decoded))
+	assert.Equal(t, topology, decoded)
+}
+
+func TestCheckReachable(t *testing.T) {
+	assert.Equal(t, "localhost:6650", dependencyHost("pulsar://localhost:6650"))
+	assert.Equal(t, "localhost:2379", dependencyHost("http://localhost:2379"))
+	assert.Equal(t, "localhost:9000", dependencyHost("localhost:9000"))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	address := l.Addr().String()
+	assert.Nil(t, CheckReachable("http://"+address, time.Second))
+	l.Close()
+	assert.Error(t, CheckReachable(address, time.Second))
+}
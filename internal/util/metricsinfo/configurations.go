@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// RedactedValue replaces the values of the secret configurations
+const RedactedValue = "******"
+
+// secretFieldNames are the lower case substrings of the names of the secret configurations
+var secretFieldNames = []string{"secret", "password", "token", "accesskey"}
+
+// ComponentConfigurations are the effective configurations of a component, the keys are the field
+// paths of its param table such as "Log.File.MaxSize"
+type ComponentConfigurations struct {
+	Name           string                 `json:"name"`
+	Configurations map[string]interface{} `json:"configurations"`
+}
+
+func isSecretField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, secret := range secretFieldNames {
+		if strings.Contains(lower, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// collectConfigurations flattens the exported fields of v into configs, the embedded structs such as
+// the base table are flattened without their names, the fields which can't be encoded are skipped
+func collectConfigurations(prefix string, v reflect.Value, configs map[string]interface{}) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			configs[prefix] = nil
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" && !field.Anonymous {
+				continue
+			}
+			name := prefix
+			if !field.Anonymous {
+				if name != "" {
+					name += "."
+				}
+				name += field.Name
+			}
+			if !field.Anonymous && isSecretField(field.Name) {
+				configs[name] = RedactedValue
+				continue
+			}
+			collectConfigurations(name, v.Field(i), configs)
+		}
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+	default:
+		if prefix == "" || !v.CanInterface() {
+			return
+		}
+		if v.Type() == durationType {
+			configs[prefix] = v.Interface().(time.Duration).String()
+			return
+		}
+		configs[prefix] = v.Interface()
+	}
+}
+
+// GetConfigurations returns the effective configurations in the param table of a component, with the
+// secrets redacted
+func GetConfigurations(componentName string, params interface{}) *ComponentConfigurations {
+	configs := make(map[string]interface{})
+	collectConfigurations("", reflect.ValueOf(params), configs)
+	return &ComponentConfigurations{
+		Name:           componentName,
+		Configurations: configs,
+	}
+}
+
+// GetConfigurationsMetrics returns the response of the SystemConfigurationsMetrics request of a component
+func GetConfigurationsMetrics(componentName string, params interface{}) (*milvuspb.GetMetricsResponse, error) {
+	resp, err := json.Marshal(GetConfigurations(componentName, params))
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("failed to encode the configurations: %s", err.Error()),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}, nil
+}
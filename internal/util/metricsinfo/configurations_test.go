@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+type mockBaseTable struct {
+	params map[string]string
+}
+
+type mockFileConfig struct {
+	Filename string
+	MaxSize  int
+}
+
+type mockParamTable struct {
+	mockBaseTable
+
+	NodeID               int64
+	EtcdEndpoints        []string
+	Timeout              time.Duration
+	MinioSecretAccessKey string
+	File                 mockFileConfig
+	Minio                *mockFileConfig
+	OnChange             func()
+	unexported           string
+}
+
+func TestGetConfigurations(t *testing.T) {
+	params := &mockParamTable{
+		mockBaseTable:        mockBaseTable{params: map[string]string{"a": "b"}},
+		NodeID:               1,
+		EtcdEndpoints:        []string{"localhost:2379"},
+		Timeout:              3 * time.Second,
+		MinioSecretAccessKey: "minioadmin",
+		File:                 mockFileConfig{Filename: "proxy.log", MaxSize: 300},
+		unexported:           "hidden",
+	}
+
+	configs := GetConfigurations("proxy1", params)
+	assert.Equal(t, "proxy1", configs.Name)
+	assert.Equal(t, map[string]interface{}{
+		"NodeID":               int64(1),
+		"EtcdEndpoints":        []string{"localhost:2379"},
+		"Timeout":              "3s",
+		"MinioSecretAccessKey": RedactedValue,
+		"File.Filename":        "proxy.log",
+		"File.MaxSize":         300,
+		"Minio":                nil,
+	}, configs.Configurations)
+
+	resp, err := GetConfigurationsMetrics("proxy1", params)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, "proxy1", resp.ComponentName)
+	decoded := &ComponentConfigurations{}
+	assert.Nil(t, json.Unmarshal([]byte(resp.Response), decoded))
+	assert.Equal(t, RedactedValue, decoded.Configurations["MinioSecretAccessKey"])
+	assert.NotContains(t, resp.Response, "minioadmin")
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	cgroupRoot  = "/sys/fs/cgroup"
+	numaNodeDir = "/sys/devices/system/node"
+)
+
+// GetCPUQuota returns the cpus the cgroup of the process is allowed to use, such as 1.5 for a kubernetes
+// cpu limit of 1500m, 0 if the cgroup has no cpu quota
+func GetCPUQuota() float64 {
+	quota, err := readCPUQuota(cgroupRoot)
+	if err != nil {
+		log.Debug("no cpu quota of cgroup was found", zap.Error(err))
+		return 0
+	}
+	return quota
+}
+
+// GetUsableCPUCount returns the cpus the process can use, which is the cpu quota of the cgroup rounded up and
+// capped by the cpus the process is scheduled on
+func GetUsableCPUCount() int {
+	return usableCPUCount(runtime.NumCPU(), GetCPUQuota())
+}
+
+// GetNUMANodes returns the cpus of the NUMA nodes ordered by the node id, nil if the topology is unknown
+func GetNUMANodes() [][]int {
+	nodes, err := readNUMANodes(numaNodeDir)
+	if err != nil {
+		log.Debug("no NUMA topology was found", zap.Error(err))
+		return nil
+	}
+	return nodes
+}
+
+func usableCPUCount(cpus int, quota float64) int {
+	if quota <= 0 {
+		return cpus
+	}
+	n := int(math.Ceil(quota))
+	if n > cpus {
+		return cpus
+	}
+	return n
+}
+
+// readCPUQuota reads cpu.max of cgroup v2, or cpu.cfs_quota_us and cpu.cfs_period_us of cgroup v1
+func readCPUQuota(root string) (float64, error) {
+	if content, err := ioutil.ReadFile(filepath.Join(root, "cpu.max")); err == nil {
+		// "max 100000" or "150000 100000"
+		fields := strings.Fields(string(content))
+		if len(fields) != 2 {
+			return 0, fmt.Errorf("invalid cpu.max %q", string(content))
+		}
+		if fields[0] == "max" {
+			return 0, nil
+		}
+		return parseCPUQuota(fields[0], fields[1])
+	}
+
+	for _, dir := range []string{"cpu", "cpu,cpuacct"} {
+		quota, err := ioutil.ReadFile(filepath.Join(root, dir, "cpu.cfs_quota_us"))
+		if err != nil {
+			continue
+		}
+		period, err := ioutil.ReadFile(filepath.Join(root, dir, "cpu.cfs_period_us"))
+		if err != nil {
+			return 0, err
+		}
+		// -1 is unlimited
+		if strings.TrimSpace(string(quota)) == "-1" {
+			return 0, nil
+		}
+		return parseCPUQuota(strings.TrimSpace(string(quota)), strings.TrimSpace(string(period)))
+	}
+	return 0, errors.New("no cpu controller of cgroup under " + root)
+}
+
+func parseCPUQuota(quota, period string) (float64, error) {
+	q, err := strconv.ParseInt(quota, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	p, err := strconv.ParseInt(period, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if q <= 0 || p <= 0 {
+		return 0, fmt.Errorf("invalid cpu quota %d and period %d", q, p)
+	}
+	return float64(q) / float64(p), nil
+}
+
+// readNUMANodes reads the cpulist of the node directories such as node0 and node1
+func readNUMANodes(dir string) ([][]int, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no NUMA node under " + dir)
+	}
+	ids := make([]int, 0, len(paths))
+	for _, path := range paths {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(path), "node"))
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	nodes := make([][]int, 0, len(ids))
+	for _, id := range ids {
+		content, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf("node%d", id), "cpulist"))
+		if err != nil {
+			return nil, err
+		}
+		cpus, err := parseCPUList(strings.TrimSpace(string(content)))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, cpus)
+	}
+	return nodes, nil
+}
+
+// parseCPUList parses a cpu list such as "0-3,8,10-11", an empty list is a node without cpus
+func parseCPUList(list string) ([]int, error) {
+	cpus := make([]int, 0)
+	if list == "" {
+		return cpus, nil
+	}
+	for _, part := range strings.Split(list, ",") {
+		bounds := strings.SplitN(part, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if last < first {
+			return nil, fmt.Errorf("invalid cpu range %q", part)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
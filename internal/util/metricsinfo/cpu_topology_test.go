@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func TestReadCPUQuota(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = readCPUQuota(dir)
+	assert.Error(t, err)
+
+	// cgroup v1
+	writeFile(t, filepath.Join(dir, "cpu,cpuacct", "cpu.cfs_quota_us"), "-1\n")
+	writeFile(t, filepath.Join(dir, "cpu,cpuacct", "cpu.cfs_period_us"), "100000\n")
+	quota, err := readCPUQuota(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), quota)
+
+	writeFile(t, filepath.Join(dir, "cpu,cpuacct", "cpu.cfs_quota_us"), "250000\n")
+	quota, err = readCPUQuota(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, quota)
+
+	// cgroup v2 is preferred
+	writeFile(t, filepath.Join(dir, "cpu.max"), "max 100000\n")
+	quota, err = readCPUQuota(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), quota)
+
+	writeFile(t, filepath.Join(dir, "cpu.max"), "150000 100000\n")
+	quota, err = readCPUQuota(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, quota)
+
+	writeFile(t, filepath.Join(dir, "cpu.max"), "150000\n")
+	_, err = readCPUQuota(dir)
+	assert.Error(t, err)
+
+	writeFile(t, filepath.Join(dir, "cpu.max"), "0 100000\n")
+	_, err = readCPUQuota(dir)
+	assert.Error(t, err)
+}
+
+func TestUsableCPUCount(t *testing.T) {
+	assert.Equal(t, 16, usableCPUCount(16, 0))
+	assert.Equal(t, 2, usableCPUCount(16, 1.5))
+	assert.Equal(t, 4, usableCPUCount(16, 4))
+	assert.Equal(t, 16, usableCPUCount(16, 32))
+	assert.True(t, GetUsableCPUCount() > 0)
+}
+
+func TestParseCPUList(t *testing.T) {
+	cpus, err := parseCPUList("0-3,8,10-11")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 8, 10, 11}, cpus)
+
+	cpus, err = parseCPUList("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(cpus))
+
+	_, err = parseCPUList("3-1")
+	assert.Error(t, err)
+	_, err = parseCPUList("a")
+	assert.Error(t, err)
+	_, err = parseCPUList("0-b")
+	assert.Error(t, err)
+}
+
+func TestReadNUMANodes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "numa")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = readNUMANodes(dir)
+	assert.Error(t, err)
+
+	writeFile(t, filepath.Join(dir, "node10", "cpulist"), "4-5\n")
+	writeFile(t, filepath.Join(dir, "node0", "cpulist"), "0-3\n")
+	writeFile(t, filepath.Join(dir, "node1", "cpulist"), "\n")
+	writeFile(t, filepath.Join(dir, "online"), "0-1,10\n")
+	nodes, err := readNUMANodes(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{0, 1, 2, 3}, {}, {4, 5}}, nodes)
+
+	writeFile(t, filepath.Join(dir, "node1", "cpulist"), "x\n")
+	_, err = readNUMANodes(dir)
+	assert.Error(t, err)
+}
@@ -15,14 +15,246 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 )
 
 const (
 	MetricTypeKey     = "metric_type"
 	SystemInfoMetrics = "system_info"
+
+	// DMLMirrorMetrics returns the state of the proxy dml mirror, the mirror drains and cuts over
+	// if DMLMirrorCutoverKey is true in the request
+	DMLMirrorMetrics    = "dml_mirror"
+	DMLMirrorCutoverKey = "cutover"
+
+	// LocalCacheMetrics returns the statistics of the local disk cache of query node, the cache is
+	// purged or resized first as LocalCacheRequest asks
+	LocalCacheMetrics = "local_cache"
+
+	// DecisionLogMetrics returns the decisions recorded by a coordinator, filtered as DecisionLogRequest asks
+	DecisionLogMetrics = "decision_log"
+
+	// WarmupQueriesMetrics returns the warm-up queries of a collection on query coord, the queries are
+	// replaced first if WarmupQueriesRequest carries queries or asks for clear
+	WarmupQueriesMetrics = "warmup_queries"
+
+	// WarmupMetrics runs the warm-up queries of WarmupQueriesRequest on query node and returns WarmupResult
+	WarmupMetrics = "warmup"
+
+	// SearchShadowMetrics returns the state of the proxy search shadow, which compares the canary group
+	// with the primary one
+	SearchShadowMetrics = "search_shadow"
+
+	// LogLevelMetrics returns the log levels of a component, the levels are changed first as the
+	// log.LevelRequest fields of the request ask
+	LogLevelMetrics = "log_level"
+
+	// SystemConfigurationsMetrics returns the effective configurations in the param table of a component,
+	// with the secrets redacted
+	SystemConfigurationsMetrics = "system_configurations"
+
+	// ListConfigMetrics returns the dynamic configs, which can be changed at runtime
+	ListConfigMetrics = "list_config"
+
+	// UpdateConfigMetrics changes a dynamic config as UpdateConfigRequest asks
+	UpdateConfigMetrics = "update_config"
+
+	// SystemTopologyMetrics returns the ClusterTopology composed by proxy, with the states of the components
+	// and the reachability of etcd, the message queue and the object store
+	SystemTopologyMetrics = "system_topology"
+
+	// LoadSimulationMetrics runs the placement of query coord for the hypothetical load of LoadSimulationRequest
+	// against the current query nodes, nothing is loaded, the result is LoadSimulationResult
+	LoadSimulationMetrics = "load_simulation"
+
+	// ProxyTasksMetrics returns the TaskInfo of the tasks queued or running in the schedulers of proxy
+	ProxyTasksMetrics = "proxy_tasks"
+
+	// TimeTickStatisticsMetrics returns the TimeTickStatistics of the dml channels on root coord, which shows
+	// the time tick reported by each source of a channel and how far it lags behind the others
+	TimeTickStatisticsMetrics = "time_tick_statistics"
+
+	// CollectionRuntimeStatsMetrics returns the runtime statistics of the collections of CollectionRuntimeStatsRequest,
+	// query node returns NodeCollectionRuntimeStats and query coord aggregates them into CollectionRuntimeStats
+	CollectionRuntimeStatsMetrics = "collection_runtime_stats"
+
+	// WarmupCollectionMetrics starts warming up the loaded segments of the collection of WarmupCollectionRequest
+	// on query coord if it is not running, and returns the WarmupProgress of the collection
+	WarmupCollectionMetrics = "warmup_collection"
+
+	// WarmupSegmentsMetrics touches the pages of the data and the indexes of the segments of WarmupSegmentsRequest
+	// on query node and returns WarmupSegmentsResult
+	WarmupSegmentsMetrics = "warmup_segments"
+)
+
+// The states of the tasks in TaskInfo
+const (
+	UnissuedTaskState = "unissued"
+	ActiveTaskState   = "active"
 )
 
+// TaskInfo is a task in a scheduler queue of a component
+type TaskInfo struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Queue       string `json:"queue"`
+	State       string `json:"state"`
+	EnqueueTime string `json:"enqueue_time,omitempty"` // only for the unissued tasks
+}
+
+// SourceTimeTick is the last time tick of a channel reported by a source, such as a proxy or root coord itself
+type SourceTimeTick struct {
+	SourceID  int64  `json:"source_id"`
+	Timestamp uint64 `json:"timestamp"`
+	Lag       int64  `json:"lag_ms"` // physical time behind the max time tick of the channel
+}
+
+// ChannelTimeTickStatistics is the time ticks of a physical dml channel, the min one is the safe timestamp
+// sent to the channel, the channel can't be consumed beyond it until the lagging source catches up
+type ChannelTimeTickStatistics struct {
+	Channel       string           `json:"channel"`
+	MinTimestamp  uint64           `json:"min_timestamp"`
+	MaxTimestamp  uint64           `json:"max_timestamp"`
+	SafeTime      string           `json:"safe_time"` // physical time of the min timestamp
+	Lag           int64            `json:"lag_ms"`    // physical time between the max and the min timestamp
+	LaggingSource int64            `json:"lagging_source"`
+	Sources       []SourceTimeTick `json:"sources"`
+}
+
+// TimeTickStatistics is the time tick statistics of all the dml channels, sorted by channel
+type TimeTickStatistics struct {
+	Channels []ChannelTimeTickStatistics `json:"channels"`
+}
+
+// LoadSimulationRequest describes a hypothetical collection to load
+type LoadSimulationRequest struct {
+	CollectionSize int64 `json:"collection_size"` // in bytes, the memory size of the loaded collection
+	NumSegments    int   `json:"num_segments"`    // num of the sealed segments, the size is evenly divided
+	ReplicaNumber  int   `json:"replica_number"`  // 1 if <= 0, each replica is a full copy of the segments
+}
+
+// SimulatedNodeLoad is the load of a query node after the simulated load
+type SimulatedNodeLoad struct {
+	NodeID           int64  `json:"node_id"`
+	ExistingSegments int    `json:"existing_segments"`
+	AssignedSegments int    `json:"assigned_segments"`
+	Memory           uint64 `json:"memory"`       // 0 if the hardware metrics of the node are not available
+	MemoryUsage      uint64 `json:"memory_usage"` // current usage
+	EstimatedUsage   uint64 `json:"estimated_usage"`
+	Overloaded       bool   `json:"overloaded"` // estimated usage exceeds the memory watermark of the node
+}
+
+// LoadSimulationResult reports whether the simulated load would succeed and how the segments would be distributed
+type LoadSimulationResult struct {
+	Feasible    bool                 `json:"feasible"`
+	Reason      string               `json:"reason,omitempty"`
+	SegmentSize int64                `json:"segment_size"`
+	Nodes       []*SimulatedNodeLoad `json:"nodes"`
+}
+
+// CollectionRuntimeStatsRequest asks the runtime statistics of the collections, all the loaded ones if empty
+type CollectionRuntimeStatsRequest struct {
+	CollectionIDs []int64 `json:"collection_ids"`
+}
+
+// NodeCollectionStats is the runtime statistics of a collection on a query node
+type NodeCollectionStats struct {
+	CollectionID      int64   `json:"collection_id"`
+	LoadedMemoryBytes int64   `json:"loaded_memory_bytes"`
+	SealedSegmentIDs  []int64 `json:"sealed_segment_ids"`
+	GrowingSegments   int     `json:"growing_segments"`
+	QueryQPS          float64 `json:"query_qps"`
+}
+
+// NodeCollectionRuntimeStats is the runtime statistics of the collections on a query node
+type NodeCollectionRuntimeStats struct {
+	NodeID      int64                  `json:"node_id"`
+	Collections []*NodeCollectionStats `json:"collections"`
+}
+
+// CollectionRuntimeStats is the runtime statistics of a collection aggregated across the query nodes
+type CollectionRuntimeStats struct {
+	CollectionID      int64   `json:"collection_id"`
+	LoadedMemoryBytes int64   `json:"loaded_memory_bytes"` // in all the replicas
+	SealedSegments    int     `json:"sealed_segments"`     // distinct sealed segments
+	GrowingSegments   int     `json:"growing_segments"`
+	ReplicaNumber     int     `json:"replica_number"` // min copies of the sealed segments
+	QueryQPS          float64 `json:"query_qps"`
+	NodeIDs           []int64 `json:"node_ids"`
+}
+
+// UpdateConfigRequest changes the value of a dynamic config, the value is reset to the one of
+// the yaml files if it is empty
+type UpdateConfigRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WarmupQuery is a representative search run on query nodes right after segments are loaded,
+// Dsl is the search dsl and the vectors fill its placeholder "$0"
+type WarmupQuery struct {
+	Dsl           string      `json:"dsl"`
+	Vectors       [][]float32 `json:"vectors,omitempty"`
+	BinaryVectors [][]byte    `json:"binary_vectors,omitempty"`
+}
+
+// WarmupQueriesRequest is the admin request on the warm-up queries of a collection
+type WarmupQueriesRequest struct {
+	CollectionID int64          `json:"collection_id"`
+	Queries      []*WarmupQuery `json:"queries"`
+	Clear        bool           `json:"clear"`
+}
+
+// WarmupResult is the result of the warm-up queries run on a query node
+type WarmupResult struct {
+	CollectionID int64  `json:"collection_id"`
+	Queries      int    `json:"queries"`
+	Failed       int    `json:"failed"`
+	ElapsedMs    int64  `json:"elapsed_ms"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// WarmupCollectionRequest asks query coord to warm up the loaded segments of a collection
+type WarmupCollectionRequest struct {
+	CollectionID int64 `json:"collection_id"`
+}
+
+// WarmupProgress is the progress of warming up the segments of a collection on the query nodes
+type WarmupProgress struct {
+	CollectionID int64  `json:"collection_id"`
+	Segments     int    `json:"segments"`
+	Warmed       int    `json:"warmed"`
+	Failed       int    `json:"failed"`
+	Percentage   int64  `json:"percentage"` // of the warmed and failed segments
+	Done         bool   `json:"done"`
+	Reason       string `json:"reason,omitempty"` // of the last failure
+}
+
+// WarmupSegmentsRequest asks a query node to warm up the sealed segments of a collection
+type WarmupSegmentsRequest struct {
+	CollectionID int64   `json:"collection_id"`
+	SegmentIDs   []int64 `json:"segment_ids"`
+}
+
+// WarmupSegmentsResult is the result of warming up the segments on a query node
+type WarmupSegmentsResult struct {
+	CollectionID int64  `json:"collection_id"`
+	Segments     int    `json:"segments"`
+	Failed       int    `json:"failed"`
+	TouchedBytes int64  `json:"touched_bytes"`
+	ElapsedMs    int64  `json:"elapsed_ms"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// LocalCacheRequest is the admin request on the local disk cache of query node
+type LocalCacheRequest struct {
+	Purge    bool   `json:"purge"`
+	Capacity *int64 `json:"capacity"` // in bytes, no limit if <= 0
+}
+
 // ParseMetricType returns the metric type of req
 func ParseMetricType(req string) (string, error) {
 	m := make(map[string]interface{})
@@ -37,6 +269,223 @@ func ParseMetricType(req string) (string, error) {
 	return metricType.(string), nil
 }
 
+// ParseDMLMirrorCutover returns whether the dml mirror request asks for cutover
+func ParseDMLMirrorCutover(req string) (bool, error) {
+	m := make(map[string]interface{})
+	err := json.Unmarshal([]byte(req), &m)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	cutover, exist := m[DMLMirrorCutoverKey]
+	if !exist {
+		return false, nil
+	}
+	ret, ok := cutover.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s should be a bool", DMLMirrorCutoverKey)
+	}
+	return ret, nil
+}
+
+// DecisionLogRequest is the query on the decision log of a coordinator
+type DecisionLogRequest struct {
+	Type  string `json:"type"`  // all the types if empty
+	Since int64  `json:"since"` // unix time in seconds
+	Limit int    `json:"limit"` // no limit if <= 0
+}
+
+// ParseDecisionLogRequest returns the filters of the decision log request
+func ParseDecisionLogRequest(req string) (*DecisionLogRequest, error) {
+	ret := &DecisionLogRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseLocalCacheRequest returns the purge and resize options of the local cache request
+func ParseLocalCacheRequest(req string) (*LocalCacheRequest, error) {
+	ret := &LocalCacheRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseWarmupQueriesRequest returns the collection and the warm-up queries of the request
+func ParseWarmupQueriesRequest(req string) (*WarmupQueriesRequest, error) {
+	ret := &WarmupQueriesRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseWarmupCollectionRequest returns the collection of the warmup request
+func ParseWarmupCollectionRequest(req string) (*WarmupCollectionRequest, error) {
+	ret := &WarmupCollectionRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseWarmupSegmentsRequest returns the collection and the segments of the warmup request
+func ParseWarmupSegmentsRequest(req string) (*WarmupSegmentsRequest, error) {
+	ret := &WarmupSegmentsRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseUpdateConfigRequest returns the dynamic config change asked by the request
+func ParseUpdateConfigRequest(req string) (*UpdateConfigRequest, error) {
+	ret := &UpdateConfigRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	if ret.Key == "" {
+		return nil, fmt.Errorf("key not found in request")
+	}
+	return ret, nil
+}
+
+// ParseLoadSimulationRequest returns the hypothetical collection of the load simulation request
+func ParseLoadSimulationRequest(req string) (*LoadSimulationRequest, error) {
+	ret := &LoadSimulationRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	if ret.CollectionSize < 0 || ret.NumSegments <= 0 {
+		return nil, fmt.Errorf("collection_size should be non-negative and num_segments should be positive")
+	}
+	if ret.ReplicaNumber <= 0 {
+		ret.ReplicaNumber = 1
+	}
+	return ret, nil
+}
+
+// ParseCollectionRuntimeStatsRequest returns the collections of the runtime statistics request
+func ParseCollectionRuntimeStatsRequest(req string) (*CollectionRuntimeStatsRequest, error) {
+	ret := &CollectionRuntimeStatsRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// ParseLogLevelRequest returns the log level change asked by the request
+func ParseLogLevelRequest(req string) (*log.LevelRequest, error) {
+	ret := &log.LevelRequest{}
+	err := json.Unmarshal([]byte(req), ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// GetLogLevelMetrics changes the log levels of the process as the request asks and returns the levels,
+// it is shared by all the components since the loggers are global
+func GetLogLevelMetrics(req *milvuspb.GetMetricsRequest, componentName string) (*milvuspb.GetMetricsResponse, error) {
+	levelReq, err := ParseLogLevelRequest(req.Request)
+	var state *log.LevelState
+	if err == nil {
+		state, err = log.ApplyLevelRequest(levelReq)
+	}
+	var resp []byte
+	if err == nil {
+		resp, err = json.Marshal(state)
+	}
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}, nil
+}
+
+// ConstructWarmupRequest constructs a request which runs the warm-up queries of a collection on query node
+func ConstructWarmupRequest(collectionID int64, queries []*WarmupQuery) (*milvuspb.GetMetricsRequest, error) {
+	m := make(map[string]interface{})
+	m[MetricTypeKey] = WarmupMetrics
+	m["collection_id"] = collectionID
+	m["queries"] = queries
+	binary, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct warmup request: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base:    nil,
+		Request: string(binary),
+	}, nil
+}
+
+// ConstructWarmupCollectionRequest constructs a request which warms up the loaded segments of a collection on query coord
+func ConstructWarmupCollectionRequest(collectionID int64) (*milvuspb.GetMetricsRequest, error) {
+	m := make(map[string]interface{})
+	m[MetricTypeKey] = WarmupCollectionMetrics
+	m["collection_id"] = collectionID
+	binary, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct warmup collection request: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base:    nil,
+		Request: string(binary),
+	}, nil
+}
+
+// ConstructWarmupSegmentsRequest constructs a request which warms up the segments of a collection on query node
+func ConstructWarmupSegmentsRequest(collectionID int64, segmentIDs []int64) (*milvuspb.GetMetricsRequest, error) {
+	m := make(map[string]interface{})
+	m[MetricTypeKey] = WarmupSegmentsMetrics
+	m["collection_id"] = collectionID
+	m["segment_ids"] = segmentIDs
+	binary, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct warmup segments request: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base:    nil,
+		Request: string(binary),
+	}, nil
+}
+
+// ConstructCollectionRuntimeStatsRequest constructs a request which returns the runtime statistics of the collections
+func ConstructCollectionRuntimeStatsRequest(collectionIDs []int64) (*milvuspb.GetMetricsRequest, error) {
+	m := make(map[string]interface{})
+	m[MetricTypeKey] = CollectionRuntimeStatsMetrics
+	m["collection_ids"] = collectionIDs
+	binary, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct collection runtime stats request: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base:    nil,
+		Request: string(binary),
+	}, nil
+}
+
 // ConstructRequestByMetricType constructs a request according to the metric type
 func ConstructRequestByMetricType(metricType string) (*milvuspb.GetMetricsRequest, error) {
 	m := make(map[string]interface{})
@@ -19,6 +19,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 )
 
 func TestParseMetricType(t *testing.T) {
@@ -78,3 +81,186 @@ func TestConstructRequestByMetricType(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDMLMirrorCutover(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     bool
+		errIsNil bool
+	}{
+		{"not in json format", false, false},
+		{`{"metric_type": "dml_mirror"}`, false, true},
+		{`{"metric_type": "dml_mirror", "cutover": true}`, true, true},
+		{`{"metric_type": "dml_mirror", "cutover": false}`, false, true},
+		{`{"metric_type": "dml_mirror", "cutover": "yes"}`, false, false},
+	}
+
+	for _, test := range cases {
+		got, err := ParseDMLMirrorCutover(test.s)
+		assert.Equal(t, test.errIsNil, err == nil)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func TestParseLocalCacheRequest(t *testing.T) {
+	_, err := ParseLocalCacheRequest("not in json format")
+	assert.NotNil(t, err)
+
+	req, err := ParseLocalCacheRequest(`{"metric_type": "local_cache"}`)
+	assert.Nil(t, err)
+	assert.False(t, req.Purge)
+	assert.Nil(t, req.Capacity)
+
+	req, err = ParseLocalCacheRequest(`{"metric_type": "local_cache", "purge": true, "capacity": 1024}`)
+	assert.Nil(t, err)
+	assert.True(t, req.Purge)
+	assert.Equal(t, int64(1024), *req.Capacity)
+
+	_, err = ParseLocalCacheRequest(`{"metric_type": "local_cache", "capacity": "1G"}`)
+	assert.NotNil(t, err)
+}
+
+func TestParseDecisionLogRequest(t *testing.T) {
+	_, err := ParseDecisionLogRequest("not in json format")
+	assert.NotNil(t, err)
+
+	req, err := ParseDecisionLogRequest(`{"metric_type": "decision_log"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionLogRequest{}, *req)
+
+	req, err = ParseDecisionLogRequest(`{"metric_type": "decision_log", "type": "balance", "since": 1600000000, "limit": 10}`)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionLogRequest{Type: "balance", Since: 1600000000, Limit: 10}, *req)
+}
+
+func TestParseWarmupQueriesRequest(t *testing.T) {
+	_, err := ParseWarmupQueriesRequest("not in json format")
+	assert.NotNil(t, err)
+
+	req, err := ParseWarmupQueriesRequest(`{"metric_type": "warmup_queries", "collection_id": 1}`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), req.CollectionID)
+	assert.Equal(t, 0, len(req.Queries))
+	assert.False(t, req.Clear)
+
+	req, err = ParseWarmupQueriesRequest(`{"metric_type": "warmup_queries", "collection_id": 1, "queries": [{"dsl": "{}", "vectors": [[1, 2]]}]}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(req.Queries))
+	assert.Equal(t, "{}", req.Queries[0].Dsl)
+	assert.Equal(t, [][]float32{{1, 2}}, req.Queries[0].Vectors)
+}
+
+func TestParseUpdateConfigRequest(t *testing.T) {
+	_, err := ParseUpdateConfigRequest("not in json format")
+	assert.Error(t, err)
+	_, err = ParseUpdateConfigRequest(`{"metric_type": "update_config", "value": "100"}`)
+	assert.Error(t, err)
+
+	req, err := ParseUpdateConfigRequest(`{"metric_type": "update_config", "key": "proxy.slowLog.threshold", "value": "100"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "proxy.slowLog.threshold", req.Key)
+	assert.Equal(t, "100", req.Value)
+}
+
+func TestParseLoadSimulationRequest(t *testing.T) {
+	_, err := ParseLoadSimulationRequest("not in json format")
+	assert.Error(t, err)
+	_, err = ParseLoadSimulationRequest(`{"metric_type": "load_simulation", "collection_size": 1024}`)
+	assert.Error(t, err)
+
+	req, err := ParseLoadSimulationRequest(`{"metric_type": "load_simulation", "collection_size": 1024, "num_segments": 4}`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1024), req.CollectionSize)
+	assert.Equal(t, 4, req.NumSegments)
+	assert.Equal(t, 1, req.ReplicaNumber)
+}
+
+func TestGetLogLevelMetrics(t *testing.T) {
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+	defer log.ResetModuleLevel("test.module")
+
+	resp, err := GetLogLevelMetrics(&milvuspb.GetMetricsRequest{Request: "not in json format"}, "test")
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	resp, err = GetLogLevelMetrics(&milvuspb.GetMetricsRequest{Request: `{"metric_type": "log_level", "module": "test.module", "level": "debug"}`}, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, "test", resp.ComponentName)
+	state := &log.LevelState{}
+	assert.Nil(t, json.Unmarshal([]byte(resp.Response), state))
+	assert.Equal(t, "debug", state.Modules["test.module"])
+
+	resp, err = GetLogLevelMetrics(&milvuspb.GetMetricsRequest{Request: `{"metric_type": "log_level", "level": "verbose"}`}, "test")
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+}
+
+func TestConstructWarmupRequest(t *testing.T) {
+	queries := []*WarmupQuery{{Dsl: "{}", BinaryVectors: [][]byte{{1, 2}}}}
+	req, err := ConstructWarmupRequest(1, queries)
+	assert.Nil(t, err)
+
+	metricType, err := ParseMetricType(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, WarmupMetrics, metricType)
+
+	warmupReq, err := ParseWarmupQueriesRequest(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), warmupReq.CollectionID)
+	assert.Equal(t, queries, warmupReq.Queries)
+}
+
+func TestConstructCollectionRuntimeStatsRequest(t *testing.T) {
+	_, err := ParseCollectionRuntimeStatsRequest("not in json format")
+	assert.Error(t, err)
+
+	req, err := ConstructCollectionRuntimeStatsRequest([]int64{1, 2})
+	assert.Nil(t, err)
+
+	metricType, err := ParseMetricType(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, CollectionRuntimeStatsMetrics, metricType)
+
+	statsReq, err := ParseCollectionRuntimeStatsRequest(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, statsReq.CollectionIDs)
+
+	statsReq, err = ParseCollectionRuntimeStatsRequest(`{"metric_type": "collection_runtime_stats"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(statsReq.CollectionIDs))
+}
+
+func TestConstructWarmupCollectionRequest(t *testing.T) {
+	_, err := ParseWarmupCollectionRequest("not in json format")
+	assert.Error(t, err)
+
+	req, err := ConstructWarmupCollectionRequest(1)
+	assert.Nil(t, err)
+
+	metricType, err := ParseMetricType(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, WarmupCollectionMetrics, metricType)
+
+	warmupReq, err := ParseWarmupCollectionRequest(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), warmupReq.CollectionID)
+}
+
+func TestConstructWarmupSegmentsRequest(t *testing.T) {
+	_, err := ParseWarmupSegmentsRequest("not in json format")
+	assert.Error(t, err)
+
+	req, err := ConstructWarmupSegmentsRequest(1, []int64{2, 3})
+	assert.Nil(t, err)
+
+	metricType, err := ParseMetricType(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, WarmupSegmentsMetrics, metricType)
+
+	warmupReq, err := ParseWarmupSegmentsRequest(req.Request)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), warmupReq.CollectionID)
+	assert.Equal(t, []int64{2, 3}, warmupReq.SegmentIDs)
+}
@@ -85,6 +85,31 @@ type QueryNodeConfiguration struct {
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
+	// Overloaded is set while the query node rejects the queries because its cpu usage exceeds the watermark
+	Overloaded bool `json:"overloaded"`
+	// MemoryInfos is the estimated memory of the memory admission of the query node
+	MemoryInfos QueryNodeMemoryInfos `json:"memory_infos"`
+}
+
+// the memory states of a query node
+const (
+	// MemoryStateNormal is the state below the low watermark, the node accepts new segments
+	MemoryStateNormal = "normal"
+	// MemoryStateEvictable is the state above the low watermark, no new segment should be assigned to the node
+	// and its segments could be balanced to the other nodes
+	MemoryStateEvictable = "evictable"
+	// MemoryStateOverloaded is the state above the high watermark, the node rejects the loads and queues the reads
+	MemoryStateOverloaded = "overloaded"
+)
+
+// QueryNodeMemoryInfos is the estimated memory of the loaded segments and the running reads of a query node,
+// the watermarks are 0 if the memory admission is disabled
+type QueryNodeMemoryInfos struct {
+	LoadedBytes        int64  `json:"loaded_bytes"`
+	InflightBytes      int64  `json:"inflight_bytes"`
+	LowWatermarkBytes  int64  `json:"low_watermark_bytes"`
+	HighWatermarkBytes int64  `json:"high_watermark_bytes"`
+	State              string `json:"state"`
 }
 
 // QueryCoordConfiguration records the configuration of query coordinator.
@@ -0,0 +1,414 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package migration moves a standalone Milvus (rocksmq and the local storage) into a cluster (pulsar and S3),
+// it copies the binlogs and index files, rewrites the paths and checkpoints in the meta of the coordinators,
+// creates the dml channels in the new message queue and verifies the copies before the cluster is started.
+package migration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type UniqueID = typeutil.UniqueID
+
+// the prefixes of the meta under the meta root, they are the same as the ones of the coordinators
+const (
+	segmentMetaPrefix    = "datacoord-meta/s/"
+	indexMetaPrefix      = "indexes/"
+	collectionMetaPrefix = "root-coord/collection/"
+	insertLogSubPath     = "insert_log"
+	statsLogSubPath      = "stats_log"
+)
+
+// skippedMetaPrefixes are the meta bound to the nodes of the standalone, the cluster builds them again:
+// the sessions, the channels watched by the data node and the collections loaded by the query node
+var skippedMetaPrefixes = []string{
+	"session/",
+	"cluster-prefix/",
+	"queryCoord-",
+}
+
+// metaBatchSize is the num of keys saved in a txn, etcd limits the ops of a txn to 128 by default
+const metaBatchSize = 64
+
+// ObjectStore is where the binlogs and index files are, storage.ChunkManager satisfies it
+type ObjectStore interface {
+	Read(key string) ([]byte, error)
+	Write(key string, content []byte) error
+	Exist(key string) bool
+}
+
+// ChannelCreator creates the physical channels in the message queue of the cluster
+type ChannelCreator interface {
+	CreateChannels(ctx context.Context, channels []string) error
+}
+
+type Config struct {
+	// SourceRootPath and TargetRootPath are the etcd.rootPath of the standalone and the cluster,
+	// the binlogs are saved under the root path as well
+	SourceRootPath string
+	TargetRootPath string
+	// MetaSubPath is etcd.metaSubPath, which must be the same in both
+	MetaSubPath string
+	// Force allows to migrate into a cluster whose meta is not empty, the keys are overwritten
+	Force bool
+}
+
+type Report struct {
+	// MetaKeys is the num of keys copied into the meta of the cluster
+	MetaKeys int
+	// Segments and Rows are the num of flushed segments and the rows in them
+	Segments int
+	Rows     int64
+	// IndexFiles is the num of index files, Objects and Bytes include the binlogs and the index files
+	IndexFiles int
+	Objects    int
+	Bytes      int64
+	// Channels are the physical dml channels of the collections created in the cluster
+	Channels []string
+}
+
+type object struct {
+	source string
+	target string
+	// optional objects are copied only if they exist, such as the stats logs
+	optional bool
+}
+
+type plan struct {
+	metas    map[string]string
+	objects  []object
+	channels []string
+	report   Report
+}
+
+type Migrator struct {
+	sourceMeta  kv.BaseKV
+	targetMeta  kv.BaseKV
+	sourceStore ObjectStore
+	targetStore ObjectStore
+	creator     ChannelCreator
+	cfg         Config
+}
+
+// NewMigrator creates a migrator, the meta kvs should be created with an empty root path
+// since the keys are loaded and saved with the root paths in Config
+func NewMigrator(sourceMeta, targetMeta kv.BaseKV, sourceStore, targetStore ObjectStore, creator ChannelCreator, cfg Config) (*Migrator, error) {
+	if cfg.SourceRootPath == "" || cfg.TargetRootPath == "" {
+		return nil, errors.New("the root paths of the standalone and the cluster should not be empty")
+	}
+	if cfg.MetaSubPath == "" {
+		cfg.MetaSubPath = "meta"
+	}
+	return &Migrator{
+		sourceMeta:  sourceMeta,
+		targetMeta:  targetMeta,
+		sourceStore: sourceStore,
+		targetStore: targetStore,
+		creator:     creator,
+		cfg:         cfg,
+	}, nil
+}
+
+func (m *Migrator) sourcePrefix() string {
+	return path.Clean(m.cfg.SourceRootPath) + "/"
+}
+
+func (m *Migrator) targetPrefix() string {
+	return path.Clean(m.cfg.TargetRootPath) + "/"
+}
+
+// rebaseKey moves a key or a binlog path under the source root to the target root
+func (m *Migrator) rebaseKey(key string) string {
+	if !strings.HasPrefix(key, m.sourcePrefix()) {
+		return key
+	}
+	return m.targetPrefix() + strings.TrimPrefix(key, m.sourcePrefix())
+}
+
+// rebasePosition drops the msg id of a checkpoint, the ids of rocksmq mean nothing to pulsar,
+// the consumers of the channels created in the cluster subscribe them from the earliest position
+func rebasePosition(pos *internalpb.MsgPosition) {
+	if pos != nil {
+		pos.MsgID = nil
+	}
+}
+
+// Check builds the plan of the migration without changing anything, it fails if the standalone
+// has segments not flushed or indexes being built, since the data in rocksmq is not migrated
+func (m *Migrator) Check() (*Report, error) {
+	p, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+	return &p.report, nil
+}
+
+func (m *Migrator) check() (*plan, error) {
+	p, err := m.buildPlan()
+	if err != nil {
+		return nil, err
+	}
+	if !m.cfg.Force {
+		keys, _, err := m.targetMeta.LoadWithPrefix(m.targetPrefix())
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("the meta of the cluster under %s is not empty, %d keys found", m.targetPrefix(), len(keys))
+		}
+	}
+	return p, nil
+}
+
+func (m *Migrator) buildPlan() (*plan, error) {
+	keys, values, err := m.sourceMeta.LoadWithPrefix(m.sourcePrefix())
+	if err != nil {
+		return nil, err
+	}
+	metaPrefix := m.sourcePrefix() + m.cfg.MetaSubPath + "/"
+
+	p := &plan{metas: make(map[string]string)}
+	channels := make(map[string]struct{})
+	var unflushed, building []UniqueID
+
+outer:
+	for i, key := range keys {
+		subKey := strings.TrimPrefix(key, metaPrefix)
+		value := values[i]
+		if subKey != key {
+			for _, prefix := range skippedMetaPrefixes {
+				if strings.HasPrefix(subKey, prefix) {
+					continue outer
+				}
+			}
+			switch {
+			case strings.HasPrefix(subKey, segmentMetaPrefix):
+				segment := &datapb.SegmentInfo{}
+				if err := proto.UnmarshalText(value, segment); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal segment %s: %w", key, err)
+				}
+				if segment.State == commonpb.SegmentState_NotExist {
+					continue
+				}
+				if segment.State != commonpb.SegmentState_Flushed {
+					unflushed = append(unflushed, segment.ID)
+					continue
+				}
+				m.rebaseSegment(p, segment)
+				value = proto.MarshalTextString(segment)
+			case strings.HasPrefix(subKey, indexMetaPrefix):
+				index := &indexpb.IndexMeta{}
+				if err := proto.UnmarshalText(value, index); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal index %s: %w", key, err)
+				}
+				if !index.MarkDeleted && (index.State == commonpb.IndexState_Unissued || index.State == commonpb.IndexState_InProgress) {
+					building = append(building, index.IndexBuildID)
+					continue
+				}
+				m.rebaseIndex(p, index)
+				value = proto.MarshalTextString(index)
+			case strings.HasPrefix(subKey, collectionMetaPrefix):
+				collection := &etcdpb.CollectionInfo{}
+				if err := proto.UnmarshalText(value, collection); err == nil {
+					for _, channel := range collection.PhysicalChannelNames {
+						channels[channel] = struct{}{}
+					}
+				}
+			}
+		}
+		p.metas[m.rebaseKey(key)] = value
+	}
+
+	if len(unflushed) > 0 {
+		return nil, fmt.Errorf("segments %v of the standalone are not flushed, stop inserting and flush all collections before migrating", unflushed)
+	}
+	if len(building) > 0 {
+		return nil, fmt.Errorf("indexes %v of the standalone are being built, wait until they are finished before migrating", building)
+	}
+
+	for channel := range channels {
+		p.channels = append(p.channels, channel)
+	}
+	sort.Strings(p.channels)
+	p.report.MetaKeys = len(p.metas)
+	p.report.Channels = p.channels
+	return p, nil
+}
+
+func (m *Migrator) rebaseSegment(p *plan, segment *datapb.SegmentInfo) {
+	insertLogPrefix := path.Join(m.cfg.SourceRootPath, insertLogSubPath) + "/"
+	for _, fieldBinlog := range segment.Binlogs {
+		for i, binlog := range fieldBinlog.Binlogs {
+			p.objects = append(p.objects, object{source: binlog, target: m.rebaseKey(binlog)})
+			// the stats log of a binlog is saved with the same key under the stats log path
+			if strings.HasPrefix(binlog, insertLogPrefix) {
+				statsLog := path.Join(m.cfg.SourceRootPath, statsLogSubPath, strings.TrimPrefix(binlog, insertLogPrefix))
+				p.objects = append(p.objects, object{source: statsLog, target: m.rebaseKey(statsLog), optional: true})
+			}
+			fieldBinlog.Binlogs[i] = m.rebaseKey(binlog)
+		}
+	}
+	rebasePosition(segment.StartPosition)
+	rebasePosition(segment.DmlPosition)
+	p.report.Segments++
+	p.report.Rows += segment.NumOfRows
+}
+
+func (m *Migrator) rebaseIndex(p *plan, index *indexpb.IndexMeta) {
+	for i, dataPath := range index.GetReq().GetDataPaths() {
+		index.Req.DataPaths[i] = m.rebaseKey(dataPath)
+	}
+	if index.MarkDeleted || index.State != commonpb.IndexState_Finished {
+		return
+	}
+	// the index files are saved without the root path
+	for _, indexFile := range index.IndexFilePaths {
+		p.objects = append(p.objects, object{source: indexFile, target: indexFile})
+	}
+	p.report.IndexFiles += len(index.IndexFilePaths)
+}
+
+// Migrate copies the objects, creates the channels and then saves the meta into the cluster,
+// the meta is saved last so that the cluster never refers to the objects not copied,
+// it verifies the migration at the end and the cluster should only be started if it succeeds
+func (m *Migrator) Migrate(ctx context.Context) (*Report, error) {
+	p, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range p.objects {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if obj.optional && !m.sourceStore.Exist(obj.source) {
+			continue
+		}
+		content, err := m.sourceStore.Read(obj.source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from the standalone: %w", obj.source, err)
+		}
+		if err := m.targetStore.Write(obj.target, content); err != nil {
+			return nil, fmt.Errorf("failed to write %s into the cluster: %w", obj.target, err)
+		}
+		p.report.Objects++
+		p.report.Bytes += int64(len(content))
+	}
+	log.Debug("migration copied the objects", zap.Int("objects", p.report.Objects), zap.Int64("bytes", p.report.Bytes))
+
+	if len(p.channels) > 0 {
+		if err := m.creator.CreateChannels(ctx, p.channels); err != nil {
+			return nil, fmt.Errorf("failed to create the channels in the cluster: %w", err)
+		}
+		log.Debug("migration created the channels", zap.Strings("channels", p.channels))
+	}
+
+	keys := make([]string, 0, len(p.metas))
+	for key := range p.metas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for start := 0; start < len(keys); start += metaBatchSize {
+		end := start + metaBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		kvs := make(map[string]string, end-start)
+		for _, key := range keys[start:end] {
+			kvs[key] = p.metas[key]
+		}
+		if err := m.targetMeta.MultiSave(kvs); err != nil {
+			return nil, fmt.Errorf("failed to save the meta into the cluster: %w", err)
+		}
+	}
+	log.Debug("migration saved the meta", zap.Int("keys", len(keys)))
+
+	if err := m.verify(p); err != nil {
+		return nil, err
+	}
+	return &p.report, nil
+}
+
+// Verify checks that the meta and the objects of the standalone are all in the cluster
+func (m *Migrator) Verify() error {
+	p, err := m.buildPlan()
+	if err != nil {
+		return err
+	}
+	return m.verify(p)
+}
+
+func (m *Migrator) verify(p *plan) error {
+	keys, values, err := m.targetMeta.LoadWithPrefix(m.targetPrefix())
+	if err != nil {
+		return err
+	}
+	targetMetas := make(map[string]string, len(keys))
+	for i, key := range keys {
+		targetMetas[key] = values[i]
+	}
+	var problems []string
+	for key, value := range p.metas {
+		v, ok := targetMetas[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("meta %s is missing", key))
+		} else if v != value {
+			problems = append(problems, fmt.Sprintf("meta %s is different", key))
+		}
+	}
+
+	for _, obj := range p.objects {
+		if obj.optional && !m.sourceStore.Exist(obj.source) {
+			continue
+		}
+		source, err := m.sourceStore.Read(obj.source)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from the standalone: %w", obj.source, err)
+		}
+		if !m.targetStore.Exist(obj.target) {
+			problems = append(problems, fmt.Sprintf("object %s is missing", obj.target))
+			continue
+		}
+		target, err := m.targetStore.Read(obj.target)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from the cluster: %w", obj.target, err)
+		}
+		if !bytes.Equal(source, target) {
+			problems = append(problems, fmt.Sprintf("object %s is different", obj.target))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("migration verification failed, %d problems found: %s", len(problems), strings.Join(problems, "; "))
+	}
+	return nil
+}
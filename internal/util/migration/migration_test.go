@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+type memoryStore map[string][]byte
+
+func (s memoryStore) Read(key string) ([]byte, error) {
+	content, ok := s[key]
+	if !ok {
+		return nil, errors.New("not found: " + key)
+	}
+	return content, nil
+}
+
+func (s memoryStore) Write(key string, content []byte) error {
+	s[key] = content
+	return nil
+}
+
+func (s memoryStore) Exist(key string) bool {
+	_, ok := s[key]
+	return ok
+}
+
+type mockChannelCreator struct {
+	channels []string
+}
+
+func (c *mockChannelCreator) CreateChannels(ctx context.Context, channels []string) error {
+	c.channels = append(c.channels, channels...)
+	return nil
+}
+
+func prepareStandalone(t *testing.T, state commonpb.SegmentState) (*memkv.MemoryKV, memoryStore) {
+	meta := memkv.NewMemoryKV()
+	store := memoryStore{}
+
+	segment := &datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "by-dev-rootcoord-dml_0_1v0",
+		NumOfRows:     2000,
+		State:         state,
+		StartPosition: &internalpb.MsgPosition{ChannelName: "by-dev-rootcoord-dml_0_1v0", MsgID: []byte{1}, Timestamp: 100},
+		DmlPosition:   &internalpb.MsgPosition{ChannelName: "by-dev-rootcoord-dml_0_1v0", MsgID: []byte{2}, Timestamp: 200},
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 101, Binlogs: []string{"standalone/insert_log/1/10/100/101/1"}},
+		},
+	}
+	assert.Nil(t, meta.Save("standalone/meta/datacoord-meta/s/1/10/100", proto.MarshalTextString(segment)))
+	store["standalone/insert_log/1/10/100/101/1"] = []byte("binlog")
+	store["standalone/stats_log/1/10/100/101/1"] = []byte("stats")
+
+	index := &indexpb.IndexMeta{
+		IndexBuildID:   1000,
+		State:          commonpb.IndexState_Finished,
+		Req:            &indexpb.BuildIndexRequest{DataPaths: []string{"standalone/insert_log/1/10/100/101/1"}},
+		IndexFilePaths: []string{"1000/1/10/100/IVF"},
+	}
+	assert.Nil(t, meta.Save("standalone/meta/indexes/1000", proto.MarshalTextString(index)))
+	store["1000/1/10/100/IVF"] = []byte("index")
+
+	collection := &etcdpb.CollectionInfo{ID: 1, PhysicalChannelNames: []string{"by-dev-rootcoord-dml_0"}}
+	assert.Nil(t, meta.Save("standalone/meta/root-coord/collection/1", proto.MarshalTextString(collection)))
+	assert.Nil(t, meta.Save("standalone/meta/session/id", "1"))
+	assert.Nil(t, meta.Save("standalone/meta/queryCoord-collectionMeta/1", "loaded"))
+	assert.Nil(t, meta.Save("standalone/kv/gid/timestamp", "12345"))
+	return meta, store
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	sourceMeta, sourceStore := prepareStandalone(t, commonpb.SegmentState_Flushed)
+	targetMeta := memkv.NewMemoryKV()
+	targetStore := memoryStore{}
+	creator := &mockChannelCreator{}
+
+	m, err := NewMigrator(sourceMeta, targetMeta, sourceStore, targetStore, creator, Config{
+		SourceRootPath: "standalone",
+		TargetRootPath: "cluster",
+	})
+	assert.Nil(t, err)
+
+	report, err := m.Check()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, report.MetaKeys)
+	assert.Equal(t, 1, report.Segments)
+	assert.Equal(t, int64(2000), report.Rows)
+	assert.Equal(t, []string{"by-dev-rootcoord-dml_0"}, report.Channels)
+	assert.Zero(t, len(targetStore))
+
+	report, err = m.Migrate(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, report.Objects)
+	assert.Equal(t, 1, report.IndexFiles)
+	assert.Equal(t, int64(len("binlog")+len("stats")+len("index")), report.Bytes)
+	assert.Equal(t, []string{"by-dev-rootcoord-dml_0"}, creator.channels)
+
+	assert.Equal(t, []byte("binlog"), targetStore["cluster/insert_log/1/10/100/101/1"])
+	assert.Equal(t, []byte("stats"), targetStore["cluster/stats_log/1/10/100/101/1"])
+	assert.Equal(t, []byte("index"), targetStore["1000/1/10/100/IVF"])
+
+	value, err := targetMeta.Load("cluster/meta/datacoord-meta/s/1/10/100")
+	assert.Nil(t, err)
+	segment := &datapb.SegmentInfo{}
+	assert.Nil(t, proto.UnmarshalText(value, segment))
+	assert.Equal(t, []string{"cluster/insert_log/1/10/100/101/1"}, segment.Binlogs[0].Binlogs)
+	assert.Nil(t, segment.DmlPosition.MsgID)
+	assert.Equal(t, uint64(200), segment.DmlPosition.Timestamp)
+	assert.Equal(t, "by-dev-rootcoord-dml_0_1v0", segment.DmlPosition.ChannelName)
+	assert.Nil(t, segment.StartPosition.MsgID)
+
+	value, err = targetMeta.Load("cluster/meta/indexes/1000")
+	assert.Nil(t, err)
+	index := &indexpb.IndexMeta{}
+	assert.Nil(t, proto.UnmarshalText(value, index))
+	assert.Equal(t, []string{"cluster/insert_log/1/10/100/101/1"}, index.Req.DataPaths)
+
+	value, err = targetMeta.Load("cluster/kv/gid/timestamp")
+	assert.Nil(t, err)
+	assert.Equal(t, "12345", value)
+	keys, _, err := targetMeta.LoadWithPrefix("cluster/meta/session/")
+	assert.Nil(t, err)
+	assert.Zero(t, len(keys))
+	keys, _, err = targetMeta.LoadWithPrefix("cluster/meta/queryCoord-")
+	assert.Nil(t, err)
+	assert.Zero(t, len(keys))
+
+	assert.Nil(t, m.Verify())
+
+	// the binlog is corrupted after the migration
+	targetStore["cluster/insert_log/1/10/100/101/1"] = []byte("corrupted")
+	delete(targetStore, "1000/1/10/100/IVF")
+	err = m.Verify()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "object cluster/insert_log/1/10/100/101/1 is different")
+	assert.Contains(t, err.Error(), "object 1000/1/10/100/IVF is missing")
+
+	// the cluster is not empty any more
+	_, err = m.Migrate(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestMigrator_Check(t *testing.T) {
+	_, err := NewMigrator(nil, nil, nil, nil, nil, Config{SourceRootPath: "standalone"})
+	assert.NotNil(t, err)
+
+	sourceMeta, sourceStore := prepareStandalone(t, commonpb.SegmentState_Growing)
+	m, err := NewMigrator(sourceMeta, memkv.NewMemoryKV(), sourceStore, memoryStore{}, &mockChannelCreator{}, Config{
+		SourceRootPath: "standalone",
+		TargetRootPath: "cluster",
+	})
+	assert.Nil(t, err)
+	_, err = m.Check()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "segments [100] of the standalone are not flushed")
+
+	sourceMeta, sourceStore = prepareStandalone(t, commonpb.SegmentState_Flushed)
+	index := &indexpb.IndexMeta{IndexBuildID: 1001, State: commonpb.IndexState_InProgress}
+	assert.Nil(t, sourceMeta.Save("standalone/meta/indexes/1001", proto.MarshalTextString(index)))
+	m, err = NewMigrator(sourceMeta, memkv.NewMemoryKV(), sourceStore, memoryStore{}, &mockChannelCreator{}, Config{
+		SourceRootPath: "standalone",
+		TargetRootPath: "cluster",
+	})
+	assert.Nil(t, err)
+	_, err = m.Check()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "indexes [1001] of the standalone are being built")
+}
@@ -14,6 +14,8 @@ package milvuserrors
 import (
 	"errors"
 	"fmt"
+
+	"github.com/milvus-io/milvus/internal/util/merr"
 )
 
 func MsgCollectionAlreadyExist(name string) string {
@@ -29,7 +31,7 @@ func MsgCollectionNotExist(name string) string {
 }
 
 func ErrCollectionNotExist(name string) error {
-	return errors.New(MsgCollectionNotExist(name))
+	return merr.Errorf(merr.ErrCollectionNotFound, "%s", MsgCollectionNotExist(name))
 }
 
 func MsgPartitionAlreadyExist(name string) string {
@@ -45,5 +47,5 @@ func MsgPartitionNotExist(name string) string {
 }
 
 func ErrPartitionNotExist(name string) error {
-	return errors.New(MsgPartitionNotExist(name))
+	return merr.Errorf(merr.ErrPartitionNotFound, "%s", MsgPartitionNotExist(name))
 }
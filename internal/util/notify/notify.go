@@ -0,0 +1,305 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package notify sends the events of the coordinators, such as the flushed segments and the built indexes,
+// to the configured webhooks and the notification topic, so that the external orchestration reacts to them
+// without polling.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// CollectionCreated is sent by rootcoord when a collection is created
+	CollectionCreated = "CollectionCreated"
+	// FlushCompleted is sent by datacoord when a segment is flushed
+	FlushCompleted = "FlushCompleted"
+	// IndexBuilt is sent by indexcoord when the index of a segment is built
+	IndexBuilt = "IndexBuilt"
+	// CompactionCompleted is sent by datacoord when a compaction is completed
+	CompactionCompleted = "CompactionCompleted"
+	// NodeDown is sent by the coordinators when the session of a node is gone
+	NodeDown = "NodeDown"
+)
+
+// Event is an event of a coordinator, it's sent in json
+type Event struct {
+	Type       string                 `json:"type"`
+	Time       time.Time              `json:"time"`
+	Source     string                 `json:"source"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// Sink is where the events are sent to
+type Sink interface {
+	Send(ctx context.Context, event *Event, payload []byte) error
+	Close()
+}
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a Sink which POSTs the events to url, the calls time out after timeout
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	return &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *webhookSink) Send(ctx context.Context, event *Event, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returns status %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *webhookSink) Close() {
+	s.client.CloseIdleConnections()
+}
+
+type streamSink struct {
+	stream msgstream.MsgStream
+}
+
+// NewStreamSink returns a Sink which produces the events to the producer channels of stream
+func NewStreamSink(stream msgstream.MsgStream) Sink {
+	return &streamSink{stream: stream}
+}
+
+func (s *streamSink) Send(ctx context.Context, event *Event, payload []byte) error {
+	ts := tsoutil.ComposeTS(event.Time.UnixNano()/int64(time.Millisecond), 0)
+	msg := &msgstream.EventNotificationMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     []uint32{0},
+		},
+		EventNotificationMsg: internalpb.EventNotificationMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_EventNotification,
+				Timestamp: ts,
+			},
+			EventType: event.Type,
+			Payload:   string(payload),
+		},
+	}
+	return s.stream.Produce(&msgstream.MsgPack{
+		BeginTs: ts,
+		EndTs:   ts,
+		Msgs:    []msgstream.TsMsg{msg},
+	})
+}
+
+func (s *streamSink) Close() {
+	s.stream.Close()
+}
+
+// Config is the notification section of the config
+type Config struct {
+	Enabled   bool
+	Webhooks  []string
+	Topic     string
+	QueueSize int
+	Timeout   time.Duration
+	Attempts  uint
+}
+
+// LoadConfig reads the notification section of the config
+func LoadConfig(table *paramtable.BaseTable) Config {
+	cfg := Config{
+		Enabled:   table.ParseBool("notification.enabled", false),
+		QueueSize: 1024,
+		Timeout:   5 * time.Second,
+		Attempts:  3,
+	}
+	if webhooks, err := table.Load("notification.webhooks"); err == nil {
+		for _, url := range strings.Split(webhooks, ",") {
+			if url = strings.TrimSpace(url); url != "" {
+				cfg.Webhooks = append(cfg.Webhooks, url)
+			}
+		}
+	}
+	if topic, err := table.Load("notification.topic"); err == nil {
+		cfg.Topic = strings.TrimSpace(topic)
+	}
+	if _, err := table.Load("notification.queueSize"); err == nil {
+		cfg.QueueSize = table.ParseInt("notification.queueSize")
+	}
+	if _, err := table.Load("notification.timeout"); err == nil {
+		cfg.Timeout = time.Duration(table.ParseInt64("notification.timeout")) * time.Millisecond
+	}
+	if _, err := table.Load("notification.attempts"); err == nil {
+		cfg.Attempts = uint(table.ParseInt("notification.attempts"))
+	}
+	return cfg
+}
+
+// Notifier sends the events to the sinks in the background, the events are dropped if the queue is full,
+// so that the coordinators are never blocked by the slow sinks. It's safe to notify on a nil Notifier
+type Notifier struct {
+	source   string
+	sinks    []Sink
+	attempts uint
+	events   chan *Event
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+// NewNotifier returns a Notifier sending the events of source to sinks, an event is sent to a sink
+// at most attempts times
+func NewNotifier(source string, sinks []Sink, queueSize int, attempts uint) *Notifier {
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	if attempts == 0 {
+		attempts = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Notifier{
+		source:   source,
+		sinks:    sinks,
+		attempts: attempts,
+		events:   make(chan *Event, queueSize),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// NewNotifierFromConfig returns the Notifier of the webhooks and the topic of cfg, or nil if the notification
+// is disabled, the events are produced to the topic by a msg stream of factory if it's not nil
+func NewNotifierFromConfig(ctx context.Context, source string, cfg Config, factory msgstream.Factory) (*Notifier, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	sinks := make([]Sink, 0, len(cfg.Webhooks)+1)
+	for _, url := range cfg.Webhooks {
+		sinks = append(sinks, NewWebhookSink(url, cfg.Timeout))
+	}
+	if cfg.Topic != "" && factory == nil {
+		log.Warn("no msg stream to produce the events to the notification topic", zap.String("source", source),
+			zap.String("topic", cfg.Topic))
+	} else if cfg.Topic != "" {
+		stream, err := factory.NewMsgStream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		stream.AsProducer([]string{cfg.Topic})
+		sinks = append(sinks, NewStreamSink(stream))
+	}
+	log.Debug("notification enabled", zap.String("source", source),
+		zap.Strings("webhooks", cfg.Webhooks), zap.String("topic", cfg.Topic))
+	return NewNotifier(source, sinks, cfg.QueueSize, cfg.Attempts), nil
+}
+
+// Start starts sending the events
+func (n *Notifier) Start() {
+	if n == nil {
+		return
+	}
+	n.wg.Add(1)
+	go n.loop()
+}
+
+// Stop stops sending the events and closes the sinks, the events in the queue are dropped
+func (n *Notifier) Stop() {
+	if n == nil {
+		return
+	}
+	n.stopOnce.Do(func() {
+		n.cancel()
+		n.wg.Wait()
+		for _, sink := range n.sinks {
+			sink.Close()
+		}
+	})
+}
+
+// Notify queues an event of eventType with the properties
+func (n *Notifier) Notify(eventType string, properties map[string]interface{}) {
+	if n == nil {
+		return
+	}
+	event := &Event{
+		Type:       eventType,
+		Time:       time.Now(),
+		Source:     n.source,
+		Properties: properties,
+	}
+	select {
+	case n.events <- event:
+	default:
+		log.Warn("notification queue is full, drop the event", zap.String("type", eventType),
+			zap.Any("properties", properties))
+	}
+}
+
+func (n *Notifier) loop() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case event := <-n.events:
+			n.send(event)
+		}
+	}
+}
+
+func (n *Notifier) send(event *Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("marshal notification event failed", zap.String("type", event.Type), zap.Error(err))
+		return
+	}
+	for _, sink := range n.sinks {
+		err := retry.Do(n.ctx, func() error {
+			return sink.Send(n.ctx, event, payload)
+		}, retry.Attempts(n.attempts), retry.Sleep(100*time.Millisecond))
+		if err != nil {
+			log.Warn("send notification event failed", zap.String("type", event.Type), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSink struct {
+	mu     sync.Mutex
+	events []*Event
+	fails  int
+	closed bool
+}
+
+func (s *mockSink) Send(ctx context.Context, event *Event, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fails > 0 {
+		s.fails--
+		return errors.New("mock sink failure")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *mockSink) Close() {
+	s.closed = true
+}
+
+func (s *mockSink) numEvents() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func TestNotifier(t *testing.T) {
+	var nilNotifier *Notifier
+	nilNotifier.Start()
+	nilNotifier.Notify(NodeDown, nil)
+	nilNotifier.Stop()
+
+	sink := &mockSink{fails: 1}
+	n := NewNotifier("datacoord", []Sink{sink}, 16, 2)
+	n.Start()
+	n.Notify(FlushCompleted, map[string]interface{}{"segment_id": 1})
+	n.Notify(CompactionCompleted, nil)
+	assert.Eventually(t, func() bool { return sink.numEvents() == 2 }, 5*time.Second, 10*time.Millisecond)
+	n.Stop()
+	assert.True(t, sink.closed)
+
+	assert.Equal(t, FlushCompleted, sink.events[0].Type)
+	assert.Equal(t, "datacoord", sink.events[0].Source)
+	assert.Equal(t, 1, sink.events[0].Properties["segment_id"])
+
+	// the events are dropped when the queue is full
+	n = NewNotifier("indexcoord", []Sink{sink}, 1, 1)
+	n.Notify(IndexBuilt, nil)
+	n.Notify(IndexBuilt, nil)
+	assert.Equal(t, 1, len(n.events))
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		var event Event
+		assert.Nil(t, json.Unmarshal(body, &event))
+		received <- event
+	}))
+	defer server.Close()
+
+	n := NewNotifier("rootcoord", []Sink{NewWebhookSink(server.URL, time.Second)}, 16, 1)
+	n.Start()
+	defer n.Stop()
+	n.Notify(CollectionCreated, map[string]interface{}{"collection_name": "c1"})
+	select {
+	case event := <-received:
+		assert.Equal(t, CollectionCreated, event.Type)
+		assert.Equal(t, "rootcoord", event.Source)
+		assert.Equal(t, "c1", event.Properties["collection_name"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event is not received by the webhook")
+	}
+
+	failed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failed.Close()
+	sink := NewWebhookSink(failed.URL, time.Second)
+	defer sink.Close()
+	err := sink.Send(context.Background(), &Event{Type: NodeDown}, []byte("{}"))
+	assert.NotNil(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	table := &paramtable.BaseTable{}
+	table.Init()
+
+	cfg := LoadConfig(table)
+	assert.False(t, cfg.Enabled)
+
+	assert.Nil(t, table.Save("notification.enabled", "true"))
+	assert.Nil(t, table.Save("notification.webhooks", "http://a:8080/events, ,http://b:8080/events"))
+	assert.Nil(t, table.Save("notification.topic", "milvus-events"))
+	assert.Nil(t, table.Save("notification.timeout", "1000"))
+	cfg = LoadConfig(table)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, []string{"http://a:8080/events", "http://b:8080/events"}, cfg.Webhooks)
+	assert.Equal(t, "milvus-events", cfg.Topic)
+	assert.Equal(t, time.Second, cfg.Timeout)
+
+	n, err := NewNotifierFromConfig(context.Background(), "rootcoord", Config{}, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, n)
+}
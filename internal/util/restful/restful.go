@@ -0,0 +1,605 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package restful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// Path is the prefix of the RESTful APIs, the bodies of the requests and the responses are json:
+	//   GET  collections                          the names of all the collections
+	//   GET  collections/describe?collection_name the schema of a collection
+	//   POST collections/create                   create a collection
+	//   POST collections/drop                     drop a collection
+	//   POST collections/load                     load a collection to the query nodes
+	//   POST collections/release                  release a collection from the query nodes
+	//   POST insert                               insert the rows of a collection
+	//   POST search                               search the vectors of a collection
+	//   POST query                                query the rows of a collection by a boolean expression
+	Path = "/v1/vector/"
+
+	requestTimeout = 60 * time.Second
+	// maxBodySize limits the size of a request body
+	maxBodySize = 64 << 20
+
+	// the keys of the search params, which are the same as the ones of proxy
+	annsFieldKey    = "anns_field"
+	topKKey         = "topk"
+	metricTypeKey   = "metric_type"
+	searchParamsKey = "params"
+)
+
+var errNoService = errors.New("proxy is not registered to the restful server")
+
+// Service is the part of the milvus service used by the RESTful APIs, which is served by proxy
+type Service interface {
+	CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+}
+
+// Error is the body of a failed response
+type Error struct {
+	ErrorCode string `json:"error_code"`
+	Reason    string `json:"reason"`
+}
+
+// Field is a field of the schema of a collection
+type Field struct {
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	DataType     string            `json:"data_type"`
+	IsPrimaryKey bool              `json:"is_primary_key,omitempty"`
+	AutoID       bool              `json:"auto_id,omitempty"`
+	TypeParams   map[string]string `json:"type_params,omitempty"`
+}
+
+// Collection is the schema of a collection
+type Collection struct {
+	CollectionName string   `json:"collection_name"`
+	Description    string   `json:"description,omitempty"`
+	ShardsNum      int32    `json:"shards_num,omitempty"`
+	Fields         []*Field `json:"fields"`
+}
+
+// CollectionRequest is the request of the APIs on a whole collection
+type CollectionRequest struct {
+	CollectionName string `json:"collection_name"`
+}
+
+// InsertRequest is the request to insert rows into a collection, a row maps the names of the fields to the values
+type InsertRequest struct {
+	CollectionName string                       `json:"collection_name"`
+	PartitionName  string                       `json:"partition_name,omitempty"`
+	Rows           []map[string]json.RawMessage `json:"rows"`
+}
+
+// InsertResponse is the response of insert
+type InsertResponse struct {
+	InsertCount int64         `json:"insert_count"`
+	IDs         []interface{} `json:"ids"`
+}
+
+// SearchRequest is the request to search the vectors of a collection
+type SearchRequest struct {
+	CollectionName string            `json:"collection_name"`
+	PartitionNames []string          `json:"partition_names,omitempty"`
+	VectorField    string            `json:"vector_field"`
+	Vectors        []json.RawMessage `json:"vectors"`
+	TopK           int64             `json:"top_k"`
+	MetricType     string            `json:"metric_type"`
+	Params         map[string]string `json:"params,omitempty"`
+	Filter         string            `json:"filter,omitempty"`
+	OutputFields   []string          `json:"output_fields,omitempty"`
+}
+
+// SearchResponse is the response of search, there is a list of hits for each vector of the request
+type SearchResponse struct {
+	Results [][]map[string]interface{} `json:"results"`
+}
+
+// QueryRequest is the request to query the rows of a collection
+type QueryRequest struct {
+	CollectionName string   `json:"collection_name"`
+	PartitionNames []string `json:"partition_names,omitempty"`
+	Filter         string   `json:"filter"`
+	OutputFields   []string `json:"output_fields,omitempty"`
+}
+
+// QueryResponse is the response of query
+type QueryResponse struct {
+	Rows []map[string]interface{} `json:"rows"`
+}
+
+// requestError is an invalid request
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+func invalidRequest(format string, a ...interface{}) error {
+	return &requestError{err: fmt.Errorf(format, a...)}
+}
+
+// statusError is a failed status returned by proxy
+type statusError struct {
+	status *commonpb.Status
+}
+
+func (e *statusError) Error() string {
+	return e.status.Reason
+}
+
+func checkStatus(status *commonpb.Status) error {
+	if status == nil {
+		return errors.New("status is not reported")
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return &statusError{status: status}
+	}
+	return nil
+}
+
+// Server serves the RESTful APIs on top of the milvus service of proxy
+type Server struct {
+	mu      sync.RWMutex
+	service Service
+}
+
+var defaultServer = NewServer()
+
+// NewServer returns a Server without service, the APIs fail until a service is registered
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Register sets the service of the default server
+func Register(service Service) {
+	defaultServer.Register(service)
+}
+
+// Handle serves the default server on mux
+func Handle(mux *http.ServeMux) {
+	defaultServer.Handle(mux)
+}
+
+// Register sets the service of the server
+func (s *Server) Register(service Service) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.service = service
+}
+
+func (s *Server) getService() Service {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.service
+}
+
+// Handle serves the APIs on mux
+func (s *Server) Handle(mux *http.ServeMux) {
+	mux.HandleFunc(Path, s.serveAPI)
+}
+
+type handler func(ctx context.Context, service Service, r *http.Request) (interface{}, error)
+
+var routes = map[string]struct {
+	method  string
+	handler handler
+}{
+	"collections":          {http.MethodGet, showCollections},
+	"collections/describe": {http.MethodGet, describeCollection},
+	"collections/create":   {http.MethodPost, createCollection},
+	"collections/drop":     {http.MethodPost, dropCollection},
+	"collections/load":     {http.MethodPost, loadCollection},
+	"collections/release":  {http.MethodPost, releaseCollection},
+	"insert":               {http.MethodPost, insert},
+	"search":               {http.MethodPost, search},
+	"query":                {http.MethodPost, query},
+}
+
+func (s *Server) serveAPI(w http.ResponseWriter, r *http.Request) {
+	route, ok := routes[strings.TrimPrefix(r.URL.Path, Path)]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, &Error{ErrorCode: "NotFound", Reason: "unknown api " + r.URL.Path})
+		return
+	}
+	if r.Method != route.method {
+		writeError(w, r, http.StatusMethodNotAllowed, &Error{ErrorCode: "MethodNotAllowed", Reason: "method not allowed"})
+		return
+	}
+	service := s.getService()
+	if service == nil {
+		writeError(w, r, http.StatusServiceUnavailable, &Error{ErrorCode: "Unavailable", Reason: errNoService.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	resp, err := route.handler(ctx, service, r)
+	if err != nil {
+		var reqErr *requestError
+		var stErr *statusError
+		switch {
+		case errors.As(err, &reqErr):
+			writeError(w, r, http.StatusBadRequest, &Error{ErrorCode: "InvalidRequest", Reason: err.Error()})
+		case errors.As(err, &stErr):
+			writeError(w, r, http.StatusInternalServerError, &Error{ErrorCode: stErr.status.ErrorCode.String(), Reason: stErr.status.Reason})
+		default:
+			writeError(w, r, http.StatusInternalServerError, &Error{ErrorCode: commonpb.ErrorCode_UnexpectedError.String(), Reason: err.Error()})
+		}
+		return
+	}
+	writeJSON(w, r, http.StatusOK, resp)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, code int, e *Error) {
+	log.Debug("restful request failed", zap.String("path", r.URL.Path), zap.Int("code", code), zap.String("reason", e.Reason))
+	writeJSON(w, r, code, e)
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, code int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warn("failed to write the restful response", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
+
+func decodeBody(r *http.Request, req interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(req); err != nil {
+		return invalidRequest("invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+func decodeCollectionRequest(r *http.Request) (*CollectionRequest, error) {
+	req := &CollectionRequest{}
+	if err := decodeBody(r, req); err != nil {
+		return nil, err
+	}
+	if req.CollectionName == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	return req, nil
+}
+
+func showCollections(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	resp, err := service.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{Type: milvuspb.ShowType_All})
+	if err == nil {
+		err = checkStatus(resp.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	names := resp.CollectionNames
+	if names == nil {
+		names = []string{}
+	}
+	return map[string][]string{"collections": names}, nil
+}
+
+// getSchema returns the schema of a collection from proxy
+func getSchema(ctx context.Context, service Service, name string) (*schemapb.CollectionSchema, *milvuspb.DescribeCollectionResponse, error) {
+	resp, err := service.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: name})
+	if err == nil {
+		err = checkStatus(resp.Status)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Schema == nil {
+		return nil, nil, fmt.Errorf("schema of collection %s is not reported", name)
+	}
+	return resp.Schema, resp, nil
+}
+
+func describeCollection(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	name := r.URL.Query().Get("collection_name")
+	if name == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	schema, resp, err := getSchema(ctx, service, name)
+	if err != nil {
+		return nil, err
+	}
+	collection := &Collection{
+		CollectionName: name,
+		Description:    schema.Description,
+		ShardsNum:      resp.ShardsNum,
+		Fields:         make([]*Field, 0, len(schema.Fields)),
+	}
+	for _, field := range schema.Fields {
+		f := &Field{
+			Name:         field.Name,
+			Description:  field.Description,
+			DataType:     field.DataType.String(),
+			IsPrimaryKey: field.IsPrimaryKey,
+			AutoID:       field.AutoID,
+		}
+		if len(field.TypeParams) > 0 {
+			f.TypeParams = make(map[string]string, len(field.TypeParams))
+			for _, kv := range field.TypeParams {
+				f.TypeParams[kv.Key] = kv.Value
+			}
+		}
+		collection.Fields = append(collection.Fields, f)
+	}
+	return collection, nil
+}
+
+func createCollection(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req := &Collection{}
+	if err := decodeBody(r, req); err != nil {
+		return nil, err
+	}
+	if req.CollectionName == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	schema := &schemapb.CollectionSchema{
+		Name:        req.CollectionName,
+		Description: req.Description,
+		Fields:      make([]*schemapb.FieldSchema, 0, len(req.Fields)),
+	}
+	for _, field := range req.Fields {
+		dataType, ok := schemapb.DataType_value[field.DataType]
+		if !ok {
+			return nil, invalidRequest("unknown data type %s of field %s", field.DataType, field.Name)
+		}
+		if field.AutoID {
+			schema.AutoID = true
+		}
+		f := &schemapb.FieldSchema{
+			Name:         field.Name,
+			Description:  field.Description,
+			DataType:     schemapb.DataType(dataType),
+			IsPrimaryKey: field.IsPrimaryKey,
+			AutoID:       field.AutoID,
+		}
+		for k, v := range field.TypeParams {
+			f.TypeParams = append(f.TypeParams, &commonpb.KeyValuePair{Key: k, Value: v})
+		}
+		schema.Fields = append(schema.Fields, f)
+	}
+	bs, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	status, err := service.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: req.CollectionName,
+		Schema:         bs,
+		ShardsNum:      req.ShardsNum,
+	})
+	if err == nil {
+		err = checkStatus(status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func dropCollection(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req, err := decodeCollectionRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	status, err := service.DropCollection(ctx, &milvuspb.DropCollectionRequest{CollectionName: req.CollectionName})
+	if err == nil {
+		err = checkStatus(status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func loadCollection(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req, err := decodeCollectionRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	status, err := service.LoadCollection(ctx, &milvuspb.LoadCollectionRequest{CollectionName: req.CollectionName})
+	if err == nil {
+		err = checkStatus(status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func releaseCollection(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req, err := decodeCollectionRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	status, err := service.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{CollectionName: req.CollectionName})
+	if err == nil {
+		err = checkStatus(status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func insert(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req := &InsertRequest{}
+	if err := decodeBody(r, req); err != nil {
+		return nil, err
+	}
+	if req.CollectionName == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	if len(req.Rows) == 0 {
+		return nil, invalidRequest("no row to insert")
+	}
+	schema, _, err := getSchema(ctx, service, req.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	fieldsData, err := rowsToColumns(schema, req.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result, err := service.Insert(ctx, &milvuspb.InsertRequest{
+		CollectionName: req.CollectionName,
+		PartitionName:  req.PartitionName,
+		FieldsData:     fieldsData,
+		NumRows:        uint32(len(req.Rows)),
+	})
+	if err == nil {
+		err = checkStatus(result.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &InsertResponse{
+		InsertCount: result.InsertCnt,
+		IDs:         idsToValues(result.IDs),
+	}, nil
+}
+
+func search(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req := &SearchRequest{}
+	if err := decodeBody(r, req); err != nil {
+		return nil, err
+	}
+	if req.CollectionName == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	if req.VectorField == "" {
+		return nil, invalidRequest("vector_field is required")
+	}
+	if len(req.Vectors) == 0 {
+		return nil, invalidRequest("no vector to search")
+	}
+	if req.TopK <= 0 {
+		return nil, invalidRequest("top_k should be positive")
+	}
+	schema, _, err := getSchema(ctx, service, req.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	var vectorField *schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		if field.Name == req.VectorField {
+			vectorField = field
+		}
+	}
+	if vectorField == nil {
+		return nil, invalidRequest("field %s does not exist", req.VectorField)
+	}
+	placeholderGroup, err := vectorsToPlaceholderGroup(vectorField, req.Vectors)
+	if err != nil {
+		return nil, err
+	}
+	plg, err := proto.Marshal(placeholderGroup)
+	if err != nil {
+		return nil, err
+	}
+	params := req.Params
+	if params == nil {
+		params = map[string]string{}
+	}
+	paramsBs, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := service.Search(ctx, &milvuspb.SearchRequest{
+		CollectionName:   req.CollectionName,
+		PartitionNames:   req.PartitionNames,
+		Dsl:              req.Filter,
+		DslType:          commonpb.DslType_BoolExprV1,
+		PlaceholderGroup: plg,
+		OutputFields:     req.OutputFields,
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: annsFieldKey, Value: req.VectorField},
+			{Key: topKKey, Value: strconv.FormatInt(req.TopK, 10)},
+			{Key: metricTypeKey, Value: req.MetricType},
+			{Key: searchParamsKey, Value: string(paramsBs)},
+		},
+	})
+	if err == nil {
+		err = checkStatus(result.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	results, err := searchResultsToHits(result.Results, len(req.Vectors))
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{Results: results}, nil
+}
+
+func query(ctx context.Context, service Service, r *http.Request) (interface{}, error) {
+	req := &QueryRequest{}
+	if err := decodeBody(r, req); err != nil {
+		return nil, err
+	}
+	if req.CollectionName == "" {
+		return nil, invalidRequest("collection_name is required")
+	}
+	if req.Filter == "" {
+		return nil, invalidRequest("filter is required")
+	}
+	result, err := service.Query(ctx, &milvuspb.QueryRequest{
+		CollectionName: req.CollectionName,
+		PartitionNames: req.PartitionNames,
+		Expr:           req.Filter,
+		OutputFields:   req.OutputFields,
+	})
+	if err == nil {
+		err = checkStatus(result.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, err := columnsToRows(result.FieldsData)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryResponse{Rows: rows}, nil
+}
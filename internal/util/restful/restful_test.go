@@ -0,0 +1,306 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package restful
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type mockService struct {
+	schema   *schemapb.CollectionSchema
+	created  *milvuspb.CreateCollectionRequest
+	inserted *milvuspb.InsertRequest
+	searched *milvuspb.SearchRequest
+	queried  *milvuspb.QueryRequest
+}
+
+func success() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func newMockService() *mockService {
+	return &mockService{
+		schema: &schemapb.CollectionSchema{
+			Name: "c1",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int8},
+				{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+				{FieldID: 103, Name: "bin", DataType: schemapb.DataType_BinaryVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+			},
+		},
+	}
+}
+
+func (m *mockService) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.created = request
+	return success(), nil
+}
+
+func (m *mockService) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return success(), nil
+}
+
+func (m *mockService) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	if request.CollectionName != m.schema.Name {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{Status: success(), Schema: m.schema, ShardsNum: 2}, nil
+}
+
+func (m *mockService) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return &milvuspb.ShowCollectionsResponse{Status: success(), CollectionNames: []string{m.schema.Name}}, nil
+}
+
+func (m *mockService) LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	return success(), nil
+}
+
+func (m *mockService) ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "release failed"}, nil
+}
+
+func (m *mockService) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.inserted = request
+	return &milvuspb.MutationResult{
+		Status:    success(),
+		InsertCnt: int64(request.NumRows),
+		IDs:       &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+	}, nil
+}
+
+func (m *mockService) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.searched = request
+	return &milvuspb.SearchResults{
+		Status: success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       2,
+			Topks:      []int64{2, 1},
+			Scores:     []float32{0.5, 1, 2},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_Int8,
+				FieldName: "age",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{10, 20, 30}}},
+				}},
+			}},
+		},
+	}, nil
+}
+
+func (m *mockService) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	m.queried = request
+	return &milvuspb.QueryResults{
+		Status: success(),
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "pk",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				}},
+			},
+			{
+				Type:      schemapb.DataType_BinaryVector,
+				FieldName: "bin",
+				Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim:  16,
+					Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{1, 2, 3, 4}},
+				}},
+			},
+		},
+	}, nil
+}
+
+func do(mux *http.ServeMux, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return w
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) *Error {
+	e := &Error{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), e))
+	return e
+}
+
+func TestServer(t *testing.T) {
+	s := NewServer()
+	mux := http.NewServeMux()
+	s.Handle(mux)
+
+	w := do(mux, http.MethodGet, Path+"collections", "")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	service := newMockService()
+	s.Register(service)
+
+	t.Run("collections", func(t *testing.T) {
+		w := do(mux, http.MethodGet, Path+"collections", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"collections":["c1"]}`, w.Body.String())
+
+		w = do(mux, http.MethodGet, Path+"collections/describe?collection_name=c1", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		collection := &Collection{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), collection))
+		assert.Equal(t, "c1", collection.CollectionName)
+		assert.Equal(t, int32(2), collection.ShardsNum)
+		assert.Equal(t, 4, len(collection.Fields))
+		assert.Equal(t, "FloatVector", collection.Fields[2].DataType)
+		assert.Equal(t, map[string]string{"dim": "2"}, collection.Fields[2].TypeParams)
+
+		w = do(mux, http.MethodGet, Path+"collections/describe?collection_name=c2", "")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, &Error{ErrorCode: "CollectionNotExists", Reason: "collection not found"}, decodeError(t, w))
+
+		w = do(mux, http.MethodPost, Path+"collections/create", `{"collection_name":"c2","shards_num":2,"fields":[
+			{"name":"pk","data_type":"Int64","is_primary_key":true,"auto_id":true},
+			{"name":"vec","data_type":"FloatVector","type_params":{"dim":"8"}}]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "c2", service.created.CollectionName)
+		assert.Equal(t, int32(2), service.created.ShardsNum)
+		schema := &schemapb.CollectionSchema{}
+		assert.Nil(t, proto.Unmarshal(service.created.Schema, schema))
+		assert.True(t, schema.AutoID)
+		assert.Equal(t, schemapb.DataType_FloatVector, schema.Fields[1].DataType)
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}, schema.Fields[1].TypeParams)
+
+		w = do(mux, http.MethodPost, Path+"collections/create", `{"collection_name":"c2","fields":[{"name":"pk","data_type":"Unknown"}]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = do(mux, http.MethodPost, Path+"collections/load", `{"collection_name":"c1"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		w = do(mux, http.MethodPost, Path+"collections/drop", `{"collection_name":"c1"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		w = do(mux, http.MethodPost, Path+"collections/release", `{"collection_name":"c1"}`)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "release failed", decodeError(t, w).Reason)
+		w = do(mux, http.MethodPost, Path+"collections/load", `{}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		w := do(mux, http.MethodPost, Path+"insert", `{"collection_name":"c1","rows":[
+			{"age":1,"vec":[0.1,0.2],"bin":[1,2]},
+			{"age":2,"vec":[0.3,0.4],"bin":[3,4]}]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"insert_count":2,"ids":[1,2]}`, w.Body.String())
+
+		fieldsData := service.inserted.FieldsData
+		assert.Equal(t, uint32(2), service.inserted.NumRows)
+		assert.Equal(t, 3, len(fieldsData))
+		assert.Equal(t, []int32{1, 2}, fieldsData[0].GetScalars().GetIntData().GetData())
+		assert.Equal(t, int64(101), fieldsData[0].FieldId)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, fieldsData[1].GetVectors().GetFloatVector().GetData())
+		assert.Equal(t, int64(2), fieldsData[1].GetVectors().GetDim())
+		assert.Equal(t, []byte{1, 2, 3, 4}, fieldsData[2].GetVectors().GetBinaryVector())
+
+		for _, rows := range []string{
+			// auto generated primary key
+			`[{"pk":1,"age":1,"vec":[0.1,0.2],"bin":[1,2]}]`,
+			// missing field
+			`[{"vec":[0.1,0.2],"bin":[1,2]}]`,
+			// out of range of Int8
+			`[{"age":1000,"vec":[0.1,0.2],"bin":[1,2]}]`,
+			// wrong dimension
+			`[{"age":1,"vec":[0.1],"bin":[1,2]}]`,
+			`[{"age":1,"vec":[0.1,0.2],"bin":[1,256]}]`,
+			`[]`,
+		} {
+			w = do(mux, http.MethodPost, Path+"insert", `{"collection_name":"c1","rows":`+rows+`}`)
+			assert.Equal(t, http.StatusBadRequest, w.Code, rows)
+			assert.Equal(t, "InvalidRequest", decodeError(t, w).ErrorCode)
+		}
+	})
+
+	t.Run("search", func(t *testing.T) {
+		w := do(mux, http.MethodPost, Path+"search", `{"collection_name":"c1","vector_field":"vec",
+			"vectors":[[1,2],[3,4]],"top_k":2,"metric_type":"L2","params":{"nprobe":"10"},
+			"filter":"age > 1","output_fields":["age"]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"results":[
+			[{"id":1,"distance":0.5,"age":10},{"id":2,"distance":1,"age":20}],
+			[{"id":3,"distance":2,"age":30}]]}`, w.Body.String())
+
+		assert.Equal(t, "age > 1", service.searched.Dsl)
+		assert.Equal(t, commonpb.DslType_BoolExprV1, service.searched.DslType)
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: annsFieldKey, Value: "vec"},
+			{Key: topKKey, Value: "2"},
+			{Key: metricTypeKey, Value: "L2"},
+			{Key: searchParamsKey, Value: `{"nprobe":"10"}`},
+		}, service.searched.SearchParams)
+		placeholderGroup := &milvuspb.PlaceholderGroup{}
+		assert.Nil(t, proto.Unmarshal(service.searched.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, milvuspb.PlaceholderType_FloatVector, placeholderGroup.Placeholders[0].Type)
+		assert.Equal(t, 2, len(placeholderGroup.Placeholders[0].Values))
+		assert.Equal(t, []byte{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}, placeholderGroup.Placeholders[0].Values[0])
+
+		w = do(mux, http.MethodPost, Path+"search", `{"collection_name":"c1","vector_field":"bin","vectors":[[1,2]],"top_k":2}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Nil(t, proto.Unmarshal(service.searched.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, milvuspb.PlaceholderType_BinaryVector, placeholderGroup.Placeholders[0].Type)
+		assert.Equal(t, [][]byte{{1, 2}}, placeholderGroup.Placeholders[0].Values)
+
+		for _, body := range []string{
+			`{"collection_name":"c1","vector_field":"age","vectors":[[1,2]],"top_k":2}`,
+			`{"collection_name":"c1","vector_field":"unknown","vectors":[[1,2]],"top_k":2}`,
+			`{"collection_name":"c1","vector_field":"vec","vectors":[[1,2,3]],"top_k":2}`,
+			`{"collection_name":"c1","vector_field":"vec","vectors":[[1,2]],"top_k":0}`,
+			`{"collection_name":"c1","vector_field":"vec","vectors":[]}`,
+		} {
+			w = do(mux, http.MethodPost, Path+"search", body)
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		}
+	})
+
+	t.Run("query", func(t *testing.T) {
+		w := do(mux, http.MethodPost, Path+"query", `{"collection_name":"c1","filter":"pk in [1, 2]","output_fields":["bin"]}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"rows":[{"pk":1,"bin":[1,2]},{"pk":2,"bin":[3,4]}]}`, w.Body.String())
+		assert.Equal(t, "pk in [1, 2]", service.queried.Expr)
+		assert.Equal(t, []string{"bin"}, service.queried.OutputFields)
+
+		w = do(mux, http.MethodPost, Path+"query", `{"collection_name":"c1"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		w := do(mux, http.MethodGet, Path+"unknown", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		w = do(mux, http.MethodGet, Path+"insert", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		w = do(mux, http.MethodPost, Path+"query", `{"collection_name":"c1","unknown":1}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = do(mux, http.MethodPost, Path+"query", `not json`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,416 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package restful
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	idKey       = "id"
+	distanceKey = "distance"
+)
+
+// getDim returns the dimension of a vector field
+func getDim(field *schemapb.FieldSchema) (int64, error) {
+	for _, kv := range field.TypeParams {
+		if kv.Key == "dim" {
+			dim, err := strconv.ParseInt(kv.Value, 10, 64)
+			if err != nil || dim <= 0 {
+				return 0, fmt.Errorf("invalid dimension %s of field %s", kv.Value, field.Name)
+			}
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("dimension of field %s is not set", field.Name)
+}
+
+// decodeBinaryVector decodes a binary vector from a json array of bytes, the numbers in [0, 255]
+func decodeBinaryVector(raw json.RawMessage, dim int64) ([]byte, error) {
+	var values []int
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	if int64(len(values))*8 != dim {
+		return nil, fmt.Errorf("binary vector should have %d bytes, but got %d", dim/8, len(values))
+	}
+	vector := make([]byte, 0, len(values))
+	for _, v := range values {
+		if v < 0 || v > math.MaxUint8 {
+			return nil, fmt.Errorf("byte %d of binary vector out of range", v)
+		}
+		vector = append(vector, byte(v))
+	}
+	return vector, nil
+}
+
+func decodeFloatVector(raw json.RawMessage, dim int64) ([]float32, error) {
+	var vector []float32
+	if err := json.Unmarshal(raw, &vector); err != nil {
+		return nil, err
+	}
+	if int64(len(vector)) != dim {
+		return nil, fmt.Errorf("float vector should have dimension %d, but got %d", dim, len(vector))
+	}
+	return vector, nil
+}
+
+func decodeInt(raw json.RawMessage, dataType schemapb.DataType) (int32, error) {
+	var v int32
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return 0, err
+	}
+	switch dataType {
+	case schemapb.DataType_Int8:
+		if v < math.MinInt8 || v > math.MaxInt8 {
+			return 0, fmt.Errorf("%d out of range of Int8", v)
+		}
+	case schemapb.DataType_Int16:
+		if v < math.MinInt16 || v > math.MaxInt16 {
+			return 0, fmt.Errorf("%d out of range of Int16", v)
+		}
+	}
+	return v, nil
+}
+
+// rowToColumn encodes a field of all the rows by the schema of the field
+func rowToColumn(field *schemapb.FieldSchema, rows []map[string]json.RawMessage) (*schemapb.FieldData, error) {
+	values := make([]json.RawMessage, 0, len(rows))
+	for i, row := range rows {
+		value, ok := row[field.Name]
+		if !ok {
+			return nil, invalidRequest("field %s is missing in row %d", field.Name, i)
+		}
+		values = append(values, value)
+	}
+
+	fieldData := &schemapb.FieldData{
+		Type:      field.DataType,
+		FieldName: field.Name,
+		FieldId:   field.FieldID,
+	}
+	var err error
+	wrap := func(i int, err error) error {
+		return invalidRequest("invalid value of field %s in row %d: %s", field.Name, i, err.Error())
+	}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, len(values))
+		for i, value := range values {
+			if err = json.Unmarshal(value, &data[i]); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}},
+		}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, len(values))
+		for i, value := range values {
+			if data[i], err = decodeInt(value, field.DataType); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}},
+		}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, len(values))
+		for i, value := range values {
+			if err = json.Unmarshal(value, &data[i]); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+		}}
+	case schemapb.DataType_Float:
+		data := make([]float32, len(values))
+		for i, value := range values {
+			if err = json.Unmarshal(value, &data[i]); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}},
+		}}
+	case schemapb.DataType_Double:
+		data := make([]float64, len(values))
+		for i, value := range values {
+			if err = json.Unmarshal(value, &data[i]); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}},
+		}}
+	case schemapb.DataType_String:
+		data := make([]string, len(values))
+		for i, value := range values {
+			if err = json.Unmarshal(value, &data[i]); err != nil {
+				return nil, wrap(i, err)
+			}
+		}
+		fieldData.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+		}}
+	case schemapb.DataType_FloatVector:
+		dim, err := getDim(field)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]float32, 0, int64(len(values))*dim)
+		for i, value := range values {
+			vector, err := decodeFloatVector(value, dim)
+			if err != nil {
+				return nil, wrap(i, err)
+			}
+			data = append(data, vector...)
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  dim,
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+		}}
+	case schemapb.DataType_BinaryVector:
+		dim, err := getDim(field)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 0, int64(len(values))*dim/8)
+		for i, value := range values {
+			vector, err := decodeBinaryVector(value, dim)
+			if err != nil {
+				return nil, wrap(i, err)
+			}
+			data = append(data, vector...)
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  dim,
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+		}}
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.DataType.String(), field.Name)
+	}
+	return fieldData, nil
+}
+
+// rowsToColumns encodes the json rows to the columns of insert by the schema of the collection,
+// the primary key is not allowed in the rows if it is auto generated
+func rowsToColumns(schema *schemapb.CollectionSchema, rows []map[string]json.RawMessage) ([]*schemapb.FieldData, error) {
+	fields := make(map[string]bool, len(schema.Fields))
+	fieldsData := make([]*schemapb.FieldData, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			continue
+		}
+		fields[field.Name] = true
+		fieldData, err := rowToColumn(field, rows)
+		if err != nil {
+			return nil, err
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	for i, row := range rows {
+		for name := range row {
+			if !fields[name] {
+				return nil, invalidRequest("unexpected field %s in row %d", name, i)
+			}
+		}
+	}
+	return fieldsData, nil
+}
+
+// vectorsToPlaceholderGroup encodes the json vectors to search by the schema of the vector field
+func vectorsToPlaceholderGroup(field *schemapb.FieldSchema, vectors []json.RawMessage) (*milvuspb.PlaceholderGroup, error) {
+	dim, err := getDim(field)
+	if err != nil {
+		return nil, invalidRequest(err.Error())
+	}
+	placeholder := &milvuspb.PlaceholderValue{
+		Tag:    "$0",
+		Values: make([][]byte, 0, len(vectors)),
+	}
+	switch field.DataType {
+	case schemapb.DataType_FloatVector:
+		placeholder.Type = milvuspb.PlaceholderType_FloatVector
+		for i, raw := range vectors {
+			vector, err := decodeFloatVector(raw, dim)
+			if err != nil {
+				return nil, invalidRequest("invalid vector %d: %s", i, err.Error())
+			}
+			var buffer bytes.Buffer
+			if err := binary.Write(&buffer, binary.LittleEndian, vector); err != nil {
+				return nil, err
+			}
+			placeholder.Values = append(placeholder.Values, buffer.Bytes())
+		}
+	case schemapb.DataType_BinaryVector:
+		placeholder.Type = milvuspb.PlaceholderType_BinaryVector
+		for i, raw := range vectors {
+			vector, err := decodeBinaryVector(raw, dim)
+			if err != nil {
+				return nil, invalidRequest("invalid vector %d: %s", i, err.Error())
+			}
+			placeholder.Values = append(placeholder.Values, vector)
+		}
+	default:
+		return nil, invalidRequest("field %s is not a vector field", field.Name)
+	}
+	return &milvuspb.PlaceholderGroup{Placeholders: []*milvuspb.PlaceholderValue{placeholder}}, nil
+}
+
+// fieldLen returns the number of rows of a column
+func fieldLen(fieldData *schemapb.FieldData) int {
+	switch fieldData.Type {
+	case schemapb.DataType_Bool:
+		return len(fieldData.GetScalars().GetBoolData().GetData())
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return len(fieldData.GetScalars().GetIntData().GetData())
+	case schemapb.DataType_Int64:
+		return len(fieldData.GetScalars().GetLongData().GetData())
+	case schemapb.DataType_Float:
+		return len(fieldData.GetScalars().GetFloatData().GetData())
+	case schemapb.DataType_Double:
+		return len(fieldData.GetScalars().GetDoubleData().GetData())
+	case schemapb.DataType_String:
+		return len(fieldData.GetScalars().GetStringData().GetData())
+	case schemapb.DataType_FloatVector:
+		dim := fieldData.GetVectors().GetDim()
+		if dim <= 0 {
+			return 0
+		}
+		return len(fieldData.GetVectors().GetFloatVector().GetData()) / int(dim)
+	case schemapb.DataType_BinaryVector:
+		dim := fieldData.GetVectors().GetDim()
+		if dim < 8 {
+			return 0
+		}
+		return len(fieldData.GetVectors().GetBinaryVector()) / int(dim/8)
+	}
+	return 0
+}
+
+// fieldValue returns the json value of row i of a column, the bytes of binary vectors are numbers as in the requests
+func fieldValue(fieldData *schemapb.FieldData, i int) interface{} {
+	switch fieldData.Type {
+	case schemapb.DataType_Bool:
+		return fieldData.GetScalars().GetBoolData().GetData()[i]
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return fieldData.GetScalars().GetIntData().GetData()[i]
+	case schemapb.DataType_Int64:
+		return fieldData.GetScalars().GetLongData().GetData()[i]
+	case schemapb.DataType_Float:
+		return fieldData.GetScalars().GetFloatData().GetData()[i]
+	case schemapb.DataType_Double:
+		return fieldData.GetScalars().GetDoubleData().GetData()[i]
+	case schemapb.DataType_String:
+		return fieldData.GetScalars().GetStringData().GetData()[i]
+	case schemapb.DataType_FloatVector:
+		dim := int(fieldData.GetVectors().GetDim())
+		return fieldData.GetVectors().GetFloatVector().GetData()[i*dim : (i+1)*dim]
+	case schemapb.DataType_BinaryVector:
+		size := int(fieldData.GetVectors().GetDim() / 8)
+		data := fieldData.GetVectors().GetBinaryVector()[i*size : (i+1)*size]
+		vector := make([]int, 0, size)
+		for _, b := range data {
+			vector = append(vector, int(b))
+		}
+		return vector
+	}
+	return nil
+}
+
+// columnsToRows decodes the columns of a query result to json rows
+func columnsToRows(fieldsData []*schemapb.FieldData) ([]map[string]interface{}, error) {
+	num := -1
+	for _, fieldData := range fieldsData {
+		n := fieldLen(fieldData)
+		if num >= 0 && n != num {
+			return nil, fmt.Errorf("field %s has %d rows, but others have %d", fieldData.FieldName, n, num)
+		}
+		num = n
+	}
+	if num < 0 {
+		num = 0
+	}
+	rows := make([]map[string]interface{}, 0, num)
+	for i := 0; i < num; i++ {
+		row := make(map[string]interface{}, len(fieldsData))
+		for _, fieldData := range fieldsData {
+			row[fieldData.FieldName] = fieldValue(fieldData, i)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// idsToValues returns the int or string primary keys
+func idsToValues(ids *schemapb.IDs) []interface{} {
+	values := make([]interface{}, 0)
+	for _, id := range ids.GetIntId().GetData() {
+		values = append(values, id)
+	}
+	for _, id := range ids.GetStrId().GetData() {
+		values = append(values, id)
+	}
+	return values
+}
+
+// searchResultsToHits splits the flattened search results into the hits of each query vector
+func searchResultsToHits(results *schemapb.SearchResultData, nq int) ([][]map[string]interface{}, error) {
+	hits := make([][]map[string]interface{}, 0, nq)
+	if results == nil {
+		for i := 0; i < nq; i++ {
+			hits = append(hits, make([]map[string]interface{}, 0))
+		}
+		return hits, nil
+	}
+	topks := results.Topks
+	if len(topks) == 0 {
+		for i := int64(0); i < results.NumQueries; i++ {
+			topks = append(topks, results.TopK)
+		}
+	}
+	ids := idsToValues(results.Ids)
+	for _, fieldData := range results.FieldsData {
+		if n := fieldLen(fieldData); n != len(ids) {
+			return nil, fmt.Errorf("field %s has %d hits, but got %d ids", fieldData.FieldName, n, len(ids))
+		}
+	}
+	offset := 0
+	for _, topk := range topks {
+		queryHits := make([]map[string]interface{}, 0, topk)
+		for j := 0; j < int(topk); j++ {
+			if offset >= len(ids) || offset >= len(results.Scores) {
+				return nil, fmt.Errorf("search results have %d ids and %d scores, less than the topks", len(ids), len(results.Scores))
+			}
+			hit := make(map[string]interface{}, len(results.FieldsData)+2)
+			for _, fieldData := range results.FieldsData {
+				hit[fieldData.FieldName] = fieldValue(fieldData, offset)
+			}
+			hit[idKey] = ids[offset]
+			hit[distanceKey] = results.Scores[offset]
+			queryHits = append(queryHits, hit)
+			offset++
+		}
+		hits = append(hits, queryHits)
+	}
+	return hits, nil
+}
@@ -0,0 +1,384 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package secret encrypts the credentials and the other secrets saved in etcd by envelope encryption, each value is
+// encrypted by a random data key, which is wrapped by a master key of the key file or of a KMS plugin. A master key
+// is rotated by rewrapping the data keys with the new one, the values themselves are not encrypted again.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"plugin"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// ProviderKeyFile reads the master keys from a local file of `id:base64(key)` lines, the keys are of 32 bytes
+	ProviderKeyFile = "keyfile"
+	// ProviderPlugin wraps the data keys by a go plugin, such as the one binding to a cloud KMS
+	ProviderPlugin = "plugin"
+
+	// Prefix tells the encrypted values from the plain ones
+	Prefix = "enc:v1:"
+
+	// pluginWrapSymbol and pluginUnwrapSymbol are the functions exported by the plugins, of type
+	// func(keyID string, key []byte) ([]byte, error)
+	pluginWrapSymbol   = "WrapKey"
+	pluginUnwrapSymbol = "UnwrapKey"
+	// pluginInitSymbol is the optional function initializing the plugins with the plugin params, of type
+	// func(params map[string]string) error
+	pluginInitSymbol = "Init"
+
+	dataKeyBytes = 32
+)
+
+// KeyProvider wraps the data keys by the master keys
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the master key wrapping the new data keys
+	CurrentKeyID() string
+	WrapKey(keyID string, key []byte) ([]byte, error)
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Config is the encryption section of the config
+type Config struct {
+	Enabled  bool
+	Provider string
+	KeyFile  string
+	// CurrentKey is the id of the master key wrapping the new data keys, the last key of the key file if empty
+	CurrentKey string
+	PluginPath string
+	// PluginParams are passed to the Init of the plugin
+	PluginParams map[string]string
+}
+
+// LoadConfig reads the encryption section of the config
+func LoadConfig(table *paramtable.BaseTable) Config {
+	cfg := Config{
+		Enabled:      table.ParseBool("encryption.enabled", false),
+		Provider:     ProviderKeyFile,
+		PluginParams: make(map[string]string),
+	}
+	load := func(key string) string {
+		value, err := table.LoadWithDefault(key, "")
+		if err != nil {
+			panic(err)
+		}
+		return strings.TrimSpace(value)
+	}
+	if provider := load("encryption.provider"); provider != "" {
+		cfg.Provider = provider
+	}
+	cfg.KeyFile = load("encryption.keyFile")
+	cfg.CurrentKey = load("encryption.currentKey")
+	cfg.PluginPath = load("encryption.plugin.path")
+	for _, param := range strings.Split(load("encryption.plugin.params"), ",") {
+		if param = strings.TrimSpace(param); param == "" {
+			continue
+		}
+		i := strings.IndexByte(param, '=')
+		if i <= 0 {
+			panic(fmt.Sprintf("encryption.plugin.params must be key=value pairs, got %s", param))
+		}
+		cfg.PluginParams[param[:i]] = param[i+1:]
+	}
+	return cfg
+}
+
+// envelope is an encrypted value, the []byte fields are in base64 in json
+type envelope struct {
+	KeyID      string `json:"k"`
+	WrappedKey []byte `json:"w"`
+	Nonce      []byte `json:"n"`
+	Ciphertext []byte `json:"c"`
+}
+
+// Cipher encrypts and decrypts the values. It's safe to decrypt the plain values, which are returned as is,
+// and a nil Cipher doesn't encrypt
+type Cipher struct {
+	provider KeyProvider
+}
+
+// NewCipher returns a Cipher wrapping the data keys by provider
+func NewCipher(provider KeyProvider) *Cipher {
+	return &Cipher{provider: provider}
+}
+
+// NewCipherFromConfig returns the Cipher of the provider of cfg, or nil if the encryption is disabled
+func NewCipherFromConfig(cfg Config) (*Cipher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	var provider KeyProvider
+	var err error
+	switch cfg.Provider {
+	case ProviderKeyFile:
+		provider, err = NewKeyFileProvider(cfg.KeyFile, cfg.CurrentKey)
+	case ProviderPlugin:
+		provider, err = newPluginProvider(cfg.PluginPath, cfg.CurrentKey, cfg.PluginParams)
+	default:
+		err = fmt.Errorf("unknown encryption provider %s", cfg.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewCipher(provider), nil
+}
+
+// IsEncrypted tells whether the value is encrypted
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// Encrypt encrypts the value by a new data key wrapped by the current master key
+func (c *Cipher) Encrypt(value string) (string, error) {
+	if c == nil {
+		return value, nil
+	}
+	key := make([]byte, dataKeyBytes)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	env := &envelope{KeyID: c.provider.CurrentKeyID(), Nonce: make([]byte, aead.NonceSize())}
+	if _, err = rand.Read(env.Nonce); err != nil {
+		return "", err
+	}
+	if env.WrappedKey, err = c.provider.WrapKey(env.KeyID, key); err != nil {
+		return "", fmt.Errorf("wrap data key by master key %s failed: %w", env.KeyID, err)
+	}
+	env.Ciphertext = aead.Seal(nil, env.Nonce, []byte(value), nil)
+	return marshalEnvelope(env)
+}
+
+// Decrypt decrypts the encrypted value, the plain value is returned as is
+func (c *Cipher) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	if c == nil {
+		return "", errors.New("the value is encrypted but the encryption is not enabled")
+	}
+	env, err := unmarshalEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	key, err := c.provider.UnwrapKey(env.KeyID, env.WrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("unwrap data key by master key %s failed: %w", env.KeyID, err)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	plain, err := aead.Open(nil, env.Nonce, env.Ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt value failed: %w", err)
+	}
+	return string(plain), nil
+}
+
+// Rewrap returns the value of the data key wrapped by the current master key, and whether it's changed. The plain
+// values are encrypted, and the ones of the current master key are returned as is
+func (c *Cipher) Rewrap(value string) (string, bool, error) {
+	if c == nil {
+		return value, false, nil
+	}
+	if !IsEncrypted(value) {
+		encrypted, err := c.Encrypt(value)
+		return encrypted, err == nil, err
+	}
+	env, err := unmarshalEnvelope(value)
+	if err != nil {
+		return "", false, err
+	}
+	current := c.provider.CurrentKeyID()
+	if env.KeyID == current {
+		return value, false, nil
+	}
+	key, err := c.provider.UnwrapKey(env.KeyID, env.WrappedKey)
+	if err != nil {
+		return "", false, fmt.Errorf("unwrap data key by master key %s failed: %w", env.KeyID, err)
+	}
+	if env.WrappedKey, err = c.provider.WrapKey(current, key); err != nil {
+		return "", false, fmt.Errorf("wrap data key by master key %s failed: %w", current, err)
+	}
+	env.KeyID = current
+	rewrapped, err := marshalEnvelope(env)
+	return rewrapped, err == nil, err
+}
+
+func marshalEnvelope(env *envelope) (string, error) {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return "", err
+	}
+	return Prefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+func unmarshalEnvelope(value string) (*envelope, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, Prefix))
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	env := &envelope{}
+	if err = json.Unmarshal(data, env); err != nil {
+		return nil, fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	return env, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+type keyFileProvider struct {
+	keys    map[string][]byte
+	current string
+}
+
+// NewKeyFileProvider returns the KeyProvider of the master keys of the key file, the new data keys are wrapped by the
+// key of current, or by the last key of the file if current is empty. The rotated keys are kept in the file until
+// the values wrapped by them are rewrapped
+func NewKeyFileProvider(file string, current string) (KeyProvider, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	p := &keyFileProvider{keys: make(map[string][]byte), current: current}
+	last := ""
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.IndexByte(line, ':')
+		if i <= 0 {
+			return nil, fmt.Errorf("the lines of the key file %s must be id:base64(key)", file)
+		}
+		key, err := base64.StdEncoding.DecodeString(line[i+1:])
+		if err != nil || len(key) != dataKeyBytes {
+			return nil, fmt.Errorf("master key %s of the key file %s is not a base64 encoded %d bytes key", line[:i], file, dataKeyBytes)
+		}
+		last = line[:i]
+		p.keys[last] = key
+	}
+	if p.current == "" {
+		p.current = last
+	}
+	if _, ok := p.keys[p.current]; !ok {
+		return nil, fmt.Errorf("no master key %s in the key file %s", p.current, file)
+	}
+	return p, nil
+}
+
+func (p *keyFileProvider) CurrentKeyID() string {
+	return p.current
+}
+
+func (p *keyFileProvider) WrapKey(keyID string, key []byte) ([]byte, error) {
+	master, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("no master key %s", keyID)
+	}
+	aead, err := newGCM(master)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, key, []byte(keyID)), nil
+}
+
+func (p *keyFileProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	master, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("no master key %s", keyID)
+	}
+	aead, err := newGCM(master)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("malformed wrapped data key")
+	}
+	return aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+}
+
+type pluginProvider struct {
+	current string
+	wrap    func(keyID string, key []byte) ([]byte, error)
+	unwrap  func(keyID string, wrapped []byte) ([]byte, error)
+}
+
+func newPluginProvider(path string, current string, params map[string]string) (*pluginProvider, error) {
+	if current == "" {
+		return nil, errors.New("the plugin encryption provider requires encryption.currentKey")
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if sym, err := p.Lookup(pluginInitSymbol); err == nil {
+		initFn, ok := sym.(func(map[string]string) error)
+		if !ok {
+			return nil, fmt.Errorf("%s of plugin %s is not a func(map[string]string) error", pluginInitSymbol, path)
+		}
+		if err = initFn(params); err != nil {
+			return nil, fmt.Errorf("init plugin %s failed: %w", path, err)
+		}
+	}
+	provider := &pluginProvider{current: current}
+	for symbol, fn := range map[string]*func(string, []byte) ([]byte, error){
+		pluginWrapSymbol:   &provider.wrap,
+		pluginUnwrapSymbol: &provider.unwrap,
+	} {
+		sym, err := p.Lookup(symbol)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := sym.(func(string, []byte) ([]byte, error))
+		if !ok {
+			return nil, fmt.Errorf("%s of plugin %s is not a func(string, []byte) ([]byte, error)", symbol, path)
+		}
+		*fn = f
+	}
+	return provider, nil
+}
+
+func (p *pluginProvider) CurrentKeyID() string {
+	return p.current
+}
+
+func (p *pluginProvider) WrapKey(keyID string, key []byte) ([]byte, error) {
+	return p.wrap(keyID, key)
+}
+
+func (p *pluginProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	return p.unwrap(keyID, wrapped)
+}
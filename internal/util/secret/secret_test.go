@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func writeKeyFile(t *testing.T, dir string, ids ...string) string {
+	lines := []string{"# master keys"}
+	for _, id := range ids {
+		key := make([]byte, dataKeyBytes)
+		_, err := rand.Read(key)
+		assert.Nil(t, err)
+		lines = append(lines, id+":"+base64.StdEncoding.EncodeToString(key))
+	}
+	file := path.Join(dir, "keys")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(strings.Join(lines, "\n")), 0600))
+	return file
+}
+
+func TestCipher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := writeKeyFile(t, dir, "k1")
+
+	c, err := NewCipherFromConfig(Config{Enabled: true, Provider: ProviderKeyFile, KeyFile: file})
+	assert.Nil(t, err)
+	encrypted, err := c.Encrypt("secret value")
+	assert.Nil(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, encrypted, "secret value")
+	decrypted, err := c.Decrypt(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "secret value", decrypted)
+
+	// the plain values are returned as is
+	decrypted, err = c.Decrypt("plain value")
+	assert.Nil(t, err)
+	assert.Equal(t, "plain value", decrypted)
+	var disabled *Cipher
+	value, err := disabled.Encrypt("plain value")
+	assert.Nil(t, err)
+	assert.Equal(t, "plain value", value)
+	_, err = disabled.Decrypt(encrypted)
+	assert.NotNil(t, err)
+
+	// tampered
+	_, err = c.Decrypt(encrypted[:len(encrypted)-8] + "AAAAAAA=")
+	assert.NotNil(t, err)
+	_, err = c.Decrypt(Prefix + "!!!")
+	assert.NotNil(t, err)
+}
+
+func TestRewrap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := writeKeyFile(t, dir, "k1", "k2")
+
+	p1, err := NewKeyFileProvider(file, "k1")
+	assert.Nil(t, err)
+	c1 := NewCipher(p1)
+	encrypted, err := c1.Encrypt("secret value")
+	assert.Nil(t, err)
+	rewrapped, changed, err := c1.Rewrap(encrypted)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, encrypted, rewrapped)
+
+	// the last key is the current one by default
+	p2, err := NewKeyFileProvider(file, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", p2.CurrentKeyID())
+	c2 := NewCipher(p2)
+	rewrapped, changed, err = c2.Rewrap(encrypted)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	env, err := unmarshalEnvelope(rewrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", env.KeyID)
+	decrypted, err := c2.Decrypt(rewrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, "secret value", decrypted)
+
+	// the plain values are encrypted
+	rewrapped, changed, err = c2.Rewrap("plain value")
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	decrypted, err = c2.Decrypt(rewrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, "plain value", decrypted)
+
+	// the values of the removed keys can't be decrypted
+	file = writeKeyFile(t, dir, "k2")
+	p3, err := NewKeyFileProvider(file, "")
+	assert.Nil(t, err)
+	_, err = NewCipher(p3).Decrypt(encrypted)
+	assert.NotNil(t, err)
+}
+
+func TestKeyFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewKeyFileProvider(path.Join(dir, "not_exist"), "")
+	assert.NotNil(t, err)
+	file := writeKeyFile(t, dir, "k1")
+	_, err = NewKeyFileProvider(file, "k2")
+	assert.NotNil(t, err)
+
+	for _, content := range []string{"", "k1", "k1:!!!", "k1:" + base64.StdEncoding.EncodeToString([]byte("short"))} {
+		assert.Nil(t, ioutil.WriteFile(file, []byte(content), 0600))
+		_, err = NewKeyFileProvider(file, "")
+		assert.NotNil(t, err, content)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	table := &paramtable.BaseTable{}
+	table.Init()
+
+	cfg := LoadConfig(table)
+	assert.False(t, cfg.Enabled)
+	c, err := NewCipherFromConfig(cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, c)
+
+	assert.Nil(t, table.Save("encryption.enabled", "true"))
+	assert.Nil(t, table.Save("encryption.provider", ProviderPlugin))
+	assert.Nil(t, table.Save("encryption.currentKey", "projects/milvus/keys/etcd"))
+	assert.Nil(t, table.Save("encryption.plugin.params", "region=us-east-1, endpoint=https://kms"))
+	cfg = LoadConfig(table)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, ProviderPlugin, cfg.Provider)
+	assert.Equal(t, "projects/milvus/keys/etcd", cfg.CurrentKey)
+	assert.Equal(t, map[string]string{"region": "us-east-1", "endpoint": "https://kms"}, cfg.PluginParams)
+
+	_, err = NewCipherFromConfig(Config{Enabled: true, Provider: ProviderPlugin, PluginPath: "/not/exist.so", CurrentKey: "k1"})
+	assert.NotNil(t, err)
+	_, err = NewCipherFromConfig(Config{Enabled: true, Provider: ProviderPlugin, PluginPath: "/not/exist.so"})
+	assert.NotNil(t, err)
+	_, err = NewCipherFromConfig(Config{Enabled: true, Provider: "vault"})
+	assert.NotNil(t, err)
+}
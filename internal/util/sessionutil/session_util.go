@@ -21,6 +21,9 @@ const (
 	DefaultIDKey       = "id"
 	DefaultRetryTimes  = 30
 	DefaultTTL         = 10
+
+	// LabelGPU is the label of the servers which have a gpu
+	LabelGPU = "gpu"
 )
 
 type SessionEventType int
@@ -40,6 +43,8 @@ type Session struct {
 	ServerName string `json:"ServerName,omitempty"`
 	Address    string `json:"Address,omitempty"`
 	Exclusive  bool   `json:"Exclusive,omitempty"`
+	// Labels are the capabilities of the server seen by the others, they are set before Init
+	Labels map[string]string `json:"Labels,omitempty"`
 
 	etcdCli  *clientv3.Client
 	leaseID  clientv3.LeaseID
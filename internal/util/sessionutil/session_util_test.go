@@ -165,3 +165,28 @@ func TestUpdateSessions(t *testing.T) {
 	assert.Equal(t, addEventLen, 10)
 	assert.Equal(t, delEventLen, 10)
 }
+
+func TestSessionLabels(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	assert.NoError(t, err)
+	etcdEndpoints := strings.Split(endpoints, ",")
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+	etcdKV, err := etcdkv.NewEtcdKV(etcdEndpoints, metaRoot)
+	assert.NoError(t, err)
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s := NewSession(ctx, metaRoot, etcdEndpoints)
+	s.Labels = map[string]string{LabelGPU: "true"}
+	s.Init("testLabels", "testAddr", false)
+
+	sessions, _, err := s.GetSessions("testLabels")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sessions))
+	for _, session := range sessions {
+		assert.Equal(t, "true", session.Labels[LabelGPU])
+	}
+}
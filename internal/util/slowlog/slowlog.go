@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package slowlog
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// SearchType is the type of the slow search records
+	SearchType = "search"
+	// QueryType is the type of the slow query records
+	QueryType = "query"
+
+	// StrongConsistency means the request waits until all the data before it is visible
+	StrongConsistency = "Strong"
+	// CustomizedConsistency means the request waits until the data before its guarantee timestamp is visible
+	CustomizedConsistency = "Customized"
+)
+
+// ConsistencyLevel returns the consistency level of a request from its guarantee timestamp, the timestamp
+// of the request is used as the guarantee timestamp if it is not set
+func ConsistencyLevel(guaranteeTimestamp uint64, timestamp uint64) string {
+	if guaranteeTimestamp == 0 || guaranteeTimestamp >= timestamp {
+		return StrongConsistency
+	}
+	return CustomizedConsistency
+}
+
+// Stage is the time spent in a stage of a request
+type Stage struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Stages records the time of the stages of a request one by one, it is safe to record on a nil Stages
+type Stages struct {
+	mu     sync.Mutex
+	start  time.Time
+	last   time.Time
+	stages []Stage
+}
+
+// NewStages returns a Stages starting from now
+func NewStages() *Stages {
+	now := time.Now()
+	return &Stages{
+		start: now,
+		last:  now,
+	}
+}
+
+// Record records the time since the last stage as the stage name
+func (s *Stages) Record(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.stages = append(s.stages, Stage{Name: name, Duration: now.Sub(s.last)})
+	s.last = now
+}
+
+// Elapse returns the time since the beginning
+func (s *Stages) Elapse() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return time.Since(s.start)
+}
+
+// Stages returns a copy of the recorded stages
+func (s *Stages) Stages() []Stage {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Stage{}, s.stages...)
+}
+
+// Record is a request whose latency exceeds the threshold
+type Record struct {
+	Type               string
+	MsgID              int64
+	Collection         string
+	Partitions         []string
+	Expr               string
+	Nq                 int64
+	TopK               int64
+	ConsistencyLevel   string
+	GuaranteeTimestamp uint64
+	TravelTimestamp    uint64
+	Error              string
+	Latency            time.Duration
+	Stages             []Stage
+}
+
+type stagesMarshaler []Stage
+
+func (stages stagesMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, stage := range stages {
+		enc.AddInt64(stage.Name, stage.Duration.Milliseconds())
+	}
+	return nil
+}
+
+// Logger writes the records of the slow requests to a dedicated rotating log file
+type Logger struct {
+	threshold int64 // time.Duration, accessed atomically since it can be changed at runtime
+	logger    *zap.Logger
+}
+
+// NewLogger returns a Logger writing the records whose latency is not less than threshold to the file of cfg,
+// it writes to the global logger if the file name is empty. Nothing is written if threshold is not positive
+func NewLogger(role string, threshold time.Duration, cfg log.FileLogConfig) (*Logger, error) {
+	logger := log.L()
+	if len(cfg.Filename) > 0 {
+		var err error
+		logger, _, err = log.InitLogger(&log.Config{
+			Level:             "info",
+			Format:            "json",
+			File:              cfg,
+			DisableCaller:     true,
+			DisableStacktrace: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Logger{
+		threshold: int64(threshold),
+		logger:    logger.With(zap.String("role", role)),
+	}, nil
+}
+
+// SetThreshold changes the latency threshold of the slow requests
+func (l *Logger) SetThreshold(threshold time.Duration) {
+	atomic.StoreInt64(&l.threshold, int64(threshold))
+}
+
+// IsSlow returns whether a request of the latency should be logged
+func (l *Logger) IsSlow(latency time.Duration) bool {
+	if l == nil {
+		return false
+	}
+	threshold := time.Duration(atomic.LoadInt64(&l.threshold))
+	return threshold > 0 && latency >= threshold
+}
+
+// Log writes the record if the request is slow
+func (l *Logger) Log(record *Record) {
+	if !l.IsSlow(record.Latency) {
+		return
+	}
+	fields := []zap.Field{
+		zap.String("type", record.Type),
+		zap.Int64("msgID", record.MsgID),
+		zap.String("collection", record.Collection),
+		zap.Strings("partitions", record.Partitions),
+		zap.String("expr", record.Expr),
+		zap.Int64("nq", record.Nq),
+		zap.Int64("topk", record.TopK),
+		zap.String("consistencyLevel", record.ConsistencyLevel),
+		zap.Uint64("guaranteeTimestamp", record.GuaranteeTimestamp),
+		zap.Uint64("travelTimestamp", record.TravelTimestamp),
+		zap.Int64("latencyMs", record.Latency.Milliseconds()),
+		zap.Object("stagesMs", stagesMarshaler(record.Stages)),
+	}
+	if record.Error != "" {
+		fields = append(fields, zap.String("error", record.Error))
+	}
+	l.logger.Warn("slow request", fields...)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package slowlog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+func TestConsistencyLevel(t *testing.T) {
+	assert.Equal(t, StrongConsistency, ConsistencyLevel(0, 100))
+	assert.Equal(t, StrongConsistency, ConsistencyLevel(100, 100))
+	assert.Equal(t, CustomizedConsistency, ConsistencyLevel(99, 100))
+}
+
+func TestStages(t *testing.T) {
+	var nilStages *Stages
+	nilStages.Record("nil")
+	assert.Nil(t, nilStages.Stages())
+	assert.Equal(t, time.Duration(0), nilStages.Elapse())
+
+	stages := NewStages()
+	time.Sleep(time.Millisecond)
+	stages.Record("first")
+	stages.Record("second")
+	recorded := stages.Stages()
+	assert.Equal(t, 2, len(recorded))
+	assert.Equal(t, "first", recorded[0].Name)
+	assert.True(t, recorded[0].Duration >= time.Millisecond)
+	assert.True(t, stages.Elapse() >= recorded[0].Duration+recorded[1].Duration)
+}
+
+func TestLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slowlog")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	filename := path.Join(dir, "slow.log")
+
+	logger, err := NewLogger("proxy", 100*time.Millisecond, log.FileLogConfig{Filename: filename})
+	assert.Nil(t, err)
+	assert.False(t, logger.IsSlow(99*time.Millisecond))
+	assert.True(t, logger.IsSlow(100*time.Millisecond))
+
+	logger.Log(&Record{Type: SearchType, Collection: "fast", Latency: time.Millisecond})
+	logger.Log(&Record{
+		Type:             SearchType,
+		Collection:       "slow",
+		Expr:             "age > 10",
+		Nq:               2,
+		TopK:             10,
+		ConsistencyLevel: StrongConsistency,
+		Latency:          time.Second,
+		Stages:           []Stage{{Name: "execute", Duration: 900 * time.Millisecond}},
+	})
+	err = logger.logger.Sync()
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Equal(t, 1, len(lines))
+	assert.True(t, json.Valid([]byte(lines[0])))
+	assert.Contains(t, lines[0], `"collection":"slow"`)
+	assert.Contains(t, lines[0], `"role":"proxy"`)
+	assert.Contains(t, lines[0], "age > 10")
+	assert.Contains(t, lines[0], `"stagesMs":{"execute":900}`)
+
+	// the threshold is not positive
+	disabled, err := NewLogger("proxy", 0, log.FileLogConfig{})
+	assert.Nil(t, err)
+	assert.False(t, disabled.IsSlow(time.Hour))
+	disabled.SetThreshold(time.Second)
+	assert.True(t, disabled.IsSlow(time.Hour))
+	var nilLogger *Logger
+	assert.False(t, nilLogger.IsSlow(time.Hour))
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package sparse
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// The default parameters of BM25
+const (
+	DefaultK1 = 1.2
+	DefaultB  = 0.75
+)
+
+// BM25 weights the terms of the documents and the queries into the sparse rows,
+// the inner product of a document row and a query row is the BM25 score of the document.
+// The statistics of the corpus are collected by Add, the document rows should be rebuilt
+// when the statistics change a lot.
+type BM25 struct {
+	K1 float64
+	B  float64
+
+	numDocs  int64
+	numTerms int64
+	docFreq  map[uint32]int64
+}
+
+// NewBM25 returns a BM25 of an empty corpus
+func NewBM25(k1, b float64) *BM25 {
+	return &BM25{
+		K1:      k1,
+		B:       b,
+		docFreq: make(map[uint32]int64),
+	}
+}
+
+// Tokenize splits the text into the lower case terms of letters and digits
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// TermIndex returns the index of the term in the sparse rows, the terms of the same hash share the index
+func TermIndex(term string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(term))
+	return h.Sum32()
+}
+
+// Add adds a document to the statistics of the corpus
+func (m *BM25) Add(terms []string) {
+	m.numDocs++
+	m.numTerms += int64(len(terms))
+	for index := range termFreq(terms) {
+		m.docFreq[index]++
+	}
+}
+
+// DocumentRow returns the row of a document, weighted by the term frequency normalized by the document length
+func (m *BM25) DocumentRow(terms []string) ([]byte, error) {
+	avgLen := 1.0
+	if m.numDocs > 0 && m.numTerms > 0 {
+		avgLen = float64(m.numTerms) / float64(m.numDocs)
+	}
+	norm := m.K1 * (1 - m.B + m.B*float64(len(terms))/avgLen)
+
+	freq := termFreq(terms)
+	indices := make([]uint32, 0, len(freq))
+	values := make([]float32, 0, len(freq))
+	for index, tf := range freq {
+		indices = append(indices, index)
+		values = append(values, float32(tf*(m.K1+1)/(tf+norm)))
+	}
+	return Encode(indices, values)
+}
+
+// QueryRow returns the row of a query, weighted by the inverse document frequency of each term
+func (m *BM25) QueryRow(terms []string) ([]byte, error) {
+	freq := termFreq(terms)
+	indices := make([]uint32, 0, len(freq))
+	values := make([]float32, 0, len(freq))
+	for index := range freq {
+		df := float64(m.docFreq[index])
+		idf := math.Log(1 + (float64(m.numDocs)-df+0.5)/(df+0.5))
+		indices = append(indices, index)
+		values = append(values, float32(idf))
+	}
+	return Encode(indices, values)
+}
+
+func termFreq(terms []string) map[uint32]float64 {
+	freq := make(map[uint32]float64, len(terms))
+	for _, term := range terms {
+		freq[TermIndex(term)]++
+	}
+	return freq
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"milvus", "is", "a", "vector", "db2"}, Tokenize("Milvus is a vector-DB2!"))
+	assert.Empty(t, Tokenize(" ,. "))
+}
+
+func TestBM25(t *testing.T) {
+	docs := []string{
+		"the quick brown fox",
+		"the lazy dog",
+		"the quick dog jumps over the lazy fox",
+		"vector search",
+	}
+	m := NewBM25(DefaultK1, DefaultB)
+	for _, doc := range docs {
+		m.Add(Tokenize(doc))
+	}
+
+	idx := NewIndex()
+	for i, doc := range docs {
+		row, err := m.DocumentRow(Tokenize(doc))
+		assert.Nil(t, err)
+		assert.Nil(t, idx.Add(int64(i), row))
+	}
+
+	query, err := m.QueryRow(Tokenize("quick fox"))
+	assert.Nil(t, err)
+	ids, scores, err := idx.Search(query, 10, nil)
+	assert.Nil(t, err)
+	// the shorter document of both terms ranks first
+	assert.Equal(t, []int64{0, 2}, ids)
+	assert.Greater(t, scores[0], scores[1])
+
+	// the common term weighs less than the rare one
+	common, _ := m.QueryRow([]string{"the"})
+	rare, _ := m.QueryRow([]string{"vector"})
+	_, commonValues, _ := Decode(common)
+	_, rareValues, _ := Decode(rare)
+	assert.Less(t, commonValues[0], rareValues[0])
+
+	ids, _, err = idx.Search(rare, 10, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3}, ids)
+
+	empty := NewBM25(DefaultK1, DefaultB)
+	row, err := empty.DocumentRow(Tokenize("a a b"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, Len(row))
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package sparse
+
+import (
+	"errors"
+	"sort"
+)
+
+type posting struct {
+	offset int
+	value  float32
+}
+
+// Index is an inverted index of the sparse rows, which maps each index of the elements to the rows having it.
+// It is searched by the inner product, the rows sharing no index with the query are not returned.
+type Index struct {
+	ids      []int64
+	postings map[uint32][]posting
+}
+
+// NewIndex returns an empty index
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[uint32][]posting),
+	}
+}
+
+// Add adds the row of id to the index
+func (idx *Index) Add(id int64, row []byte) error {
+	if err := Validate(row); err != nil {
+		return err
+	}
+	offset := len(idx.ids)
+	idx.ids = append(idx.ids, id)
+	for i := 0; i < Len(row); i++ {
+		index, value := element(row, i)
+		idx.postings[index] = append(idx.postings[index], posting{offset: offset, value: value})
+	}
+	return nil
+}
+
+// Len returns the number of the rows in the index
+func (idx *Index) Len() int {
+	return len(idx.ids)
+}
+
+// Search returns the ids and the scores of the topK rows of the largest inner products with the query,
+// sorted by the score in descending order and then by the id. If filter is not nil,
+// only the rows whose ids pass the filter are returned.
+func (idx *Index) Search(query []byte, topK int, filter func(id int64) bool) ([]int64, []float32, error) {
+	if topK <= 0 {
+		return nil, nil, errors.New("topK should be positive")
+	}
+	if err := Validate(query); err != nil {
+		return nil, nil, err
+	}
+
+	scores := make(map[int]float32)
+	for i := 0; i < Len(query); i++ {
+		index, value := element(query, i)
+		for _, p := range idx.postings[index] {
+			scores[p.offset] += value * p.value
+		}
+	}
+
+	candidates := make([]int, 0, len(scores))
+	for offset := range scores {
+		if filter == nil || filter(idx.ids[offset]) {
+			candidates = append(candidates, offset)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		si, sj := scores[candidates[i]], scores[candidates[j]]
+		if si != sj {
+			return si > sj
+		}
+		return idx.ids[candidates[i]] < idx.ids[candidates[j]]
+	})
+	if len(candidates) > topK {
+		candidates = candidates[:topK]
+	}
+
+	ids := make([]int64, 0, len(candidates))
+	distances := make([]float32, 0, len(candidates))
+	for _, offset := range candidates {
+		ids = append(ids, idx.ids[offset])
+		distances = append(distances, scores[offset])
+	}
+	return ids, distances, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	idx := NewIndex()
+	rows := map[int64][]uint32{
+		1: {1, 2},
+		2: {2, 3},
+		3: {3, 4},
+		4: {5},
+	}
+	for _, id := range []int64{1, 2, 3, 4} {
+		values := make([]float32, len(rows[id]))
+		for i := range values {
+			values[i] = 1
+		}
+		row, err := Encode(rows[id], values)
+		assert.Nil(t, err)
+		assert.Nil(t, idx.Add(id, row))
+	}
+	assert.Equal(t, 4, idx.Len())
+	assert.NotNil(t, idx.Add(5, []byte{1}))
+
+	query, _ := Encode([]uint32{2, 3}, []float32{1, 2})
+	ids, scores, err := idx.Search(query, 10, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 3, 1}, ids)
+	assert.Equal(t, []float32{3, 2, 1}, scores)
+
+	ids, scores, err = idx.Search(query, 2, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 3}, ids)
+	assert.Equal(t, []float32{3, 2}, scores)
+
+	ids, _, err = idx.Search(query, 10, func(id int64) bool {
+		return id != 2
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 1}, ids)
+
+	tie, _ := Encode([]uint32{1, 4}, []float32{1, 1})
+	ids, _, err = idx.Search(tie, 10, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 3}, ids)
+
+	_, _, err = idx.Search(query, 0, nil)
+	assert.NotNil(t, err)
+	_, _, err = idx.Search([]byte{1}, 10, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package sparse encodes the rows of the sparse float vectors, and searches them by an inverted index.
+//
+// A row is the (uint32 index, float32 value) pairs sorted by the index, each pair is encoded as
+// 8 bytes in little endian, so a row of n non-zero elements is 8*n bytes.
+package sparse
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// elementSize is the bytes of a (index, value) pair
+const elementSize = 8
+
+// Encode returns the row of the pairs, the pairs are sorted by the index
+func Encode(indices []uint32, values []float32) ([]byte, error) {
+	if len(indices) != len(values) {
+		return nil, fmt.Errorf("%d indices but %d values", len(indices), len(values))
+	}
+	order := make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return indices[order[i]] < indices[order[j]]
+	})
+
+	row := make([]byte, len(indices)*elementSize)
+	for i, o := range order {
+		if i > 0 && indices[o] == indices[order[i-1]] {
+			return nil, fmt.Errorf("duplicated index %d", indices[o])
+		}
+		if err := checkValue(values[o]); err != nil {
+			return nil, err
+		}
+		binary.LittleEndian.PutUint32(row[i*elementSize:], indices[o])
+		binary.LittleEndian.PutUint32(row[i*elementSize+4:], math.Float32bits(values[o]))
+	}
+	return row, nil
+}
+
+// Decode returns the indices and the values of the row
+func Decode(row []byte) ([]uint32, []float32, error) {
+	if err := Validate(row); err != nil {
+		return nil, nil, err
+	}
+	n := Len(row)
+	indices := make([]uint32, n)
+	values := make([]float32, n)
+	for i := 0; i < n; i++ {
+		indices[i], values[i] = element(row, i)
+	}
+	return indices, values, nil
+}
+
+// Validate checks that the row is made of the pairs of finite values, sorted by the unique indices
+func Validate(row []byte) error {
+	if len(row)%elementSize != 0 {
+		return fmt.Errorf("invalid sparse row of %d bytes, which should be a multiple of %d", len(row), elementSize)
+	}
+	for i := 0; i < Len(row); i++ {
+		index, value := element(row, i)
+		if i > 0 {
+			prev, _ := element(row, i-1)
+			if index <= prev {
+				return fmt.Errorf("indices of sparse row are not sorted or unique at %d", index)
+			}
+		}
+		if err := checkValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Len returns the number of the non-zero elements of the row
+func Len(row []byte) int {
+	return len(row) / elementSize
+}
+
+// Dim returns the max index plus one of the row, which is 0 for an empty row
+func Dim(row []byte) int64 {
+	n := Len(row)
+	if n == 0 {
+		return 0
+	}
+	index, _ := element(row, n-1)
+	return int64(index) + 1
+}
+
+// InnerProduct returns the inner product of two valid rows
+func InnerProduct(a, b []byte) float32 {
+	var sum float32
+	i, j := 0, 0
+	for i < Len(a) && j < Len(b) {
+		ia, va := element(a, i)
+		ib, vb := element(b, j)
+		switch {
+		case ia == ib:
+			sum += va * vb
+			i++
+			j++
+		case ia < ib:
+			i++
+		default:
+			j++
+		}
+	}
+	return sum
+}
+
+func element(row []byte, i int) (uint32, float32) {
+	index := binary.LittleEndian.Uint32(row[i*elementSize:])
+	value := math.Float32frombits(binary.LittleEndian.Uint32(row[i*elementSize+4:]))
+	return index, value
+}
+
+func checkValue(value float32) error {
+	if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+		return errors.New("value of sparse row should be finite")
+	}
+	return nil
+}
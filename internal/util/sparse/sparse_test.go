@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package sparse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	row, err := Encode([]uint32{7, 1, 3}, []float32{0.7, 0.1, 0.3})
+	assert.Nil(t, err)
+	assert.Equal(t, 3*elementSize, len(row))
+	assert.Equal(t, 3, Len(row))
+	assert.Equal(t, int64(8), Dim(row))
+
+	indices, values, err := Decode(row)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{1, 3, 7}, indices)
+	assert.Equal(t, []float32{0.1, 0.3, 0.7}, values)
+
+	empty, err := Encode(nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), Dim(empty))
+
+	_, err = Encode([]uint32{1, 2}, []float32{0.1})
+	assert.NotNil(t, err)
+	_, err = Encode([]uint32{1, 1}, []float32{0.1, 0.2})
+	assert.NotNil(t, err)
+	_, err = Encode([]uint32{1}, []float32{float32(math.NaN())})
+	assert.NotNil(t, err)
+	_, err = Encode([]uint32{1}, []float32{float32(math.Inf(1))})
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	row, err := Encode([]uint32{1, 3}, []float32{0.1, 0.3})
+	assert.Nil(t, err)
+	assert.Nil(t, Validate(row))
+
+	assert.NotNil(t, Validate(row[:5]))
+	_, _, err = Decode(row[:5])
+	assert.NotNil(t, err)
+
+	unsorted := append(append([]byte{}, row[elementSize:]...), row[:elementSize]...)
+	assert.NotNil(t, Validate(unsorted))
+}
+
+func TestInnerProduct(t *testing.T) {
+	a, _ := Encode([]uint32{1, 3, 5}, []float32{1, 2, 3})
+	b, _ := Encode([]uint32{0, 3, 5, 9}, []float32{4, 5, 6, 7})
+	assert.Equal(t, float32(2*5+3*6), InnerProduct(a, b))
+	assert.Equal(t, InnerProduct(a, b), InnerProduct(b, a))
+	assert.Equal(t, float32(0), InnerProduct(a, nil))
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+const (
+	// OTLPEndpointEnv is the environment variable of the OTLP collector endpoint, spans are exported via
+	// OTLP instead of jaeger when it is set
+	OTLPEndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	otlpShutdownTimeout = 5 * time.Second
+)
+
+// otlpTracer adapts the opentelemetry bridge tracer, which only accepts http headers carriers,
+// to the text map carriers used by the grpc metadata and the msgstream properties
+type otlpTracer struct {
+	*otbridge.BridgeTracer
+}
+
+func (t *otlpTracer) Inject(sc opentracing.SpanContext, format interface{}, carrier interface{}) error {
+	if format != opentracing.TextMap && format != opentracing.HTTPHeaders {
+		return opentracing.ErrUnsupportedFormat
+	}
+	writer, ok := carrier.(opentracing.TextMapWriter)
+	if !ok {
+		return opentracing.ErrInvalidCarrier
+	}
+	header := http.Header{}
+	if err := t.BridgeTracer.Inject(sc, opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err != nil {
+		return err
+	}
+	for key := range header {
+		writer.Set(key, header.Get(key))
+	}
+	return nil
+}
+
+func (t *otlpTracer) Extract(format interface{}, carrier interface{}) (opentracing.SpanContext, error) {
+	if format != opentracing.TextMap && format != opentracing.HTTPHeaders {
+		return nil, opentracing.ErrUnsupportedFormat
+	}
+	reader, ok := carrier.(opentracing.TextMapReader)
+	if !ok {
+		return nil, opentracing.ErrInvalidCarrier
+	}
+	header := http.Header{}
+	err := reader.ForeachKey(func(key, val string) error {
+		header.Set(key, val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.BridgeTracer.Extract(opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header))
+}
+
+// otlpCloser flushes the pending spans and stops the exporter
+type otlpCloser struct {
+	provider *sdktrace.TracerProvider
+}
+
+func (c *otlpCloser) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpShutdownTimeout)
+	defer cancel()
+	return c.provider.Shutdown(ctx)
+}
+
+// newOTLPTracer returns an opentracing tracer whose spans are exported to the OTLP collector at endpoint,
+// the span context is propagated in the w3c trace context format
+func newOTLPTracer(serviceName string, endpoint string) (opentracing.Tracer, *otlpCloser, error) {
+	driver := otlpgrpc.NewDriver(
+		otlpgrpc.WithInsecure(),
+		otlpgrpc.WithEndpoint(endpoint),
+	)
+	exporter, err := otlp.NewExporter(context.Background(), driver)
+	if err != nil {
+		return nil, nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+	)
+
+	bridgeTracer := otbridge.NewBridgeTracer()
+	bridgeTracer.SetOpenTelemetryTracer(provider.Tracer(serviceName))
+	bridgeTracer.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	bridgeTracer.SetWarningHandler(func(msg string) {})
+	return &otlpTracer{BridgeTracer: bridgeTracer}, &otlpCloser{provider: provider}, nil
+}
+
+func otlpEndpointFromEnv() string {
+	return os.Getenv(OTLPEndpointEnv)
+}
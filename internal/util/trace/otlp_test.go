@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLPTracer(t *testing.T) {
+	tracer, closer, err := newOTLPTracer("test", "localhost:4317")
+	assert.Nil(t, err)
+	defer closer.Close()
+
+	sp := tracer.StartSpan("test")
+	traceID, sampled, found := InfoFromSpan(sp)
+	assert.True(t, found)
+	assert.True(t, sampled)
+	assert.Equal(t, 32, len(traceID))
+
+	// the msgstream properties are a text map carrier
+	pp := PropertiesReaderWriter{PpMap: map[string]string{}}
+	err = tracer.Inject(sp.Context(), opentracing.TextMap, pp)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, pp.PpMap["traceparent"])
+
+	sc, err := tracer.Extract(opentracing.TextMap, pp)
+	assert.Nil(t, err)
+	child := tracer.StartSpan("child", opentracing.ChildOf(sc))
+	childTraceID, _, found := InfoFromSpan(child)
+	assert.True(t, found)
+	assert.Equal(t, traceID, childTraceID)
+
+	_, err = tracer.Extract(opentracing.Binary, pp)
+	assert.Equal(t, opentracing.ErrUnsupportedFormat, err)
+	err = tracer.Inject(sp.Context(), opentracing.TextMap, struct{}{})
+	assert.Equal(t, opentracing.ErrInvalidCarrier, err)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/http"
 	"runtime"
 	"strings"
 	"sync"
@@ -36,6 +37,16 @@ func InitTracing(serviceName string) io.Closer {
 		return tracingCloser
 	}
 
+	if endpoint := otlpEndpointFromEnv(); endpoint != "" {
+		tracer, closer, err := newOTLPTracer(serviceName, endpoint)
+		if err == nil {
+			tracingCloser = closer
+			opentracing.SetGlobalTracer(tracer)
+			return tracingCloser
+		}
+		log.Error(err)
+	}
+
 	cfg := &config.Configuration{
 		ServiceName: serviceName,
 		Sampler: &config.SamplerConfig{
@@ -156,6 +167,13 @@ func InfoFromSpan(span opentracing.Span) (traceID string, sampled bool, found bo
 			sampled = spanContext.IsSampled()
 			return traceID, sampled, true
 		}
+		// the span context of other tracers is read from the w3c traceparent header, "00-{traceID}-{spanID}-{flags}"
+		header := http.Header{}
+		if err := span.Tracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err == nil {
+			if fields := strings.Split(header.Get("traceparent"), "-"); len(fields) == 4 {
+				return fields[1], fields[3] == "01", true
+			}
+		}
 	}
 	return "", false, false
 }
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// SmallestKeys returns the offsets of the limit smallest keys in the ascending order of the keys,
+// the offsets of all the keys if limit is not positive
+func SmallestKeys(keys []int64, limit int) []int {
+	offsets := make([]int, len(keys))
+	for i := range offsets {
+		offsets[i] = i
+	}
+	sort.SliceStable(offsets, func(i, j int) bool {
+		return keys[offsets[i]] < keys[offsets[j]]
+	})
+	if limit > 0 && limit < len(offsets) {
+		offsets = offsets[:limit]
+	}
+	return offsets
+}
+
+// SelectFieldData returns the rows of the fields at offsets, in the order of offsets
+func SelectFieldData(fields []*schemapb.FieldData, offsets []int) ([]*schemapb.FieldData, error) {
+	ret := make([]*schemapb.FieldData, 0, len(fields))
+	for _, field := range fields {
+		selected := &schemapb.FieldData{
+			Type:      field.Type,
+			FieldName: field.FieldName,
+			FieldId:   field.FieldId,
+		}
+		switch f := field.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			scalars := &schemapb.ScalarField{}
+			switch data := f.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				values := make([]bool, 0, len(offsets))
+				for _, offset := range offsets {
+					values = append(values, data.BoolData.Data[offset])
+				}
+				scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: values}}
+			case *schemapb.ScalarField_IntData:
+				values := make([]int32, 0, len(offsets))
+				for _, offset := range offsets {
+					values = append(values, data.IntData.Data[offset])
+				}
+				scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: values}}
+			case *schemapb.ScalarField_LongData:
+				values := make([]int64, 0, len(offsets))
+				for _, offset := range offsets {
+					values = append(values, data.LongData.Data[offset])
+				}
+				scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}}
+			case *schemapb.ScalarField_FloatData:
+				values := make([]float32, 0, len(offsets))
+				for _, offset := range offsets {
+					values = append(values, data.FloatData.Data[offset])
+				}
+				scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: values}}
+			case *schemapb.ScalarField_DoubleData:
+				values := make([]float64, 0, len(offsets))
+				for _, offset := range offsets {
+					values = append(values, data.DoubleData.Data[offset])
+				}
+				scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}}
+			default:
+				return nil, fmt.Errorf("not supported data of field %s", field.FieldName)
+			}
+			selected.Field = &schemapb.FieldData_Scalars{Scalars: scalars}
+		case *schemapb.FieldData_Vectors:
+			dim := int(f.Vectors.Dim)
+			vectors := &schemapb.VectorField{Dim: f.Vectors.Dim}
+			switch data := f.Vectors.Data.(type) {
+			case *schemapb.VectorField_FloatVector:
+				values := make([]float32, 0, len(offsets)*dim)
+				for _, offset := range offsets {
+					values = append(values, data.FloatVector.Data[offset*dim:(offset+1)*dim]...)
+				}
+				vectors.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: values}}
+			case *schemapb.VectorField_BinaryVector:
+				bytesPerRow := dim / 8
+				values := make([]byte, 0, len(offsets)*bytesPerRow)
+				for _, offset := range offsets {
+					values = append(values, data.BinaryVector[offset*bytesPerRow:(offset+1)*bytesPerRow]...)
+				}
+				vectors.Data = &schemapb.VectorField_BinaryVector{BinaryVector: values}
+			case *schemapb.VectorField_SparseFloatVector:
+				contents := make([][]byte, 0, len(offsets))
+				for _, offset := range offsets {
+					contents = append(contents, data.SparseFloatVector.Contents[offset])
+				}
+				vectors.Data = &schemapb.VectorField_SparseFloatVector{SparseFloatVector: &schemapb.SparseFloatArray{Contents: contents, Dim: data.SparseFloatVector.Dim}}
+			default:
+				return nil, fmt.Errorf("not supported data of field %s", field.FieldName)
+			}
+			selected.Field = &schemapb.FieldData_Vectors{Vectors: vectors}
+		default:
+			return nil, fmt.Errorf("not supported data of field %s", field.FieldName)
+		}
+		ret = append(ret, selected)
+	}
+	return ret, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmallestKeys(t *testing.T) {
+	keys := []int64{5, 1, 4, 2, 3}
+	assert.Equal(t, []int{1, 3, 4, 2, 0}, SmallestKeys(keys, 0))
+	assert.Equal(t, []int{1, 3}, SmallestKeys(keys, 2))
+	assert.Equal(t, []int{1, 3, 4, 2, 0}, SmallestKeys(keys, 10))
+	assert.Empty(t, SmallestKeys(nil, 2))
+}
+
+func TestSelectFieldData(t *testing.T) {
+	fields := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "pk",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3, 1, 2}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Bool,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: []bool{true, false, true}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Int32,
+			FieldId: 102,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{30, 10, 20}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Float,
+			FieldId: 103,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{3, 1, 2}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Double,
+			FieldId: 104,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{3, 1, 2}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_FloatVector,
+			FieldId: 105,
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{3, 3, 1, 1, 2, 2}}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_BinaryVector,
+			FieldId: 106,
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  16,
+				Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{3, 3, 1, 1, 2, 2}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_SparseFloatVector,
+			FieldId: 107,
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim: 100,
+				Data: &schemapb.VectorField_SparseFloatVector{SparseFloatVector: &schemapb.SparseFloatArray{
+					Contents: [][]byte{{3}, {1}, {2}},
+					Dim:      100,
+				}},
+			}},
+		},
+	}
+
+	offsets := SmallestKeys(fields[0].GetScalars().GetLongData().Data, 2)
+	selected, err := SelectFieldData(fields, offsets)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(selected))
+	assert.Equal(t, "pk", selected[0].FieldName)
+	assert.Equal(t, int64(100), selected[0].FieldId)
+	assert.Equal(t, schemapb.DataType_Int64, selected[0].Type)
+	assert.Equal(t, []int64{1, 2}, selected[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []bool{false, true}, selected[1].GetScalars().GetBoolData().Data)
+	assert.Equal(t, []int32{10, 20}, selected[2].GetScalars().GetIntData().Data)
+	assert.Equal(t, []float32{1, 2}, selected[3].GetScalars().GetFloatData().Data)
+	assert.Equal(t, []float64{1, 2}, selected[4].GetScalars().GetDoubleData().Data)
+	assert.Equal(t, int64(2), selected[5].GetVectors().Dim)
+	assert.Equal(t, []float32{1, 1, 2, 2}, selected[5].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, []byte{1, 1, 2, 2}, selected[6].GetVectors().GetBinaryVector())
+	assert.Equal(t, [][]byte{{1}, {2}}, selected[7].GetVectors().GetSparseFloatVector().Contents)
+	assert.Equal(t, int64(100), selected[7].GetVectors().GetSparseFloatVector().Dim)
+
+	_, err = SelectFieldData([]*schemapb.FieldData{{FieldName: "empty"}}, offsets)
+	assert.Error(t, err)
+}
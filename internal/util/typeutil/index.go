@@ -13,6 +13,10 @@ package typeutil
 
 import "github.com/milvus-io/milvus/internal/proto/commonpb"
 
+// IndexFormatVersion is the format version of the index files written by this release, the index coord re-encodes
+// the indexes of older versions in the background. It is bumped when the format of the index files changes
+const IndexFormatVersion int32 = 1
+
 func CompareIndexParams(indexParam1 []*commonpb.KeyValuePair, indexParam2 []*commonpb.KeyValuePair) bool {
 	if indexParam1 == nil && indexParam2 == nil {
 		return true
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package udf
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// boundCall is a call of a function with the arguments of each row
+type boundCall struct {
+	fn   *Function
+	args []func(row int) interface{}
+}
+
+func columnArg(fn *Function, t ArgType, column *schemapb.FieldData, numRows int) (func(row int) interface{}, error) {
+	scalars := column.GetScalars()
+	var arg func(row int) interface{}
+	var length int
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_IntData:
+		values := data.IntData.GetData()
+		length = len(values)
+		if t == Int {
+			arg = func(row int) interface{} { return int64(values[row]) }
+		} else {
+			arg = func(row int) interface{} { return float64(values[row]) }
+		}
+	case *schemapb.ScalarField_LongData:
+		values := data.LongData.GetData()
+		length = len(values)
+		if t == Int {
+			arg = func(row int) interface{} { return values[row] }
+		} else {
+			arg = func(row int) interface{} { return float64(values[row]) }
+		}
+	case *schemapb.ScalarField_FloatData:
+		if t != Float {
+			return nil, fmt.Errorf("function %s expects %s, got a float column", fn.Name, t.String())
+		}
+		values := data.FloatData.GetData()
+		length = len(values)
+		arg = func(row int) interface{} { return float64(values[row]) }
+	case *schemapb.ScalarField_DoubleData:
+		if t != Float {
+			return nil, fmt.Errorf("function %s expects %s, got a float column", fn.Name, t.String())
+		}
+		values := data.DoubleData.GetData()
+		length = len(values)
+		arg = func(row int) interface{} { return values[row] }
+	default:
+		return nil, fmt.Errorf("function %s expects %s, got a column of unsupported type", fn.Name, t.String())
+	}
+	if length < numRows {
+		return nil, fmt.Errorf("column of function %s has %d rows, expected %d", fn.Name, length, numRows)
+	}
+	return arg, nil
+}
+
+func numericValue(value *planpb.GenericValue) (float64, bool) {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return float64(v.Int64Val), true
+	case *planpb.GenericValue_FloatVal:
+		return v.FloatVal, true
+	default:
+		return 0, false
+	}
+}
+
+func constantArg(fn *Function, t ArgType, values []*planpb.GenericValue) (func(row int) interface{}, error) {
+	var constant interface{}
+	switch t {
+	case Int, Float:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("function %s expects %s, got %d values", fn.Name, t.String(), len(values))
+		}
+		if t == Int {
+			v, ok := values[0].GetVal().(*planpb.GenericValue_Int64Val)
+			if !ok {
+				return nil, fmt.Errorf("function %s expects %s, got %v", fn.Name, t.String(), values[0])
+			}
+			constant = v.Int64Val
+		} else {
+			v, ok := numericValue(values[0])
+			if !ok {
+				return nil, fmt.Errorf("function %s expects %s, got %v", fn.Name, t.String(), values[0])
+			}
+			constant = v
+		}
+	case FloatArray:
+		array := make([]float64, 0, len(values))
+		for _, value := range values {
+			v, ok := numericValue(value)
+			if !ok {
+				return nil, fmt.Errorf("function %s expects %s, got %v", fn.Name, t.String(), value)
+			}
+			array = append(array, v)
+		}
+		constant = array
+	}
+	// each call gets a copy of the array, so that a function can not change the arguments of the next rows
+	if array, ok := constant.([]float64); ok {
+		return func(int) interface{} { return append([]float64(nil), array...) }, nil
+	}
+	return func(int) interface{} { return constant }, nil
+}
+
+func bind(call *planpb.UDFExpr, columns map[int64]*schemapb.FieldData, numRows int) (*boundCall, error) {
+	fn, ok := Get(call.GetFunctionName())
+	if !ok {
+		return nil, fmt.Errorf("function %s is not registered", call.GetFunctionName())
+	}
+	if len(call.GetArgs()) != len(fn.Args) {
+		return nil, fmt.Errorf("function %s expects %d arguments, got %d", fn.Name, len(fn.Args), len(call.GetArgs()))
+	}
+
+	bc := &boundCall{fn: fn, args: make([]func(int) interface{}, 0, len(fn.Args))}
+	for i, t := range fn.Args {
+		a := call.GetArgs()[i]
+		var arg func(int) interface{}
+		var err error
+		if a.GetColumnInfo() != nil {
+			if t == FloatArray {
+				return nil, fmt.Errorf("function %s expects a constant %s", fn.Name, t.String())
+			}
+			column, ok := columns[a.GetColumnInfo().GetFieldId()]
+			if !ok {
+				return nil, fmt.Errorf("column %d of function %s is not retrieved", a.GetColumnInfo().GetFieldId(), fn.Name)
+			}
+			arg, err = columnArg(fn, t, column, numRows)
+		} else {
+			arg, err = constantArg(fn, t, a.GetValues())
+		}
+		if err != nil {
+			return nil, err
+		}
+		bc.args = append(bc.args, arg)
+	}
+	return bc, nil
+}
+
+func (bc *boundCall) eval(row int) (matched bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("function %s panicked: %v", bc.fn.Name, r)
+		}
+	}()
+	args := make([]interface{}, len(bc.args))
+	for i, arg := range bc.args {
+		args[i] = arg(row)
+	}
+	return bc.fn.Eval(args)
+}
+
+type filterResult struct {
+	offsets []int
+	err     error
+}
+
+// Filter returns the offsets of the rows matching all the calls in the ascending order,
+// columns are the fields data of the numRows rows by their field ids.
+//
+// The calls are evaluated in a goroutine watched by the caller, which returns an error if no call is finished
+// in the max timeout of the functions. Since a goroutine can not be stopped, the stuck one is abandoned.
+func Filter(calls []*planpb.UDFExpr, columns map[int64]*schemapb.FieldData, numRows int) ([]int, error) {
+	bound := make([]*boundCall, 0, len(calls))
+	var timeout time.Duration
+	for _, call := range calls {
+		bc, err := bind(call, columns, numRows)
+		if err != nil {
+			return nil, err
+		}
+		bound = append(bound, bc)
+		if bc.fn.timeout() > timeout {
+			timeout = bc.fn.timeout()
+		}
+	}
+	if len(bound) == 0 || numRows == 0 {
+		offsets := make([]int, numRows)
+		for i := range offsets {
+			offsets[i] = i
+		}
+		return offsets, nil
+	}
+
+	var finished int64
+	done := make(chan filterResult, 1)
+	go func() {
+		offsets := make([]int, 0, numRows)
+		for row := 0; row < numRows; row++ {
+			matched := true
+			for _, bc := range bound {
+				ok, err := bc.eval(row)
+				atomic.AddInt64(&finished, 1)
+				if err != nil {
+					done <- filterResult{err: err}
+					return
+				}
+				if !ok {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				offsets = append(offsets, row)
+			}
+		}
+		done <- filterResult{offsets: offsets}
+	}()
+
+	ticker := time.NewTicker(timeout)
+	defer ticker.Stop()
+	var last int64
+	for {
+		select {
+		case result := <-done:
+			return result.offsets, result.err
+		case <-ticker.C:
+			n := atomic.LoadInt64(&finished)
+			if n == last {
+				return nil, fmt.Errorf("a call of the user-defined functions exceeds the timeout %v", timeout)
+			}
+			last = n
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package udf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func longColumn(data ...int64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}},
+		},
+	}
+}
+
+func doubleColumn(data ...float64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type: schemapb.DataType_Double,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}},
+		},
+	}
+}
+
+func columnArgOf(fieldID int64) *planpb.UDFArg {
+	return &planpb.UDFArg{ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID}}
+}
+
+func constantArgOf(values ...float64) *planpb.UDFArg {
+	arg := &planpb.UDFArg{}
+	for _, v := range values {
+		arg.Values = append(arg.Values, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}})
+	}
+	return arg
+}
+
+func TestFilter(t *testing.T) {
+	MustRegister(&Function{
+		Name: "test_mod",
+		Args: []ArgType{Int, Int},
+		Eval: func(args []interface{}) (bool, error) {
+			return args[0].(int64)%args[1].(int64) == 0, nil
+		},
+	})
+	columns := map[int64]*schemapb.FieldData{
+		100: longColumn(1, 2, 3, 4, 5, 6),
+		101: doubleColumn(1, 5, 5, 20, 5, 5),
+		102: doubleColumn(1, 5, 5, 5, 5, -1),
+	}
+	within := &planpb.UDFExpr{
+		FunctionName: "geo_within",
+		Args:         []*planpb.UDFArg{columnArgOf(101), columnArgOf(102), constantArgOf(0, 0, 0, 10, 10, 10, 10, 0)},
+	}
+	even := &planpb.UDFExpr{
+		FunctionName: "test_mod",
+		Args: []*planpb.UDFArg{
+			columnArgOf(100),
+			{Values: []*planpb.GenericValue{{Val: &planpb.GenericValue_Int64Val{Int64Val: 2}}}},
+		},
+	}
+
+	offsets, err := Filter([]*planpb.UDFExpr{within}, columns, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2, 4}, offsets)
+
+	offsets, err = Filter([]*planpb.UDFExpr{within, even}, columns, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1}, offsets)
+
+	offsets, err = Filter(nil, columns, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2}, offsets)
+
+	invalidCalls := []*planpb.UDFExpr{
+		{FunctionName: "test_not_registered"},
+		{FunctionName: "test_mod", Args: []*planpb.UDFArg{columnArgOf(100)}},
+		// not retrieved
+		{FunctionName: "test_mod", Args: []*planpb.UDFArg{columnArgOf(103), columnArgOf(100)}},
+		// float column of an Int argument
+		{FunctionName: "test_mod", Args: []*planpb.UDFArg{columnArgOf(101), columnArgOf(100)}},
+		// float constant of an Int argument
+		{FunctionName: "test_mod", Args: []*planpb.UDFArg{columnArgOf(100), constantArgOf(2)}},
+		// array of a scalar argument
+		{FunctionName: "test_mod", Args: []*planpb.UDFArg{columnArgOf(100), constantArgOf()}},
+		// column of an array argument
+		{FunctionName: "geo_within", Args: []*planpb.UDFArg{columnArgOf(101), columnArgOf(102), columnArgOf(101)}},
+		// invalid polygon
+		{FunctionName: "geo_within", Args: []*planpb.UDFArg{columnArgOf(101), columnArgOf(102), constantArgOf(0, 0)}},
+	}
+	for _, call := range invalidCalls {
+		_, err = Filter([]*planpb.UDFExpr{call}, columns, 6)
+		assert.Error(t, err, call.String())
+	}
+
+	// the columns are shorter than the rows
+	_, err = Filter([]*planpb.UDFExpr{within}, columns, 7)
+	assert.Error(t, err)
+}
+
+func TestFilter_Sandbox(t *testing.T) {
+	MustRegister(&Function{
+		Name: "test_panic",
+		Args: []ArgType{Int},
+		Eval: func(args []interface{}) (bool, error) {
+			panic("boom")
+		},
+	})
+	block := make(chan struct{})
+	defer close(block)
+	MustRegister(&Function{
+		Name: "test_block",
+		Args: []ArgType{Int},
+		Eval: func(args []interface{}) (bool, error) {
+			<-block
+			return true, nil
+		},
+		Timeout: 10 * time.Millisecond,
+	})
+	MustRegister(&Function{
+		Name: "test_modify",
+		Args: []ArgType{FloatArray},
+		Eval: func(args []interface{}) (bool, error) {
+			array := args[0].([]float64)
+			matched := array[0] == 0
+			array[0] = 1
+			return matched, nil
+		},
+	})
+	columns := map[int64]*schemapb.FieldData{100: longColumn(1, 2)}
+
+	_, err := Filter([]*planpb.UDFExpr{{FunctionName: "test_panic", Args: []*planpb.UDFArg{columnArgOf(100)}}}, columns, 2)
+	assert.Error(t, err)
+
+	_, err = Filter([]*planpb.UDFExpr{{FunctionName: "test_block", Args: []*planpb.UDFArg{columnArgOf(100)}}}, columns, 2)
+	assert.Error(t, err)
+
+	offsets, err := Filter([]*planpb.UDFExpr{{FunctionName: "test_modify", Args: []*planpb.UDFArg{constantArgOf(0)}}}, columns, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1}, offsets)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package udf
+
+import "fmt"
+
+func init() {
+	MustRegister(&Function{
+		Name: "geo_within",
+		Args: []ArgType{Float, Float, FloatArray},
+		Eval: geoWithin,
+	})
+}
+
+// geoWithin returns whether the point (lat, lon) is within the polygon of the vertices [lat1, lon1, lat2, lon2, ...],
+// by counting the edges crossed by a ray from the point
+func geoWithin(args []interface{}) (bool, error) {
+	lat, lon, polygon := args[0].(float64), args[1].(float64), args[2].([]float64)
+	if len(polygon) < 6 || len(polygon)%2 != 0 {
+		return false, fmt.Errorf("geo_within expects a polygon of at least 3 vertices, got %d numbers", len(polygon))
+	}
+
+	within := false
+	n := len(polygon) / 2
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		lati, loni := polygon[2*i], polygon[2*i+1]
+		latj, lonj := polygon[2*j], polygon[2*j+1]
+		if (loni > lon) != (lonj > lon) && lat < (latj-lati)*(lon-loni)/(lonj-loni)+lati {
+			within = !within
+		}
+	}
+	return within, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package udf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoWithin(t *testing.T) {
+	square := []float64{0, 0, 0, 10, 10, 10, 10, 0}
+	cases := []struct {
+		lat, lon float64
+		within   bool
+	}{
+		{5, 5, true},
+		{1, 9, true},
+		{-1, 5, false},
+		{5, 11, false},
+		{20, 20, false},
+	}
+	for _, c := range cases {
+		within, err := geoWithin([]interface{}{c.lat, c.lon, square})
+		assert.Nil(t, err)
+		assert.Equal(t, c.within, within, c)
+	}
+
+	triangle := []float64{0, 0, 10, 0, 0, 10}
+	within, err := geoWithin([]interface{}{2.0, 2.0, triangle})
+	assert.Nil(t, err)
+	assert.True(t, within)
+	within, err = geoWithin([]interface{}{6.0, 6.0, triangle})
+	assert.Nil(t, err)
+	assert.False(t, within)
+
+	_, err = geoWithin([]interface{}{1.0, 1.0, []float64{0, 0, 1, 1}})
+	assert.Error(t, err)
+	_, err = geoWithin([]interface{}{1.0, 1.0, []float64{0, 0, 1, 1, 2}})
+	assert.Error(t, err)
+
+	_, ok := Get("geo_within")
+	assert.True(t, ok)
+}
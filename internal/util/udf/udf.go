@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package udf is the plugin point of the user-defined scalar functions in the filter expressions of the queries,
+// such as geo_within(lat, lon, [0, 0, 0, 10, 10, 10]). A function is registered by MustRegister in the init of
+// a package linked into the milvus binary, the proxies check the calls against it and the query nodes evaluate them
+// on the retrieved rows.
+package udf
+
+import (
+	"fmt"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ArgType is the type of an argument of a Function
+type ArgType int32
+
+const (
+	// Int is an integer column or constant, passed as int64
+	Int ArgType = iota
+	// Float is a numeric column or constant, passed as float64
+	Float
+	// FloatArray is a constant array of numbers, passed as []float64
+	FloatArray
+)
+
+func (t ArgType) String() string {
+	switch t {
+	case Int:
+		return "Int"
+	case Float:
+		return "Float"
+	case FloatArray:
+		return "FloatArray"
+	default:
+		return fmt.Sprintf("ArgType(%d)", int32(t))
+	}
+}
+
+// DefaultTimeout is the timeout of a call of the functions without a timeout
+const DefaultTimeout = 100 * time.Millisecond
+
+// Function is a user-defined function returning whether a row matches
+type Function struct {
+	Name string
+	Args []ArgType
+	// Eval is called with the arguments of a row in the types of Args, the panics are returned as errors
+	Eval func(args []interface{}) (bool, error)
+	// Timeout of a call, DefaultTimeout if 0
+	Timeout time.Duration
+}
+
+func (f *Function) timeout() time.Duration {
+	if f.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return f.Timeout
+}
+
+var (
+	mu        sync.RWMutex
+	functions = make(map[string]*Function)
+)
+
+func isValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// Register registers f, whose name should not be registered before
+func Register(f *Function) error {
+	if !isValidName(f.Name) {
+		return fmt.Errorf("invalid function name %q", f.Name)
+	}
+	if f.Eval == nil {
+		return fmt.Errorf("function %s has no Eval", f.Name)
+	}
+	for _, t := range f.Args {
+		if t < Int || t > FloatArray {
+			return fmt.Errorf("function %s has an argument of invalid type %s", f.Name, t.String())
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := functions[f.Name]; ok {
+		return fmt.Errorf("function %s is already registered", f.Name)
+	}
+	functions[f.Name] = f
+	return nil
+}
+
+// MustRegister registers f and panics if it fails
+func MustRegister(f *Function) {
+	if err := Register(f); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the registered function of name
+func Get(name string) (*Function, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	f, ok := functions[name]
+	return f, ok
+}
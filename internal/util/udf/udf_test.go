@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package udf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	eval := func(args []interface{}) (bool, error) { return true, nil }
+
+	err := Register(&Function{Name: "test_register", Args: []ArgType{Int}, Eval: eval})
+	assert.Nil(t, err)
+	f, ok := Get("test_register")
+	assert.True(t, ok)
+	assert.Equal(t, DefaultTimeout, f.timeout())
+
+	err = Register(&Function{Name: "test_register", Eval: eval})
+	assert.Error(t, err)
+	err = Register(&Function{Name: "1abc", Eval: eval})
+	assert.Error(t, err)
+	err = Register(&Function{Name: "test_no_eval"})
+	assert.Error(t, err)
+	err = Register(&Function{Name: "test_invalid_arg", Args: []ArgType{ArgType(10)}, Eval: eval})
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		MustRegister(&Function{Name: "test_register", Eval: eval})
+	})
+
+	_, ok = Get("test_not_registered")
+	assert.False(t, ok)
+
+	MustRegister(&Function{Name: "test_error", Eval: func([]interface{}) (bool, error) { return false, errors.New("error") }})
+	_, ok = Get("test_error")
+	assert.True(t, ok)
+}
+
+func TestArgType(t *testing.T) {
+	assert.Equal(t, "Int", Int.String())
+	assert.Equal(t, "Float", Float.String())
+	assert.Equal(t, "FloatArray", FloatArray.String())
+	assert.Equal(t, "ArgType(10)", ArgType(10).String())
+}